@@ -0,0 +1,161 @@
+package ddex
+
+import (
+	"encoding/xml"
+	"fmt"
+	"reflect"
+	"strings"
+	"time"
+)
+
+// NamespaceERNC is the namespace of the ERN Choreography messages, FtpAcknowledgementMessage among them
+const NamespaceERNC = "http://ddex.net/xml/ern-c/15"
+
+// fileStatuses are the values of the ErncFileStatus allowed value set in their XML spelling, which the
+// generated enum's XMLString does not preserve
+var fileStatuses = []string{
+	"ArtistRoleUnknown", "CommercialReleaseDateInvalid", "ConflictingAvailabilityPeriods", "DuplicatedPublisherNames",
+	"ErnMissing", "FileOK", "IdentifierInvalid", "IdentifierSyntaxInvalid", "InternalError", "MetadataMissing",
+	"NewReleaseMessageInvalid", "NoDealForTrackRelease", "NoDealInNewReleaseMessage",
+	"OriginalReleaseDateLaterThanReleaseDate", "PrimaryArtistNameMissing", "ResourceCorrupt", "ResourceMissing",
+	"ResourceNotMeetingSpecifications", "SignatureOrHashSumWrongOrMissing", "UnsupportedUsage", "UserDefined",
+}
+
+// FtpAcknowledgementMessage is a minimal hand-written form of the ERN Choreography acknowledgement a
+// recipient sends back after processing a delivery. The choreography XSDs are not part of this repository,
+// so there is no generated type; it marshals with encoding/xml.
+type FtpAcknowledgementMessage struct {
+	XMLName          xml.Name               `xml:"FtpAcknowledgementMessage"`
+	Xmlns            string                 `xml:"xmlns:ernc,attr"`
+	MessageVersionId string                 `xml:"MessageVersionId,attr"`
+	MessageHeader    *AcknowledgementHeader `xml:"MessageHeader"`
+	AcknowledgedFile *AcknowledgedFile      `xml:"AcknowledgedFile"`
+	FileStatus       string                 `xml:"FileStatus"`
+}
+
+// MarshalXML writes the root element in the ERN-C namespace as ernc:FtpAcknowledgementMessage, which
+// declares the ernc prefix; the child elements are unqualified, as the choreography schema defines them
+func (m *FtpAcknowledgementMessage) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	type plain FtpAcknowledgementMessage
+	copied := plain(*m)
+	if copied.Xmlns == "" {
+		copied.Xmlns = NamespaceERNC
+	}
+	start.Name = xml.Name{Local: "ernc:FtpAcknowledgementMessage"}
+	return e.EncodeElement(copied, start)
+}
+
+// AcknowledgementHeader is the MessageHeader of an FtpAcknowledgementMessage
+type AcknowledgementHeader struct {
+	MessageThreadId        string                  `xml:"MessageThreadId,omitempty"`
+	MessageId              string                  `xml:"MessageId"`
+	MessageSender          *AcknowledgementParty   `xml:"MessageSender"`
+	MessageRecipient       []*AcknowledgementParty `xml:"MessageRecipient"`
+	MessageCreatedDateTime string                  `xml:"MessageCreatedDateTime"`
+}
+
+// AcknowledgementParty is a messaging party of an FtpAcknowledgementMessage
+type AcknowledgementParty struct {
+	PartyId  string `xml:"PartyId"`
+	FullName string `xml:"PartyName>FullName,omitempty"`
+}
+
+// AcknowledgedFile identifies the acknowledged message
+type AcknowledgedFile struct {
+	MessageId string `xml:"MessageId"`
+	FileName  string `xml:"FileName,omitempty"`
+}
+
+// NewAcknowledgement builds an FtpAcknowledgementMessage for an ingested message of any generated DDEX type:
+// it acknowledges the original MessageId (and MessageFileName, if any) with status, a FileStatus of the
+// ErncFileStatus allowed value set such as "FileOK" or "InternalError" (matched case-insensitively). The
+// acknowledgement continues the original's message thread, is sent from its first MessageRecipient to its
+// MessageSender, and has the MessageId "ACK-" plus the original MessageId. The result is a
+// *FtpAcknowledgementMessage.
+func NewAcknowledgement(original interface{}, status string) (interface{}, error) {
+	fileStatus := ""
+	for _, value := range fileStatuses {
+		if strings.EqualFold(value, strings.TrimSpace(status)) {
+			fileStatus = value
+		}
+	}
+	if fileStatus == "" {
+		return nil, fmt.Errorf("unknown file status %q", status)
+	}
+
+	v := reflect.ValueOf(original)
+	for v.Kind() == reflect.Ptr || v.Kind() == reflect.Interface {
+		if v.IsNil() {
+			return nil, fmt.Errorf("original message is nil")
+		}
+		v = v.Elem()
+	}
+	var header reflect.Value
+	if v.Kind() == reflect.Struct {
+		header = v.FieldByName("MessageHeader")
+	}
+	if !header.IsValid() || header.Kind() != reflect.Ptr || header.IsNil() {
+		return nil, fmt.Errorf("%T has no MessageHeader", original)
+	}
+	header = header.Elem()
+
+	messageId := stringField(header, "MessageId")
+	if messageId == "" {
+		return nil, fmt.Errorf("original message has no MessageId")
+	}
+	threadId := stringField(header, "MessageThreadId")
+	if threadId == "" {
+		threadId = messageId
+	}
+
+	sender := acknowledgementParty(header.FieldByName("MessageSender"))
+	if sender == nil {
+		return nil, fmt.Errorf("original message has no MessageSender")
+	}
+	var recipient *AcknowledgementParty
+	if recipients := header.FieldByName("MessageRecipient"); recipients.Kind() == reflect.Slice && recipients.Len() > 0 {
+		recipient = acknowledgementParty(recipients.Index(0))
+	}
+	if recipient == nil {
+		return nil, fmt.Errorf("original message has no MessageRecipient")
+	}
+
+	return &FtpAcknowledgementMessage{
+		Xmlns:            NamespaceERNC,
+		MessageVersionId: "ern-c/15",
+		MessageHeader: &AcknowledgementHeader{
+			MessageThreadId:        threadId,
+			MessageId:              "ACK-" + messageId,
+			MessageSender:          recipient,
+			MessageRecipient:       []*AcknowledgementParty{sender},
+			MessageCreatedDateTime: time.Now().UTC().Format(time.RFC3339),
+		},
+		AcknowledgedFile: &AcknowledgedFile{MessageId: messageId, FileName: stringField(header, "MessageFileName")},
+		FileStatus:       fileStatus,
+	}, nil
+}
+
+// stringField returns the named string field of a struct value, or "" if it has none
+func stringField(v reflect.Value, name string) string {
+	if field := v.FieldByName(name); field.Kind() == reflect.String {
+		return strings.TrimSpace(field.String())
+	}
+	return ""
+}
+
+// acknowledgementParty copies the first PartyId and the FullName of a generated messaging party
+func acknowledgementParty(v reflect.Value) *AcknowledgementParty {
+	if v.Kind() != reflect.Ptr || v.IsNil() {
+		return nil
+	}
+	party := &AcknowledgementParty{}
+	if ids := partyIdsOf(v.Elem()); len(ids) > 0 {
+		party.PartyId = strings.TrimSpace(ids[0].Value)
+	}
+	walkScalars(v.Interface(), func(path string, field xmlField, value reflect.Value) {
+		if party.FullName == "" && value.Kind() == reflect.String && strings.HasSuffix(path, "/PartyName/FullName") {
+			party.FullName = strings.TrimSpace(value.String())
+		}
+	})
+	return party
+}
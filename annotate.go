@@ -0,0 +1,138 @@
+package ddex
+
+import (
+	"encoding/xml"
+	"fmt"
+	"reflect"
+	"regexp"
+	"strings"
+)
+
+// definingReferences are the elements that declare a message-local reference (A1, R0, P1, ...);
+// every other *Reference element points at one of them
+var definingReferences = map[string]bool{
+	"ResourceReference":                 true,
+	"ReleaseReference":                  true,
+	"PartyReference":                    true,
+	"ChapterReference":                  true,
+	"CueSheetReference":                 true,
+	"WorkReference":                     true,
+	"MusicalWorkReference":              true,
+	"CollectionReference":               true,
+	"TechnicalResourceDetailsReference": true,
+	"VisibilityReference":               true,
+	"RightShareReference":               true,
+	"SourceReference":                   true,
+}
+
+// titleFields are tried in order to find a human-readable name for a referenced element
+var titleFields = []string{"DisplayTitleText", "DisplayTitle", "ReferenceTitle", "Title", "PartyName", "FullName"}
+
+// referenceUsagePattern matches a leaf *Reference element in marshaled output
+var referenceUsagePattern = regexp.MustCompile(`<(\w+Reference)(?:\s[^>]*)?>([^<]*)</\w+Reference>`)
+
+// AnnotateReferences marshals a DDEX message to indented XML with a comment after each reference usage
+// naming what it resolves to, e.g. <ReleaseResourceReference>A1</ReleaseResourceReference><!-- SoundRecording: "Song Title" -->.
+// Intended for manual review; the output is still valid XML.
+func AnnotateReferences(msg interface{}) ([]byte, error) {
+	output, err := xml.MarshalIndent(msg, "", "  ")
+	if err != nil {
+		return nil, err
+	}
+
+	targets := make(map[string]string)
+	collectReferenceTargets(reflect.ValueOf(msg), targets)
+
+	return referenceUsagePattern.ReplaceAllFunc(output, func(match []byte) []byte {
+		groups := referenceUsagePattern.FindSubmatch(match)
+		if definingReferences[string(groups[1])] {
+			return match
+		}
+		label, ok := targets[strings.TrimSpace(string(groups[2]))]
+		if !ok {
+			return match
+		}
+		annotated := make([]byte, 0, len(match)+len(label)+9)
+		annotated = append(annotated, match...)
+		return append(annotated, "<!-- "+label+" -->"...)
+	}), nil
+}
+
+// collectReferenceTargets indexes every struct that declares a reference by that reference's value
+func collectReferenceTargets(v reflect.Value, targets map[string]string) {
+	switch v.Kind() {
+	case reflect.Ptr, reflect.Interface:
+		if !v.IsNil() {
+			collectReferenceTargets(v.Elem(), targets)
+		}
+	case reflect.Slice:
+		for i := 0; i < v.Len(); i++ {
+			collectReferenceTargets(v.Index(i), targets)
+		}
+	case reflect.Struct:
+		t := v.Type()
+		for i := 0; i < t.NumField(); i++ {
+			field, ok := xmlFieldOf(t.Field(i))
+			if !ok {
+				continue
+			}
+			if definingReferences[field.Name] {
+				label := referenceLabel(v)
+				for _, reference := range stringValues(v.Field(i)) {
+					targets[strings.TrimSpace(reference)] = label
+				}
+				continue
+			}
+			collectReferenceTargets(v.Field(i), targets)
+		}
+	}
+}
+
+// referenceLabel describes a referenced struct as `Type: "Title"`, or just its type when it has no title
+func referenceLabel(v reflect.Value) string {
+	for _, name := range titleFields {
+		field := v.FieldByName(name)
+		if !field.IsValid() {
+			continue
+		}
+		if title := firstText(field); title != "" {
+			// Titles may wrap across lines in the source, and "--" is not allowed inside XML comments, so
+			// every hyphen that follows another is spaced apart ("---" becomes "- - -"); the closing quote
+			// keeps a trailing hyphen away from the "-->"
+			title = strings.Join(strings.Fields(title), " ")
+			for strings.Contains(title, "--") {
+				title = strings.ReplaceAll(title, "--", "- -")
+			}
+			return fmt.Sprintf("%s: \"%s\"", v.Type().Name(), title)
+		}
+	}
+	return v.Type().Name()
+}
+
+// firstText returns the first non-empty string found in a value, depth first
+func firstText(v reflect.Value) string {
+	var text string
+	walkValue(v, "", xmlField{}, func(_ string, field xmlField, value reflect.Value) {
+		if text == "" && !field.Attr && value.Kind() == reflect.String {
+			text = strings.TrimSpace(value.String())
+		}
+	})
+	return text
+}
+
+// stringValues returns the value of a string or []string field
+func stringValues(v reflect.Value) []string {
+	switch v.Kind() {
+	case reflect.String:
+		return []string{v.String()}
+	case reflect.Slice:
+		values := make([]string, 0, v.Len())
+		for i := 0; i < v.Len(); i++ {
+			if v.Index(i).Kind() == reflect.String {
+				values = append(values, v.Index(i).String())
+			}
+		}
+		return values
+	}
+	return nil
+}
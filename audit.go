@@ -0,0 +1,116 @@
+package ddex
+
+import (
+	"fmt"
+	"reflect"
+	"strings"
+	"time"
+)
+
+// Audit is the who-sent-what-when summary of a message's MessageHeader
+type Audit struct {
+	MessageId          string
+	Sender             AuditParty
+	Recipients         []AuditParty
+	CreatedDateTime    time.Time
+	MessageControlType string
+}
+
+// AuditParty is a MessageSender or MessageRecipient; Id is the first PartyId (usually a DPID)
+type AuditParty struct {
+	Id   string
+	Name string
+}
+
+// dateTimeLayouts are the xs:dateTime forms accepted for MessageCreatedDateTime, with and without a zone
+var dateTimeLayouts = []string{
+	time.RFC3339Nano,
+	"2006-01-02T15:04:05.999999999",
+}
+
+// parseDateTime parses an xs:dateTime value. Values without a zone are taken as UTC.
+func parseDateTime(value string) (time.Time, error) {
+	value = strings.TrimSpace(value)
+	for _, layout := range dateTimeLayouts {
+		if t, err := time.Parse(layout, value); err == nil {
+			return t, nil
+		}
+	}
+	return time.Time{}, fmt.Errorf("invalid dateTime %q", value)
+}
+
+// AuditInfo extracts the audit trail of any parsed ERN, MEAD or PIE message from its MessageHeader:
+// MessageId, sender and recipients, MessageCreatedDateTime and MessageControlType
+func AuditInfo(msg interface{}) (*Audit, error) {
+	header, err := GetHeader(msg)
+	if err != nil {
+		return nil, err
+	}
+
+	audit := &Audit{
+		MessageId:          header.GetMessageId(),
+		MessageControlType: header.GetMessageControlType(),
+	}
+	if created := header.GetMessageCreatedDateTime(); created != "" {
+		if audit.CreatedDateTime, err = parseDateTime(created); err != nil {
+			return nil, fmt.Errorf("MessageCreatedDateTime: %w", err)
+		}
+	}
+
+	v := reflect.ValueOf(header).Elem()
+	audit.Sender = auditParty(v.FieldByName("MessageSender"))
+	if recipients := v.FieldByName("MessageRecipient"); recipients.Kind() == reflect.Slice {
+		for i := 0; i < recipients.Len(); i++ {
+			audit.Recipients = append(audit.Recipients, auditParty(recipients.Index(i)))
+		}
+	}
+	return audit, nil
+}
+
+// auditParty reads a MessagingParty (ERN 3) or MessagingPartyWithoutCode (ERN 4, MEAD, PIE)
+func auditParty(party reflect.Value) AuditParty {
+	if party.Kind() == reflect.Ptr {
+		if party.IsNil() {
+			return AuditParty{}
+		}
+		party = party.Elem()
+	}
+	if party.Kind() != reflect.Struct {
+		return AuditParty{}
+	}
+
+	id := textValue(party.FieldByName("PartyId"))
+	name := textValue(fieldOf(party.FieldByName("PartyName"), "FullName"))
+	return AuditParty{Id: id, Name: name}
+}
+
+// fieldOf returns field name of struct pointer v, or the zero Value
+func fieldOf(v reflect.Value, name string) reflect.Value {
+	if v.Kind() == reflect.Ptr {
+		if v.IsNil() {
+			return reflect.Value{}
+		}
+		v = v.Elem()
+	}
+	if v.Kind() != reflect.Struct {
+		return reflect.Value{}
+	}
+	return v.FieldByName(name)
+}
+
+// textValue returns the text of a string field, of the first element of a slice, or of the Value of a
+// simple-content struct
+func textValue(v reflect.Value) string {
+	switch v.Kind() {
+	case reflect.String:
+		return strings.TrimSpace(v.String())
+	case reflect.Slice:
+		if v.Len() == 0 {
+			return ""
+		}
+		return textValue(v.Index(0))
+	case reflect.Ptr:
+		return textValue(fieldOf(v, "Value"))
+	}
+	return ""
+}
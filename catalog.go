@@ -0,0 +1,83 @@
+package ddex
+
+import (
+	"bytes"
+	"encoding/xml"
+	"fmt"
+	"io"
+
+	ernv383 "github.com/alecsavvy/ddex-proto/gen/ddex/ern/v383"
+)
+
+// CatalogStreamWriter writes an ERN 3.8.3 CatalogListMessage one catalog item at a time, so that catalogs
+// too large to hold in memory can be assembled release by release
+type CatalogStreamWriter struct {
+	w       io.Writer
+	encoder *xml.Encoder
+	endTag  []byte
+	closed  bool
+}
+
+// NewCatalogStreamWriter writes the root start tag, MessageHeader and PublicationDate of shell to w and
+// returns a writer for its CatalogItems. shell carries everything but the catalog items (header, publication
+// date, root attributes and NamespaceAttrs); any CatalogItem it already holds is written first. The
+// complete output is byte-for-byte what xml.Marshal produces for shell with all written items appended.
+func NewCatalogStreamWriter(w io.Writer, shell *ernv383.CatalogListMessage) (*CatalogStreamWriter, error) {
+	if shell == nil {
+		return nil, fmt.Errorf("catalog message is nil")
+	}
+	copied, err := withNamespaceAttrs(shell, func(map[string]string) {})
+	if err != nil {
+		return nil, err
+	}
+	root := copied.(*ernv383.CatalogListMessage)
+	root.CatalogItem = nil
+
+	// Marshal the message without items and hold back its end tag until Close
+	head, err := xml.Marshal(root)
+	if err != nil {
+		return nil, err
+	}
+	endTag := []byte("</CatalogListMessage>")
+	if !bytes.HasSuffix(head, endTag) {
+		return nil, fmt.Errorf("unexpected CatalogListMessage encoding")
+	}
+	if _, err := w.Write(head[:len(head)-len(endTag)]); err != nil {
+		return nil, err
+	}
+
+	writer := &CatalogStreamWriter{w: w, encoder: xml.NewEncoder(w), endTag: endTag}
+	for _, item := range shell.GetCatalogItem() {
+		if err := writer.WriteRelease(item); err != nil {
+			return nil, err
+		}
+	}
+	return writer, nil
+}
+
+// WriteRelease writes one release of the catalog as a CatalogItem element
+func (c *CatalogStreamWriter) WriteRelease(item *ernv383.CatalogItem) error {
+	if c.closed {
+		return fmt.Errorf("catalog stream writer is closed")
+	}
+	if item == nil {
+		return fmt.Errorf("catalog item is nil")
+	}
+	if err := c.encoder.EncodeElement(item, xml.StartElement{Name: xml.Name{Local: "CatalogItem"}}); err != nil {
+		return err
+	}
+	return c.encoder.Flush()
+}
+
+// Close writes the CatalogListMessage end tag. It does not close the underlying writer.
+func (c *CatalogStreamWriter) Close() error {
+	if c.closed {
+		return nil
+	}
+	c.closed = true
+	if err := c.encoder.Close(); err != nil {
+		return err
+	}
+	_, err := c.w.Write(c.endTag)
+	return err
+}
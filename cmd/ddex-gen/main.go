@@ -3,11 +3,17 @@
 // It generates:
 // - enum_strings.go: String conversion methods for enums
 // - *.xml.go: XML marshaling methods with namespace support
+// - resources.go: Resource interface implemented by each resource type
 // - registry.go: Dynamic message type registry
 //
 // Usage:
 //
 //	ddex-gen [directory]
+//	ddex-gen -only=registry [directory]
+//	ddex-gen -json-schema ./schemas [directory]
+//	ddex-gen -openapi ./openapi.json [directory]
+//	ddex-gen -verify [directory]
+//	ddex-gen -build-tags='ddex && !nogen' [directory]
 //
 // If no directory is specified, it defaults to "./gen"
 //
@@ -34,6 +40,12 @@ func main() {
 		verbose         = flag.Bool("verbose", false, "Enable verbose logging")
 		targetDir       = flag.String("dir", "", "Target directory containing generated .pb.go files (default: ./gen)")
 		goPackagePrefix = flag.String("go-package-prefix", "", "Go package prefix for import paths (e.g., github.com/user/repo/gen)")
+		only            = flag.String("only", "", "Comma-separated artifacts to generate: registry,enums,xml,resources (default: all)")
+		jsonSchemaDir   = flag.String("json-schema", "", "Write a JSON Schema per root message to this directory (e.g., ./schemas) instead of generating Go code")
+		openAPIPath     = flag.String("openapi", "", "Write an OpenAPI 3.1 document of the pkg/ddexhttp parse API to this file (e.g., ./openapi.json) instead of generating Go code")
+		verify          = flag.Bool("verify", false, "Run go build on the generated packages and fail if they do not compile")
+		buildTags       = flag.String("build-tags", "", "Build constraint expression written as a //go:build line at the top of each generated file (e.g., \"ddex && !nogen\")")
+		banner          = flag.String("banner", "", "Header comment of the generated files (default: \"Code generated by generate-go-extensions. DO NOT EDIT.\")")
 	)
 	flag.Parse()
 
@@ -73,8 +85,46 @@ func main() {
 		fmt.Printf("Processing generated files in: %s\n\n", absDir)
 	}
 
+	// JSON Schema mode only emits schemas
+	if *jsonSchemaDir != "" {
+		if err := ddexgen.GenerateJSONSchemas(absDir, *jsonSchemaDir, *verbose); err != nil {
+			fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+			os.Exit(1)
+		}
+		if *verbose {
+			fmt.Println("\n✓ JSON Schema generation complete!")
+		}
+		return
+	}
+
+	// OpenAPI mode only emits the API document
+	if *openAPIPath != "" {
+		if err := ddexgen.GenerateOpenAPI(absDir, *openAPIPath, *verbose); err != nil {
+			fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+			os.Exit(1)
+		}
+		if *verbose {
+			fmt.Println("\n✓ OpenAPI generation complete!")
+		}
+		return
+	}
+
+	artifacts, err := ddexgen.ParseArtifacts(*only)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+		os.Exit(1)
+	}
+
 	// Generate DDEX extensions
-	if err := ddexgen.Generate(absDir, *verbose, *goPackagePrefix); err != nil {
+	opts := ddexgen.Options{
+		Verbose:         *verbose,
+		GoPackagePrefix: *goPackagePrefix,
+		Only:            artifacts,
+		Verify:          *verify,
+		BuildTags:       *buildTags,
+		Banner:          *banner,
+	}
+	if err := ddexgen.Generate(absDir, opts); err != nil {
 		fmt.Fprintf(os.Stderr, "Error: %v\n", err)
 		os.Exit(1)
 	}
@@ -0,0 +1,113 @@
+package main
+
+import (
+	"encoding/csv"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"strconv"
+	"strings"
+
+	ddex "github.com/alecsavvy/ddex-proto"
+	"github.com/alecsavvy/ddex-proto/gen"
+)
+
+// flattenColumns are the CSV columns ddex flatten can write by name
+var flattenColumns = map[string]func(row ddex.ReleaseRow) string{
+	"MessageId":        func(row ddex.ReleaseRow) string { return row.MessageId },
+	"ReleaseReference": func(row ddex.ReleaseRow) string { return row.ReleaseReference },
+	"Title":            func(row ddex.ReleaseRow) string { return row.Title },
+	"DisplayArtist":    func(row ddex.ReleaseRow) string { return row.DisplayArtist },
+	"ICPN":             func(row ddex.ReleaseRow) string { return row.ICPN },
+	"ReleaseType":      func(row ddex.ReleaseRow) string { return row.ReleaseType },
+	"TrackCount":       func(row ddex.ReleaseRow) string { return strconv.Itoa(row.TrackCount) },
+	"TerritoryCount":   func(row ddex.ReleaseRow) string { return strconv.Itoa(row.TerritoryCount) },
+}
+
+// defaultFlattenColumns are all columns in the order written without -columns
+const defaultFlattenColumns = "MessageId,ReleaseReference,Title,DisplayArtist,ICPN,ReleaseType,TrackCount,TerritoryCount"
+
+func runFlatten(args []string) error {
+	flags := flag.NewFlagSet("flatten", flag.ExitOnError)
+	var (
+		inDir   = flags.String("in", "", "Directory of DDEX XML files (searched recursively)")
+		outPath = flags.String("out", "", "CSV file to write (default: stdout)")
+		columns = flags.String("columns", defaultFlattenColumns, "Comma-separated columns to write, in order")
+		maxSize = flags.Int64("max-size", 0, "Reject files larger than this many bytes (default: no limit)")
+	)
+	flags.Parse(args)
+
+	if *inDir == "" {
+		flags.Usage()
+		return fmt.Errorf("-in is required")
+	}
+	var selected []string
+	for _, column := range strings.Split(*columns, ",") {
+		column = strings.TrimSpace(column)
+		if _, ok := flattenColumns[column]; !ok {
+			return fmt.Errorf("unknown column %q (available: %s)", column, defaultFlattenColumns)
+		}
+		selected = append(selected, column)
+	}
+
+	files, err := findXMLFiles(*inDir)
+	if err != nil {
+		return err
+	}
+
+	var out io.Writer = os.Stdout
+	if *outPath != "" {
+		file, err := os.Create(*outPath)
+		if err != nil {
+			return err
+		}
+		defer file.Close()
+		out = file
+	}
+
+	writer := csv.NewWriter(out)
+	if err := writer.Write(selected); err != nil {
+		return err
+	}
+	failed := 0
+	for _, path := range files {
+		if err := flattenFile(path, *maxSize, writer, selected); err != nil {
+			fmt.Fprintf(os.Stderr, "%s: %v\n", path, err)
+			failed++
+		}
+	}
+	writer.Flush()
+	if err := writer.Error(); err != nil {
+		return err
+	}
+
+	if failed > 0 {
+		return fmt.Errorf("%d of %d files failed to flatten", failed, len(files))
+	}
+	return nil
+}
+
+// flattenFile parses one DDEX XML file via the registry and writes a CSV row per release
+func flattenFile(path string, maxSize int64, writer *csv.Writer, columns []string) error {
+	data, err := readXMLFile(path, maxSize)
+	if err != nil {
+		return err
+	}
+
+	msg, _, _, err := gen.ParseAny(data)
+	if err != nil {
+		return err
+	}
+
+	for _, row := range ddex.FlattenReleases(msg) {
+		record := make([]string, len(columns))
+		for i, column := range columns {
+			record[i] = flattenColumns[column](row)
+		}
+		if err := writer.Write(record); err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,76 @@
+// ddex is a command-line toolkit for working with DDEX XML messages.
+//
+// Commands:
+//
+//	transcode  Convert a directory of DDEX XML files to another format (ndjson)
+//	flatten    Write the releases of a directory of DDEX XML files as CSV rows
+//	types      List every supported message type, version and namespace
+//	testdata   Report which message types and versions have real test files
+//
+// Usage:
+//
+//	ddex <command> [flags]
+//
+// Installation:
+//
+//	go install github.com/alecsavvy/ddex-proto/cmd/ddex@latest
+package main
+
+import (
+	"fmt"
+	"os"
+)
+
+const version = "0.1.0"
+
+// command is a ddex subcommand; run receives the arguments following the command name
+type command struct {
+	name    string
+	summary string
+	run     func(args []string) error
+}
+
+var commands = []command{
+	{name: "transcode", summary: "Convert a directory of DDEX XML files to another format (ndjson)", run: runTranscode},
+	{name: "flatten", summary: "Write the releases of a directory of DDEX XML files as CSV rows", run: runFlatten},
+	{name: "types", summary: "List every supported message type, version and namespace", run: runTypes},
+	{name: "testdata", summary: "Report which message types and versions have real test files (status)", run: runTestdata},
+}
+
+func main() {
+	if len(os.Args) < 2 {
+		usage()
+		os.Exit(2)
+	}
+
+	switch os.Args[1] {
+	case "-h", "-help", "--help", "help":
+		usage()
+		return
+	case "-version", "--version", "version":
+		fmt.Printf("ddex version %s\n", version)
+		return
+	}
+
+	for _, cmd := range commands {
+		if cmd.name == os.Args[1] {
+			if err := cmd.run(os.Args[2:]); err != nil {
+				fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+				os.Exit(1)
+			}
+			return
+		}
+	}
+
+	fmt.Fprintf(os.Stderr, "Error: unknown command %q\n\n", os.Args[1])
+	usage()
+	os.Exit(2)
+}
+
+func usage() {
+	fmt.Fprintf(os.Stderr, "Usage: ddex <command> [flags]\n\nCommands:\n")
+	for _, cmd := range commands {
+		fmt.Fprintf(os.Stderr, "  %-12s %s\n", cmd.name, cmd.summary)
+	}
+	fmt.Fprintf(os.Stderr, "\nRun 'ddex <command> -h' for command flags\n")
+}
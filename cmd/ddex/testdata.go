@@ -0,0 +1,132 @@
+package main
+
+import (
+	"encoding/json"
+	"flag"
+	"fmt"
+	"io/fs"
+	"os"
+	"sort"
+	"strings"
+	"text/tabwriter"
+
+	"github.com/alecsavvy/ddex-proto/gen"
+	"github.com/alecsavvy/ddex-proto/testdata"
+)
+
+// testdataRecord describes the embedded testdata of one message type and version
+type testdataRecord struct {
+	Type    string `json:"type"`
+	Version string `json:"version"`
+	// RealFiles counts the files the tests run against (GenerateTestFileMap)
+	RealFiles int `json:"realFiles"`
+	// StubFiles counts the XML files skipped as stub or skip files
+	StubFiles int `json:"stubFiles"`
+	// Status is "ok", "stub only" (a directory without real files) or "missing" (a registered version
+	// without a testdata directory)
+	Status string `json:"status"`
+}
+
+func runTestdata(args []string) error {
+	if len(args) == 0 || args[0] != "status" {
+		return fmt.Errorf("usage: ddex testdata status [-json]")
+	}
+
+	flags := flag.NewFlagSet("testdata status", flag.ExitOnError)
+	asJSON := flags.Bool("json", false, "Print a JSON array instead of a table")
+	flags.Parse(args[1:])
+
+	records, err := testdataStatus()
+	if err != nil {
+		return err
+	}
+
+	if *asJSON {
+		encoder := json.NewEncoder(os.Stdout)
+		encoder.SetIndent("", "  ")
+		return encoder.Encode(records)
+	}
+
+	w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(w, "TYPE\tVERSION\tREAL FILES\tSTUB FILES\tSTATUS")
+	gaps := 0
+	for _, record := range records {
+		if record.Status != "ok" {
+			gaps++
+		}
+		fmt.Fprintf(w, "%s\t%s\t%d\t%d\t%s\n", record.Type, record.Version, record.RealFiles, record.StubFiles, record.Status)
+	}
+	if err := w.Flush(); err != nil {
+		return err
+	}
+	fmt.Printf("\n%d of %d versions lack real test files\n", gaps, len(records))
+	return nil
+}
+
+// testdataStatus lists every message type and version discovered in the embedded testdata, plus every
+// registered one without a testdata directory, with the counts of real and stub files. Files are
+// discovered with the same testdata functions the tests use.
+func testdataStatus() ([]testdataRecord, error) {
+	discovered, err := testdata.DiscoverMessageTypesAndVersions()
+	if err != nil {
+		return nil, err
+	}
+
+	seen := make(map[string]bool)
+	var records []testdataRecord
+	for messageType, versions := range discovered {
+		for _, version := range versions {
+			files, err := testdata.GenerateTestFileMap(messageType, version)
+			if err != nil {
+				return nil, err
+			}
+			all, err := countXMLFiles(messageType, version)
+			if err != nil {
+				return nil, err
+			}
+
+			record := testdataRecord{Type: messageType, Version: version, RealFiles: len(files), StubFiles: all - len(files), Status: "ok"}
+			if len(files) == 0 {
+				record.Status = "stub only"
+			}
+			records = append(records, record)
+			seen[messageType+"/"+version] = true
+		}
+	}
+
+	for key := range gen.GetRegisteredTypes() {
+		parts := strings.Split(key, "/")
+		if len(parts) != 3 || seen[parts[0]+"/"+parts[1]] {
+			continue
+		}
+		seen[parts[0]+"/"+parts[1]] = true
+		records = append(records, testdataRecord{Type: parts[0], Version: parts[1], Status: "missing"})
+	}
+
+	sort.Slice(records, func(i, j int) bool {
+		if records[i].Type != records[j].Type {
+			return records[i].Type < records[j].Type
+		}
+		return records[i].Version < records[j].Version
+	})
+	return records, nil
+}
+
+// countXMLFiles counts the XML files, stubs included, in the testdata directory of a message type and version
+func countXMLFiles(messageType, version string) (int, error) {
+	fsys, err := testdata.GetEmbeddedFS(messageType, version)
+	if err != nil {
+		return 0, err
+	}
+	count := 0
+	err = fs.WalkDir(fsys, ".", func(path string, d fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		if !d.IsDir() && strings.HasSuffix(strings.ToLower(d.Name()), ".xml") {
+			count++
+		}
+		return nil
+	})
+	return count, err
+}
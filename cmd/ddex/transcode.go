@@ -0,0 +1,192 @@
+package main
+
+import (
+	"encoding/json"
+	"flag"
+	"fmt"
+	"io"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	ddex "github.com/alecsavvy/ddex-proto"
+	"github.com/alecsavvy/ddex-proto/gen"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+)
+
+// transcodeRecord is one line of ndjson output
+type transcodeRecord struct {
+	Type      string          `json:"type"`
+	Version   string          `json:"version"`
+	MessageID string          `json:"messageId"`
+	Payload   json.RawMessage `json:"payload"`
+}
+
+func runTranscode(args []string) error {
+	flags := flag.NewFlagSet("transcode", flag.ExitOnError)
+	var (
+		inDir   = flags.String("in", "", "Directory of DDEX XML files (searched recursively)")
+		format  = flags.String("format", "ndjson", "Output format (ndjson)")
+		maxSize = flags.Int64("max-size", 0, "Reject files larger than this many bytes (default: no limit)")
+		workers = flags.Int("workers", 1, "Number of files to transcode in parallel")
+	)
+	flags.Parse(args)
+
+	if *inDir == "" {
+		flags.Usage()
+		return fmt.Errorf("-in is required")
+	}
+	if *format != "ndjson" {
+		return fmt.Errorf("unsupported format %q (supported: ndjson)", *format)
+	}
+	if *workers < 1 {
+		return fmt.Errorf("-workers must be at least 1")
+	}
+
+	files, err := findXMLFiles(*inDir)
+	if err != nil {
+		return err
+	}
+
+	// Workers take files in order and each result has its own channel, so records are written in file
+	// order whatever order the files finish in
+	start := time.Now()
+	results := make([]chan transcodeResult, len(files))
+	for i := range results {
+		results[i] = make(chan transcodeResult, 1)
+	}
+	jobs := make(chan int)
+	go func() {
+		for i := range files {
+			jobs <- i
+		}
+		close(jobs)
+	}()
+	for range *workers {
+		go func() {
+			for i := range jobs {
+				line, err := transcodeFile(files[i], *maxSize)
+				results[i] <- transcodeResult{line: line, err: err}
+			}
+		}()
+	}
+
+	failed := 0
+	for i, path := range files {
+		result := <-results[i]
+		if result.err == nil {
+			_, result.err = os.Stdout.Write(result.line)
+		}
+		if result.err != nil {
+			fmt.Fprintf(os.Stderr, "%s: %v\n", path, result.err)
+			failed++
+		}
+	}
+
+	elapsed := time.Since(start)
+	fmt.Fprintf(os.Stderr, "Transcoded %d files in %s (%.1f files/sec)\n",
+		len(files)-failed, elapsed.Round(time.Millisecond), float64(len(files))/elapsed.Seconds())
+
+	if failed > 0 {
+		return fmt.Errorf("%d of %d files failed to transcode", failed, len(files))
+	}
+	return nil
+}
+
+// transcodeResult is the ndjson line of a transcoded file, or why it failed
+type transcodeResult struct {
+	line []byte
+	err  error
+}
+
+// transcodeFile parses one DDEX XML file via the registry and returns it as an ndjson line
+func transcodeFile(path string, maxSize int64) ([]byte, error) {
+	data, err := readXMLFile(path, maxSize)
+	if err != nil {
+		return nil, err
+	}
+
+	msg, messageType, version, err := gen.ParseAny(data)
+	if err != nil {
+		return nil, err
+	}
+
+	protoMsg, ok := msg.(proto.Message)
+	if !ok {
+		return nil, fmt.Errorf("%T is not a protobuf message", msg)
+	}
+	payload, err := protojson.Marshal(protoMsg)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal JSON: %w", err)
+	}
+
+	record := transcodeRecord{
+		Type:    messageType,
+		Version: version,
+		Payload: payload,
+	}
+	if header, err := ddex.GetHeader(msg); err == nil {
+		record.MessageID = header.GetMessageId()
+	}
+
+	line, err := json.Marshal(record)
+	if err != nil {
+		return nil, err
+	}
+	return append(line, '\n'), nil
+}
+
+// readXMLFile reads a file, refusing it without reading it when it is larger than maxSize bytes (0 for no
+// limit)
+func readXMLFile(path string, maxSize int64) ([]byte, error) {
+	file, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer file.Close()
+
+	if maxSize > 0 {
+		info, err := file.Stat()
+		if err != nil {
+			return nil, err
+		}
+		if info.Size() > maxSize {
+			return nil, fmt.Errorf("file is %d bytes, larger than -max-size %d", info.Size(), maxSize)
+		}
+	}
+
+	// A file may grow between Stat and reading it, so the read is bounded too
+	reader := io.Reader(file)
+	if maxSize > 0 {
+		reader = io.LimitReader(file, maxSize+1)
+	}
+	data, err := io.ReadAll(reader)
+	if err != nil {
+		return nil, err
+	}
+	if maxSize > 0 && int64(len(data)) > maxSize {
+		return nil, fmt.Errorf("file is larger than -max-size %d", maxSize)
+	}
+	return data, nil
+}
+
+// findXMLFiles returns the .xml files under dir in lexical order
+func findXMLFiles(dir string) ([]string, error) {
+	var files []string
+	err := filepath.WalkDir(dir, func(path string, d fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		if !d.IsDir() && strings.EqualFold(filepath.Ext(path), ".xml") {
+			files = append(files, path)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to read %s: %w", dir, err)
+	}
+	return files, nil
+}
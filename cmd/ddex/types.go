@@ -0,0 +1,106 @@
+package main
+
+import (
+	"encoding/json"
+	"flag"
+	"fmt"
+	"io/fs"
+	"os"
+	"sort"
+	"strings"
+	"text/tabwriter"
+
+	"github.com/alecsavvy/ddex-proto/gen"
+	"github.com/alecsavvy/ddex-proto/testdata"
+)
+
+// typeRecord describes one registered message type
+type typeRecord struct {
+	Type        string `json:"type"`
+	Version     string `json:"version"`
+	MessageName string `json:"messageName"`
+	RootElement string `json:"rootElement"`
+	Namespace   string `json:"namespace"`
+	// TestFiles counts the embedded testdata files detected as this message
+	TestFiles int `json:"testFiles"`
+}
+
+func runTypes(args []string) error {
+	flags := flag.NewFlagSet("types", flag.ExitOnError)
+	asJSON := flags.Bool("json", false, "Print a JSON array instead of a table")
+	flags.Parse(args)
+
+	coverage, err := testdataCoverage()
+	if err != nil {
+		return err
+	}
+
+	var records []typeRecord
+	for key, info := range gen.GetRegisteredTypes() {
+		parts := strings.Split(key, "/")
+		if len(parts) != 3 {
+			continue
+		}
+		records = append(records, typeRecord{
+			Type:        parts[0],
+			Version:     parts[1],
+			MessageName: parts[2],
+			RootElement: info.RootElement,
+			Namespace:   info.Namespace,
+			TestFiles:   coverage[key],
+		})
+	}
+	sort.Slice(records, func(i, j int) bool {
+		if records[i].Type != records[j].Type {
+			return records[i].Type < records[j].Type
+		}
+		if records[i].Version != records[j].Version {
+			return records[i].Version < records[j].Version
+		}
+		return records[i].MessageName < records[j].MessageName
+	})
+
+	if *asJSON {
+		encoder := json.NewEncoder(os.Stdout)
+		encoder.SetIndent("", "  ")
+		return encoder.Encode(records)
+	}
+
+	w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(w, "TYPE\tVERSION\tROOT ELEMENT\tNAMESPACE\tTEST FILES")
+	for _, record := range records {
+		tested := "-"
+		if record.TestFiles > 0 {
+			tested = fmt.Sprint(record.TestFiles)
+		}
+		fmt.Fprintf(w, "%s\t%s\t%s\t%s\t%s\n", record.Type, record.Version, record.RootElement, record.Namespace, tested)
+	}
+	return w.Flush()
+}
+
+// testdataCoverage counts the embedded testdata files (excluding stubs) by the registry key of the
+// message they are detected as
+func testdataCoverage() (map[string]int, error) {
+	coverage := make(map[string]int)
+	err := fs.WalkDir(testdata.DDEXTestDataFS, "ddex", func(path string, d fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		name := strings.ToLower(d.Name())
+		if d.IsDir() || !strings.HasSuffix(name, ".xml") || strings.Contains(name, "stub") {
+			return nil
+		}
+
+		data, err := testdata.DDEXTestDataFS.ReadFile(path)
+		if err != nil {
+			return err
+		}
+		messageType, version, messageName, err := gen.DetectMessageType(data)
+		if err != nil {
+			return nil
+		}
+		coverage[messageType+"/"+version+"/"+messageName]++
+		return nil
+	})
+	return coverage, err
+}
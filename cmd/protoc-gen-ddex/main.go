@@ -8,8 +8,12 @@
 // Usage:
 //
 //	protoc-gen-ddex [directory]
+//	protoc-gen-ddex -out ./gen-ddex [directory]
+//	protoc-gen-ddex -dry-run [directory]
 //
-// If no directory is specified, it defaults to "./gen"
+// If no directory is specified, it defaults to "./gen". With -out, generated files are written to a
+// mirrored tree along with an overlay.json for `go build -overlay`. With -dry-run, it only reports which
+// files it would modify or write.
 //
 // Example:
 //
@@ -26,6 +30,7 @@
 package main
 
 import (
+	"bytes"
 	"flag"
 	"fmt"
 	"os"
@@ -44,6 +49,8 @@ func main() {
 		verbose         = flag.Bool("verbose", false, "Enable verbose logging")
 		targetDir       = flag.String("dir", "", "Target directory containing generated .pb.go files (default: ./gen)")
 		goPackagePrefix = flag.String("go-package-prefix", "", "Go package prefix for import paths (e.g., github.com/user/repo/gen)")
+		outDir          = flag.String("out", "", "Write generated files to a mirrored tree under this directory instead of next to the .pb.go files")
+		dryRun          = flag.Bool("dry-run", false, "Report the files that would be modified or written without changing anything")
 	)
 	flag.Parse()
 
@@ -81,17 +88,31 @@ func main() {
 	fmt.Printf("protoc-gen-ddex v%s\n", version)
 	fmt.Printf("Processing generated files in: %s\n\n", absDir)
 
+	opts := ddexgen.Options{
+		Verbose:         *verbose,
+		GoPackagePrefix: *goPackagePrefix,
+		OutDir:          *outDir,
+	}
+
+	if *dryRun {
+		if err := printDryRun(absDir, opts); err != nil {
+			fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+			os.Exit(1)
+		}
+		return
+	}
+
 	// Step 1: Inject XML tags into .pb.go files
 	fmt.Println("Step 1: Injecting XML tags into .pb.go files...")
-	if err := injectTagsIntoDirectory(absDir, *verbose); err != nil {
+	if _, err := injectTagsIntoDirectory(absDir, *verbose, false); err != nil {
 		fmt.Fprintf(os.Stderr, "Error injecting tags: %v\n", err)
 		os.Exit(1)
 	}
 	fmt.Println("✓ XML tags injected")
 
-	// Step 2: Generate Go extensions (enum_strings.go, *.xml.go, registry.go)
+	// Step 2: Generate Go extensions (enum_strings.go, *.xml.go, resources.go, registry.go)
 	fmt.Println("Step 2: Generating Go extensions...")
-	if err := ddexgen.Generate(absDir, *verbose, *goPackagePrefix); err != nil {
+	if err := ddexgen.Generate(absDir, opts); err != nil {
 		fmt.Fprintf(os.Stderr, "Error generating extensions: %v\n", err)
 		os.Exit(1)
 	}
@@ -102,13 +123,49 @@ func main() {
 	fmt.Println("  - XML struct tags injected into .pb.go files")
 	fmt.Println("  - enum_strings.go (enum String() methods)")
 	fmt.Println("  - *.xml.go (XML marshaling with namespace support)")
+	fmt.Println("  - resources.go (Resource interface of the resource types)")
 	if *goPackagePrefix != "" {
 		fmt.Println("  - registry.go (dynamic message type registry)")
 	}
+	if *outDir != "" {
+		fmt.Printf("\nGenerated files were written to %s\n", *outDir)
+		fmt.Printf("Build with: go build -overlay=%s ./...\n", filepath.Join(*outDir, "overlay.json"))
+	}
 }
 
-// injectTagsIntoDirectory injects XML struct tags into all .pb.go files in a directory
-func injectTagsIntoDirectory(targetDir string, verbose bool) error {
+// printDryRun reports what a run over targetDir would modify or write
+func printDryRun(targetDir string, opts ddexgen.Options) error {
+	injected, err := injectTagsIntoDirectory(targetDir, opts.Verbose, true)
+	if err != nil {
+		return err
+	}
+	plan, err := ddexgen.PlanGenerate(targetDir, opts)
+	if err != nil {
+		return err
+	}
+
+	fmt.Println("Dry run: no files were modified")
+	fmt.Printf("  - %d .pb.go files would get XML tags injected\n", injected)
+	fmt.Printf("  - %d enum_strings.go files would be written\n", len(plan.EnumFiles))
+	fmt.Printf("  - %d *.xml.go files would be written\n", len(plan.XMLFiles))
+	fmt.Printf("  - %d resources.go files would be written\n", len(plan.ResourceFiles))
+	if plan.Registry != "" {
+		fmt.Printf("  - registry.go would be written to %s\n", plan.Registry)
+	} else {
+		fmt.Println("  - registry.go would not be generated")
+	}
+	if opts.Verbose {
+		files := append(append(plan.EnumFiles, plan.XMLFiles...), plan.ResourceFiles...)
+		for _, file := range files {
+			fmt.Printf("    %s\n", file)
+		}
+	}
+	return nil
+}
+
+// injectTagsIntoDirectory injects XML struct tags into all .pb.go files in a directory and returns how many
+// files had tags to inject. With dryRun, no file is written and only files the injection would change count.
+func injectTagsIntoDirectory(targetDir string, verbose, dryRun bool) (int, error) {
 	var pbFiles []string
 
 	// Find all .pb.go files
@@ -124,14 +181,15 @@ func injectTagsIntoDirectory(targetDir string, verbose bool) error {
 		return nil
 	})
 	if err != nil {
-		return fmt.Errorf("failed to walk directory: %w", err)
+		return 0, fmt.Errorf("failed to walk directory: %w", err)
 	}
 
 	if len(pbFiles) == 0 {
-		return fmt.Errorf("no .pb.go files found in %s - did you run 'buf generate' first?", targetDir)
+		return 0, fmt.Errorf("no .pb.go files found in %s - did you run 'buf generate' first?", targetDir)
 	}
 
 	// Inject tags into each file
+	injected := 0
 	for _, file := range pbFiles {
 		if verbose {
 			fmt.Printf("  Processing: %s\n", file)
@@ -140,29 +198,37 @@ func injectTagsIntoDirectory(targetDir string, verbose bool) error {
 		// Read the file
 		src, err := os.ReadFile(file)
 		if err != nil {
-			return fmt.Errorf("failed to read %s: %w", file, err)
+			return 0, fmt.Errorf("failed to read %s: %w", file, err)
 		}
 
 		// Parse and inject tags
 		areas, err := injecttag.ParseFile(file, src, nil)
 		if err != nil {
-			return fmt.Errorf("failed to parse %s: %w", file, err)
+			return 0, fmt.Errorf("failed to parse %s: %w", file, err)
 		}
 
 		// If no tags to inject, skip
 		if len(areas) == 0 {
 			continue
 		}
+		if dryRun {
+			// Already processed files come out unchanged
+			if !bytes.Equal(injecttag.Inject(src, areas, false), src) {
+				injected++
+			}
+			continue
+		}
+		injected++
 
 		// Write the modified file back
 		if err := injecttag.WriteFile(file, areas, false); err != nil {
-			return fmt.Errorf("failed to write %s: %w", file, err)
+			return 0, fmt.Errorf("failed to write %s: %w", file, err)
 		}
 	}
 
-	if verbose {
+	if verbose && !dryRun {
 		fmt.Printf("  Processed %d files\n", len(pbFiles))
 	}
 
-	return nil
+	return injected, nil
 }
@@ -2,6 +2,7 @@ package main
 
 import (
 	"flag"
+	"fmt"
 	"log"
 	"os"
 	"path/filepath"
@@ -11,11 +12,13 @@ import (
 )
 
 func main() {
-	var inputFiles, xxxTags string
-	var removeTagComment bool
+	var inputFiles, xxxTags, xsdPath string
+	var removeTagComment, validate bool
 	flag.StringVar(&inputFiles, "input", "", "pattern to match input file(s)")
 	flag.StringVar(&xxxTags, "XXX_skip", "", "tags that should be skipped (applies 'tag:\"-\"') for unknown fields (deprecated since protoc-gen-go v1.4.0)")
 	flag.BoolVar(&removeTagComment, "remove_tag_comment", false, "removes tag comments from the generated file(s)")
+	flag.StringVar(&xsdPath, "xsd", "", "XSD to derive xml tags from for fields without a tag comment")
+	flag.BoolVar(&validate, "validate", false, "report exported message fields without an xml tag instead of injecting tags")
 	flag.BoolVar(&injecttag.Verbose, "verbose", false, "verbose logging")
 
 	flag.Parse()
@@ -30,6 +33,14 @@ func main() {
 		log.Fatal("input file is mandatory, see: -help")
 	}
 
+	var schema *injecttag.Schema
+	if xsdPath != "" {
+		var err error
+		if schema, err = injecttag.ParseSchema(xsdPath); err != nil {
+			log.Fatal(err)
+		}
+	}
+
 	// Handle ** recursive glob pattern by walking directories
 	var globResults []string
 	if strings.Contains(inputFiles, "**") {
@@ -62,7 +73,7 @@ func main() {
 		}
 	}
 
-	var matched int
+	var matched, missing int
 	for _, path := range globResults {
 		finfo, err := os.Stat(path)
 		if err != nil {
@@ -80,7 +91,24 @@ func main() {
 
 		matched++
 
-		areas, err := injecttag.ParseFile(path, nil, xxxSkipSlice)
+		if validate {
+			fields, err := injecttag.ValidateFile(path, nil)
+			if err != nil {
+				log.Fatal(err)
+			}
+			for _, field := range fields {
+				fmt.Fprintln(os.Stderr, field)
+			}
+			missing += len(fields)
+			continue
+		}
+
+		var areas []injecttag.TextArea
+		if schema != nil {
+			areas, err = injecttag.ParseFileWithSchema(path, nil, schema)
+		} else {
+			areas, err = injecttag.ParseFile(path, nil, xxxSkipSlice)
+		}
 		if err != nil {
 			log.Fatal(err)
 		}
@@ -92,4 +120,7 @@ func main() {
 	if matched == 0 {
 		log.Fatalf("input %q matched no files, see: -help", inputFiles)
 	}
+	if missing > 0 {
+		log.Fatalf("%d fields without an xml tag in %d files", missing, matched)
+	}
 }
@@ -74,6 +74,7 @@ type XSDElement struct {
 
 type XSDComplexType struct {
 	Name          string            `xml:"name,attr"`
+	Mixed         bool              `xml:"mixed,attr"`
 	Sequence      *XSDSequence      `xml:"sequence"`
 	Choice        *XSDChoice        `xml:"choice"`
 	SimpleContent *XSDSimpleContent `xml:"simpleContent"`
@@ -642,6 +643,14 @@ func generateComplexTypeMessageWithNamespace(name string, complexType *XSDComple
 		fieldNum++
 	}
 
+	// mixed="true" → capture the raw inner XML so interleaved text and inline markup survive a round-trip
+	if complexType.Mixed {
+		injectComment := "  // @gotags: xml:\",innerxml\""
+		fieldName := getUniqueFieldName("mixed_content", usedFieldNames)
+		builder.WriteString(fmt.Sprintf("%s\n  string %s = %d;\n", injectComment, fieldName, fieldNum))
+		fieldNum++
+	}
+
 	// Add namespace attributes for root elements
 	if isRootElement && targetNamespace != "" {
 		// Add namespace attributes map to capture all xmlns:* attributes
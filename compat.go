@@ -0,0 +1,203 @@
+package ddex
+
+import (
+	"bytes"
+	"encoding/xml"
+	"fmt"
+	"reflect"
+	"strings"
+
+	"github.com/alecsavvy/ddex-proto/gen"
+)
+
+// CompatibilityLevel is the verdict of CheckCompatibility
+type CompatibilityLevel int
+
+const (
+	// CompatibilitySupported means a generated package covers the document's schema version and every
+	// element in it
+	CompatibilitySupported CompatibilityLevel = iota
+	// CompatibilityDegraded means the document parses, but parts of it are not covered: elements the
+	// generated package does not have, which parsing drops, or a MessageSchemaVersionId other than the
+	// version of the package its namespace selects
+	CompatibilityDegraded
+	// CompatibilityUnsupported means no generated package covers the document's schema version
+	CompatibilityUnsupported
+)
+
+// String returns the name of the level: Supported, Degraded or Unsupported
+func (l CompatibilityLevel) String() string {
+	switch l {
+	case CompatibilitySupported:
+		return "Supported"
+	case CompatibilityDegraded:
+		return "Degraded"
+	case CompatibilityUnsupported:
+		return "Unsupported"
+	}
+	return fmt.Sprintf("CompatibilityLevel(%d)", int(l))
+}
+
+// Compatibility is the verdict of CheckCompatibility for a document
+type Compatibility struct {
+	Level CompatibilityLevel
+	// SchemaVersion is the detected schema version, e.g. "ern/432": the root's MessageSchemaVersionId when
+	// it has one, else the version of its namespace
+	SchemaVersion string
+	// MessageType, Version and MessageName are the registered message that parses the document, empty
+	// when Unsupported
+	MessageType string
+	Version     string
+	MessageName string
+	// MissingElements are the paths of elements (/Root/Child) the generated package has no field for
+	MissingElements []string
+	// Reason explains a Degraded or Unsupported verdict
+	Reason string
+}
+
+// namespaceBase is the prefix of the DDEX namespaces, which end in the schema version, e.g. ern/432
+const namespaceBase = "http://ddex.net/xml/"
+
+// CheckCompatibility reports how well the generated packages cover a document before it is parsed: its
+// schema version is compared against the versions of the registered messages, and its elements against
+// the fields of the matching generated type. It returns an error only if the document has no root element.
+func CheckCompatibility(data []byte) (Compatibility, error) {
+	root, err := readRootStart(data)
+	if err != nil {
+		return Compatibility{}, err
+	}
+
+	namespace := root.Name.Space
+	declared := ""
+	for _, attr := range root.Attr {
+		switch {
+		case namespace == "" && (attr.Name.Local == "xmlns" || attr.Name.Space == "xmlns"):
+			namespace = attr.Value
+		case attr.Name.Local == "MessageSchemaVersionId":
+			declared = strings.TrimSpace(attr.Value)
+		}
+	}
+	namespaceVersion := strings.TrimPrefix(namespace, namespaceBase)
+	compat := Compatibility{SchemaVersion: namespaceVersion}
+	if declared != "" {
+		compat.SchemaVersion = declared
+	}
+
+	messageType, version, messageName, err := gen.DetectMessageType(data)
+	if err != nil {
+		compat.Level = CompatibilityUnsupported
+		compat.Reason = fmt.Sprintf("%s %s in namespace %q is not covered (supported: %s)",
+			compat.SchemaVersion, root.Name.Local, namespace, strings.Join(supportedSchemaVersions(), ", "))
+		return compat, nil
+	}
+	compat.MessageType, compat.Version, compat.MessageName = messageType, version, messageName
+
+	message, err := gen.NewByMessageName(messageType, version, messageName)
+	if err != nil {
+		return Compatibility{}, err
+	}
+	compat.MissingElements = missingElements(data, reflect.TypeOf(message).Elem())
+
+	var reasons []string
+	if declared != "" && !strings.EqualFold(declared, namespaceVersion) {
+		reasons = append(reasons, fmt.Sprintf("MessageSchemaVersionId %s is parsed with the %s/%s package", declared, messageType, version))
+	}
+	if len(compat.MissingElements) > 0 {
+		reasons = append(reasons, fmt.Sprintf("%d elements are not in the %s/%s package and are dropped", len(compat.MissingElements), messageType, version))
+	}
+	if len(reasons) > 0 {
+		compat.Level = CompatibilityDegraded
+		compat.Reason = strings.Join(reasons, "; ")
+	}
+	return compat, nil
+}
+
+// supportedSchemaVersions returns the sorted schema versions of the registered messages, e.g. ern/432
+func supportedSchemaVersions() []string {
+	seen := make(map[string]bool)
+	for _, info := range gen.GetRegisteredTypes() {
+		seen[strings.TrimPrefix(info.Namespace, namespaceBase)] = true
+	}
+	return sortedKeys(seen)
+}
+
+// readRootStart returns the start element of the root
+func readRootStart(data []byte) (xml.StartElement, error) {
+	decoder := xml.NewDecoder(bytes.NewReader(data))
+	for {
+		token, err := decoder.Token()
+		if err != nil {
+			return xml.StartElement{}, fmt.Errorf("failed to parse XML: %w", err)
+		}
+		if start, ok := token.(xml.StartElement); ok {
+			return start, nil
+		}
+	}
+}
+
+// missingElements returns the sorted, de-duplicated paths of the elements in data that generated root
+// type t has no field for. The content of a missing element is not descended into.
+func missingElements(data []byte, t reflect.Type) []string {
+	missing := make(map[string]bool)
+	type frame struct {
+		path string
+		// t is the struct type of the element, nil for scalar content and missing elements
+		t reflect.Type
+		// skip is set inside a missing element
+		skip bool
+	}
+	var stack []frame
+
+	decoder := xml.NewDecoder(bytes.NewReader(data))
+	for {
+		token, err := decoder.Token()
+		if err != nil {
+			break
+		}
+
+		switch token := token.(type) {
+		case xml.StartElement:
+			name := token.Name.Local
+			if len(stack) == 0 {
+				stack = append(stack, frame{path: "/" + name, t: t})
+				continue
+			}
+			parent := stack[len(stack)-1]
+			child := frame{path: parent.path + "/" + name, skip: parent.skip}
+			switch {
+			case parent.skip || (parent.t != nil && hasInnerXML(parent.t)):
+				// Kept verbatim by an innerxml field
+				child.skip = true
+			case parent.t == nil || !hasElementField(parent.t, name):
+				missing[child.path] = true
+				child.skip = true
+			default:
+				child.t = childStructType(parent.t, name)
+			}
+			stack = append(stack, child)
+		case xml.EndElement:
+			stack = stack[:len(stack)-1]
+		}
+	}
+	return sortedKeys(missing)
+}
+
+// hasElementField reports whether struct type t has a field for child element name
+func hasElementField(t reflect.Type, name string) bool {
+	for i := 0; i < t.NumField(); i++ {
+		if field, ok := xmlFieldOf(t.Field(i)); ok && !field.Attr && !field.CharData && !field.InnerXML && field.Name == name {
+			return true
+		}
+	}
+	return false
+}
+
+// hasInnerXML reports whether struct type t keeps its content verbatim in an innerxml field
+func hasInnerXML(t reflect.Type) bool {
+	for i := 0; i < t.NumField(); i++ {
+		if field, ok := xmlFieldOf(t.Field(i)); ok && field.InnerXML {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,1259 @@
+package ddex
+
+import (
+	"fmt"
+	"reflect"
+	"strings"
+
+	ernv383 "github.com/alecsavvy/ddex-proto/gen/ddex/ern/v383"
+	ernv432 "github.com/alecsavvy/ddex-proto/gen/ddex/ern/v432"
+)
+
+// DowngradeToV383 converts an ERN 4.3.2 NewReleaseMessage to ERN 3.8.3 for consumers that only understand
+// ERN 3, resolving PartyList references into the inline artist and label names 3.8.3 expects.
+//
+// The conversion is a best-effort subset: the message header, releases (identifiers, titles, types,
+// artists, labels, genres, P/C lines, dates and resource references) and sound recordings (identifiers,
+// titles, artists, type, duration and P lines). Deals and all other resource types are not converted.
+// Territorial details become a single Worldwide ...DetailsByTerritory.
+func DowngradeToV383(msg *ernv432.NewReleaseMessage) (*ernv383.NewReleaseMessage, error) {
+	if msg == nil {
+		return nil, fmt.Errorf("message is nil")
+	}
+
+	parties := make(map[string]*ernv432.Party)
+	for _, party := range msg.GetPartyList().GetParty() {
+		parties[party.GetPartyReference()] = party
+	}
+
+	out := &ernv383.NewReleaseMessage{
+		MessageHeader:          downgradeHeader(msg.GetMessageHeader()),
+		MessageSchemaVersionId: "ern/383",
+		LanguageAndScriptCode:  msg.GetLanguageAndScriptCode(),
+	}
+
+	if resources := msg.GetResourceList(); resources != nil {
+		out.ResourceList = &ernv383.ResourceList{}
+		for _, sr := range resources.GetSoundRecording() {
+			out.ResourceList.SoundRecording = append(out.ResourceList.SoundRecording, downgradeSoundRecording(sr, parties))
+		}
+	}
+
+	if releases := msg.GetReleaseList(); releases != nil {
+		out.ReleaseList = &ernv383.ReleaseList{}
+		if release := releases.GetRelease(); release != nil {
+			out.ReleaseList.Release = append(out.ReleaseList.Release, downgradeRelease(release, parties))
+		}
+		for _, trackRelease := range releases.GetTrackRelease() {
+			out.ReleaseList.Release = append(out.ReleaseList.Release, downgradeTrackRelease(trackRelease, parties))
+		}
+	}
+
+	return out, nil
+}
+
+// downgradeHeader converts the message header; 4.3.2 party IDs and names are plain strings
+func downgradeHeader(header *ernv432.MessageHeader) *ernv383.MessageHeader {
+	if header == nil {
+		return nil
+	}
+	out := &ernv383.MessageHeader{
+		MessageThreadId:        header.GetMessageThreadId(),
+		MessageId:              header.GetMessageId(),
+		MessageFileName:        header.GetMessageFileName(),
+		MessageSender:          downgradeMessagingParty(header.GetMessageSender()),
+		SentOnBehalfOf:         downgradeMessagingParty(header.GetSentOnBehalfOf()),
+		MessageCreatedDateTime: header.GetMessageCreatedDateTime(),
+		MessageControlType:     header.GetMessageControlType(),
+	}
+	for _, recipient := range header.GetMessageRecipient() {
+		out.MessageRecipient = append(out.MessageRecipient, downgradeMessagingParty(recipient))
+	}
+	return out
+}
+
+// downgradeMessagingParty converts a message sender or recipient
+func downgradeMessagingParty(party *ernv432.MessagingPartyWithoutCode) *ernv383.MessagingParty {
+	if party == nil {
+		return nil
+	}
+	out := &ernv383.MessagingParty{}
+	if party.GetPartyId() != "" {
+		out.PartyId = []*ernv383.PartyId{{Value: party.GetPartyId()}}
+	}
+	if name := party.GetPartyName().GetFullName(); name != "" {
+		out.PartyName = &ernv383.PartyName{FullName: &ernv383.Name{Value: name}}
+	}
+	if party.GetTradingName() != "" {
+		out.TradingName = &ernv383.Name{Value: party.GetTradingName()}
+	}
+	return out
+}
+
+// downgradeSoundRecording converts a sound recording, taking identifiers and P lines from its editions
+func downgradeSoundRecording(sr *ernv432.SoundRecording, parties map[string]*ernv432.Party) *ernv383.SoundRecording {
+	out := &ernv383.SoundRecording{
+		ResourceReference: sr.GetResourceReference(),
+		ReferenceTitle:    downgradeReferenceTitle(sr.GetDisplayTitleText(), sr.GetDisplayTitle()),
+		Duration:          sr.GetDuration(),
+		IsInstrumental:    sr.GetIsInstrumental(),
+		IsRemastered:      sr.GetIsRemastered(),
+	}
+	if soundRecordingType := sr.GetType(); soundRecordingType != nil {
+		out.SoundRecordingType = &ernv383.SoundRecordingType{
+			Value:            soundRecordingType.GetValue(),
+			Namespace:        soundRecordingType.GetNamespace(),
+			UserDefinedValue: soundRecordingType.GetUserDefinedValue(),
+		}
+	}
+
+	details := &ernv383.SoundRecordingDetailsByTerritory{
+		TerritoryCode:     []*ernv383.CurrentTerritoryCode{{Value: TerritoryWorldwide}},
+		Title:             downgradeTitles(sr.GetDisplayTitleText(), sr.GetDisplayTitle()),
+		DisplayArtist:     downgradeDisplayArtists(sr.GetDisplayArtist(), parties),
+		DisplayArtistName: downgradeDisplayArtistNames(sr.GetDisplayArtistName()),
+	}
+	for _, edition := range sr.GetSoundRecordingEdition() {
+		for _, id := range edition.GetResourceId() {
+			out.SoundRecordingId = append(out.SoundRecordingId, &ernv383.SoundRecordingId{
+				ISRC:          id.GetISRC(),
+				CatalogNumber: downgradeCatalogNumber(id.GetCatalogNumber()),
+				ProprietaryId: downgradeProprietaryIds(id.GetProprietaryId()),
+				IsReplaced:    id.GetIsReplaced(),
+			})
+		}
+		for _, pLine := range edition.GetPLine() {
+			details.PLine = append(details.PLine, downgradePLine(pLine))
+		}
+	}
+	out.SoundRecordingDetailsByTerritory = []*ernv383.SoundRecordingDetailsByTerritory{details}
+
+	return out
+}
+
+// downgradeRelease converts the main release, listing the resources of its resource group
+func downgradeRelease(release *ernv432.Release, parties map[string]*ernv432.Party) *ernv383.Release {
+	out := &ernv383.Release{
+		ReleaseId:      downgradeReleaseId(release.GetReleaseId()),
+		ReferenceTitle: downgradeReferenceTitle(release.GetDisplayTitleText(), release.GetDisplayTitle()),
+		Duration:       release.GetDuration(),
+		IsMainRelease:  true,
+	}
+	if release.GetReleaseReference() != "" {
+		out.ReleaseReference = []string{release.GetReleaseReference()}
+	}
+	for _, releaseType := range release.GetReleaseType() {
+		out.ReleaseType = append(out.ReleaseType, &ernv383.ReleaseType{
+			Value:            releaseType.GetValue(),
+			Namespace:        releaseType.GetNamespace(),
+			UserDefinedValue: releaseType.GetUserDefinedValue(),
+		})
+	}
+	for _, pLine := range release.GetPLine() {
+		out.PLine = append(out.PLine, downgradePLine(pLine))
+	}
+	for _, cLine := range release.GetCLine() {
+		out.CLine = append(out.CLine, downgradeCLine(cLine))
+	}
+
+	details := &ernv383.ReleaseDetailsByTerritory{
+		TerritoryCode:            []*ernv383.CurrentTerritoryCode{{Value: TerritoryWorldwide}},
+		DisplayArtistName:        downgradeDisplayArtistNames(release.GetDisplayArtistName()),
+		LabelName:                downgradeLabelNames(release.GetReleaseLabelReference(), parties),
+		Title:                    downgradeTitles(release.GetDisplayTitleText(), release.GetDisplayTitle()),
+		DisplayArtist:            downgradeDisplayArtists(release.GetDisplayArtist(), parties),
+		IsMultiArtistCompilation: release.GetIsMultiArtistCompilation(),
+		Genre:                    downgradeGenres(release.GetDisplayGenre()),
+		PLine:                    out.PLine,
+		CLine:                    out.CLine,
+	}
+	if dates := release.GetReleaseDate(); len(dates) > 0 {
+		details.ReleaseDate = &ernv383.EventDate{Value: dates[0].GetValue(), IsApproximate: dates[0].GetIsApproximate()}
+	}
+	if dates := release.GetOriginalReleaseDate(); len(dates) > 0 {
+		details.OriginalReleaseDate = &ernv383.EventDate{Value: dates[0].GetValue(), IsApproximate: dates[0].GetIsApproximate()}
+	}
+	out.ReleaseDetailsByTerritory = []*ernv383.ReleaseDetailsByTerritory{details}
+
+	var references []*ernv383.ReleaseResourceReference
+	var collect func(items []*ernv432.ResourceGroupContentItem, groups []*ernv432.ResourceSubGroup)
+	collect = func(items []*ernv432.ResourceGroupContentItem, groups []*ernv432.ResourceSubGroup) {
+		for _, item := range items {
+			references = append(references, &ernv383.ReleaseResourceReference{Value: item.GetReleaseResourceReference()})
+		}
+		for _, group := range groups {
+			collect(group.GetResourceGroupContentItem(), group.GetResourceGroup())
+		}
+	}
+	collect(release.GetResourceGroup().GetResourceGroupContentItem(), release.GetResourceGroup().GetResourceGroup())
+	if len(references) > 0 {
+		out.ReleaseResourceReferenceList = &ernv383.ReleaseResourceReferenceList{ReleaseResourceReference: references}
+	}
+
+	return out
+}
+
+// downgradeTrackRelease converts a track release to a 3.8.3 Release of its single resource
+func downgradeTrackRelease(trackRelease *ernv432.TrackRelease, parties map[string]*ernv432.Party) *ernv383.Release {
+	out := &ernv383.Release{
+		ReleaseId:      downgradeReleaseId(trackRelease.GetReleaseId()),
+		ReferenceTitle: downgradeReferenceTitle(trackRelease.GetDisplayTitleText(), trackRelease.GetDisplayTitle()),
+		ReleaseType:    []*ernv383.ReleaseType{{Value: "TrackRelease"}},
+		ReleaseDetailsByTerritory: []*ernv383.ReleaseDetailsByTerritory{{
+			TerritoryCode: []*ernv383.CurrentTerritoryCode{{Value: TerritoryWorldwide}},
+			LabelName:     downgradeLabelNames(trackRelease.GetReleaseLabelReference(), parties),
+			Title:         downgradeTitles(trackRelease.GetDisplayTitleText(), trackRelease.GetDisplayTitle()),
+			Genre:         downgradeGenres(trackRelease.GetDisplayGenre()),
+		}},
+	}
+	if trackRelease.GetReleaseReference() != "" {
+		out.ReleaseReference = []string{trackRelease.GetReleaseReference()}
+	}
+	if trackRelease.GetReleaseResourceReference() != "" {
+		out.ReleaseResourceReferenceList = &ernv383.ReleaseResourceReferenceList{
+			ReleaseResourceReference: []*ernv383.ReleaseResourceReference{{Value: trackRelease.GetReleaseResourceReference()}},
+		}
+	}
+	return out
+}
+
+// downgradeReleaseId converts release identifiers
+func downgradeReleaseId(id *ernv432.ReleaseId) []*ernv383.ReleaseId {
+	if id == nil {
+		return nil
+	}
+	out := &ernv383.ReleaseId{
+		GRid:          id.GetGRid(),
+		CatalogNumber: downgradeCatalogNumber(id.GetCatalogNumber()),
+		ProprietaryId: downgradeProprietaryIds(id.GetProprietaryId()),
+	}
+	if id.GetICPN() != "" {
+		out.ICPN = &ernv383.ICPN{Value: id.GetICPN()}
+	}
+	return []*ernv383.ReleaseId{out}
+}
+
+// downgradeCatalogNumber converts a catalog number
+func downgradeCatalogNumber(catalogNumber *ernv432.CatalogNumber) *ernv383.CatalogNumber {
+	if catalogNumber == nil {
+		return nil
+	}
+	return &ernv383.CatalogNumber{Value: catalogNumber.GetValue(), Namespace: catalogNumber.GetNamespace()}
+}
+
+// downgradeProprietaryIds converts proprietary identifiers
+func downgradeProprietaryIds(ids []*ernv432.ProprietaryId) []*ernv383.ProprietaryId {
+	var out []*ernv383.ProprietaryId
+	for _, id := range ids {
+		out = append(out, &ernv383.ProprietaryId{Value: id.GetValue(), Namespace: id.GetNamespace()})
+	}
+	return out
+}
+
+// downgradeReferenceTitle picks the default (or first) display title as the 3.8.3 ReferenceTitle
+func downgradeReferenceTitle(titleTexts []*ernv432.DisplayTitleText, titles []*ernv432.DisplayTitle) *ernv383.ReferenceTitle {
+	if title := defaultDisplayTitle(titles); title != nil {
+		out := &ernv383.ReferenceTitle{TitleText: &ernv383.TitleText{Value: title.GetTitleText()}}
+		if subTitles := title.GetSubTitle(); len(subTitles) > 0 {
+			out.SubTitle = &ernv383.SubTitle{Value: subTitles[0].GetValue()}
+		}
+		return out
+	}
+	if titleText := defaultDisplayTitleText(titleTexts); titleText != nil {
+		return &ernv383.ReferenceTitle{TitleText: &ernv383.TitleText{Value: titleText.GetValue()}}
+	}
+	return nil
+}
+
+// downgradeTitles converts display titles to 3.8.3 Titles of type DisplayTitle
+func downgradeTitles(titleTexts []*ernv432.DisplayTitleText, titles []*ernv432.DisplayTitle) []*ernv383.Title {
+	var out []*ernv383.Title
+	for _, title := range titles {
+		converted := &ernv383.Title{
+			TitleText:             &ernv383.TitleText{Value: title.GetTitleText()},
+			LanguageAndScriptCode: title.GetLanguageAndScriptCode(),
+			TitleType:             "DisplayTitle",
+		}
+		for _, subTitle := range title.GetSubTitle() {
+			converted.SubTitle = append(converted.SubTitle, &ernv383.TypedSubTitle{
+				Value:        subTitle.GetValue(),
+				SubTitleType: subTitle.GetSubTitleType(),
+			})
+		}
+		out = append(out, converted)
+	}
+	if len(out) == 0 {
+		for _, titleText := range titleTexts {
+			out = append(out, &ernv383.Title{
+				TitleText:             &ernv383.TitleText{Value: titleText.GetValue()},
+				LanguageAndScriptCode: titleText.GetLanguageAndScriptCode(),
+				TitleType:             "DisplayTitle",
+			})
+		}
+	}
+	return out
+}
+
+// defaultDisplayTitle returns the title flagged IsDefault, or the first one
+func defaultDisplayTitle(titles []*ernv432.DisplayTitle) *ernv432.DisplayTitle {
+	for _, title := range titles {
+		if title.GetIsDefault() {
+			return title
+		}
+	}
+	if len(titles) > 0 {
+		return titles[0]
+	}
+	return nil
+}
+
+// defaultDisplayTitleText returns the title text flagged IsDefault, or the first one
+func defaultDisplayTitleText(titleTexts []*ernv432.DisplayTitleText) *ernv432.DisplayTitleText {
+	for _, titleText := range titleTexts {
+		if titleText.GetIsDefault() {
+			return titleText
+		}
+	}
+	if len(titleTexts) > 0 {
+		return titleTexts[0]
+	}
+	return nil
+}
+
+// downgradeDisplayArtists inlines the referenced party's identifiers and names into 3.8.3 Artists
+func downgradeDisplayArtists(artists []*ernv432.DisplayArtist, parties map[string]*ernv432.Party) []*ernv383.Artist {
+	var out []*ernv383.Artist
+	for _, artist := range artists {
+		converted := &ernv383.Artist{SequenceNumber: artist.GetSequenceNumber()}
+		if role := artist.GetDisplayArtistRole(); role != nil {
+			converted.ArtistRole = []*ernv383.ArtistRole{{
+				Value:            role.GetValue(),
+				Namespace:        role.GetNamespace(),
+				UserDefinedValue: role.GetUserDefinedValue(),
+			}}
+		}
+		if party, ok := parties[artist.GetArtistPartyReference()]; ok {
+			converted.PartyId = downgradePartyIds(party.GetPartyId())
+			for _, name := range party.GetPartyName() {
+				converted.PartyName = append(converted.PartyName, downgradePartyName(name))
+			}
+		}
+		out = append(out, converted)
+	}
+	return out
+}
+
+// downgradeDisplayArtistNames converts display artist names
+func downgradeDisplayArtistNames(names []*ernv432.DisplayArtistNameWithOriginalLanguage) []*ernv383.Name {
+	var out []*ernv383.Name
+	for _, name := range names {
+		out = append(out, &ernv383.Name{Value: name.GetValue(), LanguageAndScriptCode: name.GetLanguageAndScriptCode()})
+	}
+	return out
+}
+
+// downgradeLabelNames resolves release label references to the label party's full name
+func downgradeLabelNames(labels []*ernv432.ReleaseLabelReferenceWithParty, parties map[string]*ernv432.Party) []*ernv383.LabelName {
+	var out []*ernv383.LabelName
+	for _, label := range labels {
+		party, ok := parties[label.GetValue()]
+		if !ok {
+			continue
+		}
+		for _, name := range party.GetPartyName() {
+			if fullName := name.GetFullName().GetValue(); fullName != "" {
+				out = append(out, &ernv383.LabelName{Value: fullName, LabelNameType: label.GetLabelType()})
+				break
+			}
+		}
+	}
+	return out
+}
+
+// downgradePartyIds converts party identifiers; 3.8.3 has a single typed PartyId per identifier
+func downgradePartyIds(ids []*ernv432.DetailedPartyId) []*ernv383.PartyId {
+	var out []*ernv383.PartyId
+	for _, id := range ids {
+		if id.GetISNI() != "" {
+			out = append(out, &ernv383.PartyId{Value: id.GetISNI(), IsISNI: true})
+		}
+		if id.GetDPID() != "" {
+			out = append(out, &ernv383.PartyId{Value: id.GetDPID(), IsDPID: true})
+		}
+		for _, proprietaryID := range id.GetProprietaryId() {
+			out = append(out, &ernv383.PartyId{Value: proprietaryID.GetValue(), Namespace: proprietaryID.GetNamespace()})
+		}
+	}
+	return out
+}
+
+// downgradePartyName converts a party name
+func downgradePartyName(name *ernv432.PartyNameWithTerritory) *ernv383.PartyName {
+	return &ernv383.PartyName{
+		FullName:                 downgradeName(name.GetFullName()),
+		FullNameAsciiTranscribed: name.GetFullNameAsciiTranscribed(),
+		FullNameIndexed:          downgradeName(name.GetFullNameIndexed()),
+		NamesBeforeKeyName:       downgradeName(name.GetNamesBeforeKeyName()),
+		KeyName:                  downgradeName(name.GetKeyName()),
+		NamesAfterKeyName:        downgradeName(name.GetNamesAfterKeyName()),
+		AbbreviatedName:          downgradeName(name.GetAbbreviatedName()),
+		LanguageAndScriptCode:    name.GetLanguageAndScriptCode(),
+	}
+}
+
+// downgradeName converts a name
+func downgradeName(name *ernv432.Name) *ernv383.Name {
+	if name == nil {
+		return nil
+	}
+	return &ernv383.Name{Value: name.GetValue(), LanguageAndScriptCode: name.GetLanguageAndScriptCode()}
+}
+
+// downgradeGenres converts display genres
+func downgradeGenres(genres []*ernv432.GenreWithTerritory) []*ernv383.Genre {
+	var out []*ernv383.Genre
+	for _, genre := range genres {
+		converted := &ernv383.Genre{
+			GenreText:             &ernv383.Description{Value: genre.GetGenreText()},
+			LanguageAndScriptCode: genre.GetLanguageAndScriptCode(),
+		}
+		if genre.GetSubGenre() != "" {
+			converted.SubGenre = &ernv383.Description{Value: genre.GetSubGenre()}
+		}
+		out = append(out, converted)
+	}
+	return out
+}
+
+// downgradePLine converts a P line
+func downgradePLine(pLine *ernv432.PLine) *ernv383.PLine {
+	return &ernv383.PLine{
+		Year:                  pLine.GetYear(),
+		PLineCompany:          pLine.GetPLineCompany(),
+		PLineText:             pLine.GetPLineText(),
+		LanguageAndScriptCode: pLine.GetLanguageAndScriptCode(),
+	}
+}
+
+// downgradeCLine converts a C line
+func downgradeCLine(cLine *ernv432.CLine) *ernv383.CLine {
+	return &ernv383.CLine{
+		Year:                  cLine.GetYear(),
+		CLineCompany:          cLine.GetCLineCompany(),
+		CLineText:             cLine.GetCLineText(),
+		LanguageAndScriptCode: cLine.GetLanguageAndScriptCode(),
+	}
+}
+
+// UnmappedFieldsError lists the populated fields of a source message that a conversion could not carry over
+type UnmappedFieldsError struct {
+	// Fields are the DDEX paths of the dropped elements and attributes, e.g.
+	// /NewReleaseMessage/DealList/ReleaseDeal/Deal/DealTerms/TakeDown
+	Fields []string
+}
+
+// Error implements the error interface
+func (e *UnmappedFieldsError) Error() string {
+	return fmt.Sprintf("%d fields could not be mapped: %s", len(e.Fields), strings.Join(e.Fields, ", "))
+}
+
+// UpgradeV383ToV432 converts an ERN 3.8.3 NewReleaseMessage to ERN 4.3.2, moving the inline artists and
+// labels of 3.8.3 into a PartyList and referencing them by PartyReference.
+//
+// The conversion is partial: the message header, sound recordings (identifiers, titles, artists, type,
+// duration, P lines and parental warnings), releases (identifiers, titles, types, artists, labels,
+// genres, P/C lines, dates and resource groups) and deals (commercial models, use types, territories,
+// validity periods and prices) are mapped; the main release becomes the Release and the others become
+// TrackReleases. Territorial details are merged, so only Worldwide TerritoryCodes are considered mapped.
+//
+// When populated fields could not be mapped the converted message is still returned, together with an
+// *UnmappedFieldsError listing them.
+func UpgradeV383ToV432(msg *ernv383.NewReleaseMessage) (*ernv432.NewReleaseMessage, error) {
+	if msg == nil {
+		return nil, fmt.Errorf("message is nil")
+	}
+
+	u := &upgrader{parties: make(map[string]*ernv432.Party)}
+	out := &ernv432.NewReleaseMessage{
+		MessageHeader:         upgradeHeader(msg.GetMessageHeader()),
+		LanguageAndScriptCode: msg.GetLanguageAndScriptCode(),
+	}
+
+	walkScalars(msg, func(path string, field xmlField, value reflect.Value) {
+		if !strings.HasPrefix(path, "/NewReleaseMessage/ReleaseList/") {
+			u.check("", path, value, upgradeMessagePaths)
+		}
+	})
+
+	if resources := msg.GetResourceList(); resources != nil {
+		out.ResourceList = &ernv432.ResourceList{}
+		for _, sr := range resources.GetSoundRecording() {
+			out.ResourceList.SoundRecording = append(out.ResourceList.SoundRecording, u.upgradeSoundRecording(sr))
+		}
+	}
+
+	if releases := msg.GetReleaseList().GetRelease(); len(releases) > 0 {
+		out.ReleaseList = &ernv432.ReleaseList{}
+		main := mainRelease(releases)
+		for _, release := range releases {
+			paths := upgradeTrackReleasePaths
+			if release == main {
+				out.ReleaseList.Release = u.upgradeRelease(release)
+				paths = upgradeReleasePaths
+			} else {
+				out.ReleaseList.TrackRelease = append(out.ReleaseList.TrackRelease, u.upgradeTrackRelease(release))
+			}
+			walkScalars(release, func(path string, field xmlField, value reflect.Value) {
+				u.check("/NewReleaseMessage/ReleaseList", path, value, paths)
+			})
+		}
+	}
+
+	if deals := msg.GetDealList(); deals != nil {
+		out.DealList = &ernv432.DealList{}
+		for _, releaseDeal := range deals.GetReleaseDeal() {
+			out.DealList.ReleaseDeal = append(out.DealList.ReleaseDeal, upgradeReleaseDeal(releaseDeal))
+		}
+	}
+
+	if len(u.partyList) > 0 {
+		out.PartyList = &ernv432.PartyList{Party: u.partyList}
+	}
+
+	if len(u.unmapped) > 0 {
+		return out, &UnmappedFieldsError{Fields: u.unmapped}
+	}
+	return out, nil
+}
+
+// upgrader holds the parties created from inline artists and labels and the unmapped fields found so far
+type upgrader struct {
+	parties   map[string]*ernv432.Party
+	partyList []*ernv432.Party
+	unmapped  []string
+	seen      map[string]bool
+}
+
+// check records path (relative to prefix) as unmapped unless it is in mapped. Nested resource groups
+// share the paths of the outermost group, and territorial details are mapped only for Worldwide.
+func (u *upgrader) check(prefix, path string, value reflect.Value, mapped map[string]bool) {
+	normalized := path
+	for strings.Contains(normalized, "/ResourceGroup/ResourceGroup") {
+		normalized = strings.ReplaceAll(normalized, "/ResourceGroup/ResourceGroup", "/ResourceGroup")
+	}
+	if strings.HasSuffix(normalized, "DetailsByTerritory/TerritoryCode") {
+		if value.Kind() == reflect.String && value.String() == TerritoryWorldwide {
+			return
+		}
+	} else if mapped[normalized] {
+		return
+	}
+
+	if u.seen == nil {
+		u.seen = make(map[string]bool)
+	}
+	if !u.seen[prefix+path] {
+		u.seen[prefix+path] = true
+		u.unmapped = append(u.unmapped, prefix+path)
+	}
+}
+
+// mainRelease returns the release flagged IsMainRelease, falling back to the first that is not a
+// TrackRelease
+func mainRelease(releases []*ernv383.Release) *ernv383.Release {
+	for _, release := range releases {
+		if release.GetIsMainRelease() {
+			return release
+		}
+	}
+	for _, release := range releases {
+		isTrack := false
+		for _, releaseType := range release.GetReleaseType() {
+			isTrack = isTrack || releaseType.GetValue() == "TrackRelease"
+		}
+		if !isTrack {
+			return release
+		}
+	}
+	return releases[0]
+}
+
+// upgradeHeader converts the message header
+func upgradeHeader(header *ernv383.MessageHeader) *ernv432.MessageHeader {
+	if header == nil {
+		return nil
+	}
+	out := &ernv432.MessageHeader{
+		MessageThreadId:        header.GetMessageThreadId(),
+		MessageId:              header.GetMessageId(),
+		MessageFileName:        header.GetMessageFileName(),
+		MessageSender:          upgradeMessagingParty(header.GetMessageSender()),
+		SentOnBehalfOf:         upgradeMessagingParty(header.GetSentOnBehalfOf()),
+		MessageCreatedDateTime: header.GetMessageCreatedDateTime(),
+		MessageControlType:     header.GetMessageControlType(),
+	}
+	for _, recipient := range header.GetMessageRecipient() {
+		out.MessageRecipient = append(out.MessageRecipient, upgradeMessagingParty(recipient))
+	}
+	return out
+}
+
+// upgradeMessagingParty converts a message sender or recipient, keeping its first party ID
+func upgradeMessagingParty(party *ernv383.MessagingParty) *ernv432.MessagingPartyWithoutCode {
+	if party == nil {
+		return nil
+	}
+	out := &ernv432.MessagingPartyWithoutCode{TradingName: party.GetTradingName().GetValue()}
+	if ids := party.GetPartyId(); len(ids) > 0 {
+		out.PartyId = ids[0].GetValue()
+	}
+	if name := party.GetPartyName().GetFullName().GetValue(); name != "" {
+		out.PartyName = &ernv432.PartyNameWithoutCode{FullName: name}
+	}
+	return out
+}
+
+// upgradeSoundRecording converts a sound recording, merging its territorial details
+func (u *upgrader) upgradeSoundRecording(sr *ernv383.SoundRecording) *ernv432.SoundRecording {
+	out := &ernv432.SoundRecording{
+		ResourceReference: sr.GetResourceReference(),
+		DisplayTitleText:  upgradeDisplayTitleText(sr.GetReferenceTitle()),
+		Duration:          sr.GetDuration(),
+		IsInstrumental:    sr.GetIsInstrumental(),
+		IsRemastered:      sr.GetIsRemastered(),
+	}
+	if soundRecordingType := sr.GetSoundRecordingType(); soundRecordingType != nil {
+		out.Type = &ernv432.SoundRecordingType{
+			Value:            soundRecordingType.GetValue(),
+			Namespace:        soundRecordingType.GetNamespace(),
+			UserDefinedValue: soundRecordingType.GetUserDefinedValue(),
+		}
+	}
+
+	edition := &ernv432.SoundRecordingEdition{}
+	for _, id := range sr.GetSoundRecordingId() {
+		edition.ResourceId = append(edition.ResourceId, &ernv432.SoundRecordingId{
+			ISRC:          id.GetISRC(),
+			CatalogNumber: upgradeCatalogNumber(id.GetCatalogNumber()),
+			ProprietaryId: upgradeProprietaryIds(id.GetProprietaryId()),
+			IsReplaced:    id.GetIsReplaced(),
+		})
+	}
+
+	for _, details := range sr.GetSoundRecordingDetailsByTerritory() {
+		display, formal, grouping := upgradeTitles(details.GetTitle())
+		out.DisplayTitle = append(out.DisplayTitle, display...)
+		out.FormalTitle = append(out.FormalTitle, formal...)
+		out.GroupingTitle = append(out.GroupingTitle, grouping...)
+		out.DisplayArtist = append(out.DisplayArtist, u.upgradeDisplayArtists(details.GetDisplayArtist())...)
+		out.DisplayArtistName = append(out.DisplayArtistName, upgradeDisplayArtistNames(details.GetDisplayArtistName())...)
+		out.ParentalWarningType = append(out.ParentalWarningType, upgradeParentalWarnings(details.GetParentalWarningType())...)
+		for _, pLine := range details.GetPLine() {
+			edition.PLine = append(edition.PLine, upgradePLine(pLine))
+		}
+	}
+	if len(out.DisplayTitle) == 0 {
+		out.DisplayTitle = upgradeDisplayTitle(sr.GetReferenceTitle())
+	}
+	if len(edition.ResourceId) > 0 || len(edition.PLine) > 0 {
+		out.SoundRecordingEdition = []*ernv432.SoundRecordingEdition{edition}
+	}
+
+	return out
+}
+
+// upgradeRelease converts the main release, merging its territorial details
+func (u *upgrader) upgradeRelease(release *ernv383.Release) *ernv432.Release {
+	out := &ernv432.Release{
+		ReleaseId:        upgradeReleaseId(release.GetReleaseId()),
+		DisplayTitleText: upgradeDisplayTitleText(release.GetReferenceTitle()),
+		Duration:         release.GetDuration(),
+	}
+	if references := release.GetReleaseReference(); len(references) > 0 {
+		out.ReleaseReference = references[0]
+	}
+	for _, releaseType := range release.GetReleaseType() {
+		out.ReleaseType = append(out.ReleaseType, &ernv432.ReleaseTypeForReleaseNotification{
+			Value:            releaseType.GetValue(),
+			Namespace:        releaseType.GetNamespace(),
+			UserDefinedValue: releaseType.GetUserDefinedValue(),
+		})
+	}
+	for _, pLine := range release.GetPLine() {
+		out.PLine = append(out.PLine, upgradePLine(pLine))
+	}
+	for _, cLine := range release.GetCLine() {
+		out.CLine = append(out.CLine, upgradeCLine(cLine))
+	}
+	if date := release.GetGlobalReleaseDate(); date != nil {
+		out.ReleaseDate = append(out.ReleaseDate, upgradeEventDate(date))
+	}
+	if date := release.GetGlobalOriginalReleaseDate(); date != nil {
+		out.OriginalReleaseDate = append(out.OriginalReleaseDate, upgradeEventDate(date))
+	}
+
+	for _, details := range release.GetReleaseDetailsByTerritory() {
+		display, formal, grouping := upgradeTitles(details.GetTitle())
+		out.DisplayTitle = append(out.DisplayTitle, display...)
+		out.FormalTitle = append(out.FormalTitle, formal...)
+		out.GroupingTitle = append(out.GroupingTitle, grouping...)
+		out.DisplayArtist = append(out.DisplayArtist, u.upgradeDisplayArtists(details.GetDisplayArtist())...)
+		out.DisplayArtistName = append(out.DisplayArtistName, upgradeDisplayArtistNames(details.GetDisplayArtistName())...)
+		out.ReleaseLabelReference = append(out.ReleaseLabelReference, u.upgradeLabelNames(details.GetLabelName())...)
+		out.DisplayGenre = append(out.DisplayGenre, upgradeGenres(details.GetGenre())...)
+		out.ParentalWarningType = append(out.ParentalWarningType, upgradeParentalWarnings(details.GetParentalWarningType())...)
+		out.IsMultiArtistCompilation = out.IsMultiArtistCompilation || details.GetIsMultiArtistCompilation()
+		for _, pLine := range details.GetPLine() {
+			out.PLine = append(out.PLine, upgradePLine(pLine))
+		}
+		for _, cLine := range details.GetCLine() {
+			out.CLine = append(out.CLine, upgradeCLine(cLine))
+		}
+		if date := details.GetReleaseDate(); date != nil {
+			out.ReleaseDate = append(out.ReleaseDate, upgradeEventDate(date))
+		}
+		if date := details.GetOriginalReleaseDate(); date != nil {
+			out.OriginalReleaseDate = append(out.OriginalReleaseDate, upgradeEventDate(date))
+		}
+	}
+	if len(out.DisplayTitle) == 0 {
+		out.DisplayTitle = upgradeDisplayTitle(release.GetReferenceTitle())
+	}
+
+	// The first territory's resource groups define the release structure; without any, the resource
+	// reference list is used in order
+	var groups []*ernv383.ResourceGroup
+	if details := release.GetReleaseDetailsByTerritory(); len(details) > 0 {
+		groups = details[0].GetResourceGroup()
+	}
+	if len(groups) == 1 {
+		out.ResourceGroup = &ernv432.ResourceGroup{
+			SequenceNumber:           groups[0].GetSequenceNumber(),
+			ResourceGroup:            upgradeResourceSubGroups(groups[0].GetResourceGroup()),
+			ResourceGroupContentItem: upgradeContentItems(groups[0].GetResourceGroupContentItem()),
+		}
+		out.ResourceGroup.DisplayTitle, out.ResourceGroup.FormalTitle, out.ResourceGroup.GroupingTitle = upgradeTitles(groups[0].GetTitle())
+	} else if len(groups) > 1 {
+		out.ResourceGroup = &ernv432.ResourceGroup{ResourceGroup: upgradeResourceSubGroups(groups)}
+	} else if list := release.GetReleaseResourceReferenceList(); list != nil {
+		out.ResourceGroup = &ernv432.ResourceGroup{}
+		for i, reference := range list.GetReleaseResourceReference() {
+			out.ResourceGroup.ResourceGroupContentItem = append(out.ResourceGroup.ResourceGroupContentItem, &ernv432.ResourceGroupContentItem{
+				SequenceNumber:           int32(i + 1),
+				ReleaseResourceReference: reference.GetValue(),
+			})
+		}
+	}
+
+	return out
+}
+
+// upgradeTrackRelease converts a non-main release to a TrackRelease of its first resource
+func (u *upgrader) upgradeTrackRelease(release *ernv383.Release) *ernv432.TrackRelease {
+	out := &ernv432.TrackRelease{
+		ReleaseId:        upgradeReleaseId(release.GetReleaseId()),
+		DisplayTitleText: upgradeDisplayTitleText(release.GetReferenceTitle()),
+	}
+	if references := release.GetReleaseReference(); len(references) > 0 {
+		out.ReleaseReference = references[0]
+	}
+	if references := release.GetReleaseResourceReferenceList().GetReleaseResourceReference(); len(references) > 0 {
+		out.ReleaseResourceReference = references[0].GetValue()
+	}
+	for _, details := range release.GetReleaseDetailsByTerritory() {
+		display, formal, grouping := upgradeTitles(details.GetTitle())
+		out.DisplayTitle = append(out.DisplayTitle, display...)
+		out.FormalTitle = append(out.FormalTitle, formal...)
+		out.GroupingTitle = append(out.GroupingTitle, grouping...)
+		out.ReleaseLabelReference = append(out.ReleaseLabelReference, u.upgradeLabelNames(details.GetLabelName())...)
+		out.DisplayGenre = append(out.DisplayGenre, upgradeGenres(details.GetGenre())...)
+	}
+	if len(out.DisplayTitle) == 0 {
+		out.DisplayTitle = upgradeDisplayTitle(release.GetReferenceTitle())
+	}
+	return out
+}
+
+// upgradeReleaseId converts the first release identifier; 4.3.2 releases have a single ReleaseId
+func upgradeReleaseId(ids []*ernv383.ReleaseId) *ernv432.ReleaseId {
+	if len(ids) == 0 {
+		return nil
+	}
+	return &ernv432.ReleaseId{
+		GRid:          ids[0].GetGRid(),
+		ICPN:          ids[0].GetICPN().GetValue(),
+		CatalogNumber: upgradeCatalogNumber(ids[0].GetCatalogNumber()),
+		ProprietaryId: upgradeProprietaryIds(ids[0].GetProprietaryId()),
+	}
+}
+
+// upgradeCatalogNumber converts a catalog number
+func upgradeCatalogNumber(catalogNumber *ernv383.CatalogNumber) *ernv432.CatalogNumber {
+	if catalogNumber == nil {
+		return nil
+	}
+	return &ernv432.CatalogNumber{Value: catalogNumber.GetValue(), Namespace: catalogNumber.GetNamespace()}
+}
+
+// upgradeProprietaryIds converts proprietary identifiers
+func upgradeProprietaryIds(ids []*ernv383.ProprietaryId) []*ernv432.ProprietaryId {
+	var out []*ernv432.ProprietaryId
+	for _, id := range ids {
+		out = append(out, &ernv432.ProprietaryId{Value: id.GetValue(), Namespace: id.GetNamespace()})
+	}
+	return out
+}
+
+// upgradeDisplayTitleText converts a ReferenceTitle to the default DisplayTitleText
+func upgradeDisplayTitleText(title *ernv383.ReferenceTitle) []*ernv432.DisplayTitleText {
+	if title.GetTitleText().GetValue() == "" {
+		return nil
+	}
+	return []*ernv432.DisplayTitleText{{
+		Value:                 title.GetTitleText().GetValue(),
+		LanguageAndScriptCode: title.GetLanguageAndScriptCode(),
+		IsDefault:             true,
+	}}
+}
+
+// upgradeDisplayTitle converts a ReferenceTitle to the default DisplayTitle, for resources and releases
+// without territorial titles
+func upgradeDisplayTitle(title *ernv383.ReferenceTitle) []*ernv432.DisplayTitle {
+	if title.GetTitleText().GetValue() == "" {
+		return nil
+	}
+	out := &ernv432.DisplayTitle{
+		TitleText:             title.GetTitleText().GetValue(),
+		LanguageAndScriptCode: title.GetLanguageAndScriptCode(),
+		IsDefault:             true,
+	}
+	if subTitle := title.GetSubTitle().GetValue(); subTitle != "" {
+		out.SubTitle = []*ernv432.DisplaySubTitle{{Value: subTitle}}
+	}
+	return []*ernv432.DisplayTitle{out}
+}
+
+// upgradeTitles sorts 3.8.3 Titles by TitleType into display, formal and grouping titles
+func upgradeTitles(titles []*ernv383.Title) (display, formal, grouping []*ernv432.DisplayTitle) {
+	for _, title := range titles {
+		converted := &ernv432.DisplayTitle{
+			TitleText:             title.GetTitleText().GetValue(),
+			LanguageAndScriptCode: title.GetLanguageAndScriptCode(),
+		}
+		for _, subTitle := range title.GetSubTitle() {
+			converted.SubTitle = append(converted.SubTitle, &ernv432.DisplaySubTitle{
+				Value:        subTitle.GetValue(),
+				SubTitleType: subTitle.GetSubTitleType(),
+			})
+		}
+		switch title.GetTitleType() {
+		case "FormalTitle":
+			formal = append(formal, converted)
+		case "GroupingTitle":
+			grouping = append(grouping, converted)
+		default:
+			display = append(display, converted)
+		}
+	}
+	return display, formal, grouping
+}
+
+// upgradeDisplayArtists converts inline artists to DisplayArtists referencing a party in the PartyList
+func (u *upgrader) upgradeDisplayArtists(artists []*ernv383.Artist) []*ernv432.DisplayArtist {
+	var out []*ernv432.DisplayArtist
+	for _, artist := range artists {
+		converted := &ernv432.DisplayArtist{
+			ArtistPartyReference: u.partyReference(artist.GetPartyId(), artist.GetPartyName()),
+			SequenceNumber:       artist.GetSequenceNumber(),
+		}
+		if roles := artist.GetArtistRole(); len(roles) > 0 {
+			converted.DisplayArtistRole = &ernv432.DisplayArtistRole{
+				Value:            roles[0].GetValue(),
+				Namespace:        roles[0].GetNamespace(),
+				UserDefinedValue: roles[0].GetUserDefinedValue(),
+			}
+		}
+		out = append(out, converted)
+	}
+	return out
+}
+
+// upgradeLabelNames converts label names to ReleaseLabelReferences to a party in the PartyList
+func (u *upgrader) upgradeLabelNames(labels []*ernv383.LabelName) []*ernv432.ReleaseLabelReferenceWithParty {
+	var out []*ernv432.ReleaseLabelReferenceWithParty
+	for _, label := range labels {
+		if label.GetValue() == "" {
+			continue
+		}
+		name := &ernv383.PartyName{FullName: &ernv383.Name{Value: label.GetValue(), LanguageAndScriptCode: label.GetLanguageAndScriptCode()}}
+		out = append(out, &ernv432.ReleaseLabelReferenceWithParty{
+			Value:     u.partyReference(nil, []*ernv383.PartyName{name}),
+			LabelType: label.GetLabelNameType(),
+		})
+	}
+	return out
+}
+
+// partyReference returns the reference of the party with the given identifiers and names, adding it to the
+// PartyList the first time it is seen. Parties are matched by their first identifier, else their first name.
+func (u *upgrader) partyReference(ids []*ernv383.PartyId, names []*ernv383.PartyName) string {
+	var key string
+	switch {
+	case len(ids) > 0:
+		key = "id:" + ids[0].GetNamespace() + ":" + ids[0].GetValue()
+	case len(names) > 0:
+		key = "name:" + names[0].GetFullName().GetValue()
+	}
+	if party, ok := u.parties[key]; ok {
+		return party.GetPartyReference()
+	}
+
+	party := &ernv432.Party{PartyReference: fmt.Sprintf("P%d", len(u.partyList)+1)}
+	for _, id := range ids {
+		switch {
+		case id.GetIsISNI():
+			party.PartyId = append(party.PartyId, &ernv432.DetailedPartyId{ISNI: id.GetValue()})
+		case id.GetIsDPID():
+			party.PartyId = append(party.PartyId, &ernv432.DetailedPartyId{DPID: id.GetValue()})
+		default:
+			party.PartyId = append(party.PartyId, &ernv432.DetailedPartyId{
+				ProprietaryId: []*ernv432.ProprietaryId{{Value: id.GetValue(), Namespace: id.GetNamespace()}},
+			})
+		}
+	}
+	for _, name := range names {
+		party.PartyName = append(party.PartyName, &ernv432.PartyNameWithTerritory{
+			FullName:                 upgradeName(name.GetFullName()),
+			FullNameAsciiTranscribed: name.GetFullNameAsciiTranscribed(),
+			FullNameIndexed:          upgradeName(name.GetFullNameIndexed()),
+			NamesBeforeKeyName:       upgradeName(name.GetNamesBeforeKeyName()),
+			KeyName:                  upgradeName(name.GetKeyName()),
+			NamesAfterKeyName:        upgradeName(name.GetNamesAfterKeyName()),
+			AbbreviatedName:          upgradeName(name.GetAbbreviatedName()),
+			LanguageAndScriptCode:    name.GetLanguageAndScriptCode(),
+		})
+	}
+
+	u.parties[key] = party
+	u.partyList = append(u.partyList, party)
+	return party.GetPartyReference()
+}
+
+// upgradeName converts a name
+func upgradeName(name *ernv383.Name) *ernv432.Name {
+	if name == nil {
+		return nil
+	}
+	return &ernv432.Name{Value: name.GetValue(), LanguageAndScriptCode: name.GetLanguageAndScriptCode()}
+}
+
+// upgradeDisplayArtistNames converts display artist names
+func upgradeDisplayArtistNames(names []*ernv383.Name) []*ernv432.DisplayArtistNameWithOriginalLanguage {
+	var out []*ernv432.DisplayArtistNameWithOriginalLanguage
+	for _, name := range names {
+		out = append(out, &ernv432.DisplayArtistNameWithOriginalLanguage{
+			Value:                 name.GetValue(),
+			LanguageAndScriptCode: name.GetLanguageAndScriptCode(),
+		})
+	}
+	return out
+}
+
+// upgradeGenres converts genres to display genres
+func upgradeGenres(genres []*ernv383.Genre) []*ernv432.GenreWithTerritory {
+	var out []*ernv432.GenreWithTerritory
+	for _, genre := range genres {
+		out = append(out, &ernv432.GenreWithTerritory{
+			GenreText:             genre.GetGenreText().GetValue(),
+			SubGenre:              genre.GetSubGenre().GetValue(),
+			LanguageAndScriptCode: genre.GetLanguageAndScriptCode(),
+		})
+	}
+	return out
+}
+
+// upgradeParentalWarnings converts parental warning types
+func upgradeParentalWarnings(warnings []*ernv383.ParentalWarningType) []*ernv432.ParentalWarningTypeWithStandard {
+	var out []*ernv432.ParentalWarningTypeWithStandard
+	for _, warning := range warnings {
+		out = append(out, &ernv432.ParentalWarningTypeWithStandard{
+			Value:                warning.GetValue(),
+			TypeNamespace:        warning.GetNamespace(),
+			TypeUserDefinedValue: warning.GetUserDefinedValue(),
+		})
+	}
+	return out
+}
+
+// upgradePLine converts a P line
+func upgradePLine(pLine *ernv383.PLine) *ernv432.PLine {
+	return &ernv432.PLine{
+		Year:                  pLine.GetYear(),
+		PLineCompany:          pLine.GetPLineCompany(),
+		PLineText:             pLine.GetPLineText(),
+		LanguageAndScriptCode: pLine.GetLanguageAndScriptCode(),
+	}
+}
+
+// upgradeCLine converts a C line
+func upgradeCLine(cLine *ernv383.CLine) *ernv432.CLine {
+	return &ernv432.CLine{
+		Year:                  cLine.GetYear(),
+		CLineCompany:          cLine.GetCLineCompany(),
+		CLineText:             cLine.GetCLineText(),
+		LanguageAndScriptCode: cLine.GetLanguageAndScriptCode(),
+	}
+}
+
+// upgradeEventDate converts a release date
+func upgradeEventDate(date *ernv383.EventDate) *ernv432.EventDateWithDefault {
+	return &ernv432.EventDateWithDefault{Value: date.GetValue(), IsApproximate: date.GetIsApproximate()}
+}
+
+// upgradeResourceSubGroups converts nested resource groups and their titles
+func upgradeResourceSubGroups(groups []*ernv383.ResourceGroup) []*ernv432.ResourceSubGroup {
+	var out []*ernv432.ResourceSubGroup
+	for _, group := range groups {
+		converted := &ernv432.ResourceSubGroup{
+			SequenceNumber:           group.GetSequenceNumber(),
+			ResourceGroup:            upgradeResourceSubGroups(group.GetResourceGroup()),
+			ResourceGroupContentItem: upgradeContentItems(group.GetResourceGroupContentItem()),
+		}
+		converted.DisplayTitle, converted.FormalTitle, converted.GroupingTitle = upgradeTitles(group.GetTitle())
+		out = append(out, converted)
+	}
+	return out
+}
+
+// upgradeContentItems converts resource group content items
+func upgradeContentItems(items []*ernv383.ExtendedResourceGroupContentItem) []*ernv432.ResourceGroupContentItem {
+	var out []*ernv432.ResourceGroupContentItem
+	for _, item := range items {
+		out = append(out, &ernv432.ResourceGroupContentItem{
+			SequenceNumber:                 item.GetSequenceNumber(),
+			ReleaseResourceReference:       item.GetReleaseResourceReference().GetValue(),
+			IsBonusResource:                item.GetIsBonusResource(),
+			IsInstantGratificationResource: item.GetIsInstantGratificationResource(),
+			IsPreOrderIncentiveResource:    item.GetIsPreOrderIncentiveResource(),
+		})
+	}
+	return out
+}
+
+// upgradeReleaseDeal converts a release deal; usages are flattened into the deal terms as in ERN 4
+func upgradeReleaseDeal(releaseDeal *ernv383.ReleaseDeal) *ernv432.ReleaseDeal {
+	out := &ernv432.ReleaseDeal{DealReleaseReference: releaseDeal.GetDealReleaseReference()}
+	for _, deal := range releaseDeal.GetDeal() {
+		converted := &ernv432.Deal{}
+		for _, reference := range deal.GetDealReference() {
+			converted.DealReference = append(converted.DealReference, reference.GetValue())
+		}
+		if terms := deal.GetDealTerms(); terms != nil {
+			converted.DealTerms = upgradeDealTerms(terms)
+		}
+		out.Deal = append(out.Deal, converted)
+	}
+	return out
+}
+
+// upgradeDealTerms converts deal terms
+func upgradeDealTerms(terms *ernv383.DealTerms) *ernv432.DealTerms {
+	out := &ernv432.DealTerms{
+		IsPreOrderDeal: terms.GetIsPreOrderDeal(),
+		IsPromotional:  terms.GetIsPromotional(),
+	}
+	for _, model := range terms.GetCommercialModelType() {
+		out.CommercialModelType = append(out.CommercialModelType, &ernv432.CommercialModelType{
+			Value:            model.GetValue(),
+			Namespace:        model.GetNamespace(),
+			UserDefinedValue: model.GetUserDefinedValue(),
+		})
+	}
+	for _, usage := range terms.GetUsage() {
+		for _, useType := range usage.GetUseType() {
+			out.UseType = append(out.UseType, &ernv432.DiscoverableUseType{
+				Value:            useType.GetValue(),
+				Namespace:        useType.GetNamespace(),
+				UserDefinedValue: useType.GetUserDefinedValue(),
+			})
+		}
+		for _, interfaceType := range usage.GetUserInterfaceType() {
+			out.UserInterfaceType = append(out.UserInterfaceType, &ernv432.UserInterfaceType{
+				Value:            interfaceType.GetValue(),
+				Namespace:        interfaceType.GetNamespace(),
+				UserDefinedValue: interfaceType.GetUserDefinedValue(),
+			})
+		}
+	}
+	for _, territory := range terms.GetTerritoryCode() {
+		out.TerritoryCode = append(out.TerritoryCode, &ernv432.CurrentTerritoryCode{Value: territory.GetValue(), IdentifierType: territory.GetIdentifierType()})
+	}
+	for _, territory := range terms.GetExcludedTerritoryCode() {
+		out.ExcludedTerritoryCode = append(out.ExcludedTerritoryCode, &ernv432.CurrentTerritoryCode{Value: territory.GetValue(), IdentifierType: territory.GetIdentifierType()})
+	}
+	for _, period := range terms.GetValidityPeriod() {
+		converted := &ernv432.PeriodWithStartDate{}
+		if date := period.GetStartDate(); date != nil {
+			converted.StartDate = &ernv432.EventDateWithCurrentTerritory{Value: date.GetValue(), IsApproximate: date.GetIsApproximate()}
+		}
+		if date := period.GetEndDate(); date != nil {
+			converted.EndDate = &ernv432.EventDateWithCurrentTerritory{Value: date.GetValue(), IsApproximate: date.GetIsApproximate()}
+		}
+		if dateTime := period.GetStartDateTime(); dateTime != nil {
+			converted.StartDateTime = &ernv432.EventDateTimeWithoutFlags{Value: dateTime.GetValue(), IsApproximate: dateTime.GetIsApproximate()}
+		}
+		if dateTime := period.GetEndDateTime(); dateTime != nil {
+			converted.EndDateTime = &ernv432.EventDateTimeWithoutFlags{Value: dateTime.GetValue(), IsApproximate: dateTime.GetIsApproximate()}
+		}
+		out.ValidityPeriod = append(out.ValidityPeriod, converted)
+	}
+	for _, price := range terms.GetPriceInformation() {
+		converted := &ernv432.PriceInformation{
+			WholesalePricePerUnit:          upgradePrice(price.GetWholesalePricePerUnit()),
+			BulkOrderWholesalePricePerUnit: upgradePrice(price.GetBulkOrderWholesalePricePerUnit()),
+			SuggestedRetailPrice:           upgradePrice(price.GetSuggestedRetailPrice()),
+			PriceType:                      price.GetPriceType_1(),
+		}
+		if priceType := price.GetPriceType(); priceType != nil {
+			converted.PriceCode = &ernv432.PriceType{Value: priceType.GetValue(), Namespace: priceType.GetNamespace()}
+		}
+		out.PriceInformation = append(out.PriceInformation, converted)
+	}
+	return out
+}
+
+// upgradePrice converts a price
+func upgradePrice(price *ernv383.Price) *ernv432.Price {
+	if price == nil {
+		return nil
+	}
+	return &ernv432.Price{Value: price.GetValue(), CurrencyCode: price.GetCurrencyCode()}
+}
+
+// Paths of the 3.8.3 elements and attributes UpgradeV383ToV432 carries over, for reporting the rest
+var (
+	upgradeTypedValuePaths = []string{"", "@Namespace", "@UserDefinedValue"}
+	upgradeNamePaths       = []string{"", "@LanguageAndScriptCode"}
+	upgradeTitlePaths      = []string{"@LanguageAndScriptCode", "@TitleType", "/TitleText", "/SubTitle", "/SubTitle@SubTitleType"}
+	upgradeReferenceTitle  = []string{"@LanguageAndScriptCode", "/TitleText", "/SubTitle"}
+	upgradeLinePaths       = []string{"@LanguageAndScriptCode", "/Year", "/PLineCompany", "/PLineText", "/CLineCompany", "/CLineText"}
+	upgradeReleaseIdPaths  = []string{"/GRid", "/ICPN", "/ICPN@IsEan", "/CatalogNumber", "/CatalogNumber@Namespace", "/ProprietaryId", "/ProprietaryId@Namespace"}
+
+	upgradeArtistPaths = joinPaths(
+		withPrefix("/ArtistRole", upgradeTypedValuePaths...),
+		[]string{"@SequenceNumber", "/PartyId", "/PartyId@Namespace", "/PartyId@IsDPID", "/PartyId@IsISNI"},
+		[]string{"/PartyName@LanguageAndScriptCode", "/PartyName/FullNameAsciiTranscribed"},
+		withPrefix("/PartyName/FullName", upgradeNamePaths...),
+		withPrefix("/PartyName/FullNameIndexed", upgradeNamePaths...),
+		withPrefix("/PartyName/NamesBeforeKeyName", upgradeNamePaths...),
+		withPrefix("/PartyName/KeyName", upgradeNamePaths...),
+		withPrefix("/PartyName/NamesAfterKeyName", upgradeNamePaths...),
+		withPrefix("/PartyName/AbbreviatedName", upgradeNamePaths...),
+	)
+
+	upgradeMessagePaths = pathSet(
+		[]string{"/NewReleaseMessage@LanguageAndScriptCode", "/NewReleaseMessage@MessageSchemaVersionId"},
+		withPrefix("/NewReleaseMessage/MessageHeader",
+			"/MessageThreadId", "/MessageId", "/MessageFileName", "/MessageCreatedDateTime", "/MessageControlType"),
+		withPrefix("/NewReleaseMessage/MessageHeader/MessageSender", "/PartyId", "/PartyName/FullName", "/TradingName"),
+		withPrefix("/NewReleaseMessage/MessageHeader/SentOnBehalfOf", "/PartyId", "/PartyName/FullName", "/TradingName"),
+		withPrefix("/NewReleaseMessage/MessageHeader/MessageRecipient", "/PartyId", "/PartyName/FullName", "/TradingName"),
+
+		withPrefix("/NewReleaseMessage/ResourceList/SoundRecording",
+			"/ResourceReference", "/Duration", "/IsInstrumental", "/IsRemastered",
+			"/SoundRecordingId/ISRC", "/SoundRecordingId@IsReplaced", "/SoundRecordingId/CatalogNumber",
+			"/SoundRecordingId/CatalogNumber@Namespace", "/SoundRecordingId/ProprietaryId", "/SoundRecordingId/ProprietaryId@Namespace"),
+		withPrefix("/NewReleaseMessage/ResourceList/SoundRecording/SoundRecordingType", upgradeTypedValuePaths...),
+		withPrefix("/NewReleaseMessage/ResourceList/SoundRecording/ReferenceTitle", upgradeReferenceTitle...),
+		withPrefix("/NewReleaseMessage/ResourceList/SoundRecording/SoundRecordingDetailsByTerritory/Title", upgradeTitlePaths...),
+		withPrefix("/NewReleaseMessage/ResourceList/SoundRecording/SoundRecordingDetailsByTerritory/DisplayArtist", upgradeArtistPaths...),
+		withPrefix("/NewReleaseMessage/ResourceList/SoundRecording/SoundRecordingDetailsByTerritory/DisplayArtistName", upgradeNamePaths...),
+		withPrefix("/NewReleaseMessage/ResourceList/SoundRecording/SoundRecordingDetailsByTerritory/PLine", upgradeLinePaths...),
+		withPrefix("/NewReleaseMessage/ResourceList/SoundRecording/SoundRecordingDetailsByTerritory/ParentalWarningType", upgradeTypedValuePaths...),
+
+		withPrefix("/NewReleaseMessage/DealList/ReleaseDeal", "/DealReleaseReference", "/Deal/DealReference"),
+		withPrefix("/NewReleaseMessage/DealList/ReleaseDeal/Deal/DealTerms",
+			"/IsPreOrderDeal", "/IsPromotional", "/TerritoryCode", "/TerritoryCode@IdentifierType",
+			"/ExcludedTerritoryCode", "/ExcludedTerritoryCode@IdentifierType",
+			"/ValidityPeriod/StartDate", "/ValidityPeriod/StartDate@IsApproximate",
+			"/ValidityPeriod/EndDate", "/ValidityPeriod/EndDate@IsApproximate",
+			"/ValidityPeriod/StartDateTime", "/ValidityPeriod/StartDateTime@IsApproximate",
+			"/ValidityPeriod/EndDateTime", "/ValidityPeriod/EndDateTime@IsApproximate",
+			"/PriceInformation@PriceType", "/PriceInformation/PriceType", "/PriceInformation/PriceType@Namespace",
+			"/PriceInformation/WholesalePricePerUnit", "/PriceInformation/WholesalePricePerUnit@CurrencyCode",
+			"/PriceInformation/BulkOrderWholesalePricePerUnit", "/PriceInformation/BulkOrderWholesalePricePerUnit@CurrencyCode",
+			"/PriceInformation/SuggestedRetailPrice", "/PriceInformation/SuggestedRetailPrice@CurrencyCode"),
+		withPrefix("/NewReleaseMessage/DealList/ReleaseDeal/Deal/DealTerms/CommercialModelType", upgradeTypedValuePaths...),
+		withPrefix("/NewReleaseMessage/DealList/ReleaseDeal/Deal/DealTerms/Usage/UseType", upgradeTypedValuePaths...),
+		withPrefix("/NewReleaseMessage/DealList/ReleaseDeal/Deal/DealTerms/Usage/UserInterfaceType", upgradeTypedValuePaths...),
+	)
+
+	upgradeTrackReleasePaths = pathSet(
+		[]string{"/Release@IsMainRelease", "/Release/ReleaseReference", "/Release/ReleaseResourceReferenceList/ReleaseResourceReference",
+			"/Release/ReleaseResourceReferenceList/ReleaseResourceReference@ReleaseResourceType"},
+		withPrefix("/Release/ReleaseType", upgradeTypedValuePaths...),
+		withPrefix("/Release/ReleaseId", upgradeReleaseIdPaths...),
+		withPrefix("/Release/ReferenceTitle", upgradeReferenceTitle...),
+		withPrefix("/Release/ReleaseDetailsByTerritory/Title", upgradeTitlePaths...),
+		withPrefix("/Release/ReleaseDetailsByTerritory/LabelName", "", "@LanguageAndScriptCode", "@LabelNameType"),
+		withPrefix("/Release/ReleaseDetailsByTerritory/Genre", "@LanguageAndScriptCode", "/GenreText", "/SubGenre"),
+	)
+
+	upgradeReleasePaths = pathSet(
+		keys(upgradeTrackReleasePaths),
+		[]string{"/Release/Duration", "/Release/GlobalReleaseDate", "/Release/GlobalReleaseDate@IsApproximate",
+			"/Release/GlobalOriginalReleaseDate", "/Release/GlobalOriginalReleaseDate@IsApproximate"},
+		withPrefix("/Release/PLine", upgradeLinePaths...),
+		withPrefix("/Release/CLine", upgradeLinePaths...),
+		withPrefix("/Release/ReleaseDetailsByTerritory",
+			"/IsMultiArtistCompilation", "/ReleaseDate", "/ReleaseDate@IsApproximate",
+			"/OriginalReleaseDate", "/OriginalReleaseDate@IsApproximate"),
+		withPrefix("/Release/ReleaseDetailsByTerritory/DisplayArtist", upgradeArtistPaths...),
+		withPrefix("/Release/ReleaseDetailsByTerritory/DisplayArtistName", upgradeNamePaths...),
+		withPrefix("/Release/ReleaseDetailsByTerritory/PLine", upgradeLinePaths...),
+		withPrefix("/Release/ReleaseDetailsByTerritory/CLine", upgradeLinePaths...),
+		withPrefix("/Release/ReleaseDetailsByTerritory/ParentalWarningType", upgradeTypedValuePaths...),
+		withPrefix("/Release/ReleaseDetailsByTerritory/ResourceGroup/Title", upgradeTitlePaths...),
+		withPrefix("/Release/ReleaseDetailsByTerritory/ResourceGroup",
+			"/SequenceNumber", "/ResourceGroupContentItem/SequenceNumber",
+			"/ResourceGroupContentItem/ReleaseResourceReference", "/ResourceGroupContentItem/ReleaseResourceReference@ReleaseResourceType",
+			"/ResourceGroupContentItem/IsBonusResource", "/ResourceGroupContentItem/IsInstantGratificationResource",
+			"/ResourceGroupContentItem/IsPreOrderIncentiveResource"),
+	)
+)
+
+// withPrefix prepends prefix to each suffix
+func withPrefix(prefix string, suffixes ...string) []string {
+	paths := make([]string, len(suffixes))
+	for i, suffix := range suffixes {
+		paths[i] = prefix + suffix
+	}
+	return paths
+}
+
+// joinPaths concatenates path lists
+func joinPaths(lists ...[]string) []string {
+	var paths []string
+	for _, list := range lists {
+		paths = append(paths, list...)
+	}
+	return paths
+}
+
+// pathSet builds a lookup set from path lists
+func pathSet(lists ...[]string) map[string]bool {
+	set := make(map[string]bool)
+	for _, path := range joinPaths(lists...) {
+		set[path] = true
+	}
+	return set
+}
+
+// keys returns the paths of a set
+func keys(set map[string]bool) []string {
+	paths := make([]string, 0, len(set))
+	for path := range set {
+		paths = append(paths, path)
+	}
+	return paths
+}
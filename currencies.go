@@ -0,0 +1,124 @@
+package ddex
+
+import (
+	"fmt"
+	"reflect"
+	"strings"
+
+	avslatest "github.com/alecsavvy/ddex-proto/gen/ddex/avs/vlatest"
+)
+
+// CurrencyError describes a currency code that is not in ISO 4217
+type CurrencyError struct {
+	// Path is the DDEX path of the code, e.g.
+	// /NewReleaseMessage/DealList/ReleaseDeal/Deal/DealTerms/PriceInformation/WholesalePricePerUnit@CurrencyCode
+	Path string
+	// Code is the rejected currency code
+	Code string
+	// DealReference is the DealReference of the Deal holding the code, "" if it has none
+	DealReference string
+	// ReleaseReferences are the DealReleaseReferences of the ReleaseDeal holding the code
+	ReleaseReferences []string
+}
+
+// Error implements the error interface
+func (e CurrencyError) Error() string {
+	deal := e.DealReference
+	if deal == "" {
+		deal = "for " + strings.Join(e.ReleaseReferences, ", ")
+	}
+	return fmt.Sprintf("%s: %q is not an ISO 4217 currency code (deal %s)", e.Path, e.Code, deal)
+}
+
+// IsValidCurrency reports whether code is a current ISO 4217 currency code, e.g. USD. Codes are upper
+// case, as in the IsoCurrencyCode allowed value set; deprecated codes such as DEM are not valid.
+func IsValidCurrency(code string) bool {
+	if code != strings.ToUpper(code) {
+		return false
+	}
+	_, ok := avslatest.ParseIsoCurrencyCodeString(code)
+	return ok
+}
+
+// ValidateCurrencies checks the CurrencyCodes of any generated DDEX message, such as those of a deal's
+// PriceInformation, against ISO 4217, reporting each invalid code in document order with its deal
+func ValidateCurrencies(msg interface{}) []CurrencyError {
+	v := reflect.ValueOf(msg)
+	for v.Kind() == reflect.Ptr || v.Kind() == reflect.Interface {
+		if v.IsNil() {
+			return nil
+		}
+		v = v.Elem()
+	}
+	if v.Kind() != reflect.Struct {
+		return nil
+	}
+
+	var errs []CurrencyError
+	walkCurrencies(v, "/"+v.Type().Name(), CurrencyError{}, &errs)
+	return errs
+}
+
+// walkCurrencies checks the CurrencyCodes under a value at path, with deal the Deal and ReleaseDeal
+// references of the enclosing deal
+func walkCurrencies(v reflect.Value, path string, deal CurrencyError, errs *[]CurrencyError) {
+	switch v.Kind() {
+	case reflect.Ptr, reflect.Interface:
+		if !v.IsNil() {
+			walkCurrencies(v.Elem(), path, deal, errs)
+		}
+	case reflect.Slice:
+		for i := 0; i < v.Len(); i++ {
+			walkCurrencies(v.Index(i), path, deal, errs)
+		}
+	case reflect.Struct:
+		switch v.Type().Name() {
+		case "ReleaseDeal":
+			deal = CurrencyError{ReleaseReferences: stringValues(v.FieldByName("DealReleaseReference"))}
+		case "Deal":
+			deal.DealReference = firstDealReference(v.FieldByName("DealReference"))
+		}
+
+		t := v.Type()
+		for i := 0; i < t.NumField(); i++ {
+			field, ok := xmlFieldOf(t.Field(i))
+			if !ok || field.CharData || field.InnerXML {
+				continue
+			}
+			fieldPath := path + "/" + field.Name
+			if field.Attr {
+				fieldPath = path + "@" + field.Name
+			}
+			if field.Name == "CurrencyCode" && v.Field(i).Kind() == reflect.String {
+				code := strings.TrimSpace(v.Field(i).String())
+				if code != "" && !IsValidCurrency(code) {
+					invalid := deal
+					invalid.Path, invalid.Code = fieldPath, code
+					*errs = append(*errs, invalid)
+				}
+				continue
+			}
+			if !field.Attr {
+				walkCurrencies(v.Field(i), fieldPath, deal, errs)
+			}
+		}
+	}
+}
+
+// firstDealReference returns the first DealReference of a Deal: a []string in ERN 4, DealReference
+// elements with a Value in ERN 3
+func firstDealReference(v reflect.Value) string {
+	if v.Kind() != reflect.Slice {
+		return ""
+	}
+	for i := 0; i < v.Len(); i++ {
+		item := v.Index(i)
+		if item.Kind() == reflect.Ptr && !item.IsNil() {
+			item = item.Elem().FieldByName("Value")
+		}
+		if item.Kind() == reflect.String && strings.TrimSpace(item.String()) != "" {
+			return strings.TrimSpace(item.String())
+		}
+	}
+	return ""
+}
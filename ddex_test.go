@@ -1,13 +1,30 @@
 package ddex
 
 import (
+	"archive/zip"
+	"bytes"
 	"encoding/xml"
+	"errors"
+	"fmt"
+	"io"
+	"maps"
+	"reflect"
+	"regexp"
+	"strings"
 	"testing"
+	"time"
 
 	"github.com/alecsavvy/ddex-proto/gen"
+	avslatest "github.com/alecsavvy/ddex-proto/gen/ddex/avs/vlatest"
+	ernv383 "github.com/alecsavvy/ddex-proto/gen/ddex/ern/v383"
+	ernv43 "github.com/alecsavvy/ddex-proto/gen/ddex/ern/v43"
+	ernv432 "github.com/alecsavvy/ddex-proto/gen/ddex/ern/v432"
+	meadv11 "github.com/alecsavvy/ddex-proto/gen/ddex/mead/v11"
+	piev10 "github.com/alecsavvy/ddex-proto/gen/ddex/pie/v10"
 	"github.com/alecsavvy/ddex-proto/testdata"
 	"github.com/alecsavvy/ddex-proto/testutil"
 	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/proto"
 )
 
 // getUnmarshalerForMessageType uses the auto-generated registry to create unmarshalers
@@ -78,6 +95,11 @@ func TestDDEX(t *testing.T) {
 					}
 					testutil.RunIntegrityTests(t, messageType, version, validator)
 				})
+
+				// Run golden file tests (go test -update rewrites the golden files)
+				t.Run("golden", func(t *testing.T) {
+					testutil.RunGoldenTests(t, messageType, version, getRoundTripValidatorForMessageType(messageType))
+				})
 			})
 		}
 	}
@@ -131,3 +153,2549 @@ func BenchmarkDDEX(b *testing.B) {
 		}
 	}
 }
+
+// BenchmarkRootMarshalXML measures marshaling a full testdata message, which runs the root MarshalXML's
+// namespace attribute handling once and the generated element marshaling for the whole tree
+func BenchmarkRootMarshalXML(b *testing.B) {
+	xmlData, err := testdata.DDEXTestDataFS.ReadFile("ddex/ern/v43/1 Audio.xml")
+	if err != nil {
+		b.Fatal(err)
+	}
+	msg, err := ParseTyped[NewReleaseMessageV43](xmlData)
+	if err != nil {
+		b.Fatal(err)
+	}
+
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		if _, err := xml.Marshal(msg); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
+
+// TestMixedContentRoundTrip verifies that mixed="true" elements keep inline markup through a round-trip
+func TestMixedContentRoundTrip(t *testing.T) {
+	input := `<entry><summary type="xhtml">A <em>landmark</em> record, <strong>remastered</strong> in 2020</summary></entry>`
+
+	var entry meadv11.Entry
+	require.NoError(t, xml.Unmarshal([]byte(input), &entry))
+	require.NotNil(t, entry.Summary)
+	require.Equal(t, "xhtml", entry.Summary.Type)
+	require.Equal(t, "A <em>landmark</em> record, <strong>remastered</strong> in 2020", entry.Summary.MixedContent)
+
+	output, err := xml.Marshal(&entry)
+	require.NoError(t, err)
+	require.Contains(t, string(output), `<summary type="xhtml">A <em>landmark</em> record, <strong>remastered</strong> in 2020</summary>`)
+
+	// PIE content is mixed the same way
+	var content piev10.Content
+	require.NoError(t, xml.Unmarshal([]byte(`<content type="xhtml">Born in <em>Brooklyn</em></content>`), &content))
+	require.Equal(t, "Born in <em>Brooklyn</em>", content.MixedContent)
+	output, err = xml.Marshal(&content)
+	require.NoError(t, err)
+	require.Contains(t, string(output), `>Born in <em>Brooklyn</em></Content>`)
+
+	// The ERN Description is not mixed in the XSD but a plain text type, so markup in it is escaped text
+	description := ernv383.Description{Value: "A <em>landmark</em> record"}
+	output, err = xml.Marshal(&description)
+	require.NoError(t, err)
+	require.Contains(t, string(output), `>A &lt;em&gt;landmark&lt;/em&gt; record</Description>`)
+	var reparsed ernv383.Description
+	require.NoError(t, xml.Unmarshal(output, &reparsed))
+	require.Equal(t, description.Value, reparsed.Value)
+}
+
+// TestParseAnyLimited verifies that depth and token limits abort parsing of oversized documents
+func TestParseAnyLimited(t *testing.T) {
+	nested := `<ern:NewReleaseMessage xmlns:ern="http://ddex.net/xml/ern/43">` +
+		strings.Repeat("<a>", 1000) + strings.Repeat("</a>", 1000) + `</ern:NewReleaseMessage>`
+
+	msg, messageType, version, err := ParseAnyLimited([]byte(nested), Limits{})
+	require.NoError(t, err)
+	require.NotNil(t, msg)
+	require.Equal(t, "ern", messageType)
+	require.Equal(t, "v43", version)
+
+	_, _, _, err = ParseAnyLimited([]byte(nested), Limits{MaxDepth: 64})
+	require.ErrorContains(t, err, "depth limit exceeded")
+
+	_, _, _, err = ParseAnyLimited([]byte(nested), Limits{MaxTokens: 100})
+	require.ErrorContains(t, err, "token limit exceeded")
+}
+
+// TestProtoBytesRoundTrip verifies that a parsed message survives the protobuf binary round-trip
+func TestProtoBytesRoundTrip(t *testing.T) {
+	xmlData, err := testdata.DDEXTestDataFS.ReadFile("ddex/ern/v43/1 Audio.xml")
+	require.NoError(t, err)
+
+	msg, messageType, version, err := gen.ParseAny(xmlData)
+	require.NoError(t, err)
+
+	data, err := ToProtoBytes(msg)
+	require.NoError(t, err)
+
+	restored, err := FromProtoBytes(data, messageType, version, "NewReleaseMessage")
+	require.NoError(t, err)
+	require.True(t, proto.Equal(msg.(proto.Message), restored.(proto.Message)))
+	require.Equal(t, msg.(*NewReleaseMessageV43).NamespaceAttrs, restored.(*NewReleaseMessageV43).NamespaceAttrs)
+
+	// Other ERN root messages decode as themselves rather than as a NewReleaseMessage
+	purge := &ernv43.PurgeReleaseMessage{MessageHeader: &ernv43.MessageHeader{MessageId: "P1"}}
+	data, err = ToProtoBytes(purge)
+	require.NoError(t, err)
+	restored, err = FromProtoBytes(data, "ern", "v43", "PurgeReleaseMessage")
+	require.NoError(t, err)
+	require.True(t, proto.Equal(purge, restored.(proto.Message)))
+
+	_, err = FromProtoBytes(data, "ern", "v43", "")
+	require.EqualError(t, err, "ern/v43 has several root messages (NewReleaseMessage, PurgeReleaseMessage), pass the message name")
+
+	mead := &meadv11.MeadMessage{MessageHeader: &meadv11.MessageHeader{MessageId: "M1"}}
+	data, err = ToProtoBytes(mead)
+	require.NoError(t, err)
+	restored, err = FromProtoBytes(data, "mead", "v11", "")
+	require.NoError(t, err)
+	require.True(t, proto.Equal(mead, restored.(proto.Message)))
+}
+
+// TestMarshalWhitespace verifies leaf text trimming and that whitespace inside values is never changed
+func TestMarshalWhitespace(t *testing.T) {
+	msg := &ernv432.NewReleaseMessage{
+		MessageHeader: &ernv432.MessageHeader{
+			MessageThreadId: " \n\t ",
+			MessageId:       "  ID  with   internal spaces ",
+			MessageFileName: "Greatest Hits  Vol. 1",
+		},
+	}
+
+	preserved, err := MarshalIndent(msg, DefaultMarshalOptions)
+	require.NoError(t, err)
+	expected, err := xml.MarshalIndent(msg, "", "  ")
+	require.NoError(t, err)
+	require.Equal(t, string(expected), string(preserved))
+
+	trimmedEmpty, err := MarshalIndent(msg, MarshalOptions{Indent: "  ", Whitespace: WhitespaceTrimEmpty})
+	require.NoError(t, err)
+	require.Contains(t, string(trimmedEmpty), "<MessageThreadId></MessageThreadId>")
+	require.Contains(t, string(trimmedEmpty), "<MessageId>  ID  with   internal spaces </MessageId>")
+	require.Contains(t, string(trimmedEmpty), "<MessageFileName>Greatest Hits  Vol. 1</MessageFileName>")
+
+	trimmedEdges, err := MarshalIndent(msg, MarshalOptions{Indent: "  ", Whitespace: WhitespaceTrimEdges})
+	require.NoError(t, err)
+	require.Contains(t, string(trimmedEdges), "<MessageThreadId></MessageThreadId>")
+	require.Contains(t, string(trimmedEdges), "<MessageId>ID  with   internal spaces</MessageId>")
+	require.Contains(t, string(trimmedEdges), "<MessageFileName>Greatest Hits  Vol. 1</MessageFileName>")
+
+	// The caller's message is left untouched
+	require.Equal(t, "  ID  with   internal spaces ", msg.MessageHeader.MessageId)
+}
+
+// TestParseTyped verifies that ParseTyped returns the concrete type and rejects mismatches
+func TestParseTyped(t *testing.T) {
+	xmlData, err := testdata.DDEXTestDataFS.ReadFile("ddex/ern/v43/1 Audio.xml")
+	require.NoError(t, err)
+
+	msg, err := ParseTyped[NewReleaseMessageV43](xmlData)
+	require.NoError(t, err)
+	require.NotNil(t, msg.GetMessageHeader())
+
+	_, err = ParseTyped[NewReleaseMessageV432](xmlData)
+	require.ErrorContains(t, err, "expected *ernv432.NewReleaseMessage")
+}
+
+// TestSanitizeXML verifies bare ampersands, HTML entities and illegal characters are repaired
+func TestSanitizeXML(t *testing.T) {
+	input := "<a>Tom & Jerry &amp; &#38; &nbsp;Caf&eacute; &bogus\x01\x0b &copyright; &semi;<![CDATA[R&B]]></a>"
+	sanitized := SanitizeXML([]byte(input))
+	require.Equal(t, "<a>Tom &amp; Jerry &amp; &#38; \u00a0Café &amp;bogus &amp;copyright; ;<![CDATA[R&B]]></a>", string(sanitized))
+
+	var decoded struct {
+		Text string `xml:",chardata"`
+	}
+	require.NoError(t, xml.Unmarshal(sanitized, &decoded))
+	require.Equal(t, "Tom & Jerry & & \u00a0Café &bogus &copyright; ;R&B", decoded.Text)
+}
+
+// TestValidateReferencesUpdateMessage verifies known references are only accepted for incremental updates
+func TestValidateReferencesUpdateMessage(t *testing.T) {
+	message := func(indicator string) []byte {
+		return []byte(`<NewReleaseMessage xmlns="http://ddex.net/xml/ern/381">` +
+			`<UpdateIndicator>` + indicator + `</UpdateIndicator>` +
+			`<DealList><ReleaseDeal><DealReleaseReference>R9</DealReleaseReference></ReleaseDeal></DealList>` +
+			`</NewReleaseMessage>`)
+	}
+	knownRefs := map[string]bool{"R9": true}
+
+	msg, _, _, err := gen.ParseAny(message("UpdateMessage"))
+	require.NoError(t, err)
+	require.Empty(t, ValidateReferences(msg, knownRefs))
+
+	msg, _, _, err = gen.ParseAny(message("OriginalMessage"))
+	require.NoError(t, err)
+	errs := ValidateReferences(msg, knownRefs)
+	require.Len(t, errs, 1)
+	require.Equal(t, "/NewReleaseMessage/DealList/ReleaseDeal/DealReleaseReference", errs[0].Path)
+	require.Equal(t, "R9", errs[0].Reference)
+}
+
+// TestDeprecatedFieldsUsed verifies elements documented as deprecated in the XSD are reported
+func TestDeprecatedFieldsUsed(t *testing.T) {
+	xmlData, err := testdata.DDEXTestDataFS.ReadFile("ddex/ern/v43/1 Audio.xml")
+	require.NoError(t, err)
+
+	msg, _, _, err := gen.ParseAny(xmlData)
+	require.NoError(t, err)
+	require.Equal(t, []string{"/NewReleaseMessage/DealList/ReleaseDeal/Deal/DealTerms/ValidityPeriod/StartDate"}, gen.DeprecatedFieldsUsed(msg))
+}
+
+// TestPrefixedChildElements verifies a fully-prefixed ERN file, with XMLSchema-instance bound to a
+// non-standard prefix, parses to the same message as its default-namespace form and marshals back validly
+func TestPrefixedChildElements(t *testing.T) {
+	xmlData, err := testdata.DDEXTestDataFS.ReadFile("ddex/ern/v43/1 Audio.xml")
+	require.NoError(t, err)
+
+	prefixed := regexp.MustCompile(`<(/?)(?:\w+:)?(\w+)([\s>/])`).ReplaceAll(xmlData, []byte("<${1}e:${2}${3}"))
+	prefixed = []byte(strings.NewReplacer(
+		`xmlns:ern=`, `xmlns:e=`,
+		`xmlns:xsi=`, `xmlns:x=`,
+		`xsi:schemaLocation=`, `x:schemaLocation=`,
+	).Replace(string(prefixed)))
+	require.Contains(t, string(prefixed), "<e:ResourceList>")
+
+	defaultMsg, err := ParseTyped[NewReleaseMessageV43](xmlData)
+	require.NoError(t, err)
+	prefixedMsg, err := ParseTyped[NewReleaseMessageV43](prefixed)
+	require.NoError(t, err)
+
+	// Only the captured namespace declarations differ
+	require.Equal(t, "http://ddex.net/xml/ern/43", prefixedMsg.NamespaceAttrs["xmlns:e"])
+	require.NotEmpty(t, prefixedMsg.NamespaceAttrs["xsi:schemaLocation"])
+	require.Equal(t, "http://www.w3.org/2001/XMLSchema-instance", prefixedMsg.NamespaceAttrs["xmlns:xsi"])
+	defaultMsg.NamespaceAttrs, prefixedMsg.NamespaceAttrs = nil, nil
+	require.True(t, proto.Equal(defaultMsg, prefixedMsg))
+
+	// The marshaled schemaLocation must use a declared prefix
+	prefixedMsg, err = ParseTyped[NewReleaseMessageV43](prefixed)
+	require.NoError(t, err)
+	output, err := xml.Marshal(prefixedMsg)
+	require.NoError(t, err)
+	var reparsed struct {
+		SchemaLocation string `xml:"http://www.w3.org/2001/XMLSchema-instance schemaLocation,attr"`
+	}
+	require.NoError(t, xml.Unmarshal(output, &reparsed))
+	require.Equal(t, prefixedMsg.NamespaceAttrs["xsi:schemaLocation"], reparsed.SchemaLocation)
+}
+
+// TestDetectMessageTypeVerbose verifies near misses are ranked and explained
+func TestDetectMessageTypeVerbose(t *testing.T) {
+	detection, candidates, err := DetectMessageTypeVerbose([]byte(`<ern:NewReleaseMessage xmlns:ern="http://ddex.net/xml/ern/4321"/>`))
+	require.Error(t, err)
+	require.Empty(t, detection.MessageType)
+	require.Equal(t, "NewReleaseMessage", candidates[0].MessageName)
+	require.Equal(t, "v432", candidates[0].Version)
+	require.Equal(t, 1.0, candidates[0].RootElementScore)
+	require.Contains(t, candidates[0].Explanation, "root element matched but namespace was")
+
+	detection, _, err = DetectMessageTypeVerbose([]byte(`<NewReleaseMessage xmlns="http://ddex.net/xml/ern/43"/>`))
+	require.NoError(t, err)
+	require.Equal(t, "v43", detection.Version)
+}
+
+// TestParseNumericAVSCodes verifies numeric TIS territory codes parse to the same constant as the ISO code
+func TestParseNumericAVSCodes(t *testing.T) {
+	textual, ok := avslatest.ParseAllTerritoryCodeString("af")
+	require.True(t, ok)
+	require.Equal(t, avslatest.AllTerritoryCode_ALL_TERRITORY_CODE_AF, textual)
+
+	numeric, ok := avslatest.ParseAllTerritoryCodeString("4")
+	require.True(t, ok)
+	require.Equal(t, textual, numeric)
+	require.Equal(t, "AF", numeric.XMLString())
+
+	// Numeric codes without a textual equivalent keep their own constant
+	world, ok := avslatest.ParseAllTerritoryCodeString("2136")
+	require.True(t, ok)
+	require.Equal(t, "2136", world.XMLString())
+
+	_, ok = avslatest.ParseAllTerritoryCodeString("99999")
+	require.False(t, ok)
+}
+
+// TestDowngradeToV383 verifies party references are inlined and the result marshals as ERN 3.8.3
+func TestDowngradeToV383(t *testing.T) {
+	xmlData, err := testdata.DDEXTestDataFS.ReadFile("ddex/ern/v43/1 Audio.xml")
+	require.NoError(t, err)
+	xmlData = []byte(strings.ReplaceAll(string(xmlData), "http://ddex.net/xml/ern/43", "http://ddex.net/xml/ern/432"))
+
+	msg, err := ParseTyped[NewReleaseMessageV432](xmlData)
+	require.NoError(t, err)
+
+	downgraded, err := DowngradeToV383(msg)
+	require.NoError(t, err)
+	require.Equal(t, msg.GetMessageHeader().GetMessageId(), downgraded.GetMessageHeader().GetMessageId())
+	require.Len(t, downgraded.GetResourceList().GetSoundRecording(), len(msg.GetResourceList().GetSoundRecording()))
+	require.Len(t, downgraded.GetReleaseList().GetRelease(), 1+len(msg.GetReleaseList().GetTrackRelease()))
+
+	main := downgraded.GetReleaseList().GetRelease()[0]
+	require.True(t, main.GetIsMainRelease())
+	artist := main.GetReleaseDetailsByTerritory()[0].GetDisplayArtist()[0]
+	require.NotEmpty(t, artist.GetPartyName()[0].GetFullName().GetValue())
+	require.NotEmpty(t, main.GetReleaseResourceReferenceList().GetReleaseResourceReference())
+
+	output, err := xml.Marshal(downgraded)
+	require.NoError(t, err)
+	reparsed, _, version, err := gen.ParseAny(output)
+	require.NoError(t, err)
+	require.Equal(t, "v383", version)
+	require.NotNil(t, reparsed)
+}
+
+// TestUpgradeV383ToV432 verifies inline artists become parties and unmapped fields are reported
+func TestUpgradeV383ToV432(t *testing.T) {
+	xmlData, err := testdata.DDEXTestDataFS.ReadFile("ddex/ern/v381/Single.xml")
+	require.NoError(t, err)
+	xmlData = []byte(strings.ReplaceAll(string(xmlData), "http://ddex.net/xml/ern/381", "http://ddex.net/xml/ern/383"))
+
+	msg, err := ParseTyped[NewReleaseMessageV383](xmlData)
+	require.NoError(t, err)
+
+	upgraded, err := UpgradeV383ToV432(msg)
+	var unmapped *UnmappedFieldsError
+	require.True(t, errors.As(err, &unmapped))
+	require.Contains(t, unmapped.Fields, "/NewReleaseMessage/ResourceList/SoundRecording/SoundRecordingDetailsByTerritory/ResourceContributor/PartyName/FullName")
+	require.NotNil(t, upgraded)
+
+	require.Equal(t, msg.GetMessageHeader().GetMessageId(), upgraded.GetMessageHeader().GetMessageId())
+	require.NotNil(t, upgraded.GetReleaseList().GetRelease())
+	require.Len(t, upgraded.GetReleaseList().GetTrackRelease(), len(msg.GetReleaseList().GetRelease())-1)
+
+	parties := make(map[string]bool)
+	for _, party := range upgraded.GetPartyList().GetParty() {
+		parties[party.GetPartyReference()] = true
+	}
+	artists := upgraded.GetReleaseList().GetRelease().GetDisplayArtist()
+	require.NotEmpty(t, artists)
+	for _, artist := range artists {
+		require.True(t, parties[artist.GetArtistPartyReference()])
+	}
+
+	output, err := xml.Marshal(upgraded)
+	require.NoError(t, err)
+	_, _, version, err := gen.ParseAny(output)
+	require.NoError(t, err)
+	require.Equal(t, "v432", version)
+}
+
+// TestMarshalSelfClosing verifies empty elements, including nested ones, are self-closed while elements
+// with whitespace text keep their end tag
+func TestMarshalSelfClosing(t *testing.T) {
+	closed, err := selfCloseEmptyElements([]byte(`<A x="1"><B></B><C><D></D><E/></C><F> </F><G>
+</G></A>`))
+	require.NoError(t, err)
+	require.Equal(t, `<A x="1"><B/><C><D/><E/></C><F> </F><G>
+</G></A>`, string(closed))
+
+	msg := &ernv432.NewReleaseMessage{
+		MessageHeader: &ernv432.MessageHeader{
+			MessageThreadId: " ",
+			MessageId:       "MSG-1",
+			MessageSender:   &ernv432.MessagingPartyWithoutCode{PartyName: &ernv432.PartyNameWithoutCode{}},
+		},
+	}
+	output, err := MarshalIndent(msg, MarshalOptions{Indent: "  ", SelfClosing: true})
+	require.NoError(t, err)
+	require.Contains(t, string(output), "<MessageThreadId> </MessageThreadId>")
+	require.Contains(t, string(output), "<MessageFileName/>")
+	require.Contains(t, string(output), "<FullName/>")
+	require.NotContains(t, string(output), "></MessageFileName>")
+
+	var reparsed ernv432.NewReleaseMessage
+	require.NoError(t, xml.Unmarshal(output, &reparsed))
+	require.Equal(t, "MSG-1", reparsed.GetMessageHeader().GetMessageId())
+	require.Equal(t, " ", reparsed.GetMessageHeader().GetMessageThreadId())
+}
+
+// TestDefaultAccessors verifies GetXxxOr falls back to the default for empty values and nil messages
+func TestDefaultAccessors(t *testing.T) {
+	var missing *ernv432.NewReleaseMessage
+	require.Equal(t, "unknown", missing.GetMessageHeader().GetMessageControlTypeOr("unknown"))
+
+	msg := &ernv432.NewReleaseMessage{MessageHeader: &ernv432.MessageHeader{MessageControlType: "LiveMessage"}}
+	require.Equal(t, "LiveMessage", msg.GetMessageHeader().GetMessageControlTypeOr("TestMessage"))
+	require.Equal(t, "en", msg.GetLanguageAndScriptCodeOr("en"))
+}
+
+// TestValidateElementOrder verifies siblings are checked against the schema sequence
+func TestValidateElementOrder(t *testing.T) {
+	xmlData, err := testdata.DDEXTestDataFS.ReadFile("ddex/ern/v43/1 Audio.xml")
+	require.NoError(t, err)
+	require.Empty(t, ValidateElementOrder(xmlData, "ern", "v43"))
+
+	swapped := []byte(`<ern:NewReleaseMessage xmlns:ern="http://ddex.net/xml/ern/432">
+  <MessageHeader><MessageId>MSG-1</MessageId></MessageHeader>
+  <ReleaseList><Release><ReleaseReference>R0</ReleaseReference></Release></ReleaseList>
+  <ResourceList><SoundRecording><ResourceReference>A1</ResourceReference></SoundRecording></ResourceList>
+</ern:NewReleaseMessage>`)
+	errs := ValidateElementOrder(swapped, "ern", "v432")
+	require.Len(t, errs, 1)
+	require.Equal(t, "/NewReleaseMessage/ResourceList", errs[0].Path)
+	require.Equal(t, "ReleaseList", errs[0].After)
+}
+
+// TestAuditInfo verifies the audit trail is read from ERN 3 and ERN 4 headers alike
+func TestAuditInfo(t *testing.T) {
+	xmlData, err := testdata.DDEXTestDataFS.ReadFile("ddex/ern/v381/Single.xml")
+	require.NoError(t, err)
+	msg, err := ParseTyped[NewReleaseMessageV383](xmlData)
+	require.NoError(t, err)
+
+	audit, err := AuditInfo(msg)
+	require.NoError(t, err)
+	require.Equal(t, "1", audit.MessageId)
+	require.Equal(t, AuditParty{Id: "PADPIDA67890", Name: "TestLabel"}, audit.Sender)
+	require.Equal(t, []AuditParty{{Id: "PADPIDA12345", Name: "Testpartner"}}, audit.Recipients)
+	require.Equal(t, time.Date(2022, 4, 22, 1, 6, 36, 729000000, time.UTC), audit.CreatedDateTime)
+	require.Equal(t, "TestMessage", audit.MessageControlType)
+
+	xmlData, err = testdata.DDEXTestDataFS.ReadFile("ddex/ern/v43/1 Audio.xml")
+	require.NoError(t, err)
+	msg43, err := ParseTyped[NewReleaseMessageV43](xmlData)
+	require.NoError(t, err)
+
+	audit, err = AuditInfo(msg43)
+	require.NoError(t, err)
+	require.Equal(t, AuditParty{Id: "PADPIDA2013042401U", Name: "UniversalMusicGroup"}, audit.Sender)
+	require.True(t, audit.CreatedDateTime.Equal(time.Date(2014, 9, 24, 13, 57, 25, 0, time.UTC)))
+}
+
+// TestParseHeaderOnly verifies the header is decoded without the rest of the message
+func TestParseHeaderOnly(t *testing.T) {
+	xmlData, err := testdata.DDEXTestDataFS.ReadFile("ddex/ern/v43/1 Audio.xml")
+	require.NoError(t, err)
+
+	header, messageType, version, err := ParseHeaderOnly(xmlData)
+	require.NoError(t, err)
+	require.Equal(t, "ern", messageType)
+	require.Equal(t, "v43", version)
+	require.Equal(t, &HeaderInfo{
+		MessageThreadId:        "Test1",
+		MessageId:              "Test1.1",
+		MessageCreatedDateTime: "2014-09-24T14:57:25+01:00",
+		MessageSender:          HeaderParty{PartyIds: []string{"PADPIDA2013042401U"}, PartyName: "UniversalMusicGroup"},
+		MessageRecipients:      []HeaderParty{{PartyIds: []string{"PADPIDA2009101501Y"}, PartyName: "Sony DADC"}},
+	}, header)
+
+	// ERN 3.8 parties have repeatable PartyIds and a FullName with a language
+	xmlData, err = testdata.DDEXTestDataFS.ReadFile("ddex/ern/v381/Album.xml")
+	require.NoError(t, err)
+	header, messageType, version, err = ParseHeaderOnly(xmlData)
+	require.NoError(t, err)
+	require.Equal(t, "ern", messageType)
+	require.Equal(t, "v383", version)
+	require.Equal(t, "TestMessage", header.MessageControlType)
+	require.Equal(t, HeaderParty{PartyIds: []string{"PADPIDA67890"}, PartyName: "TestLabel"}, header.MessageSender)
+	require.Equal(t, []HeaderParty{{PartyIds: []string{"PADPIDA12345"}, PartyName: "Testpartner"}}, header.MessageRecipients)
+
+	_, _, _, err = ParseHeaderOnly([]byte(`<NewReleaseMessage xmlns="http://ddex.net/xml/ern/43"><PartyList/></NewReleaseMessage>`))
+	require.EqualError(t, err, "no MessageHeader found")
+}
+
+// BenchmarkParseHeaderOnly compares decoding only the header with a full parse of a large message
+func BenchmarkParseHeaderOnly(b *testing.B) {
+	xmlData, err := testdata.DDEXTestDataFS.ReadFile("ddex/ern/v42/Variant BoxedSet.xml")
+	require.NoError(b, err)
+
+	b.Run("header_only", func(b *testing.B) {
+		b.SetBytes(int64(len(xmlData)))
+		for i := 0; i < b.N; i++ {
+			if _, _, _, err := ParseHeaderOnly(xmlData); err != nil {
+				b.Fatal(err)
+			}
+		}
+	})
+	b.Run("full", func(b *testing.B) {
+		b.SetBytes(int64(len(xmlData)))
+		for i := 0; i < b.N; i++ {
+			if _, _, _, err := gen.ParseAny(xmlData); err != nil {
+				b.Fatal(err)
+			}
+		}
+	})
+}
+
+// TestNamespaceAttrAccessors verifies root attributes set through SetNamespaceAttr are marshaled
+func TestNamespaceAttrAccessors(t *testing.T) {
+	var missing *ernv432.NewReleaseMessage
+	_, ok := missing.GetNamespaceAttr("xmlns:avs")
+	require.False(t, ok)
+
+	msg := &ernv432.NewReleaseMessage{}
+	msg.SetNamespaceAttr("xmlns:avs", "http://ddex.net/xml/avs/avs")
+	value, ok := msg.GetNamespaceAttr("xmlns:avs")
+	require.True(t, ok)
+	require.Equal(t, "http://ddex.net/xml/avs/avs", value)
+
+	output, err := xml.Marshal(msg)
+	require.NoError(t, err)
+	require.Contains(t, string(output), `xmlns:avs="http://ddex.net/xml/avs/avs"`)
+}
+
+// TestValidateDealList verifies absent and empty DealLists are reported distinctly
+func TestValidateDealList(t *testing.T) {
+	msg := &ernv432.NewReleaseMessage{}
+	require.ErrorIs(t, ValidateDealList(msg), ErrNoDealList)
+
+	require.NoError(t, xml.Unmarshal([]byte(`<NewReleaseMessage><DealList/></NewReleaseMessage>`), msg))
+	require.ErrorIs(t, ValidateDealList(msg), ErrEmptyDealList)
+
+	msg.DealList.ReleaseDeal = []*ernv432.ReleaseDeal{{Deal: []*ernv432.Deal{{}}}}
+	require.NoError(t, ValidateDealList(msg))
+}
+
+// TestSplitByRelease verifies each release gets a self-contained message with only what it references
+func TestSplitByRelease(t *testing.T) {
+	xmlData, err := testdata.DDEXTestDataFS.ReadFile("ddex/ern/v381/Album.xml")
+	require.NoError(t, err)
+	msg, err := ParseTyped[NewReleaseMessageV383](xmlData)
+	require.NoError(t, err)
+	upgraded, _ := UpgradeV383ToV432(msg)
+
+	split, err := SplitByRelease(upgraded)
+	require.NoError(t, err)
+	require.Len(t, split, 1+len(upgraded.GetReleaseList().GetTrackRelease()))
+
+	require.NotNil(t, split[0].GetReleaseList().GetRelease())
+	require.Len(t, split[0].GetResourceList().GetSoundRecording(), 4)
+	for _, part := range split[1:] {
+		require.Nil(t, part.GetReleaseList().GetRelease())
+		require.Len(t, part.GetReleaseList().GetTrackRelease(), 1)
+		require.Len(t, part.GetResourceList().GetSoundRecording(), 1)
+		require.Empty(t, ValidateReferences(part, nil))
+	}
+
+	split[1].GetPartyList().GetParty()[0].PartyReference = "changed"
+	require.NotEqual(t, "changed", split[2].GetPartyList().GetParty()[0].GetPartyReference())
+
+	// Filtered lists copy their non-repeated fields too
+	list := upgraded.GetReleaseList()
+	filtered, ok := filterListItems(list, map[proto.Message]bool{list.GetTrackRelease()[1]: true})
+	require.True(t, ok)
+	copied := filtered.(*ernv432.ReleaseList)
+	require.Len(t, copied.GetTrackRelease(), 1)
+	require.True(t, proto.Equal(list.GetTrackRelease()[1], copied.GetTrackRelease()[0]))
+	require.NotSame(t, list.GetTrackRelease()[1], copied.GetTrackRelease()[0])
+	require.True(t, proto.Equal(list.GetRelease(), copied.GetRelease()))
+	require.NotSame(t, list.GetRelease(), copied.GetRelease())
+}
+
+// TestValidateAgainstSchema verifies documents are checked against the embedded XSD
+func TestValidateAgainstSchema(t *testing.T) {
+	xmlData, err := testdata.DDEXTestDataFS.ReadFile("ddex/ern/v43/1 Audio.xml")
+	require.NoError(t, err)
+	require.Empty(t, ValidateAgainstSchema(xmlData))
+
+	invalid := strings.Replace(string(xmlData), "<DisplayArtistRole>MainArtist</DisplayArtistRole>", "<DisplayArtistRole>Headliner</DisplayArtistRole>", 1)
+	invalid = strings.Replace(invalid, "<MessageId>", "<MessageNote/><MessageId>", 1)
+	errs := ValidateAgainstSchema([]byte(invalid))
+	require.Len(t, errs, 2)
+	require.Equal(t, "/NewReleaseMessage/MessageHeader/MessageNote", errs[0].Path)
+	require.Contains(t, errs[0].Message, "expected MessageId")
+	require.Equal(t, "/NewReleaseMessage/ResourceList/SoundRecording/DisplayArtist/DisplayArtistRole", errs[1].Path)
+}
+
+// TestRemapNamespacePrefix verifies a remapped message prefix is declared and used for the root element
+func TestRemapNamespacePrefix(t *testing.T) {
+	xmlData, err := testdata.DDEXTestDataFS.ReadFile("ddex/ern/v43/2 Video.xml")
+	require.NoError(t, err)
+	msg, err := ParseTyped[NewReleaseMessageV43](xmlData)
+	require.NoError(t, err)
+
+	require.Error(t, RemapNamespacePrefix(msg, "missing", "e"))
+	require.Error(t, RemapNamespacePrefix(msg, "ern", "xsi"))
+	require.NoError(t, RemapNamespacePrefix(msg, "ern", "e"))
+	_, ok := msg.GetNamespaceAttr("xmlns:ern")
+	require.False(t, ok)
+
+	msg.SetNamespaceAttr("xmlns:avs", "http://ddex.net/xml/avs/avs")
+	require.NoError(t, RemapNamespacePrefix(msg, "avs", "codes"))
+	value, _ := msg.GetNamespaceAttr("xmlns:codes")
+	require.Equal(t, "http://ddex.net/xml/avs/avs", value)
+
+	output, err := MarshalIndent(msg, MarshalOptions{Indent: "  ", PrefixedRoot: true})
+	require.NoError(t, err)
+	require.True(t, strings.HasPrefix(string(output), `<e:NewReleaseMessage xmlns:codes="http://ddex.net/xml/avs/avs" xmlns:e="http://ddex.net/xml/ern/43"`))
+	require.True(t, strings.HasSuffix(string(output), "</e:NewReleaseMessage>"))
+	require.NotContains(t, string(output), "xmlns:ern")
+
+	reparsed, err := ParseTyped[NewReleaseMessageV43](output)
+	require.NoError(t, err)
+	require.Equal(t, msg.GetMessageHeader().GetMessageId(), reparsed.GetMessageHeader().GetMessageId())
+}
+
+// TestCatalogStreamWriter verifies a streamed CatalogListMessage matches the buffered marshal of the same message
+func TestCatalogStreamWriter(t *testing.T) {
+	msg, err := NewMessage("ern", "v383", "CatalogListMessage")
+	require.NoError(t, err)
+	catalog := msg.(*CatalogListMessageV383)
+	catalog.MessageSchemaVersionId = "ern/383"
+	catalog.MessageHeader = &ernv383.MessageHeader{MessageId: "CATALOG-1"}
+	catalog.PublicationDate = "2024-01-01T00:00:00Z"
+	catalog.CatalogItem = []*ernv383.CatalogItem{{ReleaseId: []*ernv383.ReleaseId{{ICPN: &ernv383.ICPN{Value: "0000000000001"}}}}}
+
+	var streamed strings.Builder
+	writer, err := NewCatalogStreamWriter(&streamed, catalog)
+	require.NoError(t, err)
+	for _, icpn := range []string{"0000000000002", "0000000000003"} {
+		item := &ernv383.CatalogItem{ReleaseId: []*ernv383.ReleaseId{{ICPN: &ernv383.ICPN{Value: icpn}}}}
+		require.NoError(t, writer.WriteRelease(item))
+		catalog.CatalogItem = append(catalog.CatalogItem, item)
+	}
+	require.NoError(t, writer.Close())
+	require.Error(t, writer.WriteRelease(&ernv383.CatalogItem{}))
+
+	buffered, err := xml.Marshal(catalog)
+	require.NoError(t, err)
+	require.Equal(t, string(buffered), streamed.String())
+
+	parsed, err := ParseTyped[CatalogListMessageV383]([]byte(streamed.String()))
+	require.NoError(t, err)
+	require.Len(t, parsed.CatalogItem, 3)
+}
+
+// TestValidateCopyrightYears verifies placeholder and future PLine/CLine years are reported with their path
+func TestValidateCopyrightYears(t *testing.T) {
+	xmlData, err := testdata.DDEXTestDataFS.ReadFile("ddex/ern/v43/1 Audio.xml")
+	require.NoError(t, err)
+	msg, err := ParseTyped[NewReleaseMessageV43](xmlData)
+	require.NoError(t, err)
+	require.Empty(t, ValidateCopyrightYears(msg))
+
+	edition := msg.ResourceList.SoundRecording[0].SoundRecordingEdition[0]
+	edition.PLine[0].Year = "0000"
+	edition.PLine = append(edition.PLine, &ernv43.PLineWithDefault{Year: fmt.Sprint(time.Now().Year() + 2)})
+	edition.PLine = append(edition.PLine, &ernv43.PLineWithDefault{Year: "94"})
+
+	errs := ValidateCopyrightYears(msg)
+	require.Len(t, errs, 3)
+	require.Equal(t, "/NewReleaseMessage/ResourceList/SoundRecording/SoundRecordingEdition/PLine/Year", errs[0].Path)
+	require.Equal(t, "0000", errs[0].Year)
+	require.Contains(t, errs[1].Message, "outside")
+	require.Contains(t, errs[2].Message, "four digits")
+}
+
+// TestParseWithOptions verifies each parse option on top of the registry parse functions
+func TestParseWithOptions(t *testing.T) {
+	xmlData, err := testdata.DDEXTestDataFS.ReadFile("ddex/ern/v43/1 Audio.xml")
+	require.NoError(t, err)
+
+	msg, messageType, version, err := ParseAnyWithOptions(xmlData, WithValidation(), WithStrictReferences())
+	require.NoError(t, err)
+	require.Equal(t, "ern", messageType)
+	require.Equal(t, "v43", version)
+	require.IsType(t, &NewReleaseMessageV43{}, msg)
+
+	_, err = ParseWithOptions(xmlData, "ern", "v43", WithLimits(Limits{MaxDepth: 3}))
+	require.ErrorContains(t, err, "depth limit exceeded")
+
+	broken := []byte(strings.Replace(string(xmlData), "<ResourceReference>A1</ResourceReference>", "<ResourceReference>X1</ResourceReference>", 1))
+	_, err = ParseWithOptions(broken, "ern", "v43")
+	require.NoError(t, err)
+	_, err = ParseWithOptions(broken, "ern", "v43", WithStrictReferences())
+	require.ErrorContains(t, err, "unresolved reference")
+
+	invalid := []byte(strings.Replace(string(xmlData), "<ResourceList>", "<ResourceList><Bogus/>", 1))
+	_, _, _, err = ParseAnyWithOptions(invalid, WithValidation())
+	require.ErrorContains(t, err, "schema validation failed")
+
+	// The limits apply before the schema pass reads the document
+	_, _, _, err = ParseAnyWithOptions(invalid, WithLimits(Limits{MaxDepth: 3}), WithValidation())
+	require.ErrorContains(t, err, "depth limit exceeded")
+	require.NotContains(t, err.Error(), "schema validation failed")
+
+	// An ISO-8859-1 document only parses with charset detection
+	latin1 := []byte(strings.Replace(string(xmlData), `encoding="UTF-8"`, `encoding="ISO-8859-1"`, 1))
+	latin1 = []byte(strings.Replace(string(latin1), "EMI Music Japan Inc.", "EMI Music Japan Inc.\xe9", 1))
+	_, _, _, err = ParseAnyWithOptions(latin1)
+	require.Error(t, err)
+	msg, _, _, err = ParseAnyWithOptions(latin1, WithCharsetDetection())
+	require.NoError(t, err)
+	require.Contains(t, msg.(*NewReleaseMessageV43).ResourceList.SoundRecording[0].SoundRecordingEdition[0].PLine[0].PLineText, "Inc.é")
+
+	// Versions with several root messages decode into the one named by the root element
+	purge, err := xml.Marshal(&ernv43.PurgeReleaseMessage{
+		MessageHeader: &ernv43.MessageHeader{MessageId: "Purge1"},
+		PurgedRelease: &ernv43.PurgedRelease{ReleaseId: &ernv43.ReleaseId{ICPN: "00094631432057"}},
+	})
+	require.NoError(t, err)
+	msg, err = ParseWithOptions(purge, "ern", "v43")
+	require.NoError(t, err)
+	require.IsType(t, &ernv43.PurgeReleaseMessage{}, msg)
+	require.Equal(t, "Purge1", msg.(*ernv43.PurgeReleaseMessage).MessageHeader.MessageId)
+	msg, _, _, err = ParseAnyWithOptions(purge)
+	require.NoError(t, err)
+	require.IsType(t, &ernv43.PurgeReleaseMessage{}, msg)
+}
+
+// TestContentHash verifies the hash ignores namespace declaration order, whitespace and the order of
+// referenced elements, but not content changes
+func TestContentHash(t *testing.T) {
+	xmlData, err := testdata.DDEXTestDataFS.ReadFile("ddex/ern/v43/1 Audio.xml")
+	require.NoError(t, err)
+	msg, err := ParseTyped[NewReleaseMessageV43](xmlData)
+	require.NoError(t, err)
+	hash, err := ContentHash(msg)
+	require.NoError(t, err)
+	require.Len(t, hash, 64)
+
+	reordered := regexp.MustCompile(`(xmlns:ern="[^"]*")(\s+)(xmlns:xsi="[^"]*")`).ReplaceAll(xmlData, []byte("${3}${2}${1}"))
+	require.NotEqual(t, xmlData, reordered)
+	reordered = []byte(strings.Replace(string(reordered), "<ISRC>JPTO09404900</ISRC>", "<ISRC>\n JPTO09404900 </ISRC>", 1))
+	other, err := ParseTyped[NewReleaseMessageV43](reordered)
+	require.NoError(t, err)
+	recordings := other.ResourceList.SoundRecording
+	recordings[0], recordings[1] = recordings[1], recordings[0]
+	otherHash, err := ContentHash(other)
+	require.NoError(t, err)
+	require.Equal(t, hash, otherHash)
+	require.Equal(t, "A2", other.ResourceList.SoundRecording[0].ResourceReference)
+
+	other.ResourceList.SoundRecording[0].Duration = "PT1M"
+	changedHash, err := ContentHash(other)
+	require.NoError(t, err)
+	require.NotEqual(t, hash, changedHash)
+}
+
+// TestXsiType verifies an xsi:type naming an element's declared type parses as that type and validates,
+// while any other type is reported since the DDEX schemas declare no derived types
+func TestXsiType(t *testing.T) {
+	xmlData, err := testdata.DDEXTestDataFS.ReadFile("ddex/ern/v43/1 Audio.xml")
+	require.NoError(t, err)
+	typed := []byte(strings.Replace(string(xmlData), "<SoundRecording>", `<SoundRecording xsi:type="ern:SoundRecording">`, 1))
+	require.NotEqual(t, xmlData, typed)
+
+	plain, err := ParseTyped[NewReleaseMessageV43](xmlData)
+	require.NoError(t, err)
+	msg, err := ParseTyped[NewReleaseMessageV43](typed)
+	require.NoError(t, err)
+	require.True(t, proto.Equal(plain, msg))
+	require.Empty(t, ValidateAgainstSchema(typed))
+
+	wrong := []byte(strings.Replace(string(xmlData), "<SoundRecording>", `<SoundRecording xsi:type="ern:Video">`, 1))
+	errs := ValidateAgainstSchema(wrong)
+	require.Len(t, errs, 1)
+	require.Equal(t, "/NewReleaseMessage/ResourceList/SoundRecording@type", errs[0].Path)
+	require.Contains(t, errs[0].Message, "not the declared type SoundRecording")
+}
+
+// TestMarshalElementNames verifies element-name overrides rename only the element at the given path,
+// including self-closed ones
+func TestMarshalElementNames(t *testing.T) {
+	xmlData, err := testdata.DDEXTestDataFS.ReadFile("ddex/ern/v43/1 Audio.xml")
+	require.NoError(t, err)
+	msg, err := ParseTyped[NewReleaseMessageV43](xmlData)
+	require.NoError(t, err)
+	msg.ResourceList.SoundRecording[0].Duration = ""
+
+	output, err := MarshalIndent(msg, MarshalOptions{Indent: "  ", SelfClosing: true, ElementNames: map[string]string{
+		"/NewReleaseMessage/ResourceList/SoundRecording/ResourceReference": "SoundRecordingId",
+		"/NewReleaseMessage/ResourceList/SoundRecording/Duration":          "Length",
+	}})
+	require.NoError(t, err)
+	require.Contains(t, string(output), "<SoundRecordingId>A1</SoundRecordingId>")
+	require.Equal(t, 1, strings.Count(string(output), "<Length/>"))
+	require.Equal(t, 20, strings.Count(string(output), "<Length>"))
+	require.Equal(t, 21, strings.Count(string(output), "<SoundRecordingId>"))
+	require.Contains(t, string(output), "<ResourceReference>A22</ResourceReference>")
+}
+
+// TestDetectEncodingMismatch verifies Latin-1 bytes in a document declared UTF-8 are flagged
+func TestDetectEncodingMismatch(t *testing.T) {
+	xmlData, err := testdata.DDEXTestDataFS.ReadFile("ddex/ern/v43/1 Audio.xml")
+	require.NoError(t, err)
+	declared, looksLike, mismatch := DetectEncodingMismatch(xmlData)
+	require.Equal(t, "UTF-8", declared)
+	require.Contains(t, []string{"US-ASCII", "UTF-8"}, looksLike)
+	require.False(t, mismatch)
+
+	latin1 := []byte(strings.Replace(string(xmlData), "EMI Music Japan Inc.", "EMI Music Japan Inc.\xe9", 1))
+	declared, looksLike, mismatch = DetectEncodingMismatch(latin1)
+	require.Equal(t, "UTF-8", declared)
+	require.Equal(t, "ISO-8859-1", looksLike)
+	require.True(t, mismatch)
+
+	declaredLatin1 := []byte(strings.Replace(string(latin1), `encoding="UTF-8"`, `encoding="ISO-8859-1"`, 1))
+	_, _, mismatch = DetectEncodingMismatch(declaredLatin1)
+	require.False(t, mismatch)
+
+	_, looksLike, mismatch = DetectEncodingMismatch([]byte(`<?xml version="1.0" encoding="windows-1252"?><a>caf` + "é" + `</a>`))
+	require.Equal(t, "UTF-8", looksLike)
+	require.True(t, mismatch)
+}
+
+// TestCoveredTerritories verifies Worldwide expansion, exclusions and the union across a release's deals
+func TestCoveredTerritories(t *testing.T) {
+	territories := func(codes ...string) []*ernv432.CurrentTerritoryCode {
+		var list []*ernv432.CurrentTerritoryCode
+		for _, code := range codes {
+			list = append(list, &ernv432.CurrentTerritoryCode{Value: code})
+		}
+		return list
+	}
+	msg := &ernv432.NewReleaseMessage{DealList: &ernv432.DealList{ReleaseDeal: []*ernv432.ReleaseDeal{
+		{DealReleaseReference: []string{"R0"}, Deal: []*ernv432.Deal{
+			{DealTerms: &ernv432.DealTerms{TerritoryCode: territories("Worldwide"), ExcludedTerritoryCode: territories("US", "CA")}},
+			{DealTerms: &ernv432.DealTerms{TerritoryCode: territories("ca")}},
+		}},
+		{DealReleaseReference: []string{"R1"}, Deal: []*ernv432.Deal{
+			{DealTerms: &ernv432.DealTerms{TerritoryCode: territories("GB", "IE")}},
+		}},
+		{DealReleaseReference: []string{"R2"}, Deal: []*ernv432.Deal{
+			{DealTerms: &ernv432.DealTerms{TerritoryCode: territories("2136")}},
+		}},
+	}}}
+
+	covered, err := CoveredTerritories(msg, "R0")
+	require.NoError(t, err)
+	require.Contains(t, covered, "CA")
+	require.Contains(t, covered, "JP")
+	require.NotContains(t, covered, "US")
+	require.NotContains(t, covered, "AN")
+	require.Greater(t, len(covered), 240)
+
+	covered, err = CoveredTerritories(msg, "R1")
+	require.NoError(t, err)
+	require.Equal(t, []string{"GB", "IE"}, covered)
+
+	_, err = CoveredTerritories(msg, "R2")
+	require.ErrorContains(t, err, "does not resolve")
+	_, err = CoveredTerritories(msg, "R9")
+	require.Error(t, err)
+}
+
+// TestValidateMessageParties verifies MessageSender and MessageRecipient party ID checks for ERN 4 and ERN 3.8
+func TestValidateMessageParties(t *testing.T) {
+	xmlData, err := testdata.DDEXTestDataFS.ReadFile("ddex/ern/v43/1 Audio.xml")
+	require.NoError(t, err)
+	msg, err := ParseTyped[NewReleaseMessageV43](xmlData)
+	require.NoError(t, err)
+	require.Empty(t, ValidateMessageParties(msg))
+
+	msg.MessageHeader.MessageSender.PartyId = "PADPIDA2222222222"
+	msg.MessageHeader.MessageRecipient = nil
+	errs := ValidateMessageParties(msg)
+	require.Len(t, errs, 2)
+	require.Equal(t, "MessageSender", errs[0].Party)
+	require.Equal(t, "PADPIDA2222222222", errs[0].PartyId)
+	require.Equal(t, "MessageRecipient", errs[1].Party)
+
+	// ERN 3.8 PartyIds may be ISNIs or proprietary identifiers
+	legacy := &ernv383.NewReleaseMessage{MessageHeader: &ernv383.MessageHeader{
+		MessageSender: &ernv383.MessagingParty{PartyId: []*ernv383.PartyId{{Value: "0000000121032683", IsISNI: true}}},
+		MessageRecipient: []*ernv383.MessagingParty{
+			{PartyId: []*ernv383.PartyId{{Value: "DSP-42", Namespace: "PADPIDA2007050901U"}}},
+			{PartyId: []*ernv383.PartyId{{Value: "DSP-43"}}},
+		},
+	}}
+	errs = ValidateMessageParties(legacy)
+	require.Len(t, errs, 1)
+	require.Equal(t, "MessageRecipient", errs[0].Party)
+	require.Contains(t, errs[0].Message, "recognized namespace")
+}
+
+// TestNewAcknowledgement verifies the FtpAcknowledgementMessage built for a parsed message and its ERN-C root element
+func TestNewAcknowledgement(t *testing.T) {
+	xmlData, err := testdata.DDEXTestDataFS.ReadFile("ddex/ern/v43/1 Audio.xml")
+	require.NoError(t, err)
+	msg, err := ParseTyped[NewReleaseMessageV43](xmlData)
+	require.NoError(t, err)
+
+	ack, err := NewAcknowledgement(msg, "fileok")
+	require.NoError(t, err)
+	out, err := xml.Marshal(ack)
+	require.NoError(t, err)
+	require.True(t, strings.HasPrefix(string(out), `<ernc:FtpAcknowledgementMessage xmlns:ernc="`+NamespaceERNC+`" MessageVersionId="ern-c/15"><MessageHeader>`), string(out))
+	require.Contains(t, string(out), "<AcknowledgedFile><MessageId>Test1.1</MessageId></AcknowledgedFile><FileStatus>FileOK</FileStatus></ernc:FtpAcknowledgementMessage>")
+
+	// The root is in the ERN-C namespace and the children in none
+	decoder := xml.NewDecoder(bytes.NewReader(out))
+	var names []xml.Name
+	for {
+		token, err := decoder.Token()
+		if err == io.EOF {
+			break
+		}
+		require.NoError(t, err)
+		if start, ok := token.(xml.StartElement); ok {
+			names = append(names, start.Name)
+		}
+	}
+	require.Equal(t, xml.Name{Space: NamespaceERNC, Local: "FtpAcknowledgementMessage"}, names[0])
+	require.Equal(t, xml.Name{Local: "MessageHeader"}, names[1])
+
+	var decoded FtpAcknowledgementMessage
+	require.NoError(t, xml.Unmarshal(out, &decoded))
+	require.Equal(t, "FileOK", decoded.FileStatus)
+
+	header := ack.(*FtpAcknowledgementMessage).MessageHeader
+	require.Equal(t, "ACK-Test1.1", header.MessageId)
+	require.Equal(t, "Sony DADC", header.MessageSender.FullName)
+	require.Equal(t, msg.MessageHeader.MessageSender.PartyId, header.MessageRecipient[0].PartyId)
+
+	_, err = NewAcknowledgement(msg, "Received")
+	require.Error(t, err)
+	_, err = NewAcknowledgement(&NewReleaseMessageV43{}, "FileOK")
+	require.Error(t, err)
+
+	// The file statuses must cover the ErncFileStatus allowed value set
+	var codes []string
+	for number := range avslatest.ErncFileStatus_name {
+		if code := avslatest.ErncFileStatus(number).XMLString(); code != "" {
+			codes = append(codes, code)
+		}
+	}
+	require.Len(t, fileStatuses, len(codes))
+	for _, status := range fileStatuses {
+		_, ok := avslatest.ParseErncFileStatusString(status)
+		require.True(t, ok, status)
+	}
+}
+
+// TestFindOrphanResources verifies resources no release references, directly or as linked resources, are reported
+func TestFindOrphanResources(t *testing.T) {
+	msg := &ernv432.NewReleaseMessage{
+		ResourceList: &ernv432.ResourceList{
+			SoundRecording: []*ernv432.SoundRecording{{ResourceReference: "A1"}, {ResourceReference: "A2"}},
+			Image:          []*ernv432.Image{{ResourceReference: "A3"}, {ResourceReference: "A4"}},
+		},
+		ReleaseList: &ernv432.ReleaseList{
+			Release: &ernv432.Release{ResourceGroup: &ernv432.ResourceGroup{
+				ResourceGroupContentItem: []*ernv432.ResourceGroupContentItem{{
+					ReleaseResourceReference:       "A1",
+					LinkedReleaseResourceReference: []*ernv432.LinkedReleaseResourceReference{{Value: "A3"}},
+				}},
+			}},
+		},
+	}
+	require.Equal(t, []string{"A2", "A4"}, FindOrphanResources(msg))
+
+	msg.ReleaseList.TrackRelease = []*ernv432.TrackRelease{{ReleaseResourceReference: "A2"}}
+	require.Equal(t, []string{"A4"}, FindOrphanResources(msg))
+}
+
+// TestParseSOAPWrapped verifies messages are unwrapped from SOAP 1.1 and 1.2 envelopes
+func TestParseSOAPWrapped(t *testing.T) {
+	xmlData, err := testdata.DDEXTestDataFS.ReadFile("ddex/ern/v43/1 Audio.xml")
+	require.NoError(t, err)
+	document := strings.TrimPrefix(string(xmlData), `<?xml version="1.0" encoding="UTF-8"?>`)
+
+	// SOAP 1.2, with a Header
+	wrapped := `<?xml version="1.0"?><env:Envelope xmlns:env="http://www.w3.org/2003/05/soap-envelope">` +
+		`<env:Header><Auth><Token>x</Token></Auth></env:Header><env:Body>` + document + `</env:Body></env:Envelope>`
+	msg, messageType, version, err := ParseSOAPWrapped([]byte(wrapped))
+	require.NoError(t, err)
+	require.Equal(t, "ern", messageType)
+	require.Equal(t, "v43", version)
+	require.Equal(t, "Test1.1", msg.(*NewReleaseMessageV43).MessageHeader.MessageId)
+
+	// SOAP 1.1, with the ern prefix declared on the Envelope only
+	document = strings.Replace(document, `xmlns:ern="http://ddex.net/xml/ern/43"`, "", 1)
+	wrapped = `<soap:Envelope xmlns:soap="http://schemas.xmlsoap.org/soap/envelope/" xmlns:ern="http://ddex.net/xml/ern/43">` +
+		`<soap:Body>` + document + `</soap:Body></soap:Envelope>`
+	msg, _, version, err = ParseSOAPWrapped([]byte(wrapped))
+	require.NoError(t, err)
+	require.Equal(t, "v43", version)
+	require.Len(t, msg.(*NewReleaseMessageV43).ResourceList.SoundRecording, 21)
+
+	// Declarations on the Header stay with the Header, even for prefixes the message uses
+	wrapped = `<soap:Envelope xmlns:soap="http://schemas.xmlsoap.org/soap/envelope/" xmlns:ern="http://ddex.net/xml/ern/43">` +
+		`<soap:Header xmlns="urn:example:auth" xmlns:ern="urn:example:session"><ern:Token>x</ern:Token></soap:Header>` +
+		`<soap:Body>` + document + `</soap:Body></soap:Envelope>`
+	messageData, err := soapBodyMessage([]byte(wrapped))
+	require.NoError(t, err)
+	require.NotContains(t, string(messageData), "urn:example")
+	msg, _, version, err = ParseSOAPWrapped([]byte(wrapped))
+	require.NoError(t, err)
+	require.Equal(t, "v43", version)
+	require.Equal(t, "Test1.1", msg.(*NewReleaseMessageV43).MessageHeader.MessageId)
+
+	_, _, _, err = ParseSOAPWrapped(xmlData)
+	require.ErrorContains(t, err, "not a SOAP Envelope")
+	_, _, _, err = ParseSOAPWrapped([]byte(`<soap:Envelope xmlns:soap="http://schemas.xmlsoap.org/soap/envelope/"><soap:Body/></soap:Envelope>`))
+	require.ErrorContains(t, err, "no message")
+}
+
+// TestFieldToElement verifies the generated proto field to element map against the xml tags
+func TestFieldToElement(t *testing.T) {
+	require.Equal(t, "MessageHeader", ernv43.FieldToElement["NewReleaseMessage.message_header"])
+	require.Equal(t, "@LanguageAndScriptCode", ernv43.FieldToElement["NewReleaseMessage.language_and_script_code"])
+	require.Equal(t, "DealReleaseReference", ernv432.FieldToElement["ReleaseDeal.deal_release_reference"])
+
+	fields := (&ernv43.SoundRecording{}).ProtoReflect().Descriptor().Fields()
+	for i := 0; i < fields.Len(); i++ {
+		element, ok := ernv43.FieldToElement["SoundRecording."+string(fields.Get(i).Name())]
+		require.True(t, ok, fields.Get(i).Name())
+		require.NotEmpty(t, element)
+	}
+}
+
+// TestValidateArtistNameLocalization verifies party names need a default per territory and valid language codes
+func TestValidateArtistNameLocalization(t *testing.T) {
+	xmlData, err := testdata.DDEXTestDataFS.ReadFile("ddex/ern/v43/1 Audio.xml")
+	require.NoError(t, err)
+	msg, err := ParseTyped[NewReleaseMessageV43](xmlData)
+	require.NoError(t, err)
+	require.Empty(t, ValidateArtistNameLocalization(msg))
+
+	// PSaekoShu has a default name and a ja-Jpan one
+	party := msg.PartyList.Party[0]
+	party.PartyName[0].LanguageAndScriptCode = "ja-Latn"
+	party.PartyName = append(party.PartyName, &ernv43.PartyNameWithTerritory{LanguageAndScriptCode: "JA-JPAN"})
+	errs := ValidateArtistNameLocalization(msg)
+	require.Len(t, errs, 2)
+	require.Equal(t, "/NewReleaseMessage/PartyList/Party/PartyName", errs[0].Path)
+	require.Equal(t, party.PartyReference, errs[0].Reference)
+	require.Equal(t, "JA-JPAN", errs[0].Language)
+	require.Contains(t, errs[1].Message, "no default")
+
+	// A default per territory
+	party.PartyName[2].ApplicableTerritoryCode = "JP"
+	party.PartyName = append(party.PartyName, &ernv43.PartyNameWithTerritory{}, &ernv43.PartyNameWithTerritory{ApplicableTerritoryCode: "JP"})
+	require.Empty(t, ValidateArtistNameLocalization(msg))
+}
+
+// TestVerifyFileHashes verifies resource files are hashed and compared with their declared HashSum
+func TestVerifyFileHashes(t *testing.T) {
+	file := func(uri, algorithm, value string) *ernv432.TechnicalImageDetails {
+		return &ernv432.TechnicalImageDetails{File: &ernv432.File{URI: uri, HashSum: &ernv432.DetailedHashSum{
+			Algorithm:    &ernv432.HashSumAlgorithmType{Value: algorithm},
+			HashSumValue: value,
+		}}}
+	}
+	msg := &ernv432.NewReleaseMessage{ResourceList: &ernv432.ResourceList{Image: []*ernv432.Image{
+		// MD5 and SHA-256 of "cover"
+		{ResourceReference: "A1", TechnicalDetails: []*ernv432.TechnicalImageDetails{
+			file("cover.jpg", "MD5", "41D0E299CA1ABEB2094852DA042165C7"),
+			file("cover.jpg", "SHA-256", "3fa405a8301ace34d11cf44a816080b8f0e49a48fbd048b8aef1543a8c58bdb6"),
+		}},
+		{ResourceReference: "A2", TechnicalDetails: []*ernv432.TechnicalImageDetails{
+			file("missing.jpg", "MD5", "00"),
+			file("cover.jpg", "SHA3", "00"),
+		}},
+	}}}
+
+	resolve := func(uri string) (io.Reader, error) {
+		if uri != "cover.jpg" {
+			return nil, fmt.Errorf("not found")
+		}
+		return strings.NewReader("cover"), nil
+	}
+	errs := VerifyFileHashes(msg, resolve)
+	require.Len(t, errs, 2)
+	require.Equal(t, "A2", errs[0].ResourceReference)
+	require.Contains(t, errs[0].Message, "cannot resolve")
+	require.Contains(t, errs[1].Message, "SHA3")
+
+	msg.ResourceList.Image[1].TechnicalDetails = []*ernv432.TechnicalImageDetails{file("cover.jpg", "SHA1", "deadbeef")}
+	errs = VerifyFileHashes(msg, resolve)
+	require.Len(t, errs, 1)
+	require.Equal(t, "deadbeef", errs[0].Expected)
+	require.Len(t, errs[0].Actual, 40)
+}
+
+// TestProject verifies a projection keeps only the listed paths and leaves the original untouched
+func TestProject(t *testing.T) {
+	xmlData, err := testdata.DDEXTestDataFS.ReadFile("ddex/ern/v43/1 Audio.xml")
+	require.NoError(t, err)
+	msg, err := ParseTyped[NewReleaseMessageV43](xmlData)
+	require.NoError(t, err)
+
+	projected, err := Project(msg, []string{
+		"/NewReleaseMessage/MessageHeader/MessageId",
+		"/NewReleaseMessage/ResourceList/SoundRecording/ResourceReference",
+		"/NewReleaseMessage/ResourceList/SoundRecording/DisplayTitleText",
+		"/NewReleaseMessage@LanguageAndScriptCode",
+	})
+	require.NoError(t, err)
+	projection := projected.(*NewReleaseMessageV43)
+	require.Equal(t, "Test1.1", projection.MessageHeader.MessageId)
+	require.Nil(t, projection.MessageHeader.MessageSender)
+	require.Len(t, projection.ResourceList.SoundRecording, 21)
+	recording := projection.ResourceList.SoundRecording[0]
+	require.Equal(t, msg.ResourceList.SoundRecording[0].ResourceReference, recording.ResourceReference)
+	require.Equal(t, msg.ResourceList.SoundRecording[0].DisplayTitleText[0].Value, recording.DisplayTitleText[0].Value)
+	require.Empty(t, recording.DisplayArtistName)
+	require.Nil(t, projection.ReleaseList)
+	require.Nil(t, projection.PartyList)
+	require.Equal(t, "en", projection.LanguageAndScriptCode)
+	require.Empty(t, projection.ReleaseProfileVersionId)
+	require.Equal(t, msg.NamespaceAttrs, projection.NamespaceAttrs)
+
+	// The original is untouched
+	require.NotNil(t, msg.MessageHeader.MessageSender)
+
+	_, err = Project(msg, []string{"/NewReleaseMessage/ResourceList/SoundRecording/Title"})
+	require.ErrorContains(t, err, "does not exist")
+}
+
+// TestLocalizedTitles verifies titles are collected by reference and language, including ERN 3.8 releases
+func TestLocalizedTitles(t *testing.T) {
+	xmlData, err := testdata.DDEXTestDataFS.ReadFile("ddex/ern/v43/1 Audio.xml")
+	require.NoError(t, err)
+	msg, err := ParseTyped[NewReleaseMessageV43](xmlData)
+	require.NoError(t, err)
+
+	titles := LocalizedTitles(msg)
+	require.Equal(t, map[string]string{"": "Yume no Lullaby", "ja-Jpan": "夢のララバイ"}, titles["A1"])
+	require.Equal(t, "Yume no Hajmari", titles["R0"][""])
+	require.Equal(t, "ﾕﾒﾉﾊｼﾞﾏﾘ", titles["R0"]["ja-Kana"])
+	require.Empty(t, LocalizedTitles(&NewReleaseMessageV43{}))
+
+	// ERN 3.8.x releases repeat ReleaseReference; the first is the release's own
+	legacy := &ernv383.NewReleaseMessage{ReleaseList: &ernv383.ReleaseList{Release: []*ernv383.Release{
+		{ReleaseReference: []string{" R0 ", "R1"}, ReferenceTitle: &ernv383.ReferenceTitle{TitleText: &ernv383.TitleText{Value: "Album"}}},
+		{ReleaseReference: []string{"R1"}, ReferenceTitle: &ernv383.ReferenceTitle{TitleText: &ernv383.TitleText{Value: "Track"}, LanguageAndScriptCode: "en"}},
+	}}}
+	require.Equal(t, map[string]map[string]string{"R0": {"": "Album"}, "R1": {"en": "Track"}}, LocalizedTitles(legacy))
+}
+
+// TestFlattenReleases verifies the one-row-per-release summary of a message
+func TestFlattenReleases(t *testing.T) {
+	xmlData, err := testdata.DDEXTestDataFS.ReadFile("ddex/ern/v43/1 Audio.xml")
+	require.NoError(t, err)
+	msg, err := ParseTyped[NewReleaseMessageV43](xmlData)
+	require.NoError(t, err)
+
+	rows := FlattenReleases(msg)
+	require.Len(t, rows, 22)
+	require.Equal(t, ReleaseRow{
+		MessageId:        "Test1.1",
+		ReleaseReference: "R0",
+		Title:            "Yume no Hajmari",
+		DisplayArtist:    "Saeko Shu",
+		ICPN:             "00094631432057",
+		ReleaseType:      "Album",
+		TrackCount:       21,
+		TerritoryCount:   1,
+	}, rows[0])
+	require.Equal(t, "R1", rows[1].ReleaseReference)
+	require.Equal(t, 1, rows[1].TrackCount)
+
+	require.Empty(t, FlattenReleases(&NewReleaseMessageV43{}))
+}
+
+// TestParseWarnings verifies conflicting namespace declarations are reported and reset on the next parse
+func TestParseWarnings(t *testing.T) {
+	xmlData, err := testdata.DDEXTestDataFS.ReadFile("ddex/ern/v43/1 Audio.xml")
+	require.NoError(t, err)
+	msg, err := ParseTyped[NewReleaseMessageV43](xmlData)
+	require.NoError(t, err)
+	require.Empty(t, msg.ParseWarnings())
+
+	// Marshaled output declares the namespace as both xmlns and xmlns:ern, which is not a conflict
+	out, err := xml.Marshal(msg)
+	require.NoError(t, err)
+	reparsed, err := ParseTyped[NewReleaseMessageV43](out)
+	require.NoError(t, err)
+	require.Empty(t, reparsed.ParseWarnings())
+
+	conflicting := `<NewReleaseMessage xmlns="http://ddex.net/xml/ern/43" xmlns:avs="http://ddex.net/xml/avs/avs" xmlns:avs="http://example.com/avs"/>`
+	var parsed NewReleaseMessageV43
+	require.NoError(t, xml.Unmarshal([]byte(conflicting), &parsed))
+	require.Equal(t, []string{`xmlns:avs is declared twice, as "http://ddex.net/xml/avs/avs" and "http://example.com/avs"`}, parsed.ParseWarnings())
+	require.Equal(t, "http://example.com/avs", parsed.NamespaceAttrs["xmlns:avs"])
+
+	// Parsing again into the same message replaces its warnings
+	require.NoError(t, xml.Unmarshal(out, &parsed))
+	require.Empty(t, parsed.ParseWarnings())
+	require.Nil(t, (*NewReleaseMessageV43)(nil).ParseWarnings())
+}
+
+// TestTrackListing verifies a release's tracks are ordered by disc and sequence number and resolved to their recordings
+func TestTrackListing(t *testing.T) {
+	recording := func(reference, title, isrc string) *ernv432.SoundRecording {
+		return &ernv432.SoundRecording{
+			ResourceReference:     reference,
+			DisplayTitleText:      []*ernv432.DisplayTitleText{{Value: title}},
+			DisplayArtistName:     []*ernv432.DisplayArtistNameWithOriginalLanguage{{Value: "Artist"}},
+			Duration:              "PT3M",
+			SoundRecordingEdition: []*ernv432.SoundRecordingEdition{{ResourceId: []*ernv432.SoundRecordingId{{ISRC: isrc}}}},
+		}
+	}
+	item := func(sequence int32, reference string) *ernv432.ResourceGroupContentItem {
+		return &ernv432.ResourceGroupContentItem{SequenceNumber: sequence, ReleaseResourceReference: reference}
+	}
+	msg := &ernv432.NewReleaseMessage{
+		ResourceList: &ernv432.ResourceList{
+			SoundRecording: []*ernv432.SoundRecording{
+				recording("A1", "One", "USABC0000001"),
+				recording("A2", "Two", "USABC0000002"),
+				recording("A3", "Three", "USABC0000003"),
+			},
+			Image: []*ernv432.Image{{ResourceReference: "A4"}},
+		},
+		ReleaseList: &ernv432.ReleaseList{
+			Release: &ernv432.Release{
+				ReleaseReference: "R0",
+				ResourceGroup: &ernv432.ResourceGroup{
+					ResourceGroup: []*ernv432.ResourceSubGroup{
+						{SequenceNumber: 2, ResourceGroupContentItem: []*ernv432.ResourceGroupContentItem{item(1, "A3")}},
+						{SequenceNumber: 1, ResourceGroupContentItem: []*ernv432.ResourceGroupContentItem{item(2, "A2"), item(1, "A1"), item(3, "A4")}},
+					},
+				},
+			},
+			TrackRelease: []*ernv432.TrackRelease{{ReleaseReference: "R1", ReleaseResourceReference: "A2"}},
+		},
+	}
+
+	tracks, err := TrackListing(msg, "R0")
+	require.NoError(t, err)
+	require.Len(t, tracks, 3)
+	require.Equal(t, Track{ResourceReference: "A1", DiscNumber: 1, TrackNumber: 1, Title: "One", DisplayArtist: "Artist", ISRC: "USABC0000001", Duration: "PT3M"}, tracks[0])
+	require.Equal(t, "A2", tracks[1].ResourceReference)
+	require.Equal(t, "A3", tracks[2].ResourceReference)
+	require.Equal(t, 2, tracks[2].DiscNumber)
+	require.Equal(t, 1, tracks[2].TrackNumber)
+
+	tracks, err = TrackListing(msg, "R1")
+	require.NoError(t, err)
+	require.Len(t, tracks, 1)
+	require.Equal(t, "Two", tracks[0].Title)
+
+	_, err = TrackListing(msg, "R9")
+	require.ErrorContains(t, err, "not found")
+	msg.ReleaseList.Release.ResourceGroup.ResourceGroupContentItem = []*ernv432.ResourceGroupContentItem{item(3, "A9")}
+	_, err = TrackListing(msg, "R0")
+	require.ErrorContains(t, err, "unknown resource A9")
+}
+
+// TestParseDuration verifies ISO 8601 durations are parsed and malformed ones rejected
+func TestParseDuration(t *testing.T) {
+	for input, want := range map[string]time.Duration{
+		"PT3M45S":    3*time.Minute + 45*time.Second,
+		"PT30.072S":  30*time.Second + 72*time.Millisecond,
+		"P1DT2H":     26 * time.Hour,
+		"PT0S":       0,
+		"P0Y0M1D":    24 * time.Hour,
+		"-PT1M":      -time.Minute,
+		"PT1.5S":     1500 * time.Millisecond,
+		"PT4M8.000S": 4*time.Minute + 8*time.Second,
+	} {
+		got, err := ParseDuration(input)
+		require.NoError(t, err, input)
+		require.Equal(t, want, got, input)
+	}
+	for _, input := range []string{"", "P", "PT", "P1DT", "3:45", "PT3M45", "PT1.5M", "P1Y", "PT99999999999999999999S"} {
+		_, err := ParseDuration(input)
+		require.Error(t, err, input)
+	}
+}
+
+// TestValidateDurations verifies durations that are not valid ISO 8601 durations are reported
+func TestValidateDurations(t *testing.T) {
+	xmlData, err := testdata.DDEXTestDataFS.ReadFile("ddex/ern/v43/1 Audio.xml")
+	require.NoError(t, err)
+	msg, err := ParseTyped[NewReleaseMessageV43](xmlData)
+	require.NoError(t, err)
+	require.Empty(t, ValidateDurations(msg))
+
+	msg.ResourceList.SoundRecording[1].Duration = "3:45"
+	msg.ResourceList.SoundRecording[2].Duration = "P1Y"
+	errs := ValidateDurations(msg)
+	require.Len(t, errs, 1)
+	require.Equal(t, "/NewReleaseMessage/ResourceList/SoundRecording/Duration", errs[0].Path)
+	require.Equal(t, msg.ResourceList.SoundRecording[1].ResourceReference, errs[0].Reference)
+	require.Equal(t, "3:45", errs[0].Value)
+}
+
+// TestGenerateSample verifies the generated samples, and that the sample document passes the schema
+func TestGenerateSample(t *testing.T) {
+	for _, tv := range [][2]string{{"ern", "v381"}, {"ern", "v383"}, {"ern", "v42"}, {"ern", "v43"}, {"ern", "v432"}, {"mead", "v11"}, {"pie", "v10"}} {
+		msg, err := GenerateSample(tv[0], tv[1])
+		require.NoError(t, err, tv)
+		require.Empty(t, ValidateReferences(msg, nil), tv)
+		require.Empty(t, ValidateDurations(msg), tv)
+
+		// The sample document is schema-valid and decodes to the sample; marshaling the message writes
+		// the empty optional fields too but reads back the same
+		document, err := GenerateSampleDocument(tv[0], tv[1], 1)
+		require.NoError(t, err, tv)
+		require.Empty(t, ValidateAgainstSchema(document), tv)
+		fromDocument, _, _, err := gen.ParseAny(document)
+		require.NoError(t, err, tv)
+		require.True(t, proto.Equal(msg.(proto.Message), fromDocument.(proto.Message)), tv)
+		marshaled, err := xml.Marshal(msg)
+		require.NoError(t, err, tv)
+		reparsed, _, _, err := gen.ParseAny(marshaled)
+		require.NoError(t, err, tv)
+		require.True(t, proto.Equal(msg.(proto.Message), reparsed.(proto.Message)), tv)
+	}
+
+	msg, err := GenerateSample("ern", "v432")
+	require.NoError(t, err)
+	release := msg.(*NewReleaseMessageV432)
+	require.Len(t, release.ResourceList.SoundRecording, 2)
+	for _, recording := range release.ResourceList.SoundRecording {
+		require.Regexp(t, `^[A-Z]{2}[A-Z0-9]{3}\d{7}$`, recording.SoundRecordingEdition[0].ResourceId[0].ISRC)
+	}
+	tracks, err := TrackListing(release, release.ReleaseList.Release.ReleaseReference)
+	require.NoError(t, err)
+	require.Len(t, tracks, 2)
+	rows := FlattenReleases(msg)
+	require.Equal(t, "Album", rows[0].ReleaseType)
+	require.Equal(t, TerritoryWorldwide, release.DealList.ReleaseDeal[0].Deal[0].DealTerms.TerritoryCode[0].Value)
+
+	again, err := GenerateSample("ern", "v432")
+	require.NoError(t, err)
+	require.True(t, proto.Equal(release, again.(*NewReleaseMessageV432)))
+	other, err := GenerateSampleWithSeed("ern", "v432", 2)
+	require.NoError(t, err)
+	require.False(t, proto.Equal(release, other.(*NewReleaseMessageV432)))
+
+	_, err = GenerateSample("ern", "v99")
+	require.Error(t, err)
+}
+
+// TestParseAnyPreserving verifies the preserved input bytes survive edits to the message and the input buffer
+func TestParseAnyPreserving(t *testing.T) {
+	xmlData, err := testdata.DDEXTestDataFS.ReadFile("ddex/ern/v43/1 Audio.xml")
+	require.NoError(t, err)
+	data := append([]byte(nil), xmlData...)
+
+	parsed, err := ParseAnyPreserving(data)
+	require.NoError(t, err)
+	require.Equal(t, "ern", parsed.MessageType)
+	require.Equal(t, "v43", parsed.Version)
+	msg := parsed.Message.(*NewReleaseMessageV43)
+	require.Equal(t, "Test1.1", msg.MessageHeader.MessageId)
+
+	// Neither edits to the message nor to the input buffer reach the preserved bytes
+	msg.MessageHeader.MessageId = "changed"
+	data[0] = ' '
+	require.Equal(t, xmlData, parsed.Reserialize())
+	parsed.Reserialize()[0] = ' '
+	require.Equal(t, xmlData, parsed.Reserialize())
+
+	_, err = ParseAnyPreserving([]byte("<NotDDEX/>"))
+	require.Error(t, err)
+}
+
+// TestValidateReleaseDateConsistency verifies deal start dates are compared with the release dates of their territory
+func TestValidateReleaseDateConsistency(t *testing.T) {
+	deal := func(reference, start, territory string, preOrder bool) *ernv432.Deal {
+		return &ernv432.Deal{DealReference: []string{reference}, DealTerms: &ernv432.DealTerms{
+			TerritoryCode:  []*ernv432.CurrentTerritoryCode{{Value: territory}},
+			ValidityPeriod: []*ernv432.PeriodWithStartDate{{StartDate: &ernv432.EventDateWithCurrentTerritory{Value: start}}},
+			IsPreOrderDeal: preOrder,
+		}}
+	}
+	msg := &ernv432.NewReleaseMessage{
+		ReleaseList: &ernv432.ReleaseList{
+			Release: &ernv432.Release{
+				ReleaseReference: "R0",
+				ReleaseDate: []*ernv432.EventDateWithDefault{
+					{Value: "2025-06-01", IsDefault: true},
+					{Value: "2025-07-01", ApplicableTerritoryCode: "JP"},
+				},
+				OriginalReleaseDate: []*ernv432.EventDateWithDefault{{Value: "2025-06-01"}},
+			},
+		},
+		DealList: &ernv432.DealList{
+			ReleaseDeal: []*ernv432.ReleaseDeal{{DealReleaseReference: []string{"R0"}, Deal: []*ernv432.Deal{
+				deal("D1", "2025-06-03", "Worldwide", false),
+				deal("D2", "2025-07-01", "JP", false),
+				deal("D3", "2025-05-01", "Worldwide", true),
+				deal("D4", "2025-05-01", "Worldwide", false),
+				deal("D5", "2025-08-01", "US", false),
+			}}},
+		},
+	}
+
+	errs := ValidateReleaseDateConsistency(msg)
+	require.Len(t, errs, 3)
+	require.Equal(t, DateError{
+		Path:             "/NewReleaseMessage/DealList/ReleaseDeal/Deal/DealTerms/ValidityPeriod",
+		ReleaseReference: "R0",
+		DealReference:    "D4",
+		Field:            "ReleaseDate",
+		ReleaseDate:      "2025-06-01",
+		StartDate:        "2025-05-01",
+		Message:          "deal starts 2025-05-01, 31 days before the ReleaseDate 2025-06-01",
+	}, errs[0])
+	require.Equal(t, []string{"D4", "OriginalReleaseDate", "D5", "ReleaseDate"},
+		[]string{errs[1].DealReference, errs[1].Field, errs[2].DealReference, errs[2].Field})
+	require.Contains(t, errs[2].Error(), "61 days after the ReleaseDate 2025-06-01 (release R0, deal D5)")
+
+	// Without tolerance D1 starting two days late is reported too
+	require.Len(t, ValidateReleaseDateConsistencyWithin(msg, 90*24*time.Hour), 0)
+	require.Len(t, ValidateReleaseDateConsistencyWithin(msg, 0), 4)
+}
+
+// TestIsPreOrder verifies pre-order status from the release date and the deals running at a given time
+func TestIsPreOrder(t *testing.T) {
+	deal := func(start, end string, preOrder bool) *ernv432.Deal {
+		period := &ernv432.PeriodWithStartDate{StartDate: &ernv432.EventDateWithCurrentTerritory{Value: start}}
+		if end != "" {
+			period.EndDate = &ernv432.EventDateWithCurrentTerritory{Value: end}
+		}
+		return &ernv432.Deal{DealTerms: &ernv432.DealTerms{ValidityPeriod: []*ernv432.PeriodWithStartDate{period}, IsPreOrderDeal: preOrder}}
+	}
+	msg := &ernv432.NewReleaseMessage{
+		ReleaseList: &ernv432.ReleaseList{
+			Release: &ernv432.Release{
+				ReleaseReference: "R0",
+				ReleaseDate:      []*ernv432.EventDateWithDefault{{Value: "2025-06-01"}},
+			},
+			TrackRelease: []*ernv432.TrackRelease{{ReleaseReference: "R1"}, {ReleaseReference: "R2"}},
+		},
+		DealList: &ernv432.DealList{
+			ReleaseDeal: []*ernv432.ReleaseDeal{
+				{DealReleaseReference: []string{"R0"}, Deal: []*ernv432.Deal{deal("2025-05-01", "", false)}},
+				{DealReleaseReference: []string{"R1"}, Deal: []*ernv432.Deal{deal("2025-05-01", "", true)}},
+				{DealReleaseReference: []string{"R2"}, Deal: []*ernv432.Deal{deal("2025-05-01", "2025-05-10", true)}},
+			},
+		},
+	}
+	asOf := time.Date(2025, 5, 15, 0, 0, 0, 0, time.UTC)
+
+	for reference, want := range map[string]bool{"R0": true, "R1": true, "R2": false} {
+		preOrder, err := IsPreOrder(msg, reference, asOf)
+		require.NoError(t, err, reference)
+		require.Equal(t, want, preOrder, reference)
+	}
+
+	// Released by asOf, or sold only from the street date on
+	preOrder, err := IsPreOrder(msg, "R0", time.Date(2025, 6, 1, 0, 0, 0, 0, time.UTC))
+	require.NoError(t, err)
+	require.False(t, preOrder)
+	msg.DealList.ReleaseDeal[0].Deal[0] = deal("2025-06-01", "", false)
+	preOrder, err = IsPreOrder(msg, "R0", asOf)
+	require.NoError(t, err)
+	require.False(t, preOrder)
+
+	_, err = IsPreOrder(msg, "R9", asOf)
+	require.ErrorContains(t, err, "release R9 not found")
+	msg.ReleaseList.Release.ReleaseDate[0].Value = "June 2025"
+	_, err = IsPreOrder(msg, "R0", asOf)
+	require.ErrorContains(t, err, "invalid date")
+}
+
+// TestResources verifies the Resources iterator yields every resource in document order with its type
+func TestResources(t *testing.T) {
+	xmlData, err := testdata.DDEXTestDataFS.ReadFile("ddex/ern/v43/1 Audio.xml")
+	require.NoError(t, err)
+	msg, err := ParseTyped[NewReleaseMessageV43](xmlData)
+	require.NoError(t, err)
+
+	// The 21 sound recordings, then the front cover
+	var types []string
+	for resource := range msg.ResourceList.Resources() {
+		types = append(types, resource.GetResourceReference()+" "+resource.ResourceType())
+	}
+	require.Len(t, types, 22)
+	require.Equal(t, "A1 MusicalWorkSoundRecording", types[0])
+	require.Equal(t, "A22 FrontCoverImage", types[21])
+
+	var recording ernv383.Resource = &ernv383.SoundRecording{SoundRecordingType: &ernv383.SoundRecordingType{Value: "MusicalWorkSoundRecording"}}
+	require.Equal(t, "MusicalWorkSoundRecording", recording.ResourceType())
+	require.Empty(t, (*ernv432.Video)(nil).ResourceType())
+}
+
+// TestParseAndValidate verifies parse errors are returned separately from reference, identifier and schema findings
+func TestParseAndValidate(t *testing.T) {
+	xmlData, err := testdata.DDEXTestDataFS.ReadFile("ddex/ern/v43/1 Audio.xml")
+	require.NoError(t, err)
+	msg, errs, err := ParseAndValidate(xmlData)
+	require.NoError(t, err)
+	require.Empty(t, errs)
+	require.IsType(t, &NewReleaseMessageV43{}, msg)
+
+	// An unresolved reference and a malformed ISRC are flagged, the message is still returned
+	invalid := strings.Replace(string(xmlData), "<ReleaseResourceReference>A2<", "<ReleaseResourceReference>A99<", 1)
+	invalid = strings.Replace(invalid, "<ISRC>JPTO09404900</ISRC>", "<ISRC>JPTO-094049</ISRC>", 1)
+	msg, errs, err = ParseAndValidate([]byte(invalid))
+	require.NoError(t, err)
+	require.Equal(t, "Test1.1", msg.(*NewReleaseMessageV43).MessageHeader.MessageId)
+	require.Len(t, errs, 2)
+	var refErr RefError
+	require.ErrorAs(t, errs[0], &refErr)
+	var identifierErr IdentifierError
+	require.ErrorAs(t, errs[1], &identifierErr)
+	require.Equal(t, "JPTO-094049", identifierErr.Value)
+
+	// Missing required elements are schema violations
+	invalid = strings.Replace(string(xmlData), "<MessageId>Test1.1</MessageId>", "", 1)
+	_, errs, err = ParseAndValidate([]byte(invalid))
+	require.NoError(t, err)
+	require.NotEmpty(t, errs)
+	var schemaErr SchemaError
+	require.ErrorAs(t, errs[0], &schemaErr)
+
+	_, _, err = ParseAndValidate([]byte("<NewReleaseMessage"))
+	require.Error(t, err)
+}
+
+// TestValidateIdentifiers verifies ISRC format and ICPN check digit validation
+func TestValidateIdentifiers(t *testing.T) {
+	xmlData, err := testdata.DDEXTestDataFS.ReadFile("ddex/ern/v43/1 Audio.xml")
+	require.NoError(t, err)
+	msg, err := ParseTyped[NewReleaseMessageV43](xmlData)
+	require.NoError(t, err)
+	require.Empty(t, ValidateIdentifiers(msg))
+
+	msg.ReleaseList.Release.ReleaseId.ICPN = "00094631432058"
+	msg.ResourceList.SoundRecording[0].SoundRecordingEdition[0].ResourceId[0].ISRC = "JP-TO0-94-04900"
+	errs := ValidateIdentifiers(msg)
+	require.Len(t, errs, 2)
+	require.Equal(t, "/NewReleaseMessage/ResourceList/SoundRecording/SoundRecordingEdition/ResourceId/ISRC", errs[0].Path)
+	require.Equal(t, "ICPN check digit is wrong", errs[1].Message)
+}
+
+// TestParseZip verifies DDEX messages are parsed from a delivery zip and oversized entries are rejected
+func TestParseZip(t *testing.T) {
+	xmlData, err := testdata.DDEXTestDataFS.ReadFile("ddex/ern/v43/1 Audio.xml")
+	require.NoError(t, err)
+
+	var buf bytes.Buffer
+	archive := zip.NewWriter(&buf)
+	for name, content := range map[string][]byte{
+		"0094631432057/0094631432057.XML":                  xmlData,
+		"0094631432057/resources/0094631432057_01_001.wav": []byte("RIFF"),
+		"0094631432057/broken.xml":                         []byte("<NewReleaseMessage"),
+		"__MACOSX/0094631432057/._0094631432057.XML":       {0, 5, 22, 7},
+	} {
+		w, err := archive.Create(name)
+		require.NoError(t, err)
+		_, err = w.Write(content)
+		require.NoError(t, err)
+	}
+	_, err = archive.Create("0094631432057/resources/")
+	require.NoError(t, err)
+	require.NoError(t, archive.Close())
+
+	entries, err := ParseZip(bytes.NewReader(buf.Bytes()), int64(buf.Len()))
+	require.NoError(t, err)
+	byName := make(map[string]ZipEntry)
+	for _, entry := range entries {
+		byName[entry.Name] = entry
+	}
+	require.Len(t, byName, 3)
+
+	message := byName["0094631432057/0094631432057.XML"]
+	require.NoError(t, message.Err)
+	require.Equal(t, "ern", message.MessageType)
+	require.Equal(t, "v43", message.Version)
+	require.Equal(t, "Test1.1", message.Message.(*NewReleaseMessageV43).MessageHeader.MessageId)
+
+	media := byName["0094631432057/resources/0094631432057_01_001.wav"]
+	require.Nil(t, media.Message)
+	require.NoError(t, media.Err)
+	require.Error(t, byName["0094631432057/broken.xml"].Err)
+
+	_, err = ParseZip(bytes.NewReader(xmlData), int64(len(xmlData)))
+	require.Error(t, err)
+
+	// Oversized entries are not parsed
+	entries, err = ParseZipWithLimit(bytes.NewReader(buf.Bytes()), int64(buf.Len()), 1024)
+	require.NoError(t, err)
+	for _, entry := range entries {
+		if entry.Name == "0094631432057/0094631432057.XML" {
+			require.Nil(t, entry.Message)
+			require.EqualError(t, entry.Err, "0094631432057/0094631432057.XML is larger than 1024 bytes")
+		}
+	}
+
+	// An entry declaring more than the limit is rejected unread; one understating its size fails on reading
+	buf.Reset()
+	archive = zip.NewWriter(&buf)
+	for name, declared := range map[string]uint64{"declared.xml": MaxZipEntrySize + 1, "understated.xml": 10} {
+		w, err := archive.CreateRaw(&zip.FileHeader{Name: name, Method: zip.Store, UncompressedSize64: declared, CompressedSize64: uint64(len(xmlData))})
+		require.NoError(t, err)
+		_, err = w.Write(xmlData)
+		require.NoError(t, err)
+	}
+	require.NoError(t, archive.Close())
+	entries, err = ParseZipWithLimit(bytes.NewReader(buf.Bytes()), int64(buf.Len()), MaxZipEntrySize)
+	require.NoError(t, err)
+	require.Len(t, entries, 2)
+	for _, entry := range entries {
+		if entry.Name == "declared.xml" {
+			require.ErrorContains(t, entry.Err, "is larger than 67108864 bytes")
+		}
+	}
+	entries, err = ParseZipWithLimit(bytes.NewReader(buf.Bytes()), int64(buf.Len()), 1024)
+	require.NoError(t, err)
+	for _, entry := range entries {
+		require.Nil(t, entry.Message)
+		require.Error(t, entry.Err)
+	}
+}
+
+// TestNewPurgeForRelease verifies the PurgeReleaseMessage built for a release message
+func TestNewPurgeForRelease(t *testing.T) {
+	original := &ernv432.NewReleaseMessage{
+		MessageHeader: &ernv432.MessageHeader{
+			MessageId:       "MSG-1",
+			MessageFileName: "MSG-1.xml",
+			MessageSender:   &ernv432.MessagingPartyWithoutCode{PartyId: "PADPIDA0000000001"},
+		},
+		ReleaseList: &ernv432.ReleaseList{
+			Release: &ernv432.Release{
+				ReleaseReference: "R0",
+				ReleaseId:        &ernv432.ReleaseId{ICPN: "00094631432057"},
+				DisplayTitleText: []*ernv432.DisplayTitleText{{Value: "Yume no Hajmari", LanguageAndScriptCode: "ja"}},
+			},
+		},
+		AvsVersionId:   "7",
+		NamespaceAttrs: map[string]string{"xmlns:ern": "http://ddex.net/xml/ern/432"},
+	}
+
+	purge, err := NewPurgeForRelease(original)
+	require.NoError(t, err)
+	header := purge.GetMessageHeader()
+	require.Regexp(t, `^[0-9a-f]{32}$`, header.GetMessageId())
+	require.Equal(t, "MSG-1", header.GetMessageThreadId())
+	require.Empty(t, header.GetMessageFileName())
+	require.Equal(t, "PADPIDA0000000001", header.GetMessageSender().GetPartyId())
+	require.Equal(t, "00094631432057", purge.GetPurgedRelease().GetReleaseId().GetICPN())
+	require.Equal(t, "Yume no Hajmari", purge.GetPurgedRelease().GetTitle()[0].GetTitleText())
+	require.Equal(t, "7", purge.GetAvsVersionId())
+
+	// The original is left untouched
+	require.Equal(t, "MSG-1", original.GetMessageHeader().GetMessageId())
+	require.Equal(t, "MSG-1.xml", original.GetMessageHeader().GetMessageFileName())
+
+	out, err := xml.Marshal(purge)
+	require.NoError(t, err)
+	require.Contains(t, string(out), `<PurgeReleaseMessage xmlns="http://ddex.net/xml/ern/432"`)
+
+	again, err := NewPurgeForRelease(original)
+	require.NoError(t, err)
+	require.NotEqual(t, header.GetMessageId(), again.GetMessageHeader().GetMessageId())
+
+	_, err = NewPurgeForRelease(&ernv432.NewReleaseMessage{MessageHeader: original.GetMessageHeader()})
+	require.Error(t, err)
+	_, err = NewPurgeForRelease(&ernv432.NewReleaseMessage{ReleaseList: original.GetReleaseList()})
+	require.Error(t, err)
+}
+
+// TestValidateCurrencies verifies price currency codes are checked against ISO 4217
+func TestValidateCurrencies(t *testing.T) {
+	require.True(t, IsValidCurrency("USD"))
+	require.True(t, IsValidCurrency("JPY"))
+	require.False(t, IsValidCurrency("usd"))
+	require.False(t, IsValidCurrency("DEM"))
+	require.False(t, IsValidCurrency("XYZ"))
+
+	price := func(code string) *ernv432.Price { return &ernv432.Price{Value: "9.99", CurrencyCode: code} }
+	msg := &ernv432.NewReleaseMessage{
+		DealList: &ernv432.DealList{
+			ReleaseDeal: []*ernv432.ReleaseDeal{{
+				DealReleaseReference: []string{"R0"},
+				Deal: []*ernv432.Deal{
+					{
+						DealReference: []string{"D1"},
+						DealTerms: &ernv432.DealTerms{PriceInformation: []*ernv432.PriceInformation{
+							{WholesalePricePerUnit: price("USD"), SuggestedRetailPrice: price("EURO")},
+						}},
+					},
+					{DealTerms: &ernv432.DealTerms{PriceInformation: []*ernv432.PriceInformation{{WholesalePricePerUnit: price("gbp")}}}},
+				},
+			}},
+		},
+	}
+
+	errs := ValidateCurrencies(msg)
+	require.Len(t, errs, 2)
+	require.Equal(t, "/NewReleaseMessage/DealList/ReleaseDeal/Deal/DealTerms/PriceInformation/SuggestedRetailPrice@CurrencyCode", errs[0].Path)
+	require.Equal(t, "EURO", errs[0].Code)
+	require.Equal(t, "D1", errs[0].DealReference)
+	require.Equal(t, "gbp", errs[1].Code)
+	require.Empty(t, errs[1].DealReference)
+	require.Equal(t, []string{"R0"}, errs[1].ReleaseReferences)
+	require.Contains(t, errs[1].Error(), "deal for R0")
+
+	xmlData, err := testdata.DDEXTestDataFS.ReadFile("ddex/ern/v43/1 Audio.xml")
+	require.NoError(t, err)
+	parsed, err := ParseTyped[NewReleaseMessageV43](xmlData)
+	require.NoError(t, err)
+	require.Empty(t, ValidateCurrencies(parsed))
+}
+
+// TestTreeString verifies the indented tree rendering of a message
+func TestTreeString(t *testing.T) {
+	msg := &ernv432.NewReleaseMessage{
+		MessageHeader: &ernv432.MessageHeader{
+			MessageId:     "MSG-1",
+			MessageSender: &ernv432.MessagingPartyWithoutCode{PartyId: "PADPIDA0000000001"},
+		},
+		ReleaseList: &ernv432.ReleaseList{
+			Release: &ernv432.Release{
+				ReleaseReference: "R0",
+				ReleaseId:        &ernv432.ReleaseId{},
+				DisplayTitleText: []*ernv432.DisplayTitleText{{Value: "Yume", LanguageAndScriptCode: "ja"}, {Value: "Dream"}},
+			},
+		},
+		AvsVersionId:   "7",
+		NamespaceAttrs: map[string]string{"xmlns:ern": "http://ddex.net/xml/ern/432"},
+	}
+
+	require.Equal(t, `NewReleaseMessage
+  @AvsVersionId: 7
+  MessageHeader
+    MessageId: MSG-1
+    MessageSender
+      PartyId: PADPIDA0000000001
+  ReleaseList
+    Release
+      ReleaseReference: R0
+      DisplayTitleText: Yume
+        @LanguageAndScriptCode: ja
+      DisplayTitleText: Dream
+`, TreeString(msg))
+	require.Empty(t, TreeString(nil))
+}
+
+// TestCheckCompatibility verifies the supported, degraded and unsupported verdicts for a document's schema version
+func TestCheckCompatibility(t *testing.T) {
+	xmlData, err := testdata.DDEXTestDataFS.ReadFile("ddex/ern/v43/1 Audio.xml")
+	require.NoError(t, err)
+
+	compat, err := CheckCompatibility(xmlData)
+	require.NoError(t, err)
+	require.Equal(t, CompatibilitySupported, compat.Level)
+	require.Equal(t, "ern/43", compat.SchemaVersion)
+	require.Equal(t, "v43", compat.Version)
+	require.Empty(t, compat.MissingElements)
+
+	// An element the v43 package has no field for parses, but is dropped
+	extended := bytes.Replace(xmlData, []byte("<MessageId>Test1.1</MessageId>"),
+		[]byte("<MessageId>Test1.1</MessageId><MessagePriority><Level>1</Level></MessagePriority>"), 1)
+	compat, err = CheckCompatibility(extended)
+	require.NoError(t, err)
+	require.Equal(t, CompatibilityDegraded, compat.Level)
+	require.Equal(t, []string{"/NewReleaseMessage/MessageHeader/MessagePriority"}, compat.MissingElements)
+	require.Equal(t, "Degraded", compat.Level.String())
+
+	album, err := testdata.DDEXTestDataFS.ReadFile("ddex/ern/v381/Album.xml")
+	require.NoError(t, err)
+	compat, err = CheckCompatibility(bytes.Replace(album, []byte(`MessageSchemaVersionId="ern/383"`), []byte(`MessageSchemaVersionId="ern/382"`), 1))
+	require.NoError(t, err)
+	require.Equal(t, CompatibilityDegraded, compat.Level)
+	require.Equal(t, "ern/382", compat.SchemaVersion)
+	require.Equal(t, "v383", compat.Version)
+
+	compat, err = CheckCompatibility(bytes.ReplaceAll(xmlData, []byte("http://ddex.net/xml/ern/43"), []byte("http://ddex.net/xml/ern/411")))
+	require.NoError(t, err)
+	require.Equal(t, CompatibilityUnsupported, compat.Level)
+	require.Equal(t, "ern/411", compat.SchemaVersion)
+	require.Empty(t, compat.MessageType)
+	require.Contains(t, compat.Reason, "ern/432")
+
+	_, err = CheckCompatibility([]byte("not xml"))
+	require.Error(t, err)
+}
+
+// TestMergeDeals verifies territory-specific deals override a worldwide base deal for the same usage
+func TestMergeDeals(t *testing.T) {
+	territories := func(codes ...string) []*ernv432.CurrentTerritoryCode {
+		var list []*ernv432.CurrentTerritoryCode
+		for _, code := range codes {
+			list = append(list, &ernv432.CurrentTerritoryCode{Value: code})
+		}
+		return list
+	}
+	deal := func(reference string, codes []string, price string) Deal {
+		terms := &ernv432.DealTerms{
+			CommercialModelType: []*ernv432.CommercialModelType{{Value: "PayAsYouGoModel"}},
+			UseType:             []*ernv432.DiscoverableUseType{{Value: "PermanentDownload"}},
+			TerritoryCode:       territories(codes...),
+		}
+		if price != "" {
+			terms.PriceInformation = []*ernv432.PriceInformation{{PriceCode: &ernv432.PriceType{Value: price}}}
+		}
+		return Deal{ReleaseReference: "R0", Deal: &ernv432.Deal{DealReference: []string{reference}, DealTerms: terms}}
+	}
+
+	base := deal("BASE", []string{"Worldwide"}, "MID")
+	base.Deal.DealTerms.IsPreOrderDeal = true
+	base.Deal.DealTerms.ExcludedTerritoryCode = territories("CN")
+	streaming := deal("STREAM", []string{"Worldwide"}, "")
+	streaming.Deal.DealTerms.UseType[0].Value = "OnDemandStream"
+	unscoped := Deal{ReleaseReference: "R0", Deal: &ernv432.Deal{DealReference: []string{"NONE"}}}
+
+	merged := MergeDeals([]Deal{
+		deal("JP", []string{"jp"}, "FRONT"),
+		base,
+		deal("US-GB", []string{"US", "GB"}, "BUDGET"),
+		streaming,
+		deal("US", []string{"US"}, "PREMIUM"),
+		unscoped,
+	})
+	require.Len(t, merged, 6)
+
+	require.Equal(t, unscoped, merged[0])
+
+	// The base no longer covers the overridden territories
+	require.Equal(t, []string{"BASE"}, merged[1].Deal.GetDealReference())
+	require.Equal(t, "Worldwide", merged[1].Deal.GetDealTerms().GetTerritoryCode()[0].GetValue())
+	var excluded []string
+	for _, code := range merged[1].Deal.GetDealTerms().GetExcludedTerritoryCode() {
+		excluded = append(excluded, code.GetValue())
+	}
+	require.Equal(t, []string{"CN", "GB", "JP", "US"}, excluded)
+
+	price := func(d Deal) string {
+		return d.Deal.GetDealTerms().GetPriceInformation()[0].GetPriceCode().GetValue()
+	}
+	wantTerritories := []string{"JP", "US", "GB"}
+	wantPrices := []string{"FRONT", "PREMIUM", "BUDGET"}
+	for i, d := range merged[2:5] {
+		require.Equal(t, "R0", d.ReleaseReference)
+		require.Len(t, d.Deal.GetDealTerms().GetTerritoryCode(), 1)
+		require.Equal(t, wantTerritories[i], d.Deal.GetDealTerms().GetTerritoryCode()[0].GetValue())
+		require.Empty(t, d.Deal.GetDealTerms().GetExcludedTerritoryCode())
+		require.Equal(t, wantPrices[i], price(d))
+		// Inherited from the base
+		require.True(t, d.Deal.GetDealTerms().GetIsPreOrderDeal())
+	}
+	require.Equal(t, []string{"US"}, merged[3].Deal.GetDealReference())
+
+	// A different UseType does not overlap the download deals
+	require.Equal(t, []string{"STREAM"}, merged[5].Deal.GetDealReference())
+	require.Empty(t, merged[5].Deal.GetDealTerms().GetExcludedTerritoryCode())
+
+	// The input is left as it was
+	require.Equal(t, "CN", base.Deal.GetDealTerms().GetExcludedTerritoryCode()[0].GetValue())
+	require.Len(t, base.Deal.GetDealTerms().GetExcludedTerritoryCode(), 1)
+}
+
+// TestParseProjection verifies the streaming projection agrees with a full unmarshal
+func TestParseProjection(t *testing.T) {
+	xmlData, err := testdata.DDEXTestDataFS.ReadFile("ddex/ern/v43/1 Audio.xml")
+	require.NoError(t, err)
+
+	paths := []string{
+		"/NewReleaseMessage/ResourceList/SoundRecording/SoundRecordingEdition/ResourceId/ISRC",
+		"/NewReleaseMessage/ReleaseList/Release/ReleaseId/ICPN",
+		"/NewReleaseMessage/ReleaseList/Release/DisplayArtistName",
+		"/NewReleaseMessage/ReleaseList/Release/DisplayArtistName@LanguageAndScriptCode",
+		"/NewReleaseMessage/MessageHeader",
+		"/NewReleaseMessage/NoSuchElement",
+	}
+	values, err := ParseProjection(xmlData, paths)
+	require.NoError(t, err)
+
+	isrcs := values[paths[0]]
+	require.Len(t, isrcs, 21)
+	require.Equal(t, "JPTO09404900", isrcs[0])
+	require.Equal(t, []string{"00094631432057"}, values[paths[1]])
+	require.Equal(t, "Saeko Shu", values[paths[2]][0])
+	require.Equal(t, "ja-Latn", values[paths[3]][0])
+	// Elements with only child elements and missing elements have no values
+	require.NotContains(t, values, paths[4])
+	require.NotContains(t, values, paths[5])
+
+	// The projection agrees with a full unmarshal
+	msg, err := ParseTyped[NewReleaseMessageV43](xmlData)
+	require.NoError(t, err)
+	var parsed []string
+	walkScalars(msg, func(path string, _ xmlField, value reflect.Value) {
+		if path == paths[0] {
+			parsed = append(parsed, value.String())
+		}
+	})
+	require.Equal(t, parsed, isrcs)
+
+	_, err = ParseProjection(xmlData, []string{"NewReleaseMessage"})
+	require.Error(t, err)
+	_, err = ParseProjection([]byte("<NewReleaseMessage>"), paths)
+	require.Error(t, err)
+}
+
+// TestValidateDealReleaseReferences verifies DealReleaseReferences must name releases rather than any reference
+func TestValidateDealReleaseReferences(t *testing.T) {
+	msg := &ernv432.NewReleaseMessage{
+		ResourceList: &ernv432.ResourceList{SoundRecording: []*ernv432.SoundRecording{{ResourceReference: "A1"}}},
+		ReleaseList: &ernv432.ReleaseList{
+			Release:      &ernv432.Release{ReleaseReference: "R0"},
+			TrackRelease: []*ernv432.TrackRelease{{ReleaseReference: "R1"}},
+		},
+		DealList: &ernv432.DealList{
+			ReleaseDeal: []*ernv432.ReleaseDeal{
+				{DealReleaseReference: []string{"R0", "R1"}, Deal: []*ernv432.Deal{{DealReference: []string{"D1"}}}},
+				{DealReleaseReference: []string{"R9", "A1"}, Deal: []*ernv432.Deal{{DealReference: []string{"D2"}}, {}}},
+			},
+		},
+	}
+
+	errs := ValidateDealReleaseReferences(msg, nil)
+	require.Len(t, errs, 4)
+	require.Equal(t, DealRefError{Path: "/NewReleaseMessage/DealList/ReleaseDeal/DealReleaseReference", DealReference: "D2", ReleaseReference: "R9"}, errs[0])
+	require.Empty(t, errs[1].DealReference)
+	// A resource is not a release, though ValidateReferences accepts it
+	require.Equal(t, "A1", errs[2].ReleaseReference)
+	refErrs := ValidateReferences(msg, nil)
+	require.Len(t, refErrs, 1)
+	require.Equal(t, "R9", refErrs[0].Reference)
+	require.Contains(t, errs[1].Error(), `deal without DealReference references release "R9"`)
+
+	xmlData, err := testdata.DDEXTestDataFS.ReadFile("ddex/ern/v43/1 Audio.xml")
+	require.NoError(t, err)
+	parsed, err := ParseTyped[NewReleaseMessageV43](xmlData)
+	require.NoError(t, err)
+	require.Empty(t, ValidateDealReleaseReferences(parsed, nil))
+
+	parsed.DealList.ReleaseDeal[0].DealReleaseReference[0] = "R99"
+	errs = ValidateDealReleaseReferences(parsed, nil)
+	require.Len(t, errs, 1)
+	require.Equal(t, "R99", errs[0].ReleaseReference)
+}
+
+// TestMarshalDocument verifies the XML declaration options of MarshalDocument
+func TestMarshalDocument(t *testing.T) {
+	msg := &ernv432.PurgeReleaseMessage{
+		MessageHeader:  &ernv432.MessageHeader{MessageId: "MSG-1"},
+		NamespaceAttrs: map[string]string{"xmlns:ern": "http://ddex.net/xml/ern/432"},
+	}
+
+	out, err := MarshalDocument(msg, DocumentOptions{})
+	require.NoError(t, err)
+	body, err := xml.Marshal(msg)
+	require.NoError(t, err)
+	require.Equal(t, xml.Header+string(body), string(out))
+
+	out, err = MarshalDocument(msg, DocumentOptions{MarshalOptions: DefaultMarshalOptions, Standalone: "yes", Encoding: "utf-8"})
+	require.NoError(t, err)
+	require.True(t, strings.HasPrefix(string(out), `<?xml version="1.0" encoding="utf-8" standalone="yes"?>`+"\n<PurgeReleaseMessage"))
+	require.Contains(t, string(out), "\n  <MessageHeader>")
+
+	var parsed ernv432.PurgeReleaseMessage
+	require.NoError(t, xml.Unmarshal(out, &parsed))
+	require.Equal(t, "MSG-1", parsed.GetMessageHeader().GetMessageId())
+
+	out, err = MarshalDocument(msg, DocumentOptions{OmitDeclaration: true})
+	require.NoError(t, err)
+	require.Equal(t, string(body), string(out))
+
+	_, err = MarshalDocument(msg, DocumentOptions{Encoding: "ISO-8859-1"})
+	require.Error(t, err)
+	_, err = MarshalDocument(msg, DocumentOptions{Version: "1.1"})
+	require.Error(t, err)
+	_, err = MarshalDocument(msg, DocumentOptions{Standalone: "true"})
+	require.Error(t, err)
+}
+
+// TestValidatePartyReferences verifies party and metadata source references resolve to their own kind
+func TestValidatePartyReferences(t *testing.T) {
+	xmlData, err := testdata.DDEXTestDataFS.ReadFile("ddex/pie/v10/reward.xml")
+	require.NoError(t, err)
+	msg, err := ParseTyped[PieMessageV10](xmlData)
+	require.NoError(t, err)
+	require.Empty(t, ValidatePartyReferences(msg))
+
+	party, ok := ResolveParty(msg, " P1 ")
+	require.True(t, ok)
+	require.Equal(t, "Norah Jones", party.(*piev10.Party).GetPartyName()[0].GetFullName().GetName().GetValue())
+	_, ok = ResolveParty(msg, "P2")
+	require.False(t, ok)
+
+	msg.MetadataSourceList = &piev10.MetadataSourceList{MetadataSource: []*piev10.MetadataSource{{SourceReference: "S1"}}}
+	msg.PartyList.Party = append(msg.PartyList.Party, &piev10.Party{PartyReference: "P2"}, &piev10.Party{PartyReference: "P2"})
+	norah := msg.PartyList.Party[0]
+	norah.RelatedParty = []*piev10.RelatedParty{{PartyRelatedPartyReference: "P2"}, {PartyRelatedPartyReference: "S1"}}
+	norah.PartyName[0].MetadataSourceReference = []*piev10.MetadataSourceReference{{Value: "S1"}, {Value: "S9"}}
+
+	source, ok := ResolveParty(msg, "S1")
+	require.True(t, ok)
+	require.IsType(t, &piev10.MetadataSource{}, source)
+
+	errs := ValidatePartyReferences(msg)
+	require.Len(t, errs, 3)
+	require.Equal(t, RefError{Path: "/PieMessage/PartyList/Party/PartyReference", Reference: "P2", Message: "duplicate reference"}, errs[0])
+	require.Equal(t, RefError{Path: "/PieMessage/PartyList/Party/PartyName/MetadataSourceReference", Reference: "S9", Message: "unresolved metadata source reference"}, errs[1])
+	// A SourceReference is not a party
+	require.Equal(t, RefError{Path: "/PieMessage/PartyList/Party/RelatedParty/PartyRelatedPartyReference", Reference: "S1", Message: "unresolved party reference"}, errs[2])
+
+	// The generic validator knows SourceReference declares a reference
+	require.Empty(t, ValidateReferences(msg, nil))
+}
+
+// TestCanonicalFileName verifies file names are derived from the main release's GRid or ICPN
+func TestCanonicalFileName(t *testing.T) {
+	xmlData, err := testdata.DDEXTestDataFS.ReadFile("ddex/ern/v43/1 Audio.xml")
+	require.NoError(t, err)
+	msg, err := ParseTyped[NewReleaseMessageV43](xmlData)
+	require.NoError(t, err)
+	name, err := CanonicalFileName(msg)
+	require.NoError(t, err)
+	require.Equal(t, "00094631432057_NewReleaseMessage.xml", name)
+
+	// ERN 3: the main release among the album and its track releases
+	album, err := testdata.DDEXTestDataFS.ReadFile("ddex/ern/v381/Album.xml")
+	require.NoError(t, err)
+	parsed, _, _, err := gen.ParseAny(album)
+	require.NoError(t, err)
+	name, err = CanonicalFileName(parsed)
+	require.NoError(t, err)
+	require.Equal(t, "00028948386765_NewReleaseMessage.xml", name)
+
+	// The GRid wins over the ICPN
+	purge := &ernv432.PurgeReleaseMessage{PurgedRelease: &ernv432.PurgedRelease{
+		ReleaseId: &ernv432.ReleaseId{GRid: "A10302B0001234567T", ICPN: "00094631432057"},
+	}}
+	name, err = CanonicalFileName(purge)
+	require.NoError(t, err)
+	require.Equal(t, "A10302B0001234567T_PurgeReleaseMessage.xml", name)
+
+	purge.PurgedRelease.ReleaseId = &ernv432.ReleaseId{CatalogNumber: &ernv432.CatalogNumber{Value: "CAT-1"}}
+	_, err = CanonicalFileName(purge)
+	require.ErrorContains(t, err, "neither a GRid nor an ICPN")
+	_, err = CanonicalFileName(&piev10.PieMessage{})
+	require.ErrorContains(t, err, "PieMessage has no main release")
+}
+
+// TestValidateParentalWarnings verifies where titles, versions and release profiles require a ParentalWarningType
+func TestValidateParentalWarnings(t *testing.T) {
+	warning := func(value string) []*ernv432.ParentalWarningTypeWithStandard {
+		return []*ernv432.ParentalWarningTypeWithStandard{{Value: value}}
+	}
+	msg := &ernv432.NewReleaseMessage{
+		ResourceList: &ernv432.ResourceList{SoundRecording: []*ernv432.SoundRecording{
+			{ResourceReference: "A1", ParentalWarningType: warning("Explicit")},
+			{ResourceReference: "A2", DisplayTitleText: []*ernv432.DisplayTitleText{{Value: "Song (Explicit)"}}},
+			{ResourceReference: "A3", VersionType: []*ernv432.VersionType{{Value: "CleanVersion"}}},
+			{ResourceReference: "A4"},
+		}},
+		ReleaseList: &ernv432.ReleaseList{Release: &ernv432.Release{
+			ReleaseReference: "R0",
+			ResourceGroup: &ernv432.ResourceGroup{ResourceGroupContentItem: []*ernv432.ResourceGroupContentItem{
+				{ReleaseResourceReference: "A1"}, {ReleaseResourceReference: "A2"},
+			}},
+		}},
+	}
+
+	errs := ValidateParentalWarnings(msg)
+	require.Equal(t, []WarningError{
+		{Path: "/NewReleaseMessage/ResourceList/SoundRecording/ParentalWarningType", Reference: "A2", Message: `no ParentalWarningType although the title "Song (Explicit)" is marked explicit`},
+		{Path: "/NewReleaseMessage/ResourceList/SoundRecording/ParentalWarningType", Reference: "A3", Message: "no ParentalWarningType on a CleanVersion of explicit content"},
+		{Path: "/NewReleaseMessage/ReleaseList/Release/ParentalWarningType", Reference: "R0", Message: "no ParentalWarningType on a release containing Explicit recordings A1"},
+	}, errs)
+
+	// An Explicit release needs warnings on its recordings; A4 is not in it
+	msg.ReleaseList.Release.ParentalWarningType = warning("Explicit")
+	msg.ResourceList.SoundRecording[1].DisplayTitleText = nil
+	errs = ValidateParentalWarnings(msg)
+	require.Len(t, errs, 2)
+	require.Equal(t, "no ParentalWarningType on a recording of Explicit release R0", errs[0].Message)
+
+	// Release profiles require them everywhere
+	msg.ReleaseProfileVersionId = "Audio"
+	errs = ValidateParentalWarnings(msg)
+	require.Len(t, errs, 3)
+	require.Equal(t, "A4", errs[2].Reference)
+	require.EqualError(t, errs[2], `/NewReleaseMessage/ResourceList/SoundRecording/ParentalWarningType: ParentalWarningType is required by the Audio release profile ("A4")`)
+}
+
+// TestFieldFrequency verifies how many messages populate each path
+func TestFieldFrequency(t *testing.T) {
+	var messages []interface{}
+	for _, path := range []string{"ddex/ern/v381/Album.xml", "ddex/ern/v381/Single.xml", "ddex/ern/v43/1 Audio.xml"} {
+		xmlData, err := testdata.DDEXTestDataFS.ReadFile(path)
+		require.NoError(t, err)
+		msg, _, _, err := gen.ParseAny(xmlData)
+		require.NoError(t, err)
+		messages = append(messages, msg)
+	}
+
+	frequency := FieldFrequency(messages)
+	require.Equal(t, 3, frequency["/NewReleaseMessage"])
+	require.Equal(t, 3, frequency["/NewReleaseMessage/MessageHeader/MessageId"])
+	require.Equal(t, 3, frequency["/NewReleaseMessage/ResourceList/SoundRecording"])
+	require.Equal(t, 2, frequency["/NewReleaseMessage@MessageSchemaVersionId"])
+	require.Equal(t, 1, frequency["/NewReleaseMessage/ReleaseList/Release/DisplayTitleText"])
+	require.Zero(t, frequency["/NewReleaseMessage/Unknown"])
+	require.Empty(t, FieldFrequency(nil))
+}
+
+// TestValidateSupplementalReferences verifies resource file references are checked against the delivered files
+func TestValidateSupplementalReferences(t *testing.T) {
+	xmlData, err := testdata.DDEXTestDataFS.ReadFile("ddex/ern/v381/Single.xml")
+	require.NoError(t, err)
+	msg, _, _, err := gen.ParseAny(xmlData)
+	require.NoError(t, err)
+
+	var checked []string
+	delivered := map[string]bool{"00028948530434_T3_audtrk.mp3": true, "00028948530434_T2_audtrk.mp3": true}
+	errs := ValidateSupplementalReferences(msg, func(uri string) bool {
+		checked = append(checked, uri)
+		return delivered[uri]
+	})
+	require.Equal(t, []string{"00028948530434_T3_audtrk.mp3", "00028948530434_T2_audtrk.mp3", "22UMGIM16162_T1_cvrart.jpg"}, checked)
+	require.Len(t, errs, 1)
+	require.Equal(t, "22UMGIM16162_T1_cvrart.jpg", errs[0].Reference)
+	require.True(t, strings.HasPrefix(errs[0].Path, "/NewReleaseMessage/ResourceList/Image/"), errs[0].Path)
+	require.True(t, strings.HasSuffix(errs[0].Path, "/File/URL"), errs[0].Path)
+	require.Contains(t, errs[0].Message, "file of resource ")
+
+	// ERN 3 files may be named by FilePath and FileName instead
+	files := []*ernv383.File{{FilePath: "resources/", FileName: "lyrics.txt"}}
+	errs = ValidateSupplementalReferences(&ernv383.Text{ResourceReference: "A9", TextDetailsByTerritory: []*ernv383.TextDetailsByTerritory{
+		{TechnicalTextDetails: []*ernv383.TechnicalTextDetails{{File: files}}},
+	}}, func(string) bool { return false })
+	require.Equal(t, []RefError{{
+		Path:      "/Text/TextDetailsByTerritory/TechnicalTextDetails/File/FileName",
+		Reference: "resources/lyrics.txt",
+		Message:   "file of resource A9 is not in the delivery",
+	}}, errs)
+}
+
+// TestNormalizeDurations verifies durations are rewritten to minutes and seconds and unparseable ones left alone
+func TestNormalizeDurations(t *testing.T) {
+	for input, want := range map[string]string{
+		"PT180S":        "PT3M0S",
+		"PT3M":          "PT3M0S",
+		"PT1H2M3.500S":  "PT62M3.5S",
+		"P1DT0.072S":    "PT1440M0.072S",
+		"-PT45S":        "-PT0M45S",
+		"P1Y":           "P1Y",
+		"three minutes": "three minutes",
+	} {
+		msg := &ernv432.NewReleaseMessage{ResourceList: &ernv432.ResourceList{SoundRecording: []*ernv432.SoundRecording{{Duration: input}}}}
+		NormalizeDurations(msg)
+		require.Equal(t, want, msg.ResourceList.SoundRecording[0].Duration, input)
+	}
+
+	xmlData, err := testdata.DDEXTestDataFS.ReadFile("ddex/ern/v43/1 Audio.xml")
+	require.NoError(t, err)
+	msg, err := ParseTyped[NewReleaseMessageV43](xmlData)
+	require.NoError(t, err)
+	NormalizeDurations(msg)
+	for _, recording := range msg.ResourceList.SoundRecording {
+		require.Regexp(t, `^PT\d+M\d+(\.\d+)?S$`, recording.Duration)
+	}
+	require.Empty(t, ValidateDurations(msg))
+}
+
+// TestParseAnyStrict verifies elements the generated types do not know are reported, after the parse limits apply
+func TestParseAnyStrict(t *testing.T) {
+	xmlData, err := testdata.DDEXTestDataFS.ReadFile("ddex/ern/v43/1 Audio.xml")
+	require.NoError(t, err)
+	msg, messageType, version, err := ParseAnyStrict(xmlData)
+	require.NoError(t, err)
+	require.IsType(t, &NewReleaseMessageV43{}, msg)
+	require.Equal(t, "ern", messageType)
+	require.Equal(t, "v43", version)
+
+	extended := bytes.Replace(xmlData, []byte("<MessageId>Test1.1</MessageId>"),
+		[]byte("<MessageId>Test1.1</MessageId><MessagePriority><Level>1</Level></MessagePriority><Comment/>"), 1)
+	_, _, _, err = ParseAnyStrict(extended)
+	var unknownErr *UnknownElementsError
+	require.ErrorAs(t, err, &unknownErr)
+	require.Equal(t, []string{"/NewReleaseMessage/MessageHeader/Comment", "/NewReleaseMessage/MessageHeader/MessagePriority"}, unknownErr.Paths)
+	require.EqualError(t, err, "2 unknown elements: /NewReleaseMessage/MessageHeader/Comment, /NewReleaseMessage/MessageHeader/MessagePriority")
+
+	// The tolerant parse drops them
+	_, _, _, err = ParseAnyWithOptions(extended)
+	require.NoError(t, err)
+
+	// The limits apply before the unknown elements are looked for
+	_, _, _, err = ParseAnyWithOptions(extended, WithLimits(Limits{MaxDepth: 3}), WithStrictElements())
+	require.ErrorContains(t, err, "depth limit exceeded")
+}
+
+// TestAddDisplayArtist verifies display artists are added with a party for ERN 4 and inline for ERN 3
+func TestAddDisplayArtist(t *testing.T) {
+	msg := &NewReleaseMessageV432{PartyList: &ernv432.PartyList{Party: []*ernv432.Party{
+		{PartyReference: "P1", PartyName: []*ernv432.PartyNameWithTerritory{{FullName: &ernv432.Name{Value: "Label"}}}},
+	}}}
+	msg.AddDisplayArtist("Saeko Shu", "MainArtist")
+	msg.AddDisplayArtist("Label", "FeaturedArtist")
+	msg.AddDisplayArtist("Saeko Shu", "Artist")
+
+	require.Len(t, msg.PartyList.Party, 2)
+	require.Equal(t, "P2", msg.PartyList.Party[1].PartyReference)
+	require.Equal(t, "Saeko Shu", msg.PartyList.Party[1].PartyName[0].GetFullName().GetValue())
+	artists := msg.ReleaseList.Release.DisplayArtist
+	require.Len(t, artists, 3)
+	require.Equal(t, []string{"P2", "P1", "P2"}, []string{artists[0].ArtistPartyReference, artists[1].ArtistPartyReference, artists[2].ArtistPartyReference})
+	require.Equal(t, "FeaturedArtist", artists[1].GetDisplayArtistRole().GetValue())
+	require.Equal(t, int32(3), artists[2].SequenceNumber)
+	require.Empty(t, ValidateReferences(msg, nil))
+
+	// ERN 3 display artists are inline in every territory of the main release
+	xmlData, err := testdata.DDEXTestDataFS.ReadFile("ddex/ern/v381/Album.xml")
+	require.NoError(t, err)
+	album, err := ParseTyped[ernv383.NewReleaseMessage](xmlData)
+	require.NoError(t, err)
+	main := mainRelease(album.ReleaseList.Release)
+	before := len(main.ReleaseDetailsByTerritory[0].DisplayArtist)
+	album.AddDisplayArtist("Guest", "FeaturedArtist")
+	for _, details := range main.ReleaseDetailsByTerritory {
+		added := details.DisplayArtist[len(details.DisplayArtist)-1]
+		require.Equal(t, "Guest", added.PartyName[0].GetFullName().GetValue())
+		require.Equal(t, "FeaturedArtist", added.ArtistRole[0].GetValue())
+	}
+	require.Len(t, main.ReleaseDetailsByTerritory[0].DisplayArtist, before+1)
+
+	empty := &ernv383.NewReleaseMessage{}
+	empty.AddDisplayArtist("Guest", "MainArtist")
+	require.True(t, empty.ReleaseList.Release[0].IsMainRelease)
+	require.Equal(t, "Worldwide", empty.ReleaseList.Release[0].ReleaseDetailsByTerritory[0].TerritoryCode[0].GetValue())
+}
+
+// TestFindEncodingArtifacts verifies mojibake, replacement and control characters are reported by path
+func TestFindEncodingArtifacts(t *testing.T) {
+	xmlData, err := testdata.DDEXTestDataFS.ReadFile("ddex/ern/v43/1 Audio.xml")
+	require.NoError(t, err)
+	msg, err := ParseTyped[NewReleaseMessageV43](xmlData)
+	require.NoError(t, err)
+	require.Empty(t, FindEncodingArtifacts(msg))
+
+	msg.MessageHeader.MessageId = "Test�1"
+	msg.ResourceList.SoundRecording[0].DisplayTitleText[0].Value = "CafÃ© del Mar"
+	msg.ResourceList.SoundRecording[1].DisplayTitleText[0].Value = "Donâ€™t Stop"
+	msg.ResourceList.SoundRecording[2].DisplayTitleText[0].Value = "Tab\tand\x01control"
+	msg.ResourceList.SoundRecording[3].DisplayTitleText[0].Value = "Café, Ã la carte, naïve"
+	require.Equal(t, []string{
+		"/NewReleaseMessage/MessageHeader/MessageId",
+		"/NewReleaseMessage/ResourceList/SoundRecording/DisplayTitleText",
+	}, FindEncodingArtifacts(msg))
+
+	for i, title := range []string{"CafÃ©", "Donâ€™t Stop", "Tab\tand\x01control"} {
+		require.True(t, hasEncodingArtifact(title), i)
+	}
+	require.False(t, hasEncodingArtifact("Café, Ã la carte, naïve"))
+	require.False(t, hasEncodingArtifact("Saeko Shu\n"))
+}
+
+// TestMarshalWithoutSchemaLocation verifies xsi:schemaLocation is dropped from the output and kept on the message
+func TestMarshalWithoutSchemaLocation(t *testing.T) {
+	xmlData, err := testdata.DDEXTestDataFS.ReadFile("ddex/ern/v43/1 Audio.xml")
+	require.NoError(t, err)
+	msg, err := ParseTyped[NewReleaseMessageV43](xmlData)
+	require.NoError(t, err)
+	attrs := maps.Clone(msg.NamespaceAttrs)
+	require.Contains(t, attrs, "xsi:schemaLocation")
+
+	output, err := MarshalWithoutSchemaLocation(msg)
+	require.NoError(t, err)
+	require.NotContains(t, string(output), "schemaLocation")
+	require.Contains(t, string(output), `xmlns:ern="http://ddex.net/xml/ern/43"`)
+	require.Contains(t, string(output), `xmlns:xsi="http://www.w3.org/2001/XMLSchema-instance"`)
+	require.Equal(t, attrs, msg.NamespaceAttrs)
+
+	reparsed, err := ParseTyped[NewReleaseMessageV43](output)
+	require.NoError(t, err)
+	require.Equal(t, msg.MessageHeader.MessageId, reparsed.MessageHeader.MessageId)
+}
+
+// TestPopulatedPaths verifies only paths with non-empty values are listed, once each
+func TestPopulatedPaths(t *testing.T) {
+	msg := &NewReleaseMessageV432{
+		AvsVersionId:  "4",
+		MessageHeader: &ernv432.MessageHeader{MessageId: "M1", MessageThreadId: ""},
+		ResourceList: &ernv432.ResourceList{SoundRecording: []*ernv432.SoundRecording{
+			{ResourceReference: "A1", Type: &ernv432.SoundRecordingType{Value: "UserDefined", UserDefinedValue: "Demo"}},
+			{ResourceReference: "A2", Type: &ernv432.SoundRecordingType{}},
+		}},
+	}
+
+	// Empty scalars (MessageThreadId, LanguageAndScriptCode, the second Type) are skipped and repeated
+	// elements listed once
+	require.Equal(t, []string{
+		"/NewReleaseMessage/MessageHeader/MessageId",
+		"/NewReleaseMessage/ResourceList/SoundRecording/ResourceReference",
+		"/NewReleaseMessage/ResourceList/SoundRecording/Type",
+		"/NewReleaseMessage/ResourceList/SoundRecording/Type@UserDefinedValue",
+		"/NewReleaseMessage@AvsVersionId",
+	}, PopulatedPaths(msg))
+	require.Empty(t, PopulatedPaths(&NewReleaseMessageV432{}))
+}
+
+// TestValidatePurgeReferences verifies purged release identifiers are checked against the known releases
+func TestValidatePurgeReferences(t *testing.T) {
+	msg := &ernv432.PurgeReleaseMessage{PurgedRelease: &ernv432.PurgedRelease{ReleaseId: &ernv432.ReleaseId{
+		ICPN:          "00094631432057",
+		ProprietaryId: []*ernv432.ProprietaryId{{Namespace: "DPID:PADPIDA2014120301U", Value: "REL-1"}},
+	}}}
+
+	// Any one known identifier is enough
+	require.Empty(t, ValidatePurgeReferences(msg, map[string]bool{"REL-1": true}))
+
+	errs := ValidatePurgeReferences(msg, map[string]bool{"00000000000000": true})
+	require.Len(t, errs, 2)
+	require.Equal(t, "/PurgeReleaseMessage/PurgedRelease/ReleaseId/ICPN", errs[0].Path)
+	require.Equal(t, "00094631432057", errs[0].Reference)
+	require.Equal(t, "/PurgeReleaseMessage/PurgedRelease/ReleaseId/ProprietaryId", errs[1].Path)
+	require.Equal(t, "unknown release identifier", errs[1].Message)
+
+	require.Len(t, ValidatePurgeReferences(msg, nil), 2)
+	require.Len(t, ValidatePurgeReferences(msg, map[string]bool{}), 2)
+
+	errs = ValidatePurgeReferences(&ernv432.PurgeReleaseMessage{}, nil)
+	require.Len(t, errs, 1)
+	require.Equal(t, "purged release has no identifier", errs[0].Message)
+}
+
+// TestDealsForTerritory verifies which deals apply to a territory, with worldwide and excluded territories
+func TestDealsForTerritory(t *testing.T) {
+	territories := func(codes ...string) []*ernv432.CurrentTerritoryCode {
+		var list []*ernv432.CurrentTerritoryCode
+		for _, code := range codes {
+			list = append(list, &ernv432.CurrentTerritoryCode{Value: code})
+		}
+		return list
+	}
+	tests := []struct {
+		name     string
+		terms    *ernv432.DealTerms
+		included []string
+		excluded []string
+	}{
+		{"worldwide", &ernv432.DealTerms{TerritoryCode: territories("Worldwide")}, []string{"US", "JP"}, nil},
+		{"explicit", &ernv432.DealTerms{TerritoryCode: territories("US", "CA")}, []string{"US", "CA"}, []string{"JP"}},
+		{"excluded only", &ernv432.DealTerms{ExcludedTerritoryCode: territories("JP")}, []string{"US", "GB"}, []string{"JP"}},
+		{"worldwide and excluded", &ernv432.DealTerms{TerritoryCode: territories("Worldwide"), ExcludedTerritoryCode: territories("JP")}, []string{"US"}, []string{"JP"}},
+		{"case and whitespace", &ernv432.DealTerms{TerritoryCode: territories(" us ", "worldWIDE"), ExcludedTerritoryCode: territories("jp ")}, []string{"US", " us", "gb"}, []string{"JP", " jp"}},
+		{"no territories", &ernv432.DealTerms{}, nil, []string{"US"}},
+		{"no terms", nil, nil, []string{"US"}},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			msg := &NewReleaseMessageV432{DealList: &ernv432.DealList{ReleaseDeal: []*ernv432.ReleaseDeal{{
+				DealReleaseReference: []string{"R0"},
+				Deal:                 []*ernv432.Deal{{DealTerms: tc.terms}},
+			}}}}
+			for _, territory := range tc.included {
+				require.Len(t, DealsForTerritory(msg, territory), 1, territory)
+			}
+			for _, territory := range tc.excluded {
+				require.Empty(t, DealsForTerritory(msg, territory), territory)
+			}
+		})
+	}
+
+	// A deal listed under several releases applies to each of them
+	deal := &ernv432.Deal{DealTerms: &ernv432.DealTerms{TerritoryCode: territories("US")}}
+	msg := &NewReleaseMessageV432{DealList: &ernv432.DealList{ReleaseDeal: []*ernv432.ReleaseDeal{
+		{DealReleaseReference: []string{"R0", "R1"}, Deal: []*ernv432.Deal{deal}},
+		{DealReleaseReference: []string{"R2"}, Deal: []*ernv432.Deal{{DealTerms: &ernv432.DealTerms{TerritoryCode: territories("JP")}}}},
+	}}}
+	deals := DealsForTerritory(msg, "US")
+	require.Len(t, deals, 2)
+	require.Equal(t, "R0", deals[0].ReleaseReference)
+	require.Equal(t, "R1", deals[1].ReleaseReference)
+	require.Same(t, deal, deals[0].Deal)
+	require.Same(t, deal, deals[1].Deal)
+}
+
+// TestIsTestMessage verifies the MessageControlType check and the errors for messages without a header
+func TestIsTestMessage(t *testing.T) {
+	for controlType, expected := range map[string]bool{"TestMessage": true, " testmessage ": true, "LiveMessage": false, "": false} {
+		isTest, err := IsTestMessage(&NewReleaseMessageV432{MessageHeader: &ernv432.MessageHeader{MessageControlType: controlType}})
+		require.NoError(t, err)
+		require.Equal(t, expected, isTest, controlType)
+	}
+
+	_, err := IsTestMessage(&ernv432.DealList{})
+	require.ErrorContains(t, err, "has no MessageHeader")
+	_, err = IsTestMessage(&NewReleaseMessageV432{})
+	require.ErrorContains(t, err, "has an empty MessageHeader")
+}
+
+// TestValidateTechnicalReferences verifies resources need a File URI and a supported HashSum algorithm
+func TestValidateTechnicalReferences(t *testing.T) {
+	msg := &NewReleaseMessageV432{ResourceList: &ernv432.ResourceList{
+		SoundRecording: []*ernv432.SoundRecording{{
+			ResourceReference: "A1",
+			SoundRecordingEdition: []*ernv432.SoundRecordingEdition{{TechnicalDetails: []*ernv432.TechnicalSoundRecordingDetails{{
+				DeliveryFile: []*ernv432.AudioDeliveryFile{{File: &ernv432.File{
+					URI:     "A1.flac",
+					HashSum: &ernv432.DetailedHashSum{Algorithm: &ernv432.HashSumAlgorithmType{Value: "SHA-256"}, HashSumValue: "00"},
+				}}},
+			}}}},
+		}},
+		Image: []*ernv432.Image{{
+			ResourceReference: "A2",
+			TechnicalDetails: []*ernv432.TechnicalImageDetails{{File: &ernv432.File{
+				URI:     "A2.jpg",
+				HashSum: &ernv432.DetailedHashSum{Algorithm: &ernv432.HashSumAlgorithmType{Value: "CRC32"}},
+			}}},
+		}},
+	}}
+	errs := ValidateTechnicalReferences(msg)
+	require.Len(t, errs, 1)
+	require.Equal(t, "A2", errs[0].ResourceReference)
+	require.Equal(t, "/NewReleaseMessage/ResourceList/Image/TechnicalDetails/File/HashSum/Algorithm", errs[0].Path)
+	require.Equal(t, `unsupported HashSum algorithm "CRC32"`, errs[0].Message)
+
+	// A resource without a File URI
+	msg.ResourceList.Image[0].TechnicalDetails[0].File = &ernv432.File{URI: " "}
+	msg.ResourceList.Text = []*ernv432.Text{{ResourceReference: "A3"}}
+	errs = ValidateTechnicalReferences(msg)
+	require.Len(t, errs, 2)
+	require.Equal(t, TechError{ResourceReference: "A2", Path: "/NewReleaseMessage/ResourceList/Image", Message: "no TechnicalDetails File with a URI"}, errs[0])
+	require.Equal(t, "A3", errs[1].ResourceReference)
+}
+
+// TestAnnotateReferences verifies references are annotated with comments naming their target without changing the parse
+func TestAnnotateReferences(t *testing.T) {
+	xmlData, err := testdata.DDEXTestDataFS.ReadFile("ddex/ern/v43/1 Audio.xml")
+	require.NoError(t, err)
+	msg, err := ParseTyped[NewReleaseMessageV43](xmlData)
+	require.NoError(t, err)
+	msg.ResourceList.SoundRecording[0].DisplayArtist[0].ArtistPartyReference = "PUnknown"
+	msg.ResourceList.SoundRecording[1].DisplayTitleText[0].Value = "Intro --- Outro -"
+
+	output, err := AnnotateReferences(msg)
+	require.NoError(t, err)
+	require.Contains(t, string(output), `<ReleaseResourceReference>A1</ReleaseResourceReference><!-- SoundRecording: "Yume no Lullaby" -->`)
+	require.Contains(t, string(output), `<ReleaseResourceReference>A2</ReleaseResourceReference><!-- SoundRecording: "Intro - - - Outro -" -->`)
+	require.Contains(t, string(output), `<ArtistPartyReference>PSaekoShu</ArtistPartyReference><!-- Party: "Saeko Shu" -->`)
+	// Unresolved references and the defining references are left alone
+	require.Contains(t, string(output), "<ArtistPartyReference>PUnknown</ArtistPartyReference>\n")
+	require.Contains(t, string(output), "<ResourceReference>A1</ResourceReference>\n")
+
+	// The comments do not change what the output parses to
+	plain, err := xml.MarshalIndent(msg, "", "  ")
+	require.NoError(t, err)
+	expected, err := ParseTyped[NewReleaseMessageV43](plain)
+	require.NoError(t, err)
+	reparsed, err := ParseTyped[NewReleaseMessageV43](output)
+	require.NoError(t, err)
+	require.True(t, proto.Equal(expected, reparsed))
+}
+
+// TestFindDuplicateMessageIds verifies MessageIds shared by several files are reported by file index
+func TestFindDuplicateMessageIds(t *testing.T) {
+	var files [][]byte
+	for _, name := range []string{"1 Audio", "2 Video", "3 MixedMedia", "4 SimpleAudioSingle"} {
+		data, err := testdata.DDEXTestDataFS.ReadFile("ddex/ern/v43/" + name + ".xml")
+		require.NoError(t, err)
+		files = append(files, data)
+	}
+
+	// 2 Video and 3 MixedMedia both use MessageId test1
+	duplicates, err := FindDuplicateMessageIds(files)
+	require.NoError(t, err)
+	require.Equal(t, map[string][]int{"test1": {1, 2}}, duplicates)
+
+	duplicates, err = FindDuplicateMessageIds([][]byte{files[0], files[1], files[3]})
+	require.NoError(t, err)
+	require.Empty(t, duplicates)
+
+	_, err = FindDuplicateMessageIds([][]byte{files[0], []byte(`<NewReleaseMessage><PartyList/></NewReleaseMessage>`)})
+	require.EqualError(t, err, "file 1: no MessageHeader found")
+}
+
+// TestResourcesMissingTitle verifies resources without a non-blank title are listed
+func TestResourcesMissingTitle(t *testing.T) {
+	msg := &NewReleaseMessageV432{ResourceList: &ernv432.ResourceList{
+		SoundRecording: []*ernv432.SoundRecording{
+			{ResourceReference: "A1", DisplayTitleText: []*ernv432.DisplayTitleText{{Value: "Song"}}},
+			{ResourceReference: "A2", DisplayTitle: []*ernv432.DisplayTitle{{TitleText: "Song (Live)"}}},
+			{ResourceReference: "A3", DisplayTitleText: []*ernv432.DisplayTitleText{{Value: "  "}}},
+		},
+		Video: []*ernv432.Video{{ResourceReference: "A4"}},
+		Image: []*ernv432.Image{{ResourceReference: "A5", DisplayTitle: []*ernv432.DisplayTitle{{TitleText: "Cover"}}}},
+	}}
+	require.Equal(t, []string{"A3", "A4"}, ResourcesMissingTitle(msg))
+	require.Empty(t, ResourcesMissingTitle(&NewReleaseMessageV432{}))
+}
+
+// TestMessageStats verifies the resource, release, deal, party and element counts of a message
+func TestMessageStats(t *testing.T) {
+	xmlData, err := testdata.DDEXTestDataFS.ReadFile("ddex/ern/v43/1 Audio.xml")
+	require.NoError(t, err)
+	msg, err := ParseTyped[NewReleaseMessageV43](xmlData)
+	require.NoError(t, err)
+
+	stats := MessageStats(msg)
+	require.Equal(t, map[string]int{"SoundRecording": 21, "Image": 1}, stats.Resources)
+	require.Equal(t, 22, stats.Releases)
+	require.Equal(t, 3, stats.Deals)
+	require.Equal(t, 2, stats.Parties)
+	require.Greater(t, stats.Elements, 1000)
+
+	require.Equal(t, Stats{Resources: map[string]int{}, Elements: 1}, MessageStats(&NewReleaseMessageV43{}))
+	require.Equal(t, Stats{Resources: map[string]int{}}, MessageStats((*NewReleaseMessageV43)(nil)))
+}
+
+// TestValidateGenres verifies genre values are checked against an allowed list
+func TestValidateGenres(t *testing.T) {
+	xmlData, err := testdata.DDEXTestDataFS.ReadFile("ddex/ern/v43/1 Audio.xml")
+	require.NoError(t, err)
+	msg, err := ParseTyped[NewReleaseMessageV43](xmlData)
+	require.NoError(t, err)
+	require.Empty(t, ValidateGenres(msg, map[string]bool{"J-Pop": true}))
+
+	// Values are trimmed but otherwise compared exactly, and each occurrence is reported
+	msg.ReleaseList.Release.Genre[0].GenreText = " J-Pop "
+	msg.ReleaseList.TrackRelease[0].Genre[0].GenreText = "j-pop"
+	errs := ValidateGenres(msg, map[string]bool{"J-Pop": true})
+	require.Equal(t, []GenreError{{Path: "/NewReleaseMessage/ReleaseList/TrackRelease/Genre/GenreText", Value: "j-pop"}}, errs)
+	require.EqualError(t, errs[0], `/NewReleaseMessage/ReleaseList/TrackRelease/Genre/GenreText: genre "j-pop" is not allowed`)
+
+	require.Len(t, ValidateGenres(msg, nil), 22)
+}
+
+// TestFindDuplicateISRCs verifies ISRCs shared by several recordings are found after normalization
+func TestFindDuplicateISRCs(t *testing.T) {
+	recording := func(reference string, isrcs ...string) *ernv432.SoundRecording {
+		sr := &ernv432.SoundRecording{ResourceReference: reference}
+		for _, isrc := range isrcs {
+			sr.SoundRecordingEdition = append(sr.SoundRecordingEdition, &ernv432.SoundRecordingEdition{
+				ResourceId: []*ernv432.SoundRecordingId{{ISRC: isrc}},
+			})
+		}
+		return sr
+	}
+	msg := &NewReleaseMessageV432{ResourceList: &ernv432.ResourceList{SoundRecording: []*ernv432.SoundRecording{
+		// One ISRC on both editions of a recording
+		recording("A1", "USS1Z9900001", "US-S1Z-99-00001"),
+		recording("A2", "GBAYE0000001"),
+		recording("A3", "us-s1z-99-00001"),
+		recording("A4", ""),
+		recording("A5", ""),
+	}}}
+	require.Equal(t, map[string][]string{"USS1Z9900001": {"A1", "A3"}}, FindDuplicateISRCs(msg))
+
+	msg.ResourceList.SoundRecording = msg.ResourceList.SoundRecording[:2]
+	require.Empty(t, FindDuplicateISRCs(msg))
+}
+
+// TestSchemaLocation verifies the default xsi:schemaLocation, a mirror base URL, and that NewMessage
+// output carries the location
+func TestSchemaLocation(t *testing.T) {
+	location, err := SchemaLocation("ern", "v432")
+	require.NoError(t, err)
+	require.Equal(t, "http://ddex.net/xml/ern/432 http://ddex.net/xml/ern/432/release-notification.xsd", location)
+
+	_, err = SchemaLocation("ern", "v99")
+	require.EqualError(t, err, "unknown message type/version: ern/v99")
+	_, err = SchemaLocation("dsr", "v43")
+	require.EqualError(t, err, "unknown message type: dsr")
+
+	defaultBaseURL := SchemaLocationBaseURL
+	t.Cleanup(func() { SchemaLocationBaseURL = defaultBaseURL })
+	SchemaLocationBaseURL = "https://schemas.example.com/ddex/"
+
+	location, err = SchemaLocation("mead", "v11")
+	require.NoError(t, err)
+	require.Equal(t, "http://ddex.net/xml/mead/11 https://schemas.example.com/ddex/mead/11/media-enrichment-and-description.xsd", location)
+
+	msg, err := NewMessage("ern", "v43", "NewReleaseMessage")
+	require.NoError(t, err)
+	msg.(*NewReleaseMessageV43).MessageHeader = &ernv43.MessageHeader{MessageId: "M1"}
+	output, err := xml.Marshal(msg)
+	require.NoError(t, err)
+	require.Contains(t, string(output), `xmlns:xsi="http://www.w3.org/2001/XMLSchema-instance"`)
+
+	var reparsed struct {
+		SchemaLocation string `xml:"http://www.w3.org/2001/XMLSchema-instance schemaLocation,attr"`
+	}
+	require.NoError(t, xml.Unmarshal(output, &reparsed))
+	require.Equal(t, "http://ddex.net/xml/ern/43 https://schemas.example.com/ddex/ern/43/release-notification.xsd", reparsed.SchemaLocation)
+}
@@ -0,0 +1,43 @@
+package ddex
+
+import (
+	"errors"
+	"fmt"
+	"reflect"
+)
+
+var (
+	// ErrNoDealList is returned by ValidateDealList when the message has no DealList element
+	ErrNoDealList = errors.New("message has no DealList")
+	// ErrEmptyDealList is returned by ValidateDealList when a DealList is present but contains no Deal
+	ErrEmptyDealList = errors.New("DealList contains no Deal")
+)
+
+// ValidateDealList checks that an ERN message (any version) carries at least one Deal. An absent DealList
+// (ErrNoDealList) and a present DealList without any ReleaseDeal/Deal (ErrEmptyDealList) are reported as
+// distinct errors, since senders use them to mean different things; test with errors.Is. A DealList holding
+// only ERN 4 ReleaseVisibility or TrackReleaseVisibility counts as empty.
+func ValidateDealList(msg interface{}) error {
+	v := reflect.ValueOf(msg)
+	if !v.IsValid() || (v.Kind() == reflect.Ptr && v.IsNil()) {
+		return fmt.Errorf("message is nil")
+	}
+
+	getter := v.MethodByName("GetDealList")
+	if !getter.IsValid() {
+		return fmt.Errorf("%T has no DealList", msg)
+	}
+	dealList := getter.Call(nil)[0]
+	if dealList.IsNil() {
+		return ErrNoDealList
+	}
+
+	releaseDeals := dealList.Elem().FieldByName("ReleaseDeal")
+	for i := 0; i < releaseDeals.Len(); i++ {
+		releaseDeal := releaseDeals.Index(i)
+		if !releaseDeal.IsNil() && releaseDeal.Elem().FieldByName("Deal").Len() > 0 {
+			return nil
+		}
+	}
+	return ErrEmptyDealList
+}
@@ -0,0 +1,170 @@
+package ddex
+
+import (
+	"bytes"
+	"encoding/xml"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/alecsavvy/ddex-proto/gen"
+)
+
+// Detection is the root element and namespace read from a document and the registered message they matched
+type Detection struct {
+	RootElement string
+	Namespace   string
+	// MessageType, Version and MessageName are empty when no registered message matched
+	MessageType string
+	Version     string
+	MessageName string
+}
+
+// Candidate scores how closely a document's root element and namespace match one registered message.
+// Scores range from 0 (nothing in common) to 1 (exact match).
+type Candidate struct {
+	MessageType      string
+	Version          string
+	MessageName      string
+	RootElement      string
+	Namespace        string
+	RootElementScore float64
+	NamespaceScore   float64
+	// Explanation summarizes the mismatch, e.g. "root element matched but namespace was ... not ..."
+	Explanation string
+}
+
+// Score is the combined score used to rank candidates
+func (c Candidate) Score() float64 {
+	return c.RootElementScore + c.NamespaceScore
+}
+
+// DetectMessageTypeVerbose is gen.DetectMessageType for triage: along with the detection it returns every
+// registered message scored against the document's root element and namespace, best match first. The
+// error is non-nil when no message matches exactly, in which case the candidates show how close each came.
+func DetectMessageTypeVerbose(data []byte) (*Detection, []Candidate, error) {
+	rootElement, namespace, err := readRoot(data)
+	if err != nil {
+		return nil, nil, err
+	}
+	detection := &Detection{RootElement: rootElement, Namespace: namespace}
+
+	var candidates []Candidate
+	for key, info := range gen.GetRegisteredTypes() {
+		parts := strings.Split(key, "/")
+		if len(parts) != 3 {
+			continue
+		}
+		candidate := Candidate{
+			MessageType:      parts[0],
+			Version:          parts[1],
+			MessageName:      parts[2],
+			RootElement:      info.RootElement,
+			Namespace:        info.Namespace,
+			RootElementScore: similarity(rootElement, info.RootElement),
+			NamespaceScore:   similarity(namespace, info.Namespace),
+		}
+		candidate.Explanation = explainCandidate(candidate, rootElement, namespace)
+		candidates = append(candidates, candidate)
+
+		if candidate.RootElementScore == 1 && candidate.NamespaceScore == 1 {
+			detection.MessageType, detection.Version, detection.MessageName = parts[0], parts[1], parts[2]
+		}
+	}
+
+	sort.Slice(candidates, func(i, j int) bool {
+		if candidates[i].Score() != candidates[j].Score() {
+			return candidates[i].Score() > candidates[j].Score()
+		}
+		if candidates[i].MessageType != candidates[j].MessageType {
+			return candidates[i].MessageType < candidates[j].MessageType
+		}
+		if candidates[i].Version != candidates[j].Version {
+			return candidates[i].Version < candidates[j].Version
+		}
+		return candidates[i].MessageName < candidates[j].MessageName
+	})
+
+	if detection.MessageType == "" {
+		return detection, candidates, fmt.Errorf("unknown DDEX message type with root element '%s' and namespace '%s'", rootElement, namespace)
+	}
+	return detection, candidates, nil
+}
+
+// readRoot returns the local name and namespace of the root element, falling back to the first xmlns
+// declaration like gen.DetectMessageType
+func readRoot(data []byte) (rootElement, namespace string, err error) {
+	decoder := xml.NewDecoder(bytes.NewReader(data))
+	for {
+		token, err := decoder.Token()
+		if err != nil {
+			return "", "", fmt.Errorf("failed to parse XML: %w", err)
+		}
+		start, ok := token.(xml.StartElement)
+		if !ok {
+			continue
+		}
+
+		namespace = start.Name.Space
+		if namespace == "" {
+			for _, attr := range start.Attr {
+				if attr.Name.Local == "xmlns" || strings.HasPrefix(attr.Name.Local, "xmlns:") {
+					namespace = attr.Value
+					break
+				}
+			}
+		}
+		return start.Name.Local, namespace, nil
+	}
+}
+
+// explainCandidate describes how a document's root element and namespace differ from a candidate's
+func explainCandidate(c Candidate, rootElement, namespace string) string {
+	rootMatched := c.RootElementScore == 1
+	namespaceMatched := c.NamespaceScore == 1
+
+	switch {
+	case rootMatched && namespaceMatched:
+		return "exact match"
+	case rootMatched:
+		return fmt.Sprintf("root element matched but namespace was %q not %q", namespace, c.Namespace)
+	case namespaceMatched:
+		return fmt.Sprintf("namespace matched but root element was %q not %q", rootElement, c.RootElement)
+	default:
+		return fmt.Sprintf("root element %q and namespace %q differ from %q and %q", rootElement, namespace, c.RootElement, c.Namespace)
+	}
+}
+
+// similarity scores two strings from 0 to 1 by Levenshtein distance relative to the longer string
+func similarity(a, b string) float64 {
+	if a == b {
+		return 1
+	}
+	longest := len(a)
+	if len(b) > longest {
+		longest = len(b)
+	}
+	return 1 - float64(levenshtein(a, b))/float64(longest)
+}
+
+// levenshtein returns the edit distance between two strings
+func levenshtein(a, b string) int {
+	previous := make([]int, len(b)+1)
+	current := make([]int, len(b)+1)
+	for j := range previous {
+		previous[j] = j
+	}
+
+	for i := 1; i <= len(a); i++ {
+		current[0] = i
+		for j := 1; j <= len(b); j++ {
+			cost := 1
+			if a[i-1] == b[j-1] {
+				cost = 0
+			}
+			current[j] = min(previous[j]+1, current[j-1]+1, previous[j-1]+cost)
+		}
+		previous, current = current, previous
+	}
+	return previous[len(b)]
+}
@@ -0,0 +1,249 @@
+package ddex
+
+import (
+	"fmt"
+	"math"
+	"reflect"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// durationPattern matches an xs:duration, the ISO 8601 duration subset DDEX uses: PnYnMnDTnHnMnS with
+// every component optional, only the seconds fractional, and a T only before a time component
+var durationPattern = regexp.MustCompile(`^(-)?P(?:(\d+)Y)?(?:(\d+)M)?(?:(\d+)D)?(?:T(?:(\d+)H)?(?:(\d+)M)?(?:(\d+)(?:\.(\d+))?S)?)?$`)
+
+// DurationError describes a Duration element that is not a valid ISO 8601 duration
+type DurationError struct {
+	// Path is the DDEX path of the element, e.g. /NewReleaseMessage/ResourceList/SoundRecording/Duration
+	Path string
+	// Reference is the reference of the containing resource or release, if any
+	Reference string
+	// Value is the rejected duration
+	Value string
+	// Message explains why the duration is invalid
+	Message string
+}
+
+// Error implements the error interface
+func (e DurationError) Error() string {
+	if e.Reference == "" {
+		return fmt.Sprintf("%s: %s (%q)", e.Path, e.Message, e.Value)
+	}
+	return fmt.Sprintf("%s (%s): %s (%q)", e.Path, e.Reference, e.Message, e.Value)
+}
+
+// ParseDuration parses an ISO 8601 duration as used by DDEX (xs:duration), e.g. PT3M45S or PT30.072S.
+// Durations with years or months have no fixed length and are rejected unless those components are zero;
+// days are 24 hours. Fractions of a second beyond nanoseconds are truncated.
+func ParseDuration(s string) (time.Duration, error) {
+	match, err := matchDuration(s)
+	if err != nil {
+		return 0, err
+	}
+	if !isZeroNumber(match[2]) || !isZeroNumber(match[3]) {
+		return 0, fmt.Errorf("duration %q has years or months, which have no fixed length", s)
+	}
+
+	var total time.Duration
+	for _, component := range []struct {
+		value string
+		unit  time.Duration
+	}{
+		{match[4], 24 * time.Hour},
+		{match[5], time.Hour},
+		{match[6], time.Minute},
+		{match[7], time.Second},
+	} {
+		if component.value == "" {
+			continue
+		}
+		n, err := strconv.ParseInt(component.value, 10, 64)
+		if err != nil || n > (math.MaxInt64-int64(total))/int64(component.unit) {
+			return 0, fmt.Errorf("duration %q is out of range", s)
+		}
+		total += time.Duration(n) * component.unit
+	}
+	if fraction := match[8]; fraction != "" {
+		if len(fraction) > 9 {
+			fraction = fraction[:9]
+		}
+		nanos, _ := strconv.ParseInt(fraction+strings.Repeat("0", 9-len(fraction)), 10, 64)
+		if nanos > math.MaxInt64-int64(total) {
+			return 0, fmt.Errorf("duration %q is out of range", s)
+		}
+		total += time.Duration(nanos)
+	}
+
+	if match[1] == "-" {
+		total = -total
+	}
+	return total, nil
+}
+
+// matchDuration matches s against the xs:duration grammar, returning the submatches of durationPattern
+func matchDuration(s string) ([]string, error) {
+	match := durationPattern.FindStringSubmatch(s)
+	switch {
+	case match == nil:
+		return nil, fmt.Errorf("duration %q is not of the form PnYnMnDTnHnMnS", s)
+	case strings.HasSuffix(s, "P") || strings.HasSuffix(s, "T"):
+		return nil, fmt.Errorf("duration %q has no components", s)
+	}
+	return match, nil
+}
+
+// isZeroNumber reports whether a matched component is absent or zero
+func isZeroNumber(s string) bool {
+	return strings.Trim(s, "0") == ""
+}
+
+// ValidateDurations checks every Duration element of any generated DDEX message, including those named
+// like UsableResourceDuration, against the ISO 8601 duration grammar DDEX uses (see ParseDuration).
+// Durations with years or months are valid here even though ParseDuration cannot convert them. Each
+// error names the nearest resource or release reference.
+func ValidateDurations(msg interface{}) []DurationError {
+	v := reflect.ValueOf(msg)
+	for v.Kind() == reflect.Ptr || v.Kind() == reflect.Interface {
+		if v.IsNil() {
+			return nil
+		}
+		v = v.Elem()
+	}
+	if v.Kind() != reflect.Struct {
+		return nil
+	}
+
+	var errs []DurationError
+	checkDurations(v, "/"+v.Type().Name(), "", &errs)
+	return errs
+}
+
+// checkDurations walks a value, checking the duration elements of each struct with the nearest reference
+// in scope
+func checkDurations(v reflect.Value, path, reference string, errs *[]DurationError) {
+	switch v.Kind() {
+	case reflect.Ptr, reflect.Interface:
+		if !v.IsNil() {
+			checkDurations(v.Elem(), path, reference, errs)
+		}
+	case reflect.Slice:
+		if v.Type().Elem().Kind() == reflect.Uint8 {
+			return
+		}
+		for i := 0; i < v.Len(); i++ {
+			checkDurations(v.Index(i), path, reference, errs)
+		}
+	case reflect.Struct:
+		if key := referenceKey(v); key != "" {
+			reference = key
+		}
+		t := v.Type()
+		for i := 0; i < t.NumField(); i++ {
+			field, ok := xmlFieldOf(t.Field(i))
+			if !ok || field.Attr || field.CharData || field.InnerXML {
+				continue
+			}
+			childPath := path + "/" + field.Name
+			if strings.HasSuffix(field.Name, "Duration") {
+				for _, value := range durationValues(v.Field(i)) {
+					if _, err := matchDuration(value); err != nil {
+						*errs = append(*errs, DurationError{
+							Path:      childPath,
+							Reference: reference,
+							Value:     value,
+							Message:   "not a valid ISO 8601 duration",
+						})
+					}
+				}
+				continue
+			}
+			checkDurations(v.Field(i), childPath, reference, errs)
+		}
+	}
+}
+
+// durationValues returns the non-empty values of a duration element: a string, a list of strings, or
+// elements with the duration as character data
+func durationValues(v reflect.Value) []string {
+	var values []string
+	switch v.Kind() {
+	case reflect.String:
+		if value := strings.TrimSpace(v.String()); value != "" {
+			values = append(values, value)
+		}
+	case reflect.Slice:
+		for i := 0; i < v.Len(); i++ {
+			values = append(values, durationValues(v.Index(i))...)
+		}
+	case reflect.Ptr:
+		if !v.IsNil() && v.Elem().Kind() == reflect.Struct {
+			values = append(values, durationValues(v.Elem())...)
+		}
+	case reflect.Struct:
+		if value := stringField(v, "Value"); value != "" {
+			values = append(values, value)
+		}
+	}
+	return values
+}
+
+// FormatDuration formats d in the canonical form of NormalizeDurations: PTnMnS with whole minutes, which
+// may exceed 59, and seconds with a fraction only when needed, e.g. PT3M0S or PT62M3.5S
+func FormatDuration(d time.Duration) string {
+	sign := ""
+	if d < 0 {
+		sign, d = "-", -d
+	}
+	minutes := d / time.Minute
+	seconds := strconv.FormatFloat((d % time.Minute).Seconds(), 'f', -1, 64)
+	return fmt.Sprintf("%sPT%dM%sS", sign, minutes, seconds)
+}
+
+// NormalizeDurations rewrites every Duration element of any generated DDEX message, like those
+// ValidateDurations checks, to the canonical form of FormatDuration, so that equivalent encodings such as
+// PT180S and PT3M compare and hash equal. Durations ParseDuration rejects, such as those with years or
+// months, are left unchanged.
+func NormalizeDurations(msg interface{}) {
+	normalizeDurations(reflect.ValueOf(msg), false)
+}
+
+// normalizeDurations walks a value, rewriting the values of duration elements; inDuration is set within one
+func normalizeDurations(v reflect.Value, inDuration bool) {
+	switch v.Kind() {
+	case reflect.Ptr, reflect.Interface:
+		if !v.IsNil() {
+			normalizeDurations(v.Elem(), inDuration)
+		}
+	case reflect.Slice:
+		if v.Type().Elem().Kind() == reflect.Uint8 {
+			return
+		}
+		for i := 0; i < v.Len(); i++ {
+			normalizeDurations(v.Index(i), inDuration)
+		}
+	case reflect.String:
+		if !inDuration || !v.CanSet() {
+			return
+		}
+		if d, err := ParseDuration(strings.TrimSpace(v.String())); err == nil {
+			v.SetString(FormatDuration(d))
+		}
+	case reflect.Struct:
+		if inDuration {
+			if value := v.FieldByName("Value"); value.IsValid() {
+				normalizeDurations(value, true)
+			}
+			return
+		}
+		t := v.Type()
+		for i := 0; i < t.NumField(); i++ {
+			field, ok := xmlFieldOf(t.Field(i))
+			if !ok || field.Attr || field.CharData || field.InnerXML {
+				continue
+			}
+			normalizeDurations(v.Field(i), strings.HasSuffix(field.Name, "Duration"))
+		}
+	}
+}
@@ -0,0 +1,134 @@
+package ddex
+
+import (
+	"bytes"
+	"reflect"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+)
+
+// DetectEncodingMismatch compares the encoding a document declares (by byte order mark or XML declaration,
+// UTF-8 if neither) with what its bytes look like, to quarantine likely mojibake before ingest. looksLike
+// is "US-ASCII" when every byte is ASCII, "UTF-8" when the bytes are valid UTF-8, "UTF-16" for a UTF-16
+// byte order mark, and otherwise "windows-1252" or "ISO-8859-1" depending on whether bytes 0x80..0x9F
+// occur. ASCII matches any ASCII-compatible declaration, and ISO-8859-1 and windows-1252 match each other.
+func DetectEncodingMismatch(data []byte) (declared string, looksLike string, mismatch bool) {
+	declared = "UTF-8"
+	switch {
+	case bytes.HasPrefix(data, []byte{0xFF, 0xFE}), bytes.HasPrefix(data, []byte{0xFE, 0xFF}):
+		// The declaration of a UTF-16 document is itself UTF-16, so the byte order mark is all there is
+		return "UTF-16", "UTF-16", false
+	case bytes.HasPrefix(data, []byte{0xEF, 0xBB, 0xBF}):
+		data = data[3:]
+	}
+	if match := encodingDecl.FindSubmatch(data); match != nil {
+		declared = string(match[3])
+	}
+
+	// Look for bytes that are not part of a valid UTF-8 sequence, and for those of them in the C1 range
+	// that only windows-1252 assigns printable characters to
+	highByte, invalid, c1Byte := false, false, false
+	for i := 0; i < len(data); {
+		r, size := utf8.DecodeRune(data[i:])
+		if r == utf8.RuneError && size == 1 {
+			invalid = true
+			c1Byte = c1Byte || data[i] <= 0x9F
+		}
+		highByte = highByte || data[i] >= 0x80
+		i += size
+	}
+	switch {
+	case !highByte:
+		looksLike = "US-ASCII"
+	case !invalid:
+		looksLike = "UTF-8"
+	case c1Byte:
+		looksLike = "windows-1252"
+	default:
+		looksLike = "ISO-8859-1"
+	}
+
+	return declared, looksLike, encodingFamily(declared) != encodingFamily(looksLike) && looksLike != "US-ASCII"
+}
+
+// encodingFamily groups encoding names that decode the same documents for DetectEncodingMismatch
+func encodingFamily(name string) string {
+	switch strings.ToLower(name) {
+	case "utf-8", "utf8":
+		return "utf-8"
+	case "iso-8859-1", "iso8859-1", "latin1", "latin-1", "windows-1252", "cp1252":
+		return "latin1"
+	case "us-ascii", "ascii":
+		return "ascii"
+	case "utf-16", "utf16":
+		return "utf-16"
+	}
+	return strings.ToLower(name)
+}
+
+// FindEncodingArtifacts returns the paths (/Root/Child, /Root/Child@attr) of the string values of any
+// generated DDEX message that show signs of an upstream encoding error, in document order and each once:
+// the replacement character U+FFFD, invalid UTF-8, control characters other than tab and line breaks,
+// and mojibake, text that reads as UTF-8 once encoded back to windows-1252, such as "CafÃ©" for "Café".
+func FindEncodingArtifacts(msg interface{}) []string {
+	var paths []string
+	seen := make(map[string]bool)
+	walkScalars(msg, func(path string, _ xmlField, value reflect.Value) {
+		if value.Kind() != reflect.String || seen[path] || !hasEncodingArtifact(value.String()) {
+			return
+		}
+		seen[path] = true
+		paths = append(paths, path)
+	})
+	return paths
+}
+
+// hasEncodingArtifact reports whether s has a replacement character, invalid UTF-8, a control character
+// or mojibake
+func hasEncodingArtifact(s string) bool {
+	if !utf8.ValidString(s) {
+		return true
+	}
+	for _, r := range s {
+		if r == utf8.RuneError || (unicode.IsControl(r) && r != '\t' && r != '\n' && r != '\r') {
+			return true
+		}
+	}
+	return isMojibake(s)
+}
+
+// isMojibake reports whether s is UTF-8 text that was decoded as windows-1252 (or ISO-8859-1): all of its
+// characters exist in windows-1252 and their bytes there form valid UTF-8 with a multi-byte character
+func isMojibake(s string) bool {
+	encoded := make([]byte, 0, len(s))
+	for _, r := range s {
+		b, ok := windows1252Byte(r)
+		if !ok {
+			return false
+		}
+		encoded = append(encoded, b)
+	}
+	if !utf8.Valid(encoded) {
+		return false
+	}
+	for _, b := range encoded {
+		if b >= utf8.RuneSelf {
+			return true
+		}
+	}
+	return false
+}
+
+// windows1252Byte returns the windows-1252 byte of r, including the C1 controls undefined bytes decode to
+func windows1252Byte(r rune) (byte, bool) {
+	if r < 0x80 || (r >= 0xA0 && r <= 0xFF) {
+		return byte(r), true
+	}
+	for i, c := range windows1252 {
+		if c == r {
+			return byte(0x80 + i), true
+		}
+	}
+	return 0, false
+}
@@ -8,10 +8,10 @@ import (
 	"os"
 	"path/filepath"
 
+	ddex "github.com/alecsavvy/ddex-proto"
 	ernv432 "github.com/alecsavvy/ddex-proto/gen/ddex/ern/v432"
 	meadv11 "github.com/alecsavvy/ddex-proto/gen/ddex/mead/v11"
 	piev10 "github.com/alecsavvy/ddex-proto/gen/ddex/pie/v10"
-	"github.com/davecgh/go-spew/spew"
 )
 
 func main() {
@@ -41,14 +41,13 @@ func main() {
 	var newRelease ernv432.NewReleaseMessage
 	if err := xml.Unmarshal(data, &newRelease); err == nil && newRelease.MessageHeader != nil {
 		fmt.Println("✓ Parsed as ERN v4.3.2 NewReleaseMessage (protobuf)")
-		spew.Dump(&newRelease)
+		fmt.Print(ddex.TreeString(&newRelease))
 
 		if outputPath != "" {
-			output, err := xml.MarshalIndent(&newRelease, "", "  ")
+			output, err := ddex.MarshalDocument(&newRelease, ddex.DocumentOptions{MarshalOptions: ddex.DefaultMarshalOptions})
 			if err != nil {
 				log.Printf("Failed to marshal back to XML: %v", err)
 			} else {
-				output = append([]byte(xml.Header), output...)
 				if err := os.WriteFile(outputPath, output, 0644); err != nil {
 					log.Printf("Failed to write output file: %v", err)
 				} else {
@@ -63,14 +62,13 @@ func main() {
 	var purgeRelease ernv432.PurgeReleaseMessage
 	if err := xml.Unmarshal(data, &purgeRelease); err == nil && purgeRelease.MessageHeader != nil {
 		fmt.Println("✓ Parsed as ERN v4.3.2 PurgeReleaseMessage (protobuf)")
-		spew.Dump(&purgeRelease)
+		fmt.Print(ddex.TreeString(&purgeRelease))
 
 		if outputPath != "" {
-			output, err := xml.MarshalIndent(&purgeRelease, "", "  ")
+			output, err := ddex.MarshalDocument(&purgeRelease, ddex.DocumentOptions{MarshalOptions: ddex.DefaultMarshalOptions})
 			if err != nil {
 				log.Printf("Failed to marshal back to XML: %v", err)
 			} else {
-				output = append([]byte(xml.Header), output...)
 				if err := os.WriteFile(outputPath, output, 0644); err != nil {
 					log.Printf("Failed to write output file: %v", err)
 				} else {
@@ -85,14 +83,13 @@ func main() {
 	var mead meadv11.MeadMessage
 	if err := xml.Unmarshal(data, &mead); err == nil && mead.MessageHeader != nil {
 		fmt.Println("✓ Parsed as MEAD v1.1 MeadMessage (protobuf)")
-		spew.Dump(&mead)
+		fmt.Print(ddex.TreeString(&mead))
 
 		if outputPath != "" {
-			output, err := xml.MarshalIndent(&mead, "", "  ")
+			output, err := ddex.MarshalDocument(&mead, ddex.DocumentOptions{MarshalOptions: ddex.DefaultMarshalOptions})
 			if err != nil {
 				log.Printf("Failed to marshal back to XML: %v", err)
 			} else {
-				output = append([]byte(xml.Header), output...)
 				if err := os.WriteFile(outputPath, output, 0644); err != nil {
 					log.Printf("Failed to write output file: %v", err)
 				} else {
@@ -107,14 +104,13 @@ func main() {
 	var pie piev10.PieMessage
 	if err := xml.Unmarshal(data, &pie); err == nil && pie.MessageHeader != nil {
 		fmt.Println("✓ Parsed as PIE v1.0 PieMessage (protobuf)")
-		spew.Dump(&pie)
+		fmt.Print(ddex.TreeString(&pie))
 
 		if outputPath != "" {
-			output, err := xml.MarshalIndent(&pie, "", "  ")
+			output, err := ddex.MarshalDocument(&pie, ddex.DocumentOptions{MarshalOptions: ddex.DefaultMarshalOptions})
 			if err != nil {
 				log.Printf("Failed to marshal back to XML: %v", err)
 			} else {
-				output = append([]byte(xml.Header), output...)
 				if err := os.WriteFile(outputPath, output, 0644); err != nil {
 					log.Printf("Failed to write output file: %v", err)
 				} else {
@@ -129,14 +125,13 @@ func main() {
 	var pieRequest piev10.PieRequestMessage
 	if err := xml.Unmarshal(data, &pieRequest); err == nil && pieRequest.MessageHeader != nil {
 		fmt.Println("✓ Parsed as PIE v1.0 PieRequestMessage (protobuf)")
-		spew.Dump(&pieRequest)
+		fmt.Print(ddex.TreeString(&pieRequest))
 
 		if outputPath != "" {
-			output, err := xml.MarshalIndent(&pieRequest, "", "  ")
+			output, err := ddex.MarshalDocument(&pieRequest, ddex.DocumentOptions{MarshalOptions: ddex.DefaultMarshalOptions})
 			if err != nil {
 				log.Printf("Failed to marshal back to XML: %v", err)
 			} else {
-				output = append([]byte(xml.Header), output...)
 				if err := os.WriteFile(outputPath, output, 0644); err != nil {
 					log.Printf("Failed to write output file: %v", err)
 				} else {
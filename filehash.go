@@ -0,0 +1,113 @@
+package ddex
+
+import (
+	"crypto/md5"
+	"crypto/sha1"
+	"crypto/sha256"
+	"crypto/sha512"
+	"encoding/base64"
+	"encoding/hex"
+	"fmt"
+	"hash"
+	"hash/crc32"
+	"io"
+	"strings"
+
+	ernv432 "github.com/alecsavvy/ddex-proto/gen/ddex/ern/v432"
+)
+
+// hashAlgorithms creates the hash of each HashSum algorithm VerifyFileHashes can compute, keyed by the
+// AVS value uppercased without separators (SHA-256 is SHA256)
+var hashAlgorithms = map[string]func() hash.Hash{
+	"CRC32":  func() hash.Hash { return crc32.NewIEEE() },
+	"MD5":    md5.New,
+	"SHA":    sha1.New,
+	"SHA1":   sha1.New,
+	"SHA224": sha256.New224,
+	"SHA256": sha256.New,
+	"SHA384": sha512.New384,
+	"SHA512": sha512.New,
+}
+
+// HashError describes a File whose content could not be verified against its declared HashSum
+type HashError struct {
+	// ResourceReference is the reference of the resource the File belongs to, e.g. A1
+	ResourceReference string
+	// URI is the File's URI
+	URI string
+	// Algorithm is the declared HashSum algorithm
+	Algorithm string
+	// Expected is the declared HashSumValue and Actual the computed one, set for mismatches
+	Expected string
+	Actual   string
+	// Message explains the problem
+	Message string
+}
+
+// Error implements the error interface
+func (e HashError) Error() string {
+	return fmt.Sprintf("%s (%s): %s", e.URI, e.ResourceReference, e.Message)
+}
+
+// VerifyFileHashes checks the content of every technical details File that declares a HashSum against it.
+// The content is read through resolve, called with the File's URI. The computed hash is compared with the
+// HashSumValue as hex (case-insensitively) or base64. Supported algorithms are CRC32, MD5, SHA/SHA1 and
+// SHA-224, -256, -384 and -512; Files with another algorithm, or whose content cannot be resolved, are
+// reported as well. Files without a URI or HashSum are skipped (see ValidateTechnicalReferences).
+func VerifyFileHashes(msg *ernv432.NewReleaseMessage, resolve func(uri string) (io.Reader, error)) []HashError {
+	var errs []HashError
+	for _, resource := range technicalFiles(msg) {
+		for _, file := range resource.files {
+			uri := strings.TrimSpace(file.GetURI())
+			hashSum := file.GetHashSum()
+			if uri == "" || hashSum == nil {
+				continue
+			}
+			if err := verifyFileHash(uri, hashSum, resolve); err != nil {
+				err.ResourceReference = resource.reference
+				errs = append(errs, *err)
+			}
+		}
+	}
+	return errs
+}
+
+// verifyFileHash hashes the content at uri with the algorithm of hashSum and compares it with the declared value
+func verifyFileHash(uri string, hashSum *ernv432.DetailedHashSum, resolve func(uri string) (io.Reader, error)) *HashError {
+	algorithm := strings.TrimSpace(hashSum.GetAlgorithm().GetValue())
+	expected := strings.TrimSpace(hashSum.GetHashSumValue())
+	hashErr := &HashError{URI: uri, Algorithm: algorithm, Expected: expected}
+
+	newHash, ok := hashAlgorithms[strings.NewReplacer("-", "", "_", "").Replace(strings.ToUpper(algorithm))]
+	if !ok {
+		hashErr.Message = fmt.Sprintf("cannot verify HashSum algorithm %q", algorithm)
+		return hashErr
+	}
+	if expected == "" {
+		hashErr.Message = "HashSum has no HashSumValue"
+		return hashErr
+	}
+
+	reader, err := resolve(uri)
+	if err != nil {
+		hashErr.Message = fmt.Sprintf("cannot resolve file: %v", err)
+		return hashErr
+	}
+	if closer, ok := reader.(io.Closer); ok {
+		defer closer.Close()
+	}
+	h := newHash()
+	if _, err := io.Copy(h, reader); err != nil {
+		hashErr.Message = fmt.Sprintf("cannot read file: %v", err)
+		return hashErr
+	}
+
+	sum := h.Sum(nil)
+	actual := hex.EncodeToString(sum)
+	if strings.EqualFold(expected, actual) || expected == base64.StdEncoding.EncodeToString(sum) {
+		return nil
+	}
+	hashErr.Actual = actual
+	hashErr.Message = fmt.Sprintf("%s hash mismatch", algorithm)
+	return hashErr
+}
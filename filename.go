@@ -0,0 +1,123 @@
+package ddex
+
+import (
+	"fmt"
+	"reflect"
+)
+
+// CanonicalFileName returns the delivery file name of a release message of any supported ERN version,
+// named after the identifier of its main release as the DDEX choreographies name deliveries:
+// <GRid>_<MessageName>.xml, or <ICPN>_<MessageName>.xml when the release has no GRid, e.g.
+// 00094631432057_NewReleaseMessage.xml. The main release is the Release of ERN 4, the one flagged
+// IsMainRelease in ERN 3 (else the first that is not a TrackRelease) and the PurgedRelease of a
+// PurgeReleaseMessage. It returns an error for other messages and releases with neither identifier.
+func CanonicalFileName(msg interface{}) (string, error) {
+	v := reflect.ValueOf(msg)
+	for v.Kind() == reflect.Ptr || v.Kind() == reflect.Interface {
+		if v.IsNil() {
+			return "", fmt.Errorf("message is nil")
+		}
+		v = v.Elem()
+	}
+	if v.Kind() != reflect.Struct {
+		return "", fmt.Errorf("%T is not a DDEX message", msg)
+	}
+	messageName := v.Type().Name()
+
+	var release reflect.Value
+	if purged := v.FieldByName("PurgedRelease"); purged.IsValid() {
+		release = purged
+	} else if releaseList := indirectStruct(v.FieldByName("ReleaseList")); releaseList.IsValid() {
+		release = mainReleaseValue(releaseList.FieldByName("Release"))
+	}
+	release = indirectStruct(release)
+	if !release.IsValid() {
+		return "", fmt.Errorf("%s has no main release", messageName)
+	}
+
+	grid, icpn := releaseIdentifiers(release.FieldByName("ReleaseId"))
+	switch {
+	case grid != "":
+		return grid + "_" + messageName + ".xml", nil
+	case icpn != "":
+		return icpn + "_" + messageName + ".xml", nil
+	}
+	return "", fmt.Errorf("main release of %s has neither a GRid nor an ICPN", messageName)
+}
+
+// indirectStruct dereferences a pointer to a struct, returning the zero Value for nil and non-structs
+func indirectStruct(v reflect.Value) reflect.Value {
+	for v.Kind() == reflect.Ptr || v.Kind() == reflect.Interface {
+		if v.IsNil() {
+			return reflect.Value{}
+		}
+		v = v.Elem()
+	}
+	if v.Kind() != reflect.Struct {
+		return reflect.Value{}
+	}
+	return v
+}
+
+// mainReleaseValue returns the main release of a ReleaseList's Release field: the field itself in
+// ERN 4, in ERN 3 the release flagged IsMainRelease, else the first that is not a TrackRelease, else the
+// first (like mainRelease)
+func mainReleaseValue(releases reflect.Value) reflect.Value {
+	if releases.Kind() != reflect.Slice {
+		return releases
+	}
+	if releases.Len() == 0 {
+		return reflect.Value{}
+	}
+	for i := 0; i < releases.Len(); i++ {
+		if release := indirectStruct(releases.Index(i)); release.IsValid() && release.FieldByName("IsMainRelease").Kind() == reflect.Bool && release.FieldByName("IsMainRelease").Bool() {
+			return release
+		}
+	}
+	for i := 0; i < releases.Len(); i++ {
+		release := indirectStruct(releases.Index(i))
+		if !release.IsValid() {
+			continue
+		}
+		isTrack := false
+		if releaseTypes := release.FieldByName("ReleaseType"); releaseTypes.Kind() == reflect.Slice {
+			for j := 0; j < releaseTypes.Len(); j++ {
+				if releaseType := indirectStruct(releaseTypes.Index(j)); releaseType.IsValid() {
+					isTrack = isTrack || stringField(releaseType, "Value") == "TrackRelease"
+				}
+			}
+		}
+		if !isTrack {
+			return release
+		}
+	}
+	return releases.Index(0)
+}
+
+// releaseIdentifiers returns the first GRid and ICPN of a ReleaseId field, a single ReleaseId in ERN 4
+// and a list of them in ERN 3, where the ICPN is an element with a Value
+func releaseIdentifiers(ids reflect.Value) (grid, icpn string) {
+	if !ids.IsValid() {
+		return "", ""
+	}
+	if ids.Kind() != reflect.Slice {
+		ids = reflect.Append(reflect.MakeSlice(reflect.SliceOf(ids.Type()), 0, 1), ids)
+	}
+	for i := 0; i < ids.Len(); i++ {
+		id := indirectStruct(ids.Index(i))
+		if !id.IsValid() {
+			continue
+		}
+		if grid == "" {
+			grid = stringField(id, "GRid")
+		}
+		if icpn == "" {
+			if value := indirectStruct(id.FieldByName("ICPN")); value.IsValid() {
+				icpn = stringField(value, "Value")
+			} else {
+				icpn = stringField(id, "ICPN")
+			}
+		}
+	}
+	return grid, icpn
+}
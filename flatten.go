@@ -0,0 +1,153 @@
+package ddex
+
+import (
+	"reflect"
+	"sort"
+	"strings"
+)
+
+// ReleaseRow is the flat summary of one release of a message, as written by ddex flatten
+type ReleaseRow struct {
+	MessageId        string
+	ReleaseReference string
+	Title            string
+	DisplayArtist    string
+	ICPN             string
+	ReleaseType      string
+	// TrackCount is the number of ResourceGroupContentItems of the release, or for releases without
+	// resource groups (such as ERN 4 TrackReleases) the number of resources it references
+	TrackCount int
+	// TerritoryCount is the number of distinct TerritoryCodes in the deals of the release
+	TerritoryCount int
+}
+
+// FlattenReleases summarizes each release in the ReleaseList of any generated release notification, in
+// document order. The title is the one without a LanguageAndScriptCode if there is one (see
+// LocalizedTitles), otherwise that of the first language in sorted order; DisplayArtist, ICPN and
+// ReleaseType are the first values present. Messages without a ReleaseList have no rows.
+func FlattenReleases(msg interface{}) []ReleaseRow {
+	v := reflect.ValueOf(msg)
+	for v.Kind() == reflect.Ptr || v.Kind() == reflect.Interface {
+		if v.IsNil() {
+			return nil
+		}
+		v = v.Elem()
+	}
+	if v.Kind() != reflect.Struct {
+		return nil
+	}
+	releaseList := v.FieldByName("ReleaseList")
+	if !releaseList.IsValid() || releaseList.Kind() != reflect.Ptr || releaseList.IsNil() {
+		return nil
+	}
+
+	messageId := ""
+	if header, err := GetHeader(msg); err == nil {
+		messageId = header.GetMessageId()
+	}
+	titles := LocalizedTitles(msg)
+	territories := releaseDealTerritories(v.FieldByName("DealList"))
+
+	var rows []ReleaseRow
+	list := releaseList.Elem()
+	for i := 0; i < list.NumField(); i++ {
+		if _, ok := xmlFieldOf(list.Type().Field(i)); !ok {
+			continue
+		}
+		for _, release := range structElements(list.Field(i)) {
+			reference := titledReference(release)
+			if reference == "" {
+				continue
+			}
+			row := ReleaseRow{
+				MessageId:        messageId,
+				ReleaseReference: reference,
+				Title:            preferredTitle(titles[reference]),
+				TrackCount:       countTracks(release),
+				TerritoryCount:   len(territories[reference]),
+			}
+			walkScalars(release.Addr().Interface(), func(path string, field xmlField, value reflect.Value) {
+				if value.Kind() != reflect.String {
+					return
+				}
+				text := strings.TrimSpace(value.String())
+				// Paths are rooted at the release's own element, e.g. /Release/ReleaseId/ICPN
+				steps := strings.Split(path, "/")[2:]
+				switch {
+				case row.DisplayArtist == "" && strings.HasSuffix(path, "/DisplayArtistName"):
+					row.DisplayArtist = text
+				case row.ICPN == "" && strings.Join(steps, "/") == "ReleaseId/ICPN":
+					row.ICPN = text
+				case row.ReleaseType == "" && strings.Join(steps, "/") == "ReleaseType":
+					row.ReleaseType = text
+				}
+			})
+			rows = append(rows, row)
+		}
+	}
+	return rows
+}
+
+// preferredTitle picks the title without a language, or the first by language
+func preferredTitle(byLanguage map[string]string) string {
+	if title, ok := byLanguage[""]; ok {
+		return title
+	}
+	languages := make([]string, 0, len(byLanguage))
+	for language := range byLanguage {
+		languages = append(languages, language)
+	}
+	sort.Strings(languages)
+	if len(languages) == 0 {
+		return ""
+	}
+	return byLanguage[languages[0]]
+}
+
+// countTracks counts the ResourceGroupContentItems of a release, falling back to its distinct resource
+// references
+func countTracks(release reflect.Value) int {
+	items := 0
+	references := make(map[string]bool)
+	walkScalars(release.Addr().Interface(), func(path string, field xmlField, value reflect.Value) {
+		if value.Kind() != reflect.String {
+			return
+		}
+		switch {
+		case strings.HasSuffix(path, "/ResourceGroupContentItem/ReleaseResourceReference"):
+			items++
+		case strings.HasSuffix(path, "/ReleaseResourceReference"):
+			references[strings.TrimSpace(value.String())] = true
+		}
+	})
+	if items > 0 {
+		return items
+	}
+	return len(references)
+}
+
+// releaseDealTerritories collects the distinct TerritoryCodes of the ReleaseDeals in a DealList by
+// DealReleaseReference
+func releaseDealTerritories(dealList reflect.Value) map[string]map[string]bool {
+	territories := make(map[string]map[string]bool)
+	for _, list := range structElements(dealList) {
+		for _, releaseDeal := range structElements(list.FieldByName("ReleaseDeal")) {
+			codes := make(map[string]bool)
+			walkScalars(releaseDeal.Addr().Interface(), func(path string, field xmlField, value reflect.Value) {
+				if value.Kind() == reflect.String && strings.HasSuffix(path, "/TerritoryCode") {
+					codes[strings.TrimSpace(value.String())] = true
+				}
+			})
+			for _, reference := range stringValues(releaseDeal.FieldByName("DealReleaseReference")) {
+				reference = strings.TrimSpace(reference)
+				if territories[reference] == nil {
+					territories[reference] = make(map[string]bool)
+				}
+				for code := range codes {
+					territories[reference][code] = true
+				}
+			}
+		}
+	}
+	return territories
+}
@@ -7,6 +7,10 @@ import "strings"
 // XMLString returns the XML string representation of AccessLimitation
 func (e AccessLimitation) XMLString() string {
 	switch e {
+	case AccessLimitation_ACCESS_LIMITATION_NOLIMITATION:
+		return "NOLIMITATION"
+	case AccessLimitation_ACCESS_LIMITATION_PRIVATEACCESSONLY:
+		return "PRIVATEACCESSONLY"
 	default:
 		return ""
 	}
@@ -16,6 +20,10 @@ func (e AccessLimitation) XMLString() string {
 func ParseAccessLimitationString(s string) (AccessLimitation, bool) {
 	s = strings.ToUpper(s)
 	switch s {
+	case "NOLIMITATION":
+		return AccessLimitation_ACCESS_LIMITATION_NOLIMITATION, true
+	case "PRIVATEACCESSONLY":
+		return AccessLimitation_ACCESS_LIMITATION_PRIVATEACCESSONLY, true
 	default:
 		return AccessLimitation(0), false
 	}
@@ -24,6 +32,16 @@ func ParseAccessLimitationString(s string) (AccessLimitation, bool) {
 // XMLString returns the XML string representation of AdministratingRecordCompanyRole
 func (e AdministratingRecordCompanyRole) XMLString() string {
 	switch e {
+	case AdministratingRecordCompanyRole_ADMINISTRATING_RECORD_COMPANY_ROLE_DESIGNATEDDSRMESSAGERECIPIENT:
+		return "DESIGNATEDDSRMESSAGERECIPIENT"
+	case AdministratingRecordCompanyRole_ADMINISTRATING_RECORD_COMPANY_ROLE_RIGHTSADMINISTRATOR:
+		return "RIGHTSADMINISTRATOR"
+	case AdministratingRecordCompanyRole_ADMINISTRATING_RECORD_COMPANY_ROLE_ROYALTYADMINISTRATOR:
+		return "ROYALTYADMINISTRATOR"
+	case AdministratingRecordCompanyRole_ADMINISTRATING_RECORD_COMPANY_ROLE_UNKNOWN:
+		return "UNKNOWN"
+	case AdministratingRecordCompanyRole_ADMINISTRATING_RECORD_COMPANY_ROLE_USERDEFINED:
+		return "USERDEFINED"
 	default:
 		return ""
 	}
@@ -33,6 +51,16 @@ func (e AdministratingRecordCompanyRole) XMLString() string {
 func ParseAdministratingRecordCompanyRoleString(s string) (AdministratingRecordCompanyRole, bool) {
 	s = strings.ToUpper(s)
 	switch s {
+	case "DESIGNATEDDSRMESSAGERECIPIENT":
+		return AdministratingRecordCompanyRole_ADMINISTRATING_RECORD_COMPANY_ROLE_DESIGNATEDDSRMESSAGERECIPIENT, true
+	case "RIGHTSADMINISTRATOR":
+		return AdministratingRecordCompanyRole_ADMINISTRATING_RECORD_COMPANY_ROLE_RIGHTSADMINISTRATOR, true
+	case "ROYALTYADMINISTRATOR":
+		return AdministratingRecordCompanyRole_ADMINISTRATING_RECORD_COMPANY_ROLE_ROYALTYADMINISTRATOR, true
+	case "UNKNOWN":
+		return AdministratingRecordCompanyRole_ADMINISTRATING_RECORD_COMPANY_ROLE_UNKNOWN, true
+	case "USERDEFINED":
+		return AdministratingRecordCompanyRole_ADMINISTRATING_RECORD_COMPANY_ROLE_USERDEFINED, true
 	default:
 		return AdministratingRecordCompanyRole(0), false
 	}
@@ -41,15 +69,2152 @@ func ParseAdministratingRecordCompanyRoleString(s string) (AdministratingRecordC
 // XMLString returns the XML string representation of AllTerritoryCode
 func (e AllTerritoryCode) XMLString() string {
 	switch e {
+	case AllTerritoryCode_ALL_TERRITORY_CODE_AD:
+		return "AD"
+	case AllTerritoryCode_ALL_TERRITORY_CODE_AE:
+		return "AE"
+	case AllTerritoryCode_ALL_TERRITORY_CODE_AF:
+		return "AF"
+	case AllTerritoryCode_ALL_TERRITORY_CODE_AG:
+		return "AG"
+	case AllTerritoryCode_ALL_TERRITORY_CODE_AI:
+		return "AI"
+	case AllTerritoryCode_ALL_TERRITORY_CODE_AL:
+		return "AL"
+	case AllTerritoryCode_ALL_TERRITORY_CODE_AM:
+		return "AM"
+	case AllTerritoryCode_ALL_TERRITORY_CODE_AN:
+		return "AN"
+	case AllTerritoryCode_ALL_TERRITORY_CODE_AO:
+		return "AO"
+	case AllTerritoryCode_ALL_TERRITORY_CODE_AQ:
+		return "AQ"
+	case AllTerritoryCode_ALL_TERRITORY_CODE_AR:
+		return "AR"
+	case AllTerritoryCode_ALL_TERRITORY_CODE_AS:
+		return "AS"
+	case AllTerritoryCode_ALL_TERRITORY_CODE_AT:
+		return "AT"
+	case AllTerritoryCode_ALL_TERRITORY_CODE_AU:
+		return "AU"
+	case AllTerritoryCode_ALL_TERRITORY_CODE_AW:
+		return "AW"
+	case AllTerritoryCode_ALL_TERRITORY_CODE_AX:
+		return "AX"
+	case AllTerritoryCode_ALL_TERRITORY_CODE_AZ:
+		return "AZ"
+	case AllTerritoryCode_ALL_TERRITORY_CODE_BA:
+		return "BA"
+	case AllTerritoryCode_ALL_TERRITORY_CODE_BB:
+		return "BB"
+	case AllTerritoryCode_ALL_TERRITORY_CODE_BD:
+		return "BD"
+	case AllTerritoryCode_ALL_TERRITORY_CODE_BE:
+		return "BE"
+	case AllTerritoryCode_ALL_TERRITORY_CODE_BF:
+		return "BF"
+	case AllTerritoryCode_ALL_TERRITORY_CODE_BG:
+		return "BG"
+	case AllTerritoryCode_ALL_TERRITORY_CODE_BH:
+		return "BH"
+	case AllTerritoryCode_ALL_TERRITORY_CODE_BI:
+		return "BI"
+	case AllTerritoryCode_ALL_TERRITORY_CODE_BJ:
+		return "BJ"
+	case AllTerritoryCode_ALL_TERRITORY_CODE_BL:
+		return "BL"
+	case AllTerritoryCode_ALL_TERRITORY_CODE_BM:
+		return "BM"
+	case AllTerritoryCode_ALL_TERRITORY_CODE_BN:
+		return "BN"
+	case AllTerritoryCode_ALL_TERRITORY_CODE_BO:
+		return "BO"
+	case AllTerritoryCode_ALL_TERRITORY_CODE_BQ:
+		return "BQ"
+	case AllTerritoryCode_ALL_TERRITORY_CODE_BR:
+		return "BR"
+	case AllTerritoryCode_ALL_TERRITORY_CODE_BS:
+		return "BS"
+	case AllTerritoryCode_ALL_TERRITORY_CODE_BT:
+		return "BT"
+	case AllTerritoryCode_ALL_TERRITORY_CODE_BV:
+		return "BV"
+	case AllTerritoryCode_ALL_TERRITORY_CODE_BW:
+		return "BW"
+	case AllTerritoryCode_ALL_TERRITORY_CODE_BY:
+		return "BY"
+	case AllTerritoryCode_ALL_TERRITORY_CODE_BZ:
+		return "BZ"
+	case AllTerritoryCode_ALL_TERRITORY_CODE_CA:
+		return "CA"
+	case AllTerritoryCode_ALL_TERRITORY_CODE_CC:
+		return "CC"
+	case AllTerritoryCode_ALL_TERRITORY_CODE_CD:
+		return "CD"
+	case AllTerritoryCode_ALL_TERRITORY_CODE_CF:
+		return "CF"
+	case AllTerritoryCode_ALL_TERRITORY_CODE_CG:
+		return "CG"
+	case AllTerritoryCode_ALL_TERRITORY_CODE_CH:
+		return "CH"
+	case AllTerritoryCode_ALL_TERRITORY_CODE_CI:
+		return "CI"
+	case AllTerritoryCode_ALL_TERRITORY_CODE_CK:
+		return "CK"
+	case AllTerritoryCode_ALL_TERRITORY_CODE_CL:
+		return "CL"
+	case AllTerritoryCode_ALL_TERRITORY_CODE_CM:
+		return "CM"
+	case AllTerritoryCode_ALL_TERRITORY_CODE_CN:
+		return "CN"
+	case AllTerritoryCode_ALL_TERRITORY_CODE_CO:
+		return "CO"
+	case AllTerritoryCode_ALL_TERRITORY_CODE_CR:
+		return "CR"
+	case AllTerritoryCode_ALL_TERRITORY_CODE_CS:
+		return "CS"
+	case AllTerritoryCode_ALL_TERRITORY_CODE_CU:
+		return "CU"
+	case AllTerritoryCode_ALL_TERRITORY_CODE_CV:
+		return "CV"
+	case AllTerritoryCode_ALL_TERRITORY_CODE_CW:
+		return "CW"
+	case AllTerritoryCode_ALL_TERRITORY_CODE_CX:
+		return "CX"
+	case AllTerritoryCode_ALL_TERRITORY_CODE_CY:
+		return "CY"
+	case AllTerritoryCode_ALL_TERRITORY_CODE_CZ:
+		return "CZ"
+	case AllTerritoryCode_ALL_TERRITORY_CODE_DE:
+		return "DE"
+	case AllTerritoryCode_ALL_TERRITORY_CODE_DJ:
+		return "DJ"
+	case AllTerritoryCode_ALL_TERRITORY_CODE_DK:
+		return "DK"
+	case AllTerritoryCode_ALL_TERRITORY_CODE_DM:
+		return "DM"
+	case AllTerritoryCode_ALL_TERRITORY_CODE_DO:
+		return "DO"
+	case AllTerritoryCode_ALL_TERRITORY_CODE_DZ:
+		return "DZ"
+	case AllTerritoryCode_ALL_TERRITORY_CODE_EC:
+		return "EC"
+	case AllTerritoryCode_ALL_TERRITORY_CODE_EE:
+		return "EE"
+	case AllTerritoryCode_ALL_TERRITORY_CODE_EG:
+		return "EG"
+	case AllTerritoryCode_ALL_TERRITORY_CODE_EH:
+		return "EH"
+	case AllTerritoryCode_ALL_TERRITORY_CODE_ER:
+		return "ER"
+	case AllTerritoryCode_ALL_TERRITORY_CODE_ES:
+		return "ES"
+	case AllTerritoryCode_ALL_TERRITORY_CODE_ES_CE:
+		return "ES_CE"
+	case AllTerritoryCode_ALL_TERRITORY_CODE_ES_CN:
+		return "ES_CN"
+	case AllTerritoryCode_ALL_TERRITORY_CODE_ES_ML:
+		return "ES_ML"
+	case AllTerritoryCode_ALL_TERRITORY_CODE_ET:
+		return "ET"
+	case AllTerritoryCode_ALL_TERRITORY_CODE_FI:
+		return "FI"
+	case AllTerritoryCode_ALL_TERRITORY_CODE_FJ:
+		return "FJ"
+	case AllTerritoryCode_ALL_TERRITORY_CODE_FK:
+		return "FK"
+	case AllTerritoryCode_ALL_TERRITORY_CODE_FM:
+		return "FM"
+	case AllTerritoryCode_ALL_TERRITORY_CODE_FO:
+		return "FO"
+	case AllTerritoryCode_ALL_TERRITORY_CODE_FR:
+		return "FR"
+	case AllTerritoryCode_ALL_TERRITORY_CODE_GA:
+		return "GA"
+	case AllTerritoryCode_ALL_TERRITORY_CODE_GB:
+		return "GB"
+	case AllTerritoryCode_ALL_TERRITORY_CODE_GD:
+		return "GD"
+	case AllTerritoryCode_ALL_TERRITORY_CODE_GE:
+		return "GE"
+	case AllTerritoryCode_ALL_TERRITORY_CODE_GF:
+		return "GF"
+	case AllTerritoryCode_ALL_TERRITORY_CODE_GG:
+		return "GG"
+	case AllTerritoryCode_ALL_TERRITORY_CODE_GH:
+		return "GH"
+	case AllTerritoryCode_ALL_TERRITORY_CODE_GI:
+		return "GI"
+	case AllTerritoryCode_ALL_TERRITORY_CODE_GL:
+		return "GL"
+	case AllTerritoryCode_ALL_TERRITORY_CODE_GM:
+		return "GM"
+	case AllTerritoryCode_ALL_TERRITORY_CODE_GN:
+		return "GN"
+	case AllTerritoryCode_ALL_TERRITORY_CODE_GP:
+		return "GP"
+	case AllTerritoryCode_ALL_TERRITORY_CODE_GQ:
+		return "GQ"
+	case AllTerritoryCode_ALL_TERRITORY_CODE_GR:
+		return "GR"
+	case AllTerritoryCode_ALL_TERRITORY_CODE_GS:
+		return "GS"
+	case AllTerritoryCode_ALL_TERRITORY_CODE_GT:
+		return "GT"
+	case AllTerritoryCode_ALL_TERRITORY_CODE_GU:
+		return "GU"
+	case AllTerritoryCode_ALL_TERRITORY_CODE_GW:
+		return "GW"
+	case AllTerritoryCode_ALL_TERRITORY_CODE_GY:
+		return "GY"
+	case AllTerritoryCode_ALL_TERRITORY_CODE_HK:
+		return "HK"
+	case AllTerritoryCode_ALL_TERRITORY_CODE_HM:
+		return "HM"
+	case AllTerritoryCode_ALL_TERRITORY_CODE_HN:
+		return "HN"
+	case AllTerritoryCode_ALL_TERRITORY_CODE_HR:
+		return "HR"
+	case AllTerritoryCode_ALL_TERRITORY_CODE_HT:
+		return "HT"
+	case AllTerritoryCode_ALL_TERRITORY_CODE_HU:
+		return "HU"
+	case AllTerritoryCode_ALL_TERRITORY_CODE_ID:
+		return "ID"
+	case AllTerritoryCode_ALL_TERRITORY_CODE_IE:
+		return "IE"
+	case AllTerritoryCode_ALL_TERRITORY_CODE_IL:
+		return "IL"
+	case AllTerritoryCode_ALL_TERRITORY_CODE_IM:
+		return "IM"
+	case AllTerritoryCode_ALL_TERRITORY_CODE_IN:
+		return "IN"
+	case AllTerritoryCode_ALL_TERRITORY_CODE_IO:
+		return "IO"
+	case AllTerritoryCode_ALL_TERRITORY_CODE_IQ:
+		return "IQ"
+	case AllTerritoryCode_ALL_TERRITORY_CODE_IR:
+		return "IR"
+	case AllTerritoryCode_ALL_TERRITORY_CODE_IS:
+		return "IS"
+	case AllTerritoryCode_ALL_TERRITORY_CODE_IT:
+		return "IT"
+	case AllTerritoryCode_ALL_TERRITORY_CODE_JE:
+		return "JE"
+	case AllTerritoryCode_ALL_TERRITORY_CODE_JM:
+		return "JM"
+	case AllTerritoryCode_ALL_TERRITORY_CODE_JO:
+		return "JO"
+	case AllTerritoryCode_ALL_TERRITORY_CODE_JP:
+		return "JP"
+	case AllTerritoryCode_ALL_TERRITORY_CODE_KE:
+		return "KE"
+	case AllTerritoryCode_ALL_TERRITORY_CODE_KG:
+		return "KG"
+	case AllTerritoryCode_ALL_TERRITORY_CODE_KH:
+		return "KH"
+	case AllTerritoryCode_ALL_TERRITORY_CODE_KI:
+		return "KI"
+	case AllTerritoryCode_ALL_TERRITORY_CODE_KM:
+		return "KM"
+	case AllTerritoryCode_ALL_TERRITORY_CODE_KN:
+		return "KN"
+	case AllTerritoryCode_ALL_TERRITORY_CODE_KP:
+		return "KP"
+	case AllTerritoryCode_ALL_TERRITORY_CODE_KR:
+		return "KR"
+	case AllTerritoryCode_ALL_TERRITORY_CODE_KW:
+		return "KW"
+	case AllTerritoryCode_ALL_TERRITORY_CODE_KY:
+		return "KY"
+	case AllTerritoryCode_ALL_TERRITORY_CODE_KZ:
+		return "KZ"
+	case AllTerritoryCode_ALL_TERRITORY_CODE_LA:
+		return "LA"
+	case AllTerritoryCode_ALL_TERRITORY_CODE_LB:
+		return "LB"
+	case AllTerritoryCode_ALL_TERRITORY_CODE_LC:
+		return "LC"
+	case AllTerritoryCode_ALL_TERRITORY_CODE_LI:
+		return "LI"
+	case AllTerritoryCode_ALL_TERRITORY_CODE_LK:
+		return "LK"
+	case AllTerritoryCode_ALL_TERRITORY_CODE_LR:
+		return "LR"
+	case AllTerritoryCode_ALL_TERRITORY_CODE_LS:
+		return "LS"
+	case AllTerritoryCode_ALL_TERRITORY_CODE_LT:
+		return "LT"
+	case AllTerritoryCode_ALL_TERRITORY_CODE_LU:
+		return "LU"
+	case AllTerritoryCode_ALL_TERRITORY_CODE_LV:
+		return "LV"
+	case AllTerritoryCode_ALL_TERRITORY_CODE_LY:
+		return "LY"
+	case AllTerritoryCode_ALL_TERRITORY_CODE_MA:
+		return "MA"
+	case AllTerritoryCode_ALL_TERRITORY_CODE_MC:
+		return "MC"
+	case AllTerritoryCode_ALL_TERRITORY_CODE_MD:
+		return "MD"
+	case AllTerritoryCode_ALL_TERRITORY_CODE_ME:
+		return "ME"
+	case AllTerritoryCode_ALL_TERRITORY_CODE_MF:
+		return "MF"
+	case AllTerritoryCode_ALL_TERRITORY_CODE_MG:
+		return "MG"
+	case AllTerritoryCode_ALL_TERRITORY_CODE_MH:
+		return "MH"
+	case AllTerritoryCode_ALL_TERRITORY_CODE_MK:
+		return "MK"
+	case AllTerritoryCode_ALL_TERRITORY_CODE_ML:
+		return "ML"
+	case AllTerritoryCode_ALL_TERRITORY_CODE_MM:
+		return "MM"
+	case AllTerritoryCode_ALL_TERRITORY_CODE_MN:
+		return "MN"
+	case AllTerritoryCode_ALL_TERRITORY_CODE_MO:
+		return "MO"
+	case AllTerritoryCode_ALL_TERRITORY_CODE_MP:
+		return "MP"
+	case AllTerritoryCode_ALL_TERRITORY_CODE_MQ:
+		return "MQ"
+	case AllTerritoryCode_ALL_TERRITORY_CODE_MR:
+		return "MR"
+	case AllTerritoryCode_ALL_TERRITORY_CODE_MS:
+		return "MS"
+	case AllTerritoryCode_ALL_TERRITORY_CODE_MT:
+		return "MT"
+	case AllTerritoryCode_ALL_TERRITORY_CODE_MU:
+		return "MU"
+	case AllTerritoryCode_ALL_TERRITORY_CODE_MV:
+		return "MV"
+	case AllTerritoryCode_ALL_TERRITORY_CODE_MW:
+		return "MW"
+	case AllTerritoryCode_ALL_TERRITORY_CODE_MX:
+		return "MX"
+	case AllTerritoryCode_ALL_TERRITORY_CODE_MY:
+		return "MY"
+	case AllTerritoryCode_ALL_TERRITORY_CODE_MZ:
+		return "MZ"
+	case AllTerritoryCode_ALL_TERRITORY_CODE_NA:
+		return "NA"
+	case AllTerritoryCode_ALL_TERRITORY_CODE_NC:
+		return "NC"
+	case AllTerritoryCode_ALL_TERRITORY_CODE_NE:
+		return "NE"
+	case AllTerritoryCode_ALL_TERRITORY_CODE_NF:
+		return "NF"
+	case AllTerritoryCode_ALL_TERRITORY_CODE_NG:
+		return "NG"
+	case AllTerritoryCode_ALL_TERRITORY_CODE_NI:
+		return "NI"
+	case AllTerritoryCode_ALL_TERRITORY_CODE_NL:
+		return "NL"
+	case AllTerritoryCode_ALL_TERRITORY_CODE_NO:
+		return "NO"
+	case AllTerritoryCode_ALL_TERRITORY_CODE_NP:
+		return "NP"
+	case AllTerritoryCode_ALL_TERRITORY_CODE_NR:
+		return "NR"
+	case AllTerritoryCode_ALL_TERRITORY_CODE_NU:
+		return "NU"
+	case AllTerritoryCode_ALL_TERRITORY_CODE_NZ:
+		return "NZ"
+	case AllTerritoryCode_ALL_TERRITORY_CODE_OM:
+		return "OM"
+	case AllTerritoryCode_ALL_TERRITORY_CODE_PA:
+		return "PA"
+	case AllTerritoryCode_ALL_TERRITORY_CODE_PE:
+		return "PE"
+	case AllTerritoryCode_ALL_TERRITORY_CODE_PF:
+		return "PF"
+	case AllTerritoryCode_ALL_TERRITORY_CODE_PG:
+		return "PG"
+	case AllTerritoryCode_ALL_TERRITORY_CODE_PH:
+		return "PH"
+	case AllTerritoryCode_ALL_TERRITORY_CODE_PK:
+		return "PK"
+	case AllTerritoryCode_ALL_TERRITORY_CODE_PL:
+		return "PL"
+	case AllTerritoryCode_ALL_TERRITORY_CODE_PM:
+		return "PM"
+	case AllTerritoryCode_ALL_TERRITORY_CODE_PN:
+		return "PN"
+	case AllTerritoryCode_ALL_TERRITORY_CODE_PR:
+		return "PR"
+	case AllTerritoryCode_ALL_TERRITORY_CODE_PS:
+		return "PS"
+	case AllTerritoryCode_ALL_TERRITORY_CODE_PT:
+		return "PT"
+	case AllTerritoryCode_ALL_TERRITORY_CODE_PW:
+		return "PW"
+	case AllTerritoryCode_ALL_TERRITORY_CODE_PY:
+		return "PY"
+	case AllTerritoryCode_ALL_TERRITORY_CODE_QA:
+		return "QA"
+	case AllTerritoryCode_ALL_TERRITORY_CODE_RE:
+		return "RE"
+	case AllTerritoryCode_ALL_TERRITORY_CODE_RO:
+		return "RO"
+	case AllTerritoryCode_ALL_TERRITORY_CODE_RS:
+		return "RS"
+	case AllTerritoryCode_ALL_TERRITORY_CODE_RU:
+		return "RU"
+	case AllTerritoryCode_ALL_TERRITORY_CODE_RW:
+		return "RW"
+	case AllTerritoryCode_ALL_TERRITORY_CODE_SA:
+		return "SA"
+	case AllTerritoryCode_ALL_TERRITORY_CODE_SB:
+		return "SB"
+	case AllTerritoryCode_ALL_TERRITORY_CODE_SC:
+		return "SC"
+	case AllTerritoryCode_ALL_TERRITORY_CODE_SD:
+		return "SD"
+	case AllTerritoryCode_ALL_TERRITORY_CODE_SE:
+		return "SE"
+	case AllTerritoryCode_ALL_TERRITORY_CODE_SG:
+		return "SG"
+	case AllTerritoryCode_ALL_TERRITORY_CODE_SH:
+		return "SH"
+	case AllTerritoryCode_ALL_TERRITORY_CODE_SI:
+		return "SI"
+	case AllTerritoryCode_ALL_TERRITORY_CODE_SJ:
+		return "SJ"
+	case AllTerritoryCode_ALL_TERRITORY_CODE_SK:
+		return "SK"
+	case AllTerritoryCode_ALL_TERRITORY_CODE_SL:
+		return "SL"
+	case AllTerritoryCode_ALL_TERRITORY_CODE_SM:
+		return "SM"
+	case AllTerritoryCode_ALL_TERRITORY_CODE_SN:
+		return "SN"
+	case AllTerritoryCode_ALL_TERRITORY_CODE_SO:
+		return "SO"
+	case AllTerritoryCode_ALL_TERRITORY_CODE_SR:
+		return "SR"
+	case AllTerritoryCode_ALL_TERRITORY_CODE_SS:
+		return "SS"
+	case AllTerritoryCode_ALL_TERRITORY_CODE_ST:
+		return "ST"
+	case AllTerritoryCode_ALL_TERRITORY_CODE_SV:
+		return "SV"
+	case AllTerritoryCode_ALL_TERRITORY_CODE_SX:
+		return "SX"
+	case AllTerritoryCode_ALL_TERRITORY_CODE_SY:
+		return "SY"
+	case AllTerritoryCode_ALL_TERRITORY_CODE_SZ:
+		return "SZ"
+	case AllTerritoryCode_ALL_TERRITORY_CODE_TC:
+		return "TC"
+	case AllTerritoryCode_ALL_TERRITORY_CODE_TD:
+		return "TD"
+	case AllTerritoryCode_ALL_TERRITORY_CODE_TF:
+		return "TF"
+	case AllTerritoryCode_ALL_TERRITORY_CODE_TG:
+		return "TG"
+	case AllTerritoryCode_ALL_TERRITORY_CODE_TH:
+		return "TH"
+	case AllTerritoryCode_ALL_TERRITORY_CODE_TJ:
+		return "TJ"
+	case AllTerritoryCode_ALL_TERRITORY_CODE_TK:
+		return "TK"
+	case AllTerritoryCode_ALL_TERRITORY_CODE_TL:
+		return "TL"
+	case AllTerritoryCode_ALL_TERRITORY_CODE_TM:
+		return "TM"
+	case AllTerritoryCode_ALL_TERRITORY_CODE_TN:
+		return "TN"
+	case AllTerritoryCode_ALL_TERRITORY_CODE_TO:
+		return "TO"
+	case AllTerritoryCode_ALL_TERRITORY_CODE_TR:
+		return "TR"
+	case AllTerritoryCode_ALL_TERRITORY_CODE_TT:
+		return "TT"
+	case AllTerritoryCode_ALL_TERRITORY_CODE_TV:
+		return "TV"
+	case AllTerritoryCode_ALL_TERRITORY_CODE_TW:
+		return "TW"
+	case AllTerritoryCode_ALL_TERRITORY_CODE_TZ:
+		return "TZ"
+	case AllTerritoryCode_ALL_TERRITORY_CODE_UA:
+		return "UA"
+	case AllTerritoryCode_ALL_TERRITORY_CODE_UG:
+		return "UG"
+	case AllTerritoryCode_ALL_TERRITORY_CODE_UM:
+		return "UM"
+	case AllTerritoryCode_ALL_TERRITORY_CODE_US:
+		return "US"
+	case AllTerritoryCode_ALL_TERRITORY_CODE_UY:
+		return "UY"
+	case AllTerritoryCode_ALL_TERRITORY_CODE_UZ:
+		return "UZ"
+	case AllTerritoryCode_ALL_TERRITORY_CODE_VA:
+		return "VA"
+	case AllTerritoryCode_ALL_TERRITORY_CODE_VC:
+		return "VC"
+	case AllTerritoryCode_ALL_TERRITORY_CODE_VE:
+		return "VE"
+	case AllTerritoryCode_ALL_TERRITORY_CODE_VG:
+		return "VG"
+	case AllTerritoryCode_ALL_TERRITORY_CODE_VI:
+		return "VI"
+	case AllTerritoryCode_ALL_TERRITORY_CODE_VN:
+		return "VN"
+	case AllTerritoryCode_ALL_TERRITORY_CODE_VU:
+		return "VU"
+	case AllTerritoryCode_ALL_TERRITORY_CODE_WF:
+		return "WF"
+	case AllTerritoryCode_ALL_TERRITORY_CODE_WS:
+		return "WS"
+	case AllTerritoryCode_ALL_TERRITORY_CODE_YE:
+		return "YE"
+	case AllTerritoryCode_ALL_TERRITORY_CODE_YT:
+		return "YT"
+	case AllTerritoryCode_ALL_TERRITORY_CODE_ZA:
+		return "ZA"
+	case AllTerritoryCode_ALL_TERRITORY_CODE_ZM:
+		return "ZM"
+	case AllTerritoryCode_ALL_TERRITORY_CODE_ZW:
+		return "ZW"
+	case AllTerritoryCode_ALL_TERRITORY_CODE_E_4:
+		return "4"
+	case AllTerritoryCode_ALL_TERRITORY_CODE_E_8:
+		return "8"
+	case AllTerritoryCode_ALL_TERRITORY_CODE_E_12:
+		return "12"
+	case AllTerritoryCode_ALL_TERRITORY_CODE_E_20:
+		return "20"
+	case AllTerritoryCode_ALL_TERRITORY_CODE_E_24:
+		return "24"
+	case AllTerritoryCode_ALL_TERRITORY_CODE_E_28:
+		return "28"
+	case AllTerritoryCode_ALL_TERRITORY_CODE_E_31:
+		return "31"
+	case AllTerritoryCode_ALL_TERRITORY_CODE_E_32:
+		return "32"
+	case AllTerritoryCode_ALL_TERRITORY_CODE_E_36:
+		return "36"
+	case AllTerritoryCode_ALL_TERRITORY_CODE_E_40:
+		return "40"
+	case AllTerritoryCode_ALL_TERRITORY_CODE_E_44:
+		return "44"
+	case AllTerritoryCode_ALL_TERRITORY_CODE_E_48:
+		return "48"
+	case AllTerritoryCode_ALL_TERRITORY_CODE_E_50:
+		return "50"
+	case AllTerritoryCode_ALL_TERRITORY_CODE_E_51:
+		return "51"
+	case AllTerritoryCode_ALL_TERRITORY_CODE_E_52:
+		return "52"
+	case AllTerritoryCode_ALL_TERRITORY_CODE_E_56:
+		return "56"
+	case AllTerritoryCode_ALL_TERRITORY_CODE_E_64:
+		return "64"
+	case AllTerritoryCode_ALL_TERRITORY_CODE_E_68:
+		return "68"
+	case AllTerritoryCode_ALL_TERRITORY_CODE_E_70:
+		return "70"
+	case AllTerritoryCode_ALL_TERRITORY_CODE_E_72:
+		return "72"
+	case AllTerritoryCode_ALL_TERRITORY_CODE_E_76:
+		return "76"
+	case AllTerritoryCode_ALL_TERRITORY_CODE_E_84:
+		return "84"
+	case AllTerritoryCode_ALL_TERRITORY_CODE_E_90:
+		return "90"
+	case AllTerritoryCode_ALL_TERRITORY_CODE_E_96:
+		return "96"
+	case AllTerritoryCode_ALL_TERRITORY_CODE_E_100:
+		return "100"
+	case AllTerritoryCode_ALL_TERRITORY_CODE_E_104:
+		return "104"
+	case AllTerritoryCode_ALL_TERRITORY_CODE_E_108:
+		return "108"
+	case AllTerritoryCode_ALL_TERRITORY_CODE_E_112:
+		return "112"
+	case AllTerritoryCode_ALL_TERRITORY_CODE_E_116:
+		return "116"
+	case AllTerritoryCode_ALL_TERRITORY_CODE_E_120:
+		return "120"
+	case AllTerritoryCode_ALL_TERRITORY_CODE_E_124:
+		return "124"
+	case AllTerritoryCode_ALL_TERRITORY_CODE_E_132:
+		return "132"
+	case AllTerritoryCode_ALL_TERRITORY_CODE_E_140:
+		return "140"
+	case AllTerritoryCode_ALL_TERRITORY_CODE_E_144:
+		return "144"
+	case AllTerritoryCode_ALL_TERRITORY_CODE_E_148:
+		return "148"
+	case AllTerritoryCode_ALL_TERRITORY_CODE_E_152:
+		return "152"
+	case AllTerritoryCode_ALL_TERRITORY_CODE_E_156:
+		return "156"
+	case AllTerritoryCode_ALL_TERRITORY_CODE_E_158:
+		return "158"
+	case AllTerritoryCode_ALL_TERRITORY_CODE_E_170:
+		return "170"
+	case AllTerritoryCode_ALL_TERRITORY_CODE_E_174:
+		return "174"
+	case AllTerritoryCode_ALL_TERRITORY_CODE_E_178:
+		return "178"
+	case AllTerritoryCode_ALL_TERRITORY_CODE_E_180:
+		return "180"
+	case AllTerritoryCode_ALL_TERRITORY_CODE_E_188:
+		return "188"
+	case AllTerritoryCode_ALL_TERRITORY_CODE_E_191:
+		return "191"
+	case AllTerritoryCode_ALL_TERRITORY_CODE_E_192:
+		return "192"
+	case AllTerritoryCode_ALL_TERRITORY_CODE_E_196:
+		return "196"
+	case AllTerritoryCode_ALL_TERRITORY_CODE_E_200:
+		return "200"
+	case AllTerritoryCode_ALL_TERRITORY_CODE_E_203:
+		return "203"
+	case AllTerritoryCode_ALL_TERRITORY_CODE_E_204:
+		return "204"
+	case AllTerritoryCode_ALL_TERRITORY_CODE_E_208:
+		return "208"
+	case AllTerritoryCode_ALL_TERRITORY_CODE_E_212:
+		return "212"
+	case AllTerritoryCode_ALL_TERRITORY_CODE_E_214:
+		return "214"
+	case AllTerritoryCode_ALL_TERRITORY_CODE_E_218:
+		return "218"
+	case AllTerritoryCode_ALL_TERRITORY_CODE_E_222:
+		return "222"
+	case AllTerritoryCode_ALL_TERRITORY_CODE_E_226:
+		return "226"
+	case AllTerritoryCode_ALL_TERRITORY_CODE_E_230:
+		return "230"
+	case AllTerritoryCode_ALL_TERRITORY_CODE_E_231:
+		return "231"
+	case AllTerritoryCode_ALL_TERRITORY_CODE_E_232:
+		return "232"
+	case AllTerritoryCode_ALL_TERRITORY_CODE_E_233:
+		return "233"
+	case AllTerritoryCode_ALL_TERRITORY_CODE_E_242:
+		return "242"
+	case AllTerritoryCode_ALL_TERRITORY_CODE_E_246:
+		return "246"
+	case AllTerritoryCode_ALL_TERRITORY_CODE_E_250:
+		return "250"
+	case AllTerritoryCode_ALL_TERRITORY_CODE_E_258:
+		return "258"
+	case AllTerritoryCode_ALL_TERRITORY_CODE_E_262:
+		return "262"
+	case AllTerritoryCode_ALL_TERRITORY_CODE_E_266:
+		return "266"
+	case AllTerritoryCode_ALL_TERRITORY_CODE_E_268:
+		return "268"
+	case AllTerritoryCode_ALL_TERRITORY_CODE_E_270:
+		return "270"
+	case AllTerritoryCode_ALL_TERRITORY_CODE_E_276:
+		return "276"
+	case AllTerritoryCode_ALL_TERRITORY_CODE_E_278:
+		return "278"
+	case AllTerritoryCode_ALL_TERRITORY_CODE_E_280:
+		return "280"
+	case AllTerritoryCode_ALL_TERRITORY_CODE_E_288:
+		return "288"
+	case AllTerritoryCode_ALL_TERRITORY_CODE_E_296:
+		return "296"
+	case AllTerritoryCode_ALL_TERRITORY_CODE_E_300:
+		return "300"
+	case AllTerritoryCode_ALL_TERRITORY_CODE_E_308:
+		return "308"
+	case AllTerritoryCode_ALL_TERRITORY_CODE_E_320:
+		return "320"
+	case AllTerritoryCode_ALL_TERRITORY_CODE_E_324:
+		return "324"
+	case AllTerritoryCode_ALL_TERRITORY_CODE_E_328:
+		return "328"
+	case AllTerritoryCode_ALL_TERRITORY_CODE_E_332:
+		return "332"
+	case AllTerritoryCode_ALL_TERRITORY_CODE_E_336:
+		return "336"
+	case AllTerritoryCode_ALL_TERRITORY_CODE_E_340:
+		return "340"
+	case AllTerritoryCode_ALL_TERRITORY_CODE_E_344:
+		return "344"
+	case AllTerritoryCode_ALL_TERRITORY_CODE_E_348:
+		return "348"
+	case AllTerritoryCode_ALL_TERRITORY_CODE_E_352:
+		return "352"
+	case AllTerritoryCode_ALL_TERRITORY_CODE_E_356:
+		return "356"
+	case AllTerritoryCode_ALL_TERRITORY_CODE_E_360:
+		return "360"
+	case AllTerritoryCode_ALL_TERRITORY_CODE_E_364:
+		return "364"
+	case AllTerritoryCode_ALL_TERRITORY_CODE_E_368:
+		return "368"
+	case AllTerritoryCode_ALL_TERRITORY_CODE_E_372:
+		return "372"
+	case AllTerritoryCode_ALL_TERRITORY_CODE_E_376:
+		return "376"
+	case AllTerritoryCode_ALL_TERRITORY_CODE_E_380:
+		return "380"
+	case AllTerritoryCode_ALL_TERRITORY_CODE_E_384:
+		return "384"
+	case AllTerritoryCode_ALL_TERRITORY_CODE_E_388:
+		return "388"
+	case AllTerritoryCode_ALL_TERRITORY_CODE_E_392:
+		return "392"
+	case AllTerritoryCode_ALL_TERRITORY_CODE_E_398:
+		return "398"
+	case AllTerritoryCode_ALL_TERRITORY_CODE_E_400:
+		return "400"
+	case AllTerritoryCode_ALL_TERRITORY_CODE_E_404:
+		return "404"
+	case AllTerritoryCode_ALL_TERRITORY_CODE_E_408:
+		return "408"
+	case AllTerritoryCode_ALL_TERRITORY_CODE_E_410:
+		return "410"
+	case AllTerritoryCode_ALL_TERRITORY_CODE_E_414:
+		return "414"
+	case AllTerritoryCode_ALL_TERRITORY_CODE_E_417:
+		return "417"
+	case AllTerritoryCode_ALL_TERRITORY_CODE_E_418:
+		return "418"
+	case AllTerritoryCode_ALL_TERRITORY_CODE_E_422:
+		return "422"
+	case AllTerritoryCode_ALL_TERRITORY_CODE_E_426:
+		return "426"
+	case AllTerritoryCode_ALL_TERRITORY_CODE_E_428:
+		return "428"
+	case AllTerritoryCode_ALL_TERRITORY_CODE_E_430:
+		return "430"
+	case AllTerritoryCode_ALL_TERRITORY_CODE_E_434:
+		return "434"
+	case AllTerritoryCode_ALL_TERRITORY_CODE_E_438:
+		return "438"
+	case AllTerritoryCode_ALL_TERRITORY_CODE_E_440:
+		return "440"
+	case AllTerritoryCode_ALL_TERRITORY_CODE_E_442:
+		return "442"
+	case AllTerritoryCode_ALL_TERRITORY_CODE_E_446:
+		return "446"
+	case AllTerritoryCode_ALL_TERRITORY_CODE_E_450:
+		return "450"
+	case AllTerritoryCode_ALL_TERRITORY_CODE_E_454:
+		return "454"
+	case AllTerritoryCode_ALL_TERRITORY_CODE_E_458:
+		return "458"
+	case AllTerritoryCode_ALL_TERRITORY_CODE_E_462:
+		return "462"
+	case AllTerritoryCode_ALL_TERRITORY_CODE_E_466:
+		return "466"
+	case AllTerritoryCode_ALL_TERRITORY_CODE_E_470:
+		return "470"
+	case AllTerritoryCode_ALL_TERRITORY_CODE_E_478:
+		return "478"
+	case AllTerritoryCode_ALL_TERRITORY_CODE_E_480:
+		return "480"
+	case AllTerritoryCode_ALL_TERRITORY_CODE_E_484:
+		return "484"
+	case AllTerritoryCode_ALL_TERRITORY_CODE_E_492:
+		return "492"
+	case AllTerritoryCode_ALL_TERRITORY_CODE_E_496:
+		return "496"
+	case AllTerritoryCode_ALL_TERRITORY_CODE_E_498:
+		return "498"
+	case AllTerritoryCode_ALL_TERRITORY_CODE_E_499:
+		return "499"
+	case AllTerritoryCode_ALL_TERRITORY_CODE_E_504:
+		return "504"
+	case AllTerritoryCode_ALL_TERRITORY_CODE_E_508:
+		return "508"
+	case AllTerritoryCode_ALL_TERRITORY_CODE_E_512:
+		return "512"
+	case AllTerritoryCode_ALL_TERRITORY_CODE_E_516:
+		return "516"
+	case AllTerritoryCode_ALL_TERRITORY_CODE_E_520:
+		return "520"
+	case AllTerritoryCode_ALL_TERRITORY_CODE_E_524:
+		return "524"
+	case AllTerritoryCode_ALL_TERRITORY_CODE_E_528:
+		return "528"
+	case AllTerritoryCode_ALL_TERRITORY_CODE_E_540:
+		return "540"
+	case AllTerritoryCode_ALL_TERRITORY_CODE_E_548:
+		return "548"
+	case AllTerritoryCode_ALL_TERRITORY_CODE_E_554:
+		return "554"
+	case AllTerritoryCode_ALL_TERRITORY_CODE_E_558:
+		return "558"
+	case AllTerritoryCode_ALL_TERRITORY_CODE_E_562:
+		return "562"
+	case AllTerritoryCode_ALL_TERRITORY_CODE_E_566:
+		return "566"
+	case AllTerritoryCode_ALL_TERRITORY_CODE_E_578:
+		return "578"
+	case AllTerritoryCode_ALL_TERRITORY_CODE_E_583:
+		return "583"
+	case AllTerritoryCode_ALL_TERRITORY_CODE_E_584:
+		return "584"
+	case AllTerritoryCode_ALL_TERRITORY_CODE_E_585:
+		return "585"
+	case AllTerritoryCode_ALL_TERRITORY_CODE_E_586:
+		return "586"
+	case AllTerritoryCode_ALL_TERRITORY_CODE_E_591:
+		return "591"
+	case AllTerritoryCode_ALL_TERRITORY_CODE_E_598:
+		return "598"
+	case AllTerritoryCode_ALL_TERRITORY_CODE_E_600:
+		return "600"
+	case AllTerritoryCode_ALL_TERRITORY_CODE_E_604:
+		return "604"
+	case AllTerritoryCode_ALL_TERRITORY_CODE_E_608:
+		return "608"
+	case AllTerritoryCode_ALL_TERRITORY_CODE_E_616:
+		return "616"
+	case AllTerritoryCode_ALL_TERRITORY_CODE_E_620:
+		return "620"
+	case AllTerritoryCode_ALL_TERRITORY_CODE_E_624:
+		return "624"
+	case AllTerritoryCode_ALL_TERRITORY_CODE_E_626:
+		return "626"
+	case AllTerritoryCode_ALL_TERRITORY_CODE_E_630:
+		return "630"
+	case AllTerritoryCode_ALL_TERRITORY_CODE_E_634:
+		return "634"
+	case AllTerritoryCode_ALL_TERRITORY_CODE_E_642:
+		return "642"
+	case AllTerritoryCode_ALL_TERRITORY_CODE_E_643:
+		return "643"
+	case AllTerritoryCode_ALL_TERRITORY_CODE_E_646:
+		return "646"
+	case AllTerritoryCode_ALL_TERRITORY_CODE_E_659:
+		return "659"
+	case AllTerritoryCode_ALL_TERRITORY_CODE_E_662:
+		return "662"
+	case AllTerritoryCode_ALL_TERRITORY_CODE_E_670:
+		return "670"
+	case AllTerritoryCode_ALL_TERRITORY_CODE_E_674:
+		return "674"
+	case AllTerritoryCode_ALL_TERRITORY_CODE_E_678:
+		return "678"
+	case AllTerritoryCode_ALL_TERRITORY_CODE_E_682:
+		return "682"
+	case AllTerritoryCode_ALL_TERRITORY_CODE_E_686:
+		return "686"
+	case AllTerritoryCode_ALL_TERRITORY_CODE_E_688:
+		return "688"
+	case AllTerritoryCode_ALL_TERRITORY_CODE_E_690:
+		return "690"
+	case AllTerritoryCode_ALL_TERRITORY_CODE_E_694:
+		return "694"
+	case AllTerritoryCode_ALL_TERRITORY_CODE_E_702:
+		return "702"
+	case AllTerritoryCode_ALL_TERRITORY_CODE_E_703:
+		return "703"
+	case AllTerritoryCode_ALL_TERRITORY_CODE_E_704:
+		return "704"
+	case AllTerritoryCode_ALL_TERRITORY_CODE_E_705:
+		return "705"
+	case AllTerritoryCode_ALL_TERRITORY_CODE_E_706:
+		return "706"
+	case AllTerritoryCode_ALL_TERRITORY_CODE_E_710:
+		return "710"
+	case AllTerritoryCode_ALL_TERRITORY_CODE_E_716:
+		return "716"
+	case AllTerritoryCode_ALL_TERRITORY_CODE_E_720:
+		return "720"
+	case AllTerritoryCode_ALL_TERRITORY_CODE_E_724:
+		return "724"
+	case AllTerritoryCode_ALL_TERRITORY_CODE_E_728:
+		return "728"
+	case AllTerritoryCode_ALL_TERRITORY_CODE_E_729:
+		return "729"
+	case AllTerritoryCode_ALL_TERRITORY_CODE_E_732:
+		return "732"
+	case AllTerritoryCode_ALL_TERRITORY_CODE_E_736:
+		return "736"
+	case AllTerritoryCode_ALL_TERRITORY_CODE_E_740:
+		return "740"
+	case AllTerritoryCode_ALL_TERRITORY_CODE_E_748:
+		return "748"
+	case AllTerritoryCode_ALL_TERRITORY_CODE_E_752:
+		return "752"
+	case AllTerritoryCode_ALL_TERRITORY_CODE_E_756:
+		return "756"
+	case AllTerritoryCode_ALL_TERRITORY_CODE_E_760:
+		return "760"
+	case AllTerritoryCode_ALL_TERRITORY_CODE_E_762:
+		return "762"
+	case AllTerritoryCode_ALL_TERRITORY_CODE_E_764:
+		return "764"
+	case AllTerritoryCode_ALL_TERRITORY_CODE_E_768:
+		return "768"
+	case AllTerritoryCode_ALL_TERRITORY_CODE_E_776:
+		return "776"
+	case AllTerritoryCode_ALL_TERRITORY_CODE_E_780:
+		return "780"
+	case AllTerritoryCode_ALL_TERRITORY_CODE_E_784:
+		return "784"
+	case AllTerritoryCode_ALL_TERRITORY_CODE_E_788:
+		return "788"
+	case AllTerritoryCode_ALL_TERRITORY_CODE_E_792:
+		return "792"
+	case AllTerritoryCode_ALL_TERRITORY_CODE_E_795:
+		return "795"
+	case AllTerritoryCode_ALL_TERRITORY_CODE_E_798:
+		return "798"
+	case AllTerritoryCode_ALL_TERRITORY_CODE_E_800:
+		return "800"
+	case AllTerritoryCode_ALL_TERRITORY_CODE_E_804:
+		return "804"
+	case AllTerritoryCode_ALL_TERRITORY_CODE_E_807:
+		return "807"
+	case AllTerritoryCode_ALL_TERRITORY_CODE_E_810:
+		return "810"
+	case AllTerritoryCode_ALL_TERRITORY_CODE_E_818:
+		return "818"
+	case AllTerritoryCode_ALL_TERRITORY_CODE_E_826:
+		return "826"
+	case AllTerritoryCode_ALL_TERRITORY_CODE_E_834:
+		return "834"
+	case AllTerritoryCode_ALL_TERRITORY_CODE_E_840:
+		return "840"
+	case AllTerritoryCode_ALL_TERRITORY_CODE_E_854:
+		return "854"
+	case AllTerritoryCode_ALL_TERRITORY_CODE_E_858:
+		return "858"
+	case AllTerritoryCode_ALL_TERRITORY_CODE_E_860:
+		return "860"
+	case AllTerritoryCode_ALL_TERRITORY_CODE_E_862:
+		return "862"
+	case AllTerritoryCode_ALL_TERRITORY_CODE_E_882:
+		return "882"
+	case AllTerritoryCode_ALL_TERRITORY_CODE_E_886:
+		return "886"
+	case AllTerritoryCode_ALL_TERRITORY_CODE_E_887:
+		return "887"
+	case AllTerritoryCode_ALL_TERRITORY_CODE_E_890:
+		return "890"
+	case AllTerritoryCode_ALL_TERRITORY_CODE_E_891:
+		return "891"
+	case AllTerritoryCode_ALL_TERRITORY_CODE_E_894:
+		return "894"
+	case AllTerritoryCode_ALL_TERRITORY_CODE_E_2100:
+		return "2100"
+	case AllTerritoryCode_ALL_TERRITORY_CODE_E_2101:
+		return "2101"
+	case AllTerritoryCode_ALL_TERRITORY_CODE_E_2102:
+		return "2102"
+	case AllTerritoryCode_ALL_TERRITORY_CODE_E_2103:
+		return "2103"
+	case AllTerritoryCode_ALL_TERRITORY_CODE_E_2104:
+		return "2104"
+	case AllTerritoryCode_ALL_TERRITORY_CODE_E_2105:
+		return "2105"
+	case AllTerritoryCode_ALL_TERRITORY_CODE_E_2106:
+		return "2106"
+	case AllTerritoryCode_ALL_TERRITORY_CODE_E_2107:
+		return "2107"
+	case AllTerritoryCode_ALL_TERRITORY_CODE_E_2108:
+		return "2108"
+	case AllTerritoryCode_ALL_TERRITORY_CODE_E_2109:
+		return "2109"
+	case AllTerritoryCode_ALL_TERRITORY_CODE_E_2110:
+		return "2110"
+	case AllTerritoryCode_ALL_TERRITORY_CODE_E_2111:
+		return "2111"
+	case AllTerritoryCode_ALL_TERRITORY_CODE_E_2112:
+		return "2112"
+	case AllTerritoryCode_ALL_TERRITORY_CODE_E_2113:
+		return "2113"
+	case AllTerritoryCode_ALL_TERRITORY_CODE_E_2114:
+		return "2114"
+	case AllTerritoryCode_ALL_TERRITORY_CODE_E_2115:
+		return "2115"
+	case AllTerritoryCode_ALL_TERRITORY_CODE_E_2116:
+		return "2116"
+	case AllTerritoryCode_ALL_TERRITORY_CODE_E_2117:
+		return "2117"
+	case AllTerritoryCode_ALL_TERRITORY_CODE_E_2118:
+		return "2118"
+	case AllTerritoryCode_ALL_TERRITORY_CODE_E_2119:
+		return "2119"
+	case AllTerritoryCode_ALL_TERRITORY_CODE_E_2120:
+		return "2120"
+	case AllTerritoryCode_ALL_TERRITORY_CODE_E_2121:
+		return "2121"
+	case AllTerritoryCode_ALL_TERRITORY_CODE_E_2122:
+		return "2122"
+	case AllTerritoryCode_ALL_TERRITORY_CODE_E_2123:
+		return "2123"
+	case AllTerritoryCode_ALL_TERRITORY_CODE_E_2124:
+		return "2124"
+	case AllTerritoryCode_ALL_TERRITORY_CODE_E_2125:
+		return "2125"
+	case AllTerritoryCode_ALL_TERRITORY_CODE_E_2126:
+		return "2126"
+	case AllTerritoryCode_ALL_TERRITORY_CODE_E_2127:
+		return "2127"
+	case AllTerritoryCode_ALL_TERRITORY_CODE_E_2128:
+		return "2128"
+	case AllTerritoryCode_ALL_TERRITORY_CODE_E_2129:
+		return "2129"
+	case AllTerritoryCode_ALL_TERRITORY_CODE_E_2130:
+		return "2130"
+	case AllTerritoryCode_ALL_TERRITORY_CODE_E_2131:
+		return "2131"
+	case AllTerritoryCode_ALL_TERRITORY_CODE_E_2132:
+		return "2132"
+	case AllTerritoryCode_ALL_TERRITORY_CODE_E_2133:
+		return "2133"
+	case AllTerritoryCode_ALL_TERRITORY_CODE_E_2134:
+		return "2134"
+	case AllTerritoryCode_ALL_TERRITORY_CODE_E_2136:
+		return "2136"
+	case AllTerritoryCode_ALL_TERRITORY_CODE_XK:
+		return "XK"
+	case AllTerritoryCode_ALL_TERRITORY_CODE_WORLDWIDE:
+		return "WORLDWIDE"
+	case AllTerritoryCode_ALL_TERRITORY_CODE_AIDJ:
+		return "AIDJ"
+	case AllTerritoryCode_ALL_TERRITORY_CODE_ANHH:
+		return "ANHH"
+	case AllTerritoryCode_ALL_TERRITORY_CODE_BQAQ:
+		return "BQAQ"
+	case AllTerritoryCode_ALL_TERRITORY_CODE_BUMM:
+		return "BUMM"
+	case AllTerritoryCode_ALL_TERRITORY_CODE_BYAA:
+		return "BYAA"
+	case AllTerritoryCode_ALL_TERRITORY_CODE_CSHH:
+		return "CSHH"
+	case AllTerritoryCode_ALL_TERRITORY_CODE_CSXX:
+		return "CSXX"
+	case AllTerritoryCode_ALL_TERRITORY_CODE_CTKI:
+		return "CTKI"
+	case AllTerritoryCode_ALL_TERRITORY_CODE_DDDE:
+		return "DDDE"
+	case AllTerritoryCode_ALL_TERRITORY_CODE_DYBJ:
+		return "DYBJ"
+	case AllTerritoryCode_ALL_TERRITORY_CODE_FQHH:
+		return "FQHH"
+	case AllTerritoryCode_ALL_TERRITORY_CODE_FXFR:
+		return "FXFR"
+	case AllTerritoryCode_ALL_TERRITORY_CODE_GEHH:
+		return "GEHH"
+	case AllTerritoryCode_ALL_TERRITORY_CODE_HVBF:
+		return "HVBF"
+	case AllTerritoryCode_ALL_TERRITORY_CODE_JTUM:
+		return "JTUM"
+	case AllTerritoryCode_ALL_TERRITORY_CODE_MIUM:
+		return "MIUM"
+	case AllTerritoryCode_ALL_TERRITORY_CODE_NHVU:
+		return "NHVU"
+	case AllTerritoryCode_ALL_TERRITORY_CODE_NQAQ:
+		return "NQAQ"
+	case AllTerritoryCode_ALL_TERRITORY_CODE_NTHH:
+		return "NTHH"
+	case AllTerritoryCode_ALL_TERRITORY_CODE_PCHH:
+		return "PCHH"
+	case AllTerritoryCode_ALL_TERRITORY_CODE_PUUM:
+		return "PUUM"
+	case AllTerritoryCode_ALL_TERRITORY_CODE_PZPA:
+		return "PZPA"
+	case AllTerritoryCode_ALL_TERRITORY_CODE_RHZW:
+		return "RHZW"
+	case AllTerritoryCode_ALL_TERRITORY_CODE_SKIN:
+		return "SKIN"
+	case AllTerritoryCode_ALL_TERRITORY_CODE_SUHH:
+		return "SUHH"
+	case AllTerritoryCode_ALL_TERRITORY_CODE_TPTL:
+		return "TPTL"
+	case AllTerritoryCode_ALL_TERRITORY_CODE_VDVN:
+		return "VDVN"
+	case AllTerritoryCode_ALL_TERRITORY_CODE_WKUM:
+		return "WKUM"
+	case AllTerritoryCode_ALL_TERRITORY_CODE_YDYE:
+		return "YDYE"
+	case AllTerritoryCode_ALL_TERRITORY_CODE_YUCS:
+		return "YUCS"
+	case AllTerritoryCode_ALL_TERRITORY_CODE_ZRCD:
+		return "ZRCD"
 	default:
 		return ""
 	}
 }
 
 // ParseAllTerritoryCodeString parses a string value to AllTerritoryCode enum (case-insensitive)
+// Numeric codes are accepted as well and map to the constant of the code they stand for
 func ParseAllTerritoryCodeString(s string) (AllTerritoryCode, bool) {
 	s = strings.ToUpper(s)
 	switch s {
+	case "AD":
+		return AllTerritoryCode_ALL_TERRITORY_CODE_AD, true
+	case "AE":
+		return AllTerritoryCode_ALL_TERRITORY_CODE_AE, true
+	case "AF":
+		return AllTerritoryCode_ALL_TERRITORY_CODE_AF, true
+	case "AG":
+		return AllTerritoryCode_ALL_TERRITORY_CODE_AG, true
+	case "AI":
+		return AllTerritoryCode_ALL_TERRITORY_CODE_AI, true
+	case "AL":
+		return AllTerritoryCode_ALL_TERRITORY_CODE_AL, true
+	case "AM":
+		return AllTerritoryCode_ALL_TERRITORY_CODE_AM, true
+	case "AN":
+		return AllTerritoryCode_ALL_TERRITORY_CODE_AN, true
+	case "AO":
+		return AllTerritoryCode_ALL_TERRITORY_CODE_AO, true
+	case "AQ":
+		return AllTerritoryCode_ALL_TERRITORY_CODE_AQ, true
+	case "AR":
+		return AllTerritoryCode_ALL_TERRITORY_CODE_AR, true
+	case "AS":
+		return AllTerritoryCode_ALL_TERRITORY_CODE_AS, true
+	case "AT":
+		return AllTerritoryCode_ALL_TERRITORY_CODE_AT, true
+	case "AU":
+		return AllTerritoryCode_ALL_TERRITORY_CODE_AU, true
+	case "AW":
+		return AllTerritoryCode_ALL_TERRITORY_CODE_AW, true
+	case "AX":
+		return AllTerritoryCode_ALL_TERRITORY_CODE_AX, true
+	case "AZ":
+		return AllTerritoryCode_ALL_TERRITORY_CODE_AZ, true
+	case "BA":
+		return AllTerritoryCode_ALL_TERRITORY_CODE_BA, true
+	case "BB":
+		return AllTerritoryCode_ALL_TERRITORY_CODE_BB, true
+	case "BD":
+		return AllTerritoryCode_ALL_TERRITORY_CODE_BD, true
+	case "BE":
+		return AllTerritoryCode_ALL_TERRITORY_CODE_BE, true
+	case "BF":
+		return AllTerritoryCode_ALL_TERRITORY_CODE_BF, true
+	case "BG":
+		return AllTerritoryCode_ALL_TERRITORY_CODE_BG, true
+	case "BH":
+		return AllTerritoryCode_ALL_TERRITORY_CODE_BH, true
+	case "BI":
+		return AllTerritoryCode_ALL_TERRITORY_CODE_BI, true
+	case "BJ":
+		return AllTerritoryCode_ALL_TERRITORY_CODE_BJ, true
+	case "BL":
+		return AllTerritoryCode_ALL_TERRITORY_CODE_BL, true
+	case "BM":
+		return AllTerritoryCode_ALL_TERRITORY_CODE_BM, true
+	case "BN":
+		return AllTerritoryCode_ALL_TERRITORY_CODE_BN, true
+	case "BO":
+		return AllTerritoryCode_ALL_TERRITORY_CODE_BO, true
+	case "BQ":
+		return AllTerritoryCode_ALL_TERRITORY_CODE_BQ, true
+	case "BR":
+		return AllTerritoryCode_ALL_TERRITORY_CODE_BR, true
+	case "BS":
+		return AllTerritoryCode_ALL_TERRITORY_CODE_BS, true
+	case "BT":
+		return AllTerritoryCode_ALL_TERRITORY_CODE_BT, true
+	case "BV":
+		return AllTerritoryCode_ALL_TERRITORY_CODE_BV, true
+	case "BW":
+		return AllTerritoryCode_ALL_TERRITORY_CODE_BW, true
+	case "BY":
+		return AllTerritoryCode_ALL_TERRITORY_CODE_BY, true
+	case "BZ":
+		return AllTerritoryCode_ALL_TERRITORY_CODE_BZ, true
+	case "CA":
+		return AllTerritoryCode_ALL_TERRITORY_CODE_CA, true
+	case "CC":
+		return AllTerritoryCode_ALL_TERRITORY_CODE_CC, true
+	case "CD":
+		return AllTerritoryCode_ALL_TERRITORY_CODE_CD, true
+	case "CF":
+		return AllTerritoryCode_ALL_TERRITORY_CODE_CF, true
+	case "CG":
+		return AllTerritoryCode_ALL_TERRITORY_CODE_CG, true
+	case "CH":
+		return AllTerritoryCode_ALL_TERRITORY_CODE_CH, true
+	case "CI":
+		return AllTerritoryCode_ALL_TERRITORY_CODE_CI, true
+	case "CK":
+		return AllTerritoryCode_ALL_TERRITORY_CODE_CK, true
+	case "CL":
+		return AllTerritoryCode_ALL_TERRITORY_CODE_CL, true
+	case "CM":
+		return AllTerritoryCode_ALL_TERRITORY_CODE_CM, true
+	case "CN":
+		return AllTerritoryCode_ALL_TERRITORY_CODE_CN, true
+	case "CO":
+		return AllTerritoryCode_ALL_TERRITORY_CODE_CO, true
+	case "CR":
+		return AllTerritoryCode_ALL_TERRITORY_CODE_CR, true
+	case "CS":
+		return AllTerritoryCode_ALL_TERRITORY_CODE_CS, true
+	case "CU":
+		return AllTerritoryCode_ALL_TERRITORY_CODE_CU, true
+	case "CV":
+		return AllTerritoryCode_ALL_TERRITORY_CODE_CV, true
+	case "CW":
+		return AllTerritoryCode_ALL_TERRITORY_CODE_CW, true
+	case "CX":
+		return AllTerritoryCode_ALL_TERRITORY_CODE_CX, true
+	case "CY":
+		return AllTerritoryCode_ALL_TERRITORY_CODE_CY, true
+	case "CZ":
+		return AllTerritoryCode_ALL_TERRITORY_CODE_CZ, true
+	case "DE":
+		return AllTerritoryCode_ALL_TERRITORY_CODE_DE, true
+	case "DJ":
+		return AllTerritoryCode_ALL_TERRITORY_CODE_DJ, true
+	case "DK":
+		return AllTerritoryCode_ALL_TERRITORY_CODE_DK, true
+	case "DM":
+		return AllTerritoryCode_ALL_TERRITORY_CODE_DM, true
+	case "DO":
+		return AllTerritoryCode_ALL_TERRITORY_CODE_DO, true
+	case "DZ":
+		return AllTerritoryCode_ALL_TERRITORY_CODE_DZ, true
+	case "EC":
+		return AllTerritoryCode_ALL_TERRITORY_CODE_EC, true
+	case "EE":
+		return AllTerritoryCode_ALL_TERRITORY_CODE_EE, true
+	case "EG":
+		return AllTerritoryCode_ALL_TERRITORY_CODE_EG, true
+	case "EH":
+		return AllTerritoryCode_ALL_TERRITORY_CODE_EH, true
+	case "ER":
+		return AllTerritoryCode_ALL_TERRITORY_CODE_ER, true
+	case "ES":
+		return AllTerritoryCode_ALL_TERRITORY_CODE_ES, true
+	case "ES_CE":
+		return AllTerritoryCode_ALL_TERRITORY_CODE_ES_CE, true
+	case "ES_CN":
+		return AllTerritoryCode_ALL_TERRITORY_CODE_ES_CN, true
+	case "ES_ML":
+		return AllTerritoryCode_ALL_TERRITORY_CODE_ES_ML, true
+	case "ET":
+		return AllTerritoryCode_ALL_TERRITORY_CODE_ET, true
+	case "FI":
+		return AllTerritoryCode_ALL_TERRITORY_CODE_FI, true
+	case "FJ":
+		return AllTerritoryCode_ALL_TERRITORY_CODE_FJ, true
+	case "FK":
+		return AllTerritoryCode_ALL_TERRITORY_CODE_FK, true
+	case "FM":
+		return AllTerritoryCode_ALL_TERRITORY_CODE_FM, true
+	case "FO":
+		return AllTerritoryCode_ALL_TERRITORY_CODE_FO, true
+	case "FR":
+		return AllTerritoryCode_ALL_TERRITORY_CODE_FR, true
+	case "GA":
+		return AllTerritoryCode_ALL_TERRITORY_CODE_GA, true
+	case "GB":
+		return AllTerritoryCode_ALL_TERRITORY_CODE_GB, true
+	case "GD":
+		return AllTerritoryCode_ALL_TERRITORY_CODE_GD, true
+	case "GE":
+		return AllTerritoryCode_ALL_TERRITORY_CODE_GE, true
+	case "GF":
+		return AllTerritoryCode_ALL_TERRITORY_CODE_GF, true
+	case "GG":
+		return AllTerritoryCode_ALL_TERRITORY_CODE_GG, true
+	case "GH":
+		return AllTerritoryCode_ALL_TERRITORY_CODE_GH, true
+	case "GI":
+		return AllTerritoryCode_ALL_TERRITORY_CODE_GI, true
+	case "GL":
+		return AllTerritoryCode_ALL_TERRITORY_CODE_GL, true
+	case "GM":
+		return AllTerritoryCode_ALL_TERRITORY_CODE_GM, true
+	case "GN":
+		return AllTerritoryCode_ALL_TERRITORY_CODE_GN, true
+	case "GP":
+		return AllTerritoryCode_ALL_TERRITORY_CODE_GP, true
+	case "GQ":
+		return AllTerritoryCode_ALL_TERRITORY_CODE_GQ, true
+	case "GR":
+		return AllTerritoryCode_ALL_TERRITORY_CODE_GR, true
+	case "GS":
+		return AllTerritoryCode_ALL_TERRITORY_CODE_GS, true
+	case "GT":
+		return AllTerritoryCode_ALL_TERRITORY_CODE_GT, true
+	case "GU":
+		return AllTerritoryCode_ALL_TERRITORY_CODE_GU, true
+	case "GW":
+		return AllTerritoryCode_ALL_TERRITORY_CODE_GW, true
+	case "GY":
+		return AllTerritoryCode_ALL_TERRITORY_CODE_GY, true
+	case "HK":
+		return AllTerritoryCode_ALL_TERRITORY_CODE_HK, true
+	case "HM":
+		return AllTerritoryCode_ALL_TERRITORY_CODE_HM, true
+	case "HN":
+		return AllTerritoryCode_ALL_TERRITORY_CODE_HN, true
+	case "HR":
+		return AllTerritoryCode_ALL_TERRITORY_CODE_HR, true
+	case "HT":
+		return AllTerritoryCode_ALL_TERRITORY_CODE_HT, true
+	case "HU":
+		return AllTerritoryCode_ALL_TERRITORY_CODE_HU, true
+	case "ID":
+		return AllTerritoryCode_ALL_TERRITORY_CODE_ID, true
+	case "IE":
+		return AllTerritoryCode_ALL_TERRITORY_CODE_IE, true
+	case "IL":
+		return AllTerritoryCode_ALL_TERRITORY_CODE_IL, true
+	case "IM":
+		return AllTerritoryCode_ALL_TERRITORY_CODE_IM, true
+	case "IN":
+		return AllTerritoryCode_ALL_TERRITORY_CODE_IN, true
+	case "IO":
+		return AllTerritoryCode_ALL_TERRITORY_CODE_IO, true
+	case "IQ":
+		return AllTerritoryCode_ALL_TERRITORY_CODE_IQ, true
+	case "IR":
+		return AllTerritoryCode_ALL_TERRITORY_CODE_IR, true
+	case "IS":
+		return AllTerritoryCode_ALL_TERRITORY_CODE_IS, true
+	case "IT":
+		return AllTerritoryCode_ALL_TERRITORY_CODE_IT, true
+	case "JE":
+		return AllTerritoryCode_ALL_TERRITORY_CODE_JE, true
+	case "JM":
+		return AllTerritoryCode_ALL_TERRITORY_CODE_JM, true
+	case "JO":
+		return AllTerritoryCode_ALL_TERRITORY_CODE_JO, true
+	case "JP":
+		return AllTerritoryCode_ALL_TERRITORY_CODE_JP, true
+	case "KE":
+		return AllTerritoryCode_ALL_TERRITORY_CODE_KE, true
+	case "KG":
+		return AllTerritoryCode_ALL_TERRITORY_CODE_KG, true
+	case "KH":
+		return AllTerritoryCode_ALL_TERRITORY_CODE_KH, true
+	case "KI":
+		return AllTerritoryCode_ALL_TERRITORY_CODE_KI, true
+	case "KM":
+		return AllTerritoryCode_ALL_TERRITORY_CODE_KM, true
+	case "KN":
+		return AllTerritoryCode_ALL_TERRITORY_CODE_KN, true
+	case "KP":
+		return AllTerritoryCode_ALL_TERRITORY_CODE_KP, true
+	case "KR":
+		return AllTerritoryCode_ALL_TERRITORY_CODE_KR, true
+	case "KW":
+		return AllTerritoryCode_ALL_TERRITORY_CODE_KW, true
+	case "KY":
+		return AllTerritoryCode_ALL_TERRITORY_CODE_KY, true
+	case "KZ":
+		return AllTerritoryCode_ALL_TERRITORY_CODE_KZ, true
+	case "LA":
+		return AllTerritoryCode_ALL_TERRITORY_CODE_LA, true
+	case "LB":
+		return AllTerritoryCode_ALL_TERRITORY_CODE_LB, true
+	case "LC":
+		return AllTerritoryCode_ALL_TERRITORY_CODE_LC, true
+	case "LI":
+		return AllTerritoryCode_ALL_TERRITORY_CODE_LI, true
+	case "LK":
+		return AllTerritoryCode_ALL_TERRITORY_CODE_LK, true
+	case "LR":
+		return AllTerritoryCode_ALL_TERRITORY_CODE_LR, true
+	case "LS":
+		return AllTerritoryCode_ALL_TERRITORY_CODE_LS, true
+	case "LT":
+		return AllTerritoryCode_ALL_TERRITORY_CODE_LT, true
+	case "LU":
+		return AllTerritoryCode_ALL_TERRITORY_CODE_LU, true
+	case "LV":
+		return AllTerritoryCode_ALL_TERRITORY_CODE_LV, true
+	case "LY":
+		return AllTerritoryCode_ALL_TERRITORY_CODE_LY, true
+	case "MA":
+		return AllTerritoryCode_ALL_TERRITORY_CODE_MA, true
+	case "MC":
+		return AllTerritoryCode_ALL_TERRITORY_CODE_MC, true
+	case "MD":
+		return AllTerritoryCode_ALL_TERRITORY_CODE_MD, true
+	case "ME":
+		return AllTerritoryCode_ALL_TERRITORY_CODE_ME, true
+	case "MF":
+		return AllTerritoryCode_ALL_TERRITORY_CODE_MF, true
+	case "MG":
+		return AllTerritoryCode_ALL_TERRITORY_CODE_MG, true
+	case "MH":
+		return AllTerritoryCode_ALL_TERRITORY_CODE_MH, true
+	case "MK":
+		return AllTerritoryCode_ALL_TERRITORY_CODE_MK, true
+	case "ML":
+		return AllTerritoryCode_ALL_TERRITORY_CODE_ML, true
+	case "MM":
+		return AllTerritoryCode_ALL_TERRITORY_CODE_MM, true
+	case "MN":
+		return AllTerritoryCode_ALL_TERRITORY_CODE_MN, true
+	case "MO":
+		return AllTerritoryCode_ALL_TERRITORY_CODE_MO, true
+	case "MP":
+		return AllTerritoryCode_ALL_TERRITORY_CODE_MP, true
+	case "MQ":
+		return AllTerritoryCode_ALL_TERRITORY_CODE_MQ, true
+	case "MR":
+		return AllTerritoryCode_ALL_TERRITORY_CODE_MR, true
+	case "MS":
+		return AllTerritoryCode_ALL_TERRITORY_CODE_MS, true
+	case "MT":
+		return AllTerritoryCode_ALL_TERRITORY_CODE_MT, true
+	case "MU":
+		return AllTerritoryCode_ALL_TERRITORY_CODE_MU, true
+	case "MV":
+		return AllTerritoryCode_ALL_TERRITORY_CODE_MV, true
+	case "MW":
+		return AllTerritoryCode_ALL_TERRITORY_CODE_MW, true
+	case "MX":
+		return AllTerritoryCode_ALL_TERRITORY_CODE_MX, true
+	case "MY":
+		return AllTerritoryCode_ALL_TERRITORY_CODE_MY, true
+	case "MZ":
+		return AllTerritoryCode_ALL_TERRITORY_CODE_MZ, true
+	case "NA":
+		return AllTerritoryCode_ALL_TERRITORY_CODE_NA, true
+	case "NC":
+		return AllTerritoryCode_ALL_TERRITORY_CODE_NC, true
+	case "NE":
+		return AllTerritoryCode_ALL_TERRITORY_CODE_NE, true
+	case "NF":
+		return AllTerritoryCode_ALL_TERRITORY_CODE_NF, true
+	case "NG":
+		return AllTerritoryCode_ALL_TERRITORY_CODE_NG, true
+	case "NI":
+		return AllTerritoryCode_ALL_TERRITORY_CODE_NI, true
+	case "NL":
+		return AllTerritoryCode_ALL_TERRITORY_CODE_NL, true
+	case "NO":
+		return AllTerritoryCode_ALL_TERRITORY_CODE_NO, true
+	case "NP":
+		return AllTerritoryCode_ALL_TERRITORY_CODE_NP, true
+	case "NR":
+		return AllTerritoryCode_ALL_TERRITORY_CODE_NR, true
+	case "NU":
+		return AllTerritoryCode_ALL_TERRITORY_CODE_NU, true
+	case "NZ":
+		return AllTerritoryCode_ALL_TERRITORY_CODE_NZ, true
+	case "OM":
+		return AllTerritoryCode_ALL_TERRITORY_CODE_OM, true
+	case "PA":
+		return AllTerritoryCode_ALL_TERRITORY_CODE_PA, true
+	case "PE":
+		return AllTerritoryCode_ALL_TERRITORY_CODE_PE, true
+	case "PF":
+		return AllTerritoryCode_ALL_TERRITORY_CODE_PF, true
+	case "PG":
+		return AllTerritoryCode_ALL_TERRITORY_CODE_PG, true
+	case "PH":
+		return AllTerritoryCode_ALL_TERRITORY_CODE_PH, true
+	case "PK":
+		return AllTerritoryCode_ALL_TERRITORY_CODE_PK, true
+	case "PL":
+		return AllTerritoryCode_ALL_TERRITORY_CODE_PL, true
+	case "PM":
+		return AllTerritoryCode_ALL_TERRITORY_CODE_PM, true
+	case "PN":
+		return AllTerritoryCode_ALL_TERRITORY_CODE_PN, true
+	case "PR":
+		return AllTerritoryCode_ALL_TERRITORY_CODE_PR, true
+	case "PS":
+		return AllTerritoryCode_ALL_TERRITORY_CODE_PS, true
+	case "PT":
+		return AllTerritoryCode_ALL_TERRITORY_CODE_PT, true
+	case "PW":
+		return AllTerritoryCode_ALL_TERRITORY_CODE_PW, true
+	case "PY":
+		return AllTerritoryCode_ALL_TERRITORY_CODE_PY, true
+	case "QA":
+		return AllTerritoryCode_ALL_TERRITORY_CODE_QA, true
+	case "RE":
+		return AllTerritoryCode_ALL_TERRITORY_CODE_RE, true
+	case "RO":
+		return AllTerritoryCode_ALL_TERRITORY_CODE_RO, true
+	case "RS":
+		return AllTerritoryCode_ALL_TERRITORY_CODE_RS, true
+	case "RU":
+		return AllTerritoryCode_ALL_TERRITORY_CODE_RU, true
+	case "RW":
+		return AllTerritoryCode_ALL_TERRITORY_CODE_RW, true
+	case "SA":
+		return AllTerritoryCode_ALL_TERRITORY_CODE_SA, true
+	case "SB":
+		return AllTerritoryCode_ALL_TERRITORY_CODE_SB, true
+	case "SC":
+		return AllTerritoryCode_ALL_TERRITORY_CODE_SC, true
+	case "SD":
+		return AllTerritoryCode_ALL_TERRITORY_CODE_SD, true
+	case "SE":
+		return AllTerritoryCode_ALL_TERRITORY_CODE_SE, true
+	case "SG":
+		return AllTerritoryCode_ALL_TERRITORY_CODE_SG, true
+	case "SH":
+		return AllTerritoryCode_ALL_TERRITORY_CODE_SH, true
+	case "SI":
+		return AllTerritoryCode_ALL_TERRITORY_CODE_SI, true
+	case "SJ":
+		return AllTerritoryCode_ALL_TERRITORY_CODE_SJ, true
+	case "SK":
+		return AllTerritoryCode_ALL_TERRITORY_CODE_SK, true
+	case "SL":
+		return AllTerritoryCode_ALL_TERRITORY_CODE_SL, true
+	case "SM":
+		return AllTerritoryCode_ALL_TERRITORY_CODE_SM, true
+	case "SN":
+		return AllTerritoryCode_ALL_TERRITORY_CODE_SN, true
+	case "SO":
+		return AllTerritoryCode_ALL_TERRITORY_CODE_SO, true
+	case "SR":
+		return AllTerritoryCode_ALL_TERRITORY_CODE_SR, true
+	case "SS":
+		return AllTerritoryCode_ALL_TERRITORY_CODE_SS, true
+	case "ST":
+		return AllTerritoryCode_ALL_TERRITORY_CODE_ST, true
+	case "SV":
+		return AllTerritoryCode_ALL_TERRITORY_CODE_SV, true
+	case "SX":
+		return AllTerritoryCode_ALL_TERRITORY_CODE_SX, true
+	case "SY":
+		return AllTerritoryCode_ALL_TERRITORY_CODE_SY, true
+	case "SZ":
+		return AllTerritoryCode_ALL_TERRITORY_CODE_SZ, true
+	case "TC":
+		return AllTerritoryCode_ALL_TERRITORY_CODE_TC, true
+	case "TD":
+		return AllTerritoryCode_ALL_TERRITORY_CODE_TD, true
+	case "TF":
+		return AllTerritoryCode_ALL_TERRITORY_CODE_TF, true
+	case "TG":
+		return AllTerritoryCode_ALL_TERRITORY_CODE_TG, true
+	case "TH":
+		return AllTerritoryCode_ALL_TERRITORY_CODE_TH, true
+	case "TJ":
+		return AllTerritoryCode_ALL_TERRITORY_CODE_TJ, true
+	case "TK":
+		return AllTerritoryCode_ALL_TERRITORY_CODE_TK, true
+	case "TL":
+		return AllTerritoryCode_ALL_TERRITORY_CODE_TL, true
+	case "TM":
+		return AllTerritoryCode_ALL_TERRITORY_CODE_TM, true
+	case "TN":
+		return AllTerritoryCode_ALL_TERRITORY_CODE_TN, true
+	case "TO":
+		return AllTerritoryCode_ALL_TERRITORY_CODE_TO, true
+	case "TR":
+		return AllTerritoryCode_ALL_TERRITORY_CODE_TR, true
+	case "TT":
+		return AllTerritoryCode_ALL_TERRITORY_CODE_TT, true
+	case "TV":
+		return AllTerritoryCode_ALL_TERRITORY_CODE_TV, true
+	case "TW":
+		return AllTerritoryCode_ALL_TERRITORY_CODE_TW, true
+	case "TZ":
+		return AllTerritoryCode_ALL_TERRITORY_CODE_TZ, true
+	case "UA":
+		return AllTerritoryCode_ALL_TERRITORY_CODE_UA, true
+	case "UG":
+		return AllTerritoryCode_ALL_TERRITORY_CODE_UG, true
+	case "UM":
+		return AllTerritoryCode_ALL_TERRITORY_CODE_UM, true
+	case "US":
+		return AllTerritoryCode_ALL_TERRITORY_CODE_US, true
+	case "UY":
+		return AllTerritoryCode_ALL_TERRITORY_CODE_UY, true
+	case "UZ":
+		return AllTerritoryCode_ALL_TERRITORY_CODE_UZ, true
+	case "VA":
+		return AllTerritoryCode_ALL_TERRITORY_CODE_VA, true
+	case "VC":
+		return AllTerritoryCode_ALL_TERRITORY_CODE_VC, true
+	case "VE":
+		return AllTerritoryCode_ALL_TERRITORY_CODE_VE, true
+	case "VG":
+		return AllTerritoryCode_ALL_TERRITORY_CODE_VG, true
+	case "VI":
+		return AllTerritoryCode_ALL_TERRITORY_CODE_VI, true
+	case "VN":
+		return AllTerritoryCode_ALL_TERRITORY_CODE_VN, true
+	case "VU":
+		return AllTerritoryCode_ALL_TERRITORY_CODE_VU, true
+	case "WF":
+		return AllTerritoryCode_ALL_TERRITORY_CODE_WF, true
+	case "WS":
+		return AllTerritoryCode_ALL_TERRITORY_CODE_WS, true
+	case "YE":
+		return AllTerritoryCode_ALL_TERRITORY_CODE_YE, true
+	case "YT":
+		return AllTerritoryCode_ALL_TERRITORY_CODE_YT, true
+	case "ZA":
+		return AllTerritoryCode_ALL_TERRITORY_CODE_ZA, true
+	case "ZM":
+		return AllTerritoryCode_ALL_TERRITORY_CODE_ZM, true
+	case "ZW":
+		return AllTerritoryCode_ALL_TERRITORY_CODE_ZW, true
+	case "4":
+		return AllTerritoryCode_ALL_TERRITORY_CODE_AD, true
+	case "8":
+		return AllTerritoryCode_ALL_TERRITORY_CODE_AD, true
+	case "12":
+		return AllTerritoryCode_ALL_TERRITORY_CODE_AD, true
+	case "20":
+		return AllTerritoryCode_ALL_TERRITORY_CODE_AD, true
+	case "24":
+		return AllTerritoryCode_ALL_TERRITORY_CODE_AD, true
+	case "28":
+		return AllTerritoryCode_ALL_TERRITORY_CODE_AD, true
+	case "31":
+		return AllTerritoryCode_ALL_TERRITORY_CODE_AD, true
+	case "32":
+		return AllTerritoryCode_ALL_TERRITORY_CODE_AD, true
+	case "36":
+		return AllTerritoryCode_ALL_TERRITORY_CODE_AD, true
+	case "40":
+		return AllTerritoryCode_ALL_TERRITORY_CODE_AD, true
+	case "44":
+		return AllTerritoryCode_ALL_TERRITORY_CODE_AD, true
+	case "48":
+		return AllTerritoryCode_ALL_TERRITORY_CODE_AD, true
+	case "50":
+		return AllTerritoryCode_ALL_TERRITORY_CODE_AD, true
+	case "51":
+		return AllTerritoryCode_ALL_TERRITORY_CODE_AD, true
+	case "52":
+		return AllTerritoryCode_ALL_TERRITORY_CODE_AD, true
+	case "56":
+		return AllTerritoryCode_ALL_TERRITORY_CODE_AD, true
+	case "64":
+		return AllTerritoryCode_ALL_TERRITORY_CODE_AD, true
+	case "68":
+		return AllTerritoryCode_ALL_TERRITORY_CODE_AD, true
+	case "70":
+		return AllTerritoryCode_ALL_TERRITORY_CODE_AD, true
+	case "72":
+		return AllTerritoryCode_ALL_TERRITORY_CODE_AD, true
+	case "76":
+		return AllTerritoryCode_ALL_TERRITORY_CODE_AD, true
+	case "84":
+		return AllTerritoryCode_ALL_TERRITORY_CODE_AD, true
+	case "90":
+		return AllTerritoryCode_ALL_TERRITORY_CODE_AD, true
+	case "96":
+		return AllTerritoryCode_ALL_TERRITORY_CODE_AD, true
+	case "100":
+		return AllTerritoryCode_ALL_TERRITORY_CODE_AD, true
+	case "104":
+		return AllTerritoryCode_ALL_TERRITORY_CODE_AD, true
+	case "108":
+		return AllTerritoryCode_ALL_TERRITORY_CODE_AD, true
+	case "112":
+		return AllTerritoryCode_ALL_TERRITORY_CODE_AD, true
+	case "116":
+		return AllTerritoryCode_ALL_TERRITORY_CODE_AD, true
+	case "120":
+		return AllTerritoryCode_ALL_TERRITORY_CODE_AD, true
+	case "124":
+		return AllTerritoryCode_ALL_TERRITORY_CODE_AD, true
+	case "132":
+		return AllTerritoryCode_ALL_TERRITORY_CODE_AD, true
+	case "140":
+		return AllTerritoryCode_ALL_TERRITORY_CODE_AD, true
+	case "144":
+		return AllTerritoryCode_ALL_TERRITORY_CODE_AD, true
+	case "148":
+		return AllTerritoryCode_ALL_TERRITORY_CODE_AD, true
+	case "152":
+		return AllTerritoryCode_ALL_TERRITORY_CODE_AD, true
+	case "156":
+		return AllTerritoryCode_ALL_TERRITORY_CODE_AD, true
+	case "158":
+		return AllTerritoryCode_ALL_TERRITORY_CODE_AD, true
+	case "170":
+		return AllTerritoryCode_ALL_TERRITORY_CODE_AD, true
+	case "174":
+		return AllTerritoryCode_ALL_TERRITORY_CODE_AD, true
+	case "178":
+		return AllTerritoryCode_ALL_TERRITORY_CODE_AD, true
+	case "180":
+		return AllTerritoryCode_ALL_TERRITORY_CODE_AD, true
+	case "188":
+		return AllTerritoryCode_ALL_TERRITORY_CODE_AD, true
+	case "191":
+		return AllTerritoryCode_ALL_TERRITORY_CODE_AD, true
+	case "192":
+		return AllTerritoryCode_ALL_TERRITORY_CODE_AD, true
+	case "196":
+		return AllTerritoryCode_ALL_TERRITORY_CODE_AD, true
+	case "200":
+		return AllTerritoryCode_ALL_TERRITORY_CODE_AD, true
+	case "203":
+		return AllTerritoryCode_ALL_TERRITORY_CODE_AD, true
+	case "204":
+		return AllTerritoryCode_ALL_TERRITORY_CODE_AD, true
+	case "208":
+		return AllTerritoryCode_ALL_TERRITORY_CODE_AD, true
+	case "212":
+		return AllTerritoryCode_ALL_TERRITORY_CODE_AD, true
+	case "214":
+		return AllTerritoryCode_ALL_TERRITORY_CODE_AD, true
+	case "218":
+		return AllTerritoryCode_ALL_TERRITORY_CODE_AD, true
+	case "222":
+		return AllTerritoryCode_ALL_TERRITORY_CODE_AD, true
+	case "226":
+		return AllTerritoryCode_ALL_TERRITORY_CODE_AD, true
+	case "230":
+		return AllTerritoryCode_ALL_TERRITORY_CODE_AD, true
+	case "231":
+		return AllTerritoryCode_ALL_TERRITORY_CODE_AD, true
+	case "232":
+		return AllTerritoryCode_ALL_TERRITORY_CODE_AD, true
+	case "233":
+		return AllTerritoryCode_ALL_TERRITORY_CODE_AD, true
+	case "242":
+		return AllTerritoryCode_ALL_TERRITORY_CODE_AD, true
+	case "246":
+		return AllTerritoryCode_ALL_TERRITORY_CODE_AD, true
+	case "250":
+		return AllTerritoryCode_ALL_TERRITORY_CODE_AD, true
+	case "258":
+		return AllTerritoryCode_ALL_TERRITORY_CODE_AD, true
+	case "262":
+		return AllTerritoryCode_ALL_TERRITORY_CODE_AD, true
+	case "266":
+		return AllTerritoryCode_ALL_TERRITORY_CODE_AD, true
+	case "268":
+		return AllTerritoryCode_ALL_TERRITORY_CODE_AD, true
+	case "270":
+		return AllTerritoryCode_ALL_TERRITORY_CODE_AD, true
+	case "276":
+		return AllTerritoryCode_ALL_TERRITORY_CODE_AD, true
+	case "278":
+		return AllTerritoryCode_ALL_TERRITORY_CODE_AD, true
+	case "280":
+		return AllTerritoryCode_ALL_TERRITORY_CODE_AD, true
+	case "288":
+		return AllTerritoryCode_ALL_TERRITORY_CODE_AD, true
+	case "296":
+		return AllTerritoryCode_ALL_TERRITORY_CODE_AD, true
+	case "300":
+		return AllTerritoryCode_ALL_TERRITORY_CODE_AD, true
+	case "308":
+		return AllTerritoryCode_ALL_TERRITORY_CODE_AD, true
+	case "320":
+		return AllTerritoryCode_ALL_TERRITORY_CODE_AD, true
+	case "324":
+		return AllTerritoryCode_ALL_TERRITORY_CODE_AD, true
+	case "328":
+		return AllTerritoryCode_ALL_TERRITORY_CODE_AD, true
+	case "332":
+		return AllTerritoryCode_ALL_TERRITORY_CODE_AD, true
+	case "336":
+		return AllTerritoryCode_ALL_TERRITORY_CODE_AD, true
+	case "340":
+		return AllTerritoryCode_ALL_TERRITORY_CODE_AD, true
+	case "344":
+		return AllTerritoryCode_ALL_TERRITORY_CODE_AD, true
+	case "348":
+		return AllTerritoryCode_ALL_TERRITORY_CODE_AD, true
+	case "352":
+		return AllTerritoryCode_ALL_TERRITORY_CODE_AD, true
+	case "356":
+		return AllTerritoryCode_ALL_TERRITORY_CODE_AD, true
+	case "360":
+		return AllTerritoryCode_ALL_TERRITORY_CODE_AD, true
+	case "364":
+		return AllTerritoryCode_ALL_TERRITORY_CODE_AD, true
+	case "368":
+		return AllTerritoryCode_ALL_TERRITORY_CODE_AD, true
+	case "372":
+		return AllTerritoryCode_ALL_TERRITORY_CODE_AD, true
+	case "376":
+		return AllTerritoryCode_ALL_TERRITORY_CODE_AD, true
+	case "380":
+		return AllTerritoryCode_ALL_TERRITORY_CODE_AD, true
+	case "384":
+		return AllTerritoryCode_ALL_TERRITORY_CODE_AD, true
+	case "388":
+		return AllTerritoryCode_ALL_TERRITORY_CODE_AD, true
+	case "392":
+		return AllTerritoryCode_ALL_TERRITORY_CODE_AD, true
+	case "398":
+		return AllTerritoryCode_ALL_TERRITORY_CODE_AD, true
+	case "400":
+		return AllTerritoryCode_ALL_TERRITORY_CODE_AD, true
+	case "404":
+		return AllTerritoryCode_ALL_TERRITORY_CODE_AD, true
+	case "408":
+		return AllTerritoryCode_ALL_TERRITORY_CODE_AD, true
+	case "410":
+		return AllTerritoryCode_ALL_TERRITORY_CODE_AD, true
+	case "414":
+		return AllTerritoryCode_ALL_TERRITORY_CODE_AD, true
+	case "417":
+		return AllTerritoryCode_ALL_TERRITORY_CODE_AD, true
+	case "418":
+		return AllTerritoryCode_ALL_TERRITORY_CODE_AD, true
+	case "422":
+		return AllTerritoryCode_ALL_TERRITORY_CODE_AD, true
+	case "426":
+		return AllTerritoryCode_ALL_TERRITORY_CODE_AD, true
+	case "428":
+		return AllTerritoryCode_ALL_TERRITORY_CODE_AD, true
+	case "430":
+		return AllTerritoryCode_ALL_TERRITORY_CODE_AD, true
+	case "434":
+		return AllTerritoryCode_ALL_TERRITORY_CODE_AD, true
+	case "438":
+		return AllTerritoryCode_ALL_TERRITORY_CODE_AD, true
+	case "440":
+		return AllTerritoryCode_ALL_TERRITORY_CODE_AD, true
+	case "442":
+		return AllTerritoryCode_ALL_TERRITORY_CODE_AD, true
+	case "446":
+		return AllTerritoryCode_ALL_TERRITORY_CODE_AD, true
+	case "450":
+		return AllTerritoryCode_ALL_TERRITORY_CODE_AD, true
+	case "454":
+		return AllTerritoryCode_ALL_TERRITORY_CODE_AD, true
+	case "458":
+		return AllTerritoryCode_ALL_TERRITORY_CODE_AD, true
+	case "462":
+		return AllTerritoryCode_ALL_TERRITORY_CODE_AD, true
+	case "466":
+		return AllTerritoryCode_ALL_TERRITORY_CODE_AD, true
+	case "470":
+		return AllTerritoryCode_ALL_TERRITORY_CODE_AD, true
+	case "478":
+		return AllTerritoryCode_ALL_TERRITORY_CODE_AD, true
+	case "480":
+		return AllTerritoryCode_ALL_TERRITORY_CODE_AD, true
+	case "484":
+		return AllTerritoryCode_ALL_TERRITORY_CODE_AD, true
+	case "492":
+		return AllTerritoryCode_ALL_TERRITORY_CODE_AD, true
+	case "496":
+		return AllTerritoryCode_ALL_TERRITORY_CODE_AD, true
+	case "498":
+		return AllTerritoryCode_ALL_TERRITORY_CODE_AD, true
+	case "499":
+		return AllTerritoryCode_ALL_TERRITORY_CODE_AD, true
+	case "504":
+		return AllTerritoryCode_ALL_TERRITORY_CODE_AD, true
+	case "508":
+		return AllTerritoryCode_ALL_TERRITORY_CODE_AD, true
+	case "512":
+		return AllTerritoryCode_ALL_TERRITORY_CODE_AD, true
+	case "516":
+		return AllTerritoryCode_ALL_TERRITORY_CODE_AD, true
+	case "520":
+		return AllTerritoryCode_ALL_TERRITORY_CODE_AD, true
+	case "524":
+		return AllTerritoryCode_ALL_TERRITORY_CODE_AD, true
+	case "528":
+		return AllTerritoryCode_ALL_TERRITORY_CODE_AD, true
+	case "540":
+		return AllTerritoryCode_ALL_TERRITORY_CODE_AD, true
+	case "548":
+		return AllTerritoryCode_ALL_TERRITORY_CODE_AD, true
+	case "554":
+		return AllTerritoryCode_ALL_TERRITORY_CODE_AD, true
+	case "558":
+		return AllTerritoryCode_ALL_TERRITORY_CODE_AD, true
+	case "562":
+		return AllTerritoryCode_ALL_TERRITORY_CODE_AD, true
+	case "566":
+		return AllTerritoryCode_ALL_TERRITORY_CODE_AD, true
+	case "578":
+		return AllTerritoryCode_ALL_TERRITORY_CODE_AD, true
+	case "583":
+		return AllTerritoryCode_ALL_TERRITORY_CODE_AD, true
+	case "584":
+		return AllTerritoryCode_ALL_TERRITORY_CODE_AD, true
+	case "585":
+		return AllTerritoryCode_ALL_TERRITORY_CODE_AD, true
+	case "586":
+		return AllTerritoryCode_ALL_TERRITORY_CODE_AD, true
+	case "591":
+		return AllTerritoryCode_ALL_TERRITORY_CODE_AD, true
+	case "598":
+		return AllTerritoryCode_ALL_TERRITORY_CODE_AD, true
+	case "600":
+		return AllTerritoryCode_ALL_TERRITORY_CODE_AD, true
+	case "604":
+		return AllTerritoryCode_ALL_TERRITORY_CODE_AD, true
+	case "608":
+		return AllTerritoryCode_ALL_TERRITORY_CODE_AD, true
+	case "616":
+		return AllTerritoryCode_ALL_TERRITORY_CODE_AD, true
+	case "620":
+		return AllTerritoryCode_ALL_TERRITORY_CODE_AD, true
+	case "624":
+		return AllTerritoryCode_ALL_TERRITORY_CODE_AD, true
+	case "626":
+		return AllTerritoryCode_ALL_TERRITORY_CODE_AD, true
+	case "630":
+		return AllTerritoryCode_ALL_TERRITORY_CODE_AD, true
+	case "634":
+		return AllTerritoryCode_ALL_TERRITORY_CODE_AD, true
+	case "642":
+		return AllTerritoryCode_ALL_TERRITORY_CODE_AD, true
+	case "643":
+		return AllTerritoryCode_ALL_TERRITORY_CODE_AD, true
+	case "646":
+		return AllTerritoryCode_ALL_TERRITORY_CODE_AD, true
+	case "659":
+		return AllTerritoryCode_ALL_TERRITORY_CODE_AD, true
+	case "662":
+		return AllTerritoryCode_ALL_TERRITORY_CODE_AD, true
+	case "670":
+		return AllTerritoryCode_ALL_TERRITORY_CODE_AD, true
+	case "674":
+		return AllTerritoryCode_ALL_TERRITORY_CODE_AD, true
+	case "678":
+		return AllTerritoryCode_ALL_TERRITORY_CODE_AD, true
+	case "682":
+		return AllTerritoryCode_ALL_TERRITORY_CODE_AD, true
+	case "686":
+		return AllTerritoryCode_ALL_TERRITORY_CODE_AD, true
+	case "688":
+		return AllTerritoryCode_ALL_TERRITORY_CODE_AD, true
+	case "690":
+		return AllTerritoryCode_ALL_TERRITORY_CODE_AD, true
+	case "694":
+		return AllTerritoryCode_ALL_TERRITORY_CODE_AD, true
+	case "702":
+		return AllTerritoryCode_ALL_TERRITORY_CODE_AD, true
+	case "703":
+		return AllTerritoryCode_ALL_TERRITORY_CODE_AD, true
+	case "704":
+		return AllTerritoryCode_ALL_TERRITORY_CODE_AD, true
+	case "705":
+		return AllTerritoryCode_ALL_TERRITORY_CODE_AD, true
+	case "706":
+		return AllTerritoryCode_ALL_TERRITORY_CODE_AD, true
+	case "710":
+		return AllTerritoryCode_ALL_TERRITORY_CODE_AD, true
+	case "716":
+		return AllTerritoryCode_ALL_TERRITORY_CODE_AD, true
+	case "720":
+		return AllTerritoryCode_ALL_TERRITORY_CODE_AD, true
+	case "724":
+		return AllTerritoryCode_ALL_TERRITORY_CODE_AD, true
+	case "728":
+		return AllTerritoryCode_ALL_TERRITORY_CODE_AD, true
+	case "729":
+		return AllTerritoryCode_ALL_TERRITORY_CODE_AD, true
+	case "732":
+		return AllTerritoryCode_ALL_TERRITORY_CODE_AD, true
+	case "736":
+		return AllTerritoryCode_ALL_TERRITORY_CODE_AD, true
+	case "740":
+		return AllTerritoryCode_ALL_TERRITORY_CODE_AD, true
+	case "748":
+		return AllTerritoryCode_ALL_TERRITORY_CODE_AD, true
+	case "752":
+		return AllTerritoryCode_ALL_TERRITORY_CODE_AD, true
+	case "756":
+		return AllTerritoryCode_ALL_TERRITORY_CODE_AD, true
+	case "760":
+		return AllTerritoryCode_ALL_TERRITORY_CODE_AD, true
+	case "762":
+		return AllTerritoryCode_ALL_TERRITORY_CODE_AD, true
+	case "764":
+		return AllTerritoryCode_ALL_TERRITORY_CODE_AD, true
+	case "768":
+		return AllTerritoryCode_ALL_TERRITORY_CODE_AD, true
+	case "776":
+		return AllTerritoryCode_ALL_TERRITORY_CODE_AD, true
+	case "780":
+		return AllTerritoryCode_ALL_TERRITORY_CODE_AD, true
+	case "784":
+		return AllTerritoryCode_ALL_TERRITORY_CODE_AD, true
+	case "788":
+		return AllTerritoryCode_ALL_TERRITORY_CODE_AD, true
+	case "792":
+		return AllTerritoryCode_ALL_TERRITORY_CODE_AD, true
+	case "795":
+		return AllTerritoryCode_ALL_TERRITORY_CODE_AD, true
+	case "798":
+		return AllTerritoryCode_ALL_TERRITORY_CODE_AD, true
+	case "800":
+		return AllTerritoryCode_ALL_TERRITORY_CODE_AD, true
+	case "804":
+		return AllTerritoryCode_ALL_TERRITORY_CODE_AD, true
+	case "807":
+		return AllTerritoryCode_ALL_TERRITORY_CODE_AD, true
+	case "810":
+		return AllTerritoryCode_ALL_TERRITORY_CODE_AD, true
+	case "818":
+		return AllTerritoryCode_ALL_TERRITORY_CODE_AD, true
+	case "826":
+		return AllTerritoryCode_ALL_TERRITORY_CODE_AD, true
+	case "834":
+		return AllTerritoryCode_ALL_TERRITORY_CODE_AD, true
+	case "840":
+		return AllTerritoryCode_ALL_TERRITORY_CODE_AD, true
+	case "854":
+		return AllTerritoryCode_ALL_TERRITORY_CODE_AD, true
+	case "858":
+		return AllTerritoryCode_ALL_TERRITORY_CODE_AD, true
+	case "860":
+		return AllTerritoryCode_ALL_TERRITORY_CODE_AD, true
+	case "862":
+		return AllTerritoryCode_ALL_TERRITORY_CODE_AD, true
+	case "882":
+		return AllTerritoryCode_ALL_TERRITORY_CODE_AD, true
+	case "886":
+		return AllTerritoryCode_ALL_TERRITORY_CODE_AD, true
+	case "887":
+		return AllTerritoryCode_ALL_TERRITORY_CODE_AD, true
+	case "890":
+		return AllTerritoryCode_ALL_TERRITORY_CODE_AD, true
+	case "891":
+		return AllTerritoryCode_ALL_TERRITORY_CODE_AD, true
+	case "894":
+		return AllTerritoryCode_ALL_TERRITORY_CODE_AD, true
+	case "2100":
+		return AllTerritoryCode_ALL_TERRITORY_CODE_AD, true
+	case "2101":
+		return AllTerritoryCode_ALL_TERRITORY_CODE_AD, true
+	case "2102":
+		return AllTerritoryCode_ALL_TERRITORY_CODE_AD, true
+	case "2103":
+		return AllTerritoryCode_ALL_TERRITORY_CODE_AD, true
+	case "2104":
+		return AllTerritoryCode_ALL_TERRITORY_CODE_AD, true
+	case "2105":
+		return AllTerritoryCode_ALL_TERRITORY_CODE_AD, true
+	case "2106":
+		return AllTerritoryCode_ALL_TERRITORY_CODE_AD, true
+	case "2107":
+		return AllTerritoryCode_ALL_TERRITORY_CODE_AD, true
+	case "2108":
+		return AllTerritoryCode_ALL_TERRITORY_CODE_AD, true
+	case "2109":
+		return AllTerritoryCode_ALL_TERRITORY_CODE_AD, true
+	case "2110":
+		return AllTerritoryCode_ALL_TERRITORY_CODE_AD, true
+	case "2111":
+		return AllTerritoryCode_ALL_TERRITORY_CODE_AD, true
+	case "2112":
+		return AllTerritoryCode_ALL_TERRITORY_CODE_AD, true
+	case "2113":
+		return AllTerritoryCode_ALL_TERRITORY_CODE_AD, true
+	case "2114":
+		return AllTerritoryCode_ALL_TERRITORY_CODE_AD, true
+	case "2115":
+		return AllTerritoryCode_ALL_TERRITORY_CODE_AD, true
+	case "2116":
+		return AllTerritoryCode_ALL_TERRITORY_CODE_AD, true
+	case "2117":
+		return AllTerritoryCode_ALL_TERRITORY_CODE_AD, true
+	case "2118":
+		return AllTerritoryCode_ALL_TERRITORY_CODE_AD, true
+	case "2119":
+		return AllTerritoryCode_ALL_TERRITORY_CODE_AD, true
+	case "2120":
+		return AllTerritoryCode_ALL_TERRITORY_CODE_AD, true
+	case "2121":
+		return AllTerritoryCode_ALL_TERRITORY_CODE_AD, true
+	case "2122":
+		return AllTerritoryCode_ALL_TERRITORY_CODE_AD, true
+	case "2123":
+		return AllTerritoryCode_ALL_TERRITORY_CODE_AD, true
+	case "2124":
+		return AllTerritoryCode_ALL_TERRITORY_CODE_AD, true
+	case "2125":
+		return AllTerritoryCode_ALL_TERRITORY_CODE_AD, true
+	case "2126":
+		return AllTerritoryCode_ALL_TERRITORY_CODE_AD, true
+	case "2127":
+		return AllTerritoryCode_ALL_TERRITORY_CODE_AD, true
+	case "2128":
+		return AllTerritoryCode_ALL_TERRITORY_CODE_AD, true
+	case "2129":
+		return AllTerritoryCode_ALL_TERRITORY_CODE_AD, true
+	case "2130":
+		return AllTerritoryCode_ALL_TERRITORY_CODE_AD, true
+	case "2131":
+		return AllTerritoryCode_ALL_TERRITORY_CODE_AD, true
+	case "2132":
+		return AllTerritoryCode_ALL_TERRITORY_CODE_AD, true
+	case "2133":
+		return AllTerritoryCode_ALL_TERRITORY_CODE_AD, true
+	case "2134":
+		return AllTerritoryCode_ALL_TERRITORY_CODE_AD, true
+	case "2136":
+		return AllTerritoryCode_ALL_TERRITORY_CODE_AD, true
+	case "XK":
+		return AllTerritoryCode_ALL_TERRITORY_CODE_XK, true
+	case "WORLDWIDE":
+		return AllTerritoryCode_ALL_TERRITORY_CODE_WORLDWIDE, true
+	case "AIDJ":
+		return AllTerritoryCode_ALL_TERRITORY_CODE_AIDJ, true
+	case "ANHH":
+		return AllTerritoryCode_ALL_TERRITORY_CODE_ANHH, true
+	case "BQAQ":
+		return AllTerritoryCode_ALL_TERRITORY_CODE_BQAQ, true
+	case "BUMM":
+		return AllTerritoryCode_ALL_TERRITORY_CODE_BUMM, true
+	case "BYAA":
+		return AllTerritoryCode_ALL_TERRITORY_CODE_BYAA, true
+	case "CSHH":
+		return AllTerritoryCode_ALL_TERRITORY_CODE_CSHH, true
+	case "CSXX":
+		return AllTerritoryCode_ALL_TERRITORY_CODE_CSXX, true
+	case "CTKI":
+		return AllTerritoryCode_ALL_TERRITORY_CODE_CTKI, true
+	case "DDDE":
+		return AllTerritoryCode_ALL_TERRITORY_CODE_DDDE, true
+	case "DYBJ":
+		return AllTerritoryCode_ALL_TERRITORY_CODE_DYBJ, true
+	case "FQHH":
+		return AllTerritoryCode_ALL_TERRITORY_CODE_FQHH, true
+	case "FXFR":
+		return AllTerritoryCode_ALL_TERRITORY_CODE_FXFR, true
+	case "GEHH":
+		return AllTerritoryCode_ALL_TERRITORY_CODE_GEHH, true
+	case "HVBF":
+		return AllTerritoryCode_ALL_TERRITORY_CODE_HVBF, true
+	case "JTUM":
+		return AllTerritoryCode_ALL_TERRITORY_CODE_JTUM, true
+	case "MIUM":
+		return AllTerritoryCode_ALL_TERRITORY_CODE_MIUM, true
+	case "NHVU":
+		return AllTerritoryCode_ALL_TERRITORY_CODE_NHVU, true
+	case "NQAQ":
+		return AllTerritoryCode_ALL_TERRITORY_CODE_NQAQ, true
+	case "NTHH":
+		return AllTerritoryCode_ALL_TERRITORY_CODE_NTHH, true
+	case "PCHH":
+		return AllTerritoryCode_ALL_TERRITORY_CODE_PCHH, true
+	case "PUUM":
+		return AllTerritoryCode_ALL_TERRITORY_CODE_PUUM, true
+	case "PZPA":
+		return AllTerritoryCode_ALL_TERRITORY_CODE_PZPA, true
+	case "RHZW":
+		return AllTerritoryCode_ALL_TERRITORY_CODE_RHZW, true
+	case "SKIN":
+		return AllTerritoryCode_ALL_TERRITORY_CODE_SKIN, true
+	case "SUHH":
+		return AllTerritoryCode_ALL_TERRITORY_CODE_SUHH, true
+	case "TPTL":
+		return AllTerritoryCode_ALL_TERRITORY_CODE_TPTL, true
+	case "VDVN":
+		return AllTerritoryCode_ALL_TERRITORY_CODE_VDVN, true
+	case "WKUM":
+		return AllTerritoryCode_ALL_TERRITORY_CODE_WKUM, true
+	case "YDYE":
+		return AllTerritoryCode_ALL_TERRITORY_CODE_YDYE, true
+	case "YUCS":
+		return AllTerritoryCode_ALL_TERRITORY_CODE_YUCS, true
+	case "ZRCD":
+		return AllTerritoryCode_ALL_TERRITORY_CODE_ZRCD, true
 	default:
 		return AllTerritoryCode(0), false
 	}
@@ -58,6 +2223,122 @@ func ParseAllTerritoryCodeString(s string) (AllTerritoryCode, bool) {
 // XMLString returns the XML string representation of ArtistRole
 func (e ArtistRole) XMLString() string {
 	switch e {
+	case ArtistRole_ARTIST_ROLE_ACTOR:
+		return "ACTOR"
+	case ArtistRole_ARTIST_ROLE_ADAPTER:
+		return "ADAPTER"
+	case ArtistRole_ARTIST_ROLE_ARCHITECT:
+		return "ARCHITECT"
+	case ArtistRole_ARTIST_ROLE_ARRANGER:
+		return "ARRANGER"
+	case ArtistRole_ARTIST_ROLE_ARTIST:
+		return "ARTIST"
+	case ArtistRole_ARTIST_ROLE_ASSOCIATEDPERFORMER:
+		return "ASSOCIATEDPERFORMER"
+	case ArtistRole_ARTIST_ROLE_AUTHOR:
+		return "AUTHOR"
+	case ArtistRole_ARTIST_ROLE_BAND:
+		return "BAND"
+	case ArtistRole_ARTIST_ROLE_CARTOONIST:
+		return "CARTOONIST"
+	case ArtistRole_ARTIST_ROLE_CHOIR:
+		return "CHOIR"
+	case ArtistRole_ARTIST_ROLE_CHOREOGRAPHER:
+		return "CHOREOGRAPHER"
+	case ArtistRole_ARTIST_ROLE_COMPOSER:
+		return "COMPOSER"
+	case ArtistRole_ARTIST_ROLE_COMPOSERLYRICIST:
+		return "COMPOSERLYRICIST"
+	case ArtistRole_ARTIST_ROLE_COMPUTERGRAPHICCREATOR:
+		return "COMPUTERGRAPHICCREATOR"
+	case ArtistRole_ARTIST_ROLE_CONDUCTOR:
+		return "CONDUCTOR"
+	case ArtistRole_ARTIST_ROLE_CONTRIBUTOR:
+		return "CONTRIBUTOR"
+	case ArtistRole_ARTIST_ROLE_DANCER:
+		return "DANCER"
+	case ArtistRole_ARTIST_ROLE_DESIGNER:
+		return "DESIGNER"
+	case ArtistRole_ARTIST_ROLE_DIRECTOR:
+		return "DIRECTOR"
+	case ArtistRole_ARTIST_ROLE_ENSEMBLE:
+		return "ENSEMBLE"
+	case ArtistRole_ARTIST_ROLE_FEATUREDARTIST:
+		return "FEATUREDARTIST"
+	case ArtistRole_ARTIST_ROLE_FILMDIRECTOR:
+		return "FILMDIRECTOR"
+	case ArtistRole_ARTIST_ROLE_GRAPHICARTIST:
+		return "GRAPHICARTIST"
+	case ArtistRole_ARTIST_ROLE_GRAPHICDESIGNER:
+		return "GRAPHICDESIGNER"
+	case ArtistRole_ARTIST_ROLE_JOURNALIST:
+		return "JOURNALIST"
+	case ArtistRole_ARTIST_ROLE_LIBRETTIST:
+		return "LIBRETTIST"
+	case ArtistRole_ARTIST_ROLE_LYRICIST:
+		return "LYRICIST"
+	case ArtistRole_ARTIST_ROLE_MAINARTIST:
+		return "MAINARTIST"
+	case ArtistRole_ARTIST_ROLE_NARRATOR:
+		return "NARRATOR"
+	case ArtistRole_ARTIST_ROLE_NONLYRICAUTHOR:
+		return "NONLYRICAUTHOR"
+	case ArtistRole_ARTIST_ROLE_ORCHESTRA:
+		return "ORCHESTRA"
+	case ArtistRole_ARTIST_ROLE_ORIGINALPUBLISHER:
+		return "ORIGINALPUBLISHER"
+	case ArtistRole_ARTIST_ROLE_PAINTER:
+		return "PAINTER"
+	case ArtistRole_ARTIST_ROLE_PHOTOGRAPHER:
+		return "PHOTOGRAPHER"
+	case ArtistRole_ARTIST_ROLE_PHOTOGRAPHYDIRECTOR:
+		return "PHOTOGRAPHYDIRECTOR"
+	case ArtistRole_ARTIST_ROLE_PLAYWRIGHT:
+		return "PLAYWRIGHT"
+	case ArtistRole_ARTIST_ROLE_PRIMARYMUSICIAN:
+		return "PRIMARYMUSICIAN"
+	case ArtistRole_ARTIST_ROLE_PRODUCER:
+		return "PRODUCER"
+	case ArtistRole_ARTIST_ROLE_PROGRAMMER:
+		return "PROGRAMMER"
+	case ArtistRole_ARTIST_ROLE_SCREENPLAYAUTHOR:
+		return "SCREENPLAYAUTHOR"
+	case ArtistRole_ARTIST_ROLE_SOLOIST:
+		return "SOLOIST"
+	case ArtistRole_ARTIST_ROLE_STUDIOMUSICIAN:
+		return "STUDIOMUSICIAN"
+	case ArtistRole_ARTIST_ROLE_STUDIOPERSONNEL:
+		return "STUDIOPERSONNEL"
+	case ArtistRole_ARTIST_ROLE_SUBARRANGER:
+		return "SUBARRANGER"
+	case ArtistRole_ARTIST_ROLE_TRANSLATOR:
+		return "TRANSLATOR"
+	case ArtistRole_ARTIST_ROLE_UNKNOWN:
+		return "UNKNOWN"
+	case ArtistRole_ARTIST_ROLE_USERDEFINED:
+		return "USERDEFINED"
+	case ArtistRole_ARTIST_ROLE_ARTCOPYIST:
+		return "ARTCOPYIST"
+	case ArtistRole_ARTIST_ROLE_CALLIGRAPHER:
+		return "CALLIGRAPHER"
+	case ArtistRole_ARTIST_ROLE_CARTOGRAPHER:
+		return "CARTOGRAPHER"
+	case ArtistRole_ARTIST_ROLE_COMPUTERPROGRAMMER:
+		return "COMPUTERPROGRAMMER"
+	case ArtistRole_ARTIST_ROLE_DELINEATOR:
+		return "DELINEATOR"
+	case ArtistRole_ARTIST_ROLE_DRAUGHTSMAN:
+		return "DRAUGHTSMAN"
+	case ArtistRole_ARTIST_ROLE_FACSIMILIST:
+		return "FACSIMILIST"
+	case ArtistRole_ARTIST_ROLE_ILLUSTRATOR:
+		return "ILLUSTRATOR"
+	case ArtistRole_ARTIST_ROLE_MUSICCOPYIST:
+		return "MUSICCOPYIST"
+	case ArtistRole_ARTIST_ROLE_NOTSPECIFIED:
+		return "NOTSPECIFIED"
+	case ArtistRole_ARTIST_ROLE_TYPEDESIGNER:
+		return "TYPEDESIGNER"
 	default:
 		return ""
 	}
@@ -67,6 +2348,122 @@ func (e ArtistRole) XMLString() string {
 func ParseArtistRoleString(s string) (ArtistRole, bool) {
 	s = strings.ToUpper(s)
 	switch s {
+	case "ACTOR":
+		return ArtistRole_ARTIST_ROLE_ACTOR, true
+	case "ADAPTER":
+		return ArtistRole_ARTIST_ROLE_ADAPTER, true
+	case "ARCHITECT":
+		return ArtistRole_ARTIST_ROLE_ARCHITECT, true
+	case "ARRANGER":
+		return ArtistRole_ARTIST_ROLE_ARRANGER, true
+	case "ARTIST":
+		return ArtistRole_ARTIST_ROLE_ARTIST, true
+	case "ASSOCIATEDPERFORMER":
+		return ArtistRole_ARTIST_ROLE_ASSOCIATEDPERFORMER, true
+	case "AUTHOR":
+		return ArtistRole_ARTIST_ROLE_AUTHOR, true
+	case "BAND":
+		return ArtistRole_ARTIST_ROLE_BAND, true
+	case "CARTOONIST":
+		return ArtistRole_ARTIST_ROLE_CARTOONIST, true
+	case "CHOIR":
+		return ArtistRole_ARTIST_ROLE_CHOIR, true
+	case "CHOREOGRAPHER":
+		return ArtistRole_ARTIST_ROLE_CHOREOGRAPHER, true
+	case "COMPOSER":
+		return ArtistRole_ARTIST_ROLE_COMPOSER, true
+	case "COMPOSERLYRICIST":
+		return ArtistRole_ARTIST_ROLE_COMPOSERLYRICIST, true
+	case "COMPUTERGRAPHICCREATOR":
+		return ArtistRole_ARTIST_ROLE_COMPUTERGRAPHICCREATOR, true
+	case "CONDUCTOR":
+		return ArtistRole_ARTIST_ROLE_CONDUCTOR, true
+	case "CONTRIBUTOR":
+		return ArtistRole_ARTIST_ROLE_CONTRIBUTOR, true
+	case "DANCER":
+		return ArtistRole_ARTIST_ROLE_DANCER, true
+	case "DESIGNER":
+		return ArtistRole_ARTIST_ROLE_DESIGNER, true
+	case "DIRECTOR":
+		return ArtistRole_ARTIST_ROLE_DIRECTOR, true
+	case "ENSEMBLE":
+		return ArtistRole_ARTIST_ROLE_ENSEMBLE, true
+	case "FEATUREDARTIST":
+		return ArtistRole_ARTIST_ROLE_FEATUREDARTIST, true
+	case "FILMDIRECTOR":
+		return ArtistRole_ARTIST_ROLE_FILMDIRECTOR, true
+	case "GRAPHICARTIST":
+		return ArtistRole_ARTIST_ROLE_GRAPHICARTIST, true
+	case "GRAPHICDESIGNER":
+		return ArtistRole_ARTIST_ROLE_GRAPHICDESIGNER, true
+	case "JOURNALIST":
+		return ArtistRole_ARTIST_ROLE_JOURNALIST, true
+	case "LIBRETTIST":
+		return ArtistRole_ARTIST_ROLE_LIBRETTIST, true
+	case "LYRICIST":
+		return ArtistRole_ARTIST_ROLE_LYRICIST, true
+	case "MAINARTIST":
+		return ArtistRole_ARTIST_ROLE_MAINARTIST, true
+	case "NARRATOR":
+		return ArtistRole_ARTIST_ROLE_NARRATOR, true
+	case "NONLYRICAUTHOR":
+		return ArtistRole_ARTIST_ROLE_NONLYRICAUTHOR, true
+	case "ORCHESTRA":
+		return ArtistRole_ARTIST_ROLE_ORCHESTRA, true
+	case "ORIGINALPUBLISHER":
+		return ArtistRole_ARTIST_ROLE_ORIGINALPUBLISHER, true
+	case "PAINTER":
+		return ArtistRole_ARTIST_ROLE_PAINTER, true
+	case "PHOTOGRAPHER":
+		return ArtistRole_ARTIST_ROLE_PHOTOGRAPHER, true
+	case "PHOTOGRAPHYDIRECTOR":
+		return ArtistRole_ARTIST_ROLE_PHOTOGRAPHYDIRECTOR, true
+	case "PLAYWRIGHT":
+		return ArtistRole_ARTIST_ROLE_PLAYWRIGHT, true
+	case "PRIMARYMUSICIAN":
+		return ArtistRole_ARTIST_ROLE_PRIMARYMUSICIAN, true
+	case "PRODUCER":
+		return ArtistRole_ARTIST_ROLE_PRODUCER, true
+	case "PROGRAMMER":
+		return ArtistRole_ARTIST_ROLE_PROGRAMMER, true
+	case "SCREENPLAYAUTHOR":
+		return ArtistRole_ARTIST_ROLE_SCREENPLAYAUTHOR, true
+	case "SOLOIST":
+		return ArtistRole_ARTIST_ROLE_SOLOIST, true
+	case "STUDIOMUSICIAN":
+		return ArtistRole_ARTIST_ROLE_STUDIOMUSICIAN, true
+	case "STUDIOPERSONNEL":
+		return ArtistRole_ARTIST_ROLE_STUDIOPERSONNEL, true
+	case "SUBARRANGER":
+		return ArtistRole_ARTIST_ROLE_SUBARRANGER, true
+	case "TRANSLATOR":
+		return ArtistRole_ARTIST_ROLE_TRANSLATOR, true
+	case "UNKNOWN":
+		return ArtistRole_ARTIST_ROLE_UNKNOWN, true
+	case "USERDEFINED":
+		return ArtistRole_ARTIST_ROLE_USERDEFINED, true
+	case "ARTCOPYIST":
+		return ArtistRole_ARTIST_ROLE_ARTCOPYIST, true
+	case "CALLIGRAPHER":
+		return ArtistRole_ARTIST_ROLE_CALLIGRAPHER, true
+	case "CARTOGRAPHER":
+		return ArtistRole_ARTIST_ROLE_CARTOGRAPHER, true
+	case "COMPUTERPROGRAMMER":
+		return ArtistRole_ARTIST_ROLE_COMPUTERPROGRAMMER, true
+	case "DELINEATOR":
+		return ArtistRole_ARTIST_ROLE_DELINEATOR, true
+	case "DRAUGHTSMAN":
+		return ArtistRole_ARTIST_ROLE_DRAUGHTSMAN, true
+	case "FACSIMILIST":
+		return ArtistRole_ARTIST_ROLE_FACSIMILIST, true
+	case "ILLUSTRATOR":
+		return ArtistRole_ARTIST_ROLE_ILLUSTRATOR, true
+	case "MUSICCOPYIST":
+		return ArtistRole_ARTIST_ROLE_MUSICCOPYIST, true
+	case "NOTSPECIFIED":
+		return ArtistRole_ARTIST_ROLE_NOTSPECIFIED, true
+	case "TYPEDESIGNER":
+		return ArtistRole_ARTIST_ROLE_TYPEDESIGNER, true
 	default:
 		return ArtistRole(0), false
 	}
@@ -75,6 +2472,50 @@ func ParseArtistRoleString(s string) (ArtistRole, bool) {
 // XMLString returns the XML string representation of AudioCodecType
 func (e AudioCodecType) XMLString() string {
 	switch e {
+	case AudioCodecType_AUDIO_CODEC_TYPE_AAC:
+		return "AAC"
+	case AudioCodecType_AUDIO_CODEC_TYPE_ADPCM:
+		return "ADPCM"
+	case AudioCodecType_AUDIO_CODEC_TYPE_ALAW:
+		return "ALAW"
+	case AudioCodecType_AUDIO_CODEC_TYPE_AMR_NB:
+		return "AMR_NB"
+	case AudioCodecType_AUDIO_CODEC_TYPE_AMR_WB:
+		return "AMR_WB"
+	case AudioCodecType_AUDIO_CODEC_TYPE_FLAC:
+		return "FLAC"
+	case AudioCodecType_AUDIO_CODEC_TYPE_MP2:
+		return "MP2"
+	case AudioCodecType_AUDIO_CODEC_TYPE_MP3:
+		return "MP3"
+	case AudioCodecType_AUDIO_CODEC_TYPE_MULAW:
+		return "MULAW"
+	case AudioCodecType_AUDIO_CODEC_TYPE_PCM:
+		return "PCM"
+	case AudioCodecType_AUDIO_CODEC_TYPE_PDM:
+		return "PDM"
+	case AudioCodecType_AUDIO_CODEC_TYPE_QCELP:
+		return "QCELP"
+	case AudioCodecType_AUDIO_CODEC_TYPE_REALAUDIO:
+		return "REALAUDIO"
+	case AudioCodecType_AUDIO_CODEC_TYPE_SHOCKWAVE:
+		return "SHOCKWAVE"
+	case AudioCodecType_AUDIO_CODEC_TYPE_UNKNOWN:
+		return "UNKNOWN"
+	case AudioCodecType_AUDIO_CODEC_TYPE_USERDEFINED:
+		return "USERDEFINED"
+	case AudioCodecType_AUDIO_CODEC_TYPE_VORBIS:
+		return "VORBIS"
+	case AudioCodecType_AUDIO_CODEC_TYPE_WMA:
+		return "WMA"
+	case AudioCodecType_AUDIO_CODEC_TYPE_AMR:
+		return "AMR"
+	case AudioCodecType_AUDIO_CODEC_TYPE_ATMOS:
+		return "ATMOS"
+	case AudioCodecType_AUDIO_CODEC_TYPE_MP:
+		return "MP"
+	case AudioCodecType_AUDIO_CODEC_TYPE_MQA:
+		return "MQA"
 	default:
 		return ""
 	}
@@ -84,6 +2525,50 @@ func (e AudioCodecType) XMLString() string {
 func ParseAudioCodecTypeString(s string) (AudioCodecType, bool) {
 	s = strings.ToUpper(s)
 	switch s {
+	case "AAC":
+		return AudioCodecType_AUDIO_CODEC_TYPE_AAC, true
+	case "ADPCM":
+		return AudioCodecType_AUDIO_CODEC_TYPE_ADPCM, true
+	case "ALAW":
+		return AudioCodecType_AUDIO_CODEC_TYPE_ALAW, true
+	case "AMR_NB":
+		return AudioCodecType_AUDIO_CODEC_TYPE_AMR_NB, true
+	case "AMR_WB":
+		return AudioCodecType_AUDIO_CODEC_TYPE_AMR_WB, true
+	case "FLAC":
+		return AudioCodecType_AUDIO_CODEC_TYPE_FLAC, true
+	case "MP2":
+		return AudioCodecType_AUDIO_CODEC_TYPE_MP2, true
+	case "MP3":
+		return AudioCodecType_AUDIO_CODEC_TYPE_MP3, true
+	case "MULAW":
+		return AudioCodecType_AUDIO_CODEC_TYPE_MULAW, true
+	case "PCM":
+		return AudioCodecType_AUDIO_CODEC_TYPE_PCM, true
+	case "PDM":
+		return AudioCodecType_AUDIO_CODEC_TYPE_PDM, true
+	case "QCELP":
+		return AudioCodecType_AUDIO_CODEC_TYPE_QCELP, true
+	case "REALAUDIO":
+		return AudioCodecType_AUDIO_CODEC_TYPE_REALAUDIO, true
+	case "SHOCKWAVE":
+		return AudioCodecType_AUDIO_CODEC_TYPE_SHOCKWAVE, true
+	case "UNKNOWN":
+		return AudioCodecType_AUDIO_CODEC_TYPE_UNKNOWN, true
+	case "USERDEFINED":
+		return AudioCodecType_AUDIO_CODEC_TYPE_USERDEFINED, true
+	case "VORBIS":
+		return AudioCodecType_AUDIO_CODEC_TYPE_VORBIS, true
+	case "WMA":
+		return AudioCodecType_AUDIO_CODEC_TYPE_WMA, true
+	case "AMR":
+		return AudioCodecType_AUDIO_CODEC_TYPE_AMR, true
+	case "ATMOS":
+		return AudioCodecType_AUDIO_CODEC_TYPE_ATMOS, true
+	case "MP":
+		return AudioCodecType_AUDIO_CODEC_TYPE_MP, true
+	case "MQA":
+		return AudioCodecType_AUDIO_CODEC_TYPE_MQA, true
 	default:
 		return AudioCodecType(0), false
 	}
@@ -92,6 +2577,10 @@ func ParseAudioCodecTypeString(s string) (AudioCodecType, bool) {
 // XMLString returns the XML string representation of BinaryDataType
 func (e BinaryDataType) XMLString() string {
 	switch e {
+	case BinaryDataType_BINARY_DATA_TYPE_BINARY64:
+		return "BINARY64"
+	case BinaryDataType_BINARY_DATA_TYPE_HEXBINARY:
+		return "HEXBINARY"
 	default:
 		return ""
 	}
@@ -101,6 +2590,10 @@ func (e BinaryDataType) XMLString() string {
 func ParseBinaryDataTypeString(s string) (BinaryDataType, bool) {
 	s = strings.ToUpper(s)
 	switch s {
+	case "BINARY64":
+		return BinaryDataType_BINARY_DATA_TYPE_BINARY64, true
+	case "HEXBINARY":
+		return BinaryDataType_BINARY_DATA_TYPE_HEXBINARY, true
 	default:
 		return BinaryDataType(0), false
 	}
@@ -109,6 +2602,20 @@ func ParseBinaryDataTypeString(s string) (BinaryDataType, bool) {
 // XMLString returns the XML string representation of BusinessContributorRole
 func (e BusinessContributorRole) XMLString() string {
 	switch e {
+	case BusinessContributorRole_BUSINESS_CONTRIBUTOR_ROLE_CONTRIBUTOR:
+		return "CONTRIBUTOR"
+	case BusinessContributorRole_BUSINESS_CONTRIBUTOR_ROLE_MUSICPUBLISHER:
+		return "MUSICPUBLISHER"
+	case BusinessContributorRole_BUSINESS_CONTRIBUTOR_ROLE_ORIGINALPUBLISHER:
+		return "ORIGINALPUBLISHER"
+	case BusinessContributorRole_BUSINESS_CONTRIBUTOR_ROLE_SUBPUBLISHER:
+		return "SUBPUBLISHER"
+	case BusinessContributorRole_BUSINESS_CONTRIBUTOR_ROLE_SUBSTITUTEDPUBLISHER:
+		return "SUBSTITUTEDPUBLISHER"
+	case BusinessContributorRole_BUSINESS_CONTRIBUTOR_ROLE_UNKNOWN:
+		return "UNKNOWN"
+	case BusinessContributorRole_BUSINESS_CONTRIBUTOR_ROLE_USERDEFINED:
+		return "USERDEFINED"
 	default:
 		return ""
 	}
@@ -118,6 +2625,20 @@ func (e BusinessContributorRole) XMLString() string {
 func ParseBusinessContributorRoleString(s string) (BusinessContributorRole, bool) {
 	s = strings.ToUpper(s)
 	switch s {
+	case "CONTRIBUTOR":
+		return BusinessContributorRole_BUSINESS_CONTRIBUTOR_ROLE_CONTRIBUTOR, true
+	case "MUSICPUBLISHER":
+		return BusinessContributorRole_BUSINESS_CONTRIBUTOR_ROLE_MUSICPUBLISHER, true
+	case "ORIGINALPUBLISHER":
+		return BusinessContributorRole_BUSINESS_CONTRIBUTOR_ROLE_ORIGINALPUBLISHER, true
+	case "SUBPUBLISHER":
+		return BusinessContributorRole_BUSINESS_CONTRIBUTOR_ROLE_SUBPUBLISHER, true
+	case "SUBSTITUTEDPUBLISHER":
+		return BusinessContributorRole_BUSINESS_CONTRIBUTOR_ROLE_SUBSTITUTEDPUBLISHER, true
+	case "UNKNOWN":
+		return BusinessContributorRole_BUSINESS_CONTRIBUTOR_ROLE_UNKNOWN, true
+	case "USERDEFINED":
+		return BusinessContributorRole_BUSINESS_CONTRIBUTOR_ROLE_USERDEFINED, true
 	default:
 		return BusinessContributorRole(0), false
 	}
@@ -126,6 +2647,230 @@ func ParseBusinessContributorRoleString(s string) (BusinessContributorRole, bool
 // XMLString returns the XML string representation of CarrierType
 func (e CarrierType) XMLString() string {
 	switch e {
+	case CarrierType_CARRIER_TYPE_E_12INCHDISCOSINGLEREMIX:
+		return "12INCHDISCOSINGLEREMIX"
+	case CarrierType_CARRIER_TYPE_E_33RPM10INCHLP:
+		return "33RPM10INCHLP"
+	case CarrierType_CARRIER_TYPE_E_33RPM10INCHSINGLE:
+		return "33RPM10INCHSINGLE"
+	case CarrierType_CARRIER_TYPE_E_33RPM12INCHLP:
+		return "33RPM12INCHLP"
+	case CarrierType_CARRIER_TYPE_E_33RPM12INCHLP20TRACKS:
+		return "33RPM12INCHLP20TRACKS"
+	case CarrierType_CARRIER_TYPE_E_33RPM12INCHMAXISINGLE:
+		return "33RPM12INCHMAXISINGLE"
+	case CarrierType_CARRIER_TYPE_E_33RPM12INCHSINGLE:
+		return "33RPM12INCHSINGLE"
+	case CarrierType_CARRIER_TYPE_E_33RPM7INCHLP:
+		return "33RPM7INCHLP"
+	case CarrierType_CARRIER_TYPE_E_33RPM7INCHSINGLE:
+		return "33RPM7INCHSINGLE"
+	case CarrierType_CARRIER_TYPE_E_45RPM10INCHLP:
+		return "45RPM10INCHLP"
+	case CarrierType_CARRIER_TYPE_E_45RPM10INCHMAXISINGLE:
+		return "45RPM10INCHMAXISINGLE"
+	case CarrierType_CARRIER_TYPE_E_45RPM10INCHSINGLE:
+		return "45RPM10INCHSINGLE"
+	case CarrierType_CARRIER_TYPE_E_45RPM12INCHLP:
+		return "45RPM12INCHLP"
+	case CarrierType_CARRIER_TYPE_E_45RPM12INCHMAXISINGLE:
+		return "45RPM12INCHMAXISINGLE"
+	case CarrierType_CARRIER_TYPE_E_45RPM12INCHSINGLE:
+		return "45RPM12INCHSINGLE"
+	case CarrierType_CARRIER_TYPE_E_45RPM7INCHEP:
+		return "45RPM7INCHEP"
+	case CarrierType_CARRIER_TYPE_E_45RPM7INCHSINGLE:
+		return "45RPM7INCHSINGLE"
+	case CarrierType_CARRIER_TYPE_E_7INCHMAXISINGLEREMIX:
+		return "7INCHMAXISINGLEREMIX"
+	case CarrierType_CARRIER_TYPE_BLURAY:
+		return "BLURAY"
+	case CarrierType_CARRIER_TYPE_CD:
+		return "CD"
+	case CarrierType_CARRIER_TYPE_CDCOMPILATION:
+		return "CDCOMPILATION"
+	case CarrierType_CARRIER_TYPE_CDEP:
+		return "CDEP"
+	case CarrierType_CARRIER_TYPE_CDEPENHANCED:
+		return "CDEPENHANCED"
+	case CarrierType_CARRIER_TYPE_CDEXTRACOMPILATION:
+		return "CDEXTRACOMPILATION"
+	case CarrierType_CARRIER_TYPE_CDEXTRAEP:
+		return "CDEXTRAEP"
+	case CarrierType_CARRIER_TYPE_CDEXTRALP:
+		return "CDEXTRALP"
+	case CarrierType_CARRIER_TYPE_CDEXTRAMAXIREMIX:
+		return "CDEXTRAMAXIREMIX"
+	case CarrierType_CARRIER_TYPE_CDEXTRAMAXISINGLE:
+		return "CDEXTRAMAXISINGLE"
+	case CarrierType_CARRIER_TYPE_CDEXTRASINGLE:
+		return "CDEXTRASINGLE"
+	case CarrierType_CARRIER_TYPE_CDEXTRASINGLE2TRACKS:
+		return "CDEXTRASINGLE2TRACKS"
+	case CarrierType_CARRIER_TYPE_CDLP:
+		return "CDLP"
+	case CarrierType_CARRIER_TYPE_CDLP5INCH:
+		return "CDLP5INCH"
+	case CarrierType_CARRIER_TYPE_CDLPENHANCED:
+		return "CDLPENHANCED"
+	case CarrierType_CARRIER_TYPE_CDLPPLUSCDVIDEO:
+		return "CDLPPLUSCDVIDEO"
+	case CarrierType_CARRIER_TYPE_CDLPPLUSDVDAUDIO:
+		return "CDLPPLUSDVDAUDIO"
+	case CarrierType_CARRIER_TYPE_CDLPPLUSDVDVIDEO:
+		return "CDLPPLUSDVDVIDEO"
+	case CarrierType_CARRIER_TYPE_CDLPPLUSWEB:
+		return "CDLPPLUSWEB"
+	case CarrierType_CARRIER_TYPE_CDMAXISINGLE:
+		return "CDMAXISINGLE"
+	case CarrierType_CARRIER_TYPE_CDMAXISINGLE3INCH:
+		return "CDMAXISINGLE3INCH"
+	case CarrierType_CARRIER_TYPE_CDMAXISINGLEENHANCED:
+		return "CDMAXISINGLEENHANCED"
+	case CarrierType_CARRIER_TYPE_CDMAXISINGLEREMIX:
+		return "CDMAXISINGLEREMIX"
+	case CarrierType_CARRIER_TYPE_CDPLUSCDBONUS:
+		return "CDPLUSCDBONUS"
+	case CarrierType_CARRIER_TYPE_CDPLUSDVDBONUS:
+		return "CDPLUSDVDBONUS"
+	case CarrierType_CARRIER_TYPE_CDROM:
+		return "CDROM"
+	case CarrierType_CARRIER_TYPE_CDSINGLE:
+		return "CDSINGLE"
+	case CarrierType_CARRIER_TYPE_CDSINGLE3INCH:
+		return "CDSINGLE3INCH"
+	case CarrierType_CARRIER_TYPE_CDSINGLE5INCH:
+		return "CDSINGLE5INCH"
+	case CarrierType_CARRIER_TYPE_CDVIDEO5LPNTSC:
+		return "CDVIDEO5LPNTSC"
+	case CarrierType_CARRIER_TYPE_CDVIDEO5LPPAL:
+		return "CDVIDEO5LPPAL"
+	case CarrierType_CARRIER_TYPE_CDVIDEOAUDIOCOMPATIBLE:
+		return "CDVIDEOAUDIOCOMPATIBLE"
+	case CarrierType_CARRIER_TYPE_COMBIPACK:
+		return "COMBIPACK"
+	case CarrierType_CARRIER_TYPE_DCC:
+		return "DCC"
+	case CarrierType_CARRIER_TYPE_DCCCOMPILATION:
+		return "DCCCOMPILATION"
+	case CarrierType_CARRIER_TYPE_DUALDISC:
+		return "DUALDISC"
+	case CarrierType_CARRIER_TYPE_DVD:
+		return "DVD"
+	case CarrierType_CARRIER_TYPE_DVDAUDIO:
+		return "DVDAUDIO"
+	case CarrierType_CARRIER_TYPE_DVDAUDIO5MAXISINGLE:
+		return "DVDAUDIO5MAXISINGLE"
+	case CarrierType_CARRIER_TYPE_DVDAUDIOLP:
+		return "DVDAUDIOLP"
+	case CarrierType_CARRIER_TYPE_DVDAUDIOSINGLE:
+		return "DVDAUDIOSINGLE"
+	case CarrierType_CARRIER_TYPE_DVDROM:
+		return "DVDROM"
+	case CarrierType_CARRIER_TYPE_DVDSINGLE:
+		return "DVDSINGLE"
+	case CarrierType_CARRIER_TYPE_DVDVIDEO:
+		return "DVDVIDEO"
+	case CarrierType_CARRIER_TYPE_DVDVIDEO5MAXISINGLENTSC:
+		return "DVDVIDEO5MAXISINGLENTSC"
+	case CarrierType_CARRIER_TYPE_DVDVIDEO5MAXISINGLEPAL:
+		return "DVDVIDEO5MAXISINGLEPAL"
+	case CarrierType_CARRIER_TYPE_DVDVIDEO5SINGLENTSC:
+		return "DVDVIDEO5SINGLENTSC"
+	case CarrierType_CARRIER_TYPE_DVDVIDEO5SINGLEPAL:
+		return "DVDVIDEO5SINGLEPAL"
+	case CarrierType_CARRIER_TYPE_DVDVIDEOLPNTSC:
+		return "DVDVIDEOLPNTSC"
+	case CarrierType_CARRIER_TYPE_DVDVIDEOLPPAL:
+		return "DVDVIDEOLPPAL"
+	case CarrierType_CARRIER_TYPE_DVDVIDEOLPPLUSCDLPORCDSINGLE:
+		return "DVDVIDEOLPPLUSCDLPORCDSINGLE"
+	case CarrierType_CARRIER_TYPE_FANPACK:
+		return "FANPACK"
+	case CarrierType_CARRIER_TYPE_HDDVDVIDEOLP:
+		return "HDDVDVIDEOLP"
+	case CarrierType_CARRIER_TYPE_LASERDISCLP12INCHNTSC:
+		return "LASERDISCLP12INCHNTSC"
+	case CarrierType_CARRIER_TYPE_LPCOMPIDENTICALTOCDCOMP:
+		return "LPCOMPIDENTICALTOCDCOMP"
+	case CarrierType_CARRIER_TYPE_LPCOMPILATION:
+		return "LPCOMPILATION"
+	case CarrierType_CARRIER_TYPE_LPIDENTICALTOCD:
+		return "LPIDENTICALTOCD"
+	case CarrierType_CARRIER_TYPE_MC:
+		return "MC"
+	case CarrierType_CARRIER_TYPE_MCCOMPIDENTICALTOCDCOMP:
+		return "MCCOMPIDENTICALTOCDCOMP"
+	case CarrierType_CARRIER_TYPE_MCCOMPILATION:
+		return "MCCOMPILATION"
+	case CarrierType_CARRIER_TYPE_MCDOUBLELP:
+		return "MCDOUBLELP"
+	case CarrierType_CARRIER_TYPE_MCEP:
+		return "MCEP"
+	case CarrierType_CARRIER_TYPE_MCIDENTICALTOCD:
+		return "MCIDENTICALTOCD"
+	case CarrierType_CARRIER_TYPE_MCLP:
+		return "MCLP"
+	case CarrierType_CARRIER_TYPE_MCMAXISINGLE:
+		return "MCMAXISINGLE"
+	case CarrierType_CARRIER_TYPE_MCREMIX:
+		return "MCREMIX"
+	case CarrierType_CARRIER_TYPE_MCSINGLE:
+		return "MCSINGLE"
+	case CarrierType_CARRIER_TYPE_MCSINGLEIDENTICALTOCDS:
+		return "MCSINGLEIDENTICALTOCDS"
+	case CarrierType_CARRIER_TYPE_MEMORYDEVICEAUDIOLP:
+		return "MEMORYDEVICEAUDIOLP"
+	case CarrierType_CARRIER_TYPE_MEMORYDEVICEMIXLP:
+		return "MEMORYDEVICEMIXLP"
+	case CarrierType_CARRIER_TYPE_MEMORYDEVICEVIDEOLP:
+		return "MEMORYDEVICEVIDEOLP"
+	case CarrierType_CARRIER_TYPE_MERCHANDISE:
+		return "MERCHANDISE"
+	case CarrierType_CARRIER_TYPE_MINIDISC:
+		return "MINIDISC"
+	case CarrierType_CARRIER_TYPE_MINIDISCCOMPILATION:
+		return "MINIDISCCOMPILATION"
+	case CarrierType_CARRIER_TYPE_MINIDISCEP:
+		return "MINIDISCEP"
+	case CarrierType_CARRIER_TYPE_MINIDISCMAXIREMIX:
+		return "MINIDISCMAXIREMIX"
+	case CarrierType_CARRIER_TYPE_MINIDISCSINGLEMAXISINGLE:
+		return "MINIDISCSINGLEMAXISINGLE"
+	case CarrierType_CARRIER_TYPE_PREPAIDCARD:
+		return "PREPAIDCARD"
+	case CarrierType_CARRIER_TYPE_SACD:
+		return "SACD"
+	case CarrierType_CARRIER_TYPE_SACDCOMPILATION:
+		return "SACDCOMPILATION"
+	case CarrierType_CARRIER_TYPE_SACDLPSTEREO:
+		return "SACDLPSTEREO"
+	case CarrierType_CARRIER_TYPE_SACDLPSTEREOCDAUDIO:
+		return "SACDLPSTEREOCDAUDIO"
+	case CarrierType_CARRIER_TYPE_SACDLPSTEREOSURROUND:
+		return "SACDLPSTEREOSURROUND"
+	case CarrierType_CARRIER_TYPE_SACDLPSTEREOSURROUNDCDAUDIO:
+		return "SACDLPSTEREOSURROUNDCDAUDIO"
+	case CarrierType_CARRIER_TYPE_SACDLPSURROUNDCDAUDIO:
+		return "SACDLPSURROUNDCDAUDIO"
+	case CarrierType_CARRIER_TYPE_SACDPLUSDVDVIDEO:
+		return "SACDPLUSDVDVIDEO"
+	case CarrierType_CARRIER_TYPE_USERDEFINED:
+		return "USERDEFINED"
+	case CarrierType_CARRIER_TYPE_VHSNTSC:
+		return "VHSNTSC"
+	case CarrierType_CARRIER_TYPE_VHSPAL:
+		return "VHSPAL"
+	case CarrierType_CARRIER_TYPE_VHSPLUSCDLP:
+		return "VHSPLUSCDLP"
+	case CarrierType_CARRIER_TYPE_VHSSECAM:
+		return "VHSSECAM"
+	case CarrierType_CARRIER_TYPE_FILESYSTEM:
+		return "FILESYSTEM"
+	case CarrierType_CARRIER_TYPE_MEMORYDEVICE:
+		return "MEMORYDEVICE"
+	case CarrierType_CARRIER_TYPE_ONLINESYSTEM:
+		return "ONLINESYSTEM"
 	default:
 		return ""
 	}
@@ -135,6 +2880,230 @@ func (e CarrierType) XMLString() string {
 func ParseCarrierTypeString(s string) (CarrierType, bool) {
 	s = strings.ToUpper(s)
 	switch s {
+	case "12INCHDISCOSINGLEREMIX":
+		return CarrierType_CARRIER_TYPE_E_12INCHDISCOSINGLEREMIX, true
+	case "33RPM10INCHLP":
+		return CarrierType_CARRIER_TYPE_E_33RPM10INCHLP, true
+	case "33RPM10INCHSINGLE":
+		return CarrierType_CARRIER_TYPE_E_33RPM10INCHSINGLE, true
+	case "33RPM12INCHLP":
+		return CarrierType_CARRIER_TYPE_E_33RPM12INCHLP, true
+	case "33RPM12INCHLP20TRACKS":
+		return CarrierType_CARRIER_TYPE_E_33RPM12INCHLP20TRACKS, true
+	case "33RPM12INCHMAXISINGLE":
+		return CarrierType_CARRIER_TYPE_E_33RPM12INCHMAXISINGLE, true
+	case "33RPM12INCHSINGLE":
+		return CarrierType_CARRIER_TYPE_E_33RPM12INCHSINGLE, true
+	case "33RPM7INCHLP":
+		return CarrierType_CARRIER_TYPE_E_33RPM7INCHLP, true
+	case "33RPM7INCHSINGLE":
+		return CarrierType_CARRIER_TYPE_E_33RPM7INCHSINGLE, true
+	case "45RPM10INCHLP":
+		return CarrierType_CARRIER_TYPE_E_45RPM10INCHLP, true
+	case "45RPM10INCHMAXISINGLE":
+		return CarrierType_CARRIER_TYPE_E_45RPM10INCHMAXISINGLE, true
+	case "45RPM10INCHSINGLE":
+		return CarrierType_CARRIER_TYPE_E_45RPM10INCHSINGLE, true
+	case "45RPM12INCHLP":
+		return CarrierType_CARRIER_TYPE_E_45RPM12INCHLP, true
+	case "45RPM12INCHMAXISINGLE":
+		return CarrierType_CARRIER_TYPE_E_45RPM12INCHMAXISINGLE, true
+	case "45RPM12INCHSINGLE":
+		return CarrierType_CARRIER_TYPE_E_45RPM12INCHSINGLE, true
+	case "45RPM7INCHEP":
+		return CarrierType_CARRIER_TYPE_E_45RPM7INCHEP, true
+	case "45RPM7INCHSINGLE":
+		return CarrierType_CARRIER_TYPE_E_45RPM7INCHSINGLE, true
+	case "7INCHMAXISINGLEREMIX":
+		return CarrierType_CARRIER_TYPE_E_7INCHMAXISINGLEREMIX, true
+	case "BLURAY":
+		return CarrierType_CARRIER_TYPE_BLURAY, true
+	case "CD":
+		return CarrierType_CARRIER_TYPE_CD, true
+	case "CDCOMPILATION":
+		return CarrierType_CARRIER_TYPE_CDCOMPILATION, true
+	case "CDEP":
+		return CarrierType_CARRIER_TYPE_CDEP, true
+	case "CDEPENHANCED":
+		return CarrierType_CARRIER_TYPE_CDEPENHANCED, true
+	case "CDEXTRACOMPILATION":
+		return CarrierType_CARRIER_TYPE_CDEXTRACOMPILATION, true
+	case "CDEXTRAEP":
+		return CarrierType_CARRIER_TYPE_CDEXTRAEP, true
+	case "CDEXTRALP":
+		return CarrierType_CARRIER_TYPE_CDEXTRALP, true
+	case "CDEXTRAMAXIREMIX":
+		return CarrierType_CARRIER_TYPE_CDEXTRAMAXIREMIX, true
+	case "CDEXTRAMAXISINGLE":
+		return CarrierType_CARRIER_TYPE_CDEXTRAMAXISINGLE, true
+	case "CDEXTRASINGLE":
+		return CarrierType_CARRIER_TYPE_CDEXTRASINGLE, true
+	case "CDEXTRASINGLE2TRACKS":
+		return CarrierType_CARRIER_TYPE_CDEXTRASINGLE2TRACKS, true
+	case "CDLP":
+		return CarrierType_CARRIER_TYPE_CDLP, true
+	case "CDLP5INCH":
+		return CarrierType_CARRIER_TYPE_CDLP5INCH, true
+	case "CDLPENHANCED":
+		return CarrierType_CARRIER_TYPE_CDLPENHANCED, true
+	case "CDLPPLUSCDVIDEO":
+		return CarrierType_CARRIER_TYPE_CDLPPLUSCDVIDEO, true
+	case "CDLPPLUSDVDAUDIO":
+		return CarrierType_CARRIER_TYPE_CDLPPLUSDVDAUDIO, true
+	case "CDLPPLUSDVDVIDEO":
+		return CarrierType_CARRIER_TYPE_CDLPPLUSDVDVIDEO, true
+	case "CDLPPLUSWEB":
+		return CarrierType_CARRIER_TYPE_CDLPPLUSWEB, true
+	case "CDMAXISINGLE":
+		return CarrierType_CARRIER_TYPE_CDMAXISINGLE, true
+	case "CDMAXISINGLE3INCH":
+		return CarrierType_CARRIER_TYPE_CDMAXISINGLE3INCH, true
+	case "CDMAXISINGLEENHANCED":
+		return CarrierType_CARRIER_TYPE_CDMAXISINGLEENHANCED, true
+	case "CDMAXISINGLEREMIX":
+		return CarrierType_CARRIER_TYPE_CDMAXISINGLEREMIX, true
+	case "CDPLUSCDBONUS":
+		return CarrierType_CARRIER_TYPE_CDPLUSCDBONUS, true
+	case "CDPLUSDVDBONUS":
+		return CarrierType_CARRIER_TYPE_CDPLUSDVDBONUS, true
+	case "CDROM":
+		return CarrierType_CARRIER_TYPE_CDROM, true
+	case "CDSINGLE":
+		return CarrierType_CARRIER_TYPE_CDSINGLE, true
+	case "CDSINGLE3INCH":
+		return CarrierType_CARRIER_TYPE_CDSINGLE3INCH, true
+	case "CDSINGLE5INCH":
+		return CarrierType_CARRIER_TYPE_CDSINGLE5INCH, true
+	case "CDVIDEO5LPNTSC":
+		return CarrierType_CARRIER_TYPE_CDVIDEO5LPNTSC, true
+	case "CDVIDEO5LPPAL":
+		return CarrierType_CARRIER_TYPE_CDVIDEO5LPPAL, true
+	case "CDVIDEOAUDIOCOMPATIBLE":
+		return CarrierType_CARRIER_TYPE_CDVIDEOAUDIOCOMPATIBLE, true
+	case "COMBIPACK":
+		return CarrierType_CARRIER_TYPE_COMBIPACK, true
+	case "DCC":
+		return CarrierType_CARRIER_TYPE_DCC, true
+	case "DCCCOMPILATION":
+		return CarrierType_CARRIER_TYPE_DCCCOMPILATION, true
+	case "DUALDISC":
+		return CarrierType_CARRIER_TYPE_DUALDISC, true
+	case "DVD":
+		return CarrierType_CARRIER_TYPE_DVD, true
+	case "DVDAUDIO":
+		return CarrierType_CARRIER_TYPE_DVDAUDIO, true
+	case "DVDAUDIO5MAXISINGLE":
+		return CarrierType_CARRIER_TYPE_DVDAUDIO5MAXISINGLE, true
+	case "DVDAUDIOLP":
+		return CarrierType_CARRIER_TYPE_DVDAUDIOLP, true
+	case "DVDAUDIOSINGLE":
+		return CarrierType_CARRIER_TYPE_DVDAUDIOSINGLE, true
+	case "DVDROM":
+		return CarrierType_CARRIER_TYPE_DVDROM, true
+	case "DVDSINGLE":
+		return CarrierType_CARRIER_TYPE_DVDSINGLE, true
+	case "DVDVIDEO":
+		return CarrierType_CARRIER_TYPE_DVDVIDEO, true
+	case "DVDVIDEO5MAXISINGLENTSC":
+		return CarrierType_CARRIER_TYPE_DVDVIDEO5MAXISINGLENTSC, true
+	case "DVDVIDEO5MAXISINGLEPAL":
+		return CarrierType_CARRIER_TYPE_DVDVIDEO5MAXISINGLEPAL, true
+	case "DVDVIDEO5SINGLENTSC":
+		return CarrierType_CARRIER_TYPE_DVDVIDEO5SINGLENTSC, true
+	case "DVDVIDEO5SINGLEPAL":
+		return CarrierType_CARRIER_TYPE_DVDVIDEO5SINGLEPAL, true
+	case "DVDVIDEOLPNTSC":
+		return CarrierType_CARRIER_TYPE_DVDVIDEOLPNTSC, true
+	case "DVDVIDEOLPPAL":
+		return CarrierType_CARRIER_TYPE_DVDVIDEOLPPAL, true
+	case "DVDVIDEOLPPLUSCDLPORCDSINGLE":
+		return CarrierType_CARRIER_TYPE_DVDVIDEOLPPLUSCDLPORCDSINGLE, true
+	case "FANPACK":
+		return CarrierType_CARRIER_TYPE_FANPACK, true
+	case "HDDVDVIDEOLP":
+		return CarrierType_CARRIER_TYPE_HDDVDVIDEOLP, true
+	case "LASERDISCLP12INCHNTSC":
+		return CarrierType_CARRIER_TYPE_LASERDISCLP12INCHNTSC, true
+	case "LPCOMPIDENTICALTOCDCOMP":
+		return CarrierType_CARRIER_TYPE_LPCOMPIDENTICALTOCDCOMP, true
+	case "LPCOMPILATION":
+		return CarrierType_CARRIER_TYPE_LPCOMPILATION, true
+	case "LPIDENTICALTOCD":
+		return CarrierType_CARRIER_TYPE_LPIDENTICALTOCD, true
+	case "MC":
+		return CarrierType_CARRIER_TYPE_MC, true
+	case "MCCOMPIDENTICALTOCDCOMP":
+		return CarrierType_CARRIER_TYPE_MCCOMPIDENTICALTOCDCOMP, true
+	case "MCCOMPILATION":
+		return CarrierType_CARRIER_TYPE_MCCOMPILATION, true
+	case "MCDOUBLELP":
+		return CarrierType_CARRIER_TYPE_MCDOUBLELP, true
+	case "MCEP":
+		return CarrierType_CARRIER_TYPE_MCEP, true
+	case "MCIDENTICALTOCD":
+		return CarrierType_CARRIER_TYPE_MCIDENTICALTOCD, true
+	case "MCLP":
+		return CarrierType_CARRIER_TYPE_MCLP, true
+	case "MCMAXISINGLE":
+		return CarrierType_CARRIER_TYPE_MCMAXISINGLE, true
+	case "MCREMIX":
+		return CarrierType_CARRIER_TYPE_MCREMIX, true
+	case "MCSINGLE":
+		return CarrierType_CARRIER_TYPE_MCSINGLE, true
+	case "MCSINGLEIDENTICALTOCDS":
+		return CarrierType_CARRIER_TYPE_MCSINGLEIDENTICALTOCDS, true
+	case "MEMORYDEVICEAUDIOLP":
+		return CarrierType_CARRIER_TYPE_MEMORYDEVICEAUDIOLP, true
+	case "MEMORYDEVICEMIXLP":
+		return CarrierType_CARRIER_TYPE_MEMORYDEVICEMIXLP, true
+	case "MEMORYDEVICEVIDEOLP":
+		return CarrierType_CARRIER_TYPE_MEMORYDEVICEVIDEOLP, true
+	case "MERCHANDISE":
+		return CarrierType_CARRIER_TYPE_MERCHANDISE, true
+	case "MINIDISC":
+		return CarrierType_CARRIER_TYPE_MINIDISC, true
+	case "MINIDISCCOMPILATION":
+		return CarrierType_CARRIER_TYPE_MINIDISCCOMPILATION, true
+	case "MINIDISCEP":
+		return CarrierType_CARRIER_TYPE_MINIDISCEP, true
+	case "MINIDISCMAXIREMIX":
+		return CarrierType_CARRIER_TYPE_MINIDISCMAXIREMIX, true
+	case "MINIDISCSINGLEMAXISINGLE":
+		return CarrierType_CARRIER_TYPE_MINIDISCSINGLEMAXISINGLE, true
+	case "PREPAIDCARD":
+		return CarrierType_CARRIER_TYPE_PREPAIDCARD, true
+	case "SACD":
+		return CarrierType_CARRIER_TYPE_SACD, true
+	case "SACDCOMPILATION":
+		return CarrierType_CARRIER_TYPE_SACDCOMPILATION, true
+	case "SACDLPSTEREO":
+		return CarrierType_CARRIER_TYPE_SACDLPSTEREO, true
+	case "SACDLPSTEREOCDAUDIO":
+		return CarrierType_CARRIER_TYPE_SACDLPSTEREOCDAUDIO, true
+	case "SACDLPSTEREOSURROUND":
+		return CarrierType_CARRIER_TYPE_SACDLPSTEREOSURROUND, true
+	case "SACDLPSTEREOSURROUNDCDAUDIO":
+		return CarrierType_CARRIER_TYPE_SACDLPSTEREOSURROUNDCDAUDIO, true
+	case "SACDLPSURROUNDCDAUDIO":
+		return CarrierType_CARRIER_TYPE_SACDLPSURROUNDCDAUDIO, true
+	case "SACDPLUSDVDVIDEO":
+		return CarrierType_CARRIER_TYPE_SACDPLUSDVDVIDEO, true
+	case "USERDEFINED":
+		return CarrierType_CARRIER_TYPE_USERDEFINED, true
+	case "VHSNTSC":
+		return CarrierType_CARRIER_TYPE_VHSNTSC, true
+	case "VHSPAL":
+		return CarrierType_CARRIER_TYPE_VHSPAL, true
+	case "VHSPLUSCDLP":
+		return CarrierType_CARRIER_TYPE_VHSPLUSCDLP, true
+	case "VHSSECAM":
+		return CarrierType_CARRIER_TYPE_VHSSECAM, true
+	case "FILESYSTEM":
+		return CarrierType_CARRIER_TYPE_FILESYSTEM, true
+	case "MEMORYDEVICE":
+		return CarrierType_CARRIER_TYPE_MEMORYDEVICE, true
+	case "ONLINESYSTEM":
+		return CarrierType_CARRIER_TYPE_ONLINESYSTEM, true
 	default:
 		return CarrierType(0), false
 	}
@@ -143,6 +3112,22 @@ func ParseCarrierTypeString(s string) (CarrierType, bool) {
 // XMLString returns the XML string representation of CdProtectionType
 func (e CdProtectionType) XMLString() string {
 	switch e {
+	case CdProtectionType_CD_PROTECTION_TYPE_CDS100:
+		return "CDS100"
+	case CdProtectionType_CD_PROTECTION_TYPE_CDS200:
+		return "CDS200"
+	case CdProtectionType_CD_PROTECTION_TYPE_CDS300:
+		return "CDS300"
+	case CdProtectionType_CD_PROTECTION_TYPE_KEY2AUDIO:
+		return "KEY2AUDIO"
+	case CdProtectionType_CD_PROTECTION_TYPE_MEDIAMAXCD3:
+		return "MEDIAMAXCD3"
+	case CdProtectionType_CD_PROTECTION_TYPE_NOTPROTECTED:
+		return "NOTPROTECTED"
+	case CdProtectionType_CD_PROTECTION_TYPE_UNKNOWN:
+		return "UNKNOWN"
+	case CdProtectionType_CD_PROTECTION_TYPE_USERDEFINED:
+		return "USERDEFINED"
 	default:
 		return ""
 	}
@@ -152,6 +3137,22 @@ func (e CdProtectionType) XMLString() string {
 func ParseCdProtectionTypeString(s string) (CdProtectionType, bool) {
 	s = strings.ToUpper(s)
 	switch s {
+	case "CDS100":
+		return CdProtectionType_CD_PROTECTION_TYPE_CDS100, true
+	case "CDS200":
+		return CdProtectionType_CD_PROTECTION_TYPE_CDS200, true
+	case "CDS300":
+		return CdProtectionType_CD_PROTECTION_TYPE_CDS300, true
+	case "KEY2AUDIO":
+		return CdProtectionType_CD_PROTECTION_TYPE_KEY2AUDIO, true
+	case "MEDIAMAXCD3":
+		return CdProtectionType_CD_PROTECTION_TYPE_MEDIAMAXCD3, true
+	case "NOTPROTECTED":
+		return CdProtectionType_CD_PROTECTION_TYPE_NOTPROTECTED, true
+	case "UNKNOWN":
+		return CdProtectionType_CD_PROTECTION_TYPE_UNKNOWN, true
+	case "USERDEFINED":
+		return CdProtectionType_CD_PROTECTION_TYPE_USERDEFINED, true
 	default:
 		return CdProtectionType(0), false
 	}
@@ -160,6 +3161,12 @@ func ParseCdProtectionTypeString(s string) (CdProtectionType, bool) {
 // XMLString returns the XML string representation of CharacterType
 func (e CharacterType) XMLString() string {
 	switch e {
+	case CharacterType_CHARACTER_TYPE_MAINCHARACTER:
+		return "MAINCHARACTER"
+	case CharacterType_CHARACTER_TYPE_OTHERCHARACTER:
+		return "OTHERCHARACTER"
+	case CharacterType_CHARACTER_TYPE_SUPPORTINGCHARACTER:
+		return "SUPPORTINGCHARACTER"
 	default:
 		return ""
 	}
@@ -169,6 +3176,12 @@ func (e CharacterType) XMLString() string {
 func ParseCharacterTypeString(s string) (CharacterType, bool) {
 	s = strings.ToUpper(s)
 	switch s {
+	case "MAINCHARACTER":
+		return CharacterType_CHARACTER_TYPE_MAINCHARACTER, true
+	case "OTHERCHARACTER":
+		return CharacterType_CHARACTER_TYPE_OTHERCHARACTER, true
+	case "SUPPORTINGCHARACTER":
+		return CharacterType_CHARACTER_TYPE_SUPPORTINGCHARACTER, true
 	default:
 		return CharacterType(0), false
 	}
@@ -177,6 +3190,10 @@ func ParseCharacterTypeString(s string) (CharacterType, bool) {
 // XMLString returns the XML string representation of CodingType
 func (e CodingType) XMLString() string {
 	switch e {
+	case CodingType_CODING_TYPE_LOSSLESS:
+		return "LOSSLESS"
+	case CodingType_CODING_TYPE_LOSSY:
+		return "LOSSY"
 	default:
 		return ""
 	}
@@ -186,6 +3203,10 @@ func (e CodingType) XMLString() string {
 func ParseCodingTypeString(s string) (CodingType, bool) {
 	s = strings.ToUpper(s)
 	switch s {
+	case "LOSSLESS":
+		return CodingType_CODING_TYPE_LOSSLESS, true
+	case "LOSSY":
+		return CodingType_CODING_TYPE_LOSSY, true
 	default:
 		return CodingType(0), false
 	}
@@ -194,6 +3215,22 @@ func ParseCodingTypeString(s string) (CodingType, bool) {
 // XMLString returns the XML string representation of CollectionType
 func (e CollectionType) XMLString() string {
 	switch e {
+	case CollectionType_COLLECTION_TYPE_AUDIOCHAPTER:
+		return "AUDIOCHAPTER"
+	case CollectionType_COLLECTION_TYPE_EPISODE:
+		return "EPISODE"
+	case CollectionType_COLLECTION_TYPE_FILMBUNDLE:
+		return "FILMBUNDLE"
+	case CollectionType_COLLECTION_TYPE_MEDLEYSEGMENT:
+		return "MEDLEYSEGMENT"
+	case CollectionType_COLLECTION_TYPE_POTPOURRISEGMENT:
+		return "POTPOURRISEGMENT"
+	case CollectionType_COLLECTION_TYPE_SEASON:
+		return "SEASON"
+	case CollectionType_COLLECTION_TYPE_SERIES:
+		return "SERIES"
+	case CollectionType_COLLECTION_TYPE_VIDEOCHAPTER:
+		return "VIDEOCHAPTER"
 	default:
 		return ""
 	}
@@ -203,6 +3240,22 @@ func (e CollectionType) XMLString() string {
 func ParseCollectionTypeString(s string) (CollectionType, bool) {
 	s = strings.ToUpper(s)
 	switch s {
+	case "AUDIOCHAPTER":
+		return CollectionType_COLLECTION_TYPE_AUDIOCHAPTER, true
+	case "EPISODE":
+		return CollectionType_COLLECTION_TYPE_EPISODE, true
+	case "FILMBUNDLE":
+		return CollectionType_COLLECTION_TYPE_FILMBUNDLE, true
+	case "MEDLEYSEGMENT":
+		return CollectionType_COLLECTION_TYPE_MEDLEYSEGMENT, true
+	case "POTPOURRISEGMENT":
+		return CollectionType_COLLECTION_TYPE_POTPOURRISEGMENT, true
+	case "SEASON":
+		return CollectionType_COLLECTION_TYPE_SEASON, true
+	case "SERIES":
+		return CollectionType_COLLECTION_TYPE_SERIES, true
+	case "VIDEOCHAPTER":
+		return CollectionType_COLLECTION_TYPE_VIDEOCHAPTER, true
 	default:
 		return CollectionType(0), false
 	}
@@ -211,6 +3264,26 @@ func ParseCollectionTypeString(s string) (CollectionType, bool) {
 // XMLString returns the XML string representation of CommercialModelType
 func (e CommercialModelType) XMLString() string {
 	switch e {
+	case CommercialModelType_COMMERCIAL_MODEL_TYPE_ADVERTISEMENTSUPPORTEDMODEL:
+		return "ADVERTISEMENTSUPPORTEDMODEL"
+	case CommercialModelType_COMMERCIAL_MODEL_TYPE_ASPERCONTRACT:
+		return "ASPERCONTRACT"
+	case CommercialModelType_COMMERCIAL_MODEL_TYPE_DEVICEFEEMODEL:
+		return "DEVICEFEEMODEL"
+	case CommercialModelType_COMMERCIAL_MODEL_TYPE_FREEOFCHARGEMODEL:
+		return "FREEOFCHARGEMODEL"
+	case CommercialModelType_COMMERCIAL_MODEL_TYPE_PAYASYOUGOMODEL:
+		return "PAYASYOUGOMODEL"
+	case CommercialModelType_COMMERCIAL_MODEL_TYPE_PERFORMANCEROYALTIESMODEL:
+		return "PERFORMANCEROYALTIESMODEL"
+	case CommercialModelType_COMMERCIAL_MODEL_TYPE_RIGHTSCLAIMMODEL:
+		return "RIGHTSCLAIMMODEL"
+	case CommercialModelType_COMMERCIAL_MODEL_TYPE_SUBSCRIPTIONMODEL:
+		return "SUBSCRIPTIONMODEL"
+	case CommercialModelType_COMMERCIAL_MODEL_TYPE_UNKNOWN:
+		return "UNKNOWN"
+	case CommercialModelType_COMMERCIAL_MODEL_TYPE_USERDEFINED:
+		return "USERDEFINED"
 	default:
 		return ""
 	}
@@ -220,6 +3293,26 @@ func (e CommercialModelType) XMLString() string {
 func ParseCommercialModelTypeString(s string) (CommercialModelType, bool) {
 	s = strings.ToUpper(s)
 	switch s {
+	case "ADVERTISEMENTSUPPORTEDMODEL":
+		return CommercialModelType_COMMERCIAL_MODEL_TYPE_ADVERTISEMENTSUPPORTEDMODEL, true
+	case "ASPERCONTRACT":
+		return CommercialModelType_COMMERCIAL_MODEL_TYPE_ASPERCONTRACT, true
+	case "DEVICEFEEMODEL":
+		return CommercialModelType_COMMERCIAL_MODEL_TYPE_DEVICEFEEMODEL, true
+	case "FREEOFCHARGEMODEL":
+		return CommercialModelType_COMMERCIAL_MODEL_TYPE_FREEOFCHARGEMODEL, true
+	case "PAYASYOUGOMODEL":
+		return CommercialModelType_COMMERCIAL_MODEL_TYPE_PAYASYOUGOMODEL, true
+	case "PERFORMANCEROYALTIESMODEL":
+		return CommercialModelType_COMMERCIAL_MODEL_TYPE_PERFORMANCEROYALTIESMODEL, true
+	case "RIGHTSCLAIMMODEL":
+		return CommercialModelType_COMMERCIAL_MODEL_TYPE_RIGHTSCLAIMMODEL, true
+	case "SUBSCRIPTIONMODEL":
+		return CommercialModelType_COMMERCIAL_MODEL_TYPE_SUBSCRIPTIONMODEL, true
+	case "UNKNOWN":
+		return CommercialModelType_COMMERCIAL_MODEL_TYPE_UNKNOWN, true
+	case "USERDEFINED":
+		return CommercialModelType_COMMERCIAL_MODEL_TYPE_USERDEFINED, true
 	default:
 		return CommercialModelType(0), false
 	}
@@ -228,6 +3321,12 @@ func ParseCommercialModelTypeString(s string) (CommercialModelType, bool) {
 // XMLString returns the XML string representation of CompilationType
 func (e CompilationType) XMLString() string {
 	switch e {
+	case CompilationType_COMPILATION_TYPE_INTERNALCOMPILATION:
+		return "INTERNALCOMPILATION"
+	case CompilationType_COMPILATION_TYPE_NONINTERNALCOMPILATION:
+		return "NONINTERNALCOMPILATION"
+	case CompilationType_COMPILATION_TYPE_NOTCOMPILED:
+		return "NOTCOMPILED"
 	default:
 		return ""
 	}
@@ -237,6 +3336,12 @@ func (e CompilationType) XMLString() string {
 func ParseCompilationTypeString(s string) (CompilationType, bool) {
 	s = strings.ToUpper(s)
 	switch s {
+	case "INTERNALCOMPILATION":
+		return CompilationType_COMPILATION_TYPE_INTERNALCOMPILATION, true
+	case "NONINTERNALCOMPILATION":
+		return CompilationType_COMPILATION_TYPE_NONINTERNALCOMPILATION, true
+	case "NOTCOMPILED":
+		return CompilationType_COMPILATION_TYPE_NOTCOMPILED, true
 	default:
 		return CompilationType(0), false
 	}
@@ -245,6 +3350,24 @@ func ParseCompilationTypeString(s string) (CompilationType, bool) {
 // XMLString returns the XML string representation of ContainerFormat
 func (e ContainerFormat) XMLString() string {
 	switch e {
+	case ContainerFormat_CONTAINER_FORMAT_AIFF:
+		return "AIFF"
+	case ContainerFormat_CONTAINER_FORMAT_AVI:
+		return "AVI"
+	case ContainerFormat_CONTAINER_FORMAT_MP4:
+		return "MP4"
+	case ContainerFormat_CONTAINER_FORMAT_OGG:
+		return "OGG"
+	case ContainerFormat_CONTAINER_FORMAT_QUICKTIME:
+		return "QUICKTIME"
+	case ContainerFormat_CONTAINER_FORMAT_REALMEDIA:
+		return "REALMEDIA"
+	case ContainerFormat_CONTAINER_FORMAT_RMF:
+		return "RMF"
+	case ContainerFormat_CONTAINER_FORMAT_USERDEFINED:
+		return "USERDEFINED"
+	case ContainerFormat_CONTAINER_FORMAT_WAV:
+		return "WAV"
 	default:
 		return ""
 	}
@@ -254,6 +3377,24 @@ func (e ContainerFormat) XMLString() string {
 func ParseContainerFormatString(s string) (ContainerFormat, bool) {
 	s = strings.ToUpper(s)
 	switch s {
+	case "AIFF":
+		return ContainerFormat_CONTAINER_FORMAT_AIFF, true
+	case "AVI":
+		return ContainerFormat_CONTAINER_FORMAT_AVI, true
+	case "MP4":
+		return ContainerFormat_CONTAINER_FORMAT_MP4, true
+	case "OGG":
+		return ContainerFormat_CONTAINER_FORMAT_OGG, true
+	case "QUICKTIME":
+		return ContainerFormat_CONTAINER_FORMAT_QUICKTIME, true
+	case "REALMEDIA":
+		return ContainerFormat_CONTAINER_FORMAT_REALMEDIA, true
+	case "RMF":
+		return ContainerFormat_CONTAINER_FORMAT_RMF, true
+	case "USERDEFINED":
+		return ContainerFormat_CONTAINER_FORMAT_USERDEFINED, true
+	case "WAV":
+		return ContainerFormat_CONTAINER_FORMAT_WAV, true
 	default:
 		return ContainerFormat(0), false
 	}
@@ -262,6 +3403,12 @@ func ParseContainerFormatString(s string) (ContainerFormat, bool) {
 // XMLString returns the XML string representation of CreationType
 func (e CreationType) XMLString() string {
 	switch e {
+	case CreationType_CREATION_TYPE_MUSICALWORK:
+		return "MUSICALWORK"
+	case CreationType_CREATION_TYPE_RELEASE:
+		return "RELEASE"
+	case CreationType_CREATION_TYPE_RESOURCE:
+		return "RESOURCE"
 	default:
 		return ""
 	}
@@ -271,6 +3418,12 @@ func (e CreationType) XMLString() string {
 func ParseCreationTypeString(s string) (CreationType, bool) {
 	s = strings.ToUpper(s)
 	switch s {
+	case "MUSICALWORK":
+		return CreationType_CREATION_TYPE_MUSICALWORK, true
+	case "RELEASE":
+		return CreationType_CREATION_TYPE_RELEASE, true
+	case "RESOURCE":
+		return CreationType_CREATION_TYPE_RESOURCE, true
 	default:
 		return CreationType(0), false
 	}
@@ -279,6 +3432,30 @@ func ParseCreationTypeString(s string) (CreationType, bool) {
 // XMLString returns the XML string representation of CreativeContributorRole
 func (e CreativeContributorRole) XMLString() string {
 	switch e {
+	case CreativeContributorRole_CREATIVE_CONTRIBUTOR_ROLE_ADAPTER:
+		return "ADAPTER"
+	case CreativeContributorRole_CREATIVE_CONTRIBUTOR_ROLE_ARRANGER:
+		return "ARRANGER"
+	case CreativeContributorRole_CREATIVE_CONTRIBUTOR_ROLE_ASSOCIATEDPERFORMER:
+		return "ASSOCIATEDPERFORMER"
+	case CreativeContributorRole_CREATIVE_CONTRIBUTOR_ROLE_AUTHOR:
+		return "AUTHOR"
+	case CreativeContributorRole_CREATIVE_CONTRIBUTOR_ROLE_COMPOSER:
+		return "COMPOSER"
+	case CreativeContributorRole_CREATIVE_CONTRIBUTOR_ROLE_COMPOSERLYRICIST:
+		return "COMPOSERLYRICIST"
+	case CreativeContributorRole_CREATIVE_CONTRIBUTOR_ROLE_LIBRETTIST:
+		return "LIBRETTIST"
+	case CreativeContributorRole_CREATIVE_CONTRIBUTOR_ROLE_LYRICIST:
+		return "LYRICIST"
+	case CreativeContributorRole_CREATIVE_CONTRIBUTOR_ROLE_NONLYRICAUTHOR:
+		return "NONLYRICAUTHOR"
+	case CreativeContributorRole_CREATIVE_CONTRIBUTOR_ROLE_SUBARRANGER:
+		return "SUBARRANGER"
+	case CreativeContributorRole_CREATIVE_CONTRIBUTOR_ROLE_SUBLYRICIST:
+		return "SUBLYRICIST"
+	case CreativeContributorRole_CREATIVE_CONTRIBUTOR_ROLE_TRANSLATOR:
+		return "TRANSLATOR"
 	default:
 		return ""
 	}
@@ -288,6 +3465,30 @@ func (e CreativeContributorRole) XMLString() string {
 func ParseCreativeContributorRoleString(s string) (CreativeContributorRole, bool) {
 	s = strings.ToUpper(s)
 	switch s {
+	case "ADAPTER":
+		return CreativeContributorRole_CREATIVE_CONTRIBUTOR_ROLE_ADAPTER, true
+	case "ARRANGER":
+		return CreativeContributorRole_CREATIVE_CONTRIBUTOR_ROLE_ARRANGER, true
+	case "ASSOCIATEDPERFORMER":
+		return CreativeContributorRole_CREATIVE_CONTRIBUTOR_ROLE_ASSOCIATEDPERFORMER, true
+	case "AUTHOR":
+		return CreativeContributorRole_CREATIVE_CONTRIBUTOR_ROLE_AUTHOR, true
+	case "COMPOSER":
+		return CreativeContributorRole_CREATIVE_CONTRIBUTOR_ROLE_COMPOSER, true
+	case "COMPOSERLYRICIST":
+		return CreativeContributorRole_CREATIVE_CONTRIBUTOR_ROLE_COMPOSERLYRICIST, true
+	case "LIBRETTIST":
+		return CreativeContributorRole_CREATIVE_CONTRIBUTOR_ROLE_LIBRETTIST, true
+	case "LYRICIST":
+		return CreativeContributorRole_CREATIVE_CONTRIBUTOR_ROLE_LYRICIST, true
+	case "NONLYRICAUTHOR":
+		return CreativeContributorRole_CREATIVE_CONTRIBUTOR_ROLE_NONLYRICAUTHOR, true
+	case "SUBARRANGER":
+		return CreativeContributorRole_CREATIVE_CONTRIBUTOR_ROLE_SUBARRANGER, true
+	case "SUBLYRICIST":
+		return CreativeContributorRole_CREATIVE_CONTRIBUTOR_ROLE_SUBLYRICIST, true
+	case "TRANSLATOR":
+		return CreativeContributorRole_CREATIVE_CONTRIBUTOR_ROLE_TRANSLATOR, true
 	default:
 		return CreativeContributorRole(0), false
 	}
@@ -296,6 +3497,16 @@ func ParseCreativeContributorRoleString(s string) (CreativeContributorRole, bool
 // XMLString returns the XML string representation of CueOrigin
 func (e CueOrigin) XMLString() string {
 	switch e {
+	case CueOrigin_CUE_ORIGIN_LIBRARYMUSIC:
+		return "LIBRARYMUSIC"
+	case CueOrigin_CUE_ORIGIN_PREEXISTINGMUSIC:
+		return "PREEXISTINGMUSIC"
+	case CueOrigin_CUE_ORIGIN_SPECIALLYCOMMISSIONEDMUSIC:
+		return "SPECIALLYCOMMISSIONEDMUSIC"
+	case CueOrigin_CUE_ORIGIN_UNKNOWN:
+		return "UNKNOWN"
+	case CueOrigin_CUE_ORIGIN_USERDEFINED:
+		return "USERDEFINED"
 	default:
 		return ""
 	}
@@ -305,6 +3516,16 @@ func (e CueOrigin) XMLString() string {
 func ParseCueOriginString(s string) (CueOrigin, bool) {
 	s = strings.ToUpper(s)
 	switch s {
+	case "LIBRARYMUSIC":
+		return CueOrigin_CUE_ORIGIN_LIBRARYMUSIC, true
+	case "PREEXISTINGMUSIC":
+		return CueOrigin_CUE_ORIGIN_PREEXISTINGMUSIC, true
+	case "SPECIALLYCOMMISSIONEDMUSIC":
+		return CueOrigin_CUE_ORIGIN_SPECIALLYCOMMISSIONEDMUSIC, true
+	case "UNKNOWN":
+		return CueOrigin_CUE_ORIGIN_UNKNOWN, true
+	case "USERDEFINED":
+		return CueOrigin_CUE_ORIGIN_USERDEFINED, true
 	default:
 		return CueOrigin(0), false
 	}
@@ -313,6 +3534,16 @@ func ParseCueOriginString(s string) (CueOrigin, bool) {
 // XMLString returns the XML string representation of CueSheetType
 func (e CueSheetType) XMLString() string {
 	switch e {
+	case CueSheetType_CUE_SHEET_TYPE_AVERAGECUESHEET:
+		return "AVERAGECUESHEET"
+	case CueSheetType_CUE_SHEET_TYPE_COMPOSITECUESHEET:
+		return "COMPOSITECUESHEET"
+	case CueSheetType_CUE_SHEET_TYPE_STANDARDCUESHEET:
+		return "STANDARDCUESHEET"
+	case CueSheetType_CUE_SHEET_TYPE_SUMMARISEDCUESHEET:
+		return "SUMMARISEDCUESHEET"
+	case CueSheetType_CUE_SHEET_TYPE_SURROGATECUESHEET:
+		return "SURROGATECUESHEET"
 	default:
 		return ""
 	}
@@ -322,6 +3553,16 @@ func (e CueSheetType) XMLString() string {
 func ParseCueSheetTypeString(s string) (CueSheetType, bool) {
 	s = strings.ToUpper(s)
 	switch s {
+	case "AVERAGECUESHEET":
+		return CueSheetType_CUE_SHEET_TYPE_AVERAGECUESHEET, true
+	case "COMPOSITECUESHEET":
+		return CueSheetType_CUE_SHEET_TYPE_COMPOSITECUESHEET, true
+	case "STANDARDCUESHEET":
+		return CueSheetType_CUE_SHEET_TYPE_STANDARDCUESHEET, true
+	case "SUMMARISEDCUESHEET":
+		return CueSheetType_CUE_SHEET_TYPE_SUMMARISEDCUESHEET, true
+	case "SURROGATECUESHEET":
+		return CueSheetType_CUE_SHEET_TYPE_SURROGATECUESHEET, true
 	default:
 		return CueSheetType(0), false
 	}
@@ -330,6 +3571,26 @@ func ParseCueSheetTypeString(s string) (CueSheetType, bool) {
 // XMLString returns the XML string representation of CueUseType
 func (e CueUseType) XMLString() string {
 	switch e {
+	case CueUseType_CUE_USE_TYPE_AUDIOLOGO:
+		return "AUDIOLOGO"
+	case CueUseType_CUE_USE_TYPE_BACKGROUND:
+		return "BACKGROUND"
+	case CueUseType_CUE_USE_TYPE_BUMPER:
+		return "BUMPER"
+	case CueUseType_CUE_USE_TYPE_ESSENTIALPART:
+		return "ESSENTIALPART"
+	case CueUseType_CUE_USE_TYPE_FILMTHEME:
+		return "FILMTHEME"
+	case CueUseType_CUE_USE_TYPE_INDISTINGUISHABLEBACKGROUND:
+		return "INDISTINGUISHABLEBACKGROUND"
+	case CueUseType_CUE_USE_TYPE_ONSCREENMUSIC:
+		return "ONSCREENMUSIC"
+	case CueUseType_CUE_USE_TYPE_ROLLEDUPCUE:
+		return "ROLLEDUPCUE"
+	case CueUseType_CUE_USE_TYPE_THEME:
+		return "THEME"
+	case CueUseType_CUE_USE_TYPE_USERDEFINED:
+		return "USERDEFINED"
 	default:
 		return ""
 	}
@@ -339,6 +3600,26 @@ func (e CueUseType) XMLString() string {
 func ParseCueUseTypeString(s string) (CueUseType, bool) {
 	s = strings.ToUpper(s)
 	switch s {
+	case "AUDIOLOGO":
+		return CueUseType_CUE_USE_TYPE_AUDIOLOGO, true
+	case "BACKGROUND":
+		return CueUseType_CUE_USE_TYPE_BACKGROUND, true
+	case "BUMPER":
+		return CueUseType_CUE_USE_TYPE_BUMPER, true
+	case "ESSENTIALPART":
+		return CueUseType_CUE_USE_TYPE_ESSENTIALPART, true
+	case "FILMTHEME":
+		return CueUseType_CUE_USE_TYPE_FILMTHEME, true
+	case "INDISTINGUISHABLEBACKGROUND":
+		return CueUseType_CUE_USE_TYPE_INDISTINGUISHABLEBACKGROUND, true
+	case "ONSCREENMUSIC":
+		return CueUseType_CUE_USE_TYPE_ONSCREENMUSIC, true
+	case "ROLLEDUPCUE":
+		return CueUseType_CUE_USE_TYPE_ROLLEDUPCUE, true
+	case "THEME":
+		return CueUseType_CUE_USE_TYPE_THEME, true
+	case "USERDEFINED":
+		return CueUseType_CUE_USE_TYPE_USERDEFINED, true
 	default:
 		return CueUseType(0), false
 	}
@@ -347,6 +3628,352 @@ func ParseCueUseTypeString(s string) (CueUseType, bool) {
 // XMLString returns the XML string representation of CurrencyCode
 func (e CurrencyCode) XMLString() string {
 	switch e {
+	case CurrencyCode_CURRENCY_CODE_AED:
+		return "AED"
+	case CurrencyCode_CURRENCY_CODE_AFN:
+		return "AFN"
+	case CurrencyCode_CURRENCY_CODE_ALL:
+		return "ALL"
+	case CurrencyCode_CURRENCY_CODE_AMD:
+		return "AMD"
+	case CurrencyCode_CURRENCY_CODE_ANG:
+		return "ANG"
+	case CurrencyCode_CURRENCY_CODE_AOA:
+		return "AOA"
+	case CurrencyCode_CURRENCY_CODE_ARS:
+		return "ARS"
+	case CurrencyCode_CURRENCY_CODE_AUD:
+		return "AUD"
+	case CurrencyCode_CURRENCY_CODE_AWG:
+		return "AWG"
+	case CurrencyCode_CURRENCY_CODE_AZN:
+		return "AZN"
+	case CurrencyCode_CURRENCY_CODE_BAM:
+		return "BAM"
+	case CurrencyCode_CURRENCY_CODE_BBD:
+		return "BBD"
+	case CurrencyCode_CURRENCY_CODE_BDT:
+		return "BDT"
+	case CurrencyCode_CURRENCY_CODE_BGN:
+		return "BGN"
+	case CurrencyCode_CURRENCY_CODE_BHD:
+		return "BHD"
+	case CurrencyCode_CURRENCY_CODE_BIF:
+		return "BIF"
+	case CurrencyCode_CURRENCY_CODE_BMD:
+		return "BMD"
+	case CurrencyCode_CURRENCY_CODE_BND:
+		return "BND"
+	case CurrencyCode_CURRENCY_CODE_BOB:
+		return "BOB"
+	case CurrencyCode_CURRENCY_CODE_BOV:
+		return "BOV"
+	case CurrencyCode_CURRENCY_CODE_BRL:
+		return "BRL"
+	case CurrencyCode_CURRENCY_CODE_BSD:
+		return "BSD"
+	case CurrencyCode_CURRENCY_CODE_BTN:
+		return "BTN"
+	case CurrencyCode_CURRENCY_CODE_BWP:
+		return "BWP"
+	case CurrencyCode_CURRENCY_CODE_BYR:
+		return "BYR"
+	case CurrencyCode_CURRENCY_CODE_BZD:
+		return "BZD"
+	case CurrencyCode_CURRENCY_CODE_CAD:
+		return "CAD"
+	case CurrencyCode_CURRENCY_CODE_CDF:
+		return "CDF"
+	case CurrencyCode_CURRENCY_CODE_CHF:
+		return "CHF"
+	case CurrencyCode_CURRENCY_CODE_CLF:
+		return "CLF"
+	case CurrencyCode_CURRENCY_CODE_CLP:
+		return "CLP"
+	case CurrencyCode_CURRENCY_CODE_CNY:
+		return "CNY"
+	case CurrencyCode_CURRENCY_CODE_COP:
+		return "COP"
+	case CurrencyCode_CURRENCY_CODE_COU:
+		return "COU"
+	case CurrencyCode_CURRENCY_CODE_CRC:
+		return "CRC"
+	case CurrencyCode_CURRENCY_CODE_CUC:
+		return "CUC"
+	case CurrencyCode_CURRENCY_CODE_CUP:
+		return "CUP"
+	case CurrencyCode_CURRENCY_CODE_CVE:
+		return "CVE"
+	case CurrencyCode_CURRENCY_CODE_CZK:
+		return "CZK"
+	case CurrencyCode_CURRENCY_CODE_DJF:
+		return "DJF"
+	case CurrencyCode_CURRENCY_CODE_DKK:
+		return "DKK"
+	case CurrencyCode_CURRENCY_CODE_DOP:
+		return "DOP"
+	case CurrencyCode_CURRENCY_CODE_DZD:
+		return "DZD"
+	case CurrencyCode_CURRENCY_CODE_EGP:
+		return "EGP"
+	case CurrencyCode_CURRENCY_CODE_ERN:
+		return "ERN"
+	case CurrencyCode_CURRENCY_CODE_ETB:
+		return "ETB"
+	case CurrencyCode_CURRENCY_CODE_EUR:
+		return "EUR"
+	case CurrencyCode_CURRENCY_CODE_FJD:
+		return "FJD"
+	case CurrencyCode_CURRENCY_CODE_FKP:
+		return "FKP"
+	case CurrencyCode_CURRENCY_CODE_GBP:
+		return "GBP"
+	case CurrencyCode_CURRENCY_CODE_GEL:
+		return "GEL"
+	case CurrencyCode_CURRENCY_CODE_GHS:
+		return "GHS"
+	case CurrencyCode_CURRENCY_CODE_GIP:
+		return "GIP"
+	case CurrencyCode_CURRENCY_CODE_GMD:
+		return "GMD"
+	case CurrencyCode_CURRENCY_CODE_GNF:
+		return "GNF"
+	case CurrencyCode_CURRENCY_CODE_GTQ:
+		return "GTQ"
+	case CurrencyCode_CURRENCY_CODE_GYD:
+		return "GYD"
+	case CurrencyCode_CURRENCY_CODE_HKD:
+		return "HKD"
+	case CurrencyCode_CURRENCY_CODE_HNL:
+		return "HNL"
+	case CurrencyCode_CURRENCY_CODE_HRK:
+		return "HRK"
+	case CurrencyCode_CURRENCY_CODE_HTG:
+		return "HTG"
+	case CurrencyCode_CURRENCY_CODE_HUF:
+		return "HUF"
+	case CurrencyCode_CURRENCY_CODE_IDR:
+		return "IDR"
+	case CurrencyCode_CURRENCY_CODE_ILS:
+		return "ILS"
+	case CurrencyCode_CURRENCY_CODE_INR:
+		return "INR"
+	case CurrencyCode_CURRENCY_CODE_IQD:
+		return "IQD"
+	case CurrencyCode_CURRENCY_CODE_IRR:
+		return "IRR"
+	case CurrencyCode_CURRENCY_CODE_ISK:
+		return "ISK"
+	case CurrencyCode_CURRENCY_CODE_JMD:
+		return "JMD"
+	case CurrencyCode_CURRENCY_CODE_JOD:
+		return "JOD"
+	case CurrencyCode_CURRENCY_CODE_JPY:
+		return "JPY"
+	case CurrencyCode_CURRENCY_CODE_KES:
+		return "KES"
+	case CurrencyCode_CURRENCY_CODE_KGS:
+		return "KGS"
+	case CurrencyCode_CURRENCY_CODE_KHR:
+		return "KHR"
+	case CurrencyCode_CURRENCY_CODE_KMF:
+		return "KMF"
+	case CurrencyCode_CURRENCY_CODE_KPW:
+		return "KPW"
+	case CurrencyCode_CURRENCY_CODE_KRW:
+		return "KRW"
+	case CurrencyCode_CURRENCY_CODE_KWD:
+		return "KWD"
+	case CurrencyCode_CURRENCY_CODE_KYD:
+		return "KYD"
+	case CurrencyCode_CURRENCY_CODE_KZT:
+		return "KZT"
+	case CurrencyCode_CURRENCY_CODE_LAK:
+		return "LAK"
+	case CurrencyCode_CURRENCY_CODE_LBP:
+		return "LBP"
+	case CurrencyCode_CURRENCY_CODE_LKR:
+		return "LKR"
+	case CurrencyCode_CURRENCY_CODE_LRD:
+		return "LRD"
+	case CurrencyCode_CURRENCY_CODE_LSL:
+		return "LSL"
+	case CurrencyCode_CURRENCY_CODE_LYD:
+		return "LYD"
+	case CurrencyCode_CURRENCY_CODE_MAD:
+		return "MAD"
+	case CurrencyCode_CURRENCY_CODE_MDL:
+		return "MDL"
+	case CurrencyCode_CURRENCY_CODE_MGA:
+		return "MGA"
+	case CurrencyCode_CURRENCY_CODE_MKD:
+		return "MKD"
+	case CurrencyCode_CURRENCY_CODE_MMK:
+		return "MMK"
+	case CurrencyCode_CURRENCY_CODE_MNT:
+		return "MNT"
+	case CurrencyCode_CURRENCY_CODE_MOP:
+		return "MOP"
+	case CurrencyCode_CURRENCY_CODE_MRU:
+		return "MRU"
+	case CurrencyCode_CURRENCY_CODE_MUR:
+		return "MUR"
+	case CurrencyCode_CURRENCY_CODE_MVR:
+		return "MVR"
+	case CurrencyCode_CURRENCY_CODE_MWK:
+		return "MWK"
+	case CurrencyCode_CURRENCY_CODE_MXN:
+		return "MXN"
+	case CurrencyCode_CURRENCY_CODE_MXV:
+		return "MXV"
+	case CurrencyCode_CURRENCY_CODE_MYR:
+		return "MYR"
+	case CurrencyCode_CURRENCY_CODE_MZN:
+		return "MZN"
+	case CurrencyCode_CURRENCY_CODE_NAD:
+		return "NAD"
+	case CurrencyCode_CURRENCY_CODE_NGN:
+		return "NGN"
+	case CurrencyCode_CURRENCY_CODE_NIO:
+		return "NIO"
+	case CurrencyCode_CURRENCY_CODE_NOK:
+		return "NOK"
+	case CurrencyCode_CURRENCY_CODE_NPR:
+		return "NPR"
+	case CurrencyCode_CURRENCY_CODE_NZD:
+		return "NZD"
+	case CurrencyCode_CURRENCY_CODE_OMR:
+		return "OMR"
+	case CurrencyCode_CURRENCY_CODE_PAB:
+		return "PAB"
+	case CurrencyCode_CURRENCY_CODE_PEN:
+		return "PEN"
+	case CurrencyCode_CURRENCY_CODE_PGK:
+		return "PGK"
+	case CurrencyCode_CURRENCY_CODE_PHP:
+		return "PHP"
+	case CurrencyCode_CURRENCY_CODE_PKR:
+		return "PKR"
+	case CurrencyCode_CURRENCY_CODE_PLN:
+		return "PLN"
+	case CurrencyCode_CURRENCY_CODE_PYG:
+		return "PYG"
+	case CurrencyCode_CURRENCY_CODE_QAR:
+		return "QAR"
+	case CurrencyCode_CURRENCY_CODE_RON:
+		return "RON"
+	case CurrencyCode_CURRENCY_CODE_RSD:
+		return "RSD"
+	case CurrencyCode_CURRENCY_CODE_RUB:
+		return "RUB"
+	case CurrencyCode_CURRENCY_CODE_RWF:
+		return "RWF"
+	case CurrencyCode_CURRENCY_CODE_SAR:
+		return "SAR"
+	case CurrencyCode_CURRENCY_CODE_SBD:
+		return "SBD"
+	case CurrencyCode_CURRENCY_CODE_SCR:
+		return "SCR"
+	case CurrencyCode_CURRENCY_CODE_SDG:
+		return "SDG"
+	case CurrencyCode_CURRENCY_CODE_SEK:
+		return "SEK"
+	case CurrencyCode_CURRENCY_CODE_SGD:
+		return "SGD"
+	case CurrencyCode_CURRENCY_CODE_SHP:
+		return "SHP"
+	case CurrencyCode_CURRENCY_CODE_SLL:
+		return "SLL"
+	case CurrencyCode_CURRENCY_CODE_SOS:
+		return "SOS"
+	case CurrencyCode_CURRENCY_CODE_SRD:
+		return "SRD"
+	case CurrencyCode_CURRENCY_CODE_SSP:
+		return "SSP"
+	case CurrencyCode_CURRENCY_CODE_STN:
+		return "STN"
+	case CurrencyCode_CURRENCY_CODE_SVC:
+		return "SVC"
+	case CurrencyCode_CURRENCY_CODE_SYP:
+		return "SYP"
+	case CurrencyCode_CURRENCY_CODE_SZL:
+		return "SZL"
+	case CurrencyCode_CURRENCY_CODE_THB:
+		return "THB"
+	case CurrencyCode_CURRENCY_CODE_TJS:
+		return "TJS"
+	case CurrencyCode_CURRENCY_CODE_TMT:
+		return "TMT"
+	case CurrencyCode_CURRENCY_CODE_TND:
+		return "TND"
+	case CurrencyCode_CURRENCY_CODE_TOP:
+		return "TOP"
+	case CurrencyCode_CURRENCY_CODE_TRY:
+		return "TRY"
+	case CurrencyCode_CURRENCY_CODE_TTD:
+		return "TTD"
+	case CurrencyCode_CURRENCY_CODE_TWD:
+		return "TWD"
+	case CurrencyCode_CURRENCY_CODE_TZS:
+		return "TZS"
+	case CurrencyCode_CURRENCY_CODE_UAH:
+		return "UAH"
+	case CurrencyCode_CURRENCY_CODE_UGX:
+		return "UGX"
+	case CurrencyCode_CURRENCY_CODE_USD:
+		return "USD"
+	case CurrencyCode_CURRENCY_CODE_UYI:
+		return "UYI"
+	case CurrencyCode_CURRENCY_CODE_UYU:
+		return "UYU"
+	case CurrencyCode_CURRENCY_CODE_UZS:
+		return "UZS"
+	case CurrencyCode_CURRENCY_CODE_VES:
+		return "VES"
+	case CurrencyCode_CURRENCY_CODE_VND:
+		return "VND"
+	case CurrencyCode_CURRENCY_CODE_VUV:
+		return "VUV"
+	case CurrencyCode_CURRENCY_CODE_WST:
+		return "WST"
+	case CurrencyCode_CURRENCY_CODE_XAF:
+		return "XAF"
+	case CurrencyCode_CURRENCY_CODE_XCD:
+		return "XCD"
+	case CurrencyCode_CURRENCY_CODE_XOF:
+		return "XOF"
+	case CurrencyCode_CURRENCY_CODE_XPF:
+		return "XPF"
+	case CurrencyCode_CURRENCY_CODE_YER:
+		return "YER"
+	case CurrencyCode_CURRENCY_CODE_ZAR:
+		return "ZAR"
+	case CurrencyCode_CURRENCY_CODE_ZMW:
+		return "ZMW"
+	case CurrencyCode_CURRENCY_CODE_ZWL:
+		return "ZWL"
+	case CurrencyCode_CURRENCY_CODE_CYP:
+		return "CYP"
+	case CurrencyCode_CURRENCY_CODE_EEK:
+		return "EEK"
+	case CurrencyCode_CURRENCY_CODE_LTL:
+		return "LTL"
+	case CurrencyCode_CURRENCY_CODE_LVL:
+		return "LVL"
+	case CurrencyCode_CURRENCY_CODE_MTL:
+		return "MTL"
+	case CurrencyCode_CURRENCY_CODE_MRO:
+		return "MRO"
+	case CurrencyCode_CURRENCY_CODE_ROL:
+		return "ROL"
+	case CurrencyCode_CURRENCY_CODE_SIT:
+		return "SIT"
+	case CurrencyCode_CURRENCY_CODE_SKK:
+		return "SKK"
+	case CurrencyCode_CURRENCY_CODE_STD:
+		return "STD"
+	case CurrencyCode_CURRENCY_CODE_VEF:
+		return "VEF"
 	default:
 		return ""
 	}
@@ -356,6 +3983,352 @@ func (e CurrencyCode) XMLString() string {
 func ParseCurrencyCodeString(s string) (CurrencyCode, bool) {
 	s = strings.ToUpper(s)
 	switch s {
+	case "AED":
+		return CurrencyCode_CURRENCY_CODE_AED, true
+	case "AFN":
+		return CurrencyCode_CURRENCY_CODE_AFN, true
+	case "ALL":
+		return CurrencyCode_CURRENCY_CODE_ALL, true
+	case "AMD":
+		return CurrencyCode_CURRENCY_CODE_AMD, true
+	case "ANG":
+		return CurrencyCode_CURRENCY_CODE_ANG, true
+	case "AOA":
+		return CurrencyCode_CURRENCY_CODE_AOA, true
+	case "ARS":
+		return CurrencyCode_CURRENCY_CODE_ARS, true
+	case "AUD":
+		return CurrencyCode_CURRENCY_CODE_AUD, true
+	case "AWG":
+		return CurrencyCode_CURRENCY_CODE_AWG, true
+	case "AZN":
+		return CurrencyCode_CURRENCY_CODE_AZN, true
+	case "BAM":
+		return CurrencyCode_CURRENCY_CODE_BAM, true
+	case "BBD":
+		return CurrencyCode_CURRENCY_CODE_BBD, true
+	case "BDT":
+		return CurrencyCode_CURRENCY_CODE_BDT, true
+	case "BGN":
+		return CurrencyCode_CURRENCY_CODE_BGN, true
+	case "BHD":
+		return CurrencyCode_CURRENCY_CODE_BHD, true
+	case "BIF":
+		return CurrencyCode_CURRENCY_CODE_BIF, true
+	case "BMD":
+		return CurrencyCode_CURRENCY_CODE_BMD, true
+	case "BND":
+		return CurrencyCode_CURRENCY_CODE_BND, true
+	case "BOB":
+		return CurrencyCode_CURRENCY_CODE_BOB, true
+	case "BOV":
+		return CurrencyCode_CURRENCY_CODE_BOV, true
+	case "BRL":
+		return CurrencyCode_CURRENCY_CODE_BRL, true
+	case "BSD":
+		return CurrencyCode_CURRENCY_CODE_BSD, true
+	case "BTN":
+		return CurrencyCode_CURRENCY_CODE_BTN, true
+	case "BWP":
+		return CurrencyCode_CURRENCY_CODE_BWP, true
+	case "BYR":
+		return CurrencyCode_CURRENCY_CODE_BYR, true
+	case "BZD":
+		return CurrencyCode_CURRENCY_CODE_BZD, true
+	case "CAD":
+		return CurrencyCode_CURRENCY_CODE_CAD, true
+	case "CDF":
+		return CurrencyCode_CURRENCY_CODE_CDF, true
+	case "CHF":
+		return CurrencyCode_CURRENCY_CODE_CHF, true
+	case "CLF":
+		return CurrencyCode_CURRENCY_CODE_CLF, true
+	case "CLP":
+		return CurrencyCode_CURRENCY_CODE_CLP, true
+	case "CNY":
+		return CurrencyCode_CURRENCY_CODE_CNY, true
+	case "COP":
+		return CurrencyCode_CURRENCY_CODE_COP, true
+	case "COU":
+		return CurrencyCode_CURRENCY_CODE_COU, true
+	case "CRC":
+		return CurrencyCode_CURRENCY_CODE_CRC, true
+	case "CUC":
+		return CurrencyCode_CURRENCY_CODE_CUC, true
+	case "CUP":
+		return CurrencyCode_CURRENCY_CODE_CUP, true
+	case "CVE":
+		return CurrencyCode_CURRENCY_CODE_CVE, true
+	case "CZK":
+		return CurrencyCode_CURRENCY_CODE_CZK, true
+	case "DJF":
+		return CurrencyCode_CURRENCY_CODE_DJF, true
+	case "DKK":
+		return CurrencyCode_CURRENCY_CODE_DKK, true
+	case "DOP":
+		return CurrencyCode_CURRENCY_CODE_DOP, true
+	case "DZD":
+		return CurrencyCode_CURRENCY_CODE_DZD, true
+	case "EGP":
+		return CurrencyCode_CURRENCY_CODE_EGP, true
+	case "ERN":
+		return CurrencyCode_CURRENCY_CODE_ERN, true
+	case "ETB":
+		return CurrencyCode_CURRENCY_CODE_ETB, true
+	case "EUR":
+		return CurrencyCode_CURRENCY_CODE_EUR, true
+	case "FJD":
+		return CurrencyCode_CURRENCY_CODE_FJD, true
+	case "FKP":
+		return CurrencyCode_CURRENCY_CODE_FKP, true
+	case "GBP":
+		return CurrencyCode_CURRENCY_CODE_GBP, true
+	case "GEL":
+		return CurrencyCode_CURRENCY_CODE_GEL, true
+	case "GHS":
+		return CurrencyCode_CURRENCY_CODE_GHS, true
+	case "GIP":
+		return CurrencyCode_CURRENCY_CODE_GIP, true
+	case "GMD":
+		return CurrencyCode_CURRENCY_CODE_GMD, true
+	case "GNF":
+		return CurrencyCode_CURRENCY_CODE_GNF, true
+	case "GTQ":
+		return CurrencyCode_CURRENCY_CODE_GTQ, true
+	case "GYD":
+		return CurrencyCode_CURRENCY_CODE_GYD, true
+	case "HKD":
+		return CurrencyCode_CURRENCY_CODE_HKD, true
+	case "HNL":
+		return CurrencyCode_CURRENCY_CODE_HNL, true
+	case "HRK":
+		return CurrencyCode_CURRENCY_CODE_HRK, true
+	case "HTG":
+		return CurrencyCode_CURRENCY_CODE_HTG, true
+	case "HUF":
+		return CurrencyCode_CURRENCY_CODE_HUF, true
+	case "IDR":
+		return CurrencyCode_CURRENCY_CODE_IDR, true
+	case "ILS":
+		return CurrencyCode_CURRENCY_CODE_ILS, true
+	case "INR":
+		return CurrencyCode_CURRENCY_CODE_INR, true
+	case "IQD":
+		return CurrencyCode_CURRENCY_CODE_IQD, true
+	case "IRR":
+		return CurrencyCode_CURRENCY_CODE_IRR, true
+	case "ISK":
+		return CurrencyCode_CURRENCY_CODE_ISK, true
+	case "JMD":
+		return CurrencyCode_CURRENCY_CODE_JMD, true
+	case "JOD":
+		return CurrencyCode_CURRENCY_CODE_JOD, true
+	case "JPY":
+		return CurrencyCode_CURRENCY_CODE_JPY, true
+	case "KES":
+		return CurrencyCode_CURRENCY_CODE_KES, true
+	case "KGS":
+		return CurrencyCode_CURRENCY_CODE_KGS, true
+	case "KHR":
+		return CurrencyCode_CURRENCY_CODE_KHR, true
+	case "KMF":
+		return CurrencyCode_CURRENCY_CODE_KMF, true
+	case "KPW":
+		return CurrencyCode_CURRENCY_CODE_KPW, true
+	case "KRW":
+		return CurrencyCode_CURRENCY_CODE_KRW, true
+	case "KWD":
+		return CurrencyCode_CURRENCY_CODE_KWD, true
+	case "KYD":
+		return CurrencyCode_CURRENCY_CODE_KYD, true
+	case "KZT":
+		return CurrencyCode_CURRENCY_CODE_KZT, true
+	case "LAK":
+		return CurrencyCode_CURRENCY_CODE_LAK, true
+	case "LBP":
+		return CurrencyCode_CURRENCY_CODE_LBP, true
+	case "LKR":
+		return CurrencyCode_CURRENCY_CODE_LKR, true
+	case "LRD":
+		return CurrencyCode_CURRENCY_CODE_LRD, true
+	case "LSL":
+		return CurrencyCode_CURRENCY_CODE_LSL, true
+	case "LYD":
+		return CurrencyCode_CURRENCY_CODE_LYD, true
+	case "MAD":
+		return CurrencyCode_CURRENCY_CODE_MAD, true
+	case "MDL":
+		return CurrencyCode_CURRENCY_CODE_MDL, true
+	case "MGA":
+		return CurrencyCode_CURRENCY_CODE_MGA, true
+	case "MKD":
+		return CurrencyCode_CURRENCY_CODE_MKD, true
+	case "MMK":
+		return CurrencyCode_CURRENCY_CODE_MMK, true
+	case "MNT":
+		return CurrencyCode_CURRENCY_CODE_MNT, true
+	case "MOP":
+		return CurrencyCode_CURRENCY_CODE_MOP, true
+	case "MRU":
+		return CurrencyCode_CURRENCY_CODE_MRU, true
+	case "MUR":
+		return CurrencyCode_CURRENCY_CODE_MUR, true
+	case "MVR":
+		return CurrencyCode_CURRENCY_CODE_MVR, true
+	case "MWK":
+		return CurrencyCode_CURRENCY_CODE_MWK, true
+	case "MXN":
+		return CurrencyCode_CURRENCY_CODE_MXN, true
+	case "MXV":
+		return CurrencyCode_CURRENCY_CODE_MXV, true
+	case "MYR":
+		return CurrencyCode_CURRENCY_CODE_MYR, true
+	case "MZN":
+		return CurrencyCode_CURRENCY_CODE_MZN, true
+	case "NAD":
+		return CurrencyCode_CURRENCY_CODE_NAD, true
+	case "NGN":
+		return CurrencyCode_CURRENCY_CODE_NGN, true
+	case "NIO":
+		return CurrencyCode_CURRENCY_CODE_NIO, true
+	case "NOK":
+		return CurrencyCode_CURRENCY_CODE_NOK, true
+	case "NPR":
+		return CurrencyCode_CURRENCY_CODE_NPR, true
+	case "NZD":
+		return CurrencyCode_CURRENCY_CODE_NZD, true
+	case "OMR":
+		return CurrencyCode_CURRENCY_CODE_OMR, true
+	case "PAB":
+		return CurrencyCode_CURRENCY_CODE_PAB, true
+	case "PEN":
+		return CurrencyCode_CURRENCY_CODE_PEN, true
+	case "PGK":
+		return CurrencyCode_CURRENCY_CODE_PGK, true
+	case "PHP":
+		return CurrencyCode_CURRENCY_CODE_PHP, true
+	case "PKR":
+		return CurrencyCode_CURRENCY_CODE_PKR, true
+	case "PLN":
+		return CurrencyCode_CURRENCY_CODE_PLN, true
+	case "PYG":
+		return CurrencyCode_CURRENCY_CODE_PYG, true
+	case "QAR":
+		return CurrencyCode_CURRENCY_CODE_QAR, true
+	case "RON":
+		return CurrencyCode_CURRENCY_CODE_RON, true
+	case "RSD":
+		return CurrencyCode_CURRENCY_CODE_RSD, true
+	case "RUB":
+		return CurrencyCode_CURRENCY_CODE_RUB, true
+	case "RWF":
+		return CurrencyCode_CURRENCY_CODE_RWF, true
+	case "SAR":
+		return CurrencyCode_CURRENCY_CODE_SAR, true
+	case "SBD":
+		return CurrencyCode_CURRENCY_CODE_SBD, true
+	case "SCR":
+		return CurrencyCode_CURRENCY_CODE_SCR, true
+	case "SDG":
+		return CurrencyCode_CURRENCY_CODE_SDG, true
+	case "SEK":
+		return CurrencyCode_CURRENCY_CODE_SEK, true
+	case "SGD":
+		return CurrencyCode_CURRENCY_CODE_SGD, true
+	case "SHP":
+		return CurrencyCode_CURRENCY_CODE_SHP, true
+	case "SLL":
+		return CurrencyCode_CURRENCY_CODE_SLL, true
+	case "SOS":
+		return CurrencyCode_CURRENCY_CODE_SOS, true
+	case "SRD":
+		return CurrencyCode_CURRENCY_CODE_SRD, true
+	case "SSP":
+		return CurrencyCode_CURRENCY_CODE_SSP, true
+	case "STN":
+		return CurrencyCode_CURRENCY_CODE_STN, true
+	case "SVC":
+		return CurrencyCode_CURRENCY_CODE_SVC, true
+	case "SYP":
+		return CurrencyCode_CURRENCY_CODE_SYP, true
+	case "SZL":
+		return CurrencyCode_CURRENCY_CODE_SZL, true
+	case "THB":
+		return CurrencyCode_CURRENCY_CODE_THB, true
+	case "TJS":
+		return CurrencyCode_CURRENCY_CODE_TJS, true
+	case "TMT":
+		return CurrencyCode_CURRENCY_CODE_TMT, true
+	case "TND":
+		return CurrencyCode_CURRENCY_CODE_TND, true
+	case "TOP":
+		return CurrencyCode_CURRENCY_CODE_TOP, true
+	case "TRY":
+		return CurrencyCode_CURRENCY_CODE_TRY, true
+	case "TTD":
+		return CurrencyCode_CURRENCY_CODE_TTD, true
+	case "TWD":
+		return CurrencyCode_CURRENCY_CODE_TWD, true
+	case "TZS":
+		return CurrencyCode_CURRENCY_CODE_TZS, true
+	case "UAH":
+		return CurrencyCode_CURRENCY_CODE_UAH, true
+	case "UGX":
+		return CurrencyCode_CURRENCY_CODE_UGX, true
+	case "USD":
+		return CurrencyCode_CURRENCY_CODE_USD, true
+	case "UYI":
+		return CurrencyCode_CURRENCY_CODE_UYI, true
+	case "UYU":
+		return CurrencyCode_CURRENCY_CODE_UYU, true
+	case "UZS":
+		return CurrencyCode_CURRENCY_CODE_UZS, true
+	case "VES":
+		return CurrencyCode_CURRENCY_CODE_VES, true
+	case "VND":
+		return CurrencyCode_CURRENCY_CODE_VND, true
+	case "VUV":
+		return CurrencyCode_CURRENCY_CODE_VUV, true
+	case "WST":
+		return CurrencyCode_CURRENCY_CODE_WST, true
+	case "XAF":
+		return CurrencyCode_CURRENCY_CODE_XAF, true
+	case "XCD":
+		return CurrencyCode_CURRENCY_CODE_XCD, true
+	case "XOF":
+		return CurrencyCode_CURRENCY_CODE_XOF, true
+	case "XPF":
+		return CurrencyCode_CURRENCY_CODE_XPF, true
+	case "YER":
+		return CurrencyCode_CURRENCY_CODE_YER, true
+	case "ZAR":
+		return CurrencyCode_CURRENCY_CODE_ZAR, true
+	case "ZMW":
+		return CurrencyCode_CURRENCY_CODE_ZMW, true
+	case "ZWL":
+		return CurrencyCode_CURRENCY_CODE_ZWL, true
+	case "CYP":
+		return CurrencyCode_CURRENCY_CODE_CYP, true
+	case "EEK":
+		return CurrencyCode_CURRENCY_CODE_EEK, true
+	case "LTL":
+		return CurrencyCode_CURRENCY_CODE_LTL, true
+	case "LVL":
+		return CurrencyCode_CURRENCY_CODE_LVL, true
+	case "MTL":
+		return CurrencyCode_CURRENCY_CODE_MTL, true
+	case "MRO":
+		return CurrencyCode_CURRENCY_CODE_MRO, true
+	case "ROL":
+		return CurrencyCode_CURRENCY_CODE_ROL, true
+	case "SIT":
+		return CurrencyCode_CURRENCY_CODE_SIT, true
+	case "SKK":
+		return CurrencyCode_CURRENCY_CODE_SKK, true
+	case "STD":
+		return CurrencyCode_CURRENCY_CODE_STD, true
+	case "VEF":
+		return CurrencyCode_CURRENCY_CODE_VEF, true
 	default:
 		return CurrencyCode(0), false
 	}
@@ -364,15 +4337,2028 @@ func ParseCurrencyCodeString(s string) (CurrencyCode, bool) {
 // XMLString returns the XML string representation of CurrentTerritoryCode
 func (e CurrentTerritoryCode) XMLString() string {
 	switch e {
+	case CurrentTerritoryCode_CURRENT_TERRITORY_CODE_AD:
+		return "AD"
+	case CurrentTerritoryCode_CURRENT_TERRITORY_CODE_AE:
+		return "AE"
+	case CurrentTerritoryCode_CURRENT_TERRITORY_CODE_AF:
+		return "AF"
+	case CurrentTerritoryCode_CURRENT_TERRITORY_CODE_AG:
+		return "AG"
+	case CurrentTerritoryCode_CURRENT_TERRITORY_CODE_AI:
+		return "AI"
+	case CurrentTerritoryCode_CURRENT_TERRITORY_CODE_AL:
+		return "AL"
+	case CurrentTerritoryCode_CURRENT_TERRITORY_CODE_AM:
+		return "AM"
+	case CurrentTerritoryCode_CURRENT_TERRITORY_CODE_AN:
+		return "AN"
+	case CurrentTerritoryCode_CURRENT_TERRITORY_CODE_AO:
+		return "AO"
+	case CurrentTerritoryCode_CURRENT_TERRITORY_CODE_AQ:
+		return "AQ"
+	case CurrentTerritoryCode_CURRENT_TERRITORY_CODE_AR:
+		return "AR"
+	case CurrentTerritoryCode_CURRENT_TERRITORY_CODE_AS:
+		return "AS"
+	case CurrentTerritoryCode_CURRENT_TERRITORY_CODE_AT:
+		return "AT"
+	case CurrentTerritoryCode_CURRENT_TERRITORY_CODE_AU:
+		return "AU"
+	case CurrentTerritoryCode_CURRENT_TERRITORY_CODE_AW:
+		return "AW"
+	case CurrentTerritoryCode_CURRENT_TERRITORY_CODE_AX:
+		return "AX"
+	case CurrentTerritoryCode_CURRENT_TERRITORY_CODE_AZ:
+		return "AZ"
+	case CurrentTerritoryCode_CURRENT_TERRITORY_CODE_BA:
+		return "BA"
+	case CurrentTerritoryCode_CURRENT_TERRITORY_CODE_BB:
+		return "BB"
+	case CurrentTerritoryCode_CURRENT_TERRITORY_CODE_BD:
+		return "BD"
+	case CurrentTerritoryCode_CURRENT_TERRITORY_CODE_BE:
+		return "BE"
+	case CurrentTerritoryCode_CURRENT_TERRITORY_CODE_BF:
+		return "BF"
+	case CurrentTerritoryCode_CURRENT_TERRITORY_CODE_BG:
+		return "BG"
+	case CurrentTerritoryCode_CURRENT_TERRITORY_CODE_BH:
+		return "BH"
+	case CurrentTerritoryCode_CURRENT_TERRITORY_CODE_BI:
+		return "BI"
+	case CurrentTerritoryCode_CURRENT_TERRITORY_CODE_BJ:
+		return "BJ"
+	case CurrentTerritoryCode_CURRENT_TERRITORY_CODE_BL:
+		return "BL"
+	case CurrentTerritoryCode_CURRENT_TERRITORY_CODE_BM:
+		return "BM"
+	case CurrentTerritoryCode_CURRENT_TERRITORY_CODE_BN:
+		return "BN"
+	case CurrentTerritoryCode_CURRENT_TERRITORY_CODE_BO:
+		return "BO"
+	case CurrentTerritoryCode_CURRENT_TERRITORY_CODE_BQ:
+		return "BQ"
+	case CurrentTerritoryCode_CURRENT_TERRITORY_CODE_BR:
+		return "BR"
+	case CurrentTerritoryCode_CURRENT_TERRITORY_CODE_BS:
+		return "BS"
+	case CurrentTerritoryCode_CURRENT_TERRITORY_CODE_BT:
+		return "BT"
+	case CurrentTerritoryCode_CURRENT_TERRITORY_CODE_BV:
+		return "BV"
+	case CurrentTerritoryCode_CURRENT_TERRITORY_CODE_BW:
+		return "BW"
+	case CurrentTerritoryCode_CURRENT_TERRITORY_CODE_BY:
+		return "BY"
+	case CurrentTerritoryCode_CURRENT_TERRITORY_CODE_BZ:
+		return "BZ"
+	case CurrentTerritoryCode_CURRENT_TERRITORY_CODE_CA:
+		return "CA"
+	case CurrentTerritoryCode_CURRENT_TERRITORY_CODE_CC:
+		return "CC"
+	case CurrentTerritoryCode_CURRENT_TERRITORY_CODE_CD:
+		return "CD"
+	case CurrentTerritoryCode_CURRENT_TERRITORY_CODE_CF:
+		return "CF"
+	case CurrentTerritoryCode_CURRENT_TERRITORY_CODE_CG:
+		return "CG"
+	case CurrentTerritoryCode_CURRENT_TERRITORY_CODE_CH:
+		return "CH"
+	case CurrentTerritoryCode_CURRENT_TERRITORY_CODE_CI:
+		return "CI"
+	case CurrentTerritoryCode_CURRENT_TERRITORY_CODE_CK:
+		return "CK"
+	case CurrentTerritoryCode_CURRENT_TERRITORY_CODE_CL:
+		return "CL"
+	case CurrentTerritoryCode_CURRENT_TERRITORY_CODE_CM:
+		return "CM"
+	case CurrentTerritoryCode_CURRENT_TERRITORY_CODE_CN:
+		return "CN"
+	case CurrentTerritoryCode_CURRENT_TERRITORY_CODE_CO:
+		return "CO"
+	case CurrentTerritoryCode_CURRENT_TERRITORY_CODE_CR:
+		return "CR"
+	case CurrentTerritoryCode_CURRENT_TERRITORY_CODE_CS:
+		return "CS"
+	case CurrentTerritoryCode_CURRENT_TERRITORY_CODE_CU:
+		return "CU"
+	case CurrentTerritoryCode_CURRENT_TERRITORY_CODE_CV:
+		return "CV"
+	case CurrentTerritoryCode_CURRENT_TERRITORY_CODE_CW:
+		return "CW"
+	case CurrentTerritoryCode_CURRENT_TERRITORY_CODE_CX:
+		return "CX"
+	case CurrentTerritoryCode_CURRENT_TERRITORY_CODE_CY:
+		return "CY"
+	case CurrentTerritoryCode_CURRENT_TERRITORY_CODE_CZ:
+		return "CZ"
+	case CurrentTerritoryCode_CURRENT_TERRITORY_CODE_DE:
+		return "DE"
+	case CurrentTerritoryCode_CURRENT_TERRITORY_CODE_DJ:
+		return "DJ"
+	case CurrentTerritoryCode_CURRENT_TERRITORY_CODE_DK:
+		return "DK"
+	case CurrentTerritoryCode_CURRENT_TERRITORY_CODE_DM:
+		return "DM"
+	case CurrentTerritoryCode_CURRENT_TERRITORY_CODE_DO:
+		return "DO"
+	case CurrentTerritoryCode_CURRENT_TERRITORY_CODE_DZ:
+		return "DZ"
+	case CurrentTerritoryCode_CURRENT_TERRITORY_CODE_EC:
+		return "EC"
+	case CurrentTerritoryCode_CURRENT_TERRITORY_CODE_EE:
+		return "EE"
+	case CurrentTerritoryCode_CURRENT_TERRITORY_CODE_EG:
+		return "EG"
+	case CurrentTerritoryCode_CURRENT_TERRITORY_CODE_EH:
+		return "EH"
+	case CurrentTerritoryCode_CURRENT_TERRITORY_CODE_ER:
+		return "ER"
+	case CurrentTerritoryCode_CURRENT_TERRITORY_CODE_ES:
+		return "ES"
+	case CurrentTerritoryCode_CURRENT_TERRITORY_CODE_ES_CE:
+		return "ES_CE"
+	case CurrentTerritoryCode_CURRENT_TERRITORY_CODE_ES_CN:
+		return "ES_CN"
+	case CurrentTerritoryCode_CURRENT_TERRITORY_CODE_ES_ML:
+		return "ES_ML"
+	case CurrentTerritoryCode_CURRENT_TERRITORY_CODE_ET:
+		return "ET"
+	case CurrentTerritoryCode_CURRENT_TERRITORY_CODE_FI:
+		return "FI"
+	case CurrentTerritoryCode_CURRENT_TERRITORY_CODE_FJ:
+		return "FJ"
+	case CurrentTerritoryCode_CURRENT_TERRITORY_CODE_FK:
+		return "FK"
+	case CurrentTerritoryCode_CURRENT_TERRITORY_CODE_FM:
+		return "FM"
+	case CurrentTerritoryCode_CURRENT_TERRITORY_CODE_FO:
+		return "FO"
+	case CurrentTerritoryCode_CURRENT_TERRITORY_CODE_FR:
+		return "FR"
+	case CurrentTerritoryCode_CURRENT_TERRITORY_CODE_GA:
+		return "GA"
+	case CurrentTerritoryCode_CURRENT_TERRITORY_CODE_GB:
+		return "GB"
+	case CurrentTerritoryCode_CURRENT_TERRITORY_CODE_GD:
+		return "GD"
+	case CurrentTerritoryCode_CURRENT_TERRITORY_CODE_GE:
+		return "GE"
+	case CurrentTerritoryCode_CURRENT_TERRITORY_CODE_GF:
+		return "GF"
+	case CurrentTerritoryCode_CURRENT_TERRITORY_CODE_GG:
+		return "GG"
+	case CurrentTerritoryCode_CURRENT_TERRITORY_CODE_GH:
+		return "GH"
+	case CurrentTerritoryCode_CURRENT_TERRITORY_CODE_GI:
+		return "GI"
+	case CurrentTerritoryCode_CURRENT_TERRITORY_CODE_GL:
+		return "GL"
+	case CurrentTerritoryCode_CURRENT_TERRITORY_CODE_GM:
+		return "GM"
+	case CurrentTerritoryCode_CURRENT_TERRITORY_CODE_GN:
+		return "GN"
+	case CurrentTerritoryCode_CURRENT_TERRITORY_CODE_GP:
+		return "GP"
+	case CurrentTerritoryCode_CURRENT_TERRITORY_CODE_GQ:
+		return "GQ"
+	case CurrentTerritoryCode_CURRENT_TERRITORY_CODE_GR:
+		return "GR"
+	case CurrentTerritoryCode_CURRENT_TERRITORY_CODE_GS:
+		return "GS"
+	case CurrentTerritoryCode_CURRENT_TERRITORY_CODE_GT:
+		return "GT"
+	case CurrentTerritoryCode_CURRENT_TERRITORY_CODE_GU:
+		return "GU"
+	case CurrentTerritoryCode_CURRENT_TERRITORY_CODE_GW:
+		return "GW"
+	case CurrentTerritoryCode_CURRENT_TERRITORY_CODE_GY:
+		return "GY"
+	case CurrentTerritoryCode_CURRENT_TERRITORY_CODE_HK:
+		return "HK"
+	case CurrentTerritoryCode_CURRENT_TERRITORY_CODE_HM:
+		return "HM"
+	case CurrentTerritoryCode_CURRENT_TERRITORY_CODE_HN:
+		return "HN"
+	case CurrentTerritoryCode_CURRENT_TERRITORY_CODE_HR:
+		return "HR"
+	case CurrentTerritoryCode_CURRENT_TERRITORY_CODE_HT:
+		return "HT"
+	case CurrentTerritoryCode_CURRENT_TERRITORY_CODE_HU:
+		return "HU"
+	case CurrentTerritoryCode_CURRENT_TERRITORY_CODE_ID:
+		return "ID"
+	case CurrentTerritoryCode_CURRENT_TERRITORY_CODE_IE:
+		return "IE"
+	case CurrentTerritoryCode_CURRENT_TERRITORY_CODE_IL:
+		return "IL"
+	case CurrentTerritoryCode_CURRENT_TERRITORY_CODE_IM:
+		return "IM"
+	case CurrentTerritoryCode_CURRENT_TERRITORY_CODE_IN:
+		return "IN"
+	case CurrentTerritoryCode_CURRENT_TERRITORY_CODE_IO:
+		return "IO"
+	case CurrentTerritoryCode_CURRENT_TERRITORY_CODE_IQ:
+		return "IQ"
+	case CurrentTerritoryCode_CURRENT_TERRITORY_CODE_IR:
+		return "IR"
+	case CurrentTerritoryCode_CURRENT_TERRITORY_CODE_IS:
+		return "IS"
+	case CurrentTerritoryCode_CURRENT_TERRITORY_CODE_IT:
+		return "IT"
+	case CurrentTerritoryCode_CURRENT_TERRITORY_CODE_JE:
+		return "JE"
+	case CurrentTerritoryCode_CURRENT_TERRITORY_CODE_JM:
+		return "JM"
+	case CurrentTerritoryCode_CURRENT_TERRITORY_CODE_JO:
+		return "JO"
+	case CurrentTerritoryCode_CURRENT_TERRITORY_CODE_JP:
+		return "JP"
+	case CurrentTerritoryCode_CURRENT_TERRITORY_CODE_KE:
+		return "KE"
+	case CurrentTerritoryCode_CURRENT_TERRITORY_CODE_KG:
+		return "KG"
+	case CurrentTerritoryCode_CURRENT_TERRITORY_CODE_KH:
+		return "KH"
+	case CurrentTerritoryCode_CURRENT_TERRITORY_CODE_KI:
+		return "KI"
+	case CurrentTerritoryCode_CURRENT_TERRITORY_CODE_KM:
+		return "KM"
+	case CurrentTerritoryCode_CURRENT_TERRITORY_CODE_KN:
+		return "KN"
+	case CurrentTerritoryCode_CURRENT_TERRITORY_CODE_KP:
+		return "KP"
+	case CurrentTerritoryCode_CURRENT_TERRITORY_CODE_KR:
+		return "KR"
+	case CurrentTerritoryCode_CURRENT_TERRITORY_CODE_KW:
+		return "KW"
+	case CurrentTerritoryCode_CURRENT_TERRITORY_CODE_KY:
+		return "KY"
+	case CurrentTerritoryCode_CURRENT_TERRITORY_CODE_KZ:
+		return "KZ"
+	case CurrentTerritoryCode_CURRENT_TERRITORY_CODE_LA:
+		return "LA"
+	case CurrentTerritoryCode_CURRENT_TERRITORY_CODE_LB:
+		return "LB"
+	case CurrentTerritoryCode_CURRENT_TERRITORY_CODE_LC:
+		return "LC"
+	case CurrentTerritoryCode_CURRENT_TERRITORY_CODE_LI:
+		return "LI"
+	case CurrentTerritoryCode_CURRENT_TERRITORY_CODE_LK:
+		return "LK"
+	case CurrentTerritoryCode_CURRENT_TERRITORY_CODE_LR:
+		return "LR"
+	case CurrentTerritoryCode_CURRENT_TERRITORY_CODE_LS:
+		return "LS"
+	case CurrentTerritoryCode_CURRENT_TERRITORY_CODE_LT:
+		return "LT"
+	case CurrentTerritoryCode_CURRENT_TERRITORY_CODE_LU:
+		return "LU"
+	case CurrentTerritoryCode_CURRENT_TERRITORY_CODE_LV:
+		return "LV"
+	case CurrentTerritoryCode_CURRENT_TERRITORY_CODE_LY:
+		return "LY"
+	case CurrentTerritoryCode_CURRENT_TERRITORY_CODE_MA:
+		return "MA"
+	case CurrentTerritoryCode_CURRENT_TERRITORY_CODE_MC:
+		return "MC"
+	case CurrentTerritoryCode_CURRENT_TERRITORY_CODE_MD:
+		return "MD"
+	case CurrentTerritoryCode_CURRENT_TERRITORY_CODE_ME:
+		return "ME"
+	case CurrentTerritoryCode_CURRENT_TERRITORY_CODE_MF:
+		return "MF"
+	case CurrentTerritoryCode_CURRENT_TERRITORY_CODE_MG:
+		return "MG"
+	case CurrentTerritoryCode_CURRENT_TERRITORY_CODE_MH:
+		return "MH"
+	case CurrentTerritoryCode_CURRENT_TERRITORY_CODE_MK:
+		return "MK"
+	case CurrentTerritoryCode_CURRENT_TERRITORY_CODE_ML:
+		return "ML"
+	case CurrentTerritoryCode_CURRENT_TERRITORY_CODE_MM:
+		return "MM"
+	case CurrentTerritoryCode_CURRENT_TERRITORY_CODE_MN:
+		return "MN"
+	case CurrentTerritoryCode_CURRENT_TERRITORY_CODE_MO:
+		return "MO"
+	case CurrentTerritoryCode_CURRENT_TERRITORY_CODE_MP:
+		return "MP"
+	case CurrentTerritoryCode_CURRENT_TERRITORY_CODE_MQ:
+		return "MQ"
+	case CurrentTerritoryCode_CURRENT_TERRITORY_CODE_MR:
+		return "MR"
+	case CurrentTerritoryCode_CURRENT_TERRITORY_CODE_MS:
+		return "MS"
+	case CurrentTerritoryCode_CURRENT_TERRITORY_CODE_MT:
+		return "MT"
+	case CurrentTerritoryCode_CURRENT_TERRITORY_CODE_MU:
+		return "MU"
+	case CurrentTerritoryCode_CURRENT_TERRITORY_CODE_MV:
+		return "MV"
+	case CurrentTerritoryCode_CURRENT_TERRITORY_CODE_MW:
+		return "MW"
+	case CurrentTerritoryCode_CURRENT_TERRITORY_CODE_MX:
+		return "MX"
+	case CurrentTerritoryCode_CURRENT_TERRITORY_CODE_MY:
+		return "MY"
+	case CurrentTerritoryCode_CURRENT_TERRITORY_CODE_MZ:
+		return "MZ"
+	case CurrentTerritoryCode_CURRENT_TERRITORY_CODE_NA:
+		return "NA"
+	case CurrentTerritoryCode_CURRENT_TERRITORY_CODE_NC:
+		return "NC"
+	case CurrentTerritoryCode_CURRENT_TERRITORY_CODE_NE:
+		return "NE"
+	case CurrentTerritoryCode_CURRENT_TERRITORY_CODE_NF:
+		return "NF"
+	case CurrentTerritoryCode_CURRENT_TERRITORY_CODE_NG:
+		return "NG"
+	case CurrentTerritoryCode_CURRENT_TERRITORY_CODE_NI:
+		return "NI"
+	case CurrentTerritoryCode_CURRENT_TERRITORY_CODE_NL:
+		return "NL"
+	case CurrentTerritoryCode_CURRENT_TERRITORY_CODE_NO:
+		return "NO"
+	case CurrentTerritoryCode_CURRENT_TERRITORY_CODE_NP:
+		return "NP"
+	case CurrentTerritoryCode_CURRENT_TERRITORY_CODE_NR:
+		return "NR"
+	case CurrentTerritoryCode_CURRENT_TERRITORY_CODE_NU:
+		return "NU"
+	case CurrentTerritoryCode_CURRENT_TERRITORY_CODE_NZ:
+		return "NZ"
+	case CurrentTerritoryCode_CURRENT_TERRITORY_CODE_OM:
+		return "OM"
+	case CurrentTerritoryCode_CURRENT_TERRITORY_CODE_PA:
+		return "PA"
+	case CurrentTerritoryCode_CURRENT_TERRITORY_CODE_PE:
+		return "PE"
+	case CurrentTerritoryCode_CURRENT_TERRITORY_CODE_PF:
+		return "PF"
+	case CurrentTerritoryCode_CURRENT_TERRITORY_CODE_PG:
+		return "PG"
+	case CurrentTerritoryCode_CURRENT_TERRITORY_CODE_PH:
+		return "PH"
+	case CurrentTerritoryCode_CURRENT_TERRITORY_CODE_PK:
+		return "PK"
+	case CurrentTerritoryCode_CURRENT_TERRITORY_CODE_PL:
+		return "PL"
+	case CurrentTerritoryCode_CURRENT_TERRITORY_CODE_PM:
+		return "PM"
+	case CurrentTerritoryCode_CURRENT_TERRITORY_CODE_PN:
+		return "PN"
+	case CurrentTerritoryCode_CURRENT_TERRITORY_CODE_PR:
+		return "PR"
+	case CurrentTerritoryCode_CURRENT_TERRITORY_CODE_PS:
+		return "PS"
+	case CurrentTerritoryCode_CURRENT_TERRITORY_CODE_PT:
+		return "PT"
+	case CurrentTerritoryCode_CURRENT_TERRITORY_CODE_PW:
+		return "PW"
+	case CurrentTerritoryCode_CURRENT_TERRITORY_CODE_PY:
+		return "PY"
+	case CurrentTerritoryCode_CURRENT_TERRITORY_CODE_QA:
+		return "QA"
+	case CurrentTerritoryCode_CURRENT_TERRITORY_CODE_RE:
+		return "RE"
+	case CurrentTerritoryCode_CURRENT_TERRITORY_CODE_RO:
+		return "RO"
+	case CurrentTerritoryCode_CURRENT_TERRITORY_CODE_RS:
+		return "RS"
+	case CurrentTerritoryCode_CURRENT_TERRITORY_CODE_RU:
+		return "RU"
+	case CurrentTerritoryCode_CURRENT_TERRITORY_CODE_RW:
+		return "RW"
+	case CurrentTerritoryCode_CURRENT_TERRITORY_CODE_SA:
+		return "SA"
+	case CurrentTerritoryCode_CURRENT_TERRITORY_CODE_SB:
+		return "SB"
+	case CurrentTerritoryCode_CURRENT_TERRITORY_CODE_SC:
+		return "SC"
+	case CurrentTerritoryCode_CURRENT_TERRITORY_CODE_SD:
+		return "SD"
+	case CurrentTerritoryCode_CURRENT_TERRITORY_CODE_SE:
+		return "SE"
+	case CurrentTerritoryCode_CURRENT_TERRITORY_CODE_SG:
+		return "SG"
+	case CurrentTerritoryCode_CURRENT_TERRITORY_CODE_SH:
+		return "SH"
+	case CurrentTerritoryCode_CURRENT_TERRITORY_CODE_SI:
+		return "SI"
+	case CurrentTerritoryCode_CURRENT_TERRITORY_CODE_SJ:
+		return "SJ"
+	case CurrentTerritoryCode_CURRENT_TERRITORY_CODE_SK:
+		return "SK"
+	case CurrentTerritoryCode_CURRENT_TERRITORY_CODE_SL:
+		return "SL"
+	case CurrentTerritoryCode_CURRENT_TERRITORY_CODE_SM:
+		return "SM"
+	case CurrentTerritoryCode_CURRENT_TERRITORY_CODE_SN:
+		return "SN"
+	case CurrentTerritoryCode_CURRENT_TERRITORY_CODE_SO:
+		return "SO"
+	case CurrentTerritoryCode_CURRENT_TERRITORY_CODE_SR:
+		return "SR"
+	case CurrentTerritoryCode_CURRENT_TERRITORY_CODE_SS:
+		return "SS"
+	case CurrentTerritoryCode_CURRENT_TERRITORY_CODE_ST:
+		return "ST"
+	case CurrentTerritoryCode_CURRENT_TERRITORY_CODE_SV:
+		return "SV"
+	case CurrentTerritoryCode_CURRENT_TERRITORY_CODE_SX:
+		return "SX"
+	case CurrentTerritoryCode_CURRENT_TERRITORY_CODE_SY:
+		return "SY"
+	case CurrentTerritoryCode_CURRENT_TERRITORY_CODE_SZ:
+		return "SZ"
+	case CurrentTerritoryCode_CURRENT_TERRITORY_CODE_TC:
+		return "TC"
+	case CurrentTerritoryCode_CURRENT_TERRITORY_CODE_TD:
+		return "TD"
+	case CurrentTerritoryCode_CURRENT_TERRITORY_CODE_TF:
+		return "TF"
+	case CurrentTerritoryCode_CURRENT_TERRITORY_CODE_TG:
+		return "TG"
+	case CurrentTerritoryCode_CURRENT_TERRITORY_CODE_TH:
+		return "TH"
+	case CurrentTerritoryCode_CURRENT_TERRITORY_CODE_TJ:
+		return "TJ"
+	case CurrentTerritoryCode_CURRENT_TERRITORY_CODE_TK:
+		return "TK"
+	case CurrentTerritoryCode_CURRENT_TERRITORY_CODE_TL:
+		return "TL"
+	case CurrentTerritoryCode_CURRENT_TERRITORY_CODE_TM:
+		return "TM"
+	case CurrentTerritoryCode_CURRENT_TERRITORY_CODE_TN:
+		return "TN"
+	case CurrentTerritoryCode_CURRENT_TERRITORY_CODE_TO:
+		return "TO"
+	case CurrentTerritoryCode_CURRENT_TERRITORY_CODE_TR:
+		return "TR"
+	case CurrentTerritoryCode_CURRENT_TERRITORY_CODE_TT:
+		return "TT"
+	case CurrentTerritoryCode_CURRENT_TERRITORY_CODE_TV:
+		return "TV"
+	case CurrentTerritoryCode_CURRENT_TERRITORY_CODE_TW:
+		return "TW"
+	case CurrentTerritoryCode_CURRENT_TERRITORY_CODE_TZ:
+		return "TZ"
+	case CurrentTerritoryCode_CURRENT_TERRITORY_CODE_UA:
+		return "UA"
+	case CurrentTerritoryCode_CURRENT_TERRITORY_CODE_UG:
+		return "UG"
+	case CurrentTerritoryCode_CURRENT_TERRITORY_CODE_UM:
+		return "UM"
+	case CurrentTerritoryCode_CURRENT_TERRITORY_CODE_US:
+		return "US"
+	case CurrentTerritoryCode_CURRENT_TERRITORY_CODE_UY:
+		return "UY"
+	case CurrentTerritoryCode_CURRENT_TERRITORY_CODE_UZ:
+		return "UZ"
+	case CurrentTerritoryCode_CURRENT_TERRITORY_CODE_VA:
+		return "VA"
+	case CurrentTerritoryCode_CURRENT_TERRITORY_CODE_VC:
+		return "VC"
+	case CurrentTerritoryCode_CURRENT_TERRITORY_CODE_VE:
+		return "VE"
+	case CurrentTerritoryCode_CURRENT_TERRITORY_CODE_VG:
+		return "VG"
+	case CurrentTerritoryCode_CURRENT_TERRITORY_CODE_VI:
+		return "VI"
+	case CurrentTerritoryCode_CURRENT_TERRITORY_CODE_VN:
+		return "VN"
+	case CurrentTerritoryCode_CURRENT_TERRITORY_CODE_VU:
+		return "VU"
+	case CurrentTerritoryCode_CURRENT_TERRITORY_CODE_WF:
+		return "WF"
+	case CurrentTerritoryCode_CURRENT_TERRITORY_CODE_WS:
+		return "WS"
+	case CurrentTerritoryCode_CURRENT_TERRITORY_CODE_YE:
+		return "YE"
+	case CurrentTerritoryCode_CURRENT_TERRITORY_CODE_YT:
+		return "YT"
+	case CurrentTerritoryCode_CURRENT_TERRITORY_CODE_ZA:
+		return "ZA"
+	case CurrentTerritoryCode_CURRENT_TERRITORY_CODE_ZM:
+		return "ZM"
+	case CurrentTerritoryCode_CURRENT_TERRITORY_CODE_ZW:
+		return "ZW"
+	case CurrentTerritoryCode_CURRENT_TERRITORY_CODE_E_4:
+		return "4"
+	case CurrentTerritoryCode_CURRENT_TERRITORY_CODE_E_8:
+		return "8"
+	case CurrentTerritoryCode_CURRENT_TERRITORY_CODE_E_12:
+		return "12"
+	case CurrentTerritoryCode_CURRENT_TERRITORY_CODE_E_20:
+		return "20"
+	case CurrentTerritoryCode_CURRENT_TERRITORY_CODE_E_24:
+		return "24"
+	case CurrentTerritoryCode_CURRENT_TERRITORY_CODE_E_28:
+		return "28"
+	case CurrentTerritoryCode_CURRENT_TERRITORY_CODE_E_31:
+		return "31"
+	case CurrentTerritoryCode_CURRENT_TERRITORY_CODE_E_32:
+		return "32"
+	case CurrentTerritoryCode_CURRENT_TERRITORY_CODE_E_36:
+		return "36"
+	case CurrentTerritoryCode_CURRENT_TERRITORY_CODE_E_40:
+		return "40"
+	case CurrentTerritoryCode_CURRENT_TERRITORY_CODE_E_44:
+		return "44"
+	case CurrentTerritoryCode_CURRENT_TERRITORY_CODE_E_48:
+		return "48"
+	case CurrentTerritoryCode_CURRENT_TERRITORY_CODE_E_50:
+		return "50"
+	case CurrentTerritoryCode_CURRENT_TERRITORY_CODE_E_51:
+		return "51"
+	case CurrentTerritoryCode_CURRENT_TERRITORY_CODE_E_52:
+		return "52"
+	case CurrentTerritoryCode_CURRENT_TERRITORY_CODE_E_56:
+		return "56"
+	case CurrentTerritoryCode_CURRENT_TERRITORY_CODE_E_64:
+		return "64"
+	case CurrentTerritoryCode_CURRENT_TERRITORY_CODE_E_68:
+		return "68"
+	case CurrentTerritoryCode_CURRENT_TERRITORY_CODE_E_70:
+		return "70"
+	case CurrentTerritoryCode_CURRENT_TERRITORY_CODE_E_72:
+		return "72"
+	case CurrentTerritoryCode_CURRENT_TERRITORY_CODE_E_76:
+		return "76"
+	case CurrentTerritoryCode_CURRENT_TERRITORY_CODE_E_84:
+		return "84"
+	case CurrentTerritoryCode_CURRENT_TERRITORY_CODE_E_90:
+		return "90"
+	case CurrentTerritoryCode_CURRENT_TERRITORY_CODE_E_96:
+		return "96"
+	case CurrentTerritoryCode_CURRENT_TERRITORY_CODE_E_100:
+		return "100"
+	case CurrentTerritoryCode_CURRENT_TERRITORY_CODE_E_104:
+		return "104"
+	case CurrentTerritoryCode_CURRENT_TERRITORY_CODE_E_108:
+		return "108"
+	case CurrentTerritoryCode_CURRENT_TERRITORY_CODE_E_112:
+		return "112"
+	case CurrentTerritoryCode_CURRENT_TERRITORY_CODE_E_116:
+		return "116"
+	case CurrentTerritoryCode_CURRENT_TERRITORY_CODE_E_120:
+		return "120"
+	case CurrentTerritoryCode_CURRENT_TERRITORY_CODE_E_124:
+		return "124"
+	case CurrentTerritoryCode_CURRENT_TERRITORY_CODE_E_132:
+		return "132"
+	case CurrentTerritoryCode_CURRENT_TERRITORY_CODE_E_140:
+		return "140"
+	case CurrentTerritoryCode_CURRENT_TERRITORY_CODE_E_144:
+		return "144"
+	case CurrentTerritoryCode_CURRENT_TERRITORY_CODE_E_148:
+		return "148"
+	case CurrentTerritoryCode_CURRENT_TERRITORY_CODE_E_152:
+		return "152"
+	case CurrentTerritoryCode_CURRENT_TERRITORY_CODE_E_156:
+		return "156"
+	case CurrentTerritoryCode_CURRENT_TERRITORY_CODE_E_158:
+		return "158"
+	case CurrentTerritoryCode_CURRENT_TERRITORY_CODE_E_170:
+		return "170"
+	case CurrentTerritoryCode_CURRENT_TERRITORY_CODE_E_174:
+		return "174"
+	case CurrentTerritoryCode_CURRENT_TERRITORY_CODE_E_178:
+		return "178"
+	case CurrentTerritoryCode_CURRENT_TERRITORY_CODE_E_180:
+		return "180"
+	case CurrentTerritoryCode_CURRENT_TERRITORY_CODE_E_188:
+		return "188"
+	case CurrentTerritoryCode_CURRENT_TERRITORY_CODE_E_191:
+		return "191"
+	case CurrentTerritoryCode_CURRENT_TERRITORY_CODE_E_192:
+		return "192"
+	case CurrentTerritoryCode_CURRENT_TERRITORY_CODE_E_196:
+		return "196"
+	case CurrentTerritoryCode_CURRENT_TERRITORY_CODE_E_200:
+		return "200"
+	case CurrentTerritoryCode_CURRENT_TERRITORY_CODE_E_203:
+		return "203"
+	case CurrentTerritoryCode_CURRENT_TERRITORY_CODE_E_204:
+		return "204"
+	case CurrentTerritoryCode_CURRENT_TERRITORY_CODE_E_208:
+		return "208"
+	case CurrentTerritoryCode_CURRENT_TERRITORY_CODE_E_212:
+		return "212"
+	case CurrentTerritoryCode_CURRENT_TERRITORY_CODE_E_214:
+		return "214"
+	case CurrentTerritoryCode_CURRENT_TERRITORY_CODE_E_218:
+		return "218"
+	case CurrentTerritoryCode_CURRENT_TERRITORY_CODE_E_222:
+		return "222"
+	case CurrentTerritoryCode_CURRENT_TERRITORY_CODE_E_226:
+		return "226"
+	case CurrentTerritoryCode_CURRENT_TERRITORY_CODE_E_230:
+		return "230"
+	case CurrentTerritoryCode_CURRENT_TERRITORY_CODE_E_231:
+		return "231"
+	case CurrentTerritoryCode_CURRENT_TERRITORY_CODE_E_232:
+		return "232"
+	case CurrentTerritoryCode_CURRENT_TERRITORY_CODE_E_233:
+		return "233"
+	case CurrentTerritoryCode_CURRENT_TERRITORY_CODE_E_242:
+		return "242"
+	case CurrentTerritoryCode_CURRENT_TERRITORY_CODE_E_246:
+		return "246"
+	case CurrentTerritoryCode_CURRENT_TERRITORY_CODE_E_250:
+		return "250"
+	case CurrentTerritoryCode_CURRENT_TERRITORY_CODE_E_258:
+		return "258"
+	case CurrentTerritoryCode_CURRENT_TERRITORY_CODE_E_262:
+		return "262"
+	case CurrentTerritoryCode_CURRENT_TERRITORY_CODE_E_266:
+		return "266"
+	case CurrentTerritoryCode_CURRENT_TERRITORY_CODE_E_268:
+		return "268"
+	case CurrentTerritoryCode_CURRENT_TERRITORY_CODE_E_270:
+		return "270"
+	case CurrentTerritoryCode_CURRENT_TERRITORY_CODE_E_276:
+		return "276"
+	case CurrentTerritoryCode_CURRENT_TERRITORY_CODE_E_278:
+		return "278"
+	case CurrentTerritoryCode_CURRENT_TERRITORY_CODE_E_280:
+		return "280"
+	case CurrentTerritoryCode_CURRENT_TERRITORY_CODE_E_288:
+		return "288"
+	case CurrentTerritoryCode_CURRENT_TERRITORY_CODE_E_296:
+		return "296"
+	case CurrentTerritoryCode_CURRENT_TERRITORY_CODE_E_300:
+		return "300"
+	case CurrentTerritoryCode_CURRENT_TERRITORY_CODE_E_308:
+		return "308"
+	case CurrentTerritoryCode_CURRENT_TERRITORY_CODE_E_320:
+		return "320"
+	case CurrentTerritoryCode_CURRENT_TERRITORY_CODE_E_324:
+		return "324"
+	case CurrentTerritoryCode_CURRENT_TERRITORY_CODE_E_328:
+		return "328"
+	case CurrentTerritoryCode_CURRENT_TERRITORY_CODE_E_332:
+		return "332"
+	case CurrentTerritoryCode_CURRENT_TERRITORY_CODE_E_336:
+		return "336"
+	case CurrentTerritoryCode_CURRENT_TERRITORY_CODE_E_340:
+		return "340"
+	case CurrentTerritoryCode_CURRENT_TERRITORY_CODE_E_344:
+		return "344"
+	case CurrentTerritoryCode_CURRENT_TERRITORY_CODE_E_348:
+		return "348"
+	case CurrentTerritoryCode_CURRENT_TERRITORY_CODE_E_352:
+		return "352"
+	case CurrentTerritoryCode_CURRENT_TERRITORY_CODE_E_356:
+		return "356"
+	case CurrentTerritoryCode_CURRENT_TERRITORY_CODE_E_360:
+		return "360"
+	case CurrentTerritoryCode_CURRENT_TERRITORY_CODE_E_364:
+		return "364"
+	case CurrentTerritoryCode_CURRENT_TERRITORY_CODE_E_368:
+		return "368"
+	case CurrentTerritoryCode_CURRENT_TERRITORY_CODE_E_372:
+		return "372"
+	case CurrentTerritoryCode_CURRENT_TERRITORY_CODE_E_376:
+		return "376"
+	case CurrentTerritoryCode_CURRENT_TERRITORY_CODE_E_380:
+		return "380"
+	case CurrentTerritoryCode_CURRENT_TERRITORY_CODE_E_384:
+		return "384"
+	case CurrentTerritoryCode_CURRENT_TERRITORY_CODE_E_388:
+		return "388"
+	case CurrentTerritoryCode_CURRENT_TERRITORY_CODE_E_392:
+		return "392"
+	case CurrentTerritoryCode_CURRENT_TERRITORY_CODE_E_398:
+		return "398"
+	case CurrentTerritoryCode_CURRENT_TERRITORY_CODE_E_400:
+		return "400"
+	case CurrentTerritoryCode_CURRENT_TERRITORY_CODE_E_404:
+		return "404"
+	case CurrentTerritoryCode_CURRENT_TERRITORY_CODE_E_408:
+		return "408"
+	case CurrentTerritoryCode_CURRENT_TERRITORY_CODE_E_410:
+		return "410"
+	case CurrentTerritoryCode_CURRENT_TERRITORY_CODE_E_414:
+		return "414"
+	case CurrentTerritoryCode_CURRENT_TERRITORY_CODE_E_417:
+		return "417"
+	case CurrentTerritoryCode_CURRENT_TERRITORY_CODE_E_418:
+		return "418"
+	case CurrentTerritoryCode_CURRENT_TERRITORY_CODE_E_422:
+		return "422"
+	case CurrentTerritoryCode_CURRENT_TERRITORY_CODE_E_426:
+		return "426"
+	case CurrentTerritoryCode_CURRENT_TERRITORY_CODE_E_428:
+		return "428"
+	case CurrentTerritoryCode_CURRENT_TERRITORY_CODE_E_430:
+		return "430"
+	case CurrentTerritoryCode_CURRENT_TERRITORY_CODE_E_434:
+		return "434"
+	case CurrentTerritoryCode_CURRENT_TERRITORY_CODE_E_438:
+		return "438"
+	case CurrentTerritoryCode_CURRENT_TERRITORY_CODE_E_440:
+		return "440"
+	case CurrentTerritoryCode_CURRENT_TERRITORY_CODE_E_442:
+		return "442"
+	case CurrentTerritoryCode_CURRENT_TERRITORY_CODE_E_446:
+		return "446"
+	case CurrentTerritoryCode_CURRENT_TERRITORY_CODE_E_450:
+		return "450"
+	case CurrentTerritoryCode_CURRENT_TERRITORY_CODE_E_454:
+		return "454"
+	case CurrentTerritoryCode_CURRENT_TERRITORY_CODE_E_458:
+		return "458"
+	case CurrentTerritoryCode_CURRENT_TERRITORY_CODE_E_462:
+		return "462"
+	case CurrentTerritoryCode_CURRENT_TERRITORY_CODE_E_466:
+		return "466"
+	case CurrentTerritoryCode_CURRENT_TERRITORY_CODE_E_470:
+		return "470"
+	case CurrentTerritoryCode_CURRENT_TERRITORY_CODE_E_478:
+		return "478"
+	case CurrentTerritoryCode_CURRENT_TERRITORY_CODE_E_480:
+		return "480"
+	case CurrentTerritoryCode_CURRENT_TERRITORY_CODE_E_484:
+		return "484"
+	case CurrentTerritoryCode_CURRENT_TERRITORY_CODE_E_492:
+		return "492"
+	case CurrentTerritoryCode_CURRENT_TERRITORY_CODE_E_496:
+		return "496"
+	case CurrentTerritoryCode_CURRENT_TERRITORY_CODE_E_498:
+		return "498"
+	case CurrentTerritoryCode_CURRENT_TERRITORY_CODE_E_499:
+		return "499"
+	case CurrentTerritoryCode_CURRENT_TERRITORY_CODE_E_504:
+		return "504"
+	case CurrentTerritoryCode_CURRENT_TERRITORY_CODE_E_508:
+		return "508"
+	case CurrentTerritoryCode_CURRENT_TERRITORY_CODE_E_512:
+		return "512"
+	case CurrentTerritoryCode_CURRENT_TERRITORY_CODE_E_516:
+		return "516"
+	case CurrentTerritoryCode_CURRENT_TERRITORY_CODE_E_520:
+		return "520"
+	case CurrentTerritoryCode_CURRENT_TERRITORY_CODE_E_524:
+		return "524"
+	case CurrentTerritoryCode_CURRENT_TERRITORY_CODE_E_528:
+		return "528"
+	case CurrentTerritoryCode_CURRENT_TERRITORY_CODE_E_540:
+		return "540"
+	case CurrentTerritoryCode_CURRENT_TERRITORY_CODE_E_548:
+		return "548"
+	case CurrentTerritoryCode_CURRENT_TERRITORY_CODE_E_554:
+		return "554"
+	case CurrentTerritoryCode_CURRENT_TERRITORY_CODE_E_558:
+		return "558"
+	case CurrentTerritoryCode_CURRENT_TERRITORY_CODE_E_562:
+		return "562"
+	case CurrentTerritoryCode_CURRENT_TERRITORY_CODE_E_566:
+		return "566"
+	case CurrentTerritoryCode_CURRENT_TERRITORY_CODE_E_578:
+		return "578"
+	case CurrentTerritoryCode_CURRENT_TERRITORY_CODE_E_583:
+		return "583"
+	case CurrentTerritoryCode_CURRENT_TERRITORY_CODE_E_584:
+		return "584"
+	case CurrentTerritoryCode_CURRENT_TERRITORY_CODE_E_585:
+		return "585"
+	case CurrentTerritoryCode_CURRENT_TERRITORY_CODE_E_586:
+		return "586"
+	case CurrentTerritoryCode_CURRENT_TERRITORY_CODE_E_591:
+		return "591"
+	case CurrentTerritoryCode_CURRENT_TERRITORY_CODE_E_598:
+		return "598"
+	case CurrentTerritoryCode_CURRENT_TERRITORY_CODE_E_600:
+		return "600"
+	case CurrentTerritoryCode_CURRENT_TERRITORY_CODE_E_604:
+		return "604"
+	case CurrentTerritoryCode_CURRENT_TERRITORY_CODE_E_608:
+		return "608"
+	case CurrentTerritoryCode_CURRENT_TERRITORY_CODE_E_616:
+		return "616"
+	case CurrentTerritoryCode_CURRENT_TERRITORY_CODE_E_620:
+		return "620"
+	case CurrentTerritoryCode_CURRENT_TERRITORY_CODE_E_624:
+		return "624"
+	case CurrentTerritoryCode_CURRENT_TERRITORY_CODE_E_626:
+		return "626"
+	case CurrentTerritoryCode_CURRENT_TERRITORY_CODE_E_630:
+		return "630"
+	case CurrentTerritoryCode_CURRENT_TERRITORY_CODE_E_634:
+		return "634"
+	case CurrentTerritoryCode_CURRENT_TERRITORY_CODE_E_642:
+		return "642"
+	case CurrentTerritoryCode_CURRENT_TERRITORY_CODE_E_643:
+		return "643"
+	case CurrentTerritoryCode_CURRENT_TERRITORY_CODE_E_646:
+		return "646"
+	case CurrentTerritoryCode_CURRENT_TERRITORY_CODE_E_659:
+		return "659"
+	case CurrentTerritoryCode_CURRENT_TERRITORY_CODE_E_662:
+		return "662"
+	case CurrentTerritoryCode_CURRENT_TERRITORY_CODE_E_670:
+		return "670"
+	case CurrentTerritoryCode_CURRENT_TERRITORY_CODE_E_674:
+		return "674"
+	case CurrentTerritoryCode_CURRENT_TERRITORY_CODE_E_678:
+		return "678"
+	case CurrentTerritoryCode_CURRENT_TERRITORY_CODE_E_682:
+		return "682"
+	case CurrentTerritoryCode_CURRENT_TERRITORY_CODE_E_686:
+		return "686"
+	case CurrentTerritoryCode_CURRENT_TERRITORY_CODE_E_688:
+		return "688"
+	case CurrentTerritoryCode_CURRENT_TERRITORY_CODE_E_690:
+		return "690"
+	case CurrentTerritoryCode_CURRENT_TERRITORY_CODE_E_694:
+		return "694"
+	case CurrentTerritoryCode_CURRENT_TERRITORY_CODE_E_702:
+		return "702"
+	case CurrentTerritoryCode_CURRENT_TERRITORY_CODE_E_703:
+		return "703"
+	case CurrentTerritoryCode_CURRENT_TERRITORY_CODE_E_704:
+		return "704"
+	case CurrentTerritoryCode_CURRENT_TERRITORY_CODE_E_705:
+		return "705"
+	case CurrentTerritoryCode_CURRENT_TERRITORY_CODE_E_706:
+		return "706"
+	case CurrentTerritoryCode_CURRENT_TERRITORY_CODE_E_710:
+		return "710"
+	case CurrentTerritoryCode_CURRENT_TERRITORY_CODE_E_716:
+		return "716"
+	case CurrentTerritoryCode_CURRENT_TERRITORY_CODE_E_720:
+		return "720"
+	case CurrentTerritoryCode_CURRENT_TERRITORY_CODE_E_724:
+		return "724"
+	case CurrentTerritoryCode_CURRENT_TERRITORY_CODE_E_728:
+		return "728"
+	case CurrentTerritoryCode_CURRENT_TERRITORY_CODE_E_729:
+		return "729"
+	case CurrentTerritoryCode_CURRENT_TERRITORY_CODE_E_732:
+		return "732"
+	case CurrentTerritoryCode_CURRENT_TERRITORY_CODE_E_736:
+		return "736"
+	case CurrentTerritoryCode_CURRENT_TERRITORY_CODE_E_740:
+		return "740"
+	case CurrentTerritoryCode_CURRENT_TERRITORY_CODE_E_748:
+		return "748"
+	case CurrentTerritoryCode_CURRENT_TERRITORY_CODE_E_752:
+		return "752"
+	case CurrentTerritoryCode_CURRENT_TERRITORY_CODE_E_756:
+		return "756"
+	case CurrentTerritoryCode_CURRENT_TERRITORY_CODE_E_760:
+		return "760"
+	case CurrentTerritoryCode_CURRENT_TERRITORY_CODE_E_762:
+		return "762"
+	case CurrentTerritoryCode_CURRENT_TERRITORY_CODE_E_764:
+		return "764"
+	case CurrentTerritoryCode_CURRENT_TERRITORY_CODE_E_768:
+		return "768"
+	case CurrentTerritoryCode_CURRENT_TERRITORY_CODE_E_776:
+		return "776"
+	case CurrentTerritoryCode_CURRENT_TERRITORY_CODE_E_780:
+		return "780"
+	case CurrentTerritoryCode_CURRENT_TERRITORY_CODE_E_784:
+		return "784"
+	case CurrentTerritoryCode_CURRENT_TERRITORY_CODE_E_788:
+		return "788"
+	case CurrentTerritoryCode_CURRENT_TERRITORY_CODE_E_792:
+		return "792"
+	case CurrentTerritoryCode_CURRENT_TERRITORY_CODE_E_795:
+		return "795"
+	case CurrentTerritoryCode_CURRENT_TERRITORY_CODE_E_798:
+		return "798"
+	case CurrentTerritoryCode_CURRENT_TERRITORY_CODE_E_800:
+		return "800"
+	case CurrentTerritoryCode_CURRENT_TERRITORY_CODE_E_804:
+		return "804"
+	case CurrentTerritoryCode_CURRENT_TERRITORY_CODE_E_807:
+		return "807"
+	case CurrentTerritoryCode_CURRENT_TERRITORY_CODE_E_810:
+		return "810"
+	case CurrentTerritoryCode_CURRENT_TERRITORY_CODE_E_818:
+		return "818"
+	case CurrentTerritoryCode_CURRENT_TERRITORY_CODE_E_826:
+		return "826"
+	case CurrentTerritoryCode_CURRENT_TERRITORY_CODE_E_834:
+		return "834"
+	case CurrentTerritoryCode_CURRENT_TERRITORY_CODE_E_840:
+		return "840"
+	case CurrentTerritoryCode_CURRENT_TERRITORY_CODE_E_854:
+		return "854"
+	case CurrentTerritoryCode_CURRENT_TERRITORY_CODE_E_858:
+		return "858"
+	case CurrentTerritoryCode_CURRENT_TERRITORY_CODE_E_860:
+		return "860"
+	case CurrentTerritoryCode_CURRENT_TERRITORY_CODE_E_862:
+		return "862"
+	case CurrentTerritoryCode_CURRENT_TERRITORY_CODE_E_882:
+		return "882"
+	case CurrentTerritoryCode_CURRENT_TERRITORY_CODE_E_886:
+		return "886"
+	case CurrentTerritoryCode_CURRENT_TERRITORY_CODE_E_887:
+		return "887"
+	case CurrentTerritoryCode_CURRENT_TERRITORY_CODE_E_890:
+		return "890"
+	case CurrentTerritoryCode_CURRENT_TERRITORY_CODE_E_891:
+		return "891"
+	case CurrentTerritoryCode_CURRENT_TERRITORY_CODE_E_894:
+		return "894"
+	case CurrentTerritoryCode_CURRENT_TERRITORY_CODE_E_2100:
+		return "2100"
+	case CurrentTerritoryCode_CURRENT_TERRITORY_CODE_E_2101:
+		return "2101"
+	case CurrentTerritoryCode_CURRENT_TERRITORY_CODE_E_2102:
+		return "2102"
+	case CurrentTerritoryCode_CURRENT_TERRITORY_CODE_E_2103:
+		return "2103"
+	case CurrentTerritoryCode_CURRENT_TERRITORY_CODE_E_2104:
+		return "2104"
+	case CurrentTerritoryCode_CURRENT_TERRITORY_CODE_E_2105:
+		return "2105"
+	case CurrentTerritoryCode_CURRENT_TERRITORY_CODE_E_2106:
+		return "2106"
+	case CurrentTerritoryCode_CURRENT_TERRITORY_CODE_E_2107:
+		return "2107"
+	case CurrentTerritoryCode_CURRENT_TERRITORY_CODE_E_2108:
+		return "2108"
+	case CurrentTerritoryCode_CURRENT_TERRITORY_CODE_E_2109:
+		return "2109"
+	case CurrentTerritoryCode_CURRENT_TERRITORY_CODE_E_2110:
+		return "2110"
+	case CurrentTerritoryCode_CURRENT_TERRITORY_CODE_E_2111:
+		return "2111"
+	case CurrentTerritoryCode_CURRENT_TERRITORY_CODE_E_2112:
+		return "2112"
+	case CurrentTerritoryCode_CURRENT_TERRITORY_CODE_E_2113:
+		return "2113"
+	case CurrentTerritoryCode_CURRENT_TERRITORY_CODE_E_2114:
+		return "2114"
+	case CurrentTerritoryCode_CURRENT_TERRITORY_CODE_E_2115:
+		return "2115"
+	case CurrentTerritoryCode_CURRENT_TERRITORY_CODE_E_2116:
+		return "2116"
+	case CurrentTerritoryCode_CURRENT_TERRITORY_CODE_E_2117:
+		return "2117"
+	case CurrentTerritoryCode_CURRENT_TERRITORY_CODE_E_2118:
+		return "2118"
+	case CurrentTerritoryCode_CURRENT_TERRITORY_CODE_E_2119:
+		return "2119"
+	case CurrentTerritoryCode_CURRENT_TERRITORY_CODE_E_2120:
+		return "2120"
+	case CurrentTerritoryCode_CURRENT_TERRITORY_CODE_E_2121:
+		return "2121"
+	case CurrentTerritoryCode_CURRENT_TERRITORY_CODE_E_2122:
+		return "2122"
+	case CurrentTerritoryCode_CURRENT_TERRITORY_CODE_E_2123:
+		return "2123"
+	case CurrentTerritoryCode_CURRENT_TERRITORY_CODE_E_2124:
+		return "2124"
+	case CurrentTerritoryCode_CURRENT_TERRITORY_CODE_E_2125:
+		return "2125"
+	case CurrentTerritoryCode_CURRENT_TERRITORY_CODE_E_2126:
+		return "2126"
+	case CurrentTerritoryCode_CURRENT_TERRITORY_CODE_E_2127:
+		return "2127"
+	case CurrentTerritoryCode_CURRENT_TERRITORY_CODE_E_2128:
+		return "2128"
+	case CurrentTerritoryCode_CURRENT_TERRITORY_CODE_E_2129:
+		return "2129"
+	case CurrentTerritoryCode_CURRENT_TERRITORY_CODE_E_2130:
+		return "2130"
+	case CurrentTerritoryCode_CURRENT_TERRITORY_CODE_E_2131:
+		return "2131"
+	case CurrentTerritoryCode_CURRENT_TERRITORY_CODE_E_2132:
+		return "2132"
+	case CurrentTerritoryCode_CURRENT_TERRITORY_CODE_E_2133:
+		return "2133"
+	case CurrentTerritoryCode_CURRENT_TERRITORY_CODE_E_2134:
+		return "2134"
+	case CurrentTerritoryCode_CURRENT_TERRITORY_CODE_E_2136:
+		return "2136"
+	case CurrentTerritoryCode_CURRENT_TERRITORY_CODE_XK:
+		return "XK"
+	case CurrentTerritoryCode_CURRENT_TERRITORY_CODE_WORLDWIDE:
+		return "WORLDWIDE"
 	default:
 		return ""
 	}
 }
 
 // ParseCurrentTerritoryCodeString parses a string value to CurrentTerritoryCode enum (case-insensitive)
+// Numeric codes are accepted as well and map to the constant of the code they stand for
 func ParseCurrentTerritoryCodeString(s string) (CurrentTerritoryCode, bool) {
 	s = strings.ToUpper(s)
 	switch s {
+	case "AD":
+		return CurrentTerritoryCode_CURRENT_TERRITORY_CODE_AD, true
+	case "AE":
+		return CurrentTerritoryCode_CURRENT_TERRITORY_CODE_AE, true
+	case "AF":
+		return CurrentTerritoryCode_CURRENT_TERRITORY_CODE_AF, true
+	case "AG":
+		return CurrentTerritoryCode_CURRENT_TERRITORY_CODE_AG, true
+	case "AI":
+		return CurrentTerritoryCode_CURRENT_TERRITORY_CODE_AI, true
+	case "AL":
+		return CurrentTerritoryCode_CURRENT_TERRITORY_CODE_AL, true
+	case "AM":
+		return CurrentTerritoryCode_CURRENT_TERRITORY_CODE_AM, true
+	case "AN":
+		return CurrentTerritoryCode_CURRENT_TERRITORY_CODE_AN, true
+	case "AO":
+		return CurrentTerritoryCode_CURRENT_TERRITORY_CODE_AO, true
+	case "AQ":
+		return CurrentTerritoryCode_CURRENT_TERRITORY_CODE_AQ, true
+	case "AR":
+		return CurrentTerritoryCode_CURRENT_TERRITORY_CODE_AR, true
+	case "AS":
+		return CurrentTerritoryCode_CURRENT_TERRITORY_CODE_AS, true
+	case "AT":
+		return CurrentTerritoryCode_CURRENT_TERRITORY_CODE_AT, true
+	case "AU":
+		return CurrentTerritoryCode_CURRENT_TERRITORY_CODE_AU, true
+	case "AW":
+		return CurrentTerritoryCode_CURRENT_TERRITORY_CODE_AW, true
+	case "AX":
+		return CurrentTerritoryCode_CURRENT_TERRITORY_CODE_AX, true
+	case "AZ":
+		return CurrentTerritoryCode_CURRENT_TERRITORY_CODE_AZ, true
+	case "BA":
+		return CurrentTerritoryCode_CURRENT_TERRITORY_CODE_BA, true
+	case "BB":
+		return CurrentTerritoryCode_CURRENT_TERRITORY_CODE_BB, true
+	case "BD":
+		return CurrentTerritoryCode_CURRENT_TERRITORY_CODE_BD, true
+	case "BE":
+		return CurrentTerritoryCode_CURRENT_TERRITORY_CODE_BE, true
+	case "BF":
+		return CurrentTerritoryCode_CURRENT_TERRITORY_CODE_BF, true
+	case "BG":
+		return CurrentTerritoryCode_CURRENT_TERRITORY_CODE_BG, true
+	case "BH":
+		return CurrentTerritoryCode_CURRENT_TERRITORY_CODE_BH, true
+	case "BI":
+		return CurrentTerritoryCode_CURRENT_TERRITORY_CODE_BI, true
+	case "BJ":
+		return CurrentTerritoryCode_CURRENT_TERRITORY_CODE_BJ, true
+	case "BL":
+		return CurrentTerritoryCode_CURRENT_TERRITORY_CODE_BL, true
+	case "BM":
+		return CurrentTerritoryCode_CURRENT_TERRITORY_CODE_BM, true
+	case "BN":
+		return CurrentTerritoryCode_CURRENT_TERRITORY_CODE_BN, true
+	case "BO":
+		return CurrentTerritoryCode_CURRENT_TERRITORY_CODE_BO, true
+	case "BQ":
+		return CurrentTerritoryCode_CURRENT_TERRITORY_CODE_BQ, true
+	case "BR":
+		return CurrentTerritoryCode_CURRENT_TERRITORY_CODE_BR, true
+	case "BS":
+		return CurrentTerritoryCode_CURRENT_TERRITORY_CODE_BS, true
+	case "BT":
+		return CurrentTerritoryCode_CURRENT_TERRITORY_CODE_BT, true
+	case "BV":
+		return CurrentTerritoryCode_CURRENT_TERRITORY_CODE_BV, true
+	case "BW":
+		return CurrentTerritoryCode_CURRENT_TERRITORY_CODE_BW, true
+	case "BY":
+		return CurrentTerritoryCode_CURRENT_TERRITORY_CODE_BY, true
+	case "BZ":
+		return CurrentTerritoryCode_CURRENT_TERRITORY_CODE_BZ, true
+	case "CA":
+		return CurrentTerritoryCode_CURRENT_TERRITORY_CODE_CA, true
+	case "CC":
+		return CurrentTerritoryCode_CURRENT_TERRITORY_CODE_CC, true
+	case "CD":
+		return CurrentTerritoryCode_CURRENT_TERRITORY_CODE_CD, true
+	case "CF":
+		return CurrentTerritoryCode_CURRENT_TERRITORY_CODE_CF, true
+	case "CG":
+		return CurrentTerritoryCode_CURRENT_TERRITORY_CODE_CG, true
+	case "CH":
+		return CurrentTerritoryCode_CURRENT_TERRITORY_CODE_CH, true
+	case "CI":
+		return CurrentTerritoryCode_CURRENT_TERRITORY_CODE_CI, true
+	case "CK":
+		return CurrentTerritoryCode_CURRENT_TERRITORY_CODE_CK, true
+	case "CL":
+		return CurrentTerritoryCode_CURRENT_TERRITORY_CODE_CL, true
+	case "CM":
+		return CurrentTerritoryCode_CURRENT_TERRITORY_CODE_CM, true
+	case "CN":
+		return CurrentTerritoryCode_CURRENT_TERRITORY_CODE_CN, true
+	case "CO":
+		return CurrentTerritoryCode_CURRENT_TERRITORY_CODE_CO, true
+	case "CR":
+		return CurrentTerritoryCode_CURRENT_TERRITORY_CODE_CR, true
+	case "CS":
+		return CurrentTerritoryCode_CURRENT_TERRITORY_CODE_CS, true
+	case "CU":
+		return CurrentTerritoryCode_CURRENT_TERRITORY_CODE_CU, true
+	case "CV":
+		return CurrentTerritoryCode_CURRENT_TERRITORY_CODE_CV, true
+	case "CW":
+		return CurrentTerritoryCode_CURRENT_TERRITORY_CODE_CW, true
+	case "CX":
+		return CurrentTerritoryCode_CURRENT_TERRITORY_CODE_CX, true
+	case "CY":
+		return CurrentTerritoryCode_CURRENT_TERRITORY_CODE_CY, true
+	case "CZ":
+		return CurrentTerritoryCode_CURRENT_TERRITORY_CODE_CZ, true
+	case "DE":
+		return CurrentTerritoryCode_CURRENT_TERRITORY_CODE_DE, true
+	case "DJ":
+		return CurrentTerritoryCode_CURRENT_TERRITORY_CODE_DJ, true
+	case "DK":
+		return CurrentTerritoryCode_CURRENT_TERRITORY_CODE_DK, true
+	case "DM":
+		return CurrentTerritoryCode_CURRENT_TERRITORY_CODE_DM, true
+	case "DO":
+		return CurrentTerritoryCode_CURRENT_TERRITORY_CODE_DO, true
+	case "DZ":
+		return CurrentTerritoryCode_CURRENT_TERRITORY_CODE_DZ, true
+	case "EC":
+		return CurrentTerritoryCode_CURRENT_TERRITORY_CODE_EC, true
+	case "EE":
+		return CurrentTerritoryCode_CURRENT_TERRITORY_CODE_EE, true
+	case "EG":
+		return CurrentTerritoryCode_CURRENT_TERRITORY_CODE_EG, true
+	case "EH":
+		return CurrentTerritoryCode_CURRENT_TERRITORY_CODE_EH, true
+	case "ER":
+		return CurrentTerritoryCode_CURRENT_TERRITORY_CODE_ER, true
+	case "ES":
+		return CurrentTerritoryCode_CURRENT_TERRITORY_CODE_ES, true
+	case "ES_CE":
+		return CurrentTerritoryCode_CURRENT_TERRITORY_CODE_ES_CE, true
+	case "ES_CN":
+		return CurrentTerritoryCode_CURRENT_TERRITORY_CODE_ES_CN, true
+	case "ES_ML":
+		return CurrentTerritoryCode_CURRENT_TERRITORY_CODE_ES_ML, true
+	case "ET":
+		return CurrentTerritoryCode_CURRENT_TERRITORY_CODE_ET, true
+	case "FI":
+		return CurrentTerritoryCode_CURRENT_TERRITORY_CODE_FI, true
+	case "FJ":
+		return CurrentTerritoryCode_CURRENT_TERRITORY_CODE_FJ, true
+	case "FK":
+		return CurrentTerritoryCode_CURRENT_TERRITORY_CODE_FK, true
+	case "FM":
+		return CurrentTerritoryCode_CURRENT_TERRITORY_CODE_FM, true
+	case "FO":
+		return CurrentTerritoryCode_CURRENT_TERRITORY_CODE_FO, true
+	case "FR":
+		return CurrentTerritoryCode_CURRENT_TERRITORY_CODE_FR, true
+	case "GA":
+		return CurrentTerritoryCode_CURRENT_TERRITORY_CODE_GA, true
+	case "GB":
+		return CurrentTerritoryCode_CURRENT_TERRITORY_CODE_GB, true
+	case "GD":
+		return CurrentTerritoryCode_CURRENT_TERRITORY_CODE_GD, true
+	case "GE":
+		return CurrentTerritoryCode_CURRENT_TERRITORY_CODE_GE, true
+	case "GF":
+		return CurrentTerritoryCode_CURRENT_TERRITORY_CODE_GF, true
+	case "GG":
+		return CurrentTerritoryCode_CURRENT_TERRITORY_CODE_GG, true
+	case "GH":
+		return CurrentTerritoryCode_CURRENT_TERRITORY_CODE_GH, true
+	case "GI":
+		return CurrentTerritoryCode_CURRENT_TERRITORY_CODE_GI, true
+	case "GL":
+		return CurrentTerritoryCode_CURRENT_TERRITORY_CODE_GL, true
+	case "GM":
+		return CurrentTerritoryCode_CURRENT_TERRITORY_CODE_GM, true
+	case "GN":
+		return CurrentTerritoryCode_CURRENT_TERRITORY_CODE_GN, true
+	case "GP":
+		return CurrentTerritoryCode_CURRENT_TERRITORY_CODE_GP, true
+	case "GQ":
+		return CurrentTerritoryCode_CURRENT_TERRITORY_CODE_GQ, true
+	case "GR":
+		return CurrentTerritoryCode_CURRENT_TERRITORY_CODE_GR, true
+	case "GS":
+		return CurrentTerritoryCode_CURRENT_TERRITORY_CODE_GS, true
+	case "GT":
+		return CurrentTerritoryCode_CURRENT_TERRITORY_CODE_GT, true
+	case "GU":
+		return CurrentTerritoryCode_CURRENT_TERRITORY_CODE_GU, true
+	case "GW":
+		return CurrentTerritoryCode_CURRENT_TERRITORY_CODE_GW, true
+	case "GY":
+		return CurrentTerritoryCode_CURRENT_TERRITORY_CODE_GY, true
+	case "HK":
+		return CurrentTerritoryCode_CURRENT_TERRITORY_CODE_HK, true
+	case "HM":
+		return CurrentTerritoryCode_CURRENT_TERRITORY_CODE_HM, true
+	case "HN":
+		return CurrentTerritoryCode_CURRENT_TERRITORY_CODE_HN, true
+	case "HR":
+		return CurrentTerritoryCode_CURRENT_TERRITORY_CODE_HR, true
+	case "HT":
+		return CurrentTerritoryCode_CURRENT_TERRITORY_CODE_HT, true
+	case "HU":
+		return CurrentTerritoryCode_CURRENT_TERRITORY_CODE_HU, true
+	case "ID":
+		return CurrentTerritoryCode_CURRENT_TERRITORY_CODE_ID, true
+	case "IE":
+		return CurrentTerritoryCode_CURRENT_TERRITORY_CODE_IE, true
+	case "IL":
+		return CurrentTerritoryCode_CURRENT_TERRITORY_CODE_IL, true
+	case "IM":
+		return CurrentTerritoryCode_CURRENT_TERRITORY_CODE_IM, true
+	case "IN":
+		return CurrentTerritoryCode_CURRENT_TERRITORY_CODE_IN, true
+	case "IO":
+		return CurrentTerritoryCode_CURRENT_TERRITORY_CODE_IO, true
+	case "IQ":
+		return CurrentTerritoryCode_CURRENT_TERRITORY_CODE_IQ, true
+	case "IR":
+		return CurrentTerritoryCode_CURRENT_TERRITORY_CODE_IR, true
+	case "IS":
+		return CurrentTerritoryCode_CURRENT_TERRITORY_CODE_IS, true
+	case "IT":
+		return CurrentTerritoryCode_CURRENT_TERRITORY_CODE_IT, true
+	case "JE":
+		return CurrentTerritoryCode_CURRENT_TERRITORY_CODE_JE, true
+	case "JM":
+		return CurrentTerritoryCode_CURRENT_TERRITORY_CODE_JM, true
+	case "JO":
+		return CurrentTerritoryCode_CURRENT_TERRITORY_CODE_JO, true
+	case "JP":
+		return CurrentTerritoryCode_CURRENT_TERRITORY_CODE_JP, true
+	case "KE":
+		return CurrentTerritoryCode_CURRENT_TERRITORY_CODE_KE, true
+	case "KG":
+		return CurrentTerritoryCode_CURRENT_TERRITORY_CODE_KG, true
+	case "KH":
+		return CurrentTerritoryCode_CURRENT_TERRITORY_CODE_KH, true
+	case "KI":
+		return CurrentTerritoryCode_CURRENT_TERRITORY_CODE_KI, true
+	case "KM":
+		return CurrentTerritoryCode_CURRENT_TERRITORY_CODE_KM, true
+	case "KN":
+		return CurrentTerritoryCode_CURRENT_TERRITORY_CODE_KN, true
+	case "KP":
+		return CurrentTerritoryCode_CURRENT_TERRITORY_CODE_KP, true
+	case "KR":
+		return CurrentTerritoryCode_CURRENT_TERRITORY_CODE_KR, true
+	case "KW":
+		return CurrentTerritoryCode_CURRENT_TERRITORY_CODE_KW, true
+	case "KY":
+		return CurrentTerritoryCode_CURRENT_TERRITORY_CODE_KY, true
+	case "KZ":
+		return CurrentTerritoryCode_CURRENT_TERRITORY_CODE_KZ, true
+	case "LA":
+		return CurrentTerritoryCode_CURRENT_TERRITORY_CODE_LA, true
+	case "LB":
+		return CurrentTerritoryCode_CURRENT_TERRITORY_CODE_LB, true
+	case "LC":
+		return CurrentTerritoryCode_CURRENT_TERRITORY_CODE_LC, true
+	case "LI":
+		return CurrentTerritoryCode_CURRENT_TERRITORY_CODE_LI, true
+	case "LK":
+		return CurrentTerritoryCode_CURRENT_TERRITORY_CODE_LK, true
+	case "LR":
+		return CurrentTerritoryCode_CURRENT_TERRITORY_CODE_LR, true
+	case "LS":
+		return CurrentTerritoryCode_CURRENT_TERRITORY_CODE_LS, true
+	case "LT":
+		return CurrentTerritoryCode_CURRENT_TERRITORY_CODE_LT, true
+	case "LU":
+		return CurrentTerritoryCode_CURRENT_TERRITORY_CODE_LU, true
+	case "LV":
+		return CurrentTerritoryCode_CURRENT_TERRITORY_CODE_LV, true
+	case "LY":
+		return CurrentTerritoryCode_CURRENT_TERRITORY_CODE_LY, true
+	case "MA":
+		return CurrentTerritoryCode_CURRENT_TERRITORY_CODE_MA, true
+	case "MC":
+		return CurrentTerritoryCode_CURRENT_TERRITORY_CODE_MC, true
+	case "MD":
+		return CurrentTerritoryCode_CURRENT_TERRITORY_CODE_MD, true
+	case "ME":
+		return CurrentTerritoryCode_CURRENT_TERRITORY_CODE_ME, true
+	case "MF":
+		return CurrentTerritoryCode_CURRENT_TERRITORY_CODE_MF, true
+	case "MG":
+		return CurrentTerritoryCode_CURRENT_TERRITORY_CODE_MG, true
+	case "MH":
+		return CurrentTerritoryCode_CURRENT_TERRITORY_CODE_MH, true
+	case "MK":
+		return CurrentTerritoryCode_CURRENT_TERRITORY_CODE_MK, true
+	case "ML":
+		return CurrentTerritoryCode_CURRENT_TERRITORY_CODE_ML, true
+	case "MM":
+		return CurrentTerritoryCode_CURRENT_TERRITORY_CODE_MM, true
+	case "MN":
+		return CurrentTerritoryCode_CURRENT_TERRITORY_CODE_MN, true
+	case "MO":
+		return CurrentTerritoryCode_CURRENT_TERRITORY_CODE_MO, true
+	case "MP":
+		return CurrentTerritoryCode_CURRENT_TERRITORY_CODE_MP, true
+	case "MQ":
+		return CurrentTerritoryCode_CURRENT_TERRITORY_CODE_MQ, true
+	case "MR":
+		return CurrentTerritoryCode_CURRENT_TERRITORY_CODE_MR, true
+	case "MS":
+		return CurrentTerritoryCode_CURRENT_TERRITORY_CODE_MS, true
+	case "MT":
+		return CurrentTerritoryCode_CURRENT_TERRITORY_CODE_MT, true
+	case "MU":
+		return CurrentTerritoryCode_CURRENT_TERRITORY_CODE_MU, true
+	case "MV":
+		return CurrentTerritoryCode_CURRENT_TERRITORY_CODE_MV, true
+	case "MW":
+		return CurrentTerritoryCode_CURRENT_TERRITORY_CODE_MW, true
+	case "MX":
+		return CurrentTerritoryCode_CURRENT_TERRITORY_CODE_MX, true
+	case "MY":
+		return CurrentTerritoryCode_CURRENT_TERRITORY_CODE_MY, true
+	case "MZ":
+		return CurrentTerritoryCode_CURRENT_TERRITORY_CODE_MZ, true
+	case "NA":
+		return CurrentTerritoryCode_CURRENT_TERRITORY_CODE_NA, true
+	case "NC":
+		return CurrentTerritoryCode_CURRENT_TERRITORY_CODE_NC, true
+	case "NE":
+		return CurrentTerritoryCode_CURRENT_TERRITORY_CODE_NE, true
+	case "NF":
+		return CurrentTerritoryCode_CURRENT_TERRITORY_CODE_NF, true
+	case "NG":
+		return CurrentTerritoryCode_CURRENT_TERRITORY_CODE_NG, true
+	case "NI":
+		return CurrentTerritoryCode_CURRENT_TERRITORY_CODE_NI, true
+	case "NL":
+		return CurrentTerritoryCode_CURRENT_TERRITORY_CODE_NL, true
+	case "NO":
+		return CurrentTerritoryCode_CURRENT_TERRITORY_CODE_NO, true
+	case "NP":
+		return CurrentTerritoryCode_CURRENT_TERRITORY_CODE_NP, true
+	case "NR":
+		return CurrentTerritoryCode_CURRENT_TERRITORY_CODE_NR, true
+	case "NU":
+		return CurrentTerritoryCode_CURRENT_TERRITORY_CODE_NU, true
+	case "NZ":
+		return CurrentTerritoryCode_CURRENT_TERRITORY_CODE_NZ, true
+	case "OM":
+		return CurrentTerritoryCode_CURRENT_TERRITORY_CODE_OM, true
+	case "PA":
+		return CurrentTerritoryCode_CURRENT_TERRITORY_CODE_PA, true
+	case "PE":
+		return CurrentTerritoryCode_CURRENT_TERRITORY_CODE_PE, true
+	case "PF":
+		return CurrentTerritoryCode_CURRENT_TERRITORY_CODE_PF, true
+	case "PG":
+		return CurrentTerritoryCode_CURRENT_TERRITORY_CODE_PG, true
+	case "PH":
+		return CurrentTerritoryCode_CURRENT_TERRITORY_CODE_PH, true
+	case "PK":
+		return CurrentTerritoryCode_CURRENT_TERRITORY_CODE_PK, true
+	case "PL":
+		return CurrentTerritoryCode_CURRENT_TERRITORY_CODE_PL, true
+	case "PM":
+		return CurrentTerritoryCode_CURRENT_TERRITORY_CODE_PM, true
+	case "PN":
+		return CurrentTerritoryCode_CURRENT_TERRITORY_CODE_PN, true
+	case "PR":
+		return CurrentTerritoryCode_CURRENT_TERRITORY_CODE_PR, true
+	case "PS":
+		return CurrentTerritoryCode_CURRENT_TERRITORY_CODE_PS, true
+	case "PT":
+		return CurrentTerritoryCode_CURRENT_TERRITORY_CODE_PT, true
+	case "PW":
+		return CurrentTerritoryCode_CURRENT_TERRITORY_CODE_PW, true
+	case "PY":
+		return CurrentTerritoryCode_CURRENT_TERRITORY_CODE_PY, true
+	case "QA":
+		return CurrentTerritoryCode_CURRENT_TERRITORY_CODE_QA, true
+	case "RE":
+		return CurrentTerritoryCode_CURRENT_TERRITORY_CODE_RE, true
+	case "RO":
+		return CurrentTerritoryCode_CURRENT_TERRITORY_CODE_RO, true
+	case "RS":
+		return CurrentTerritoryCode_CURRENT_TERRITORY_CODE_RS, true
+	case "RU":
+		return CurrentTerritoryCode_CURRENT_TERRITORY_CODE_RU, true
+	case "RW":
+		return CurrentTerritoryCode_CURRENT_TERRITORY_CODE_RW, true
+	case "SA":
+		return CurrentTerritoryCode_CURRENT_TERRITORY_CODE_SA, true
+	case "SB":
+		return CurrentTerritoryCode_CURRENT_TERRITORY_CODE_SB, true
+	case "SC":
+		return CurrentTerritoryCode_CURRENT_TERRITORY_CODE_SC, true
+	case "SD":
+		return CurrentTerritoryCode_CURRENT_TERRITORY_CODE_SD, true
+	case "SE":
+		return CurrentTerritoryCode_CURRENT_TERRITORY_CODE_SE, true
+	case "SG":
+		return CurrentTerritoryCode_CURRENT_TERRITORY_CODE_SG, true
+	case "SH":
+		return CurrentTerritoryCode_CURRENT_TERRITORY_CODE_SH, true
+	case "SI":
+		return CurrentTerritoryCode_CURRENT_TERRITORY_CODE_SI, true
+	case "SJ":
+		return CurrentTerritoryCode_CURRENT_TERRITORY_CODE_SJ, true
+	case "SK":
+		return CurrentTerritoryCode_CURRENT_TERRITORY_CODE_SK, true
+	case "SL":
+		return CurrentTerritoryCode_CURRENT_TERRITORY_CODE_SL, true
+	case "SM":
+		return CurrentTerritoryCode_CURRENT_TERRITORY_CODE_SM, true
+	case "SN":
+		return CurrentTerritoryCode_CURRENT_TERRITORY_CODE_SN, true
+	case "SO":
+		return CurrentTerritoryCode_CURRENT_TERRITORY_CODE_SO, true
+	case "SR":
+		return CurrentTerritoryCode_CURRENT_TERRITORY_CODE_SR, true
+	case "SS":
+		return CurrentTerritoryCode_CURRENT_TERRITORY_CODE_SS, true
+	case "ST":
+		return CurrentTerritoryCode_CURRENT_TERRITORY_CODE_ST, true
+	case "SV":
+		return CurrentTerritoryCode_CURRENT_TERRITORY_CODE_SV, true
+	case "SX":
+		return CurrentTerritoryCode_CURRENT_TERRITORY_CODE_SX, true
+	case "SY":
+		return CurrentTerritoryCode_CURRENT_TERRITORY_CODE_SY, true
+	case "SZ":
+		return CurrentTerritoryCode_CURRENT_TERRITORY_CODE_SZ, true
+	case "TC":
+		return CurrentTerritoryCode_CURRENT_TERRITORY_CODE_TC, true
+	case "TD":
+		return CurrentTerritoryCode_CURRENT_TERRITORY_CODE_TD, true
+	case "TF":
+		return CurrentTerritoryCode_CURRENT_TERRITORY_CODE_TF, true
+	case "TG":
+		return CurrentTerritoryCode_CURRENT_TERRITORY_CODE_TG, true
+	case "TH":
+		return CurrentTerritoryCode_CURRENT_TERRITORY_CODE_TH, true
+	case "TJ":
+		return CurrentTerritoryCode_CURRENT_TERRITORY_CODE_TJ, true
+	case "TK":
+		return CurrentTerritoryCode_CURRENT_TERRITORY_CODE_TK, true
+	case "TL":
+		return CurrentTerritoryCode_CURRENT_TERRITORY_CODE_TL, true
+	case "TM":
+		return CurrentTerritoryCode_CURRENT_TERRITORY_CODE_TM, true
+	case "TN":
+		return CurrentTerritoryCode_CURRENT_TERRITORY_CODE_TN, true
+	case "TO":
+		return CurrentTerritoryCode_CURRENT_TERRITORY_CODE_TO, true
+	case "TR":
+		return CurrentTerritoryCode_CURRENT_TERRITORY_CODE_TR, true
+	case "TT":
+		return CurrentTerritoryCode_CURRENT_TERRITORY_CODE_TT, true
+	case "TV":
+		return CurrentTerritoryCode_CURRENT_TERRITORY_CODE_TV, true
+	case "TW":
+		return CurrentTerritoryCode_CURRENT_TERRITORY_CODE_TW, true
+	case "TZ":
+		return CurrentTerritoryCode_CURRENT_TERRITORY_CODE_TZ, true
+	case "UA":
+		return CurrentTerritoryCode_CURRENT_TERRITORY_CODE_UA, true
+	case "UG":
+		return CurrentTerritoryCode_CURRENT_TERRITORY_CODE_UG, true
+	case "UM":
+		return CurrentTerritoryCode_CURRENT_TERRITORY_CODE_UM, true
+	case "US":
+		return CurrentTerritoryCode_CURRENT_TERRITORY_CODE_US, true
+	case "UY":
+		return CurrentTerritoryCode_CURRENT_TERRITORY_CODE_UY, true
+	case "UZ":
+		return CurrentTerritoryCode_CURRENT_TERRITORY_CODE_UZ, true
+	case "VA":
+		return CurrentTerritoryCode_CURRENT_TERRITORY_CODE_VA, true
+	case "VC":
+		return CurrentTerritoryCode_CURRENT_TERRITORY_CODE_VC, true
+	case "VE":
+		return CurrentTerritoryCode_CURRENT_TERRITORY_CODE_VE, true
+	case "VG":
+		return CurrentTerritoryCode_CURRENT_TERRITORY_CODE_VG, true
+	case "VI":
+		return CurrentTerritoryCode_CURRENT_TERRITORY_CODE_VI, true
+	case "VN":
+		return CurrentTerritoryCode_CURRENT_TERRITORY_CODE_VN, true
+	case "VU":
+		return CurrentTerritoryCode_CURRENT_TERRITORY_CODE_VU, true
+	case "WF":
+		return CurrentTerritoryCode_CURRENT_TERRITORY_CODE_WF, true
+	case "WS":
+		return CurrentTerritoryCode_CURRENT_TERRITORY_CODE_WS, true
+	case "YE":
+		return CurrentTerritoryCode_CURRENT_TERRITORY_CODE_YE, true
+	case "YT":
+		return CurrentTerritoryCode_CURRENT_TERRITORY_CODE_YT, true
+	case "ZA":
+		return CurrentTerritoryCode_CURRENT_TERRITORY_CODE_ZA, true
+	case "ZM":
+		return CurrentTerritoryCode_CURRENT_TERRITORY_CODE_ZM, true
+	case "ZW":
+		return CurrentTerritoryCode_CURRENT_TERRITORY_CODE_ZW, true
+	case "4":
+		return CurrentTerritoryCode_CURRENT_TERRITORY_CODE_AD, true
+	case "8":
+		return CurrentTerritoryCode_CURRENT_TERRITORY_CODE_AD, true
+	case "12":
+		return CurrentTerritoryCode_CURRENT_TERRITORY_CODE_AD, true
+	case "20":
+		return CurrentTerritoryCode_CURRENT_TERRITORY_CODE_AD, true
+	case "24":
+		return CurrentTerritoryCode_CURRENT_TERRITORY_CODE_AD, true
+	case "28":
+		return CurrentTerritoryCode_CURRENT_TERRITORY_CODE_AD, true
+	case "31":
+		return CurrentTerritoryCode_CURRENT_TERRITORY_CODE_AD, true
+	case "32":
+		return CurrentTerritoryCode_CURRENT_TERRITORY_CODE_AD, true
+	case "36":
+		return CurrentTerritoryCode_CURRENT_TERRITORY_CODE_AD, true
+	case "40":
+		return CurrentTerritoryCode_CURRENT_TERRITORY_CODE_AD, true
+	case "44":
+		return CurrentTerritoryCode_CURRENT_TERRITORY_CODE_AD, true
+	case "48":
+		return CurrentTerritoryCode_CURRENT_TERRITORY_CODE_AD, true
+	case "50":
+		return CurrentTerritoryCode_CURRENT_TERRITORY_CODE_AD, true
+	case "51":
+		return CurrentTerritoryCode_CURRENT_TERRITORY_CODE_AD, true
+	case "52":
+		return CurrentTerritoryCode_CURRENT_TERRITORY_CODE_AD, true
+	case "56":
+		return CurrentTerritoryCode_CURRENT_TERRITORY_CODE_AD, true
+	case "64":
+		return CurrentTerritoryCode_CURRENT_TERRITORY_CODE_AD, true
+	case "68":
+		return CurrentTerritoryCode_CURRENT_TERRITORY_CODE_AD, true
+	case "70":
+		return CurrentTerritoryCode_CURRENT_TERRITORY_CODE_AD, true
+	case "72":
+		return CurrentTerritoryCode_CURRENT_TERRITORY_CODE_AD, true
+	case "76":
+		return CurrentTerritoryCode_CURRENT_TERRITORY_CODE_AD, true
+	case "84":
+		return CurrentTerritoryCode_CURRENT_TERRITORY_CODE_AD, true
+	case "90":
+		return CurrentTerritoryCode_CURRENT_TERRITORY_CODE_AD, true
+	case "96":
+		return CurrentTerritoryCode_CURRENT_TERRITORY_CODE_AD, true
+	case "100":
+		return CurrentTerritoryCode_CURRENT_TERRITORY_CODE_AD, true
+	case "104":
+		return CurrentTerritoryCode_CURRENT_TERRITORY_CODE_AD, true
+	case "108":
+		return CurrentTerritoryCode_CURRENT_TERRITORY_CODE_AD, true
+	case "112":
+		return CurrentTerritoryCode_CURRENT_TERRITORY_CODE_AD, true
+	case "116":
+		return CurrentTerritoryCode_CURRENT_TERRITORY_CODE_AD, true
+	case "120":
+		return CurrentTerritoryCode_CURRENT_TERRITORY_CODE_AD, true
+	case "124":
+		return CurrentTerritoryCode_CURRENT_TERRITORY_CODE_AD, true
+	case "132":
+		return CurrentTerritoryCode_CURRENT_TERRITORY_CODE_AD, true
+	case "140":
+		return CurrentTerritoryCode_CURRENT_TERRITORY_CODE_AD, true
+	case "144":
+		return CurrentTerritoryCode_CURRENT_TERRITORY_CODE_AD, true
+	case "148":
+		return CurrentTerritoryCode_CURRENT_TERRITORY_CODE_AD, true
+	case "152":
+		return CurrentTerritoryCode_CURRENT_TERRITORY_CODE_AD, true
+	case "156":
+		return CurrentTerritoryCode_CURRENT_TERRITORY_CODE_AD, true
+	case "158":
+		return CurrentTerritoryCode_CURRENT_TERRITORY_CODE_AD, true
+	case "170":
+		return CurrentTerritoryCode_CURRENT_TERRITORY_CODE_AD, true
+	case "174":
+		return CurrentTerritoryCode_CURRENT_TERRITORY_CODE_AD, true
+	case "178":
+		return CurrentTerritoryCode_CURRENT_TERRITORY_CODE_AD, true
+	case "180":
+		return CurrentTerritoryCode_CURRENT_TERRITORY_CODE_AD, true
+	case "188":
+		return CurrentTerritoryCode_CURRENT_TERRITORY_CODE_AD, true
+	case "191":
+		return CurrentTerritoryCode_CURRENT_TERRITORY_CODE_AD, true
+	case "192":
+		return CurrentTerritoryCode_CURRENT_TERRITORY_CODE_AD, true
+	case "196":
+		return CurrentTerritoryCode_CURRENT_TERRITORY_CODE_AD, true
+	case "200":
+		return CurrentTerritoryCode_CURRENT_TERRITORY_CODE_AD, true
+	case "203":
+		return CurrentTerritoryCode_CURRENT_TERRITORY_CODE_AD, true
+	case "204":
+		return CurrentTerritoryCode_CURRENT_TERRITORY_CODE_AD, true
+	case "208":
+		return CurrentTerritoryCode_CURRENT_TERRITORY_CODE_AD, true
+	case "212":
+		return CurrentTerritoryCode_CURRENT_TERRITORY_CODE_AD, true
+	case "214":
+		return CurrentTerritoryCode_CURRENT_TERRITORY_CODE_AD, true
+	case "218":
+		return CurrentTerritoryCode_CURRENT_TERRITORY_CODE_AD, true
+	case "222":
+		return CurrentTerritoryCode_CURRENT_TERRITORY_CODE_AD, true
+	case "226":
+		return CurrentTerritoryCode_CURRENT_TERRITORY_CODE_AD, true
+	case "230":
+		return CurrentTerritoryCode_CURRENT_TERRITORY_CODE_AD, true
+	case "231":
+		return CurrentTerritoryCode_CURRENT_TERRITORY_CODE_AD, true
+	case "232":
+		return CurrentTerritoryCode_CURRENT_TERRITORY_CODE_AD, true
+	case "233":
+		return CurrentTerritoryCode_CURRENT_TERRITORY_CODE_AD, true
+	case "242":
+		return CurrentTerritoryCode_CURRENT_TERRITORY_CODE_AD, true
+	case "246":
+		return CurrentTerritoryCode_CURRENT_TERRITORY_CODE_AD, true
+	case "250":
+		return CurrentTerritoryCode_CURRENT_TERRITORY_CODE_AD, true
+	case "258":
+		return CurrentTerritoryCode_CURRENT_TERRITORY_CODE_AD, true
+	case "262":
+		return CurrentTerritoryCode_CURRENT_TERRITORY_CODE_AD, true
+	case "266":
+		return CurrentTerritoryCode_CURRENT_TERRITORY_CODE_AD, true
+	case "268":
+		return CurrentTerritoryCode_CURRENT_TERRITORY_CODE_AD, true
+	case "270":
+		return CurrentTerritoryCode_CURRENT_TERRITORY_CODE_AD, true
+	case "276":
+		return CurrentTerritoryCode_CURRENT_TERRITORY_CODE_AD, true
+	case "278":
+		return CurrentTerritoryCode_CURRENT_TERRITORY_CODE_AD, true
+	case "280":
+		return CurrentTerritoryCode_CURRENT_TERRITORY_CODE_AD, true
+	case "288":
+		return CurrentTerritoryCode_CURRENT_TERRITORY_CODE_AD, true
+	case "296":
+		return CurrentTerritoryCode_CURRENT_TERRITORY_CODE_AD, true
+	case "300":
+		return CurrentTerritoryCode_CURRENT_TERRITORY_CODE_AD, true
+	case "308":
+		return CurrentTerritoryCode_CURRENT_TERRITORY_CODE_AD, true
+	case "320":
+		return CurrentTerritoryCode_CURRENT_TERRITORY_CODE_AD, true
+	case "324":
+		return CurrentTerritoryCode_CURRENT_TERRITORY_CODE_AD, true
+	case "328":
+		return CurrentTerritoryCode_CURRENT_TERRITORY_CODE_AD, true
+	case "332":
+		return CurrentTerritoryCode_CURRENT_TERRITORY_CODE_AD, true
+	case "336":
+		return CurrentTerritoryCode_CURRENT_TERRITORY_CODE_AD, true
+	case "340":
+		return CurrentTerritoryCode_CURRENT_TERRITORY_CODE_AD, true
+	case "344":
+		return CurrentTerritoryCode_CURRENT_TERRITORY_CODE_AD, true
+	case "348":
+		return CurrentTerritoryCode_CURRENT_TERRITORY_CODE_AD, true
+	case "352":
+		return CurrentTerritoryCode_CURRENT_TERRITORY_CODE_AD, true
+	case "356":
+		return CurrentTerritoryCode_CURRENT_TERRITORY_CODE_AD, true
+	case "360":
+		return CurrentTerritoryCode_CURRENT_TERRITORY_CODE_AD, true
+	case "364":
+		return CurrentTerritoryCode_CURRENT_TERRITORY_CODE_AD, true
+	case "368":
+		return CurrentTerritoryCode_CURRENT_TERRITORY_CODE_AD, true
+	case "372":
+		return CurrentTerritoryCode_CURRENT_TERRITORY_CODE_AD, true
+	case "376":
+		return CurrentTerritoryCode_CURRENT_TERRITORY_CODE_AD, true
+	case "380":
+		return CurrentTerritoryCode_CURRENT_TERRITORY_CODE_AD, true
+	case "384":
+		return CurrentTerritoryCode_CURRENT_TERRITORY_CODE_AD, true
+	case "388":
+		return CurrentTerritoryCode_CURRENT_TERRITORY_CODE_AD, true
+	case "392":
+		return CurrentTerritoryCode_CURRENT_TERRITORY_CODE_AD, true
+	case "398":
+		return CurrentTerritoryCode_CURRENT_TERRITORY_CODE_AD, true
+	case "400":
+		return CurrentTerritoryCode_CURRENT_TERRITORY_CODE_AD, true
+	case "404":
+		return CurrentTerritoryCode_CURRENT_TERRITORY_CODE_AD, true
+	case "408":
+		return CurrentTerritoryCode_CURRENT_TERRITORY_CODE_AD, true
+	case "410":
+		return CurrentTerritoryCode_CURRENT_TERRITORY_CODE_AD, true
+	case "414":
+		return CurrentTerritoryCode_CURRENT_TERRITORY_CODE_AD, true
+	case "417":
+		return CurrentTerritoryCode_CURRENT_TERRITORY_CODE_AD, true
+	case "418":
+		return CurrentTerritoryCode_CURRENT_TERRITORY_CODE_AD, true
+	case "422":
+		return CurrentTerritoryCode_CURRENT_TERRITORY_CODE_AD, true
+	case "426":
+		return CurrentTerritoryCode_CURRENT_TERRITORY_CODE_AD, true
+	case "428":
+		return CurrentTerritoryCode_CURRENT_TERRITORY_CODE_AD, true
+	case "430":
+		return CurrentTerritoryCode_CURRENT_TERRITORY_CODE_AD, true
+	case "434":
+		return CurrentTerritoryCode_CURRENT_TERRITORY_CODE_AD, true
+	case "438":
+		return CurrentTerritoryCode_CURRENT_TERRITORY_CODE_AD, true
+	case "440":
+		return CurrentTerritoryCode_CURRENT_TERRITORY_CODE_AD, true
+	case "442":
+		return CurrentTerritoryCode_CURRENT_TERRITORY_CODE_AD, true
+	case "446":
+		return CurrentTerritoryCode_CURRENT_TERRITORY_CODE_AD, true
+	case "450":
+		return CurrentTerritoryCode_CURRENT_TERRITORY_CODE_AD, true
+	case "454":
+		return CurrentTerritoryCode_CURRENT_TERRITORY_CODE_AD, true
+	case "458":
+		return CurrentTerritoryCode_CURRENT_TERRITORY_CODE_AD, true
+	case "462":
+		return CurrentTerritoryCode_CURRENT_TERRITORY_CODE_AD, true
+	case "466":
+		return CurrentTerritoryCode_CURRENT_TERRITORY_CODE_AD, true
+	case "470":
+		return CurrentTerritoryCode_CURRENT_TERRITORY_CODE_AD, true
+	case "478":
+		return CurrentTerritoryCode_CURRENT_TERRITORY_CODE_AD, true
+	case "480":
+		return CurrentTerritoryCode_CURRENT_TERRITORY_CODE_AD, true
+	case "484":
+		return CurrentTerritoryCode_CURRENT_TERRITORY_CODE_AD, true
+	case "492":
+		return CurrentTerritoryCode_CURRENT_TERRITORY_CODE_AD, true
+	case "496":
+		return CurrentTerritoryCode_CURRENT_TERRITORY_CODE_AD, true
+	case "498":
+		return CurrentTerritoryCode_CURRENT_TERRITORY_CODE_AD, true
+	case "499":
+		return CurrentTerritoryCode_CURRENT_TERRITORY_CODE_AD, true
+	case "504":
+		return CurrentTerritoryCode_CURRENT_TERRITORY_CODE_AD, true
+	case "508":
+		return CurrentTerritoryCode_CURRENT_TERRITORY_CODE_AD, true
+	case "512":
+		return CurrentTerritoryCode_CURRENT_TERRITORY_CODE_AD, true
+	case "516":
+		return CurrentTerritoryCode_CURRENT_TERRITORY_CODE_AD, true
+	case "520":
+		return CurrentTerritoryCode_CURRENT_TERRITORY_CODE_AD, true
+	case "524":
+		return CurrentTerritoryCode_CURRENT_TERRITORY_CODE_AD, true
+	case "528":
+		return CurrentTerritoryCode_CURRENT_TERRITORY_CODE_AD, true
+	case "540":
+		return CurrentTerritoryCode_CURRENT_TERRITORY_CODE_AD, true
+	case "548":
+		return CurrentTerritoryCode_CURRENT_TERRITORY_CODE_AD, true
+	case "554":
+		return CurrentTerritoryCode_CURRENT_TERRITORY_CODE_AD, true
+	case "558":
+		return CurrentTerritoryCode_CURRENT_TERRITORY_CODE_AD, true
+	case "562":
+		return CurrentTerritoryCode_CURRENT_TERRITORY_CODE_AD, true
+	case "566":
+		return CurrentTerritoryCode_CURRENT_TERRITORY_CODE_AD, true
+	case "578":
+		return CurrentTerritoryCode_CURRENT_TERRITORY_CODE_AD, true
+	case "583":
+		return CurrentTerritoryCode_CURRENT_TERRITORY_CODE_AD, true
+	case "584":
+		return CurrentTerritoryCode_CURRENT_TERRITORY_CODE_AD, true
+	case "585":
+		return CurrentTerritoryCode_CURRENT_TERRITORY_CODE_AD, true
+	case "586":
+		return CurrentTerritoryCode_CURRENT_TERRITORY_CODE_AD, true
+	case "591":
+		return CurrentTerritoryCode_CURRENT_TERRITORY_CODE_AD, true
+	case "598":
+		return CurrentTerritoryCode_CURRENT_TERRITORY_CODE_AD, true
+	case "600":
+		return CurrentTerritoryCode_CURRENT_TERRITORY_CODE_AD, true
+	case "604":
+		return CurrentTerritoryCode_CURRENT_TERRITORY_CODE_AD, true
+	case "608":
+		return CurrentTerritoryCode_CURRENT_TERRITORY_CODE_AD, true
+	case "616":
+		return CurrentTerritoryCode_CURRENT_TERRITORY_CODE_AD, true
+	case "620":
+		return CurrentTerritoryCode_CURRENT_TERRITORY_CODE_AD, true
+	case "624":
+		return CurrentTerritoryCode_CURRENT_TERRITORY_CODE_AD, true
+	case "626":
+		return CurrentTerritoryCode_CURRENT_TERRITORY_CODE_AD, true
+	case "630":
+		return CurrentTerritoryCode_CURRENT_TERRITORY_CODE_AD, true
+	case "634":
+		return CurrentTerritoryCode_CURRENT_TERRITORY_CODE_AD, true
+	case "642":
+		return CurrentTerritoryCode_CURRENT_TERRITORY_CODE_AD, true
+	case "643":
+		return CurrentTerritoryCode_CURRENT_TERRITORY_CODE_AD, true
+	case "646":
+		return CurrentTerritoryCode_CURRENT_TERRITORY_CODE_AD, true
+	case "659":
+		return CurrentTerritoryCode_CURRENT_TERRITORY_CODE_AD, true
+	case "662":
+		return CurrentTerritoryCode_CURRENT_TERRITORY_CODE_AD, true
+	case "670":
+		return CurrentTerritoryCode_CURRENT_TERRITORY_CODE_AD, true
+	case "674":
+		return CurrentTerritoryCode_CURRENT_TERRITORY_CODE_AD, true
+	case "678":
+		return CurrentTerritoryCode_CURRENT_TERRITORY_CODE_AD, true
+	case "682":
+		return CurrentTerritoryCode_CURRENT_TERRITORY_CODE_AD, true
+	case "686":
+		return CurrentTerritoryCode_CURRENT_TERRITORY_CODE_AD, true
+	case "688":
+		return CurrentTerritoryCode_CURRENT_TERRITORY_CODE_AD, true
+	case "690":
+		return CurrentTerritoryCode_CURRENT_TERRITORY_CODE_AD, true
+	case "694":
+		return CurrentTerritoryCode_CURRENT_TERRITORY_CODE_AD, true
+	case "702":
+		return CurrentTerritoryCode_CURRENT_TERRITORY_CODE_AD, true
+	case "703":
+		return CurrentTerritoryCode_CURRENT_TERRITORY_CODE_AD, true
+	case "704":
+		return CurrentTerritoryCode_CURRENT_TERRITORY_CODE_AD, true
+	case "705":
+		return CurrentTerritoryCode_CURRENT_TERRITORY_CODE_AD, true
+	case "706":
+		return CurrentTerritoryCode_CURRENT_TERRITORY_CODE_AD, true
+	case "710":
+		return CurrentTerritoryCode_CURRENT_TERRITORY_CODE_AD, true
+	case "716":
+		return CurrentTerritoryCode_CURRENT_TERRITORY_CODE_AD, true
+	case "720":
+		return CurrentTerritoryCode_CURRENT_TERRITORY_CODE_AD, true
+	case "724":
+		return CurrentTerritoryCode_CURRENT_TERRITORY_CODE_AD, true
+	case "728":
+		return CurrentTerritoryCode_CURRENT_TERRITORY_CODE_AD, true
+	case "729":
+		return CurrentTerritoryCode_CURRENT_TERRITORY_CODE_AD, true
+	case "732":
+		return CurrentTerritoryCode_CURRENT_TERRITORY_CODE_AD, true
+	case "736":
+		return CurrentTerritoryCode_CURRENT_TERRITORY_CODE_AD, true
+	case "740":
+		return CurrentTerritoryCode_CURRENT_TERRITORY_CODE_AD, true
+	case "748":
+		return CurrentTerritoryCode_CURRENT_TERRITORY_CODE_AD, true
+	case "752":
+		return CurrentTerritoryCode_CURRENT_TERRITORY_CODE_AD, true
+	case "756":
+		return CurrentTerritoryCode_CURRENT_TERRITORY_CODE_AD, true
+	case "760":
+		return CurrentTerritoryCode_CURRENT_TERRITORY_CODE_AD, true
+	case "762":
+		return CurrentTerritoryCode_CURRENT_TERRITORY_CODE_AD, true
+	case "764":
+		return CurrentTerritoryCode_CURRENT_TERRITORY_CODE_AD, true
+	case "768":
+		return CurrentTerritoryCode_CURRENT_TERRITORY_CODE_AD, true
+	case "776":
+		return CurrentTerritoryCode_CURRENT_TERRITORY_CODE_AD, true
+	case "780":
+		return CurrentTerritoryCode_CURRENT_TERRITORY_CODE_AD, true
+	case "784":
+		return CurrentTerritoryCode_CURRENT_TERRITORY_CODE_AD, true
+	case "788":
+		return CurrentTerritoryCode_CURRENT_TERRITORY_CODE_AD, true
+	case "792":
+		return CurrentTerritoryCode_CURRENT_TERRITORY_CODE_AD, true
+	case "795":
+		return CurrentTerritoryCode_CURRENT_TERRITORY_CODE_AD, true
+	case "798":
+		return CurrentTerritoryCode_CURRENT_TERRITORY_CODE_AD, true
+	case "800":
+		return CurrentTerritoryCode_CURRENT_TERRITORY_CODE_AD, true
+	case "804":
+		return CurrentTerritoryCode_CURRENT_TERRITORY_CODE_AD, true
+	case "807":
+		return CurrentTerritoryCode_CURRENT_TERRITORY_CODE_AD, true
+	case "810":
+		return CurrentTerritoryCode_CURRENT_TERRITORY_CODE_AD, true
+	case "818":
+		return CurrentTerritoryCode_CURRENT_TERRITORY_CODE_AD, true
+	case "826":
+		return CurrentTerritoryCode_CURRENT_TERRITORY_CODE_AD, true
+	case "834":
+		return CurrentTerritoryCode_CURRENT_TERRITORY_CODE_AD, true
+	case "840":
+		return CurrentTerritoryCode_CURRENT_TERRITORY_CODE_AD, true
+	case "854":
+		return CurrentTerritoryCode_CURRENT_TERRITORY_CODE_AD, true
+	case "858":
+		return CurrentTerritoryCode_CURRENT_TERRITORY_CODE_AD, true
+	case "860":
+		return CurrentTerritoryCode_CURRENT_TERRITORY_CODE_AD, true
+	case "862":
+		return CurrentTerritoryCode_CURRENT_TERRITORY_CODE_AD, true
+	case "882":
+		return CurrentTerritoryCode_CURRENT_TERRITORY_CODE_AD, true
+	case "886":
+		return CurrentTerritoryCode_CURRENT_TERRITORY_CODE_AD, true
+	case "887":
+		return CurrentTerritoryCode_CURRENT_TERRITORY_CODE_AD, true
+	case "890":
+		return CurrentTerritoryCode_CURRENT_TERRITORY_CODE_AD, true
+	case "891":
+		return CurrentTerritoryCode_CURRENT_TERRITORY_CODE_AD, true
+	case "894":
+		return CurrentTerritoryCode_CURRENT_TERRITORY_CODE_AD, true
+	case "2100":
+		return CurrentTerritoryCode_CURRENT_TERRITORY_CODE_AD, true
+	case "2101":
+		return CurrentTerritoryCode_CURRENT_TERRITORY_CODE_AD, true
+	case "2102":
+		return CurrentTerritoryCode_CURRENT_TERRITORY_CODE_AD, true
+	case "2103":
+		return CurrentTerritoryCode_CURRENT_TERRITORY_CODE_AD, true
+	case "2104":
+		return CurrentTerritoryCode_CURRENT_TERRITORY_CODE_AD, true
+	case "2105":
+		return CurrentTerritoryCode_CURRENT_TERRITORY_CODE_AD, true
+	case "2106":
+		return CurrentTerritoryCode_CURRENT_TERRITORY_CODE_AD, true
+	case "2107":
+		return CurrentTerritoryCode_CURRENT_TERRITORY_CODE_AD, true
+	case "2108":
+		return CurrentTerritoryCode_CURRENT_TERRITORY_CODE_AD, true
+	case "2109":
+		return CurrentTerritoryCode_CURRENT_TERRITORY_CODE_AD, true
+	case "2110":
+		return CurrentTerritoryCode_CURRENT_TERRITORY_CODE_AD, true
+	case "2111":
+		return CurrentTerritoryCode_CURRENT_TERRITORY_CODE_AD, true
+	case "2112":
+		return CurrentTerritoryCode_CURRENT_TERRITORY_CODE_AD, true
+	case "2113":
+		return CurrentTerritoryCode_CURRENT_TERRITORY_CODE_AD, true
+	case "2114":
+		return CurrentTerritoryCode_CURRENT_TERRITORY_CODE_AD, true
+	case "2115":
+		return CurrentTerritoryCode_CURRENT_TERRITORY_CODE_AD, true
+	case "2116":
+		return CurrentTerritoryCode_CURRENT_TERRITORY_CODE_AD, true
+	case "2117":
+		return CurrentTerritoryCode_CURRENT_TERRITORY_CODE_AD, true
+	case "2118":
+		return CurrentTerritoryCode_CURRENT_TERRITORY_CODE_AD, true
+	case "2119":
+		return CurrentTerritoryCode_CURRENT_TERRITORY_CODE_AD, true
+	case "2120":
+		return CurrentTerritoryCode_CURRENT_TERRITORY_CODE_AD, true
+	case "2121":
+		return CurrentTerritoryCode_CURRENT_TERRITORY_CODE_AD, true
+	case "2122":
+		return CurrentTerritoryCode_CURRENT_TERRITORY_CODE_AD, true
+	case "2123":
+		return CurrentTerritoryCode_CURRENT_TERRITORY_CODE_AD, true
+	case "2124":
+		return CurrentTerritoryCode_CURRENT_TERRITORY_CODE_AD, true
+	case "2125":
+		return CurrentTerritoryCode_CURRENT_TERRITORY_CODE_AD, true
+	case "2126":
+		return CurrentTerritoryCode_CURRENT_TERRITORY_CODE_AD, true
+	case "2127":
+		return CurrentTerritoryCode_CURRENT_TERRITORY_CODE_AD, true
+	case "2128":
+		return CurrentTerritoryCode_CURRENT_TERRITORY_CODE_AD, true
+	case "2129":
+		return CurrentTerritoryCode_CURRENT_TERRITORY_CODE_AD, true
+	case "2130":
+		return CurrentTerritoryCode_CURRENT_TERRITORY_CODE_AD, true
+	case "2131":
+		return CurrentTerritoryCode_CURRENT_TERRITORY_CODE_AD, true
+	case "2132":
+		return CurrentTerritoryCode_CURRENT_TERRITORY_CODE_AD, true
+	case "2133":
+		return CurrentTerritoryCode_CURRENT_TERRITORY_CODE_AD, true
+	case "2134":
+		return CurrentTerritoryCode_CURRENT_TERRITORY_CODE_AD, true
+	case "2136":
+		return CurrentTerritoryCode_CURRENT_TERRITORY_CODE_AD, true
+	case "XK":
+		return CurrentTerritoryCode_CURRENT_TERRITORY_CODE_XK, true
+	case "WORLDWIDE":
+		return CurrentTerritoryCode_CURRENT_TERRITORY_CODE_WORLDWIDE, true
 	default:
 		return CurrentTerritoryCode(0), false
 	}
@@ -381,6 +6367,18 @@ func ParseCurrentTerritoryCodeString(s string) (CurrentTerritoryCode, bool) {
 // XMLString returns the XML string representation of DataMismatchResponseType
 func (e DataMismatchResponseType) XMLString() string {
 	switch e {
+	case DataMismatchResponseType_DATA_MISMATCH_RESPONSE_TYPE_ADDITIONALINFORMATIONONLY:
+		return "ADDITIONALINFORMATIONONLY"
+	case DataMismatchResponseType_DATA_MISMATCH_RESPONSE_TYPE_DATAMISMATCHCONFIRMATION:
+		return "DATAMISMATCHCONFIRMATION"
+	case DataMismatchResponseType_DATA_MISMATCH_RESPONSE_TYPE_DATAMISMATCHOUTOFSCOPE:
+		return "DATAMISMATCHOUTOFSCOPE"
+	case DataMismatchResponseType_DATA_MISMATCH_RESPONSE_TYPE_DATAMISMATCHRAISEDCOMMERCIALDISPUTE:
+		return "DATAMISMATCHRAISEDCOMMERCIALDISPUTE"
+	case DataMismatchResponseType_DATA_MISMATCH_RESPONSE_TYPE_NOREACTION:
+		return "NOREACTION"
+	case DataMismatchResponseType_DATA_MISMATCH_RESPONSE_TYPE_USERDEFINED:
+		return "USERDEFINED"
 	default:
 		return ""
 	}
@@ -390,6 +6388,18 @@ func (e DataMismatchResponseType) XMLString() string {
 func ParseDataMismatchResponseTypeString(s string) (DataMismatchResponseType, bool) {
 	s = strings.ToUpper(s)
 	switch s {
+	case "ADDITIONALINFORMATIONONLY":
+		return DataMismatchResponseType_DATA_MISMATCH_RESPONSE_TYPE_ADDITIONALINFORMATIONONLY, true
+	case "DATAMISMATCHCONFIRMATION":
+		return DataMismatchResponseType_DATA_MISMATCH_RESPONSE_TYPE_DATAMISMATCHCONFIRMATION, true
+	case "DATAMISMATCHOUTOFSCOPE":
+		return DataMismatchResponseType_DATA_MISMATCH_RESPONSE_TYPE_DATAMISMATCHOUTOFSCOPE, true
+	case "DATAMISMATCHRAISEDCOMMERCIALDISPUTE":
+		return DataMismatchResponseType_DATA_MISMATCH_RESPONSE_TYPE_DATAMISMATCHRAISEDCOMMERCIALDISPUTE, true
+	case "NOREACTION":
+		return DataMismatchResponseType_DATA_MISMATCH_RESPONSE_TYPE_NOREACTION, true
+	case "USERDEFINED":
+		return DataMismatchResponseType_DATA_MISMATCH_RESPONSE_TYPE_USERDEFINED, true
 	default:
 		return DataMismatchResponseType(0), false
 	}
@@ -398,6 +6408,16 @@ func ParseDataMismatchResponseTypeString(s string) (DataMismatchResponseType, bo
 // XMLString returns the XML string representation of DataMismatchStatus
 func (e DataMismatchStatus) XMLString() string {
 	switch e {
+	case DataMismatchStatus_DATA_MISMATCH_STATUS_ADDITIONALINFORMATIONONLY:
+		return "ADDITIONALINFORMATIONONLY"
+	case DataMismatchStatus_DATA_MISMATCH_STATUS_CORRECTED:
+		return "CORRECTED"
+	case DataMismatchStatus_DATA_MISMATCH_STATUS_FATAL:
+		return "FATAL"
+	case DataMismatchStatus_DATA_MISMATCH_STATUS_NOTCORRECTED:
+		return "NOTCORRECTED"
+	case DataMismatchStatus_DATA_MISMATCH_STATUS_USERDEFINED:
+		return "USERDEFINED"
 	default:
 		return ""
 	}
@@ -407,6 +6427,16 @@ func (e DataMismatchStatus) XMLString() string {
 func ParseDataMismatchStatusString(s string) (DataMismatchStatus, bool) {
 	s = strings.ToUpper(s)
 	switch s {
+	case "ADDITIONALINFORMATIONONLY":
+		return DataMismatchStatus_DATA_MISMATCH_STATUS_ADDITIONALINFORMATIONONLY, true
+	case "CORRECTED":
+		return DataMismatchStatus_DATA_MISMATCH_STATUS_CORRECTED, true
+	case "FATAL":
+		return DataMismatchStatus_DATA_MISMATCH_STATUS_FATAL, true
+	case "NOTCORRECTED":
+		return DataMismatchStatus_DATA_MISMATCH_STATUS_NOTCORRECTED, true
+	case "USERDEFINED":
+		return DataMismatchStatus_DATA_MISMATCH_STATUS_USERDEFINED, true
 	default:
 		return DataMismatchStatus(0), false
 	}
@@ -415,6 +6445,48 @@ func ParseDataMismatchStatusString(s string) (DataMismatchStatus, bool) {
 // XMLString returns the XML string representation of DataMismatchType
 func (e DataMismatchType) XMLString() string {
 	switch e {
+	case DataMismatchType_DATA_MISMATCH_TYPE_ADDITIONALINFORMATIONONLY:
+		return "ADDITIONALINFORMATIONONLY"
+	case DataMismatchType_DATA_MISMATCH_TYPE_CHOREOGRAPHYCONFLICT:
+		return "CHOREOGRAPHYCONFLICT"
+	case DataMismatchType_DATA_MISMATCH_TYPE_CONTRADICTORYDATA:
+		return "CONTRADICTORYDATA"
+	case DataMismatchType_DATA_MISMATCH_TYPE_DUPLICATEDDATA:
+		return "DUPLICATEDDATA"
+	case DataMismatchType_DATA_MISMATCH_TYPE_IDENTIFIERSYNTAXMISMATCH:
+		return "IDENTIFIERSYNTAXMISMATCH"
+	case DataMismatchType_DATA_MISMATCH_TYPE_MATHEMATICALINCONSISTENCY:
+		return "MATHEMATICALINCONSISTENCY"
+	case DataMismatchType_DATA_MISMATCH_TYPE_MISSINGCONTRACTUALLYMANDATORYINFORMATION:
+		return "MISSINGCONTRACTUALLYMANDATORYINFORMATION"
+	case DataMismatchType_DATA_MISMATCH_TYPE_MISSINGMANDATORYINFORMATION:
+		return "MISSINGMANDATORYINFORMATION"
+	case DataMismatchType_DATA_MISMATCH_TYPE_MISSINGREFERENCEDMUSICALWORKINFORMATION:
+		return "MISSINGREFERENCEDMUSICALWORKINFORMATION"
+	case DataMismatchType_DATA_MISMATCH_TYPE_MISSINGREFERENCEDRELEASEINFORMATION:
+		return "MISSINGREFERENCEDRELEASEINFORMATION"
+	case DataMismatchType_DATA_MISMATCH_TYPE_MISSINGREFERENCEDRESOURCEINFORMATION:
+		return "MISSINGREFERENCEDRESOURCEINFORMATION"
+	case DataMismatchType_DATA_MISMATCH_TYPE_MISSINGREFERENCEDTECHNICALRESOURCEDETAILINFORMATION:
+		return "MISSINGREFERENCEDTECHNICALRESOURCEDETAILINFORMATION"
+	case DataMismatchType_DATA_MISMATCH_TYPE_MISSINGRESOURCEFILE:
+		return "MISSINGRESOURCEFILE"
+	case DataMismatchType_DATA_MISMATCH_TYPE_TYPOGRAPHICMISMATCH:
+		return "TYPOGRAPHICMISMATCH"
+	case DataMismatchType_DATA_MISMATCH_TYPE_UNEXPECTEDALLOWEDVALUE:
+		return "UNEXPECTEDALLOWEDVALUE"
+	case DataMismatchType_DATA_MISMATCH_TYPE_UNEXPECTEDMESSAGEINTERMEDIARY:
+		return "UNEXPECTEDMESSAGEINTERMEDIARY"
+	case DataMismatchType_DATA_MISMATCH_TYPE_UNEXPECTEDMESSAGERECIPIENT:
+		return "UNEXPECTEDMESSAGERECIPIENT"
+	case DataMismatchType_DATA_MISMATCH_TYPE_UNEXPECTEDMESSAGESENDER:
+		return "UNEXPECTEDMESSAGESENDER"
+	case DataMismatchType_DATA_MISMATCH_TYPE_USERDEFINED:
+		return "USERDEFINED"
+	case DataMismatchType_DATA_MISMATCH_TYPE_XMLFORMATERROR:
+		return "XMLFORMATERROR"
+	case DataMismatchType_DATA_MISMATCH_TYPE_XMLRANGEERROR:
+		return "XMLRANGEERROR"
 	default:
 		return ""
 	}
@@ -424,6 +6496,48 @@ func (e DataMismatchType) XMLString() string {
 func ParseDataMismatchTypeString(s string) (DataMismatchType, bool) {
 	s = strings.ToUpper(s)
 	switch s {
+	case "ADDITIONALINFORMATIONONLY":
+		return DataMismatchType_DATA_MISMATCH_TYPE_ADDITIONALINFORMATIONONLY, true
+	case "CHOREOGRAPHYCONFLICT":
+		return DataMismatchType_DATA_MISMATCH_TYPE_CHOREOGRAPHYCONFLICT, true
+	case "CONTRADICTORYDATA":
+		return DataMismatchType_DATA_MISMATCH_TYPE_CONTRADICTORYDATA, true
+	case "DUPLICATEDDATA":
+		return DataMismatchType_DATA_MISMATCH_TYPE_DUPLICATEDDATA, true
+	case "IDENTIFIERSYNTAXMISMATCH":
+		return DataMismatchType_DATA_MISMATCH_TYPE_IDENTIFIERSYNTAXMISMATCH, true
+	case "MATHEMATICALINCONSISTENCY":
+		return DataMismatchType_DATA_MISMATCH_TYPE_MATHEMATICALINCONSISTENCY, true
+	case "MISSINGCONTRACTUALLYMANDATORYINFORMATION":
+		return DataMismatchType_DATA_MISMATCH_TYPE_MISSINGCONTRACTUALLYMANDATORYINFORMATION, true
+	case "MISSINGMANDATORYINFORMATION":
+		return DataMismatchType_DATA_MISMATCH_TYPE_MISSINGMANDATORYINFORMATION, true
+	case "MISSINGREFERENCEDMUSICALWORKINFORMATION":
+		return DataMismatchType_DATA_MISMATCH_TYPE_MISSINGREFERENCEDMUSICALWORKINFORMATION, true
+	case "MISSINGREFERENCEDRELEASEINFORMATION":
+		return DataMismatchType_DATA_MISMATCH_TYPE_MISSINGREFERENCEDRELEASEINFORMATION, true
+	case "MISSINGREFERENCEDRESOURCEINFORMATION":
+		return DataMismatchType_DATA_MISMATCH_TYPE_MISSINGREFERENCEDRESOURCEINFORMATION, true
+	case "MISSINGREFERENCEDTECHNICALRESOURCEDETAILINFORMATION":
+		return DataMismatchType_DATA_MISMATCH_TYPE_MISSINGREFERENCEDTECHNICALRESOURCEDETAILINFORMATION, true
+	case "MISSINGRESOURCEFILE":
+		return DataMismatchType_DATA_MISMATCH_TYPE_MISSINGRESOURCEFILE, true
+	case "TYPOGRAPHICMISMATCH":
+		return DataMismatchType_DATA_MISMATCH_TYPE_TYPOGRAPHICMISMATCH, true
+	case "UNEXPECTEDALLOWEDVALUE":
+		return DataMismatchType_DATA_MISMATCH_TYPE_UNEXPECTEDALLOWEDVALUE, true
+	case "UNEXPECTEDMESSAGEINTERMEDIARY":
+		return DataMismatchType_DATA_MISMATCH_TYPE_UNEXPECTEDMESSAGEINTERMEDIARY, true
+	case "UNEXPECTEDMESSAGERECIPIENT":
+		return DataMismatchType_DATA_MISMATCH_TYPE_UNEXPECTEDMESSAGERECIPIENT, true
+	case "UNEXPECTEDMESSAGESENDER":
+		return DataMismatchType_DATA_MISMATCH_TYPE_UNEXPECTEDMESSAGESENDER, true
+	case "USERDEFINED":
+		return DataMismatchType_DATA_MISMATCH_TYPE_USERDEFINED, true
+	case "XMLFORMATERROR":
+		return DataMismatchType_DATA_MISMATCH_TYPE_XMLFORMATERROR, true
+	case "XMLRANGEERROR":
+		return DataMismatchType_DATA_MISMATCH_TYPE_XMLRANGEERROR, true
 	default:
 		return DataMismatchType(0), false
 	}
@@ -432,6 +6546,10 @@ func ParseDataMismatchTypeString(s string) (DataMismatchType, bool) {
 // XMLString returns the XML string representation of DdexTerritoryCode
 func (e DdexTerritoryCode) XMLString() string {
 	switch e {
+	case DdexTerritoryCode_DDEX_TERRITORY_CODE_XK:
+		return "XK"
+	case DdexTerritoryCode_DDEX_TERRITORY_CODE_WORLDWIDE:
+		return "WORLDWIDE"
 	default:
 		return ""
 	}
@@ -441,6 +6559,10 @@ func (e DdexTerritoryCode) XMLString() string {
 func ParseDdexTerritoryCodeString(s string) (DdexTerritoryCode, bool) {
 	s = strings.ToUpper(s)
 	switch s {
+	case "XK":
+		return DdexTerritoryCode_DDEX_TERRITORY_CODE_XK, true
+	case "WORLDWIDE":
+		return DdexTerritoryCode_DDEX_TERRITORY_CODE_WORLDWIDE, true
 	default:
 		return DdexTerritoryCode(0), false
 	}
@@ -449,6 +6571,12 @@ func ParseDdexTerritoryCodeString(s string) (DdexTerritoryCode, bool) {
 // XMLString returns the XML string representation of DeductionRateType
 func (e DeductionRateType) XMLString() string {
 	switch e {
+	case DeductionRateType_DEDUCTION_RATE_TYPE_PENNYRATE:
+		return "PENNYRATE"
+	case DeductionRateType_DEDUCTION_RATE_TYPE_PERCENTAGERATE:
+		return "PERCENTAGERATE"
+	case DeductionRateType_DEDUCTION_RATE_TYPE_USERDEFINED:
+		return "USERDEFINED"
 	default:
 		return ""
 	}
@@ -458,6 +6586,12 @@ func (e DeductionRateType) XMLString() string {
 func ParseDeductionRateTypeString(s string) (DeductionRateType, bool) {
 	s = strings.ToUpper(s)
 	switch s {
+	case "PENNYRATE":
+		return DeductionRateType_DEDUCTION_RATE_TYPE_PENNYRATE, true
+	case "PERCENTAGERATE":
+		return DeductionRateType_DEDUCTION_RATE_TYPE_PERCENTAGERATE, true
+	case "USERDEFINED":
+		return DeductionRateType_DEDUCTION_RATE_TYPE_USERDEFINED, true
 	default:
 		return DeductionRateType(0), false
 	}
@@ -466,6 +6600,14 @@ func ParseDeductionRateTypeString(s string) (DeductionRateType, bool) {
 // XMLString returns the XML string representation of DeliveryActionType
 func (e DeliveryActionType) XMLString() string {
 	switch e {
+	case DeliveryActionType_DELIVERY_ACTION_TYPE_CHANGEDELIVERYLIMITS:
+		return "CHANGEDELIVERYLIMITS"
+	case DeliveryActionType_DELIVERY_ACTION_TYPE_RESTARTDELIVERYWITHLIMITS:
+		return "RESTARTDELIVERYWITHLIMITS"
+	case DeliveryActionType_DELIVERY_ACTION_TYPE_RESTARTDELIVERYWITHPREVIOUSLIMITS:
+		return "RESTARTDELIVERYWITHPREVIOUSLIMITS"
+	case DeliveryActionType_DELIVERY_ACTION_TYPE_STOPDELIVERY:
+		return "STOPDELIVERY"
 	default:
 		return ""
 	}
@@ -475,6 +6617,14 @@ func (e DeliveryActionType) XMLString() string {
 func ParseDeliveryActionTypeString(s string) (DeliveryActionType, bool) {
 	s = strings.ToUpper(s)
 	switch s {
+	case "CHANGEDELIVERYLIMITS":
+		return DeliveryActionType_DELIVERY_ACTION_TYPE_CHANGEDELIVERYLIMITS, true
+	case "RESTARTDELIVERYWITHLIMITS":
+		return DeliveryActionType_DELIVERY_ACTION_TYPE_RESTARTDELIVERYWITHLIMITS, true
+	case "RESTARTDELIVERYWITHPREVIOUSLIMITS":
+		return DeliveryActionType_DELIVERY_ACTION_TYPE_RESTARTDELIVERYWITHPREVIOUSLIMITS, true
+	case "STOPDELIVERY":
+		return DeliveryActionType_DELIVERY_ACTION_TYPE_STOPDELIVERY, true
 	default:
 		return DeliveryActionType(0), false
 	}
@@ -483,6 +6633,12 @@ func ParseDeliveryActionTypeString(s string) (DeliveryActionType, bool) {
 // XMLString returns the XML string representation of DeliveryMessageType
 func (e DeliveryMessageType) XMLString() string {
 	switch e {
+	case DeliveryMessageType_DELIVERY_MESSAGE_TYPE_NEWRELEASEMESSAGE:
+		return "NEWRELEASEMESSAGE"
+	case DeliveryMessageType_DELIVERY_MESSAGE_TYPE_NONDDEXMESSAGE:
+		return "NONDDEXMESSAGE"
+	case DeliveryMessageType_DELIVERY_MESSAGE_TYPE_UNKNOWN:
+		return "UNKNOWN"
 	default:
 		return ""
 	}
@@ -492,6 +6648,12 @@ func (e DeliveryMessageType) XMLString() string {
 func ParseDeliveryMessageTypeString(s string) (DeliveryMessageType, bool) {
 	s = strings.ToUpper(s)
 	switch s {
+	case "NEWRELEASEMESSAGE":
+		return DeliveryMessageType_DELIVERY_MESSAGE_TYPE_NEWRELEASEMESSAGE, true
+	case "NONDDEXMESSAGE":
+		return DeliveryMessageType_DELIVERY_MESSAGE_TYPE_NONDDEXMESSAGE, true
+	case "UNKNOWN":
+		return DeliveryMessageType_DELIVERY_MESSAGE_TYPE_UNKNOWN, true
 	default:
 		return DeliveryMessageType(0), false
 	}
@@ -500,6 +6662,28 @@ func ParseDeliveryMessageTypeString(s string) (DeliveryMessageType, bool) {
 // XMLString returns the XML string representation of DeprecatedCurrencyCode
 func (e DeprecatedCurrencyCode) XMLString() string {
 	switch e {
+	case DeprecatedCurrencyCode_DEPRECATED_CURRENCY_CODE_CYP:
+		return "CYP"
+	case DeprecatedCurrencyCode_DEPRECATED_CURRENCY_CODE_EEK:
+		return "EEK"
+	case DeprecatedCurrencyCode_DEPRECATED_CURRENCY_CODE_MTL:
+		return "MTL"
+	case DeprecatedCurrencyCode_DEPRECATED_CURRENCY_CODE_ROL:
+		return "ROL"
+	case DeprecatedCurrencyCode_DEPRECATED_CURRENCY_CODE_SIT:
+		return "SIT"
+	case DeprecatedCurrencyCode_DEPRECATED_CURRENCY_CODE_SKK:
+		return "SKK"
+	case DeprecatedCurrencyCode_DEPRECATED_CURRENCY_CODE_LTL:
+		return "LTL"
+	case DeprecatedCurrencyCode_DEPRECATED_CURRENCY_CODE_LVL:
+		return "LVL"
+	case DeprecatedCurrencyCode_DEPRECATED_CURRENCY_CODE_MRO:
+		return "MRO"
+	case DeprecatedCurrencyCode_DEPRECATED_CURRENCY_CODE_STD:
+		return "STD"
+	case DeprecatedCurrencyCode_DEPRECATED_CURRENCY_CODE_VEF:
+		return "VEF"
 	default:
 		return ""
 	}
@@ -509,6 +6693,28 @@ func (e DeprecatedCurrencyCode) XMLString() string {
 func ParseDeprecatedCurrencyCodeString(s string) (DeprecatedCurrencyCode, bool) {
 	s = strings.ToUpper(s)
 	switch s {
+	case "CYP":
+		return DeprecatedCurrencyCode_DEPRECATED_CURRENCY_CODE_CYP, true
+	case "EEK":
+		return DeprecatedCurrencyCode_DEPRECATED_CURRENCY_CODE_EEK, true
+	case "MTL":
+		return DeprecatedCurrencyCode_DEPRECATED_CURRENCY_CODE_MTL, true
+	case "ROL":
+		return DeprecatedCurrencyCode_DEPRECATED_CURRENCY_CODE_ROL, true
+	case "SIT":
+		return DeprecatedCurrencyCode_DEPRECATED_CURRENCY_CODE_SIT, true
+	case "SKK":
+		return DeprecatedCurrencyCode_DEPRECATED_CURRENCY_CODE_SKK, true
+	case "LTL":
+		return DeprecatedCurrencyCode_DEPRECATED_CURRENCY_CODE_LTL, true
+	case "LVL":
+		return DeprecatedCurrencyCode_DEPRECATED_CURRENCY_CODE_LVL, true
+	case "MRO":
+		return DeprecatedCurrencyCode_DEPRECATED_CURRENCY_CODE_MRO, true
+	case "STD":
+		return DeprecatedCurrencyCode_DEPRECATED_CURRENCY_CODE_STD, true
+	case "VEF":
+		return DeprecatedCurrencyCode_DEPRECATED_CURRENCY_CODE_VEF, true
 	default:
 		return DeprecatedCurrencyCode(0), false
 	}
@@ -517,6 +6723,68 @@ func ParseDeprecatedCurrencyCodeString(s string) (DeprecatedCurrencyCode, bool)
 // XMLString returns the XML string representation of DeprecatedIsoTerritoryCode
 func (e DeprecatedIsoTerritoryCode) XMLString() string {
 	switch e {
+	case DeprecatedIsoTerritoryCode_DEPRECATED_ISO_TERRITORY_CODE_AIDJ:
+		return "AIDJ"
+	case DeprecatedIsoTerritoryCode_DEPRECATED_ISO_TERRITORY_CODE_ANHH:
+		return "ANHH"
+	case DeprecatedIsoTerritoryCode_DEPRECATED_ISO_TERRITORY_CODE_BQAQ:
+		return "BQAQ"
+	case DeprecatedIsoTerritoryCode_DEPRECATED_ISO_TERRITORY_CODE_BUMM:
+		return "BUMM"
+	case DeprecatedIsoTerritoryCode_DEPRECATED_ISO_TERRITORY_CODE_BYAA:
+		return "BYAA"
+	case DeprecatedIsoTerritoryCode_DEPRECATED_ISO_TERRITORY_CODE_CSHH:
+		return "CSHH"
+	case DeprecatedIsoTerritoryCode_DEPRECATED_ISO_TERRITORY_CODE_CSXX:
+		return "CSXX"
+	case DeprecatedIsoTerritoryCode_DEPRECATED_ISO_TERRITORY_CODE_CTKI:
+		return "CTKI"
+	case DeprecatedIsoTerritoryCode_DEPRECATED_ISO_TERRITORY_CODE_DDDE:
+		return "DDDE"
+	case DeprecatedIsoTerritoryCode_DEPRECATED_ISO_TERRITORY_CODE_DYBJ:
+		return "DYBJ"
+	case DeprecatedIsoTerritoryCode_DEPRECATED_ISO_TERRITORY_CODE_FQHH:
+		return "FQHH"
+	case DeprecatedIsoTerritoryCode_DEPRECATED_ISO_TERRITORY_CODE_FXFR:
+		return "FXFR"
+	case DeprecatedIsoTerritoryCode_DEPRECATED_ISO_TERRITORY_CODE_GEHH:
+		return "GEHH"
+	case DeprecatedIsoTerritoryCode_DEPRECATED_ISO_TERRITORY_CODE_HVBF:
+		return "HVBF"
+	case DeprecatedIsoTerritoryCode_DEPRECATED_ISO_TERRITORY_CODE_JTUM:
+		return "JTUM"
+	case DeprecatedIsoTerritoryCode_DEPRECATED_ISO_TERRITORY_CODE_MIUM:
+		return "MIUM"
+	case DeprecatedIsoTerritoryCode_DEPRECATED_ISO_TERRITORY_CODE_NHVU:
+		return "NHVU"
+	case DeprecatedIsoTerritoryCode_DEPRECATED_ISO_TERRITORY_CODE_NQAQ:
+		return "NQAQ"
+	case DeprecatedIsoTerritoryCode_DEPRECATED_ISO_TERRITORY_CODE_NTHH:
+		return "NTHH"
+	case DeprecatedIsoTerritoryCode_DEPRECATED_ISO_TERRITORY_CODE_PCHH:
+		return "PCHH"
+	case DeprecatedIsoTerritoryCode_DEPRECATED_ISO_TERRITORY_CODE_PUUM:
+		return "PUUM"
+	case DeprecatedIsoTerritoryCode_DEPRECATED_ISO_TERRITORY_CODE_PZPA:
+		return "PZPA"
+	case DeprecatedIsoTerritoryCode_DEPRECATED_ISO_TERRITORY_CODE_RHZW:
+		return "RHZW"
+	case DeprecatedIsoTerritoryCode_DEPRECATED_ISO_TERRITORY_CODE_SKIN:
+		return "SKIN"
+	case DeprecatedIsoTerritoryCode_DEPRECATED_ISO_TERRITORY_CODE_SUHH:
+		return "SUHH"
+	case DeprecatedIsoTerritoryCode_DEPRECATED_ISO_TERRITORY_CODE_TPTL:
+		return "TPTL"
+	case DeprecatedIsoTerritoryCode_DEPRECATED_ISO_TERRITORY_CODE_VDVN:
+		return "VDVN"
+	case DeprecatedIsoTerritoryCode_DEPRECATED_ISO_TERRITORY_CODE_WKUM:
+		return "WKUM"
+	case DeprecatedIsoTerritoryCode_DEPRECATED_ISO_TERRITORY_CODE_YDYE:
+		return "YDYE"
+	case DeprecatedIsoTerritoryCode_DEPRECATED_ISO_TERRITORY_CODE_YUCS:
+		return "YUCS"
+	case DeprecatedIsoTerritoryCode_DEPRECATED_ISO_TERRITORY_CODE_ZRCD:
+		return "ZRCD"
 	default:
 		return ""
 	}
@@ -526,6 +6794,68 @@ func (e DeprecatedIsoTerritoryCode) XMLString() string {
 func ParseDeprecatedIsoTerritoryCodeString(s string) (DeprecatedIsoTerritoryCode, bool) {
 	s = strings.ToUpper(s)
 	switch s {
+	case "AIDJ":
+		return DeprecatedIsoTerritoryCode_DEPRECATED_ISO_TERRITORY_CODE_AIDJ, true
+	case "ANHH":
+		return DeprecatedIsoTerritoryCode_DEPRECATED_ISO_TERRITORY_CODE_ANHH, true
+	case "BQAQ":
+		return DeprecatedIsoTerritoryCode_DEPRECATED_ISO_TERRITORY_CODE_BQAQ, true
+	case "BUMM":
+		return DeprecatedIsoTerritoryCode_DEPRECATED_ISO_TERRITORY_CODE_BUMM, true
+	case "BYAA":
+		return DeprecatedIsoTerritoryCode_DEPRECATED_ISO_TERRITORY_CODE_BYAA, true
+	case "CSHH":
+		return DeprecatedIsoTerritoryCode_DEPRECATED_ISO_TERRITORY_CODE_CSHH, true
+	case "CSXX":
+		return DeprecatedIsoTerritoryCode_DEPRECATED_ISO_TERRITORY_CODE_CSXX, true
+	case "CTKI":
+		return DeprecatedIsoTerritoryCode_DEPRECATED_ISO_TERRITORY_CODE_CTKI, true
+	case "DDDE":
+		return DeprecatedIsoTerritoryCode_DEPRECATED_ISO_TERRITORY_CODE_DDDE, true
+	case "DYBJ":
+		return DeprecatedIsoTerritoryCode_DEPRECATED_ISO_TERRITORY_CODE_DYBJ, true
+	case "FQHH":
+		return DeprecatedIsoTerritoryCode_DEPRECATED_ISO_TERRITORY_CODE_FQHH, true
+	case "FXFR":
+		return DeprecatedIsoTerritoryCode_DEPRECATED_ISO_TERRITORY_CODE_FXFR, true
+	case "GEHH":
+		return DeprecatedIsoTerritoryCode_DEPRECATED_ISO_TERRITORY_CODE_GEHH, true
+	case "HVBF":
+		return DeprecatedIsoTerritoryCode_DEPRECATED_ISO_TERRITORY_CODE_HVBF, true
+	case "JTUM":
+		return DeprecatedIsoTerritoryCode_DEPRECATED_ISO_TERRITORY_CODE_JTUM, true
+	case "MIUM":
+		return DeprecatedIsoTerritoryCode_DEPRECATED_ISO_TERRITORY_CODE_MIUM, true
+	case "NHVU":
+		return DeprecatedIsoTerritoryCode_DEPRECATED_ISO_TERRITORY_CODE_NHVU, true
+	case "NQAQ":
+		return DeprecatedIsoTerritoryCode_DEPRECATED_ISO_TERRITORY_CODE_NQAQ, true
+	case "NTHH":
+		return DeprecatedIsoTerritoryCode_DEPRECATED_ISO_TERRITORY_CODE_NTHH, true
+	case "PCHH":
+		return DeprecatedIsoTerritoryCode_DEPRECATED_ISO_TERRITORY_CODE_PCHH, true
+	case "PUUM":
+		return DeprecatedIsoTerritoryCode_DEPRECATED_ISO_TERRITORY_CODE_PUUM, true
+	case "PZPA":
+		return DeprecatedIsoTerritoryCode_DEPRECATED_ISO_TERRITORY_CODE_PZPA, true
+	case "RHZW":
+		return DeprecatedIsoTerritoryCode_DEPRECATED_ISO_TERRITORY_CODE_RHZW, true
+	case "SKIN":
+		return DeprecatedIsoTerritoryCode_DEPRECATED_ISO_TERRITORY_CODE_SKIN, true
+	case "SUHH":
+		return DeprecatedIsoTerritoryCode_DEPRECATED_ISO_TERRITORY_CODE_SUHH, true
+	case "TPTL":
+		return DeprecatedIsoTerritoryCode_DEPRECATED_ISO_TERRITORY_CODE_TPTL, true
+	case "VDVN":
+		return DeprecatedIsoTerritoryCode_DEPRECATED_ISO_TERRITORY_CODE_VDVN, true
+	case "WKUM":
+		return DeprecatedIsoTerritoryCode_DEPRECATED_ISO_TERRITORY_CODE_WKUM, true
+	case "YDYE":
+		return DeprecatedIsoTerritoryCode_DEPRECATED_ISO_TERRITORY_CODE_YDYE, true
+	case "YUCS":
+		return DeprecatedIsoTerritoryCode_DEPRECATED_ISO_TERRITORY_CODE_YUCS, true
+	case "ZRCD":
+		return DeprecatedIsoTerritoryCode_DEPRECATED_ISO_TERRITORY_CODE_ZRCD, true
 	default:
 		return DeprecatedIsoTerritoryCode(0), false
 	}
@@ -534,6 +6864,14 @@ func ParseDeprecatedIsoTerritoryCodeString(s string) (DeprecatedIsoTerritoryCode
 // XMLString returns the XML string representation of DigitizationMode
 func (e DigitizationMode) XMLString() string {
 	switch e {
+	case DigitizationMode_DIGITIZATION_MODE_AAD:
+		return "AAD"
+	case DigitizationMode_DIGITIZATION_MODE_ADD:
+		return "ADD"
+	case DigitizationMode_DIGITIZATION_MODE_DDD:
+		return "DDD"
+	case DigitizationMode_DIGITIZATION_MODE_UNKNOWN:
+		return "UNKNOWN"
 	default:
 		return ""
 	}
@@ -543,6 +6881,14 @@ func (e DigitizationMode) XMLString() string {
 func ParseDigitizationModeString(s string) (DigitizationMode, bool) {
 	s = strings.ToUpper(s)
 	switch s {
+	case "AAD":
+		return DigitizationMode_DIGITIZATION_MODE_AAD, true
+	case "ADD":
+		return DigitizationMode_DIGITIZATION_MODE_ADD, true
+	case "DDD":
+		return DigitizationMode_DIGITIZATION_MODE_DDD, true
+	case "UNKNOWN":
+		return DigitizationMode_DIGITIZATION_MODE_UNKNOWN, true
 	default:
 		return DigitizationMode(0), false
 	}
@@ -551,6 +6897,14 @@ func ParseDigitizationModeString(s string) (DigitizationMode, bool) {
 // XMLString returns the XML string representation of DisputeReason
 func (e DisputeReason) XMLString() string {
 	switch e {
+	case DisputeReason_DISPUTE_REASON_MISSINGINFORMATION:
+		return "MISSINGINFORMATION"
+	case DisputeReason_DISPUTE_REASON_NOTPARTOFCATALOGTRANSFER:
+		return "NOTPARTOFCATALOGTRANSFER"
+	case DisputeReason_DISPUTE_REASON_MORERESEARCHNEEDED:
+		return "MORERESEARCHNEEDED"
+	case DisputeReason_DISPUTE_REASON_USERDEFINED:
+		return "USERDEFINED"
 	default:
 		return ""
 	}
@@ -560,6 +6914,14 @@ func (e DisputeReason) XMLString() string {
 func ParseDisputeReasonString(s string) (DisputeReason, bool) {
 	s = strings.ToUpper(s)
 	switch s {
+	case "MISSINGINFORMATION":
+		return DisputeReason_DISPUTE_REASON_MISSINGINFORMATION, true
+	case "NOTPARTOFCATALOGTRANSFER":
+		return DisputeReason_DISPUTE_REASON_NOTPARTOFCATALOGTRANSFER, true
+	case "MORERESEARCHNEEDED":
+		return DisputeReason_DISPUTE_REASON_MORERESEARCHNEEDED, true
+	case "USERDEFINED":
+		return DisputeReason_DISPUTE_REASON_USERDEFINED, true
 	default:
 		return DisputeReason(0), false
 	}
@@ -568,6 +6930,38 @@ func ParseDisputeReasonString(s string) (DisputeReason, bool) {
 // XMLString returns the XML string representation of DistributionChannelType
 func (e DistributionChannelType) XMLString() string {
 	switch e {
+	case DistributionChannelType_DISTRIBUTION_CHANNEL_TYPE_ASPERCONTRACT:
+		return "ASPERCONTRACT"
+	case DistributionChannelType_DISTRIBUTION_CHANNEL_TYPE_BROADCAST:
+		return "BROADCAST"
+	case DistributionChannelType_DISTRIBUTION_CHANNEL_TYPE_CABLE:
+		return "CABLE"
+	case DistributionChannelType_DISTRIBUTION_CHANNEL_TYPE_INTERNET:
+		return "INTERNET"
+	case DistributionChannelType_DISTRIBUTION_CHANNEL_TYPE_INTERNETANDMOBILE:
+		return "INTERNETANDMOBILE"
+	case DistributionChannelType_DISTRIBUTION_CHANNEL_TYPE_IPTV:
+		return "IPTV"
+	case DistributionChannelType_DISTRIBUTION_CHANNEL_TYPE_MOBILETELEPHONE:
+		return "MOBILETELEPHONE"
+	case DistributionChannelType_DISTRIBUTION_CHANNEL_TYPE_NARROWCAST:
+		return "NARROWCAST"
+	case DistributionChannelType_DISTRIBUTION_CHANNEL_TYPE_ONDEMANDSTREAM:
+		return "ONDEMANDSTREAM"
+	case DistributionChannelType_DISTRIBUTION_CHANNEL_TYPE_PEERTOPEER:
+		return "PEERTOPEER"
+	case DistributionChannelType_DISTRIBUTION_CHANNEL_TYPE_PHYSICAL:
+		return "PHYSICAL"
+	case DistributionChannelType_DISTRIBUTION_CHANNEL_TYPE_SATELLITE:
+		return "SATELLITE"
+	case DistributionChannelType_DISTRIBUTION_CHANNEL_TYPE_SIMULCAST:
+		return "SIMULCAST"
+	case DistributionChannelType_DISTRIBUTION_CHANNEL_TYPE_UNKNOWN:
+		return "UNKNOWN"
+	case DistributionChannelType_DISTRIBUTION_CHANNEL_TYPE_USERDEFINED:
+		return "USERDEFINED"
+	case DistributionChannelType_DISTRIBUTION_CHANNEL_TYPE_WEBCAST:
+		return "WEBCAST"
 	default:
 		return ""
 	}
@@ -577,6 +6971,38 @@ func (e DistributionChannelType) XMLString() string {
 func ParseDistributionChannelTypeString(s string) (DistributionChannelType, bool) {
 	s = strings.ToUpper(s)
 	switch s {
+	case "ASPERCONTRACT":
+		return DistributionChannelType_DISTRIBUTION_CHANNEL_TYPE_ASPERCONTRACT, true
+	case "BROADCAST":
+		return DistributionChannelType_DISTRIBUTION_CHANNEL_TYPE_BROADCAST, true
+	case "CABLE":
+		return DistributionChannelType_DISTRIBUTION_CHANNEL_TYPE_CABLE, true
+	case "INTERNET":
+		return DistributionChannelType_DISTRIBUTION_CHANNEL_TYPE_INTERNET, true
+	case "INTERNETANDMOBILE":
+		return DistributionChannelType_DISTRIBUTION_CHANNEL_TYPE_INTERNETANDMOBILE, true
+	case "IPTV":
+		return DistributionChannelType_DISTRIBUTION_CHANNEL_TYPE_IPTV, true
+	case "MOBILETELEPHONE":
+		return DistributionChannelType_DISTRIBUTION_CHANNEL_TYPE_MOBILETELEPHONE, true
+	case "NARROWCAST":
+		return DistributionChannelType_DISTRIBUTION_CHANNEL_TYPE_NARROWCAST, true
+	case "ONDEMANDSTREAM":
+		return DistributionChannelType_DISTRIBUTION_CHANNEL_TYPE_ONDEMANDSTREAM, true
+	case "PEERTOPEER":
+		return DistributionChannelType_DISTRIBUTION_CHANNEL_TYPE_PEERTOPEER, true
+	case "PHYSICAL":
+		return DistributionChannelType_DISTRIBUTION_CHANNEL_TYPE_PHYSICAL, true
+	case "SATELLITE":
+		return DistributionChannelType_DISTRIBUTION_CHANNEL_TYPE_SATELLITE, true
+	case "SIMULCAST":
+		return DistributionChannelType_DISTRIBUTION_CHANNEL_TYPE_SIMULCAST, true
+	case "UNKNOWN":
+		return DistributionChannelType_DISTRIBUTION_CHANNEL_TYPE_UNKNOWN, true
+	case "USERDEFINED":
+		return DistributionChannelType_DISTRIBUTION_CHANNEL_TYPE_USERDEFINED, true
+	case "WEBCAST":
+		return DistributionChannelType_DISTRIBUTION_CHANNEL_TYPE_WEBCAST, true
 	default:
 		return DistributionChannelType(0), false
 	}
@@ -585,6 +7011,12 @@ func ParseDistributionChannelTypeString(s string) (DistributionChannelType, bool
 // XMLString returns the XML string representation of DpidStatus
 func (e DpidStatus) XMLString() string {
 	switch e {
+	case DpidStatus_DPID_STATUS_ACTIVE:
+		return "ACTIVE"
+	case DpidStatus_DPID_STATUS_DELETED:
+		return "DELETED"
+	case DpidStatus_DPID_STATUS_REPLACED:
+		return "REPLACED"
 	default:
 		return ""
 	}
@@ -594,6 +7026,12 @@ func (e DpidStatus) XMLString() string {
 func ParseDpidStatusString(s string) (DpidStatus, bool) {
 	s = strings.ToUpper(s)
 	switch s {
+	case "ACTIVE":
+		return DpidStatus_DPID_STATUS_ACTIVE, true
+	case "DELETED":
+		return DpidStatus_DPID_STATUS_DELETED, true
+	case "REPLACED":
+		return DpidStatus_DPID_STATUS_REPLACED, true
 	default:
 		return DpidStatus(0), false
 	}
@@ -602,6 +7040,10 @@ func ParseDpidStatusString(s string) (DpidStatus, bool) {
 // XMLString returns the XML string representation of DrmEnforcementType
 func (e DrmEnforcementType) XMLString() string {
 	switch e {
+	case DrmEnforcementType_DRM_ENFORCEMENT_TYPE_DRMENFORCED:
+		return "DRMENFORCED"
+	case DrmEnforcementType_DRM_ENFORCEMENT_TYPE_NOTDRMENFORCED:
+		return "NOTDRMENFORCED"
 	default:
 		return ""
 	}
@@ -611,6 +7053,10 @@ func (e DrmEnforcementType) XMLString() string {
 func ParseDrmEnforcementTypeString(s string) (DrmEnforcementType, bool) {
 	s = strings.ToUpper(s)
 	switch s {
+	case "DRMENFORCED":
+		return DrmEnforcementType_DRM_ENFORCEMENT_TYPE_DRMENFORCED, true
+	case "NOTDRMENFORCED":
+		return DrmEnforcementType_DRM_ENFORCEMENT_TYPE_NOTDRMENFORCED, true
 	default:
 		return DrmEnforcementType(0), false
 	}
@@ -619,6 +7065,18 @@ func ParseDrmEnforcementTypeString(s string) (DrmEnforcementType, bool) {
 // XMLString returns the XML string representation of DrmPlatformType
 func (e DrmPlatformType) XMLString() string {
 	switch e {
+	case DrmPlatformType_DRM_PLATFORM_TYPE_E_3DAY:
+		return "3DAY"
+	case DrmPlatformType_DRM_PLATFORM_TYPE_FAIRPLAY:
+		return "FAIRPLAY"
+	case DrmPlatformType_DRM_PLATFORM_TYPE_OMA:
+		return "OMA"
+	case DrmPlatformType_DRM_PLATFORM_TYPE_UNKNOWN:
+		return "UNKNOWN"
+	case DrmPlatformType_DRM_PLATFORM_TYPE_USERDEFINED:
+		return "USERDEFINED"
+	case DrmPlatformType_DRM_PLATFORM_TYPE_WINDOWSMEDIADRM:
+		return "WINDOWSMEDIADRM"
 	default:
 		return ""
 	}
@@ -628,6 +7086,18 @@ func (e DrmPlatformType) XMLString() string {
 func ParseDrmPlatformTypeString(s string) (DrmPlatformType, bool) {
 	s = strings.ToUpper(s)
 	switch s {
+	case "3DAY":
+		return DrmPlatformType_DRM_PLATFORM_TYPE_E_3DAY, true
+	case "FAIRPLAY":
+		return DrmPlatformType_DRM_PLATFORM_TYPE_FAIRPLAY, true
+	case "OMA":
+		return DrmPlatformType_DRM_PLATFORM_TYPE_OMA, true
+	case "UNKNOWN":
+		return DrmPlatformType_DRM_PLATFORM_TYPE_UNKNOWN, true
+	case "USERDEFINED":
+		return DrmPlatformType_DRM_PLATFORM_TYPE_USERDEFINED, true
+	case "WINDOWSMEDIADRM":
+		return DrmPlatformType_DRM_PLATFORM_TYPE_WINDOWSMEDIADRM, true
 	default:
 		return DrmPlatformType(0), false
 	}
@@ -636,6 +7106,10 @@ func ParseDrmPlatformTypeString(s string) (DrmPlatformType, bool) {
 // XMLString returns the XML string representation of DsrMessageType
 func (e DsrMessageType) XMLString() string {
 	switch e {
+	case DsrMessageType_DSR_MESSAGE_TYPE_SALESREPORTTORECORDCOMPANYMESSAGE:
+		return "SALESREPORTTORECORDCOMPANYMESSAGE"
+	case DsrMessageType_DSR_MESSAGE_TYPE_SALESREPORTTOSOCIETYMESSAGE:
+		return "SALESREPORTTOSOCIETYMESSAGE"
 	default:
 		return ""
 	}
@@ -645,6 +7119,10 @@ func (e DsrMessageType) XMLString() string {
 func ParseDsrMessageTypeString(s string) (DsrMessageType, bool) {
 	s = strings.ToUpper(s)
 	switch s {
+	case "SALESREPORTTORECORDCOMPANYMESSAGE":
+		return DsrMessageType_DSR_MESSAGE_TYPE_SALESREPORTTORECORDCOMPANYMESSAGE, true
+	case "SALESREPORTTOSOCIETYMESSAGE":
+		return DsrMessageType_DSR_MESSAGE_TYPE_SALESREPORTTOSOCIETYMESSAGE, true
 	default:
 		return DsrMessageType(0), false
 	}
@@ -653,6 +7131,20 @@ func ParseDsrMessageTypeString(s string) (DsrMessageType, bool) {
 // XMLString returns the XML string representation of EquipmentType
 func (e EquipmentType) XMLString() string {
 	switch e {
+	case EquipmentType_EQUIPMENT_TYPE_COMPUTER:
+		return "COMPUTER"
+	case EquipmentType_EQUIPMENT_TYPE_MICROPHONE:
+		return "MICROPHONE"
+	case EquipmentType_EQUIPMENT_TYPE_RECORDER:
+		return "RECORDER"
+	case EquipmentType_EQUIPMENT_TYPE_SIGNALPROCESSOR:
+		return "SIGNALPROCESSOR"
+	case EquipmentType_EQUIPMENT_TYPE_SOFTWARE:
+		return "SOFTWARE"
+	case EquipmentType_EQUIPMENT_TYPE_LOUDSPEAKER:
+		return "LOUDSPEAKER"
+	case EquipmentType_EQUIPMENT_TYPE_MUSICALINSTRUMENT:
+		return "MUSICALINSTRUMENT"
 	default:
 		return ""
 	}
@@ -662,6 +7154,20 @@ func (e EquipmentType) XMLString() string {
 func ParseEquipmentTypeString(s string) (EquipmentType, bool) {
 	s = strings.ToUpper(s)
 	switch s {
+	case "COMPUTER":
+		return EquipmentType_EQUIPMENT_TYPE_COMPUTER, true
+	case "MICROPHONE":
+		return EquipmentType_EQUIPMENT_TYPE_MICROPHONE, true
+	case "RECORDER":
+		return EquipmentType_EQUIPMENT_TYPE_RECORDER, true
+	case "SIGNALPROCESSOR":
+		return EquipmentType_EQUIPMENT_TYPE_SIGNALPROCESSOR, true
+	case "SOFTWARE":
+		return EquipmentType_EQUIPMENT_TYPE_SOFTWARE, true
+	case "LOUDSPEAKER":
+		return EquipmentType_EQUIPMENT_TYPE_LOUDSPEAKER, true
+	case "MUSICALINSTRUMENT":
+		return EquipmentType_EQUIPMENT_TYPE_MUSICALINSTRUMENT, true
 	default:
 		return EquipmentType(0), false
 	}
@@ -670,6 +7176,8 @@ func ParseEquipmentTypeString(s string) (EquipmentType, bool) {
 // XMLString returns the XML string representation of ErnMessageType
 func (e ErnMessageType) XMLString() string {
 	switch e {
+	case ErnMessageType_ERN_MESSAGE_TYPE_NEWRELEASEMESSAGE:
+		return "NEWRELEASEMESSAGE"
 	default:
 		return ""
 	}
@@ -679,6 +7187,8 @@ func (e ErnMessageType) XMLString() string {
 func ParseErnMessageTypeString(s string) (ErnMessageType, bool) {
 	s = strings.ToUpper(s)
 	switch s {
+	case "NEWRELEASEMESSAGE":
+		return ErnMessageType_ERN_MESSAGE_TYPE_NEWRELEASEMESSAGE, true
 	default:
 		return ErnMessageType(0), false
 	}
@@ -687,6 +7197,48 @@ func ParseErnMessageTypeString(s string) (ErnMessageType, bool) {
 // XMLString returns the XML string representation of ErncFileStatus
 func (e ErncFileStatus) XMLString() string {
 	switch e {
+	case ErncFileStatus_ERNC_FILE_STATUS_ARTISTROLEUNKNOWN:
+		return "ARTISTROLEUNKNOWN"
+	case ErncFileStatus_ERNC_FILE_STATUS_COMMERCIALRELEASEDATEINVALID:
+		return "COMMERCIALRELEASEDATEINVALID"
+	case ErncFileStatus_ERNC_FILE_STATUS_CONFLICTINGAVAILABILITYPERIODS:
+		return "CONFLICTINGAVAILABILITYPERIODS"
+	case ErncFileStatus_ERNC_FILE_STATUS_DUPLICATEDPUBLISHERNAMES:
+		return "DUPLICATEDPUBLISHERNAMES"
+	case ErncFileStatus_ERNC_FILE_STATUS_ERNMISSING:
+		return "ERNMISSING"
+	case ErncFileStatus_ERNC_FILE_STATUS_FILEOK:
+		return "FILEOK"
+	case ErncFileStatus_ERNC_FILE_STATUS_IDENTIFIERINVALID:
+		return "IDENTIFIERINVALID"
+	case ErncFileStatus_ERNC_FILE_STATUS_IDENTIFIERSYNTAXINVALID:
+		return "IDENTIFIERSYNTAXINVALID"
+	case ErncFileStatus_ERNC_FILE_STATUS_INTERNALERROR:
+		return "INTERNALERROR"
+	case ErncFileStatus_ERNC_FILE_STATUS_METADATAMISSING:
+		return "METADATAMISSING"
+	case ErncFileStatus_ERNC_FILE_STATUS_NEWRELEASEMESSAGEINVALID:
+		return "NEWRELEASEMESSAGEINVALID"
+	case ErncFileStatus_ERNC_FILE_STATUS_NODEALFORTRACKRELEASE:
+		return "NODEALFORTRACKRELEASE"
+	case ErncFileStatus_ERNC_FILE_STATUS_NODEALINNEWRELEASEMESSAGE:
+		return "NODEALINNEWRELEASEMESSAGE"
+	case ErncFileStatus_ERNC_FILE_STATUS_ORIGINALRELEASEDATELATERTHANRELEASEDATE:
+		return "ORIGINALRELEASEDATELATERTHANRELEASEDATE"
+	case ErncFileStatus_ERNC_FILE_STATUS_PRIMARYARTISTNAMEMISSING:
+		return "PRIMARYARTISTNAMEMISSING"
+	case ErncFileStatus_ERNC_FILE_STATUS_RESOURCECORRUPT:
+		return "RESOURCECORRUPT"
+	case ErncFileStatus_ERNC_FILE_STATUS_RESOURCEMISSING:
+		return "RESOURCEMISSING"
+	case ErncFileStatus_ERNC_FILE_STATUS_RESOURCENOTMEETINGSPECIFICATIONS:
+		return "RESOURCENOTMEETINGSPECIFICATIONS"
+	case ErncFileStatus_ERNC_FILE_STATUS_SIGNATUREORHASHSUMWRONGORMISSING:
+		return "SIGNATUREORHASHSUMWRONGORMISSING"
+	case ErncFileStatus_ERNC_FILE_STATUS_UNSUPPORTEDUSAGE:
+		return "UNSUPPORTEDUSAGE"
+	case ErncFileStatus_ERNC_FILE_STATUS_USERDEFINED:
+		return "USERDEFINED"
 	default:
 		return ""
 	}
@@ -696,6 +7248,48 @@ func (e ErncFileStatus) XMLString() string {
 func ParseErncFileStatusString(s string) (ErncFileStatus, bool) {
 	s = strings.ToUpper(s)
 	switch s {
+	case "ARTISTROLEUNKNOWN":
+		return ErncFileStatus_ERNC_FILE_STATUS_ARTISTROLEUNKNOWN, true
+	case "COMMERCIALRELEASEDATEINVALID":
+		return ErncFileStatus_ERNC_FILE_STATUS_COMMERCIALRELEASEDATEINVALID, true
+	case "CONFLICTINGAVAILABILITYPERIODS":
+		return ErncFileStatus_ERNC_FILE_STATUS_CONFLICTINGAVAILABILITYPERIODS, true
+	case "DUPLICATEDPUBLISHERNAMES":
+		return ErncFileStatus_ERNC_FILE_STATUS_DUPLICATEDPUBLISHERNAMES, true
+	case "ERNMISSING":
+		return ErncFileStatus_ERNC_FILE_STATUS_ERNMISSING, true
+	case "FILEOK":
+		return ErncFileStatus_ERNC_FILE_STATUS_FILEOK, true
+	case "IDENTIFIERINVALID":
+		return ErncFileStatus_ERNC_FILE_STATUS_IDENTIFIERINVALID, true
+	case "IDENTIFIERSYNTAXINVALID":
+		return ErncFileStatus_ERNC_FILE_STATUS_IDENTIFIERSYNTAXINVALID, true
+	case "INTERNALERROR":
+		return ErncFileStatus_ERNC_FILE_STATUS_INTERNALERROR, true
+	case "METADATAMISSING":
+		return ErncFileStatus_ERNC_FILE_STATUS_METADATAMISSING, true
+	case "NEWRELEASEMESSAGEINVALID":
+		return ErncFileStatus_ERNC_FILE_STATUS_NEWRELEASEMESSAGEINVALID, true
+	case "NODEALFORTRACKRELEASE":
+		return ErncFileStatus_ERNC_FILE_STATUS_NODEALFORTRACKRELEASE, true
+	case "NODEALINNEWRELEASEMESSAGE":
+		return ErncFileStatus_ERNC_FILE_STATUS_NODEALINNEWRELEASEMESSAGE, true
+	case "ORIGINALRELEASEDATELATERTHANRELEASEDATE":
+		return ErncFileStatus_ERNC_FILE_STATUS_ORIGINALRELEASEDATELATERTHANRELEASEDATE, true
+	case "PRIMARYARTISTNAMEMISSING":
+		return ErncFileStatus_ERNC_FILE_STATUS_PRIMARYARTISTNAMEMISSING, true
+	case "RESOURCECORRUPT":
+		return ErncFileStatus_ERNC_FILE_STATUS_RESOURCECORRUPT, true
+	case "RESOURCEMISSING":
+		return ErncFileStatus_ERNC_FILE_STATUS_RESOURCEMISSING, true
+	case "RESOURCENOTMEETINGSPECIFICATIONS":
+		return ErncFileStatus_ERNC_FILE_STATUS_RESOURCENOTMEETINGSPECIFICATIONS, true
+	case "SIGNATUREORHASHSUMWRONGORMISSING":
+		return ErncFileStatus_ERNC_FILE_STATUS_SIGNATUREORHASHSUMWRONGORMISSING, true
+	case "UNSUPPORTEDUSAGE":
+		return ErncFileStatus_ERNC_FILE_STATUS_UNSUPPORTEDUSAGE, true
+	case "USERDEFINED":
+		return ErncFileStatus_ERNC_FILE_STATUS_USERDEFINED, true
 	default:
 		return ErncFileStatus(0), false
 	}
@@ -704,6 +7298,16 @@ func ParseErncFileStatusString(s string) (ErncFileStatus, bool) {
 // XMLString returns the XML string representation of ErncProposedActionType
 func (e ErncProposedActionType) XMLString() string {
 	switch e {
+	case ErncProposedActionType_ERNC_PROPOSED_ACTION_TYPE_RESENDXMLONLY:
+		return "RESENDXMLONLY"
+	case ErncProposedActionType_ERNC_PROPOSED_ACTION_TYPE_RESENDXMLANDRESOURCES:
+		return "RESENDXMLANDRESOURCES"
+	case ErncProposedActionType_ERNC_PROPOSED_ACTION_TYPE_USERDEFINED:
+		return "USERDEFINED"
+	case ErncProposedActionType_ERNC_PROPOSED_ACTION_TYPE_DONOTRESENDAFFECTEDRESOURCE:
+		return "DONOTRESENDAFFECTEDRESOURCE"
+	case ErncProposedActionType_ERNC_PROPOSED_ACTION_TYPE_DONOTRESENDRELEASE:
+		return "DONOTRESENDRELEASE"
 	default:
 		return ""
 	}
@@ -713,6 +7317,16 @@ func (e ErncProposedActionType) XMLString() string {
 func ParseErncProposedActionTypeString(s string) (ErncProposedActionType, bool) {
 	s = strings.ToUpper(s)
 	switch s {
+	case "RESENDXMLONLY":
+		return ErncProposedActionType_ERNC_PROPOSED_ACTION_TYPE_RESENDXMLONLY, true
+	case "RESENDXMLANDRESOURCES":
+		return ErncProposedActionType_ERNC_PROPOSED_ACTION_TYPE_RESENDXMLANDRESOURCES, true
+	case "USERDEFINED":
+		return ErncProposedActionType_ERNC_PROPOSED_ACTION_TYPE_USERDEFINED, true
+	case "DONOTRESENDAFFECTEDRESOURCE":
+		return ErncProposedActionType_ERNC_PROPOSED_ACTION_TYPE_DONOTRESENDAFFECTEDRESOURCE, true
+	case "DONOTRESENDRELEASE":
+		return ErncProposedActionType_ERNC_PROPOSED_ACTION_TYPE_DONOTRESENDRELEASE, true
 	default:
 		return ErncProposedActionType(0), false
 	}
@@ -721,6 +7335,10 @@ func ParseErncProposedActionTypeString(s string) (ErncProposedActionType, bool)
 // XMLString returns the XML string representation of ExpressionType
 func (e ExpressionType) XMLString() string {
 	switch e {
+	case ExpressionType_EXPRESSION_TYPE_INFORMATIVE:
+		return "INFORMATIVE"
+	case ExpressionType_EXPRESSION_TYPE_INSTRUCTIVE:
+		return "INSTRUCTIVE"
 	default:
 		return ""
 	}
@@ -730,6 +7348,10 @@ func (e ExpressionType) XMLString() string {
 func ParseExpressionTypeString(s string) (ExpressionType, bool) {
 	s = strings.ToUpper(s)
 	switch s {
+	case "INFORMATIVE":
+		return ExpressionType_EXPRESSION_TYPE_INFORMATIVE, true
+	case "INSTRUCTIVE":
+		return ExpressionType_EXPRESSION_TYPE_INSTRUCTIVE, true
 	default:
 		return ExpressionType(0), false
 	}
@@ -738,6 +7360,20 @@ func ParseExpressionTypeString(s string) (ExpressionType, bool) {
 // XMLString returns the XML string representation of ExternallyLinkedResourceType
 func (e ExternallyLinkedResourceType) XMLString() string {
 	switch e {
+	case ExternallyLinkedResourceType_EXTERNALLY_LINKED_RESOURCE_TYPE_ADDITIONALMETADATA:
+		return "ADDITIONALMETADATA"
+	case ExternallyLinkedResourceType_EXTERNALLY_LINKED_RESOURCE_TYPE_LOGO:
+		return "LOGO"
+	case ExternallyLinkedResourceType_EXTERNALLY_LINKED_RESOURCE_TYPE_PROMOTIONALIMAGE:
+		return "PROMOTIONALIMAGE"
+	case ExternallyLinkedResourceType_EXTERNALLY_LINKED_RESOURCE_TYPE_PROMOTIONALINFORMATION:
+		return "PROMOTIONALINFORMATION"
+	case ExternallyLinkedResourceType_EXTERNALLY_LINKED_RESOURCE_TYPE_PROMOTIONALITEM:
+		return "PROMOTIONALITEM"
+	case ExternallyLinkedResourceType_EXTERNALLY_LINKED_RESOURCE_TYPE_UNKNOWN:
+		return "UNKNOWN"
+	case ExternallyLinkedResourceType_EXTERNALLY_LINKED_RESOURCE_TYPE_USERDEFINED:
+		return "USERDEFINED"
 	default:
 		return ""
 	}
@@ -747,6 +7383,20 @@ func (e ExternallyLinkedResourceType) XMLString() string {
 func ParseExternallyLinkedResourceTypeString(s string) (ExternallyLinkedResourceType, bool) {
 	s = strings.ToUpper(s)
 	switch s {
+	case "ADDITIONALMETADATA":
+		return ExternallyLinkedResourceType_EXTERNALLY_LINKED_RESOURCE_TYPE_ADDITIONALMETADATA, true
+	case "LOGO":
+		return ExternallyLinkedResourceType_EXTERNALLY_LINKED_RESOURCE_TYPE_LOGO, true
+	case "PROMOTIONALIMAGE":
+		return ExternallyLinkedResourceType_EXTERNALLY_LINKED_RESOURCE_TYPE_PROMOTIONALIMAGE, true
+	case "PROMOTIONALINFORMATION":
+		return ExternallyLinkedResourceType_EXTERNALLY_LINKED_RESOURCE_TYPE_PROMOTIONALINFORMATION, true
+	case "PROMOTIONALITEM":
+		return ExternallyLinkedResourceType_EXTERNALLY_LINKED_RESOURCE_TYPE_PROMOTIONALITEM, true
+	case "UNKNOWN":
+		return ExternallyLinkedResourceType_EXTERNALLY_LINKED_RESOURCE_TYPE_UNKNOWN, true
+	case "USERDEFINED":
+		return ExternallyLinkedResourceType_EXTERNALLY_LINKED_RESOURCE_TYPE_USERDEFINED, true
 	default:
 		return ExternallyLinkedResourceType(0), false
 	}
@@ -755,6 +7405,14 @@ func ParseExternallyLinkedResourceTypeString(s string) (ExternallyLinkedResource
 // XMLString returns the XML string representation of FileStatus
 func (e FileStatus) XMLString() string {
 	switch e {
+	case FileStatus_FILE_STATUS_FILEMISSING:
+		return "FILEMISSING"
+	case FileStatus_FILE_STATUS_FILEOK:
+		return "FILEOK"
+	case FileStatus_FILE_STATUS_HASHSUMWRONG:
+		return "HASHSUMWRONG"
+	case FileStatus_FILE_STATUS_SIGNATUREWRONG:
+		return "SIGNATUREWRONG"
 	default:
 		return ""
 	}
@@ -764,6 +7422,14 @@ func (e FileStatus) XMLString() string {
 func ParseFileStatusString(s string) (FileStatus, bool) {
 	s = strings.ToUpper(s)
 	switch s {
+	case "FILEMISSING":
+		return FileStatus_FILE_STATUS_FILEMISSING, true
+	case "FILEOK":
+		return FileStatus_FILE_STATUS_FILEOK, true
+	case "HASHSUMWRONG":
+		return FileStatus_FILE_STATUS_HASHSUMWRONG, true
+	case "SIGNATUREWRONG":
+		return FileStatus_FILE_STATUS_SIGNATUREWRONG, true
 	default:
 		return FileStatus(0), false
 	}
@@ -772,6 +7438,8 @@ func ParseFileStatusString(s string) (FileStatus, bool) {
 // XMLString returns the XML string representation of FingerprintAlgorithmType
 func (e FingerprintAlgorithmType) XMLString() string {
 	switch e {
+	case FingerprintAlgorithmType_FINGERPRINT_ALGORITHM_TYPE_USERDEFINED:
+		return "USERDEFINED"
 	default:
 		return ""
 	}
@@ -781,6 +7449,8 @@ func (e FingerprintAlgorithmType) XMLString() string {
 func ParseFingerprintAlgorithmTypeString(s string) (FingerprintAlgorithmType, bool) {
 	s = strings.ToUpper(s)
 	switch s {
+	case "USERDEFINED":
+		return FingerprintAlgorithmType_FINGERPRINT_ALGORITHM_TYPE_USERDEFINED, true
 	default:
 		return FingerprintAlgorithmType(0), false
 	}
@@ -789,6 +7459,10 @@ func ParseFingerprintAlgorithmTypeString(s string) (FingerprintAlgorithmType, bo
 // XMLString returns the XML string representation of GoverningAgreementType
 func (e GoverningAgreementType) XMLString() string {
 	switch e {
+	case GoverningAgreementType_GOVERNING_AGREEMENT_TYPE_USERDEFINED:
+		return "USERDEFINED"
+	case GoverningAgreementType_GOVERNING_AGREEMENT_TYPE_SESSIONMUSICUNIONAGREEMENT:
+		return "SESSIONMUSICUNIONAGREEMENT"
 	default:
 		return ""
 	}
@@ -798,6 +7472,10 @@ func (e GoverningAgreementType) XMLString() string {
 func ParseGoverningAgreementTypeString(s string) (GoverningAgreementType, bool) {
 	s = strings.ToUpper(s)
 	switch s {
+	case "USERDEFINED":
+		return GoverningAgreementType_GOVERNING_AGREEMENT_TYPE_USERDEFINED, true
+	case "SESSIONMUSICUNIONAGREEMENT":
+		return GoverningAgreementType_GOVERNING_AGREEMENT_TYPE_SESSIONMUSICUNIONAGREEMENT, true
 	default:
 		return GoverningAgreementType(0), false
 	}
@@ -806,6 +7484,38 @@ func ParseGoverningAgreementTypeString(s string) (GoverningAgreementType, bool)
 // XMLString returns the XML string representation of HashSumAlgorithmType
 func (e HashSumAlgorithmType) XMLString() string {
 	switch e {
+	case HashSumAlgorithmType_HASH_SUM_ALGORITHM_TYPE_MD4:
+		return "MD4"
+	case HashSumAlgorithmType_HASH_SUM_ALGORITHM_TYPE_MD5:
+		return "MD5"
+	case HashSumAlgorithmType_HASH_SUM_ALGORITHM_TYPE_SHA:
+		return "SHA"
+	case HashSumAlgorithmType_HASH_SUM_ALGORITHM_TYPE_SHA1:
+		return "SHA1"
+	case HashSumAlgorithmType_HASH_SUM_ALGORITHM_TYPE_USERDEFINED:
+		return "USERDEFINED"
+	case HashSumAlgorithmType_HASH_SUM_ALGORITHM_TYPE_CRC32:
+		return "CRC32"
+	case HashSumAlgorithmType_HASH_SUM_ALGORITHM_TYPE_MD2:
+		return "MD2"
+	case HashSumAlgorithmType_HASH_SUM_ALGORITHM_TYPE_MD4_MLNET:
+		return "MD4_MLNET"
+	case HashSumAlgorithmType_HASH_SUM_ALGORITHM_TYPE_MDC2:
+		return "MDC2"
+	case HashSumAlgorithmType_HASH_SUM_ALGORITHM_TYPE_RMD160:
+		return "RMD160"
+	case HashSumAlgorithmType_HASH_SUM_ALGORITHM_TYPE_SHA2:
+		return "SHA2"
+	case HashSumAlgorithmType_HASH_SUM_ALGORITHM_TYPE_SHA_224:
+		return "SHA_224"
+	case HashSumAlgorithmType_HASH_SUM_ALGORITHM_TYPE_SHA_256:
+		return "SHA_256"
+	case HashSumAlgorithmType_HASH_SUM_ALGORITHM_TYPE_SHA3:
+		return "SHA3"
+	case HashSumAlgorithmType_HASH_SUM_ALGORITHM_TYPE_SHA_384:
+		return "SHA_384"
+	case HashSumAlgorithmType_HASH_SUM_ALGORITHM_TYPE_SHA_512:
+		return "SHA_512"
 	default:
 		return ""
 	}
@@ -815,6 +7525,38 @@ func (e HashSumAlgorithmType) XMLString() string {
 func ParseHashSumAlgorithmTypeString(s string) (HashSumAlgorithmType, bool) {
 	s = strings.ToUpper(s)
 	switch s {
+	case "MD4":
+		return HashSumAlgorithmType_HASH_SUM_ALGORITHM_TYPE_MD4, true
+	case "MD5":
+		return HashSumAlgorithmType_HASH_SUM_ALGORITHM_TYPE_MD5, true
+	case "SHA":
+		return HashSumAlgorithmType_HASH_SUM_ALGORITHM_TYPE_SHA, true
+	case "SHA1":
+		return HashSumAlgorithmType_HASH_SUM_ALGORITHM_TYPE_SHA1, true
+	case "USERDEFINED":
+		return HashSumAlgorithmType_HASH_SUM_ALGORITHM_TYPE_USERDEFINED, true
+	case "CRC32":
+		return HashSumAlgorithmType_HASH_SUM_ALGORITHM_TYPE_CRC32, true
+	case "MD2":
+		return HashSumAlgorithmType_HASH_SUM_ALGORITHM_TYPE_MD2, true
+	case "MD4_MLNET":
+		return HashSumAlgorithmType_HASH_SUM_ALGORITHM_TYPE_MD4_MLNET, true
+	case "MDC2":
+		return HashSumAlgorithmType_HASH_SUM_ALGORITHM_TYPE_MDC2, true
+	case "RMD160":
+		return HashSumAlgorithmType_HASH_SUM_ALGORITHM_TYPE_RMD160, true
+	case "SHA2":
+		return HashSumAlgorithmType_HASH_SUM_ALGORITHM_TYPE_SHA2, true
+	case "SHA_224":
+		return HashSumAlgorithmType_HASH_SUM_ALGORITHM_TYPE_SHA_224, true
+	case "SHA_256":
+		return HashSumAlgorithmType_HASH_SUM_ALGORITHM_TYPE_SHA_256, true
+	case "SHA3":
+		return HashSumAlgorithmType_HASH_SUM_ALGORITHM_TYPE_SHA3, true
+	case "SHA_384":
+		return HashSumAlgorithmType_HASH_SUM_ALGORITHM_TYPE_SHA_384, true
+	case "SHA_512":
+		return HashSumAlgorithmType_HASH_SUM_ALGORITHM_TYPE_SHA_512, true
 	default:
 		return HashSumAlgorithmType(0), false
 	}
@@ -823,6 +7565,20 @@ func ParseHashSumAlgorithmTypeString(s string) (HashSumAlgorithmType, bool) {
 // XMLString returns the XML string representation of ImageCodecType
 func (e ImageCodecType) XMLString() string {
 	switch e {
+	case ImageCodecType_IMAGE_CODEC_TYPE_GIF:
+		return "GIF"
+	case ImageCodecType_IMAGE_CODEC_TYPE_JPEG:
+		return "JPEG"
+	case ImageCodecType_IMAGE_CODEC_TYPE_JPEG2000:
+		return "JPEG2000"
+	case ImageCodecType_IMAGE_CODEC_TYPE_PNG:
+		return "PNG"
+	case ImageCodecType_IMAGE_CODEC_TYPE_TIFF:
+		return "TIFF"
+	case ImageCodecType_IMAGE_CODEC_TYPE_UNKNOWN:
+		return "UNKNOWN"
+	case ImageCodecType_IMAGE_CODEC_TYPE_USERDEFINED:
+		return "USERDEFINED"
 	default:
 		return ""
 	}
@@ -832,6 +7588,20 @@ func (e ImageCodecType) XMLString() string {
 func ParseImageCodecTypeString(s string) (ImageCodecType, bool) {
 	s = strings.ToUpper(s)
 	switch s {
+	case "GIF":
+		return ImageCodecType_IMAGE_CODEC_TYPE_GIF, true
+	case "JPEG":
+		return ImageCodecType_IMAGE_CODEC_TYPE_JPEG, true
+	case "JPEG2000":
+		return ImageCodecType_IMAGE_CODEC_TYPE_JPEG2000, true
+	case "PNG":
+		return ImageCodecType_IMAGE_CODEC_TYPE_PNG, true
+	case "TIFF":
+		return ImageCodecType_IMAGE_CODEC_TYPE_TIFF, true
+	case "UNKNOWN":
+		return ImageCodecType_IMAGE_CODEC_TYPE_UNKNOWN, true
+	case "USERDEFINED":
+		return ImageCodecType_IMAGE_CODEC_TYPE_USERDEFINED, true
 	default:
 		return ImageCodecType(0), false
 	}
@@ -840,6 +7610,36 @@ func ParseImageCodecTypeString(s string) (ImageCodecType, bool) {
 // XMLString returns the XML string representation of ImageType
 func (e ImageType) XMLString() string {
 	switch e {
+	case ImageType_IMAGE_TYPE_BACKCOVERIMAGE:
+		return "BACKCOVERIMAGE"
+	case ImageType_IMAGE_TYPE_BOOKLETBACKIMAGE:
+		return "BOOKLETBACKIMAGE"
+	case ImageType_IMAGE_TYPE_BOOKLETFRONTIMAGE:
+		return "BOOKLETFRONTIMAGE"
+	case ImageType_IMAGE_TYPE_DOCUMENTIMAGE:
+		return "DOCUMENTIMAGE"
+	case ImageType_IMAGE_TYPE_FRONTCOVERIMAGE:
+		return "FRONTCOVERIMAGE"
+	case ImageType_IMAGE_TYPE_ICON:
+		return "ICON"
+	case ImageType_IMAGE_TYPE_LOGO:
+		return "LOGO"
+	case ImageType_IMAGE_TYPE_PHOTOGRAPH:
+		return "PHOTOGRAPH"
+	case ImageType_IMAGE_TYPE_POSTER:
+		return "POSTER"
+	case ImageType_IMAGE_TYPE_TRAYIMAGE:
+		return "TRAYIMAGE"
+	case ImageType_IMAGE_TYPE_UNKNOWN:
+		return "UNKNOWN"
+	case ImageType_IMAGE_TYPE_USERDEFINED:
+		return "USERDEFINED"
+	case ImageType_IMAGE_TYPE_VIDEOSCREENCAPTURE:
+		return "VIDEOSCREENCAPTURE"
+	case ImageType_IMAGE_TYPE_WALLPAPER:
+		return "WALLPAPER"
+	case ImageType_IMAGE_TYPE_PORTRAIT:
+		return "PORTRAIT"
 	default:
 		return ""
 	}
@@ -849,6 +7649,36 @@ func (e ImageType) XMLString() string {
 func ParseImageTypeString(s string) (ImageType, bool) {
 	s = strings.ToUpper(s)
 	switch s {
+	case "BACKCOVERIMAGE":
+		return ImageType_IMAGE_TYPE_BACKCOVERIMAGE, true
+	case "BOOKLETBACKIMAGE":
+		return ImageType_IMAGE_TYPE_BOOKLETBACKIMAGE, true
+	case "BOOKLETFRONTIMAGE":
+		return ImageType_IMAGE_TYPE_BOOKLETFRONTIMAGE, true
+	case "DOCUMENTIMAGE":
+		return ImageType_IMAGE_TYPE_DOCUMENTIMAGE, true
+	case "FRONTCOVERIMAGE":
+		return ImageType_IMAGE_TYPE_FRONTCOVERIMAGE, true
+	case "ICON":
+		return ImageType_IMAGE_TYPE_ICON, true
+	case "LOGO":
+		return ImageType_IMAGE_TYPE_LOGO, true
+	case "PHOTOGRAPH":
+		return ImageType_IMAGE_TYPE_PHOTOGRAPH, true
+	case "POSTER":
+		return ImageType_IMAGE_TYPE_POSTER, true
+	case "TRAYIMAGE":
+		return ImageType_IMAGE_TYPE_TRAYIMAGE, true
+	case "UNKNOWN":
+		return ImageType_IMAGE_TYPE_UNKNOWN, true
+	case "USERDEFINED":
+		return ImageType_IMAGE_TYPE_USERDEFINED, true
+	case "VIDEOSCREENCAPTURE":
+		return ImageType_IMAGE_TYPE_VIDEOSCREENCAPTURE, true
+	case "WALLPAPER":
+		return ImageType_IMAGE_TYPE_WALLPAPER, true
+	case "PORTRAIT":
+		return ImageType_IMAGE_TYPE_PORTRAIT, true
 	default:
 		return ImageType(0), false
 	}
@@ -857,6 +7687,10 @@ func ParseImageTypeString(s string) (ImageType, bool) {
 // XMLString returns the XML string representation of InvoiceAvailabilityStatus
 func (e InvoiceAvailabilityStatus) XMLString() string {
 	switch e {
+	case InvoiceAvailabilityStatus_INVOICE_AVAILABILITY_STATUS_INVOICEAVAILABLE:
+		return "INVOICEAVAILABLE"
+	case InvoiceAvailabilityStatus_INVOICE_AVAILABILITY_STATUS_INVOICENOTAVAILABLE:
+		return "INVOICENOTAVAILABLE"
 	default:
 		return ""
 	}
@@ -866,6 +7700,10 @@ func (e InvoiceAvailabilityStatus) XMLString() string {
 func ParseInvoiceAvailabilityStatusString(s string) (InvoiceAvailabilityStatus, bool) {
 	s = strings.ToUpper(s)
 	switch s {
+	case "INVOICEAVAILABLE":
+		return InvoiceAvailabilityStatus_INVOICE_AVAILABILITY_STATUS_INVOICEAVAILABLE, true
+	case "INVOICENOTAVAILABLE":
+		return InvoiceAvailabilityStatus_INVOICE_AVAILABILITY_STATUS_INVOICENOTAVAILABLE, true
 	default:
 		return InvoiceAvailabilityStatus(0), false
 	}
@@ -874,6 +7712,344 @@ func ParseInvoiceAvailabilityStatusString(s string) (InvoiceAvailabilityStatus,
 // XMLString returns the XML string representation of IsoCurrencyCode
 func (e IsoCurrencyCode) XMLString() string {
 	switch e {
+	case IsoCurrencyCode_ISO_CURRENCY_CODE_AED:
+		return "AED"
+	case IsoCurrencyCode_ISO_CURRENCY_CODE_AFN:
+		return "AFN"
+	case IsoCurrencyCode_ISO_CURRENCY_CODE_ALL:
+		return "ALL"
+	case IsoCurrencyCode_ISO_CURRENCY_CODE_AMD:
+		return "AMD"
+	case IsoCurrencyCode_ISO_CURRENCY_CODE_ANG:
+		return "ANG"
+	case IsoCurrencyCode_ISO_CURRENCY_CODE_AOA:
+		return "AOA"
+	case IsoCurrencyCode_ISO_CURRENCY_CODE_ARS:
+		return "ARS"
+	case IsoCurrencyCode_ISO_CURRENCY_CODE_AUD:
+		return "AUD"
+	case IsoCurrencyCode_ISO_CURRENCY_CODE_AWG:
+		return "AWG"
+	case IsoCurrencyCode_ISO_CURRENCY_CODE_AZN:
+		return "AZN"
+	case IsoCurrencyCode_ISO_CURRENCY_CODE_BAM:
+		return "BAM"
+	case IsoCurrencyCode_ISO_CURRENCY_CODE_BBD:
+		return "BBD"
+	case IsoCurrencyCode_ISO_CURRENCY_CODE_BDT:
+		return "BDT"
+	case IsoCurrencyCode_ISO_CURRENCY_CODE_BGN:
+		return "BGN"
+	case IsoCurrencyCode_ISO_CURRENCY_CODE_BHD:
+		return "BHD"
+	case IsoCurrencyCode_ISO_CURRENCY_CODE_BIF:
+		return "BIF"
+	case IsoCurrencyCode_ISO_CURRENCY_CODE_BMD:
+		return "BMD"
+	case IsoCurrencyCode_ISO_CURRENCY_CODE_BND:
+		return "BND"
+	case IsoCurrencyCode_ISO_CURRENCY_CODE_BOB:
+		return "BOB"
+	case IsoCurrencyCode_ISO_CURRENCY_CODE_BOV:
+		return "BOV"
+	case IsoCurrencyCode_ISO_CURRENCY_CODE_BRL:
+		return "BRL"
+	case IsoCurrencyCode_ISO_CURRENCY_CODE_BSD:
+		return "BSD"
+	case IsoCurrencyCode_ISO_CURRENCY_CODE_BTN:
+		return "BTN"
+	case IsoCurrencyCode_ISO_CURRENCY_CODE_BWP:
+		return "BWP"
+	case IsoCurrencyCode_ISO_CURRENCY_CODE_BYR:
+		return "BYR"
+	case IsoCurrencyCode_ISO_CURRENCY_CODE_BZD:
+		return "BZD"
+	case IsoCurrencyCode_ISO_CURRENCY_CODE_CAD:
+		return "CAD"
+	case IsoCurrencyCode_ISO_CURRENCY_CODE_CDF:
+		return "CDF"
+	case IsoCurrencyCode_ISO_CURRENCY_CODE_CHF:
+		return "CHF"
+	case IsoCurrencyCode_ISO_CURRENCY_CODE_CLF:
+		return "CLF"
+	case IsoCurrencyCode_ISO_CURRENCY_CODE_CLP:
+		return "CLP"
+	case IsoCurrencyCode_ISO_CURRENCY_CODE_CNY:
+		return "CNY"
+	case IsoCurrencyCode_ISO_CURRENCY_CODE_COP:
+		return "COP"
+	case IsoCurrencyCode_ISO_CURRENCY_CODE_COU:
+		return "COU"
+	case IsoCurrencyCode_ISO_CURRENCY_CODE_CRC:
+		return "CRC"
+	case IsoCurrencyCode_ISO_CURRENCY_CODE_CUC:
+		return "CUC"
+	case IsoCurrencyCode_ISO_CURRENCY_CODE_CUP:
+		return "CUP"
+	case IsoCurrencyCode_ISO_CURRENCY_CODE_CVE:
+		return "CVE"
+	case IsoCurrencyCode_ISO_CURRENCY_CODE_CZK:
+		return "CZK"
+	case IsoCurrencyCode_ISO_CURRENCY_CODE_DJF:
+		return "DJF"
+	case IsoCurrencyCode_ISO_CURRENCY_CODE_DKK:
+		return "DKK"
+	case IsoCurrencyCode_ISO_CURRENCY_CODE_DOP:
+		return "DOP"
+	case IsoCurrencyCode_ISO_CURRENCY_CODE_DZD:
+		return "DZD"
+	case IsoCurrencyCode_ISO_CURRENCY_CODE_EGP:
+		return "EGP"
+	case IsoCurrencyCode_ISO_CURRENCY_CODE_ERN:
+		return "ERN"
+	case IsoCurrencyCode_ISO_CURRENCY_CODE_ETB:
+		return "ETB"
+	case IsoCurrencyCode_ISO_CURRENCY_CODE_EUR:
+		return "EUR"
+	case IsoCurrencyCode_ISO_CURRENCY_CODE_FJD:
+		return "FJD"
+	case IsoCurrencyCode_ISO_CURRENCY_CODE_FKP:
+		return "FKP"
+	case IsoCurrencyCode_ISO_CURRENCY_CODE_GBP:
+		return "GBP"
+	case IsoCurrencyCode_ISO_CURRENCY_CODE_GEL:
+		return "GEL"
+	case IsoCurrencyCode_ISO_CURRENCY_CODE_GHS:
+		return "GHS"
+	case IsoCurrencyCode_ISO_CURRENCY_CODE_GIP:
+		return "GIP"
+	case IsoCurrencyCode_ISO_CURRENCY_CODE_GMD:
+		return "GMD"
+	case IsoCurrencyCode_ISO_CURRENCY_CODE_GNF:
+		return "GNF"
+	case IsoCurrencyCode_ISO_CURRENCY_CODE_GTQ:
+		return "GTQ"
+	case IsoCurrencyCode_ISO_CURRENCY_CODE_GYD:
+		return "GYD"
+	case IsoCurrencyCode_ISO_CURRENCY_CODE_HKD:
+		return "HKD"
+	case IsoCurrencyCode_ISO_CURRENCY_CODE_HNL:
+		return "HNL"
+	case IsoCurrencyCode_ISO_CURRENCY_CODE_HRK:
+		return "HRK"
+	case IsoCurrencyCode_ISO_CURRENCY_CODE_HTG:
+		return "HTG"
+	case IsoCurrencyCode_ISO_CURRENCY_CODE_HUF:
+		return "HUF"
+	case IsoCurrencyCode_ISO_CURRENCY_CODE_IDR:
+		return "IDR"
+	case IsoCurrencyCode_ISO_CURRENCY_CODE_ILS:
+		return "ILS"
+	case IsoCurrencyCode_ISO_CURRENCY_CODE_INR:
+		return "INR"
+	case IsoCurrencyCode_ISO_CURRENCY_CODE_IQD:
+		return "IQD"
+	case IsoCurrencyCode_ISO_CURRENCY_CODE_IRR:
+		return "IRR"
+	case IsoCurrencyCode_ISO_CURRENCY_CODE_ISK:
+		return "ISK"
+	case IsoCurrencyCode_ISO_CURRENCY_CODE_JMD:
+		return "JMD"
+	case IsoCurrencyCode_ISO_CURRENCY_CODE_JOD:
+		return "JOD"
+	case IsoCurrencyCode_ISO_CURRENCY_CODE_JPY:
+		return "JPY"
+	case IsoCurrencyCode_ISO_CURRENCY_CODE_KES:
+		return "KES"
+	case IsoCurrencyCode_ISO_CURRENCY_CODE_KGS:
+		return "KGS"
+	case IsoCurrencyCode_ISO_CURRENCY_CODE_KHR:
+		return "KHR"
+	case IsoCurrencyCode_ISO_CURRENCY_CODE_KMF:
+		return "KMF"
+	case IsoCurrencyCode_ISO_CURRENCY_CODE_KPW:
+		return "KPW"
+	case IsoCurrencyCode_ISO_CURRENCY_CODE_KRW:
+		return "KRW"
+	case IsoCurrencyCode_ISO_CURRENCY_CODE_KWD:
+		return "KWD"
+	case IsoCurrencyCode_ISO_CURRENCY_CODE_KYD:
+		return "KYD"
+	case IsoCurrencyCode_ISO_CURRENCY_CODE_KZT:
+		return "KZT"
+	case IsoCurrencyCode_ISO_CURRENCY_CODE_LAK:
+		return "LAK"
+	case IsoCurrencyCode_ISO_CURRENCY_CODE_LBP:
+		return "LBP"
+	case IsoCurrencyCode_ISO_CURRENCY_CODE_LKR:
+		return "LKR"
+	case IsoCurrencyCode_ISO_CURRENCY_CODE_LRD:
+		return "LRD"
+	case IsoCurrencyCode_ISO_CURRENCY_CODE_LSL:
+		return "LSL"
+	case IsoCurrencyCode_ISO_CURRENCY_CODE_LTL:
+		return "LTL"
+	case IsoCurrencyCode_ISO_CURRENCY_CODE_LVL:
+		return "LVL"
+	case IsoCurrencyCode_ISO_CURRENCY_CODE_LYD:
+		return "LYD"
+	case IsoCurrencyCode_ISO_CURRENCY_CODE_MAD:
+		return "MAD"
+	case IsoCurrencyCode_ISO_CURRENCY_CODE_MDL:
+		return "MDL"
+	case IsoCurrencyCode_ISO_CURRENCY_CODE_MGA:
+		return "MGA"
+	case IsoCurrencyCode_ISO_CURRENCY_CODE_MKD:
+		return "MKD"
+	case IsoCurrencyCode_ISO_CURRENCY_CODE_MMK:
+		return "MMK"
+	case IsoCurrencyCode_ISO_CURRENCY_CODE_MNT:
+		return "MNT"
+	case IsoCurrencyCode_ISO_CURRENCY_CODE_MOP:
+		return "MOP"
+	case IsoCurrencyCode_ISO_CURRENCY_CODE_MRO:
+		return "MRO"
+	case IsoCurrencyCode_ISO_CURRENCY_CODE_MUR:
+		return "MUR"
+	case IsoCurrencyCode_ISO_CURRENCY_CODE_MVR:
+		return "MVR"
+	case IsoCurrencyCode_ISO_CURRENCY_CODE_MWK:
+		return "MWK"
+	case IsoCurrencyCode_ISO_CURRENCY_CODE_MXN:
+		return "MXN"
+	case IsoCurrencyCode_ISO_CURRENCY_CODE_MXV:
+		return "MXV"
+	case IsoCurrencyCode_ISO_CURRENCY_CODE_MYR:
+		return "MYR"
+	case IsoCurrencyCode_ISO_CURRENCY_CODE_MZM:
+		return "MZM"
+	case IsoCurrencyCode_ISO_CURRENCY_CODE_NAD:
+		return "NAD"
+	case IsoCurrencyCode_ISO_CURRENCY_CODE_NGN:
+		return "NGN"
+	case IsoCurrencyCode_ISO_CURRENCY_CODE_NIO:
+		return "NIO"
+	case IsoCurrencyCode_ISO_CURRENCY_CODE_NOK:
+		return "NOK"
+	case IsoCurrencyCode_ISO_CURRENCY_CODE_NPR:
+		return "NPR"
+	case IsoCurrencyCode_ISO_CURRENCY_CODE_NZD:
+		return "NZD"
+	case IsoCurrencyCode_ISO_CURRENCY_CODE_OMR:
+		return "OMR"
+	case IsoCurrencyCode_ISO_CURRENCY_CODE_PAB:
+		return "PAB"
+	case IsoCurrencyCode_ISO_CURRENCY_CODE_PEN:
+		return "PEN"
+	case IsoCurrencyCode_ISO_CURRENCY_CODE_PGK:
+		return "PGK"
+	case IsoCurrencyCode_ISO_CURRENCY_CODE_PHP:
+		return "PHP"
+	case IsoCurrencyCode_ISO_CURRENCY_CODE_PKR:
+		return "PKR"
+	case IsoCurrencyCode_ISO_CURRENCY_CODE_PLN:
+		return "PLN"
+	case IsoCurrencyCode_ISO_CURRENCY_CODE_PYG:
+		return "PYG"
+	case IsoCurrencyCode_ISO_CURRENCY_CODE_QAR:
+		return "QAR"
+	case IsoCurrencyCode_ISO_CURRENCY_CODE_RON:
+		return "RON"
+	case IsoCurrencyCode_ISO_CURRENCY_CODE_RSD:
+		return "RSD"
+	case IsoCurrencyCode_ISO_CURRENCY_CODE_RUB:
+		return "RUB"
+	case IsoCurrencyCode_ISO_CURRENCY_CODE_RWF:
+		return "RWF"
+	case IsoCurrencyCode_ISO_CURRENCY_CODE_SAR:
+		return "SAR"
+	case IsoCurrencyCode_ISO_CURRENCY_CODE_SBD:
+		return "SBD"
+	case IsoCurrencyCode_ISO_CURRENCY_CODE_SCR:
+		return "SCR"
+	case IsoCurrencyCode_ISO_CURRENCY_CODE_SDG:
+		return "SDG"
+	case IsoCurrencyCode_ISO_CURRENCY_CODE_SEK:
+		return "SEK"
+	case IsoCurrencyCode_ISO_CURRENCY_CODE_SGD:
+		return "SGD"
+	case IsoCurrencyCode_ISO_CURRENCY_CODE_SHP:
+		return "SHP"
+	case IsoCurrencyCode_ISO_CURRENCY_CODE_SLL:
+		return "SLL"
+	case IsoCurrencyCode_ISO_CURRENCY_CODE_SOS:
+		return "SOS"
+	case IsoCurrencyCode_ISO_CURRENCY_CODE_SRD:
+		return "SRD"
+	case IsoCurrencyCode_ISO_CURRENCY_CODE_STD:
+		return "STD"
+	case IsoCurrencyCode_ISO_CURRENCY_CODE_SVC:
+		return "SVC"
+	case IsoCurrencyCode_ISO_CURRENCY_CODE_SYP:
+		return "SYP"
+	case IsoCurrencyCode_ISO_CURRENCY_CODE_SZL:
+		return "SZL"
+	case IsoCurrencyCode_ISO_CURRENCY_CODE_THB:
+		return "THB"
+	case IsoCurrencyCode_ISO_CURRENCY_CODE_TJS:
+		return "TJS"
+	case IsoCurrencyCode_ISO_CURRENCY_CODE_TMT:
+		return "TMT"
+	case IsoCurrencyCode_ISO_CURRENCY_CODE_TND:
+		return "TND"
+	case IsoCurrencyCode_ISO_CURRENCY_CODE_TOP:
+		return "TOP"
+	case IsoCurrencyCode_ISO_CURRENCY_CODE_TRY:
+		return "TRY"
+	case IsoCurrencyCode_ISO_CURRENCY_CODE_TTD:
+		return "TTD"
+	case IsoCurrencyCode_ISO_CURRENCY_CODE_TWD:
+		return "TWD"
+	case IsoCurrencyCode_ISO_CURRENCY_CODE_TZS:
+		return "TZS"
+	case IsoCurrencyCode_ISO_CURRENCY_CODE_UAH:
+		return "UAH"
+	case IsoCurrencyCode_ISO_CURRENCY_CODE_UGX:
+		return "UGX"
+	case IsoCurrencyCode_ISO_CURRENCY_CODE_USD:
+		return "USD"
+	case IsoCurrencyCode_ISO_CURRENCY_CODE_UYI:
+		return "UYI"
+	case IsoCurrencyCode_ISO_CURRENCY_CODE_UYU:
+		return "UYU"
+	case IsoCurrencyCode_ISO_CURRENCY_CODE_UZS:
+		return "UZS"
+	case IsoCurrencyCode_ISO_CURRENCY_CODE_VEF:
+		return "VEF"
+	case IsoCurrencyCode_ISO_CURRENCY_CODE_VND:
+		return "VND"
+	case IsoCurrencyCode_ISO_CURRENCY_CODE_VUV:
+		return "VUV"
+	case IsoCurrencyCode_ISO_CURRENCY_CODE_WST:
+		return "WST"
+	case IsoCurrencyCode_ISO_CURRENCY_CODE_XAF:
+		return "XAF"
+	case IsoCurrencyCode_ISO_CURRENCY_CODE_XCD:
+		return "XCD"
+	case IsoCurrencyCode_ISO_CURRENCY_CODE_XOF:
+		return "XOF"
+	case IsoCurrencyCode_ISO_CURRENCY_CODE_XPF:
+		return "XPF"
+	case IsoCurrencyCode_ISO_CURRENCY_CODE_YER:
+		return "YER"
+	case IsoCurrencyCode_ISO_CURRENCY_CODE_ZAR:
+		return "ZAR"
+	case IsoCurrencyCode_ISO_CURRENCY_CODE_ZMK:
+		return "ZMK"
+	case IsoCurrencyCode_ISO_CURRENCY_CODE_ZWL:
+		return "ZWL"
+	case IsoCurrencyCode_ISO_CURRENCY_CODE_MRU:
+		return "MRU"
+	case IsoCurrencyCode_ISO_CURRENCY_CODE_MZN:
+		return "MZN"
+	case IsoCurrencyCode_ISO_CURRENCY_CODE_SSP:
+		return "SSP"
+	case IsoCurrencyCode_ISO_CURRENCY_CODE_STN:
+		return "STN"
+	case IsoCurrencyCode_ISO_CURRENCY_CODE_VES:
+		return "VES"
+	case IsoCurrencyCode_ISO_CURRENCY_CODE_ZMW:
+		return "ZMW"
 	default:
 		return ""
 	}
@@ -883,6 +8059,344 @@ func (e IsoCurrencyCode) XMLString() string {
 func ParseIsoCurrencyCodeString(s string) (IsoCurrencyCode, bool) {
 	s = strings.ToUpper(s)
 	switch s {
+	case "AED":
+		return IsoCurrencyCode_ISO_CURRENCY_CODE_AED, true
+	case "AFN":
+		return IsoCurrencyCode_ISO_CURRENCY_CODE_AFN, true
+	case "ALL":
+		return IsoCurrencyCode_ISO_CURRENCY_CODE_ALL, true
+	case "AMD":
+		return IsoCurrencyCode_ISO_CURRENCY_CODE_AMD, true
+	case "ANG":
+		return IsoCurrencyCode_ISO_CURRENCY_CODE_ANG, true
+	case "AOA":
+		return IsoCurrencyCode_ISO_CURRENCY_CODE_AOA, true
+	case "ARS":
+		return IsoCurrencyCode_ISO_CURRENCY_CODE_ARS, true
+	case "AUD":
+		return IsoCurrencyCode_ISO_CURRENCY_CODE_AUD, true
+	case "AWG":
+		return IsoCurrencyCode_ISO_CURRENCY_CODE_AWG, true
+	case "AZN":
+		return IsoCurrencyCode_ISO_CURRENCY_CODE_AZN, true
+	case "BAM":
+		return IsoCurrencyCode_ISO_CURRENCY_CODE_BAM, true
+	case "BBD":
+		return IsoCurrencyCode_ISO_CURRENCY_CODE_BBD, true
+	case "BDT":
+		return IsoCurrencyCode_ISO_CURRENCY_CODE_BDT, true
+	case "BGN":
+		return IsoCurrencyCode_ISO_CURRENCY_CODE_BGN, true
+	case "BHD":
+		return IsoCurrencyCode_ISO_CURRENCY_CODE_BHD, true
+	case "BIF":
+		return IsoCurrencyCode_ISO_CURRENCY_CODE_BIF, true
+	case "BMD":
+		return IsoCurrencyCode_ISO_CURRENCY_CODE_BMD, true
+	case "BND":
+		return IsoCurrencyCode_ISO_CURRENCY_CODE_BND, true
+	case "BOB":
+		return IsoCurrencyCode_ISO_CURRENCY_CODE_BOB, true
+	case "BOV":
+		return IsoCurrencyCode_ISO_CURRENCY_CODE_BOV, true
+	case "BRL":
+		return IsoCurrencyCode_ISO_CURRENCY_CODE_BRL, true
+	case "BSD":
+		return IsoCurrencyCode_ISO_CURRENCY_CODE_BSD, true
+	case "BTN":
+		return IsoCurrencyCode_ISO_CURRENCY_CODE_BTN, true
+	case "BWP":
+		return IsoCurrencyCode_ISO_CURRENCY_CODE_BWP, true
+	case "BYR":
+		return IsoCurrencyCode_ISO_CURRENCY_CODE_BYR, true
+	case "BZD":
+		return IsoCurrencyCode_ISO_CURRENCY_CODE_BZD, true
+	case "CAD":
+		return IsoCurrencyCode_ISO_CURRENCY_CODE_CAD, true
+	case "CDF":
+		return IsoCurrencyCode_ISO_CURRENCY_CODE_CDF, true
+	case "CHF":
+		return IsoCurrencyCode_ISO_CURRENCY_CODE_CHF, true
+	case "CLF":
+		return IsoCurrencyCode_ISO_CURRENCY_CODE_CLF, true
+	case "CLP":
+		return IsoCurrencyCode_ISO_CURRENCY_CODE_CLP, true
+	case "CNY":
+		return IsoCurrencyCode_ISO_CURRENCY_CODE_CNY, true
+	case "COP":
+		return IsoCurrencyCode_ISO_CURRENCY_CODE_COP, true
+	case "COU":
+		return IsoCurrencyCode_ISO_CURRENCY_CODE_COU, true
+	case "CRC":
+		return IsoCurrencyCode_ISO_CURRENCY_CODE_CRC, true
+	case "CUC":
+		return IsoCurrencyCode_ISO_CURRENCY_CODE_CUC, true
+	case "CUP":
+		return IsoCurrencyCode_ISO_CURRENCY_CODE_CUP, true
+	case "CVE":
+		return IsoCurrencyCode_ISO_CURRENCY_CODE_CVE, true
+	case "CZK":
+		return IsoCurrencyCode_ISO_CURRENCY_CODE_CZK, true
+	case "DJF":
+		return IsoCurrencyCode_ISO_CURRENCY_CODE_DJF, true
+	case "DKK":
+		return IsoCurrencyCode_ISO_CURRENCY_CODE_DKK, true
+	case "DOP":
+		return IsoCurrencyCode_ISO_CURRENCY_CODE_DOP, true
+	case "DZD":
+		return IsoCurrencyCode_ISO_CURRENCY_CODE_DZD, true
+	case "EGP":
+		return IsoCurrencyCode_ISO_CURRENCY_CODE_EGP, true
+	case "ERN":
+		return IsoCurrencyCode_ISO_CURRENCY_CODE_ERN, true
+	case "ETB":
+		return IsoCurrencyCode_ISO_CURRENCY_CODE_ETB, true
+	case "EUR":
+		return IsoCurrencyCode_ISO_CURRENCY_CODE_EUR, true
+	case "FJD":
+		return IsoCurrencyCode_ISO_CURRENCY_CODE_FJD, true
+	case "FKP":
+		return IsoCurrencyCode_ISO_CURRENCY_CODE_FKP, true
+	case "GBP":
+		return IsoCurrencyCode_ISO_CURRENCY_CODE_GBP, true
+	case "GEL":
+		return IsoCurrencyCode_ISO_CURRENCY_CODE_GEL, true
+	case "GHS":
+		return IsoCurrencyCode_ISO_CURRENCY_CODE_GHS, true
+	case "GIP":
+		return IsoCurrencyCode_ISO_CURRENCY_CODE_GIP, true
+	case "GMD":
+		return IsoCurrencyCode_ISO_CURRENCY_CODE_GMD, true
+	case "GNF":
+		return IsoCurrencyCode_ISO_CURRENCY_CODE_GNF, true
+	case "GTQ":
+		return IsoCurrencyCode_ISO_CURRENCY_CODE_GTQ, true
+	case "GYD":
+		return IsoCurrencyCode_ISO_CURRENCY_CODE_GYD, true
+	case "HKD":
+		return IsoCurrencyCode_ISO_CURRENCY_CODE_HKD, true
+	case "HNL":
+		return IsoCurrencyCode_ISO_CURRENCY_CODE_HNL, true
+	case "HRK":
+		return IsoCurrencyCode_ISO_CURRENCY_CODE_HRK, true
+	case "HTG":
+		return IsoCurrencyCode_ISO_CURRENCY_CODE_HTG, true
+	case "HUF":
+		return IsoCurrencyCode_ISO_CURRENCY_CODE_HUF, true
+	case "IDR":
+		return IsoCurrencyCode_ISO_CURRENCY_CODE_IDR, true
+	case "ILS":
+		return IsoCurrencyCode_ISO_CURRENCY_CODE_ILS, true
+	case "INR":
+		return IsoCurrencyCode_ISO_CURRENCY_CODE_INR, true
+	case "IQD":
+		return IsoCurrencyCode_ISO_CURRENCY_CODE_IQD, true
+	case "IRR":
+		return IsoCurrencyCode_ISO_CURRENCY_CODE_IRR, true
+	case "ISK":
+		return IsoCurrencyCode_ISO_CURRENCY_CODE_ISK, true
+	case "JMD":
+		return IsoCurrencyCode_ISO_CURRENCY_CODE_JMD, true
+	case "JOD":
+		return IsoCurrencyCode_ISO_CURRENCY_CODE_JOD, true
+	case "JPY":
+		return IsoCurrencyCode_ISO_CURRENCY_CODE_JPY, true
+	case "KES":
+		return IsoCurrencyCode_ISO_CURRENCY_CODE_KES, true
+	case "KGS":
+		return IsoCurrencyCode_ISO_CURRENCY_CODE_KGS, true
+	case "KHR":
+		return IsoCurrencyCode_ISO_CURRENCY_CODE_KHR, true
+	case "KMF":
+		return IsoCurrencyCode_ISO_CURRENCY_CODE_KMF, true
+	case "KPW":
+		return IsoCurrencyCode_ISO_CURRENCY_CODE_KPW, true
+	case "KRW":
+		return IsoCurrencyCode_ISO_CURRENCY_CODE_KRW, true
+	case "KWD":
+		return IsoCurrencyCode_ISO_CURRENCY_CODE_KWD, true
+	case "KYD":
+		return IsoCurrencyCode_ISO_CURRENCY_CODE_KYD, true
+	case "KZT":
+		return IsoCurrencyCode_ISO_CURRENCY_CODE_KZT, true
+	case "LAK":
+		return IsoCurrencyCode_ISO_CURRENCY_CODE_LAK, true
+	case "LBP":
+		return IsoCurrencyCode_ISO_CURRENCY_CODE_LBP, true
+	case "LKR":
+		return IsoCurrencyCode_ISO_CURRENCY_CODE_LKR, true
+	case "LRD":
+		return IsoCurrencyCode_ISO_CURRENCY_CODE_LRD, true
+	case "LSL":
+		return IsoCurrencyCode_ISO_CURRENCY_CODE_LSL, true
+	case "LTL":
+		return IsoCurrencyCode_ISO_CURRENCY_CODE_LTL, true
+	case "LVL":
+		return IsoCurrencyCode_ISO_CURRENCY_CODE_LVL, true
+	case "LYD":
+		return IsoCurrencyCode_ISO_CURRENCY_CODE_LYD, true
+	case "MAD":
+		return IsoCurrencyCode_ISO_CURRENCY_CODE_MAD, true
+	case "MDL":
+		return IsoCurrencyCode_ISO_CURRENCY_CODE_MDL, true
+	case "MGA":
+		return IsoCurrencyCode_ISO_CURRENCY_CODE_MGA, true
+	case "MKD":
+		return IsoCurrencyCode_ISO_CURRENCY_CODE_MKD, true
+	case "MMK":
+		return IsoCurrencyCode_ISO_CURRENCY_CODE_MMK, true
+	case "MNT":
+		return IsoCurrencyCode_ISO_CURRENCY_CODE_MNT, true
+	case "MOP":
+		return IsoCurrencyCode_ISO_CURRENCY_CODE_MOP, true
+	case "MRO":
+		return IsoCurrencyCode_ISO_CURRENCY_CODE_MRO, true
+	case "MUR":
+		return IsoCurrencyCode_ISO_CURRENCY_CODE_MUR, true
+	case "MVR":
+		return IsoCurrencyCode_ISO_CURRENCY_CODE_MVR, true
+	case "MWK":
+		return IsoCurrencyCode_ISO_CURRENCY_CODE_MWK, true
+	case "MXN":
+		return IsoCurrencyCode_ISO_CURRENCY_CODE_MXN, true
+	case "MXV":
+		return IsoCurrencyCode_ISO_CURRENCY_CODE_MXV, true
+	case "MYR":
+		return IsoCurrencyCode_ISO_CURRENCY_CODE_MYR, true
+	case "MZM":
+		return IsoCurrencyCode_ISO_CURRENCY_CODE_MZM, true
+	case "NAD":
+		return IsoCurrencyCode_ISO_CURRENCY_CODE_NAD, true
+	case "NGN":
+		return IsoCurrencyCode_ISO_CURRENCY_CODE_NGN, true
+	case "NIO":
+		return IsoCurrencyCode_ISO_CURRENCY_CODE_NIO, true
+	case "NOK":
+		return IsoCurrencyCode_ISO_CURRENCY_CODE_NOK, true
+	case "NPR":
+		return IsoCurrencyCode_ISO_CURRENCY_CODE_NPR, true
+	case "NZD":
+		return IsoCurrencyCode_ISO_CURRENCY_CODE_NZD, true
+	case "OMR":
+		return IsoCurrencyCode_ISO_CURRENCY_CODE_OMR, true
+	case "PAB":
+		return IsoCurrencyCode_ISO_CURRENCY_CODE_PAB, true
+	case "PEN":
+		return IsoCurrencyCode_ISO_CURRENCY_CODE_PEN, true
+	case "PGK":
+		return IsoCurrencyCode_ISO_CURRENCY_CODE_PGK, true
+	case "PHP":
+		return IsoCurrencyCode_ISO_CURRENCY_CODE_PHP, true
+	case "PKR":
+		return IsoCurrencyCode_ISO_CURRENCY_CODE_PKR, true
+	case "PLN":
+		return IsoCurrencyCode_ISO_CURRENCY_CODE_PLN, true
+	case "PYG":
+		return IsoCurrencyCode_ISO_CURRENCY_CODE_PYG, true
+	case "QAR":
+		return IsoCurrencyCode_ISO_CURRENCY_CODE_QAR, true
+	case "RON":
+		return IsoCurrencyCode_ISO_CURRENCY_CODE_RON, true
+	case "RSD":
+		return IsoCurrencyCode_ISO_CURRENCY_CODE_RSD, true
+	case "RUB":
+		return IsoCurrencyCode_ISO_CURRENCY_CODE_RUB, true
+	case "RWF":
+		return IsoCurrencyCode_ISO_CURRENCY_CODE_RWF, true
+	case "SAR":
+		return IsoCurrencyCode_ISO_CURRENCY_CODE_SAR, true
+	case "SBD":
+		return IsoCurrencyCode_ISO_CURRENCY_CODE_SBD, true
+	case "SCR":
+		return IsoCurrencyCode_ISO_CURRENCY_CODE_SCR, true
+	case "SDG":
+		return IsoCurrencyCode_ISO_CURRENCY_CODE_SDG, true
+	case "SEK":
+		return IsoCurrencyCode_ISO_CURRENCY_CODE_SEK, true
+	case "SGD":
+		return IsoCurrencyCode_ISO_CURRENCY_CODE_SGD, true
+	case "SHP":
+		return IsoCurrencyCode_ISO_CURRENCY_CODE_SHP, true
+	case "SLL":
+		return IsoCurrencyCode_ISO_CURRENCY_CODE_SLL, true
+	case "SOS":
+		return IsoCurrencyCode_ISO_CURRENCY_CODE_SOS, true
+	case "SRD":
+		return IsoCurrencyCode_ISO_CURRENCY_CODE_SRD, true
+	case "STD":
+		return IsoCurrencyCode_ISO_CURRENCY_CODE_STD, true
+	case "SVC":
+		return IsoCurrencyCode_ISO_CURRENCY_CODE_SVC, true
+	case "SYP":
+		return IsoCurrencyCode_ISO_CURRENCY_CODE_SYP, true
+	case "SZL":
+		return IsoCurrencyCode_ISO_CURRENCY_CODE_SZL, true
+	case "THB":
+		return IsoCurrencyCode_ISO_CURRENCY_CODE_THB, true
+	case "TJS":
+		return IsoCurrencyCode_ISO_CURRENCY_CODE_TJS, true
+	case "TMT":
+		return IsoCurrencyCode_ISO_CURRENCY_CODE_TMT, true
+	case "TND":
+		return IsoCurrencyCode_ISO_CURRENCY_CODE_TND, true
+	case "TOP":
+		return IsoCurrencyCode_ISO_CURRENCY_CODE_TOP, true
+	case "TRY":
+		return IsoCurrencyCode_ISO_CURRENCY_CODE_TRY, true
+	case "TTD":
+		return IsoCurrencyCode_ISO_CURRENCY_CODE_TTD, true
+	case "TWD":
+		return IsoCurrencyCode_ISO_CURRENCY_CODE_TWD, true
+	case "TZS":
+		return IsoCurrencyCode_ISO_CURRENCY_CODE_TZS, true
+	case "UAH":
+		return IsoCurrencyCode_ISO_CURRENCY_CODE_UAH, true
+	case "UGX":
+		return IsoCurrencyCode_ISO_CURRENCY_CODE_UGX, true
+	case "USD":
+		return IsoCurrencyCode_ISO_CURRENCY_CODE_USD, true
+	case "UYI":
+		return IsoCurrencyCode_ISO_CURRENCY_CODE_UYI, true
+	case "UYU":
+		return IsoCurrencyCode_ISO_CURRENCY_CODE_UYU, true
+	case "UZS":
+		return IsoCurrencyCode_ISO_CURRENCY_CODE_UZS, true
+	case "VEF":
+		return IsoCurrencyCode_ISO_CURRENCY_CODE_VEF, true
+	case "VND":
+		return IsoCurrencyCode_ISO_CURRENCY_CODE_VND, true
+	case "VUV":
+		return IsoCurrencyCode_ISO_CURRENCY_CODE_VUV, true
+	case "WST":
+		return IsoCurrencyCode_ISO_CURRENCY_CODE_WST, true
+	case "XAF":
+		return IsoCurrencyCode_ISO_CURRENCY_CODE_XAF, true
+	case "XCD":
+		return IsoCurrencyCode_ISO_CURRENCY_CODE_XCD, true
+	case "XOF":
+		return IsoCurrencyCode_ISO_CURRENCY_CODE_XOF, true
+	case "XPF":
+		return IsoCurrencyCode_ISO_CURRENCY_CODE_XPF, true
+	case "YER":
+		return IsoCurrencyCode_ISO_CURRENCY_CODE_YER, true
+	case "ZAR":
+		return IsoCurrencyCode_ISO_CURRENCY_CODE_ZAR, true
+	case "ZMK":
+		return IsoCurrencyCode_ISO_CURRENCY_CODE_ZMK, true
+	case "ZWL":
+		return IsoCurrencyCode_ISO_CURRENCY_CODE_ZWL, true
+	case "MRU":
+		return IsoCurrencyCode_ISO_CURRENCY_CODE_MRU, true
+	case "MZN":
+		return IsoCurrencyCode_ISO_CURRENCY_CODE_MZN, true
+	case "SSP":
+		return IsoCurrencyCode_ISO_CURRENCY_CODE_SSP, true
+	case "STN":
+		return IsoCurrencyCode_ISO_CURRENCY_CODE_STN, true
+	case "VES":
+		return IsoCurrencyCode_ISO_CURRENCY_CODE_VES, true
+	case "ZMW":
+		return IsoCurrencyCode_ISO_CURRENCY_CODE_ZMW, true
 	default:
 		return IsoCurrencyCode(0), false
 	}
@@ -891,6 +8405,1398 @@ func ParseIsoCurrencyCodeString(s string) (IsoCurrencyCode, bool) {
 // XMLString returns the XML string representation of IsoLanguageCode
 func (e IsoLanguageCode) XMLString() string {
 	switch e {
+	case IsoLanguageCode_ISO_LANGUAGE_CODE_RAJ:
+		return "RAJ"
+	case IsoLanguageCode_ISO_LANGUAGE_CODE_BHO:
+		return "BHO"
+	case IsoLanguageCode_ISO_LANGUAGE_CODE_AA:
+		return "AA"
+	case IsoLanguageCode_ISO_LANGUAGE_CODE_AB:
+		return "AB"
+	case IsoLanguageCode_ISO_LANGUAGE_CODE_AE:
+		return "AE"
+	case IsoLanguageCode_ISO_LANGUAGE_CODE_AF:
+		return "AF"
+	case IsoLanguageCode_ISO_LANGUAGE_CODE_AK:
+		return "AK"
+	case IsoLanguageCode_ISO_LANGUAGE_CODE_AM:
+		return "AM"
+	case IsoLanguageCode_ISO_LANGUAGE_CODE_AN:
+		return "AN"
+	case IsoLanguageCode_ISO_LANGUAGE_CODE_AR:
+		return "AR"
+	case IsoLanguageCode_ISO_LANGUAGE_CODE_AS:
+		return "AS"
+	case IsoLanguageCode_ISO_LANGUAGE_CODE_AV:
+		return "AV"
+	case IsoLanguageCode_ISO_LANGUAGE_CODE_AY:
+		return "AY"
+	case IsoLanguageCode_ISO_LANGUAGE_CODE_AZ:
+		return "AZ"
+	case IsoLanguageCode_ISO_LANGUAGE_CODE_BA:
+		return "BA"
+	case IsoLanguageCode_ISO_LANGUAGE_CODE_BE:
+		return "BE"
+	case IsoLanguageCode_ISO_LANGUAGE_CODE_BG:
+		return "BG"
+	case IsoLanguageCode_ISO_LANGUAGE_CODE_BH:
+		return "BH"
+	case IsoLanguageCode_ISO_LANGUAGE_CODE_BI:
+		return "BI"
+	case IsoLanguageCode_ISO_LANGUAGE_CODE_BM:
+		return "BM"
+	case IsoLanguageCode_ISO_LANGUAGE_CODE_BN:
+		return "BN"
+	case IsoLanguageCode_ISO_LANGUAGE_CODE_BO:
+		return "BO"
+	case IsoLanguageCode_ISO_LANGUAGE_CODE_BR:
+		return "BR"
+	case IsoLanguageCode_ISO_LANGUAGE_CODE_BS:
+		return "BS"
+	case IsoLanguageCode_ISO_LANGUAGE_CODE_CA:
+		return "CA"
+	case IsoLanguageCode_ISO_LANGUAGE_CODE_CE:
+		return "CE"
+	case IsoLanguageCode_ISO_LANGUAGE_CODE_CH:
+		return "CH"
+	case IsoLanguageCode_ISO_LANGUAGE_CODE_CO:
+		return "CO"
+	case IsoLanguageCode_ISO_LANGUAGE_CODE_CR:
+		return "CR"
+	case IsoLanguageCode_ISO_LANGUAGE_CODE_CS:
+		return "CS"
+	case IsoLanguageCode_ISO_LANGUAGE_CODE_CU:
+		return "CU"
+	case IsoLanguageCode_ISO_LANGUAGE_CODE_CV:
+		return "CV"
+	case IsoLanguageCode_ISO_LANGUAGE_CODE_CY:
+		return "CY"
+	case IsoLanguageCode_ISO_LANGUAGE_CODE_DA:
+		return "DA"
+	case IsoLanguageCode_ISO_LANGUAGE_CODE_DE:
+		return "DE"
+	case IsoLanguageCode_ISO_LANGUAGE_CODE_DV:
+		return "DV"
+	case IsoLanguageCode_ISO_LANGUAGE_CODE_DZ:
+		return "DZ"
+	case IsoLanguageCode_ISO_LANGUAGE_CODE_EE:
+		return "EE"
+	case IsoLanguageCode_ISO_LANGUAGE_CODE_EL:
+		return "EL"
+	case IsoLanguageCode_ISO_LANGUAGE_CODE_EN:
+		return "EN"
+	case IsoLanguageCode_ISO_LANGUAGE_CODE_EO:
+		return "EO"
+	case IsoLanguageCode_ISO_LANGUAGE_CODE_ES:
+		return "ES"
+	case IsoLanguageCode_ISO_LANGUAGE_CODE_ET:
+		return "ET"
+	case IsoLanguageCode_ISO_LANGUAGE_CODE_EU:
+		return "EU"
+	case IsoLanguageCode_ISO_LANGUAGE_CODE_FA:
+		return "FA"
+	case IsoLanguageCode_ISO_LANGUAGE_CODE_FF:
+		return "FF"
+	case IsoLanguageCode_ISO_LANGUAGE_CODE_FI:
+		return "FI"
+	case IsoLanguageCode_ISO_LANGUAGE_CODE_FJ:
+		return "FJ"
+	case IsoLanguageCode_ISO_LANGUAGE_CODE_FO:
+		return "FO"
+	case IsoLanguageCode_ISO_LANGUAGE_CODE_FR:
+		return "FR"
+	case IsoLanguageCode_ISO_LANGUAGE_CODE_FY:
+		return "FY"
+	case IsoLanguageCode_ISO_LANGUAGE_CODE_GA:
+		return "GA"
+	case IsoLanguageCode_ISO_LANGUAGE_CODE_GD:
+		return "GD"
+	case IsoLanguageCode_ISO_LANGUAGE_CODE_GL:
+		return "GL"
+	case IsoLanguageCode_ISO_LANGUAGE_CODE_GN:
+		return "GN"
+	case IsoLanguageCode_ISO_LANGUAGE_CODE_GU:
+		return "GU"
+	case IsoLanguageCode_ISO_LANGUAGE_CODE_GV:
+		return "GV"
+	case IsoLanguageCode_ISO_LANGUAGE_CODE_HA:
+		return "HA"
+	case IsoLanguageCode_ISO_LANGUAGE_CODE_HE:
+		return "HE"
+	case IsoLanguageCode_ISO_LANGUAGE_CODE_HI:
+		return "HI"
+	case IsoLanguageCode_ISO_LANGUAGE_CODE_HO:
+		return "HO"
+	case IsoLanguageCode_ISO_LANGUAGE_CODE_HR:
+		return "HR"
+	case IsoLanguageCode_ISO_LANGUAGE_CODE_HT:
+		return "HT"
+	case IsoLanguageCode_ISO_LANGUAGE_CODE_HU:
+		return "HU"
+	case IsoLanguageCode_ISO_LANGUAGE_CODE_HY:
+		return "HY"
+	case IsoLanguageCode_ISO_LANGUAGE_CODE_HZ:
+		return "HZ"
+	case IsoLanguageCode_ISO_LANGUAGE_CODE_IA:
+		return "IA"
+	case IsoLanguageCode_ISO_LANGUAGE_CODE_ID:
+		return "ID"
+	case IsoLanguageCode_ISO_LANGUAGE_CODE_IE:
+		return "IE"
+	case IsoLanguageCode_ISO_LANGUAGE_CODE_IG:
+		return "IG"
+	case IsoLanguageCode_ISO_LANGUAGE_CODE_II:
+		return "II"
+	case IsoLanguageCode_ISO_LANGUAGE_CODE_IK:
+		return "IK"
+	case IsoLanguageCode_ISO_LANGUAGE_CODE_IO:
+		return "IO"
+	case IsoLanguageCode_ISO_LANGUAGE_CODE_IS:
+		return "IS"
+	case IsoLanguageCode_ISO_LANGUAGE_CODE_IT:
+		return "IT"
+	case IsoLanguageCode_ISO_LANGUAGE_CODE_IU:
+		return "IU"
+	case IsoLanguageCode_ISO_LANGUAGE_CODE_JA:
+		return "JA"
+	case IsoLanguageCode_ISO_LANGUAGE_CODE_JV:
+		return "JV"
+	case IsoLanguageCode_ISO_LANGUAGE_CODE_KA:
+		return "KA"
+	case IsoLanguageCode_ISO_LANGUAGE_CODE_KG:
+		return "KG"
+	case IsoLanguageCode_ISO_LANGUAGE_CODE_KI:
+		return "KI"
+	case IsoLanguageCode_ISO_LANGUAGE_CODE_KJ:
+		return "KJ"
+	case IsoLanguageCode_ISO_LANGUAGE_CODE_KK:
+		return "KK"
+	case IsoLanguageCode_ISO_LANGUAGE_CODE_KL:
+		return "KL"
+	case IsoLanguageCode_ISO_LANGUAGE_CODE_KM:
+		return "KM"
+	case IsoLanguageCode_ISO_LANGUAGE_CODE_KN:
+		return "KN"
+	case IsoLanguageCode_ISO_LANGUAGE_CODE_KO:
+		return "KO"
+	case IsoLanguageCode_ISO_LANGUAGE_CODE_KR:
+		return "KR"
+	case IsoLanguageCode_ISO_LANGUAGE_CODE_KS:
+		return "KS"
+	case IsoLanguageCode_ISO_LANGUAGE_CODE_KU:
+		return "KU"
+	case IsoLanguageCode_ISO_LANGUAGE_CODE_KV:
+		return "KV"
+	case IsoLanguageCode_ISO_LANGUAGE_CODE_KW:
+		return "KW"
+	case IsoLanguageCode_ISO_LANGUAGE_CODE_KY:
+		return "KY"
+	case IsoLanguageCode_ISO_LANGUAGE_CODE_LA:
+		return "LA"
+	case IsoLanguageCode_ISO_LANGUAGE_CODE_LB:
+		return "LB"
+	case IsoLanguageCode_ISO_LANGUAGE_CODE_LG:
+		return "LG"
+	case IsoLanguageCode_ISO_LANGUAGE_CODE_LI:
+		return "LI"
+	case IsoLanguageCode_ISO_LANGUAGE_CODE_LN:
+		return "LN"
+	case IsoLanguageCode_ISO_LANGUAGE_CODE_LO:
+		return "LO"
+	case IsoLanguageCode_ISO_LANGUAGE_CODE_LT:
+		return "LT"
+	case IsoLanguageCode_ISO_LANGUAGE_CODE_LU:
+		return "LU"
+	case IsoLanguageCode_ISO_LANGUAGE_CODE_LV:
+		return "LV"
+	case IsoLanguageCode_ISO_LANGUAGE_CODE_MG:
+		return "MG"
+	case IsoLanguageCode_ISO_LANGUAGE_CODE_MH:
+		return "MH"
+	case IsoLanguageCode_ISO_LANGUAGE_CODE_MI:
+		return "MI"
+	case IsoLanguageCode_ISO_LANGUAGE_CODE_MK:
+		return "MK"
+	case IsoLanguageCode_ISO_LANGUAGE_CODE_ML:
+		return "ML"
+	case IsoLanguageCode_ISO_LANGUAGE_CODE_MN:
+		return "MN"
+	case IsoLanguageCode_ISO_LANGUAGE_CODE_MO:
+		return "MO"
+	case IsoLanguageCode_ISO_LANGUAGE_CODE_MR:
+		return "MR"
+	case IsoLanguageCode_ISO_LANGUAGE_CODE_MS:
+		return "MS"
+	case IsoLanguageCode_ISO_LANGUAGE_CODE_MT:
+		return "MT"
+	case IsoLanguageCode_ISO_LANGUAGE_CODE_MY:
+		return "MY"
+	case IsoLanguageCode_ISO_LANGUAGE_CODE_NA:
+		return "NA"
+	case IsoLanguageCode_ISO_LANGUAGE_CODE_NB:
+		return "NB"
+	case IsoLanguageCode_ISO_LANGUAGE_CODE_ND:
+		return "ND"
+	case IsoLanguageCode_ISO_LANGUAGE_CODE_NE:
+		return "NE"
+	case IsoLanguageCode_ISO_LANGUAGE_CODE_NG:
+		return "NG"
+	case IsoLanguageCode_ISO_LANGUAGE_CODE_NL:
+		return "NL"
+	case IsoLanguageCode_ISO_LANGUAGE_CODE_NN:
+		return "NN"
+	case IsoLanguageCode_ISO_LANGUAGE_CODE_NO:
+		return "NO"
+	case IsoLanguageCode_ISO_LANGUAGE_CODE_NR:
+		return "NR"
+	case IsoLanguageCode_ISO_LANGUAGE_CODE_NV:
+		return "NV"
+	case IsoLanguageCode_ISO_LANGUAGE_CODE_NY:
+		return "NY"
+	case IsoLanguageCode_ISO_LANGUAGE_CODE_OC:
+		return "OC"
+	case IsoLanguageCode_ISO_LANGUAGE_CODE_OJ:
+		return "OJ"
+	case IsoLanguageCode_ISO_LANGUAGE_CODE_OM:
+		return "OM"
+	case IsoLanguageCode_ISO_LANGUAGE_CODE_OR:
+		return "OR"
+	case IsoLanguageCode_ISO_LANGUAGE_CODE_OS:
+		return "OS"
+	case IsoLanguageCode_ISO_LANGUAGE_CODE_PA:
+		return "PA"
+	case IsoLanguageCode_ISO_LANGUAGE_CODE_PI:
+		return "PI"
+	case IsoLanguageCode_ISO_LANGUAGE_CODE_PL:
+		return "PL"
+	case IsoLanguageCode_ISO_LANGUAGE_CODE_PS:
+		return "PS"
+	case IsoLanguageCode_ISO_LANGUAGE_CODE_PT:
+		return "PT"
+	case IsoLanguageCode_ISO_LANGUAGE_CODE_QU:
+		return "QU"
+	case IsoLanguageCode_ISO_LANGUAGE_CODE_RM:
+		return "RM"
+	case IsoLanguageCode_ISO_LANGUAGE_CODE_RN:
+		return "RN"
+	case IsoLanguageCode_ISO_LANGUAGE_CODE_RO:
+		return "RO"
+	case IsoLanguageCode_ISO_LANGUAGE_CODE_RU:
+		return "RU"
+	case IsoLanguageCode_ISO_LANGUAGE_CODE_RW:
+		return "RW"
+	case IsoLanguageCode_ISO_LANGUAGE_CODE_SA:
+		return "SA"
+	case IsoLanguageCode_ISO_LANGUAGE_CODE_SC:
+		return "SC"
+	case IsoLanguageCode_ISO_LANGUAGE_CODE_SD:
+		return "SD"
+	case IsoLanguageCode_ISO_LANGUAGE_CODE_SE:
+		return "SE"
+	case IsoLanguageCode_ISO_LANGUAGE_CODE_SG:
+		return "SG"
+	case IsoLanguageCode_ISO_LANGUAGE_CODE_SI:
+		return "SI"
+	case IsoLanguageCode_ISO_LANGUAGE_CODE_SK:
+		return "SK"
+	case IsoLanguageCode_ISO_LANGUAGE_CODE_SL:
+		return "SL"
+	case IsoLanguageCode_ISO_LANGUAGE_CODE_SM:
+		return "SM"
+	case IsoLanguageCode_ISO_LANGUAGE_CODE_SN:
+		return "SN"
+	case IsoLanguageCode_ISO_LANGUAGE_CODE_SO:
+		return "SO"
+	case IsoLanguageCode_ISO_LANGUAGE_CODE_SQ:
+		return "SQ"
+	case IsoLanguageCode_ISO_LANGUAGE_CODE_SR:
+		return "SR"
+	case IsoLanguageCode_ISO_LANGUAGE_CODE_SS:
+		return "SS"
+	case IsoLanguageCode_ISO_LANGUAGE_CODE_ST:
+		return "ST"
+	case IsoLanguageCode_ISO_LANGUAGE_CODE_SU:
+		return "SU"
+	case IsoLanguageCode_ISO_LANGUAGE_CODE_SV:
+		return "SV"
+	case IsoLanguageCode_ISO_LANGUAGE_CODE_SW:
+		return "SW"
+	case IsoLanguageCode_ISO_LANGUAGE_CODE_TA:
+		return "TA"
+	case IsoLanguageCode_ISO_LANGUAGE_CODE_TE:
+		return "TE"
+	case IsoLanguageCode_ISO_LANGUAGE_CODE_TG:
+		return "TG"
+	case IsoLanguageCode_ISO_LANGUAGE_CODE_TH:
+		return "TH"
+	case IsoLanguageCode_ISO_LANGUAGE_CODE_TI:
+		return "TI"
+	case IsoLanguageCode_ISO_LANGUAGE_CODE_TK:
+		return "TK"
+	case IsoLanguageCode_ISO_LANGUAGE_CODE_TL:
+		return "TL"
+	case IsoLanguageCode_ISO_LANGUAGE_CODE_TN:
+		return "TN"
+	case IsoLanguageCode_ISO_LANGUAGE_CODE_TO:
+		return "TO"
+	case IsoLanguageCode_ISO_LANGUAGE_CODE_TR:
+		return "TR"
+	case IsoLanguageCode_ISO_LANGUAGE_CODE_TS:
+		return "TS"
+	case IsoLanguageCode_ISO_LANGUAGE_CODE_TT:
+		return "TT"
+	case IsoLanguageCode_ISO_LANGUAGE_CODE_TW:
+		return "TW"
+	case IsoLanguageCode_ISO_LANGUAGE_CODE_TY:
+		return "TY"
+	case IsoLanguageCode_ISO_LANGUAGE_CODE_UG:
+		return "UG"
+	case IsoLanguageCode_ISO_LANGUAGE_CODE_UK:
+		return "UK"
+	case IsoLanguageCode_ISO_LANGUAGE_CODE_UR:
+		return "UR"
+	case IsoLanguageCode_ISO_LANGUAGE_CODE_UZ:
+		return "UZ"
+	case IsoLanguageCode_ISO_LANGUAGE_CODE_VE:
+		return "VE"
+	case IsoLanguageCode_ISO_LANGUAGE_CODE_VI:
+		return "VI"
+	case IsoLanguageCode_ISO_LANGUAGE_CODE_VO:
+		return "VO"
+	case IsoLanguageCode_ISO_LANGUAGE_CODE_WA:
+		return "WA"
+	case IsoLanguageCode_ISO_LANGUAGE_CODE_WO:
+		return "WO"
+	case IsoLanguageCode_ISO_LANGUAGE_CODE_XH:
+		return "XH"
+	case IsoLanguageCode_ISO_LANGUAGE_CODE_YI:
+		return "YI"
+	case IsoLanguageCode_ISO_LANGUAGE_CODE_YO:
+		return "YO"
+	case IsoLanguageCode_ISO_LANGUAGE_CODE_ZA:
+		return "ZA"
+	case IsoLanguageCode_ISO_LANGUAGE_CODE_ZH:
+		return "ZH"
+	case IsoLanguageCode_ISO_LANGUAGE_CODE_ZU:
+		return "ZU"
+	case IsoLanguageCode_ISO_LANGUAGE_CODE_BGC:
+		return "BGC"
+	case IsoLanguageCode_ISO_LANGUAGE_CODE_QQA:
+		return "QQA"
+	case IsoLanguageCode_ISO_LANGUAGE_CODE_QQB:
+		return "QQB"
+	case IsoLanguageCode_ISO_LANGUAGE_CODE_QQC:
+		return "QQC"
+	case IsoLanguageCode_ISO_LANGUAGE_CODE_QQD:
+		return "QQD"
+	case IsoLanguageCode_ISO_LANGUAGE_CODE_QQE:
+		return "QQE"
+	case IsoLanguageCode_ISO_LANGUAGE_CODE_QQF:
+		return "QQF"
+	case IsoLanguageCode_ISO_LANGUAGE_CODE_QQG:
+		return "QQG"
+	case IsoLanguageCode_ISO_LANGUAGE_CODE_QQH:
+		return "QQH"
+	case IsoLanguageCode_ISO_LANGUAGE_CODE_QQI:
+		return "QQI"
+	case IsoLanguageCode_ISO_LANGUAGE_CODE_QQJ:
+		return "QQJ"
+	case IsoLanguageCode_ISO_LANGUAGE_CODE_AAR:
+		return "AAR"
+	case IsoLanguageCode_ISO_LANGUAGE_CODE_ABK:
+		return "ABK"
+	case IsoLanguageCode_ISO_LANGUAGE_CODE_AVE:
+		return "AVE"
+	case IsoLanguageCode_ISO_LANGUAGE_CODE_AFR:
+		return "AFR"
+	case IsoLanguageCode_ISO_LANGUAGE_CODE_AKA:
+		return "AKA"
+	case IsoLanguageCode_ISO_LANGUAGE_CODE_AMH:
+		return "AMH"
+	case IsoLanguageCode_ISO_LANGUAGE_CODE_ARG:
+		return "ARG"
+	case IsoLanguageCode_ISO_LANGUAGE_CODE_ARA:
+		return "ARA"
+	case IsoLanguageCode_ISO_LANGUAGE_CODE_ASM:
+		return "ASM"
+	case IsoLanguageCode_ISO_LANGUAGE_CODE_AVA:
+		return "AVA"
+	case IsoLanguageCode_ISO_LANGUAGE_CODE_AYM:
+		return "AYM"
+	case IsoLanguageCode_ISO_LANGUAGE_CODE_AZE:
+		return "AZE"
+	case IsoLanguageCode_ISO_LANGUAGE_CODE_BAK:
+		return "BAK"
+	case IsoLanguageCode_ISO_LANGUAGE_CODE_BEL:
+		return "BEL"
+	case IsoLanguageCode_ISO_LANGUAGE_CODE_BUL:
+		return "BUL"
+	case IsoLanguageCode_ISO_LANGUAGE_CODE_BIH:
+		return "BIH"
+	case IsoLanguageCode_ISO_LANGUAGE_CODE_BIS:
+		return "BIS"
+	case IsoLanguageCode_ISO_LANGUAGE_CODE_BAM:
+		return "BAM"
+	case IsoLanguageCode_ISO_LANGUAGE_CODE_BEN:
+		return "BEN"
+	case IsoLanguageCode_ISO_LANGUAGE_CODE_BOD:
+		return "BOD"
+	case IsoLanguageCode_ISO_LANGUAGE_CODE_BRE:
+		return "BRE"
+	case IsoLanguageCode_ISO_LANGUAGE_CODE_BOS:
+		return "BOS"
+	case IsoLanguageCode_ISO_LANGUAGE_CODE_CAT:
+		return "CAT"
+	case IsoLanguageCode_ISO_LANGUAGE_CODE_CHE:
+		return "CHE"
+	case IsoLanguageCode_ISO_LANGUAGE_CODE_CHA:
+		return "CHA"
+	case IsoLanguageCode_ISO_LANGUAGE_CODE_COS:
+		return "COS"
+	case IsoLanguageCode_ISO_LANGUAGE_CODE_CRE:
+		return "CRE"
+	case IsoLanguageCode_ISO_LANGUAGE_CODE_CES:
+		return "CES"
+	case IsoLanguageCode_ISO_LANGUAGE_CODE_CHU:
+		return "CHU"
+	case IsoLanguageCode_ISO_LANGUAGE_CODE_CHV:
+		return "CHV"
+	case IsoLanguageCode_ISO_LANGUAGE_CODE_CYM:
+		return "CYM"
+	case IsoLanguageCode_ISO_LANGUAGE_CODE_DAN:
+		return "DAN"
+	case IsoLanguageCode_ISO_LANGUAGE_CODE_DEU:
+		return "DEU"
+	case IsoLanguageCode_ISO_LANGUAGE_CODE_DIV:
+		return "DIV"
+	case IsoLanguageCode_ISO_LANGUAGE_CODE_DZO:
+		return "DZO"
+	case IsoLanguageCode_ISO_LANGUAGE_CODE_EWE:
+		return "EWE"
+	case IsoLanguageCode_ISO_LANGUAGE_CODE_ELL:
+		return "ELL"
+	case IsoLanguageCode_ISO_LANGUAGE_CODE_ENG:
+		return "ENG"
+	case IsoLanguageCode_ISO_LANGUAGE_CODE_EPO:
+		return "EPO"
+	case IsoLanguageCode_ISO_LANGUAGE_CODE_SPA:
+		return "SPA"
+	case IsoLanguageCode_ISO_LANGUAGE_CODE_EST:
+		return "EST"
+	case IsoLanguageCode_ISO_LANGUAGE_CODE_EUS:
+		return "EUS"
+	case IsoLanguageCode_ISO_LANGUAGE_CODE_FAS:
+		return "FAS"
+	case IsoLanguageCode_ISO_LANGUAGE_CODE_FUL:
+		return "FUL"
+	case IsoLanguageCode_ISO_LANGUAGE_CODE_FIN:
+		return "FIN"
+	case IsoLanguageCode_ISO_LANGUAGE_CODE_FIJ:
+		return "FIJ"
+	case IsoLanguageCode_ISO_LANGUAGE_CODE_FAO:
+		return "FAO"
+	case IsoLanguageCode_ISO_LANGUAGE_CODE_FRA:
+		return "FRA"
+	case IsoLanguageCode_ISO_LANGUAGE_CODE_FRY:
+		return "FRY"
+	case IsoLanguageCode_ISO_LANGUAGE_CODE_GLE:
+		return "GLE"
+	case IsoLanguageCode_ISO_LANGUAGE_CODE_GLA:
+		return "GLA"
+	case IsoLanguageCode_ISO_LANGUAGE_CODE_GLG:
+		return "GLG"
+	case IsoLanguageCode_ISO_LANGUAGE_CODE_GRN:
+		return "GRN"
+	case IsoLanguageCode_ISO_LANGUAGE_CODE_GUJ:
+		return "GUJ"
+	case IsoLanguageCode_ISO_LANGUAGE_CODE_GLV:
+		return "GLV"
+	case IsoLanguageCode_ISO_LANGUAGE_CODE_HAU:
+		return "HAU"
+	case IsoLanguageCode_ISO_LANGUAGE_CODE_HEB:
+		return "HEB"
+	case IsoLanguageCode_ISO_LANGUAGE_CODE_HIN:
+		return "HIN"
+	case IsoLanguageCode_ISO_LANGUAGE_CODE_HMO:
+		return "HMO"
+	case IsoLanguageCode_ISO_LANGUAGE_CODE_HRV:
+		return "HRV"
+	case IsoLanguageCode_ISO_LANGUAGE_CODE_HAT:
+		return "HAT"
+	case IsoLanguageCode_ISO_LANGUAGE_CODE_HUN:
+		return "HUN"
+	case IsoLanguageCode_ISO_LANGUAGE_CODE_HYE:
+		return "HYE"
+	case IsoLanguageCode_ISO_LANGUAGE_CODE_HER:
+		return "HER"
+	case IsoLanguageCode_ISO_LANGUAGE_CODE_INA:
+		return "INA"
+	case IsoLanguageCode_ISO_LANGUAGE_CODE_IND:
+		return "IND"
+	case IsoLanguageCode_ISO_LANGUAGE_CODE_ILE:
+		return "ILE"
+	case IsoLanguageCode_ISO_LANGUAGE_CODE_IBO:
+		return "IBO"
+	case IsoLanguageCode_ISO_LANGUAGE_CODE_III:
+		return "III"
+	case IsoLanguageCode_ISO_LANGUAGE_CODE_IPK:
+		return "IPK"
+	case IsoLanguageCode_ISO_LANGUAGE_CODE_IDO:
+		return "IDO"
+	case IsoLanguageCode_ISO_LANGUAGE_CODE_ISL:
+		return "ISL"
+	case IsoLanguageCode_ISO_LANGUAGE_CODE_ITA:
+		return "ITA"
+	case IsoLanguageCode_ISO_LANGUAGE_CODE_IKU:
+		return "IKU"
+	case IsoLanguageCode_ISO_LANGUAGE_CODE_JPN:
+		return "JPN"
+	case IsoLanguageCode_ISO_LANGUAGE_CODE_JAV:
+		return "JAV"
+	case IsoLanguageCode_ISO_LANGUAGE_CODE_KAT:
+		return "KAT"
+	case IsoLanguageCode_ISO_LANGUAGE_CODE_KON:
+		return "KON"
+	case IsoLanguageCode_ISO_LANGUAGE_CODE_KIK:
+		return "KIK"
+	case IsoLanguageCode_ISO_LANGUAGE_CODE_KUA:
+		return "KUA"
+	case IsoLanguageCode_ISO_LANGUAGE_CODE_KAZ:
+		return "KAZ"
+	case IsoLanguageCode_ISO_LANGUAGE_CODE_KAL:
+		return "KAL"
+	case IsoLanguageCode_ISO_LANGUAGE_CODE_KHM:
+		return "KHM"
+	case IsoLanguageCode_ISO_LANGUAGE_CODE_KAN:
+		return "KAN"
+	case IsoLanguageCode_ISO_LANGUAGE_CODE_KOR:
+		return "KOR"
+	case IsoLanguageCode_ISO_LANGUAGE_CODE_KAU:
+		return "KAU"
+	case IsoLanguageCode_ISO_LANGUAGE_CODE_KAS:
+		return "KAS"
+	case IsoLanguageCode_ISO_LANGUAGE_CODE_KUR:
+		return "KUR"
+	case IsoLanguageCode_ISO_LANGUAGE_CODE_KOM:
+		return "KOM"
+	case IsoLanguageCode_ISO_LANGUAGE_CODE_COR:
+		return "COR"
+	case IsoLanguageCode_ISO_LANGUAGE_CODE_KIR:
+		return "KIR"
+	case IsoLanguageCode_ISO_LANGUAGE_CODE_LAT:
+		return "LAT"
+	case IsoLanguageCode_ISO_LANGUAGE_CODE_LTZ:
+		return "LTZ"
+	case IsoLanguageCode_ISO_LANGUAGE_CODE_LUG:
+		return "LUG"
+	case IsoLanguageCode_ISO_LANGUAGE_CODE_LIM:
+		return "LIM"
+	case IsoLanguageCode_ISO_LANGUAGE_CODE_LIN:
+		return "LIN"
+	case IsoLanguageCode_ISO_LANGUAGE_CODE_LAO:
+		return "LAO"
+	case IsoLanguageCode_ISO_LANGUAGE_CODE_LIT:
+		return "LIT"
+	case IsoLanguageCode_ISO_LANGUAGE_CODE_LUB:
+		return "LUB"
+	case IsoLanguageCode_ISO_LANGUAGE_CODE_LAV:
+		return "LAV"
+	case IsoLanguageCode_ISO_LANGUAGE_CODE_MLG:
+		return "MLG"
+	case IsoLanguageCode_ISO_LANGUAGE_CODE_MAH:
+		return "MAH"
+	case IsoLanguageCode_ISO_LANGUAGE_CODE_MRI:
+		return "MRI"
+	case IsoLanguageCode_ISO_LANGUAGE_CODE_MKD:
+		return "MKD"
+	case IsoLanguageCode_ISO_LANGUAGE_CODE_MAL:
+		return "MAL"
+	case IsoLanguageCode_ISO_LANGUAGE_CODE_MON:
+		return "MON"
+	case IsoLanguageCode_ISO_LANGUAGE_CODE_MAR:
+		return "MAR"
+	case IsoLanguageCode_ISO_LANGUAGE_CODE_MSA:
+		return "MSA"
+	case IsoLanguageCode_ISO_LANGUAGE_CODE_MLT:
+		return "MLT"
+	case IsoLanguageCode_ISO_LANGUAGE_CODE_MYA:
+		return "MYA"
+	case IsoLanguageCode_ISO_LANGUAGE_CODE_NAU:
+		return "NAU"
+	case IsoLanguageCode_ISO_LANGUAGE_CODE_NOB:
+		return "NOB"
+	case IsoLanguageCode_ISO_LANGUAGE_CODE_NDE:
+		return "NDE"
+	case IsoLanguageCode_ISO_LANGUAGE_CODE_NEP:
+		return "NEP"
+	case IsoLanguageCode_ISO_LANGUAGE_CODE_NDO:
+		return "NDO"
+	case IsoLanguageCode_ISO_LANGUAGE_CODE_NLD:
+		return "NLD"
+	case IsoLanguageCode_ISO_LANGUAGE_CODE_NNO:
+		return "NNO"
+	case IsoLanguageCode_ISO_LANGUAGE_CODE_NOR:
+		return "NOR"
+	case IsoLanguageCode_ISO_LANGUAGE_CODE_NBL:
+		return "NBL"
+	case IsoLanguageCode_ISO_LANGUAGE_CODE_NAV:
+		return "NAV"
+	case IsoLanguageCode_ISO_LANGUAGE_CODE_NYA:
+		return "NYA"
+	case IsoLanguageCode_ISO_LANGUAGE_CODE_OCI:
+		return "OCI"
+	case IsoLanguageCode_ISO_LANGUAGE_CODE_OJI:
+		return "OJI"
+	case IsoLanguageCode_ISO_LANGUAGE_CODE_ORM:
+		return "ORM"
+	case IsoLanguageCode_ISO_LANGUAGE_CODE_ORI:
+		return "ORI"
+	case IsoLanguageCode_ISO_LANGUAGE_CODE_OSS:
+		return "OSS"
+	case IsoLanguageCode_ISO_LANGUAGE_CODE_PAN:
+		return "PAN"
+	case IsoLanguageCode_ISO_LANGUAGE_CODE_PLI:
+		return "PLI"
+	case IsoLanguageCode_ISO_LANGUAGE_CODE_POL:
+		return "POL"
+	case IsoLanguageCode_ISO_LANGUAGE_CODE_PUS:
+		return "PUS"
+	case IsoLanguageCode_ISO_LANGUAGE_CODE_POR:
+		return "POR"
+	case IsoLanguageCode_ISO_LANGUAGE_CODE_QUE:
+		return "QUE"
+	case IsoLanguageCode_ISO_LANGUAGE_CODE_ROH:
+		return "ROH"
+	case IsoLanguageCode_ISO_LANGUAGE_CODE_RUN:
+		return "RUN"
+	case IsoLanguageCode_ISO_LANGUAGE_CODE_RON:
+		return "RON"
+	case IsoLanguageCode_ISO_LANGUAGE_CODE_RUS:
+		return "RUS"
+	case IsoLanguageCode_ISO_LANGUAGE_CODE_KIN:
+		return "KIN"
+	case IsoLanguageCode_ISO_LANGUAGE_CODE_SAN:
+		return "SAN"
+	case IsoLanguageCode_ISO_LANGUAGE_CODE_SRD:
+		return "SRD"
+	case IsoLanguageCode_ISO_LANGUAGE_CODE_SND:
+		return "SND"
+	case IsoLanguageCode_ISO_LANGUAGE_CODE_SME:
+		return "SME"
+	case IsoLanguageCode_ISO_LANGUAGE_CODE_SAG:
+		return "SAG"
+	case IsoLanguageCode_ISO_LANGUAGE_CODE_SIN:
+		return "SIN"
+	case IsoLanguageCode_ISO_LANGUAGE_CODE_SLK:
+		return "SLK"
+	case IsoLanguageCode_ISO_LANGUAGE_CODE_SLV:
+		return "SLV"
+	case IsoLanguageCode_ISO_LANGUAGE_CODE_SMO:
+		return "SMO"
+	case IsoLanguageCode_ISO_LANGUAGE_CODE_SNA:
+		return "SNA"
+	case IsoLanguageCode_ISO_LANGUAGE_CODE_SOM:
+		return "SOM"
+	case IsoLanguageCode_ISO_LANGUAGE_CODE_SQI:
+		return "SQI"
+	case IsoLanguageCode_ISO_LANGUAGE_CODE_SRP:
+		return "SRP"
+	case IsoLanguageCode_ISO_LANGUAGE_CODE_SSW:
+		return "SSW"
+	case IsoLanguageCode_ISO_LANGUAGE_CODE_SOT:
+		return "SOT"
+	case IsoLanguageCode_ISO_LANGUAGE_CODE_SUN:
+		return "SUN"
+	case IsoLanguageCode_ISO_LANGUAGE_CODE_SWE:
+		return "SWE"
+	case IsoLanguageCode_ISO_LANGUAGE_CODE_SWA:
+		return "SWA"
+	case IsoLanguageCode_ISO_LANGUAGE_CODE_TAM:
+		return "TAM"
+	case IsoLanguageCode_ISO_LANGUAGE_CODE_TEL:
+		return "TEL"
+	case IsoLanguageCode_ISO_LANGUAGE_CODE_TGK:
+		return "TGK"
+	case IsoLanguageCode_ISO_LANGUAGE_CODE_THA:
+		return "THA"
+	case IsoLanguageCode_ISO_LANGUAGE_CODE_TIR:
+		return "TIR"
+	case IsoLanguageCode_ISO_LANGUAGE_CODE_TUK:
+		return "TUK"
+	case IsoLanguageCode_ISO_LANGUAGE_CODE_TGL:
+		return "TGL"
+	case IsoLanguageCode_ISO_LANGUAGE_CODE_TSN:
+		return "TSN"
+	case IsoLanguageCode_ISO_LANGUAGE_CODE_TON:
+		return "TON"
+	case IsoLanguageCode_ISO_LANGUAGE_CODE_TUR:
+		return "TUR"
+	case IsoLanguageCode_ISO_LANGUAGE_CODE_TSO:
+		return "TSO"
+	case IsoLanguageCode_ISO_LANGUAGE_CODE_TAT:
+		return "TAT"
+	case IsoLanguageCode_ISO_LANGUAGE_CODE_TWI:
+		return "TWI"
+	case IsoLanguageCode_ISO_LANGUAGE_CODE_TAH:
+		return "TAH"
+	case IsoLanguageCode_ISO_LANGUAGE_CODE_UIG:
+		return "UIG"
+	case IsoLanguageCode_ISO_LANGUAGE_CODE_UKR:
+		return "UKR"
+	case IsoLanguageCode_ISO_LANGUAGE_CODE_URD:
+		return "URD"
+	case IsoLanguageCode_ISO_LANGUAGE_CODE_UZB:
+		return "UZB"
+	case IsoLanguageCode_ISO_LANGUAGE_CODE_VEN:
+		return "VEN"
+	case IsoLanguageCode_ISO_LANGUAGE_CODE_VIE:
+		return "VIE"
+	case IsoLanguageCode_ISO_LANGUAGE_CODE_VOL:
+		return "VOL"
+	case IsoLanguageCode_ISO_LANGUAGE_CODE_WLN:
+		return "WLN"
+	case IsoLanguageCode_ISO_LANGUAGE_CODE_WOL:
+		return "WOL"
+	case IsoLanguageCode_ISO_LANGUAGE_CODE_XHO:
+		return "XHO"
+	case IsoLanguageCode_ISO_LANGUAGE_CODE_YID:
+		return "YID"
+	case IsoLanguageCode_ISO_LANGUAGE_CODE_YOR:
+		return "YOR"
+	case IsoLanguageCode_ISO_LANGUAGE_CODE_ZHA:
+		return "ZHA"
+	case IsoLanguageCode_ISO_LANGUAGE_CODE_ZHO:
+		return "ZHO"
+	case IsoLanguageCode_ISO_LANGUAGE_CODE_ZUL:
+		return "ZUL"
+	case IsoLanguageCode_ISO_LANGUAGE_CODE_ACE:
+		return "ACE"
+	case IsoLanguageCode_ISO_LANGUAGE_CODE_ACH:
+		return "ACH"
+	case IsoLanguageCode_ISO_LANGUAGE_CODE_ADA:
+		return "ADA"
+	case IsoLanguageCode_ISO_LANGUAGE_CODE_ADY:
+		return "ADY"
+	case IsoLanguageCode_ISO_LANGUAGE_CODE_AFA:
+		return "AFA"
+	case IsoLanguageCode_ISO_LANGUAGE_CODE_AFH:
+		return "AFH"
+	case IsoLanguageCode_ISO_LANGUAGE_CODE_AIN:
+		return "AIN"
+	case IsoLanguageCode_ISO_LANGUAGE_CODE_AKK:
+		return "AKK"
+	case IsoLanguageCode_ISO_LANGUAGE_CODE_ALE:
+		return "ALE"
+	case IsoLanguageCode_ISO_LANGUAGE_CODE_ALG:
+		return "ALG"
+	case IsoLanguageCode_ISO_LANGUAGE_CODE_ALT:
+		return "ALT"
+	case IsoLanguageCode_ISO_LANGUAGE_CODE_ANG:
+		return "ANG"
+	case IsoLanguageCode_ISO_LANGUAGE_CODE_ANP:
+		return "ANP"
+	case IsoLanguageCode_ISO_LANGUAGE_CODE_APA:
+		return "APA"
+	case IsoLanguageCode_ISO_LANGUAGE_CODE_ARC:
+		return "ARC"
+	case IsoLanguageCode_ISO_LANGUAGE_CODE_ARN:
+		return "ARN"
+	case IsoLanguageCode_ISO_LANGUAGE_CODE_ARP:
+		return "ARP"
+	case IsoLanguageCode_ISO_LANGUAGE_CODE_ART:
+		return "ART"
+	case IsoLanguageCode_ISO_LANGUAGE_CODE_ARW:
+		return "ARW"
+	case IsoLanguageCode_ISO_LANGUAGE_CODE_AST:
+		return "AST"
+	case IsoLanguageCode_ISO_LANGUAGE_CODE_ATH:
+		return "ATH"
+	case IsoLanguageCode_ISO_LANGUAGE_CODE_AUS:
+		return "AUS"
+	case IsoLanguageCode_ISO_LANGUAGE_CODE_AWA:
+		return "AWA"
+	case IsoLanguageCode_ISO_LANGUAGE_CODE_BAD:
+		return "BAD"
+	case IsoLanguageCode_ISO_LANGUAGE_CODE_BAI:
+		return "BAI"
+	case IsoLanguageCode_ISO_LANGUAGE_CODE_BAL:
+		return "BAL"
+	case IsoLanguageCode_ISO_LANGUAGE_CODE_BAN:
+		return "BAN"
+	case IsoLanguageCode_ISO_LANGUAGE_CODE_BAS:
+		return "BAS"
+	case IsoLanguageCode_ISO_LANGUAGE_CODE_BAT:
+		return "BAT"
+	case IsoLanguageCode_ISO_LANGUAGE_CODE_BEJ:
+		return "BEJ"
+	case IsoLanguageCode_ISO_LANGUAGE_CODE_BEM:
+		return "BEM"
+	case IsoLanguageCode_ISO_LANGUAGE_CODE_BER:
+		return "BER"
+	case IsoLanguageCode_ISO_LANGUAGE_CODE_BIK:
+		return "BIK"
+	case IsoLanguageCode_ISO_LANGUAGE_CODE_BIN:
+		return "BIN"
+	case IsoLanguageCode_ISO_LANGUAGE_CODE_BLA:
+		return "BLA"
+	case IsoLanguageCode_ISO_LANGUAGE_CODE_BNT:
+		return "BNT"
+	case IsoLanguageCode_ISO_LANGUAGE_CODE_BRA:
+		return "BRA"
+	case IsoLanguageCode_ISO_LANGUAGE_CODE_BTK:
+		return "BTK"
+	case IsoLanguageCode_ISO_LANGUAGE_CODE_BUA:
+		return "BUA"
+	case IsoLanguageCode_ISO_LANGUAGE_CODE_BUG:
+		return "BUG"
+	case IsoLanguageCode_ISO_LANGUAGE_CODE_BYN:
+		return "BYN"
+	case IsoLanguageCode_ISO_LANGUAGE_CODE_CAD:
+		return "CAD"
+	case IsoLanguageCode_ISO_LANGUAGE_CODE_CAI:
+		return "CAI"
+	case IsoLanguageCode_ISO_LANGUAGE_CODE_CAR:
+		return "CAR"
+	case IsoLanguageCode_ISO_LANGUAGE_CODE_CAU:
+		return "CAU"
+	case IsoLanguageCode_ISO_LANGUAGE_CODE_CEB:
+		return "CEB"
+	case IsoLanguageCode_ISO_LANGUAGE_CODE_CEL:
+		return "CEL"
+	case IsoLanguageCode_ISO_LANGUAGE_CODE_CHB:
+		return "CHB"
+	case IsoLanguageCode_ISO_LANGUAGE_CODE_CHG:
+		return "CHG"
+	case IsoLanguageCode_ISO_LANGUAGE_CODE_CHK:
+		return "CHK"
+	case IsoLanguageCode_ISO_LANGUAGE_CODE_CHM:
+		return "CHM"
+	case IsoLanguageCode_ISO_LANGUAGE_CODE_CHN:
+		return "CHN"
+	case IsoLanguageCode_ISO_LANGUAGE_CODE_CHO:
+		return "CHO"
+	case IsoLanguageCode_ISO_LANGUAGE_CODE_CHP:
+		return "CHP"
+	case IsoLanguageCode_ISO_LANGUAGE_CODE_CHR:
+		return "CHR"
+	case IsoLanguageCode_ISO_LANGUAGE_CODE_CHY:
+		return "CHY"
+	case IsoLanguageCode_ISO_LANGUAGE_CODE_CMC:
+		return "CMC"
+	case IsoLanguageCode_ISO_LANGUAGE_CODE_CNR:
+		return "CNR"
+	case IsoLanguageCode_ISO_LANGUAGE_CODE_COP:
+		return "COP"
+	case IsoLanguageCode_ISO_LANGUAGE_CODE_CPE:
+		return "CPE"
+	case IsoLanguageCode_ISO_LANGUAGE_CODE_CPF:
+		return "CPF"
+	case IsoLanguageCode_ISO_LANGUAGE_CODE_CPP:
+		return "CPP"
+	case IsoLanguageCode_ISO_LANGUAGE_CODE_CRH:
+		return "CRH"
+	case IsoLanguageCode_ISO_LANGUAGE_CODE_CRP:
+		return "CRP"
+	case IsoLanguageCode_ISO_LANGUAGE_CODE_CSB:
+		return "CSB"
+	case IsoLanguageCode_ISO_LANGUAGE_CODE_CUS:
+		return "CUS"
+	case IsoLanguageCode_ISO_LANGUAGE_CODE_DAK:
+		return "DAK"
+	case IsoLanguageCode_ISO_LANGUAGE_CODE_DAR:
+		return "DAR"
+	case IsoLanguageCode_ISO_LANGUAGE_CODE_DAY:
+		return "DAY"
+	case IsoLanguageCode_ISO_LANGUAGE_CODE_DEL:
+		return "DEL"
+	case IsoLanguageCode_ISO_LANGUAGE_CODE_DEN:
+		return "DEN"
+	case IsoLanguageCode_ISO_LANGUAGE_CODE_DGR:
+		return "DGR"
+	case IsoLanguageCode_ISO_LANGUAGE_CODE_DIN:
+		return "DIN"
+	case IsoLanguageCode_ISO_LANGUAGE_CODE_DOI:
+		return "DOI"
+	case IsoLanguageCode_ISO_LANGUAGE_CODE_DRA:
+		return "DRA"
+	case IsoLanguageCode_ISO_LANGUAGE_CODE_DSB:
+		return "DSB"
+	case IsoLanguageCode_ISO_LANGUAGE_CODE_DUA:
+		return "DUA"
+	case IsoLanguageCode_ISO_LANGUAGE_CODE_DUM:
+		return "DUM"
+	case IsoLanguageCode_ISO_LANGUAGE_CODE_DYU:
+		return "DYU"
+	case IsoLanguageCode_ISO_LANGUAGE_CODE_EFI:
+		return "EFI"
+	case IsoLanguageCode_ISO_LANGUAGE_CODE_EGY:
+		return "EGY"
+	case IsoLanguageCode_ISO_LANGUAGE_CODE_EKA:
+		return "EKA"
+	case IsoLanguageCode_ISO_LANGUAGE_CODE_ELX:
+		return "ELX"
+	case IsoLanguageCode_ISO_LANGUAGE_CODE_ENM:
+		return "ENM"
+	case IsoLanguageCode_ISO_LANGUAGE_CODE_EWO:
+		return "EWO"
+	case IsoLanguageCode_ISO_LANGUAGE_CODE_FAN:
+		return "FAN"
+	case IsoLanguageCode_ISO_LANGUAGE_CODE_FAT:
+		return "FAT"
+	case IsoLanguageCode_ISO_LANGUAGE_CODE_FIL:
+		return "FIL"
+	case IsoLanguageCode_ISO_LANGUAGE_CODE_FIU:
+		return "FIU"
+	case IsoLanguageCode_ISO_LANGUAGE_CODE_FON:
+		return "FON"
+	case IsoLanguageCode_ISO_LANGUAGE_CODE_FRM:
+		return "FRM"
+	case IsoLanguageCode_ISO_LANGUAGE_CODE_FRO:
+		return "FRO"
+	case IsoLanguageCode_ISO_LANGUAGE_CODE_FRR:
+		return "FRR"
+	case IsoLanguageCode_ISO_LANGUAGE_CODE_FRS:
+		return "FRS"
+	case IsoLanguageCode_ISO_LANGUAGE_CODE_FUR:
+		return "FUR"
+	case IsoLanguageCode_ISO_LANGUAGE_CODE_GAA:
+		return "GAA"
+	case IsoLanguageCode_ISO_LANGUAGE_CODE_GAY:
+		return "GAY"
+	case IsoLanguageCode_ISO_LANGUAGE_CODE_GBA:
+		return "GBA"
+	case IsoLanguageCode_ISO_LANGUAGE_CODE_GEM:
+		return "GEM"
+	case IsoLanguageCode_ISO_LANGUAGE_CODE_GEZ:
+		return "GEZ"
+	case IsoLanguageCode_ISO_LANGUAGE_CODE_GIL:
+		return "GIL"
+	case IsoLanguageCode_ISO_LANGUAGE_CODE_GMH:
+		return "GMH"
+	case IsoLanguageCode_ISO_LANGUAGE_CODE_GOH:
+		return "GOH"
+	case IsoLanguageCode_ISO_LANGUAGE_CODE_GON:
+		return "GON"
+	case IsoLanguageCode_ISO_LANGUAGE_CODE_GOR:
+		return "GOR"
+	case IsoLanguageCode_ISO_LANGUAGE_CODE_GOT:
+		return "GOT"
+	case IsoLanguageCode_ISO_LANGUAGE_CODE_GRB:
+		return "GRB"
+	case IsoLanguageCode_ISO_LANGUAGE_CODE_GRC:
+		return "GRC"
+	case IsoLanguageCode_ISO_LANGUAGE_CODE_GSW:
+		return "GSW"
+	case IsoLanguageCode_ISO_LANGUAGE_CODE_GWI:
+		return "GWI"
+	case IsoLanguageCode_ISO_LANGUAGE_CODE_HAI:
+		return "HAI"
+	case IsoLanguageCode_ISO_LANGUAGE_CODE_HAW:
+		return "HAW"
+	case IsoLanguageCode_ISO_LANGUAGE_CODE_HIL:
+		return "HIL"
+	case IsoLanguageCode_ISO_LANGUAGE_CODE_HIM:
+		return "HIM"
+	case IsoLanguageCode_ISO_LANGUAGE_CODE_HIT:
+		return "HIT"
+	case IsoLanguageCode_ISO_LANGUAGE_CODE_HMN:
+		return "HMN"
+	case IsoLanguageCode_ISO_LANGUAGE_CODE_HSB:
+		return "HSB"
+	case IsoLanguageCode_ISO_LANGUAGE_CODE_HUP:
+		return "HUP"
+	case IsoLanguageCode_ISO_LANGUAGE_CODE_IBA:
+		return "IBA"
+	case IsoLanguageCode_ISO_LANGUAGE_CODE_IJO:
+		return "IJO"
+	case IsoLanguageCode_ISO_LANGUAGE_CODE_ILO:
+		return "ILO"
+	case IsoLanguageCode_ISO_LANGUAGE_CODE_INC:
+		return "INC"
+	case IsoLanguageCode_ISO_LANGUAGE_CODE_INE:
+		return "INE"
+	case IsoLanguageCode_ISO_LANGUAGE_CODE_INH:
+		return "INH"
+	case IsoLanguageCode_ISO_LANGUAGE_CODE_IRA:
+		return "IRA"
+	case IsoLanguageCode_ISO_LANGUAGE_CODE_IRO:
+		return "IRO"
+	case IsoLanguageCode_ISO_LANGUAGE_CODE_JBO:
+		return "JBO"
+	case IsoLanguageCode_ISO_LANGUAGE_CODE_JPR:
+		return "JPR"
+	case IsoLanguageCode_ISO_LANGUAGE_CODE_JRB:
+		return "JRB"
+	case IsoLanguageCode_ISO_LANGUAGE_CODE_KAA:
+		return "KAA"
+	case IsoLanguageCode_ISO_LANGUAGE_CODE_KAB:
+		return "KAB"
+	case IsoLanguageCode_ISO_LANGUAGE_CODE_KAC:
+		return "KAC"
+	case IsoLanguageCode_ISO_LANGUAGE_CODE_KAM:
+		return "KAM"
+	case IsoLanguageCode_ISO_LANGUAGE_CODE_KAR:
+		return "KAR"
+	case IsoLanguageCode_ISO_LANGUAGE_CODE_KAW:
+		return "KAW"
+	case IsoLanguageCode_ISO_LANGUAGE_CODE_KBD:
+		return "KBD"
+	case IsoLanguageCode_ISO_LANGUAGE_CODE_KHA:
+		return "KHA"
+	case IsoLanguageCode_ISO_LANGUAGE_CODE_KHI:
+		return "KHI"
+	case IsoLanguageCode_ISO_LANGUAGE_CODE_KHO:
+		return "KHO"
+	case IsoLanguageCode_ISO_LANGUAGE_CODE_KMB:
+		return "KMB"
+	case IsoLanguageCode_ISO_LANGUAGE_CODE_KOK:
+		return "KOK"
+	case IsoLanguageCode_ISO_LANGUAGE_CODE_KOS:
+		return "KOS"
+	case IsoLanguageCode_ISO_LANGUAGE_CODE_KPE:
+		return "KPE"
+	case IsoLanguageCode_ISO_LANGUAGE_CODE_KRC:
+		return "KRC"
+	case IsoLanguageCode_ISO_LANGUAGE_CODE_KRL:
+		return "KRL"
+	case IsoLanguageCode_ISO_LANGUAGE_CODE_KRO:
+		return "KRO"
+	case IsoLanguageCode_ISO_LANGUAGE_CODE_KRU:
+		return "KRU"
+	case IsoLanguageCode_ISO_LANGUAGE_CODE_KUM:
+		return "KUM"
+	case IsoLanguageCode_ISO_LANGUAGE_CODE_KUT:
+		return "KUT"
+	case IsoLanguageCode_ISO_LANGUAGE_CODE_LAD:
+		return "LAD"
+	case IsoLanguageCode_ISO_LANGUAGE_CODE_LAH:
+		return "LAH"
+	case IsoLanguageCode_ISO_LANGUAGE_CODE_LAM:
+		return "LAM"
+	case IsoLanguageCode_ISO_LANGUAGE_CODE_LEZ:
+		return "LEZ"
+	case IsoLanguageCode_ISO_LANGUAGE_CODE_LOL:
+		return "LOL"
+	case IsoLanguageCode_ISO_LANGUAGE_CODE_LOZ:
+		return "LOZ"
+	case IsoLanguageCode_ISO_LANGUAGE_CODE_LUA:
+		return "LUA"
+	case IsoLanguageCode_ISO_LANGUAGE_CODE_LUI:
+		return "LUI"
+	case IsoLanguageCode_ISO_LANGUAGE_CODE_LUN:
+		return "LUN"
+	case IsoLanguageCode_ISO_LANGUAGE_CODE_LUO:
+		return "LUO"
+	case IsoLanguageCode_ISO_LANGUAGE_CODE_LUS:
+		return "LUS"
+	case IsoLanguageCode_ISO_LANGUAGE_CODE_MAD:
+		return "MAD"
+	case IsoLanguageCode_ISO_LANGUAGE_CODE_MAG:
+		return "MAG"
+	case IsoLanguageCode_ISO_LANGUAGE_CODE_MAI:
+		return "MAI"
+	case IsoLanguageCode_ISO_LANGUAGE_CODE_MAK:
+		return "MAK"
+	case IsoLanguageCode_ISO_LANGUAGE_CODE_MAN:
+		return "MAN"
+	case IsoLanguageCode_ISO_LANGUAGE_CODE_MAP:
+		return "MAP"
+	case IsoLanguageCode_ISO_LANGUAGE_CODE_MAS:
+		return "MAS"
+	case IsoLanguageCode_ISO_LANGUAGE_CODE_MDF:
+		return "MDF"
+	case IsoLanguageCode_ISO_LANGUAGE_CODE_MDR:
+		return "MDR"
+	case IsoLanguageCode_ISO_LANGUAGE_CODE_MEN:
+		return "MEN"
+	case IsoLanguageCode_ISO_LANGUAGE_CODE_MGA:
+		return "MGA"
+	case IsoLanguageCode_ISO_LANGUAGE_CODE_MIC:
+		return "MIC"
+	case IsoLanguageCode_ISO_LANGUAGE_CODE_MIN:
+		return "MIN"
+	case IsoLanguageCode_ISO_LANGUAGE_CODE_MIS:
+		return "MIS"
+	case IsoLanguageCode_ISO_LANGUAGE_CODE_MKH:
+		return "MKH"
+	case IsoLanguageCode_ISO_LANGUAGE_CODE_MNC:
+		return "MNC"
+	case IsoLanguageCode_ISO_LANGUAGE_CODE_MNI:
+		return "MNI"
+	case IsoLanguageCode_ISO_LANGUAGE_CODE_MNO:
+		return "MNO"
+	case IsoLanguageCode_ISO_LANGUAGE_CODE_MOH:
+		return "MOH"
+	case IsoLanguageCode_ISO_LANGUAGE_CODE_MOS:
+		return "MOS"
+	case IsoLanguageCode_ISO_LANGUAGE_CODE_MUL:
+		return "MUL"
+	case IsoLanguageCode_ISO_LANGUAGE_CODE_MUN:
+		return "MUN"
+	case IsoLanguageCode_ISO_LANGUAGE_CODE_MUS:
+		return "MUS"
+	case IsoLanguageCode_ISO_LANGUAGE_CODE_MWL:
+		return "MWL"
+	case IsoLanguageCode_ISO_LANGUAGE_CODE_MWR:
+		return "MWR"
+	case IsoLanguageCode_ISO_LANGUAGE_CODE_MYN:
+		return "MYN"
+	case IsoLanguageCode_ISO_LANGUAGE_CODE_MYV:
+		return "MYV"
+	case IsoLanguageCode_ISO_LANGUAGE_CODE_NAH:
+		return "NAH"
+	case IsoLanguageCode_ISO_LANGUAGE_CODE_NAI:
+		return "NAI"
+	case IsoLanguageCode_ISO_LANGUAGE_CODE_NAP:
+		return "NAP"
+	case IsoLanguageCode_ISO_LANGUAGE_CODE_NDS:
+		return "NDS"
+	case IsoLanguageCode_ISO_LANGUAGE_CODE_NEW:
+		return "NEW"
+	case IsoLanguageCode_ISO_LANGUAGE_CODE_NIA:
+		return "NIA"
+	case IsoLanguageCode_ISO_LANGUAGE_CODE_NIC:
+		return "NIC"
+	case IsoLanguageCode_ISO_LANGUAGE_CODE_NIU:
+		return "NIU"
+	case IsoLanguageCode_ISO_LANGUAGE_CODE_NOG:
+		return "NOG"
+	case IsoLanguageCode_ISO_LANGUAGE_CODE_NON:
+		return "NON"
+	case IsoLanguageCode_ISO_LANGUAGE_CODE_NQO:
+		return "NQO"
+	case IsoLanguageCode_ISO_LANGUAGE_CODE_NSO:
+		return "NSO"
+	case IsoLanguageCode_ISO_LANGUAGE_CODE_NUB:
+		return "NUB"
+	case IsoLanguageCode_ISO_LANGUAGE_CODE_NWC:
+		return "NWC"
+	case IsoLanguageCode_ISO_LANGUAGE_CODE_NYM:
+		return "NYM"
+	case IsoLanguageCode_ISO_LANGUAGE_CODE_NYN:
+		return "NYN"
+	case IsoLanguageCode_ISO_LANGUAGE_CODE_NYO:
+		return "NYO"
+	case IsoLanguageCode_ISO_LANGUAGE_CODE_NZI:
+		return "NZI"
+	case IsoLanguageCode_ISO_LANGUAGE_CODE_OSA:
+		return "OSA"
+	case IsoLanguageCode_ISO_LANGUAGE_CODE_OTA:
+		return "OTA"
+	case IsoLanguageCode_ISO_LANGUAGE_CODE_OTO:
+		return "OTO"
+	case IsoLanguageCode_ISO_LANGUAGE_CODE_PAA:
+		return "PAA"
+	case IsoLanguageCode_ISO_LANGUAGE_CODE_PAG:
+		return "PAG"
+	case IsoLanguageCode_ISO_LANGUAGE_CODE_PAL:
+		return "PAL"
+	case IsoLanguageCode_ISO_LANGUAGE_CODE_PAM:
+		return "PAM"
+	case IsoLanguageCode_ISO_LANGUAGE_CODE_PAP:
+		return "PAP"
+	case IsoLanguageCode_ISO_LANGUAGE_CODE_PAU:
+		return "PAU"
+	case IsoLanguageCode_ISO_LANGUAGE_CODE_PEO:
+		return "PEO"
+	case IsoLanguageCode_ISO_LANGUAGE_CODE_PHI:
+		return "PHI"
+	case IsoLanguageCode_ISO_LANGUAGE_CODE_PHN:
+		return "PHN"
+	case IsoLanguageCode_ISO_LANGUAGE_CODE_PON:
+		return "PON"
+	case IsoLanguageCode_ISO_LANGUAGE_CODE_PRA:
+		return "PRA"
+	case IsoLanguageCode_ISO_LANGUAGE_CODE_PRO:
+		return "PRO"
+	case IsoLanguageCode_ISO_LANGUAGE_CODE_RAP:
+		return "RAP"
+	case IsoLanguageCode_ISO_LANGUAGE_CODE_RAR:
+		return "RAR"
+	case IsoLanguageCode_ISO_LANGUAGE_CODE_ROA:
+		return "ROA"
+	case IsoLanguageCode_ISO_LANGUAGE_CODE_ROM:
+		return "ROM"
+	case IsoLanguageCode_ISO_LANGUAGE_CODE_RUP:
+		return "RUP"
+	case IsoLanguageCode_ISO_LANGUAGE_CODE_SAD:
+		return "SAD"
+	case IsoLanguageCode_ISO_LANGUAGE_CODE_SAH:
+		return "SAH"
+	case IsoLanguageCode_ISO_LANGUAGE_CODE_SAI:
+		return "SAI"
+	case IsoLanguageCode_ISO_LANGUAGE_CODE_SAL:
+		return "SAL"
+	case IsoLanguageCode_ISO_LANGUAGE_CODE_SAM:
+		return "SAM"
+	case IsoLanguageCode_ISO_LANGUAGE_CODE_SAS:
+		return "SAS"
+	case IsoLanguageCode_ISO_LANGUAGE_CODE_SAT:
+		return "SAT"
+	case IsoLanguageCode_ISO_LANGUAGE_CODE_SCN:
+		return "SCN"
+	case IsoLanguageCode_ISO_LANGUAGE_CODE_SCO:
+		return "SCO"
+	case IsoLanguageCode_ISO_LANGUAGE_CODE_SEL:
+		return "SEL"
+	case IsoLanguageCode_ISO_LANGUAGE_CODE_SEM:
+		return "SEM"
+	case IsoLanguageCode_ISO_LANGUAGE_CODE_SGA:
+		return "SGA"
+	case IsoLanguageCode_ISO_LANGUAGE_CODE_SGN:
+		return "SGN"
+	case IsoLanguageCode_ISO_LANGUAGE_CODE_SHN:
+		return "SHN"
+	case IsoLanguageCode_ISO_LANGUAGE_CODE_SID:
+		return "SID"
+	case IsoLanguageCode_ISO_LANGUAGE_CODE_SIO:
+		return "SIO"
+	case IsoLanguageCode_ISO_LANGUAGE_CODE_SIT:
+		return "SIT"
+	case IsoLanguageCode_ISO_LANGUAGE_CODE_SLA:
+		return "SLA"
+	case IsoLanguageCode_ISO_LANGUAGE_CODE_SMA:
+		return "SMA"
+	case IsoLanguageCode_ISO_LANGUAGE_CODE_SMI:
+		return "SMI"
+	case IsoLanguageCode_ISO_LANGUAGE_CODE_SMJ:
+		return "SMJ"
+	case IsoLanguageCode_ISO_LANGUAGE_CODE_SMN:
+		return "SMN"
+	case IsoLanguageCode_ISO_LANGUAGE_CODE_SMS:
+		return "SMS"
+	case IsoLanguageCode_ISO_LANGUAGE_CODE_SNK:
+		return "SNK"
+	case IsoLanguageCode_ISO_LANGUAGE_CODE_SOG:
+		return "SOG"
+	case IsoLanguageCode_ISO_LANGUAGE_CODE_SON:
+		return "SON"
+	case IsoLanguageCode_ISO_LANGUAGE_CODE_SRN:
+		return "SRN"
+	case IsoLanguageCode_ISO_LANGUAGE_CODE_SRR:
+		return "SRR"
+	case IsoLanguageCode_ISO_LANGUAGE_CODE_SSA:
+		return "SSA"
+	case IsoLanguageCode_ISO_LANGUAGE_CODE_SUK:
+		return "SUK"
+	case IsoLanguageCode_ISO_LANGUAGE_CODE_SUS:
+		return "SUS"
+	case IsoLanguageCode_ISO_LANGUAGE_CODE_SUX:
+		return "SUX"
+	case IsoLanguageCode_ISO_LANGUAGE_CODE_SYC:
+		return "SYC"
+	case IsoLanguageCode_ISO_LANGUAGE_CODE_SYR:
+		return "SYR"
+	case IsoLanguageCode_ISO_LANGUAGE_CODE_TAI:
+		return "TAI"
+	case IsoLanguageCode_ISO_LANGUAGE_CODE_TEM:
+		return "TEM"
+	case IsoLanguageCode_ISO_LANGUAGE_CODE_TER:
+		return "TER"
+	case IsoLanguageCode_ISO_LANGUAGE_CODE_TET:
+		return "TET"
+	case IsoLanguageCode_ISO_LANGUAGE_CODE_TIG:
+		return "TIG"
+	case IsoLanguageCode_ISO_LANGUAGE_CODE_TIV:
+		return "TIV"
+	case IsoLanguageCode_ISO_LANGUAGE_CODE_TKL:
+		return "TKL"
+	case IsoLanguageCode_ISO_LANGUAGE_CODE_TLH:
+		return "TLH"
+	case IsoLanguageCode_ISO_LANGUAGE_CODE_TLI:
+		return "TLI"
+	case IsoLanguageCode_ISO_LANGUAGE_CODE_TMH:
+		return "TMH"
+	case IsoLanguageCode_ISO_LANGUAGE_CODE_TOG:
+		return "TOG"
+	case IsoLanguageCode_ISO_LANGUAGE_CODE_TPI:
+		return "TPI"
+	case IsoLanguageCode_ISO_LANGUAGE_CODE_TSI:
+		return "TSI"
+	case IsoLanguageCode_ISO_LANGUAGE_CODE_TUM:
+		return "TUM"
+	case IsoLanguageCode_ISO_LANGUAGE_CODE_TUP:
+		return "TUP"
+	case IsoLanguageCode_ISO_LANGUAGE_CODE_TUT:
+		return "TUT"
+	case IsoLanguageCode_ISO_LANGUAGE_CODE_TVL:
+		return "TVL"
+	case IsoLanguageCode_ISO_LANGUAGE_CODE_TYV:
+		return "TYV"
+	case IsoLanguageCode_ISO_LANGUAGE_CODE_UDM:
+		return "UDM"
+	case IsoLanguageCode_ISO_LANGUAGE_CODE_UGA:
+		return "UGA"
+	case IsoLanguageCode_ISO_LANGUAGE_CODE_UMB:
+		return "UMB"
+	case IsoLanguageCode_ISO_LANGUAGE_CODE_UND:
+		return "UND"
+	case IsoLanguageCode_ISO_LANGUAGE_CODE_VAI:
+		return "VAI"
+	case IsoLanguageCode_ISO_LANGUAGE_CODE_VOT:
+		return "VOT"
+	case IsoLanguageCode_ISO_LANGUAGE_CODE_WAK:
+		return "WAK"
+	case IsoLanguageCode_ISO_LANGUAGE_CODE_WAL:
+		return "WAL"
+	case IsoLanguageCode_ISO_LANGUAGE_CODE_WAR:
+		return "WAR"
+	case IsoLanguageCode_ISO_LANGUAGE_CODE_WAS:
+		return "WAS"
+	case IsoLanguageCode_ISO_LANGUAGE_CODE_WEN:
+		return "WEN"
+	case IsoLanguageCode_ISO_LANGUAGE_CODE_XAL:
+		return "XAL"
+	case IsoLanguageCode_ISO_LANGUAGE_CODE_YAO:
+		return "YAO"
+	case IsoLanguageCode_ISO_LANGUAGE_CODE_YAP:
+		return "YAP"
+	case IsoLanguageCode_ISO_LANGUAGE_CODE_YPK:
+		return "YPK"
+	case IsoLanguageCode_ISO_LANGUAGE_CODE_ZAP:
+		return "ZAP"
+	case IsoLanguageCode_ISO_LANGUAGE_CODE_ZBL:
+		return "ZBL"
+	case IsoLanguageCode_ISO_LANGUAGE_CODE_ZEN:
+		return "ZEN"
+	case IsoLanguageCode_ISO_LANGUAGE_CODE_ZGH:
+		return "ZGH"
+	case IsoLanguageCode_ISO_LANGUAGE_CODE_ZND:
+		return "ZND"
+	case IsoLanguageCode_ISO_LANGUAGE_CODE_ZUN:
+		return "ZUN"
+	case IsoLanguageCode_ISO_LANGUAGE_CODE_ZXX:
+		return "ZXX"
+	case IsoLanguageCode_ISO_LANGUAGE_CODE_ZZA:
+		return "ZZA"
+	case IsoLanguageCode_ISO_LANGUAGE_CODE_AAA:
+		return "AAA"
+	case IsoLanguageCode_ISO_LANGUAGE_CODE_HNE:
+		return "HNE"
+	case IsoLanguageCode_ISO_LANGUAGE_CODE_GBM:
+		return "GBM"
+	case IsoLanguageCode_ISO_LANGUAGE_CODE_KHW:
+		return "KHW"
+	case IsoLanguageCode_ISO_LANGUAGE_CODE_CMN:
+		return "CMN"
+	case IsoLanguageCode_ISO_LANGUAGE_CODE_SCK:
+		return "SCK"
+	case IsoLanguageCode_ISO_LANGUAGE_CODE_SPV:
+		return "SPV"
+	case IsoLanguageCode_ISO_LANGUAGE_CODE_SCL:
+		return "SCL"
+	case IsoLanguageCode_ISO_LANGUAGE_CODE_YUE:
+		return "YUE"
+	case IsoLanguageCode_ISO_LANGUAGE_CODE_TCY:
+		return "TCY"
+	case IsoLanguageCode_ISO_LANGUAGE_CODE_KEY:
+		return "KEY"
+	case IsoLanguageCode_ISO_LANGUAGE_CODE_GCF:
+		return "GCF"
+	case IsoLanguageCode_ISO_LANGUAGE_CODE_JAM:
+		return "JAM"
+	case IsoLanguageCode_ISO_LANGUAGE_CODE_MCM:
+		return "MCM"
 	default:
 		return ""
 	}
@@ -900,6 +9806,1398 @@ func (e IsoLanguageCode) XMLString() string {
 func ParseIsoLanguageCodeString(s string) (IsoLanguageCode, bool) {
 	s = strings.ToUpper(s)
 	switch s {
+	case "RAJ":
+		return IsoLanguageCode_ISO_LANGUAGE_CODE_RAJ, true
+	case "BHO":
+		return IsoLanguageCode_ISO_LANGUAGE_CODE_BHO, true
+	case "AA":
+		return IsoLanguageCode_ISO_LANGUAGE_CODE_AA, true
+	case "AB":
+		return IsoLanguageCode_ISO_LANGUAGE_CODE_AB, true
+	case "AE":
+		return IsoLanguageCode_ISO_LANGUAGE_CODE_AE, true
+	case "AF":
+		return IsoLanguageCode_ISO_LANGUAGE_CODE_AF, true
+	case "AK":
+		return IsoLanguageCode_ISO_LANGUAGE_CODE_AK, true
+	case "AM":
+		return IsoLanguageCode_ISO_LANGUAGE_CODE_AM, true
+	case "AN":
+		return IsoLanguageCode_ISO_LANGUAGE_CODE_AN, true
+	case "AR":
+		return IsoLanguageCode_ISO_LANGUAGE_CODE_AR, true
+	case "AS":
+		return IsoLanguageCode_ISO_LANGUAGE_CODE_AS, true
+	case "AV":
+		return IsoLanguageCode_ISO_LANGUAGE_CODE_AV, true
+	case "AY":
+		return IsoLanguageCode_ISO_LANGUAGE_CODE_AY, true
+	case "AZ":
+		return IsoLanguageCode_ISO_LANGUAGE_CODE_AZ, true
+	case "BA":
+		return IsoLanguageCode_ISO_LANGUAGE_CODE_BA, true
+	case "BE":
+		return IsoLanguageCode_ISO_LANGUAGE_CODE_BE, true
+	case "BG":
+		return IsoLanguageCode_ISO_LANGUAGE_CODE_BG, true
+	case "BH":
+		return IsoLanguageCode_ISO_LANGUAGE_CODE_BH, true
+	case "BI":
+		return IsoLanguageCode_ISO_LANGUAGE_CODE_BI, true
+	case "BM":
+		return IsoLanguageCode_ISO_LANGUAGE_CODE_BM, true
+	case "BN":
+		return IsoLanguageCode_ISO_LANGUAGE_CODE_BN, true
+	case "BO":
+		return IsoLanguageCode_ISO_LANGUAGE_CODE_BO, true
+	case "BR":
+		return IsoLanguageCode_ISO_LANGUAGE_CODE_BR, true
+	case "BS":
+		return IsoLanguageCode_ISO_LANGUAGE_CODE_BS, true
+	case "CA":
+		return IsoLanguageCode_ISO_LANGUAGE_CODE_CA, true
+	case "CE":
+		return IsoLanguageCode_ISO_LANGUAGE_CODE_CE, true
+	case "CH":
+		return IsoLanguageCode_ISO_LANGUAGE_CODE_CH, true
+	case "CO":
+		return IsoLanguageCode_ISO_LANGUAGE_CODE_CO, true
+	case "CR":
+		return IsoLanguageCode_ISO_LANGUAGE_CODE_CR, true
+	case "CS":
+		return IsoLanguageCode_ISO_LANGUAGE_CODE_CS, true
+	case "CU":
+		return IsoLanguageCode_ISO_LANGUAGE_CODE_CU, true
+	case "CV":
+		return IsoLanguageCode_ISO_LANGUAGE_CODE_CV, true
+	case "CY":
+		return IsoLanguageCode_ISO_LANGUAGE_CODE_CY, true
+	case "DA":
+		return IsoLanguageCode_ISO_LANGUAGE_CODE_DA, true
+	case "DE":
+		return IsoLanguageCode_ISO_LANGUAGE_CODE_DE, true
+	case "DV":
+		return IsoLanguageCode_ISO_LANGUAGE_CODE_DV, true
+	case "DZ":
+		return IsoLanguageCode_ISO_LANGUAGE_CODE_DZ, true
+	case "EE":
+		return IsoLanguageCode_ISO_LANGUAGE_CODE_EE, true
+	case "EL":
+		return IsoLanguageCode_ISO_LANGUAGE_CODE_EL, true
+	case "EN":
+		return IsoLanguageCode_ISO_LANGUAGE_CODE_EN, true
+	case "EO":
+		return IsoLanguageCode_ISO_LANGUAGE_CODE_EO, true
+	case "ES":
+		return IsoLanguageCode_ISO_LANGUAGE_CODE_ES, true
+	case "ET":
+		return IsoLanguageCode_ISO_LANGUAGE_CODE_ET, true
+	case "EU":
+		return IsoLanguageCode_ISO_LANGUAGE_CODE_EU, true
+	case "FA":
+		return IsoLanguageCode_ISO_LANGUAGE_CODE_FA, true
+	case "FF":
+		return IsoLanguageCode_ISO_LANGUAGE_CODE_FF, true
+	case "FI":
+		return IsoLanguageCode_ISO_LANGUAGE_CODE_FI, true
+	case "FJ":
+		return IsoLanguageCode_ISO_LANGUAGE_CODE_FJ, true
+	case "FO":
+		return IsoLanguageCode_ISO_LANGUAGE_CODE_FO, true
+	case "FR":
+		return IsoLanguageCode_ISO_LANGUAGE_CODE_FR, true
+	case "FY":
+		return IsoLanguageCode_ISO_LANGUAGE_CODE_FY, true
+	case "GA":
+		return IsoLanguageCode_ISO_LANGUAGE_CODE_GA, true
+	case "GD":
+		return IsoLanguageCode_ISO_LANGUAGE_CODE_GD, true
+	case "GL":
+		return IsoLanguageCode_ISO_LANGUAGE_CODE_GL, true
+	case "GN":
+		return IsoLanguageCode_ISO_LANGUAGE_CODE_GN, true
+	case "GU":
+		return IsoLanguageCode_ISO_LANGUAGE_CODE_GU, true
+	case "GV":
+		return IsoLanguageCode_ISO_LANGUAGE_CODE_GV, true
+	case "HA":
+		return IsoLanguageCode_ISO_LANGUAGE_CODE_HA, true
+	case "HE":
+		return IsoLanguageCode_ISO_LANGUAGE_CODE_HE, true
+	case "HI":
+		return IsoLanguageCode_ISO_LANGUAGE_CODE_HI, true
+	case "HO":
+		return IsoLanguageCode_ISO_LANGUAGE_CODE_HO, true
+	case "HR":
+		return IsoLanguageCode_ISO_LANGUAGE_CODE_HR, true
+	case "HT":
+		return IsoLanguageCode_ISO_LANGUAGE_CODE_HT, true
+	case "HU":
+		return IsoLanguageCode_ISO_LANGUAGE_CODE_HU, true
+	case "HY":
+		return IsoLanguageCode_ISO_LANGUAGE_CODE_HY, true
+	case "HZ":
+		return IsoLanguageCode_ISO_LANGUAGE_CODE_HZ, true
+	case "IA":
+		return IsoLanguageCode_ISO_LANGUAGE_CODE_IA, true
+	case "ID":
+		return IsoLanguageCode_ISO_LANGUAGE_CODE_ID, true
+	case "IE":
+		return IsoLanguageCode_ISO_LANGUAGE_CODE_IE, true
+	case "IG":
+		return IsoLanguageCode_ISO_LANGUAGE_CODE_IG, true
+	case "II":
+		return IsoLanguageCode_ISO_LANGUAGE_CODE_II, true
+	case "IK":
+		return IsoLanguageCode_ISO_LANGUAGE_CODE_IK, true
+	case "IO":
+		return IsoLanguageCode_ISO_LANGUAGE_CODE_IO, true
+	case "IS":
+		return IsoLanguageCode_ISO_LANGUAGE_CODE_IS, true
+	case "IT":
+		return IsoLanguageCode_ISO_LANGUAGE_CODE_IT, true
+	case "IU":
+		return IsoLanguageCode_ISO_LANGUAGE_CODE_IU, true
+	case "JA":
+		return IsoLanguageCode_ISO_LANGUAGE_CODE_JA, true
+	case "JV":
+		return IsoLanguageCode_ISO_LANGUAGE_CODE_JV, true
+	case "KA":
+		return IsoLanguageCode_ISO_LANGUAGE_CODE_KA, true
+	case "KG":
+		return IsoLanguageCode_ISO_LANGUAGE_CODE_KG, true
+	case "KI":
+		return IsoLanguageCode_ISO_LANGUAGE_CODE_KI, true
+	case "KJ":
+		return IsoLanguageCode_ISO_LANGUAGE_CODE_KJ, true
+	case "KK":
+		return IsoLanguageCode_ISO_LANGUAGE_CODE_KK, true
+	case "KL":
+		return IsoLanguageCode_ISO_LANGUAGE_CODE_KL, true
+	case "KM":
+		return IsoLanguageCode_ISO_LANGUAGE_CODE_KM, true
+	case "KN":
+		return IsoLanguageCode_ISO_LANGUAGE_CODE_KN, true
+	case "KO":
+		return IsoLanguageCode_ISO_LANGUAGE_CODE_KO, true
+	case "KR":
+		return IsoLanguageCode_ISO_LANGUAGE_CODE_KR, true
+	case "KS":
+		return IsoLanguageCode_ISO_LANGUAGE_CODE_KS, true
+	case "KU":
+		return IsoLanguageCode_ISO_LANGUAGE_CODE_KU, true
+	case "KV":
+		return IsoLanguageCode_ISO_LANGUAGE_CODE_KV, true
+	case "KW":
+		return IsoLanguageCode_ISO_LANGUAGE_CODE_KW, true
+	case "KY":
+		return IsoLanguageCode_ISO_LANGUAGE_CODE_KY, true
+	case "LA":
+		return IsoLanguageCode_ISO_LANGUAGE_CODE_LA, true
+	case "LB":
+		return IsoLanguageCode_ISO_LANGUAGE_CODE_LB, true
+	case "LG":
+		return IsoLanguageCode_ISO_LANGUAGE_CODE_LG, true
+	case "LI":
+		return IsoLanguageCode_ISO_LANGUAGE_CODE_LI, true
+	case "LN":
+		return IsoLanguageCode_ISO_LANGUAGE_CODE_LN, true
+	case "LO":
+		return IsoLanguageCode_ISO_LANGUAGE_CODE_LO, true
+	case "LT":
+		return IsoLanguageCode_ISO_LANGUAGE_CODE_LT, true
+	case "LU":
+		return IsoLanguageCode_ISO_LANGUAGE_CODE_LU, true
+	case "LV":
+		return IsoLanguageCode_ISO_LANGUAGE_CODE_LV, true
+	case "MG":
+		return IsoLanguageCode_ISO_LANGUAGE_CODE_MG, true
+	case "MH":
+		return IsoLanguageCode_ISO_LANGUAGE_CODE_MH, true
+	case "MI":
+		return IsoLanguageCode_ISO_LANGUAGE_CODE_MI, true
+	case "MK":
+		return IsoLanguageCode_ISO_LANGUAGE_CODE_MK, true
+	case "ML":
+		return IsoLanguageCode_ISO_LANGUAGE_CODE_ML, true
+	case "MN":
+		return IsoLanguageCode_ISO_LANGUAGE_CODE_MN, true
+	case "MO":
+		return IsoLanguageCode_ISO_LANGUAGE_CODE_MO, true
+	case "MR":
+		return IsoLanguageCode_ISO_LANGUAGE_CODE_MR, true
+	case "MS":
+		return IsoLanguageCode_ISO_LANGUAGE_CODE_MS, true
+	case "MT":
+		return IsoLanguageCode_ISO_LANGUAGE_CODE_MT, true
+	case "MY":
+		return IsoLanguageCode_ISO_LANGUAGE_CODE_MY, true
+	case "NA":
+		return IsoLanguageCode_ISO_LANGUAGE_CODE_NA, true
+	case "NB":
+		return IsoLanguageCode_ISO_LANGUAGE_CODE_NB, true
+	case "ND":
+		return IsoLanguageCode_ISO_LANGUAGE_CODE_ND, true
+	case "NE":
+		return IsoLanguageCode_ISO_LANGUAGE_CODE_NE, true
+	case "NG":
+		return IsoLanguageCode_ISO_LANGUAGE_CODE_NG, true
+	case "NL":
+		return IsoLanguageCode_ISO_LANGUAGE_CODE_NL, true
+	case "NN":
+		return IsoLanguageCode_ISO_LANGUAGE_CODE_NN, true
+	case "NO":
+		return IsoLanguageCode_ISO_LANGUAGE_CODE_NO, true
+	case "NR":
+		return IsoLanguageCode_ISO_LANGUAGE_CODE_NR, true
+	case "NV":
+		return IsoLanguageCode_ISO_LANGUAGE_CODE_NV, true
+	case "NY":
+		return IsoLanguageCode_ISO_LANGUAGE_CODE_NY, true
+	case "OC":
+		return IsoLanguageCode_ISO_LANGUAGE_CODE_OC, true
+	case "OJ":
+		return IsoLanguageCode_ISO_LANGUAGE_CODE_OJ, true
+	case "OM":
+		return IsoLanguageCode_ISO_LANGUAGE_CODE_OM, true
+	case "OR":
+		return IsoLanguageCode_ISO_LANGUAGE_CODE_OR, true
+	case "OS":
+		return IsoLanguageCode_ISO_LANGUAGE_CODE_OS, true
+	case "PA":
+		return IsoLanguageCode_ISO_LANGUAGE_CODE_PA, true
+	case "PI":
+		return IsoLanguageCode_ISO_LANGUAGE_CODE_PI, true
+	case "PL":
+		return IsoLanguageCode_ISO_LANGUAGE_CODE_PL, true
+	case "PS":
+		return IsoLanguageCode_ISO_LANGUAGE_CODE_PS, true
+	case "PT":
+		return IsoLanguageCode_ISO_LANGUAGE_CODE_PT, true
+	case "QU":
+		return IsoLanguageCode_ISO_LANGUAGE_CODE_QU, true
+	case "RM":
+		return IsoLanguageCode_ISO_LANGUAGE_CODE_RM, true
+	case "RN":
+		return IsoLanguageCode_ISO_LANGUAGE_CODE_RN, true
+	case "RO":
+		return IsoLanguageCode_ISO_LANGUAGE_CODE_RO, true
+	case "RU":
+		return IsoLanguageCode_ISO_LANGUAGE_CODE_RU, true
+	case "RW":
+		return IsoLanguageCode_ISO_LANGUAGE_CODE_RW, true
+	case "SA":
+		return IsoLanguageCode_ISO_LANGUAGE_CODE_SA, true
+	case "SC":
+		return IsoLanguageCode_ISO_LANGUAGE_CODE_SC, true
+	case "SD":
+		return IsoLanguageCode_ISO_LANGUAGE_CODE_SD, true
+	case "SE":
+		return IsoLanguageCode_ISO_LANGUAGE_CODE_SE, true
+	case "SG":
+		return IsoLanguageCode_ISO_LANGUAGE_CODE_SG, true
+	case "SI":
+		return IsoLanguageCode_ISO_LANGUAGE_CODE_SI, true
+	case "SK":
+		return IsoLanguageCode_ISO_LANGUAGE_CODE_SK, true
+	case "SL":
+		return IsoLanguageCode_ISO_LANGUAGE_CODE_SL, true
+	case "SM":
+		return IsoLanguageCode_ISO_LANGUAGE_CODE_SM, true
+	case "SN":
+		return IsoLanguageCode_ISO_LANGUAGE_CODE_SN, true
+	case "SO":
+		return IsoLanguageCode_ISO_LANGUAGE_CODE_SO, true
+	case "SQ":
+		return IsoLanguageCode_ISO_LANGUAGE_CODE_SQ, true
+	case "SR":
+		return IsoLanguageCode_ISO_LANGUAGE_CODE_SR, true
+	case "SS":
+		return IsoLanguageCode_ISO_LANGUAGE_CODE_SS, true
+	case "ST":
+		return IsoLanguageCode_ISO_LANGUAGE_CODE_ST, true
+	case "SU":
+		return IsoLanguageCode_ISO_LANGUAGE_CODE_SU, true
+	case "SV":
+		return IsoLanguageCode_ISO_LANGUAGE_CODE_SV, true
+	case "SW":
+		return IsoLanguageCode_ISO_LANGUAGE_CODE_SW, true
+	case "TA":
+		return IsoLanguageCode_ISO_LANGUAGE_CODE_TA, true
+	case "TE":
+		return IsoLanguageCode_ISO_LANGUAGE_CODE_TE, true
+	case "TG":
+		return IsoLanguageCode_ISO_LANGUAGE_CODE_TG, true
+	case "TH":
+		return IsoLanguageCode_ISO_LANGUAGE_CODE_TH, true
+	case "TI":
+		return IsoLanguageCode_ISO_LANGUAGE_CODE_TI, true
+	case "TK":
+		return IsoLanguageCode_ISO_LANGUAGE_CODE_TK, true
+	case "TL":
+		return IsoLanguageCode_ISO_LANGUAGE_CODE_TL, true
+	case "TN":
+		return IsoLanguageCode_ISO_LANGUAGE_CODE_TN, true
+	case "TO":
+		return IsoLanguageCode_ISO_LANGUAGE_CODE_TO, true
+	case "TR":
+		return IsoLanguageCode_ISO_LANGUAGE_CODE_TR, true
+	case "TS":
+		return IsoLanguageCode_ISO_LANGUAGE_CODE_TS, true
+	case "TT":
+		return IsoLanguageCode_ISO_LANGUAGE_CODE_TT, true
+	case "TW":
+		return IsoLanguageCode_ISO_LANGUAGE_CODE_TW, true
+	case "TY":
+		return IsoLanguageCode_ISO_LANGUAGE_CODE_TY, true
+	case "UG":
+		return IsoLanguageCode_ISO_LANGUAGE_CODE_UG, true
+	case "UK":
+		return IsoLanguageCode_ISO_LANGUAGE_CODE_UK, true
+	case "UR":
+		return IsoLanguageCode_ISO_LANGUAGE_CODE_UR, true
+	case "UZ":
+		return IsoLanguageCode_ISO_LANGUAGE_CODE_UZ, true
+	case "VE":
+		return IsoLanguageCode_ISO_LANGUAGE_CODE_VE, true
+	case "VI":
+		return IsoLanguageCode_ISO_LANGUAGE_CODE_VI, true
+	case "VO":
+		return IsoLanguageCode_ISO_LANGUAGE_CODE_VO, true
+	case "WA":
+		return IsoLanguageCode_ISO_LANGUAGE_CODE_WA, true
+	case "WO":
+		return IsoLanguageCode_ISO_LANGUAGE_CODE_WO, true
+	case "XH":
+		return IsoLanguageCode_ISO_LANGUAGE_CODE_XH, true
+	case "YI":
+		return IsoLanguageCode_ISO_LANGUAGE_CODE_YI, true
+	case "YO":
+		return IsoLanguageCode_ISO_LANGUAGE_CODE_YO, true
+	case "ZA":
+		return IsoLanguageCode_ISO_LANGUAGE_CODE_ZA, true
+	case "ZH":
+		return IsoLanguageCode_ISO_LANGUAGE_CODE_ZH, true
+	case "ZU":
+		return IsoLanguageCode_ISO_LANGUAGE_CODE_ZU, true
+	case "BGC":
+		return IsoLanguageCode_ISO_LANGUAGE_CODE_BGC, true
+	case "QQA":
+		return IsoLanguageCode_ISO_LANGUAGE_CODE_QQA, true
+	case "QQB":
+		return IsoLanguageCode_ISO_LANGUAGE_CODE_QQB, true
+	case "QQC":
+		return IsoLanguageCode_ISO_LANGUAGE_CODE_QQC, true
+	case "QQD":
+		return IsoLanguageCode_ISO_LANGUAGE_CODE_QQD, true
+	case "QQE":
+		return IsoLanguageCode_ISO_LANGUAGE_CODE_QQE, true
+	case "QQF":
+		return IsoLanguageCode_ISO_LANGUAGE_CODE_QQF, true
+	case "QQG":
+		return IsoLanguageCode_ISO_LANGUAGE_CODE_QQG, true
+	case "QQH":
+		return IsoLanguageCode_ISO_LANGUAGE_CODE_QQH, true
+	case "QQI":
+		return IsoLanguageCode_ISO_LANGUAGE_CODE_QQI, true
+	case "QQJ":
+		return IsoLanguageCode_ISO_LANGUAGE_CODE_QQJ, true
+	case "AAR":
+		return IsoLanguageCode_ISO_LANGUAGE_CODE_AAR, true
+	case "ABK":
+		return IsoLanguageCode_ISO_LANGUAGE_CODE_ABK, true
+	case "AVE":
+		return IsoLanguageCode_ISO_LANGUAGE_CODE_AVE, true
+	case "AFR":
+		return IsoLanguageCode_ISO_LANGUAGE_CODE_AFR, true
+	case "AKA":
+		return IsoLanguageCode_ISO_LANGUAGE_CODE_AKA, true
+	case "AMH":
+		return IsoLanguageCode_ISO_LANGUAGE_CODE_AMH, true
+	case "ARG":
+		return IsoLanguageCode_ISO_LANGUAGE_CODE_ARG, true
+	case "ARA":
+		return IsoLanguageCode_ISO_LANGUAGE_CODE_ARA, true
+	case "ASM":
+		return IsoLanguageCode_ISO_LANGUAGE_CODE_ASM, true
+	case "AVA":
+		return IsoLanguageCode_ISO_LANGUAGE_CODE_AVA, true
+	case "AYM":
+		return IsoLanguageCode_ISO_LANGUAGE_CODE_AYM, true
+	case "AZE":
+		return IsoLanguageCode_ISO_LANGUAGE_CODE_AZE, true
+	case "BAK":
+		return IsoLanguageCode_ISO_LANGUAGE_CODE_BAK, true
+	case "BEL":
+		return IsoLanguageCode_ISO_LANGUAGE_CODE_BEL, true
+	case "BUL":
+		return IsoLanguageCode_ISO_LANGUAGE_CODE_BUL, true
+	case "BIH":
+		return IsoLanguageCode_ISO_LANGUAGE_CODE_BIH, true
+	case "BIS":
+		return IsoLanguageCode_ISO_LANGUAGE_CODE_BIS, true
+	case "BAM":
+		return IsoLanguageCode_ISO_LANGUAGE_CODE_BAM, true
+	case "BEN":
+		return IsoLanguageCode_ISO_LANGUAGE_CODE_BEN, true
+	case "BOD":
+		return IsoLanguageCode_ISO_LANGUAGE_CODE_BOD, true
+	case "BRE":
+		return IsoLanguageCode_ISO_LANGUAGE_CODE_BRE, true
+	case "BOS":
+		return IsoLanguageCode_ISO_LANGUAGE_CODE_BOS, true
+	case "CAT":
+		return IsoLanguageCode_ISO_LANGUAGE_CODE_CAT, true
+	case "CHE":
+		return IsoLanguageCode_ISO_LANGUAGE_CODE_CHE, true
+	case "CHA":
+		return IsoLanguageCode_ISO_LANGUAGE_CODE_CHA, true
+	case "COS":
+		return IsoLanguageCode_ISO_LANGUAGE_CODE_COS, true
+	case "CRE":
+		return IsoLanguageCode_ISO_LANGUAGE_CODE_CRE, true
+	case "CES":
+		return IsoLanguageCode_ISO_LANGUAGE_CODE_CES, true
+	case "CHU":
+		return IsoLanguageCode_ISO_LANGUAGE_CODE_CHU, true
+	case "CHV":
+		return IsoLanguageCode_ISO_LANGUAGE_CODE_CHV, true
+	case "CYM":
+		return IsoLanguageCode_ISO_LANGUAGE_CODE_CYM, true
+	case "DAN":
+		return IsoLanguageCode_ISO_LANGUAGE_CODE_DAN, true
+	case "DEU":
+		return IsoLanguageCode_ISO_LANGUAGE_CODE_DEU, true
+	case "DIV":
+		return IsoLanguageCode_ISO_LANGUAGE_CODE_DIV, true
+	case "DZO":
+		return IsoLanguageCode_ISO_LANGUAGE_CODE_DZO, true
+	case "EWE":
+		return IsoLanguageCode_ISO_LANGUAGE_CODE_EWE, true
+	case "ELL":
+		return IsoLanguageCode_ISO_LANGUAGE_CODE_ELL, true
+	case "ENG":
+		return IsoLanguageCode_ISO_LANGUAGE_CODE_ENG, true
+	case "EPO":
+		return IsoLanguageCode_ISO_LANGUAGE_CODE_EPO, true
+	case "SPA":
+		return IsoLanguageCode_ISO_LANGUAGE_CODE_SPA, true
+	case "EST":
+		return IsoLanguageCode_ISO_LANGUAGE_CODE_EST, true
+	case "EUS":
+		return IsoLanguageCode_ISO_LANGUAGE_CODE_EUS, true
+	case "FAS":
+		return IsoLanguageCode_ISO_LANGUAGE_CODE_FAS, true
+	case "FUL":
+		return IsoLanguageCode_ISO_LANGUAGE_CODE_FUL, true
+	case "FIN":
+		return IsoLanguageCode_ISO_LANGUAGE_CODE_FIN, true
+	case "FIJ":
+		return IsoLanguageCode_ISO_LANGUAGE_CODE_FIJ, true
+	case "FAO":
+		return IsoLanguageCode_ISO_LANGUAGE_CODE_FAO, true
+	case "FRA":
+		return IsoLanguageCode_ISO_LANGUAGE_CODE_FRA, true
+	case "FRY":
+		return IsoLanguageCode_ISO_LANGUAGE_CODE_FRY, true
+	case "GLE":
+		return IsoLanguageCode_ISO_LANGUAGE_CODE_GLE, true
+	case "GLA":
+		return IsoLanguageCode_ISO_LANGUAGE_CODE_GLA, true
+	case "GLG":
+		return IsoLanguageCode_ISO_LANGUAGE_CODE_GLG, true
+	case "GRN":
+		return IsoLanguageCode_ISO_LANGUAGE_CODE_GRN, true
+	case "GUJ":
+		return IsoLanguageCode_ISO_LANGUAGE_CODE_GUJ, true
+	case "GLV":
+		return IsoLanguageCode_ISO_LANGUAGE_CODE_GLV, true
+	case "HAU":
+		return IsoLanguageCode_ISO_LANGUAGE_CODE_HAU, true
+	case "HEB":
+		return IsoLanguageCode_ISO_LANGUAGE_CODE_HEB, true
+	case "HIN":
+		return IsoLanguageCode_ISO_LANGUAGE_CODE_HIN, true
+	case "HMO":
+		return IsoLanguageCode_ISO_LANGUAGE_CODE_HMO, true
+	case "HRV":
+		return IsoLanguageCode_ISO_LANGUAGE_CODE_HRV, true
+	case "HAT":
+		return IsoLanguageCode_ISO_LANGUAGE_CODE_HAT, true
+	case "HUN":
+		return IsoLanguageCode_ISO_LANGUAGE_CODE_HUN, true
+	case "HYE":
+		return IsoLanguageCode_ISO_LANGUAGE_CODE_HYE, true
+	case "HER":
+		return IsoLanguageCode_ISO_LANGUAGE_CODE_HER, true
+	case "INA":
+		return IsoLanguageCode_ISO_LANGUAGE_CODE_INA, true
+	case "IND":
+		return IsoLanguageCode_ISO_LANGUAGE_CODE_IND, true
+	case "ILE":
+		return IsoLanguageCode_ISO_LANGUAGE_CODE_ILE, true
+	case "IBO":
+		return IsoLanguageCode_ISO_LANGUAGE_CODE_IBO, true
+	case "III":
+		return IsoLanguageCode_ISO_LANGUAGE_CODE_III, true
+	case "IPK":
+		return IsoLanguageCode_ISO_LANGUAGE_CODE_IPK, true
+	case "IDO":
+		return IsoLanguageCode_ISO_LANGUAGE_CODE_IDO, true
+	case "ISL":
+		return IsoLanguageCode_ISO_LANGUAGE_CODE_ISL, true
+	case "ITA":
+		return IsoLanguageCode_ISO_LANGUAGE_CODE_ITA, true
+	case "IKU":
+		return IsoLanguageCode_ISO_LANGUAGE_CODE_IKU, true
+	case "JPN":
+		return IsoLanguageCode_ISO_LANGUAGE_CODE_JPN, true
+	case "JAV":
+		return IsoLanguageCode_ISO_LANGUAGE_CODE_JAV, true
+	case "KAT":
+		return IsoLanguageCode_ISO_LANGUAGE_CODE_KAT, true
+	case "KON":
+		return IsoLanguageCode_ISO_LANGUAGE_CODE_KON, true
+	case "KIK":
+		return IsoLanguageCode_ISO_LANGUAGE_CODE_KIK, true
+	case "KUA":
+		return IsoLanguageCode_ISO_LANGUAGE_CODE_KUA, true
+	case "KAZ":
+		return IsoLanguageCode_ISO_LANGUAGE_CODE_KAZ, true
+	case "KAL":
+		return IsoLanguageCode_ISO_LANGUAGE_CODE_KAL, true
+	case "KHM":
+		return IsoLanguageCode_ISO_LANGUAGE_CODE_KHM, true
+	case "KAN":
+		return IsoLanguageCode_ISO_LANGUAGE_CODE_KAN, true
+	case "KOR":
+		return IsoLanguageCode_ISO_LANGUAGE_CODE_KOR, true
+	case "KAU":
+		return IsoLanguageCode_ISO_LANGUAGE_CODE_KAU, true
+	case "KAS":
+		return IsoLanguageCode_ISO_LANGUAGE_CODE_KAS, true
+	case "KUR":
+		return IsoLanguageCode_ISO_LANGUAGE_CODE_KUR, true
+	case "KOM":
+		return IsoLanguageCode_ISO_LANGUAGE_CODE_KOM, true
+	case "COR":
+		return IsoLanguageCode_ISO_LANGUAGE_CODE_COR, true
+	case "KIR":
+		return IsoLanguageCode_ISO_LANGUAGE_CODE_KIR, true
+	case "LAT":
+		return IsoLanguageCode_ISO_LANGUAGE_CODE_LAT, true
+	case "LTZ":
+		return IsoLanguageCode_ISO_LANGUAGE_CODE_LTZ, true
+	case "LUG":
+		return IsoLanguageCode_ISO_LANGUAGE_CODE_LUG, true
+	case "LIM":
+		return IsoLanguageCode_ISO_LANGUAGE_CODE_LIM, true
+	case "LIN":
+		return IsoLanguageCode_ISO_LANGUAGE_CODE_LIN, true
+	case "LAO":
+		return IsoLanguageCode_ISO_LANGUAGE_CODE_LAO, true
+	case "LIT":
+		return IsoLanguageCode_ISO_LANGUAGE_CODE_LIT, true
+	case "LUB":
+		return IsoLanguageCode_ISO_LANGUAGE_CODE_LUB, true
+	case "LAV":
+		return IsoLanguageCode_ISO_LANGUAGE_CODE_LAV, true
+	case "MLG":
+		return IsoLanguageCode_ISO_LANGUAGE_CODE_MLG, true
+	case "MAH":
+		return IsoLanguageCode_ISO_LANGUAGE_CODE_MAH, true
+	case "MRI":
+		return IsoLanguageCode_ISO_LANGUAGE_CODE_MRI, true
+	case "MKD":
+		return IsoLanguageCode_ISO_LANGUAGE_CODE_MKD, true
+	case "MAL":
+		return IsoLanguageCode_ISO_LANGUAGE_CODE_MAL, true
+	case "MON":
+		return IsoLanguageCode_ISO_LANGUAGE_CODE_MON, true
+	case "MAR":
+		return IsoLanguageCode_ISO_LANGUAGE_CODE_MAR, true
+	case "MSA":
+		return IsoLanguageCode_ISO_LANGUAGE_CODE_MSA, true
+	case "MLT":
+		return IsoLanguageCode_ISO_LANGUAGE_CODE_MLT, true
+	case "MYA":
+		return IsoLanguageCode_ISO_LANGUAGE_CODE_MYA, true
+	case "NAU":
+		return IsoLanguageCode_ISO_LANGUAGE_CODE_NAU, true
+	case "NOB":
+		return IsoLanguageCode_ISO_LANGUAGE_CODE_NOB, true
+	case "NDE":
+		return IsoLanguageCode_ISO_LANGUAGE_CODE_NDE, true
+	case "NEP":
+		return IsoLanguageCode_ISO_LANGUAGE_CODE_NEP, true
+	case "NDO":
+		return IsoLanguageCode_ISO_LANGUAGE_CODE_NDO, true
+	case "NLD":
+		return IsoLanguageCode_ISO_LANGUAGE_CODE_NLD, true
+	case "NNO":
+		return IsoLanguageCode_ISO_LANGUAGE_CODE_NNO, true
+	case "NOR":
+		return IsoLanguageCode_ISO_LANGUAGE_CODE_NOR, true
+	case "NBL":
+		return IsoLanguageCode_ISO_LANGUAGE_CODE_NBL, true
+	case "NAV":
+		return IsoLanguageCode_ISO_LANGUAGE_CODE_NAV, true
+	case "NYA":
+		return IsoLanguageCode_ISO_LANGUAGE_CODE_NYA, true
+	case "OCI":
+		return IsoLanguageCode_ISO_LANGUAGE_CODE_OCI, true
+	case "OJI":
+		return IsoLanguageCode_ISO_LANGUAGE_CODE_OJI, true
+	case "ORM":
+		return IsoLanguageCode_ISO_LANGUAGE_CODE_ORM, true
+	case "ORI":
+		return IsoLanguageCode_ISO_LANGUAGE_CODE_ORI, true
+	case "OSS":
+		return IsoLanguageCode_ISO_LANGUAGE_CODE_OSS, true
+	case "PAN":
+		return IsoLanguageCode_ISO_LANGUAGE_CODE_PAN, true
+	case "PLI":
+		return IsoLanguageCode_ISO_LANGUAGE_CODE_PLI, true
+	case "POL":
+		return IsoLanguageCode_ISO_LANGUAGE_CODE_POL, true
+	case "PUS":
+		return IsoLanguageCode_ISO_LANGUAGE_CODE_PUS, true
+	case "POR":
+		return IsoLanguageCode_ISO_LANGUAGE_CODE_POR, true
+	case "QUE":
+		return IsoLanguageCode_ISO_LANGUAGE_CODE_QUE, true
+	case "ROH":
+		return IsoLanguageCode_ISO_LANGUAGE_CODE_ROH, true
+	case "RUN":
+		return IsoLanguageCode_ISO_LANGUAGE_CODE_RUN, true
+	case "RON":
+		return IsoLanguageCode_ISO_LANGUAGE_CODE_RON, true
+	case "RUS":
+		return IsoLanguageCode_ISO_LANGUAGE_CODE_RUS, true
+	case "KIN":
+		return IsoLanguageCode_ISO_LANGUAGE_CODE_KIN, true
+	case "SAN":
+		return IsoLanguageCode_ISO_LANGUAGE_CODE_SAN, true
+	case "SRD":
+		return IsoLanguageCode_ISO_LANGUAGE_CODE_SRD, true
+	case "SND":
+		return IsoLanguageCode_ISO_LANGUAGE_CODE_SND, true
+	case "SME":
+		return IsoLanguageCode_ISO_LANGUAGE_CODE_SME, true
+	case "SAG":
+		return IsoLanguageCode_ISO_LANGUAGE_CODE_SAG, true
+	case "SIN":
+		return IsoLanguageCode_ISO_LANGUAGE_CODE_SIN, true
+	case "SLK":
+		return IsoLanguageCode_ISO_LANGUAGE_CODE_SLK, true
+	case "SLV":
+		return IsoLanguageCode_ISO_LANGUAGE_CODE_SLV, true
+	case "SMO":
+		return IsoLanguageCode_ISO_LANGUAGE_CODE_SMO, true
+	case "SNA":
+		return IsoLanguageCode_ISO_LANGUAGE_CODE_SNA, true
+	case "SOM":
+		return IsoLanguageCode_ISO_LANGUAGE_CODE_SOM, true
+	case "SQI":
+		return IsoLanguageCode_ISO_LANGUAGE_CODE_SQI, true
+	case "SRP":
+		return IsoLanguageCode_ISO_LANGUAGE_CODE_SRP, true
+	case "SSW":
+		return IsoLanguageCode_ISO_LANGUAGE_CODE_SSW, true
+	case "SOT":
+		return IsoLanguageCode_ISO_LANGUAGE_CODE_SOT, true
+	case "SUN":
+		return IsoLanguageCode_ISO_LANGUAGE_CODE_SUN, true
+	case "SWE":
+		return IsoLanguageCode_ISO_LANGUAGE_CODE_SWE, true
+	case "SWA":
+		return IsoLanguageCode_ISO_LANGUAGE_CODE_SWA, true
+	case "TAM":
+		return IsoLanguageCode_ISO_LANGUAGE_CODE_TAM, true
+	case "TEL":
+		return IsoLanguageCode_ISO_LANGUAGE_CODE_TEL, true
+	case "TGK":
+		return IsoLanguageCode_ISO_LANGUAGE_CODE_TGK, true
+	case "THA":
+		return IsoLanguageCode_ISO_LANGUAGE_CODE_THA, true
+	case "TIR":
+		return IsoLanguageCode_ISO_LANGUAGE_CODE_TIR, true
+	case "TUK":
+		return IsoLanguageCode_ISO_LANGUAGE_CODE_TUK, true
+	case "TGL":
+		return IsoLanguageCode_ISO_LANGUAGE_CODE_TGL, true
+	case "TSN":
+		return IsoLanguageCode_ISO_LANGUAGE_CODE_TSN, true
+	case "TON":
+		return IsoLanguageCode_ISO_LANGUAGE_CODE_TON, true
+	case "TUR":
+		return IsoLanguageCode_ISO_LANGUAGE_CODE_TUR, true
+	case "TSO":
+		return IsoLanguageCode_ISO_LANGUAGE_CODE_TSO, true
+	case "TAT":
+		return IsoLanguageCode_ISO_LANGUAGE_CODE_TAT, true
+	case "TWI":
+		return IsoLanguageCode_ISO_LANGUAGE_CODE_TWI, true
+	case "TAH":
+		return IsoLanguageCode_ISO_LANGUAGE_CODE_TAH, true
+	case "UIG":
+		return IsoLanguageCode_ISO_LANGUAGE_CODE_UIG, true
+	case "UKR":
+		return IsoLanguageCode_ISO_LANGUAGE_CODE_UKR, true
+	case "URD":
+		return IsoLanguageCode_ISO_LANGUAGE_CODE_URD, true
+	case "UZB":
+		return IsoLanguageCode_ISO_LANGUAGE_CODE_UZB, true
+	case "VEN":
+		return IsoLanguageCode_ISO_LANGUAGE_CODE_VEN, true
+	case "VIE":
+		return IsoLanguageCode_ISO_LANGUAGE_CODE_VIE, true
+	case "VOL":
+		return IsoLanguageCode_ISO_LANGUAGE_CODE_VOL, true
+	case "WLN":
+		return IsoLanguageCode_ISO_LANGUAGE_CODE_WLN, true
+	case "WOL":
+		return IsoLanguageCode_ISO_LANGUAGE_CODE_WOL, true
+	case "XHO":
+		return IsoLanguageCode_ISO_LANGUAGE_CODE_XHO, true
+	case "YID":
+		return IsoLanguageCode_ISO_LANGUAGE_CODE_YID, true
+	case "YOR":
+		return IsoLanguageCode_ISO_LANGUAGE_CODE_YOR, true
+	case "ZHA":
+		return IsoLanguageCode_ISO_LANGUAGE_CODE_ZHA, true
+	case "ZHO":
+		return IsoLanguageCode_ISO_LANGUAGE_CODE_ZHO, true
+	case "ZUL":
+		return IsoLanguageCode_ISO_LANGUAGE_CODE_ZUL, true
+	case "ACE":
+		return IsoLanguageCode_ISO_LANGUAGE_CODE_ACE, true
+	case "ACH":
+		return IsoLanguageCode_ISO_LANGUAGE_CODE_ACH, true
+	case "ADA":
+		return IsoLanguageCode_ISO_LANGUAGE_CODE_ADA, true
+	case "ADY":
+		return IsoLanguageCode_ISO_LANGUAGE_CODE_ADY, true
+	case "AFA":
+		return IsoLanguageCode_ISO_LANGUAGE_CODE_AFA, true
+	case "AFH":
+		return IsoLanguageCode_ISO_LANGUAGE_CODE_AFH, true
+	case "AIN":
+		return IsoLanguageCode_ISO_LANGUAGE_CODE_AIN, true
+	case "AKK":
+		return IsoLanguageCode_ISO_LANGUAGE_CODE_AKK, true
+	case "ALE":
+		return IsoLanguageCode_ISO_LANGUAGE_CODE_ALE, true
+	case "ALG":
+		return IsoLanguageCode_ISO_LANGUAGE_CODE_ALG, true
+	case "ALT":
+		return IsoLanguageCode_ISO_LANGUAGE_CODE_ALT, true
+	case "ANG":
+		return IsoLanguageCode_ISO_LANGUAGE_CODE_ANG, true
+	case "ANP":
+		return IsoLanguageCode_ISO_LANGUAGE_CODE_ANP, true
+	case "APA":
+		return IsoLanguageCode_ISO_LANGUAGE_CODE_APA, true
+	case "ARC":
+		return IsoLanguageCode_ISO_LANGUAGE_CODE_ARC, true
+	case "ARN":
+		return IsoLanguageCode_ISO_LANGUAGE_CODE_ARN, true
+	case "ARP":
+		return IsoLanguageCode_ISO_LANGUAGE_CODE_ARP, true
+	case "ART":
+		return IsoLanguageCode_ISO_LANGUAGE_CODE_ART, true
+	case "ARW":
+		return IsoLanguageCode_ISO_LANGUAGE_CODE_ARW, true
+	case "AST":
+		return IsoLanguageCode_ISO_LANGUAGE_CODE_AST, true
+	case "ATH":
+		return IsoLanguageCode_ISO_LANGUAGE_CODE_ATH, true
+	case "AUS":
+		return IsoLanguageCode_ISO_LANGUAGE_CODE_AUS, true
+	case "AWA":
+		return IsoLanguageCode_ISO_LANGUAGE_CODE_AWA, true
+	case "BAD":
+		return IsoLanguageCode_ISO_LANGUAGE_CODE_BAD, true
+	case "BAI":
+		return IsoLanguageCode_ISO_LANGUAGE_CODE_BAI, true
+	case "BAL":
+		return IsoLanguageCode_ISO_LANGUAGE_CODE_BAL, true
+	case "BAN":
+		return IsoLanguageCode_ISO_LANGUAGE_CODE_BAN, true
+	case "BAS":
+		return IsoLanguageCode_ISO_LANGUAGE_CODE_BAS, true
+	case "BAT":
+		return IsoLanguageCode_ISO_LANGUAGE_CODE_BAT, true
+	case "BEJ":
+		return IsoLanguageCode_ISO_LANGUAGE_CODE_BEJ, true
+	case "BEM":
+		return IsoLanguageCode_ISO_LANGUAGE_CODE_BEM, true
+	case "BER":
+		return IsoLanguageCode_ISO_LANGUAGE_CODE_BER, true
+	case "BIK":
+		return IsoLanguageCode_ISO_LANGUAGE_CODE_BIK, true
+	case "BIN":
+		return IsoLanguageCode_ISO_LANGUAGE_CODE_BIN, true
+	case "BLA":
+		return IsoLanguageCode_ISO_LANGUAGE_CODE_BLA, true
+	case "BNT":
+		return IsoLanguageCode_ISO_LANGUAGE_CODE_BNT, true
+	case "BRA":
+		return IsoLanguageCode_ISO_LANGUAGE_CODE_BRA, true
+	case "BTK":
+		return IsoLanguageCode_ISO_LANGUAGE_CODE_BTK, true
+	case "BUA":
+		return IsoLanguageCode_ISO_LANGUAGE_CODE_BUA, true
+	case "BUG":
+		return IsoLanguageCode_ISO_LANGUAGE_CODE_BUG, true
+	case "BYN":
+		return IsoLanguageCode_ISO_LANGUAGE_CODE_BYN, true
+	case "CAD":
+		return IsoLanguageCode_ISO_LANGUAGE_CODE_CAD, true
+	case "CAI":
+		return IsoLanguageCode_ISO_LANGUAGE_CODE_CAI, true
+	case "CAR":
+		return IsoLanguageCode_ISO_LANGUAGE_CODE_CAR, true
+	case "CAU":
+		return IsoLanguageCode_ISO_LANGUAGE_CODE_CAU, true
+	case "CEB":
+		return IsoLanguageCode_ISO_LANGUAGE_CODE_CEB, true
+	case "CEL":
+		return IsoLanguageCode_ISO_LANGUAGE_CODE_CEL, true
+	case "CHB":
+		return IsoLanguageCode_ISO_LANGUAGE_CODE_CHB, true
+	case "CHG":
+		return IsoLanguageCode_ISO_LANGUAGE_CODE_CHG, true
+	case "CHK":
+		return IsoLanguageCode_ISO_LANGUAGE_CODE_CHK, true
+	case "CHM":
+		return IsoLanguageCode_ISO_LANGUAGE_CODE_CHM, true
+	case "CHN":
+		return IsoLanguageCode_ISO_LANGUAGE_CODE_CHN, true
+	case "CHO":
+		return IsoLanguageCode_ISO_LANGUAGE_CODE_CHO, true
+	case "CHP":
+		return IsoLanguageCode_ISO_LANGUAGE_CODE_CHP, true
+	case "CHR":
+		return IsoLanguageCode_ISO_LANGUAGE_CODE_CHR, true
+	case "CHY":
+		return IsoLanguageCode_ISO_LANGUAGE_CODE_CHY, true
+	case "CMC":
+		return IsoLanguageCode_ISO_LANGUAGE_CODE_CMC, true
+	case "CNR":
+		return IsoLanguageCode_ISO_LANGUAGE_CODE_CNR, true
+	case "COP":
+		return IsoLanguageCode_ISO_LANGUAGE_CODE_COP, true
+	case "CPE":
+		return IsoLanguageCode_ISO_LANGUAGE_CODE_CPE, true
+	case "CPF":
+		return IsoLanguageCode_ISO_LANGUAGE_CODE_CPF, true
+	case "CPP":
+		return IsoLanguageCode_ISO_LANGUAGE_CODE_CPP, true
+	case "CRH":
+		return IsoLanguageCode_ISO_LANGUAGE_CODE_CRH, true
+	case "CRP":
+		return IsoLanguageCode_ISO_LANGUAGE_CODE_CRP, true
+	case "CSB":
+		return IsoLanguageCode_ISO_LANGUAGE_CODE_CSB, true
+	case "CUS":
+		return IsoLanguageCode_ISO_LANGUAGE_CODE_CUS, true
+	case "DAK":
+		return IsoLanguageCode_ISO_LANGUAGE_CODE_DAK, true
+	case "DAR":
+		return IsoLanguageCode_ISO_LANGUAGE_CODE_DAR, true
+	case "DAY":
+		return IsoLanguageCode_ISO_LANGUAGE_CODE_DAY, true
+	case "DEL":
+		return IsoLanguageCode_ISO_LANGUAGE_CODE_DEL, true
+	case "DEN":
+		return IsoLanguageCode_ISO_LANGUAGE_CODE_DEN, true
+	case "DGR":
+		return IsoLanguageCode_ISO_LANGUAGE_CODE_DGR, true
+	case "DIN":
+		return IsoLanguageCode_ISO_LANGUAGE_CODE_DIN, true
+	case "DOI":
+		return IsoLanguageCode_ISO_LANGUAGE_CODE_DOI, true
+	case "DRA":
+		return IsoLanguageCode_ISO_LANGUAGE_CODE_DRA, true
+	case "DSB":
+		return IsoLanguageCode_ISO_LANGUAGE_CODE_DSB, true
+	case "DUA":
+		return IsoLanguageCode_ISO_LANGUAGE_CODE_DUA, true
+	case "DUM":
+		return IsoLanguageCode_ISO_LANGUAGE_CODE_DUM, true
+	case "DYU":
+		return IsoLanguageCode_ISO_LANGUAGE_CODE_DYU, true
+	case "EFI":
+		return IsoLanguageCode_ISO_LANGUAGE_CODE_EFI, true
+	case "EGY":
+		return IsoLanguageCode_ISO_LANGUAGE_CODE_EGY, true
+	case "EKA":
+		return IsoLanguageCode_ISO_LANGUAGE_CODE_EKA, true
+	case "ELX":
+		return IsoLanguageCode_ISO_LANGUAGE_CODE_ELX, true
+	case "ENM":
+		return IsoLanguageCode_ISO_LANGUAGE_CODE_ENM, true
+	case "EWO":
+		return IsoLanguageCode_ISO_LANGUAGE_CODE_EWO, true
+	case "FAN":
+		return IsoLanguageCode_ISO_LANGUAGE_CODE_FAN, true
+	case "FAT":
+		return IsoLanguageCode_ISO_LANGUAGE_CODE_FAT, true
+	case "FIL":
+		return IsoLanguageCode_ISO_LANGUAGE_CODE_FIL, true
+	case "FIU":
+		return IsoLanguageCode_ISO_LANGUAGE_CODE_FIU, true
+	case "FON":
+		return IsoLanguageCode_ISO_LANGUAGE_CODE_FON, true
+	case "FRM":
+		return IsoLanguageCode_ISO_LANGUAGE_CODE_FRM, true
+	case "FRO":
+		return IsoLanguageCode_ISO_LANGUAGE_CODE_FRO, true
+	case "FRR":
+		return IsoLanguageCode_ISO_LANGUAGE_CODE_FRR, true
+	case "FRS":
+		return IsoLanguageCode_ISO_LANGUAGE_CODE_FRS, true
+	case "FUR":
+		return IsoLanguageCode_ISO_LANGUAGE_CODE_FUR, true
+	case "GAA":
+		return IsoLanguageCode_ISO_LANGUAGE_CODE_GAA, true
+	case "GAY":
+		return IsoLanguageCode_ISO_LANGUAGE_CODE_GAY, true
+	case "GBA":
+		return IsoLanguageCode_ISO_LANGUAGE_CODE_GBA, true
+	case "GEM":
+		return IsoLanguageCode_ISO_LANGUAGE_CODE_GEM, true
+	case "GEZ":
+		return IsoLanguageCode_ISO_LANGUAGE_CODE_GEZ, true
+	case "GIL":
+		return IsoLanguageCode_ISO_LANGUAGE_CODE_GIL, true
+	case "GMH":
+		return IsoLanguageCode_ISO_LANGUAGE_CODE_GMH, true
+	case "GOH":
+		return IsoLanguageCode_ISO_LANGUAGE_CODE_GOH, true
+	case "GON":
+		return IsoLanguageCode_ISO_LANGUAGE_CODE_GON, true
+	case "GOR":
+		return IsoLanguageCode_ISO_LANGUAGE_CODE_GOR, true
+	case "GOT":
+		return IsoLanguageCode_ISO_LANGUAGE_CODE_GOT, true
+	case "GRB":
+		return IsoLanguageCode_ISO_LANGUAGE_CODE_GRB, true
+	case "GRC":
+		return IsoLanguageCode_ISO_LANGUAGE_CODE_GRC, true
+	case "GSW":
+		return IsoLanguageCode_ISO_LANGUAGE_CODE_GSW, true
+	case "GWI":
+		return IsoLanguageCode_ISO_LANGUAGE_CODE_GWI, true
+	case "HAI":
+		return IsoLanguageCode_ISO_LANGUAGE_CODE_HAI, true
+	case "HAW":
+		return IsoLanguageCode_ISO_LANGUAGE_CODE_HAW, true
+	case "HIL":
+		return IsoLanguageCode_ISO_LANGUAGE_CODE_HIL, true
+	case "HIM":
+		return IsoLanguageCode_ISO_LANGUAGE_CODE_HIM, true
+	case "HIT":
+		return IsoLanguageCode_ISO_LANGUAGE_CODE_HIT, true
+	case "HMN":
+		return IsoLanguageCode_ISO_LANGUAGE_CODE_HMN, true
+	case "HSB":
+		return IsoLanguageCode_ISO_LANGUAGE_CODE_HSB, true
+	case "HUP":
+		return IsoLanguageCode_ISO_LANGUAGE_CODE_HUP, true
+	case "IBA":
+		return IsoLanguageCode_ISO_LANGUAGE_CODE_IBA, true
+	case "IJO":
+		return IsoLanguageCode_ISO_LANGUAGE_CODE_IJO, true
+	case "ILO":
+		return IsoLanguageCode_ISO_LANGUAGE_CODE_ILO, true
+	case "INC":
+		return IsoLanguageCode_ISO_LANGUAGE_CODE_INC, true
+	case "INE":
+		return IsoLanguageCode_ISO_LANGUAGE_CODE_INE, true
+	case "INH":
+		return IsoLanguageCode_ISO_LANGUAGE_CODE_INH, true
+	case "IRA":
+		return IsoLanguageCode_ISO_LANGUAGE_CODE_IRA, true
+	case "IRO":
+		return IsoLanguageCode_ISO_LANGUAGE_CODE_IRO, true
+	case "JBO":
+		return IsoLanguageCode_ISO_LANGUAGE_CODE_JBO, true
+	case "JPR":
+		return IsoLanguageCode_ISO_LANGUAGE_CODE_JPR, true
+	case "JRB":
+		return IsoLanguageCode_ISO_LANGUAGE_CODE_JRB, true
+	case "KAA":
+		return IsoLanguageCode_ISO_LANGUAGE_CODE_KAA, true
+	case "KAB":
+		return IsoLanguageCode_ISO_LANGUAGE_CODE_KAB, true
+	case "KAC":
+		return IsoLanguageCode_ISO_LANGUAGE_CODE_KAC, true
+	case "KAM":
+		return IsoLanguageCode_ISO_LANGUAGE_CODE_KAM, true
+	case "KAR":
+		return IsoLanguageCode_ISO_LANGUAGE_CODE_KAR, true
+	case "KAW":
+		return IsoLanguageCode_ISO_LANGUAGE_CODE_KAW, true
+	case "KBD":
+		return IsoLanguageCode_ISO_LANGUAGE_CODE_KBD, true
+	case "KHA":
+		return IsoLanguageCode_ISO_LANGUAGE_CODE_KHA, true
+	case "KHI":
+		return IsoLanguageCode_ISO_LANGUAGE_CODE_KHI, true
+	case "KHO":
+		return IsoLanguageCode_ISO_LANGUAGE_CODE_KHO, true
+	case "KMB":
+		return IsoLanguageCode_ISO_LANGUAGE_CODE_KMB, true
+	case "KOK":
+		return IsoLanguageCode_ISO_LANGUAGE_CODE_KOK, true
+	case "KOS":
+		return IsoLanguageCode_ISO_LANGUAGE_CODE_KOS, true
+	case "KPE":
+		return IsoLanguageCode_ISO_LANGUAGE_CODE_KPE, true
+	case "KRC":
+		return IsoLanguageCode_ISO_LANGUAGE_CODE_KRC, true
+	case "KRL":
+		return IsoLanguageCode_ISO_LANGUAGE_CODE_KRL, true
+	case "KRO":
+		return IsoLanguageCode_ISO_LANGUAGE_CODE_KRO, true
+	case "KRU":
+		return IsoLanguageCode_ISO_LANGUAGE_CODE_KRU, true
+	case "KUM":
+		return IsoLanguageCode_ISO_LANGUAGE_CODE_KUM, true
+	case "KUT":
+		return IsoLanguageCode_ISO_LANGUAGE_CODE_KUT, true
+	case "LAD":
+		return IsoLanguageCode_ISO_LANGUAGE_CODE_LAD, true
+	case "LAH":
+		return IsoLanguageCode_ISO_LANGUAGE_CODE_LAH, true
+	case "LAM":
+		return IsoLanguageCode_ISO_LANGUAGE_CODE_LAM, true
+	case "LEZ":
+		return IsoLanguageCode_ISO_LANGUAGE_CODE_LEZ, true
+	case "LOL":
+		return IsoLanguageCode_ISO_LANGUAGE_CODE_LOL, true
+	case "LOZ":
+		return IsoLanguageCode_ISO_LANGUAGE_CODE_LOZ, true
+	case "LUA":
+		return IsoLanguageCode_ISO_LANGUAGE_CODE_LUA, true
+	case "LUI":
+		return IsoLanguageCode_ISO_LANGUAGE_CODE_LUI, true
+	case "LUN":
+		return IsoLanguageCode_ISO_LANGUAGE_CODE_LUN, true
+	case "LUO":
+		return IsoLanguageCode_ISO_LANGUAGE_CODE_LUO, true
+	case "LUS":
+		return IsoLanguageCode_ISO_LANGUAGE_CODE_LUS, true
+	case "MAD":
+		return IsoLanguageCode_ISO_LANGUAGE_CODE_MAD, true
+	case "MAG":
+		return IsoLanguageCode_ISO_LANGUAGE_CODE_MAG, true
+	case "MAI":
+		return IsoLanguageCode_ISO_LANGUAGE_CODE_MAI, true
+	case "MAK":
+		return IsoLanguageCode_ISO_LANGUAGE_CODE_MAK, true
+	case "MAN":
+		return IsoLanguageCode_ISO_LANGUAGE_CODE_MAN, true
+	case "MAP":
+		return IsoLanguageCode_ISO_LANGUAGE_CODE_MAP, true
+	case "MAS":
+		return IsoLanguageCode_ISO_LANGUAGE_CODE_MAS, true
+	case "MDF":
+		return IsoLanguageCode_ISO_LANGUAGE_CODE_MDF, true
+	case "MDR":
+		return IsoLanguageCode_ISO_LANGUAGE_CODE_MDR, true
+	case "MEN":
+		return IsoLanguageCode_ISO_LANGUAGE_CODE_MEN, true
+	case "MGA":
+		return IsoLanguageCode_ISO_LANGUAGE_CODE_MGA, true
+	case "MIC":
+		return IsoLanguageCode_ISO_LANGUAGE_CODE_MIC, true
+	case "MIN":
+		return IsoLanguageCode_ISO_LANGUAGE_CODE_MIN, true
+	case "MIS":
+		return IsoLanguageCode_ISO_LANGUAGE_CODE_MIS, true
+	case "MKH":
+		return IsoLanguageCode_ISO_LANGUAGE_CODE_MKH, true
+	case "MNC":
+		return IsoLanguageCode_ISO_LANGUAGE_CODE_MNC, true
+	case "MNI":
+		return IsoLanguageCode_ISO_LANGUAGE_CODE_MNI, true
+	case "MNO":
+		return IsoLanguageCode_ISO_LANGUAGE_CODE_MNO, true
+	case "MOH":
+		return IsoLanguageCode_ISO_LANGUAGE_CODE_MOH, true
+	case "MOS":
+		return IsoLanguageCode_ISO_LANGUAGE_CODE_MOS, true
+	case "MUL":
+		return IsoLanguageCode_ISO_LANGUAGE_CODE_MUL, true
+	case "MUN":
+		return IsoLanguageCode_ISO_LANGUAGE_CODE_MUN, true
+	case "MUS":
+		return IsoLanguageCode_ISO_LANGUAGE_CODE_MUS, true
+	case "MWL":
+		return IsoLanguageCode_ISO_LANGUAGE_CODE_MWL, true
+	case "MWR":
+		return IsoLanguageCode_ISO_LANGUAGE_CODE_MWR, true
+	case "MYN":
+		return IsoLanguageCode_ISO_LANGUAGE_CODE_MYN, true
+	case "MYV":
+		return IsoLanguageCode_ISO_LANGUAGE_CODE_MYV, true
+	case "NAH":
+		return IsoLanguageCode_ISO_LANGUAGE_CODE_NAH, true
+	case "NAI":
+		return IsoLanguageCode_ISO_LANGUAGE_CODE_NAI, true
+	case "NAP":
+		return IsoLanguageCode_ISO_LANGUAGE_CODE_NAP, true
+	case "NDS":
+		return IsoLanguageCode_ISO_LANGUAGE_CODE_NDS, true
+	case "NEW":
+		return IsoLanguageCode_ISO_LANGUAGE_CODE_NEW, true
+	case "NIA":
+		return IsoLanguageCode_ISO_LANGUAGE_CODE_NIA, true
+	case "NIC":
+		return IsoLanguageCode_ISO_LANGUAGE_CODE_NIC, true
+	case "NIU":
+		return IsoLanguageCode_ISO_LANGUAGE_CODE_NIU, true
+	case "NOG":
+		return IsoLanguageCode_ISO_LANGUAGE_CODE_NOG, true
+	case "NON":
+		return IsoLanguageCode_ISO_LANGUAGE_CODE_NON, true
+	case "NQO":
+		return IsoLanguageCode_ISO_LANGUAGE_CODE_NQO, true
+	case "NSO":
+		return IsoLanguageCode_ISO_LANGUAGE_CODE_NSO, true
+	case "NUB":
+		return IsoLanguageCode_ISO_LANGUAGE_CODE_NUB, true
+	case "NWC":
+		return IsoLanguageCode_ISO_LANGUAGE_CODE_NWC, true
+	case "NYM":
+		return IsoLanguageCode_ISO_LANGUAGE_CODE_NYM, true
+	case "NYN":
+		return IsoLanguageCode_ISO_LANGUAGE_CODE_NYN, true
+	case "NYO":
+		return IsoLanguageCode_ISO_LANGUAGE_CODE_NYO, true
+	case "NZI":
+		return IsoLanguageCode_ISO_LANGUAGE_CODE_NZI, true
+	case "OSA":
+		return IsoLanguageCode_ISO_LANGUAGE_CODE_OSA, true
+	case "OTA":
+		return IsoLanguageCode_ISO_LANGUAGE_CODE_OTA, true
+	case "OTO":
+		return IsoLanguageCode_ISO_LANGUAGE_CODE_OTO, true
+	case "PAA":
+		return IsoLanguageCode_ISO_LANGUAGE_CODE_PAA, true
+	case "PAG":
+		return IsoLanguageCode_ISO_LANGUAGE_CODE_PAG, true
+	case "PAL":
+		return IsoLanguageCode_ISO_LANGUAGE_CODE_PAL, true
+	case "PAM":
+		return IsoLanguageCode_ISO_LANGUAGE_CODE_PAM, true
+	case "PAP":
+		return IsoLanguageCode_ISO_LANGUAGE_CODE_PAP, true
+	case "PAU":
+		return IsoLanguageCode_ISO_LANGUAGE_CODE_PAU, true
+	case "PEO":
+		return IsoLanguageCode_ISO_LANGUAGE_CODE_PEO, true
+	case "PHI":
+		return IsoLanguageCode_ISO_LANGUAGE_CODE_PHI, true
+	case "PHN":
+		return IsoLanguageCode_ISO_LANGUAGE_CODE_PHN, true
+	case "PON":
+		return IsoLanguageCode_ISO_LANGUAGE_CODE_PON, true
+	case "PRA":
+		return IsoLanguageCode_ISO_LANGUAGE_CODE_PRA, true
+	case "PRO":
+		return IsoLanguageCode_ISO_LANGUAGE_CODE_PRO, true
+	case "RAP":
+		return IsoLanguageCode_ISO_LANGUAGE_CODE_RAP, true
+	case "RAR":
+		return IsoLanguageCode_ISO_LANGUAGE_CODE_RAR, true
+	case "ROA":
+		return IsoLanguageCode_ISO_LANGUAGE_CODE_ROA, true
+	case "ROM":
+		return IsoLanguageCode_ISO_LANGUAGE_CODE_ROM, true
+	case "RUP":
+		return IsoLanguageCode_ISO_LANGUAGE_CODE_RUP, true
+	case "SAD":
+		return IsoLanguageCode_ISO_LANGUAGE_CODE_SAD, true
+	case "SAH":
+		return IsoLanguageCode_ISO_LANGUAGE_CODE_SAH, true
+	case "SAI":
+		return IsoLanguageCode_ISO_LANGUAGE_CODE_SAI, true
+	case "SAL":
+		return IsoLanguageCode_ISO_LANGUAGE_CODE_SAL, true
+	case "SAM":
+		return IsoLanguageCode_ISO_LANGUAGE_CODE_SAM, true
+	case "SAS":
+		return IsoLanguageCode_ISO_LANGUAGE_CODE_SAS, true
+	case "SAT":
+		return IsoLanguageCode_ISO_LANGUAGE_CODE_SAT, true
+	case "SCN":
+		return IsoLanguageCode_ISO_LANGUAGE_CODE_SCN, true
+	case "SCO":
+		return IsoLanguageCode_ISO_LANGUAGE_CODE_SCO, true
+	case "SEL":
+		return IsoLanguageCode_ISO_LANGUAGE_CODE_SEL, true
+	case "SEM":
+		return IsoLanguageCode_ISO_LANGUAGE_CODE_SEM, true
+	case "SGA":
+		return IsoLanguageCode_ISO_LANGUAGE_CODE_SGA, true
+	case "SGN":
+		return IsoLanguageCode_ISO_LANGUAGE_CODE_SGN, true
+	case "SHN":
+		return IsoLanguageCode_ISO_LANGUAGE_CODE_SHN, true
+	case "SID":
+		return IsoLanguageCode_ISO_LANGUAGE_CODE_SID, true
+	case "SIO":
+		return IsoLanguageCode_ISO_LANGUAGE_CODE_SIO, true
+	case "SIT":
+		return IsoLanguageCode_ISO_LANGUAGE_CODE_SIT, true
+	case "SLA":
+		return IsoLanguageCode_ISO_LANGUAGE_CODE_SLA, true
+	case "SMA":
+		return IsoLanguageCode_ISO_LANGUAGE_CODE_SMA, true
+	case "SMI":
+		return IsoLanguageCode_ISO_LANGUAGE_CODE_SMI, true
+	case "SMJ":
+		return IsoLanguageCode_ISO_LANGUAGE_CODE_SMJ, true
+	case "SMN":
+		return IsoLanguageCode_ISO_LANGUAGE_CODE_SMN, true
+	case "SMS":
+		return IsoLanguageCode_ISO_LANGUAGE_CODE_SMS, true
+	case "SNK":
+		return IsoLanguageCode_ISO_LANGUAGE_CODE_SNK, true
+	case "SOG":
+		return IsoLanguageCode_ISO_LANGUAGE_CODE_SOG, true
+	case "SON":
+		return IsoLanguageCode_ISO_LANGUAGE_CODE_SON, true
+	case "SRN":
+		return IsoLanguageCode_ISO_LANGUAGE_CODE_SRN, true
+	case "SRR":
+		return IsoLanguageCode_ISO_LANGUAGE_CODE_SRR, true
+	case "SSA":
+		return IsoLanguageCode_ISO_LANGUAGE_CODE_SSA, true
+	case "SUK":
+		return IsoLanguageCode_ISO_LANGUAGE_CODE_SUK, true
+	case "SUS":
+		return IsoLanguageCode_ISO_LANGUAGE_CODE_SUS, true
+	case "SUX":
+		return IsoLanguageCode_ISO_LANGUAGE_CODE_SUX, true
+	case "SYC":
+		return IsoLanguageCode_ISO_LANGUAGE_CODE_SYC, true
+	case "SYR":
+		return IsoLanguageCode_ISO_LANGUAGE_CODE_SYR, true
+	case "TAI":
+		return IsoLanguageCode_ISO_LANGUAGE_CODE_TAI, true
+	case "TEM":
+		return IsoLanguageCode_ISO_LANGUAGE_CODE_TEM, true
+	case "TER":
+		return IsoLanguageCode_ISO_LANGUAGE_CODE_TER, true
+	case "TET":
+		return IsoLanguageCode_ISO_LANGUAGE_CODE_TET, true
+	case "TIG":
+		return IsoLanguageCode_ISO_LANGUAGE_CODE_TIG, true
+	case "TIV":
+		return IsoLanguageCode_ISO_LANGUAGE_CODE_TIV, true
+	case "TKL":
+		return IsoLanguageCode_ISO_LANGUAGE_CODE_TKL, true
+	case "TLH":
+		return IsoLanguageCode_ISO_LANGUAGE_CODE_TLH, true
+	case "TLI":
+		return IsoLanguageCode_ISO_LANGUAGE_CODE_TLI, true
+	case "TMH":
+		return IsoLanguageCode_ISO_LANGUAGE_CODE_TMH, true
+	case "TOG":
+		return IsoLanguageCode_ISO_LANGUAGE_CODE_TOG, true
+	case "TPI":
+		return IsoLanguageCode_ISO_LANGUAGE_CODE_TPI, true
+	case "TSI":
+		return IsoLanguageCode_ISO_LANGUAGE_CODE_TSI, true
+	case "TUM":
+		return IsoLanguageCode_ISO_LANGUAGE_CODE_TUM, true
+	case "TUP":
+		return IsoLanguageCode_ISO_LANGUAGE_CODE_TUP, true
+	case "TUT":
+		return IsoLanguageCode_ISO_LANGUAGE_CODE_TUT, true
+	case "TVL":
+		return IsoLanguageCode_ISO_LANGUAGE_CODE_TVL, true
+	case "TYV":
+		return IsoLanguageCode_ISO_LANGUAGE_CODE_TYV, true
+	case "UDM":
+		return IsoLanguageCode_ISO_LANGUAGE_CODE_UDM, true
+	case "UGA":
+		return IsoLanguageCode_ISO_LANGUAGE_CODE_UGA, true
+	case "UMB":
+		return IsoLanguageCode_ISO_LANGUAGE_CODE_UMB, true
+	case "UND":
+		return IsoLanguageCode_ISO_LANGUAGE_CODE_UND, true
+	case "VAI":
+		return IsoLanguageCode_ISO_LANGUAGE_CODE_VAI, true
+	case "VOT":
+		return IsoLanguageCode_ISO_LANGUAGE_CODE_VOT, true
+	case "WAK":
+		return IsoLanguageCode_ISO_LANGUAGE_CODE_WAK, true
+	case "WAL":
+		return IsoLanguageCode_ISO_LANGUAGE_CODE_WAL, true
+	case "WAR":
+		return IsoLanguageCode_ISO_LANGUAGE_CODE_WAR, true
+	case "WAS":
+		return IsoLanguageCode_ISO_LANGUAGE_CODE_WAS, true
+	case "WEN":
+		return IsoLanguageCode_ISO_LANGUAGE_CODE_WEN, true
+	case "XAL":
+		return IsoLanguageCode_ISO_LANGUAGE_CODE_XAL, true
+	case "YAO":
+		return IsoLanguageCode_ISO_LANGUAGE_CODE_YAO, true
+	case "YAP":
+		return IsoLanguageCode_ISO_LANGUAGE_CODE_YAP, true
+	case "YPK":
+		return IsoLanguageCode_ISO_LANGUAGE_CODE_YPK, true
+	case "ZAP":
+		return IsoLanguageCode_ISO_LANGUAGE_CODE_ZAP, true
+	case "ZBL":
+		return IsoLanguageCode_ISO_LANGUAGE_CODE_ZBL, true
+	case "ZEN":
+		return IsoLanguageCode_ISO_LANGUAGE_CODE_ZEN, true
+	case "ZGH":
+		return IsoLanguageCode_ISO_LANGUAGE_CODE_ZGH, true
+	case "ZND":
+		return IsoLanguageCode_ISO_LANGUAGE_CODE_ZND, true
+	case "ZUN":
+		return IsoLanguageCode_ISO_LANGUAGE_CODE_ZUN, true
+	case "ZXX":
+		return IsoLanguageCode_ISO_LANGUAGE_CODE_ZXX, true
+	case "ZZA":
+		return IsoLanguageCode_ISO_LANGUAGE_CODE_ZZA, true
+	case "AAA":
+		return IsoLanguageCode_ISO_LANGUAGE_CODE_AAA, true
+	case "HNE":
+		return IsoLanguageCode_ISO_LANGUAGE_CODE_HNE, true
+	case "GBM":
+		return IsoLanguageCode_ISO_LANGUAGE_CODE_GBM, true
+	case "KHW":
+		return IsoLanguageCode_ISO_LANGUAGE_CODE_KHW, true
+	case "CMN":
+		return IsoLanguageCode_ISO_LANGUAGE_CODE_CMN, true
+	case "SCK":
+		return IsoLanguageCode_ISO_LANGUAGE_CODE_SCK, true
+	case "SPV":
+		return IsoLanguageCode_ISO_LANGUAGE_CODE_SPV, true
+	case "SCL":
+		return IsoLanguageCode_ISO_LANGUAGE_CODE_SCL, true
+	case "YUE":
+		return IsoLanguageCode_ISO_LANGUAGE_CODE_YUE, true
+	case "TCY":
+		return IsoLanguageCode_ISO_LANGUAGE_CODE_TCY, true
+	case "KEY":
+		return IsoLanguageCode_ISO_LANGUAGE_CODE_KEY, true
+	case "GCF":
+		return IsoLanguageCode_ISO_LANGUAGE_CODE_GCF, true
+	case "JAM":
+		return IsoLanguageCode_ISO_LANGUAGE_CODE_JAM, true
+	case "MCM":
+		return IsoLanguageCode_ISO_LANGUAGE_CODE_MCM, true
 	default:
 		return IsoLanguageCode(0), false
 	}
@@ -908,6 +11206,514 @@ func ParseIsoLanguageCodeString(s string) (IsoLanguageCode, bool) {
 // XMLString returns the XML string representation of IsoTerritoryCode
 func (e IsoTerritoryCode) XMLString() string {
 	switch e {
+	case IsoTerritoryCode_ISO_TERRITORY_CODE_AD:
+		return "AD"
+	case IsoTerritoryCode_ISO_TERRITORY_CODE_AE:
+		return "AE"
+	case IsoTerritoryCode_ISO_TERRITORY_CODE_AF:
+		return "AF"
+	case IsoTerritoryCode_ISO_TERRITORY_CODE_AG:
+		return "AG"
+	case IsoTerritoryCode_ISO_TERRITORY_CODE_AI:
+		return "AI"
+	case IsoTerritoryCode_ISO_TERRITORY_CODE_AL:
+		return "AL"
+	case IsoTerritoryCode_ISO_TERRITORY_CODE_AM:
+		return "AM"
+	case IsoTerritoryCode_ISO_TERRITORY_CODE_AN:
+		return "AN"
+	case IsoTerritoryCode_ISO_TERRITORY_CODE_AO:
+		return "AO"
+	case IsoTerritoryCode_ISO_TERRITORY_CODE_AQ:
+		return "AQ"
+	case IsoTerritoryCode_ISO_TERRITORY_CODE_AR:
+		return "AR"
+	case IsoTerritoryCode_ISO_TERRITORY_CODE_AS:
+		return "AS"
+	case IsoTerritoryCode_ISO_TERRITORY_CODE_AT:
+		return "AT"
+	case IsoTerritoryCode_ISO_TERRITORY_CODE_AU:
+		return "AU"
+	case IsoTerritoryCode_ISO_TERRITORY_CODE_AW:
+		return "AW"
+	case IsoTerritoryCode_ISO_TERRITORY_CODE_AX:
+		return "AX"
+	case IsoTerritoryCode_ISO_TERRITORY_CODE_AZ:
+		return "AZ"
+	case IsoTerritoryCode_ISO_TERRITORY_CODE_BA:
+		return "BA"
+	case IsoTerritoryCode_ISO_TERRITORY_CODE_BB:
+		return "BB"
+	case IsoTerritoryCode_ISO_TERRITORY_CODE_BD:
+		return "BD"
+	case IsoTerritoryCode_ISO_TERRITORY_CODE_BE:
+		return "BE"
+	case IsoTerritoryCode_ISO_TERRITORY_CODE_BF:
+		return "BF"
+	case IsoTerritoryCode_ISO_TERRITORY_CODE_BG:
+		return "BG"
+	case IsoTerritoryCode_ISO_TERRITORY_CODE_BH:
+		return "BH"
+	case IsoTerritoryCode_ISO_TERRITORY_CODE_BI:
+		return "BI"
+	case IsoTerritoryCode_ISO_TERRITORY_CODE_BJ:
+		return "BJ"
+	case IsoTerritoryCode_ISO_TERRITORY_CODE_BL:
+		return "BL"
+	case IsoTerritoryCode_ISO_TERRITORY_CODE_BM:
+		return "BM"
+	case IsoTerritoryCode_ISO_TERRITORY_CODE_BN:
+		return "BN"
+	case IsoTerritoryCode_ISO_TERRITORY_CODE_BO:
+		return "BO"
+	case IsoTerritoryCode_ISO_TERRITORY_CODE_BQ:
+		return "BQ"
+	case IsoTerritoryCode_ISO_TERRITORY_CODE_BR:
+		return "BR"
+	case IsoTerritoryCode_ISO_TERRITORY_CODE_BS:
+		return "BS"
+	case IsoTerritoryCode_ISO_TERRITORY_CODE_BT:
+		return "BT"
+	case IsoTerritoryCode_ISO_TERRITORY_CODE_BV:
+		return "BV"
+	case IsoTerritoryCode_ISO_TERRITORY_CODE_BW:
+		return "BW"
+	case IsoTerritoryCode_ISO_TERRITORY_CODE_BY:
+		return "BY"
+	case IsoTerritoryCode_ISO_TERRITORY_CODE_BZ:
+		return "BZ"
+	case IsoTerritoryCode_ISO_TERRITORY_CODE_CA:
+		return "CA"
+	case IsoTerritoryCode_ISO_TERRITORY_CODE_CC:
+		return "CC"
+	case IsoTerritoryCode_ISO_TERRITORY_CODE_CD:
+		return "CD"
+	case IsoTerritoryCode_ISO_TERRITORY_CODE_CF:
+		return "CF"
+	case IsoTerritoryCode_ISO_TERRITORY_CODE_CG:
+		return "CG"
+	case IsoTerritoryCode_ISO_TERRITORY_CODE_CH:
+		return "CH"
+	case IsoTerritoryCode_ISO_TERRITORY_CODE_CI:
+		return "CI"
+	case IsoTerritoryCode_ISO_TERRITORY_CODE_CK:
+		return "CK"
+	case IsoTerritoryCode_ISO_TERRITORY_CODE_CL:
+		return "CL"
+	case IsoTerritoryCode_ISO_TERRITORY_CODE_CM:
+		return "CM"
+	case IsoTerritoryCode_ISO_TERRITORY_CODE_CN:
+		return "CN"
+	case IsoTerritoryCode_ISO_TERRITORY_CODE_CO:
+		return "CO"
+	case IsoTerritoryCode_ISO_TERRITORY_CODE_CR:
+		return "CR"
+	case IsoTerritoryCode_ISO_TERRITORY_CODE_CS:
+		return "CS"
+	case IsoTerritoryCode_ISO_TERRITORY_CODE_CU:
+		return "CU"
+	case IsoTerritoryCode_ISO_TERRITORY_CODE_CV:
+		return "CV"
+	case IsoTerritoryCode_ISO_TERRITORY_CODE_CW:
+		return "CW"
+	case IsoTerritoryCode_ISO_TERRITORY_CODE_CX:
+		return "CX"
+	case IsoTerritoryCode_ISO_TERRITORY_CODE_CY:
+		return "CY"
+	case IsoTerritoryCode_ISO_TERRITORY_CODE_CZ:
+		return "CZ"
+	case IsoTerritoryCode_ISO_TERRITORY_CODE_DE:
+		return "DE"
+	case IsoTerritoryCode_ISO_TERRITORY_CODE_DJ:
+		return "DJ"
+	case IsoTerritoryCode_ISO_TERRITORY_CODE_DK:
+		return "DK"
+	case IsoTerritoryCode_ISO_TERRITORY_CODE_DM:
+		return "DM"
+	case IsoTerritoryCode_ISO_TERRITORY_CODE_DO:
+		return "DO"
+	case IsoTerritoryCode_ISO_TERRITORY_CODE_DZ:
+		return "DZ"
+	case IsoTerritoryCode_ISO_TERRITORY_CODE_EC:
+		return "EC"
+	case IsoTerritoryCode_ISO_TERRITORY_CODE_EE:
+		return "EE"
+	case IsoTerritoryCode_ISO_TERRITORY_CODE_EG:
+		return "EG"
+	case IsoTerritoryCode_ISO_TERRITORY_CODE_EH:
+		return "EH"
+	case IsoTerritoryCode_ISO_TERRITORY_CODE_ER:
+		return "ER"
+	case IsoTerritoryCode_ISO_TERRITORY_CODE_ES:
+		return "ES"
+	case IsoTerritoryCode_ISO_TERRITORY_CODE_ES_CE:
+		return "ES_CE"
+	case IsoTerritoryCode_ISO_TERRITORY_CODE_ES_CN:
+		return "ES_CN"
+	case IsoTerritoryCode_ISO_TERRITORY_CODE_ES_ML:
+		return "ES_ML"
+	case IsoTerritoryCode_ISO_TERRITORY_CODE_ET:
+		return "ET"
+	case IsoTerritoryCode_ISO_TERRITORY_CODE_FI:
+		return "FI"
+	case IsoTerritoryCode_ISO_TERRITORY_CODE_FJ:
+		return "FJ"
+	case IsoTerritoryCode_ISO_TERRITORY_CODE_FK:
+		return "FK"
+	case IsoTerritoryCode_ISO_TERRITORY_CODE_FM:
+		return "FM"
+	case IsoTerritoryCode_ISO_TERRITORY_CODE_FO:
+		return "FO"
+	case IsoTerritoryCode_ISO_TERRITORY_CODE_FR:
+		return "FR"
+	case IsoTerritoryCode_ISO_TERRITORY_CODE_GA:
+		return "GA"
+	case IsoTerritoryCode_ISO_TERRITORY_CODE_GB:
+		return "GB"
+	case IsoTerritoryCode_ISO_TERRITORY_CODE_GD:
+		return "GD"
+	case IsoTerritoryCode_ISO_TERRITORY_CODE_GE:
+		return "GE"
+	case IsoTerritoryCode_ISO_TERRITORY_CODE_GF:
+		return "GF"
+	case IsoTerritoryCode_ISO_TERRITORY_CODE_GG:
+		return "GG"
+	case IsoTerritoryCode_ISO_TERRITORY_CODE_GH:
+		return "GH"
+	case IsoTerritoryCode_ISO_TERRITORY_CODE_GI:
+		return "GI"
+	case IsoTerritoryCode_ISO_TERRITORY_CODE_GL:
+		return "GL"
+	case IsoTerritoryCode_ISO_TERRITORY_CODE_GM:
+		return "GM"
+	case IsoTerritoryCode_ISO_TERRITORY_CODE_GN:
+		return "GN"
+	case IsoTerritoryCode_ISO_TERRITORY_CODE_GP:
+		return "GP"
+	case IsoTerritoryCode_ISO_TERRITORY_CODE_GQ:
+		return "GQ"
+	case IsoTerritoryCode_ISO_TERRITORY_CODE_GR:
+		return "GR"
+	case IsoTerritoryCode_ISO_TERRITORY_CODE_GS:
+		return "GS"
+	case IsoTerritoryCode_ISO_TERRITORY_CODE_GT:
+		return "GT"
+	case IsoTerritoryCode_ISO_TERRITORY_CODE_GU:
+		return "GU"
+	case IsoTerritoryCode_ISO_TERRITORY_CODE_GW:
+		return "GW"
+	case IsoTerritoryCode_ISO_TERRITORY_CODE_GY:
+		return "GY"
+	case IsoTerritoryCode_ISO_TERRITORY_CODE_HK:
+		return "HK"
+	case IsoTerritoryCode_ISO_TERRITORY_CODE_HM:
+		return "HM"
+	case IsoTerritoryCode_ISO_TERRITORY_CODE_HN:
+		return "HN"
+	case IsoTerritoryCode_ISO_TERRITORY_CODE_HR:
+		return "HR"
+	case IsoTerritoryCode_ISO_TERRITORY_CODE_HT:
+		return "HT"
+	case IsoTerritoryCode_ISO_TERRITORY_CODE_HU:
+		return "HU"
+	case IsoTerritoryCode_ISO_TERRITORY_CODE_ID:
+		return "ID"
+	case IsoTerritoryCode_ISO_TERRITORY_CODE_IE:
+		return "IE"
+	case IsoTerritoryCode_ISO_TERRITORY_CODE_IL:
+		return "IL"
+	case IsoTerritoryCode_ISO_TERRITORY_CODE_IM:
+		return "IM"
+	case IsoTerritoryCode_ISO_TERRITORY_CODE_IN:
+		return "IN"
+	case IsoTerritoryCode_ISO_TERRITORY_CODE_IO:
+		return "IO"
+	case IsoTerritoryCode_ISO_TERRITORY_CODE_IQ:
+		return "IQ"
+	case IsoTerritoryCode_ISO_TERRITORY_CODE_IR:
+		return "IR"
+	case IsoTerritoryCode_ISO_TERRITORY_CODE_IS:
+		return "IS"
+	case IsoTerritoryCode_ISO_TERRITORY_CODE_IT:
+		return "IT"
+	case IsoTerritoryCode_ISO_TERRITORY_CODE_JE:
+		return "JE"
+	case IsoTerritoryCode_ISO_TERRITORY_CODE_JM:
+		return "JM"
+	case IsoTerritoryCode_ISO_TERRITORY_CODE_JO:
+		return "JO"
+	case IsoTerritoryCode_ISO_TERRITORY_CODE_JP:
+		return "JP"
+	case IsoTerritoryCode_ISO_TERRITORY_CODE_KE:
+		return "KE"
+	case IsoTerritoryCode_ISO_TERRITORY_CODE_KG:
+		return "KG"
+	case IsoTerritoryCode_ISO_TERRITORY_CODE_KH:
+		return "KH"
+	case IsoTerritoryCode_ISO_TERRITORY_CODE_KI:
+		return "KI"
+	case IsoTerritoryCode_ISO_TERRITORY_CODE_KM:
+		return "KM"
+	case IsoTerritoryCode_ISO_TERRITORY_CODE_KN:
+		return "KN"
+	case IsoTerritoryCode_ISO_TERRITORY_CODE_KP:
+		return "KP"
+	case IsoTerritoryCode_ISO_TERRITORY_CODE_KR:
+		return "KR"
+	case IsoTerritoryCode_ISO_TERRITORY_CODE_KW:
+		return "KW"
+	case IsoTerritoryCode_ISO_TERRITORY_CODE_KY:
+		return "KY"
+	case IsoTerritoryCode_ISO_TERRITORY_CODE_KZ:
+		return "KZ"
+	case IsoTerritoryCode_ISO_TERRITORY_CODE_LA:
+		return "LA"
+	case IsoTerritoryCode_ISO_TERRITORY_CODE_LB:
+		return "LB"
+	case IsoTerritoryCode_ISO_TERRITORY_CODE_LC:
+		return "LC"
+	case IsoTerritoryCode_ISO_TERRITORY_CODE_LI:
+		return "LI"
+	case IsoTerritoryCode_ISO_TERRITORY_CODE_LK:
+		return "LK"
+	case IsoTerritoryCode_ISO_TERRITORY_CODE_LR:
+		return "LR"
+	case IsoTerritoryCode_ISO_TERRITORY_CODE_LS:
+		return "LS"
+	case IsoTerritoryCode_ISO_TERRITORY_CODE_LT:
+		return "LT"
+	case IsoTerritoryCode_ISO_TERRITORY_CODE_LU:
+		return "LU"
+	case IsoTerritoryCode_ISO_TERRITORY_CODE_LV:
+		return "LV"
+	case IsoTerritoryCode_ISO_TERRITORY_CODE_LY:
+		return "LY"
+	case IsoTerritoryCode_ISO_TERRITORY_CODE_MA:
+		return "MA"
+	case IsoTerritoryCode_ISO_TERRITORY_CODE_MC:
+		return "MC"
+	case IsoTerritoryCode_ISO_TERRITORY_CODE_MD:
+		return "MD"
+	case IsoTerritoryCode_ISO_TERRITORY_CODE_ME:
+		return "ME"
+	case IsoTerritoryCode_ISO_TERRITORY_CODE_MF:
+		return "MF"
+	case IsoTerritoryCode_ISO_TERRITORY_CODE_MG:
+		return "MG"
+	case IsoTerritoryCode_ISO_TERRITORY_CODE_MH:
+		return "MH"
+	case IsoTerritoryCode_ISO_TERRITORY_CODE_MK:
+		return "MK"
+	case IsoTerritoryCode_ISO_TERRITORY_CODE_ML:
+		return "ML"
+	case IsoTerritoryCode_ISO_TERRITORY_CODE_MM:
+		return "MM"
+	case IsoTerritoryCode_ISO_TERRITORY_CODE_MN:
+		return "MN"
+	case IsoTerritoryCode_ISO_TERRITORY_CODE_MO:
+		return "MO"
+	case IsoTerritoryCode_ISO_TERRITORY_CODE_MP:
+		return "MP"
+	case IsoTerritoryCode_ISO_TERRITORY_CODE_MQ:
+		return "MQ"
+	case IsoTerritoryCode_ISO_TERRITORY_CODE_MR:
+		return "MR"
+	case IsoTerritoryCode_ISO_TERRITORY_CODE_MS:
+		return "MS"
+	case IsoTerritoryCode_ISO_TERRITORY_CODE_MT:
+		return "MT"
+	case IsoTerritoryCode_ISO_TERRITORY_CODE_MU:
+		return "MU"
+	case IsoTerritoryCode_ISO_TERRITORY_CODE_MV:
+		return "MV"
+	case IsoTerritoryCode_ISO_TERRITORY_CODE_MW:
+		return "MW"
+	case IsoTerritoryCode_ISO_TERRITORY_CODE_MX:
+		return "MX"
+	case IsoTerritoryCode_ISO_TERRITORY_CODE_MY:
+		return "MY"
+	case IsoTerritoryCode_ISO_TERRITORY_CODE_MZ:
+		return "MZ"
+	case IsoTerritoryCode_ISO_TERRITORY_CODE_NA:
+		return "NA"
+	case IsoTerritoryCode_ISO_TERRITORY_CODE_NC:
+		return "NC"
+	case IsoTerritoryCode_ISO_TERRITORY_CODE_NE:
+		return "NE"
+	case IsoTerritoryCode_ISO_TERRITORY_CODE_NF:
+		return "NF"
+	case IsoTerritoryCode_ISO_TERRITORY_CODE_NG:
+		return "NG"
+	case IsoTerritoryCode_ISO_TERRITORY_CODE_NI:
+		return "NI"
+	case IsoTerritoryCode_ISO_TERRITORY_CODE_NL:
+		return "NL"
+	case IsoTerritoryCode_ISO_TERRITORY_CODE_NO:
+		return "NO"
+	case IsoTerritoryCode_ISO_TERRITORY_CODE_NP:
+		return "NP"
+	case IsoTerritoryCode_ISO_TERRITORY_CODE_NR:
+		return "NR"
+	case IsoTerritoryCode_ISO_TERRITORY_CODE_NU:
+		return "NU"
+	case IsoTerritoryCode_ISO_TERRITORY_CODE_NZ:
+		return "NZ"
+	case IsoTerritoryCode_ISO_TERRITORY_CODE_OM:
+		return "OM"
+	case IsoTerritoryCode_ISO_TERRITORY_CODE_PA:
+		return "PA"
+	case IsoTerritoryCode_ISO_TERRITORY_CODE_PE:
+		return "PE"
+	case IsoTerritoryCode_ISO_TERRITORY_CODE_PF:
+		return "PF"
+	case IsoTerritoryCode_ISO_TERRITORY_CODE_PG:
+		return "PG"
+	case IsoTerritoryCode_ISO_TERRITORY_CODE_PH:
+		return "PH"
+	case IsoTerritoryCode_ISO_TERRITORY_CODE_PK:
+		return "PK"
+	case IsoTerritoryCode_ISO_TERRITORY_CODE_PL:
+		return "PL"
+	case IsoTerritoryCode_ISO_TERRITORY_CODE_PM:
+		return "PM"
+	case IsoTerritoryCode_ISO_TERRITORY_CODE_PN:
+		return "PN"
+	case IsoTerritoryCode_ISO_TERRITORY_CODE_PR:
+		return "PR"
+	case IsoTerritoryCode_ISO_TERRITORY_CODE_PS:
+		return "PS"
+	case IsoTerritoryCode_ISO_TERRITORY_CODE_PT:
+		return "PT"
+	case IsoTerritoryCode_ISO_TERRITORY_CODE_PW:
+		return "PW"
+	case IsoTerritoryCode_ISO_TERRITORY_CODE_PY:
+		return "PY"
+	case IsoTerritoryCode_ISO_TERRITORY_CODE_QA:
+		return "QA"
+	case IsoTerritoryCode_ISO_TERRITORY_CODE_RE:
+		return "RE"
+	case IsoTerritoryCode_ISO_TERRITORY_CODE_RO:
+		return "RO"
+	case IsoTerritoryCode_ISO_TERRITORY_CODE_RS:
+		return "RS"
+	case IsoTerritoryCode_ISO_TERRITORY_CODE_RU:
+		return "RU"
+	case IsoTerritoryCode_ISO_TERRITORY_CODE_RW:
+		return "RW"
+	case IsoTerritoryCode_ISO_TERRITORY_CODE_SA:
+		return "SA"
+	case IsoTerritoryCode_ISO_TERRITORY_CODE_SB:
+		return "SB"
+	case IsoTerritoryCode_ISO_TERRITORY_CODE_SC:
+		return "SC"
+	case IsoTerritoryCode_ISO_TERRITORY_CODE_SD:
+		return "SD"
+	case IsoTerritoryCode_ISO_TERRITORY_CODE_SE:
+		return "SE"
+	case IsoTerritoryCode_ISO_TERRITORY_CODE_SG:
+		return "SG"
+	case IsoTerritoryCode_ISO_TERRITORY_CODE_SH:
+		return "SH"
+	case IsoTerritoryCode_ISO_TERRITORY_CODE_SI:
+		return "SI"
+	case IsoTerritoryCode_ISO_TERRITORY_CODE_SJ:
+		return "SJ"
+	case IsoTerritoryCode_ISO_TERRITORY_CODE_SK:
+		return "SK"
+	case IsoTerritoryCode_ISO_TERRITORY_CODE_SL:
+		return "SL"
+	case IsoTerritoryCode_ISO_TERRITORY_CODE_SM:
+		return "SM"
+	case IsoTerritoryCode_ISO_TERRITORY_CODE_SN:
+		return "SN"
+	case IsoTerritoryCode_ISO_TERRITORY_CODE_SO:
+		return "SO"
+	case IsoTerritoryCode_ISO_TERRITORY_CODE_SR:
+		return "SR"
+	case IsoTerritoryCode_ISO_TERRITORY_CODE_SS:
+		return "SS"
+	case IsoTerritoryCode_ISO_TERRITORY_CODE_ST:
+		return "ST"
+	case IsoTerritoryCode_ISO_TERRITORY_CODE_SV:
+		return "SV"
+	case IsoTerritoryCode_ISO_TERRITORY_CODE_SX:
+		return "SX"
+	case IsoTerritoryCode_ISO_TERRITORY_CODE_SY:
+		return "SY"
+	case IsoTerritoryCode_ISO_TERRITORY_CODE_SZ:
+		return "SZ"
+	case IsoTerritoryCode_ISO_TERRITORY_CODE_TC:
+		return "TC"
+	case IsoTerritoryCode_ISO_TERRITORY_CODE_TD:
+		return "TD"
+	case IsoTerritoryCode_ISO_TERRITORY_CODE_TF:
+		return "TF"
+	case IsoTerritoryCode_ISO_TERRITORY_CODE_TG:
+		return "TG"
+	case IsoTerritoryCode_ISO_TERRITORY_CODE_TH:
+		return "TH"
+	case IsoTerritoryCode_ISO_TERRITORY_CODE_TJ:
+		return "TJ"
+	case IsoTerritoryCode_ISO_TERRITORY_CODE_TK:
+		return "TK"
+	case IsoTerritoryCode_ISO_TERRITORY_CODE_TL:
+		return "TL"
+	case IsoTerritoryCode_ISO_TERRITORY_CODE_TM:
+		return "TM"
+	case IsoTerritoryCode_ISO_TERRITORY_CODE_TN:
+		return "TN"
+	case IsoTerritoryCode_ISO_TERRITORY_CODE_TO:
+		return "TO"
+	case IsoTerritoryCode_ISO_TERRITORY_CODE_TR:
+		return "TR"
+	case IsoTerritoryCode_ISO_TERRITORY_CODE_TT:
+		return "TT"
+	case IsoTerritoryCode_ISO_TERRITORY_CODE_TV:
+		return "TV"
+	case IsoTerritoryCode_ISO_TERRITORY_CODE_TW:
+		return "TW"
+	case IsoTerritoryCode_ISO_TERRITORY_CODE_TZ:
+		return "TZ"
+	case IsoTerritoryCode_ISO_TERRITORY_CODE_UA:
+		return "UA"
+	case IsoTerritoryCode_ISO_TERRITORY_CODE_UG:
+		return "UG"
+	case IsoTerritoryCode_ISO_TERRITORY_CODE_UM:
+		return "UM"
+	case IsoTerritoryCode_ISO_TERRITORY_CODE_US:
+		return "US"
+	case IsoTerritoryCode_ISO_TERRITORY_CODE_UY:
+		return "UY"
+	case IsoTerritoryCode_ISO_TERRITORY_CODE_UZ:
+		return "UZ"
+	case IsoTerritoryCode_ISO_TERRITORY_CODE_VA:
+		return "VA"
+	case IsoTerritoryCode_ISO_TERRITORY_CODE_VC:
+		return "VC"
+	case IsoTerritoryCode_ISO_TERRITORY_CODE_VE:
+		return "VE"
+	case IsoTerritoryCode_ISO_TERRITORY_CODE_VG:
+		return "VG"
+	case IsoTerritoryCode_ISO_TERRITORY_CODE_VI:
+		return "VI"
+	case IsoTerritoryCode_ISO_TERRITORY_CODE_VN:
+		return "VN"
+	case IsoTerritoryCode_ISO_TERRITORY_CODE_VU:
+		return "VU"
+	case IsoTerritoryCode_ISO_TERRITORY_CODE_WF:
+		return "WF"
+	case IsoTerritoryCode_ISO_TERRITORY_CODE_WS:
+		return "WS"
+	case IsoTerritoryCode_ISO_TERRITORY_CODE_YE:
+		return "YE"
+	case IsoTerritoryCode_ISO_TERRITORY_CODE_YT:
+		return "YT"
+	case IsoTerritoryCode_ISO_TERRITORY_CODE_ZA:
+		return "ZA"
+	case IsoTerritoryCode_ISO_TERRITORY_CODE_ZM:
+		return "ZM"
+	case IsoTerritoryCode_ISO_TERRITORY_CODE_ZW:
+		return "ZW"
 	default:
 		return ""
 	}
@@ -917,6 +11723,514 @@ func (e IsoTerritoryCode) XMLString() string {
 func ParseIsoTerritoryCodeString(s string) (IsoTerritoryCode, bool) {
 	s = strings.ToUpper(s)
 	switch s {
+	case "AD":
+		return IsoTerritoryCode_ISO_TERRITORY_CODE_AD, true
+	case "AE":
+		return IsoTerritoryCode_ISO_TERRITORY_CODE_AE, true
+	case "AF":
+		return IsoTerritoryCode_ISO_TERRITORY_CODE_AF, true
+	case "AG":
+		return IsoTerritoryCode_ISO_TERRITORY_CODE_AG, true
+	case "AI":
+		return IsoTerritoryCode_ISO_TERRITORY_CODE_AI, true
+	case "AL":
+		return IsoTerritoryCode_ISO_TERRITORY_CODE_AL, true
+	case "AM":
+		return IsoTerritoryCode_ISO_TERRITORY_CODE_AM, true
+	case "AN":
+		return IsoTerritoryCode_ISO_TERRITORY_CODE_AN, true
+	case "AO":
+		return IsoTerritoryCode_ISO_TERRITORY_CODE_AO, true
+	case "AQ":
+		return IsoTerritoryCode_ISO_TERRITORY_CODE_AQ, true
+	case "AR":
+		return IsoTerritoryCode_ISO_TERRITORY_CODE_AR, true
+	case "AS":
+		return IsoTerritoryCode_ISO_TERRITORY_CODE_AS, true
+	case "AT":
+		return IsoTerritoryCode_ISO_TERRITORY_CODE_AT, true
+	case "AU":
+		return IsoTerritoryCode_ISO_TERRITORY_CODE_AU, true
+	case "AW":
+		return IsoTerritoryCode_ISO_TERRITORY_CODE_AW, true
+	case "AX":
+		return IsoTerritoryCode_ISO_TERRITORY_CODE_AX, true
+	case "AZ":
+		return IsoTerritoryCode_ISO_TERRITORY_CODE_AZ, true
+	case "BA":
+		return IsoTerritoryCode_ISO_TERRITORY_CODE_BA, true
+	case "BB":
+		return IsoTerritoryCode_ISO_TERRITORY_CODE_BB, true
+	case "BD":
+		return IsoTerritoryCode_ISO_TERRITORY_CODE_BD, true
+	case "BE":
+		return IsoTerritoryCode_ISO_TERRITORY_CODE_BE, true
+	case "BF":
+		return IsoTerritoryCode_ISO_TERRITORY_CODE_BF, true
+	case "BG":
+		return IsoTerritoryCode_ISO_TERRITORY_CODE_BG, true
+	case "BH":
+		return IsoTerritoryCode_ISO_TERRITORY_CODE_BH, true
+	case "BI":
+		return IsoTerritoryCode_ISO_TERRITORY_CODE_BI, true
+	case "BJ":
+		return IsoTerritoryCode_ISO_TERRITORY_CODE_BJ, true
+	case "BL":
+		return IsoTerritoryCode_ISO_TERRITORY_CODE_BL, true
+	case "BM":
+		return IsoTerritoryCode_ISO_TERRITORY_CODE_BM, true
+	case "BN":
+		return IsoTerritoryCode_ISO_TERRITORY_CODE_BN, true
+	case "BO":
+		return IsoTerritoryCode_ISO_TERRITORY_CODE_BO, true
+	case "BQ":
+		return IsoTerritoryCode_ISO_TERRITORY_CODE_BQ, true
+	case "BR":
+		return IsoTerritoryCode_ISO_TERRITORY_CODE_BR, true
+	case "BS":
+		return IsoTerritoryCode_ISO_TERRITORY_CODE_BS, true
+	case "BT":
+		return IsoTerritoryCode_ISO_TERRITORY_CODE_BT, true
+	case "BV":
+		return IsoTerritoryCode_ISO_TERRITORY_CODE_BV, true
+	case "BW":
+		return IsoTerritoryCode_ISO_TERRITORY_CODE_BW, true
+	case "BY":
+		return IsoTerritoryCode_ISO_TERRITORY_CODE_BY, true
+	case "BZ":
+		return IsoTerritoryCode_ISO_TERRITORY_CODE_BZ, true
+	case "CA":
+		return IsoTerritoryCode_ISO_TERRITORY_CODE_CA, true
+	case "CC":
+		return IsoTerritoryCode_ISO_TERRITORY_CODE_CC, true
+	case "CD":
+		return IsoTerritoryCode_ISO_TERRITORY_CODE_CD, true
+	case "CF":
+		return IsoTerritoryCode_ISO_TERRITORY_CODE_CF, true
+	case "CG":
+		return IsoTerritoryCode_ISO_TERRITORY_CODE_CG, true
+	case "CH":
+		return IsoTerritoryCode_ISO_TERRITORY_CODE_CH, true
+	case "CI":
+		return IsoTerritoryCode_ISO_TERRITORY_CODE_CI, true
+	case "CK":
+		return IsoTerritoryCode_ISO_TERRITORY_CODE_CK, true
+	case "CL":
+		return IsoTerritoryCode_ISO_TERRITORY_CODE_CL, true
+	case "CM":
+		return IsoTerritoryCode_ISO_TERRITORY_CODE_CM, true
+	case "CN":
+		return IsoTerritoryCode_ISO_TERRITORY_CODE_CN, true
+	case "CO":
+		return IsoTerritoryCode_ISO_TERRITORY_CODE_CO, true
+	case "CR":
+		return IsoTerritoryCode_ISO_TERRITORY_CODE_CR, true
+	case "CS":
+		return IsoTerritoryCode_ISO_TERRITORY_CODE_CS, true
+	case "CU":
+		return IsoTerritoryCode_ISO_TERRITORY_CODE_CU, true
+	case "CV":
+		return IsoTerritoryCode_ISO_TERRITORY_CODE_CV, true
+	case "CW":
+		return IsoTerritoryCode_ISO_TERRITORY_CODE_CW, true
+	case "CX":
+		return IsoTerritoryCode_ISO_TERRITORY_CODE_CX, true
+	case "CY":
+		return IsoTerritoryCode_ISO_TERRITORY_CODE_CY, true
+	case "CZ":
+		return IsoTerritoryCode_ISO_TERRITORY_CODE_CZ, true
+	case "DE":
+		return IsoTerritoryCode_ISO_TERRITORY_CODE_DE, true
+	case "DJ":
+		return IsoTerritoryCode_ISO_TERRITORY_CODE_DJ, true
+	case "DK":
+		return IsoTerritoryCode_ISO_TERRITORY_CODE_DK, true
+	case "DM":
+		return IsoTerritoryCode_ISO_TERRITORY_CODE_DM, true
+	case "DO":
+		return IsoTerritoryCode_ISO_TERRITORY_CODE_DO, true
+	case "DZ":
+		return IsoTerritoryCode_ISO_TERRITORY_CODE_DZ, true
+	case "EC":
+		return IsoTerritoryCode_ISO_TERRITORY_CODE_EC, true
+	case "EE":
+		return IsoTerritoryCode_ISO_TERRITORY_CODE_EE, true
+	case "EG":
+		return IsoTerritoryCode_ISO_TERRITORY_CODE_EG, true
+	case "EH":
+		return IsoTerritoryCode_ISO_TERRITORY_CODE_EH, true
+	case "ER":
+		return IsoTerritoryCode_ISO_TERRITORY_CODE_ER, true
+	case "ES":
+		return IsoTerritoryCode_ISO_TERRITORY_CODE_ES, true
+	case "ES_CE":
+		return IsoTerritoryCode_ISO_TERRITORY_CODE_ES_CE, true
+	case "ES_CN":
+		return IsoTerritoryCode_ISO_TERRITORY_CODE_ES_CN, true
+	case "ES_ML":
+		return IsoTerritoryCode_ISO_TERRITORY_CODE_ES_ML, true
+	case "ET":
+		return IsoTerritoryCode_ISO_TERRITORY_CODE_ET, true
+	case "FI":
+		return IsoTerritoryCode_ISO_TERRITORY_CODE_FI, true
+	case "FJ":
+		return IsoTerritoryCode_ISO_TERRITORY_CODE_FJ, true
+	case "FK":
+		return IsoTerritoryCode_ISO_TERRITORY_CODE_FK, true
+	case "FM":
+		return IsoTerritoryCode_ISO_TERRITORY_CODE_FM, true
+	case "FO":
+		return IsoTerritoryCode_ISO_TERRITORY_CODE_FO, true
+	case "FR":
+		return IsoTerritoryCode_ISO_TERRITORY_CODE_FR, true
+	case "GA":
+		return IsoTerritoryCode_ISO_TERRITORY_CODE_GA, true
+	case "GB":
+		return IsoTerritoryCode_ISO_TERRITORY_CODE_GB, true
+	case "GD":
+		return IsoTerritoryCode_ISO_TERRITORY_CODE_GD, true
+	case "GE":
+		return IsoTerritoryCode_ISO_TERRITORY_CODE_GE, true
+	case "GF":
+		return IsoTerritoryCode_ISO_TERRITORY_CODE_GF, true
+	case "GG":
+		return IsoTerritoryCode_ISO_TERRITORY_CODE_GG, true
+	case "GH":
+		return IsoTerritoryCode_ISO_TERRITORY_CODE_GH, true
+	case "GI":
+		return IsoTerritoryCode_ISO_TERRITORY_CODE_GI, true
+	case "GL":
+		return IsoTerritoryCode_ISO_TERRITORY_CODE_GL, true
+	case "GM":
+		return IsoTerritoryCode_ISO_TERRITORY_CODE_GM, true
+	case "GN":
+		return IsoTerritoryCode_ISO_TERRITORY_CODE_GN, true
+	case "GP":
+		return IsoTerritoryCode_ISO_TERRITORY_CODE_GP, true
+	case "GQ":
+		return IsoTerritoryCode_ISO_TERRITORY_CODE_GQ, true
+	case "GR":
+		return IsoTerritoryCode_ISO_TERRITORY_CODE_GR, true
+	case "GS":
+		return IsoTerritoryCode_ISO_TERRITORY_CODE_GS, true
+	case "GT":
+		return IsoTerritoryCode_ISO_TERRITORY_CODE_GT, true
+	case "GU":
+		return IsoTerritoryCode_ISO_TERRITORY_CODE_GU, true
+	case "GW":
+		return IsoTerritoryCode_ISO_TERRITORY_CODE_GW, true
+	case "GY":
+		return IsoTerritoryCode_ISO_TERRITORY_CODE_GY, true
+	case "HK":
+		return IsoTerritoryCode_ISO_TERRITORY_CODE_HK, true
+	case "HM":
+		return IsoTerritoryCode_ISO_TERRITORY_CODE_HM, true
+	case "HN":
+		return IsoTerritoryCode_ISO_TERRITORY_CODE_HN, true
+	case "HR":
+		return IsoTerritoryCode_ISO_TERRITORY_CODE_HR, true
+	case "HT":
+		return IsoTerritoryCode_ISO_TERRITORY_CODE_HT, true
+	case "HU":
+		return IsoTerritoryCode_ISO_TERRITORY_CODE_HU, true
+	case "ID":
+		return IsoTerritoryCode_ISO_TERRITORY_CODE_ID, true
+	case "IE":
+		return IsoTerritoryCode_ISO_TERRITORY_CODE_IE, true
+	case "IL":
+		return IsoTerritoryCode_ISO_TERRITORY_CODE_IL, true
+	case "IM":
+		return IsoTerritoryCode_ISO_TERRITORY_CODE_IM, true
+	case "IN":
+		return IsoTerritoryCode_ISO_TERRITORY_CODE_IN, true
+	case "IO":
+		return IsoTerritoryCode_ISO_TERRITORY_CODE_IO, true
+	case "IQ":
+		return IsoTerritoryCode_ISO_TERRITORY_CODE_IQ, true
+	case "IR":
+		return IsoTerritoryCode_ISO_TERRITORY_CODE_IR, true
+	case "IS":
+		return IsoTerritoryCode_ISO_TERRITORY_CODE_IS, true
+	case "IT":
+		return IsoTerritoryCode_ISO_TERRITORY_CODE_IT, true
+	case "JE":
+		return IsoTerritoryCode_ISO_TERRITORY_CODE_JE, true
+	case "JM":
+		return IsoTerritoryCode_ISO_TERRITORY_CODE_JM, true
+	case "JO":
+		return IsoTerritoryCode_ISO_TERRITORY_CODE_JO, true
+	case "JP":
+		return IsoTerritoryCode_ISO_TERRITORY_CODE_JP, true
+	case "KE":
+		return IsoTerritoryCode_ISO_TERRITORY_CODE_KE, true
+	case "KG":
+		return IsoTerritoryCode_ISO_TERRITORY_CODE_KG, true
+	case "KH":
+		return IsoTerritoryCode_ISO_TERRITORY_CODE_KH, true
+	case "KI":
+		return IsoTerritoryCode_ISO_TERRITORY_CODE_KI, true
+	case "KM":
+		return IsoTerritoryCode_ISO_TERRITORY_CODE_KM, true
+	case "KN":
+		return IsoTerritoryCode_ISO_TERRITORY_CODE_KN, true
+	case "KP":
+		return IsoTerritoryCode_ISO_TERRITORY_CODE_KP, true
+	case "KR":
+		return IsoTerritoryCode_ISO_TERRITORY_CODE_KR, true
+	case "KW":
+		return IsoTerritoryCode_ISO_TERRITORY_CODE_KW, true
+	case "KY":
+		return IsoTerritoryCode_ISO_TERRITORY_CODE_KY, true
+	case "KZ":
+		return IsoTerritoryCode_ISO_TERRITORY_CODE_KZ, true
+	case "LA":
+		return IsoTerritoryCode_ISO_TERRITORY_CODE_LA, true
+	case "LB":
+		return IsoTerritoryCode_ISO_TERRITORY_CODE_LB, true
+	case "LC":
+		return IsoTerritoryCode_ISO_TERRITORY_CODE_LC, true
+	case "LI":
+		return IsoTerritoryCode_ISO_TERRITORY_CODE_LI, true
+	case "LK":
+		return IsoTerritoryCode_ISO_TERRITORY_CODE_LK, true
+	case "LR":
+		return IsoTerritoryCode_ISO_TERRITORY_CODE_LR, true
+	case "LS":
+		return IsoTerritoryCode_ISO_TERRITORY_CODE_LS, true
+	case "LT":
+		return IsoTerritoryCode_ISO_TERRITORY_CODE_LT, true
+	case "LU":
+		return IsoTerritoryCode_ISO_TERRITORY_CODE_LU, true
+	case "LV":
+		return IsoTerritoryCode_ISO_TERRITORY_CODE_LV, true
+	case "LY":
+		return IsoTerritoryCode_ISO_TERRITORY_CODE_LY, true
+	case "MA":
+		return IsoTerritoryCode_ISO_TERRITORY_CODE_MA, true
+	case "MC":
+		return IsoTerritoryCode_ISO_TERRITORY_CODE_MC, true
+	case "MD":
+		return IsoTerritoryCode_ISO_TERRITORY_CODE_MD, true
+	case "ME":
+		return IsoTerritoryCode_ISO_TERRITORY_CODE_ME, true
+	case "MF":
+		return IsoTerritoryCode_ISO_TERRITORY_CODE_MF, true
+	case "MG":
+		return IsoTerritoryCode_ISO_TERRITORY_CODE_MG, true
+	case "MH":
+		return IsoTerritoryCode_ISO_TERRITORY_CODE_MH, true
+	case "MK":
+		return IsoTerritoryCode_ISO_TERRITORY_CODE_MK, true
+	case "ML":
+		return IsoTerritoryCode_ISO_TERRITORY_CODE_ML, true
+	case "MM":
+		return IsoTerritoryCode_ISO_TERRITORY_CODE_MM, true
+	case "MN":
+		return IsoTerritoryCode_ISO_TERRITORY_CODE_MN, true
+	case "MO":
+		return IsoTerritoryCode_ISO_TERRITORY_CODE_MO, true
+	case "MP":
+		return IsoTerritoryCode_ISO_TERRITORY_CODE_MP, true
+	case "MQ":
+		return IsoTerritoryCode_ISO_TERRITORY_CODE_MQ, true
+	case "MR":
+		return IsoTerritoryCode_ISO_TERRITORY_CODE_MR, true
+	case "MS":
+		return IsoTerritoryCode_ISO_TERRITORY_CODE_MS, true
+	case "MT":
+		return IsoTerritoryCode_ISO_TERRITORY_CODE_MT, true
+	case "MU":
+		return IsoTerritoryCode_ISO_TERRITORY_CODE_MU, true
+	case "MV":
+		return IsoTerritoryCode_ISO_TERRITORY_CODE_MV, true
+	case "MW":
+		return IsoTerritoryCode_ISO_TERRITORY_CODE_MW, true
+	case "MX":
+		return IsoTerritoryCode_ISO_TERRITORY_CODE_MX, true
+	case "MY":
+		return IsoTerritoryCode_ISO_TERRITORY_CODE_MY, true
+	case "MZ":
+		return IsoTerritoryCode_ISO_TERRITORY_CODE_MZ, true
+	case "NA":
+		return IsoTerritoryCode_ISO_TERRITORY_CODE_NA, true
+	case "NC":
+		return IsoTerritoryCode_ISO_TERRITORY_CODE_NC, true
+	case "NE":
+		return IsoTerritoryCode_ISO_TERRITORY_CODE_NE, true
+	case "NF":
+		return IsoTerritoryCode_ISO_TERRITORY_CODE_NF, true
+	case "NG":
+		return IsoTerritoryCode_ISO_TERRITORY_CODE_NG, true
+	case "NI":
+		return IsoTerritoryCode_ISO_TERRITORY_CODE_NI, true
+	case "NL":
+		return IsoTerritoryCode_ISO_TERRITORY_CODE_NL, true
+	case "NO":
+		return IsoTerritoryCode_ISO_TERRITORY_CODE_NO, true
+	case "NP":
+		return IsoTerritoryCode_ISO_TERRITORY_CODE_NP, true
+	case "NR":
+		return IsoTerritoryCode_ISO_TERRITORY_CODE_NR, true
+	case "NU":
+		return IsoTerritoryCode_ISO_TERRITORY_CODE_NU, true
+	case "NZ":
+		return IsoTerritoryCode_ISO_TERRITORY_CODE_NZ, true
+	case "OM":
+		return IsoTerritoryCode_ISO_TERRITORY_CODE_OM, true
+	case "PA":
+		return IsoTerritoryCode_ISO_TERRITORY_CODE_PA, true
+	case "PE":
+		return IsoTerritoryCode_ISO_TERRITORY_CODE_PE, true
+	case "PF":
+		return IsoTerritoryCode_ISO_TERRITORY_CODE_PF, true
+	case "PG":
+		return IsoTerritoryCode_ISO_TERRITORY_CODE_PG, true
+	case "PH":
+		return IsoTerritoryCode_ISO_TERRITORY_CODE_PH, true
+	case "PK":
+		return IsoTerritoryCode_ISO_TERRITORY_CODE_PK, true
+	case "PL":
+		return IsoTerritoryCode_ISO_TERRITORY_CODE_PL, true
+	case "PM":
+		return IsoTerritoryCode_ISO_TERRITORY_CODE_PM, true
+	case "PN":
+		return IsoTerritoryCode_ISO_TERRITORY_CODE_PN, true
+	case "PR":
+		return IsoTerritoryCode_ISO_TERRITORY_CODE_PR, true
+	case "PS":
+		return IsoTerritoryCode_ISO_TERRITORY_CODE_PS, true
+	case "PT":
+		return IsoTerritoryCode_ISO_TERRITORY_CODE_PT, true
+	case "PW":
+		return IsoTerritoryCode_ISO_TERRITORY_CODE_PW, true
+	case "PY":
+		return IsoTerritoryCode_ISO_TERRITORY_CODE_PY, true
+	case "QA":
+		return IsoTerritoryCode_ISO_TERRITORY_CODE_QA, true
+	case "RE":
+		return IsoTerritoryCode_ISO_TERRITORY_CODE_RE, true
+	case "RO":
+		return IsoTerritoryCode_ISO_TERRITORY_CODE_RO, true
+	case "RS":
+		return IsoTerritoryCode_ISO_TERRITORY_CODE_RS, true
+	case "RU":
+		return IsoTerritoryCode_ISO_TERRITORY_CODE_RU, true
+	case "RW":
+		return IsoTerritoryCode_ISO_TERRITORY_CODE_RW, true
+	case "SA":
+		return IsoTerritoryCode_ISO_TERRITORY_CODE_SA, true
+	case "SB":
+		return IsoTerritoryCode_ISO_TERRITORY_CODE_SB, true
+	case "SC":
+		return IsoTerritoryCode_ISO_TERRITORY_CODE_SC, true
+	case "SD":
+		return IsoTerritoryCode_ISO_TERRITORY_CODE_SD, true
+	case "SE":
+		return IsoTerritoryCode_ISO_TERRITORY_CODE_SE, true
+	case "SG":
+		return IsoTerritoryCode_ISO_TERRITORY_CODE_SG, true
+	case "SH":
+		return IsoTerritoryCode_ISO_TERRITORY_CODE_SH, true
+	case "SI":
+		return IsoTerritoryCode_ISO_TERRITORY_CODE_SI, true
+	case "SJ":
+		return IsoTerritoryCode_ISO_TERRITORY_CODE_SJ, true
+	case "SK":
+		return IsoTerritoryCode_ISO_TERRITORY_CODE_SK, true
+	case "SL":
+		return IsoTerritoryCode_ISO_TERRITORY_CODE_SL, true
+	case "SM":
+		return IsoTerritoryCode_ISO_TERRITORY_CODE_SM, true
+	case "SN":
+		return IsoTerritoryCode_ISO_TERRITORY_CODE_SN, true
+	case "SO":
+		return IsoTerritoryCode_ISO_TERRITORY_CODE_SO, true
+	case "SR":
+		return IsoTerritoryCode_ISO_TERRITORY_CODE_SR, true
+	case "SS":
+		return IsoTerritoryCode_ISO_TERRITORY_CODE_SS, true
+	case "ST":
+		return IsoTerritoryCode_ISO_TERRITORY_CODE_ST, true
+	case "SV":
+		return IsoTerritoryCode_ISO_TERRITORY_CODE_SV, true
+	case "SX":
+		return IsoTerritoryCode_ISO_TERRITORY_CODE_SX, true
+	case "SY":
+		return IsoTerritoryCode_ISO_TERRITORY_CODE_SY, true
+	case "SZ":
+		return IsoTerritoryCode_ISO_TERRITORY_CODE_SZ, true
+	case "TC":
+		return IsoTerritoryCode_ISO_TERRITORY_CODE_TC, true
+	case "TD":
+		return IsoTerritoryCode_ISO_TERRITORY_CODE_TD, true
+	case "TF":
+		return IsoTerritoryCode_ISO_TERRITORY_CODE_TF, true
+	case "TG":
+		return IsoTerritoryCode_ISO_TERRITORY_CODE_TG, true
+	case "TH":
+		return IsoTerritoryCode_ISO_TERRITORY_CODE_TH, true
+	case "TJ":
+		return IsoTerritoryCode_ISO_TERRITORY_CODE_TJ, true
+	case "TK":
+		return IsoTerritoryCode_ISO_TERRITORY_CODE_TK, true
+	case "TL":
+		return IsoTerritoryCode_ISO_TERRITORY_CODE_TL, true
+	case "TM":
+		return IsoTerritoryCode_ISO_TERRITORY_CODE_TM, true
+	case "TN":
+		return IsoTerritoryCode_ISO_TERRITORY_CODE_TN, true
+	case "TO":
+		return IsoTerritoryCode_ISO_TERRITORY_CODE_TO, true
+	case "TR":
+		return IsoTerritoryCode_ISO_TERRITORY_CODE_TR, true
+	case "TT":
+		return IsoTerritoryCode_ISO_TERRITORY_CODE_TT, true
+	case "TV":
+		return IsoTerritoryCode_ISO_TERRITORY_CODE_TV, true
+	case "TW":
+		return IsoTerritoryCode_ISO_TERRITORY_CODE_TW, true
+	case "TZ":
+		return IsoTerritoryCode_ISO_TERRITORY_CODE_TZ, true
+	case "UA":
+		return IsoTerritoryCode_ISO_TERRITORY_CODE_UA, true
+	case "UG":
+		return IsoTerritoryCode_ISO_TERRITORY_CODE_UG, true
+	case "UM":
+		return IsoTerritoryCode_ISO_TERRITORY_CODE_UM, true
+	case "US":
+		return IsoTerritoryCode_ISO_TERRITORY_CODE_US, true
+	case "UY":
+		return IsoTerritoryCode_ISO_TERRITORY_CODE_UY, true
+	case "UZ":
+		return IsoTerritoryCode_ISO_TERRITORY_CODE_UZ, true
+	case "VA":
+		return IsoTerritoryCode_ISO_TERRITORY_CODE_VA, true
+	case "VC":
+		return IsoTerritoryCode_ISO_TERRITORY_CODE_VC, true
+	case "VE":
+		return IsoTerritoryCode_ISO_TERRITORY_CODE_VE, true
+	case "VG":
+		return IsoTerritoryCode_ISO_TERRITORY_CODE_VG, true
+	case "VI":
+		return IsoTerritoryCode_ISO_TERRITORY_CODE_VI, true
+	case "VN":
+		return IsoTerritoryCode_ISO_TERRITORY_CODE_VN, true
+	case "VU":
+		return IsoTerritoryCode_ISO_TERRITORY_CODE_VU, true
+	case "WF":
+		return IsoTerritoryCode_ISO_TERRITORY_CODE_WF, true
+	case "WS":
+		return IsoTerritoryCode_ISO_TERRITORY_CODE_WS, true
+	case "YE":
+		return IsoTerritoryCode_ISO_TERRITORY_CODE_YE, true
+	case "YT":
+		return IsoTerritoryCode_ISO_TERRITORY_CODE_YT, true
+	case "ZA":
+		return IsoTerritoryCode_ISO_TERRITORY_CODE_ZA, true
+	case "ZM":
+		return IsoTerritoryCode_ISO_TERRITORY_CODE_ZM, true
+	case "ZW":
+		return IsoTerritoryCode_ISO_TERRITORY_CODE_ZW, true
 	default:
 		return IsoTerritoryCode(0), false
 	}
@@ -925,6 +12239,10 @@ func ParseIsoTerritoryCodeString(s string) (IsoTerritoryCode, bool) {
 // XMLString returns the XML string representation of LabelNameType
 func (e LabelNameType) XMLString() string {
 	switch e {
+	case LabelNameType_LABEL_NAME_TYPE_DISPLAYLABELNAME:
+		return "DISPLAYLABELNAME"
+	case LabelNameType_LABEL_NAME_TYPE_USERDEFINED:
+		return "USERDEFINED"
 	default:
 		return ""
 	}
@@ -934,6 +12252,10 @@ func (e LabelNameType) XMLString() string {
 func ParseLabelNameTypeString(s string) (LabelNameType, bool) {
 	s = strings.ToUpper(s)
 	switch s {
+	case "DISPLAYLABELNAME":
+		return LabelNameType_LABEL_NAME_TYPE_DISPLAYLABELNAME, true
+	case "USERDEFINED":
+		return LabelNameType_LABEL_NAME_TYPE_USERDEFINED, true
 	default:
 		return LabelNameType(0), false
 	}
@@ -942,6 +12264,86 @@ func ParseLabelNameTypeString(s string) (LabelNameType, bool) {
 // XMLString returns the XML string representation of LicenseOrClaimRefusalReason
 func (e LicenseOrClaimRefusalReason) XMLString() string {
 	switch e {
+	case LicenseOrClaimRefusalReason_LICENSE_OR_CLAIM_REFUSAL_REASON_AGREEMENTOFADDITIONALPROVISIONSREQUIRED:
+		return "AGREEMENTOFADDITIONALPROVISIONSREQUIRED"
+	case LicenseOrClaimRefusalReason_LICENSE_OR_CLAIM_REFUSAL_REASON_CORRECTIONOFADVANCEPAYMENTREQUIRED:
+		return "CORRECTIONOFADVANCEPAYMENTREQUIRED"
+	case LicenseOrClaimRefusalReason_LICENSE_OR_CLAIM_REFUSAL_REASON_CORRECTIONOFGUARANTEEREQUIRED:
+		return "CORRECTIONOFGUARANTEEREQUIRED"
+	case LicenseOrClaimRefusalReason_LICENSE_OR_CLAIM_REFUSAL_REASON_CORRECTIONOFLICENSEEREQUIRED:
+		return "CORRECTIONOFLICENSEEREQUIRED"
+	case LicenseOrClaimRefusalReason_LICENSE_OR_CLAIM_REFUSAL_REASON_CORRECTIONOFMOSTFAVOREDNATIONCLAUSEREQUIRED:
+		return "CORRECTIONOFMOSTFAVOREDNATIONCLAUSEREQUIRED"
+	case LicenseOrClaimRefusalReason_LICENSE_OR_CLAIM_REFUSAL_REASON_CORRECTIONOFNUMBEROFRESOURCESREQUIRED:
+		return "CORRECTIONOFNUMBEROFRESOURCESREQUIRED"
+	case LicenseOrClaimRefusalReason_LICENSE_OR_CLAIM_REFUSAL_REASON_CORRECTIONOFPLAYINGTIMEREQUIRED:
+		return "CORRECTIONOFPLAYINGTIMEREQUIRED"
+	case LicenseOrClaimRefusalReason_LICENSE_OR_CLAIM_REFUSAL_REASON_CORRECTIONOFPUBLISHERINFORMATIONREQUIRED:
+		return "CORRECTIONOFPUBLISHERINFORMATIONREQUIRED"
+	case LicenseOrClaimRefusalReason_LICENSE_OR_CLAIM_REFUSAL_REASON_CORRECTIONOFPUBLISHERPERCENTAGEREQUIRED:
+		return "CORRECTIONOFPUBLISHERPERCENTAGEREQUIRED"
+	case LicenseOrClaimRefusalReason_LICENSE_OR_CLAIM_REFUSAL_REASON_CORRECTIONOFRATEREQUIRED:
+		return "CORRECTIONOFRATEREQUIRED"
+	case LicenseOrClaimRefusalReason_LICENSE_OR_CLAIM_REFUSAL_REASON_CORRECTIONOFRELEASECREATORINFORMATIONREQUIRED:
+		return "CORRECTIONOFRELEASECREATORINFORMATIONREQUIRED"
+	case LicenseOrClaimRefusalReason_LICENSE_OR_CLAIM_REFUSAL_REASON_CORRECTIONOFRELEASEDATEREQUIRED:
+		return "CORRECTIONOFRELEASEDATEREQUIRED"
+	case LicenseOrClaimRefusalReason_LICENSE_OR_CLAIM_REFUSAL_REASON_CORRECTIONOFRELEASETITLEREQUIRED:
+		return "CORRECTIONOFRELEASETITLEREQUIRED"
+	case LicenseOrClaimRefusalReason_LICENSE_OR_CLAIM_REFUSAL_REASON_CORRECTIONOFWORKCONTRIBUTORREQUIRED:
+		return "CORRECTIONOFWORKCONTRIBUTORREQUIRED"
+	case LicenseOrClaimRefusalReason_LICENSE_OR_CLAIM_REFUSAL_REASON_CORRECTIONOFWORKTITLEREQUIRED:
+		return "CORRECTIONOFWORKTITLEREQUIRED"
+	case LicenseOrClaimRefusalReason_LICENSE_OR_CLAIM_REFUSAL_REASON_DEALEXPIRED:
+		return "DEALEXPIRED"
+	case LicenseOrClaimRefusalReason_LICENSE_OR_CLAIM_REFUSAL_REASON_DIFFERENTWORK:
+		return "DIFFERENTWORK"
+	case LicenseOrClaimRefusalReason_LICENSE_OR_CLAIM_REFUSAL_REASON_DIRECTLICENSE:
+		return "DIRECTLICENSE"
+	case LicenseOrClaimRefusalReason_LICENSE_OR_CLAIM_REFUSAL_REASON_DUPLICATELICENSE:
+		return "DUPLICATELICENSE"
+	case LicenseOrClaimRefusalReason_LICENSE_OR_CLAIM_REFUSAL_REASON_DUPLICATEREQUEST:
+		return "DUPLICATEREQUEST"
+	case LicenseOrClaimRefusalReason_LICENSE_OR_CLAIM_REFUSAL_REASON_IMPORTLICENSEEXISTS:
+		return "IMPORTLICENSEEXISTS"
+	case LicenseOrClaimRefusalReason_LICENSE_OR_CLAIM_REFUSAL_REASON_INCORRECTCLAIM:
+		return "INCORRECTCLAIM"
+	case LicenseOrClaimRefusalReason_LICENSE_OR_CLAIM_REFUSAL_REASON_INCORRECTCONTROLLEDCOMPOSITIONRATE:
+		return "INCORRECTCONTROLLEDCOMPOSITIONRATE"
+	case LicenseOrClaimRefusalReason_LICENSE_OR_CLAIM_REFUSAL_REASON_INHOUSELICENSEEXISTS:
+		return "INHOUSELICENSEEXISTS"
+	case LicenseOrClaimRefusalReason_LICENSE_OR_CLAIM_REFUSAL_REASON_INSUFFICIENTINFORMATION:
+		return "INSUFFICIENTINFORMATION"
+	case LicenseOrClaimRefusalReason_LICENSE_OR_CLAIM_REFUSAL_REASON_LICENSEENOTAUTHORIZED:
+		return "LICENSEENOTAUTHORIZED"
+	case LicenseOrClaimRefusalReason_LICENSE_OR_CLAIM_REFUSAL_REASON_MEDLEYREQUEST:
+		return "MEDLEYREQUEST"
+	case LicenseOrClaimRefusalReason_LICENSE_OR_CLAIM_REFUSAL_REASON_NOOPTIN:
+		return "NOOPTIN"
+	case LicenseOrClaimRefusalReason_LICENSE_OR_CLAIM_REFUSAL_REASON_NOPUBLISHERCLAIM:
+		return "NOPUBLISHERCLAIM"
+	case LicenseOrClaimRefusalReason_LICENSE_OR_CLAIM_REFUSAL_REASON_OWNERSHIPUNCONFIRMED:
+		return "OWNERSHIPUNCONFIRMED"
+	case LicenseOrClaimRefusalReason_LICENSE_OR_CLAIM_REFUSAL_REASON_PRODUCTUNAVAILABLE:
+		return "PRODUCTUNAVAILABLE"
+	case LicenseOrClaimRefusalReason_LICENSE_OR_CLAIM_REFUSAL_REASON_PUBLISHERNOTREPRESENTED:
+		return "PUBLISHERNOTREPRESENTED"
+	case LicenseOrClaimRefusalReason_LICENSE_OR_CLAIM_REFUSAL_REASON_RELEASEWITHDRAWN:
+		return "RELEASEWITHDRAWN"
+	case LicenseOrClaimRefusalReason_LICENSE_OR_CLAIM_REFUSAL_REASON_RELINQUISHEDCLAIM:
+		return "RELINQUISHEDCLAIM"
+	case LicenseOrClaimRefusalReason_LICENSE_OR_CLAIM_REFUSAL_REASON_USERDEFINED:
+		return "USERDEFINED"
+	case LicenseOrClaimRefusalReason_LICENSE_OR_CLAIM_REFUSAL_REASON_WORKDELETEDFROMRELEASE:
+		return "WORKDELETEDFROMRELEASE"
+	case LicenseOrClaimRefusalReason_LICENSE_OR_CLAIM_REFUSAL_REASON_WORKINCORRECTLYIDENTIFIED:
+		return "WORKINCORRECTLYIDENTIFIED"
+	case LicenseOrClaimRefusalReason_LICENSE_OR_CLAIM_REFUSAL_REASON_WORKINPUBLICDOMAIN:
+		return "WORKINPUBLICDOMAIN"
+	case LicenseOrClaimRefusalReason_LICENSE_OR_CLAIM_REFUSAL_REASON_WORKNOTUSED:
+		return "WORKNOTUSED"
+	case LicenseOrClaimRefusalReason_LICENSE_OR_CLAIM_REFUSAL_REASON_WORKUNKNOWN:
+		return "WORKUNKNOWN"
 	default:
 		return ""
 	}
@@ -951,6 +12353,86 @@ func (e LicenseOrClaimRefusalReason) XMLString() string {
 func ParseLicenseOrClaimRefusalReasonString(s string) (LicenseOrClaimRefusalReason, bool) {
 	s = strings.ToUpper(s)
 	switch s {
+	case "AGREEMENTOFADDITIONALPROVISIONSREQUIRED":
+		return LicenseOrClaimRefusalReason_LICENSE_OR_CLAIM_REFUSAL_REASON_AGREEMENTOFADDITIONALPROVISIONSREQUIRED, true
+	case "CORRECTIONOFADVANCEPAYMENTREQUIRED":
+		return LicenseOrClaimRefusalReason_LICENSE_OR_CLAIM_REFUSAL_REASON_CORRECTIONOFADVANCEPAYMENTREQUIRED, true
+	case "CORRECTIONOFGUARANTEEREQUIRED":
+		return LicenseOrClaimRefusalReason_LICENSE_OR_CLAIM_REFUSAL_REASON_CORRECTIONOFGUARANTEEREQUIRED, true
+	case "CORRECTIONOFLICENSEEREQUIRED":
+		return LicenseOrClaimRefusalReason_LICENSE_OR_CLAIM_REFUSAL_REASON_CORRECTIONOFLICENSEEREQUIRED, true
+	case "CORRECTIONOFMOSTFAVOREDNATIONCLAUSEREQUIRED":
+		return LicenseOrClaimRefusalReason_LICENSE_OR_CLAIM_REFUSAL_REASON_CORRECTIONOFMOSTFAVOREDNATIONCLAUSEREQUIRED, true
+	case "CORRECTIONOFNUMBEROFRESOURCESREQUIRED":
+		return LicenseOrClaimRefusalReason_LICENSE_OR_CLAIM_REFUSAL_REASON_CORRECTIONOFNUMBEROFRESOURCESREQUIRED, true
+	case "CORRECTIONOFPLAYINGTIMEREQUIRED":
+		return LicenseOrClaimRefusalReason_LICENSE_OR_CLAIM_REFUSAL_REASON_CORRECTIONOFPLAYINGTIMEREQUIRED, true
+	case "CORRECTIONOFPUBLISHERINFORMATIONREQUIRED":
+		return LicenseOrClaimRefusalReason_LICENSE_OR_CLAIM_REFUSAL_REASON_CORRECTIONOFPUBLISHERINFORMATIONREQUIRED, true
+	case "CORRECTIONOFPUBLISHERPERCENTAGEREQUIRED":
+		return LicenseOrClaimRefusalReason_LICENSE_OR_CLAIM_REFUSAL_REASON_CORRECTIONOFPUBLISHERPERCENTAGEREQUIRED, true
+	case "CORRECTIONOFRATEREQUIRED":
+		return LicenseOrClaimRefusalReason_LICENSE_OR_CLAIM_REFUSAL_REASON_CORRECTIONOFRATEREQUIRED, true
+	case "CORRECTIONOFRELEASECREATORINFORMATIONREQUIRED":
+		return LicenseOrClaimRefusalReason_LICENSE_OR_CLAIM_REFUSAL_REASON_CORRECTIONOFRELEASECREATORINFORMATIONREQUIRED, true
+	case "CORRECTIONOFRELEASEDATEREQUIRED":
+		return LicenseOrClaimRefusalReason_LICENSE_OR_CLAIM_REFUSAL_REASON_CORRECTIONOFRELEASEDATEREQUIRED, true
+	case "CORRECTIONOFRELEASETITLEREQUIRED":
+		return LicenseOrClaimRefusalReason_LICENSE_OR_CLAIM_REFUSAL_REASON_CORRECTIONOFRELEASETITLEREQUIRED, true
+	case "CORRECTIONOFWORKCONTRIBUTORREQUIRED":
+		return LicenseOrClaimRefusalReason_LICENSE_OR_CLAIM_REFUSAL_REASON_CORRECTIONOFWORKCONTRIBUTORREQUIRED, true
+	case "CORRECTIONOFWORKTITLEREQUIRED":
+		return LicenseOrClaimRefusalReason_LICENSE_OR_CLAIM_REFUSAL_REASON_CORRECTIONOFWORKTITLEREQUIRED, true
+	case "DEALEXPIRED":
+		return LicenseOrClaimRefusalReason_LICENSE_OR_CLAIM_REFUSAL_REASON_DEALEXPIRED, true
+	case "DIFFERENTWORK":
+		return LicenseOrClaimRefusalReason_LICENSE_OR_CLAIM_REFUSAL_REASON_DIFFERENTWORK, true
+	case "DIRECTLICENSE":
+		return LicenseOrClaimRefusalReason_LICENSE_OR_CLAIM_REFUSAL_REASON_DIRECTLICENSE, true
+	case "DUPLICATELICENSE":
+		return LicenseOrClaimRefusalReason_LICENSE_OR_CLAIM_REFUSAL_REASON_DUPLICATELICENSE, true
+	case "DUPLICATEREQUEST":
+		return LicenseOrClaimRefusalReason_LICENSE_OR_CLAIM_REFUSAL_REASON_DUPLICATEREQUEST, true
+	case "IMPORTLICENSEEXISTS":
+		return LicenseOrClaimRefusalReason_LICENSE_OR_CLAIM_REFUSAL_REASON_IMPORTLICENSEEXISTS, true
+	case "INCORRECTCLAIM":
+		return LicenseOrClaimRefusalReason_LICENSE_OR_CLAIM_REFUSAL_REASON_INCORRECTCLAIM, true
+	case "INCORRECTCONTROLLEDCOMPOSITIONRATE":
+		return LicenseOrClaimRefusalReason_LICENSE_OR_CLAIM_REFUSAL_REASON_INCORRECTCONTROLLEDCOMPOSITIONRATE, true
+	case "INHOUSELICENSEEXISTS":
+		return LicenseOrClaimRefusalReason_LICENSE_OR_CLAIM_REFUSAL_REASON_INHOUSELICENSEEXISTS, true
+	case "INSUFFICIENTINFORMATION":
+		return LicenseOrClaimRefusalReason_LICENSE_OR_CLAIM_REFUSAL_REASON_INSUFFICIENTINFORMATION, true
+	case "LICENSEENOTAUTHORIZED":
+		return LicenseOrClaimRefusalReason_LICENSE_OR_CLAIM_REFUSAL_REASON_LICENSEENOTAUTHORIZED, true
+	case "MEDLEYREQUEST":
+		return LicenseOrClaimRefusalReason_LICENSE_OR_CLAIM_REFUSAL_REASON_MEDLEYREQUEST, true
+	case "NOOPTIN":
+		return LicenseOrClaimRefusalReason_LICENSE_OR_CLAIM_REFUSAL_REASON_NOOPTIN, true
+	case "NOPUBLISHERCLAIM":
+		return LicenseOrClaimRefusalReason_LICENSE_OR_CLAIM_REFUSAL_REASON_NOPUBLISHERCLAIM, true
+	case "OWNERSHIPUNCONFIRMED":
+		return LicenseOrClaimRefusalReason_LICENSE_OR_CLAIM_REFUSAL_REASON_OWNERSHIPUNCONFIRMED, true
+	case "PRODUCTUNAVAILABLE":
+		return LicenseOrClaimRefusalReason_LICENSE_OR_CLAIM_REFUSAL_REASON_PRODUCTUNAVAILABLE, true
+	case "PUBLISHERNOTREPRESENTED":
+		return LicenseOrClaimRefusalReason_LICENSE_OR_CLAIM_REFUSAL_REASON_PUBLISHERNOTREPRESENTED, true
+	case "RELEASEWITHDRAWN":
+		return LicenseOrClaimRefusalReason_LICENSE_OR_CLAIM_REFUSAL_REASON_RELEASEWITHDRAWN, true
+	case "RELINQUISHEDCLAIM":
+		return LicenseOrClaimRefusalReason_LICENSE_OR_CLAIM_REFUSAL_REASON_RELINQUISHEDCLAIM, true
+	case "USERDEFINED":
+		return LicenseOrClaimRefusalReason_LICENSE_OR_CLAIM_REFUSAL_REASON_USERDEFINED, true
+	case "WORKDELETEDFROMRELEASE":
+		return LicenseOrClaimRefusalReason_LICENSE_OR_CLAIM_REFUSAL_REASON_WORKDELETEDFROMRELEASE, true
+	case "WORKINCORRECTLYIDENTIFIED":
+		return LicenseOrClaimRefusalReason_LICENSE_OR_CLAIM_REFUSAL_REASON_WORKINCORRECTLYIDENTIFIED, true
+	case "WORKINPUBLICDOMAIN":
+		return LicenseOrClaimRefusalReason_LICENSE_OR_CLAIM_REFUSAL_REASON_WORKINPUBLICDOMAIN, true
+	case "WORKNOTUSED":
+		return LicenseOrClaimRefusalReason_LICENSE_OR_CLAIM_REFUSAL_REASON_WORKNOTUSED, true
+	case "WORKUNKNOWN":
+		return LicenseOrClaimRefusalReason_LICENSE_OR_CLAIM_REFUSAL_REASON_WORKUNKNOWN, true
 	default:
 		return LicenseOrClaimRefusalReason(0), false
 	}
@@ -959,6 +12441,12 @@ func ParseLicenseOrClaimRefusalReasonString(s string) (LicenseOrClaimRefusalReas
 // XMLString returns the XML string representation of LicenseOrClaimRequestUpdateReason
 func (e LicenseOrClaimRequestUpdateReason) XMLString() string {
 	switch e {
+	case LicenseOrClaimRequestUpdateReason_LICENSE_OR_CLAIM_REQUEST_UPDATE_REASON_ADDITIONALINFORMATIONPROVIDED:
+		return "ADDITIONALINFORMATIONPROVIDED"
+	case LicenseOrClaimRequestUpdateReason_LICENSE_OR_CLAIM_REQUEST_UPDATE_REASON_ADDITIONALINFORMATIONPROVIDEDASREQUESTED:
+		return "ADDITIONALINFORMATIONPROVIDEDASREQUESTED"
+	case LicenseOrClaimRequestUpdateReason_LICENSE_OR_CLAIM_REQUEST_UPDATE_REASON_USERDEFINED:
+		return "USERDEFINED"
 	default:
 		return ""
 	}
@@ -968,6 +12456,12 @@ func (e LicenseOrClaimRequestUpdateReason) XMLString() string {
 func ParseLicenseOrClaimRequestUpdateReasonString(s string) (LicenseOrClaimRequestUpdateReason, bool) {
 	s = strings.ToUpper(s)
 	switch s {
+	case "ADDITIONALINFORMATIONPROVIDED":
+		return LicenseOrClaimRequestUpdateReason_LICENSE_OR_CLAIM_REQUEST_UPDATE_REASON_ADDITIONALINFORMATIONPROVIDED, true
+	case "ADDITIONALINFORMATIONPROVIDEDASREQUESTED":
+		return LicenseOrClaimRequestUpdateReason_LICENSE_OR_CLAIM_REQUEST_UPDATE_REASON_ADDITIONALINFORMATIONPROVIDEDASREQUESTED, true
+	case "USERDEFINED":
+		return LicenseOrClaimRequestUpdateReason_LICENSE_OR_CLAIM_REQUEST_UPDATE_REASON_USERDEFINED, true
 	default:
 		return LicenseOrClaimRequestUpdateReason(0), false
 	}
@@ -976,6 +12470,18 @@ func ParseLicenseOrClaimRequestUpdateReasonString(s string) (LicenseOrClaimReque
 // XMLString returns the XML string representation of LicenseOrClaimUpdateReason
 func (e LicenseOrClaimUpdateReason) XMLString() string {
 	switch e {
+	case LicenseOrClaimUpdateReason_LICENSE_OR_CLAIM_UPDATE_REASON_NEWLICENSEISSUED:
+		return "NEWLICENSEISSUED"
+	case LicenseOrClaimUpdateReason_LICENSE_OR_CLAIM_UPDATE_REASON_NEWRIGHTSHAREIDENTIFIED:
+		return "NEWRIGHTSHAREIDENTIFIED"
+	case LicenseOrClaimUpdateReason_LICENSE_OR_CLAIM_UPDATE_REASON_NEWRIGHTSHOLDERIDENTIFIED:
+		return "NEWRIGHTSHOLDERIDENTIFIED"
+	case LicenseOrClaimUpdateReason_LICENSE_OR_CLAIM_UPDATE_REASON_NEWWORKIDENTIFIED:
+		return "NEWWORKIDENTIFIED"
+	case LicenseOrClaimUpdateReason_LICENSE_OR_CLAIM_UPDATE_REASON_REVOKED:
+		return "REVOKED"
+	case LicenseOrClaimUpdateReason_LICENSE_OR_CLAIM_UPDATE_REASON_USERDEFINED:
+		return "USERDEFINED"
 	default:
 		return ""
 	}
@@ -985,6 +12491,18 @@ func (e LicenseOrClaimUpdateReason) XMLString() string {
 func ParseLicenseOrClaimUpdateReasonString(s string) (LicenseOrClaimUpdateReason, bool) {
 	s = strings.ToUpper(s)
 	switch s {
+	case "NEWLICENSEISSUED":
+		return LicenseOrClaimUpdateReason_LICENSE_OR_CLAIM_UPDATE_REASON_NEWLICENSEISSUED, true
+	case "NEWRIGHTSHAREIDENTIFIED":
+		return LicenseOrClaimUpdateReason_LICENSE_OR_CLAIM_UPDATE_REASON_NEWRIGHTSHAREIDENTIFIED, true
+	case "NEWRIGHTSHOLDERIDENTIFIED":
+		return LicenseOrClaimUpdateReason_LICENSE_OR_CLAIM_UPDATE_REASON_NEWRIGHTSHOLDERIDENTIFIED, true
+	case "NEWWORKIDENTIFIED":
+		return LicenseOrClaimUpdateReason_LICENSE_OR_CLAIM_UPDATE_REASON_NEWWORKIDENTIFIED, true
+	case "REVOKED":
+		return LicenseOrClaimUpdateReason_LICENSE_OR_CLAIM_UPDATE_REASON_REVOKED, true
+	case "USERDEFINED":
+		return LicenseOrClaimUpdateReason_LICENSE_OR_CLAIM_UPDATE_REASON_USERDEFINED, true
 	default:
 		return LicenseOrClaimUpdateReason(0), false
 	}
@@ -993,6 +12511,30 @@ func ParseLicenseOrClaimUpdateReasonString(s string) (LicenseOrClaimUpdateReason
 // XMLString returns the XML string representation of LicenseRejectionReason
 func (e LicenseRejectionReason) XMLString() string {
 	switch e {
+	case LicenseRejectionReason_LICENSE_REJECTION_REASON_DISAGREEMENTOVERROYALTIES:
+		return "DISAGREEMENTOVERROYALTIES"
+	case LicenseRejectionReason_LICENSE_REJECTION_REASON_DISAGREEMENTOVERSCOPEOFLICENSE:
+		return "DISAGREEMENTOVERSCOPEOFLICENSE"
+	case LicenseRejectionReason_LICENSE_REJECTION_REASON_LICENSEEXISTS:
+		return "LICENSEEXISTS"
+	case LicenseRejectionReason_LICENSE_REJECTION_REASON_LICENSENOTNEEDED:
+		return "LICENSENOTNEEDED"
+	case LicenseRejectionReason_LICENSE_REJECTION_REASON_WRONGADDRESSEE:
+		return "WRONGADDRESSEE"
+	case LicenseRejectionReason_LICENSE_REJECTION_REASON_USERDEFINED:
+		return "USERDEFINED"
+	case LicenseRejectionReason_LICENSE_REJECTION_REASON_WORKINPUBLICDOMAIN:
+		return "WORKINPUBLICDOMAIN"
+	case LicenseRejectionReason_LICENSE_REJECTION_REASON_DUPLICATELICENSEREQUESTNUMBER:
+		return "DUPLICATELICENSEREQUESTNUMBER"
+	case LicenseRejectionReason_LICENSE_REJECTION_REASON_LICENSEBLOCKED:
+		return "LICENSEBLOCKED"
+	case LicenseRejectionReason_LICENSE_REJECTION_REASON_REFERENCEDDOCUMENTMISSING:
+		return "REFERENCEDDOCUMENTMISSING"
+	case LicenseRejectionReason_LICENSE_REJECTION_REASON_SHARESPLITSDIFFER:
+		return "SHARESPLITSDIFFER"
+	case LicenseRejectionReason_LICENSE_REJECTION_REASON_WORKUSEDMULTIPLETIMES:
+		return "WORKUSEDMULTIPLETIMES"
 	default:
 		return ""
 	}
@@ -1002,6 +12544,30 @@ func (e LicenseRejectionReason) XMLString() string {
 func ParseLicenseRejectionReasonString(s string) (LicenseRejectionReason, bool) {
 	s = strings.ToUpper(s)
 	switch s {
+	case "DISAGREEMENTOVERROYALTIES":
+		return LicenseRejectionReason_LICENSE_REJECTION_REASON_DISAGREEMENTOVERROYALTIES, true
+	case "DISAGREEMENTOVERSCOPEOFLICENSE":
+		return LicenseRejectionReason_LICENSE_REJECTION_REASON_DISAGREEMENTOVERSCOPEOFLICENSE, true
+	case "LICENSEEXISTS":
+		return LicenseRejectionReason_LICENSE_REJECTION_REASON_LICENSEEXISTS, true
+	case "LICENSENOTNEEDED":
+		return LicenseRejectionReason_LICENSE_REJECTION_REASON_LICENSENOTNEEDED, true
+	case "WRONGADDRESSEE":
+		return LicenseRejectionReason_LICENSE_REJECTION_REASON_WRONGADDRESSEE, true
+	case "USERDEFINED":
+		return LicenseRejectionReason_LICENSE_REJECTION_REASON_USERDEFINED, true
+	case "WORKINPUBLICDOMAIN":
+		return LicenseRejectionReason_LICENSE_REJECTION_REASON_WORKINPUBLICDOMAIN, true
+	case "DUPLICATELICENSEREQUESTNUMBER":
+		return LicenseRejectionReason_LICENSE_REJECTION_REASON_DUPLICATELICENSEREQUESTNUMBER, true
+	case "LICENSEBLOCKED":
+		return LicenseRejectionReason_LICENSE_REJECTION_REASON_LICENSEBLOCKED, true
+	case "REFERENCEDDOCUMENTMISSING":
+		return LicenseRejectionReason_LICENSE_REJECTION_REASON_REFERENCEDDOCUMENTMISSING, true
+	case "SHARESPLITSDIFFER":
+		return LicenseRejectionReason_LICENSE_REJECTION_REASON_SHARESPLITSDIFFER, true
+	case "WORKUSEDMULTIPLETIMES":
+		return LicenseRejectionReason_LICENSE_REJECTION_REASON_WORKUSEDMULTIPLETIMES, true
 	default:
 		return LicenseRejectionReason(0), false
 	}
@@ -1010,6 +12576,12 @@ func ParseLicenseRejectionReasonString(s string) (LicenseRejectionReason, bool)
 // XMLString returns the XML string representation of LicenseStatus
 func (e LicenseStatus) XMLString() string {
 	switch e {
+	case LicenseStatus_LICENSE_STATUS_ACTIVE:
+		return "ACTIVE"
+	case LicenseStatus_LICENSE_STATUS_PENDING:
+		return "PENDING"
+	case LicenseStatus_LICENSE_STATUS_REVOKED:
+		return "REVOKED"
 	default:
 		return ""
 	}
@@ -1019,6 +12591,12 @@ func (e LicenseStatus) XMLString() string {
 func ParseLicenseStatusString(s string) (LicenseStatus, bool) {
 	s = strings.ToUpper(s)
 	switch s {
+	case "ACTIVE":
+		return LicenseStatus_LICENSE_STATUS_ACTIVE, true
+	case "PENDING":
+		return LicenseStatus_LICENSE_STATUS_PENDING, true
+	case "REVOKED":
+		return LicenseStatus_LICENSE_STATUS_REVOKED, true
 	default:
 		return LicenseStatus(0), false
 	}
@@ -1027,6 +12605,12 @@ func ParseLicenseStatusString(s string) (LicenseStatus, bool) {
 // XMLString returns the XML string representation of LicensingProcessStatus
 func (e LicensingProcessStatus) XMLString() string {
 	switch e {
+	case LicensingProcessStatus_LICENSING_PROCESS_STATUS_PENDING:
+		return "PENDING"
+	case LicensingProcessStatus_LICENSING_PROCESS_STATUS_PROCESSED:
+		return "PROCESSED"
+	case LicensingProcessStatus_LICENSING_PROCESS_STATUS_THIRDPARTYINFORMATIONREQUESTED:
+		return "THIRDPARTYINFORMATIONREQUESTED"
 	default:
 		return ""
 	}
@@ -1036,6 +12620,12 @@ func (e LicensingProcessStatus) XMLString() string {
 func ParseLicensingProcessStatusString(s string) (LicensingProcessStatus, bool) {
 	s = strings.ToUpper(s)
 	switch s {
+	case "PENDING":
+		return LicensingProcessStatus_LICENSING_PROCESS_STATUS_PENDING, true
+	case "PROCESSED":
+		return LicensingProcessStatus_LICENSING_PROCESS_STATUS_PROCESSED, true
+	case "THIRDPARTYINFORMATIONREQUESTED":
+		return LicensingProcessStatus_LICENSING_PROCESS_STATUS_THIRDPARTYINFORMATIONREQUESTED, true
 	default:
 		return LicensingProcessStatus(0), false
 	}
@@ -1044,6 +12634,8 @@ func ParseLicensingProcessStatusString(s string) (LicensingProcessStatus, bool)
 // XMLString returns the XML string representation of LodFileStatus
 func (e LodFileStatus) XMLString() string {
 	switch e {
+	case LodFileStatus_LOD_FILE_STATUS_FILEOK:
+		return "FILEOK"
 	default:
 		return ""
 	}
@@ -1053,6 +12645,8 @@ func (e LodFileStatus) XMLString() string {
 func ParseLodFileStatusString(s string) (LodFileStatus, bool) {
 	s = strings.ToUpper(s)
 	switch s {
+	case "FILEOK":
+		return LodFileStatus_LOD_FILE_STATUS_FILEOK, true
 	default:
 		return LodFileStatus(0), false
 	}
@@ -1061,6 +12655,8 @@ func ParseLodFileStatusString(s string) (LodFileStatus, bool) {
 // XMLString returns the XML string representation of LodProposedActionType
 func (e LodProposedActionType) XMLString() string {
 	switch e {
+	case LodProposedActionType_LOD_PROPOSED_ACTION_TYPE_RESENDXMLONLY:
+		return "RESENDXMLONLY"
 	default:
 		return ""
 	}
@@ -1070,6 +12666,8 @@ func (e LodProposedActionType) XMLString() string {
 func ParseLodProposedActionTypeString(s string) (LodProposedActionType, bool) {
 	s = strings.ToUpper(s)
 	switch s {
+	case "RESENDXMLONLY":
+		return LodProposedActionType_LOD_PROPOSED_ACTION_TYPE_RESENDXMLONLY, true
 	default:
 		return LodProposedActionType(0), false
 	}
@@ -1078,6 +12676,12 @@ func ParseLodProposedActionTypeString(s string) (LodProposedActionType, bool) {
 // XMLString returns the XML string representation of MembershipType
 func (e MembershipType) XMLString() string {
 	switch e {
+	case MembershipType_MEMBERSHIP_TYPE_NATIONALMEMBER:
+		return "NATIONALMEMBER"
+	case MembershipType_MEMBERSHIP_TYPE_REGIONALMEMBER:
+		return "REGIONALMEMBER"
+	case MembershipType_MEMBERSHIP_TYPE_WORLDWIDEMEMBER:
+		return "WORLDWIDEMEMBER"
 	default:
 		return ""
 	}
@@ -1087,6 +12691,12 @@ func (e MembershipType) XMLString() string {
 func ParseMembershipTypeString(s string) (MembershipType, bool) {
 	s = strings.ToUpper(s)
 	switch s {
+	case "NATIONALMEMBER":
+		return MembershipType_MEMBERSHIP_TYPE_NATIONALMEMBER, true
+	case "REGIONALMEMBER":
+		return MembershipType_MEMBERSHIP_TYPE_REGIONALMEMBER, true
+	case "WORLDWIDEMEMBER":
+		return MembershipType_MEMBERSHIP_TYPE_WORLDWIDEMEMBER, true
 	default:
 		return MembershipType(0), false
 	}
@@ -1095,6 +12705,18 @@ func ParseMembershipTypeString(s string) (MembershipType, bool) {
 // XMLString returns the XML string representation of MessageActionType
 func (e MessageActionType) XMLString() string {
 	switch e {
+	case MessageActionType_MESSAGE_ACTION_TYPE_BACKCATALOGDELIVERY:
+		return "BACKCATALOGDELIVERY"
+	case MessageActionType_MESSAGE_ACTION_TYPE_HIGHPRIORITYDELIVERY:
+		return "HIGHPRIORITYDELIVERY"
+	case MessageActionType_MESSAGE_ACTION_TYPE_NEWRELEASEDELIVERY:
+		return "NEWRELEASEDELIVERY"
+	case MessageActionType_MESSAGE_ACTION_TYPE_REDELIVERY:
+		return "REDELIVERY"
+	case MessageActionType_MESSAGE_ACTION_TYPE_TAKEDOWN:
+		return "TAKEDOWN"
+	case MessageActionType_MESSAGE_ACTION_TYPE_USERDEFINED:
+		return "USERDEFINED"
 	default:
 		return ""
 	}
@@ -1104,6 +12726,18 @@ func (e MessageActionType) XMLString() string {
 func ParseMessageActionTypeString(s string) (MessageActionType, bool) {
 	s = strings.ToUpper(s)
 	switch s {
+	case "BACKCATALOGDELIVERY":
+		return MessageActionType_MESSAGE_ACTION_TYPE_BACKCATALOGDELIVERY, true
+	case "HIGHPRIORITYDELIVERY":
+		return MessageActionType_MESSAGE_ACTION_TYPE_HIGHPRIORITYDELIVERY, true
+	case "NEWRELEASEDELIVERY":
+		return MessageActionType_MESSAGE_ACTION_TYPE_NEWRELEASEDELIVERY, true
+	case "REDELIVERY":
+		return MessageActionType_MESSAGE_ACTION_TYPE_REDELIVERY, true
+	case "TAKEDOWN":
+		return MessageActionType_MESSAGE_ACTION_TYPE_TAKEDOWN, true
+	case "USERDEFINED":
+		return MessageActionType_MESSAGE_ACTION_TYPE_USERDEFINED, true
 	default:
 		return MessageActionType(0), false
 	}
@@ -1112,6 +12746,12 @@ func ParseMessageActionTypeString(s string) (MessageActionType, bool) {
 // XMLString returns the XML string representation of MessageContentRevenueType
 func (e MessageContentRevenueType) XMLString() string {
 	switch e {
+	case MessageContentRevenueType_MESSAGE_CONTENT_REVENUE_TYPE_NONTRANSACTIONALREVENUE:
+		return "NONTRANSACTIONALREVENUE"
+	case MessageContentRevenueType_MESSAGE_CONTENT_REVENUE_TYPE_TRANSACTIONALREVENUE:
+		return "TRANSACTIONALREVENUE"
+	case MessageContentRevenueType_MESSAGE_CONTENT_REVENUE_TYPE_USERDEFINED:
+		return "USERDEFINED"
 	default:
 		return ""
 	}
@@ -1121,6 +12761,12 @@ func (e MessageContentRevenueType) XMLString() string {
 func ParseMessageContentRevenueTypeString(s string) (MessageContentRevenueType, bool) {
 	s = strings.ToUpper(s)
 	switch s {
+	case "NONTRANSACTIONALREVENUE":
+		return MessageContentRevenueType_MESSAGE_CONTENT_REVENUE_TYPE_NONTRANSACTIONALREVENUE, true
+	case "TRANSACTIONALREVENUE":
+		return MessageContentRevenueType_MESSAGE_CONTENT_REVENUE_TYPE_TRANSACTIONALREVENUE, true
+	case "USERDEFINED":
+		return MessageContentRevenueType_MESSAGE_CONTENT_REVENUE_TYPE_USERDEFINED, true
 	default:
 		return MessageContentRevenueType(0), false
 	}
@@ -1129,6 +12775,18 @@ func ParseMessageContentRevenueTypeString(s string) (MessageContentRevenueType,
 // XMLString returns the XML string representation of MessageContextType
 func (e MessageContextType) XMLString() string {
 	switch e {
+	case MessageContextType_MESSAGE_CONTEXT_TYPE_MUSICALWORKCLAIMREQUESTMESSAGEINIDENTIFICATIONCYCLE:
+		return "MUSICALWORKCLAIMREQUESTMESSAGEINIDENTIFICATIONCYCLE"
+	case MessageContextType_MESSAGE_CONTEXT_TYPE_MUSICALWORKCLAIMNOTIFICATIONMESSAGEINIDENTIFICATIONCYCLE:
+		return "MUSICALWORKCLAIMNOTIFICATIONMESSAGEINIDENTIFICATIONCYCLE"
+	case MessageContextType_MESSAGE_CONTEXT_TYPE_MUSICALWORKCLAIMREQUESTMESSAGEINCONFIRMATIONCYCLE:
+		return "MUSICALWORKCLAIMREQUESTMESSAGEINCONFIRMATIONCYCLE"
+	case MessageContextType_MESSAGE_CONTEXT_TYPE_MUSICALWORKCLAIMNOTIFICATIONMESSAGEINCONFIRMATIONCYCLE:
+		return "MUSICALWORKCLAIMNOTIFICATIONMESSAGEINCONFIRMATIONCYCLE"
+	case MessageContextType_MESSAGE_CONTEXT_TYPE_MUSICALWORKCLAIMNOTIFICATIONMESSAGEINLOCCYCLEASLODMESSAGE:
+		return "MUSICALWORKCLAIMNOTIFICATIONMESSAGEINLOCCYCLEASLODMESSAGE"
+	case MessageContextType_MESSAGE_CONTEXT_TYPE_MUSICALWORKCLAIMNOTIFICATIONMESSAGEINLOCCYCLEASLODCONFIRMATION:
+		return "MUSICALWORKCLAIMNOTIFICATIONMESSAGEINLOCCYCLEASLODCONFIRMATION"
 	default:
 		return ""
 	}
@@ -1138,6 +12796,18 @@ func (e MessageContextType) XMLString() string {
 func ParseMessageContextTypeString(s string) (MessageContextType, bool) {
 	s = strings.ToUpper(s)
 	switch s {
+	case "MUSICALWORKCLAIMREQUESTMESSAGEINIDENTIFICATIONCYCLE":
+		return MessageContextType_MESSAGE_CONTEXT_TYPE_MUSICALWORKCLAIMREQUESTMESSAGEINIDENTIFICATIONCYCLE, true
+	case "MUSICALWORKCLAIMNOTIFICATIONMESSAGEINIDENTIFICATIONCYCLE":
+		return MessageContextType_MESSAGE_CONTEXT_TYPE_MUSICALWORKCLAIMNOTIFICATIONMESSAGEINIDENTIFICATIONCYCLE, true
+	case "MUSICALWORKCLAIMREQUESTMESSAGEINCONFIRMATIONCYCLE":
+		return MessageContextType_MESSAGE_CONTEXT_TYPE_MUSICALWORKCLAIMREQUESTMESSAGEINCONFIRMATIONCYCLE, true
+	case "MUSICALWORKCLAIMNOTIFICATIONMESSAGEINCONFIRMATIONCYCLE":
+		return MessageContextType_MESSAGE_CONTEXT_TYPE_MUSICALWORKCLAIMNOTIFICATIONMESSAGEINCONFIRMATIONCYCLE, true
+	case "MUSICALWORKCLAIMNOTIFICATIONMESSAGEINLOCCYCLEASLODMESSAGE":
+		return MessageContextType_MESSAGE_CONTEXT_TYPE_MUSICALWORKCLAIMNOTIFICATIONMESSAGEINLOCCYCLEASLODMESSAGE, true
+	case "MUSICALWORKCLAIMNOTIFICATIONMESSAGEINLOCCYCLEASLODCONFIRMATION":
+		return MessageContextType_MESSAGE_CONTEXT_TYPE_MUSICALWORKCLAIMNOTIFICATIONMESSAGEINLOCCYCLEASLODCONFIRMATION, true
 	default:
 		return MessageContextType(0), false
 	}
@@ -1146,6 +12816,10 @@ func ParseMessageContextTypeString(s string) (MessageContextType, bool) {
 // XMLString returns the XML string representation of MessageControlType
 func (e MessageControlType) XMLString() string {
 	switch e {
+	case MessageControlType_MESSAGE_CONTROL_TYPE_LIVEMESSAGE:
+		return "LIVEMESSAGE"
+	case MessageControlType_MESSAGE_CONTROL_TYPE_TESTMESSAGE:
+		return "TESTMESSAGE"
 	default:
 		return ""
 	}
@@ -1155,6 +12829,10 @@ func (e MessageControlType) XMLString() string {
 func ParseMessageControlTypeString(s string) (MessageControlType, bool) {
 	s = strings.ToUpper(s)
 	switch s {
+	case "LIVEMESSAGE":
+		return MessageControlType_MESSAGE_CONTROL_TYPE_LIVEMESSAGE, true
+	case "TESTMESSAGE":
+		return MessageControlType_MESSAGE_CONTROL_TYPE_TESTMESSAGE, true
 	default:
 		return MessageControlType(0), false
 	}
@@ -1163,6 +12841,14 @@ func ParseMessageControlTypeString(s string) (MessageControlType, bool) {
 // XMLString returns the XML string representation of MidiType
 func (e MidiType) XMLString() string {
 	switch e {
+	case MidiType_MIDI_TYPE_MONOPHONICMIDI:
+		return "MONOPHONICMIDI"
+	case MidiType_MIDI_TYPE_POLYPHONICMIDI:
+		return "POLYPHONICMIDI"
+	case MidiType_MIDI_TYPE_UNKNOWN:
+		return "UNKNOWN"
+	case MidiType_MIDI_TYPE_USERDEFINED:
+		return "USERDEFINED"
 	default:
 		return ""
 	}
@@ -1172,6 +12858,14 @@ func (e MidiType) XMLString() string {
 func ParseMidiTypeString(s string) (MidiType, bool) {
 	s = strings.ToUpper(s)
 	switch s {
+	case "MONOPHONICMIDI":
+		return MidiType_MIDI_TYPE_MONOPHONICMIDI, true
+	case "POLYPHONICMIDI":
+		return MidiType_MIDI_TYPE_POLYPHONICMIDI, true
+	case "UNKNOWN":
+		return MidiType_MIDI_TYPE_UNKNOWN, true
+	case "USERDEFINED":
+		return MidiType_MIDI_TYPE_USERDEFINED, true
 	default:
 		return MidiType(0), false
 	}
@@ -1180,6 +12874,16 @@ func ParseMidiTypeString(s string) (MidiType, bool) {
 // XMLString returns the XML string representation of MlcMessageType
 func (e MlcMessageType) XMLString() string {
 	switch e {
+	case MlcMessageType_MLC_MESSAGE_TYPE_DECLARATIONOFSOUNDRECORDINGRIGHTSCLAIMMESSAGE:
+		return "DECLARATIONOFSOUNDRECORDINGRIGHTSCLAIMMESSAGE"
+	case MlcMessageType_MLC_MESSAGE_TYPE_REQUESTSOUNDRECORDINGINFORMATIONMESSAGE:
+		return "REQUESTSOUNDRECORDINGINFORMATIONMESSAGE"
+	case MlcMessageType_MLC_MESSAGE_TYPE_REVOKESOUNDRECORDINGRIGHTSCLAIMMESSAGE:
+		return "REVOKESOUNDRECORDINGRIGHTSCLAIMMESSAGE"
+	case MlcMessageType_MLC_MESSAGE_TYPE_SALESREPORTMESSAGE:
+		return "SALESREPORTMESSAGE"
+	case MlcMessageType_MLC_MESSAGE_TYPE_DECLARATIONOFREVENUEMESSAGE:
+		return "DECLARATIONOFREVENUEMESSAGE"
 	default:
 		return ""
 	}
@@ -1189,6 +12893,16 @@ func (e MlcMessageType) XMLString() string {
 func ParseMlcMessageTypeString(s string) (MlcMessageType, bool) {
 	s = strings.ToUpper(s)
 	switch s {
+	case "DECLARATIONOFSOUNDRECORDINGRIGHTSCLAIMMESSAGE":
+		return MlcMessageType_MLC_MESSAGE_TYPE_DECLARATIONOFSOUNDRECORDINGRIGHTSCLAIMMESSAGE, true
+	case "REQUESTSOUNDRECORDINGINFORMATIONMESSAGE":
+		return MlcMessageType_MLC_MESSAGE_TYPE_REQUESTSOUNDRECORDINGINFORMATIONMESSAGE, true
+	case "REVOKESOUNDRECORDINGRIGHTSCLAIMMESSAGE":
+		return MlcMessageType_MLC_MESSAGE_TYPE_REVOKESOUNDRECORDINGRIGHTSCLAIMMESSAGE, true
+	case "SALESREPORTMESSAGE":
+		return MlcMessageType_MLC_MESSAGE_TYPE_SALESREPORTMESSAGE, true
+	case "DECLARATIONOFREVENUEMESSAGE":
+		return MlcMessageType_MLC_MESSAGE_TYPE_DECLARATIONOFREVENUEMESSAGE, true
 	default:
 		return MlcMessageType(0), false
 	}
@@ -1197,6 +12911,102 @@ func ParseMlcMessageTypeString(s string) (MlcMessageType, bool) {
 // XMLString returns the XML string representation of MusicalWorkContributorRole
 func (e MusicalWorkContributorRole) XMLString() string {
 	switch e {
+	case MusicalWorkContributorRole_MUSICAL_WORK_CONTRIBUTOR_ROLE_ADAPTER:
+		return "ADAPTER"
+	case MusicalWorkContributorRole_MUSICAL_WORK_CONTRIBUTOR_ROLE_ARCHITECT:
+		return "ARCHITECT"
+	case MusicalWorkContributorRole_MUSICAL_WORK_CONTRIBUTOR_ROLE_ARRANGER:
+		return "ARRANGER"
+	case MusicalWorkContributorRole_MUSICAL_WORK_CONTRIBUTOR_ROLE_AUTHOR:
+		return "AUTHOR"
+	case MusicalWorkContributorRole_MUSICAL_WORK_CONTRIBUTOR_ROLE_AUTHORINQUOTATIONS:
+		return "AUTHORINQUOTATIONS"
+	case MusicalWorkContributorRole_MUSICAL_WORK_CONTRIBUTOR_ROLE_AUTHOROFAFTERWORD:
+		return "AUTHOROFAFTERWORD"
+	case MusicalWorkContributorRole_MUSICAL_WORK_CONTRIBUTOR_ROLE_COMPILER:
+		return "COMPILER"
+	case MusicalWorkContributorRole_MUSICAL_WORK_CONTRIBUTOR_ROLE_COMPOSER:
+		return "COMPOSER"
+	case MusicalWorkContributorRole_MUSICAL_WORK_CONTRIBUTOR_ROLE_COMPOSERLYRICIST:
+		return "COMPOSERLYRICIST"
+	case MusicalWorkContributorRole_MUSICAL_WORK_CONTRIBUTOR_ROLE_CONCEPTOR:
+		return "CONCEPTOR"
+	case MusicalWorkContributorRole_MUSICAL_WORK_CONTRIBUTOR_ROLE_CREATOR:
+		return "CREATOR"
+	case MusicalWorkContributorRole_MUSICAL_WORK_CONTRIBUTOR_ROLE_DIALOGUEAUTHOR:
+		return "DIALOGUEAUTHOR"
+	case MusicalWorkContributorRole_MUSICAL_WORK_CONTRIBUTOR_ROLE_DISSERTANT:
+		return "DISSERTANT"
+	case MusicalWorkContributorRole_MUSICAL_WORK_CONTRIBUTOR_ROLE_ENGRAVER:
+		return "ENGRAVER"
+	case MusicalWorkContributorRole_MUSICAL_WORK_CONTRIBUTOR_ROLE_ETCHER:
+		return "ETCHER"
+	case MusicalWorkContributorRole_MUSICAL_WORK_CONTRIBUTOR_ROLE_JOURNALIST:
+		return "JOURNALIST"
+	case MusicalWorkContributorRole_MUSICAL_WORK_CONTRIBUTOR_ROLE_LANDSCAPEARCHITECT:
+		return "LANDSCAPEARCHITECT"
+	case MusicalWorkContributorRole_MUSICAL_WORK_CONTRIBUTOR_ROLE_LIBRETTIST:
+		return "LIBRETTIST"
+	case MusicalWorkContributorRole_MUSICAL_WORK_CONTRIBUTOR_ROLE_LITHOGRAPHER:
+		return "LITHOGRAPHER"
+	case MusicalWorkContributorRole_MUSICAL_WORK_CONTRIBUTOR_ROLE_LYRICIST:
+		return "LYRICIST"
+	case MusicalWorkContributorRole_MUSICAL_WORK_CONTRIBUTOR_ROLE_METALENGRAVER:
+		return "METALENGRAVER"
+	case MusicalWorkContributorRole_MUSICAL_WORK_CONTRIBUTOR_ROLE_NONLYRICAUTHOR:
+		return "NONLYRICAUTHOR"
+	case MusicalWorkContributorRole_MUSICAL_WORK_CONTRIBUTOR_ROLE_PLATEMAKER:
+		return "PLATEMAKER"
+	case MusicalWorkContributorRole_MUSICAL_WORK_CONTRIBUTOR_ROLE_PLAYWRIGHT:
+		return "PLAYWRIGHT"
+	case MusicalWorkContributorRole_MUSICAL_WORK_CONTRIBUTOR_ROLE_REPORTER:
+		return "REPORTER"
+	case MusicalWorkContributorRole_MUSICAL_WORK_CONTRIBUTOR_ROLE_REVIEWER:
+		return "REVIEWER"
+	case MusicalWorkContributorRole_MUSICAL_WORK_CONTRIBUTOR_ROLE_RUBRICATOR:
+		return "RUBRICATOR"
+	case MusicalWorkContributorRole_MUSICAL_WORK_CONTRIBUTOR_ROLE_SCREENPLAYAUTHOR:
+		return "SCREENPLAYAUTHOR"
+	case MusicalWorkContributorRole_MUSICAL_WORK_CONTRIBUTOR_ROLE_SCULPTOR:
+		return "SCULPTOR"
+	case MusicalWorkContributorRole_MUSICAL_WORK_CONTRIBUTOR_ROLE_SUBARRANGER:
+		return "SUBARRANGER"
+	case MusicalWorkContributorRole_MUSICAL_WORK_CONTRIBUTOR_ROLE_SUBLYRICIST:
+		return "SUBLYRICIST"
+	case MusicalWorkContributorRole_MUSICAL_WORK_CONTRIBUTOR_ROLE_TRANSLATOR:
+		return "TRANSLATOR"
+	case MusicalWorkContributorRole_MUSICAL_WORK_CONTRIBUTOR_ROLE_WOODCUTTER:
+		return "WOODCUTTER"
+	case MusicalWorkContributorRole_MUSICAL_WORK_CONTRIBUTOR_ROLE_WOODENGRAVER:
+		return "WOODENGRAVER"
+	case MusicalWorkContributorRole_MUSICAL_WORK_CONTRIBUTOR_ROLE_WRITEROFACCOMPANYINGMATERIAL:
+		return "WRITEROFACCOMPANYINGMATERIAL"
+	case MusicalWorkContributorRole_MUSICAL_WORK_CONTRIBUTOR_ROLE_BOOKPUBLISHER:
+		return "BOOKPUBLISHER"
+	case MusicalWorkContributorRole_MUSICAL_WORK_CONTRIBUTOR_ROLE_COPYRIGHTCLAIMANT:
+		return "COPYRIGHTCLAIMANT"
+	case MusicalWorkContributorRole_MUSICAL_WORK_CONTRIBUTOR_ROLE_COPYRIGHTHOLDER:
+		return "COPYRIGHTHOLDER"
+	case MusicalWorkContributorRole_MUSICAL_WORK_CONTRIBUTOR_ROLE_MUSICPUBLISHER:
+		return "MUSICPUBLISHER"
+	case MusicalWorkContributorRole_MUSICAL_WORK_CONTRIBUTOR_ROLE_NEWSPAPERPUBLISHER:
+		return "NEWSPAPERPUBLISHER"
+	case MusicalWorkContributorRole_MUSICAL_WORK_CONTRIBUTOR_ROLE_ORIGINALPUBLISHER:
+		return "ORIGINALPUBLISHER"
+	case MusicalWorkContributorRole_MUSICAL_WORK_CONTRIBUTOR_ROLE_PERIODICALPUBLISHER:
+		return "PERIODICALPUBLISHER"
+	case MusicalWorkContributorRole_MUSICAL_WORK_CONTRIBUTOR_ROLE_SUBPUBLISHER:
+		return "SUBPUBLISHER"
+	case MusicalWorkContributorRole_MUSICAL_WORK_CONTRIBUTOR_ROLE_SUBSTITUTEDPUBLISHER:
+		return "SUBSTITUTEDPUBLISHER"
+	case MusicalWorkContributorRole_MUSICAL_WORK_CONTRIBUTOR_ROLE_UNKNOWN:
+		return "UNKNOWN"
+	case MusicalWorkContributorRole_MUSICAL_WORK_CONTRIBUTOR_ROLE_USERDEFINED:
+		return "USERDEFINED"
+	case MusicalWorkContributorRole_MUSICAL_WORK_CONTRIBUTOR_ROLE_ASSOCIATEDPERFORMER:
+		return "ASSOCIATEDPERFORMER"
+	case MusicalWorkContributorRole_MUSICAL_WORK_CONTRIBUTOR_ROLE_CONTRIBUTOR:
+		return "CONTRIBUTOR"
 	default:
 		return ""
 	}
@@ -1206,6 +13016,102 @@ func (e MusicalWorkContributorRole) XMLString() string {
 func ParseMusicalWorkContributorRoleString(s string) (MusicalWorkContributorRole, bool) {
 	s = strings.ToUpper(s)
 	switch s {
+	case "ADAPTER":
+		return MusicalWorkContributorRole_MUSICAL_WORK_CONTRIBUTOR_ROLE_ADAPTER, true
+	case "ARCHITECT":
+		return MusicalWorkContributorRole_MUSICAL_WORK_CONTRIBUTOR_ROLE_ARCHITECT, true
+	case "ARRANGER":
+		return MusicalWorkContributorRole_MUSICAL_WORK_CONTRIBUTOR_ROLE_ARRANGER, true
+	case "AUTHOR":
+		return MusicalWorkContributorRole_MUSICAL_WORK_CONTRIBUTOR_ROLE_AUTHOR, true
+	case "AUTHORINQUOTATIONS":
+		return MusicalWorkContributorRole_MUSICAL_WORK_CONTRIBUTOR_ROLE_AUTHORINQUOTATIONS, true
+	case "AUTHOROFAFTERWORD":
+		return MusicalWorkContributorRole_MUSICAL_WORK_CONTRIBUTOR_ROLE_AUTHOROFAFTERWORD, true
+	case "COMPILER":
+		return MusicalWorkContributorRole_MUSICAL_WORK_CONTRIBUTOR_ROLE_COMPILER, true
+	case "COMPOSER":
+		return MusicalWorkContributorRole_MUSICAL_WORK_CONTRIBUTOR_ROLE_COMPOSER, true
+	case "COMPOSERLYRICIST":
+		return MusicalWorkContributorRole_MUSICAL_WORK_CONTRIBUTOR_ROLE_COMPOSERLYRICIST, true
+	case "CONCEPTOR":
+		return MusicalWorkContributorRole_MUSICAL_WORK_CONTRIBUTOR_ROLE_CONCEPTOR, true
+	case "CREATOR":
+		return MusicalWorkContributorRole_MUSICAL_WORK_CONTRIBUTOR_ROLE_CREATOR, true
+	case "DIALOGUEAUTHOR":
+		return MusicalWorkContributorRole_MUSICAL_WORK_CONTRIBUTOR_ROLE_DIALOGUEAUTHOR, true
+	case "DISSERTANT":
+		return MusicalWorkContributorRole_MUSICAL_WORK_CONTRIBUTOR_ROLE_DISSERTANT, true
+	case "ENGRAVER":
+		return MusicalWorkContributorRole_MUSICAL_WORK_CONTRIBUTOR_ROLE_ENGRAVER, true
+	case "ETCHER":
+		return MusicalWorkContributorRole_MUSICAL_WORK_CONTRIBUTOR_ROLE_ETCHER, true
+	case "JOURNALIST":
+		return MusicalWorkContributorRole_MUSICAL_WORK_CONTRIBUTOR_ROLE_JOURNALIST, true
+	case "LANDSCAPEARCHITECT":
+		return MusicalWorkContributorRole_MUSICAL_WORK_CONTRIBUTOR_ROLE_LANDSCAPEARCHITECT, true
+	case "LIBRETTIST":
+		return MusicalWorkContributorRole_MUSICAL_WORK_CONTRIBUTOR_ROLE_LIBRETTIST, true
+	case "LITHOGRAPHER":
+		return MusicalWorkContributorRole_MUSICAL_WORK_CONTRIBUTOR_ROLE_LITHOGRAPHER, true
+	case "LYRICIST":
+		return MusicalWorkContributorRole_MUSICAL_WORK_CONTRIBUTOR_ROLE_LYRICIST, true
+	case "METALENGRAVER":
+		return MusicalWorkContributorRole_MUSICAL_WORK_CONTRIBUTOR_ROLE_METALENGRAVER, true
+	case "NONLYRICAUTHOR":
+		return MusicalWorkContributorRole_MUSICAL_WORK_CONTRIBUTOR_ROLE_NONLYRICAUTHOR, true
+	case "PLATEMAKER":
+		return MusicalWorkContributorRole_MUSICAL_WORK_CONTRIBUTOR_ROLE_PLATEMAKER, true
+	case "PLAYWRIGHT":
+		return MusicalWorkContributorRole_MUSICAL_WORK_CONTRIBUTOR_ROLE_PLAYWRIGHT, true
+	case "REPORTER":
+		return MusicalWorkContributorRole_MUSICAL_WORK_CONTRIBUTOR_ROLE_REPORTER, true
+	case "REVIEWER":
+		return MusicalWorkContributorRole_MUSICAL_WORK_CONTRIBUTOR_ROLE_REVIEWER, true
+	case "RUBRICATOR":
+		return MusicalWorkContributorRole_MUSICAL_WORK_CONTRIBUTOR_ROLE_RUBRICATOR, true
+	case "SCREENPLAYAUTHOR":
+		return MusicalWorkContributorRole_MUSICAL_WORK_CONTRIBUTOR_ROLE_SCREENPLAYAUTHOR, true
+	case "SCULPTOR":
+		return MusicalWorkContributorRole_MUSICAL_WORK_CONTRIBUTOR_ROLE_SCULPTOR, true
+	case "SUBARRANGER":
+		return MusicalWorkContributorRole_MUSICAL_WORK_CONTRIBUTOR_ROLE_SUBARRANGER, true
+	case "SUBLYRICIST":
+		return MusicalWorkContributorRole_MUSICAL_WORK_CONTRIBUTOR_ROLE_SUBLYRICIST, true
+	case "TRANSLATOR":
+		return MusicalWorkContributorRole_MUSICAL_WORK_CONTRIBUTOR_ROLE_TRANSLATOR, true
+	case "WOODCUTTER":
+		return MusicalWorkContributorRole_MUSICAL_WORK_CONTRIBUTOR_ROLE_WOODCUTTER, true
+	case "WOODENGRAVER":
+		return MusicalWorkContributorRole_MUSICAL_WORK_CONTRIBUTOR_ROLE_WOODENGRAVER, true
+	case "WRITEROFACCOMPANYINGMATERIAL":
+		return MusicalWorkContributorRole_MUSICAL_WORK_CONTRIBUTOR_ROLE_WRITEROFACCOMPANYINGMATERIAL, true
+	case "BOOKPUBLISHER":
+		return MusicalWorkContributorRole_MUSICAL_WORK_CONTRIBUTOR_ROLE_BOOKPUBLISHER, true
+	case "COPYRIGHTCLAIMANT":
+		return MusicalWorkContributorRole_MUSICAL_WORK_CONTRIBUTOR_ROLE_COPYRIGHTCLAIMANT, true
+	case "COPYRIGHTHOLDER":
+		return MusicalWorkContributorRole_MUSICAL_WORK_CONTRIBUTOR_ROLE_COPYRIGHTHOLDER, true
+	case "MUSICPUBLISHER":
+		return MusicalWorkContributorRole_MUSICAL_WORK_CONTRIBUTOR_ROLE_MUSICPUBLISHER, true
+	case "NEWSPAPERPUBLISHER":
+		return MusicalWorkContributorRole_MUSICAL_WORK_CONTRIBUTOR_ROLE_NEWSPAPERPUBLISHER, true
+	case "ORIGINALPUBLISHER":
+		return MusicalWorkContributorRole_MUSICAL_WORK_CONTRIBUTOR_ROLE_ORIGINALPUBLISHER, true
+	case "PERIODICALPUBLISHER":
+		return MusicalWorkContributorRole_MUSICAL_WORK_CONTRIBUTOR_ROLE_PERIODICALPUBLISHER, true
+	case "SUBPUBLISHER":
+		return MusicalWorkContributorRole_MUSICAL_WORK_CONTRIBUTOR_ROLE_SUBPUBLISHER, true
+	case "SUBSTITUTEDPUBLISHER":
+		return MusicalWorkContributorRole_MUSICAL_WORK_CONTRIBUTOR_ROLE_SUBSTITUTEDPUBLISHER, true
+	case "UNKNOWN":
+		return MusicalWorkContributorRole_MUSICAL_WORK_CONTRIBUTOR_ROLE_UNKNOWN, true
+	case "USERDEFINED":
+		return MusicalWorkContributorRole_MUSICAL_WORK_CONTRIBUTOR_ROLE_USERDEFINED, true
+	case "ASSOCIATEDPERFORMER":
+		return MusicalWorkContributorRole_MUSICAL_WORK_CONTRIBUTOR_ROLE_ASSOCIATEDPERFORMER, true
+	case "CONTRIBUTOR":
+		return MusicalWorkContributorRole_MUSICAL_WORK_CONTRIBUTOR_ROLE_CONTRIBUTOR, true
 	default:
 		return MusicalWorkContributorRole(0), false
 	}
@@ -1214,6 +13120,16 @@ func ParseMusicalWorkContributorRoleString(s string) (MusicalWorkContributorRole
 // XMLString returns the XML string representation of MusicalWorkRightsClaimType
 func (e MusicalWorkRightsClaimType) XMLString() string {
 	switch e {
+	case MusicalWorkRightsClaimType_MUSICAL_WORK_RIGHTS_CLAIM_TYPE_COPYRIGHTCONTROL:
+		return "COPYRIGHTCONTROL"
+	case MusicalWorkRightsClaimType_MUSICAL_WORK_RIGHTS_CLAIM_TYPE_NONMEMBERCLAIM:
+		return "NONMEMBERCLAIM"
+	case MusicalWorkRightsClaimType_MUSICAL_WORK_RIGHTS_CLAIM_TYPE_PUBLICDOMAIN:
+		return "PUBLICDOMAIN"
+	case MusicalWorkRightsClaimType_MUSICAL_WORK_RIGHTS_CLAIM_TYPE_SOCIETYCLAIM:
+		return "SOCIETYCLAIM"
+	case MusicalWorkRightsClaimType_MUSICAL_WORK_RIGHTS_CLAIM_TYPE_UNKNOWN:
+		return "UNKNOWN"
 	default:
 		return ""
 	}
@@ -1223,6 +13139,16 @@ func (e MusicalWorkRightsClaimType) XMLString() string {
 func ParseMusicalWorkRightsClaimTypeString(s string) (MusicalWorkRightsClaimType, bool) {
 	s = strings.ToUpper(s)
 	switch s {
+	case "COPYRIGHTCONTROL":
+		return MusicalWorkRightsClaimType_MUSICAL_WORK_RIGHTS_CLAIM_TYPE_COPYRIGHTCONTROL, true
+	case "NONMEMBERCLAIM":
+		return MusicalWorkRightsClaimType_MUSICAL_WORK_RIGHTS_CLAIM_TYPE_NONMEMBERCLAIM, true
+	case "PUBLICDOMAIN":
+		return MusicalWorkRightsClaimType_MUSICAL_WORK_RIGHTS_CLAIM_TYPE_PUBLICDOMAIN, true
+	case "SOCIETYCLAIM":
+		return MusicalWorkRightsClaimType_MUSICAL_WORK_RIGHTS_CLAIM_TYPE_SOCIETYCLAIM, true
+	case "UNKNOWN":
+		return MusicalWorkRightsClaimType_MUSICAL_WORK_RIGHTS_CLAIM_TYPE_UNKNOWN, true
 	default:
 		return MusicalWorkRightsClaimType(0), false
 	}
@@ -1231,6 +13157,64 @@ func ParseMusicalWorkRightsClaimTypeString(s string) (MusicalWorkRightsClaimType
 // XMLString returns the XML string representation of MusicalWorkType
 func (e MusicalWorkType) XMLString() string {
 	switch e {
+	case MusicalWorkType_MUSICAL_WORK_TYPE_ADAPTEDINORIGINALLANGUAGE:
+		return "ADAPTEDINORIGINALLANGUAGE"
+	case MusicalWorkType_MUSICAL_WORK_TYPE_ADAPTEDINSTRUMENTALWORK:
+		return "ADAPTEDINSTRUMENTALWORK"
+	case MusicalWorkType_MUSICAL_WORK_TYPE_ADAPTEDWITHNEWLYRICS:
+		return "ADAPTEDWITHNEWLYRICS"
+	case MusicalWorkType_MUSICAL_WORK_TYPE_ARRANGEDWITHNEWMUSIC:
+		return "ARRANGEDWITHNEWMUSIC"
+	case MusicalWorkType_MUSICAL_WORK_TYPE_COMPOSITEMUSICALWORK:
+		return "COMPOSITEMUSICALWORK"
+	case MusicalWorkType_MUSICAL_WORK_TYPE_DRAMATICOMUSICALWORK:
+		return "DRAMATICOMUSICALWORK"
+	case MusicalWorkType_MUSICAL_WORK_TYPE_LYRICREMOVAL:
+		return "LYRICREMOVAL"
+	case MusicalWorkType_MUSICAL_WORK_TYPE_LYRICREPLACEMENT:
+		return "LYRICREPLACEMENT"
+	case MusicalWorkType_MUSICAL_WORK_TYPE_LYRICTRANSLATION:
+		return "LYRICTRANSLATION"
+	case MusicalWorkType_MUSICAL_WORK_TYPE_MASHUP:
+		return "MASHUP"
+	case MusicalWorkType_MUSICAL_WORK_TYPE_MEDLEY:
+		return "MEDLEY"
+	case MusicalWorkType_MUSICAL_WORK_TYPE_MULTIMEDIAPRODUCTIONWORK:
+		return "MULTIMEDIAPRODUCTIONWORK"
+	case MusicalWorkType_MUSICAL_WORK_TYPE_MUSICALWORKMOVEMENT:
+		return "MUSICALWORKMOVEMENT"
+	case MusicalWorkType_MUSICAL_WORK_TYPE_MUSICALWORKWITHSAMPLES:
+		return "MUSICALWORKWITHSAMPLES"
+	case MusicalWorkType_MUSICAL_WORK_TYPE_MUSICARRANGEMENT:
+		return "MUSICARRANGEMENT"
+	case MusicalWorkType_MUSICAL_WORK_TYPE_MUSICARRANGEMENTOFTEXT:
+		return "MUSICARRANGEMENTOFTEXT"
+	case MusicalWorkType_MUSICAL_WORK_TYPE_ORIGINALLYRICSARRANGEMENT:
+		return "ORIGINALLYRICSARRANGEMENT"
+	case MusicalWorkType_MUSICAL_WORK_TYPE_ORIGINALMUSICADAPTATION:
+		return "ORIGINALMUSICADAPTATION"
+	case MusicalWorkType_MUSICAL_WORK_TYPE_ORIGINALMUSICALWORK:
+		return "ORIGINALMUSICALWORK"
+	case MusicalWorkType_MUSICAL_WORK_TYPE_POTPOURRI:
+		return "POTPOURRI"
+	case MusicalWorkType_MUSICAL_WORK_TYPE_PRODUCTIONMUSICLIBRARYWORK:
+		return "PRODUCTIONMUSICLIBRARYWORK"
+	case MusicalWorkType_MUSICAL_WORK_TYPE_RADIOPRODUCTIONWORK:
+		return "RADIOPRODUCTIONWORK"
+	case MusicalWorkType_MUSICAL_WORK_TYPE_THEATERPRODUCTIONWORK:
+		return "THEATERPRODUCTIONWORK"
+	case MusicalWorkType_MUSICAL_WORK_TYPE_TVPRODUCTIONWORK:
+		return "TVPRODUCTIONWORK"
+	case MusicalWorkType_MUSICAL_WORK_TYPE_UNKNOWN:
+		return "UNKNOWN"
+	case MusicalWorkType_MUSICAL_WORK_TYPE_UNSPECIFIEDARRANGEMENT:
+		return "UNSPECIFIEDARRANGEMENT"
+	case MusicalWorkType_MUSICAL_WORK_TYPE_UNSPECIFIEDMUSICALWORKEXCERPT:
+		return "UNSPECIFIEDMUSICALWORKEXCERPT"
+	case MusicalWorkType_MUSICAL_WORK_TYPE_USERDEFINED:
+		return "USERDEFINED"
+	case MusicalWorkType_MUSICAL_WORK_TYPE_VIDEOPRODUCTIONWORK:
+		return "VIDEOPRODUCTIONWORK"
 	default:
 		return ""
 	}
@@ -1240,6 +13224,64 @@ func (e MusicalWorkType) XMLString() string {
 func ParseMusicalWorkTypeString(s string) (MusicalWorkType, bool) {
 	s = strings.ToUpper(s)
 	switch s {
+	case "ADAPTEDINORIGINALLANGUAGE":
+		return MusicalWorkType_MUSICAL_WORK_TYPE_ADAPTEDINORIGINALLANGUAGE, true
+	case "ADAPTEDINSTRUMENTALWORK":
+		return MusicalWorkType_MUSICAL_WORK_TYPE_ADAPTEDINSTRUMENTALWORK, true
+	case "ADAPTEDWITHNEWLYRICS":
+		return MusicalWorkType_MUSICAL_WORK_TYPE_ADAPTEDWITHNEWLYRICS, true
+	case "ARRANGEDWITHNEWMUSIC":
+		return MusicalWorkType_MUSICAL_WORK_TYPE_ARRANGEDWITHNEWMUSIC, true
+	case "COMPOSITEMUSICALWORK":
+		return MusicalWorkType_MUSICAL_WORK_TYPE_COMPOSITEMUSICALWORK, true
+	case "DRAMATICOMUSICALWORK":
+		return MusicalWorkType_MUSICAL_WORK_TYPE_DRAMATICOMUSICALWORK, true
+	case "LYRICREMOVAL":
+		return MusicalWorkType_MUSICAL_WORK_TYPE_LYRICREMOVAL, true
+	case "LYRICREPLACEMENT":
+		return MusicalWorkType_MUSICAL_WORK_TYPE_LYRICREPLACEMENT, true
+	case "LYRICTRANSLATION":
+		return MusicalWorkType_MUSICAL_WORK_TYPE_LYRICTRANSLATION, true
+	case "MASHUP":
+		return MusicalWorkType_MUSICAL_WORK_TYPE_MASHUP, true
+	case "MEDLEY":
+		return MusicalWorkType_MUSICAL_WORK_TYPE_MEDLEY, true
+	case "MULTIMEDIAPRODUCTIONWORK":
+		return MusicalWorkType_MUSICAL_WORK_TYPE_MULTIMEDIAPRODUCTIONWORK, true
+	case "MUSICALWORKMOVEMENT":
+		return MusicalWorkType_MUSICAL_WORK_TYPE_MUSICALWORKMOVEMENT, true
+	case "MUSICALWORKWITHSAMPLES":
+		return MusicalWorkType_MUSICAL_WORK_TYPE_MUSICALWORKWITHSAMPLES, true
+	case "MUSICARRANGEMENT":
+		return MusicalWorkType_MUSICAL_WORK_TYPE_MUSICARRANGEMENT, true
+	case "MUSICARRANGEMENTOFTEXT":
+		return MusicalWorkType_MUSICAL_WORK_TYPE_MUSICARRANGEMENTOFTEXT, true
+	case "ORIGINALLYRICSARRANGEMENT":
+		return MusicalWorkType_MUSICAL_WORK_TYPE_ORIGINALLYRICSARRANGEMENT, true
+	case "ORIGINALMUSICADAPTATION":
+		return MusicalWorkType_MUSICAL_WORK_TYPE_ORIGINALMUSICADAPTATION, true
+	case "ORIGINALMUSICALWORK":
+		return MusicalWorkType_MUSICAL_WORK_TYPE_ORIGINALMUSICALWORK, true
+	case "POTPOURRI":
+		return MusicalWorkType_MUSICAL_WORK_TYPE_POTPOURRI, true
+	case "PRODUCTIONMUSICLIBRARYWORK":
+		return MusicalWorkType_MUSICAL_WORK_TYPE_PRODUCTIONMUSICLIBRARYWORK, true
+	case "RADIOPRODUCTIONWORK":
+		return MusicalWorkType_MUSICAL_WORK_TYPE_RADIOPRODUCTIONWORK, true
+	case "THEATERPRODUCTIONWORK":
+		return MusicalWorkType_MUSICAL_WORK_TYPE_THEATERPRODUCTIONWORK, true
+	case "TVPRODUCTIONWORK":
+		return MusicalWorkType_MUSICAL_WORK_TYPE_TVPRODUCTIONWORK, true
+	case "UNKNOWN":
+		return MusicalWorkType_MUSICAL_WORK_TYPE_UNKNOWN, true
+	case "UNSPECIFIEDARRANGEMENT":
+		return MusicalWorkType_MUSICAL_WORK_TYPE_UNSPECIFIEDARRANGEMENT, true
+	case "UNSPECIFIEDMUSICALWORKEXCERPT":
+		return MusicalWorkType_MUSICAL_WORK_TYPE_UNSPECIFIEDMUSICALWORKEXCERPT, true
+	case "USERDEFINED":
+		return MusicalWorkType_MUSICAL_WORK_TYPE_USERDEFINED, true
+	case "VIDEOPRODUCTIONWORK":
+		return MusicalWorkType_MUSICAL_WORK_TYPE_VIDEOPRODUCTIONWORK, true
 	default:
 		return MusicalWorkType(0), false
 	}
@@ -1248,6 +13290,20 @@ func ParseMusicalWorkTypeString(s string) (MusicalWorkType, bool) {
 // XMLString returns the XML string representation of MwlCaCMessageInBatchType
 func (e MwlCaCMessageInBatchType) XMLString() string {
 	switch e {
+	case MwlCaCMessageInBatchType_MWL_CA_C_MESSAGE_IN_BATCH_TYPE_LICENSEORCLAIMREQUESTMESSAGE:
+		return "LICENSEORCLAIMREQUESTMESSAGE"
+	case MwlCaCMessageInBatchType_MWL_CA_C_MESSAGE_IN_BATCH_TYPE_LICENSEORCLAIMMESSAGE:
+		return "LICENSEORCLAIMMESSAGE"
+	case MwlCaCMessageInBatchType_MWL_CA_C_MESSAGE_IN_BATCH_TYPE_LICENSINGINFORMATIONREQUESTMESSAGE:
+		return "LICENSINGINFORMATIONREQUESTMESSAGE"
+	case MwlCaCMessageInBatchType_MWL_CA_C_MESSAGE_IN_BATCH_TYPE_LICENSEORCLAIMCONFIRMATIONMESSAGE:
+		return "LICENSEORCLAIMCONFIRMATIONMESSAGE"
+	case MwlCaCMessageInBatchType_MWL_CA_C_MESSAGE_IN_BATCH_TYPE_NEWRELEASEMESSAGE:
+		return "NEWRELEASEMESSAGE"
+	case MwlCaCMessageInBatchType_MWL_CA_C_MESSAGE_IN_BATCH_TYPE_CONTRACTDELIVERYMESSAGE:
+		return "CONTRACTDELIVERYMESSAGE"
+	case MwlCaCMessageInBatchType_MWL_CA_C_MESSAGE_IN_BATCH_TYPE_PRODUCTDELETIONMESSAGE:
+		return "PRODUCTDELETIONMESSAGE"
 	default:
 		return ""
 	}
@@ -1257,6 +13313,20 @@ func (e MwlCaCMessageInBatchType) XMLString() string {
 func ParseMwlCaCMessageInBatchTypeString(s string) (MwlCaCMessageInBatchType, bool) {
 	s = strings.ToUpper(s)
 	switch s {
+	case "LICENSEORCLAIMREQUESTMESSAGE":
+		return MwlCaCMessageInBatchType_MWL_CA_C_MESSAGE_IN_BATCH_TYPE_LICENSEORCLAIMREQUESTMESSAGE, true
+	case "LICENSEORCLAIMMESSAGE":
+		return MwlCaCMessageInBatchType_MWL_CA_C_MESSAGE_IN_BATCH_TYPE_LICENSEORCLAIMMESSAGE, true
+	case "LICENSINGINFORMATIONREQUESTMESSAGE":
+		return MwlCaCMessageInBatchType_MWL_CA_C_MESSAGE_IN_BATCH_TYPE_LICENSINGINFORMATIONREQUESTMESSAGE, true
+	case "LICENSEORCLAIMCONFIRMATIONMESSAGE":
+		return MwlCaCMessageInBatchType_MWL_CA_C_MESSAGE_IN_BATCH_TYPE_LICENSEORCLAIMCONFIRMATIONMESSAGE, true
+	case "NEWRELEASEMESSAGE":
+		return MwlCaCMessageInBatchType_MWL_CA_C_MESSAGE_IN_BATCH_TYPE_NEWRELEASEMESSAGE, true
+	case "CONTRACTDELIVERYMESSAGE":
+		return MwlCaCMessageInBatchType_MWL_CA_C_MESSAGE_IN_BATCH_TYPE_CONTRACTDELIVERYMESSAGE, true
+	case "PRODUCTDELETIONMESSAGE":
+		return MwlCaCMessageInBatchType_MWL_CA_C_MESSAGE_IN_BATCH_TYPE_PRODUCTDELETIONMESSAGE, true
 	default:
 		return MwlCaCMessageInBatchType(0), false
 	}
@@ -1265,6 +13335,16 @@ func ParseMwlCaCMessageInBatchTypeString(s string) (MwlCaCMessageInBatchType, bo
 // XMLString returns the XML string representation of MwnMessageType
 func (e MwnMessageType) XMLString() string {
 	switch e {
+	case MwnMessageType_MWN_MESSAGE_TYPE_MUSICALWORKCLAIMNOTIFICATIONMESSAGE:
+		return "MUSICALWORKCLAIMNOTIFICATIONMESSAGE"
+	case MwnMessageType_MWN_MESSAGE_TYPE_MUSICALWORKCLAIMCONFLICTNOTIFICATIONMESSAGE:
+		return "MUSICALWORKCLAIMCONFLICTNOTIFICATIONMESSAGE"
+	case MwnMessageType_MWN_MESSAGE_TYPE_MUSICALWORKCLAIMREQUESTMESSAGE:
+		return "MUSICALWORKCLAIMREQUESTMESSAGE"
+	case MwnMessageType_MWN_MESSAGE_TYPE_FTPACKNOWLEDGEMENTMESSAGE:
+		return "FTPACKNOWLEDGEMENTMESSAGE"
+	case MwnMessageType_MWN_MESSAGE_TYPE_MANIFESTMESSAGE:
+		return "MANIFESTMESSAGE"
 	default:
 		return ""
 	}
@@ -1274,6 +13354,16 @@ func (e MwnMessageType) XMLString() string {
 func ParseMwnMessageTypeString(s string) (MwnMessageType, bool) {
 	s = strings.ToUpper(s)
 	switch s {
+	case "MUSICALWORKCLAIMNOTIFICATIONMESSAGE":
+		return MwnMessageType_MWN_MESSAGE_TYPE_MUSICALWORKCLAIMNOTIFICATIONMESSAGE, true
+	case "MUSICALWORKCLAIMCONFLICTNOTIFICATIONMESSAGE":
+		return MwnMessageType_MWN_MESSAGE_TYPE_MUSICALWORKCLAIMCONFLICTNOTIFICATIONMESSAGE, true
+	case "MUSICALWORKCLAIMREQUESTMESSAGE":
+		return MwnMessageType_MWN_MESSAGE_TYPE_MUSICALWORKCLAIMREQUESTMESSAGE, true
+	case "FTPACKNOWLEDGEMENTMESSAGE":
+		return MwnMessageType_MWN_MESSAGE_TYPE_FTPACKNOWLEDGEMENTMESSAGE, true
+	case "MANIFESTMESSAGE":
+		return MwnMessageType_MWN_MESSAGE_TYPE_MANIFESTMESSAGE, true
 	default:
 		return MwnMessageType(0), false
 	}
@@ -1282,6 +13372,10 @@ func ParseMwnMessageTypeString(s string) (MwnMessageType, bool) {
 // XMLString returns the XML string representation of NewReleaseMessageStatus
 func (e NewReleaseMessageStatus) XMLString() string {
 	switch e {
+	case NewReleaseMessageStatus_NEW_RELEASE_MESSAGE_STATUS_NEWRELEASEMESSAGENOTPROVIDED:
+		return "NEWRELEASEMESSAGENOTPROVIDED"
+	case NewReleaseMessageStatus_NEW_RELEASE_MESSAGE_STATUS_NEWRELEASEMESSAGEPROVIDED:
+		return "NEWRELEASEMESSAGEPROVIDED"
 	default:
 		return ""
 	}
@@ -1291,6 +13385,10 @@ func (e NewReleaseMessageStatus) XMLString() string {
 func ParseNewReleaseMessageStatusString(s string) (NewReleaseMessageStatus, bool) {
 	s = strings.ToUpper(s)
 	switch s {
+	case "NEWRELEASEMESSAGENOTPROVIDED":
+		return NewReleaseMessageStatus_NEW_RELEASE_MESSAGE_STATUS_NEWRELEASEMESSAGENOTPROVIDED, true
+	case "NEWRELEASEMESSAGEPROVIDED":
+		return NewReleaseMessageStatus_NEW_RELEASE_MESSAGE_STATUS_NEWRELEASEMESSAGEPROVIDED, true
 	default:
 		return NewReleaseMessageStatus(0), false
 	}
@@ -1299,6 +13397,14 @@ func ParseNewReleaseMessageStatusString(s string) (NewReleaseMessageStatus, bool
 // XMLString returns the XML string representation of OperatingSystemType
 func (e OperatingSystemType) XMLString() string {
 	switch e {
+	case OperatingSystemType_OPERATING_SYSTEM_TYPE_MACOS:
+		return "MACOS"
+	case OperatingSystemType_OPERATING_SYSTEM_TYPE_MSWINDOWS:
+		return "MSWINDOWS"
+	case OperatingSystemType_OPERATING_SYSTEM_TYPE_SYMBIAN:
+		return "SYMBIAN"
+	case OperatingSystemType_OPERATING_SYSTEM_TYPE_UNKNOWN:
+		return "UNKNOWN"
 	default:
 		return ""
 	}
@@ -1308,6 +13414,14 @@ func (e OperatingSystemType) XMLString() string {
 func ParseOperatingSystemTypeString(s string) (OperatingSystemType, bool) {
 	s = strings.ToUpper(s)
 	switch s {
+	case "MACOS":
+		return OperatingSystemType_OPERATING_SYSTEM_TYPE_MACOS, true
+	case "MSWINDOWS":
+		return OperatingSystemType_OPERATING_SYSTEM_TYPE_MSWINDOWS, true
+	case "SYMBIAN":
+		return OperatingSystemType_OPERATING_SYSTEM_TYPE_SYMBIAN, true
+	case "UNKNOWN":
+		return OperatingSystemType_OPERATING_SYSTEM_TYPE_UNKNOWN, true
 	default:
 		return OperatingSystemType(0), false
 	}
@@ -1316,6 +13430,26 @@ func ParseOperatingSystemTypeString(s string) (OperatingSystemType, bool) {
 // XMLString returns the XML string representation of OrderType
 func (e OrderType) XMLString() string {
 	switch e {
+	case OrderType_ORDER_TYPE_BACKCATALOGORDER:
+		return "BACKCATALOGORDER"
+	case OrderType_ORDER_TYPE_EXPRESSORDER:
+		return "EXPRESSORDER"
+	case OrderType_ORDER_TYPE_HARDDISKORDER:
+		return "HARDDISKORDER"
+	case OrderType_ORDER_TYPE_METADATAONLYORDER:
+		return "METADATAONLYORDER"
+	case OrderType_ORDER_TYPE_NEWRELEASEORDER:
+		return "NEWRELEASEORDER"
+	case OrderType_ORDER_TYPE_OFFCYCLERUSHORDER:
+		return "OFFCYCLERUSHORDER"
+	case OrderType_ORDER_TYPE_PREORDER:
+		return "PREORDER"
+	case OrderType_ORDER_TYPE_REDELIVERYORDER:
+		return "REDELIVERYORDER"
+	case OrderType_ORDER_TYPE_TAKEDOWNORDER:
+		return "TAKEDOWNORDER"
+	case OrderType_ORDER_TYPE_USERDEFINED:
+		return "USERDEFINED"
 	default:
 		return ""
 	}
@@ -1325,6 +13459,26 @@ func (e OrderType) XMLString() string {
 func ParseOrderTypeString(s string) (OrderType, bool) {
 	s = strings.ToUpper(s)
 	switch s {
+	case "BACKCATALOGORDER":
+		return OrderType_ORDER_TYPE_BACKCATALOGORDER, true
+	case "EXPRESSORDER":
+		return OrderType_ORDER_TYPE_EXPRESSORDER, true
+	case "HARDDISKORDER":
+		return OrderType_ORDER_TYPE_HARDDISKORDER, true
+	case "METADATAONLYORDER":
+		return OrderType_ORDER_TYPE_METADATAONLYORDER, true
+	case "NEWRELEASEORDER":
+		return OrderType_ORDER_TYPE_NEWRELEASEORDER, true
+	case "OFFCYCLERUSHORDER":
+		return OrderType_ORDER_TYPE_OFFCYCLERUSHORDER, true
+	case "PREORDER":
+		return OrderType_ORDER_TYPE_PREORDER, true
+	case "REDELIVERYORDER":
+		return OrderType_ORDER_TYPE_REDELIVERYORDER, true
+	case "TAKEDOWNORDER":
+		return OrderType_ORDER_TYPE_TAKEDOWNORDER, true
+	case "USERDEFINED":
+		return OrderType_ORDER_TYPE_USERDEFINED, true
 	default:
 		return OrderType(0), false
 	}
@@ -1333,6 +13487,10 @@ func ParseOrderTypeString(s string) (OrderType, bool) {
 // XMLString returns the XML string representation of PLineType
 func (e PLineType) XMLString() string {
 	switch e {
+	case PLineType_P_LINE_TYPE_ORIGINALPLINE:
+		return "ORIGINALPLINE"
+	case PLineType_P_LINE_TYPE_REMASTERINGPLINE:
+		return "REMASTERINGPLINE"
 	default:
 		return ""
 	}
@@ -1342,6 +13500,10 @@ func (e PLineType) XMLString() string {
 func ParsePLineTypeString(s string) (PLineType, bool) {
 	s = strings.ToUpper(s)
 	switch s {
+	case "ORIGINALPLINE":
+		return PLineType_P_LINE_TYPE_ORIGINALPLINE, true
+	case "REMASTERINGPLINE":
+		return PLineType_P_LINE_TYPE_REMASTERINGPLINE, true
 	default:
 		return PLineType(0), false
 	}
@@ -1350,6 +13512,18 @@ func ParsePLineTypeString(s string) (PLineType, bool) {
 // XMLString returns the XML string representation of ParentalWarningType
 func (e ParentalWarningType) XMLString() string {
 	switch e {
+	case ParentalWarningType_PARENTAL_WARNING_TYPE_EXPLICIT:
+		return "EXPLICIT"
+	case ParentalWarningType_PARENTAL_WARNING_TYPE_EXPLICITCONTENTEDITED:
+		return "EXPLICITCONTENTEDITED"
+	case ParentalWarningType_PARENTAL_WARNING_TYPE_NOADVICEAVAILABLE:
+		return "NOADVICEAVAILABLE"
+	case ParentalWarningType_PARENTAL_WARNING_TYPE_NOTEXPLICIT:
+		return "NOTEXPLICIT"
+	case ParentalWarningType_PARENTAL_WARNING_TYPE_UNKNOWN:
+		return "UNKNOWN"
+	case ParentalWarningType_PARENTAL_WARNING_TYPE_USERDEFINED:
+		return "USERDEFINED"
 	default:
 		return ""
 	}
@@ -1359,6 +13533,18 @@ func (e ParentalWarningType) XMLString() string {
 func ParseParentalWarningTypeString(s string) (ParentalWarningType, bool) {
 	s = strings.ToUpper(s)
 	switch s {
+	case "EXPLICIT":
+		return ParentalWarningType_PARENTAL_WARNING_TYPE_EXPLICIT, true
+	case "EXPLICITCONTENTEDITED":
+		return ParentalWarningType_PARENTAL_WARNING_TYPE_EXPLICITCONTENTEDITED, true
+	case "NOADVICEAVAILABLE":
+		return ParentalWarningType_PARENTAL_WARNING_TYPE_NOADVICEAVAILABLE, true
+	case "NOTEXPLICIT":
+		return ParentalWarningType_PARENTAL_WARNING_TYPE_NOTEXPLICIT, true
+	case "UNKNOWN":
+		return ParentalWarningType_PARENTAL_WARNING_TYPE_UNKNOWN, true
+	case "USERDEFINED":
+		return ParentalWarningType_PARENTAL_WARNING_TYPE_USERDEFINED, true
 	default:
 		return ParentalWarningType(0), false
 	}
@@ -1367,6 +13553,18 @@ func ParseParentalWarningTypeString(s string) (ParentalWarningType, bool) {
 // XMLString returns the XML string representation of PercentageType
 func (e PercentageType) XMLString() string {
 	switch e {
+	case PercentageType_PERCENTAGE_TYPE_PERCENTAGEOFFREEGOODSPERMITTED:
+		return "PERCENTAGEOFFREEGOODSPERMITTED"
+	case PercentageType_PERCENTAGE_TYPE_PERCENTAGEOFGROSSREVENUE:
+		return "PERCENTAGEOFGROSSREVENUE"
+	case PercentageType_PERCENTAGE_TYPE_PERCENTAGEOFNETREVENUE:
+		return "PERCENTAGEOFNETREVENUE"
+	case PercentageType_PERCENTAGE_TYPE_PERCENTAGEOFNETSALES:
+		return "PERCENTAGEOFNETSALES"
+	case PercentageType_PERCENTAGE_TYPE_PERCENTAGEOFPRICECONSUMERPAID:
+		return "PERCENTAGEOFPRICECONSUMERPAID"
+	case PercentageType_PERCENTAGE_TYPE_PERCENTAGEOFSTATUTORYROYALTYRATE:
+		return "PERCENTAGEOFSTATUTORYROYALTYRATE"
 	default:
 		return ""
 	}
@@ -1376,6 +13574,18 @@ func (e PercentageType) XMLString() string {
 func ParsePercentageTypeString(s string) (PercentageType, bool) {
 	s = strings.ToUpper(s)
 	switch s {
+	case "PERCENTAGEOFFREEGOODSPERMITTED":
+		return PercentageType_PERCENTAGE_TYPE_PERCENTAGEOFFREEGOODSPERMITTED, true
+	case "PERCENTAGEOFGROSSREVENUE":
+		return PercentageType_PERCENTAGE_TYPE_PERCENTAGEOFGROSSREVENUE, true
+	case "PERCENTAGEOFNETREVENUE":
+		return PercentageType_PERCENTAGE_TYPE_PERCENTAGEOFNETREVENUE, true
+	case "PERCENTAGEOFNETSALES":
+		return PercentageType_PERCENTAGE_TYPE_PERCENTAGEOFNETSALES, true
+	case "PERCENTAGEOFPRICECONSUMERPAID":
+		return PercentageType_PERCENTAGE_TYPE_PERCENTAGEOFPRICECONSUMERPAID, true
+	case "PERCENTAGEOFSTATUTORYROYALTYRATE":
+		return PercentageType_PERCENTAGE_TYPE_PERCENTAGEOFSTATUTORYROYALTYRATE, true
 	default:
 		return PercentageType(0), false
 	}
@@ -1384,6 +13594,12 @@ func ParsePercentageTypeString(s string) (PercentageType, bool) {
 // XMLString returns the XML string representation of PriceInformationType
 func (e PriceInformationType) XMLString() string {
 	switch e {
+	case PriceInformationType_PRICE_INFORMATION_TYPE_STANDARDRETAILPRICE:
+		return "STANDARDRETAILPRICE"
+	case PriceInformationType_PRICE_INFORMATION_TYPE_PREORDERPRICE:
+		return "PREORDERPRICE"
+	case PriceInformationType_PRICE_INFORMATION_TYPE_USERDEFINED:
+		return "USERDEFINED"
 	default:
 		return ""
 	}
@@ -1393,6 +13609,12 @@ func (e PriceInformationType) XMLString() string {
 func ParsePriceInformationTypeString(s string) (PriceInformationType, bool) {
 	s = strings.ToUpper(s)
 	switch s {
+	case "STANDARDRETAILPRICE":
+		return PriceInformationType_PRICE_INFORMATION_TYPE_STANDARDRETAILPRICE, true
+	case "PREORDERPRICE":
+		return PriceInformationType_PRICE_INFORMATION_TYPE_PREORDERPRICE, true
+	case "USERDEFINED":
+		return PriceInformationType_PRICE_INFORMATION_TYPE_USERDEFINED, true
 	default:
 		return PriceInformationType(0), false
 	}
@@ -1430,6 +13652,18 @@ func ParsePriorityString(s string) (Priority, bool) {
 // XMLString returns the XML string representation of ProductType
 func (e ProductType) XMLString() string {
 	switch e {
+	case ProductType_PRODUCT_TYPE_AUDIOPRODUCT:
+		return "AUDIOPRODUCT"
+	case ProductType_PRODUCT_TYPE_GRAPHICSPRODUCT:
+		return "GRAPHICSPRODUCT"
+	case ProductType_PRODUCT_TYPE_MIXEDMEDIABUNDLEPRODUCT:
+		return "MIXEDMEDIABUNDLEPRODUCT"
+	case ProductType_PRODUCT_TYPE_MOBILEPRODUCT:
+		return "MOBILEPRODUCT"
+	case ProductType_PRODUCT_TYPE_USERDEFINED:
+		return "USERDEFINED"
+	case ProductType_PRODUCT_TYPE_VIDEOPRODUCT:
+		return "VIDEOPRODUCT"
 	default:
 		return ""
 	}
@@ -1439,6 +13673,18 @@ func (e ProductType) XMLString() string {
 func ParseProductTypeString(s string) (ProductType, bool) {
 	s = strings.ToUpper(s)
 	switch s {
+	case "AUDIOPRODUCT":
+		return ProductType_PRODUCT_TYPE_AUDIOPRODUCT, true
+	case "GRAPHICSPRODUCT":
+		return ProductType_PRODUCT_TYPE_GRAPHICSPRODUCT, true
+	case "MIXEDMEDIABUNDLEPRODUCT":
+		return ProductType_PRODUCT_TYPE_MIXEDMEDIABUNDLEPRODUCT, true
+	case "MOBILEPRODUCT":
+		return ProductType_PRODUCT_TYPE_MOBILEPRODUCT, true
+	case "USERDEFINED":
+		return ProductType_PRODUCT_TYPE_USERDEFINED, true
+	case "VIDEOPRODUCT":
+		return ProductType_PRODUCT_TYPE_VIDEOPRODUCT, true
 	default:
 		return ProductType(0), false
 	}
@@ -1492,6 +13738,14 @@ func ParsePurposeString(s string) (Purpose, bool) {
 // XMLString returns the XML string representation of RateModificationType
 func (e RateModificationType) XMLString() string {
 	switch e {
+	case RateModificationType_RATE_MODIFICATION_TYPE_MULTIPLEDISCPROVISION:
+		return "MULTIPLEDISCPROVISION"
+	case RateModificationType_RATE_MODIFICATION_TYPE_OTHERPROVISION:
+		return "OTHERPROVISION"
+	case RateModificationType_RATE_MODIFICATION_TYPE_SALESVOLUMEPROVISION:
+		return "SALESVOLUMEPROVISION"
+	case RateModificationType_RATE_MODIFICATION_TYPE_VIDEOPROVISION:
+		return "VIDEOPROVISION"
 	default:
 		return ""
 	}
@@ -1501,6 +13755,14 @@ func (e RateModificationType) XMLString() string {
 func ParseRateModificationTypeString(s string) (RateModificationType, bool) {
 	s = strings.ToUpper(s)
 	switch s {
+	case "MULTIPLEDISCPROVISION":
+		return RateModificationType_RATE_MODIFICATION_TYPE_MULTIPLEDISCPROVISION, true
+	case "OTHERPROVISION":
+		return RateModificationType_RATE_MODIFICATION_TYPE_OTHERPROVISION, true
+	case "SALESVOLUMEPROVISION":
+		return RateModificationType_RATE_MODIFICATION_TYPE_SALESVOLUMEPROVISION, true
+	case "VIDEOPROVISION":
+		return RateModificationType_RATE_MODIFICATION_TYPE_VIDEOPROVISION, true
 	default:
 		return RateModificationType(0), false
 	}
@@ -1509,6 +13771,182 @@ func ParseRateModificationTypeString(s string) (RateModificationType, bool) {
 // XMLString returns the XML string representation of RatingAgency
 func (e RatingAgency) XMLString() string {
 	switch e {
+	case RatingAgency_RATING_AGENCY_AFR:
+		return "AFR"
+	case RatingAgency_RATING_AGENCY_BBFC:
+		return "BBFC"
+	case RatingAgency_RATING_AGENCY_BFCO:
+		return "BFCO"
+	case RatingAgency_RATING_AGENCY_BFSC:
+		return "BFSC"
+	case RatingAgency_RATING_AGENCY_BMUKK:
+		return "BMUKK"
+	case RatingAgency_RATING_AGENCY_CBFC:
+		return "CBFC"
+	case RatingAgency_RATING_AGENCY_CCC:
+		return "CCC"
+	case RatingAgency_RATING_AGENCY_CCE:
+		return "CCE"
+	case RatingAgency_RATING_AGENCY_CHVRS:
+		return "CHVRS"
+	case RatingAgency_RATING_AGENCY_CNC:
+		return "CNC"
+	case RatingAgency_RATING_AGENCY_DJCTQ:
+		return "DJCTQ"
+	case RatingAgency_RATING_AGENCY_EIRIN:
+		return "EIRIN"
+	case RatingAgency_RATING_AGENCY_FCB:
+		return "FCB"
+	case RatingAgency_RATING_AGENCY_FILMTILSYNET:
+		return "FILMTILSYNET"
+	case RatingAgency_RATING_AGENCY_FPB:
+		return "FPB"
+	case RatingAgency_RATING_AGENCY_FSK:
+		return "FSK"
+	case RatingAgency_RATING_AGENCY_IFCO:
+		return "IFCO"
+	case RatingAgency_RATING_AGENCY_INCAA:
+		return "INCAA"
+	case RatingAgency_RATING_AGENCY_KMRB:
+		return "KMRB"
+	case RatingAgency_RATING_AGENCY_KR:
+		return "KR"
+	case RatingAgency_RATING_AGENCY_KRRIT:
+		return "KRRIT"
+	case RatingAgency_RATING_AGENCY_LSF:
+		return "LSF"
+	case RatingAgency_RATING_AGENCY_MBU:
+		return "MBU"
+	case RatingAgency_RATING_AGENCY_MDA:
+		return "MDA"
+	case RatingAgency_RATING_AGENCY_MDCB:
+		return "MDCB"
+	case RatingAgency_RATING_AGENCY_MFCB:
+		return "MFCB"
+	case RatingAgency_RATING_AGENCY_MIC:
+		return "MIC"
+	case RatingAgency_RATING_AGENCY_MPAA:
+		return "MPAA"
+	case RatingAgency_RATING_AGENCY_MTRCB:
+		return "MTRCB"
+	case RatingAgency_RATING_AGENCY_NBC:
+		return "NBC"
+	case RatingAgency_RATING_AGENCY_NFVCB:
+		return "NFVCB"
+	case RatingAgency_RATING_AGENCY_NICAM:
+		return "NICAM"
+	case RatingAgency_RATING_AGENCY_NKC:
+		return "NKC"
+	case RatingAgency_RATING_AGENCY_OFLC:
+		return "OFLC"
+	case RatingAgency_RATING_AGENCY_OFLC_NZ:
+		return "OFLC_NZ"
+	case RatingAgency_RATING_AGENCY_OFRB:
+		return "OFRB"
+	case RatingAgency_RATING_AGENCY_RDCQ:
+		return "RDCQ"
+	case RatingAgency_RATING_AGENCY_RTC:
+		return "RTC"
+	case RatingAgency_RATING_AGENCY_SBB:
+		return "SBB"
+	case RatingAgency_RATING_AGENCY_SMAIS:
+		return "SMAIS"
+	case RatingAgency_RATING_AGENCY_SPIO_JK:
+		return "SPIO_JK"
+	case RatingAgency_RATING_AGENCY_TELA:
+		return "TELA"
+	case RatingAgency_RATING_AGENCY_USERDEFINED:
+		return "USERDEFINED"
+	case RatingAgency_RATING_AGENCY_VET:
+		return "VET"
+	case RatingAgency_RATING_AGENCY_ACMA:
+		return "ACMA"
+	case RatingAgency_RATING_AGENCY_AGCOM:
+		return "AGCOM"
+	case RatingAgency_RATING_AGENCY_ANATEL:
+		return "ANATEL"
+	case RatingAgency_RATING_AGENCY_BFVC:
+		return "BFVC"
+	case RatingAgency_RATING_AGENCY_CBSC:
+		return "CBSC"
+	case RatingAgency_RATING_AGENCY_CBSC_F:
+		return "CBSC_F"
+	case RatingAgency_RATING_AGENCY_CICF:
+		return "CICF"
+	case RatingAgency_RATING_AGENCY_CNA:
+		return "CNA"
+	case RatingAgency_RATING_AGENCY_CPBC:
+		return "CPBC"
+	case RatingAgency_RATING_AGENCY_CSA:
+		return "CSA"
+	case RatingAgency_RATING_AGENCY_CSCF:
+		return "CSCF"
+	case RatingAgency_RATING_AGENCY_ESRB:
+		return "ESRB"
+	case RatingAgency_RATING_AGENCY_FAB:
+		return "FAB"
+	case RatingAgency_RATING_AGENCY_FCO:
+		return "FCO"
+	case RatingAgency_RATING_AGENCY_FILM_CH:
+		return "FILM_CH"
+	case RatingAgency_RATING_AGENCY_FILM_CZ:
+		return "FILM_CZ"
+	case RatingAgency_RATING_AGENCY_FILM_EG:
+		return "FILM_EG"
+	case RatingAgency_RATING_AGENCY_FILM_EE:
+		return "FILM_EE"
+	case RatingAgency_RATING_AGENCY_FILM_GR:
+		return "FILM_GR"
+	case RatingAgency_RATING_AGENCY_FILM_PE:
+		return "FILM_PE"
+	case RatingAgency_RATING_AGENCY_FILM_SK:
+		return "FILM_SK"
+	case RatingAgency_RATING_AGENCY_FRB:
+		return "FRB"
+	case RatingAgency_RATING_AGENCY_ICAA:
+		return "ICAA"
+	case RatingAgency_RATING_AGENCY_IFCOF:
+		return "IFCOF"
+	case RatingAgency_RATING_AGENCY_KFCB:
+		return "KFCB"
+	case RatingAgency_RATING_AGENCY_KIJKWIJZER:
+		return "KIJKWIJZER"
+	case RatingAgency_RATING_AGENCY_MBACT:
+		return "MBACT"
+	case RatingAgency_RATING_AGENCY_MCCAA:
+		return "MCCAA"
+	case RatingAgency_RATING_AGENCY_MEDIETILSYNET:
+		return "MEDIETILSYNET"
+	case RatingAgency_RATING_AGENCY_MEKU:
+		return "MEKU"
+	case RatingAgency_RATING_AGENCY_MKRF:
+		return "MKRF"
+	case RatingAgency_RATING_AGENCY_MOC:
+		return "MOC"
+	case RatingAgency_RATING_AGENCY_MOC_TW:
+		return "MOC_TW"
+	case RatingAgency_RATING_AGENCY_MPAAT:
+		return "MPAAT"
+	case RatingAgency_RATING_AGENCY_NCS:
+		return "NCS"
+	case RatingAgency_RATING_AGENCY_NFRC:
+		return "NFRC"
+	case RatingAgency_RATING_AGENCY_PEGI:
+		return "PEGI"
+	case RatingAgency_RATING_AGENCY_RCNOF:
+		return "RCNOF"
+	case RatingAgency_RATING_AGENCY_RIAA:
+		return "RIAA"
+	case RatingAgency_RATING_AGENCY_RTE:
+		return "RTE"
+	case RatingAgency_RATING_AGENCY_SIBCI:
+		return "SIBCI"
+	case RatingAgency_RATING_AGENCY_SM_SA:
+		return "SM_SA"
+	case RatingAgency_RATING_AGENCY_USFA:
+		return "USFA"
+	case RatingAgency_RATING_AGENCY_TVPG:
+		return "TVPG"
 	default:
 		return ""
 	}
@@ -1518,6 +13956,182 @@ func (e RatingAgency) XMLString() string {
 func ParseRatingAgencyString(s string) (RatingAgency, bool) {
 	s = strings.ToUpper(s)
 	switch s {
+	case "AFR":
+		return RatingAgency_RATING_AGENCY_AFR, true
+	case "BBFC":
+		return RatingAgency_RATING_AGENCY_BBFC, true
+	case "BFCO":
+		return RatingAgency_RATING_AGENCY_BFCO, true
+	case "BFSC":
+		return RatingAgency_RATING_AGENCY_BFSC, true
+	case "BMUKK":
+		return RatingAgency_RATING_AGENCY_BMUKK, true
+	case "CBFC":
+		return RatingAgency_RATING_AGENCY_CBFC, true
+	case "CCC":
+		return RatingAgency_RATING_AGENCY_CCC, true
+	case "CCE":
+		return RatingAgency_RATING_AGENCY_CCE, true
+	case "CHVRS":
+		return RatingAgency_RATING_AGENCY_CHVRS, true
+	case "CNC":
+		return RatingAgency_RATING_AGENCY_CNC, true
+	case "DJCTQ":
+		return RatingAgency_RATING_AGENCY_DJCTQ, true
+	case "EIRIN":
+		return RatingAgency_RATING_AGENCY_EIRIN, true
+	case "FCB":
+		return RatingAgency_RATING_AGENCY_FCB, true
+	case "FILMTILSYNET":
+		return RatingAgency_RATING_AGENCY_FILMTILSYNET, true
+	case "FPB":
+		return RatingAgency_RATING_AGENCY_FPB, true
+	case "FSK":
+		return RatingAgency_RATING_AGENCY_FSK, true
+	case "IFCO":
+		return RatingAgency_RATING_AGENCY_IFCO, true
+	case "INCAA":
+		return RatingAgency_RATING_AGENCY_INCAA, true
+	case "KMRB":
+		return RatingAgency_RATING_AGENCY_KMRB, true
+	case "KR":
+		return RatingAgency_RATING_AGENCY_KR, true
+	case "KRRIT":
+		return RatingAgency_RATING_AGENCY_KRRIT, true
+	case "LSF":
+		return RatingAgency_RATING_AGENCY_LSF, true
+	case "MBU":
+		return RatingAgency_RATING_AGENCY_MBU, true
+	case "MDA":
+		return RatingAgency_RATING_AGENCY_MDA, true
+	case "MDCB":
+		return RatingAgency_RATING_AGENCY_MDCB, true
+	case "MFCB":
+		return RatingAgency_RATING_AGENCY_MFCB, true
+	case "MIC":
+		return RatingAgency_RATING_AGENCY_MIC, true
+	case "MPAA":
+		return RatingAgency_RATING_AGENCY_MPAA, true
+	case "MTRCB":
+		return RatingAgency_RATING_AGENCY_MTRCB, true
+	case "NBC":
+		return RatingAgency_RATING_AGENCY_NBC, true
+	case "NFVCB":
+		return RatingAgency_RATING_AGENCY_NFVCB, true
+	case "NICAM":
+		return RatingAgency_RATING_AGENCY_NICAM, true
+	case "NKC":
+		return RatingAgency_RATING_AGENCY_NKC, true
+	case "OFLC":
+		return RatingAgency_RATING_AGENCY_OFLC, true
+	case "OFLC_NZ":
+		return RatingAgency_RATING_AGENCY_OFLC_NZ, true
+	case "OFRB":
+		return RatingAgency_RATING_AGENCY_OFRB, true
+	case "RDCQ":
+		return RatingAgency_RATING_AGENCY_RDCQ, true
+	case "RTC":
+		return RatingAgency_RATING_AGENCY_RTC, true
+	case "SBB":
+		return RatingAgency_RATING_AGENCY_SBB, true
+	case "SMAIS":
+		return RatingAgency_RATING_AGENCY_SMAIS, true
+	case "SPIO_JK":
+		return RatingAgency_RATING_AGENCY_SPIO_JK, true
+	case "TELA":
+		return RatingAgency_RATING_AGENCY_TELA, true
+	case "USERDEFINED":
+		return RatingAgency_RATING_AGENCY_USERDEFINED, true
+	case "VET":
+		return RatingAgency_RATING_AGENCY_VET, true
+	case "ACMA":
+		return RatingAgency_RATING_AGENCY_ACMA, true
+	case "AGCOM":
+		return RatingAgency_RATING_AGENCY_AGCOM, true
+	case "ANATEL":
+		return RatingAgency_RATING_AGENCY_ANATEL, true
+	case "BFVC":
+		return RatingAgency_RATING_AGENCY_BFVC, true
+	case "CBSC":
+		return RatingAgency_RATING_AGENCY_CBSC, true
+	case "CBSC_F":
+		return RatingAgency_RATING_AGENCY_CBSC_F, true
+	case "CICF":
+		return RatingAgency_RATING_AGENCY_CICF, true
+	case "CNA":
+		return RatingAgency_RATING_AGENCY_CNA, true
+	case "CPBC":
+		return RatingAgency_RATING_AGENCY_CPBC, true
+	case "CSA":
+		return RatingAgency_RATING_AGENCY_CSA, true
+	case "CSCF":
+		return RatingAgency_RATING_AGENCY_CSCF, true
+	case "ESRB":
+		return RatingAgency_RATING_AGENCY_ESRB, true
+	case "FAB":
+		return RatingAgency_RATING_AGENCY_FAB, true
+	case "FCO":
+		return RatingAgency_RATING_AGENCY_FCO, true
+	case "FILM_CH":
+		return RatingAgency_RATING_AGENCY_FILM_CH, true
+	case "FILM_CZ":
+		return RatingAgency_RATING_AGENCY_FILM_CZ, true
+	case "FILM_EG":
+		return RatingAgency_RATING_AGENCY_FILM_EG, true
+	case "FILM_EE":
+		return RatingAgency_RATING_AGENCY_FILM_EE, true
+	case "FILM_GR":
+		return RatingAgency_RATING_AGENCY_FILM_GR, true
+	case "FILM_PE":
+		return RatingAgency_RATING_AGENCY_FILM_PE, true
+	case "FILM_SK":
+		return RatingAgency_RATING_AGENCY_FILM_SK, true
+	case "FRB":
+		return RatingAgency_RATING_AGENCY_FRB, true
+	case "ICAA":
+		return RatingAgency_RATING_AGENCY_ICAA, true
+	case "IFCOF":
+		return RatingAgency_RATING_AGENCY_IFCOF, true
+	case "KFCB":
+		return RatingAgency_RATING_AGENCY_KFCB, true
+	case "KIJKWIJZER":
+		return RatingAgency_RATING_AGENCY_KIJKWIJZER, true
+	case "MBACT":
+		return RatingAgency_RATING_AGENCY_MBACT, true
+	case "MCCAA":
+		return RatingAgency_RATING_AGENCY_MCCAA, true
+	case "MEDIETILSYNET":
+		return RatingAgency_RATING_AGENCY_MEDIETILSYNET, true
+	case "MEKU":
+		return RatingAgency_RATING_AGENCY_MEKU, true
+	case "MKRF":
+		return RatingAgency_RATING_AGENCY_MKRF, true
+	case "MOC":
+		return RatingAgency_RATING_AGENCY_MOC, true
+	case "MOC_TW":
+		return RatingAgency_RATING_AGENCY_MOC_TW, true
+	case "MPAAT":
+		return RatingAgency_RATING_AGENCY_MPAAT, true
+	case "NCS":
+		return RatingAgency_RATING_AGENCY_NCS, true
+	case "NFRC":
+		return RatingAgency_RATING_AGENCY_NFRC, true
+	case "PEGI":
+		return RatingAgency_RATING_AGENCY_PEGI, true
+	case "RCNOF":
+		return RatingAgency_RATING_AGENCY_RCNOF, true
+	case "RIAA":
+		return RatingAgency_RATING_AGENCY_RIAA, true
+	case "RTE":
+		return RatingAgency_RATING_AGENCY_RTE, true
+	case "SIBCI":
+		return RatingAgency_RATING_AGENCY_SIBCI, true
+	case "SM_SA":
+		return RatingAgency_RATING_AGENCY_SM_SA, true
+	case "USFA":
+		return RatingAgency_RATING_AGENCY_USFA, true
+	case "TVPG":
+		return RatingAgency_RATING_AGENCY_TVPG, true
 	default:
 		return RatingAgency(0), false
 	}
@@ -1526,6 +14140,12 @@ func ParseRatingAgencyString(s string) (RatingAgency, bool) {
 // XMLString returns the XML string representation of ReasonType
 func (e ReasonType) XMLString() string {
 	switch e {
+	case ReasonType_REASON_TYPE_CHARTREPORTING:
+		return "CHARTREPORTING"
+	case ReasonType_REASON_TYPE_ROYALTYREPORTING:
+		return "ROYALTYREPORTING"
+	case ReasonType_REASON_TYPE_USERDEFINED:
+		return "USERDEFINED"
 	default:
 		return ""
 	}
@@ -1535,6 +14155,12 @@ func (e ReasonType) XMLString() string {
 func ParseReasonTypeString(s string) (ReasonType, bool) {
 	s = strings.ToUpper(s)
 	switch s {
+	case "CHARTREPORTING":
+		return ReasonType_REASON_TYPE_CHARTREPORTING, true
+	case "ROYALTYREPORTING":
+		return ReasonType_REASON_TYPE_ROYALTYREPORTING, true
+	case "USERDEFINED":
+		return ReasonType_REASON_TYPE_USERDEFINED, true
 	default:
 		return ReasonType(0), false
 	}
@@ -1543,6 +14169,12 @@ func ParseReasonTypeString(s string) (ReasonType, bool) {
 // XMLString returns the XML string representation of RecipientRevenueType
 func (e RecipientRevenueType) XMLString() string {
 	switch e {
+	case RecipientRevenueType_RECIPIENT_REVENUE_TYPE_PERFORMERANDPRODUCERREVENUE:
+		return "PERFORMERANDPRODUCERREVENUE"
+	case RecipientRevenueType_RECIPIENT_REVENUE_TYPE_PERFORMERREVENUE:
+		return "PERFORMERREVENUE"
+	case RecipientRevenueType_RECIPIENT_REVENUE_TYPE_PRODUCERREVENUE:
+		return "PRODUCERREVENUE"
 	default:
 		return ""
 	}
@@ -1552,6 +14184,12 @@ func (e RecipientRevenueType) XMLString() string {
 func ParseRecipientRevenueTypeString(s string) (RecipientRevenueType, bool) {
 	s = strings.ToUpper(s)
 	switch s {
+	case "PERFORMERANDPRODUCERREVENUE":
+		return RecipientRevenueType_RECIPIENT_REVENUE_TYPE_PERFORMERANDPRODUCERREVENUE, true
+	case "PERFORMERREVENUE":
+		return RecipientRevenueType_RECIPIENT_REVENUE_TYPE_PERFORMERREVENUE, true
+	case "PRODUCERREVENUE":
+		return RecipientRevenueType_RECIPIENT_REVENUE_TYPE_PRODUCERREVENUE, true
 	default:
 		return RecipientRevenueType(0), false
 	}
@@ -1560,6 +14198,26 @@ func ParseRecipientRevenueTypeString(s string) (RecipientRevenueType, bool) {
 // XMLString returns the XML string representation of RecordingMode
 func (e RecordingMode) XMLString() string {
 	switch e {
+	case RecordingMode_RECORDING_MODE_MONO:
+		return "MONO"
+	case RecordingMode_RECORDING_MODE_MULTICHANNELAUDIO:
+		return "MULTICHANNELAUDIO"
+	case RecordingMode_RECORDING_MODE_STEREO:
+		return "STEREO"
+	case RecordingMode_RECORDING_MODE_UNKNOWN:
+		return "UNKNOWN"
+	case RecordingMode_RECORDING_MODE_BINAURALAUDIO:
+		return "BINAURALAUDIO"
+	case RecordingMode_RECORDING_MODE_LCR:
+		return "LCR"
+	case RecordingMode_RECORDING_MODE_MULTITRACK:
+		return "MULTITRACK"
+	case RecordingMode_RECORDING_MODE_QUAD:
+		return "QUAD"
+	case RecordingMode_RECORDING_MODE_STEMS:
+		return "STEMS"
+	case RecordingMode_RECORDING_MODE_SURROUNDSOUND:
+		return "SURROUNDSOUND"
 	default:
 		return ""
 	}
@@ -1569,6 +14227,26 @@ func (e RecordingMode) XMLString() string {
 func ParseRecordingModeString(s string) (RecordingMode, bool) {
 	s = strings.ToUpper(s)
 	switch s {
+	case "MONO":
+		return RecordingMode_RECORDING_MODE_MONO, true
+	case "MULTICHANNELAUDIO":
+		return RecordingMode_RECORDING_MODE_MULTICHANNELAUDIO, true
+	case "STEREO":
+		return RecordingMode_RECORDING_MODE_STEREO, true
+	case "UNKNOWN":
+		return RecordingMode_RECORDING_MODE_UNKNOWN, true
+	case "BINAURALAUDIO":
+		return RecordingMode_RECORDING_MODE_BINAURALAUDIO, true
+	case "LCR":
+		return RecordingMode_RECORDING_MODE_LCR, true
+	case "MULTITRACK":
+		return RecordingMode_RECORDING_MODE_MULTITRACK, true
+	case "QUAD":
+		return RecordingMode_RECORDING_MODE_QUAD, true
+	case "STEMS":
+		return RecordingMode_RECORDING_MODE_STEMS, true
+	case "SURROUNDSOUND":
+		return RecordingMode_RECORDING_MODE_SURROUNDSOUND, true
 	default:
 		return RecordingMode(0), false
 	}
@@ -1577,6 +14255,16 @@ func ParseRecordingModeString(s string) (RecordingMode, bool) {
 // XMLString returns the XML string representation of RedeliveryReasonType
 func (e RedeliveryReasonType) XMLString() string {
 	switch e {
+	case RedeliveryReasonType_REDELIVERY_REASON_TYPE_BINARYCORRUPTED:
+		return "BINARYCORRUPTED"
+	case RedeliveryReasonType_REDELIVERY_REASON_TYPE_METADATAINADEQUATE:
+		return "METADATAINADEQUATE"
+	case RedeliveryReasonType_REDELIVERY_REASON_TYPE_PACKAGEINCOMPLETE:
+		return "PACKAGEINCOMPLETE"
+	case RedeliveryReasonType_REDELIVERY_REASON_TYPE_PROCESSINGERRORATRELEASEDISTRIBUTOR:
+		return "PROCESSINGERRORATRELEASEDISTRIBUTOR"
+	case RedeliveryReasonType_REDELIVERY_REASON_TYPE_USERDEFINED:
+		return "USERDEFINED"
 	default:
 		return ""
 	}
@@ -1586,6 +14274,16 @@ func (e RedeliveryReasonType) XMLString() string {
 func ParseRedeliveryReasonTypeString(s string) (RedeliveryReasonType, bool) {
 	s = strings.ToUpper(s)
 	switch s {
+	case "BINARYCORRUPTED":
+		return RedeliveryReasonType_REDELIVERY_REASON_TYPE_BINARYCORRUPTED, true
+	case "METADATAINADEQUATE":
+		return RedeliveryReasonType_REDELIVERY_REASON_TYPE_METADATAINADEQUATE, true
+	case "PACKAGEINCOMPLETE":
+		return RedeliveryReasonType_REDELIVERY_REASON_TYPE_PACKAGEINCOMPLETE, true
+	case "PROCESSINGERRORATRELEASEDISTRIBUTOR":
+		return RedeliveryReasonType_REDELIVERY_REASON_TYPE_PROCESSINGERRORATRELEASEDISTRIBUTOR, true
+	case "USERDEFINED":
+		return RedeliveryReasonType_REDELIVERY_REASON_TYPE_USERDEFINED, true
 	default:
 		return RedeliveryReasonType(0), false
 	}
@@ -1594,6 +14292,10 @@ func ParseRedeliveryReasonTypeString(s string) (RedeliveryReasonType, bool) {
 // XMLString returns the XML string representation of ReferenceUnit
 func (e ReferenceUnit) XMLString() string {
 	switch e {
+	case ReferenceUnit_REFERENCE_UNIT_PERLICENSE:
+		return "PERLICENSE"
+	case ReferenceUnit_REFERENCE_UNIT_PERUSE:
+		return "PERUSE"
 	default:
 		return ""
 	}
@@ -1603,6 +14305,10 @@ func (e ReferenceUnit) XMLString() string {
 func ParseReferenceUnitString(s string) (ReferenceUnit, bool) {
 	s = strings.ToUpper(s)
 	switch s {
+	case "PERLICENSE":
+		return ReferenceUnit_REFERENCE_UNIT_PERLICENSE, true
+	case "PERUSE":
+		return ReferenceUnit_REFERENCE_UNIT_PERUSE, true
 	default:
 		return ReferenceUnit(0), false
 	}
@@ -1611,6 +14317,18 @@ func ParseReferenceUnitString(s string) (ReferenceUnit, bool) {
 // XMLString returns the XML string representation of RelationalRelator
 func (e RelationalRelator) XMLString() string {
 	switch e {
+	case RelationalRelator_RELATIONAL_RELATOR_EQUALTO:
+		return "EQUALTO"
+	case RelationalRelator_RELATIONAL_RELATOR_LESSTHAN:
+		return "LESSTHAN"
+	case RelationalRelator_RELATIONAL_RELATOR_LESSTHANOREQUALTO:
+		return "LESSTHANOREQUALTO"
+	case RelationalRelator_RELATIONAL_RELATOR_MORETHAN:
+		return "MORETHAN"
+	case RelationalRelator_RELATIONAL_RELATOR_MORETHANOREQUALTO:
+		return "MORETHANOREQUALTO"
+	case RelationalRelator_RELATIONAL_RELATOR_NOTEQUALTO:
+		return "NOTEQUALTO"
 	default:
 		return ""
 	}
@@ -1620,6 +14338,18 @@ func (e RelationalRelator) XMLString() string {
 func ParseRelationalRelatorString(s string) (RelationalRelator, bool) {
 	s = strings.ToUpper(s)
 	switch s {
+	case "EQUALTO":
+		return RelationalRelator_RELATIONAL_RELATOR_EQUALTO, true
+	case "LESSTHAN":
+		return RelationalRelator_RELATIONAL_RELATOR_LESSTHAN, true
+	case "LESSTHANOREQUALTO":
+		return RelationalRelator_RELATIONAL_RELATOR_LESSTHANOREQUALTO, true
+	case "MORETHAN":
+		return RelationalRelator_RELATIONAL_RELATOR_MORETHAN, true
+	case "MORETHANOREQUALTO":
+		return RelationalRelator_RELATIONAL_RELATOR_MORETHANOREQUALTO, true
+	case "NOTEQUALTO":
+		return RelationalRelator_RELATIONAL_RELATOR_NOTEQUALTO, true
 	default:
 		return RelationalRelator(0), false
 	}
@@ -1628,6 +14358,18 @@ func ParseRelationalRelatorString(s string) (RelationalRelator, bool) {
 // XMLString returns the XML string representation of ReleaseAvailabilityStatus
 func (e ReleaseAvailabilityStatus) XMLString() string {
 	switch e {
+	case ReleaseAvailabilityStatus_RELEASE_AVAILABILITY_STATUS_AVAILABLEFORDSP:
+		return "AVAILABLEFORDSP"
+	case ReleaseAvailabilityStatus_RELEASE_AVAILABILITY_STATUS_NOTAVAILABLEFORDSP:
+		return "NOTAVAILABLEFORDSP"
+	case ReleaseAvailabilityStatus_RELEASE_AVAILABILITY_STATUS_NOTCLEAREDFORDSP:
+		return "NOTCLEAREDFORDSP"
+	case ReleaseAvailabilityStatus_RELEASE_AVAILABILITY_STATUS_NOTCLEAREDFORTERRITORY:
+		return "NOTCLEAREDFORTERRITORY"
+	case ReleaseAvailabilityStatus_RELEASE_AVAILABILITY_STATUS_NOTYETPREPARED:
+		return "NOTYETPREPARED"
+	case ReleaseAvailabilityStatus_RELEASE_AVAILABILITY_STATUS_USERDEFINED:
+		return "USERDEFINED"
 	default:
 		return ""
 	}
@@ -1637,6 +14379,18 @@ func (e ReleaseAvailabilityStatus) XMLString() string {
 func ParseReleaseAvailabilityStatusString(s string) (ReleaseAvailabilityStatus, bool) {
 	s = strings.ToUpper(s)
 	switch s {
+	case "AVAILABLEFORDSP":
+		return ReleaseAvailabilityStatus_RELEASE_AVAILABILITY_STATUS_AVAILABLEFORDSP, true
+	case "NOTAVAILABLEFORDSP":
+		return ReleaseAvailabilityStatus_RELEASE_AVAILABILITY_STATUS_NOTAVAILABLEFORDSP, true
+	case "NOTCLEAREDFORDSP":
+		return ReleaseAvailabilityStatus_RELEASE_AVAILABILITY_STATUS_NOTCLEAREDFORDSP, true
+	case "NOTCLEAREDFORTERRITORY":
+		return ReleaseAvailabilityStatus_RELEASE_AVAILABILITY_STATUS_NOTCLEAREDFORTERRITORY, true
+	case "NOTYETPREPARED":
+		return ReleaseAvailabilityStatus_RELEASE_AVAILABILITY_STATUS_NOTYETPREPARED, true
+	case "USERDEFINED":
+		return ReleaseAvailabilityStatus_RELEASE_AVAILABILITY_STATUS_USERDEFINED, true
 	default:
 		return ReleaseAvailabilityStatus(0), false
 	}
@@ -1645,6 +14399,46 @@ func ParseReleaseAvailabilityStatusString(s string) (ReleaseAvailabilityStatus,
 // XMLString returns the XML string representation of ReleaseRelationshipType
 func (e ReleaseRelationshipType) XMLString() string {
 	switch e {
+	case ReleaseRelationshipType_RELEASE_RELATIONSHIP_TYPE_HASARTISTFROMENSEMBLE:
+		return "HASARTISTFROMENSEMBLE"
+	case ReleaseRelationshipType_RELEASE_RELATIONSHIP_TYPE_HASARTISTFROMSAMEENSEMBLE:
+		return "HASARTISTFROMSAMEENSEMBLE"
+	case ReleaseRelationshipType_RELEASE_RELATIONSHIP_TYPE_HASENSEMBLEWITHARTIST:
+		return "HASENSEMBLEWITHARTIST"
+	case ReleaseRelationshipType_RELEASE_RELATIONSHIP_TYPE_HASSAMEARTIST:
+		return "HASSAMEARTIST"
+	case ReleaseRelationshipType_RELEASE_RELATIONSHIP_TYPE_HASSAMERECORDINGPROJECT:
+		return "HASSAMERECORDINGPROJECT"
+	case ReleaseRelationshipType_RELEASE_RELATIONSHIP_TYPE_HASSIMILARCONTENT:
+		return "HASSIMILARCONTENT"
+	case ReleaseRelationshipType_RELEASE_RELATIONSHIP_TYPE_ISDIGITALEQUIVALENTTOPHYSICAL:
+		return "ISDIGITALEQUIVALENTTOPHYSICAL"
+	case ReleaseRelationshipType_RELEASE_RELATIONSHIP_TYPE_ISEQUIVALENTTOAUDIO:
+		return "ISEQUIVALENTTOAUDIO"
+	case ReleaseRelationshipType_RELEASE_RELATIONSHIP_TYPE_ISEQUIVALENTTOVIDEO:
+		return "ISEQUIVALENTTOVIDEO"
+	case ReleaseRelationshipType_RELEASE_RELATIONSHIP_TYPE_ISEXTENDEDFROMALBUM:
+		return "ISEXTENDEDFROMALBUM"
+	case ReleaseRelationshipType_RELEASE_RELATIONSHIP_TYPE_ISFROMAUDIO:
+		return "ISFROMAUDIO"
+	case ReleaseRelationshipType_RELEASE_RELATIONSHIP_TYPE_ISFROMVIDEO:
+		return "ISFROMVIDEO"
+	case ReleaseRelationshipType_RELEASE_RELATIONSHIP_TYPE_ISPARENTRELEASE:
+		return "ISPARENTRELEASE"
+	case ReleaseRelationshipType_RELEASE_RELATIONSHIP_TYPE_ISPHYSICALEQUIVALENTTODIGITAL:
+		return "ISPHYSICALEQUIVALENTTODIGITAL"
+	case ReleaseRelationshipType_RELEASE_RELATIONSHIP_TYPE_ISRELEASEFROMRELEASE:
+		return "ISRELEASEFROMRELEASE"
+	case ReleaseRelationshipType_RELEASE_RELATIONSHIP_TYPE_ISSHORTENEDFROMALBUM:
+		return "ISSHORTENEDFROMALBUM"
+	case ReleaseRelationshipType_RELEASE_RELATIONSHIP_TYPE_UNKNOWN:
+		return "UNKNOWN"
+	case ReleaseRelationshipType_RELEASE_RELATIONSHIP_TYPE_USERDEFINED:
+		return "USERDEFINED"
+	case ReleaseRelationshipType_RELEASE_RELATIONSHIP_TYPE_ISDIFFERENTENCODING:
+		return "ISDIFFERENTENCODING"
+	case ReleaseRelationshipType_RELEASE_RELATIONSHIP_TYPE_HASCONTENTFROM:
+		return "HASCONTENTFROM"
 	default:
 		return ""
 	}
@@ -1654,6 +14448,46 @@ func (e ReleaseRelationshipType) XMLString() string {
 func ParseReleaseRelationshipTypeString(s string) (ReleaseRelationshipType, bool) {
 	s = strings.ToUpper(s)
 	switch s {
+	case "HASARTISTFROMENSEMBLE":
+		return ReleaseRelationshipType_RELEASE_RELATIONSHIP_TYPE_HASARTISTFROMENSEMBLE, true
+	case "HASARTISTFROMSAMEENSEMBLE":
+		return ReleaseRelationshipType_RELEASE_RELATIONSHIP_TYPE_HASARTISTFROMSAMEENSEMBLE, true
+	case "HASENSEMBLEWITHARTIST":
+		return ReleaseRelationshipType_RELEASE_RELATIONSHIP_TYPE_HASENSEMBLEWITHARTIST, true
+	case "HASSAMEARTIST":
+		return ReleaseRelationshipType_RELEASE_RELATIONSHIP_TYPE_HASSAMEARTIST, true
+	case "HASSAMERECORDINGPROJECT":
+		return ReleaseRelationshipType_RELEASE_RELATIONSHIP_TYPE_HASSAMERECORDINGPROJECT, true
+	case "HASSIMILARCONTENT":
+		return ReleaseRelationshipType_RELEASE_RELATIONSHIP_TYPE_HASSIMILARCONTENT, true
+	case "ISDIGITALEQUIVALENTTOPHYSICAL":
+		return ReleaseRelationshipType_RELEASE_RELATIONSHIP_TYPE_ISDIGITALEQUIVALENTTOPHYSICAL, true
+	case "ISEQUIVALENTTOAUDIO":
+		return ReleaseRelationshipType_RELEASE_RELATIONSHIP_TYPE_ISEQUIVALENTTOAUDIO, true
+	case "ISEQUIVALENTTOVIDEO":
+		return ReleaseRelationshipType_RELEASE_RELATIONSHIP_TYPE_ISEQUIVALENTTOVIDEO, true
+	case "ISEXTENDEDFROMALBUM":
+		return ReleaseRelationshipType_RELEASE_RELATIONSHIP_TYPE_ISEXTENDEDFROMALBUM, true
+	case "ISFROMAUDIO":
+		return ReleaseRelationshipType_RELEASE_RELATIONSHIP_TYPE_ISFROMAUDIO, true
+	case "ISFROMVIDEO":
+		return ReleaseRelationshipType_RELEASE_RELATIONSHIP_TYPE_ISFROMVIDEO, true
+	case "ISPARENTRELEASE":
+		return ReleaseRelationshipType_RELEASE_RELATIONSHIP_TYPE_ISPARENTRELEASE, true
+	case "ISPHYSICALEQUIVALENTTODIGITAL":
+		return ReleaseRelationshipType_RELEASE_RELATIONSHIP_TYPE_ISPHYSICALEQUIVALENTTODIGITAL, true
+	case "ISRELEASEFROMRELEASE":
+		return ReleaseRelationshipType_RELEASE_RELATIONSHIP_TYPE_ISRELEASEFROMRELEASE, true
+	case "ISSHORTENEDFROMALBUM":
+		return ReleaseRelationshipType_RELEASE_RELATIONSHIP_TYPE_ISSHORTENEDFROMALBUM, true
+	case "UNKNOWN":
+		return ReleaseRelationshipType_RELEASE_RELATIONSHIP_TYPE_UNKNOWN, true
+	case "USERDEFINED":
+		return ReleaseRelationshipType_RELEASE_RELATIONSHIP_TYPE_USERDEFINED, true
+	case "ISDIFFERENTENCODING":
+		return ReleaseRelationshipType_RELEASE_RELATIONSHIP_TYPE_ISDIFFERENTENCODING, true
+	case "HASCONTENTFROM":
+		return ReleaseRelationshipType_RELEASE_RELATIONSHIP_TYPE_HASCONTENTFROM, true
 	default:
 		return ReleaseRelationshipType(0), false
 	}
@@ -1662,6 +14496,10 @@ func ParseReleaseRelationshipTypeString(s string) (ReleaseRelationshipType, bool
 // XMLString returns the XML string representation of ReleaseResourceType
 func (e ReleaseResourceType) XMLString() string {
 	switch e {
+	case ReleaseResourceType_RELEASE_RESOURCE_TYPE_PRIMARYRESOURCE:
+		return "PRIMARYRESOURCE"
+	case ReleaseResourceType_RELEASE_RESOURCE_TYPE_SECONDARYRESOURCE:
+		return "SECONDARYRESOURCE"
 	default:
 		return ""
 	}
@@ -1671,6 +14509,10 @@ func (e ReleaseResourceType) XMLString() string {
 func ParseReleaseResourceTypeString(s string) (ReleaseResourceType, bool) {
 	s = strings.ToUpper(s)
 	switch s {
+	case "PRIMARYRESOURCE":
+		return ReleaseResourceType_RELEASE_RESOURCE_TYPE_PRIMARYRESOURCE, true
+	case "SECONDARYRESOURCE":
+		return ReleaseResourceType_RELEASE_RESOURCE_TYPE_SECONDARYRESOURCE, true
 	default:
 		return ReleaseResourceType(0), false
 	}
@@ -1679,6 +14521,170 @@ func ParseReleaseResourceTypeString(s string) (ReleaseResourceType, bool) {
 // XMLString returns the XML string representation of ReleaseType
 func (e ReleaseType) XMLString() string {
 	switch e {
+	case ReleaseType_RELEASE_TYPE_ADVERTISEMENTVIDEO:
+		return "ADVERTISEMENTVIDEO"
+	case ReleaseType_RELEASE_TYPE_ALBUM:
+		return "ALBUM"
+	case ReleaseType_RELEASE_TYPE_ALERTTONERELEASE:
+		return "ALERTTONERELEASE"
+	case ReleaseType_RELEASE_TYPE_ANIMATION:
+		return "ANIMATION"
+	case ReleaseType_RELEASE_TYPE_ASPERCONTRACT:
+		return "ASPERCONTRACT"
+	case ReleaseType_RELEASE_TYPE_AUDIOCLIPRELEASE:
+		return "AUDIOCLIPRELEASE"
+	case ReleaseType_RELEASE_TYPE_BACKCOVERIMAGERELEASE:
+		return "BACKCOVERIMAGERELEASE"
+	case ReleaseType_RELEASE_TYPE_BOOKLETBACKIMAGERELEASE:
+		return "BOOKLETBACKIMAGERELEASE"
+	case ReleaseType_RELEASE_TYPE_BOOKLETFRONTIMAGERELEASE:
+		return "BOOKLETFRONTIMAGERELEASE"
+	case ReleaseType_RELEASE_TYPE_BOOKLETRELEASE:
+		return "BOOKLETRELEASE"
+	case ReleaseType_RELEASE_TYPE_BUNDLE:
+		return "BUNDLE"
+	case ReleaseType_RELEASE_TYPE_CLASSICALALBUM:
+		return "CLASSICALALBUM"
+	case ReleaseType_RELEASE_TYPE_CONCERTVIDEO:
+		return "CONCERTVIDEO"
+	case ReleaseType_RELEASE_TYPE_CORPORATEFILM:
+		return "CORPORATEFILM"
+	case ReleaseType_RELEASE_TYPE_DIGITALBOXSETRELEASE:
+		return "DIGITALBOXSETRELEASE"
+	case ReleaseType_RELEASE_TYPE_DOCUMENTARY:
+		return "DOCUMENTARY"
+	case ReleaseType_RELEASE_TYPE_DOCUMENTIMAGERELEASE:
+		return "DOCUMENTIMAGERELEASE"
+	case ReleaseType_RELEASE_TYPE_EBOOKRELEASE:
+		return "EBOOKRELEASE"
+	case ReleaseType_RELEASE_TYPE_EP:
+		return "EP"
+	case ReleaseType_RELEASE_TYPE_EPISODE:
+		return "EPISODE"
+	case ReleaseType_RELEASE_TYPE_FEATUREFILM:
+		return "FEATUREFILM"
+	case ReleaseType_RELEASE_TYPE_FILMBUNDLE:
+		return "FILMBUNDLE"
+	case ReleaseType_RELEASE_TYPE_FRONTCOVERIMAGERELEASE:
+		return "FRONTCOVERIMAGERELEASE"
+	case ReleaseType_RELEASE_TYPE_ICONRELEASE:
+		return "ICONRELEASE"
+	case ReleaseType_RELEASE_TYPE_INFOMERCIALVIDEO:
+		return "INFOMERCIALVIDEO"
+	case ReleaseType_RELEASE_TYPE_INTERACTIVEBOOKLETRELEASE:
+		return "INTERACTIVEBOOKLETRELEASE"
+	case ReleaseType_RELEASE_TYPE_KARAOKERELEASE:
+		return "KARAOKERELEASE"
+	case ReleaseType_RELEASE_TYPE_LIVEEVENTVIDEO:
+		return "LIVEEVENTVIDEO"
+	case ReleaseType_RELEASE_TYPE_LOGORELEASE:
+		return "LOGORELEASE"
+	case ReleaseType_RELEASE_TYPE_LONGFORMMUSICALWORKVIDEORELEASE:
+		return "LONGFORMMUSICALWORKVIDEORELEASE"
+	case ReleaseType_RELEASE_TYPE_LONGFORMNONMUSICALWORKVIDEORELEASE:
+		return "LONGFORMNONMUSICALWORKVIDEORELEASE"
+	case ReleaseType_RELEASE_TYPE_LYRICSHEETRELEASE:
+		return "LYRICSHEETRELEASE"
+	case ReleaseType_RELEASE_TYPE_MULTIMEDIAALBUM:
+		return "MULTIMEDIAALBUM"
+	case ReleaseType_RELEASE_TYPE_MULTIMEDIASINGLE:
+		return "MULTIMEDIASINGLE"
+	case ReleaseType_RELEASE_TYPE_MUSICALWORKBASEDGAMERELEASE:
+		return "MUSICALWORKBASEDGAMERELEASE"
+	case ReleaseType_RELEASE_TYPE_MUSICALWORKCLIPRELEASE:
+		return "MUSICALWORKCLIPRELEASE"
+	case ReleaseType_RELEASE_TYPE_MUSICALWORKREADALONGVIDEORELEASE:
+		return "MUSICALWORKREADALONGVIDEORELEASE"
+	case ReleaseType_RELEASE_TYPE_MUSICALWORKTRAILERRELEASE:
+		return "MUSICALWORKTRAILERRELEASE"
+	case ReleaseType_RELEASE_TYPE_MUSICALWORKVIDEOCHAPTERRELEASE:
+		return "MUSICALWORKVIDEOCHAPTERRELEASE"
+	case ReleaseType_RELEASE_TYPE_NEWS:
+		return "NEWS"
+	case ReleaseType_RELEASE_TYPE_NONMUSICALWORKBASEDGAMERELEASE:
+		return "NONMUSICALWORKBASEDGAMERELEASE"
+	case ReleaseType_RELEASE_TYPE_NONMUSICALWORKCLIPRELEASE:
+		return "NONMUSICALWORKCLIPRELEASE"
+	case ReleaseType_RELEASE_TYPE_NONMUSICALWORKREADALONGVIDEORELEASE:
+		return "NONMUSICALWORKREADALONGVIDEORELEASE"
+	case ReleaseType_RELEASE_TYPE_NONMUSICALWORKTRAILERRELEASE:
+		return "NONMUSICALWORKTRAILERRELEASE"
+	case ReleaseType_RELEASE_TYPE_NONMUSICALWORKVIDEOCHAPTERRELEASE:
+		return "NONMUSICALWORKVIDEOCHAPTERRELEASE"
+	case ReleaseType_RELEASE_TYPE_NONSERIALAUDIOVISUALRECORDING:
+		return "NONSERIALAUDIOVISUALRECORDING"
+	case ReleaseType_RELEASE_TYPE_PHOTOGRAPHRELEASE:
+		return "PHOTOGRAPHRELEASE"
+	case ReleaseType_RELEASE_TYPE_RINGBACKTONERELEASE:
+		return "RINGBACKTONERELEASE"
+	case ReleaseType_RELEASE_TYPE_RINGTONERELEASE:
+		return "RINGTONERELEASE"
+	case ReleaseType_RELEASE_TYPE_SCREENSAVERRELEASE:
+		return "SCREENSAVERRELEASE"
+	case ReleaseType_RELEASE_TYPE_SEASON:
+		return "SEASON"
+	case ReleaseType_RELEASE_TYPE_SERIES:
+		return "SERIES"
+	case ReleaseType_RELEASE_TYPE_SHEETMUSICRELEASE:
+		return "SHEETMUSICRELEASE"
+	case ReleaseType_RELEASE_TYPE_SHORTFORMMUSICALWORKVIDEORELEASE:
+		return "SHORTFORMMUSICALWORKVIDEORELEASE"
+	case ReleaseType_RELEASE_TYPE_SHORTFORMNONMUSICALWORKVIDEORELEASE:
+		return "SHORTFORMNONMUSICALWORKVIDEORELEASE"
+	case ReleaseType_RELEASE_TYPE_SINGLE:
+		return "SINGLE"
+	case ReleaseType_RELEASE_TYPE_SINGLERESOURCERELEASE:
+		return "SINGLERESOURCERELEASE"
+	case ReleaseType_RELEASE_TYPE_SINGLERESOURCERELEASEWITHCOVERART:
+		return "SINGLERESOURCERELEASEWITHCOVERART"
+	case ReleaseType_RELEASE_TYPE_TRACKRELEASE:
+		return "TRACKRELEASE"
+	case ReleaseType_RELEASE_TYPE_TRAILERVIDEO:
+		return "TRAILERVIDEO"
+	case ReleaseType_RELEASE_TYPE_TRAYIMAGERELEASE:
+		return "TRAYIMAGERELEASE"
+	case ReleaseType_RELEASE_TYPE_UNKNOWN:
+		return "UNKNOWN"
+	case ReleaseType_RELEASE_TYPE_USERDEFINED:
+		return "USERDEFINED"
+	case ReleaseType_RELEASE_TYPE_VIDEOALBUM:
+		return "VIDEOALBUM"
+	case ReleaseType_RELEASE_TYPE_VIDEOCHAPTERRELEASE:
+		return "VIDEOCHAPTERRELEASE"
+	case ReleaseType_RELEASE_TYPE_VIDEOCLIPRELEASE:
+		return "VIDEOCLIPRELEASE"
+	case ReleaseType_RELEASE_TYPE_VIDEOSCREENCAPTURERELEASE:
+		return "VIDEOSCREENCAPTURERELEASE"
+	case ReleaseType_RELEASE_TYPE_VIDEOSINGLE:
+		return "VIDEOSINGLE"
+	case ReleaseType_RELEASE_TYPE_VIDEOTRACKRELEASE:
+		return "VIDEOTRACKRELEASE"
+	case ReleaseType_RELEASE_TYPE_WALLPAPERRELEASE:
+		return "WALLPAPERRELEASE"
+	case ReleaseType_RELEASE_TYPE_AUDIOBOOKRELEASE:
+		return "AUDIOBOOKRELEASE"
+	case ReleaseType_RELEASE_TYPE_AUDIODRAMARELEASE:
+		return "AUDIODRAMARELEASE"
+	case ReleaseType_RELEASE_TYPE_CLASSICALDIGITALBOXEDSET:
+		return "CLASSICALDIGITALBOXEDSET"
+	case ReleaseType_RELEASE_TYPE_CLASSICALMULTIMEDIAALBUM:
+		return "CLASSICALMULTIMEDIAALBUM"
+	case ReleaseType_RELEASE_TYPE_DJMIX:
+		return "DJMIX"
+	case ReleaseType_RELEASE_TYPE_DRAMA:
+		return "DRAMA"
+	case ReleaseType_RELEASE_TYPE_DRAMATICOMUSICALVIDEORELEASE:
+		return "DRAMATICOMUSICALVIDEORELEASE"
+	case ReleaseType_RELEASE_TYPE_MULTIMEDIADIGITALBOXEDSET:
+		return "MULTIMEDIADIGITALBOXEDSET"
+	case ReleaseType_RELEASE_TYPE_PLAYLIST:
+		return "PLAYLIST"
+	case ReleaseType_RELEASE_TYPE_SHORTFILM:
+		return "SHORTFILM"
+	case ReleaseType_RELEASE_TYPE_STEMBUNDLE:
+		return "STEMBUNDLE"
+	case ReleaseType_RELEASE_TYPE_VIDEOMASTERTONERELEASE:
+		return "VIDEOMASTERTONERELEASE"
 	default:
 		return ""
 	}
@@ -1688,6 +14694,170 @@ func (e ReleaseType) XMLString() string {
 func ParseReleaseTypeString(s string) (ReleaseType, bool) {
 	s = strings.ToUpper(s)
 	switch s {
+	case "ADVERTISEMENTVIDEO":
+		return ReleaseType_RELEASE_TYPE_ADVERTISEMENTVIDEO, true
+	case "ALBUM":
+		return ReleaseType_RELEASE_TYPE_ALBUM, true
+	case "ALERTTONERELEASE":
+		return ReleaseType_RELEASE_TYPE_ALERTTONERELEASE, true
+	case "ANIMATION":
+		return ReleaseType_RELEASE_TYPE_ANIMATION, true
+	case "ASPERCONTRACT":
+		return ReleaseType_RELEASE_TYPE_ASPERCONTRACT, true
+	case "AUDIOCLIPRELEASE":
+		return ReleaseType_RELEASE_TYPE_AUDIOCLIPRELEASE, true
+	case "BACKCOVERIMAGERELEASE":
+		return ReleaseType_RELEASE_TYPE_BACKCOVERIMAGERELEASE, true
+	case "BOOKLETBACKIMAGERELEASE":
+		return ReleaseType_RELEASE_TYPE_BOOKLETBACKIMAGERELEASE, true
+	case "BOOKLETFRONTIMAGERELEASE":
+		return ReleaseType_RELEASE_TYPE_BOOKLETFRONTIMAGERELEASE, true
+	case "BOOKLETRELEASE":
+		return ReleaseType_RELEASE_TYPE_BOOKLETRELEASE, true
+	case "BUNDLE":
+		return ReleaseType_RELEASE_TYPE_BUNDLE, true
+	case "CLASSICALALBUM":
+		return ReleaseType_RELEASE_TYPE_CLASSICALALBUM, true
+	case "CONCERTVIDEO":
+		return ReleaseType_RELEASE_TYPE_CONCERTVIDEO, true
+	case "CORPORATEFILM":
+		return ReleaseType_RELEASE_TYPE_CORPORATEFILM, true
+	case "DIGITALBOXSETRELEASE":
+		return ReleaseType_RELEASE_TYPE_DIGITALBOXSETRELEASE, true
+	case "DOCUMENTARY":
+		return ReleaseType_RELEASE_TYPE_DOCUMENTARY, true
+	case "DOCUMENTIMAGERELEASE":
+		return ReleaseType_RELEASE_TYPE_DOCUMENTIMAGERELEASE, true
+	case "EBOOKRELEASE":
+		return ReleaseType_RELEASE_TYPE_EBOOKRELEASE, true
+	case "EP":
+		return ReleaseType_RELEASE_TYPE_EP, true
+	case "EPISODE":
+		return ReleaseType_RELEASE_TYPE_EPISODE, true
+	case "FEATUREFILM":
+		return ReleaseType_RELEASE_TYPE_FEATUREFILM, true
+	case "FILMBUNDLE":
+		return ReleaseType_RELEASE_TYPE_FILMBUNDLE, true
+	case "FRONTCOVERIMAGERELEASE":
+		return ReleaseType_RELEASE_TYPE_FRONTCOVERIMAGERELEASE, true
+	case "ICONRELEASE":
+		return ReleaseType_RELEASE_TYPE_ICONRELEASE, true
+	case "INFOMERCIALVIDEO":
+		return ReleaseType_RELEASE_TYPE_INFOMERCIALVIDEO, true
+	case "INTERACTIVEBOOKLETRELEASE":
+		return ReleaseType_RELEASE_TYPE_INTERACTIVEBOOKLETRELEASE, true
+	case "KARAOKERELEASE":
+		return ReleaseType_RELEASE_TYPE_KARAOKERELEASE, true
+	case "LIVEEVENTVIDEO":
+		return ReleaseType_RELEASE_TYPE_LIVEEVENTVIDEO, true
+	case "LOGORELEASE":
+		return ReleaseType_RELEASE_TYPE_LOGORELEASE, true
+	case "LONGFORMMUSICALWORKVIDEORELEASE":
+		return ReleaseType_RELEASE_TYPE_LONGFORMMUSICALWORKVIDEORELEASE, true
+	case "LONGFORMNONMUSICALWORKVIDEORELEASE":
+		return ReleaseType_RELEASE_TYPE_LONGFORMNONMUSICALWORKVIDEORELEASE, true
+	case "LYRICSHEETRELEASE":
+		return ReleaseType_RELEASE_TYPE_LYRICSHEETRELEASE, true
+	case "MULTIMEDIAALBUM":
+		return ReleaseType_RELEASE_TYPE_MULTIMEDIAALBUM, true
+	case "MULTIMEDIASINGLE":
+		return ReleaseType_RELEASE_TYPE_MULTIMEDIASINGLE, true
+	case "MUSICALWORKBASEDGAMERELEASE":
+		return ReleaseType_RELEASE_TYPE_MUSICALWORKBASEDGAMERELEASE, true
+	case "MUSICALWORKCLIPRELEASE":
+		return ReleaseType_RELEASE_TYPE_MUSICALWORKCLIPRELEASE, true
+	case "MUSICALWORKREADALONGVIDEORELEASE":
+		return ReleaseType_RELEASE_TYPE_MUSICALWORKREADALONGVIDEORELEASE, true
+	case "MUSICALWORKTRAILERRELEASE":
+		return ReleaseType_RELEASE_TYPE_MUSICALWORKTRAILERRELEASE, true
+	case "MUSICALWORKVIDEOCHAPTERRELEASE":
+		return ReleaseType_RELEASE_TYPE_MUSICALWORKVIDEOCHAPTERRELEASE, true
+	case "NEWS":
+		return ReleaseType_RELEASE_TYPE_NEWS, true
+	case "NONMUSICALWORKBASEDGAMERELEASE":
+		return ReleaseType_RELEASE_TYPE_NONMUSICALWORKBASEDGAMERELEASE, true
+	case "NONMUSICALWORKCLIPRELEASE":
+		return ReleaseType_RELEASE_TYPE_NONMUSICALWORKCLIPRELEASE, true
+	case "NONMUSICALWORKREADALONGVIDEORELEASE":
+		return ReleaseType_RELEASE_TYPE_NONMUSICALWORKREADALONGVIDEORELEASE, true
+	case "NONMUSICALWORKTRAILERRELEASE":
+		return ReleaseType_RELEASE_TYPE_NONMUSICALWORKTRAILERRELEASE, true
+	case "NONMUSICALWORKVIDEOCHAPTERRELEASE":
+		return ReleaseType_RELEASE_TYPE_NONMUSICALWORKVIDEOCHAPTERRELEASE, true
+	case "NONSERIALAUDIOVISUALRECORDING":
+		return ReleaseType_RELEASE_TYPE_NONSERIALAUDIOVISUALRECORDING, true
+	case "PHOTOGRAPHRELEASE":
+		return ReleaseType_RELEASE_TYPE_PHOTOGRAPHRELEASE, true
+	case "RINGBACKTONERELEASE":
+		return ReleaseType_RELEASE_TYPE_RINGBACKTONERELEASE, true
+	case "RINGTONERELEASE":
+		return ReleaseType_RELEASE_TYPE_RINGTONERELEASE, true
+	case "SCREENSAVERRELEASE":
+		return ReleaseType_RELEASE_TYPE_SCREENSAVERRELEASE, true
+	case "SEASON":
+		return ReleaseType_RELEASE_TYPE_SEASON, true
+	case "SERIES":
+		return ReleaseType_RELEASE_TYPE_SERIES, true
+	case "SHEETMUSICRELEASE":
+		return ReleaseType_RELEASE_TYPE_SHEETMUSICRELEASE, true
+	case "SHORTFORMMUSICALWORKVIDEORELEASE":
+		return ReleaseType_RELEASE_TYPE_SHORTFORMMUSICALWORKVIDEORELEASE, true
+	case "SHORTFORMNONMUSICALWORKVIDEORELEASE":
+		return ReleaseType_RELEASE_TYPE_SHORTFORMNONMUSICALWORKVIDEORELEASE, true
+	case "SINGLE":
+		return ReleaseType_RELEASE_TYPE_SINGLE, true
+	case "SINGLERESOURCERELEASE":
+		return ReleaseType_RELEASE_TYPE_SINGLERESOURCERELEASE, true
+	case "SINGLERESOURCERELEASEWITHCOVERART":
+		return ReleaseType_RELEASE_TYPE_SINGLERESOURCERELEASEWITHCOVERART, true
+	case "TRACKRELEASE":
+		return ReleaseType_RELEASE_TYPE_TRACKRELEASE, true
+	case "TRAILERVIDEO":
+		return ReleaseType_RELEASE_TYPE_TRAILERVIDEO, true
+	case "TRAYIMAGERELEASE":
+		return ReleaseType_RELEASE_TYPE_TRAYIMAGERELEASE, true
+	case "UNKNOWN":
+		return ReleaseType_RELEASE_TYPE_UNKNOWN, true
+	case "USERDEFINED":
+		return ReleaseType_RELEASE_TYPE_USERDEFINED, true
+	case "VIDEOALBUM":
+		return ReleaseType_RELEASE_TYPE_VIDEOALBUM, true
+	case "VIDEOCHAPTERRELEASE":
+		return ReleaseType_RELEASE_TYPE_VIDEOCHAPTERRELEASE, true
+	case "VIDEOCLIPRELEASE":
+		return ReleaseType_RELEASE_TYPE_VIDEOCLIPRELEASE, true
+	case "VIDEOSCREENCAPTURERELEASE":
+		return ReleaseType_RELEASE_TYPE_VIDEOSCREENCAPTURERELEASE, true
+	case "VIDEOSINGLE":
+		return ReleaseType_RELEASE_TYPE_VIDEOSINGLE, true
+	case "VIDEOTRACKRELEASE":
+		return ReleaseType_RELEASE_TYPE_VIDEOTRACKRELEASE, true
+	case "WALLPAPERRELEASE":
+		return ReleaseType_RELEASE_TYPE_WALLPAPERRELEASE, true
+	case "AUDIOBOOKRELEASE":
+		return ReleaseType_RELEASE_TYPE_AUDIOBOOKRELEASE, true
+	case "AUDIODRAMARELEASE":
+		return ReleaseType_RELEASE_TYPE_AUDIODRAMARELEASE, true
+	case "CLASSICALDIGITALBOXEDSET":
+		return ReleaseType_RELEASE_TYPE_CLASSICALDIGITALBOXEDSET, true
+	case "CLASSICALMULTIMEDIAALBUM":
+		return ReleaseType_RELEASE_TYPE_CLASSICALMULTIMEDIAALBUM, true
+	case "DJMIX":
+		return ReleaseType_RELEASE_TYPE_DJMIX, true
+	case "DRAMA":
+		return ReleaseType_RELEASE_TYPE_DRAMA, true
+	case "DRAMATICOMUSICALVIDEORELEASE":
+		return ReleaseType_RELEASE_TYPE_DRAMATICOMUSICALVIDEORELEASE, true
+	case "MULTIMEDIADIGITALBOXEDSET":
+		return ReleaseType_RELEASE_TYPE_MULTIMEDIADIGITALBOXEDSET, true
+	case "PLAYLIST":
+		return ReleaseType_RELEASE_TYPE_PLAYLIST, true
+	case "SHORTFILM":
+		return ReleaseType_RELEASE_TYPE_SHORTFILM, true
+	case "STEMBUNDLE":
+		return ReleaseType_RELEASE_TYPE_STEMBUNDLE, true
+	case "VIDEOMASTERTONERELEASE":
+		return ReleaseType_RELEASE_TYPE_VIDEOMASTERTONERELEASE, true
 	default:
 		return ReleaseType(0), false
 	}
@@ -1696,6 +14866,20 @@ func ParseReleaseTypeString(s string) (ReleaseType, bool) {
 // XMLString returns the XML string representation of ReportFormat
 func (e ReportFormat) XMLString() string {
 	switch e {
+	case ReportFormat_REPORT_FORMAT_ASCII:
+		return "ASCII"
+	case ReportFormat_REPORT_FORMAT_CSV:
+		return "CSV"
+	case ReportFormat_REPORT_FORMAT_EXCEL2000:
+		return "EXCEL2000"
+	case ReportFormat_REPORT_FORMAT_EXCEL2007:
+		return "EXCEL2007"
+	case ReportFormat_REPORT_FORMAT_EXCEL2010:
+		return "EXCEL2010"
+	case ReportFormat_REPORT_FORMAT_USERDEFINED:
+		return "USERDEFINED"
+	case ReportFormat_REPORT_FORMAT_XML:
+		return "XML"
 	default:
 		return ""
 	}
@@ -1705,6 +14889,20 @@ func (e ReportFormat) XMLString() string {
 func ParseReportFormatString(s string) (ReportFormat, bool) {
 	s = strings.ToUpper(s)
 	switch s {
+	case "ASCII":
+		return ReportFormat_REPORT_FORMAT_ASCII, true
+	case "CSV":
+		return ReportFormat_REPORT_FORMAT_CSV, true
+	case "EXCEL2000":
+		return ReportFormat_REPORT_FORMAT_EXCEL2000, true
+	case "EXCEL2007":
+		return ReportFormat_REPORT_FORMAT_EXCEL2007, true
+	case "EXCEL2010":
+		return ReportFormat_REPORT_FORMAT_EXCEL2010, true
+	case "USERDEFINED":
+		return ReportFormat_REPORT_FORMAT_USERDEFINED, true
+	case "XML":
+		return ReportFormat_REPORT_FORMAT_XML, true
 	default:
 		return ReportFormat(0), false
 	}
@@ -1713,6 +14911,32 @@ func ParseReportFormatString(s string) (ReportFormat, bool) {
 // XMLString returns the XML string representation of ReportType
 func (e ReportType) XMLString() string {
 	switch e {
+	case ReportType_REPORT_TYPE_DELIVERYFREQUENCYREQUESTCALL:
+		return "DELIVERYFREQUENCYREQUESTCALL"
+	case ReportType_REPORT_TYPE_INFORMATIONABOUTDELIVEREDANDAVAILABLERELEASESCALL:
+		return "INFORMATIONABOUTDELIVEREDANDAVAILABLERELEASESCALL"
+	case ReportType_REPORT_TYPE_ORDEREDRELEASESINQUEUEREQUESTCALL:
+		return "ORDEREDRELEASESINQUEUEREQUESTCALL"
+	case ReportType_REPORT_TYPE_REDELIVERYREQUESTCALL:
+		return "REDELIVERYREQUESTCALL"
+	case ReportType_REPORT_TYPE_RELEASEAVAILABILITYCALL:
+		return "RELEASEAVAILABILITYCALL"
+	case ReportType_REPORT_TYPE_RELEASEAVAILABILITYREQUESTCALL:
+		return "RELEASEAVAILABILITYREQUESTCALL"
+	case ReportType_REPORT_TYPE_RELEASESTATUSINFORMATIONCALL:
+		return "RELEASESTATUSINFORMATIONCALL"
+	case ReportType_REPORT_TYPE_RELEASESTATUSREQUESTCALL:
+		return "RELEASESTATUSREQUESTCALL"
+	case ReportType_REPORT_TYPE_RELEASESUPPLYCHAINREQUESTCALL:
+		return "RELEASESUPPLYCHAINREQUESTCALL"
+	case ReportType_REPORT_TYPE_REPORTDELIVERYCALL:
+		return "REPORTDELIVERYCALL"
+	case ReportType_REPORT_TYPE_REPORTREQUESTCALL:
+		return "REPORTREQUESTCALL"
+	case ReportType_REPORT_TYPE_SUPPLYCHAINSTATUSCALL:
+		return "SUPPLYCHAINSTATUSCALL"
+	case ReportType_REPORT_TYPE_USERDEFINED:
+		return "USERDEFINED"
 	default:
 		return ""
 	}
@@ -1722,6 +14946,32 @@ func (e ReportType) XMLString() string {
 func ParseReportTypeString(s string) (ReportType, bool) {
 	s = strings.ToUpper(s)
 	switch s {
+	case "DELIVERYFREQUENCYREQUESTCALL":
+		return ReportType_REPORT_TYPE_DELIVERYFREQUENCYREQUESTCALL, true
+	case "INFORMATIONABOUTDELIVEREDANDAVAILABLERELEASESCALL":
+		return ReportType_REPORT_TYPE_INFORMATIONABOUTDELIVEREDANDAVAILABLERELEASESCALL, true
+	case "ORDEREDRELEASESINQUEUEREQUESTCALL":
+		return ReportType_REPORT_TYPE_ORDEREDRELEASESINQUEUEREQUESTCALL, true
+	case "REDELIVERYREQUESTCALL":
+		return ReportType_REPORT_TYPE_REDELIVERYREQUESTCALL, true
+	case "RELEASEAVAILABILITYCALL":
+		return ReportType_REPORT_TYPE_RELEASEAVAILABILITYCALL, true
+	case "RELEASEAVAILABILITYREQUESTCALL":
+		return ReportType_REPORT_TYPE_RELEASEAVAILABILITYREQUESTCALL, true
+	case "RELEASESTATUSINFORMATIONCALL":
+		return ReportType_REPORT_TYPE_RELEASESTATUSINFORMATIONCALL, true
+	case "RELEASESTATUSREQUESTCALL":
+		return ReportType_REPORT_TYPE_RELEASESTATUSREQUESTCALL, true
+	case "RELEASESUPPLYCHAINREQUESTCALL":
+		return ReportType_REPORT_TYPE_RELEASESUPPLYCHAINREQUESTCALL, true
+	case "REPORTDELIVERYCALL":
+		return ReportType_REPORT_TYPE_REPORTDELIVERYCALL, true
+	case "REPORTREQUESTCALL":
+		return ReportType_REPORT_TYPE_REPORTREQUESTCALL, true
+	case "SUPPLYCHAINSTATUSCALL":
+		return ReportType_REPORT_TYPE_SUPPLYCHAINSTATUSCALL, true
+	case "USERDEFINED":
+		return ReportType_REPORT_TYPE_USERDEFINED, true
 	default:
 		return ReportType(0), false
 	}
@@ -1730,6 +14980,30 @@ func ParseReportTypeString(s string) (ReportType, bool) {
 // XMLString returns the XML string representation of RequestReason
 func (e RequestReason) XMLString() string {
 	switch e {
+	case RequestReason_REQUEST_REASON_USERDEFINED:
+		return "USERDEFINED"
+	case RequestReason_REQUEST_REASON_DISPUTERESOLUTIONREQUEST:
+		return "DISPUTERESOLUTIONREQUEST"
+	case RequestReason_REQUEST_REASON_GENERALREQUEST:
+		return "GENERALREQUEST"
+	case RequestReason_REQUEST_REASON_PUBLISHERADDITION:
+		return "PUBLISHERADDITION"
+	case RequestReason_REQUEST_REASON_PUBLISHERCHANGE:
+		return "PUBLISHERCHANGE"
+	case RequestReason_REQUEST_REASON_PUBLISHERREMOVAL:
+		return "PUBLISHERREMOVAL"
+	case RequestReason_REQUEST_REASON_RECALL:
+		return "RECALL"
+	case RequestReason_REQUEST_REASON_RELEASELISTUPDATE:
+		return "RELEASELISTUPDATE"
+	case RequestReason_REQUEST_REASON_SPECIFICREQUEST:
+		return "SPECIFICREQUEST"
+	case RequestReason_REQUEST_REASON_WRITERADDITION:
+		return "WRITERADDITION"
+	case RequestReason_REQUEST_REASON_WRITERCHANGE:
+		return "WRITERCHANGE"
+	case RequestReason_REQUEST_REASON_WRITERREMOVAL:
+		return "WRITERREMOVAL"
 	default:
 		return ""
 	}
@@ -1739,6 +15013,30 @@ func (e RequestReason) XMLString() string {
 func ParseRequestReasonString(s string) (RequestReason, bool) {
 	s = strings.ToUpper(s)
 	switch s {
+	case "USERDEFINED":
+		return RequestReason_REQUEST_REASON_USERDEFINED, true
+	case "DISPUTERESOLUTIONREQUEST":
+		return RequestReason_REQUEST_REASON_DISPUTERESOLUTIONREQUEST, true
+	case "GENERALREQUEST":
+		return RequestReason_REQUEST_REASON_GENERALREQUEST, true
+	case "PUBLISHERADDITION":
+		return RequestReason_REQUEST_REASON_PUBLISHERADDITION, true
+	case "PUBLISHERCHANGE":
+		return RequestReason_REQUEST_REASON_PUBLISHERCHANGE, true
+	case "PUBLISHERREMOVAL":
+		return RequestReason_REQUEST_REASON_PUBLISHERREMOVAL, true
+	case "RECALL":
+		return RequestReason_REQUEST_REASON_RECALL, true
+	case "RELEASELISTUPDATE":
+		return RequestReason_REQUEST_REASON_RELEASELISTUPDATE, true
+	case "SPECIFICREQUEST":
+		return RequestReason_REQUEST_REASON_SPECIFICREQUEST, true
+	case "WRITERADDITION":
+		return RequestReason_REQUEST_REASON_WRITERADDITION, true
+	case "WRITERCHANGE":
+		return RequestReason_REQUEST_REASON_WRITERCHANGE, true
+	case "WRITERREMOVAL":
+		return RequestReason_REQUEST_REASON_WRITERREMOVAL, true
 	default:
 		return RequestReason(0), false
 	}
@@ -1747,6 +15045,16 @@ func ParseRequestReasonString(s string) (RequestReason, bool) {
 // XMLString returns the XML string representation of RequestedActionType
 func (e RequestedActionType) XMLString() string {
 	switch e {
+	case RequestedActionType_REQUESTED_ACTION_TYPE_ADDITIONALINFORMATIONONLY:
+		return "ADDITIONALINFORMATIONONLY"
+	case RequestedActionType_REQUESTED_ACTION_TYPE_CORRECTANDINFORM:
+		return "CORRECTANDINFORM"
+	case RequestedActionType_REQUESTED_ACTION_TYPE_CORRECTANDRESEND:
+		return "CORRECTANDRESEND"
+	case RequestedActionType_REQUESTED_ACTION_TYPE_NOACTION:
+		return "NOACTION"
+	case RequestedActionType_REQUESTED_ACTION_TYPE_USERDEFINED:
+		return "USERDEFINED"
 	default:
 		return ""
 	}
@@ -1756,6 +15064,16 @@ func (e RequestedActionType) XMLString() string {
 func ParseRequestedActionTypeString(s string) (RequestedActionType, bool) {
 	s = strings.ToUpper(s)
 	switch s {
+	case "ADDITIONALINFORMATIONONLY":
+		return RequestedActionType_REQUESTED_ACTION_TYPE_ADDITIONALINFORMATIONONLY, true
+	case "CORRECTANDINFORM":
+		return RequestedActionType_REQUESTED_ACTION_TYPE_CORRECTANDINFORM, true
+	case "CORRECTANDRESEND":
+		return RequestedActionType_REQUESTED_ACTION_TYPE_CORRECTANDRESEND, true
+	case "NOACTION":
+		return RequestedActionType_REQUESTED_ACTION_TYPE_NOACTION, true
+	case "USERDEFINED":
+		return RequestedActionType_REQUESTED_ACTION_TYPE_USERDEFINED, true
 	default:
 		return RequestedActionType(0), false
 	}
@@ -1764,6 +15082,546 @@ func ParseRequestedActionTypeString(s string) (RequestedActionType, bool) {
 // XMLString returns the XML string representation of ResourceContributorRole
 func (e ResourceContributorRole) XMLString() string {
 	switch e {
+	case ResourceContributorRole_RESOURCE_CONTRIBUTOR_ROLE_ACCOMPANYIST:
+		return "ACCOMPANYIST"
+	case ResourceContributorRole_RESOURCE_CONTRIBUTOR_ROLE_ACTOR:
+		return "ACTOR"
+	case ResourceContributorRole_RESOURCE_CONTRIBUTOR_ROLE_ADDITIONALENGINEER:
+		return "ADDITIONALENGINEER"
+	case ResourceContributorRole_RESOURCE_CONTRIBUTOR_ROLE_ADDITIONALMIXINGENGINEER:
+		return "ADDITIONALMIXINGENGINEER"
+	case ResourceContributorRole_RESOURCE_CONTRIBUTOR_ROLE_ADDITIONALPERFORMER:
+		return "ADDITIONALPERFORMER"
+	case ResourceContributorRole_RESOURCE_CONTRIBUTOR_ROLE_ADDITIONALPROGRAMMINGENGINEER:
+		return "ADDITIONALPROGRAMMINGENGINEER"
+	case ResourceContributorRole_RESOURCE_CONTRIBUTOR_ROLE_ADDITIONALSTUDIOPRODUCER:
+		return "ADDITIONALSTUDIOPRODUCER"
+	case ResourceContributorRole_RESOURCE_CONTRIBUTOR_ROLE_ANCHORPERSON:
+		return "ANCHORPERSON"
+	case ResourceContributorRole_RESOURCE_CONTRIBUTOR_ROLE_ANIMALTRAINER:
+		return "ANIMALTRAINER"
+	case ResourceContributorRole_RESOURCE_CONTRIBUTOR_ROLE_ANIMATOR:
+		return "ANIMATOR"
+	case ResourceContributorRole_RESOURCE_CONTRIBUTOR_ROLE_ANNOTATOR:
+		return "ANNOTATOR"
+	case ResourceContributorRole_RESOURCE_CONTRIBUTOR_ROLE_ANNOUNCER:
+		return "ANNOUNCER"
+	case ResourceContributorRole_RESOURCE_CONTRIBUTOR_ROLE_AANDRADMINISTRATOR:
+		return "AANDRADMINISTRATOR"
+	case ResourceContributorRole_RESOURCE_CONTRIBUTOR_ROLE_AANDRCOORDINATOR:
+		return "AANDRCOORDINATOR"
+	case ResourceContributorRole_RESOURCE_CONTRIBUTOR_ROLE_ARMOURER:
+		return "ARMOURER"
+	case ResourceContributorRole_RESOURCE_CONTRIBUTOR_ROLE_ARTCOPYIST:
+		return "ARTCOPYIST"
+	case ResourceContributorRole_RESOURCE_CONTRIBUTOR_ROLE_ARTDIRECTOR:
+		return "ARTDIRECTOR"
+	case ResourceContributorRole_RESOURCE_CONTRIBUTOR_ROLE_ARTIST:
+		return "ARTIST"
+	case ResourceContributorRole_RESOURCE_CONTRIBUTOR_ROLE_ARTISTBACKGROUNDVOCALENGINEER:
+		return "ARTISTBACKGROUNDVOCALENGINEER"
+	case ResourceContributorRole_RESOURCE_CONTRIBUTOR_ROLE_ARTISTVOCALENGINEER:
+		return "ARTISTVOCALENGINEER"
+	case ResourceContributorRole_RESOURCE_CONTRIBUTOR_ROLE_ARTISTVOCALSECONDENGINEER:
+		return "ARTISTVOCALSECONDENGINEER"
+	case ResourceContributorRole_RESOURCE_CONTRIBUTOR_ROLE_ASSISTANTCAMERAOPERATOR:
+		return "ASSISTANTCAMERAOPERATOR"
+	case ResourceContributorRole_RESOURCE_CONTRIBUTOR_ROLE_ASSISTANTCHIEFLIGHTINGTECHNICIAN:
+		return "ASSISTANTCHIEFLIGHTINGTECHNICIAN"
+	case ResourceContributorRole_RESOURCE_CONTRIBUTOR_ROLE_ASSISTANTCONDUCTOR:
+		return "ASSISTANTCONDUCTOR"
+	case ResourceContributorRole_RESOURCE_CONTRIBUTOR_ROLE_ASSISTANTDIRECTOR:
+		return "ASSISTANTDIRECTOR"
+	case ResourceContributorRole_RESOURCE_CONTRIBUTOR_ROLE_ASSISTANTEDITOR:
+		return "ASSISTANTEDITOR"
+	case ResourceContributorRole_RESOURCE_CONTRIBUTOR_ROLE_ASSISTANTENGINEER:
+		return "ASSISTANTENGINEER"
+	case ResourceContributorRole_RESOURCE_CONTRIBUTOR_ROLE_ASSISTANTPRODUCER:
+		return "ASSISTANTPRODUCER"
+	case ResourceContributorRole_RESOURCE_CONTRIBUTOR_ROLE_ASSISTANTVISUALEDITOR:
+		return "ASSISTANTVISUALEDITOR"
+	case ResourceContributorRole_RESOURCE_CONTRIBUTOR_ROLE_ASSOCIATEDPERFORMER:
+		return "ASSOCIATEDPERFORMER"
+	case ResourceContributorRole_RESOURCE_CONTRIBUTOR_ROLE_ASSOCIATEPRODUCER:
+		return "ASSOCIATEPRODUCER"
+	case ResourceContributorRole_RESOURCE_CONTRIBUTOR_ROLE_AURALTRAINER:
+		return "AURALTRAINER"
+	case ResourceContributorRole_RESOURCE_CONTRIBUTOR_ROLE_BACKGROUNDVOCALIST:
+		return "BACKGROUNDVOCALIST"
+	case ResourceContributorRole_RESOURCE_CONTRIBUTOR_ROLE_BALANCEENGINEER:
+		return "BALANCEENGINEER"
+	case ResourceContributorRole_RESOURCE_CONTRIBUTOR_ROLE_BANDLEADER:
+		return "BANDLEADER"
+	case ResourceContributorRole_RESOURCE_CONTRIBUTOR_ROLE_BINDER:
+		return "BINDER"
+	case ResourceContributorRole_RESOURCE_CONTRIBUTOR_ROLE_BINDINGDESIGNER:
+		return "BINDINGDESIGNER"
+	case ResourceContributorRole_RESOURCE_CONTRIBUTOR_ROLE_BOOKDESIGNER:
+		return "BOOKDESIGNER"
+	case ResourceContributorRole_RESOURCE_CONTRIBUTOR_ROLE_BOOKJACKDESIGNER:
+		return "BOOKJACKDESIGNER"
+	case ResourceContributorRole_RESOURCE_CONTRIBUTOR_ROLE_BOOKPLATEDESIGNER:
+		return "BOOKPLATEDESIGNER"
+	case ResourceContributorRole_RESOURCE_CONTRIBUTOR_ROLE_BOOKPRODUCER:
+		return "BOOKPRODUCER"
+	case ResourceContributorRole_RESOURCE_CONTRIBUTOR_ROLE_BROADCASTASSISTANT:
+		return "BROADCASTASSISTANT"
+	case ResourceContributorRole_RESOURCE_CONTRIBUTOR_ROLE_BROADCASTJOURNALIST:
+		return "BROADCASTJOURNALIST"
+	case ResourceContributorRole_RESOURCE_CONTRIBUTOR_ROLE_CALLIGRAPHER:
+		return "CALLIGRAPHER"
+	case ResourceContributorRole_RESOURCE_CONTRIBUTOR_ROLE_CAMERAOPERATOR:
+		return "CAMERAOPERATOR"
+	case ResourceContributorRole_RESOURCE_CONTRIBUTOR_ROLE_CARPENTER:
+		return "CARPENTER"
+	case ResourceContributorRole_RESOURCE_CONTRIBUTOR_ROLE_CARTOGRAPHER:
+		return "CARTOGRAPHER"
+	case ResourceContributorRole_RESOURCE_CONTRIBUTOR_ROLE_CARTOONIST:
+		return "CARTOONIST"
+	case ResourceContributorRole_RESOURCE_CONTRIBUTOR_ROLE_CASTINGDIRECTOR:
+		return "CASTINGDIRECTOR"
+	case ResourceContributorRole_RESOURCE_CONTRIBUTOR_ROLE_CAUSEUR:
+		return "CAUSEUR"
+	case ResourceContributorRole_RESOURCE_CONTRIBUTOR_ROLE_CENSOR:
+		return "CENSOR"
+	case ResourceContributorRole_RESOURCE_CONTRIBUTOR_ROLE_CHIEFLIGHTINGTECHNICIAN:
+		return "CHIEFLIGHTINGTECHNICIAN"
+	case ResourceContributorRole_RESOURCE_CONTRIBUTOR_ROLE_CHOIR:
+		return "CHOIR"
+	case ResourceContributorRole_RESOURCE_CONTRIBUTOR_ROLE_CHOIRMEMBER:
+		return "CHOIRMEMBER"
+	case ResourceContributorRole_RESOURCE_CONTRIBUTOR_ROLE_CHOREOGRAPHER:
+		return "CHOREOGRAPHER"
+	case ResourceContributorRole_RESOURCE_CONTRIBUTOR_ROLE_CHORUSMASTER:
+		return "CHORUSMASTER"
+	case ResourceContributorRole_RESOURCE_CONTRIBUTOR_ROLE_CIRCUSARTIST:
+		return "CIRCUSARTIST"
+	case ResourceContributorRole_RESOURCE_CONTRIBUTOR_ROLE_CLAPPERLOADER:
+		return "CLAPPERLOADER"
+	case ResourceContributorRole_RESOURCE_CONTRIBUTOR_ROLE_CLUBDJ:
+		return "CLUBDJ"
+	case ResourceContributorRole_RESOURCE_CONTRIBUTOR_ROLE_CODIRECTOR:
+		return "CODIRECTOR"
+	case ResourceContributorRole_RESOURCE_CONTRIBUTOR_ROLE_COEXECUTIVEPRODUCER:
+		return "COEXECUTIVEPRODUCER"
+	case ResourceContributorRole_RESOURCE_CONTRIBUTOR_ROLE_COLORSEPARATOR:
+		return "COLORSEPARATOR"
+	case ResourceContributorRole_RESOURCE_CONTRIBUTOR_ROLE_COMEDIAN:
+		return "COMEDIAN"
+	case ResourceContributorRole_RESOURCE_CONTRIBUTOR_ROLE_COMIXER:
+		return "COMIXER"
+	case ResourceContributorRole_RESOURCE_CONTRIBUTOR_ROLE_COMIXINGENGINEER:
+		return "COMIXINGENGINEER"
+	case ResourceContributorRole_RESOURCE_CONTRIBUTOR_ROLE_COMMENTATOR:
+		return "COMMENTATOR"
+	case ResourceContributorRole_RESOURCE_CONTRIBUTOR_ROLE_COMMISSIONINGBROADCASTER:
+		return "COMMISSIONINGBROADCASTER"
+	case ResourceContributorRole_RESOURCE_CONTRIBUTOR_ROLE_COMPILATIONPRODUCER:
+		return "COMPILATIONPRODUCER"
+	case ResourceContributorRole_RESOURCE_CONTRIBUTOR_ROLE_COMPUTERGRAPHICCREATOR:
+		return "COMPUTERGRAPHICCREATOR"
+	case ResourceContributorRole_RESOURCE_CONTRIBUTOR_ROLE_COMPUTERPROGRAMMER:
+		return "COMPUTERPROGRAMMER"
+	case ResourceContributorRole_RESOURCE_CONTRIBUTOR_ROLE_CONCERTMASTER:
+		return "CONCERTMASTER"
+	case ResourceContributorRole_RESOURCE_CONTRIBUTOR_ROLE_CONDUCTOR:
+		return "CONDUCTOR"
+	case ResourceContributorRole_RESOURCE_CONTRIBUTOR_ROLE_CONSULTANT:
+		return "CONSULTANT"
+	case ResourceContributorRole_RESOURCE_CONTRIBUTOR_ROLE_CONTINUITYCHECKER:
+		return "CONTINUITYCHECKER"
+	case ResourceContributorRole_RESOURCE_CONTRIBUTOR_ROLE_CONTRACTOR:
+		return "CONTRACTOR"
+	case ResourceContributorRole_RESOURCE_CONTRIBUTOR_ROLE_COPRODUCER:
+		return "COPRODUCER"
+	case ResourceContributorRole_RESOURCE_CONTRIBUTOR_ROLE_CORRESPONDENT:
+		return "CORRESPONDENT"
+	case ResourceContributorRole_RESOURCE_CONTRIBUTOR_ROLE_COSTUMEDESIGNER:
+		return "COSTUMEDESIGNER"
+	case ResourceContributorRole_RESOURCE_CONTRIBUTOR_ROLE_COVERDESIGNER:
+		return "COVERDESIGNER"
+	case ResourceContributorRole_RESOURCE_CONTRIBUTOR_ROLE_DANCER:
+		return "DANCER"
+	case ResourceContributorRole_RESOURCE_CONTRIBUTOR_ROLE_DELINEATOR:
+		return "DELINEATOR"
+	case ResourceContributorRole_RESOURCE_CONTRIBUTOR_ROLE_DESIGNER:
+		return "DESIGNER"
+	case ResourceContributorRole_RESOURCE_CONTRIBUTOR_ROLE_DIALOGUECOACH:
+		return "DIALOGUECOACH"
+	case ResourceContributorRole_RESOURCE_CONTRIBUTOR_ROLE_DIALOGUEDIRECTOR:
+		return "DIALOGUEDIRECTOR"
+	case ResourceContributorRole_RESOURCE_CONTRIBUTOR_ROLE_DIGITALAUDIOWORKSTATIONENGINEER:
+		return "DIGITALAUDIOWORKSTATIONENGINEER"
+	case ResourceContributorRole_RESOURCE_CONTRIBUTOR_ROLE_DIGITALEDITINGENGINEER:
+		return "DIGITALEDITINGENGINEER"
+	case ResourceContributorRole_RESOURCE_CONTRIBUTOR_ROLE_DIGITALEDITINGSECONDENGINEER:
+		return "DIGITALEDITINGSECONDENGINEER"
+	case ResourceContributorRole_RESOURCE_CONTRIBUTOR_ROLE_DIRECTOR:
+		return "DIRECTOR"
+	case ResourceContributorRole_RESOURCE_CONTRIBUTOR_ROLE_DIRECTSTREAMDIGITALENGINEER:
+		return "DIRECTSTREAMDIGITALENGINEER"
+	case ResourceContributorRole_RESOURCE_CONTRIBUTOR_ROLE_DISTRIBUTIONCOMPANY:
+		return "DISTRIBUTIONCOMPANY"
+	case ResourceContributorRole_RESOURCE_CONTRIBUTOR_ROLE_DJ:
+		return "DJ"
+	case ResourceContributorRole_RESOURCE_CONTRIBUTOR_ROLE_DRAUGHTSMAN:
+		return "DRAUGHTSMAN"
+	case ResourceContributorRole_RESOURCE_CONTRIBUTOR_ROLE_DRESSER:
+		return "DRESSER"
+	case ResourceContributorRole_RESOURCE_CONTRIBUTOR_ROLE_DUBBER:
+		return "DUBBER"
+	case ResourceContributorRole_RESOURCE_CONTRIBUTOR_ROLE_EDITOR:
+		return "EDITOR"
+	case ResourceContributorRole_RESOURCE_CONTRIBUTOR_ROLE_EDITORINCHIEF:
+		return "EDITORINCHIEF"
+	case ResourceContributorRole_RESOURCE_CONTRIBUTOR_ROLE_EDITOROFTHEDAY:
+		return "EDITOROFTHEDAY"
+	case ResourceContributorRole_RESOURCE_CONTRIBUTOR_ROLE_ENCODER:
+		return "ENCODER"
+	case ResourceContributorRole_RESOURCE_CONTRIBUTOR_ROLE_ENGINEER:
+		return "ENGINEER"
+	case ResourceContributorRole_RESOURCE_CONTRIBUTOR_ROLE_ENSEMBLE:
+		return "ENSEMBLE"
+	case ResourceContributorRole_RESOURCE_CONTRIBUTOR_ROLE_EXECUTIVEPRODUCER:
+		return "EXECUTIVEPRODUCER"
+	case ResourceContributorRole_RESOURCE_CONTRIBUTOR_ROLE_EXPERT:
+		return "EXPERT"
+	case ResourceContributorRole_RESOURCE_CONTRIBUTOR_ROLE_FACSIMILIST:
+		return "FACSIMILIST"
+	case ResourceContributorRole_RESOURCE_CONTRIBUTOR_ROLE_FIGHTDIRECTOR:
+		return "FIGHTDIRECTOR"
+	case ResourceContributorRole_RESOURCE_CONTRIBUTOR_ROLE_FILMDIRECTOR:
+		return "FILMDIRECTOR"
+	case ResourceContributorRole_RESOURCE_CONTRIBUTOR_ROLE_FILMDISTRIBUTOR:
+		return "FILMDISTRIBUTOR"
+	case ResourceContributorRole_RESOURCE_CONTRIBUTOR_ROLE_FILMEDITOR:
+		return "FILMEDITOR"
+	case ResourceContributorRole_RESOURCE_CONTRIBUTOR_ROLE_FILMPRODUCER:
+		return "FILMPRODUCER"
+	case ResourceContributorRole_RESOURCE_CONTRIBUTOR_ROLE_FILMSOUNDENGINEER:
+		return "FILMSOUNDENGINEER"
+	case ResourceContributorRole_RESOURCE_CONTRIBUTOR_ROLE_FLOORMANAGER:
+		return "FLOORMANAGER"
+	case ResourceContributorRole_RESOURCE_CONTRIBUTOR_ROLE_FOCUSPULLER:
+		return "FOCUSPULLER"
+	case ResourceContributorRole_RESOURCE_CONTRIBUTOR_ROLE_FOLEYARTIST:
+		return "FOLEYARTIST"
+	case ResourceContributorRole_RESOURCE_CONTRIBUTOR_ROLE_FOLEYEDITOR:
+		return "FOLEYEDITOR"
+	case ResourceContributorRole_RESOURCE_CONTRIBUTOR_ROLE_FOLEYMIXER:
+		return "FOLEYMIXER"
+	case ResourceContributorRole_RESOURCE_CONTRIBUTOR_ROLE_GRAPHICARTIST:
+		return "GRAPHICARTIST"
+	case ResourceContributorRole_RESOURCE_CONTRIBUTOR_ROLE_GRAPHICASSISTANT:
+		return "GRAPHICASSISTANT"
+	case ResourceContributorRole_RESOURCE_CONTRIBUTOR_ROLE_GRAPHICDESIGNER:
+		return "GRAPHICDESIGNER"
+	case ResourceContributorRole_RESOURCE_CONTRIBUTOR_ROLE_GREENSMAN:
+		return "GREENSMAN"
+	case ResourceContributorRole_RESOURCE_CONTRIBUTOR_ROLE_GRIP:
+		return "GRIP"
+	case ResourceContributorRole_RESOURCE_CONTRIBUTOR_ROLE_GUESTCONDUCTOR:
+		return "GUESTCONDUCTOR"
+	case ResourceContributorRole_RESOURCE_CONTRIBUTOR_ROLE_GROUPMEMBER:
+		return "GROUPMEMBER"
+	case ResourceContributorRole_RESOURCE_CONTRIBUTOR_ROLE_HAIRDRESSER:
+		return "HAIRDRESSER"
+	case ResourceContributorRole_RESOURCE_CONTRIBUTOR_ROLE_ILLUSTRATOR:
+		return "ILLUSTRATOR"
+	case ResourceContributorRole_RESOURCE_CONTRIBUTOR_ROLE_INITIALPRODUCER:
+		return "INITIALPRODUCER"
+	case ResourceContributorRole_RESOURCE_CONTRIBUTOR_ROLE_INTERVIEWEDGUEST:
+		return "INTERVIEWEDGUEST"
+	case ResourceContributorRole_RESOURCE_CONTRIBUTOR_ROLE_INTERVIEWER:
+		return "INTERVIEWER"
+	case ResourceContributorRole_RESOURCE_CONTRIBUTOR_ROLE_KEYCHARACTER:
+		return "KEYCHARACTER"
+	case ResourceContributorRole_RESOURCE_CONTRIBUTOR_ROLE_KEYGRIP:
+		return "KEYGRIP"
+	case ResourceContributorRole_RESOURCE_CONTRIBUTOR_ROLE_KEYTALENT:
+		return "KEYTALENT"
+	case ResourceContributorRole_RESOURCE_CONTRIBUTOR_ROLE_LEADMAN:
+		return "LEADMAN"
+	case ResourceContributorRole_RESOURCE_CONTRIBUTOR_ROLE_LEADPERFORMER:
+		return "LEADPERFORMER"
+	case ResourceContributorRole_RESOURCE_CONTRIBUTOR_ROLE_LEADVOCALIST:
+		return "LEADVOCALIST"
+	case ResourceContributorRole_RESOURCE_CONTRIBUTOR_ROLE_LIGHTINGDIRECTOR:
+		return "LIGHTINGDIRECTOR"
+	case ResourceContributorRole_RESOURCE_CONTRIBUTOR_ROLE_LIGHTINGTECHNICIAN:
+		return "LIGHTINGTECHNICIAN"
+	case ResourceContributorRole_RESOURCE_CONTRIBUTOR_ROLE_LOCATIONMANAGER:
+		return "LOCATIONMANAGER"
+	case ResourceContributorRole_RESOURCE_CONTRIBUTOR_ROLE_MAKEUPARTIST:
+		return "MAKEUPARTIST"
+	case ResourceContributorRole_RESOURCE_CONTRIBUTOR_ROLE_MANUFACTURER:
+		return "MANUFACTURER"
+	case ResourceContributorRole_RESOURCE_CONTRIBUTOR_ROLE_MASTERINGENGINEER:
+		return "MASTERINGENGINEER"
+	case ResourceContributorRole_RESOURCE_CONTRIBUTOR_ROLE_MASTERINGSECONDENGINEER:
+		return "MASTERINGSECONDENGINEER"
+	case ResourceContributorRole_RESOURCE_CONTRIBUTOR_ROLE_MATTEARTIST:
+		return "MATTEARTIST"
+	case ResourceContributorRole_RESOURCE_CONTRIBUTOR_ROLE_MIXER:
+		return "MIXER"
+	case ResourceContributorRole_RESOURCE_CONTRIBUTOR_ROLE_MIXINGENGINEER:
+		return "MIXINGENGINEER"
+	case ResourceContributorRole_RESOURCE_CONTRIBUTOR_ROLE_MIXINGSECONDENGINEER:
+		return "MIXINGSECONDENGINEER"
+	case ResourceContributorRole_RESOURCE_CONTRIBUTOR_ROLE_MUSICARRANGER:
+		return "MUSICARRANGER"
+	case ResourceContributorRole_RESOURCE_CONTRIBUTOR_ROLE_MUSICCOPYIST:
+		return "MUSICCOPYIST"
+	case ResourceContributorRole_RESOURCE_CONTRIBUTOR_ROLE_MUSICDIRECTOR:
+		return "MUSICDIRECTOR"
+	case ResourceContributorRole_RESOURCE_CONTRIBUTOR_ROLE_MUSICGROUP:
+		return "MUSICGROUP"
+	case ResourceContributorRole_RESOURCE_CONTRIBUTOR_ROLE_MUSICIAN:
+		return "MUSICIAN"
+	case ResourceContributorRole_RESOURCE_CONTRIBUTOR_ROLE_NARRATOR:
+		return "NARRATOR"
+	case ResourceContributorRole_RESOURCE_CONTRIBUTOR_ROLE_NEWSPRODUCER:
+		return "NEWSPRODUCER"
+	case ResourceContributorRole_RESOURCE_CONTRIBUTOR_ROLE_NEWSREADER:
+		return "NEWSREADER"
+	case ResourceContributorRole_RESOURCE_CONTRIBUTOR_ROLE_NOTSPECIFIED:
+		return "NOTSPECIFIED"
+	case ResourceContributorRole_RESOURCE_CONTRIBUTOR_ROLE_ORCHESTRA:
+		return "ORCHESTRA"
+	case ResourceContributorRole_RESOURCE_CONTRIBUTOR_ROLE_ORCHESTRAMEMBER:
+		return "ORCHESTRAMEMBER"
+	case ResourceContributorRole_RESOURCE_CONTRIBUTOR_ROLE_ORIGINALARTIST:
+		return "ORIGINALARTIST"
+	case ResourceContributorRole_RESOURCE_CONTRIBUTOR_ROLE_OVERDUBENGINEER:
+		return "OVERDUBENGINEER"
+	case ResourceContributorRole_RESOURCE_CONTRIBUTOR_ROLE_OVERDUBSECONDENGINEER:
+		return "OVERDUBSECONDENGINEER"
+	case ResourceContributorRole_RESOURCE_CONTRIBUTOR_ROLE_PAINTER:
+		return "PAINTER"
+	case ResourceContributorRole_RESOURCE_CONTRIBUTOR_ROLE_PERFORMER:
+		return "PERFORMER"
+	case ResourceContributorRole_RESOURCE_CONTRIBUTOR_ROLE_PHOTOGRAPHER:
+		return "PHOTOGRAPHER"
+	case ResourceContributorRole_RESOURCE_CONTRIBUTOR_ROLE_PHOTOGRAPHYDIRECTOR:
+		return "PHOTOGRAPHYDIRECTOR"
+	case ResourceContributorRole_RESOURCE_CONTRIBUTOR_ROLE_PLAYBACKSINGER:
+		return "PLAYBACKSINGER"
+	case ResourceContributorRole_RESOURCE_CONTRIBUTOR_ROLE_POSTPRODUCER:
+		return "POSTPRODUCER"
+	case ResourceContributorRole_RESOURCE_CONTRIBUTOR_ROLE_PREPRODUCTION:
+		return "PREPRODUCTION"
+	case ResourceContributorRole_RESOURCE_CONTRIBUTOR_ROLE_PREPRODUCTIONENGINEER:
+		return "PREPRODUCTIONENGINEER"
+	case ResourceContributorRole_RESOURCE_CONTRIBUTOR_ROLE_PREPRODUCTIONSECONDENGINEER:
+		return "PREPRODUCTIONSECONDENGINEER"
+	case ResourceContributorRole_RESOURCE_CONTRIBUTOR_ROLE_PRIMARYMUSICIAN:
+		return "PRIMARYMUSICIAN"
+	case ResourceContributorRole_RESOURCE_CONTRIBUTOR_ROLE_PRODUCTIONASSISTANT:
+		return "PRODUCTIONASSISTANT"
+	case ResourceContributorRole_RESOURCE_CONTRIBUTOR_ROLE_PRODUCTIONCOMPANY:
+		return "PRODUCTIONCOMPANY"
+	case ResourceContributorRole_RESOURCE_CONTRIBUTOR_ROLE_PRODUCTIONCOORDINATOR:
+		return "PRODUCTIONCOORDINATOR"
+	case ResourceContributorRole_RESOURCE_CONTRIBUTOR_ROLE_PRODUCTIONDEPARTMENT:
+		return "PRODUCTIONDEPARTMENT"
+	case ResourceContributorRole_RESOURCE_CONTRIBUTOR_ROLE_PRODUCTIONMANAGER:
+		return "PRODUCTIONMANAGER"
+	case ResourceContributorRole_RESOURCE_CONTRIBUTOR_ROLE_PRODUCTIONSECRETARY:
+		return "PRODUCTIONSECRETARY"
+	case ResourceContributorRole_RESOURCE_CONTRIBUTOR_ROLE_PROJECTENGINEER:
+		return "PROJECTENGINEER"
+	case ResourceContributorRole_RESOURCE_CONTRIBUTOR_ROLE_PROGRAMMER:
+		return "PROGRAMMER"
+	case ResourceContributorRole_RESOURCE_CONTRIBUTOR_ROLE_PROGRAMMINGENGINEER:
+		return "PROGRAMMINGENGINEER"
+	case ResourceContributorRole_RESOURCE_CONTRIBUTOR_ROLE_PROGRAMPRODUCER:
+		return "PROGRAMPRODUCER"
+	case ResourceContributorRole_RESOURCE_CONTRIBUTOR_ROLE_PROPERTYMANAGER:
+		return "PROPERTYMANAGER"
+	case ResourceContributorRole_RESOURCE_CONTRIBUTOR_ROLE_PUBLISHINGDIRECTOR:
+		return "PUBLISHINGDIRECTOR"
+	case ResourceContributorRole_RESOURCE_CONTRIBUTOR_ROLE_PUPPETEER:
+		return "PUPPETEER"
+	case ResourceContributorRole_RESOURCE_CONTRIBUTOR_ROLE_PYROTECHNICIAN:
+		return "PYROTECHNICIAN"
+	case ResourceContributorRole_RESOURCE_CONTRIBUTOR_ROLE_RECORDINGENGINEER:
+		return "RECORDINGENGINEER"
+	case ResourceContributorRole_RESOURCE_CONTRIBUTOR_ROLE_RECORDINGSECONDENGINEER:
+		return "RECORDINGSECONDENGINEER"
+	case ResourceContributorRole_RESOURCE_CONTRIBUTOR_ROLE_REDACTOR:
+		return "REDACTOR"
+	case ResourceContributorRole_RESOURCE_CONTRIBUTOR_ROLE_REISSUEPRODUCER:
+		return "REISSUEPRODUCER"
+	case ResourceContributorRole_RESOURCE_CONTRIBUTOR_ROLE_REMIXEDARTIST:
+		return "REMIXEDARTIST"
+	case ResourceContributorRole_RESOURCE_CONTRIBUTOR_ROLE_REMIXER:
+		return "REMIXER"
+	case ResourceContributorRole_RESOURCE_CONTRIBUTOR_ROLE_REMIXINGENGINEER:
+		return "REMIXINGENGINEER"
+	case ResourceContributorRole_RESOURCE_CONTRIBUTOR_ROLE_REMIXINGSECONDENGINEER:
+		return "REMIXINGSECONDENGINEER"
+	case ResourceContributorRole_RESOURCE_CONTRIBUTOR_ROLE_REPETITEUR:
+		return "REPETITEUR"
+	case ResourceContributorRole_RESOURCE_CONTRIBUTOR_ROLE_RESEARCHER:
+		return "RESEARCHER"
+	case ResourceContributorRole_RESOURCE_CONTRIBUTOR_ROLE_RESEARCHTEAMHEAD:
+		return "RESEARCHTEAMHEAD"
+	case ResourceContributorRole_RESOURCE_CONTRIBUTOR_ROLE_RESEARCHTEAMMEMBER:
+		return "RESEARCHTEAMMEMBER"
+	case ResourceContributorRole_RESOURCE_CONTRIBUTOR_ROLE_RESTAGER:
+		return "RESTAGER"
+	case ResourceContributorRole_RESOURCE_CONTRIBUTOR_ROLE_RIGGER:
+		return "RIGGER"
+	case ResourceContributorRole_RESOURCE_CONTRIBUTOR_ROLE_RIGHTSCONTROLLERONPRODUCT:
+		return "RIGHTSCONTROLLERONPRODUCT"
+	case ResourceContributorRole_RESOURCE_CONTRIBUTOR_ROLE_RUNNER:
+		return "RUNNER"
+	case ResourceContributorRole_RESOURCE_CONTRIBUTOR_ROLE_SCENICOPERATIVE:
+		return "SCENICOPERATIVE"
+	case ResourceContributorRole_RESOURCE_CONTRIBUTOR_ROLE_SCIENTIFICADVISOR:
+		return "SCIENTIFICADVISOR"
+	case ResourceContributorRole_RESOURCE_CONTRIBUTOR_ROLE_SCRIPTSUPERVISOR:
+		return "SCRIPTSUPERVISOR"
+	case ResourceContributorRole_RESOURCE_CONTRIBUTOR_ROLE_SECONDASSISTANTCAMERAOPERATOR:
+		return "SECONDASSISTANTCAMERAOPERATOR"
+	case ResourceContributorRole_RESOURCE_CONTRIBUTOR_ROLE_SECONDASSISTANTDIRECTOR:
+		return "SECONDASSISTANTDIRECTOR"
+	case ResourceContributorRole_RESOURCE_CONTRIBUTOR_ROLE_SECONDCONDUCTOR:
+		return "SECONDCONDUCTOR"
+	case ResourceContributorRole_RESOURCE_CONTRIBUTOR_ROLE_SECONDENGINEER:
+		return "SECONDENGINEER"
+	case ResourceContributorRole_RESOURCE_CONTRIBUTOR_ROLE_SECONDUNITDIRECTOR:
+		return "SECONDUNITDIRECTOR"
+	case ResourceContributorRole_RESOURCE_CONTRIBUTOR_ROLE_SERIESPRODUCER:
+		return "SERIESPRODUCER"
+	case ResourceContributorRole_RESOURCE_CONTRIBUTOR_ROLE_SETDESIGNER:
+		return "SETDESIGNER"
+	case ResourceContributorRole_RESOURCE_CONTRIBUTOR_ROLE_SETDRESSER:
+		return "SETDRESSER"
+	case ResourceContributorRole_RESOURCE_CONTRIBUTOR_ROLE_SIGNLANGUAGEINTERPRETER:
+		return "SIGNLANGUAGEINTERPRETER"
+	case ResourceContributorRole_RESOURCE_CONTRIBUTOR_ROLE_SOLOIST:
+		return "SOLOIST"
+	case ResourceContributorRole_RESOURCE_CONTRIBUTOR_ROLE_SOUNDDESIGNER:
+		return "SOUNDDESIGNER"
+	case ResourceContributorRole_RESOURCE_CONTRIBUTOR_ROLE_SOUNDMIXER:
+		return "SOUNDMIXER"
+	case ResourceContributorRole_RESOURCE_CONTRIBUTOR_ROLE_SOUNDRECORDIST:
+		return "SOUNDRECORDIST"
+	case ResourceContributorRole_RESOURCE_CONTRIBUTOR_ROLE_SOUNDSUPERVISOR:
+		return "SOUNDSUPERVISOR"
+	case ResourceContributorRole_RESOURCE_CONTRIBUTOR_ROLE_SPEAKER:
+		return "SPEAKER"
+	case ResourceContributorRole_RESOURCE_CONTRIBUTOR_ROLE_SPECIALEFFECTSTECHNICIAN:
+		return "SPECIALEFFECTSTECHNICIAN"
+	case ResourceContributorRole_RESOURCE_CONTRIBUTOR_ROLE_SPONSOR:
+		return "SPONSOR"
+	case ResourceContributorRole_RESOURCE_CONTRIBUTOR_ROLE_STAGEASSISTANTENGINEER:
+		return "STAGEASSISTANTENGINEER"
+	case ResourceContributorRole_RESOURCE_CONTRIBUTOR_ROLE_STAGEDIRECTOR:
+		return "STAGEDIRECTOR"
+	case ResourceContributorRole_RESOURCE_CONTRIBUTOR_ROLE_STAGEENGINEER:
+		return "STAGEENGINEER"
+	case ResourceContributorRole_RESOURCE_CONTRIBUTOR_ROLE_STORYTELLER:
+		return "STORYTELLER"
+	case ResourceContributorRole_RESOURCE_CONTRIBUTOR_ROLE_STRINGENGINEER:
+		return "STRINGENGINEER"
+	case ResourceContributorRole_RESOURCE_CONTRIBUTOR_ROLE_STRINGPRODUCER:
+		return "STRINGPRODUCER"
+	case ResourceContributorRole_RESOURCE_CONTRIBUTOR_ROLE_STRINGSDIRECTOR:
+		return "STRINGSDIRECTOR"
+	case ResourceContributorRole_RESOURCE_CONTRIBUTOR_ROLE_STUDIOCONDUCTOR:
+		return "STUDIOCONDUCTOR"
+	case ResourceContributorRole_RESOURCE_CONTRIBUTOR_ROLE_STUDIOMUSICIAN:
+		return "STUDIOMUSICIAN"
+	case ResourceContributorRole_RESOURCE_CONTRIBUTOR_ROLE_STUDIOPERSONNEL:
+		return "STUDIOPERSONNEL"
+	case ResourceContributorRole_RESOURCE_CONTRIBUTOR_ROLE_STUDIOPRODUCER:
+		return "STUDIOPRODUCER"
+	case ResourceContributorRole_RESOURCE_CONTRIBUTOR_ROLE_STUNTS:
+		return "STUNTS"
+	case ResourceContributorRole_RESOURCE_CONTRIBUTOR_ROLE_SUBTITLESEDITOR:
+		return "SUBTITLESEDITOR"
+	case ResourceContributorRole_RESOURCE_CONTRIBUTOR_ROLE_SUBTITLESTRANSLATOR:
+		return "SUBTITLESTRANSLATOR"
+	case ResourceContributorRole_RESOURCE_CONTRIBUTOR_ROLE_SUPPORTINGACTOR:
+		return "SUPPORTINGACTOR"
+	case ResourceContributorRole_RESOURCE_CONTRIBUTOR_ROLE_SURROUNDMIXINGENGINEER:
+		return "SURROUNDMIXINGENGINEER"
+	case ResourceContributorRole_RESOURCE_CONTRIBUTOR_ROLE_SURROUNDMIXINGSECONDENGINEER:
+		return "SURROUNDMIXINGSECONDENGINEER"
+	case ResourceContributorRole_RESOURCE_CONTRIBUTOR_ROLE_TAPEOPERATOR:
+		return "TAPEOPERATOR"
+	case ResourceContributorRole_RESOURCE_CONTRIBUTOR_ROLE_TECHNICALDIRECTOR:
+		return "TECHNICALDIRECTOR"
+	case ResourceContributorRole_RESOURCE_CONTRIBUTOR_ROLE_TONMEISTER:
+		return "TONMEISTER"
+	case ResourceContributorRole_RESOURCE_CONTRIBUTOR_ROLE_TRACKINGENGINEER:
+		return "TRACKINGENGINEER"
+	case ResourceContributorRole_RESOURCE_CONTRIBUTOR_ROLE_TRACKINGSECONDENGINEER:
+		return "TRACKINGSECONDENGINEER"
+	case ResourceContributorRole_RESOURCE_CONTRIBUTOR_ROLE_TRANSFERSANDSAFETIESENGINEER:
+		return "TRANSFERSANDSAFETIESENGINEER"
+	case ResourceContributorRole_RESOURCE_CONTRIBUTOR_ROLE_TRANSFERSANDSAFETIESSECONDENGINEER:
+		return "TRANSFERSANDSAFETIESSECONDENGINEER"
+	case ResourceContributorRole_RESOURCE_CONTRIBUTOR_ROLE_TRANSPORTATIONMANAGER:
+		return "TRANSPORTATIONMANAGER"
+	case ResourceContributorRole_RESOURCE_CONTRIBUTOR_ROLE_TREATMENT_PROGRAMPROPOSAL:
+		return "TREATMENT_PROGRAMPROPOSAL"
+	case ResourceContributorRole_RESOURCE_CONTRIBUTOR_ROLE_TYPEDESIGNER:
+		return "TYPEDESIGNER"
+	case ResourceContributorRole_RESOURCE_CONTRIBUTOR_ROLE_UNKNOWN:
+		return "UNKNOWN"
+	case ResourceContributorRole_RESOURCE_CONTRIBUTOR_ROLE_USERDEFINED:
+		return "USERDEFINED"
+	case ResourceContributorRole_RESOURCE_CONTRIBUTOR_ROLE_VIDEODIRECTOR:
+		return "VIDEODIRECTOR"
+	case ResourceContributorRole_RESOURCE_CONTRIBUTOR_ROLE_VIDEOGRAPHER:
+		return "VIDEOGRAPHER"
+	case ResourceContributorRole_RESOURCE_CONTRIBUTOR_ROLE_VIDEOMUSICALDIRECTOR:
+		return "VIDEOMUSICALDIRECTOR"
+	case ResourceContributorRole_RESOURCE_CONTRIBUTOR_ROLE_VIDEOPRODUCER:
+		return "VIDEOPRODUCER"
+	case ResourceContributorRole_RESOURCE_CONTRIBUTOR_ROLE_VISIONMIXER:
+		return "VISIONMIXER"
+	case ResourceContributorRole_RESOURCE_CONTRIBUTOR_ROLE_VISUALEDITOR:
+		return "VISUALEDITOR"
+	case ResourceContributorRole_RESOURCE_CONTRIBUTOR_ROLE_VISUALEFFECTSTECHNICIAN:
+		return "VISUALEFFECTSTECHNICIAN"
+	case ResourceContributorRole_RESOURCE_CONTRIBUTOR_ROLE_VOCALARRANGER:
+		return "VOCALARRANGER"
+	case ResourceContributorRole_RESOURCE_CONTRIBUTOR_ROLE_VOCALEDITINGENGINEER:
+		return "VOCALEDITINGENGINEER"
+	case ResourceContributorRole_RESOURCE_CONTRIBUTOR_ROLE_VOCALEDITINGSECONDENGINEER:
+		return "VOCALEDITINGSECONDENGINEER"
+	case ResourceContributorRole_RESOURCE_CONTRIBUTOR_ROLE_VOCALENGINEER:
+		return "VOCALENGINEER"
+	case ResourceContributorRole_RESOURCE_CONTRIBUTOR_ROLE_VOCALIST:
+		return "VOCALIST"
+	case ResourceContributorRole_RESOURCE_CONTRIBUTOR_ROLE_VOCALSECONDENGINEER:
+		return "VOCALSECONDENGINEER"
+	case ResourceContributorRole_RESOURCE_CONTRIBUTOR_ROLE_VOCALPRODUCER:
+		return "VOCALPRODUCER"
+	case ResourceContributorRole_RESOURCE_CONTRIBUTOR_ROLE_VOICEACTOR:
+		return "VOICEACTOR"
+	case ResourceContributorRole_RESOURCE_CONTRIBUTOR_ROLE_WARDROBE:
+		return "WARDROBE"
+	case ResourceContributorRole_RESOURCE_CONTRIBUTOR_ROLE_BAND:
+		return "BAND"
+	case ResourceContributorRole_RESOURCE_CONTRIBUTOR_ROLE_CONTRIBUTOR:
+		return "CONTRIBUTOR"
+	case ResourceContributorRole_RESOURCE_CONTRIBUTOR_ROLE_FEATUREDARTIST:
+		return "FEATUREDARTIST"
+	case ResourceContributorRole_RESOURCE_CONTRIBUTOR_ROLE_MAINARTIST:
+		return "MAINARTIST"
+	case ResourceContributorRole_RESOURCE_CONTRIBUTOR_ROLE_MEMBER:
+		return "MEMBER"
+	case ResourceContributorRole_RESOURCE_CONTRIBUTOR_ROLE_PRODUCER:
+		return "PRODUCER"
+	case ResourceContributorRole_RESOURCE_CONTRIBUTOR_ROLE_IMMERSIVEMIXINGENGINEER:
+		return "IMMERSIVEMIXINGENGINEER"
+	case ResourceContributorRole_RESOURCE_CONTRIBUTOR_ROLE_PRESENTER:
+		return "PRESENTER"
 	default:
 		return ""
 	}
@@ -1773,6 +15631,546 @@ func (e ResourceContributorRole) XMLString() string {
 func ParseResourceContributorRoleString(s string) (ResourceContributorRole, bool) {
 	s = strings.ToUpper(s)
 	switch s {
+	case "ACCOMPANYIST":
+		return ResourceContributorRole_RESOURCE_CONTRIBUTOR_ROLE_ACCOMPANYIST, true
+	case "ACTOR":
+		return ResourceContributorRole_RESOURCE_CONTRIBUTOR_ROLE_ACTOR, true
+	case "ADDITIONALENGINEER":
+		return ResourceContributorRole_RESOURCE_CONTRIBUTOR_ROLE_ADDITIONALENGINEER, true
+	case "ADDITIONALMIXINGENGINEER":
+		return ResourceContributorRole_RESOURCE_CONTRIBUTOR_ROLE_ADDITIONALMIXINGENGINEER, true
+	case "ADDITIONALPERFORMER":
+		return ResourceContributorRole_RESOURCE_CONTRIBUTOR_ROLE_ADDITIONALPERFORMER, true
+	case "ADDITIONALPROGRAMMINGENGINEER":
+		return ResourceContributorRole_RESOURCE_CONTRIBUTOR_ROLE_ADDITIONALPROGRAMMINGENGINEER, true
+	case "ADDITIONALSTUDIOPRODUCER":
+		return ResourceContributorRole_RESOURCE_CONTRIBUTOR_ROLE_ADDITIONALSTUDIOPRODUCER, true
+	case "ANCHORPERSON":
+		return ResourceContributorRole_RESOURCE_CONTRIBUTOR_ROLE_ANCHORPERSON, true
+	case "ANIMALTRAINER":
+		return ResourceContributorRole_RESOURCE_CONTRIBUTOR_ROLE_ANIMALTRAINER, true
+	case "ANIMATOR":
+		return ResourceContributorRole_RESOURCE_CONTRIBUTOR_ROLE_ANIMATOR, true
+	case "ANNOTATOR":
+		return ResourceContributorRole_RESOURCE_CONTRIBUTOR_ROLE_ANNOTATOR, true
+	case "ANNOUNCER":
+		return ResourceContributorRole_RESOURCE_CONTRIBUTOR_ROLE_ANNOUNCER, true
+	case "AANDRADMINISTRATOR":
+		return ResourceContributorRole_RESOURCE_CONTRIBUTOR_ROLE_AANDRADMINISTRATOR, true
+	case "AANDRCOORDINATOR":
+		return ResourceContributorRole_RESOURCE_CONTRIBUTOR_ROLE_AANDRCOORDINATOR, true
+	case "ARMOURER":
+		return ResourceContributorRole_RESOURCE_CONTRIBUTOR_ROLE_ARMOURER, true
+	case "ARTCOPYIST":
+		return ResourceContributorRole_RESOURCE_CONTRIBUTOR_ROLE_ARTCOPYIST, true
+	case "ARTDIRECTOR":
+		return ResourceContributorRole_RESOURCE_CONTRIBUTOR_ROLE_ARTDIRECTOR, true
+	case "ARTIST":
+		return ResourceContributorRole_RESOURCE_CONTRIBUTOR_ROLE_ARTIST, true
+	case "ARTISTBACKGROUNDVOCALENGINEER":
+		return ResourceContributorRole_RESOURCE_CONTRIBUTOR_ROLE_ARTISTBACKGROUNDVOCALENGINEER, true
+	case "ARTISTVOCALENGINEER":
+		return ResourceContributorRole_RESOURCE_CONTRIBUTOR_ROLE_ARTISTVOCALENGINEER, true
+	case "ARTISTVOCALSECONDENGINEER":
+		return ResourceContributorRole_RESOURCE_CONTRIBUTOR_ROLE_ARTISTVOCALSECONDENGINEER, true
+	case "ASSISTANTCAMERAOPERATOR":
+		return ResourceContributorRole_RESOURCE_CONTRIBUTOR_ROLE_ASSISTANTCAMERAOPERATOR, true
+	case "ASSISTANTCHIEFLIGHTINGTECHNICIAN":
+		return ResourceContributorRole_RESOURCE_CONTRIBUTOR_ROLE_ASSISTANTCHIEFLIGHTINGTECHNICIAN, true
+	case "ASSISTANTCONDUCTOR":
+		return ResourceContributorRole_RESOURCE_CONTRIBUTOR_ROLE_ASSISTANTCONDUCTOR, true
+	case "ASSISTANTDIRECTOR":
+		return ResourceContributorRole_RESOURCE_CONTRIBUTOR_ROLE_ASSISTANTDIRECTOR, true
+	case "ASSISTANTEDITOR":
+		return ResourceContributorRole_RESOURCE_CONTRIBUTOR_ROLE_ASSISTANTEDITOR, true
+	case "ASSISTANTENGINEER":
+		return ResourceContributorRole_RESOURCE_CONTRIBUTOR_ROLE_ASSISTANTENGINEER, true
+	case "ASSISTANTPRODUCER":
+		return ResourceContributorRole_RESOURCE_CONTRIBUTOR_ROLE_ASSISTANTPRODUCER, true
+	case "ASSISTANTVISUALEDITOR":
+		return ResourceContributorRole_RESOURCE_CONTRIBUTOR_ROLE_ASSISTANTVISUALEDITOR, true
+	case "ASSOCIATEDPERFORMER":
+		return ResourceContributorRole_RESOURCE_CONTRIBUTOR_ROLE_ASSOCIATEDPERFORMER, true
+	case "ASSOCIATEPRODUCER":
+		return ResourceContributorRole_RESOURCE_CONTRIBUTOR_ROLE_ASSOCIATEPRODUCER, true
+	case "AURALTRAINER":
+		return ResourceContributorRole_RESOURCE_CONTRIBUTOR_ROLE_AURALTRAINER, true
+	case "BACKGROUNDVOCALIST":
+		return ResourceContributorRole_RESOURCE_CONTRIBUTOR_ROLE_BACKGROUNDVOCALIST, true
+	case "BALANCEENGINEER":
+		return ResourceContributorRole_RESOURCE_CONTRIBUTOR_ROLE_BALANCEENGINEER, true
+	case "BANDLEADER":
+		return ResourceContributorRole_RESOURCE_CONTRIBUTOR_ROLE_BANDLEADER, true
+	case "BINDER":
+		return ResourceContributorRole_RESOURCE_CONTRIBUTOR_ROLE_BINDER, true
+	case "BINDINGDESIGNER":
+		return ResourceContributorRole_RESOURCE_CONTRIBUTOR_ROLE_BINDINGDESIGNER, true
+	case "BOOKDESIGNER":
+		return ResourceContributorRole_RESOURCE_CONTRIBUTOR_ROLE_BOOKDESIGNER, true
+	case "BOOKJACKDESIGNER":
+		return ResourceContributorRole_RESOURCE_CONTRIBUTOR_ROLE_BOOKJACKDESIGNER, true
+	case "BOOKPLATEDESIGNER":
+		return ResourceContributorRole_RESOURCE_CONTRIBUTOR_ROLE_BOOKPLATEDESIGNER, true
+	case "BOOKPRODUCER":
+		return ResourceContributorRole_RESOURCE_CONTRIBUTOR_ROLE_BOOKPRODUCER, true
+	case "BROADCASTASSISTANT":
+		return ResourceContributorRole_RESOURCE_CONTRIBUTOR_ROLE_BROADCASTASSISTANT, true
+	case "BROADCASTJOURNALIST":
+		return ResourceContributorRole_RESOURCE_CONTRIBUTOR_ROLE_BROADCASTJOURNALIST, true
+	case "CALLIGRAPHER":
+		return ResourceContributorRole_RESOURCE_CONTRIBUTOR_ROLE_CALLIGRAPHER, true
+	case "CAMERAOPERATOR":
+		return ResourceContributorRole_RESOURCE_CONTRIBUTOR_ROLE_CAMERAOPERATOR, true
+	case "CARPENTER":
+		return ResourceContributorRole_RESOURCE_CONTRIBUTOR_ROLE_CARPENTER, true
+	case "CARTOGRAPHER":
+		return ResourceContributorRole_RESOURCE_CONTRIBUTOR_ROLE_CARTOGRAPHER, true
+	case "CARTOONIST":
+		return ResourceContributorRole_RESOURCE_CONTRIBUTOR_ROLE_CARTOONIST, true
+	case "CASTINGDIRECTOR":
+		return ResourceContributorRole_RESOURCE_CONTRIBUTOR_ROLE_CASTINGDIRECTOR, true
+	case "CAUSEUR":
+		return ResourceContributorRole_RESOURCE_CONTRIBUTOR_ROLE_CAUSEUR, true
+	case "CENSOR":
+		return ResourceContributorRole_RESOURCE_CONTRIBUTOR_ROLE_CENSOR, true
+	case "CHIEFLIGHTINGTECHNICIAN":
+		return ResourceContributorRole_RESOURCE_CONTRIBUTOR_ROLE_CHIEFLIGHTINGTECHNICIAN, true
+	case "CHOIR":
+		return ResourceContributorRole_RESOURCE_CONTRIBUTOR_ROLE_CHOIR, true
+	case "CHOIRMEMBER":
+		return ResourceContributorRole_RESOURCE_CONTRIBUTOR_ROLE_CHOIRMEMBER, true
+	case "CHOREOGRAPHER":
+		return ResourceContributorRole_RESOURCE_CONTRIBUTOR_ROLE_CHOREOGRAPHER, true
+	case "CHORUSMASTER":
+		return ResourceContributorRole_RESOURCE_CONTRIBUTOR_ROLE_CHORUSMASTER, true
+	case "CIRCUSARTIST":
+		return ResourceContributorRole_RESOURCE_CONTRIBUTOR_ROLE_CIRCUSARTIST, true
+	case "CLAPPERLOADER":
+		return ResourceContributorRole_RESOURCE_CONTRIBUTOR_ROLE_CLAPPERLOADER, true
+	case "CLUBDJ":
+		return ResourceContributorRole_RESOURCE_CONTRIBUTOR_ROLE_CLUBDJ, true
+	case "CODIRECTOR":
+		return ResourceContributorRole_RESOURCE_CONTRIBUTOR_ROLE_CODIRECTOR, true
+	case "COEXECUTIVEPRODUCER":
+		return ResourceContributorRole_RESOURCE_CONTRIBUTOR_ROLE_COEXECUTIVEPRODUCER, true
+	case "COLORSEPARATOR":
+		return ResourceContributorRole_RESOURCE_CONTRIBUTOR_ROLE_COLORSEPARATOR, true
+	case "COMEDIAN":
+		return ResourceContributorRole_RESOURCE_CONTRIBUTOR_ROLE_COMEDIAN, true
+	case "COMIXER":
+		return ResourceContributorRole_RESOURCE_CONTRIBUTOR_ROLE_COMIXER, true
+	case "COMIXINGENGINEER":
+		return ResourceContributorRole_RESOURCE_CONTRIBUTOR_ROLE_COMIXINGENGINEER, true
+	case "COMMENTATOR":
+		return ResourceContributorRole_RESOURCE_CONTRIBUTOR_ROLE_COMMENTATOR, true
+	case "COMMISSIONINGBROADCASTER":
+		return ResourceContributorRole_RESOURCE_CONTRIBUTOR_ROLE_COMMISSIONINGBROADCASTER, true
+	case "COMPILATIONPRODUCER":
+		return ResourceContributorRole_RESOURCE_CONTRIBUTOR_ROLE_COMPILATIONPRODUCER, true
+	case "COMPUTERGRAPHICCREATOR":
+		return ResourceContributorRole_RESOURCE_CONTRIBUTOR_ROLE_COMPUTERGRAPHICCREATOR, true
+	case "COMPUTERPROGRAMMER":
+		return ResourceContributorRole_RESOURCE_CONTRIBUTOR_ROLE_COMPUTERPROGRAMMER, true
+	case "CONCERTMASTER":
+		return ResourceContributorRole_RESOURCE_CONTRIBUTOR_ROLE_CONCERTMASTER, true
+	case "CONDUCTOR":
+		return ResourceContributorRole_RESOURCE_CONTRIBUTOR_ROLE_CONDUCTOR, true
+	case "CONSULTANT":
+		return ResourceContributorRole_RESOURCE_CONTRIBUTOR_ROLE_CONSULTANT, true
+	case "CONTINUITYCHECKER":
+		return ResourceContributorRole_RESOURCE_CONTRIBUTOR_ROLE_CONTINUITYCHECKER, true
+	case "CONTRACTOR":
+		return ResourceContributorRole_RESOURCE_CONTRIBUTOR_ROLE_CONTRACTOR, true
+	case "COPRODUCER":
+		return ResourceContributorRole_RESOURCE_CONTRIBUTOR_ROLE_COPRODUCER, true
+	case "CORRESPONDENT":
+		return ResourceContributorRole_RESOURCE_CONTRIBUTOR_ROLE_CORRESPONDENT, true
+	case "COSTUMEDESIGNER":
+		return ResourceContributorRole_RESOURCE_CONTRIBUTOR_ROLE_COSTUMEDESIGNER, true
+	case "COVERDESIGNER":
+		return ResourceContributorRole_RESOURCE_CONTRIBUTOR_ROLE_COVERDESIGNER, true
+	case "DANCER":
+		return ResourceContributorRole_RESOURCE_CONTRIBUTOR_ROLE_DANCER, true
+	case "DELINEATOR":
+		return ResourceContributorRole_RESOURCE_CONTRIBUTOR_ROLE_DELINEATOR, true
+	case "DESIGNER":
+		return ResourceContributorRole_RESOURCE_CONTRIBUTOR_ROLE_DESIGNER, true
+	case "DIALOGUECOACH":
+		return ResourceContributorRole_RESOURCE_CONTRIBUTOR_ROLE_DIALOGUECOACH, true
+	case "DIALOGUEDIRECTOR":
+		return ResourceContributorRole_RESOURCE_CONTRIBUTOR_ROLE_DIALOGUEDIRECTOR, true
+	case "DIGITALAUDIOWORKSTATIONENGINEER":
+		return ResourceContributorRole_RESOURCE_CONTRIBUTOR_ROLE_DIGITALAUDIOWORKSTATIONENGINEER, true
+	case "DIGITALEDITINGENGINEER":
+		return ResourceContributorRole_RESOURCE_CONTRIBUTOR_ROLE_DIGITALEDITINGENGINEER, true
+	case "DIGITALEDITINGSECONDENGINEER":
+		return ResourceContributorRole_RESOURCE_CONTRIBUTOR_ROLE_DIGITALEDITINGSECONDENGINEER, true
+	case "DIRECTOR":
+		return ResourceContributorRole_RESOURCE_CONTRIBUTOR_ROLE_DIRECTOR, true
+	case "DIRECTSTREAMDIGITALENGINEER":
+		return ResourceContributorRole_RESOURCE_CONTRIBUTOR_ROLE_DIRECTSTREAMDIGITALENGINEER, true
+	case "DISTRIBUTIONCOMPANY":
+		return ResourceContributorRole_RESOURCE_CONTRIBUTOR_ROLE_DISTRIBUTIONCOMPANY, true
+	case "DJ":
+		return ResourceContributorRole_RESOURCE_CONTRIBUTOR_ROLE_DJ, true
+	case "DRAUGHTSMAN":
+		return ResourceContributorRole_RESOURCE_CONTRIBUTOR_ROLE_DRAUGHTSMAN, true
+	case "DRESSER":
+		return ResourceContributorRole_RESOURCE_CONTRIBUTOR_ROLE_DRESSER, true
+	case "DUBBER":
+		return ResourceContributorRole_RESOURCE_CONTRIBUTOR_ROLE_DUBBER, true
+	case "EDITOR":
+		return ResourceContributorRole_RESOURCE_CONTRIBUTOR_ROLE_EDITOR, true
+	case "EDITORINCHIEF":
+		return ResourceContributorRole_RESOURCE_CONTRIBUTOR_ROLE_EDITORINCHIEF, true
+	case "EDITOROFTHEDAY":
+		return ResourceContributorRole_RESOURCE_CONTRIBUTOR_ROLE_EDITOROFTHEDAY, true
+	case "ENCODER":
+		return ResourceContributorRole_RESOURCE_CONTRIBUTOR_ROLE_ENCODER, true
+	case "ENGINEER":
+		return ResourceContributorRole_RESOURCE_CONTRIBUTOR_ROLE_ENGINEER, true
+	case "ENSEMBLE":
+		return ResourceContributorRole_RESOURCE_CONTRIBUTOR_ROLE_ENSEMBLE, true
+	case "EXECUTIVEPRODUCER":
+		return ResourceContributorRole_RESOURCE_CONTRIBUTOR_ROLE_EXECUTIVEPRODUCER, true
+	case "EXPERT":
+		return ResourceContributorRole_RESOURCE_CONTRIBUTOR_ROLE_EXPERT, true
+	case "FACSIMILIST":
+		return ResourceContributorRole_RESOURCE_CONTRIBUTOR_ROLE_FACSIMILIST, true
+	case "FIGHTDIRECTOR":
+		return ResourceContributorRole_RESOURCE_CONTRIBUTOR_ROLE_FIGHTDIRECTOR, true
+	case "FILMDIRECTOR":
+		return ResourceContributorRole_RESOURCE_CONTRIBUTOR_ROLE_FILMDIRECTOR, true
+	case "FILMDISTRIBUTOR":
+		return ResourceContributorRole_RESOURCE_CONTRIBUTOR_ROLE_FILMDISTRIBUTOR, true
+	case "FILMEDITOR":
+		return ResourceContributorRole_RESOURCE_CONTRIBUTOR_ROLE_FILMEDITOR, true
+	case "FILMPRODUCER":
+		return ResourceContributorRole_RESOURCE_CONTRIBUTOR_ROLE_FILMPRODUCER, true
+	case "FILMSOUNDENGINEER":
+		return ResourceContributorRole_RESOURCE_CONTRIBUTOR_ROLE_FILMSOUNDENGINEER, true
+	case "FLOORMANAGER":
+		return ResourceContributorRole_RESOURCE_CONTRIBUTOR_ROLE_FLOORMANAGER, true
+	case "FOCUSPULLER":
+		return ResourceContributorRole_RESOURCE_CONTRIBUTOR_ROLE_FOCUSPULLER, true
+	case "FOLEYARTIST":
+		return ResourceContributorRole_RESOURCE_CONTRIBUTOR_ROLE_FOLEYARTIST, true
+	case "FOLEYEDITOR":
+		return ResourceContributorRole_RESOURCE_CONTRIBUTOR_ROLE_FOLEYEDITOR, true
+	case "FOLEYMIXER":
+		return ResourceContributorRole_RESOURCE_CONTRIBUTOR_ROLE_FOLEYMIXER, true
+	case "GRAPHICARTIST":
+		return ResourceContributorRole_RESOURCE_CONTRIBUTOR_ROLE_GRAPHICARTIST, true
+	case "GRAPHICASSISTANT":
+		return ResourceContributorRole_RESOURCE_CONTRIBUTOR_ROLE_GRAPHICASSISTANT, true
+	case "GRAPHICDESIGNER":
+		return ResourceContributorRole_RESOURCE_CONTRIBUTOR_ROLE_GRAPHICDESIGNER, true
+	case "GREENSMAN":
+		return ResourceContributorRole_RESOURCE_CONTRIBUTOR_ROLE_GREENSMAN, true
+	case "GRIP":
+		return ResourceContributorRole_RESOURCE_CONTRIBUTOR_ROLE_GRIP, true
+	case "GUESTCONDUCTOR":
+		return ResourceContributorRole_RESOURCE_CONTRIBUTOR_ROLE_GUESTCONDUCTOR, true
+	case "GROUPMEMBER":
+		return ResourceContributorRole_RESOURCE_CONTRIBUTOR_ROLE_GROUPMEMBER, true
+	case "HAIRDRESSER":
+		return ResourceContributorRole_RESOURCE_CONTRIBUTOR_ROLE_HAIRDRESSER, true
+	case "ILLUSTRATOR":
+		return ResourceContributorRole_RESOURCE_CONTRIBUTOR_ROLE_ILLUSTRATOR, true
+	case "INITIALPRODUCER":
+		return ResourceContributorRole_RESOURCE_CONTRIBUTOR_ROLE_INITIALPRODUCER, true
+	case "INTERVIEWEDGUEST":
+		return ResourceContributorRole_RESOURCE_CONTRIBUTOR_ROLE_INTERVIEWEDGUEST, true
+	case "INTERVIEWER":
+		return ResourceContributorRole_RESOURCE_CONTRIBUTOR_ROLE_INTERVIEWER, true
+	case "KEYCHARACTER":
+		return ResourceContributorRole_RESOURCE_CONTRIBUTOR_ROLE_KEYCHARACTER, true
+	case "KEYGRIP":
+		return ResourceContributorRole_RESOURCE_CONTRIBUTOR_ROLE_KEYGRIP, true
+	case "KEYTALENT":
+		return ResourceContributorRole_RESOURCE_CONTRIBUTOR_ROLE_KEYTALENT, true
+	case "LEADMAN":
+		return ResourceContributorRole_RESOURCE_CONTRIBUTOR_ROLE_LEADMAN, true
+	case "LEADPERFORMER":
+		return ResourceContributorRole_RESOURCE_CONTRIBUTOR_ROLE_LEADPERFORMER, true
+	case "LEADVOCALIST":
+		return ResourceContributorRole_RESOURCE_CONTRIBUTOR_ROLE_LEADVOCALIST, true
+	case "LIGHTINGDIRECTOR":
+		return ResourceContributorRole_RESOURCE_CONTRIBUTOR_ROLE_LIGHTINGDIRECTOR, true
+	case "LIGHTINGTECHNICIAN":
+		return ResourceContributorRole_RESOURCE_CONTRIBUTOR_ROLE_LIGHTINGTECHNICIAN, true
+	case "LOCATIONMANAGER":
+		return ResourceContributorRole_RESOURCE_CONTRIBUTOR_ROLE_LOCATIONMANAGER, true
+	case "MAKEUPARTIST":
+		return ResourceContributorRole_RESOURCE_CONTRIBUTOR_ROLE_MAKEUPARTIST, true
+	case "MANUFACTURER":
+		return ResourceContributorRole_RESOURCE_CONTRIBUTOR_ROLE_MANUFACTURER, true
+	case "MASTERINGENGINEER":
+		return ResourceContributorRole_RESOURCE_CONTRIBUTOR_ROLE_MASTERINGENGINEER, true
+	case "MASTERINGSECONDENGINEER":
+		return ResourceContributorRole_RESOURCE_CONTRIBUTOR_ROLE_MASTERINGSECONDENGINEER, true
+	case "MATTEARTIST":
+		return ResourceContributorRole_RESOURCE_CONTRIBUTOR_ROLE_MATTEARTIST, true
+	case "MIXER":
+		return ResourceContributorRole_RESOURCE_CONTRIBUTOR_ROLE_MIXER, true
+	case "MIXINGENGINEER":
+		return ResourceContributorRole_RESOURCE_CONTRIBUTOR_ROLE_MIXINGENGINEER, true
+	case "MIXINGSECONDENGINEER":
+		return ResourceContributorRole_RESOURCE_CONTRIBUTOR_ROLE_MIXINGSECONDENGINEER, true
+	case "MUSICARRANGER":
+		return ResourceContributorRole_RESOURCE_CONTRIBUTOR_ROLE_MUSICARRANGER, true
+	case "MUSICCOPYIST":
+		return ResourceContributorRole_RESOURCE_CONTRIBUTOR_ROLE_MUSICCOPYIST, true
+	case "MUSICDIRECTOR":
+		return ResourceContributorRole_RESOURCE_CONTRIBUTOR_ROLE_MUSICDIRECTOR, true
+	case "MUSICGROUP":
+		return ResourceContributorRole_RESOURCE_CONTRIBUTOR_ROLE_MUSICGROUP, true
+	case "MUSICIAN":
+		return ResourceContributorRole_RESOURCE_CONTRIBUTOR_ROLE_MUSICIAN, true
+	case "NARRATOR":
+		return ResourceContributorRole_RESOURCE_CONTRIBUTOR_ROLE_NARRATOR, true
+	case "NEWSPRODUCER":
+		return ResourceContributorRole_RESOURCE_CONTRIBUTOR_ROLE_NEWSPRODUCER, true
+	case "NEWSREADER":
+		return ResourceContributorRole_RESOURCE_CONTRIBUTOR_ROLE_NEWSREADER, true
+	case "NOTSPECIFIED":
+		return ResourceContributorRole_RESOURCE_CONTRIBUTOR_ROLE_NOTSPECIFIED, true
+	case "ORCHESTRA":
+		return ResourceContributorRole_RESOURCE_CONTRIBUTOR_ROLE_ORCHESTRA, true
+	case "ORCHESTRAMEMBER":
+		return ResourceContributorRole_RESOURCE_CONTRIBUTOR_ROLE_ORCHESTRAMEMBER, true
+	case "ORIGINALARTIST":
+		return ResourceContributorRole_RESOURCE_CONTRIBUTOR_ROLE_ORIGINALARTIST, true
+	case "OVERDUBENGINEER":
+		return ResourceContributorRole_RESOURCE_CONTRIBUTOR_ROLE_OVERDUBENGINEER, true
+	case "OVERDUBSECONDENGINEER":
+		return ResourceContributorRole_RESOURCE_CONTRIBUTOR_ROLE_OVERDUBSECONDENGINEER, true
+	case "PAINTER":
+		return ResourceContributorRole_RESOURCE_CONTRIBUTOR_ROLE_PAINTER, true
+	case "PERFORMER":
+		return ResourceContributorRole_RESOURCE_CONTRIBUTOR_ROLE_PERFORMER, true
+	case "PHOTOGRAPHER":
+		return ResourceContributorRole_RESOURCE_CONTRIBUTOR_ROLE_PHOTOGRAPHER, true
+	case "PHOTOGRAPHYDIRECTOR":
+		return ResourceContributorRole_RESOURCE_CONTRIBUTOR_ROLE_PHOTOGRAPHYDIRECTOR, true
+	case "PLAYBACKSINGER":
+		return ResourceContributorRole_RESOURCE_CONTRIBUTOR_ROLE_PLAYBACKSINGER, true
+	case "POSTPRODUCER":
+		return ResourceContributorRole_RESOURCE_CONTRIBUTOR_ROLE_POSTPRODUCER, true
+	case "PREPRODUCTION":
+		return ResourceContributorRole_RESOURCE_CONTRIBUTOR_ROLE_PREPRODUCTION, true
+	case "PREPRODUCTIONENGINEER":
+		return ResourceContributorRole_RESOURCE_CONTRIBUTOR_ROLE_PREPRODUCTIONENGINEER, true
+	case "PREPRODUCTIONSECONDENGINEER":
+		return ResourceContributorRole_RESOURCE_CONTRIBUTOR_ROLE_PREPRODUCTIONSECONDENGINEER, true
+	case "PRIMARYMUSICIAN":
+		return ResourceContributorRole_RESOURCE_CONTRIBUTOR_ROLE_PRIMARYMUSICIAN, true
+	case "PRODUCTIONASSISTANT":
+		return ResourceContributorRole_RESOURCE_CONTRIBUTOR_ROLE_PRODUCTIONASSISTANT, true
+	case "PRODUCTIONCOMPANY":
+		return ResourceContributorRole_RESOURCE_CONTRIBUTOR_ROLE_PRODUCTIONCOMPANY, true
+	case "PRODUCTIONCOORDINATOR":
+		return ResourceContributorRole_RESOURCE_CONTRIBUTOR_ROLE_PRODUCTIONCOORDINATOR, true
+	case "PRODUCTIONDEPARTMENT":
+		return ResourceContributorRole_RESOURCE_CONTRIBUTOR_ROLE_PRODUCTIONDEPARTMENT, true
+	case "PRODUCTIONMANAGER":
+		return ResourceContributorRole_RESOURCE_CONTRIBUTOR_ROLE_PRODUCTIONMANAGER, true
+	case "PRODUCTIONSECRETARY":
+		return ResourceContributorRole_RESOURCE_CONTRIBUTOR_ROLE_PRODUCTIONSECRETARY, true
+	case "PROJECTENGINEER":
+		return ResourceContributorRole_RESOURCE_CONTRIBUTOR_ROLE_PROJECTENGINEER, true
+	case "PROGRAMMER":
+		return ResourceContributorRole_RESOURCE_CONTRIBUTOR_ROLE_PROGRAMMER, true
+	case "PROGRAMMINGENGINEER":
+		return ResourceContributorRole_RESOURCE_CONTRIBUTOR_ROLE_PROGRAMMINGENGINEER, true
+	case "PROGRAMPRODUCER":
+		return ResourceContributorRole_RESOURCE_CONTRIBUTOR_ROLE_PROGRAMPRODUCER, true
+	case "PROPERTYMANAGER":
+		return ResourceContributorRole_RESOURCE_CONTRIBUTOR_ROLE_PROPERTYMANAGER, true
+	case "PUBLISHINGDIRECTOR":
+		return ResourceContributorRole_RESOURCE_CONTRIBUTOR_ROLE_PUBLISHINGDIRECTOR, true
+	case "PUPPETEER":
+		return ResourceContributorRole_RESOURCE_CONTRIBUTOR_ROLE_PUPPETEER, true
+	case "PYROTECHNICIAN":
+		return ResourceContributorRole_RESOURCE_CONTRIBUTOR_ROLE_PYROTECHNICIAN, true
+	case "RECORDINGENGINEER":
+		return ResourceContributorRole_RESOURCE_CONTRIBUTOR_ROLE_RECORDINGENGINEER, true
+	case "RECORDINGSECONDENGINEER":
+		return ResourceContributorRole_RESOURCE_CONTRIBUTOR_ROLE_RECORDINGSECONDENGINEER, true
+	case "REDACTOR":
+		return ResourceContributorRole_RESOURCE_CONTRIBUTOR_ROLE_REDACTOR, true
+	case "REISSUEPRODUCER":
+		return ResourceContributorRole_RESOURCE_CONTRIBUTOR_ROLE_REISSUEPRODUCER, true
+	case "REMIXEDARTIST":
+		return ResourceContributorRole_RESOURCE_CONTRIBUTOR_ROLE_REMIXEDARTIST, true
+	case "REMIXER":
+		return ResourceContributorRole_RESOURCE_CONTRIBUTOR_ROLE_REMIXER, true
+	case "REMIXINGENGINEER":
+		return ResourceContributorRole_RESOURCE_CONTRIBUTOR_ROLE_REMIXINGENGINEER, true
+	case "REMIXINGSECONDENGINEER":
+		return ResourceContributorRole_RESOURCE_CONTRIBUTOR_ROLE_REMIXINGSECONDENGINEER, true
+	case "REPETITEUR":
+		return ResourceContributorRole_RESOURCE_CONTRIBUTOR_ROLE_REPETITEUR, true
+	case "RESEARCHER":
+		return ResourceContributorRole_RESOURCE_CONTRIBUTOR_ROLE_RESEARCHER, true
+	case "RESEARCHTEAMHEAD":
+		return ResourceContributorRole_RESOURCE_CONTRIBUTOR_ROLE_RESEARCHTEAMHEAD, true
+	case "RESEARCHTEAMMEMBER":
+		return ResourceContributorRole_RESOURCE_CONTRIBUTOR_ROLE_RESEARCHTEAMMEMBER, true
+	case "RESTAGER":
+		return ResourceContributorRole_RESOURCE_CONTRIBUTOR_ROLE_RESTAGER, true
+	case "RIGGER":
+		return ResourceContributorRole_RESOURCE_CONTRIBUTOR_ROLE_RIGGER, true
+	case "RIGHTSCONTROLLERONPRODUCT":
+		return ResourceContributorRole_RESOURCE_CONTRIBUTOR_ROLE_RIGHTSCONTROLLERONPRODUCT, true
+	case "RUNNER":
+		return ResourceContributorRole_RESOURCE_CONTRIBUTOR_ROLE_RUNNER, true
+	case "SCENICOPERATIVE":
+		return ResourceContributorRole_RESOURCE_CONTRIBUTOR_ROLE_SCENICOPERATIVE, true
+	case "SCIENTIFICADVISOR":
+		return ResourceContributorRole_RESOURCE_CONTRIBUTOR_ROLE_SCIENTIFICADVISOR, true
+	case "SCRIPTSUPERVISOR":
+		return ResourceContributorRole_RESOURCE_CONTRIBUTOR_ROLE_SCRIPTSUPERVISOR, true
+	case "SECONDASSISTANTCAMERAOPERATOR":
+		return ResourceContributorRole_RESOURCE_CONTRIBUTOR_ROLE_SECONDASSISTANTCAMERAOPERATOR, true
+	case "SECONDASSISTANTDIRECTOR":
+		return ResourceContributorRole_RESOURCE_CONTRIBUTOR_ROLE_SECONDASSISTANTDIRECTOR, true
+	case "SECONDCONDUCTOR":
+		return ResourceContributorRole_RESOURCE_CONTRIBUTOR_ROLE_SECONDCONDUCTOR, true
+	case "SECONDENGINEER":
+		return ResourceContributorRole_RESOURCE_CONTRIBUTOR_ROLE_SECONDENGINEER, true
+	case "SECONDUNITDIRECTOR":
+		return ResourceContributorRole_RESOURCE_CONTRIBUTOR_ROLE_SECONDUNITDIRECTOR, true
+	case "SERIESPRODUCER":
+		return ResourceContributorRole_RESOURCE_CONTRIBUTOR_ROLE_SERIESPRODUCER, true
+	case "SETDESIGNER":
+		return ResourceContributorRole_RESOURCE_CONTRIBUTOR_ROLE_SETDESIGNER, true
+	case "SETDRESSER":
+		return ResourceContributorRole_RESOURCE_CONTRIBUTOR_ROLE_SETDRESSER, true
+	case "SIGNLANGUAGEINTERPRETER":
+		return ResourceContributorRole_RESOURCE_CONTRIBUTOR_ROLE_SIGNLANGUAGEINTERPRETER, true
+	case "SOLOIST":
+		return ResourceContributorRole_RESOURCE_CONTRIBUTOR_ROLE_SOLOIST, true
+	case "SOUNDDESIGNER":
+		return ResourceContributorRole_RESOURCE_CONTRIBUTOR_ROLE_SOUNDDESIGNER, true
+	case "SOUNDMIXER":
+		return ResourceContributorRole_RESOURCE_CONTRIBUTOR_ROLE_SOUNDMIXER, true
+	case "SOUNDRECORDIST":
+		return ResourceContributorRole_RESOURCE_CONTRIBUTOR_ROLE_SOUNDRECORDIST, true
+	case "SOUNDSUPERVISOR":
+		return ResourceContributorRole_RESOURCE_CONTRIBUTOR_ROLE_SOUNDSUPERVISOR, true
+	case "SPEAKER":
+		return ResourceContributorRole_RESOURCE_CONTRIBUTOR_ROLE_SPEAKER, true
+	case "SPECIALEFFECTSTECHNICIAN":
+		return ResourceContributorRole_RESOURCE_CONTRIBUTOR_ROLE_SPECIALEFFECTSTECHNICIAN, true
+	case "SPONSOR":
+		return ResourceContributorRole_RESOURCE_CONTRIBUTOR_ROLE_SPONSOR, true
+	case "STAGEASSISTANTENGINEER":
+		return ResourceContributorRole_RESOURCE_CONTRIBUTOR_ROLE_STAGEASSISTANTENGINEER, true
+	case "STAGEDIRECTOR":
+		return ResourceContributorRole_RESOURCE_CONTRIBUTOR_ROLE_STAGEDIRECTOR, true
+	case "STAGEENGINEER":
+		return ResourceContributorRole_RESOURCE_CONTRIBUTOR_ROLE_STAGEENGINEER, true
+	case "STORYTELLER":
+		return ResourceContributorRole_RESOURCE_CONTRIBUTOR_ROLE_STORYTELLER, true
+	case "STRINGENGINEER":
+		return ResourceContributorRole_RESOURCE_CONTRIBUTOR_ROLE_STRINGENGINEER, true
+	case "STRINGPRODUCER":
+		return ResourceContributorRole_RESOURCE_CONTRIBUTOR_ROLE_STRINGPRODUCER, true
+	case "STRINGSDIRECTOR":
+		return ResourceContributorRole_RESOURCE_CONTRIBUTOR_ROLE_STRINGSDIRECTOR, true
+	case "STUDIOCONDUCTOR":
+		return ResourceContributorRole_RESOURCE_CONTRIBUTOR_ROLE_STUDIOCONDUCTOR, true
+	case "STUDIOMUSICIAN":
+		return ResourceContributorRole_RESOURCE_CONTRIBUTOR_ROLE_STUDIOMUSICIAN, true
+	case "STUDIOPERSONNEL":
+		return ResourceContributorRole_RESOURCE_CONTRIBUTOR_ROLE_STUDIOPERSONNEL, true
+	case "STUDIOPRODUCER":
+		return ResourceContributorRole_RESOURCE_CONTRIBUTOR_ROLE_STUDIOPRODUCER, true
+	case "STUNTS":
+		return ResourceContributorRole_RESOURCE_CONTRIBUTOR_ROLE_STUNTS, true
+	case "SUBTITLESEDITOR":
+		return ResourceContributorRole_RESOURCE_CONTRIBUTOR_ROLE_SUBTITLESEDITOR, true
+	case "SUBTITLESTRANSLATOR":
+		return ResourceContributorRole_RESOURCE_CONTRIBUTOR_ROLE_SUBTITLESTRANSLATOR, true
+	case "SUPPORTINGACTOR":
+		return ResourceContributorRole_RESOURCE_CONTRIBUTOR_ROLE_SUPPORTINGACTOR, true
+	case "SURROUNDMIXINGENGINEER":
+		return ResourceContributorRole_RESOURCE_CONTRIBUTOR_ROLE_SURROUNDMIXINGENGINEER, true
+	case "SURROUNDMIXINGSECONDENGINEER":
+		return ResourceContributorRole_RESOURCE_CONTRIBUTOR_ROLE_SURROUNDMIXINGSECONDENGINEER, true
+	case "TAPEOPERATOR":
+		return ResourceContributorRole_RESOURCE_CONTRIBUTOR_ROLE_TAPEOPERATOR, true
+	case "TECHNICALDIRECTOR":
+		return ResourceContributorRole_RESOURCE_CONTRIBUTOR_ROLE_TECHNICALDIRECTOR, true
+	case "TONMEISTER":
+		return ResourceContributorRole_RESOURCE_CONTRIBUTOR_ROLE_TONMEISTER, true
+	case "TRACKINGENGINEER":
+		return ResourceContributorRole_RESOURCE_CONTRIBUTOR_ROLE_TRACKINGENGINEER, true
+	case "TRACKINGSECONDENGINEER":
+		return ResourceContributorRole_RESOURCE_CONTRIBUTOR_ROLE_TRACKINGSECONDENGINEER, true
+	case "TRANSFERSANDSAFETIESENGINEER":
+		return ResourceContributorRole_RESOURCE_CONTRIBUTOR_ROLE_TRANSFERSANDSAFETIESENGINEER, true
+	case "TRANSFERSANDSAFETIESSECONDENGINEER":
+		return ResourceContributorRole_RESOURCE_CONTRIBUTOR_ROLE_TRANSFERSANDSAFETIESSECONDENGINEER, true
+	case "TRANSPORTATIONMANAGER":
+		return ResourceContributorRole_RESOURCE_CONTRIBUTOR_ROLE_TRANSPORTATIONMANAGER, true
+	case "TREATMENT_PROGRAMPROPOSAL":
+		return ResourceContributorRole_RESOURCE_CONTRIBUTOR_ROLE_TREATMENT_PROGRAMPROPOSAL, true
+	case "TYPEDESIGNER":
+		return ResourceContributorRole_RESOURCE_CONTRIBUTOR_ROLE_TYPEDESIGNER, true
+	case "UNKNOWN":
+		return ResourceContributorRole_RESOURCE_CONTRIBUTOR_ROLE_UNKNOWN, true
+	case "USERDEFINED":
+		return ResourceContributorRole_RESOURCE_CONTRIBUTOR_ROLE_USERDEFINED, true
+	case "VIDEODIRECTOR":
+		return ResourceContributorRole_RESOURCE_CONTRIBUTOR_ROLE_VIDEODIRECTOR, true
+	case "VIDEOGRAPHER":
+		return ResourceContributorRole_RESOURCE_CONTRIBUTOR_ROLE_VIDEOGRAPHER, true
+	case "VIDEOMUSICALDIRECTOR":
+		return ResourceContributorRole_RESOURCE_CONTRIBUTOR_ROLE_VIDEOMUSICALDIRECTOR, true
+	case "VIDEOPRODUCER":
+		return ResourceContributorRole_RESOURCE_CONTRIBUTOR_ROLE_VIDEOPRODUCER, true
+	case "VISIONMIXER":
+		return ResourceContributorRole_RESOURCE_CONTRIBUTOR_ROLE_VISIONMIXER, true
+	case "VISUALEDITOR":
+		return ResourceContributorRole_RESOURCE_CONTRIBUTOR_ROLE_VISUALEDITOR, true
+	case "VISUALEFFECTSTECHNICIAN":
+		return ResourceContributorRole_RESOURCE_CONTRIBUTOR_ROLE_VISUALEFFECTSTECHNICIAN, true
+	case "VOCALARRANGER":
+		return ResourceContributorRole_RESOURCE_CONTRIBUTOR_ROLE_VOCALARRANGER, true
+	case "VOCALEDITINGENGINEER":
+		return ResourceContributorRole_RESOURCE_CONTRIBUTOR_ROLE_VOCALEDITINGENGINEER, true
+	case "VOCALEDITINGSECONDENGINEER":
+		return ResourceContributorRole_RESOURCE_CONTRIBUTOR_ROLE_VOCALEDITINGSECONDENGINEER, true
+	case "VOCALENGINEER":
+		return ResourceContributorRole_RESOURCE_CONTRIBUTOR_ROLE_VOCALENGINEER, true
+	case "VOCALIST":
+		return ResourceContributorRole_RESOURCE_CONTRIBUTOR_ROLE_VOCALIST, true
+	case "VOCALSECONDENGINEER":
+		return ResourceContributorRole_RESOURCE_CONTRIBUTOR_ROLE_VOCALSECONDENGINEER, true
+	case "VOCALPRODUCER":
+		return ResourceContributorRole_RESOURCE_CONTRIBUTOR_ROLE_VOCALPRODUCER, true
+	case "VOICEACTOR":
+		return ResourceContributorRole_RESOURCE_CONTRIBUTOR_ROLE_VOICEACTOR, true
+	case "WARDROBE":
+		return ResourceContributorRole_RESOURCE_CONTRIBUTOR_ROLE_WARDROBE, true
+	case "BAND":
+		return ResourceContributorRole_RESOURCE_CONTRIBUTOR_ROLE_BAND, true
+	case "CONTRIBUTOR":
+		return ResourceContributorRole_RESOURCE_CONTRIBUTOR_ROLE_CONTRIBUTOR, true
+	case "FEATUREDARTIST":
+		return ResourceContributorRole_RESOURCE_CONTRIBUTOR_ROLE_FEATUREDARTIST, true
+	case "MAINARTIST":
+		return ResourceContributorRole_RESOURCE_CONTRIBUTOR_ROLE_MAINARTIST, true
+	case "MEMBER":
+		return ResourceContributorRole_RESOURCE_CONTRIBUTOR_ROLE_MEMBER, true
+	case "PRODUCER":
+		return ResourceContributorRole_RESOURCE_CONTRIBUTOR_ROLE_PRODUCER, true
+	case "IMMERSIVEMIXINGENGINEER":
+		return ResourceContributorRole_RESOURCE_CONTRIBUTOR_ROLE_IMMERSIVEMIXINGENGINEER, true
+	case "PRESENTER":
+		return ResourceContributorRole_RESOURCE_CONTRIBUTOR_ROLE_PRESENTER, true
 	default:
 		return ResourceContributorRole(0), false
 	}
@@ -1781,6 +16179,14 @@ func ParseResourceContributorRoleString(s string) (ResourceContributorRole, bool
 // XMLString returns the XML string representation of ResourceOmissionReason
 func (e ResourceOmissionReason) XMLString() string {
 	switch e {
+	case ResourceOmissionReason_RESOURCE_OMISSION_REASON_PASSPORTSERVICERELEASE:
+		return "PASSPORTSERVICERELEASE"
+	case ResourceOmissionReason_RESOURCE_OMISSION_REASON_PRERELEASE:
+		return "PRERELEASE"
+	case ResourceOmissionReason_RESOURCE_OMISSION_REASON_USERDEFINED:
+		return "USERDEFINED"
+	case ResourceOmissionReason_RESOURCE_OMISSION_REASON_VIRTUALRELEASE:
+		return "VIRTUALRELEASE"
 	default:
 		return ""
 	}
@@ -1790,6 +16196,14 @@ func (e ResourceOmissionReason) XMLString() string {
 func ParseResourceOmissionReasonString(s string) (ResourceOmissionReason, bool) {
 	s = strings.ToUpper(s)
 	switch s {
+	case "PASSPORTSERVICERELEASE":
+		return ResourceOmissionReason_RESOURCE_OMISSION_REASON_PASSPORTSERVICERELEASE, true
+	case "PRERELEASE":
+		return ResourceOmissionReason_RESOURCE_OMISSION_REASON_PRERELEASE, true
+	case "USERDEFINED":
+		return ResourceOmissionReason_RESOURCE_OMISSION_REASON_USERDEFINED, true
+	case "VIRTUALRELEASE":
+		return ResourceOmissionReason_RESOURCE_OMISSION_REASON_VIRTUALRELEASE, true
 	default:
 		return ResourceOmissionReason(0), false
 	}
@@ -1798,6 +16212,22 @@ func ParseResourceOmissionReasonString(s string) (ResourceOmissionReason, bool)
 // XMLString returns the XML string representation of ResourceType
 func (e ResourceType) XMLString() string {
 	switch e {
+	case ResourceType_RESOURCE_TYPE_IMAGE:
+		return "IMAGE"
+	case ResourceType_RESOURCE_TYPE_MIDI:
+		return "MIDI"
+	case ResourceType_RESOURCE_TYPE_SHEETMUSIC:
+		return "SHEETMUSIC"
+	case ResourceType_RESOURCE_TYPE_SOFTWARE:
+		return "SOFTWARE"
+	case ResourceType_RESOURCE_TYPE_SOUNDRECORDING:
+		return "SOUNDRECORDING"
+	case ResourceType_RESOURCE_TYPE_TEXT:
+		return "TEXT"
+	case ResourceType_RESOURCE_TYPE_USERDEFINEDRESOURCE:
+		return "USERDEFINEDRESOURCE"
+	case ResourceType_RESOURCE_TYPE_VIDEO:
+		return "VIDEO"
 	default:
 		return ""
 	}
@@ -1807,6 +16237,22 @@ func (e ResourceType) XMLString() string {
 func ParseResourceTypeString(s string) (ResourceType, bool) {
 	s = strings.ToUpper(s)
 	switch s {
+	case "IMAGE":
+		return ResourceType_RESOURCE_TYPE_IMAGE, true
+	case "MIDI":
+		return ResourceType_RESOURCE_TYPE_MIDI, true
+	case "SHEETMUSIC":
+		return ResourceType_RESOURCE_TYPE_SHEETMUSIC, true
+	case "SOFTWARE":
+		return ResourceType_RESOURCE_TYPE_SOFTWARE, true
+	case "SOUNDRECORDING":
+		return ResourceType_RESOURCE_TYPE_SOUNDRECORDING, true
+	case "TEXT":
+		return ResourceType_RESOURCE_TYPE_TEXT, true
+	case "USERDEFINEDRESOURCE":
+		return ResourceType_RESOURCE_TYPE_USERDEFINEDRESOURCE, true
+	case "VIDEO":
+		return ResourceType_RESOURCE_TYPE_VIDEO, true
 	default:
 		return ResourceType(0), false
 	}
@@ -1815,6 +16261,12 @@ func ParseResourceTypeString(s string) (ResourceType, bool) {
 // XMLString returns the XML string representation of RevenueSourceType
 func (e RevenueSourceType) XMLString() string {
 	switch e {
+	case RevenueSourceType_REVENUE_SOURCE_TYPE_FINANCIALREVENUE:
+		return "FINANCIALREVENUE"
+	case RevenueSourceType_REVENUE_SOURCE_TYPE_INDEMNITYREVENUE:
+		return "INDEMNITYREVENUE"
+	case RevenueSourceType_REVENUE_SOURCE_TYPE_ROYALTYREVENUE:
+		return "ROYALTYREVENUE"
 	default:
 		return ""
 	}
@@ -1824,6 +16276,12 @@ func (e RevenueSourceType) XMLString() string {
 func ParseRevenueSourceTypeString(s string) (RevenueSourceType, bool) {
 	s = strings.ToUpper(s)
 	switch s {
+	case "FINANCIALREVENUE":
+		return RevenueSourceType_REVENUE_SOURCE_TYPE_FINANCIALREVENUE, true
+	case "INDEMNITYREVENUE":
+		return RevenueSourceType_REVENUE_SOURCE_TYPE_INDEMNITYREVENUE, true
+	case "ROYALTYREVENUE":
+		return RevenueSourceType_REVENUE_SOURCE_TYPE_ROYALTYREVENUE, true
 	default:
 		return RevenueSourceType(0), false
 	}
@@ -1832,6 +16290,14 @@ func ParseRevenueSourceTypeString(s string) (RevenueSourceType, bool) {
 // XMLString returns the XML string representation of RightShareType
 func (e RightShareType) XMLString() string {
 	switch e {
+	case RightShareType_RIGHT_SHARE_TYPE_MUSICALWORKMANUSCRIPTSHARE:
+		return "MUSICALWORKMANUSCRIPTSHARE"
+	case RightShareType_RIGHT_SHARE_TYPE_MUSICALWORKCOLLECTIONSHARE:
+		return "MUSICALWORKCOLLECTIONSHARE"
+	case RightShareType_RIGHT_SHARE_TYPE_ORIGINALPUBLISHERSHARE:
+		return "ORIGINALPUBLISHERSHARE"
+	case RightShareType_RIGHT_SHARE_TYPE_LICENSINGSHARE:
+		return "LICENSINGSHARE"
 	default:
 		return ""
 	}
@@ -1841,6 +16307,14 @@ func (e RightShareType) XMLString() string {
 func ParseRightShareTypeString(s string) (RightShareType, bool) {
 	s = strings.ToUpper(s)
 	switch s {
+	case "MUSICALWORKMANUSCRIPTSHARE":
+		return RightShareType_RIGHT_SHARE_TYPE_MUSICALWORKMANUSCRIPTSHARE, true
+	case "MUSICALWORKCOLLECTIONSHARE":
+		return RightShareType_RIGHT_SHARE_TYPE_MUSICALWORKCOLLECTIONSHARE, true
+	case "ORIGINALPUBLISHERSHARE":
+		return RightShareType_RIGHT_SHARE_TYPE_ORIGINALPUBLISHERSHARE, true
+	case "LICENSINGSHARE":
+		return RightShareType_RIGHT_SHARE_TYPE_LICENSINGSHARE, true
 	default:
 		return RightShareType(0), false
 	}
@@ -1849,6 +16323,12 @@ func ParseRightShareTypeString(s string) (RightShareType, bool) {
 // XMLString returns the XML string representation of RightsClaimPolicyType
 func (e RightsClaimPolicyType) XMLString() string {
 	switch e {
+	case RightsClaimPolicyType_RIGHTS_CLAIM_POLICY_TYPE_REPORTUSAGE:
+		return "REPORTUSAGE"
+	case RightsClaimPolicyType_RIGHTS_CLAIM_POLICY_TYPE_BLOCKACCESS:
+		return "BLOCKACCESS"
+	case RightsClaimPolicyType_RIGHTS_CLAIM_POLICY_TYPE_MONETIZE:
+		return "MONETIZE"
 	default:
 		return ""
 	}
@@ -1858,6 +16338,12 @@ func (e RightsClaimPolicyType) XMLString() string {
 func ParseRightsClaimPolicyTypeString(s string) (RightsClaimPolicyType, bool) {
 	s = strings.ToUpper(s)
 	switch s {
+	case "REPORTUSAGE":
+		return RightsClaimPolicyType_RIGHTS_CLAIM_POLICY_TYPE_REPORTUSAGE, true
+	case "BLOCKACCESS":
+		return RightsClaimPolicyType_RIGHTS_CLAIM_POLICY_TYPE_BLOCKACCESS, true
+	case "MONETIZE":
+		return RightsClaimPolicyType_RIGHTS_CLAIM_POLICY_TYPE_MONETIZE, true
 	default:
 		return RightsClaimPolicyType(0), false
 	}
@@ -1866,6 +16352,18 @@ func ParseRightsClaimPolicyTypeString(s string) (RightsClaimPolicyType, bool) {
 // XMLString returns the XML string representation of RightsControllerRole
 func (e RightsControllerRole) XMLString() string {
 	switch e {
+	case RightsControllerRole_RIGHTS_CONTROLLER_ROLE_ADMINISTRATINGRECORDCOMPANY:
+		return "ADMINISTRATINGRECORDCOMPANY"
+	case RightsControllerRole_RIGHTS_CONTROLLER_ROLE_RIGHTSADMINISTRATOR:
+		return "RIGHTSADMINISTRATOR"
+	case RightsControllerRole_RIGHTS_CONTROLLER_ROLE_RIGHTSCONTROLLER:
+		return "RIGHTSCONTROLLER"
+	case RightsControllerRole_RIGHTS_CONTROLLER_ROLE_ROYALTYADMINISTRATOR:
+		return "ROYALTYADMINISTRATOR"
+	case RightsControllerRole_RIGHTS_CONTROLLER_ROLE_UNKNOWN:
+		return "UNKNOWN"
+	case RightsControllerRole_RIGHTS_CONTROLLER_ROLE_LOCALPAYEE:
+		return "LOCALPAYEE"
 	default:
 		return ""
 	}
@@ -1875,6 +16373,18 @@ func (e RightsControllerRole) XMLString() string {
 func ParseRightsControllerRoleString(s string) (RightsControllerRole, bool) {
 	s = strings.ToUpper(s)
 	switch s {
+	case "ADMINISTRATINGRECORDCOMPANY":
+		return RightsControllerRole_RIGHTS_CONTROLLER_ROLE_ADMINISTRATINGRECORDCOMPANY, true
+	case "RIGHTSADMINISTRATOR":
+		return RightsControllerRole_RIGHTS_CONTROLLER_ROLE_RIGHTSADMINISTRATOR, true
+	case "RIGHTSCONTROLLER":
+		return RightsControllerRole_RIGHTS_CONTROLLER_ROLE_RIGHTSCONTROLLER, true
+	case "ROYALTYADMINISTRATOR":
+		return RightsControllerRole_RIGHTS_CONTROLLER_ROLE_ROYALTYADMINISTRATOR, true
+	case "UNKNOWN":
+		return RightsControllerRole_RIGHTS_CONTROLLER_ROLE_UNKNOWN, true
+	case "LOCALPAYEE":
+		return RightsControllerRole_RIGHTS_CONTROLLER_ROLE_LOCALPAYEE, true
 	default:
 		return RightsControllerRole(0), false
 	}
@@ -1883,6 +16393,12 @@ func ParseRightsControllerRoleString(s string) (RightsControllerRole, bool) {
 // XMLString returns the XML string representation of RightsControllerType
 func (e RightsControllerType) XMLString() string {
 	switch e {
+	case RightsControllerType_RIGHTS_CONTROLLER_TYPE_ORIGINALOWNER:
+		return "ORIGINALOWNER"
+	case RightsControllerType_RIGHTS_CONTROLLER_TYPE_SUCCESSORINTITLE:
+		return "SUCCESSORINTITLE"
+	case RightsControllerType_RIGHTS_CONTROLLER_TYPE_EXCLUSIVELICENSEE:
+		return "EXCLUSIVELICENSEE"
 	default:
 		return ""
 	}
@@ -1892,6 +16408,12 @@ func (e RightsControllerType) XMLString() string {
 func ParseRightsControllerTypeString(s string) (RightsControllerType, bool) {
 	s = strings.ToUpper(s)
 	switch s {
+	case "ORIGINALOWNER":
+		return RightsControllerType_RIGHTS_CONTROLLER_TYPE_ORIGINALOWNER, true
+	case "SUCCESSORINTITLE":
+		return RightsControllerType_RIGHTS_CONTROLLER_TYPE_SUCCESSORINTITLE, true
+	case "EXCLUSIVELICENSEE":
+		return RightsControllerType_RIGHTS_CONTROLLER_TYPE_EXCLUSIVELICENSEE, true
 	default:
 		return RightsControllerType(0), false
 	}
@@ -1900,6 +16422,20 @@ func ParseRightsControllerTypeString(s string) (RightsControllerType, bool) {
 // XMLString returns the XML string representation of RightsCoverage
 func (e RightsCoverage) XMLString() string {
 	switch e {
+	case RightsCoverage_RIGHTS_COVERAGE_MAKEAVAILABLERIGHT:
+		return "MAKEAVAILABLERIGHT"
+	case RightsCoverage_RIGHTS_COVERAGE_MECHANICALRIGHT:
+		return "MECHANICALRIGHT"
+	case RightsCoverage_RIGHTS_COVERAGE_PERFORMINGRIGHT:
+		return "PERFORMINGRIGHT"
+	case RightsCoverage_RIGHTS_COVERAGE_PRINTRIGHT:
+		return "PRINTRIGHT"
+	case RightsCoverage_RIGHTS_COVERAGE_REPRODUCTIONRIGHT:
+		return "REPRODUCTIONRIGHT"
+	case RightsCoverage_RIGHTS_COVERAGE_SYNCHRONIZATIONRIGHT:
+		return "SYNCHRONIZATIONRIGHT"
+	case RightsCoverage_RIGHTS_COVERAGE_USERDEFINED:
+		return "USERDEFINED"
 	default:
 		return ""
 	}
@@ -1909,6 +16445,20 @@ func (e RightsCoverage) XMLString() string {
 func ParseRightsCoverageString(s string) (RightsCoverage, bool) {
 	s = strings.ToUpper(s)
 	switch s {
+	case "MAKEAVAILABLERIGHT":
+		return RightsCoverage_RIGHTS_COVERAGE_MAKEAVAILABLERIGHT, true
+	case "MECHANICALRIGHT":
+		return RightsCoverage_RIGHTS_COVERAGE_MECHANICALRIGHT, true
+	case "PERFORMINGRIGHT":
+		return RightsCoverage_RIGHTS_COVERAGE_PERFORMINGRIGHT, true
+	case "PRINTRIGHT":
+		return RightsCoverage_RIGHTS_COVERAGE_PRINTRIGHT, true
+	case "REPRODUCTIONRIGHT":
+		return RightsCoverage_RIGHTS_COVERAGE_REPRODUCTIONRIGHT, true
+	case "SYNCHRONIZATIONRIGHT":
+		return RightsCoverage_RIGHTS_COVERAGE_SYNCHRONIZATIONRIGHT, true
+	case "USERDEFINED":
+		return RightsCoverage_RIGHTS_COVERAGE_USERDEFINED, true
 	default:
 		return RightsCoverage(0), false
 	}
@@ -1917,6 +16467,26 @@ func ParseRightsCoverageString(s string) (RightsCoverage, bool) {
 // XMLString returns the XML string representation of RoyaltyRateCalculationType
 func (e RoyaltyRateCalculationType) XMLString() string {
 	switch e {
+	case RoyaltyRateCalculationType_ROYALTY_RATE_CALCULATION_TYPE_BUDGETROYALTYRATE:
+		return "BUDGETROYALTYRATE"
+	case RoyaltyRateCalculationType_ROYALTY_RATE_CALCULATION_TYPE_CONTROLLEDCOMPOSITIONROYALTYRATE:
+		return "CONTROLLEDCOMPOSITIONROYALTYRATE"
+	case RoyaltyRateCalculationType_ROYALTY_RATE_CALCULATION_TYPE_CONTROLLEDSHAREROYALTYRATE:
+		return "CONTROLLEDSHAREROYALTYRATE"
+	case RoyaltyRateCalculationType_ROYALTY_RATE_CALCULATION_TYPE_MINIMUMSTATUTORYROYALTYRATE:
+		return "MINIMUMSTATUTORYROYALTYRATE"
+	case RoyaltyRateCalculationType_ROYALTY_RATE_CALCULATION_TYPE_NEGOTIATEDROYALTYRATE:
+		return "NEGOTIATEDROYALTYRATE"
+	case RoyaltyRateCalculationType_ROYALTY_RATE_CALCULATION_TYPE_REDUCEDROYALTYRATE:
+		return "REDUCEDROYALTYRATE"
+	case RoyaltyRateCalculationType_ROYALTY_RATE_CALCULATION_TYPE_REDUCEDSTATUTORYROYALTYRATE:
+		return "REDUCEDSTATUTORYROYALTYRATE"
+	case RoyaltyRateCalculationType_ROYALTY_RATE_CALCULATION_TYPE_STATUTORYROYALTYRATE:
+		return "STATUTORYROYALTYRATE"
+	case RoyaltyRateCalculationType_ROYALTY_RATE_CALCULATION_TYPE_PPD:
+		return "PPD"
+	case RoyaltyRateCalculationType_ROYALTY_RATE_CALCULATION_TYPE_RETAILPRICE:
+		return "RETAILPRICE"
 	default:
 		return ""
 	}
@@ -1926,6 +16496,26 @@ func (e RoyaltyRateCalculationType) XMLString() string {
 func ParseRoyaltyRateCalculationTypeString(s string) (RoyaltyRateCalculationType, bool) {
 	s = strings.ToUpper(s)
 	switch s {
+	case "BUDGETROYALTYRATE":
+		return RoyaltyRateCalculationType_ROYALTY_RATE_CALCULATION_TYPE_BUDGETROYALTYRATE, true
+	case "CONTROLLEDCOMPOSITIONROYALTYRATE":
+		return RoyaltyRateCalculationType_ROYALTY_RATE_CALCULATION_TYPE_CONTROLLEDCOMPOSITIONROYALTYRATE, true
+	case "CONTROLLEDSHAREROYALTYRATE":
+		return RoyaltyRateCalculationType_ROYALTY_RATE_CALCULATION_TYPE_CONTROLLEDSHAREROYALTYRATE, true
+	case "MINIMUMSTATUTORYROYALTYRATE":
+		return RoyaltyRateCalculationType_ROYALTY_RATE_CALCULATION_TYPE_MINIMUMSTATUTORYROYALTYRATE, true
+	case "NEGOTIATEDROYALTYRATE":
+		return RoyaltyRateCalculationType_ROYALTY_RATE_CALCULATION_TYPE_NEGOTIATEDROYALTYRATE, true
+	case "REDUCEDROYALTYRATE":
+		return RoyaltyRateCalculationType_ROYALTY_RATE_CALCULATION_TYPE_REDUCEDROYALTYRATE, true
+	case "REDUCEDSTATUTORYROYALTYRATE":
+		return RoyaltyRateCalculationType_ROYALTY_RATE_CALCULATION_TYPE_REDUCEDSTATUTORYROYALTYRATE, true
+	case "STATUTORYROYALTYRATE":
+		return RoyaltyRateCalculationType_ROYALTY_RATE_CALCULATION_TYPE_STATUTORYROYALTYRATE, true
+	case "PPD":
+		return RoyaltyRateCalculationType_ROYALTY_RATE_CALCULATION_TYPE_PPD, true
+	case "RETAILPRICE":
+		return RoyaltyRateCalculationType_ROYALTY_RATE_CALCULATION_TYPE_RETAILPRICE, true
 	default:
 		return RoyaltyRateCalculationType(0), false
 	}
@@ -1934,6 +16524,12 @@ func ParseRoyaltyRateCalculationTypeString(s string) (RoyaltyRateCalculationType
 // XMLString returns the XML string representation of RoyaltyRateType
 func (e RoyaltyRateType) XMLString() string {
 	switch e {
+	case RoyaltyRateType_ROYALTY_RATE_TYPE_PENNYRATE:
+		return "PENNYRATE"
+	case RoyaltyRateType_ROYALTY_RATE_TYPE_PERCENTAGEROYALTYRATE:
+		return "PERCENTAGEROYALTYRATE"
+	case RoyaltyRateType_ROYALTY_RATE_TYPE_USERDEFINED:
+		return "USERDEFINED"
 	default:
 		return ""
 	}
@@ -1943,6 +16539,12 @@ func (e RoyaltyRateType) XMLString() string {
 func ParseRoyaltyRateTypeString(s string) (RoyaltyRateType, bool) {
 	s = strings.ToUpper(s)
 	switch s {
+	case "PENNYRATE":
+		return RoyaltyRateType_ROYALTY_RATE_TYPE_PENNYRATE, true
+	case "PERCENTAGEROYALTYRATE":
+		return RoyaltyRateType_ROYALTY_RATE_TYPE_PERCENTAGEROYALTYRATE, true
+	case "USERDEFINED":
+		return RoyaltyRateType_ROYALTY_RATE_TYPE_USERDEFINED, true
 	default:
 		return RoyaltyRateType(0), false
 	}
@@ -1951,6 +16553,10 @@ func ParseRoyaltyRateTypeString(s string) (RoyaltyRateType, bool) {
 // XMLString returns the XML string representation of SalesReportAvailabilityStatus
 func (e SalesReportAvailabilityStatus) XMLString() string {
 	switch e {
+	case SalesReportAvailabilityStatus_SALES_REPORT_AVAILABILITY_STATUS_SALESREPORTAVAILABLE:
+		return "SALESREPORTAVAILABLE"
+	case SalesReportAvailabilityStatus_SALES_REPORT_AVAILABILITY_STATUS_SALESREPORTNOTAVAILABLE:
+		return "SALESREPORTNOTAVAILABLE"
 	default:
 		return ""
 	}
@@ -1960,6 +16566,10 @@ func (e SalesReportAvailabilityStatus) XMLString() string {
 func ParseSalesReportAvailabilityStatusString(s string) (SalesReportAvailabilityStatus, bool) {
 	s = strings.ToUpper(s)
 	switch s {
+	case "SALESREPORTAVAILABLE":
+		return SalesReportAvailabilityStatus_SALES_REPORT_AVAILABILITY_STATUS_SALESREPORTAVAILABLE, true
+	case "SALESREPORTNOTAVAILABLE":
+		return SalesReportAvailabilityStatus_SALES_REPORT_AVAILABILITY_STATUS_SALESREPORTNOTAVAILABLE, true
 	default:
 		return SalesReportAvailabilityStatus(0), false
 	}
@@ -1997,6 +16607,18 @@ func ParseSexString(s string) (Sex, bool) {
 // XMLString returns the XML string representation of SoftwareType
 func (e SoftwareType) XMLString() string {
 	switch e {
+	case SoftwareType_SOFTWARE_TYPE_INTERACTIVEBOOKLET:
+		return "INTERACTIVEBOOKLET"
+	case SoftwareType_SOFTWARE_TYPE_MUSICALWORKBASEDGAME:
+		return "MUSICALWORKBASEDGAME"
+	case SoftwareType_SOFTWARE_TYPE_NONMUSICALWORKBASEDGAME:
+		return "NONMUSICALWORKBASEDGAME"
+	case SoftwareType_SOFTWARE_TYPE_SCREENSAVER:
+		return "SCREENSAVER"
+	case SoftwareType_SOFTWARE_TYPE_UNKNOWN:
+		return "UNKNOWN"
+	case SoftwareType_SOFTWARE_TYPE_USERDEFINED:
+		return "USERDEFINED"
 	default:
 		return ""
 	}
@@ -2006,6 +16628,18 @@ func (e SoftwareType) XMLString() string {
 func ParseSoftwareTypeString(s string) (SoftwareType, bool) {
 	s = strings.ToUpper(s)
 	switch s {
+	case "INTERACTIVEBOOKLET":
+		return SoftwareType_SOFTWARE_TYPE_INTERACTIVEBOOKLET, true
+	case "MUSICALWORKBASEDGAME":
+		return SoftwareType_SOFTWARE_TYPE_MUSICALWORKBASEDGAME, true
+	case "NONMUSICALWORKBASEDGAME":
+		return SoftwareType_SOFTWARE_TYPE_NONMUSICALWORKBASEDGAME, true
+	case "SCREENSAVER":
+		return SoftwareType_SOFTWARE_TYPE_SCREENSAVER, true
+	case "UNKNOWN":
+		return SoftwareType_SOFTWARE_TYPE_UNKNOWN, true
+	case "USERDEFINED":
+		return SoftwareType_SOFTWARE_TYPE_USERDEFINED, true
 	default:
 		return SoftwareType(0), false
 	}
@@ -2014,6 +16648,16 @@ func ParseSoftwareTypeString(s string) (SoftwareType, bool) {
 // XMLString returns the XML string representation of SoundProcessorType
 func (e SoundProcessorType) XMLString() string {
 	switch e {
+	case SoundProcessorType_SOUND_PROCESSOR_TYPE_MIDIPROCESSOR:
+		return "MIDIPROCESSOR"
+	case SoundProcessorType_SOUND_PROCESSOR_TYPE_SMAF_MA2:
+		return "SMAF_MA2"
+	case SoundProcessorType_SOUND_PROCESSOR_TYPE_SMAF_MA3:
+		return "SMAF_MA3"
+	case SoundProcessorType_SOUND_PROCESSOR_TYPE_UNKNOWN:
+		return "UNKNOWN"
+	case SoundProcessorType_SOUND_PROCESSOR_TYPE_USERDEFINED:
+		return "USERDEFINED"
 	default:
 		return ""
 	}
@@ -2023,6 +16667,16 @@ func (e SoundProcessorType) XMLString() string {
 func ParseSoundProcessorTypeString(s string) (SoundProcessorType, bool) {
 	s = strings.ToUpper(s)
 	switch s {
+	case "MIDIPROCESSOR":
+		return SoundProcessorType_SOUND_PROCESSOR_TYPE_MIDIPROCESSOR, true
+	case "SMAF_MA2":
+		return SoundProcessorType_SOUND_PROCESSOR_TYPE_SMAF_MA2, true
+	case "SMAF_MA3":
+		return SoundProcessorType_SOUND_PROCESSOR_TYPE_SMAF_MA3, true
+	case "UNKNOWN":
+		return SoundProcessorType_SOUND_PROCESSOR_TYPE_UNKNOWN, true
+	case "USERDEFINED":
+		return SoundProcessorType_SOUND_PROCESSOR_TYPE_USERDEFINED, true
 	default:
 		return SoundProcessorType(0), false
 	}
@@ -2031,6 +16685,22 @@ func ParseSoundProcessorTypeString(s string) (SoundProcessorType, bool) {
 // XMLString returns the XML string representation of SoundRecordingType
 func (e SoundRecordingType) XMLString() string {
 	switch e {
+	case SoundRecordingType_SOUND_RECORDING_TYPE_MUSICALWORKREADALONGSOUNDRECORDING:
+		return "MUSICALWORKREADALONGSOUNDRECORDING"
+	case SoundRecordingType_SOUND_RECORDING_TYPE_MUSICALWORKSOUNDRECORDING:
+		return "MUSICALWORKSOUNDRECORDING"
+	case SoundRecordingType_SOUND_RECORDING_TYPE_NONMUSICALWORKREADALONGSOUNDRECORDING:
+		return "NONMUSICALWORKREADALONGSOUNDRECORDING"
+	case SoundRecordingType_SOUND_RECORDING_TYPE_NONMUSICALWORKSOUNDRECORDING:
+		return "NONMUSICALWORKSOUNDRECORDING"
+	case SoundRecordingType_SOUND_RECORDING_TYPE_SPOKENWORDSOUNDRECORDING:
+		return "SPOKENWORDSOUNDRECORDING"
+	case SoundRecordingType_SOUND_RECORDING_TYPE_UNKNOWN:
+		return "UNKNOWN"
+	case SoundRecordingType_SOUND_RECORDING_TYPE_USERDEFINED:
+		return "USERDEFINED"
+	case SoundRecordingType_SOUND_RECORDING_TYPE_AUDIOSTEM:
+		return "AUDIOSTEM"
 	default:
 		return ""
 	}
@@ -2040,6 +16710,22 @@ func (e SoundRecordingType) XMLString() string {
 func ParseSoundRecordingTypeString(s string) (SoundRecordingType, bool) {
 	s = strings.ToUpper(s)
 	switch s {
+	case "MUSICALWORKREADALONGSOUNDRECORDING":
+		return SoundRecordingType_SOUND_RECORDING_TYPE_MUSICALWORKREADALONGSOUNDRECORDING, true
+	case "MUSICALWORKSOUNDRECORDING":
+		return SoundRecordingType_SOUND_RECORDING_TYPE_MUSICALWORKSOUNDRECORDING, true
+	case "NONMUSICALWORKREADALONGSOUNDRECORDING":
+		return SoundRecordingType_SOUND_RECORDING_TYPE_NONMUSICALWORKREADALONGSOUNDRECORDING, true
+	case "NONMUSICALWORKSOUNDRECORDING":
+		return SoundRecordingType_SOUND_RECORDING_TYPE_NONMUSICALWORKSOUNDRECORDING, true
+	case "SPOKENWORDSOUNDRECORDING":
+		return SoundRecordingType_SOUND_RECORDING_TYPE_SPOKENWORDSOUNDRECORDING, true
+	case "UNKNOWN":
+		return SoundRecordingType_SOUND_RECORDING_TYPE_UNKNOWN, true
+	case "USERDEFINED":
+		return SoundRecordingType_SOUND_RECORDING_TYPE_USERDEFINED, true
+	case "AUDIOSTEM":
+		return SoundRecordingType_SOUND_RECORDING_TYPE_AUDIOSTEM, true
 	default:
 		return SoundRecordingType(0), false
 	}
@@ -2048,6 +16734,34 @@ func ParseSoundRecordingTypeString(s string) (SoundRecordingType, bool) {
 // XMLString returns the XML string representation of SupplyChainStatus
 func (e SupplyChainStatus) XMLString() string {
 	switch e {
+	case SupplyChainStatus_SUPPLY_CHAIN_STATUS_DELIVEREDTORELEASEDISTRIBUTOR:
+		return "DELIVEREDTORELEASEDISTRIBUTOR"
+	case SupplyChainStatus_SUPPLY_CHAIN_STATUS_INDELIVERYTORELEASEDISTRIBUTOR:
+		return "INDELIVERYTORELEASEDISTRIBUTOR"
+	case SupplyChainStatus_SUPPLY_CHAIN_STATUS_INPREPARATIONFORDELIVERYTORELEASEDISTRIBUTOR:
+		return "INPREPARATIONFORDELIVERYTORELEASEDISTRIBUTOR"
+	case SupplyChainStatus_SUPPLY_CHAIN_STATUS_ORDERPLACEDFORRELEASEDISTRIBUTOR:
+		return "ORDERPLACEDFORRELEASEDISTRIBUTOR"
+	case SupplyChainStatus_SUPPLY_CHAIN_STATUS_PROCESSINGERRORATRELEASECREATOR:
+		return "PROCESSINGERRORATRELEASECREATOR"
+	case SupplyChainStatus_SUPPLY_CHAIN_STATUS_PROCESSINGERRORATRELEASEDISTRIBUTOR:
+		return "PROCESSINGERRORATRELEASEDISTRIBUTOR"
+	case SupplyChainStatus_SUPPLY_CHAIN_STATUS_RELEASEMADEAVAILABLETOCONSUMERS:
+		return "RELEASEMADEAVAILABLETOCONSUMERS"
+	case SupplyChainStatus_SUPPLY_CHAIN_STATUS_RELEASENOTAVAILABLE:
+		return "RELEASENOTAVAILABLE"
+	case SupplyChainStatus_SUPPLY_CHAIN_STATUS_RELEASERECEIVEDBYRELEASEDISTRIBUTOR:
+		return "RELEASERECEIVEDBYRELEASEDISTRIBUTOR"
+	case SupplyChainStatus_SUPPLY_CHAIN_STATUS_RELEASESTAGEDFORPUBLICATION:
+		return "RELEASESTAGEDFORPUBLICATION"
+	case SupplyChainStatus_SUPPLY_CHAIN_STATUS_SUCCESSFULLYINGESTEDBYRELEASEDISTRIBUTOR:
+		return "SUCCESSFULLYINGESTEDBYRELEASEDISTRIBUTOR"
+	case SupplyChainStatus_SUPPLY_CHAIN_STATUS_USERDEFINED:
+		return "USERDEFINED"
+	case SupplyChainStatus_SUPPLY_CHAIN_STATUS_RELEASEVIOLATESTERMSOFSERVICE:
+		return "RELEASEVIOLATESTERMSOFSERVICE"
+	case SupplyChainStatus_SUPPLY_CHAIN_STATUS_RIGHTSCONFLICT:
+		return "RIGHTSCONFLICT"
 	default:
 		return ""
 	}
@@ -2057,6 +16771,34 @@ func (e SupplyChainStatus) XMLString() string {
 func ParseSupplyChainStatusString(s string) (SupplyChainStatus, bool) {
 	s = strings.ToUpper(s)
 	switch s {
+	case "DELIVEREDTORELEASEDISTRIBUTOR":
+		return SupplyChainStatus_SUPPLY_CHAIN_STATUS_DELIVEREDTORELEASEDISTRIBUTOR, true
+	case "INDELIVERYTORELEASEDISTRIBUTOR":
+		return SupplyChainStatus_SUPPLY_CHAIN_STATUS_INDELIVERYTORELEASEDISTRIBUTOR, true
+	case "INPREPARATIONFORDELIVERYTORELEASEDISTRIBUTOR":
+		return SupplyChainStatus_SUPPLY_CHAIN_STATUS_INPREPARATIONFORDELIVERYTORELEASEDISTRIBUTOR, true
+	case "ORDERPLACEDFORRELEASEDISTRIBUTOR":
+		return SupplyChainStatus_SUPPLY_CHAIN_STATUS_ORDERPLACEDFORRELEASEDISTRIBUTOR, true
+	case "PROCESSINGERRORATRELEASECREATOR":
+		return SupplyChainStatus_SUPPLY_CHAIN_STATUS_PROCESSINGERRORATRELEASECREATOR, true
+	case "PROCESSINGERRORATRELEASEDISTRIBUTOR":
+		return SupplyChainStatus_SUPPLY_CHAIN_STATUS_PROCESSINGERRORATRELEASEDISTRIBUTOR, true
+	case "RELEASEMADEAVAILABLETOCONSUMERS":
+		return SupplyChainStatus_SUPPLY_CHAIN_STATUS_RELEASEMADEAVAILABLETOCONSUMERS, true
+	case "RELEASENOTAVAILABLE":
+		return SupplyChainStatus_SUPPLY_CHAIN_STATUS_RELEASENOTAVAILABLE, true
+	case "RELEASERECEIVEDBYRELEASEDISTRIBUTOR":
+		return SupplyChainStatus_SUPPLY_CHAIN_STATUS_RELEASERECEIVEDBYRELEASEDISTRIBUTOR, true
+	case "RELEASESTAGEDFORPUBLICATION":
+		return SupplyChainStatus_SUPPLY_CHAIN_STATUS_RELEASESTAGEDFORPUBLICATION, true
+	case "SUCCESSFULLYINGESTEDBYRELEASEDISTRIBUTOR":
+		return SupplyChainStatus_SUPPLY_CHAIN_STATUS_SUCCESSFULLYINGESTEDBYRELEASEDISTRIBUTOR, true
+	case "USERDEFINED":
+		return SupplyChainStatus_SUPPLY_CHAIN_STATUS_USERDEFINED, true
+	case "RELEASEVIOLATESTERMSOFSERVICE":
+		return SupplyChainStatus_SUPPLY_CHAIN_STATUS_RELEASEVIOLATESTERMSOFSERVICE, true
+	case "RIGHTSCONFLICT":
+		return SupplyChainStatus_SUPPLY_CHAIN_STATUS_RIGHTSCONFLICT, true
 	default:
 		return SupplyChainStatus(0), false
 	}
@@ -2065,6 +16807,18 @@ func ParseSupplyChainStatusString(s string) (SupplyChainStatus, bool) {
 // XMLString returns the XML string representation of TaxScope
 func (e TaxScope) XMLString() string {
 	switch e {
+	case TaxScope_TAX_SCOPE_COMBINEDTAX:
+		return "COMBINEDTAX"
+	case TaxScope_TAX_SCOPE_FEDERALTAX:
+		return "FEDERALTAX"
+	case TaxScope_TAX_SCOPE_LOCALTAX:
+		return "LOCALTAX"
+	case TaxScope_TAX_SCOPE_PROVINCIALTAX:
+		return "PROVINCIALTAX"
+	case TaxScope_TAX_SCOPE_STATETAX:
+		return "STATETAX"
+	case TaxScope_TAX_SCOPE_USERDEFINED:
+		return "USERDEFINED"
 	default:
 		return ""
 	}
@@ -2074,6 +16828,18 @@ func (e TaxScope) XMLString() string {
 func ParseTaxScopeString(s string) (TaxScope, bool) {
 	s = strings.ToUpper(s)
 	switch s {
+	case "COMBINEDTAX":
+		return TaxScope_TAX_SCOPE_COMBINEDTAX, true
+	case "FEDERALTAX":
+		return TaxScope_TAX_SCOPE_FEDERALTAX, true
+	case "LOCALTAX":
+		return TaxScope_TAX_SCOPE_LOCALTAX, true
+	case "PROVINCIALTAX":
+		return TaxScope_TAX_SCOPE_PROVINCIALTAX, true
+	case "STATETAX":
+		return TaxScope_TAX_SCOPE_STATETAX, true
+	case "USERDEFINED":
+		return TaxScope_TAX_SCOPE_USERDEFINED, true
 	default:
 		return TaxScope(0), false
 	}
@@ -2082,6 +16848,16 @@ func ParseTaxScopeString(s string) (TaxScope, bool) {
 // XMLString returns the XML string representation of TaxType
 func (e TaxType) XMLString() string {
 	switch e {
+	case TaxType_TAX_TYPE_COMBINEDTAX:
+		return "COMBINEDTAX"
+	case TaxType_TAX_TYPE_SALESTAX:
+		return "SALESTAX"
+	case TaxType_TAX_TYPE_SERVICETAX:
+		return "SERVICETAX"
+	case TaxType_TAX_TYPE_SOURCETAX:
+		return "SOURCETAX"
+	case TaxType_TAX_TYPE_USERDEFINED:
+		return "USERDEFINED"
 	default:
 		return ""
 	}
@@ -2091,6 +16867,16 @@ func (e TaxType) XMLString() string {
 func ParseTaxTypeString(s string) (TaxType, bool) {
 	s = strings.ToUpper(s)
 	switch s {
+	case "COMBINEDTAX":
+		return TaxType_TAX_TYPE_COMBINEDTAX, true
+	case "SALESTAX":
+		return TaxType_TAX_TYPE_SALESTAX, true
+	case "SERVICETAX":
+		return TaxType_TAX_TYPE_SERVICETAX, true
+	case "SOURCETAX":
+		return TaxType_TAX_TYPE_SOURCETAX, true
+	case "USERDEFINED":
+		return TaxType_TAX_TYPE_USERDEFINED, true
 	default:
 		return TaxType(0), false
 	}
@@ -2099,6 +16885,10 @@ func ParseTaxTypeString(s string) (TaxType, bool) {
 // XMLString returns the XML string representation of TerritoryCodeType
 func (e TerritoryCodeType) XMLString() string {
 	switch e {
+	case TerritoryCodeType_TERRITORY_CODE_TYPE_ISO:
+		return "ISO"
+	case TerritoryCodeType_TERRITORY_CODE_TYPE_TIS:
+		return "TIS"
 	default:
 		return ""
 	}
@@ -2108,6 +16898,10 @@ func (e TerritoryCodeType) XMLString() string {
 func ParseTerritoryCodeTypeString(s string) (TerritoryCodeType, bool) {
 	s = strings.ToUpper(s)
 	switch s {
+	case "ISO":
+		return TerritoryCodeType_TERRITORY_CODE_TYPE_ISO, true
+	case "TIS":
+		return TerritoryCodeType_TERRITORY_CODE_TYPE_TIS, true
 	default:
 		return TerritoryCodeType(0), false
 	}
@@ -2116,6 +16910,12 @@ func ParseTerritoryCodeTypeString(s string) (TerritoryCodeType, bool) {
 // XMLString returns the XML string representation of TerritoryCodeTypeIncludingDeprecatedCodes
 func (e TerritoryCodeTypeIncludingDeprecatedCodes) XMLString() string {
 	switch e {
+	case TerritoryCodeTypeIncludingDeprecatedCodes_TERRITORY_CODE_TYPE_INCLUDING_DEPRECATED_CODES_DEPRECATEDISO:
+		return "DEPRECATEDISO"
+	case TerritoryCodeTypeIncludingDeprecatedCodes_TERRITORY_CODE_TYPE_INCLUDING_DEPRECATED_CODES_ISO:
+		return "ISO"
+	case TerritoryCodeTypeIncludingDeprecatedCodes_TERRITORY_CODE_TYPE_INCLUDING_DEPRECATED_CODES_TIS:
+		return "TIS"
 	default:
 		return ""
 	}
@@ -2125,6 +16925,12 @@ func (e TerritoryCodeTypeIncludingDeprecatedCodes) XMLString() string {
 func ParseTerritoryCodeTypeIncludingDeprecatedCodesString(s string) (TerritoryCodeTypeIncludingDeprecatedCodes, bool) {
 	s = strings.ToUpper(s)
 	switch s {
+	case "DEPRECATEDISO":
+		return TerritoryCodeTypeIncludingDeprecatedCodes_TERRITORY_CODE_TYPE_INCLUDING_DEPRECATED_CODES_DEPRECATEDISO, true
+	case "ISO":
+		return TerritoryCodeTypeIncludingDeprecatedCodes_TERRITORY_CODE_TYPE_INCLUDING_DEPRECATED_CODES_ISO, true
+	case "TIS":
+		return TerritoryCodeTypeIncludingDeprecatedCodes_TERRITORY_CODE_TYPE_INCLUDING_DEPRECATED_CODES_TIS, true
 	default:
 		return TerritoryCodeTypeIncludingDeprecatedCodes(0), false
 	}
@@ -2133,6 +16939,52 @@ func ParseTerritoryCodeTypeIncludingDeprecatedCodesString(s string) (TerritoryCo
 // XMLString returns the XML string representation of TextCodecType
 func (e TextCodecType) XMLString() string {
 	switch e {
+	case TextCodecType_TEXT_CODEC_TYPE_ASCII:
+		return "ASCII"
+	case TextCodecType_TEXT_CODEC_TYPE_EBU_TT:
+		return "EBU_TT"
+	case TextCodecType_TEXT_CODEC_TYPE_HTML:
+		return "HTML"
+	case TextCodecType_TEXT_CODEC_TYPE_OOXML:
+		return "OOXML"
+	case TextCodecType_TEXT_CODEC_TYPE_PDF:
+		return "PDF"
+	case TextCodecType_TEXT_CODEC_TYPE_POSTSCRIPT:
+		return "POSTSCRIPT"
+	case TextCodecType_TEXT_CODEC_TYPE_RTF:
+		return "RTF"
+	case TextCodecType_TEXT_CODEC_TYPE_SRT:
+		return "SRT"
+	case TextCodecType_TEXT_CODEC_TYPE_TTML:
+		return "TTML"
+	case TextCodecType_TEXT_CODEC_TYPE_UNKNOWN:
+		return "UNKNOWN"
+	case TextCodecType_TEXT_CODEC_TYPE_USERDEFINED:
+		return "USERDEFINED"
+	case TextCodecType_TEXT_CODEC_TYPE_VTT:
+		return "VTT"
+	case TextCodecType_TEXT_CODEC_TYPE_ASCIIORISO8859NTEXT:
+		return "ASCIIORISO8859NTEXT"
+	case TextCodecType_TEXT_CODEC_TYPE_ENHANCEDLRC:
+		return "ENHANCEDLRC"
+	case TextCodecType_TEXT_CODEC_TYPE_EPUB:
+		return "EPUB"
+	case TextCodecType_TEXT_CODEC_TYPE_LRC:
+		return "LRC"
+	case TextCodecType_TEXT_CODEC_TYPE_MICROSOFTWORD:
+		return "MICROSOFTWORD"
+	case TextCodecType_TEXT_CODEC_TYPE_OPENDOCUMENTTEXT:
+		return "OPENDOCUMENTTEXT"
+	case TextCodecType_TEXT_CODEC_TYPE_SIMPLELRC:
+		return "SIMPLELRC"
+	case TextCodecType_TEXT_CODEC_TYPE_UTF8TEXT:
+		return "UTF8TEXT"
+	case TextCodecType_TEXT_CODEC_TYPE_WINDOWSTEXT:
+		return "WINDOWSTEXT"
+	case TextCodecType_TEXT_CODEC_TYPE_XHTML:
+		return "XHTML"
+	case TextCodecType_TEXT_CODEC_TYPE_XML:
+		return "XML"
 	default:
 		return ""
 	}
@@ -2142,6 +16994,52 @@ func (e TextCodecType) XMLString() string {
 func ParseTextCodecTypeString(s string) (TextCodecType, bool) {
 	s = strings.ToUpper(s)
 	switch s {
+	case "ASCII":
+		return TextCodecType_TEXT_CODEC_TYPE_ASCII, true
+	case "EBU_TT":
+		return TextCodecType_TEXT_CODEC_TYPE_EBU_TT, true
+	case "HTML":
+		return TextCodecType_TEXT_CODEC_TYPE_HTML, true
+	case "OOXML":
+		return TextCodecType_TEXT_CODEC_TYPE_OOXML, true
+	case "PDF":
+		return TextCodecType_TEXT_CODEC_TYPE_PDF, true
+	case "POSTSCRIPT":
+		return TextCodecType_TEXT_CODEC_TYPE_POSTSCRIPT, true
+	case "RTF":
+		return TextCodecType_TEXT_CODEC_TYPE_RTF, true
+	case "SRT":
+		return TextCodecType_TEXT_CODEC_TYPE_SRT, true
+	case "TTML":
+		return TextCodecType_TEXT_CODEC_TYPE_TTML, true
+	case "UNKNOWN":
+		return TextCodecType_TEXT_CODEC_TYPE_UNKNOWN, true
+	case "USERDEFINED":
+		return TextCodecType_TEXT_CODEC_TYPE_USERDEFINED, true
+	case "VTT":
+		return TextCodecType_TEXT_CODEC_TYPE_VTT, true
+	case "ASCIIORISO8859NTEXT":
+		return TextCodecType_TEXT_CODEC_TYPE_ASCIIORISO8859NTEXT, true
+	case "ENHANCEDLRC":
+		return TextCodecType_TEXT_CODEC_TYPE_ENHANCEDLRC, true
+	case "EPUB":
+		return TextCodecType_TEXT_CODEC_TYPE_EPUB, true
+	case "LRC":
+		return TextCodecType_TEXT_CODEC_TYPE_LRC, true
+	case "MICROSOFTWORD":
+		return TextCodecType_TEXT_CODEC_TYPE_MICROSOFTWORD, true
+	case "OPENDOCUMENTTEXT":
+		return TextCodecType_TEXT_CODEC_TYPE_OPENDOCUMENTTEXT, true
+	case "SIMPLELRC":
+		return TextCodecType_TEXT_CODEC_TYPE_SIMPLELRC, true
+	case "UTF8TEXT":
+		return TextCodecType_TEXT_CODEC_TYPE_UTF8TEXT, true
+	case "WINDOWSTEXT":
+		return TextCodecType_TEXT_CODEC_TYPE_WINDOWSTEXT, true
+	case "XHTML":
+		return TextCodecType_TEXT_CODEC_TYPE_XHTML, true
+	case "XML":
+		return TextCodecType_TEXT_CODEC_TYPE_XML, true
 	default:
 		return TextCodecType(0), false
 	}
@@ -2150,6 +17048,22 @@ func ParseTextCodecTypeString(s string) (TextCodecType, bool) {
 // XMLString returns the XML string representation of TextType
 func (e TextType) XMLString() string {
 	switch e {
+	case TextType_TEXT_TYPE_CAPTION:
+		return "CAPTION"
+	case TextType_TEXT_TYPE_EBOOK:
+		return "EBOOK"
+	case TextType_TEXT_TYPE_LINERNOTES:
+		return "LINERNOTES"
+	case TextType_TEXT_TYPE_LYRICTEXT:
+		return "LYRICTEXT"
+	case TextType_TEXT_TYPE_NONINTERACTIVEBOOKLET:
+		return "NONINTERACTIVEBOOKLET"
+	case TextType_TEXT_TYPE_TEXTDOCUMENT:
+		return "TEXTDOCUMENT"
+	case TextType_TEXT_TYPE_UNKNOWN:
+		return "UNKNOWN"
+	case TextType_TEXT_TYPE_USERDEFINED:
+		return "USERDEFINED"
 	default:
 		return ""
 	}
@@ -2159,6 +17073,22 @@ func (e TextType) XMLString() string {
 func ParseTextTypeString(s string) (TextType, bool) {
 	s = strings.ToUpper(s)
 	switch s {
+	case "CAPTION":
+		return TextType_TEXT_TYPE_CAPTION, true
+	case "EBOOK":
+		return TextType_TEXT_TYPE_EBOOK, true
+	case "LINERNOTES":
+		return TextType_TEXT_TYPE_LINERNOTES, true
+	case "LYRICTEXT":
+		return TextType_TEXT_TYPE_LYRICTEXT, true
+	case "NONINTERACTIVEBOOKLET":
+		return TextType_TEXT_TYPE_NONINTERACTIVEBOOKLET, true
+	case "TEXTDOCUMENT":
+		return TextType_TEXT_TYPE_TEXTDOCUMENT, true
+	case "UNKNOWN":
+		return TextType_TEXT_TYPE_UNKNOWN, true
+	case "USERDEFINED":
+		return TextType_TEXT_TYPE_USERDEFINED, true
 	default:
 		return TextType(0), false
 	}
@@ -2167,6 +17097,18 @@ func ParseTextTypeString(s string) (TextType, bool) {
 // XMLString returns the XML string representation of ThemeType
 func (e ThemeType) XMLString() string {
 	switch e {
+	case ThemeType_THEME_TYPE_CLOSINGTHEME:
+		return "CLOSINGTHEME"
+	case ThemeType_THEME_TYPE_MAINTHEME:
+		return "MAINTHEME"
+	case ThemeType_THEME_TYPE_OPENINGTHEME:
+		return "OPENINGTHEME"
+	case ThemeType_THEME_TYPE_SEGMENTTHEME:
+		return "SEGMENTTHEME"
+	case ThemeType_THEME_TYPE_TITLETHEME:
+		return "TITLETHEME"
+	case ThemeType_THEME_TYPE_USERDEFINED:
+		return "USERDEFINED"
 	default:
 		return ""
 	}
@@ -2176,6 +17118,18 @@ func (e ThemeType) XMLString() string {
 func ParseThemeTypeString(s string) (ThemeType, bool) {
 	s = strings.ToUpper(s)
 	switch s {
+	case "CLOSINGTHEME":
+		return ThemeType_THEME_TYPE_CLOSINGTHEME, true
+	case "MAINTHEME":
+		return ThemeType_THEME_TYPE_MAINTHEME, true
+	case "OPENINGTHEME":
+		return ThemeType_THEME_TYPE_OPENINGTHEME, true
+	case "SEGMENTTHEME":
+		return ThemeType_THEME_TYPE_SEGMENTTHEME, true
+	case "TITLETHEME":
+		return ThemeType_THEME_TYPE_TITLETHEME, true
+	case "USERDEFINED":
+		return ThemeType_THEME_TYPE_USERDEFINED, true
 	default:
 		return ThemeType(0), false
 	}
@@ -2184,6 +17138,500 @@ func ParseThemeTypeString(s string) (ThemeType, bool) {
 // XMLString returns the XML string representation of TisTerritoryCode
 func (e TisTerritoryCode) XMLString() string {
 	switch e {
+	case TisTerritoryCode_TIS_TERRITORY_CODE_E_4:
+		return "4"
+	case TisTerritoryCode_TIS_TERRITORY_CODE_E_8:
+		return "8"
+	case TisTerritoryCode_TIS_TERRITORY_CODE_E_12:
+		return "12"
+	case TisTerritoryCode_TIS_TERRITORY_CODE_E_20:
+		return "20"
+	case TisTerritoryCode_TIS_TERRITORY_CODE_E_24:
+		return "24"
+	case TisTerritoryCode_TIS_TERRITORY_CODE_E_28:
+		return "28"
+	case TisTerritoryCode_TIS_TERRITORY_CODE_E_31:
+		return "31"
+	case TisTerritoryCode_TIS_TERRITORY_CODE_E_32:
+		return "32"
+	case TisTerritoryCode_TIS_TERRITORY_CODE_E_36:
+		return "36"
+	case TisTerritoryCode_TIS_TERRITORY_CODE_E_40:
+		return "40"
+	case TisTerritoryCode_TIS_TERRITORY_CODE_E_44:
+		return "44"
+	case TisTerritoryCode_TIS_TERRITORY_CODE_E_48:
+		return "48"
+	case TisTerritoryCode_TIS_TERRITORY_CODE_E_50:
+		return "50"
+	case TisTerritoryCode_TIS_TERRITORY_CODE_E_51:
+		return "51"
+	case TisTerritoryCode_TIS_TERRITORY_CODE_E_52:
+		return "52"
+	case TisTerritoryCode_TIS_TERRITORY_CODE_E_56:
+		return "56"
+	case TisTerritoryCode_TIS_TERRITORY_CODE_E_64:
+		return "64"
+	case TisTerritoryCode_TIS_TERRITORY_CODE_E_68:
+		return "68"
+	case TisTerritoryCode_TIS_TERRITORY_CODE_E_70:
+		return "70"
+	case TisTerritoryCode_TIS_TERRITORY_CODE_E_72:
+		return "72"
+	case TisTerritoryCode_TIS_TERRITORY_CODE_E_76:
+		return "76"
+	case TisTerritoryCode_TIS_TERRITORY_CODE_E_84:
+		return "84"
+	case TisTerritoryCode_TIS_TERRITORY_CODE_E_90:
+		return "90"
+	case TisTerritoryCode_TIS_TERRITORY_CODE_E_96:
+		return "96"
+	case TisTerritoryCode_TIS_TERRITORY_CODE_E_100:
+		return "100"
+	case TisTerritoryCode_TIS_TERRITORY_CODE_E_104:
+		return "104"
+	case TisTerritoryCode_TIS_TERRITORY_CODE_E_108:
+		return "108"
+	case TisTerritoryCode_TIS_TERRITORY_CODE_E_112:
+		return "112"
+	case TisTerritoryCode_TIS_TERRITORY_CODE_E_116:
+		return "116"
+	case TisTerritoryCode_TIS_TERRITORY_CODE_E_120:
+		return "120"
+	case TisTerritoryCode_TIS_TERRITORY_CODE_E_124:
+		return "124"
+	case TisTerritoryCode_TIS_TERRITORY_CODE_E_132:
+		return "132"
+	case TisTerritoryCode_TIS_TERRITORY_CODE_E_140:
+		return "140"
+	case TisTerritoryCode_TIS_TERRITORY_CODE_E_144:
+		return "144"
+	case TisTerritoryCode_TIS_TERRITORY_CODE_E_148:
+		return "148"
+	case TisTerritoryCode_TIS_TERRITORY_CODE_E_152:
+		return "152"
+	case TisTerritoryCode_TIS_TERRITORY_CODE_E_156:
+		return "156"
+	case TisTerritoryCode_TIS_TERRITORY_CODE_E_158:
+		return "158"
+	case TisTerritoryCode_TIS_TERRITORY_CODE_E_170:
+		return "170"
+	case TisTerritoryCode_TIS_TERRITORY_CODE_E_174:
+		return "174"
+	case TisTerritoryCode_TIS_TERRITORY_CODE_E_178:
+		return "178"
+	case TisTerritoryCode_TIS_TERRITORY_CODE_E_180:
+		return "180"
+	case TisTerritoryCode_TIS_TERRITORY_CODE_E_188:
+		return "188"
+	case TisTerritoryCode_TIS_TERRITORY_CODE_E_191:
+		return "191"
+	case TisTerritoryCode_TIS_TERRITORY_CODE_E_192:
+		return "192"
+	case TisTerritoryCode_TIS_TERRITORY_CODE_E_196:
+		return "196"
+	case TisTerritoryCode_TIS_TERRITORY_CODE_E_200:
+		return "200"
+	case TisTerritoryCode_TIS_TERRITORY_CODE_E_203:
+		return "203"
+	case TisTerritoryCode_TIS_TERRITORY_CODE_E_204:
+		return "204"
+	case TisTerritoryCode_TIS_TERRITORY_CODE_E_208:
+		return "208"
+	case TisTerritoryCode_TIS_TERRITORY_CODE_E_212:
+		return "212"
+	case TisTerritoryCode_TIS_TERRITORY_CODE_E_214:
+		return "214"
+	case TisTerritoryCode_TIS_TERRITORY_CODE_E_218:
+		return "218"
+	case TisTerritoryCode_TIS_TERRITORY_CODE_E_222:
+		return "222"
+	case TisTerritoryCode_TIS_TERRITORY_CODE_E_226:
+		return "226"
+	case TisTerritoryCode_TIS_TERRITORY_CODE_E_230:
+		return "230"
+	case TisTerritoryCode_TIS_TERRITORY_CODE_E_231:
+		return "231"
+	case TisTerritoryCode_TIS_TERRITORY_CODE_E_232:
+		return "232"
+	case TisTerritoryCode_TIS_TERRITORY_CODE_E_233:
+		return "233"
+	case TisTerritoryCode_TIS_TERRITORY_CODE_E_242:
+		return "242"
+	case TisTerritoryCode_TIS_TERRITORY_CODE_E_246:
+		return "246"
+	case TisTerritoryCode_TIS_TERRITORY_CODE_E_250:
+		return "250"
+	case TisTerritoryCode_TIS_TERRITORY_CODE_E_258:
+		return "258"
+	case TisTerritoryCode_TIS_TERRITORY_CODE_E_262:
+		return "262"
+	case TisTerritoryCode_TIS_TERRITORY_CODE_E_266:
+		return "266"
+	case TisTerritoryCode_TIS_TERRITORY_CODE_E_268:
+		return "268"
+	case TisTerritoryCode_TIS_TERRITORY_CODE_E_270:
+		return "270"
+	case TisTerritoryCode_TIS_TERRITORY_CODE_E_276:
+		return "276"
+	case TisTerritoryCode_TIS_TERRITORY_CODE_E_278:
+		return "278"
+	case TisTerritoryCode_TIS_TERRITORY_CODE_E_280:
+		return "280"
+	case TisTerritoryCode_TIS_TERRITORY_CODE_E_288:
+		return "288"
+	case TisTerritoryCode_TIS_TERRITORY_CODE_E_296:
+		return "296"
+	case TisTerritoryCode_TIS_TERRITORY_CODE_E_300:
+		return "300"
+	case TisTerritoryCode_TIS_TERRITORY_CODE_E_308:
+		return "308"
+	case TisTerritoryCode_TIS_TERRITORY_CODE_E_320:
+		return "320"
+	case TisTerritoryCode_TIS_TERRITORY_CODE_E_324:
+		return "324"
+	case TisTerritoryCode_TIS_TERRITORY_CODE_E_328:
+		return "328"
+	case TisTerritoryCode_TIS_TERRITORY_CODE_E_332:
+		return "332"
+	case TisTerritoryCode_TIS_TERRITORY_CODE_E_336:
+		return "336"
+	case TisTerritoryCode_TIS_TERRITORY_CODE_E_340:
+		return "340"
+	case TisTerritoryCode_TIS_TERRITORY_CODE_E_344:
+		return "344"
+	case TisTerritoryCode_TIS_TERRITORY_CODE_E_348:
+		return "348"
+	case TisTerritoryCode_TIS_TERRITORY_CODE_E_352:
+		return "352"
+	case TisTerritoryCode_TIS_TERRITORY_CODE_E_356:
+		return "356"
+	case TisTerritoryCode_TIS_TERRITORY_CODE_E_360:
+		return "360"
+	case TisTerritoryCode_TIS_TERRITORY_CODE_E_364:
+		return "364"
+	case TisTerritoryCode_TIS_TERRITORY_CODE_E_368:
+		return "368"
+	case TisTerritoryCode_TIS_TERRITORY_CODE_E_372:
+		return "372"
+	case TisTerritoryCode_TIS_TERRITORY_CODE_E_376:
+		return "376"
+	case TisTerritoryCode_TIS_TERRITORY_CODE_E_380:
+		return "380"
+	case TisTerritoryCode_TIS_TERRITORY_CODE_E_384:
+		return "384"
+	case TisTerritoryCode_TIS_TERRITORY_CODE_E_388:
+		return "388"
+	case TisTerritoryCode_TIS_TERRITORY_CODE_E_392:
+		return "392"
+	case TisTerritoryCode_TIS_TERRITORY_CODE_E_398:
+		return "398"
+	case TisTerritoryCode_TIS_TERRITORY_CODE_E_400:
+		return "400"
+	case TisTerritoryCode_TIS_TERRITORY_CODE_E_404:
+		return "404"
+	case TisTerritoryCode_TIS_TERRITORY_CODE_E_408:
+		return "408"
+	case TisTerritoryCode_TIS_TERRITORY_CODE_E_410:
+		return "410"
+	case TisTerritoryCode_TIS_TERRITORY_CODE_E_414:
+		return "414"
+	case TisTerritoryCode_TIS_TERRITORY_CODE_E_417:
+		return "417"
+	case TisTerritoryCode_TIS_TERRITORY_CODE_E_418:
+		return "418"
+	case TisTerritoryCode_TIS_TERRITORY_CODE_E_422:
+		return "422"
+	case TisTerritoryCode_TIS_TERRITORY_CODE_E_426:
+		return "426"
+	case TisTerritoryCode_TIS_TERRITORY_CODE_E_428:
+		return "428"
+	case TisTerritoryCode_TIS_TERRITORY_CODE_E_430:
+		return "430"
+	case TisTerritoryCode_TIS_TERRITORY_CODE_E_434:
+		return "434"
+	case TisTerritoryCode_TIS_TERRITORY_CODE_E_438:
+		return "438"
+	case TisTerritoryCode_TIS_TERRITORY_CODE_E_440:
+		return "440"
+	case TisTerritoryCode_TIS_TERRITORY_CODE_E_442:
+		return "442"
+	case TisTerritoryCode_TIS_TERRITORY_CODE_E_450:
+		return "450"
+	case TisTerritoryCode_TIS_TERRITORY_CODE_E_454:
+		return "454"
+	case TisTerritoryCode_TIS_TERRITORY_CODE_E_458:
+		return "458"
+	case TisTerritoryCode_TIS_TERRITORY_CODE_E_462:
+		return "462"
+	case TisTerritoryCode_TIS_TERRITORY_CODE_E_466:
+		return "466"
+	case TisTerritoryCode_TIS_TERRITORY_CODE_E_470:
+		return "470"
+	case TisTerritoryCode_TIS_TERRITORY_CODE_E_478:
+		return "478"
+	case TisTerritoryCode_TIS_TERRITORY_CODE_E_480:
+		return "480"
+	case TisTerritoryCode_TIS_TERRITORY_CODE_E_484:
+		return "484"
+	case TisTerritoryCode_TIS_TERRITORY_CODE_E_492:
+		return "492"
+	case TisTerritoryCode_TIS_TERRITORY_CODE_E_496:
+		return "496"
+	case TisTerritoryCode_TIS_TERRITORY_CODE_E_498:
+		return "498"
+	case TisTerritoryCode_TIS_TERRITORY_CODE_E_499:
+		return "499"
+	case TisTerritoryCode_TIS_TERRITORY_CODE_E_504:
+		return "504"
+	case TisTerritoryCode_TIS_TERRITORY_CODE_E_508:
+		return "508"
+	case TisTerritoryCode_TIS_TERRITORY_CODE_E_512:
+		return "512"
+	case TisTerritoryCode_TIS_TERRITORY_CODE_E_516:
+		return "516"
+	case TisTerritoryCode_TIS_TERRITORY_CODE_E_520:
+		return "520"
+	case TisTerritoryCode_TIS_TERRITORY_CODE_E_524:
+		return "524"
+	case TisTerritoryCode_TIS_TERRITORY_CODE_E_528:
+		return "528"
+	case TisTerritoryCode_TIS_TERRITORY_CODE_E_540:
+		return "540"
+	case TisTerritoryCode_TIS_TERRITORY_CODE_E_548:
+		return "548"
+	case TisTerritoryCode_TIS_TERRITORY_CODE_E_554:
+		return "554"
+	case TisTerritoryCode_TIS_TERRITORY_CODE_E_558:
+		return "558"
+	case TisTerritoryCode_TIS_TERRITORY_CODE_E_562:
+		return "562"
+	case TisTerritoryCode_TIS_TERRITORY_CODE_E_566:
+		return "566"
+	case TisTerritoryCode_TIS_TERRITORY_CODE_E_578:
+		return "578"
+	case TisTerritoryCode_TIS_TERRITORY_CODE_E_583:
+		return "583"
+	case TisTerritoryCode_TIS_TERRITORY_CODE_E_584:
+		return "584"
+	case TisTerritoryCode_TIS_TERRITORY_CODE_E_585:
+		return "585"
+	case TisTerritoryCode_TIS_TERRITORY_CODE_E_586:
+		return "586"
+	case TisTerritoryCode_TIS_TERRITORY_CODE_E_591:
+		return "591"
+	case TisTerritoryCode_TIS_TERRITORY_CODE_E_598:
+		return "598"
+	case TisTerritoryCode_TIS_TERRITORY_CODE_E_600:
+		return "600"
+	case TisTerritoryCode_TIS_TERRITORY_CODE_E_604:
+		return "604"
+	case TisTerritoryCode_TIS_TERRITORY_CODE_E_608:
+		return "608"
+	case TisTerritoryCode_TIS_TERRITORY_CODE_E_616:
+		return "616"
+	case TisTerritoryCode_TIS_TERRITORY_CODE_E_620:
+		return "620"
+	case TisTerritoryCode_TIS_TERRITORY_CODE_E_624:
+		return "624"
+	case TisTerritoryCode_TIS_TERRITORY_CODE_E_626:
+		return "626"
+	case TisTerritoryCode_TIS_TERRITORY_CODE_E_630:
+		return "630"
+	case TisTerritoryCode_TIS_TERRITORY_CODE_E_634:
+		return "634"
+	case TisTerritoryCode_TIS_TERRITORY_CODE_E_642:
+		return "642"
+	case TisTerritoryCode_TIS_TERRITORY_CODE_E_643:
+		return "643"
+	case TisTerritoryCode_TIS_TERRITORY_CODE_E_646:
+		return "646"
+	case TisTerritoryCode_TIS_TERRITORY_CODE_E_659:
+		return "659"
+	case TisTerritoryCode_TIS_TERRITORY_CODE_E_662:
+		return "662"
+	case TisTerritoryCode_TIS_TERRITORY_CODE_E_670:
+		return "670"
+	case TisTerritoryCode_TIS_TERRITORY_CODE_E_674:
+		return "674"
+	case TisTerritoryCode_TIS_TERRITORY_CODE_E_678:
+		return "678"
+	case TisTerritoryCode_TIS_TERRITORY_CODE_E_682:
+		return "682"
+	case TisTerritoryCode_TIS_TERRITORY_CODE_E_686:
+		return "686"
+	case TisTerritoryCode_TIS_TERRITORY_CODE_E_688:
+		return "688"
+	case TisTerritoryCode_TIS_TERRITORY_CODE_E_690:
+		return "690"
+	case TisTerritoryCode_TIS_TERRITORY_CODE_E_694:
+		return "694"
+	case TisTerritoryCode_TIS_TERRITORY_CODE_E_702:
+		return "702"
+	case TisTerritoryCode_TIS_TERRITORY_CODE_E_703:
+		return "703"
+	case TisTerritoryCode_TIS_TERRITORY_CODE_E_704:
+		return "704"
+	case TisTerritoryCode_TIS_TERRITORY_CODE_E_705:
+		return "705"
+	case TisTerritoryCode_TIS_TERRITORY_CODE_E_706:
+		return "706"
+	case TisTerritoryCode_TIS_TERRITORY_CODE_E_710:
+		return "710"
+	case TisTerritoryCode_TIS_TERRITORY_CODE_E_716:
+		return "716"
+	case TisTerritoryCode_TIS_TERRITORY_CODE_E_720:
+		return "720"
+	case TisTerritoryCode_TIS_TERRITORY_CODE_E_724:
+		return "724"
+	case TisTerritoryCode_TIS_TERRITORY_CODE_E_728:
+		return "728"
+	case TisTerritoryCode_TIS_TERRITORY_CODE_E_729:
+		return "729"
+	case TisTerritoryCode_TIS_TERRITORY_CODE_E_732:
+		return "732"
+	case TisTerritoryCode_TIS_TERRITORY_CODE_E_736:
+		return "736"
+	case TisTerritoryCode_TIS_TERRITORY_CODE_E_740:
+		return "740"
+	case TisTerritoryCode_TIS_TERRITORY_CODE_E_748:
+		return "748"
+	case TisTerritoryCode_TIS_TERRITORY_CODE_E_752:
+		return "752"
+	case TisTerritoryCode_TIS_TERRITORY_CODE_E_756:
+		return "756"
+	case TisTerritoryCode_TIS_TERRITORY_CODE_E_760:
+		return "760"
+	case TisTerritoryCode_TIS_TERRITORY_CODE_E_762:
+		return "762"
+	case TisTerritoryCode_TIS_TERRITORY_CODE_E_764:
+		return "764"
+	case TisTerritoryCode_TIS_TERRITORY_CODE_E_768:
+		return "768"
+	case TisTerritoryCode_TIS_TERRITORY_CODE_E_776:
+		return "776"
+	case TisTerritoryCode_TIS_TERRITORY_CODE_E_780:
+		return "780"
+	case TisTerritoryCode_TIS_TERRITORY_CODE_E_784:
+		return "784"
+	case TisTerritoryCode_TIS_TERRITORY_CODE_E_788:
+		return "788"
+	case TisTerritoryCode_TIS_TERRITORY_CODE_E_792:
+		return "792"
+	case TisTerritoryCode_TIS_TERRITORY_CODE_E_795:
+		return "795"
+	case TisTerritoryCode_TIS_TERRITORY_CODE_E_798:
+		return "798"
+	case TisTerritoryCode_TIS_TERRITORY_CODE_E_800:
+		return "800"
+	case TisTerritoryCode_TIS_TERRITORY_CODE_E_804:
+		return "804"
+	case TisTerritoryCode_TIS_TERRITORY_CODE_E_807:
+		return "807"
+	case TisTerritoryCode_TIS_TERRITORY_CODE_E_810:
+		return "810"
+	case TisTerritoryCode_TIS_TERRITORY_CODE_E_818:
+		return "818"
+	case TisTerritoryCode_TIS_TERRITORY_CODE_E_826:
+		return "826"
+	case TisTerritoryCode_TIS_TERRITORY_CODE_E_834:
+		return "834"
+	case TisTerritoryCode_TIS_TERRITORY_CODE_E_840:
+		return "840"
+	case TisTerritoryCode_TIS_TERRITORY_CODE_E_854:
+		return "854"
+	case TisTerritoryCode_TIS_TERRITORY_CODE_E_858:
+		return "858"
+	case TisTerritoryCode_TIS_TERRITORY_CODE_E_860:
+		return "860"
+	case TisTerritoryCode_TIS_TERRITORY_CODE_E_862:
+		return "862"
+	case TisTerritoryCode_TIS_TERRITORY_CODE_E_882:
+		return "882"
+	case TisTerritoryCode_TIS_TERRITORY_CODE_E_886:
+		return "886"
+	case TisTerritoryCode_TIS_TERRITORY_CODE_E_887:
+		return "887"
+	case TisTerritoryCode_TIS_TERRITORY_CODE_E_890:
+		return "890"
+	case TisTerritoryCode_TIS_TERRITORY_CODE_E_891:
+		return "891"
+	case TisTerritoryCode_TIS_TERRITORY_CODE_E_894:
+		return "894"
+	case TisTerritoryCode_TIS_TERRITORY_CODE_E_2100:
+		return "2100"
+	case TisTerritoryCode_TIS_TERRITORY_CODE_E_2101:
+		return "2101"
+	case TisTerritoryCode_TIS_TERRITORY_CODE_E_2102:
+		return "2102"
+	case TisTerritoryCode_TIS_TERRITORY_CODE_E_2103:
+		return "2103"
+	case TisTerritoryCode_TIS_TERRITORY_CODE_E_2104:
+		return "2104"
+	case TisTerritoryCode_TIS_TERRITORY_CODE_E_2105:
+		return "2105"
+	case TisTerritoryCode_TIS_TERRITORY_CODE_E_2106:
+		return "2106"
+	case TisTerritoryCode_TIS_TERRITORY_CODE_E_2107:
+		return "2107"
+	case TisTerritoryCode_TIS_TERRITORY_CODE_E_2108:
+		return "2108"
+	case TisTerritoryCode_TIS_TERRITORY_CODE_E_2109:
+		return "2109"
+	case TisTerritoryCode_TIS_TERRITORY_CODE_E_2110:
+		return "2110"
+	case TisTerritoryCode_TIS_TERRITORY_CODE_E_2111:
+		return "2111"
+	case TisTerritoryCode_TIS_TERRITORY_CODE_E_2112:
+		return "2112"
+	case TisTerritoryCode_TIS_TERRITORY_CODE_E_2113:
+		return "2113"
+	case TisTerritoryCode_TIS_TERRITORY_CODE_E_2114:
+		return "2114"
+	case TisTerritoryCode_TIS_TERRITORY_CODE_E_2115:
+		return "2115"
+	case TisTerritoryCode_TIS_TERRITORY_CODE_E_2116:
+		return "2116"
+	case TisTerritoryCode_TIS_TERRITORY_CODE_E_2117:
+		return "2117"
+	case TisTerritoryCode_TIS_TERRITORY_CODE_E_2118:
+		return "2118"
+	case TisTerritoryCode_TIS_TERRITORY_CODE_E_2119:
+		return "2119"
+	case TisTerritoryCode_TIS_TERRITORY_CODE_E_2120:
+		return "2120"
+	case TisTerritoryCode_TIS_TERRITORY_CODE_E_2121:
+		return "2121"
+	case TisTerritoryCode_TIS_TERRITORY_CODE_E_2122:
+		return "2122"
+	case TisTerritoryCode_TIS_TERRITORY_CODE_E_2123:
+		return "2123"
+	case TisTerritoryCode_TIS_TERRITORY_CODE_E_2124:
+		return "2124"
+	case TisTerritoryCode_TIS_TERRITORY_CODE_E_2125:
+		return "2125"
+	case TisTerritoryCode_TIS_TERRITORY_CODE_E_2126:
+		return "2126"
+	case TisTerritoryCode_TIS_TERRITORY_CODE_E_2127:
+		return "2127"
+	case TisTerritoryCode_TIS_TERRITORY_CODE_E_2128:
+		return "2128"
+	case TisTerritoryCode_TIS_TERRITORY_CODE_E_2129:
+		return "2129"
+	case TisTerritoryCode_TIS_TERRITORY_CODE_E_2130:
+		return "2130"
+	case TisTerritoryCode_TIS_TERRITORY_CODE_E_2131:
+		return "2131"
+	case TisTerritoryCode_TIS_TERRITORY_CODE_E_2132:
+		return "2132"
+	case TisTerritoryCode_TIS_TERRITORY_CODE_E_2133:
+		return "2133"
+	case TisTerritoryCode_TIS_TERRITORY_CODE_E_2134:
+		return "2134"
+	case TisTerritoryCode_TIS_TERRITORY_CODE_E_2136:
+		return "2136"
+	case TisTerritoryCode_TIS_TERRITORY_CODE_E_446:
+		return "446"
 	default:
 		return ""
 	}
@@ -2193,6 +17641,500 @@ func (e TisTerritoryCode) XMLString() string {
 func ParseTisTerritoryCodeString(s string) (TisTerritoryCode, bool) {
 	s = strings.ToUpper(s)
 	switch s {
+	case "4":
+		return TisTerritoryCode_TIS_TERRITORY_CODE_E_4, true
+	case "8":
+		return TisTerritoryCode_TIS_TERRITORY_CODE_E_8, true
+	case "12":
+		return TisTerritoryCode_TIS_TERRITORY_CODE_E_12, true
+	case "20":
+		return TisTerritoryCode_TIS_TERRITORY_CODE_E_20, true
+	case "24":
+		return TisTerritoryCode_TIS_TERRITORY_CODE_E_24, true
+	case "28":
+		return TisTerritoryCode_TIS_TERRITORY_CODE_E_28, true
+	case "31":
+		return TisTerritoryCode_TIS_TERRITORY_CODE_E_31, true
+	case "32":
+		return TisTerritoryCode_TIS_TERRITORY_CODE_E_32, true
+	case "36":
+		return TisTerritoryCode_TIS_TERRITORY_CODE_E_36, true
+	case "40":
+		return TisTerritoryCode_TIS_TERRITORY_CODE_E_40, true
+	case "44":
+		return TisTerritoryCode_TIS_TERRITORY_CODE_E_44, true
+	case "48":
+		return TisTerritoryCode_TIS_TERRITORY_CODE_E_48, true
+	case "50":
+		return TisTerritoryCode_TIS_TERRITORY_CODE_E_50, true
+	case "51":
+		return TisTerritoryCode_TIS_TERRITORY_CODE_E_51, true
+	case "52":
+		return TisTerritoryCode_TIS_TERRITORY_CODE_E_52, true
+	case "56":
+		return TisTerritoryCode_TIS_TERRITORY_CODE_E_56, true
+	case "64":
+		return TisTerritoryCode_TIS_TERRITORY_CODE_E_64, true
+	case "68":
+		return TisTerritoryCode_TIS_TERRITORY_CODE_E_68, true
+	case "70":
+		return TisTerritoryCode_TIS_TERRITORY_CODE_E_70, true
+	case "72":
+		return TisTerritoryCode_TIS_TERRITORY_CODE_E_72, true
+	case "76":
+		return TisTerritoryCode_TIS_TERRITORY_CODE_E_76, true
+	case "84":
+		return TisTerritoryCode_TIS_TERRITORY_CODE_E_84, true
+	case "90":
+		return TisTerritoryCode_TIS_TERRITORY_CODE_E_90, true
+	case "96":
+		return TisTerritoryCode_TIS_TERRITORY_CODE_E_96, true
+	case "100":
+		return TisTerritoryCode_TIS_TERRITORY_CODE_E_100, true
+	case "104":
+		return TisTerritoryCode_TIS_TERRITORY_CODE_E_104, true
+	case "108":
+		return TisTerritoryCode_TIS_TERRITORY_CODE_E_108, true
+	case "112":
+		return TisTerritoryCode_TIS_TERRITORY_CODE_E_112, true
+	case "116":
+		return TisTerritoryCode_TIS_TERRITORY_CODE_E_116, true
+	case "120":
+		return TisTerritoryCode_TIS_TERRITORY_CODE_E_120, true
+	case "124":
+		return TisTerritoryCode_TIS_TERRITORY_CODE_E_124, true
+	case "132":
+		return TisTerritoryCode_TIS_TERRITORY_CODE_E_132, true
+	case "140":
+		return TisTerritoryCode_TIS_TERRITORY_CODE_E_140, true
+	case "144":
+		return TisTerritoryCode_TIS_TERRITORY_CODE_E_144, true
+	case "148":
+		return TisTerritoryCode_TIS_TERRITORY_CODE_E_148, true
+	case "152":
+		return TisTerritoryCode_TIS_TERRITORY_CODE_E_152, true
+	case "156":
+		return TisTerritoryCode_TIS_TERRITORY_CODE_E_156, true
+	case "158":
+		return TisTerritoryCode_TIS_TERRITORY_CODE_E_158, true
+	case "170":
+		return TisTerritoryCode_TIS_TERRITORY_CODE_E_170, true
+	case "174":
+		return TisTerritoryCode_TIS_TERRITORY_CODE_E_174, true
+	case "178":
+		return TisTerritoryCode_TIS_TERRITORY_CODE_E_178, true
+	case "180":
+		return TisTerritoryCode_TIS_TERRITORY_CODE_E_180, true
+	case "188":
+		return TisTerritoryCode_TIS_TERRITORY_CODE_E_188, true
+	case "191":
+		return TisTerritoryCode_TIS_TERRITORY_CODE_E_191, true
+	case "192":
+		return TisTerritoryCode_TIS_TERRITORY_CODE_E_192, true
+	case "196":
+		return TisTerritoryCode_TIS_TERRITORY_CODE_E_196, true
+	case "200":
+		return TisTerritoryCode_TIS_TERRITORY_CODE_E_200, true
+	case "203":
+		return TisTerritoryCode_TIS_TERRITORY_CODE_E_203, true
+	case "204":
+		return TisTerritoryCode_TIS_TERRITORY_CODE_E_204, true
+	case "208":
+		return TisTerritoryCode_TIS_TERRITORY_CODE_E_208, true
+	case "212":
+		return TisTerritoryCode_TIS_TERRITORY_CODE_E_212, true
+	case "214":
+		return TisTerritoryCode_TIS_TERRITORY_CODE_E_214, true
+	case "218":
+		return TisTerritoryCode_TIS_TERRITORY_CODE_E_218, true
+	case "222":
+		return TisTerritoryCode_TIS_TERRITORY_CODE_E_222, true
+	case "226":
+		return TisTerritoryCode_TIS_TERRITORY_CODE_E_226, true
+	case "230":
+		return TisTerritoryCode_TIS_TERRITORY_CODE_E_230, true
+	case "231":
+		return TisTerritoryCode_TIS_TERRITORY_CODE_E_231, true
+	case "232":
+		return TisTerritoryCode_TIS_TERRITORY_CODE_E_232, true
+	case "233":
+		return TisTerritoryCode_TIS_TERRITORY_CODE_E_233, true
+	case "242":
+		return TisTerritoryCode_TIS_TERRITORY_CODE_E_242, true
+	case "246":
+		return TisTerritoryCode_TIS_TERRITORY_CODE_E_246, true
+	case "250":
+		return TisTerritoryCode_TIS_TERRITORY_CODE_E_250, true
+	case "258":
+		return TisTerritoryCode_TIS_TERRITORY_CODE_E_258, true
+	case "262":
+		return TisTerritoryCode_TIS_TERRITORY_CODE_E_262, true
+	case "266":
+		return TisTerritoryCode_TIS_TERRITORY_CODE_E_266, true
+	case "268":
+		return TisTerritoryCode_TIS_TERRITORY_CODE_E_268, true
+	case "270":
+		return TisTerritoryCode_TIS_TERRITORY_CODE_E_270, true
+	case "276":
+		return TisTerritoryCode_TIS_TERRITORY_CODE_E_276, true
+	case "278":
+		return TisTerritoryCode_TIS_TERRITORY_CODE_E_278, true
+	case "280":
+		return TisTerritoryCode_TIS_TERRITORY_CODE_E_280, true
+	case "288":
+		return TisTerritoryCode_TIS_TERRITORY_CODE_E_288, true
+	case "296":
+		return TisTerritoryCode_TIS_TERRITORY_CODE_E_296, true
+	case "300":
+		return TisTerritoryCode_TIS_TERRITORY_CODE_E_300, true
+	case "308":
+		return TisTerritoryCode_TIS_TERRITORY_CODE_E_308, true
+	case "320":
+		return TisTerritoryCode_TIS_TERRITORY_CODE_E_320, true
+	case "324":
+		return TisTerritoryCode_TIS_TERRITORY_CODE_E_324, true
+	case "328":
+		return TisTerritoryCode_TIS_TERRITORY_CODE_E_328, true
+	case "332":
+		return TisTerritoryCode_TIS_TERRITORY_CODE_E_332, true
+	case "336":
+		return TisTerritoryCode_TIS_TERRITORY_CODE_E_336, true
+	case "340":
+		return TisTerritoryCode_TIS_TERRITORY_CODE_E_340, true
+	case "344":
+		return TisTerritoryCode_TIS_TERRITORY_CODE_E_344, true
+	case "348":
+		return TisTerritoryCode_TIS_TERRITORY_CODE_E_348, true
+	case "352":
+		return TisTerritoryCode_TIS_TERRITORY_CODE_E_352, true
+	case "356":
+		return TisTerritoryCode_TIS_TERRITORY_CODE_E_356, true
+	case "360":
+		return TisTerritoryCode_TIS_TERRITORY_CODE_E_360, true
+	case "364":
+		return TisTerritoryCode_TIS_TERRITORY_CODE_E_364, true
+	case "368":
+		return TisTerritoryCode_TIS_TERRITORY_CODE_E_368, true
+	case "372":
+		return TisTerritoryCode_TIS_TERRITORY_CODE_E_372, true
+	case "376":
+		return TisTerritoryCode_TIS_TERRITORY_CODE_E_376, true
+	case "380":
+		return TisTerritoryCode_TIS_TERRITORY_CODE_E_380, true
+	case "384":
+		return TisTerritoryCode_TIS_TERRITORY_CODE_E_384, true
+	case "388":
+		return TisTerritoryCode_TIS_TERRITORY_CODE_E_388, true
+	case "392":
+		return TisTerritoryCode_TIS_TERRITORY_CODE_E_392, true
+	case "398":
+		return TisTerritoryCode_TIS_TERRITORY_CODE_E_398, true
+	case "400":
+		return TisTerritoryCode_TIS_TERRITORY_CODE_E_400, true
+	case "404":
+		return TisTerritoryCode_TIS_TERRITORY_CODE_E_404, true
+	case "408":
+		return TisTerritoryCode_TIS_TERRITORY_CODE_E_408, true
+	case "410":
+		return TisTerritoryCode_TIS_TERRITORY_CODE_E_410, true
+	case "414":
+		return TisTerritoryCode_TIS_TERRITORY_CODE_E_414, true
+	case "417":
+		return TisTerritoryCode_TIS_TERRITORY_CODE_E_417, true
+	case "418":
+		return TisTerritoryCode_TIS_TERRITORY_CODE_E_418, true
+	case "422":
+		return TisTerritoryCode_TIS_TERRITORY_CODE_E_422, true
+	case "426":
+		return TisTerritoryCode_TIS_TERRITORY_CODE_E_426, true
+	case "428":
+		return TisTerritoryCode_TIS_TERRITORY_CODE_E_428, true
+	case "430":
+		return TisTerritoryCode_TIS_TERRITORY_CODE_E_430, true
+	case "434":
+		return TisTerritoryCode_TIS_TERRITORY_CODE_E_434, true
+	case "438":
+		return TisTerritoryCode_TIS_TERRITORY_CODE_E_438, true
+	case "440":
+		return TisTerritoryCode_TIS_TERRITORY_CODE_E_440, true
+	case "442":
+		return TisTerritoryCode_TIS_TERRITORY_CODE_E_442, true
+	case "450":
+		return TisTerritoryCode_TIS_TERRITORY_CODE_E_450, true
+	case "454":
+		return TisTerritoryCode_TIS_TERRITORY_CODE_E_454, true
+	case "458":
+		return TisTerritoryCode_TIS_TERRITORY_CODE_E_458, true
+	case "462":
+		return TisTerritoryCode_TIS_TERRITORY_CODE_E_462, true
+	case "466":
+		return TisTerritoryCode_TIS_TERRITORY_CODE_E_466, true
+	case "470":
+		return TisTerritoryCode_TIS_TERRITORY_CODE_E_470, true
+	case "478":
+		return TisTerritoryCode_TIS_TERRITORY_CODE_E_478, true
+	case "480":
+		return TisTerritoryCode_TIS_TERRITORY_CODE_E_480, true
+	case "484":
+		return TisTerritoryCode_TIS_TERRITORY_CODE_E_484, true
+	case "492":
+		return TisTerritoryCode_TIS_TERRITORY_CODE_E_492, true
+	case "496":
+		return TisTerritoryCode_TIS_TERRITORY_CODE_E_496, true
+	case "498":
+		return TisTerritoryCode_TIS_TERRITORY_CODE_E_498, true
+	case "499":
+		return TisTerritoryCode_TIS_TERRITORY_CODE_E_499, true
+	case "504":
+		return TisTerritoryCode_TIS_TERRITORY_CODE_E_504, true
+	case "508":
+		return TisTerritoryCode_TIS_TERRITORY_CODE_E_508, true
+	case "512":
+		return TisTerritoryCode_TIS_TERRITORY_CODE_E_512, true
+	case "516":
+		return TisTerritoryCode_TIS_TERRITORY_CODE_E_516, true
+	case "520":
+		return TisTerritoryCode_TIS_TERRITORY_CODE_E_520, true
+	case "524":
+		return TisTerritoryCode_TIS_TERRITORY_CODE_E_524, true
+	case "528":
+		return TisTerritoryCode_TIS_TERRITORY_CODE_E_528, true
+	case "540":
+		return TisTerritoryCode_TIS_TERRITORY_CODE_E_540, true
+	case "548":
+		return TisTerritoryCode_TIS_TERRITORY_CODE_E_548, true
+	case "554":
+		return TisTerritoryCode_TIS_TERRITORY_CODE_E_554, true
+	case "558":
+		return TisTerritoryCode_TIS_TERRITORY_CODE_E_558, true
+	case "562":
+		return TisTerritoryCode_TIS_TERRITORY_CODE_E_562, true
+	case "566":
+		return TisTerritoryCode_TIS_TERRITORY_CODE_E_566, true
+	case "578":
+		return TisTerritoryCode_TIS_TERRITORY_CODE_E_578, true
+	case "583":
+		return TisTerritoryCode_TIS_TERRITORY_CODE_E_583, true
+	case "584":
+		return TisTerritoryCode_TIS_TERRITORY_CODE_E_584, true
+	case "585":
+		return TisTerritoryCode_TIS_TERRITORY_CODE_E_585, true
+	case "586":
+		return TisTerritoryCode_TIS_TERRITORY_CODE_E_586, true
+	case "591":
+		return TisTerritoryCode_TIS_TERRITORY_CODE_E_591, true
+	case "598":
+		return TisTerritoryCode_TIS_TERRITORY_CODE_E_598, true
+	case "600":
+		return TisTerritoryCode_TIS_TERRITORY_CODE_E_600, true
+	case "604":
+		return TisTerritoryCode_TIS_TERRITORY_CODE_E_604, true
+	case "608":
+		return TisTerritoryCode_TIS_TERRITORY_CODE_E_608, true
+	case "616":
+		return TisTerritoryCode_TIS_TERRITORY_CODE_E_616, true
+	case "620":
+		return TisTerritoryCode_TIS_TERRITORY_CODE_E_620, true
+	case "624":
+		return TisTerritoryCode_TIS_TERRITORY_CODE_E_624, true
+	case "626":
+		return TisTerritoryCode_TIS_TERRITORY_CODE_E_626, true
+	case "630":
+		return TisTerritoryCode_TIS_TERRITORY_CODE_E_630, true
+	case "634":
+		return TisTerritoryCode_TIS_TERRITORY_CODE_E_634, true
+	case "642":
+		return TisTerritoryCode_TIS_TERRITORY_CODE_E_642, true
+	case "643":
+		return TisTerritoryCode_TIS_TERRITORY_CODE_E_643, true
+	case "646":
+		return TisTerritoryCode_TIS_TERRITORY_CODE_E_646, true
+	case "659":
+		return TisTerritoryCode_TIS_TERRITORY_CODE_E_659, true
+	case "662":
+		return TisTerritoryCode_TIS_TERRITORY_CODE_E_662, true
+	case "670":
+		return TisTerritoryCode_TIS_TERRITORY_CODE_E_670, true
+	case "674":
+		return TisTerritoryCode_TIS_TERRITORY_CODE_E_674, true
+	case "678":
+		return TisTerritoryCode_TIS_TERRITORY_CODE_E_678, true
+	case "682":
+		return TisTerritoryCode_TIS_TERRITORY_CODE_E_682, true
+	case "686":
+		return TisTerritoryCode_TIS_TERRITORY_CODE_E_686, true
+	case "688":
+		return TisTerritoryCode_TIS_TERRITORY_CODE_E_688, true
+	case "690":
+		return TisTerritoryCode_TIS_TERRITORY_CODE_E_690, true
+	case "694":
+		return TisTerritoryCode_TIS_TERRITORY_CODE_E_694, true
+	case "702":
+		return TisTerritoryCode_TIS_TERRITORY_CODE_E_702, true
+	case "703":
+		return TisTerritoryCode_TIS_TERRITORY_CODE_E_703, true
+	case "704":
+		return TisTerritoryCode_TIS_TERRITORY_CODE_E_704, true
+	case "705":
+		return TisTerritoryCode_TIS_TERRITORY_CODE_E_705, true
+	case "706":
+		return TisTerritoryCode_TIS_TERRITORY_CODE_E_706, true
+	case "710":
+		return TisTerritoryCode_TIS_TERRITORY_CODE_E_710, true
+	case "716":
+		return TisTerritoryCode_TIS_TERRITORY_CODE_E_716, true
+	case "720":
+		return TisTerritoryCode_TIS_TERRITORY_CODE_E_720, true
+	case "724":
+		return TisTerritoryCode_TIS_TERRITORY_CODE_E_724, true
+	case "728":
+		return TisTerritoryCode_TIS_TERRITORY_CODE_E_728, true
+	case "729":
+		return TisTerritoryCode_TIS_TERRITORY_CODE_E_729, true
+	case "732":
+		return TisTerritoryCode_TIS_TERRITORY_CODE_E_732, true
+	case "736":
+		return TisTerritoryCode_TIS_TERRITORY_CODE_E_736, true
+	case "740":
+		return TisTerritoryCode_TIS_TERRITORY_CODE_E_740, true
+	case "748":
+		return TisTerritoryCode_TIS_TERRITORY_CODE_E_748, true
+	case "752":
+		return TisTerritoryCode_TIS_TERRITORY_CODE_E_752, true
+	case "756":
+		return TisTerritoryCode_TIS_TERRITORY_CODE_E_756, true
+	case "760":
+		return TisTerritoryCode_TIS_TERRITORY_CODE_E_760, true
+	case "762":
+		return TisTerritoryCode_TIS_TERRITORY_CODE_E_762, true
+	case "764":
+		return TisTerritoryCode_TIS_TERRITORY_CODE_E_764, true
+	case "768":
+		return TisTerritoryCode_TIS_TERRITORY_CODE_E_768, true
+	case "776":
+		return TisTerritoryCode_TIS_TERRITORY_CODE_E_776, true
+	case "780":
+		return TisTerritoryCode_TIS_TERRITORY_CODE_E_780, true
+	case "784":
+		return TisTerritoryCode_TIS_TERRITORY_CODE_E_784, true
+	case "788":
+		return TisTerritoryCode_TIS_TERRITORY_CODE_E_788, true
+	case "792":
+		return TisTerritoryCode_TIS_TERRITORY_CODE_E_792, true
+	case "795":
+		return TisTerritoryCode_TIS_TERRITORY_CODE_E_795, true
+	case "798":
+		return TisTerritoryCode_TIS_TERRITORY_CODE_E_798, true
+	case "800":
+		return TisTerritoryCode_TIS_TERRITORY_CODE_E_800, true
+	case "804":
+		return TisTerritoryCode_TIS_TERRITORY_CODE_E_804, true
+	case "807":
+		return TisTerritoryCode_TIS_TERRITORY_CODE_E_807, true
+	case "810":
+		return TisTerritoryCode_TIS_TERRITORY_CODE_E_810, true
+	case "818":
+		return TisTerritoryCode_TIS_TERRITORY_CODE_E_818, true
+	case "826":
+		return TisTerritoryCode_TIS_TERRITORY_CODE_E_826, true
+	case "834":
+		return TisTerritoryCode_TIS_TERRITORY_CODE_E_834, true
+	case "840":
+		return TisTerritoryCode_TIS_TERRITORY_CODE_E_840, true
+	case "854":
+		return TisTerritoryCode_TIS_TERRITORY_CODE_E_854, true
+	case "858":
+		return TisTerritoryCode_TIS_TERRITORY_CODE_E_858, true
+	case "860":
+		return TisTerritoryCode_TIS_TERRITORY_CODE_E_860, true
+	case "862":
+		return TisTerritoryCode_TIS_TERRITORY_CODE_E_862, true
+	case "882":
+		return TisTerritoryCode_TIS_TERRITORY_CODE_E_882, true
+	case "886":
+		return TisTerritoryCode_TIS_TERRITORY_CODE_E_886, true
+	case "887":
+		return TisTerritoryCode_TIS_TERRITORY_CODE_E_887, true
+	case "890":
+		return TisTerritoryCode_TIS_TERRITORY_CODE_E_890, true
+	case "891":
+		return TisTerritoryCode_TIS_TERRITORY_CODE_E_891, true
+	case "894":
+		return TisTerritoryCode_TIS_TERRITORY_CODE_E_894, true
+	case "2100":
+		return TisTerritoryCode_TIS_TERRITORY_CODE_E_2100, true
+	case "2101":
+		return TisTerritoryCode_TIS_TERRITORY_CODE_E_2101, true
+	case "2102":
+		return TisTerritoryCode_TIS_TERRITORY_CODE_E_2102, true
+	case "2103":
+		return TisTerritoryCode_TIS_TERRITORY_CODE_E_2103, true
+	case "2104":
+		return TisTerritoryCode_TIS_TERRITORY_CODE_E_2104, true
+	case "2105":
+		return TisTerritoryCode_TIS_TERRITORY_CODE_E_2105, true
+	case "2106":
+		return TisTerritoryCode_TIS_TERRITORY_CODE_E_2106, true
+	case "2107":
+		return TisTerritoryCode_TIS_TERRITORY_CODE_E_2107, true
+	case "2108":
+		return TisTerritoryCode_TIS_TERRITORY_CODE_E_2108, true
+	case "2109":
+		return TisTerritoryCode_TIS_TERRITORY_CODE_E_2109, true
+	case "2110":
+		return TisTerritoryCode_TIS_TERRITORY_CODE_E_2110, true
+	case "2111":
+		return TisTerritoryCode_TIS_TERRITORY_CODE_E_2111, true
+	case "2112":
+		return TisTerritoryCode_TIS_TERRITORY_CODE_E_2112, true
+	case "2113":
+		return TisTerritoryCode_TIS_TERRITORY_CODE_E_2113, true
+	case "2114":
+		return TisTerritoryCode_TIS_TERRITORY_CODE_E_2114, true
+	case "2115":
+		return TisTerritoryCode_TIS_TERRITORY_CODE_E_2115, true
+	case "2116":
+		return TisTerritoryCode_TIS_TERRITORY_CODE_E_2116, true
+	case "2117":
+		return TisTerritoryCode_TIS_TERRITORY_CODE_E_2117, true
+	case "2118":
+		return TisTerritoryCode_TIS_TERRITORY_CODE_E_2118, true
+	case "2119":
+		return TisTerritoryCode_TIS_TERRITORY_CODE_E_2119, true
+	case "2120":
+		return TisTerritoryCode_TIS_TERRITORY_CODE_E_2120, true
+	case "2121":
+		return TisTerritoryCode_TIS_TERRITORY_CODE_E_2121, true
+	case "2122":
+		return TisTerritoryCode_TIS_TERRITORY_CODE_E_2122, true
+	case "2123":
+		return TisTerritoryCode_TIS_TERRITORY_CODE_E_2123, true
+	case "2124":
+		return TisTerritoryCode_TIS_TERRITORY_CODE_E_2124, true
+	case "2125":
+		return TisTerritoryCode_TIS_TERRITORY_CODE_E_2125, true
+	case "2126":
+		return TisTerritoryCode_TIS_TERRITORY_CODE_E_2126, true
+	case "2127":
+		return TisTerritoryCode_TIS_TERRITORY_CODE_E_2127, true
+	case "2128":
+		return TisTerritoryCode_TIS_TERRITORY_CODE_E_2128, true
+	case "2129":
+		return TisTerritoryCode_TIS_TERRITORY_CODE_E_2129, true
+	case "2130":
+		return TisTerritoryCode_TIS_TERRITORY_CODE_E_2130, true
+	case "2131":
+		return TisTerritoryCode_TIS_TERRITORY_CODE_E_2131, true
+	case "2132":
+		return TisTerritoryCode_TIS_TERRITORY_CODE_E_2132, true
+	case "2133":
+		return TisTerritoryCode_TIS_TERRITORY_CODE_E_2133, true
+	case "2134":
+		return TisTerritoryCode_TIS_TERRITORY_CODE_E_2134, true
+	case "2136":
+		return TisTerritoryCode_TIS_TERRITORY_CODE_E_2136, true
+	case "446":
+		return TisTerritoryCode_TIS_TERRITORY_CODE_E_446, true
 	default:
 		return TisTerritoryCode(0), false
 	}
@@ -2201,6 +18143,40 @@ func ParseTisTerritoryCodeString(s string) (TisTerritoryCode, bool) {
 // XMLString returns the XML string representation of TitleType
 func (e TitleType) XMLString() string {
 	switch e {
+	case TitleType_TITLE_TYPE_ABBREVIATEDDISPLAYTITLE:
+		return "ABBREVIATEDDISPLAYTITLE"
+	case TitleType_TITLE_TYPE_ALTERNATIVETITLE:
+		return "ALTERNATIVETITLE"
+	case TitleType_TITLE_TYPE_DISPLAYTITLE:
+		return "DISPLAYTITLE"
+	case TitleType_TITLE_TYPE_FIRSTLINEOFTEXT:
+		return "FIRSTLINEOFTEXT"
+	case TitleType_TITLE_TYPE_FORMALTITLE:
+		return "FORMALTITLE"
+	case TitleType_TITLE_TYPE_GROUPINGTITLE:
+		return "GROUPINGTITLE"
+	case TitleType_TITLE_TYPE_INCORRECTTITLE:
+		return "INCORRECTTITLE"
+	case TitleType_TITLE_TYPE_MISSPELLEDTITLE:
+		return "MISSPELLEDTITLE"
+	case TitleType_TITLE_TYPE_ORIGINALTITLE:
+		return "ORIGINALTITLE"
+	case TitleType_TITLE_TYPE_SEARCHTITLE:
+		return "SEARCHTITLE"
+	case TitleType_TITLE_TYPE_SORTINGTITLE:
+		return "SORTINGTITLE"
+	case TitleType_TITLE_TYPE_TITLEASPART:
+		return "TITLEASPART"
+	case TitleType_TITLE_TYPE_TITLEWITHOUTPUNCTUATION:
+		return "TITLEWITHOUTPUNCTUATION"
+	case TitleType_TITLE_TYPE_TRANSLATEDTITLE:
+		return "TRANSLATEDTITLE"
+	case TitleType_TITLE_TYPE_UNKNOWN:
+		return "UNKNOWN"
+	case TitleType_TITLE_TYPE_USERDEFINED:
+		return "USERDEFINED"
+	case TitleType_TITLE_TYPE_MUSICALWORKTITLE:
+		return "MUSICALWORKTITLE"
 	default:
 		return ""
 	}
@@ -2210,6 +18186,40 @@ func (e TitleType) XMLString() string {
 func ParseTitleTypeString(s string) (TitleType, bool) {
 	s = strings.ToUpper(s)
 	switch s {
+	case "ABBREVIATEDDISPLAYTITLE":
+		return TitleType_TITLE_TYPE_ABBREVIATEDDISPLAYTITLE, true
+	case "ALTERNATIVETITLE":
+		return TitleType_TITLE_TYPE_ALTERNATIVETITLE, true
+	case "DISPLAYTITLE":
+		return TitleType_TITLE_TYPE_DISPLAYTITLE, true
+	case "FIRSTLINEOFTEXT":
+		return TitleType_TITLE_TYPE_FIRSTLINEOFTEXT, true
+	case "FORMALTITLE":
+		return TitleType_TITLE_TYPE_FORMALTITLE, true
+	case "GROUPINGTITLE":
+		return TitleType_TITLE_TYPE_GROUPINGTITLE, true
+	case "INCORRECTTITLE":
+		return TitleType_TITLE_TYPE_INCORRECTTITLE, true
+	case "MISSPELLEDTITLE":
+		return TitleType_TITLE_TYPE_MISSPELLEDTITLE, true
+	case "ORIGINALTITLE":
+		return TitleType_TITLE_TYPE_ORIGINALTITLE, true
+	case "SEARCHTITLE":
+		return TitleType_TITLE_TYPE_SEARCHTITLE, true
+	case "SORTINGTITLE":
+		return TitleType_TITLE_TYPE_SORTINGTITLE, true
+	case "TITLEASPART":
+		return TitleType_TITLE_TYPE_TITLEASPART, true
+	case "TITLEWITHOUTPUNCTUATION":
+		return TitleType_TITLE_TYPE_TITLEWITHOUTPUNCTUATION, true
+	case "TRANSLATEDTITLE":
+		return TitleType_TITLE_TYPE_TRANSLATEDTITLE, true
+	case "UNKNOWN":
+		return TitleType_TITLE_TYPE_UNKNOWN, true
+	case "USERDEFINED":
+		return TitleType_TITLE_TYPE_USERDEFINED, true
+	case "MUSICALWORKTITLE":
+		return TitleType_TITLE_TYPE_MUSICALWORKTITLE, true
 	default:
 		return TitleType(0), false
 	}
@@ -2218,6 +18228,14 @@ func ParseTitleTypeString(s string) (TitleType, bool) {
 // XMLString returns the XML string representation of UnitOfBitRate
 func (e UnitOfBitRate) XMLString() string {
 	switch e {
+	case UnitOfBitRate_UNIT_OF_BIT_RATE_BPS:
+		return "BPS"
+	case UnitOfBitRate_UNIT_OF_BIT_RATE_GBPS:
+		return "GBPS"
+	case UnitOfBitRate_UNIT_OF_BIT_RATE_KBPS:
+		return "KBPS"
+	case UnitOfBitRate_UNIT_OF_BIT_RATE_MBPS:
+		return "MBPS"
 	default:
 		return ""
 	}
@@ -2227,6 +18245,14 @@ func (e UnitOfBitRate) XMLString() string {
 func ParseUnitOfBitRateString(s string) (UnitOfBitRate, bool) {
 	s = strings.ToUpper(s)
 	switch s {
+	case "BPS":
+		return UnitOfBitRate_UNIT_OF_BIT_RATE_BPS, true
+	case "GBPS":
+		return UnitOfBitRate_UNIT_OF_BIT_RATE_GBPS, true
+	case "KBPS":
+		return UnitOfBitRate_UNIT_OF_BIT_RATE_KBPS, true
+	case "MBPS":
+		return UnitOfBitRate_UNIT_OF_BIT_RATE_MBPS, true
 	default:
 		return UnitOfBitRate(0), false
 	}
@@ -2235,6 +18261,16 @@ func ParseUnitOfBitRateString(s string) (UnitOfBitRate, bool) {
 // XMLString returns the XML string representation of UnitOfConditionValue
 func (e UnitOfConditionValue) XMLString() string {
 	switch e {
+	case UnitOfConditionValue_UNIT_OF_CONDITION_VALUE_MILLISECOND:
+		return "MILLISECOND"
+	case UnitOfConditionValue_UNIT_OF_CONDITION_VALUE_MINUTE:
+		return "MINUTE"
+	case UnitOfConditionValue_UNIT_OF_CONDITION_VALUE_PERCENT:
+		return "PERCENT"
+	case UnitOfConditionValue_UNIT_OF_CONDITION_VALUE_PIXEL:
+		return "PIXEL"
+	case UnitOfConditionValue_UNIT_OF_CONDITION_VALUE_SECOND:
+		return "SECOND"
 	default:
 		return ""
 	}
@@ -2244,6 +18280,16 @@ func (e UnitOfConditionValue) XMLString() string {
 func ParseUnitOfConditionValueString(s string) (UnitOfConditionValue, bool) {
 	s = strings.ToUpper(s)
 	switch s {
+	case "MILLISECOND":
+		return UnitOfConditionValue_UNIT_OF_CONDITION_VALUE_MILLISECOND, true
+	case "MINUTE":
+		return UnitOfConditionValue_UNIT_OF_CONDITION_VALUE_MINUTE, true
+	case "PERCENT":
+		return UnitOfConditionValue_UNIT_OF_CONDITION_VALUE_PERCENT, true
+	case "PIXEL":
+		return UnitOfConditionValue_UNIT_OF_CONDITION_VALUE_PIXEL, true
+	case "SECOND":
+		return UnitOfConditionValue_UNIT_OF_CONDITION_VALUE_SECOND, true
 	default:
 		return UnitOfConditionValue(0), false
 	}
@@ -2252,6 +18298,16 @@ func ParseUnitOfConditionValueString(s string) (UnitOfConditionValue, bool) {
 // XMLString returns the XML string representation of UnitOfExtent
 func (e UnitOfExtent) XMLString() string {
 	switch e {
+	case UnitOfExtent_UNIT_OF_EXTENT_CM:
+		return "CM"
+	case UnitOfExtent_UNIT_OF_EXTENT_INCH:
+		return "INCH"
+	case UnitOfExtent_UNIT_OF_EXTENT_MM:
+		return "MM"
+	case UnitOfExtent_UNIT_OF_EXTENT_PERCENTOFSCREEN:
+		return "PERCENTOFSCREEN"
+	case UnitOfExtent_UNIT_OF_EXTENT_PIXEL:
+		return "PIXEL"
 	default:
 		return ""
 	}
@@ -2261,6 +18317,16 @@ func (e UnitOfExtent) XMLString() string {
 func ParseUnitOfExtentString(s string) (UnitOfExtent, bool) {
 	s = strings.ToUpper(s)
 	switch s {
+	case "CM":
+		return UnitOfExtent_UNIT_OF_EXTENT_CM, true
+	case "INCH":
+		return UnitOfExtent_UNIT_OF_EXTENT_INCH, true
+	case "MM":
+		return UnitOfExtent_UNIT_OF_EXTENT_MM, true
+	case "PERCENTOFSCREEN":
+		return UnitOfExtent_UNIT_OF_EXTENT_PERCENTOFSCREEN, true
+	case "PIXEL":
+		return UnitOfExtent_UNIT_OF_EXTENT_PIXEL, true
 	default:
 		return UnitOfExtent(0), false
 	}
@@ -2269,6 +18335,10 @@ func ParseUnitOfExtentString(s string) (UnitOfExtent, bool) {
 // XMLString returns the XML string representation of UnitOfFrameRate
 func (e UnitOfFrameRate) XMLString() string {
 	switch e {
+	case UnitOfFrameRate_UNIT_OF_FRAME_RATE_HZ_INTERLACED:
+		return "HZ_INTERLACED"
+	case UnitOfFrameRate_UNIT_OF_FRAME_RATE_HZ_NON_INTERLACED:
+		return "HZ_NON_INTERLACED"
 	default:
 		return ""
 	}
@@ -2278,6 +18348,10 @@ func (e UnitOfFrameRate) XMLString() string {
 func ParseUnitOfFrameRateString(s string) (UnitOfFrameRate, bool) {
 	s = strings.ToUpper(s)
 	switch s {
+	case "HZ_INTERLACED":
+		return UnitOfFrameRate_UNIT_OF_FRAME_RATE_HZ_INTERLACED, true
+	case "HZ_NON_INTERLACED":
+		return UnitOfFrameRate_UNIT_OF_FRAME_RATE_HZ_NON_INTERLACED, true
 	default:
 		return UnitOfFrameRate(0), false
 	}
@@ -2286,6 +18360,14 @@ func ParseUnitOfFrameRateString(s string) (UnitOfFrameRate, bool) {
 // XMLString returns the XML string representation of UnitOfFrequency
 func (e UnitOfFrequency) XMLString() string {
 	switch e {
+	case UnitOfFrequency_UNIT_OF_FREQUENCY_GHZ:
+		return "GHZ"
+	case UnitOfFrequency_UNIT_OF_FREQUENCY_HZ:
+		return "HZ"
+	case UnitOfFrequency_UNIT_OF_FREQUENCY_KHZ:
+		return "KHZ"
+	case UnitOfFrequency_UNIT_OF_FREQUENCY_MHZ:
+		return "MHZ"
 	default:
 		return ""
 	}
@@ -2295,6 +18377,14 @@ func (e UnitOfFrequency) XMLString() string {
 func ParseUnitOfFrequencyString(s string) (UnitOfFrequency, bool) {
 	s = strings.ToUpper(s)
 	switch s {
+	case "GHZ":
+		return UnitOfFrequency_UNIT_OF_FREQUENCY_GHZ, true
+	case "HZ":
+		return UnitOfFrequency_UNIT_OF_FREQUENCY_HZ, true
+	case "KHZ":
+		return UnitOfFrequency_UNIT_OF_FREQUENCY_KHZ, true
+	case "MHZ":
+		return UnitOfFrequency_UNIT_OF_FREQUENCY_MHZ, true
 	default:
 		return UnitOfFrequency(0), false
 	}
@@ -2303,6 +18393,10 @@ func ParseUnitOfFrequencyString(s string) (UnitOfFrequency, bool) {
 // XMLString returns the XML string representation of UpdateIndicator
 func (e UpdateIndicator) XMLString() string {
 	switch e {
+	case UpdateIndicator_UPDATE_INDICATOR_ORIGINALMESSAGE:
+		return "ORIGINALMESSAGE"
+	case UpdateIndicator_UPDATE_INDICATOR_UPDATEMESSAGE:
+		return "UPDATEMESSAGE"
 	default:
 		return ""
 	}
@@ -2312,6 +18406,10 @@ func (e UpdateIndicator) XMLString() string {
 func ParseUpdateIndicatorString(s string) (UpdateIndicator, bool) {
 	s = strings.ToUpper(s)
 	switch s {
+	case "ORIGINALMESSAGE":
+		return UpdateIndicator_UPDATE_INDICATOR_ORIGINALMESSAGE, true
+	case "UPDATEMESSAGE":
+		return UpdateIndicator_UPDATE_INDICATOR_UPDATEMESSAGE, true
 	default:
 		return UpdateIndicator(0), false
 	}
@@ -2320,6 +18418,120 @@ func ParseUpdateIndicatorString(s string) (UpdateIndicator, bool) {
 // XMLString returns the XML string representation of UseType
 func (e UseType) XMLString() string {
 	switch e {
+	case UseType_USE_TYPE_ASPERCONTRACT:
+		return "ASPERCONTRACT"
+	case UseType_USE_TYPE_BROADCAST:
+		return "BROADCAST"
+	case UseType_USE_TYPE_CONDITIONALDOWNLOAD:
+		return "CONDITIONALDOWNLOAD"
+	case UseType_USE_TYPE_CONTENTINFLUENCEDSTREAM:
+		return "CONTENTINFLUENCEDSTREAM"
+	case UseType_USE_TYPE_DISPLAY:
+		return "DISPLAY"
+	case UseType_USE_TYPE_DOWNLOAD:
+		return "DOWNLOAD"
+	case UseType_USE_TYPE_DUBFORADVERTISEMENT:
+		return "DUBFORADVERTISEMENT"
+	case UseType_USE_TYPE_DUBFORLIVEPERFORMANCE:
+		return "DUBFORLIVEPERFORMANCE"
+	case UseType_USE_TYPE_DUBFORMOVIES:
+		return "DUBFORMOVIES"
+	case UseType_USE_TYPE_DUBFORMUSICONHOLD:
+		return "DUBFORMUSICONHOLD"
+	case UseType_USE_TYPE_DUBFORPUBLICPERFORMANCE:
+		return "DUBFORPUBLICPERFORMANCE"
+	case UseType_USE_TYPE_DUBFORRADIO:
+		return "DUBFORRADIO"
+	case UseType_USE_TYPE_DUBFORTV:
+		return "DUBFORTV"
+	case UseType_USE_TYPE_EXTRACTFORINTERNET:
+		return "EXTRACTFORINTERNET"
+	case UseType_USE_TYPE_KIOSKDOWNLOAD:
+		return "KIOSKDOWNLOAD"
+	case UseType_USE_TYPE_NARROWCAST:
+		return "NARROWCAST"
+	case UseType_USE_TYPE_NONINTERACTIVESTREAM:
+		return "NONINTERACTIVESTREAM"
+	case UseType_USE_TYPE_ONDEMANDSTREAM:
+		return "ONDEMANDSTREAM"
+	case UseType_USE_TYPE_PERFORMASMUSICONHOLD:
+		return "PERFORMASMUSICONHOLD"
+	case UseType_USE_TYPE_PERFORMINLIVEPERFORMANCE:
+		return "PERFORMINLIVEPERFORMANCE"
+	case UseType_USE_TYPE_PERFORMINPUBLIC:
+		return "PERFORMINPUBLIC"
+	case UseType_USE_TYPE_PERMANENTDOWNLOAD:
+		return "PERMANENTDOWNLOAD"
+	case UseType_USE_TYPE_PLAYBACK:
+		return "PLAYBACK"
+	case UseType_USE_TYPE_PLAYINPUBLIC:
+		return "PLAYINPUBLIC"
+	case UseType_USE_TYPE_PODCAST:
+		return "PODCAST"
+	case UseType_USE_TYPE_PRINT:
+		return "PRINT"
+	case UseType_USE_TYPE_PRIVATECOPY:
+		return "PRIVATECOPY"
+	case UseType_USE_TYPE_PURCHASEASPHYSICALPRODUCT:
+		return "PURCHASEASPHYSICALPRODUCT"
+	case UseType_USE_TYPE_RENT:
+		return "RENT"
+	case UseType_USE_TYPE_SIMULCAST:
+		return "SIMULCAST"
+	case UseType_USE_TYPE_STREAM:
+		return "STREAM"
+	case UseType_USE_TYPE_TETHEREDDOWNLOAD:
+		return "TETHEREDDOWNLOAD"
+	case UseType_USE_TYPE_TIMEINFLUENCEDSTREAM:
+		return "TIMEINFLUENCEDSTREAM"
+	case UseType_USE_TYPE_UNKNOWN:
+		return "UNKNOWN"
+	case UseType_USE_TYPE_USEASALERTTONE:
+		return "USEASALERTTONE"
+	case UseType_USE_TYPE_USEASDEVICE:
+		return "USEASDEVICE"
+	case UseType_USE_TYPE_USEASKARAOKE:
+		return "USEASKARAOKE"
+	case UseType_USE_TYPE_USEASRINGBACKTONE:
+		return "USEASRINGBACKTONE"
+	case UseType_USE_TYPE_USEASRINGBACKTUNE:
+		return "USEASRINGBACKTUNE"
+	case UseType_USE_TYPE_USEASRINGTONE:
+		return "USEASRINGTONE"
+	case UseType_USE_TYPE_USEASRINGTUNE:
+		return "USEASRINGTUNE"
+	case UseType_USE_TYPE_USEASSCREENSAVER:
+		return "USEASSCREENSAVER"
+	case UseType_USE_TYPE_USEASVOICEMAIL:
+		return "USEASVOICEMAIL"
+	case UseType_USE_TYPE_USEASWALLPAPER:
+		return "USEASWALLPAPER"
+	case UseType_USE_TYPE_USEFORIDENTIFICATION:
+		return "USEFORIDENTIFICATION"
+	case UseType_USE_TYPE_USEINMOBILEPHONEMESSAGING:
+		return "USEINMOBILEPHONEMESSAGING"
+	case UseType_USE_TYPE_USEINPHONELISTENING:
+		return "USEINPHONELISTENING"
+	case UseType_USE_TYPE_USERDEFINED:
+		return "USERDEFINED"
+	case UseType_USE_TYPE_USERMAKEAVAILABLELABELPROVIDED:
+		return "USERMAKEAVAILABLELABELPROVIDED"
+	case UseType_USE_TYPE_USERMAKEAVAILABLEUSERPROVIDED:
+		return "USERMAKEAVAILABLEUSERPROVIDED"
+	case UseType_USE_TYPE_WEBCAST:
+		return "WEBCAST"
+	case UseType_USE_TYPE_CABLE:
+		return "CABLE"
+	case UseType_USE_TYPE_DUB:
+		return "DUB"
+	case UseType_USE_TYPE_DUBFORONDEMANDSTREAMING:
+		return "DUBFORONDEMANDSTREAMING"
+	case UseType_USE_TYPE_PERFORM:
+		return "PERFORM"
+	case UseType_USE_TYPE_USE:
+		return "USE"
+	case UseType_USE_TYPE_USEFORGENERATIVEAI:
+		return "USEFORGENERATIVEAI"
 	default:
 		return ""
 	}
@@ -2329,6 +18541,120 @@ func (e UseType) XMLString() string {
 func ParseUseTypeString(s string) (UseType, bool) {
 	s = strings.ToUpper(s)
 	switch s {
+	case "ASPERCONTRACT":
+		return UseType_USE_TYPE_ASPERCONTRACT, true
+	case "BROADCAST":
+		return UseType_USE_TYPE_BROADCAST, true
+	case "CONDITIONALDOWNLOAD":
+		return UseType_USE_TYPE_CONDITIONALDOWNLOAD, true
+	case "CONTENTINFLUENCEDSTREAM":
+		return UseType_USE_TYPE_CONTENTINFLUENCEDSTREAM, true
+	case "DISPLAY":
+		return UseType_USE_TYPE_DISPLAY, true
+	case "DOWNLOAD":
+		return UseType_USE_TYPE_DOWNLOAD, true
+	case "DUBFORADVERTISEMENT":
+		return UseType_USE_TYPE_DUBFORADVERTISEMENT, true
+	case "DUBFORLIVEPERFORMANCE":
+		return UseType_USE_TYPE_DUBFORLIVEPERFORMANCE, true
+	case "DUBFORMOVIES":
+		return UseType_USE_TYPE_DUBFORMOVIES, true
+	case "DUBFORMUSICONHOLD":
+		return UseType_USE_TYPE_DUBFORMUSICONHOLD, true
+	case "DUBFORPUBLICPERFORMANCE":
+		return UseType_USE_TYPE_DUBFORPUBLICPERFORMANCE, true
+	case "DUBFORRADIO":
+		return UseType_USE_TYPE_DUBFORRADIO, true
+	case "DUBFORTV":
+		return UseType_USE_TYPE_DUBFORTV, true
+	case "EXTRACTFORINTERNET":
+		return UseType_USE_TYPE_EXTRACTFORINTERNET, true
+	case "KIOSKDOWNLOAD":
+		return UseType_USE_TYPE_KIOSKDOWNLOAD, true
+	case "NARROWCAST":
+		return UseType_USE_TYPE_NARROWCAST, true
+	case "NONINTERACTIVESTREAM":
+		return UseType_USE_TYPE_NONINTERACTIVESTREAM, true
+	case "ONDEMANDSTREAM":
+		return UseType_USE_TYPE_ONDEMANDSTREAM, true
+	case "PERFORMASMUSICONHOLD":
+		return UseType_USE_TYPE_PERFORMASMUSICONHOLD, true
+	case "PERFORMINLIVEPERFORMANCE":
+		return UseType_USE_TYPE_PERFORMINLIVEPERFORMANCE, true
+	case "PERFORMINPUBLIC":
+		return UseType_USE_TYPE_PERFORMINPUBLIC, true
+	case "PERMANENTDOWNLOAD":
+		return UseType_USE_TYPE_PERMANENTDOWNLOAD, true
+	case "PLAYBACK":
+		return UseType_USE_TYPE_PLAYBACK, true
+	case "PLAYINPUBLIC":
+		return UseType_USE_TYPE_PLAYINPUBLIC, true
+	case "PODCAST":
+		return UseType_USE_TYPE_PODCAST, true
+	case "PRINT":
+		return UseType_USE_TYPE_PRINT, true
+	case "PRIVATECOPY":
+		return UseType_USE_TYPE_PRIVATECOPY, true
+	case "PURCHASEASPHYSICALPRODUCT":
+		return UseType_USE_TYPE_PURCHASEASPHYSICALPRODUCT, true
+	case "RENT":
+		return UseType_USE_TYPE_RENT, true
+	case "SIMULCAST":
+		return UseType_USE_TYPE_SIMULCAST, true
+	case "STREAM":
+		return UseType_USE_TYPE_STREAM, true
+	case "TETHEREDDOWNLOAD":
+		return UseType_USE_TYPE_TETHEREDDOWNLOAD, true
+	case "TIMEINFLUENCEDSTREAM":
+		return UseType_USE_TYPE_TIMEINFLUENCEDSTREAM, true
+	case "UNKNOWN":
+		return UseType_USE_TYPE_UNKNOWN, true
+	case "USEASALERTTONE":
+		return UseType_USE_TYPE_USEASALERTTONE, true
+	case "USEASDEVICE":
+		return UseType_USE_TYPE_USEASDEVICE, true
+	case "USEASKARAOKE":
+		return UseType_USE_TYPE_USEASKARAOKE, true
+	case "USEASRINGBACKTONE":
+		return UseType_USE_TYPE_USEASRINGBACKTONE, true
+	case "USEASRINGBACKTUNE":
+		return UseType_USE_TYPE_USEASRINGBACKTUNE, true
+	case "USEASRINGTONE":
+		return UseType_USE_TYPE_USEASRINGTONE, true
+	case "USEASRINGTUNE":
+		return UseType_USE_TYPE_USEASRINGTUNE, true
+	case "USEASSCREENSAVER":
+		return UseType_USE_TYPE_USEASSCREENSAVER, true
+	case "USEASVOICEMAIL":
+		return UseType_USE_TYPE_USEASVOICEMAIL, true
+	case "USEASWALLPAPER":
+		return UseType_USE_TYPE_USEASWALLPAPER, true
+	case "USEFORIDENTIFICATION":
+		return UseType_USE_TYPE_USEFORIDENTIFICATION, true
+	case "USEINMOBILEPHONEMESSAGING":
+		return UseType_USE_TYPE_USEINMOBILEPHONEMESSAGING, true
+	case "USEINPHONELISTENING":
+		return UseType_USE_TYPE_USEINPHONELISTENING, true
+	case "USERDEFINED":
+		return UseType_USE_TYPE_USERDEFINED, true
+	case "USERMAKEAVAILABLELABELPROVIDED":
+		return UseType_USE_TYPE_USERMAKEAVAILABLELABELPROVIDED, true
+	case "USERMAKEAVAILABLEUSERPROVIDED":
+		return UseType_USE_TYPE_USERMAKEAVAILABLEUSERPROVIDED, true
+	case "WEBCAST":
+		return UseType_USE_TYPE_WEBCAST, true
+	case "CABLE":
+		return UseType_USE_TYPE_CABLE, true
+	case "DUB":
+		return UseType_USE_TYPE_DUB, true
+	case "DUBFORONDEMANDSTREAMING":
+		return UseType_USE_TYPE_DUBFORONDEMANDSTREAMING, true
+	case "PERFORM":
+		return UseType_USE_TYPE_PERFORM, true
+	case "USE":
+		return UseType_USE_TYPE_USE, true
+	case "USEFORGENERATIVEAI":
+		return UseType_USE_TYPE_USEFORGENERATIVEAI, true
 	default:
 		return UseType(0), false
 	}
@@ -2337,6 +18663,34 @@ func ParseUseTypeString(s string) (UseType, bool) {
 // XMLString returns the XML string representation of UserInterfaceType
 func (e UserInterfaceType) XMLString() string {
 	switch e {
+	case UserInterfaceType_USER_INTERFACE_TYPE_ASPERCONTRACT:
+		return "ASPERCONTRACT"
+	case UserInterfaceType_USER_INTERFACE_TYPE_CONNECTEDDEVICE:
+		return "CONNECTEDDEVICE"
+	case UserInterfaceType_USER_INTERFACE_TYPE_GAMECONSOLE:
+		return "GAMECONSOLE"
+	case UserInterfaceType_USER_INTERFACE_TYPE_JUKEBOX:
+		return "JUKEBOX"
+	case UserInterfaceType_USER_INTERFACE_TYPE_KARAOKEMACHINE:
+		return "KARAOKEMACHINE"
+	case UserInterfaceType_USER_INTERFACE_TYPE_KIOSK:
+		return "KIOSK"
+	case UserInterfaceType_USER_INTERFACE_TYPE_LOCALSTORAGEJUKEBOX:
+		return "LOCALSTORAGEJUKEBOX"
+	case UserInterfaceType_USER_INTERFACE_TYPE_PERSONALCOMPUTER:
+		return "PERSONALCOMPUTER"
+	case UserInterfaceType_USER_INTERFACE_TYPE_PHYSICALMEDIAWRITER:
+		return "PHYSICALMEDIAWRITER"
+	case UserInterfaceType_USER_INTERFACE_TYPE_PORTABLEDEVICE:
+		return "PORTABLEDEVICE"
+	case UserInterfaceType_USER_INTERFACE_TYPE_REMOTESTORAGEJUKEBOX:
+		return "REMOTESTORAGEJUKEBOX"
+	case UserInterfaceType_USER_INTERFACE_TYPE_UNKNOWN:
+		return "UNKNOWN"
+	case UserInterfaceType_USER_INTERFACE_TYPE_USERDEFINED:
+		return "USERDEFINED"
+	case UserInterfaceType_USER_INTERFACE_TYPE_SMARTSPEAKERS:
+		return "SMARTSPEAKERS"
 	default:
 		return ""
 	}
@@ -2346,6 +18700,34 @@ func (e UserInterfaceType) XMLString() string {
 func ParseUserInterfaceTypeString(s string) (UserInterfaceType, bool) {
 	s = strings.ToUpper(s)
 	switch s {
+	case "ASPERCONTRACT":
+		return UserInterfaceType_USER_INTERFACE_TYPE_ASPERCONTRACT, true
+	case "CONNECTEDDEVICE":
+		return UserInterfaceType_USER_INTERFACE_TYPE_CONNECTEDDEVICE, true
+	case "GAMECONSOLE":
+		return UserInterfaceType_USER_INTERFACE_TYPE_GAMECONSOLE, true
+	case "JUKEBOX":
+		return UserInterfaceType_USER_INTERFACE_TYPE_JUKEBOX, true
+	case "KARAOKEMACHINE":
+		return UserInterfaceType_USER_INTERFACE_TYPE_KARAOKEMACHINE, true
+	case "KIOSK":
+		return UserInterfaceType_USER_INTERFACE_TYPE_KIOSK, true
+	case "LOCALSTORAGEJUKEBOX":
+		return UserInterfaceType_USER_INTERFACE_TYPE_LOCALSTORAGEJUKEBOX, true
+	case "PERSONALCOMPUTER":
+		return UserInterfaceType_USER_INTERFACE_TYPE_PERSONALCOMPUTER, true
+	case "PHYSICALMEDIAWRITER":
+		return UserInterfaceType_USER_INTERFACE_TYPE_PHYSICALMEDIAWRITER, true
+	case "PORTABLEDEVICE":
+		return UserInterfaceType_USER_INTERFACE_TYPE_PORTABLEDEVICE, true
+	case "REMOTESTORAGEJUKEBOX":
+		return UserInterfaceType_USER_INTERFACE_TYPE_REMOTESTORAGEJUKEBOX, true
+	case "UNKNOWN":
+		return UserInterfaceType_USER_INTERFACE_TYPE_UNKNOWN, true
+	case "USERDEFINED":
+		return UserInterfaceType_USER_INTERFACE_TYPE_USERDEFINED, true
+	case "SMARTSPEAKERS":
+		return UserInterfaceType_USER_INTERFACE_TYPE_SMARTSPEAKERS, true
 	default:
 		return UserInterfaceType(0), false
 	}
@@ -2354,6 +18736,12 @@ func ParseUserInterfaceTypeString(s string) (UserInterfaceType, bool) {
 // XMLString returns the XML string representation of ValueType
 func (e ValueType) XMLString() string {
 	switch e {
+	case ValueType_VALUE_TYPE_CALCULATED:
+		return "CALCULATED"
+	case ValueType_VALUE_TYPE_MAXIMUM:
+		return "MAXIMUM"
+	case ValueType_VALUE_TYPE_MINIMUM:
+		return "MINIMUM"
 	default:
 		return ""
 	}
@@ -2363,6 +18751,12 @@ func (e ValueType) XMLString() string {
 func ParseValueTypeString(s string) (ValueType, bool) {
 	s = strings.ToUpper(s)
 	switch s {
+	case "CALCULATED":
+		return ValueType_VALUE_TYPE_CALCULATED, true
+	case "MAXIMUM":
+		return ValueType_VALUE_TYPE_MAXIMUM, true
+	case "MINIMUM":
+		return ValueType_VALUE_TYPE_MINIMUM, true
 	default:
 		return ValueType(0), false
 	}
@@ -2371,6 +18765,30 @@ func ParseValueTypeString(s string) (ValueType, bool) {
 // XMLString returns the XML string representation of VideoCodecType
 func (e VideoCodecType) XMLString() string {
 	switch e {
+	case VideoCodecType_VIDEO_CODEC_TYPE_AVC:
+		return "AVC"
+	case VideoCodecType_VIDEO_CODEC_TYPE_H_261:
+		return "H_261"
+	case VideoCodecType_VIDEO_CODEC_TYPE_H_263:
+		return "H_263"
+	case VideoCodecType_VIDEO_CODEC_TYPE_MPEG_1:
+		return "MPEG_1"
+	case VideoCodecType_VIDEO_CODEC_TYPE_MPEG_2:
+		return "MPEG_2"
+	case VideoCodecType_VIDEO_CODEC_TYPE_MPEG_4:
+		return "MPEG_4"
+	case VideoCodecType_VIDEO_CODEC_TYPE_QUICKTIME:
+		return "QUICKTIME"
+	case VideoCodecType_VIDEO_CODEC_TYPE_REALVIDEO:
+		return "REALVIDEO"
+	case VideoCodecType_VIDEO_CODEC_TYPE_SHOCKWAVE:
+		return "SHOCKWAVE"
+	case VideoCodecType_VIDEO_CODEC_TYPE_UNKNOWN:
+		return "UNKNOWN"
+	case VideoCodecType_VIDEO_CODEC_TYPE_USERDEFINED:
+		return "USERDEFINED"
+	case VideoCodecType_VIDEO_CODEC_TYPE_WMV:
+		return "WMV"
 	default:
 		return ""
 	}
@@ -2380,6 +18798,30 @@ func (e VideoCodecType) XMLString() string {
 func ParseVideoCodecTypeString(s string) (VideoCodecType, bool) {
 	s = strings.ToUpper(s)
 	switch s {
+	case "AVC":
+		return VideoCodecType_VIDEO_CODEC_TYPE_AVC, true
+	case "H_261":
+		return VideoCodecType_VIDEO_CODEC_TYPE_H_261, true
+	case "H_263":
+		return VideoCodecType_VIDEO_CODEC_TYPE_H_263, true
+	case "MPEG_1":
+		return VideoCodecType_VIDEO_CODEC_TYPE_MPEG_1, true
+	case "MPEG_2":
+		return VideoCodecType_VIDEO_CODEC_TYPE_MPEG_2, true
+	case "MPEG_4":
+		return VideoCodecType_VIDEO_CODEC_TYPE_MPEG_4, true
+	case "QUICKTIME":
+		return VideoCodecType_VIDEO_CODEC_TYPE_QUICKTIME, true
+	case "REALVIDEO":
+		return VideoCodecType_VIDEO_CODEC_TYPE_REALVIDEO, true
+	case "SHOCKWAVE":
+		return VideoCodecType_VIDEO_CODEC_TYPE_SHOCKWAVE, true
+	case "UNKNOWN":
+		return VideoCodecType_VIDEO_CODEC_TYPE_UNKNOWN, true
+	case "USERDEFINED":
+		return VideoCodecType_VIDEO_CODEC_TYPE_USERDEFINED, true
+	case "WMV":
+		return VideoCodecType_VIDEO_CODEC_TYPE_WMV, true
 	default:
 		return VideoCodecType(0), false
 	}
@@ -2388,6 +18830,12 @@ func ParseVideoCodecTypeString(s string) (VideoCodecType, bool) {
 // XMLString returns the XML string representation of VideoContentType
 func (e VideoContentType) XMLString() string {
 	switch e {
+	case VideoContentType_VIDEO_CONTENT_TYPE_ACTEDVIDEO:
+		return "ACTEDVIDEO"
+	case VideoContentType_VIDEO_CONTENT_TYPE_ANIMATION:
+		return "ANIMATION"
+	case VideoContentType_VIDEO_CONTENT_TYPE_ANIMATIONANDACTEDVIDEO:
+		return "ANIMATIONANDACTEDVIDEO"
 	default:
 		return ""
 	}
@@ -2397,6 +18845,12 @@ func (e VideoContentType) XMLString() string {
 func ParseVideoContentTypeString(s string) (VideoContentType, bool) {
 	s = strings.ToUpper(s)
 	switch s {
+	case "ACTEDVIDEO":
+		return VideoContentType_VIDEO_CONTENT_TYPE_ACTEDVIDEO, true
+	case "ANIMATION":
+		return VideoContentType_VIDEO_CONTENT_TYPE_ANIMATION, true
+	case "ANIMATIONANDACTEDVIDEO":
+		return VideoContentType_VIDEO_CONTENT_TYPE_ANIMATIONANDACTEDVIDEO, true
 	default:
 		return VideoContentType(0), false
 	}
@@ -2405,6 +18859,12 @@ func ParseVideoContentTypeString(s string) (VideoContentType, bool) {
 // XMLString returns the XML string representation of VideoDefinitionType
 func (e VideoDefinitionType) XMLString() string {
 	switch e {
+	case VideoDefinitionType_VIDEO_DEFINITION_TYPE_HIGHDEFINITION:
+		return "HIGHDEFINITION"
+	case VideoDefinitionType_VIDEO_DEFINITION_TYPE_STANDARDDEFINITION:
+		return "STANDARDDEFINITION"
+	case VideoDefinitionType_VIDEO_DEFINITION_TYPE_USERDEFINED:
+		return "USERDEFINED"
 	default:
 		return ""
 	}
@@ -2414,6 +18874,12 @@ func (e VideoDefinitionType) XMLString() string {
 func ParseVideoDefinitionTypeString(s string) (VideoDefinitionType, bool) {
 	s = strings.ToUpper(s)
 	switch s {
+	case "HIGHDEFINITION":
+		return VideoDefinitionType_VIDEO_DEFINITION_TYPE_HIGHDEFINITION, true
+	case "STANDARDDEFINITION":
+		return VideoDefinitionType_VIDEO_DEFINITION_TYPE_STANDARDDEFINITION, true
+	case "USERDEFINED":
+		return VideoDefinitionType_VIDEO_DEFINITION_TYPE_USERDEFINED, true
 	default:
 		return VideoDefinitionType(0), false
 	}
@@ -2422,6 +18888,144 @@ func ParseVideoDefinitionTypeString(s string) (VideoDefinitionType, bool) {
 // XMLString returns the XML string representation of VideoType
 func (e VideoType) XMLString() string {
 	switch e {
+	case VideoType_VIDEO_TYPE_ADVERTISEMENTVIDEO:
+		return "ADVERTISEMENTVIDEO"
+	case VideoType_VIDEO_TYPE_ANIMATION:
+		return "ANIMATION"
+	case VideoType_VIDEO_TYPE_BEHINDTHESCENES:
+		return "BEHINDTHESCENES"
+	case VideoType_VIDEO_TYPE_CONCERTCLIP:
+		return "CONCERTCLIP"
+	case VideoType_VIDEO_TYPE_CONCERTVIDEO:
+		return "CONCERTVIDEO"
+	case VideoType_VIDEO_TYPE_CORPORATEFILM:
+		return "CORPORATEFILM"
+	case VideoType_VIDEO_TYPE_CREDITS:
+		return "CREDITS"
+	case VideoType_VIDEO_TYPE_DOCUMENTARY:
+		return "DOCUMENTARY"
+	case VideoType_VIDEO_TYPE_EDUCATIONALVIDEO:
+		return "EDUCATIONALVIDEO"
+	case VideoType_VIDEO_TYPE_EPISODE:
+		return "EPISODE"
+	case VideoType_VIDEO_TYPE_FEATUREFILM:
+		return "FEATUREFILM"
+	case VideoType_VIDEO_TYPE_INFOMERCIALVIDEO:
+		return "INFOMERCIALVIDEO"
+	case VideoType_VIDEO_TYPE_INTERVIEW:
+		return "INTERVIEW"
+	case VideoType_VIDEO_TYPE_KARAOKE:
+		return "KARAOKE"
+	case VideoType_VIDEO_TYPE_LIVEEVENTVIDEO:
+		return "LIVEEVENTVIDEO"
+	case VideoType_VIDEO_TYPE_LONGFORMMUSICALWORKVIDEO:
+		return "LONGFORMMUSICALWORKVIDEO"
+	case VideoType_VIDEO_TYPE_LONGFORMNONMUSICALWORKVIDEO:
+		return "LONGFORMNONMUSICALWORKVIDEO"
+	case VideoType_VIDEO_TYPE_LYRICVIDEO:
+		return "LYRICVIDEO"
+	case VideoType_VIDEO_TYPE_MENU:
+		return "MENU"
+	case VideoType_VIDEO_TYPE_MULTIMEDIAVIDEO:
+		return "MULTIMEDIAVIDEO"
+	case VideoType_VIDEO_TYPE_MUSICALWORKCLIP:
+		return "MUSICALWORKCLIP"
+	case VideoType_VIDEO_TYPE_MUSICALWORKREADALONGVIDEO:
+		return "MUSICALWORKREADALONGVIDEO"
+	case VideoType_VIDEO_TYPE_MUSICALWORKTRAILER:
+		return "MUSICALWORKTRAILER"
+	case VideoType_VIDEO_TYPE_MUSICALWORKVIDEOCHAPTER:
+		return "MUSICALWORKVIDEOCHAPTER"
+	case VideoType_VIDEO_TYPE_NEWS:
+		return "NEWS"
+	case VideoType_VIDEO_TYPE_NONMUSICALWORKCLIP:
+		return "NONMUSICALWORKCLIP"
+	case VideoType_VIDEO_TYPE_NONMUSICALWORKREADALONGVIDEO:
+		return "NONMUSICALWORKREADALONGVIDEO"
+	case VideoType_VIDEO_TYPE_NONMUSICALWORKTRAILER:
+		return "NONMUSICALWORKTRAILER"
+	case VideoType_VIDEO_TYPE_NONMUSICALWORKVIDEOCHAPTER:
+		return "NONMUSICALWORKVIDEOCHAPTER"
+	case VideoType_VIDEO_TYPE_NONSERIALAUDIOVISUALRECORDING:
+		return "NONSERIALAUDIOVISUALRECORDING"
+	case VideoType_VIDEO_TYPE_OPERAVIDEO:
+		return "OPERAVIDEO"
+	case VideoType_VIDEO_TYPE_PERFORMANCE:
+		return "PERFORMANCE"
+	case VideoType_VIDEO_TYPE_SEASON:
+		return "SEASON"
+	case VideoType_VIDEO_TYPE_SERIES:
+		return "SERIES"
+	case VideoType_VIDEO_TYPE_SHORTFILM:
+		return "SHORTFILM"
+	case VideoType_VIDEO_TYPE_SHORTFORMMUSICALWORKVIDEO:
+		return "SHORTFORMMUSICALWORKVIDEO"
+	case VideoType_VIDEO_TYPE_SHORTFORMNONMUSICALWORKVIDEO:
+		return "SHORTFORMNONMUSICALWORKVIDEO"
+	case VideoType_VIDEO_TYPE_SPECIALEVENT:
+		return "SPECIALEVENT"
+	case VideoType_VIDEO_TYPE_SPORT:
+		return "SPORT"
+	case VideoType_VIDEO_TYPE_THEATRICALWORKVIDEO:
+		return "THEATRICALWORKVIDEO"
+	case VideoType_VIDEO_TYPE_TRAILERVIDEO:
+		return "TRAILERVIDEO"
+	case VideoType_VIDEO_TYPE_TVFILM:
+		return "TVFILM"
+	case VideoType_VIDEO_TYPE_TVSHOWVIDEO:
+		return "TVSHOWVIDEO"
+	case VideoType_VIDEO_TYPE_UNKNOWN:
+		return "UNKNOWN"
+	case VideoType_VIDEO_TYPE_USERDEFINED:
+		return "USERDEFINED"
+	case VideoType_VIDEO_TYPE_VIDEOCHAPTER:
+		return "VIDEOCHAPTER"
+	case VideoType_VIDEO_TYPE_VIDEOSTEM:
+		return "VIDEOSTEM"
+	case VideoType_VIDEO_TYPE_ADULTCONTENT:
+		return "ADULTCONTENT"
+	case VideoType_VIDEO_TYPE_ADVICEMAGAZINE:
+		return "ADVICEMAGAZINE"
+	case VideoType_VIDEO_TYPE_BALLETVIDEO:
+		return "BALLETVIDEO"
+	case VideoType_VIDEO_TYPE_BLACKANDWHITEVIDEO:
+		return "BLACKANDWHITEVIDEO"
+	case VideoType_VIDEO_TYPE_CHILDRENSFILM:
+		return "CHILDRENSFILM"
+	case VideoType_VIDEO_TYPE_COLORIZEDVIDEO:
+		return "COLORIZEDVIDEO"
+	case VideoType_VIDEO_TYPE_COLUMNVIDEO:
+		return "COLUMNVIDEO"
+	case VideoType_VIDEO_TYPE_FICTION:
+		return "FICTION"
+	case VideoType_VIDEO_TYPE_MAGAZINE:
+		return "MAGAZINE"
+	case VideoType_VIDEO_TYPE_READALONGVIDEO:
+		return "READALONGVIDEO"
+	case VideoType_VIDEO_TYPE_REALITYTVSHOWVIDEO:
+		return "REALITYTVSHOWVIDEO"
+	case VideoType_VIDEO_TYPE_SERIALAUDIOVISUALRECORDING:
+		return "SERIALAUDIOVISUALRECORDING"
+	case VideoType_VIDEO_TYPE_SILENTVIDEO:
+		return "SILENTVIDEO"
+	case VideoType_VIDEO_TYPE_SKETCHVIDEO:
+		return "SKETCHVIDEO"
+	case VideoType_VIDEO_TYPE_SOAPSITCOM:
+		return "SOAPSITCOM"
+	case VideoType_VIDEO_TYPE_TVPROGRAM:
+		return "TVPROGRAM"
+	case VideoType_VIDEO_TYPE_VIDEOCLIP:
+		return "VIDEOCLIP"
+	case VideoType_VIDEO_TYPE_VIDEOREPORT:
+		return "VIDEOREPORT"
+	case VideoType_VIDEO_TYPE_DRAMA:
+		return "DRAMA"
+	case VideoType_VIDEO_TYPE_DRAMATICOMUSICALVIDEO:
+		return "DRAMATICOMUSICALVIDEO"
+	case VideoType_VIDEO_TYPE_INTERACTIVERESOURCE:
+		return "INTERACTIVERESOURCE"
+	case VideoType_VIDEO_TYPE_WEBRESOURCE:
+		return "WEBRESOURCE"
 	default:
 		return ""
 	}
@@ -2431,6 +19035,144 @@ func (e VideoType) XMLString() string {
 func ParseVideoTypeString(s string) (VideoType, bool) {
 	s = strings.ToUpper(s)
 	switch s {
+	case "ADVERTISEMENTVIDEO":
+		return VideoType_VIDEO_TYPE_ADVERTISEMENTVIDEO, true
+	case "ANIMATION":
+		return VideoType_VIDEO_TYPE_ANIMATION, true
+	case "BEHINDTHESCENES":
+		return VideoType_VIDEO_TYPE_BEHINDTHESCENES, true
+	case "CONCERTCLIP":
+		return VideoType_VIDEO_TYPE_CONCERTCLIP, true
+	case "CONCERTVIDEO":
+		return VideoType_VIDEO_TYPE_CONCERTVIDEO, true
+	case "CORPORATEFILM":
+		return VideoType_VIDEO_TYPE_CORPORATEFILM, true
+	case "CREDITS":
+		return VideoType_VIDEO_TYPE_CREDITS, true
+	case "DOCUMENTARY":
+		return VideoType_VIDEO_TYPE_DOCUMENTARY, true
+	case "EDUCATIONALVIDEO":
+		return VideoType_VIDEO_TYPE_EDUCATIONALVIDEO, true
+	case "EPISODE":
+		return VideoType_VIDEO_TYPE_EPISODE, true
+	case "FEATUREFILM":
+		return VideoType_VIDEO_TYPE_FEATUREFILM, true
+	case "INFOMERCIALVIDEO":
+		return VideoType_VIDEO_TYPE_INFOMERCIALVIDEO, true
+	case "INTERVIEW":
+		return VideoType_VIDEO_TYPE_INTERVIEW, true
+	case "KARAOKE":
+		return VideoType_VIDEO_TYPE_KARAOKE, true
+	case "LIVEEVENTVIDEO":
+		return VideoType_VIDEO_TYPE_LIVEEVENTVIDEO, true
+	case "LONGFORMMUSICALWORKVIDEO":
+		return VideoType_VIDEO_TYPE_LONGFORMMUSICALWORKVIDEO, true
+	case "LONGFORMNONMUSICALWORKVIDEO":
+		return VideoType_VIDEO_TYPE_LONGFORMNONMUSICALWORKVIDEO, true
+	case "LYRICVIDEO":
+		return VideoType_VIDEO_TYPE_LYRICVIDEO, true
+	case "MENU":
+		return VideoType_VIDEO_TYPE_MENU, true
+	case "MULTIMEDIAVIDEO":
+		return VideoType_VIDEO_TYPE_MULTIMEDIAVIDEO, true
+	case "MUSICALWORKCLIP":
+		return VideoType_VIDEO_TYPE_MUSICALWORKCLIP, true
+	case "MUSICALWORKREADALONGVIDEO":
+		return VideoType_VIDEO_TYPE_MUSICALWORKREADALONGVIDEO, true
+	case "MUSICALWORKTRAILER":
+		return VideoType_VIDEO_TYPE_MUSICALWORKTRAILER, true
+	case "MUSICALWORKVIDEOCHAPTER":
+		return VideoType_VIDEO_TYPE_MUSICALWORKVIDEOCHAPTER, true
+	case "NEWS":
+		return VideoType_VIDEO_TYPE_NEWS, true
+	case "NONMUSICALWORKCLIP":
+		return VideoType_VIDEO_TYPE_NONMUSICALWORKCLIP, true
+	case "NONMUSICALWORKREADALONGVIDEO":
+		return VideoType_VIDEO_TYPE_NONMUSICALWORKREADALONGVIDEO, true
+	case "NONMUSICALWORKTRAILER":
+		return VideoType_VIDEO_TYPE_NONMUSICALWORKTRAILER, true
+	case "NONMUSICALWORKVIDEOCHAPTER":
+		return VideoType_VIDEO_TYPE_NONMUSICALWORKVIDEOCHAPTER, true
+	case "NONSERIALAUDIOVISUALRECORDING":
+		return VideoType_VIDEO_TYPE_NONSERIALAUDIOVISUALRECORDING, true
+	case "OPERAVIDEO":
+		return VideoType_VIDEO_TYPE_OPERAVIDEO, true
+	case "PERFORMANCE":
+		return VideoType_VIDEO_TYPE_PERFORMANCE, true
+	case "SEASON":
+		return VideoType_VIDEO_TYPE_SEASON, true
+	case "SERIES":
+		return VideoType_VIDEO_TYPE_SERIES, true
+	case "SHORTFILM":
+		return VideoType_VIDEO_TYPE_SHORTFILM, true
+	case "SHORTFORMMUSICALWORKVIDEO":
+		return VideoType_VIDEO_TYPE_SHORTFORMMUSICALWORKVIDEO, true
+	case "SHORTFORMNONMUSICALWORKVIDEO":
+		return VideoType_VIDEO_TYPE_SHORTFORMNONMUSICALWORKVIDEO, true
+	case "SPECIALEVENT":
+		return VideoType_VIDEO_TYPE_SPECIALEVENT, true
+	case "SPORT":
+		return VideoType_VIDEO_TYPE_SPORT, true
+	case "THEATRICALWORKVIDEO":
+		return VideoType_VIDEO_TYPE_THEATRICALWORKVIDEO, true
+	case "TRAILERVIDEO":
+		return VideoType_VIDEO_TYPE_TRAILERVIDEO, true
+	case "TVFILM":
+		return VideoType_VIDEO_TYPE_TVFILM, true
+	case "TVSHOWVIDEO":
+		return VideoType_VIDEO_TYPE_TVSHOWVIDEO, true
+	case "UNKNOWN":
+		return VideoType_VIDEO_TYPE_UNKNOWN, true
+	case "USERDEFINED":
+		return VideoType_VIDEO_TYPE_USERDEFINED, true
+	case "VIDEOCHAPTER":
+		return VideoType_VIDEO_TYPE_VIDEOCHAPTER, true
+	case "VIDEOSTEM":
+		return VideoType_VIDEO_TYPE_VIDEOSTEM, true
+	case "ADULTCONTENT":
+		return VideoType_VIDEO_TYPE_ADULTCONTENT, true
+	case "ADVICEMAGAZINE":
+		return VideoType_VIDEO_TYPE_ADVICEMAGAZINE, true
+	case "BALLETVIDEO":
+		return VideoType_VIDEO_TYPE_BALLETVIDEO, true
+	case "BLACKANDWHITEVIDEO":
+		return VideoType_VIDEO_TYPE_BLACKANDWHITEVIDEO, true
+	case "CHILDRENSFILM":
+		return VideoType_VIDEO_TYPE_CHILDRENSFILM, true
+	case "COLORIZEDVIDEO":
+		return VideoType_VIDEO_TYPE_COLORIZEDVIDEO, true
+	case "COLUMNVIDEO":
+		return VideoType_VIDEO_TYPE_COLUMNVIDEO, true
+	case "FICTION":
+		return VideoType_VIDEO_TYPE_FICTION, true
+	case "MAGAZINE":
+		return VideoType_VIDEO_TYPE_MAGAZINE, true
+	case "READALONGVIDEO":
+		return VideoType_VIDEO_TYPE_READALONGVIDEO, true
+	case "REALITYTVSHOWVIDEO":
+		return VideoType_VIDEO_TYPE_REALITYTVSHOWVIDEO, true
+	case "SERIALAUDIOVISUALRECORDING":
+		return VideoType_VIDEO_TYPE_SERIALAUDIOVISUALRECORDING, true
+	case "SILENTVIDEO":
+		return VideoType_VIDEO_TYPE_SILENTVIDEO, true
+	case "SKETCHVIDEO":
+		return VideoType_VIDEO_TYPE_SKETCHVIDEO, true
+	case "SOAPSITCOM":
+		return VideoType_VIDEO_TYPE_SOAPSITCOM, true
+	case "TVPROGRAM":
+		return VideoType_VIDEO_TYPE_TVPROGRAM, true
+	case "VIDEOCLIP":
+		return VideoType_VIDEO_TYPE_VIDEOCLIP, true
+	case "VIDEOREPORT":
+		return VideoType_VIDEO_TYPE_VIDEOREPORT, true
+	case "DRAMA":
+		return VideoType_VIDEO_TYPE_DRAMA, true
+	case "DRAMATICOMUSICALVIDEO":
+		return VideoType_VIDEO_TYPE_DRAMATICOMUSICALVIDEO, true
+	case "INTERACTIVERESOURCE":
+		return VideoType_VIDEO_TYPE_INTERACTIVERESOURCE, true
+	case "WEBRESOURCE":
+		return VideoType_VIDEO_TYPE_WEBRESOURCE, true
 	default:
 		return VideoType(0), false
 	}
@@ -2439,6 +19181,12 @@ func ParseVideoTypeString(s string) (VideoType, bool) {
 // XMLString returns the XML string representation of VisualPerceptionType
 func (e VisualPerceptionType) XMLString() string {
 	switch e {
+	case VisualPerceptionType_VISUAL_PERCEPTION_TYPE_BACKGROUND:
+		return "BACKGROUND"
+	case VisualPerceptionType_VISUAL_PERCEPTION_TYPE_USERDEFINED:
+		return "USERDEFINED"
+	case VisualPerceptionType_VISUAL_PERCEPTION_TYPE_VISUAL:
+		return "VISUAL"
 	default:
 		return ""
 	}
@@ -2448,6 +19196,12 @@ func (e VisualPerceptionType) XMLString() string {
 func ParseVisualPerceptionTypeString(s string) (VisualPerceptionType, bool) {
 	s = strings.ToUpper(s)
 	switch s {
+	case "BACKGROUND":
+		return VisualPerceptionType_VISUAL_PERCEPTION_TYPE_BACKGROUND, true
+	case "USERDEFINED":
+		return VisualPerceptionType_VISUAL_PERCEPTION_TYPE_USERDEFINED, true
+	case "VISUAL":
+		return VisualPerceptionType_VISUAL_PERCEPTION_TYPE_VISUAL, true
 	default:
 		return VisualPerceptionType(0), false
 	}
@@ -2456,6 +19210,12 @@ func ParseVisualPerceptionTypeString(s string) (VisualPerceptionType, bool) {
 // XMLString returns the XML string representation of VocalType
 func (e VocalType) XMLString() string {
 	switch e {
+	case VocalType_VOCAL_TYPE_INSTRUMENTAL:
+		return "INSTRUMENTAL"
+	case VocalType_VOCAL_TYPE_USERDEFINED:
+		return "USERDEFINED"
+	case VocalType_VOCAL_TYPE_VOCAL:
+		return "VOCAL"
 	default:
 		return ""
 	}
@@ -2465,6 +19225,12 @@ func (e VocalType) XMLString() string {
 func ParseVocalTypeString(s string) (VocalType, bool) {
 	s = strings.ToUpper(s)
 	switch s {
+	case "INSTRUMENTAL":
+		return VocalType_VOCAL_TYPE_INSTRUMENTAL, true
+	case "USERDEFINED":
+		return VocalType_VOCAL_TYPE_USERDEFINED, true
+	case "VOCAL":
+		return VocalType_VOCAL_TYPE_VOCAL, true
 	default:
 		return VocalType(0), false
 	}
@@ -2473,6 +19239,14 @@ func ParseVocalTypeString(s string) (VocalType, bool) {
 // XMLString returns the XML string representation of WsMessageStatus
 func (e WsMessageStatus) XMLString() string {
 	switch e {
+	case WsMessageStatus_WS_MESSAGE_STATUS_BACKENDPROCESSINGERROR:
+		return "BACKENDPROCESSINGERROR"
+	case WsMessageStatus_WS_MESSAGE_STATUS_NOVALIDMESSAGERECEIVED:
+		return "NOVALIDMESSAGERECEIVED"
+	case WsMessageStatus_WS_MESSAGE_STATUS_VALIDMESSAGEQUEUEDFORPROCESSING:
+		return "VALIDMESSAGEQUEUEDFORPROCESSING"
+	case WsMessageStatus_WS_MESSAGE_STATUS_VALIDMESSAGERECEIVED:
+		return "VALIDMESSAGERECEIVED"
 	default:
 		return ""
 	}
@@ -2482,6 +19256,14 @@ func (e WsMessageStatus) XMLString() string {
 func ParseWsMessageStatusString(s string) (WsMessageStatus, bool) {
 	s = strings.ToUpper(s)
 	switch s {
+	case "BACKENDPROCESSINGERROR":
+		return WsMessageStatus_WS_MESSAGE_STATUS_BACKENDPROCESSINGERROR, true
+	case "NOVALIDMESSAGERECEIVED":
+		return WsMessageStatus_WS_MESSAGE_STATUS_NOVALIDMESSAGERECEIVED, true
+	case "VALIDMESSAGEQUEUEDFORPROCESSING":
+		return WsMessageStatus_WS_MESSAGE_STATUS_VALIDMESSAGEQUEUEDFORPROCESSING, true
+	case "VALIDMESSAGERECEIVED":
+		return WsMessageStatus_WS_MESSAGE_STATUS_VALIDMESSAGERECEIVED, true
 	default:
 		return WsMessageStatus(0), false
 	}
@@ -2490,6 +19272,514 @@ func ParseWsMessageStatusString(s string) (WsMessageStatus, bool) {
 // XMLString returns the XML string representation of TerritoryCode
 func (e TerritoryCode) XMLString() string {
 	switch e {
+	case TerritoryCode_TERRITORY_CODE_AD:
+		return "AD"
+	case TerritoryCode_TERRITORY_CODE_AE:
+		return "AE"
+	case TerritoryCode_TERRITORY_CODE_AF:
+		return "AF"
+	case TerritoryCode_TERRITORY_CODE_AG:
+		return "AG"
+	case TerritoryCode_TERRITORY_CODE_AI:
+		return "AI"
+	case TerritoryCode_TERRITORY_CODE_AL:
+		return "AL"
+	case TerritoryCode_TERRITORY_CODE_AM:
+		return "AM"
+	case TerritoryCode_TERRITORY_CODE_AN:
+		return "AN"
+	case TerritoryCode_TERRITORY_CODE_AO:
+		return "AO"
+	case TerritoryCode_TERRITORY_CODE_AQ:
+		return "AQ"
+	case TerritoryCode_TERRITORY_CODE_AR:
+		return "AR"
+	case TerritoryCode_TERRITORY_CODE_AS:
+		return "AS"
+	case TerritoryCode_TERRITORY_CODE_AT:
+		return "AT"
+	case TerritoryCode_TERRITORY_CODE_AU:
+		return "AU"
+	case TerritoryCode_TERRITORY_CODE_AW:
+		return "AW"
+	case TerritoryCode_TERRITORY_CODE_AX:
+		return "AX"
+	case TerritoryCode_TERRITORY_CODE_AZ:
+		return "AZ"
+	case TerritoryCode_TERRITORY_CODE_BA:
+		return "BA"
+	case TerritoryCode_TERRITORY_CODE_BB:
+		return "BB"
+	case TerritoryCode_TERRITORY_CODE_BD:
+		return "BD"
+	case TerritoryCode_TERRITORY_CODE_BE:
+		return "BE"
+	case TerritoryCode_TERRITORY_CODE_BF:
+		return "BF"
+	case TerritoryCode_TERRITORY_CODE_BG:
+		return "BG"
+	case TerritoryCode_TERRITORY_CODE_BH:
+		return "BH"
+	case TerritoryCode_TERRITORY_CODE_BI:
+		return "BI"
+	case TerritoryCode_TERRITORY_CODE_BJ:
+		return "BJ"
+	case TerritoryCode_TERRITORY_CODE_BL:
+		return "BL"
+	case TerritoryCode_TERRITORY_CODE_BM:
+		return "BM"
+	case TerritoryCode_TERRITORY_CODE_BN:
+		return "BN"
+	case TerritoryCode_TERRITORY_CODE_BO:
+		return "BO"
+	case TerritoryCode_TERRITORY_CODE_BQ:
+		return "BQ"
+	case TerritoryCode_TERRITORY_CODE_BR:
+		return "BR"
+	case TerritoryCode_TERRITORY_CODE_BS:
+		return "BS"
+	case TerritoryCode_TERRITORY_CODE_BT:
+		return "BT"
+	case TerritoryCode_TERRITORY_CODE_BV:
+		return "BV"
+	case TerritoryCode_TERRITORY_CODE_BW:
+		return "BW"
+	case TerritoryCode_TERRITORY_CODE_BY:
+		return "BY"
+	case TerritoryCode_TERRITORY_CODE_BZ:
+		return "BZ"
+	case TerritoryCode_TERRITORY_CODE_CA:
+		return "CA"
+	case TerritoryCode_TERRITORY_CODE_CC:
+		return "CC"
+	case TerritoryCode_TERRITORY_CODE_CD:
+		return "CD"
+	case TerritoryCode_TERRITORY_CODE_CF:
+		return "CF"
+	case TerritoryCode_TERRITORY_CODE_CG:
+		return "CG"
+	case TerritoryCode_TERRITORY_CODE_CH:
+		return "CH"
+	case TerritoryCode_TERRITORY_CODE_CI:
+		return "CI"
+	case TerritoryCode_TERRITORY_CODE_CK:
+		return "CK"
+	case TerritoryCode_TERRITORY_CODE_CL:
+		return "CL"
+	case TerritoryCode_TERRITORY_CODE_CM:
+		return "CM"
+	case TerritoryCode_TERRITORY_CODE_CN:
+		return "CN"
+	case TerritoryCode_TERRITORY_CODE_CO:
+		return "CO"
+	case TerritoryCode_TERRITORY_CODE_CR:
+		return "CR"
+	case TerritoryCode_TERRITORY_CODE_CS:
+		return "CS"
+	case TerritoryCode_TERRITORY_CODE_CU:
+		return "CU"
+	case TerritoryCode_TERRITORY_CODE_CV:
+		return "CV"
+	case TerritoryCode_TERRITORY_CODE_CW:
+		return "CW"
+	case TerritoryCode_TERRITORY_CODE_CX:
+		return "CX"
+	case TerritoryCode_TERRITORY_CODE_CY:
+		return "CY"
+	case TerritoryCode_TERRITORY_CODE_CZ:
+		return "CZ"
+	case TerritoryCode_TERRITORY_CODE_DE:
+		return "DE"
+	case TerritoryCode_TERRITORY_CODE_DJ:
+		return "DJ"
+	case TerritoryCode_TERRITORY_CODE_DK:
+		return "DK"
+	case TerritoryCode_TERRITORY_CODE_DM:
+		return "DM"
+	case TerritoryCode_TERRITORY_CODE_DO:
+		return "DO"
+	case TerritoryCode_TERRITORY_CODE_DZ:
+		return "DZ"
+	case TerritoryCode_TERRITORY_CODE_EC:
+		return "EC"
+	case TerritoryCode_TERRITORY_CODE_EE:
+		return "EE"
+	case TerritoryCode_TERRITORY_CODE_EG:
+		return "EG"
+	case TerritoryCode_TERRITORY_CODE_EH:
+		return "EH"
+	case TerritoryCode_TERRITORY_CODE_ER:
+		return "ER"
+	case TerritoryCode_TERRITORY_CODE_ES:
+		return "ES"
+	case TerritoryCode_TERRITORY_CODE_ES_CE:
+		return "ES_CE"
+	case TerritoryCode_TERRITORY_CODE_ES_CN:
+		return "ES_CN"
+	case TerritoryCode_TERRITORY_CODE_ES_ML:
+		return "ES_ML"
+	case TerritoryCode_TERRITORY_CODE_ET:
+		return "ET"
+	case TerritoryCode_TERRITORY_CODE_FI:
+		return "FI"
+	case TerritoryCode_TERRITORY_CODE_FJ:
+		return "FJ"
+	case TerritoryCode_TERRITORY_CODE_FK:
+		return "FK"
+	case TerritoryCode_TERRITORY_CODE_FM:
+		return "FM"
+	case TerritoryCode_TERRITORY_CODE_FO:
+		return "FO"
+	case TerritoryCode_TERRITORY_CODE_FR:
+		return "FR"
+	case TerritoryCode_TERRITORY_CODE_GA:
+		return "GA"
+	case TerritoryCode_TERRITORY_CODE_GB:
+		return "GB"
+	case TerritoryCode_TERRITORY_CODE_GD:
+		return "GD"
+	case TerritoryCode_TERRITORY_CODE_GE:
+		return "GE"
+	case TerritoryCode_TERRITORY_CODE_GF:
+		return "GF"
+	case TerritoryCode_TERRITORY_CODE_GG:
+		return "GG"
+	case TerritoryCode_TERRITORY_CODE_GH:
+		return "GH"
+	case TerritoryCode_TERRITORY_CODE_GI:
+		return "GI"
+	case TerritoryCode_TERRITORY_CODE_GL:
+		return "GL"
+	case TerritoryCode_TERRITORY_CODE_GM:
+		return "GM"
+	case TerritoryCode_TERRITORY_CODE_GN:
+		return "GN"
+	case TerritoryCode_TERRITORY_CODE_GP:
+		return "GP"
+	case TerritoryCode_TERRITORY_CODE_GQ:
+		return "GQ"
+	case TerritoryCode_TERRITORY_CODE_GR:
+		return "GR"
+	case TerritoryCode_TERRITORY_CODE_GS:
+		return "GS"
+	case TerritoryCode_TERRITORY_CODE_GT:
+		return "GT"
+	case TerritoryCode_TERRITORY_CODE_GU:
+		return "GU"
+	case TerritoryCode_TERRITORY_CODE_GW:
+		return "GW"
+	case TerritoryCode_TERRITORY_CODE_GY:
+		return "GY"
+	case TerritoryCode_TERRITORY_CODE_HK:
+		return "HK"
+	case TerritoryCode_TERRITORY_CODE_HM:
+		return "HM"
+	case TerritoryCode_TERRITORY_CODE_HN:
+		return "HN"
+	case TerritoryCode_TERRITORY_CODE_HR:
+		return "HR"
+	case TerritoryCode_TERRITORY_CODE_HT:
+		return "HT"
+	case TerritoryCode_TERRITORY_CODE_HU:
+		return "HU"
+	case TerritoryCode_TERRITORY_CODE_ID:
+		return "ID"
+	case TerritoryCode_TERRITORY_CODE_IE:
+		return "IE"
+	case TerritoryCode_TERRITORY_CODE_IL:
+		return "IL"
+	case TerritoryCode_TERRITORY_CODE_IM:
+		return "IM"
+	case TerritoryCode_TERRITORY_CODE_IN:
+		return "IN"
+	case TerritoryCode_TERRITORY_CODE_IO:
+		return "IO"
+	case TerritoryCode_TERRITORY_CODE_IQ:
+		return "IQ"
+	case TerritoryCode_TERRITORY_CODE_IR:
+		return "IR"
+	case TerritoryCode_TERRITORY_CODE_IS:
+		return "IS"
+	case TerritoryCode_TERRITORY_CODE_IT:
+		return "IT"
+	case TerritoryCode_TERRITORY_CODE_JE:
+		return "JE"
+	case TerritoryCode_TERRITORY_CODE_JM:
+		return "JM"
+	case TerritoryCode_TERRITORY_CODE_JO:
+		return "JO"
+	case TerritoryCode_TERRITORY_CODE_JP:
+		return "JP"
+	case TerritoryCode_TERRITORY_CODE_KE:
+		return "KE"
+	case TerritoryCode_TERRITORY_CODE_KG:
+		return "KG"
+	case TerritoryCode_TERRITORY_CODE_KH:
+		return "KH"
+	case TerritoryCode_TERRITORY_CODE_KI:
+		return "KI"
+	case TerritoryCode_TERRITORY_CODE_KM:
+		return "KM"
+	case TerritoryCode_TERRITORY_CODE_KN:
+		return "KN"
+	case TerritoryCode_TERRITORY_CODE_KP:
+		return "KP"
+	case TerritoryCode_TERRITORY_CODE_KR:
+		return "KR"
+	case TerritoryCode_TERRITORY_CODE_KW:
+		return "KW"
+	case TerritoryCode_TERRITORY_CODE_KY:
+		return "KY"
+	case TerritoryCode_TERRITORY_CODE_KZ:
+		return "KZ"
+	case TerritoryCode_TERRITORY_CODE_LA:
+		return "LA"
+	case TerritoryCode_TERRITORY_CODE_LB:
+		return "LB"
+	case TerritoryCode_TERRITORY_CODE_LC:
+		return "LC"
+	case TerritoryCode_TERRITORY_CODE_LI:
+		return "LI"
+	case TerritoryCode_TERRITORY_CODE_LK:
+		return "LK"
+	case TerritoryCode_TERRITORY_CODE_LR:
+		return "LR"
+	case TerritoryCode_TERRITORY_CODE_LS:
+		return "LS"
+	case TerritoryCode_TERRITORY_CODE_LT:
+		return "LT"
+	case TerritoryCode_TERRITORY_CODE_LU:
+		return "LU"
+	case TerritoryCode_TERRITORY_CODE_LV:
+		return "LV"
+	case TerritoryCode_TERRITORY_CODE_LY:
+		return "LY"
+	case TerritoryCode_TERRITORY_CODE_MA:
+		return "MA"
+	case TerritoryCode_TERRITORY_CODE_MC:
+		return "MC"
+	case TerritoryCode_TERRITORY_CODE_MD:
+		return "MD"
+	case TerritoryCode_TERRITORY_CODE_ME:
+		return "ME"
+	case TerritoryCode_TERRITORY_CODE_MF:
+		return "MF"
+	case TerritoryCode_TERRITORY_CODE_MG:
+		return "MG"
+	case TerritoryCode_TERRITORY_CODE_MH:
+		return "MH"
+	case TerritoryCode_TERRITORY_CODE_MK:
+		return "MK"
+	case TerritoryCode_TERRITORY_CODE_ML:
+		return "ML"
+	case TerritoryCode_TERRITORY_CODE_MM:
+		return "MM"
+	case TerritoryCode_TERRITORY_CODE_MN:
+		return "MN"
+	case TerritoryCode_TERRITORY_CODE_MO:
+		return "MO"
+	case TerritoryCode_TERRITORY_CODE_MP:
+		return "MP"
+	case TerritoryCode_TERRITORY_CODE_MQ:
+		return "MQ"
+	case TerritoryCode_TERRITORY_CODE_MR:
+		return "MR"
+	case TerritoryCode_TERRITORY_CODE_MS:
+		return "MS"
+	case TerritoryCode_TERRITORY_CODE_MT:
+		return "MT"
+	case TerritoryCode_TERRITORY_CODE_MU:
+		return "MU"
+	case TerritoryCode_TERRITORY_CODE_MV:
+		return "MV"
+	case TerritoryCode_TERRITORY_CODE_MW:
+		return "MW"
+	case TerritoryCode_TERRITORY_CODE_MX:
+		return "MX"
+	case TerritoryCode_TERRITORY_CODE_MY:
+		return "MY"
+	case TerritoryCode_TERRITORY_CODE_MZ:
+		return "MZ"
+	case TerritoryCode_TERRITORY_CODE_NA:
+		return "NA"
+	case TerritoryCode_TERRITORY_CODE_NC:
+		return "NC"
+	case TerritoryCode_TERRITORY_CODE_NE:
+		return "NE"
+	case TerritoryCode_TERRITORY_CODE_NF:
+		return "NF"
+	case TerritoryCode_TERRITORY_CODE_NG:
+		return "NG"
+	case TerritoryCode_TERRITORY_CODE_NI:
+		return "NI"
+	case TerritoryCode_TERRITORY_CODE_NL:
+		return "NL"
+	case TerritoryCode_TERRITORY_CODE_NO:
+		return "NO"
+	case TerritoryCode_TERRITORY_CODE_NP:
+		return "NP"
+	case TerritoryCode_TERRITORY_CODE_NR:
+		return "NR"
+	case TerritoryCode_TERRITORY_CODE_NU:
+		return "NU"
+	case TerritoryCode_TERRITORY_CODE_NZ:
+		return "NZ"
+	case TerritoryCode_TERRITORY_CODE_OM:
+		return "OM"
+	case TerritoryCode_TERRITORY_CODE_PA:
+		return "PA"
+	case TerritoryCode_TERRITORY_CODE_PE:
+		return "PE"
+	case TerritoryCode_TERRITORY_CODE_PF:
+		return "PF"
+	case TerritoryCode_TERRITORY_CODE_PG:
+		return "PG"
+	case TerritoryCode_TERRITORY_CODE_PH:
+		return "PH"
+	case TerritoryCode_TERRITORY_CODE_PK:
+		return "PK"
+	case TerritoryCode_TERRITORY_CODE_PL:
+		return "PL"
+	case TerritoryCode_TERRITORY_CODE_PM:
+		return "PM"
+	case TerritoryCode_TERRITORY_CODE_PN:
+		return "PN"
+	case TerritoryCode_TERRITORY_CODE_PR:
+		return "PR"
+	case TerritoryCode_TERRITORY_CODE_PS:
+		return "PS"
+	case TerritoryCode_TERRITORY_CODE_PT:
+		return "PT"
+	case TerritoryCode_TERRITORY_CODE_PW:
+		return "PW"
+	case TerritoryCode_TERRITORY_CODE_PY:
+		return "PY"
+	case TerritoryCode_TERRITORY_CODE_QA:
+		return "QA"
+	case TerritoryCode_TERRITORY_CODE_RE:
+		return "RE"
+	case TerritoryCode_TERRITORY_CODE_RO:
+		return "RO"
+	case TerritoryCode_TERRITORY_CODE_RS:
+		return "RS"
+	case TerritoryCode_TERRITORY_CODE_RU:
+		return "RU"
+	case TerritoryCode_TERRITORY_CODE_RW:
+		return "RW"
+	case TerritoryCode_TERRITORY_CODE_SA:
+		return "SA"
+	case TerritoryCode_TERRITORY_CODE_SB:
+		return "SB"
+	case TerritoryCode_TERRITORY_CODE_SC:
+		return "SC"
+	case TerritoryCode_TERRITORY_CODE_SD:
+		return "SD"
+	case TerritoryCode_TERRITORY_CODE_SE:
+		return "SE"
+	case TerritoryCode_TERRITORY_CODE_SG:
+		return "SG"
+	case TerritoryCode_TERRITORY_CODE_SH:
+		return "SH"
+	case TerritoryCode_TERRITORY_CODE_SI:
+		return "SI"
+	case TerritoryCode_TERRITORY_CODE_SJ:
+		return "SJ"
+	case TerritoryCode_TERRITORY_CODE_SK:
+		return "SK"
+	case TerritoryCode_TERRITORY_CODE_SL:
+		return "SL"
+	case TerritoryCode_TERRITORY_CODE_SM:
+		return "SM"
+	case TerritoryCode_TERRITORY_CODE_SN:
+		return "SN"
+	case TerritoryCode_TERRITORY_CODE_SO:
+		return "SO"
+	case TerritoryCode_TERRITORY_CODE_SR:
+		return "SR"
+	case TerritoryCode_TERRITORY_CODE_SS:
+		return "SS"
+	case TerritoryCode_TERRITORY_CODE_ST:
+		return "ST"
+	case TerritoryCode_TERRITORY_CODE_SV:
+		return "SV"
+	case TerritoryCode_TERRITORY_CODE_SX:
+		return "SX"
+	case TerritoryCode_TERRITORY_CODE_SY:
+		return "SY"
+	case TerritoryCode_TERRITORY_CODE_SZ:
+		return "SZ"
+	case TerritoryCode_TERRITORY_CODE_TC:
+		return "TC"
+	case TerritoryCode_TERRITORY_CODE_TD:
+		return "TD"
+	case TerritoryCode_TERRITORY_CODE_TF:
+		return "TF"
+	case TerritoryCode_TERRITORY_CODE_TG:
+		return "TG"
+	case TerritoryCode_TERRITORY_CODE_TH:
+		return "TH"
+	case TerritoryCode_TERRITORY_CODE_TJ:
+		return "TJ"
+	case TerritoryCode_TERRITORY_CODE_TK:
+		return "TK"
+	case TerritoryCode_TERRITORY_CODE_TL:
+		return "TL"
+	case TerritoryCode_TERRITORY_CODE_TM:
+		return "TM"
+	case TerritoryCode_TERRITORY_CODE_TN:
+		return "TN"
+	case TerritoryCode_TERRITORY_CODE_TO:
+		return "TO"
+	case TerritoryCode_TERRITORY_CODE_TR:
+		return "TR"
+	case TerritoryCode_TERRITORY_CODE_TT:
+		return "TT"
+	case TerritoryCode_TERRITORY_CODE_TV:
+		return "TV"
+	case TerritoryCode_TERRITORY_CODE_TW:
+		return "TW"
+	case TerritoryCode_TERRITORY_CODE_TZ:
+		return "TZ"
+	case TerritoryCode_TERRITORY_CODE_UA:
+		return "UA"
+	case TerritoryCode_TERRITORY_CODE_UG:
+		return "UG"
+	case TerritoryCode_TERRITORY_CODE_UM:
+		return "UM"
+	case TerritoryCode_TERRITORY_CODE_US:
+		return "US"
+	case TerritoryCode_TERRITORY_CODE_UY:
+		return "UY"
+	case TerritoryCode_TERRITORY_CODE_UZ:
+		return "UZ"
+	case TerritoryCode_TERRITORY_CODE_VA:
+		return "VA"
+	case TerritoryCode_TERRITORY_CODE_VC:
+		return "VC"
+	case TerritoryCode_TERRITORY_CODE_VE:
+		return "VE"
+	case TerritoryCode_TERRITORY_CODE_VG:
+		return "VG"
+	case TerritoryCode_TERRITORY_CODE_VI:
+		return "VI"
+	case TerritoryCode_TERRITORY_CODE_VN:
+		return "VN"
+	case TerritoryCode_TERRITORY_CODE_VU:
+		return "VU"
+	case TerritoryCode_TERRITORY_CODE_WF:
+		return "WF"
+	case TerritoryCode_TERRITORY_CODE_WS:
+		return "WS"
+	case TerritoryCode_TERRITORY_CODE_YE:
+		return "YE"
+	case TerritoryCode_TERRITORY_CODE_YT:
+		return "YT"
+	case TerritoryCode_TERRITORY_CODE_ZA:
+		return "ZA"
+	case TerritoryCode_TERRITORY_CODE_ZM:
+		return "ZM"
+	case TerritoryCode_TERRITORY_CODE_ZW:
+		return "ZW"
 	default:
 		return ""
 	}
@@ -2499,6 +19789,514 @@ func (e TerritoryCode) XMLString() string {
 func ParseTerritoryCodeString(s string) (TerritoryCode, bool) {
 	s = strings.ToUpper(s)
 	switch s {
+	case "AD":
+		return TerritoryCode_TERRITORY_CODE_AD, true
+	case "AE":
+		return TerritoryCode_TERRITORY_CODE_AE, true
+	case "AF":
+		return TerritoryCode_TERRITORY_CODE_AF, true
+	case "AG":
+		return TerritoryCode_TERRITORY_CODE_AG, true
+	case "AI":
+		return TerritoryCode_TERRITORY_CODE_AI, true
+	case "AL":
+		return TerritoryCode_TERRITORY_CODE_AL, true
+	case "AM":
+		return TerritoryCode_TERRITORY_CODE_AM, true
+	case "AN":
+		return TerritoryCode_TERRITORY_CODE_AN, true
+	case "AO":
+		return TerritoryCode_TERRITORY_CODE_AO, true
+	case "AQ":
+		return TerritoryCode_TERRITORY_CODE_AQ, true
+	case "AR":
+		return TerritoryCode_TERRITORY_CODE_AR, true
+	case "AS":
+		return TerritoryCode_TERRITORY_CODE_AS, true
+	case "AT":
+		return TerritoryCode_TERRITORY_CODE_AT, true
+	case "AU":
+		return TerritoryCode_TERRITORY_CODE_AU, true
+	case "AW":
+		return TerritoryCode_TERRITORY_CODE_AW, true
+	case "AX":
+		return TerritoryCode_TERRITORY_CODE_AX, true
+	case "AZ":
+		return TerritoryCode_TERRITORY_CODE_AZ, true
+	case "BA":
+		return TerritoryCode_TERRITORY_CODE_BA, true
+	case "BB":
+		return TerritoryCode_TERRITORY_CODE_BB, true
+	case "BD":
+		return TerritoryCode_TERRITORY_CODE_BD, true
+	case "BE":
+		return TerritoryCode_TERRITORY_CODE_BE, true
+	case "BF":
+		return TerritoryCode_TERRITORY_CODE_BF, true
+	case "BG":
+		return TerritoryCode_TERRITORY_CODE_BG, true
+	case "BH":
+		return TerritoryCode_TERRITORY_CODE_BH, true
+	case "BI":
+		return TerritoryCode_TERRITORY_CODE_BI, true
+	case "BJ":
+		return TerritoryCode_TERRITORY_CODE_BJ, true
+	case "BL":
+		return TerritoryCode_TERRITORY_CODE_BL, true
+	case "BM":
+		return TerritoryCode_TERRITORY_CODE_BM, true
+	case "BN":
+		return TerritoryCode_TERRITORY_CODE_BN, true
+	case "BO":
+		return TerritoryCode_TERRITORY_CODE_BO, true
+	case "BQ":
+		return TerritoryCode_TERRITORY_CODE_BQ, true
+	case "BR":
+		return TerritoryCode_TERRITORY_CODE_BR, true
+	case "BS":
+		return TerritoryCode_TERRITORY_CODE_BS, true
+	case "BT":
+		return TerritoryCode_TERRITORY_CODE_BT, true
+	case "BV":
+		return TerritoryCode_TERRITORY_CODE_BV, true
+	case "BW":
+		return TerritoryCode_TERRITORY_CODE_BW, true
+	case "BY":
+		return TerritoryCode_TERRITORY_CODE_BY, true
+	case "BZ":
+		return TerritoryCode_TERRITORY_CODE_BZ, true
+	case "CA":
+		return TerritoryCode_TERRITORY_CODE_CA, true
+	case "CC":
+		return TerritoryCode_TERRITORY_CODE_CC, true
+	case "CD":
+		return TerritoryCode_TERRITORY_CODE_CD, true
+	case "CF":
+		return TerritoryCode_TERRITORY_CODE_CF, true
+	case "CG":
+		return TerritoryCode_TERRITORY_CODE_CG, true
+	case "CH":
+		return TerritoryCode_TERRITORY_CODE_CH, true
+	case "CI":
+		return TerritoryCode_TERRITORY_CODE_CI, true
+	case "CK":
+		return TerritoryCode_TERRITORY_CODE_CK, true
+	case "CL":
+		return TerritoryCode_TERRITORY_CODE_CL, true
+	case "CM":
+		return TerritoryCode_TERRITORY_CODE_CM, true
+	case "CN":
+		return TerritoryCode_TERRITORY_CODE_CN, true
+	case "CO":
+		return TerritoryCode_TERRITORY_CODE_CO, true
+	case "CR":
+		return TerritoryCode_TERRITORY_CODE_CR, true
+	case "CS":
+		return TerritoryCode_TERRITORY_CODE_CS, true
+	case "CU":
+		return TerritoryCode_TERRITORY_CODE_CU, true
+	case "CV":
+		return TerritoryCode_TERRITORY_CODE_CV, true
+	case "CW":
+		return TerritoryCode_TERRITORY_CODE_CW, true
+	case "CX":
+		return TerritoryCode_TERRITORY_CODE_CX, true
+	case "CY":
+		return TerritoryCode_TERRITORY_CODE_CY, true
+	case "CZ":
+		return TerritoryCode_TERRITORY_CODE_CZ, true
+	case "DE":
+		return TerritoryCode_TERRITORY_CODE_DE, true
+	case "DJ":
+		return TerritoryCode_TERRITORY_CODE_DJ, true
+	case "DK":
+		return TerritoryCode_TERRITORY_CODE_DK, true
+	case "DM":
+		return TerritoryCode_TERRITORY_CODE_DM, true
+	case "DO":
+		return TerritoryCode_TERRITORY_CODE_DO, true
+	case "DZ":
+		return TerritoryCode_TERRITORY_CODE_DZ, true
+	case "EC":
+		return TerritoryCode_TERRITORY_CODE_EC, true
+	case "EE":
+		return TerritoryCode_TERRITORY_CODE_EE, true
+	case "EG":
+		return TerritoryCode_TERRITORY_CODE_EG, true
+	case "EH":
+		return TerritoryCode_TERRITORY_CODE_EH, true
+	case "ER":
+		return TerritoryCode_TERRITORY_CODE_ER, true
+	case "ES":
+		return TerritoryCode_TERRITORY_CODE_ES, true
+	case "ES_CE":
+		return TerritoryCode_TERRITORY_CODE_ES_CE, true
+	case "ES_CN":
+		return TerritoryCode_TERRITORY_CODE_ES_CN, true
+	case "ES_ML":
+		return TerritoryCode_TERRITORY_CODE_ES_ML, true
+	case "ET":
+		return TerritoryCode_TERRITORY_CODE_ET, true
+	case "FI":
+		return TerritoryCode_TERRITORY_CODE_FI, true
+	case "FJ":
+		return TerritoryCode_TERRITORY_CODE_FJ, true
+	case "FK":
+		return TerritoryCode_TERRITORY_CODE_FK, true
+	case "FM":
+		return TerritoryCode_TERRITORY_CODE_FM, true
+	case "FO":
+		return TerritoryCode_TERRITORY_CODE_FO, true
+	case "FR":
+		return TerritoryCode_TERRITORY_CODE_FR, true
+	case "GA":
+		return TerritoryCode_TERRITORY_CODE_GA, true
+	case "GB":
+		return TerritoryCode_TERRITORY_CODE_GB, true
+	case "GD":
+		return TerritoryCode_TERRITORY_CODE_GD, true
+	case "GE":
+		return TerritoryCode_TERRITORY_CODE_GE, true
+	case "GF":
+		return TerritoryCode_TERRITORY_CODE_GF, true
+	case "GG":
+		return TerritoryCode_TERRITORY_CODE_GG, true
+	case "GH":
+		return TerritoryCode_TERRITORY_CODE_GH, true
+	case "GI":
+		return TerritoryCode_TERRITORY_CODE_GI, true
+	case "GL":
+		return TerritoryCode_TERRITORY_CODE_GL, true
+	case "GM":
+		return TerritoryCode_TERRITORY_CODE_GM, true
+	case "GN":
+		return TerritoryCode_TERRITORY_CODE_GN, true
+	case "GP":
+		return TerritoryCode_TERRITORY_CODE_GP, true
+	case "GQ":
+		return TerritoryCode_TERRITORY_CODE_GQ, true
+	case "GR":
+		return TerritoryCode_TERRITORY_CODE_GR, true
+	case "GS":
+		return TerritoryCode_TERRITORY_CODE_GS, true
+	case "GT":
+		return TerritoryCode_TERRITORY_CODE_GT, true
+	case "GU":
+		return TerritoryCode_TERRITORY_CODE_GU, true
+	case "GW":
+		return TerritoryCode_TERRITORY_CODE_GW, true
+	case "GY":
+		return TerritoryCode_TERRITORY_CODE_GY, true
+	case "HK":
+		return TerritoryCode_TERRITORY_CODE_HK, true
+	case "HM":
+		return TerritoryCode_TERRITORY_CODE_HM, true
+	case "HN":
+		return TerritoryCode_TERRITORY_CODE_HN, true
+	case "HR":
+		return TerritoryCode_TERRITORY_CODE_HR, true
+	case "HT":
+		return TerritoryCode_TERRITORY_CODE_HT, true
+	case "HU":
+		return TerritoryCode_TERRITORY_CODE_HU, true
+	case "ID":
+		return TerritoryCode_TERRITORY_CODE_ID, true
+	case "IE":
+		return TerritoryCode_TERRITORY_CODE_IE, true
+	case "IL":
+		return TerritoryCode_TERRITORY_CODE_IL, true
+	case "IM":
+		return TerritoryCode_TERRITORY_CODE_IM, true
+	case "IN":
+		return TerritoryCode_TERRITORY_CODE_IN, true
+	case "IO":
+		return TerritoryCode_TERRITORY_CODE_IO, true
+	case "IQ":
+		return TerritoryCode_TERRITORY_CODE_IQ, true
+	case "IR":
+		return TerritoryCode_TERRITORY_CODE_IR, true
+	case "IS":
+		return TerritoryCode_TERRITORY_CODE_IS, true
+	case "IT":
+		return TerritoryCode_TERRITORY_CODE_IT, true
+	case "JE":
+		return TerritoryCode_TERRITORY_CODE_JE, true
+	case "JM":
+		return TerritoryCode_TERRITORY_CODE_JM, true
+	case "JO":
+		return TerritoryCode_TERRITORY_CODE_JO, true
+	case "JP":
+		return TerritoryCode_TERRITORY_CODE_JP, true
+	case "KE":
+		return TerritoryCode_TERRITORY_CODE_KE, true
+	case "KG":
+		return TerritoryCode_TERRITORY_CODE_KG, true
+	case "KH":
+		return TerritoryCode_TERRITORY_CODE_KH, true
+	case "KI":
+		return TerritoryCode_TERRITORY_CODE_KI, true
+	case "KM":
+		return TerritoryCode_TERRITORY_CODE_KM, true
+	case "KN":
+		return TerritoryCode_TERRITORY_CODE_KN, true
+	case "KP":
+		return TerritoryCode_TERRITORY_CODE_KP, true
+	case "KR":
+		return TerritoryCode_TERRITORY_CODE_KR, true
+	case "KW":
+		return TerritoryCode_TERRITORY_CODE_KW, true
+	case "KY":
+		return TerritoryCode_TERRITORY_CODE_KY, true
+	case "KZ":
+		return TerritoryCode_TERRITORY_CODE_KZ, true
+	case "LA":
+		return TerritoryCode_TERRITORY_CODE_LA, true
+	case "LB":
+		return TerritoryCode_TERRITORY_CODE_LB, true
+	case "LC":
+		return TerritoryCode_TERRITORY_CODE_LC, true
+	case "LI":
+		return TerritoryCode_TERRITORY_CODE_LI, true
+	case "LK":
+		return TerritoryCode_TERRITORY_CODE_LK, true
+	case "LR":
+		return TerritoryCode_TERRITORY_CODE_LR, true
+	case "LS":
+		return TerritoryCode_TERRITORY_CODE_LS, true
+	case "LT":
+		return TerritoryCode_TERRITORY_CODE_LT, true
+	case "LU":
+		return TerritoryCode_TERRITORY_CODE_LU, true
+	case "LV":
+		return TerritoryCode_TERRITORY_CODE_LV, true
+	case "LY":
+		return TerritoryCode_TERRITORY_CODE_LY, true
+	case "MA":
+		return TerritoryCode_TERRITORY_CODE_MA, true
+	case "MC":
+		return TerritoryCode_TERRITORY_CODE_MC, true
+	case "MD":
+		return TerritoryCode_TERRITORY_CODE_MD, true
+	case "ME":
+		return TerritoryCode_TERRITORY_CODE_ME, true
+	case "MF":
+		return TerritoryCode_TERRITORY_CODE_MF, true
+	case "MG":
+		return TerritoryCode_TERRITORY_CODE_MG, true
+	case "MH":
+		return TerritoryCode_TERRITORY_CODE_MH, true
+	case "MK":
+		return TerritoryCode_TERRITORY_CODE_MK, true
+	case "ML":
+		return TerritoryCode_TERRITORY_CODE_ML, true
+	case "MM":
+		return TerritoryCode_TERRITORY_CODE_MM, true
+	case "MN":
+		return TerritoryCode_TERRITORY_CODE_MN, true
+	case "MO":
+		return TerritoryCode_TERRITORY_CODE_MO, true
+	case "MP":
+		return TerritoryCode_TERRITORY_CODE_MP, true
+	case "MQ":
+		return TerritoryCode_TERRITORY_CODE_MQ, true
+	case "MR":
+		return TerritoryCode_TERRITORY_CODE_MR, true
+	case "MS":
+		return TerritoryCode_TERRITORY_CODE_MS, true
+	case "MT":
+		return TerritoryCode_TERRITORY_CODE_MT, true
+	case "MU":
+		return TerritoryCode_TERRITORY_CODE_MU, true
+	case "MV":
+		return TerritoryCode_TERRITORY_CODE_MV, true
+	case "MW":
+		return TerritoryCode_TERRITORY_CODE_MW, true
+	case "MX":
+		return TerritoryCode_TERRITORY_CODE_MX, true
+	case "MY":
+		return TerritoryCode_TERRITORY_CODE_MY, true
+	case "MZ":
+		return TerritoryCode_TERRITORY_CODE_MZ, true
+	case "NA":
+		return TerritoryCode_TERRITORY_CODE_NA, true
+	case "NC":
+		return TerritoryCode_TERRITORY_CODE_NC, true
+	case "NE":
+		return TerritoryCode_TERRITORY_CODE_NE, true
+	case "NF":
+		return TerritoryCode_TERRITORY_CODE_NF, true
+	case "NG":
+		return TerritoryCode_TERRITORY_CODE_NG, true
+	case "NI":
+		return TerritoryCode_TERRITORY_CODE_NI, true
+	case "NL":
+		return TerritoryCode_TERRITORY_CODE_NL, true
+	case "NO":
+		return TerritoryCode_TERRITORY_CODE_NO, true
+	case "NP":
+		return TerritoryCode_TERRITORY_CODE_NP, true
+	case "NR":
+		return TerritoryCode_TERRITORY_CODE_NR, true
+	case "NU":
+		return TerritoryCode_TERRITORY_CODE_NU, true
+	case "NZ":
+		return TerritoryCode_TERRITORY_CODE_NZ, true
+	case "OM":
+		return TerritoryCode_TERRITORY_CODE_OM, true
+	case "PA":
+		return TerritoryCode_TERRITORY_CODE_PA, true
+	case "PE":
+		return TerritoryCode_TERRITORY_CODE_PE, true
+	case "PF":
+		return TerritoryCode_TERRITORY_CODE_PF, true
+	case "PG":
+		return TerritoryCode_TERRITORY_CODE_PG, true
+	case "PH":
+		return TerritoryCode_TERRITORY_CODE_PH, true
+	case "PK":
+		return TerritoryCode_TERRITORY_CODE_PK, true
+	case "PL":
+		return TerritoryCode_TERRITORY_CODE_PL, true
+	case "PM":
+		return TerritoryCode_TERRITORY_CODE_PM, true
+	case "PN":
+		return TerritoryCode_TERRITORY_CODE_PN, true
+	case "PR":
+		return TerritoryCode_TERRITORY_CODE_PR, true
+	case "PS":
+		return TerritoryCode_TERRITORY_CODE_PS, true
+	case "PT":
+		return TerritoryCode_TERRITORY_CODE_PT, true
+	case "PW":
+		return TerritoryCode_TERRITORY_CODE_PW, true
+	case "PY":
+		return TerritoryCode_TERRITORY_CODE_PY, true
+	case "QA":
+		return TerritoryCode_TERRITORY_CODE_QA, true
+	case "RE":
+		return TerritoryCode_TERRITORY_CODE_RE, true
+	case "RO":
+		return TerritoryCode_TERRITORY_CODE_RO, true
+	case "RS":
+		return TerritoryCode_TERRITORY_CODE_RS, true
+	case "RU":
+		return TerritoryCode_TERRITORY_CODE_RU, true
+	case "RW":
+		return TerritoryCode_TERRITORY_CODE_RW, true
+	case "SA":
+		return TerritoryCode_TERRITORY_CODE_SA, true
+	case "SB":
+		return TerritoryCode_TERRITORY_CODE_SB, true
+	case "SC":
+		return TerritoryCode_TERRITORY_CODE_SC, true
+	case "SD":
+		return TerritoryCode_TERRITORY_CODE_SD, true
+	case "SE":
+		return TerritoryCode_TERRITORY_CODE_SE, true
+	case "SG":
+		return TerritoryCode_TERRITORY_CODE_SG, true
+	case "SH":
+		return TerritoryCode_TERRITORY_CODE_SH, true
+	case "SI":
+		return TerritoryCode_TERRITORY_CODE_SI, true
+	case "SJ":
+		return TerritoryCode_TERRITORY_CODE_SJ, true
+	case "SK":
+		return TerritoryCode_TERRITORY_CODE_SK, true
+	case "SL":
+		return TerritoryCode_TERRITORY_CODE_SL, true
+	case "SM":
+		return TerritoryCode_TERRITORY_CODE_SM, true
+	case "SN":
+		return TerritoryCode_TERRITORY_CODE_SN, true
+	case "SO":
+		return TerritoryCode_TERRITORY_CODE_SO, true
+	case "SR":
+		return TerritoryCode_TERRITORY_CODE_SR, true
+	case "SS":
+		return TerritoryCode_TERRITORY_CODE_SS, true
+	case "ST":
+		return TerritoryCode_TERRITORY_CODE_ST, true
+	case "SV":
+		return TerritoryCode_TERRITORY_CODE_SV, true
+	case "SX":
+		return TerritoryCode_TERRITORY_CODE_SX, true
+	case "SY":
+		return TerritoryCode_TERRITORY_CODE_SY, true
+	case "SZ":
+		return TerritoryCode_TERRITORY_CODE_SZ, true
+	case "TC":
+		return TerritoryCode_TERRITORY_CODE_TC, true
+	case "TD":
+		return TerritoryCode_TERRITORY_CODE_TD, true
+	case "TF":
+		return TerritoryCode_TERRITORY_CODE_TF, true
+	case "TG":
+		return TerritoryCode_TERRITORY_CODE_TG, true
+	case "TH":
+		return TerritoryCode_TERRITORY_CODE_TH, true
+	case "TJ":
+		return TerritoryCode_TERRITORY_CODE_TJ, true
+	case "TK":
+		return TerritoryCode_TERRITORY_CODE_TK, true
+	case "TL":
+		return TerritoryCode_TERRITORY_CODE_TL, true
+	case "TM":
+		return TerritoryCode_TERRITORY_CODE_TM, true
+	case "TN":
+		return TerritoryCode_TERRITORY_CODE_TN, true
+	case "TO":
+		return TerritoryCode_TERRITORY_CODE_TO, true
+	case "TR":
+		return TerritoryCode_TERRITORY_CODE_TR, true
+	case "TT":
+		return TerritoryCode_TERRITORY_CODE_TT, true
+	case "TV":
+		return TerritoryCode_TERRITORY_CODE_TV, true
+	case "TW":
+		return TerritoryCode_TERRITORY_CODE_TW, true
+	case "TZ":
+		return TerritoryCode_TERRITORY_CODE_TZ, true
+	case "UA":
+		return TerritoryCode_TERRITORY_CODE_UA, true
+	case "UG":
+		return TerritoryCode_TERRITORY_CODE_UG, true
+	case "UM":
+		return TerritoryCode_TERRITORY_CODE_UM, true
+	case "US":
+		return TerritoryCode_TERRITORY_CODE_US, true
+	case "UY":
+		return TerritoryCode_TERRITORY_CODE_UY, true
+	case "UZ":
+		return TerritoryCode_TERRITORY_CODE_UZ, true
+	case "VA":
+		return TerritoryCode_TERRITORY_CODE_VA, true
+	case "VC":
+		return TerritoryCode_TERRITORY_CODE_VC, true
+	case "VE":
+		return TerritoryCode_TERRITORY_CODE_VE, true
+	case "VG":
+		return TerritoryCode_TERRITORY_CODE_VG, true
+	case "VI":
+		return TerritoryCode_TERRITORY_CODE_VI, true
+	case "VN":
+		return TerritoryCode_TERRITORY_CODE_VN, true
+	case "VU":
+		return TerritoryCode_TERRITORY_CODE_VU, true
+	case "WF":
+		return TerritoryCode_TERRITORY_CODE_WF, true
+	case "WS":
+		return TerritoryCode_TERRITORY_CODE_WS, true
+	case "YE":
+		return TerritoryCode_TERRITORY_CODE_YE, true
+	case "YT":
+		return TerritoryCode_TERRITORY_CODE_YT, true
+	case "ZA":
+		return TerritoryCode_TERRITORY_CODE_ZA, true
+	case "ZM":
+		return TerritoryCode_TERRITORY_CODE_ZM, true
+	case "ZW":
+		return TerritoryCode_TERRITORY_CODE_ZW, true
 	default:
 		return TerritoryCode(0), false
 	}
@@ -2507,6 +20305,10 @@ func ParseTerritoryCodeString(s string) (TerritoryCode, bool) {
 // XMLString returns the XML string representation of ReferenceCreation
 func (e ReferenceCreation) XMLString() string {
 	switch e {
+	case ReferenceCreation_REFERENCE_CREATION_REFERENCERESOURCE:
+		return "REFERENCERESOURCE"
+	case ReferenceCreation_REFERENCE_CREATION_CONSUMERRESOURCE:
+		return "CONSUMERRESOURCE"
 	default:
 		return ""
 	}
@@ -2516,6 +20318,10 @@ func (e ReferenceCreation) XMLString() string {
 func ParseReferenceCreationString(s string) (ReferenceCreation, bool) {
 	s = strings.ToUpper(s)
 	switch s {
+	case "REFERENCERESOURCE":
+		return ReferenceCreation_REFERENCE_CREATION_REFERENCERESOURCE, true
+	case "CONSUMERRESOURCE":
+		return ReferenceCreation_REFERENCE_CREATION_CONSUMERRESOURCE, true
 	default:
 		return ReferenceCreation(0), false
 	}
@@ -0,0 +1,100 @@
+// Code generated by generate-go-extensions. DO NOT EDIT.
+
+package ernv381
+
+import "iter"
+
+// Resource is implemented by the resource types of the package, which share a ResourceReference and a
+// resource type: Image, SheetMusic, Software, SoundRecording, Text, UserDefinedResource, Video
+type Resource interface {
+	GetResourceReference() string
+	// ResourceType returns the Value of the resource's type element, e.g. MusicalWorkSoundRecording
+	ResourceType() string
+}
+
+var (
+	_ Resource = (*Image)(nil)
+	_ Resource = (*SheetMusic)(nil)
+	_ Resource = (*Software)(nil)
+	_ Resource = (*SoundRecording)(nil)
+	_ Resource = (*Text)(nil)
+	_ Resource = (*UserDefinedResource)(nil)
+	_ Resource = (*Video)(nil)
+)
+
+// ResourceType returns the Value of ImageType
+func (x *Image) ResourceType() string {
+	return x.GetImageType().GetValue()
+}
+
+// ResourceType returns the Value of SheetMusicType
+func (x *SheetMusic) ResourceType() string {
+	return x.GetSheetMusicType().GetValue()
+}
+
+// ResourceType returns the Value of SoftwareType
+func (x *Software) ResourceType() string {
+	return x.GetSoftwareType().GetValue()
+}
+
+// ResourceType returns the Value of SoundRecordingType
+func (x *SoundRecording) ResourceType() string {
+	return x.GetSoundRecordingType().GetValue()
+}
+
+// ResourceType returns the Value of TextType
+func (x *Text) ResourceType() string {
+	return x.GetTextType().GetValue()
+}
+
+// ResourceType returns the Value of UserDefinedResourceType
+func (x *UserDefinedResource) ResourceType() string {
+	return x.GetUserDefinedResourceType().GetValue()
+}
+
+// ResourceType returns the Value of VideoType
+func (x *Video) ResourceType() string {
+	return x.GetVideoType().GetValue()
+}
+
+// Resources yields the resources of the list, kind by kind in schema order:
+// SoundRecording, Video, Image, Text, SheetMusic, Software, UserDefinedResource
+func (x *ResourceList) Resources() iter.Seq[Resource] {
+	return func(yield func(Resource) bool) {
+		for _, resource := range x.GetSoundRecording() {
+			if !yield(resource) {
+				return
+			}
+		}
+		for _, resource := range x.GetVideo() {
+			if !yield(resource) {
+				return
+			}
+		}
+		for _, resource := range x.GetImage() {
+			if !yield(resource) {
+				return
+			}
+		}
+		for _, resource := range x.GetText() {
+			if !yield(resource) {
+				return
+			}
+		}
+		for _, resource := range x.GetSheetMusic() {
+			if !yield(resource) {
+				return
+			}
+		}
+		for _, resource := range x.GetSoftware() {
+			if !yield(resource) {
+				return
+			}
+		}
+		for _, resource := range x.GetUserDefinedResource() {
+			if !yield(resource) {
+				return
+			}
+		}
+	}
+}
@@ -4,8 +4,13 @@ package ernv381
 
 import (
 	"encoding/xml"
+	"fmt"
 	"reflect"
+	"runtime"
+	"sort"
 	"strings"
+	"sync"
+	"weak"
 )
 
 // Package-level namespace constants
@@ -16,6 +21,17 @@ const (
 	NamespaceAVS    = "http://ddex.net/xml/avs/avs"
 )
 
+// parseWarnings holds the ParseWarnings of root messages by weak pointer, as the generated message
+// structs have no field for them
+var parseWarnings sync.Map
+
+// newReleaseMessageAttrs caches the attributes that struct fields of NewReleaseMessage handle, which MarshalXML
+// must not repeat from NamespaceAttrs
+var (
+	newReleaseMessageAttrsOnce sync.Once
+	newReleaseMessageAttrs     map[string]bool
+)
+
 // MarshalXML implements xml.Marshaler for NewReleaseMessage
 func (m *NewReleaseMessage) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
 	// Set default namespace values if empty
@@ -25,34 +41,38 @@ func (m *NewReleaseMessage) MarshalXML(e *xml.Encoder, start xml.StartElement) e
 	// Set the namespace on the start element
 	start.Name.Space = Namespace
 
-	// Add namespace attributes to the element, avoiding duplicates
-	// Use reflection to find which attributes are already handled by struct fields
-	existingAttrs := make(map[string]bool)
-	v := reflect.ValueOf(m).Elem()
-	t := v.Type()
-	for i := 0; i < v.NumField(); i++ {
-		field := t.Field(i)
-		if xmlTag := field.Tag.Get("xml"); xmlTag != "" && xmlTag != "-" {
-			// Parse the XML tag to get the attribute name
-			if strings.HasSuffix(xmlTag, ",attr") {
-				attrName := strings.TrimSuffix(xmlTag, ",attr")
-				if colonIdx := strings.Index(attrName, ":"); colonIdx >= 0 {
-					// For tags like "xmlns:ern,attr" or "xsi:schemaLocation,attr"
-					existingAttrs[attrName] = true
-				} else if attrName != "" {
-					// For tags like "LanguageAndScriptCode,attr"
-					existingAttrs[attrName] = true
+	// Add namespace attributes to the element, avoiding duplicates. The attributes already handled by
+	// struct fields are fixed per type, so they are found by reflection once and cached
+	newReleaseMessageAttrsOnce.Do(func() {
+		newReleaseMessageAttrs = make(map[string]bool)
+		t := reflect.TypeOf(m).Elem()
+		for i := 0; i < t.NumField(); i++ {
+			field := t.Field(i)
+			if xmlTag := field.Tag.Get("xml"); xmlTag != "" && xmlTag != "-" {
+				// Parse the XML tag to get the attribute name, from tags like "xmlns:ern,attr",
+				// "xsi:schemaLocation,attr" or "LanguageAndScriptCode,attr"
+				if strings.HasSuffix(xmlTag, ",attr") {
+					if attrName := strings.TrimSuffix(xmlTag, ",attr"); attrName != "" {
+						newReleaseMessageAttrs[attrName] = true
+					}
 				}
 			}
 		}
-	}
+	})
+	existingAttrs := newReleaseMessageAttrs
 
-	// Add attributes from the map that aren't already handled
-	for key, value := range m.NamespaceAttrs {
+	// Add attributes from the map that aren't already handled, sorted by key
+	// so that marshaled output is deterministic
+	keys := make([]string, 0, len(m.NamespaceAttrs))
+	for key := range m.NamespaceAttrs {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	for _, key := range keys {
 		if !existingAttrs[key] {
 			start.Attr = append(start.Attr, xml.Attr{
 				Name:  xml.Name{Local: key},
-				Value: value,
+				Value: m.NamespaceAttrs[key],
 			})
 		}
 	}
@@ -68,6 +88,9 @@ func (m *NewReleaseMessage) UnmarshalXML(d *xml.Decoder, start xml.StartElement)
 	if m.NamespaceAttrs == nil {
 		m.NamespaceAttrs = make(map[string]string)
 	}
+	// Namespace declarations of this element, to report conflicting ones as parse warnings
+	var warnings []string
+	declared := make(map[string]string)
 	for _, attr := range start.Attr {
 		// Capture all xmlns:* attributes and xsi:schemaLocation
 		if attr.Name.Space == "xmlns" || attr.Name.Local == "xmlns" ||
@@ -80,17 +103,76 @@ func (m *NewReleaseMessage) UnmarshalXML(d *xml.Decoder, start xml.StartElement)
 				// Preserve the namespace prefix for attributes like xsi:schemaLocation
 				if attr.Name.Space == "http://www.w3.org/2001/XMLSchema-instance" {
 					key = "xsi:" + attr.Name.Local
+					// The document may bind XMLSchema-instance to another prefix; the attribute is
+					// always written back as xsi:, so make sure that prefix is declared
+					if _, ok := m.NamespaceAttrs["xmlns:xsi"]; !ok {
+						m.NamespaceAttrs["xmlns:xsi"] = attr.Name.Space
+					}
+				}
+			}
+			if key == "xmlns" || strings.HasPrefix(key, "xmlns:") {
+				// The map keeps only the last declaration of a prefix, so record the conflict
+				if previous, ok := declared[key]; ok && previous != attr.Value {
+					warnings = append(warnings, fmt.Sprintf("%s is declared twice, as %q and %q", key, previous, attr.Value))
 				}
+				declared[key] = attr.Value
 			}
 			m.NamespaceAttrs[key] = attr.Value
 		}
 	}
+	// Replace the warnings of any earlier parse into m; the entry is dropped when m is collected
+	ref := weak.Make(m)
+	parseWarnings.Delete(ref)
+	if len(warnings) > 0 {
+		parseWarnings.Store(ref, warnings)
+		runtime.AddCleanup(m, func(ref weak.Pointer[NewReleaseMessage]) { parseWarnings.Delete(ref) }, ref)
+	}
 
 	// Create an alias type to avoid infinite recursion
 	type alias NewReleaseMessage
 	return d.DecodeElement((*alias)(m), &start)
 }
 
+// SetNamespaceAttr sets an attribute written on the root element. key is the attribute's qualified
+// name as it appears in the document: "xmlns" for the default namespace, "xmlns:<prefix>" for a
+// namespace declaration such as "xmlns:avs", or "xsi:schemaLocation".
+func (m *NewReleaseMessage) SetNamespaceAttr(key, value string) {
+	if m.NamespaceAttrs == nil {
+		m.NamespaceAttrs = make(map[string]string)
+	}
+	m.NamespaceAttrs[key] = value
+}
+
+// GetNamespaceAttr returns the root element attribute stored under key (see SetNamespaceAttr) and
+// whether it is set
+func (m *NewReleaseMessage) GetNamespaceAttr(key string) (string, bool) {
+	if m == nil {
+		return "", false
+	}
+	value, ok := m.NamespaceAttrs[key]
+	return value, ok
+}
+
+// ParseWarnings returns the problems UnmarshalXML found in the root element without failing the
+// parse, namely namespace prefixes declared twice with different namespaces, of which NamespaceAttrs
+// keeps only the last
+func (m *NewReleaseMessage) ParseWarnings() []string {
+	if m == nil {
+		return nil
+	}
+	if warnings, ok := parseWarnings.Load(weak.Make(m)); ok {
+		return warnings.([]string)
+	}
+	return nil
+}
+
+// catalogListMessageAttrs caches the attributes that struct fields of CatalogListMessage handle, which MarshalXML
+// must not repeat from NamespaceAttrs
+var (
+	catalogListMessageAttrsOnce sync.Once
+	catalogListMessageAttrs     map[string]bool
+)
+
 // MarshalXML implements xml.Marshaler for CatalogListMessage
 func (m *CatalogListMessage) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
 	// Set default namespace values if empty
@@ -100,34 +182,38 @@ func (m *CatalogListMessage) MarshalXML(e *xml.Encoder, start xml.StartElement)
 	// Set the namespace on the start element
 	start.Name.Space = Namespace
 
-	// Add namespace attributes to the element, avoiding duplicates
-	// Use reflection to find which attributes are already handled by struct fields
-	existingAttrs := make(map[string]bool)
-	v := reflect.ValueOf(m).Elem()
-	t := v.Type()
-	for i := 0; i < v.NumField(); i++ {
-		field := t.Field(i)
-		if xmlTag := field.Tag.Get("xml"); xmlTag != "" && xmlTag != "-" {
-			// Parse the XML tag to get the attribute name
-			if strings.HasSuffix(xmlTag, ",attr") {
-				attrName := strings.TrimSuffix(xmlTag, ",attr")
-				if colonIdx := strings.Index(attrName, ":"); colonIdx >= 0 {
-					// For tags like "xmlns:ern,attr" or "xsi:schemaLocation,attr"
-					existingAttrs[attrName] = true
-				} else if attrName != "" {
-					// For tags like "LanguageAndScriptCode,attr"
-					existingAttrs[attrName] = true
+	// Add namespace attributes to the element, avoiding duplicates. The attributes already handled by
+	// struct fields are fixed per type, so they are found by reflection once and cached
+	catalogListMessageAttrsOnce.Do(func() {
+		catalogListMessageAttrs = make(map[string]bool)
+		t := reflect.TypeOf(m).Elem()
+		for i := 0; i < t.NumField(); i++ {
+			field := t.Field(i)
+			if xmlTag := field.Tag.Get("xml"); xmlTag != "" && xmlTag != "-" {
+				// Parse the XML tag to get the attribute name, from tags like "xmlns:ern,attr",
+				// "xsi:schemaLocation,attr" or "LanguageAndScriptCode,attr"
+				if strings.HasSuffix(xmlTag, ",attr") {
+					if attrName := strings.TrimSuffix(xmlTag, ",attr"); attrName != "" {
+						catalogListMessageAttrs[attrName] = true
+					}
 				}
 			}
 		}
-	}
+	})
+	existingAttrs := catalogListMessageAttrs
 
-	// Add attributes from the map that aren't already handled
-	for key, value := range m.NamespaceAttrs {
+	// Add attributes from the map that aren't already handled, sorted by key
+	// so that marshaled output is deterministic
+	keys := make([]string, 0, len(m.NamespaceAttrs))
+	for key := range m.NamespaceAttrs {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	for _, key := range keys {
 		if !existingAttrs[key] {
 			start.Attr = append(start.Attr, xml.Attr{
 				Name:  xml.Name{Local: key},
-				Value: value,
+				Value: m.NamespaceAttrs[key],
 			})
 		}
 	}
@@ -143,6 +229,9 @@ func (m *CatalogListMessage) UnmarshalXML(d *xml.Decoder, start xml.StartElement
 	if m.NamespaceAttrs == nil {
 		m.NamespaceAttrs = make(map[string]string)
 	}
+	// Namespace declarations of this element, to report conflicting ones as parse warnings
+	var warnings []string
+	declared := make(map[string]string)
 	for _, attr := range start.Attr {
 		// Capture all xmlns:* attributes and xsi:schemaLocation
 		if attr.Name.Space == "xmlns" || attr.Name.Local == "xmlns" ||
@@ -155,17 +244,76 @@ func (m *CatalogListMessage) UnmarshalXML(d *xml.Decoder, start xml.StartElement
 				// Preserve the namespace prefix for attributes like xsi:schemaLocation
 				if attr.Name.Space == "http://www.w3.org/2001/XMLSchema-instance" {
 					key = "xsi:" + attr.Name.Local
+					// The document may bind XMLSchema-instance to another prefix; the attribute is
+					// always written back as xsi:, so make sure that prefix is declared
+					if _, ok := m.NamespaceAttrs["xmlns:xsi"]; !ok {
+						m.NamespaceAttrs["xmlns:xsi"] = attr.Name.Space
+					}
 				}
 			}
+			if key == "xmlns" || strings.HasPrefix(key, "xmlns:") {
+				// The map keeps only the last declaration of a prefix, so record the conflict
+				if previous, ok := declared[key]; ok && previous != attr.Value {
+					warnings = append(warnings, fmt.Sprintf("%s is declared twice, as %q and %q", key, previous, attr.Value))
+				}
+				declared[key] = attr.Value
+			}
 			m.NamespaceAttrs[key] = attr.Value
 		}
 	}
+	// Replace the warnings of any earlier parse into m; the entry is dropped when m is collected
+	ref := weak.Make(m)
+	parseWarnings.Delete(ref)
+	if len(warnings) > 0 {
+		parseWarnings.Store(ref, warnings)
+		runtime.AddCleanup(m, func(ref weak.Pointer[CatalogListMessage]) { parseWarnings.Delete(ref) }, ref)
+	}
 
 	// Create an alias type to avoid infinite recursion
 	type alias CatalogListMessage
 	return d.DecodeElement((*alias)(m), &start)
 }
 
+// SetNamespaceAttr sets an attribute written on the root element. key is the attribute's qualified
+// name as it appears in the document: "xmlns" for the default namespace, "xmlns:<prefix>" for a
+// namespace declaration such as "xmlns:avs", or "xsi:schemaLocation".
+func (m *CatalogListMessage) SetNamespaceAttr(key, value string) {
+	if m.NamespaceAttrs == nil {
+		m.NamespaceAttrs = make(map[string]string)
+	}
+	m.NamespaceAttrs[key] = value
+}
+
+// GetNamespaceAttr returns the root element attribute stored under key (see SetNamespaceAttr) and
+// whether it is set
+func (m *CatalogListMessage) GetNamespaceAttr(key string) (string, bool) {
+	if m == nil {
+		return "", false
+	}
+	value, ok := m.NamespaceAttrs[key]
+	return value, ok
+}
+
+// ParseWarnings returns the problems UnmarshalXML found in the root element without failing the
+// parse, namely namespace prefixes declared twice with different namespaces, of which NamespaceAttrs
+// keeps only the last
+func (m *CatalogListMessage) ParseWarnings() []string {
+	if m == nil {
+		return nil
+	}
+	if warnings, ok := parseWarnings.Load(weak.Make(m)); ok {
+		return warnings.([]string)
+	}
+	return nil
+}
+
+// purgeReleaseMessageAttrs caches the attributes that struct fields of PurgeReleaseMessage handle, which MarshalXML
+// must not repeat from NamespaceAttrs
+var (
+	purgeReleaseMessageAttrsOnce sync.Once
+	purgeReleaseMessageAttrs     map[string]bool
+)
+
 // MarshalXML implements xml.Marshaler for PurgeReleaseMessage
 func (m *PurgeReleaseMessage) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
 	// Set default namespace values if empty
@@ -175,34 +323,38 @@ func (m *PurgeReleaseMessage) MarshalXML(e *xml.Encoder, start xml.StartElement)
 	// Set the namespace on the start element
 	start.Name.Space = Namespace
 
-	// Add namespace attributes to the element, avoiding duplicates
-	// Use reflection to find which attributes are already handled by struct fields
-	existingAttrs := make(map[string]bool)
-	v := reflect.ValueOf(m).Elem()
-	t := v.Type()
-	for i := 0; i < v.NumField(); i++ {
-		field := t.Field(i)
-		if xmlTag := field.Tag.Get("xml"); xmlTag != "" && xmlTag != "-" {
-			// Parse the XML tag to get the attribute name
-			if strings.HasSuffix(xmlTag, ",attr") {
-				attrName := strings.TrimSuffix(xmlTag, ",attr")
-				if colonIdx := strings.Index(attrName, ":"); colonIdx >= 0 {
-					// For tags like "xmlns:ern,attr" or "xsi:schemaLocation,attr"
-					existingAttrs[attrName] = true
-				} else if attrName != "" {
-					// For tags like "LanguageAndScriptCode,attr"
-					existingAttrs[attrName] = true
+	// Add namespace attributes to the element, avoiding duplicates. The attributes already handled by
+	// struct fields are fixed per type, so they are found by reflection once and cached
+	purgeReleaseMessageAttrsOnce.Do(func() {
+		purgeReleaseMessageAttrs = make(map[string]bool)
+		t := reflect.TypeOf(m).Elem()
+		for i := 0; i < t.NumField(); i++ {
+			field := t.Field(i)
+			if xmlTag := field.Tag.Get("xml"); xmlTag != "" && xmlTag != "-" {
+				// Parse the XML tag to get the attribute name, from tags like "xmlns:ern,attr",
+				// "xsi:schemaLocation,attr" or "LanguageAndScriptCode,attr"
+				if strings.HasSuffix(xmlTag, ",attr") {
+					if attrName := strings.TrimSuffix(xmlTag, ",attr"); attrName != "" {
+						purgeReleaseMessageAttrs[attrName] = true
+					}
 				}
 			}
 		}
-	}
+	})
+	existingAttrs := purgeReleaseMessageAttrs
 
-	// Add attributes from the map that aren't already handled
-	for key, value := range m.NamespaceAttrs {
+	// Add attributes from the map that aren't already handled, sorted by key
+	// so that marshaled output is deterministic
+	keys := make([]string, 0, len(m.NamespaceAttrs))
+	for key := range m.NamespaceAttrs {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	for _, key := range keys {
 		if !existingAttrs[key] {
 			start.Attr = append(start.Attr, xml.Attr{
 				Name:  xml.Name{Local: key},
-				Value: value,
+				Value: m.NamespaceAttrs[key],
 			})
 		}
 	}
@@ -218,6 +370,9 @@ func (m *PurgeReleaseMessage) UnmarshalXML(d *xml.Decoder, start xml.StartElemen
 	if m.NamespaceAttrs == nil {
 		m.NamespaceAttrs = make(map[string]string)
 	}
+	// Namespace declarations of this element, to report conflicting ones as parse warnings
+	var warnings []string
+	declared := make(map[string]string)
 	for _, attr := range start.Attr {
 		// Capture all xmlns:* attributes and xsi:schemaLocation
 		if attr.Name.Space == "xmlns" || attr.Name.Local == "xmlns" ||
@@ -230,13 +385,1474 @@ func (m *PurgeReleaseMessage) UnmarshalXML(d *xml.Decoder, start xml.StartElemen
 				// Preserve the namespace prefix for attributes like xsi:schemaLocation
 				if attr.Name.Space == "http://www.w3.org/2001/XMLSchema-instance" {
 					key = "xsi:" + attr.Name.Local
+					// The document may bind XMLSchema-instance to another prefix; the attribute is
+					// always written back as xsi:, so make sure that prefix is declared
+					if _, ok := m.NamespaceAttrs["xmlns:xsi"]; !ok {
+						m.NamespaceAttrs["xmlns:xsi"] = attr.Name.Space
+					}
 				}
 			}
+			if key == "xmlns" || strings.HasPrefix(key, "xmlns:") {
+				// The map keeps only the last declaration of a prefix, so record the conflict
+				if previous, ok := declared[key]; ok && previous != attr.Value {
+					warnings = append(warnings, fmt.Sprintf("%s is declared twice, as %q and %q", key, previous, attr.Value))
+				}
+				declared[key] = attr.Value
+			}
 			m.NamespaceAttrs[key] = attr.Value
 		}
 	}
+	// Replace the warnings of any earlier parse into m; the entry is dropped when m is collected
+	ref := weak.Make(m)
+	parseWarnings.Delete(ref)
+	if len(warnings) > 0 {
+		parseWarnings.Store(ref, warnings)
+		runtime.AddCleanup(m, func(ref weak.Pointer[PurgeReleaseMessage]) { parseWarnings.Delete(ref) }, ref)
+	}
 
 	// Create an alias type to avoid infinite recursion
 	type alias PurgeReleaseMessage
 	return d.DecodeElement((*alias)(m), &start)
 }
+
+// SetNamespaceAttr sets an attribute written on the root element. key is the attribute's qualified
+// name as it appears in the document: "xmlns" for the default namespace, "xmlns:<prefix>" for a
+// namespace declaration such as "xmlns:avs", or "xsi:schemaLocation".
+func (m *PurgeReleaseMessage) SetNamespaceAttr(key, value string) {
+	if m.NamespaceAttrs == nil {
+		m.NamespaceAttrs = make(map[string]string)
+	}
+	m.NamespaceAttrs[key] = value
+}
+
+// GetNamespaceAttr returns the root element attribute stored under key (see SetNamespaceAttr) and
+// whether it is set
+func (m *PurgeReleaseMessage) GetNamespaceAttr(key string) (string, bool) {
+	if m == nil {
+		return "", false
+	}
+	value, ok := m.NamespaceAttrs[key]
+	return value, ok
+}
+
+// ParseWarnings returns the problems UnmarshalXML found in the root element without failing the
+// parse, namely namespace prefixes declared twice with different namespaces, of which NamespaceAttrs
+// keeps only the last
+func (m *PurgeReleaseMessage) ParseWarnings() []string {
+	if m == nil {
+		return nil
+	}
+	if warnings, ok := parseWarnings.Load(weak.Make(m)); ok {
+		return warnings.([]string)
+	}
+	return nil
+}
+
+// GetUpdateIndicatorOr returns UpdateIndicator, or def when it is empty or x is nil
+func (x *NewReleaseMessage) GetUpdateIndicatorOr(def string) string {
+	if v := x.GetUpdateIndicator(); v != "" {
+		return v
+	}
+	return def
+}
+
+// GetMessageSchemaVersionIdOr returns MessageSchemaVersionId, or def when it is empty or x is nil
+func (x *NewReleaseMessage) GetMessageSchemaVersionIdOr(def string) string {
+	if v := x.GetMessageSchemaVersionId(); v != "" {
+		return v
+	}
+	return def
+}
+
+// GetBusinessProfileVersionIdOr returns BusinessProfileVersionId, or def when it is empty or x is nil
+func (x *NewReleaseMessage) GetBusinessProfileVersionIdOr(def string) string {
+	if v := x.GetBusinessProfileVersionId(); v != "" {
+		return v
+	}
+	return def
+}
+
+// GetReleaseProfileVersionIdOr returns ReleaseProfileVersionId, or def when it is empty or x is nil
+func (x *NewReleaseMessage) GetReleaseProfileVersionIdOr(def string) string {
+	if v := x.GetReleaseProfileVersionId(); v != "" {
+		return v
+	}
+	return def
+}
+
+// GetLanguageAndScriptCodeOr returns LanguageAndScriptCode, or def when it is empty or x is nil
+func (x *NewReleaseMessage) GetLanguageAndScriptCodeOr(def string) string {
+	if v := x.GetLanguageAndScriptCode(); v != "" {
+		return v
+	}
+	return def
+}
+
+// GetMessageThreadIdOr returns MessageThreadId, or def when it is empty or x is nil
+func (x *MessageHeader) GetMessageThreadIdOr(def string) string {
+	if v := x.GetMessageThreadId(); v != "" {
+		return v
+	}
+	return def
+}
+
+// GetMessageIdOr returns MessageId, or def when it is empty or x is nil
+func (x *MessageHeader) GetMessageIdOr(def string) string {
+	if v := x.GetMessageId(); v != "" {
+		return v
+	}
+	return def
+}
+
+// GetMessageFileNameOr returns MessageFileName, or def when it is empty or x is nil
+func (x *MessageHeader) GetMessageFileNameOr(def string) string {
+	if v := x.GetMessageFileName(); v != "" {
+		return v
+	}
+	return def
+}
+
+// GetMessageCreatedDateTimeOr returns MessageCreatedDateTime, or def when it is empty or x is nil
+func (x *MessageHeader) GetMessageCreatedDateTimeOr(def string) string {
+	if v := x.GetMessageCreatedDateTime(); v != "" {
+		return v
+	}
+	return def
+}
+
+// GetMessageControlTypeOr returns MessageControlType, or def when it is empty or x is nil
+func (x *MessageHeader) GetMessageControlTypeOr(def string) string {
+	if v := x.GetMessageControlType(); v != "" {
+		return v
+	}
+	return def
+}
+
+// GetLanguageAndScriptCodeOr returns LanguageAndScriptCode, or def when it is empty or x is nil
+func (x *MessageHeader) GetLanguageAndScriptCodeOr(def string) string {
+	if v := x.GetLanguageAndScriptCode(); v != "" {
+		return v
+	}
+	return def
+}
+
+// GetPublicationDateOr returns PublicationDate, or def when it is empty or x is nil
+func (x *CatalogListMessage) GetPublicationDateOr(def string) string {
+	if v := x.GetPublicationDate(); v != "" {
+		return v
+	}
+	return def
+}
+
+// GetMessageSchemaVersionIdOr returns MessageSchemaVersionId, or def when it is empty or x is nil
+func (x *CatalogListMessage) GetMessageSchemaVersionIdOr(def string) string {
+	if v := x.GetMessageSchemaVersionId(); v != "" {
+		return v
+	}
+	return def
+}
+
+// GetBusinessProfileVersionIdOr returns BusinessProfileVersionId, or def when it is empty or x is nil
+func (x *CatalogListMessage) GetBusinessProfileVersionIdOr(def string) string {
+	if v := x.GetBusinessProfileVersionId(); v != "" {
+		return v
+	}
+	return def
+}
+
+// GetReleaseProfileVersionIdOr returns ReleaseProfileVersionId, or def when it is empty or x is nil
+func (x *CatalogListMessage) GetReleaseProfileVersionIdOr(def string) string {
+	if v := x.GetReleaseProfileVersionId(); v != "" {
+		return v
+	}
+	return def
+}
+
+// GetLanguageAndScriptCodeOr returns LanguageAndScriptCode, or def when it is empty or x is nil
+func (x *CatalogListMessage) GetLanguageAndScriptCodeOr(def string) string {
+	if v := x.GetLanguageAndScriptCode(); v != "" {
+		return v
+	}
+	return def
+}
+
+// GetMessageSchemaVersionIdOr returns MessageSchemaVersionId, or def when it is empty or x is nil
+func (x *PurgeReleaseMessage) GetMessageSchemaVersionIdOr(def string) string {
+	if v := x.GetMessageSchemaVersionId(); v != "" {
+		return v
+	}
+	return def
+}
+
+// GetLanguageAndScriptCodeOr returns LanguageAndScriptCode, or def when it is empty or x is nil
+func (x *PurgeReleaseMessage) GetLanguageAndScriptCodeOr(def string) string {
+	if v := x.GetLanguageAndScriptCode(); v != "" {
+		return v
+	}
+	return def
+}
+
+// FieldToElement maps each proto field, keyed "Message.proto_field_name", to the DDEX element it
+// comes from; attributes are prefixed with @. Character data fields are not listed.
+var FieldToElement = map[string]string{
+	"AdministratingRecordCompany.namespace":                             "@Namespace",
+	"AdministratingRecordCompany.party_id":                              "PartyId",
+	"AdministratingRecordCompany.party_name":                            "PartyName",
+	"AdministratingRecordCompany.role":                                  "@Role",
+	"AdministratingRecordCompany.user_defined_value":                    "@UserDefinedValue",
+	"AllTerritoryCode.identifier_type":                                  "@IdentifierType",
+	"Artist.artist_role":                                                "ArtistRole",
+	"Artist.nationality":                                                "Nationality",
+	"Artist.party_id":                                                   "PartyId",
+	"Artist.party_name":                                                 "PartyName",
+	"Artist.sequence_number":                                            "@SequenceNumber",
+	"ArtistDelegatedUsageRights.membership_type":                        "MembershipType",
+	"ArtistDelegatedUsageRights.period_of_rights_delegation":            "PeriodOfRightsDelegation",
+	"ArtistDelegatedUsageRights.territory_of_rights_delegation":         "TerritoryOfRightsDelegation",
+	"ArtistDelegatedUsageRights.use_type":                               "UseType",
+	"ArtistDelegatedUsageRights.user_interface_type":                    "UserInterfaceType",
+	"ArtistRole.namespace":                                              "@Namespace",
+	"ArtistRole.user_defined_value":                                     "@UserDefinedValue",
+	"AspectRatio.aspect_ratio_type":                                     "@AspectRatioType",
+	"AudioCodecType.namespace":                                          "@Namespace",
+	"AudioCodecType.user_defined_value":                                 "@UserDefinedValue",
+	"AudioCodecType.version":                                            "@Version",
+	"AvRating.rating_agency":                                            "RatingAgency",
+	"AvRating.rating_scheme_description":                                "RatingSchemeDescription",
+	"AvRating.rating_text":                                              "RatingText",
+	"BitRate.unit_of_measure":                                           "@UnitOfMeasure",
+	"CLine.c_line_company":                                              "CLineCompany",
+	"CLine.c_line_text":                                                 "CLineText",
+	"CLine.language_and_script_code":                                    "@LanguageAndScriptCode",
+	"CLine.year":                                                        "Year",
+	"CarrierType.namespace":                                             "@Namespace",
+	"CarrierType.user_defined_value":                                    "@UserDefinedValue",
+	"CatalogItem.c_line":                                                "CLine",
+	"CatalogItem.contributor_name":                                      "ContributorName",
+	"CatalogItem.display_artist_name":                                   "DisplayArtistName",
+	"CatalogItem.display_title":                                         "DisplayTitle",
+	"CatalogItem.genre":                                                 "Genre",
+	"CatalogItem.label_name":                                            "LabelName",
+	"CatalogItem.p_line":                                                "PLine",
+	"CatalogItem.release_date":                                          "ReleaseDate",
+	"CatalogItem.release_id":                                            "ReleaseId",
+	"CatalogItem.territory_code":                                        "TerritoryCode",
+	"CatalogItem.title":                                                 "Title",
+	"CatalogListMessage.business_profile_version_id":                    "@BusinessProfileVersionId",
+	"CatalogListMessage.catalog_item":                                   "CatalogItem",
+	"CatalogListMessage.language_and_script_code":                       "@LanguageAndScriptCode",
+	"CatalogListMessage.message_header":                                 "MessageHeader",
+	"CatalogListMessage.message_schema_version_id":                      "@MessageSchemaVersionId",
+	"CatalogListMessage.publication_date":                               "PublicationDate",
+	"CatalogListMessage.release_profile_version_id":                     "@ReleaseProfileVersionId",
+	"CatalogNumber.namespace":                                           "@Namespace",
+	"CatalogReleaseReferenceList.catalog_release_reference":             "CatalogReleaseReference",
+	"CatalogTransfer.catalog_release_reference_list":                    "CatalogReleaseReferenceList",
+	"CatalogTransfer.catalog_transfer_completed":                        "CatalogTransferCompleted",
+	"CatalogTransfer.effective_transfer_date":                           "EffectiveTransferDate",
+	"CatalogTransfer.excluded_territory_code":                           "ExcludedTerritoryCode",
+	"CatalogTransfer.territory_code":                                    "TerritoryCode",
+	"CatalogTransfer.transferring_from":                                 "TransferringFrom",
+	"CatalogTransfer.transferring_to":                                   "TransferringTo",
+	"Character.party_id":                                                "PartyId",
+	"Character.party_name":                                              "PartyName",
+	"Character.resource_contributor":                                    "ResourceContributor",
+	"Character.sequence_number":                                         "@SequenceNumber",
+	"Collection.c_line":                                                 "CLine",
+	"Collection.character":                                              "Character",
+	"Collection.collection_collection_reference_list":                   "CollectionCollectionReferenceList",
+	"Collection.collection_details_by_territory":                        "CollectionDetailsByTerritory",
+	"Collection.collection_id":                                          "CollectionId",
+	"Collection.collection_reference":                                   "CollectionReference",
+	"Collection.collection_resource_reference_list":                     "CollectionResourceReferenceList",
+	"Collection.collection_type":                                        "CollectionType",
+	"Collection.collection_work_reference_list":                         "CollectionWorkReferenceList",
+	"Collection.contributor":                                            "Contributor",
+	"Collection.creation_date":                                          "CreationDate",
+	"Collection.duration":                                               "Duration",
+	"Collection.duration_of_musical_content":                            "DurationOfMusicalContent",
+	"Collection.equivalent_release_reference":                           "EquivalentReleaseReference",
+	"Collection.is_complete":                                            "IsComplete",
+	"Collection.language_and_script_code":                               "@LanguageAndScriptCode",
+	"Collection.original_language":                                      "OriginalLanguage",
+	"Collection.original_release_date":                                  "OriginalReleaseDate",
+	"Collection.p_line":                                                 "PLine",
+	"Collection.release_date":                                           "ReleaseDate",
+	"Collection.representative_image_reference":                         "RepresentativeImageReference",
+	"Collection.sequence_number":                                        "SequenceNumber",
+	"Collection.title":                                                  "Title",
+	"CollectionCollectionReference.collection_collection_reference":     "CollectionCollectionReference",
+	"CollectionCollectionReference.duration":                            "Duration",
+	"CollectionCollectionReference.end_time":                            "EndTime",
+	"CollectionCollectionReference.inclusion_date":                      "InclusionDate",
+	"CollectionCollectionReference.sequence_number":                     "SequenceNumber",
+	"CollectionCollectionReference.start_time":                          "StartTime",
+	"CollectionCollectionReferenceList.collection_collection_reference": "CollectionCollectionReference",
+	"CollectionCollectionReferenceList.number_of_collections":           "NumberOfCollections",
+	"CollectionDetailsByTerritory.character":                            "Character",
+	"CollectionDetailsByTerritory.contributor":                          "Contributor",
+	"CollectionDetailsByTerritory.excluded_territory_code":              "ExcludedTerritoryCode",
+	"CollectionDetailsByTerritory.is_complete":                          "IsComplete",
+	"CollectionDetailsByTerritory.territory_code":                       "TerritoryCode",
+	"CollectionDetailsByTerritory.title":                                "Title",
+	"CollectionId.catalog_number":                                       "CatalogNumber",
+	"CollectionId.g_rid":                                                "GRid",
+	"CollectionId.i_c_p_n":                                              "ICPN",
+	"CollectionId.i_s_a_n":                                              "ISAN",
+	"CollectionId.i_s_r_c":                                              "ISRC",
+	"CollectionId.is_replaced":                                          "@IsReplaced",
+	"CollectionId.proprietary_id":                                       "ProprietaryId",
+	"CollectionId.v_i_s_a_n":                                            "VISAN",
+	"CollectionList.collection":                                         "Collection",
+	"CollectionList.language_and_script_code":                           "@LanguageAndScriptCode",
+	"CollectionResourceReference.collection_resource_reference":         "CollectionResourceReference",
+	"CollectionResourceReference.duration":                              "Duration",
+	"CollectionResourceReference.sequence_number":                       "SequenceNumber",
+	"CollectionResourceReferenceList.collection_resource_reference":     "CollectionResourceReference",
+	"CollectionType.namespace":                                          "@Namespace",
+	"CollectionType.user_defined_value":                                 "@UserDefinedValue",
+	"CollectionWorkReference.collection_work_reference":                 "CollectionWorkReference",
+	"CollectionWorkReference.duration":                                  "Duration",
+	"CollectionWorkReferenceList.collection_work_reference":             "CollectionWorkReference",
+	"Comment.language_and_script_code":                                  "@LanguageAndScriptCode",
+	"CommercialModelType.namespace":                                     "@Namespace",
+	"CommercialModelType.user_defined_value":                            "@UserDefinedValue",
+	"Condition.relational_relator":                                      "RelationalRelator",
+	"Condition.unit":                                                    "Unit",
+	"Condition.value":                                                   "Value",
+	"ConsumerRentalPeriod.is_extensible":                                "@IsExtensible",
+	"ContactId.email_address":                                           "EmailAddress",
+	"ContactId.fax_number":                                              "FaxNumber",
+	"ContactId.phone_number":                                            "PhoneNumber",
+	"ContainerFormat.namespace":                                         "@Namespace",
+	"ContainerFormat.user_defined_value":                                "@UserDefinedValue",
+	"CourtesyLine.language_and_script_code":                             "@LanguageAndScriptCode",
+	"CreationId.catalog_number":                                         "CatalogNumber",
+	"CreationId.composer_catalog_number":                                "ComposerCatalogNumber",
+	"CreationId.i_s_a_n":                                                "ISAN",
+	"CreationId.i_s_b_n":                                                "ISBN",
+	"CreationId.i_s_m_n":                                                "ISMN",
+	"CreationId.i_s_r_c":                                                "ISRC",
+	"CreationId.i_s_s_n":                                                "ISSN",
+	"CreationId.i_s_w_c":                                                "ISWC",
+	"CreationId.opus_number":                                            "OpusNumber",
+	"CreationId.proprietary_id":                                         "ProprietaryId",
+	"CreationId.s_i_c_i":                                                "SICI",
+	"CreationId.v_i_s_a_n":                                              "VISAN",
+	"Cue.c_line":                                                        "CLine",
+	"Cue.cue_creation_reference":                                        "CueCreationReference",
+	"Cue.cue_origin":                                                    "CueOrigin",
+	"Cue.cue_theme_type":                                                "CueThemeType",
+	"Cue.cue_use_type":                                                  "CueUseType",
+	"Cue.cue_visual_perception_type":                                    "CueVisualPerceptionType",
+	"Cue.cue_vocal_type":                                                "CueVocalType",
+	"Cue.duration":                                                      "Duration",
+	"Cue.end_time":                                                      "EndTime",
+	"Cue.has_musical_content":                                           "HasMusicalContent",
+	"Cue.is_dance":                                                      "IsDance",
+	"Cue.p_line":                                                        "PLine",
+	"Cue.referenced_creation_character":                                 "ReferencedCreationCharacter",
+	"Cue.referenced_creation_contributor":                               "ReferencedCreationContributor",
+	"Cue.referenced_creation_id":                                        "ReferencedCreationId",
+	"Cue.referenced_creation_title":                                     "ReferencedCreationTitle",
+	"Cue.referenced_creation_type":                                      "ReferencedCreationType",
+	"Cue.referenced_indirect_creation_contributor":                      "ReferencedIndirectCreationContributor",
+	"Cue.start_time":                                                    "StartTime",
+	"CueCreationReference.cue_resource_reference":                       "CueResourceReference",
+	"CueCreationReference.cue_work_reference":                           "CueWorkReference",
+	"CueOrigin.namespace":                                               "@Namespace",
+	"CueOrigin.user_defined_value":                                      "@UserDefinedValue",
+	"CueSheet.cue":                                                      "Cue",
+	"CueSheet.cue_sheet_id":                                             "CueSheetId",
+	"CueSheet.cue_sheet_reference":                                      "CueSheetReference",
+	"CueSheet.cue_sheet_type":                                           "CueSheetType",
+	"CueSheetList.cue_sheet":                                            "CueSheet",
+	"CueSheetType.namespace":                                            "@Namespace",
+	"CueSheetType.user_defined_value":                                   "@UserDefinedValue",
+	"CueThemeType.namespace":                                            "@Namespace",
+	"CueThemeType.user_defined_value":                                   "@UserDefinedValue",
+	"CueUseType.namespace":                                              "@Namespace",
+	"CueUseType.user_defined_value":                                     "@UserDefinedValue",
+	"CueVisualPerceptionType.namespace":                                 "@Namespace",
+	"CueVisualPerceptionType.user_defined_value":                        "@UserDefinedValue",
+	"CueVocalType.namespace":                                            "@Namespace",
+	"CueVocalType.user_defined_value":                                   "@UserDefinedValue",
+	"CurrentTerritoryCode.identifier_type":                              "@IdentifierType",
+	"DSP.language_and_script_code":                                      "@LanguageAndScriptCode",
+	"DSP.party_id":                                                      "PartyId",
+	"DSP.party_name":                                                    "PartyName",
+	"DSP.territory_code":                                                "TerritoryCode",
+	"DSP.trading_name":                                                  "TradingName",
+	"DSP.u_r_l":                                                         "URL",
+	"Deal.deal_reference":                                               "DealReference",
+	"Deal.deal_technical_resource_details_reference_list":               "DealTechnicalResourceDetailsReferenceList",
+	"Deal.deal_terms":                                                   "DealTerms",
+	"Deal.distribution_channel_page":                                    "DistributionChannelPage",
+	"Deal.language_and_script_code":                                     "@LanguageAndScriptCode",
+	"Deal.resource_usage":                                               "ResourceUsage",
+	"DealList.language_and_script_code":                                 "@LanguageAndScriptCode",
+	"DealList.release_deal":                                             "ReleaseDeal",
+	"DealReference.language_and_script_code":                            "@LanguageAndScriptCode",
+	"DealResourceReferenceList.deal_resource_reference":                 "DealResourceReference",
+	"DealResourceReferenceList.period":                                  "Period",
+	"DealTechnicalResourceDetailsReferenceList.deal_technical_resource_details_reference": "DealTechnicalResourceDetailsReference",
+	"DealTerms.all_deals_cancelled":                                                  "AllDealsCancelled",
+	"DealTerms.clip_preview_start_date":                                              "ClipPreviewStartDate",
+	"DealTerms.clip_preview_start_date_time":                                         "ClipPreviewStartDateTime",
+	"DealTerms.commercial_model_type":                                                "CommercialModelType",
+	"DealTerms.consumer_rental_period":                                               "ConsumerRentalPeriod",
+	"DealTerms.cover_art_preview_start_date":                                         "CoverArtPreviewStartDate",
+	"DealTerms.cover_art_preview_start_date_time":                                    "CoverArtPreviewStartDateTime",
+	"DealTerms.distribution_channel":                                                 "DistributionChannel",
+	"DealTerms.excluded_distribution_channel":                                        "ExcludedDistributionChannel",
+	"DealTerms.excluded_territory_code":                                              "ExcludedTerritoryCode",
+	"DealTerms.instant_gratification_resource_list":                                  "InstantGratificationResourceList",
+	"DealTerms.is_exclusive":                                                         "IsExclusive",
+	"DealTerms.is_pre_order_deal":                                                    "IsPreOrderDeal",
+	"DealTerms.is_promotional":                                                       "IsPromotional",
+	"DealTerms.language_and_script_code":                                             "@LanguageAndScriptCode",
+	"DealTerms.number_of_products_per_carton":                                        "NumberOfProductsPerCarton",
+	"DealTerms.physical_returns":                                                     "PhysicalReturns",
+	"DealTerms.pre_order_incentive_resource_list":                                    "PreOrderIncentiveResourceList",
+	"DealTerms.pre_order_preview_date":                                               "PreOrderPreviewDate",
+	"DealTerms.pre_order_preview_date_time":                                          "PreOrderPreviewDateTime",
+	"DealTerms.pre_order_release_date":                                               "PreOrderReleaseDate",
+	"DealTerms.price_information":                                                    "PriceInformation",
+	"DealTerms.promotional_code":                                                     "PromotionalCode",
+	"DealTerms.related_release_offer_set":                                            "RelatedReleaseOfferSet",
+	"DealTerms.release_display_start_date":                                           "ReleaseDisplayStartDate",
+	"DealTerms.release_display_start_date_time":                                      "ReleaseDisplayStartDateTime",
+	"DealTerms.rights_claim_policy":                                                  "RightsClaimPolicy",
+	"DealTerms.take_down":                                                            "TakeDown",
+	"DealTerms.territory_code":                                                       "TerritoryCode",
+	"DealTerms.track_listing_preview_start_date":                                     "TrackListingPreviewStartDate",
+	"DealTerms.track_listing_preview_start_date_time":                                "TrackListingPreviewStartDateTime",
+	"DealTerms.usage":                                                                "Usage",
+	"DealTerms.validity_period":                                                      "ValidityPeriod",
+	"DealTerms.web_policy":                                                           "WebPolicy",
+	"Description.language_and_script_code":                                           "@LanguageAndScriptCode",
+	"DetailedResourceContributor.additional_roles":                                   "AdditionalRoles",
+	"DetailedResourceContributor.artist_delegated_usage_rights":                      "ArtistDelegatedUsageRights",
+	"DetailedResourceContributor.citizenship":                                        "Citizenship",
+	"DetailedResourceContributor.contact_information":                                "ContactInformation",
+	"DetailedResourceContributor.date_and_place_of_birth":                            "DateAndPlaceOfBirth",
+	"DetailedResourceContributor.date_and_place_of_death":                            "DateAndPlaceOfDeath",
+	"DetailedResourceContributor.genre":                                              "Genre",
+	"DetailedResourceContributor.governing_agreement_type":                           "GoverningAgreementType",
+	"DetailedResourceContributor.instrument_type":                                    "InstrumentType",
+	"DetailedResourceContributor.is_contracted_artist":                               "IsContractedArtist",
+	"DetailedResourceContributor.is_featured_artist":                                 "IsFeaturedArtist",
+	"DetailedResourceContributor.membership":                                         "Membership",
+	"DetailedResourceContributor.nationality":                                        "Nationality",
+	"DetailedResourceContributor.party_id":                                           "PartyId",
+	"DetailedResourceContributor.party_name":                                         "PartyName",
+	"DetailedResourceContributor.performance":                                        "Performance",
+	"DetailedResourceContributor.primary_instrument_type":                            "PrimaryInstrumentType",
+	"DetailedResourceContributor.primary_role":                                       "PrimaryRole",
+	"DetailedResourceContributor.resource_contributor_role":                          "ResourceContributorRole",
+	"DetailedResourceContributor.sequence_number":                                    "@SequenceNumber",
+	"DetailedResourceContributor.sex":                                                "Sex",
+	"DetailedResourceContributor.territory_of_residency":                             "TerritoryOfResidency",
+	"DistributionChannelType.namespace":                                              "@Namespace",
+	"DistributionChannelType.user_defined_value":                                     "@UserDefinedValue",
+	"DrmPlatformType.namespace":                                                      "@Namespace",
+	"DrmPlatformType.user_defined_value":                                             "@UserDefinedValue",
+	"DrmPlatformType.version":                                                        "@Version",
+	"EventDate.is_after":                                                             "@IsAfter",
+	"EventDate.is_approximate":                                                       "@IsApproximate",
+	"EventDate.is_before":                                                            "@IsBefore",
+	"EventDate.language_and_script_code":                                             "@LanguageAndScriptCode",
+	"EventDate.location_description":                                                 "@LocationDescription",
+	"EventDate.territory_code":                                                       "@TerritoryCode",
+	"EventDateTime.is_after":                                                         "@IsAfter",
+	"EventDateTime.is_approximate":                                                   "@IsApproximate",
+	"EventDateTime.is_before":                                                        "@IsBefore",
+	"EventDateTime.language_and_script_code":                                         "@LanguageAndScriptCode",
+	"EventDateTime.location_description":                                             "@LocationDescription",
+	"EventDateTime.territory_code":                                                   "@TerritoryCode",
+	"ExtendedResourceGroupContentItem.duration":                                      "Duration",
+	"ExtendedResourceGroupContentItem.is_bonus_resource":                             "IsBonusResource",
+	"ExtendedResourceGroupContentItem.is_hidden_resource":                            "IsHiddenResource",
+	"ExtendedResourceGroupContentItem.is_instant_gratification_resource":             "IsInstantGratificationResource",
+	"ExtendedResourceGroupContentItem.is_pre_order_incentive_resource":               "IsPreOrderIncentiveResource",
+	"ExtendedResourceGroupContentItem.linked_release_resource_reference":             "LinkedReleaseResourceReference",
+	"ExtendedResourceGroupContentItem.release_id":                                    "ReleaseId",
+	"ExtendedResourceGroupContentItem.release_resource_reference":                    "ReleaseResourceReference",
+	"ExtendedResourceGroupContentItem.resource_group_content_item_release_reference": "ResourceGroupContentItemReleaseReference",
+	"ExtendedResourceGroupContentItem.resource_type":                                 "ResourceType",
+	"ExtendedResourceGroupContentItem.sequence_number":                               "SequenceNumber",
+	"ExtendedResourceGroupContentItem.sequence_sub_number":                           "SequenceSubNumber",
+	"Extent.unit_of_measure":                                                         "@UnitOfMeasure",
+	"ExternalResourceLink.external_link":                                             "ExternalLink",
+	"ExternalResourceLink.externally_linked_resource_type":                           "ExternallyLinkedResourceType",
+	"ExternalResourceLink.file_format":                                               "FileFormat",
+	"ExternalResourceLink.u_r_l":                                                     "URL",
+	"ExternalResourceLink.validity_period":                                           "ValidityPeriod",
+	"ExternallyLinkedResourceType.namespace":                                         "@Namespace",
+	"ExternallyLinkedResourceType.user_defined_value":                                "@UserDefinedValue",
+	"File.file_name":                                                                 "FileName",
+	"File.file_path":                                                                 "FilePath",
+	"File.hash_sum":                                                                  "HashSum",
+	"File.u_r_l":                                                                     "URL",
+	"Fingerprint.fingerprint":                                                        "Fingerprint",
+	"Fingerprint.fingerprint_algorithm_parameter":                                    "FingerprintAlgorithmParameter",
+	"Fingerprint.fingerprint_algorithm_type":                                         "FingerprintAlgorithmType",
+	"Fingerprint.fingerprint_algorithm_version":                                      "FingerprintAlgorithmVersion",
+	"Fingerprint.fingerprint_data_type":                                              "FingerprintDataType",
+	"FingerprintAlgorithmType.namespace":                                             "@Namespace",
+	"FingerprintAlgorithmType.user_defined_value":                                    "@UserDefinedValue",
+	"FrameRate.unit_of_measure":                                                      "@UnitOfMeasure",
+	"FulfillmentDate.fulfillment_date":                                               "FulfillmentDate",
+	"FulfillmentDate.resource_release_reference":                                     "ResourceReleaseReference",
+	"Genre.genre_text":                                                               "GenreText",
+	"Genre.language_and_script_code":                                                 "@LanguageAndScriptCode",
+	"Genre.sub_genre":                                                                "SubGenre",
+	"GoverningAgreementType.namespace":                                               "@Namespace",
+	"GoverningAgreementType.user_defined_value":                                      "@UserDefinedValue",
+	"HashSum.hash_sum":                                                               "HashSum",
+	"HashSum.hash_sum_algorithm_type":                                                "HashSumAlgorithmType",
+	"HashSum.hash_sum_data_type":                                                     "HashSumDataType",
+	"HashSumAlgorithmType.namespace":                                                 "@Namespace",
+	"HashSumAlgorithmType.user_defined_value":                                        "@UserDefinedValue",
+	"HostSoundCarrier.administrating_record_company":                                 "AdministratingRecordCompany",
+	"HostSoundCarrier.display_artist":                                                "DisplayArtist",
+	"HostSoundCarrier.release_id":                                                    "ReleaseId",
+	"HostSoundCarrier.rights_agreement_id":                                           "RightsAgreementId",
+	"HostSoundCarrier.title":                                                         "Title",
+	"HostSoundCarrier.track_number":                                                  "TrackNumber",
+	"HostSoundCarrier.volume_number_in_set":                                          "VolumeNumberInSet",
+	"ICPN.is_ean":                                                                    "@IsEan",
+	"Image.creation_date":                                                            "CreationDate",
+	"Image.image_details_by_territory":                                               "ImageDetailsByTerritory",
+	"Image.image_id":                                                                 "ImageId",
+	"Image.image_type":                                                               "ImageType",
+	"Image.is_artist_related":                                                        "IsArtistRelated",
+	"Image.is_updated":                                                               "@IsUpdated",
+	"Image.language_and_script_code":                                                 "@LanguageAndScriptCode",
+	"Image.resource_reference":                                                       "ResourceReference",
+	"Image.title":                                                                    "Title",
+	"ImageCodecType.namespace":                                                       "@Namespace",
+	"ImageCodecType.user_defined_value":                                              "@UserDefinedValue",
+	"ImageCodecType.version":                                                         "@Version",
+	"ImageDetailsByTerritory.c_line":                                                 "CLine",
+	"ImageDetailsByTerritory.courtesy_line":                                          "CourtesyLine",
+	"ImageDetailsByTerritory.description":                                            "Description",
+	"ImageDetailsByTerritory.display_artist_name":                                    "DisplayArtistName",
+	"ImageDetailsByTerritory.excluded_territory_code":                                "ExcludedTerritoryCode",
+	"ImageDetailsByTerritory.fulfillment_date":                                       "FulfillmentDate",
+	"ImageDetailsByTerritory.genre":                                                  "Genre",
+	"ImageDetailsByTerritory.indirect_resource_contributor":                          "IndirectResourceContributor",
+	"ImageDetailsByTerritory.keywords":                                               "Keywords",
+	"ImageDetailsByTerritory.language_and_script_code":                               "@LanguageAndScriptCode",
+	"ImageDetailsByTerritory.original_resource_release_date":                         "OriginalResourceReleaseDate",
+	"ImageDetailsByTerritory.parental_warning_type":                                  "ParentalWarningType",
+	"ImageDetailsByTerritory.resource_contributor":                                   "ResourceContributor",
+	"ImageDetailsByTerritory.resource_release_date":                                  "ResourceReleaseDate",
+	"ImageDetailsByTerritory.synopsis":                                               "Synopsis",
+	"ImageDetailsByTerritory.technical_image_details":                                "TechnicalImageDetails",
+	"ImageDetailsByTerritory.territory_code":                                         "TerritoryCode",
+	"ImageDetailsByTerritory.title":                                                  "Title",
+	"ImageType.namespace":                                                            "@Namespace",
+	"ImageType.user_defined_value":                                                   "@UserDefinedValue",
+	"IndirectResourceContributor.indirect_resource_contributor_role":                 "IndirectResourceContributorRole",
+	"IndirectResourceContributor.nationality":                                        "Nationality",
+	"IndirectResourceContributor.party_id":                                           "PartyId",
+	"IndirectResourceContributor.party_name":                                         "PartyName",
+	"IndirectResourceContributor.sequence_number":                                    "@SequenceNumber",
+	"Keywords.language_and_script_code":                                              "@LanguageAndScriptCode",
+	"LabelName.label_name_type":                                                      "@LabelNameType",
+	"LabelName.language_and_script_code":                                             "@LanguageAndScriptCode",
+	"LabelName.namespace":                                                            "@Namespace",
+	"LabelName.user_defined_value":                                                   "@UserDefinedValue",
+	"LinkedReleaseResourceReference.language_and_script_code":                        "@LanguageAndScriptCode",
+	"LinkedReleaseResourceReference.link_description":                                "@LinkDescription",
+	"MIDI.creation_date":                                                             "CreationDate",
+	"MIDI.duration":                                                                  "Duration",
+	"MIDI.indirect_midi_id":                                                          "IndirectMidiId",
+	"MIDI.instrumentation_description":                                               "InstrumentationDescription",
+	"MIDI.is_artist_related":                                                         "IsArtistRelated",
+	"MIDI.is_background":                                                             "IsBackground",
+	"MIDI.is_bonus_resource":                                                         "IsBonusResource",
+	"MIDI.is_computer_generated":                                                     "IsComputerGenerated",
+	"MIDI.is_hidden_resource":                                                        "IsHiddenResource",
+	"MIDI.is_instrumental":                                                           "IsInstrumental",
+	"MIDI.is_medley":                                                                 "IsMedley",
+	"MIDI.is_potpourri":                                                              "IsPotpourri",
+	"MIDI.is_updated":                                                                "@IsUpdated",
+	"MIDI.language_and_script_code":                                                  "@LanguageAndScriptCode",
+	"MIDI.language_of_performance":                                                   "LanguageOfPerformance",
+	"MIDI.mastered_date":                                                             "MasteredDate",
+	"MIDI.midi_details_by_territory":                                                 "MidiDetailsByTerritory",
+	"MIDI.midi_id":                                                                   "MidiId",
+	"MIDI.midi_type":                                                                 "MidiType",
+	"MIDI.no_silence_after":                                                          "NoSilenceAfter",
+	"MIDI.no_silence_before":                                                         "NoSilenceBefore",
+	"MIDI.performer_information_required":                                            "PerformerInformationRequired",
+	"MIDI.reference_title":                                                           "ReferenceTitle",
+	"MIDI.remastered_date":                                                           "RemasteredDate",
+	"MIDI.resource_contained_resource_reference_list":                                "ResourceContainedResourceReferenceList",
+	"MIDI.resource_musical_work_reference_list":                                      "ResourceMusicalWorkReferenceList",
+	"MIDI.resource_reference":                                                        "ResourceReference",
+	"MIDI.rights_agreement_id":                                                       "RightsAgreementId",
+	"Membership.end_date":                                                            "EndDate",
+	"Membership.membership_type":                                                     "MembershipType",
+	"Membership.organization":                                                        "Organization",
+	"Membership.start_date":                                                          "StartDate",
+	"MessageAuditTrail.language_and_script_code":                                     "@LanguageAndScriptCode",
+	"MessageAuditTrail.message_audit_trail_event":                                    "MessageAuditTrailEvent",
+	"MessageAuditTrailEvent.date_time":                                               "DateTime",
+	"MessageAuditTrailEvent.messaging_party_descriptor":                              "MessagingPartyDescriptor",
+	"MessageHeader.comment":                                                          "Comment",
+	"MessageHeader.language_and_script_code":                                         "@LanguageAndScriptCode",
+	"MessageHeader.message_audit_trail":                                              "MessageAuditTrail",
+	"MessageHeader.message_control_type":                                             "MessageControlType",
+	"MessageHeader.message_created_date_time":                                        "MessageCreatedDateTime",
+	"MessageHeader.message_file_name":                                                "MessageFileName",
+	"MessageHeader.message_id":                                                       "MessageId",
+	"MessageHeader.message_recipient":                                                "MessageRecipient",
+	"MessageHeader.message_sender":                                                   "MessageSender",
+	"MessageHeader.message_thread_id":                                                "MessageThreadId",
+	"MessageHeader.sent_on_behalf_of":                                                "SentOnBehalfOf",
+	"MessagingParty.language_and_script_code":                                        "@LanguageAndScriptCode",
+	"MessagingParty.party_id":                                                        "PartyId",
+	"MessagingParty.party_name":                                                      "PartyName",
+	"MessagingParty.trading_name":                                                    "TradingName",
+	"MidiDetailsByTerritory.c_line":                                                  "CLine",
+	"MidiDetailsByTerritory.courtesy_line":                                           "CourtesyLine",
+	"MidiDetailsByTerritory.display_artist":                                          "DisplayArtist",
+	"MidiDetailsByTerritory.display_artist_name":                                     "DisplayArtistName",
+	"MidiDetailsByTerritory.excluded_territory_code":                                 "ExcludedTerritoryCode",
+	"MidiDetailsByTerritory.fulfillment_date":                                        "FulfillmentDate",
+	"MidiDetailsByTerritory.genre":                                                   "Genre",
+	"MidiDetailsByTerritory.host_sound_carrier":                                      "HostSoundCarrier",
+	"MidiDetailsByTerritory.indirect_resource_contributor":                           "IndirectResourceContributor",
+	"MidiDetailsByTerritory.keywords":                                                "Keywords",
+	"MidiDetailsByTerritory.label_name":                                              "LabelName",
+	"MidiDetailsByTerritory.language_and_script_code":                                "@LanguageAndScriptCode",
+	"MidiDetailsByTerritory.marketing_comment":                                       "MarketingComment",
+	"MidiDetailsByTerritory.original_resource_release_date":                          "OriginalResourceReleaseDate",
+	"MidiDetailsByTerritory.parental_warning_type":                                   "ParentalWarningType",
+	"MidiDetailsByTerritory.remastered_date":                                         "RemasteredDate",
+	"MidiDetailsByTerritory.resource_contributor":                                    "ResourceContributor",
+	"MidiDetailsByTerritory.resource_release_date":                                   "ResourceReleaseDate",
+	"MidiDetailsByTerritory.rights_agreement_id":                                     "RightsAgreementId",
+	"MidiDetailsByTerritory.rights_controller":                                       "RightsController",
+	"MidiDetailsByTerritory.sequence_number":                                         "SequenceNumber",
+	"MidiDetailsByTerritory.synopsis":                                                "Synopsis",
+	"MidiDetailsByTerritory.technical_midi_details":                                  "TechnicalMidiDetails",
+	"MidiDetailsByTerritory.territory_code":                                          "TerritoryCode",
+	"MidiDetailsByTerritory.title":                                                   "Title",
+	"MidiType.namespace":                                                             "@Namespace",
+	"MidiType.user_defined_value":                                                    "@UserDefinedValue",
+	"MusicalWork.is_updated":                                                         "@IsUpdated",
+	"MusicalWork.language_and_script_code":                                           "@LanguageAndScriptCode",
+	"MusicalWork.musical_work_contributor":                                           "MusicalWorkContributor",
+	"MusicalWork.musical_work_details_by_territory":                                  "MusicalWorkDetailsByTerritory",
+	"MusicalWork.musical_work_id":                                                    "MusicalWorkId",
+	"MusicalWork.musical_work_reference":                                             "MusicalWorkReference",
+	"MusicalWork.musical_work_type":                                                  "MusicalWorkType",
+	"MusicalWork.reference_title":                                                    "ReferenceTitle",
+	"MusicalWork.right_share":                                                        "RightShare",
+	"MusicalWork.rights_agreement_id":                                                "RightsAgreementId",
+	"MusicalWorkContributor.musical_work_contributor_role":                           "MusicalWorkContributorRole",
+	"MusicalWorkContributor.party_id":                                                "PartyId",
+	"MusicalWorkContributor.party_name":                                              "PartyName",
+	"MusicalWorkContributor.sequence_number":                                         "@SequenceNumber",
+	"MusicalWorkContributor.society_affiliation":                                     "SocietyAffiliation",
+	"MusicalWorkContributorRole.namespace":                                           "@Namespace",
+	"MusicalWorkContributorRole.user_defined_value":                                  "@UserDefinedValue",
+	"MusicalWorkDetailsByTerritory.display_artist_name":                              "DisplayArtistName",
+	"MusicalWorkDetailsByTerritory.excluded_territory_code":                          "ExcludedTerritoryCode",
+	"MusicalWorkDetailsByTerritory.language_and_script_code":                         "@LanguageAndScriptCode",
+	"MusicalWorkDetailsByTerritory.musical_work_contributor":                         "MusicalWorkContributor",
+	"MusicalWorkDetailsByTerritory.territory_code":                                   "TerritoryCode",
+	"MusicalWorkId.composer_catalog_number":                                          "ComposerCatalogNumber",
+	"MusicalWorkId.i_s_w_c":                                                          "ISWC",
+	"MusicalWorkId.is_replaced":                                                      "@IsReplaced",
+	"MusicalWorkId.opus_number":                                                      "OpusNumber",
+	"MusicalWorkId.proprietary_id":                                                   "ProprietaryId",
+	"MusicalWorkType.namespace":                                                      "@Namespace",
+	"MusicalWorkType.user_defined_value":                                             "@UserDefinedValue",
+	"Name.language_and_script_code":                                                  "@LanguageAndScriptCode",
+	"NewReleaseMessage.business_profile_version_id":                                  "@BusinessProfileVersionId",
+	"NewReleaseMessage.catalog_transfer":                                             "CatalogTransfer",
+	"NewReleaseMessage.collection_list":                                              "CollectionList",
+	"NewReleaseMessage.cue_sheet_list":                                               "CueSheetList",
+	"NewReleaseMessage.deal_list":                                                    "DealList",
+	"NewReleaseMessage.is_backfill":                                                  "IsBackfill",
+	"NewReleaseMessage.language_and_script_code":                                     "@LanguageAndScriptCode",
+	"NewReleaseMessage.message_header":                                               "MessageHeader",
+	"NewReleaseMessage.message_schema_version_id":                                    "@MessageSchemaVersionId",
+	"NewReleaseMessage.release_list":                                                 "ReleaseList",
+	"NewReleaseMessage.release_profile_version_id":                                   "@ReleaseProfileVersionId",
+	"NewReleaseMessage.resource_list":                                                "ResourceList",
+	"NewReleaseMessage.update_indicator":                                             "UpdateIndicator",
+	"NewReleaseMessage.work_list":                                                    "WorkList",
+	"OperatingSystemType.namespace":                                                  "@Namespace",
+	"OperatingSystemType.user_defined_value":                                         "@UserDefinedValue",
+	"OperatingSystemType.version":                                                    "@Version",
+	"PLine.language_and_script_code":                                                 "@LanguageAndScriptCode",
+	"PLine.p_line_company":                                                           "PLineCompany",
+	"PLine.p_line_text":                                                              "PLineText",
+	"PLine.p_line_type":                                                              "@PLineType",
+	"PLine.year":                                                                     "Year",
+	"ParentalWarningType.namespace":                                                  "@Namespace",
+	"ParentalWarningType.user_defined_value":                                         "@UserDefinedValue",
+	"PartyDescriptor.party_id":                                                       "PartyId",
+	"PartyDescriptor.party_name":                                                     "PartyName",
+	"PartyId.is_d_p_i_d":                                                             "@IsDPID",
+	"PartyId.is_i_s_n_i":                                                             "@IsISNI",
+	"PartyId.namespace":                                                              "@Namespace",
+	"PartyName.abbreviated_name":                                                     "AbbreviatedName",
+	"PartyName.full_name":                                                            "FullName",
+	"PartyName.full_name_ascii_transcribed":                                          "FullNameAsciiTranscribed",
+	"PartyName.full_name_indexed":                                                    "FullNameIndexed",
+	"PartyName.key_name":                                                             "KeyName",
+	"PartyName.language_and_script_code":                                             "@LanguageAndScriptCode",
+	"PartyName.names_after_key_name":                                                 "NamesAfterKeyName",
+	"PartyName.names_before_key_name":                                                "NamesBeforeKeyName",
+	"Percentage.has_max_value_of_one":                                                "@HasMaxValueOfOne",
+	"Performance.date":                                                               "Date",
+	"Performance.territory":                                                          "Territory",
+	"Period.end_date":                                                                "EndDate",
+	"Period.end_date_time":                                                           "EndDateTime",
+	"Period.start_date":                                                              "StartDate",
+	"Period.start_date_time":                                                         "StartDateTime",
+	"PhysicalReturns.latest_date_for_physical_returns":                               "LatestDateForPhysicalReturns",
+	"PhysicalReturns.physical_returns_allowed":                                       "PhysicalReturnsAllowed",
+	"PreviewDetails.bottom_right_corner":                                             "BottomRightCorner",
+	"PreviewDetails.expression_type":                                                 "ExpressionType",
+	"PreviewDetails.part_type":                                                       "PartType",
+	"PreviewDetails.top_left_corner":                                                 "TopLeftCorner",
+	"Price.currency_code":                                                            "@CurrencyCode",
+	"PriceInformation.bulk_order_wholesale_price_per_unit":                           "BulkOrderWholesalePricePerUnit",
+	"PriceInformation.description":                                                   "Description",
+	"PriceInformation.price_range_type":                                              "PriceRangeType",
+	"PriceInformation.price_type":                                                    "PriceType",
+	"PriceInformation.price_type_1":                                                  "@PriceType",
+	"PriceInformation.suggested_retail_price":                                        "SuggestedRetailPrice",
+	"PriceInformation.wholesale_price_per_unit":                                      "WholesalePricePerUnit",
+	"PriceRangeType.namespace":                                                       "@Namespace",
+	"PriceType.namespace":                                                            "@Namespace",
+	"PromotionalCode.namespace":                                                      "@Namespace",
+	"ProprietaryId.namespace":                                                        "@Namespace",
+	"PurgeReleaseMessage.language_and_script_code":                                   "@LanguageAndScriptCode",
+	"PurgeReleaseMessage.message_header":                                             "MessageHeader",
+	"PurgeReleaseMessage.message_schema_version_id":                                  "@MessageSchemaVersionId",
+	"PurgeReleaseMessage.purged_release":                                             "PurgedRelease",
+	"PurgedRelease.release_id":                                                       "ReleaseId",
+	"PurgedRelease.resource_contributor":                                             "ResourceContributor",
+	"PurgedRelease.title":                                                            "Title",
+	"Purpose.namespace":                                                              "@Namespace",
+	"Purpose.user_defined_value":                                                     "@UserDefinedValue",
+	"RatingAgency.namespace":                                                         "@Namespace",
+	"RatingAgency.user_defined_value":                                                "@UserDefinedValue",
+	"Reason.language_and_script_code":                                                "@LanguageAndScriptCode",
+	"ReasonType.namespace":                                                           "@Namespace",
+	"ReasonType.user_defined_value":                                                  "@UserDefinedValue",
+	"ReferenceTitle.language_and_script_code":                                        "@LanguageAndScriptCode",
+	"ReferenceTitle.sub_title":                                                       "SubTitle",
+	"ReferenceTitle.title_text":                                                      "TitleText",
+	"RelatedRelease.language_and_script_code":                                        "@LanguageAndScriptCode",
+	"RelatedRelease.original_release_date":                                           "OriginalReleaseDate",
+	"RelatedRelease.reference_title":                                                 "ReferenceTitle",
+	"RelatedRelease.release_date":                                                    "ReleaseDate",
+	"RelatedRelease.release_id":                                                      "ReleaseId",
+	"RelatedRelease.release_relationship_type":                                       "ReleaseRelationshipType",
+	"RelatedRelease.release_summary_details_by_territory":                            "ReleaseSummaryDetailsByTerritory",
+	"RelatedRelease.rights_agreement_id":                                             "RightsAgreementId",
+	"RelatedReleaseOfferSet.deal":                                                    "Deal",
+	"RelatedReleaseOfferSet.language_and_script_code":                                "@LanguageAndScriptCode",
+	"RelatedReleaseOfferSet.release_description":                                     "ReleaseDescription",
+	"RelatedReleaseOfferSet.release_id":                                              "ReleaseId",
+	"Release.artist_profile_page":                                                    "ArtistProfilePage",
+	"Release.c_line":                                                                 "CLine",
+	"Release.duration":                                                               "Duration",
+	"Release.external_resource_link":                                                 "ExternalResourceLink",
+	"Release.global_original_release_date":                                           "GlobalOriginalReleaseDate",
+	"Release.global_release_date":                                                    "GlobalReleaseDate",
+	"Release.is_main_release":                                                        "@IsMainRelease",
+	"Release.language_and_script_code":                                               "@LanguageAndScriptCode",
+	"Release.language_of_dubbing":                                                    "LanguageOfDubbing",
+	"Release.language_of_performance":                                                "LanguageOfPerformance",
+	"Release.p_line":                                                                 "PLine",
+	"Release.reference_title":                                                        "ReferenceTitle",
+	"Release.release_collection_reference_list":                                      "ReleaseCollectionReferenceList",
+	"Release.release_details_by_territory":                                           "ReleaseDetailsByTerritory",
+	"Release.release_id":                                                             "ReleaseId",
+	"Release.release_reference":                                                      "ReleaseReference",
+	"Release.release_resource_reference_list":                                        "ReleaseResourceReferenceList",
+	"Release.release_type":                                                           "ReleaseType",
+	"Release.resource_omission_reason":                                               "ResourceOmissionReason",
+	"Release.rights_agreement_id":                                                    "RightsAgreementId",
+	"Release.sales_reporting_proxy_release_id":                                       "SalesReportingProxyReleaseId",
+	"Release.sub_title_language":                                                     "SubTitleLanguage",
+	"ReleaseCollectionReference.release_resource_type":                               "@ReleaseResourceType",
+	"ReleaseCollectionReferenceList.number_of_collections":                           "NumberOfCollections",
+	"ReleaseCollectionReferenceList.release_collection_reference":                    "ReleaseCollectionReference",
+	"ReleaseDeal.deal":                                                               "Deal",
+	"ReleaseDeal.deal_release_reference":                                             "DealReleaseReference",
+	"ReleaseDeal.effective_date":                                                     "EffectiveDate",
+	"ReleaseDeal.language_and_script_code":                                           "@LanguageAndScriptCode",
+	"ReleaseDetailsByTerritory.administrating_record_company":                        "AdministratingRecordCompany",
+	"ReleaseDetailsByTerritory.av_rating":                                            "AvRating",
+	"ReleaseDetailsByTerritory.c_line":                                               "CLine",
+	"ReleaseDetailsByTerritory.character":                                            "Character",
+	"ReleaseDetailsByTerritory.display_artist":                                       "DisplayArtist",
+	"ReleaseDetailsByTerritory.display_artist_name":                                  "DisplayArtistName",
+	"ReleaseDetailsByTerritory.display_conductor":                                    "DisplayConductor",
+	"ReleaseDetailsByTerritory.excluded_territory_code":                              "ExcludedTerritoryCode",
+	"ReleaseDetailsByTerritory.file":                                                 "File",
+	"ReleaseDetailsByTerritory.file_availability_description":                        "FileAvailabilityDescription",
+	"ReleaseDetailsByTerritory.genre":                                                "Genre",
+	"ReleaseDetailsByTerritory.is_multi_artist_compilation":                          "IsMultiArtistCompilation",
+	"ReleaseDetailsByTerritory.keywords":                                             "Keywords",
+	"ReleaseDetailsByTerritory.label_name":                                           "LabelName",
+	"ReleaseDetailsByTerritory.language_and_script_code":                             "@LanguageAndScriptCode",
+	"ReleaseDetailsByTerritory.marketing_comment":                                    "MarketingComment",
+	"ReleaseDetailsByTerritory.number_of_units_per_physical_release":                 "NumberOfUnitsPerPhysicalRelease",
+	"ReleaseDetailsByTerritory.original_digital_release_date":                        "OriginalDigitalReleaseDate",
+	"ReleaseDetailsByTerritory.original_release_date":                                "OriginalReleaseDate",
+	"ReleaseDetailsByTerritory.p_line":                                               "PLine",
+	"ReleaseDetailsByTerritory.parental_warning_type":                                "ParentalWarningType",
+	"ReleaseDetailsByTerritory.related_release":                                      "RelatedRelease",
+	"ReleaseDetailsByTerritory.release_date":                                         "ReleaseDate",
+	"ReleaseDetailsByTerritory.release_type":                                         "ReleaseType",
+	"ReleaseDetailsByTerritory.resource_group":                                       "ResourceGroup",
+	"ReleaseDetailsByTerritory.rights_agreement_id":                                  "RightsAgreementId",
+	"ReleaseDetailsByTerritory.synopsis":                                             "Synopsis",
+	"ReleaseDetailsByTerritory.territory_code":                                       "TerritoryCode",
+	"ReleaseDetailsByTerritory.title":                                                "Title",
+	"ReleaseId.catalog_number":                                                       "CatalogNumber",
+	"ReleaseId.g_rid":                                                                "GRid",
+	"ReleaseId.i_c_p_n":                                                              "ICPN",
+	"ReleaseId.i_s_r_c":                                                              "ISRC",
+	"ReleaseId.is_replaced":                                                          "@IsReplaced",
+	"ReleaseId.proprietary_id":                                                       "ProprietaryId",
+	"ReleaseList.language_and_script_code":                                           "@LanguageAndScriptCode",
+	"ReleaseList.release":                                                            "Release",
+	"ReleaseRelationshipType.namespace":                                              "@Namespace",
+	"ReleaseRelationshipType.user_defined_value":                                     "@UserDefinedValue",
+	"ReleaseResourceReference.release_resource_type":                                 "@ReleaseResourceType",
+	"ReleaseResourceReferenceList.release_resource_reference":                        "ReleaseResourceReference",
+	"ReleaseSummaryDetailsByTerritory.display_artist_name":                           "DisplayArtistName",
+	"ReleaseSummaryDetailsByTerritory.excluded_territory_code":                       "ExcludedTerritoryCode",
+	"ReleaseSummaryDetailsByTerritory.label_name":                                    "LabelName",
+	"ReleaseSummaryDetailsByTerritory.language_and_script_code":                      "@LanguageAndScriptCode",
+	"ReleaseSummaryDetailsByTerritory.rights_agreement_id":                           "RightsAgreementId",
+	"ReleaseSummaryDetailsByTerritory.territory_code":                                "TerritoryCode",
+	"ReleaseType.namespace":                                                          "@Namespace",
+	"ReleaseType.user_defined_value":                                                 "@UserDefinedValue",
+	"ResourceContainedResourceReference.duration_used":                               "DurationUsed",
+	"ResourceContainedResourceReference.purpose":                                     "Purpose",
+	"ResourceContainedResourceReference.resource_contained_resource_reference":       "ResourceContainedResourceReference",
+	"ResourceContainedResourceReference.start_point":                                 "StartPoint",
+	"ResourceContainedResourceReferenceList.resource_contained_resource_reference":   "ResourceContainedResourceReference",
+	"ResourceContributor.party_id":                                                   "PartyId",
+	"ResourceContributor.party_name":                                                 "PartyName",
+	"ResourceContributor.resource_contributor_role":                                  "ResourceContributorRole",
+	"ResourceContributor.sequence_number":                                            "@SequenceNumber",
+	"ResourceContributorRole.namespace":                                              "@Namespace",
+	"ResourceContributorRole.user_defined_value":                                     "@UserDefinedValue",
+	"ResourceGroup.carrier_type":                                                     "CarrierType",
+	"ResourceGroup.display_artist":                                                   "DisplayArtist",
+	"ResourceGroup.display_composer":                                                 "DisplayComposer",
+	"ResourceGroup.display_conductor":                                                "DisplayConductor",
+	"ResourceGroup.indirect_resource_contributor":                                    "IndirectResourceContributor",
+	"ResourceGroup.language_and_script_code":                                         "@LanguageAndScriptCode",
+	"ResourceGroup.release_id":                                                       "ReleaseId",
+	"ResourceGroup.resource_contributor":                                             "ResourceContributor",
+	"ResourceGroup.resource_group":                                                   "ResourceGroup",
+	"ResourceGroup.resource_group_content_item":                                      "ResourceGroupContentItem",
+	"ResourceGroup.resource_group_release_reference":                                 "ResourceGroupReleaseReference",
+	"ResourceGroup.resource_group_resource_reference_list":                           "ResourceGroupResourceReferenceList",
+	"ResourceGroup.sequence_number":                                                  "SequenceNumber",
+	"ResourceGroup.title":                                                            "Title",
+	"ResourceGroupResourceReferenceList.resource_group_resource_reference":           "ResourceGroupResourceReference",
+	"ResourceList.image":                                                             "Image",
+	"ResourceList.language_and_script_code":                                          "@LanguageAndScriptCode",
+	"ResourceList.m_i_d_i":                                                           "MIDI",
+	"ResourceList.sheet_music":                                                       "SheetMusic",
+	"ResourceList.software":                                                          "Software",
+	"ResourceList.sound_recording":                                                   "SoundRecording",
+	"ResourceList.text":                                                              "Text",
+	"ResourceList.user_defined_resource":                                             "UserDefinedResource",
+	"ResourceList.video":                                                             "Video",
+	"ResourceMusicalWorkReference.duration_used":                                     "DurationUsed",
+	"ResourceMusicalWorkReference.is_fragment":                                       "IsFragment",
+	"ResourceMusicalWorkReference.resource_musical_work_reference":                   "ResourceMusicalWorkReference",
+	"ResourceMusicalWorkReference.sequence_number":                                   "SequenceNumber",
+	"ResourceMusicalWorkReferenceList.resource_musical_work_reference":               "ResourceMusicalWorkReference",
+	"ResourceOmissionReason.namespace":                                               "@Namespace",
+	"ResourceOmissionReason.user_defined_value":                                      "@UserDefinedValue",
+	"ResourceProprietaryId.is_replaced":                                              "@IsReplaced",
+	"ResourceProprietaryId.proprietary_id":                                           "ProprietaryId",
+	"ResourceType.namespace":                                                         "@Namespace",
+	"ResourceType.user_defined_value":                                                "@UserDefinedValue",
+	"ResourceUsage.deal_resource_reference":                                          "DealResourceReference",
+	"ResourceUsage.usage":                                                            "Usage",
+	"RightShare.carrier_type":                                                        "CarrierType",
+	"RightShare.commercial_model_type":                                               "CommercialModelType",
+	"RightShare.distribution_channel_type":                                           "DistributionChannelType",
+	"RightShare.excluded_territory_code":                                             "ExcludedTerritoryCode",
+	"RightShare.has_first_license_refusal":                                           "HasFirstLicenseRefusal",
+	"RightShare.language_and_script_code":                                            "@LanguageAndScriptCode",
+	"RightShare.license_status":                                                      "LicenseStatus",
+	"RightShare.musical_work_rights_claim_type":                                      "MusicalWorkRightsClaimType",
+	"RightShare.right_share_creation_reference_list":                                 "RightShareCreationReferenceList",
+	"RightShare.right_share_id":                                                      "RightShareId",
+	"RightShare.right_share_percentage":                                              "RightSharePercentage",
+	"RightShare.right_share_reference":                                               "RightShareReference",
+	"RightShare.right_share_unknown":                                                 "RightShareUnknown",
+	"RightShare.rights_controller":                                                   "RightsController",
+	"RightShare.rights_type":                                                         "RightsType",
+	"RightShare.tariff_reference":                                                    "TariffReference",
+	"RightShare.territory_code":                                                      "TerritoryCode",
+	"RightShare.use_type":                                                            "UseType",
+	"RightShare.user_interface_type":                                                 "UserInterfaceType",
+	"RightShare.validity_period":                                                     "ValidityPeriod",
+	"RightShareCreationReferenceList.right_share_release_reference":                  "RightShareReleaseReference",
+	"RightShareCreationReferenceList.right_share_resource_reference":                 "RightShareResourceReference",
+	"RightShareCreationReferenceList.right_share_work_reference":                     "RightShareWorkReference",
+	"RightsAgreementId.m_w_l_i":                                                      "MWLI",
+	"RightsAgreementId.proprietary_id":                                               "ProprietaryId",
+	"RightsClaimPolicy.condition":                                                    "Condition",
+	"RightsClaimPolicy.rights_claim_policy_type":                                     "RightsClaimPolicyType",
+	"RightsController.party_id":                                                      "PartyId",
+	"RightsController.party_name":                                                    "PartyName",
+	"RightsController.right_share_percentage":                                        "RightSharePercentage",
+	"RightsController.right_share_unknown":                                           "RightShareUnknown",
+	"RightsController.rights_controller_role":                                        "RightsControllerRole",
+	"RightsController.rights_controller_type":                                        "RightsControllerType",
+	"RightsController.sequence_number":                                               "@SequenceNumber",
+	"RightsType.namespace":                                                           "@Namespace",
+	"RightsType.territory_code":                                                      "@TerritoryCode",
+	"RightsType.user_defined_value":                                                  "@UserDefinedValue",
+	"SalesReportingProxyReleaseId.reason":                                            "Reason",
+	"SalesReportingProxyReleaseId.reason_type":                                       "ReasonType",
+	"SalesReportingProxyReleaseId.release_id":                                        "ReleaseId",
+	"SamplingRate.unit_of_measure":                                                   "@UnitOfMeasure",
+	"SheetMusic.creation_date":                                                       "CreationDate",
+	"SheetMusic.indirect_sheet_music_id":                                             "IndirectSheetMusicId",
+	"SheetMusic.is_artist_related":                                                   "IsArtistRelated",
+	"SheetMusic.is_updated":                                                          "@IsUpdated",
+	"SheetMusic.language_and_script_code":                                            "@LanguageAndScriptCode",
+	"SheetMusic.language_of_lyrics":                                                  "LanguageOfLyrics",
+	"SheetMusic.reference_title":                                                     "ReferenceTitle",
+	"SheetMusic.resource_contained_resource_reference_list":                          "ResourceContainedResourceReferenceList",
+	"SheetMusic.resource_musical_work_reference_list":                                "ResourceMusicalWorkReferenceList",
+	"SheetMusic.resource_reference":                                                  "ResourceReference",
+	"SheetMusic.rights_agreement_id":                                                 "RightsAgreementId",
+	"SheetMusic.sheet_music_details_by_territory":                                    "SheetMusicDetailsByTerritory",
+	"SheetMusic.sheet_music_id":                                                      "SheetMusicId",
+	"SheetMusic.sheet_music_type":                                                    "SheetMusicType",
+	"SheetMusicCodecType.namespace":                                                  "@Namespace",
+	"SheetMusicCodecType.user_defined_value":                                         "@UserDefinedValue",
+	"SheetMusicCodecType.version":                                                    "@Version",
+	"SheetMusicDetailsByTerritory.c_line":                                            "CLine",
+	"SheetMusicDetailsByTerritory.courtesy_line":                                     "CourtesyLine",
+	"SheetMusicDetailsByTerritory.display_artist_name":                               "DisplayArtistName",
+	"SheetMusicDetailsByTerritory.excluded_territory_code":                           "ExcludedTerritoryCode",
+	"SheetMusicDetailsByTerritory.fulfillment_date":                                  "FulfillmentDate",
+	"SheetMusicDetailsByTerritory.genre":                                             "Genre",
+	"SheetMusicDetailsByTerritory.indirect_resource_contributor":                     "IndirectResourceContributor",
+	"SheetMusicDetailsByTerritory.language_and_script_code":                          "@LanguageAndScriptCode",
+	"SheetMusicDetailsByTerritory.original_resource_release_date":                    "OriginalResourceReleaseDate",
+	"SheetMusicDetailsByTerritory.parental_warning_type":                             "ParentalWarningType",
+	"SheetMusicDetailsByTerritory.resource_contributor":                              "ResourceContributor",
+	"SheetMusicDetailsByTerritory.resource_release_date":                             "ResourceReleaseDate",
+	"SheetMusicDetailsByTerritory.technical_sheet_music_details":                     "TechnicalSheetMusicDetails",
+	"SheetMusicDetailsByTerritory.territory_code":                                    "TerritoryCode",
+	"SheetMusicDetailsByTerritory.title":                                             "Title",
+	"SheetMusicId.i_s_m_n":                                                           "ISMN",
+	"SheetMusicId.is_replaced":                                                       "@IsReplaced",
+	"SheetMusicId.proprietary_id":                                                    "ProprietaryId",
+	"SheetMusicType.namespace":                                                       "@Namespace",
+	"SheetMusicType.user_defined_value":                                              "@UserDefinedValue",
+	"SocietyAffiliation.excluded_territory_code":                                     "ExcludedTerritoryCode",
+	"SocietyAffiliation.music_rights_society":                                        "MusicRightsSociety",
+	"SocietyAffiliation.territory_code":                                              "TerritoryCode",
+	"Software.creation_date":                                                         "CreationDate",
+	"Software.indirect_software_id":                                                  "IndirectSoftwareId",
+	"Software.is_artist_related":                                                     "IsArtistRelated",
+	"Software.is_updated":                                                            "@IsUpdated",
+	"Software.language_and_script_code":                                              "@LanguageAndScriptCode",
+	"Software.resource_contained_resource_reference_list":                            "ResourceContainedResourceReferenceList",
+	"Software.resource_musical_work_reference_list":                                  "ResourceMusicalWorkReferenceList",
+	"Software.resource_reference":                                                    "ResourceReference",
+	"Software.software_details_by_territory":                                         "SoftwareDetailsByTerritory",
+	"Software.software_id":                                                           "SoftwareId",
+	"Software.software_type":                                                         "SoftwareType",
+	"Software.title":                                                                 "Title",
+	"SoftwareDetailsByTerritory.c_line":                                              "CLine",
+	"SoftwareDetailsByTerritory.courtesy_line":                                       "CourtesyLine",
+	"SoftwareDetailsByTerritory.display_artist_name":                                 "DisplayArtistName",
+	"SoftwareDetailsByTerritory.excluded_territory_code":                             "ExcludedTerritoryCode",
+	"SoftwareDetailsByTerritory.fulfillment_date":                                    "FulfillmentDate",
+	"SoftwareDetailsByTerritory.genre":                                               "Genre",
+	"SoftwareDetailsByTerritory.indirect_resource_contributor":                       "IndirectResourceContributor",
+	"SoftwareDetailsByTerritory.keywords":                                            "Keywords",
+	"SoftwareDetailsByTerritory.language_and_script_code":                            "@LanguageAndScriptCode",
+	"SoftwareDetailsByTerritory.original_resource_release_date":                      "OriginalResourceReleaseDate",
+	"SoftwareDetailsByTerritory.p_line":                                              "PLine",
+	"SoftwareDetailsByTerritory.parental_warning_type":                               "ParentalWarningType",
+	"SoftwareDetailsByTerritory.resource_contributor":                                "ResourceContributor",
+	"SoftwareDetailsByTerritory.resource_release_date":                               "ResourceReleaseDate",
+	"SoftwareDetailsByTerritory.synopsis":                                            "Synopsis",
+	"SoftwareDetailsByTerritory.technical_software_details":                          "TechnicalSoftwareDetails",
+	"SoftwareDetailsByTerritory.territory_code":                                      "TerritoryCode",
+	"SoftwareDetailsByTerritory.title":                                               "Title",
+	"SoftwareType.namespace":                                                         "@Namespace",
+	"SoftwareType.user_defined_value":                                                "@UserDefinedValue",
+	"SoundProcessorType.namespace":                                                   "@Namespace",
+	"SoundProcessorType.user_defined_value":                                          "@UserDefinedValue",
+	"SoundProcessorType.version":                                                     "@Version",
+	"SoundRecording.creation_date":                                                   "CreationDate",
+	"SoundRecording.duration":                                                        "Duration",
+	"SoundRecording.has_pre_order_fulfillment":                                       "HasPreOrderFulfillment",
+	"SoundRecording.indirect_sound_recording_id":                                     "IndirectSoundRecordingId",
+	"SoundRecording.instrumentation_description":                                     "InstrumentationDescription",
+	"SoundRecording.is_artist_related":                                               "IsArtistRelated",
+	"SoundRecording.is_background":                                                   "IsBackground",
+	"SoundRecording.is_bonus_resource":                                               "IsBonusResource",
+	"SoundRecording.is_computer_generated":                                           "IsComputerGenerated",
+	"SoundRecording.is_hidden_resource":                                              "IsHiddenResource",
+	"SoundRecording.is_instrumental":                                                 "IsInstrumental",
+	"SoundRecording.is_medley":                                                       "IsMedley",
+	"SoundRecording.is_potpourri":                                                    "IsPotpourri",
+	"SoundRecording.is_remastered":                                                   "IsRemastered",
+	"SoundRecording.is_updated":                                                      "@IsUpdated",
+	"SoundRecording.language_and_script_code":                                        "@LanguageAndScriptCode",
+	"SoundRecording.language_of_performance":                                         "LanguageOfPerformance",
+	"SoundRecording.mastered_date":                                                   "MasteredDate",
+	"SoundRecording.no_silence_after":                                                "NoSilenceAfter",
+	"SoundRecording.no_silence_before":                                               "NoSilenceBefore",
+	"SoundRecording.number_of_contracted_artists":                                    "NumberOfContractedArtists",
+	"SoundRecording.number_of_featured_artists":                                      "NumberOfFeaturedArtists",
+	"SoundRecording.number_of_non_contracted_artists":                                "NumberOfNonContractedArtists",
+	"SoundRecording.number_of_non_featured_artists":                                  "NumberOfNonFeaturedArtists",
+	"SoundRecording.performer_information_required":                                  "PerformerInformationRequired",
+	"SoundRecording.reference_title":                                                 "ReferenceTitle",
+	"SoundRecording.remastered_date":                                                 "RemasteredDate",
+	"SoundRecording.resource_contained_resource_reference_list":                      "ResourceContainedResourceReferenceList",
+	"SoundRecording.resource_musical_work_reference_list":                            "ResourceMusicalWorkReferenceList",
+	"SoundRecording.resource_reference":                                              "ResourceReference",
+	"SoundRecording.rights_agreement_id":                                             "RightsAgreementId",
+	"SoundRecording.sound_recording_collection_reference_list":                       "SoundRecordingCollectionReferenceList",
+	"SoundRecording.sound_recording_details_by_territory":                            "SoundRecordingDetailsByTerritory",
+	"SoundRecording.sound_recording_id":                                              "SoundRecordingId",
+	"SoundRecording.sound_recording_type":                                            "SoundRecordingType",
+	"SoundRecording.territory_of_commissioning":                                      "TerritoryOfCommissioning",
+	"SoundRecordingCollectionReference.duration":                                     "Duration",
+	"SoundRecordingCollectionReference.end_time":                                     "EndTime",
+	"SoundRecordingCollectionReference.release_resource_type":                        "ReleaseResourceType",
+	"SoundRecordingCollectionReference.sequence_number":                              "SequenceNumber",
+	"SoundRecordingCollectionReference.sound_recording_collection_reference":         "SoundRecordingCollectionReference",
+	"SoundRecordingCollectionReference.start_time":                                   "StartTime",
+	"SoundRecordingCollectionReferenceList.number_of_collections":                    "NumberOfCollections",
+	"SoundRecordingCollectionReferenceList.sound_recording_collection_reference":     "SoundRecordingCollectionReference",
+	"SoundRecordingDetailsByTerritory.av_rating":                                     "AvRating",
+	"SoundRecordingDetailsByTerritory.courtesy_line":                                 "CourtesyLine",
+	"SoundRecordingDetailsByTerritory.display_artist":                                "DisplayArtist",
+	"SoundRecordingDetailsByTerritory.display_artist_name":                           "DisplayArtistName",
+	"SoundRecordingDetailsByTerritory.display_conductor":                             "DisplayConductor",
+	"SoundRecordingDetailsByTerritory.excluded_territory_code":                       "ExcludedTerritoryCode",
+	"SoundRecordingDetailsByTerritory.fulfillment_date":                              "FulfillmentDate",
+	"SoundRecordingDetailsByTerritory.genre":                                         "Genre",
+	"SoundRecordingDetailsByTerritory.host_sound_carrier":                            "HostSoundCarrier",
+	"SoundRecordingDetailsByTerritory.indirect_resource_contributor":                 "IndirectResourceContributor",
+	"SoundRecordingDetailsByTerritory.keywords":                                      "Keywords",
+	"SoundRecordingDetailsByTerritory.label_name":                                    "LabelName",
+	"SoundRecordingDetailsByTerritory.language_and_script_code":                      "@LanguageAndScriptCode",
+	"SoundRecordingDetailsByTerritory.marketing_comment":                             "MarketingComment",
+	"SoundRecordingDetailsByTerritory.original_resource_release_date":                "OriginalResourceReleaseDate",
+	"SoundRecordingDetailsByTerritory.p_line":                                        "PLine",
+	"SoundRecordingDetailsByTerritory.parental_warning_type":                         "ParentalWarningType",
+	"SoundRecordingDetailsByTerritory.remastered_date":                               "RemasteredDate",
+	"SoundRecordingDetailsByTerritory.resource_contributor":                          "ResourceContributor",
+	"SoundRecordingDetailsByTerritory.resource_release_date":                         "ResourceReleaseDate",
+	"SoundRecordingDetailsByTerritory.rights_agreement_id":                           "RightsAgreementId",
+	"SoundRecordingDetailsByTerritory.rights_controller":                             "RightsController",
+	"SoundRecordingDetailsByTerritory.sequence_number":                               "SequenceNumber",
+	"SoundRecordingDetailsByTerritory.synopsis":                                      "Synopsis",
+	"SoundRecordingDetailsByTerritory.technical_sound_recording_details":             "TechnicalSoundRecordingDetails",
+	"SoundRecordingDetailsByTerritory.territory_code":                                "TerritoryCode",
+	"SoundRecordingDetailsByTerritory.title":                                         "Title",
+	"SoundRecordingId.catalog_number":                                                "CatalogNumber",
+	"SoundRecordingId.i_s_r_c":                                                       "ISRC",
+	"SoundRecordingId.is_replaced":                                                   "@IsReplaced",
+	"SoundRecordingId.proprietary_id":                                                "ProprietaryId",
+	"SoundRecordingPreviewDetails.bottom_right_corner":                               "BottomRightCorner",
+	"SoundRecordingPreviewDetails.duration":                                          "Duration",
+	"SoundRecordingPreviewDetails.end_point":                                         "EndPoint",
+	"SoundRecordingPreviewDetails.expression_type":                                   "ExpressionType",
+	"SoundRecordingPreviewDetails.part_type":                                         "PartType",
+	"SoundRecordingPreviewDetails.start_point":                                       "StartPoint",
+	"SoundRecordingPreviewDetails.top_left_corner":                                   "TopLeftCorner",
+	"SoundRecordingType.namespace":                                                   "@Namespace",
+	"SoundRecordingType.user_defined_value":                                          "@UserDefinedValue",
+	"SubTitle.language_and_script_code":                                              "@LanguageAndScriptCode",
+	"Synopsis.language_and_script_code":                                              "@LanguageAndScriptCode",
+	"TariffReference.language_and_script_code":                                       "@LanguageAndScriptCode",
+	"TariffReference.tariff_sub_reference":                                           "@TariffSubReference",
+	"TechnicalImageDetails.aspect_ratio":                                             "AspectRatio",
+	"TechnicalImageDetails.color_depth":                                              "ColorDepth",
+	"TechnicalImageDetails.consumer_fulfillment_date":                                "ConsumerFulfillmentDate",
+	"TechnicalImageDetails.container_format":                                         "ContainerFormat",
+	"TechnicalImageDetails.drm_platform_type":                                        "DrmPlatformType",
+	"TechnicalImageDetails.file":                                                     "File",
+	"TechnicalImageDetails.file_availability_description":                            "FileAvailabilityDescription",
+	"TechnicalImageDetails.fingerprint":                                              "Fingerprint",
+	"TechnicalImageDetails.fulfillment_date":                                         "FulfillmentDate",
+	"TechnicalImageDetails.image_codec_type":                                         "ImageCodecType",
+	"TechnicalImageDetails.image_height":                                             "ImageHeight",
+	"TechnicalImageDetails.image_resolution":                                         "ImageResolution",
+	"TechnicalImageDetails.image_width":                                              "ImageWidth",
+	"TechnicalImageDetails.is_preview":                                               "IsPreview",
+	"TechnicalImageDetails.language_and_script_code":                                 "@LanguageAndScriptCode",
+	"TechnicalImageDetails.preview_details":                                          "PreviewDetails",
+	"TechnicalImageDetails.technical_resource_details_reference":                     "TechnicalResourceDetailsReference",
+	"TechnicalInstantiation.bit_rate":                                                "BitRate",
+	"TechnicalInstantiation.coding_type":                                             "CodingType",
+	"TechnicalInstantiation.drm_enforcement_type":                                    "DrmEnforcementType",
+	"TechnicalInstantiation.video_definition_type":                                   "VideoDefinitionType",
+	"TechnicalMidiDetails.consumer_fulfillment_date":                                 "ConsumerFulfillmentDate",
+	"TechnicalMidiDetails.duration":                                                  "Duration",
+	"TechnicalMidiDetails.file":                                                      "File",
+	"TechnicalMidiDetails.file_availability_description":                             "FileAvailabilityDescription",
+	"TechnicalMidiDetails.fingerprint":                                               "Fingerprint",
+	"TechnicalMidiDetails.fulfillment_date":                                          "FulfillmentDate",
+	"TechnicalMidiDetails.is_preview":                                                "IsPreview",
+	"TechnicalMidiDetails.language_and_script_code":                                  "@LanguageAndScriptCode",
+	"TechnicalMidiDetails.number_of_voices":                                          "NumberOfVoices",
+	"TechnicalMidiDetails.preview_details":                                           "PreviewDetails",
+	"TechnicalMidiDetails.resource_processing_required":                              "ResourceProcessingRequired",
+	"TechnicalMidiDetails.sound_processor_type":                                      "SoundProcessorType",
+	"TechnicalMidiDetails.technical_resource_details_reference":                      "TechnicalResourceDetailsReference",
+	"TechnicalMidiDetails.usable_resource_duration":                                  "UsableResourceDuration",
+	"TechnicalSheetMusicDetails.consumer_fulfillment_date":                           "ConsumerFulfillmentDate",
+	"TechnicalSheetMusicDetails.container_format":                                    "ContainerFormat",
+	"TechnicalSheetMusicDetails.drm_platform_type":                                   "DrmPlatformType",
+	"TechnicalSheetMusicDetails.file":                                                "File",
+	"TechnicalSheetMusicDetails.file_availability_description":                       "FileAvailabilityDescription",
+	"TechnicalSheetMusicDetails.fingerprint":                                         "Fingerprint",
+	"TechnicalSheetMusicDetails.fulfillment_date":                                    "FulfillmentDate",
+	"TechnicalSheetMusicDetails.is_preview":                                          "IsPreview",
+	"TechnicalSheetMusicDetails.language_and_script_code":                            "@LanguageAndScriptCode",
+	"TechnicalSheetMusicDetails.preview_details":                                     "PreviewDetails",
+	"TechnicalSheetMusicDetails.sheet_music_codec_type":                              "SheetMusicCodecType",
+	"TechnicalSheetMusicDetails.technical_resource_details_reference":                "TechnicalResourceDetailsReference",
+	"TechnicalSoftwareDetails.consumer_fulfillment_date":                             "ConsumerFulfillmentDate",
+	"TechnicalSoftwareDetails.drm_platform_type":                                     "DrmPlatformType",
+	"TechnicalSoftwareDetails.file":                                                  "File",
+	"TechnicalSoftwareDetails.file_availability_description":                         "FileAvailabilityDescription",
+	"TechnicalSoftwareDetails.fingerprint":                                           "Fingerprint",
+	"TechnicalSoftwareDetails.fulfillment_date":                                      "FulfillmentDate",
+	"TechnicalSoftwareDetails.is_preview":                                            "IsPreview",
+	"TechnicalSoftwareDetails.language_and_script_code":                              "@LanguageAndScriptCode",
+	"TechnicalSoftwareDetails.operating_system_type":                                 "OperatingSystemType",
+	"TechnicalSoftwareDetails.preview_details":                                       "PreviewDetails",
+	"TechnicalSoftwareDetails.technical_resource_details_reference":                  "TechnicalResourceDetailsReference",
+	"TechnicalSoundRecordingDetails.audio_codec_type":                                "AudioCodecType",
+	"TechnicalSoundRecordingDetails.bit_rate":                                        "BitRate",
+	"TechnicalSoundRecordingDetails.bits_per_sample":                                 "BitsPerSample",
+	"TechnicalSoundRecordingDetails.consumer_fulfillment_date":                       "ConsumerFulfillmentDate",
+	"TechnicalSoundRecordingDetails.container_format":                                "ContainerFormat",
+	"TechnicalSoundRecordingDetails.drm_platform_type":                               "DrmPlatformType",
+	"TechnicalSoundRecordingDetails.duration":                                        "Duration",
+	"TechnicalSoundRecordingDetails.file":                                            "File",
+	"TechnicalSoundRecordingDetails.file_availability_description":                   "FileAvailabilityDescription",
+	"TechnicalSoundRecordingDetails.fingerprint":                                     "Fingerprint",
+	"TechnicalSoundRecordingDetails.fulfillment_date":                                "FulfillmentDate",
+	"TechnicalSoundRecordingDetails.is_preview":                                      "IsPreview",
+	"TechnicalSoundRecordingDetails.language_and_script_code":                        "@LanguageAndScriptCode",
+	"TechnicalSoundRecordingDetails.number_of_channels":                              "NumberOfChannels",
+	"TechnicalSoundRecordingDetails.preview_details":                                 "PreviewDetails",
+	"TechnicalSoundRecordingDetails.resource_processing_required":                    "ResourceProcessingRequired",
+	"TechnicalSoundRecordingDetails.sampling_rate":                                   "SamplingRate",
+	"TechnicalSoundRecordingDetails.technical_resource_details_reference":            "TechnicalResourceDetailsReference",
+	"TechnicalSoundRecordingDetails.usable_resource_duration":                        "UsableResourceDuration",
+	"TechnicalTextDetails.consumer_fulfillment_date":                                 "ConsumerFulfillmentDate",
+	"TechnicalTextDetails.container_format":                                          "ContainerFormat",
+	"TechnicalTextDetails.drm_platform_type":                                         "DrmPlatformType",
+	"TechnicalTextDetails.file":                                                      "File",
+	"TechnicalTextDetails.file_availability_description":                             "FileAvailabilityDescription",
+	"TechnicalTextDetails.fingerprint":                                               "Fingerprint",
+	"TechnicalTextDetails.fulfillment_date":                                          "FulfillmentDate",
+	"TechnicalTextDetails.is_preview":                                                "IsPreview",
+	"TechnicalTextDetails.language_and_script_code":                                  "@LanguageAndScriptCode",
+	"TechnicalTextDetails.preview_details":                                           "PreviewDetails",
+	"TechnicalTextDetails.technical_resource_details_reference":                      "TechnicalResourceDetailsReference",
+	"TechnicalTextDetails.text_codec_type":                                           "TextCodecType",
+	"TechnicalUserDefinedResourceDetails.consumer_fulfillment_date":                  "ConsumerFulfillmentDate",
+	"TechnicalUserDefinedResourceDetails.file":                                       "File",
+	"TechnicalUserDefinedResourceDetails.file_availability_description":              "FileAvailabilityDescription",
+	"TechnicalUserDefinedResourceDetails.fingerprint":                                "Fingerprint",
+	"TechnicalUserDefinedResourceDetails.fulfillment_date":                           "FulfillmentDate",
+	"TechnicalUserDefinedResourceDetails.is_preview":                                 "IsPreview",
+	"TechnicalUserDefinedResourceDetails.language_and_script_code":                   "@LanguageAndScriptCode",
+	"TechnicalUserDefinedResourceDetails.preview_details":                            "PreviewDetails",
+	"TechnicalUserDefinedResourceDetails.technical_resource_details_reference":       "TechnicalResourceDetailsReference",
+	"TechnicalUserDefinedResourceDetails.user_defined_value":                         "UserDefinedValue",
+	"TechnicalVideoDetails.aspect_ratio":                                             "AspectRatio",
+	"TechnicalVideoDetails.audio_bit_rate":                                           "AudioBitRate",
+	"TechnicalVideoDetails.audio_bits_per_sample":                                    "AudioBitsPerSample",
+	"TechnicalVideoDetails.audio_codec_type":                                         "AudioCodecType",
+	"TechnicalVideoDetails.audio_sampling_rate":                                      "AudioSamplingRate",
+	"TechnicalVideoDetails.color_depth":                                              "ColorDepth",
+	"TechnicalVideoDetails.consumer_fulfillment_date":                                "ConsumerFulfillmentDate",
+	"TechnicalVideoDetails.container_format":                                         "ContainerFormat",
+	"TechnicalVideoDetails.drm_platform_type":                                        "DrmPlatformType",
+	"TechnicalVideoDetails.duration":                                                 "Duration",
+	"TechnicalVideoDetails.file":                                                     "File",
+	"TechnicalVideoDetails.file_availability_description":                            "FileAvailabilityDescription",
+	"TechnicalVideoDetails.fingerprint":                                              "Fingerprint",
+	"TechnicalVideoDetails.frame_rate":                                               "FrameRate",
+	"TechnicalVideoDetails.fulfillment_date":                                         "FulfillmentDate",
+	"TechnicalVideoDetails.image_height":                                             "ImageHeight",
+	"TechnicalVideoDetails.image_width":                                              "ImageWidth",
+	"TechnicalVideoDetails.is_preview":                                               "IsPreview",
+	"TechnicalVideoDetails.language_and_script_code":                                 "@LanguageAndScriptCode",
+	"TechnicalVideoDetails.number_of_audio_channels":                                 "NumberOfAudioChannels",
+	"TechnicalVideoDetails.overall_bit_rate":                                         "OverallBitRate",
+	"TechnicalVideoDetails.preview_details":                                          "PreviewDetails",
+	"TechnicalVideoDetails.resource_processing_required":                             "ResourceProcessingRequired",
+	"TechnicalVideoDetails.technical_resource_details_reference":                     "TechnicalResourceDetailsReference",
+	"TechnicalVideoDetails.usable_resource_duration":                                 "UsableResourceDuration",
+	"TechnicalVideoDetails.video_bit_rate":                                           "VideoBitRate",
+	"TechnicalVideoDetails.video_codec_type":                                         "VideoCodecType",
+	"TechnicalVideoDetails.video_definition_type":                                    "VideoDefinitionType",
+	"Text.creation_date":                                                             "CreationDate",
+	"Text.indirect_text_id":                                                          "IndirectTextId",
+	"Text.is_artist_related":                                                         "IsArtistRelated",
+	"Text.is_updated":                                                                "@IsUpdated",
+	"Text.language_and_script_code":                                                  "@LanguageAndScriptCode",
+	"Text.resource_contained_resource_reference_list":                                "ResourceContainedResourceReferenceList",
+	"Text.resource_musical_work_reference_list":                                      "ResourceMusicalWorkReferenceList",
+	"Text.resource_reference":                                                        "ResourceReference",
+	"Text.text_details_by_territory":                                                 "TextDetailsByTerritory",
+	"Text.text_id":                                                                   "TextId",
+	"Text.text_type":                                                                 "TextType",
+	"Text.title":                                                                     "Title",
+	"TextCodecType.namespace":                                                        "@Namespace",
+	"TextCodecType.user_defined_value":                                               "@UserDefinedValue",
+	"TextCodecType.version":                                                          "@Version",
+	"TextDetailsByTerritory.c_line":                                                  "CLine",
+	"TextDetailsByTerritory.courtesy_line":                                           "CourtesyLine",
+	"TextDetailsByTerritory.display_artist_name":                                     "DisplayArtistName",
+	"TextDetailsByTerritory.excluded_territory_code":                                 "ExcludedTerritoryCode",
+	"TextDetailsByTerritory.fulfillment_date":                                        "FulfillmentDate",
+	"TextDetailsByTerritory.genre":                                                   "Genre",
+	"TextDetailsByTerritory.indirect_resource_contributor":                           "IndirectResourceContributor",
+	"TextDetailsByTerritory.keywords":                                                "Keywords",
+	"TextDetailsByTerritory.language_and_script_code":                                "@LanguageAndScriptCode",
+	"TextDetailsByTerritory.original_resource_release_date":                          "OriginalResourceReleaseDate",
+	"TextDetailsByTerritory.parental_warning_type":                                   "ParentalWarningType",
+	"TextDetailsByTerritory.resource_contributor":                                    "ResourceContributor",
+	"TextDetailsByTerritory.resource_release_date":                                   "ResourceReleaseDate",
+	"TextDetailsByTerritory.synopsis":                                                "Synopsis",
+	"TextDetailsByTerritory.technical_text_details":                                  "TechnicalTextDetails",
+	"TextDetailsByTerritory.territory_code":                                          "TerritoryCode",
+	"TextDetailsByTerritory.title":                                                   "Title",
+	"TextId.i_s_b_n":                                                                 "ISBN",
+	"TextId.i_s_s_n":                                                                 "ISSN",
+	"TextId.is_replaced":                                                             "@IsReplaced",
+	"TextId.proprietary_id":                                                          "ProprietaryId",
+	"TextId.s_i_c_i":                                                                 "SICI",
+	"TextType.namespace":                                                             "@Namespace",
+	"TextType.user_defined_value":                                                    "@UserDefinedValue",
+	"Title.language_and_script_code":                                                 "@LanguageAndScriptCode",
+	"Title.sub_title":                                                                "SubTitle",
+	"Title.title_text":                                                               "TitleText",
+	"Title.title_type":                                                               "@TitleType",
+	"TitleText.language_and_script_code":                                             "@LanguageAndScriptCode",
+	"TypedRightsController.end_date":                                                 "EndDate",
+	"TypedRightsController.party_id":                                                 "PartyId",
+	"TypedRightsController.party_name":                                               "PartyName",
+	"TypedRightsController.right_share_percentage":                                   "RightSharePercentage",
+	"TypedRightsController.right_share_unknown":                                      "RightShareUnknown",
+	"TypedRightsController.rights_controller_role":                                   "RightsControllerRole",
+	"TypedRightsController.rights_controller_type":                                   "RightsControllerType",
+	"TypedRightsController.sequence_number":                                          "@SequenceNumber",
+	"TypedRightsController.start_date":                                               "StartDate",
+	"TypedRightsController.territory_of_registration":                                "TerritoryOfRegistration",
+	"TypedSubTitle.language_and_script_code":                                         "@LanguageAndScriptCode",
+	"TypedSubTitle.sub_title_type":                                                   "@SubTitleType",
+	"Usage.carrier_type":                                                             "CarrierType",
+	"Usage.distribution_channel_type":                                                "DistributionChannelType",
+	"Usage.number_of_usages":                                                         "NumberOfUsages",
+	"Usage.technical_instantiation":                                                  "TechnicalInstantiation",
+	"Usage.use_type":                                                                 "UseType",
+	"Usage.user_interface_type":                                                      "UserInterfaceType",
+	"UseType.namespace":                                                              "@Namespace",
+	"UseType.user_defined_value":                                                     "@UserDefinedValue",
+	"UserDefinedResource.creation_date":                                              "CreationDate",
+	"UserDefinedResource.indirect_user_defined_resource_id":                          "IndirectUserDefinedResourceId",
+	"UserDefinedResource.is_artist_related":                                          "IsArtistRelated",
+	"UserDefinedResource.is_updated":                                                 "@IsUpdated",
+	"UserDefinedResource.language_and_script_code":                                   "@LanguageAndScriptCode",
+	"UserDefinedResource.resource_contained_resource_reference_list":                 "ResourceContainedResourceReferenceList",
+	"UserDefinedResource.resource_musical_work_reference_list":                       "ResourceMusicalWorkReferenceList",
+	"UserDefinedResource.resource_reference":                                         "ResourceReference",
+	"UserDefinedResource.title":                                                      "Title",
+	"UserDefinedResource.user_defined_resource_details_by_territory":                 "UserDefinedResourceDetailsByTerritory",
+	"UserDefinedResource.user_defined_resource_id":                                   "UserDefinedResourceId",
+	"UserDefinedResource.user_defined_resource_type":                                 "UserDefinedResourceType",
+	"UserDefinedResource.user_defined_value":                                         "UserDefinedValue",
+	"UserDefinedResourceDetailsByTerritory.c_line":                                   "CLine",
+	"UserDefinedResourceDetailsByTerritory.display_artist_name":                      "DisplayArtistName",
+	"UserDefinedResourceDetailsByTerritory.excluded_territory_code":                  "ExcludedTerritoryCode",
+	"UserDefinedResourceDetailsByTerritory.fulfillment_date":                         "FulfillmentDate",
+	"UserDefinedResourceDetailsByTerritory.genre":                                    "Genre",
+	"UserDefinedResourceDetailsByTerritory.indirect_resource_contributor":            "IndirectResourceContributor",
+	"UserDefinedResourceDetailsByTerritory.keywords":                                 "Keywords",
+	"UserDefinedResourceDetailsByTerritory.language_and_script_code":                 "@LanguageAndScriptCode",
+	"UserDefinedResourceDetailsByTerritory.original_resource_release_date":           "OriginalResourceReleaseDate",
+	"UserDefinedResourceDetailsByTerritory.p_line":                                   "PLine",
+	"UserDefinedResourceDetailsByTerritory.parental_warning_type":                    "ParentalWarningType",
+	"UserDefinedResourceDetailsByTerritory.resource_contributor":                     "ResourceContributor",
+	"UserDefinedResourceDetailsByTerritory.resource_release_date":                    "ResourceReleaseDate",
+	"UserDefinedResourceDetailsByTerritory.synopsis":                                 "Synopsis",
+	"UserDefinedResourceDetailsByTerritory.technical_user_defined_resource_details":  "TechnicalUserDefinedResourceDetails",
+	"UserDefinedResourceDetailsByTerritory.territory_code":                           "TerritoryCode",
+	"UserDefinedResourceDetailsByTerritory.title":                                    "Title",
+	"UserDefinedResourceDetailsByTerritory.user_defined_value":                       "UserDefinedValue",
+	"UserDefinedResourceType.namespace":                                              "@Namespace",
+	"UserDefinedValue.description":                                                   "@Description",
+	"UserDefinedValue.language_and_script_code":                                      "@LanguageAndScriptCode",
+	"UserDefinedValue.namespace":                                                     "@Namespace",
+	"UserInterfaceType.namespace":                                                    "@Namespace",
+	"UserInterfaceType.user_defined_value":                                           "@UserDefinedValue",
+	"Video.creation_date":                                                            "CreationDate",
+	"Video.duration":                                                                 "Duration",
+	"Video.has_pre_order_fulfillment":                                                "HasPreOrderFulfillment",
+	"Video.indirect_video_id":                                                        "IndirectVideoId",
+	"Video.instrumentation_description":                                              "InstrumentationDescription",
+	"Video.is_artist_related":                                                        "IsArtistRelated",
+	"Video.is_background":                                                            "IsBackground",
+	"Video.is_bonus_resource":                                                        "IsBonusResource",
+	"Video.is_hidden_resource":                                                       "IsHiddenResource",
+	"Video.is_instrumental":                                                          "IsInstrumental",
+	"Video.is_medley":                                                                "IsMedley",
+	"Video.is_potpourri":                                                             "IsPotpourri",
+	"Video.is_remastered":                                                            "IsRemastered",
+	"Video.is_updated":                                                               "@IsUpdated",
+	"Video.language_and_script_code":                                                 "@LanguageAndScriptCode",
+	"Video.language_of_dubbing":                                                      "LanguageOfDubbing",
+	"Video.language_of_performance":                                                  "LanguageOfPerformance",
+	"Video.mastered_date":                                                            "MasteredDate",
+	"Video.no_silence_after":                                                         "NoSilenceAfter",
+	"Video.no_silence_before":                                                        "NoSilenceBefore",
+	"Video.number_of_contracted_artists":                                             "NumberOfContractedArtists",
+	"Video.number_of_featured_artists":                                               "NumberOfFeaturedArtists",
+	"Video.number_of_non_contracted_artists":                                         "NumberOfNonContractedArtists",
+	"Video.number_of_non_featured_artists":                                           "NumberOfNonFeaturedArtists",
+	"Video.performer_information_required":                                           "PerformerInformationRequired",
+	"Video.reason_for_cue_sheet_absence":                                             "ReasonForCueSheetAbsence",
+	"Video.reference_title":                                                          "ReferenceTitle",
+	"Video.remastered_date":                                                          "RemasteredDate",
+	"Video.resource_contained_resource_reference_list":                               "ResourceContainedResourceReferenceList",
+	"Video.resource_musical_work_reference_list":                                     "ResourceMusicalWorkReferenceList",
+	"Video.resource_reference":                                                       "ResourceReference",
+	"Video.rights_agreement_id":                                                      "RightsAgreementId",
+	"Video.sub_title_language":                                                       "SubTitleLanguage",
+	"Video.territory_of_commissioning":                                               "TerritoryOfCommissioning",
+	"Video.title":                                                                    "Title",
+	"Video.video_collection_reference_list":                                          "VideoCollectionReferenceList",
+	"Video.video_cue_sheet_reference":                                                "VideoCueSheetReference",
+	"Video.video_details_by_territory":                                               "VideoDetailsByTerritory",
+	"Video.video_id":                                                                 "VideoId",
+	"Video.video_type":                                                               "VideoType",
+	"VideoCodecType.namespace":                                                       "@Namespace",
+	"VideoCodecType.user_defined_value":                                              "@UserDefinedValue",
+	"VideoCodecType.version":                                                         "@Version",
+	"VideoCueSheetReference.video_cue_sheet_reference":                               "VideoCueSheetReference",
+	"VideoDetailsByTerritory.av_rating":                                              "AvRating",
+	"VideoDetailsByTerritory.c_line":                                                 "CLine",
+	"VideoDetailsByTerritory.character":                                              "Character",
+	"VideoDetailsByTerritory.courtesy_line":                                          "CourtesyLine",
+	"VideoDetailsByTerritory.display_artist":                                         "DisplayArtist",
+	"VideoDetailsByTerritory.display_artist_name":                                    "DisplayArtistName",
+	"VideoDetailsByTerritory.display_conductor":                                      "DisplayConductor",
+	"VideoDetailsByTerritory.excluded_territory_code":                                "ExcludedTerritoryCode",
+	"VideoDetailsByTerritory.fulfillment_date":                                       "FulfillmentDate",
+	"VideoDetailsByTerritory.genre":                                                  "Genre",
+	"VideoDetailsByTerritory.host_sound_carrier":                                     "HostSoundCarrier",
+	"VideoDetailsByTerritory.indirect_resource_contributor":                          "IndirectResourceContributor",
+	"VideoDetailsByTerritory.keywords":                                               "Keywords",
+	"VideoDetailsByTerritory.label_name":                                             "LabelName",
+	"VideoDetailsByTerritory.language_and_script_code":                               "@LanguageAndScriptCode",
+	"VideoDetailsByTerritory.marketing_comment":                                      "MarketingComment",
+	"VideoDetailsByTerritory.original_resource_release_date":                         "OriginalResourceReleaseDate",
+	"VideoDetailsByTerritory.p_line":                                                 "PLine",
+	"VideoDetailsByTerritory.parental_warning_type":                                  "ParentalWarningType",
+	"VideoDetailsByTerritory.remastered_date":                                        "RemasteredDate",
+	"VideoDetailsByTerritory.resource_contributor":                                   "ResourceContributor",
+	"VideoDetailsByTerritory.resource_release_date":                                  "ResourceReleaseDate",
+	"VideoDetailsByTerritory.rights_agreement_id":                                    "RightsAgreementId",
+	"VideoDetailsByTerritory.rights_controller":                                      "RightsController",
+	"VideoDetailsByTerritory.sequence_number":                                        "SequenceNumber",
+	"VideoDetailsByTerritory.synopsis":                                               "Synopsis",
+	"VideoDetailsByTerritory.technical_video_details":                                "TechnicalVideoDetails",
+	"VideoDetailsByTerritory.territory_code":                                         "TerritoryCode",
+	"VideoDetailsByTerritory.title":                                                  "Title",
+	"VideoId.catalog_number":                                                         "CatalogNumber",
+	"VideoId.e_i_d_r":                                                                "EIDR",
+	"VideoId.i_s_a_n":                                                                "ISAN",
+	"VideoId.i_s_r_c":                                                                "ISRC",
+	"VideoId.is_replaced":                                                            "@IsReplaced",
+	"VideoId.proprietary_id":                                                         "ProprietaryId",
+	"VideoId.v_i_s_a_n":                                                              "VISAN",
+	"VideoType.namespace":                                                            "@Namespace",
+	"VideoType.user_defined_value":                                                   "@UserDefinedValue",
+	"WebPage.page_name":                                                              "PageName",
+	"WebPage.party_id":                                                               "PartyId",
+	"WebPage.password":                                                               "Password",
+	"WebPage.release_id":                                                             "ReleaseId",
+	"WebPage.u_r_l":                                                                  "URL",
+	"WebPage.user_name":                                                              "UserName",
+	"WebPolicy.access_blocking_requested":                                            "AccessBlockingRequested",
+	"WebPolicy.access_limitation":                                                    "AccessLimitation",
+	"WebPolicy.condition":                                                            "Condition",
+	"WebPolicy.embedding_allowed":                                                    "EmbeddingAllowed",
+	"WebPolicy.syndication_allowed":                                                  "SyndicationAllowed",
+	"WebPolicy.user_comment_allowed":                                                 "UserCommentAllowed",
+	"WebPolicy.user_rating_allowed":                                                  "UserRatingAllowed",
+	"WebPolicy.user_responses_allowed":                                               "UserResponsesAllowed",
+	"WorkList.language_and_script_code":                                              "@LanguageAndScriptCode",
+	"WorkList.musical_work":                                                          "MusicalWork",
+}
+
+// AddDisplayArtist adds a DisplayArtist named name with ArtistRole role to each ReleaseDetailsByTerritory
+// of the main Release: the one flagged IsMainRelease, else the first. A Release, and Worldwide details for
+// it, are created if missing. The DisplayArtistNames are left as is.
+func (m *NewReleaseMessage) AddDisplayArtist(name, role string) {
+	if m.ReleaseList == nil {
+		m.ReleaseList = &ReleaseList{}
+	}
+	var release *Release
+	for _, candidate := range m.ReleaseList.Release {
+		if release == nil && candidate.GetIsMainRelease() {
+			release = candidate
+		}
+	}
+	if release == nil {
+		if len(m.ReleaseList.Release) == 0 {
+			m.ReleaseList.Release = append(m.ReleaseList.Release, &Release{IsMainRelease: true})
+		}
+		release = m.ReleaseList.Release[0]
+	}
+	if len(release.ReleaseDetailsByTerritory) == 0 {
+		release.ReleaseDetailsByTerritory = append(release.ReleaseDetailsByTerritory, &ReleaseDetailsByTerritory{
+			TerritoryCode: []*CurrentTerritoryCode{{Value: "Worldwide"}},
+		})
+	}
+
+	for _, details := range release.ReleaseDetailsByTerritory {
+		details.DisplayArtist = append(details.DisplayArtist, &Artist{
+			PartyName:      []*PartyName{{FullName: &Name{Value: name}}},
+			ArtistRole:     []*ArtistRole{{Value: role}},
+			SequenceNumber: int32(len(details.DisplayArtist) + 1),
+		})
+	}
+}
@@ -7,6 +7,8 @@ import "strings"
 // XMLString returns the XML string representation of DdexCCurrentTerritoryCode
 func (e DdexCCurrentTerritoryCode) XMLString() string {
 	switch e {
+	case DdexCCurrentTerritoryCode_DDEX_C_CURRENT_TERRITORY_CODE_IDENTIFIERTYPE:
+		return "IDENTIFIERTYPE"
 	default:
 		return ""
 	}
@@ -16,6 +18,8 @@ func (e DdexCCurrentTerritoryCode) XMLString() string {
 func ParseDdexCCurrentTerritoryCodeString(s string) (DdexCCurrentTerritoryCode, bool) {
 	s = strings.ToUpper(s)
 	switch s {
+	case "IDENTIFIERTYPE":
+		return DdexCCurrentTerritoryCode_DDEX_C_CURRENT_TERRITORY_CODE_IDENTIFIERTYPE, true
 	default:
 		return DdexCCurrentTerritoryCode(0), false
 	}
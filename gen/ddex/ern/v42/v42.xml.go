@@ -4,8 +4,13 @@ package ernv42
 
 import (
 	"encoding/xml"
+	"fmt"
 	"reflect"
+	"runtime"
+	"sort"
 	"strings"
+	"sync"
+	"weak"
 )
 
 // Package-level namespace constants
@@ -16,6 +21,17 @@ const (
 	NamespaceAVS    = "http://ddex.net/xml/avs/avs"
 )
 
+// parseWarnings holds the ParseWarnings of root messages by weak pointer, as the generated message
+// structs have no field for them
+var parseWarnings sync.Map
+
+// newReleaseMessageAttrs caches the attributes that struct fields of NewReleaseMessage handle, which MarshalXML
+// must not repeat from NamespaceAttrs
+var (
+	newReleaseMessageAttrsOnce sync.Once
+	newReleaseMessageAttrs     map[string]bool
+)
+
 // MarshalXML implements xml.Marshaler for NewReleaseMessage
 func (m *NewReleaseMessage) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
 	// Set default namespace values if empty
@@ -25,34 +41,38 @@ func (m *NewReleaseMessage) MarshalXML(e *xml.Encoder, start xml.StartElement) e
 	// Set the namespace on the start element
 	start.Name.Space = Namespace
 
-	// Add namespace attributes to the element, avoiding duplicates
-	// Use reflection to find which attributes are already handled by struct fields
-	existingAttrs := make(map[string]bool)
-	v := reflect.ValueOf(m).Elem()
-	t := v.Type()
-	for i := 0; i < v.NumField(); i++ {
-		field := t.Field(i)
-		if xmlTag := field.Tag.Get("xml"); xmlTag != "" && xmlTag != "-" {
-			// Parse the XML tag to get the attribute name
-			if strings.HasSuffix(xmlTag, ",attr") {
-				attrName := strings.TrimSuffix(xmlTag, ",attr")
-				if colonIdx := strings.Index(attrName, ":"); colonIdx >= 0 {
-					// For tags like "xmlns:ern,attr" or "xsi:schemaLocation,attr"
-					existingAttrs[attrName] = true
-				} else if attrName != "" {
-					// For tags like "LanguageAndScriptCode,attr"
-					existingAttrs[attrName] = true
+	// Add namespace attributes to the element, avoiding duplicates. The attributes already handled by
+	// struct fields are fixed per type, so they are found by reflection once and cached
+	newReleaseMessageAttrsOnce.Do(func() {
+		newReleaseMessageAttrs = make(map[string]bool)
+		t := reflect.TypeOf(m).Elem()
+		for i := 0; i < t.NumField(); i++ {
+			field := t.Field(i)
+			if xmlTag := field.Tag.Get("xml"); xmlTag != "" && xmlTag != "-" {
+				// Parse the XML tag to get the attribute name, from tags like "xmlns:ern,attr",
+				// "xsi:schemaLocation,attr" or "LanguageAndScriptCode,attr"
+				if strings.HasSuffix(xmlTag, ",attr") {
+					if attrName := strings.TrimSuffix(xmlTag, ",attr"); attrName != "" {
+						newReleaseMessageAttrs[attrName] = true
+					}
 				}
 			}
 		}
-	}
+	})
+	existingAttrs := newReleaseMessageAttrs
 
-	// Add attributes from the map that aren't already handled
-	for key, value := range m.NamespaceAttrs {
+	// Add attributes from the map that aren't already handled, sorted by key
+	// so that marshaled output is deterministic
+	keys := make([]string, 0, len(m.NamespaceAttrs))
+	for key := range m.NamespaceAttrs {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	for _, key := range keys {
 		if !existingAttrs[key] {
 			start.Attr = append(start.Attr, xml.Attr{
 				Name:  xml.Name{Local: key},
-				Value: value,
+				Value: m.NamespaceAttrs[key],
 			})
 		}
 	}
@@ -68,6 +88,9 @@ func (m *NewReleaseMessage) UnmarshalXML(d *xml.Decoder, start xml.StartElement)
 	if m.NamespaceAttrs == nil {
 		m.NamespaceAttrs = make(map[string]string)
 	}
+	// Namespace declarations of this element, to report conflicting ones as parse warnings
+	var warnings []string
+	declared := make(map[string]string)
 	for _, attr := range start.Attr {
 		// Capture all xmlns:* attributes and xsi:schemaLocation
 		if attr.Name.Space == "xmlns" || attr.Name.Local == "xmlns" ||
@@ -80,17 +103,76 @@ func (m *NewReleaseMessage) UnmarshalXML(d *xml.Decoder, start xml.StartElement)
 				// Preserve the namespace prefix for attributes like xsi:schemaLocation
 				if attr.Name.Space == "http://www.w3.org/2001/XMLSchema-instance" {
 					key = "xsi:" + attr.Name.Local
+					// The document may bind XMLSchema-instance to another prefix; the attribute is
+					// always written back as xsi:, so make sure that prefix is declared
+					if _, ok := m.NamespaceAttrs["xmlns:xsi"]; !ok {
+						m.NamespaceAttrs["xmlns:xsi"] = attr.Name.Space
+					}
 				}
 			}
+			if key == "xmlns" || strings.HasPrefix(key, "xmlns:") {
+				// The map keeps only the last declaration of a prefix, so record the conflict
+				if previous, ok := declared[key]; ok && previous != attr.Value {
+					warnings = append(warnings, fmt.Sprintf("%s is declared twice, as %q and %q", key, previous, attr.Value))
+				}
+				declared[key] = attr.Value
+			}
 			m.NamespaceAttrs[key] = attr.Value
 		}
 	}
+	// Replace the warnings of any earlier parse into m; the entry is dropped when m is collected
+	ref := weak.Make(m)
+	parseWarnings.Delete(ref)
+	if len(warnings) > 0 {
+		parseWarnings.Store(ref, warnings)
+		runtime.AddCleanup(m, func(ref weak.Pointer[NewReleaseMessage]) { parseWarnings.Delete(ref) }, ref)
+	}
 
 	// Create an alias type to avoid infinite recursion
 	type alias NewReleaseMessage
 	return d.DecodeElement((*alias)(m), &start)
 }
 
+// SetNamespaceAttr sets an attribute written on the root element. key is the attribute's qualified
+// name as it appears in the document: "xmlns" for the default namespace, "xmlns:<prefix>" for a
+// namespace declaration such as "xmlns:avs", or "xsi:schemaLocation".
+func (m *NewReleaseMessage) SetNamespaceAttr(key, value string) {
+	if m.NamespaceAttrs == nil {
+		m.NamespaceAttrs = make(map[string]string)
+	}
+	m.NamespaceAttrs[key] = value
+}
+
+// GetNamespaceAttr returns the root element attribute stored under key (see SetNamespaceAttr) and
+// whether it is set
+func (m *NewReleaseMessage) GetNamespaceAttr(key string) (string, bool) {
+	if m == nil {
+		return "", false
+	}
+	value, ok := m.NamespaceAttrs[key]
+	return value, ok
+}
+
+// ParseWarnings returns the problems UnmarshalXML found in the root element without failing the
+// parse, namely namespace prefixes declared twice with different namespaces, of which NamespaceAttrs
+// keeps only the last
+func (m *NewReleaseMessage) ParseWarnings() []string {
+	if m == nil {
+		return nil
+	}
+	if warnings, ok := parseWarnings.Load(weak.Make(m)); ok {
+		return warnings.([]string)
+	}
+	return nil
+}
+
+// purgeReleaseMessageAttrs caches the attributes that struct fields of PurgeReleaseMessage handle, which MarshalXML
+// must not repeat from NamespaceAttrs
+var (
+	purgeReleaseMessageAttrsOnce sync.Once
+	purgeReleaseMessageAttrs     map[string]bool
+)
+
 // MarshalXML implements xml.Marshaler for PurgeReleaseMessage
 func (m *PurgeReleaseMessage) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
 	// Set default namespace values if empty
@@ -100,34 +182,38 @@ func (m *PurgeReleaseMessage) MarshalXML(e *xml.Encoder, start xml.StartElement)
 	// Set the namespace on the start element
 	start.Name.Space = Namespace
 
-	// Add namespace attributes to the element, avoiding duplicates
-	// Use reflection to find which attributes are already handled by struct fields
-	existingAttrs := make(map[string]bool)
-	v := reflect.ValueOf(m).Elem()
-	t := v.Type()
-	for i := 0; i < v.NumField(); i++ {
-		field := t.Field(i)
-		if xmlTag := field.Tag.Get("xml"); xmlTag != "" && xmlTag != "-" {
-			// Parse the XML tag to get the attribute name
-			if strings.HasSuffix(xmlTag, ",attr") {
-				attrName := strings.TrimSuffix(xmlTag, ",attr")
-				if colonIdx := strings.Index(attrName, ":"); colonIdx >= 0 {
-					// For tags like "xmlns:ern,attr" or "xsi:schemaLocation,attr"
-					existingAttrs[attrName] = true
-				} else if attrName != "" {
-					// For tags like "LanguageAndScriptCode,attr"
-					existingAttrs[attrName] = true
+	// Add namespace attributes to the element, avoiding duplicates. The attributes already handled by
+	// struct fields are fixed per type, so they are found by reflection once and cached
+	purgeReleaseMessageAttrsOnce.Do(func() {
+		purgeReleaseMessageAttrs = make(map[string]bool)
+		t := reflect.TypeOf(m).Elem()
+		for i := 0; i < t.NumField(); i++ {
+			field := t.Field(i)
+			if xmlTag := field.Tag.Get("xml"); xmlTag != "" && xmlTag != "-" {
+				// Parse the XML tag to get the attribute name, from tags like "xmlns:ern,attr",
+				// "xsi:schemaLocation,attr" or "LanguageAndScriptCode,attr"
+				if strings.HasSuffix(xmlTag, ",attr") {
+					if attrName := strings.TrimSuffix(xmlTag, ",attr"); attrName != "" {
+						purgeReleaseMessageAttrs[attrName] = true
+					}
 				}
 			}
 		}
-	}
+	})
+	existingAttrs := purgeReleaseMessageAttrs
 
-	// Add attributes from the map that aren't already handled
-	for key, value := range m.NamespaceAttrs {
+	// Add attributes from the map that aren't already handled, sorted by key
+	// so that marshaled output is deterministic
+	keys := make([]string, 0, len(m.NamespaceAttrs))
+	for key := range m.NamespaceAttrs {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	for _, key := range keys {
 		if !existingAttrs[key] {
 			start.Attr = append(start.Attr, xml.Attr{
 				Name:  xml.Name{Local: key},
-				Value: value,
+				Value: m.NamespaceAttrs[key],
 			})
 		}
 	}
@@ -143,6 +229,9 @@ func (m *PurgeReleaseMessage) UnmarshalXML(d *xml.Decoder, start xml.StartElemen
 	if m.NamespaceAttrs == nil {
 		m.NamespaceAttrs = make(map[string]string)
 	}
+	// Namespace declarations of this element, to report conflicting ones as parse warnings
+	var warnings []string
+	declared := make(map[string]string)
 	for _, attr := range start.Attr {
 		// Capture all xmlns:* attributes and xsi:schemaLocation
 		if attr.Name.Space == "xmlns" || attr.Name.Local == "xmlns" ||
@@ -155,13 +244,1198 @@ func (m *PurgeReleaseMessage) UnmarshalXML(d *xml.Decoder, start xml.StartElemen
 				// Preserve the namespace prefix for attributes like xsi:schemaLocation
 				if attr.Name.Space == "http://www.w3.org/2001/XMLSchema-instance" {
 					key = "xsi:" + attr.Name.Local
+					// The document may bind XMLSchema-instance to another prefix; the attribute is
+					// always written back as xsi:, so make sure that prefix is declared
+					if _, ok := m.NamespaceAttrs["xmlns:xsi"]; !ok {
+						m.NamespaceAttrs["xmlns:xsi"] = attr.Name.Space
+					}
+				}
+			}
+			if key == "xmlns" || strings.HasPrefix(key, "xmlns:") {
+				// The map keeps only the last declaration of a prefix, so record the conflict
+				if previous, ok := declared[key]; ok && previous != attr.Value {
+					warnings = append(warnings, fmt.Sprintf("%s is declared twice, as %q and %q", key, previous, attr.Value))
 				}
+				declared[key] = attr.Value
 			}
 			m.NamespaceAttrs[key] = attr.Value
 		}
 	}
+	// Replace the warnings of any earlier parse into m; the entry is dropped when m is collected
+	ref := weak.Make(m)
+	parseWarnings.Delete(ref)
+	if len(warnings) > 0 {
+		parseWarnings.Store(ref, warnings)
+		runtime.AddCleanup(m, func(ref weak.Pointer[PurgeReleaseMessage]) { parseWarnings.Delete(ref) }, ref)
+	}
 
 	// Create an alias type to avoid infinite recursion
 	type alias PurgeReleaseMessage
 	return d.DecodeElement((*alias)(m), &start)
 }
+
+// SetNamespaceAttr sets an attribute written on the root element. key is the attribute's qualified
+// name as it appears in the document: "xmlns" for the default namespace, "xmlns:<prefix>" for a
+// namespace declaration such as "xmlns:avs", or "xsi:schemaLocation".
+func (m *PurgeReleaseMessage) SetNamespaceAttr(key, value string) {
+	if m.NamespaceAttrs == nil {
+		m.NamespaceAttrs = make(map[string]string)
+	}
+	m.NamespaceAttrs[key] = value
+}
+
+// GetNamespaceAttr returns the root element attribute stored under key (see SetNamespaceAttr) and
+// whether it is set
+func (m *PurgeReleaseMessage) GetNamespaceAttr(key string) (string, bool) {
+	if m == nil {
+		return "", false
+	}
+	value, ok := m.NamespaceAttrs[key]
+	return value, ok
+}
+
+// ParseWarnings returns the problems UnmarshalXML found in the root element without failing the
+// parse, namely namespace prefixes declared twice with different namespaces, of which NamespaceAttrs
+// keeps only the last
+func (m *PurgeReleaseMessage) ParseWarnings() []string {
+	if m == nil {
+		return nil
+	}
+	if warnings, ok := parseWarnings.Load(weak.Make(m)); ok {
+		return warnings.([]string)
+	}
+	return nil
+}
+
+// GetReleaseProfileVersionIdOr returns ReleaseProfileVersionId, or def when it is empty or x is nil
+func (x *NewReleaseMessage) GetReleaseProfileVersionIdOr(def string) string {
+	if v := x.GetReleaseProfileVersionId(); v != "" {
+		return v
+	}
+	return def
+}
+
+// GetReleaseProfileVariantVersionIdOr returns ReleaseProfileVariantVersionId, or def when it is empty or x is nil
+func (x *NewReleaseMessage) GetReleaseProfileVariantVersionIdOr(def string) string {
+	if v := x.GetReleaseProfileVariantVersionId(); v != "" {
+		return v
+	}
+	return def
+}
+
+// GetLanguageAndScriptCodeOr returns LanguageAndScriptCode, or def when it is empty or x is nil
+func (x *NewReleaseMessage) GetLanguageAndScriptCodeOr(def string) string {
+	if v := x.GetLanguageAndScriptCode(); v != "" {
+		return v
+	}
+	return def
+}
+
+// GetMessageThreadIdOr returns MessageThreadId, or def when it is empty or x is nil
+func (x *MessageHeader) GetMessageThreadIdOr(def string) string {
+	if v := x.GetMessageThreadId(); v != "" {
+		return v
+	}
+	return def
+}
+
+// GetMessageIdOr returns MessageId, or def when it is empty or x is nil
+func (x *MessageHeader) GetMessageIdOr(def string) string {
+	if v := x.GetMessageId(); v != "" {
+		return v
+	}
+	return def
+}
+
+// GetMessageFileNameOr returns MessageFileName, or def when it is empty or x is nil
+func (x *MessageHeader) GetMessageFileNameOr(def string) string {
+	if v := x.GetMessageFileName(); v != "" {
+		return v
+	}
+	return def
+}
+
+// GetMessageCreatedDateTimeOr returns MessageCreatedDateTime, or def when it is empty or x is nil
+func (x *MessageHeader) GetMessageCreatedDateTimeOr(def string) string {
+	if v := x.GetMessageCreatedDateTime(); v != "" {
+		return v
+	}
+	return def
+}
+
+// GetMessageControlTypeOr returns MessageControlType, or def when it is empty or x is nil
+func (x *MessageHeader) GetMessageControlTypeOr(def string) string {
+	if v := x.GetMessageControlType(); v != "" {
+		return v
+	}
+	return def
+}
+
+// GetLanguageAndScriptCodeOr returns LanguageAndScriptCode, or def when it is empty or x is nil
+func (x *PurgeReleaseMessage) GetLanguageAndScriptCodeOr(def string) string {
+	if v := x.GetLanguageAndScriptCode(); v != "" {
+		return v
+	}
+	return def
+}
+
+// FieldToElement maps each proto field, keyed "Message.proto_field_name", to the DDEX element it
+// comes from; attributes are prefixed with @. Character data fields are not listed.
+var FieldToElement = map[string]string{
+	"AdditionalTitle.applicable_territory_code":                               "@ApplicableTerritoryCode",
+	"AdditionalTitle.is_default":                                              "@IsDefault",
+	"AdditionalTitle.language_and_script_code":                                "@LanguageAndScriptCode",
+	"AdditionalTitle.namespace":                                               "@Namespace",
+	"AdditionalTitle.sub_title":                                               "SubTitle",
+	"AdditionalTitle.title_text":                                              "TitleText",
+	"AdditionalTitle.title_type":                                              "@TitleType",
+	"AdditionalTitle.user_defined_value":                                      "@UserDefinedValue",
+	"AdministratingRecordCompanyRole.namespace":                               "@Namespace",
+	"AdministratingRecordCompanyRole.user_defined_value":                      "@UserDefinedValue",
+	"AdministratingRecordCompanyWithReference.record_company_party_reference": "RecordCompanyPartyReference",
+	"AdministratingRecordCompanyWithReference.role":                           "Role",
+	"Affiliation.company_name":                                                "CompanyName",
+	"Affiliation.excluded_territory_code":                                     "ExcludedTerritoryCode",
+	"Affiliation.party_affiliate_reference":                                   "PartyAffiliateReference",
+	"Affiliation.percentage_of_rights_assignment":                             "PercentageOfRightsAssignment",
+	"Affiliation.rights_type":                                                 "RightsType",
+	"Affiliation.territory_code":                                              "TerritoryCode",
+	"Affiliation.type":                                                        "Type",
+	"Affiliation.validity_period":                                             "ValidityPeriod",
+	"AllTerritoryCode.identifier_type":                                        "@IdentifierType",
+	"AspectRatio.aspect_ratio_type":                                           "@AspectRatioType",
+	"AudioCodecType.namespace":                                                "@Namespace",
+	"AudioCodecType.user_defined_value":                                       "@UserDefinedValue",
+	"AudioCodecType.version":                                                  "@Version",
+	"AvRating.agency":                                                         "Agency",
+	"AvRating.applicable_territory_code":                                      "@ApplicableTerritoryCode",
+	"AvRating.is_default":                                                     "@IsDefault",
+	"AvRating.rating":                                                         "Rating",
+	"AvRating.reason":                                                         "Reason",
+	"BitRate.unit_of_measure":                                                 "@UnitOfMeasure",
+	"CLine.c_line_company":                                                    "CLineCompany",
+	"CLine.c_line_text":                                                       "CLineText",
+	"CLine.language_and_script_code":                                          "@LanguageAndScriptCode",
+	"CLine.year":                                                              "Year",
+	"CLineWithDefault.applicable_territory_code":                              "@ApplicableTerritoryCode",
+	"CLineWithDefault.c_line_company":                                         "CLineCompany",
+	"CLineWithDefault.c_line_text":                                            "CLineText",
+	"CLineWithDefault.is_default":                                             "@IsDefault",
+	"CLineWithDefault.language_and_script_code":                               "@LanguageAndScriptCode",
+	"CLineWithDefault.year":                                                   "Year",
+	"CarrierType.namespace":                                                   "@Namespace",
+	"CarrierType.user_defined_value":                                          "@UserDefinedValue",
+	"CatalogNumber.namespace":                                                 "@Namespace",
+	"Chapter.additional_title":                                                "AdditionalTitle",
+	"Chapter.chapter_id":                                                      "ChapterId",
+	"Chapter.chapter_reference":                                               "ChapterReference",
+	"Chapter.character":                                                       "Character",
+	"Chapter.contributor":                                                     "Contributor",
+	"Chapter.display_title":                                                   "DisplayTitle",
+	"Chapter.display_title_text":                                              "DisplayTitleText",
+	"Chapter.duration":                                                        "Duration",
+	"Chapter.end_time":                                                        "EndTime",
+	"Chapter.language_and_script_code":                                        "@LanguageAndScriptCode",
+	"Chapter.representative_image_reference":                                  "RepresentativeImageReference",
+	"Chapter.sequence_number":                                                 "SequenceNumber",
+	"Chapter.start_time":                                                      "StartTime",
+	"ChapterList.chapter":                                                     "Chapter",
+	"ChapterList.language_and_script_code":                                    "@LanguageAndScriptCode",
+	"Character.character_party_reference":                                     "CharacterPartyReference",
+	"Character.performer":                                                     "Performer",
+	"Character.sequence_number":                                               "@SequenceNumber",
+	"CommercialModelType.namespace":                                           "@Namespace",
+	"CommercialModelType.user_defined_value":                                  "@UserDefinedValue",
+	"ConditionForRightsClaimPolicy.measurement_type":                          "MeasurementType",
+	"ConditionForRightsClaimPolicy.reference_creation":                        "ReferenceCreation",
+	"ConditionForRightsClaimPolicy.relational_relator":                        "RelationalRelator",
+	"ConditionForRightsClaimPolicy.unit":                                      "Unit",
+	"ConditionForRightsClaimPolicy.value":                                     "Value",
+	"ContainerFormat.namespace":                                               "@Namespace",
+	"ContainerFormat.user_defined_value":                                      "@UserDefinedValue",
+	"Contributor.contributor_party_reference":                                 "ContributorPartyReference",
+	"Contributor.display_credits":                                             "DisplayCredits",
+	"Contributor.has_made_contracted_contribution":                            "HasMadeContractedContribution",
+	"Contributor.has_made_featured_contribution":                              "HasMadeFeaturedContribution",
+	"Contributor.instrument_type":                                             "InstrumentType",
+	"Contributor.is_credited":                                                 "IsCredited",
+	"Contributor.role":                                                        "Role",
+	"Contributor.sequence_number":                                             "@SequenceNumber",
+	"ContributorRole.namespace":                                               "@Namespace",
+	"ContributorRole.user_defined_value":                                      "@UserDefinedValue",
+	"CoreArea.bottom_right_corner":                                            "BottomRightCorner",
+	"CoreArea.top_left_corner":                                                "TopLeftCorner",
+	"CourtesyLineWithDefault.applicable_territory_code":                       "@ApplicableTerritoryCode",
+	"CourtesyLineWithDefault.is_default":                                      "@IsDefault",
+	"CourtesyLineWithDefault.language_and_script_code":                        "@LanguageAndScriptCode",
+	"CueOrigin.namespace":                                                     "@Namespace",
+	"CueOrigin.user_defined_value":                                            "@UserDefinedValue",
+	"CueSheetType.namespace":                                                  "@Namespace",
+	"CueSheetType.user_defined_value":                                         "@UserDefinedValue",
+	"CueThemeType.namespace":                                                  "@Namespace",
+	"CueThemeType.user_defined_value":                                         "@UserDefinedValue",
+	"CueUseType.namespace":                                                    "@Namespace",
+	"CueUseType.user_defined_value":                                           "@UserDefinedValue",
+	"CueVisualPerceptionType.namespace":                                       "@Namespace",
+	"CueVisualPerceptionType.user_defined_value":                              "@UserDefinedValue",
+	"CueVocalType.namespace":                                                  "@Namespace",
+	"CueVocalType.user_defined_value":                                         "@UserDefinedValue",
+	"CurrentTerritoryCode.identifier_type":                                    "@IdentifierType",
+	"DSP.party_id":                                                            "PartyId",
+	"DSP.party_name":                                                          "PartyName",
+	"DSP.trading_name":                                                        "TradingName",
+	"DSP.u_r_l":                                                               "URL",
+	"Deal.deal_reference":                                                     "DealReference",
+	"Deal.deal_technical_resource_details_reference_list":                     "DealTechnicalResourceDetailsReferenceList",
+	"Deal.deal_terms":                                                         "DealTerms",
+	"Deal.distribution_channel_page":                                          "DistributionChannelPage",
+	"Deal.is_communicated_out_of_band":                                        "IsCommunicatedOutOfBand",
+	"DealList.release_deal":                                                   "ReleaseDeal",
+	"DealList.release_visibility":                                             "ReleaseVisibility",
+	"DealList.track_release_visibility":                                       "TrackReleaseVisibility",
+	"DealResourceReferenceList.deal_resource_reference":                       "DealResourceReference",
+	"DealTechnicalResourceDetailsReferenceList.deal_technical_resource_details_reference": "DealTechnicalResourceDetailsReference",
+	"DealTerms.carrier_type":                                                   "CarrierType",
+	"DealTerms.commercial_model_type":                                          "CommercialModelType",
+	"DealTerms.distribution_channel":                                           "DistributionChannel",
+	"DealTerms.excluded_distribution_channel":                                  "ExcludedDistributionChannel",
+	"DealTerms.excluded_territory_code":                                        "ExcludedTerritoryCode",
+	"DealTerms.instant_gratification_resource_list":                            "InstantGratificationResourceList",
+	"DealTerms.is_pre_order_deal":                                              "IsPreOrderDeal",
+	"DealTerms.is_promotional":                                                 "IsPromotional",
+	"DealTerms.number_of_products_per_carton":                                  "NumberOfProductsPerCarton",
+	"DealTerms.number_of_usages":                                               "NumberOfUsages",
+	"DealTerms.physical_returns":                                               "PhysicalReturns",
+	"DealTerms.price_information":                                              "PriceInformation",
+	"DealTerms.promotional_code":                                               "PromotionalCode",
+	"DealTerms.rights_claim_policy":                                            "RightsClaimPolicy",
+	"DealTerms.technical_instantiation":                                        "TechnicalInstantiation",
+	"DealTerms.territory_code":                                                 "TerritoryCode",
+	"DealTerms.use_type":                                                       "UseType",
+	"DealTerms.user_interface_type":                                            "UserInterfaceType",
+	"DealTerms.validity_period":                                                "ValidityPeriod",
+	"DealTermsTechnicalInstantiation.bit_rate":                                 "BitRate",
+	"DealTermsTechnicalInstantiation.coding_type":                              "CodingType",
+	"DealTermsTechnicalInstantiation.video_definition_type":                    "VideoDefinitionType",
+	"Deity.applicable_territory_code":                                          "@ApplicableTerritoryCode",
+	"Deity.is_default":                                                         "@IsDefault",
+	"DelegatedUsageRights.period_of_rights_delegation":                         "PeriodOfRightsDelegation",
+	"DelegatedUsageRights.territory_of_rights_delegation":                      "TerritoryOfRightsDelegation",
+	"DelegatedUsageRights.use_type":                                            "UseType",
+	"DescriptionWithTerritory.applicable_territory_code":                       "@ApplicableTerritoryCode",
+	"DescriptionWithTerritory.is_default":                                      "@IsDefault",
+	"DescriptionWithTerritory.language_and_script_code":                        "@LanguageAndScriptCode",
+	"DetailedCue.additional_title":                                             "AdditionalTitle",
+	"DetailedCue.c_line":                                                       "CLine",
+	"DetailedCue.contributor":                                                  "Contributor",
+	"DetailedCue.cue_origin":                                                   "CueOrigin",
+	"DetailedCue.cue_theme_type":                                               "CueThemeType",
+	"DetailedCue.cue_use_type":                                                 "CueUseType",
+	"DetailedCue.cue_visual_perception_type":                                   "CueVisualPerceptionType",
+	"DetailedCue.cue_vocal_type":                                               "CueVocalType",
+	"DetailedCue.display_title":                                                "DisplayTitle",
+	"DetailedCue.display_title_text":                                           "DisplayTitleText",
+	"DetailedCue.duration":                                                     "Duration",
+	"DetailedCue.end_time":                                                     "EndTime",
+	"DetailedCue.has_musical_content":                                          "HasMusicalContent",
+	"DetailedCue.is_dance":                                                     "IsDance",
+	"DetailedCue.p_line":                                                       "PLine",
+	"DetailedCue.resource_id":                                                  "ResourceId",
+	"DetailedCue.start_time":                                                   "StartTime",
+	"DetailedCue.work_id":                                                      "WorkId",
+	"DetailedCueSheet.cue":                                                     "Cue",
+	"DetailedCueSheet.cue_sheet_id":                                            "CueSheetId",
+	"DetailedCueSheet.cue_sheet_reference":                                     "CueSheetReference",
+	"DetailedCueSheet.cue_sheet_type":                                          "CueSheetType",
+	"DetailedCueSheetList.cue_sheet":                                           "CueSheet",
+	"DetailedHashSum.algorithm":                                                "Algorithm",
+	"DetailedHashSum.data_type":                                                "DataType",
+	"DetailedHashSum.hash_sum_value":                                           "HashSumValue",
+	"DetailedHashSum.parameter":                                                "Parameter",
+	"DetailedHashSum.version":                                                  "Version",
+	"DetailedPartyId.cisac_society_id":                                         "CisacSocietyId",
+	"DetailedPartyId.d_p_i_d":                                                  "DPID",
+	"DetailedPartyId.i_p_n":                                                    "IPN",
+	"DetailedPartyId.i_s_n_i":                                                  "ISNI",
+	"DetailedPartyId.ipi_name_number":                                          "IpiNameNumber",
+	"DetailedPartyId.proprietary_id":                                           "ProprietaryId",
+	"DetailedResourceContributor.display_credits":                              "DisplayCredits",
+	"DetailedResourceContributor.has_made_contracted_contribution":             "HasMadeContractedContribution",
+	"DetailedResourceContributor.has_made_featured_contribution":               "HasMadeFeaturedContribution",
+	"DetailedResourceContributor.instrument_type":                              "InstrumentType",
+	"DetailedResourceContributor.party_id":                                     "PartyId",
+	"DetailedResourceContributor.party_name":                                   "PartyName",
+	"DetailedResourceContributor.role":                                         "Role",
+	"DetailedResourceContributor.sequence_number":                              "@SequenceNumber",
+	"DiscoverableUseType.is_discoverable":                                      "@IsDiscoverable",
+	"DiscoverableUseType.namespace":                                            "@Namespace",
+	"DiscoverableUseType.user_defined_value":                                   "@UserDefinedValue",
+	"DisplayArtist.artist_party_reference":                                     "ArtistPartyReference",
+	"DisplayArtist.artistic_role":                                              "ArtisticRole",
+	"DisplayArtist.display_artist_role":                                        "DisplayArtistRole",
+	"DisplayArtist.sequence_number":                                            "@SequenceNumber",
+	"DisplayArtist.title_display_information":                                  "TitleDisplayInformation",
+	"DisplayArtistNameWithDefault.applicable_territory_code":                   "@ApplicableTerritoryCode",
+	"DisplayArtistNameWithDefault.is_default":                                  "@IsDefault",
+	"DisplayArtistNameWithDefault.language_and_script_code":                    "@LanguageAndScriptCode",
+	"DisplayArtistRole.namespace":                                              "@Namespace",
+	"DisplayArtistRole.user_defined_value":                                     "@UserDefinedValue",
+	"DisplayCredits.applicable_territory_code":                                 "@ApplicableTerritoryCode",
+	"DisplayCredits.display_credit_text":                                       "DisplayCreditText",
+	"DisplayCredits.is_default":                                                "@IsDefault",
+	"DisplayCredits.language_and_script_code":                                  "@LanguageAndScriptCode",
+	"DisplaySubTitle.is_displayed_in_title":                                    "@IsDisplayedInTitle",
+	"DisplaySubTitle.sequence_number":                                          "@SequenceNumber",
+	"DisplaySubTitle.sub_title_type":                                           "@SubTitleType",
+	"DisplayTitle.applicable_territory_code":                                   "@ApplicableTerritoryCode",
+	"DisplayTitle.is_default":                                                  "@IsDefault",
+	"DisplayTitle.language_and_script_code":                                    "@LanguageAndScriptCode",
+	"DisplayTitle.sub_title":                                                   "SubTitle",
+	"DisplayTitle.title_text":                                                  "TitleText",
+	"DisplayTitleText.applicable_territory_code":                               "@ApplicableTerritoryCode",
+	"DisplayTitleText.is_default":                                              "@IsDefault",
+	"DisplayTitleText.language_and_script_code":                                "@LanguageAndScriptCode",
+	"DistributionChannelPage.page_name":                                        "PageName",
+	"DistributionChannelPage.party_id":                                         "PartyId",
+	"DistributionChannelPage.u_r_l":                                            "URL",
+	"DistributionChannelPage.user_name":                                        "UserName",
+	"EventDate.applicable_territory_code":                                      "@ApplicableTerritoryCode",
+	"EventDate.is_after":                                                       "@IsAfter",
+	"EventDate.is_approximate":                                                 "@IsApproximate",
+	"EventDate.is_before":                                                      "@IsBefore",
+	"EventDate.language_and_script_code":                                       "@LanguageAndScriptCode",
+	"EventDate.location_description":                                           "@LocationDescription",
+	"EventDateTime.is_after":                                                   "@IsAfter",
+	"EventDateTime.is_approximate":                                             "@IsApproximate",
+	"EventDateTime.is_before":                                                  "@IsBefore",
+	"EventDateTime.language_and_script_code":                                   "@LanguageAndScriptCode",
+	"EventDateTime.location_description":                                       "@LocationDescription",
+	"EventDateTime.territory_code":                                             "@TerritoryCode",
+	"EventDateTimeWithoutFlags.applicable_territory_code":                      "@ApplicableTerritoryCode",
+	"EventDateTimeWithoutFlags.is_approximate":                                 "@IsApproximate",
+	"EventDateTimeWithoutFlags.language_and_script_code":                       "@LanguageAndScriptCode",
+	"EventDateTimeWithoutFlags.location_description":                           "@LocationDescription",
+	"EventDateWithCurrentTerritory.applicable_territory_code":                  "@ApplicableTerritoryCode",
+	"EventDateWithCurrentTerritory.is_approximate":                             "@IsApproximate",
+	"EventDateWithCurrentTerritory.language_and_script_code":                   "@LanguageAndScriptCode",
+	"EventDateWithCurrentTerritory.location_description":                       "@LocationDescription",
+	"EventDateWithDefault.applicable_territory_code":                           "@ApplicableTerritoryCode",
+	"EventDateWithDefault.is_approximate":                                      "@IsApproximate",
+	"EventDateWithDefault.is_default":                                          "@IsDefault",
+	"EventDateWithoutFlags.applicable_territory_code":                          "@ApplicableTerritoryCode",
+	"EventDateWithoutFlags.is_approximate":                                     "@IsApproximate",
+	"EventDateWithoutFlags.language_and_script_code":                           "@LanguageAndScriptCode",
+	"EventDateWithoutFlags.location_description":                               "@LocationDescription",
+	"Extent.unit_of_measure":                                                   "@UnitOfMeasure",
+	"ExternalResourceLink.external_link":                                       "ExternalLink",
+	"ExternalResourceLink.externally_linked_resource_type":                     "ExternallyLinkedResourceType",
+	"ExternalResourceLink.file_format":                                         "FileFormat",
+	"ExternalResourceLink.u_r_l":                                               "URL",
+	"ExternalResourceLink.validity_period":                                     "ValidityPeriod",
+	"ExternallyLinkedResourceType.namespace":                                   "@Namespace",
+	"ExternallyLinkedResourceType.user_defined_value":                          "@UserDefinedValue",
+	"File.file_size":                                                           "FileSize",
+	"File.hash_sum":                                                            "HashSum",
+	"File.u_r_i":                                                               "URI",
+	"Fingerprint.algorithm":                                                    "Algorithm",
+	"Fingerprint.data_type":                                                    "DataType",
+	"Fingerprint.file":                                                         "File",
+	"Fingerprint.fingerprint_value":                                            "FingerprintValue",
+	"Fingerprint.parameter":                                                    "Parameter",
+	"Fingerprint.version":                                                      "Version",
+	"FingerprintAlgorithmType.namespace":                                       "@Namespace",
+	"FingerprintAlgorithmType.user_defined_value":                              "@UserDefinedValue",
+	"FirstPublicationDate.applicable_territory_code":                           "@ApplicableTerritoryCode",
+	"FirstPublicationDate.is_default":                                          "@IsDefault",
+	"FrameRate.unit_of_measure":                                                "@UnitOfMeasure",
+	"FulfillmentDateWithTerritory.applicable_territory_code":                   "@ApplicableTerritoryCode",
+	"FulfillmentDateWithTerritory.fulfillment_date":                            "FulfillmentDate",
+	"FulfillmentDateWithTerritory.is_default":                                  "@IsDefault",
+	"FulfillmentDateWithTerritory.resource_release_reference":                  "ResourceReleaseReference",
+	"GenreCategory.applicable_territory_code":                                  "@ApplicableTerritoryCode",
+	"GenreCategory.description":                                                "Description",
+	"GenreCategory.value":                                                      "Value",
+	"GenreCategoryValue.language_and_script_code":                              "@LanguageAndScriptCode",
+	"GenreCategoryValue.namespace":                                             "@Namespace",
+	"GenreCategoryValue.user_defined_value":                                    "@UserDefinedValue",
+	"GenreWithTerritory.applicable_territory_code":                             "@ApplicableTerritoryCode",
+	"GenreWithTerritory.genre_category":                                        "GenreCategory",
+	"GenreWithTerritory.genre_text":                                            "GenreText",
+	"GenreWithTerritory.is_default":                                            "@IsDefault",
+	"GenreWithTerritory.language_and_script_code":                              "@LanguageAndScriptCode",
+	"GenreWithTerritory.sub_genre":                                             "SubGenre",
+	"GenreWithTerritory.sub_genre_category":                                    "SubGenreCategory",
+	"HashSumAlgorithmType.namespace":                                           "@Namespace",
+	"HashSumAlgorithmType.user_defined_value":                                  "@UserDefinedValue",
+	"Image.additional_title":                                                   "AdditionalTitle",
+	"Image.c_line":                                                             "CLine",
+	"Image.contains_hidden_content":                                            "ContainsHiddenContent",
+	"Image.contributor":                                                        "Contributor",
+	"Image.courtesy_line":                                                      "CourtesyLine",
+	"Image.creation_date":                                                      "CreationDate",
+	"Image.description":                                                        "Description",
+	"Image.display_artist":                                                     "DisplayArtist",
+	"Image.display_artist_name":                                                "DisplayArtistName",
+	"Image.display_title":                                                      "DisplayTitle",
+	"Image.display_title_text":                                                 "DisplayTitleText",
+	"Image.first_publication_date":                                             "FirstPublicationDate",
+	"Image.is_supplemental":                                                    "@IsSupplemental",
+	"Image.language_and_script_code":                                           "@LanguageAndScriptCode",
+	"Image.parental_warning_type":                                              "ParentalWarningType",
+	"Image.related_release":                                                    "RelatedRelease",
+	"Image.related_resource":                                                   "RelatedResource",
+	"Image.resource_id":                                                        "ResourceId",
+	"Image.resource_reference":                                                 "ResourceReference",
+	"Image.resource_rights_controller":                                         "ResourceRightsController",
+	"Image.technical_details":                                                  "TechnicalDetails",
+	"Image.type":                                                               "Type",
+	"Image.version_type":                                                       "VersionType",
+	"Image.work_rights_controller":                                             "WorkRightsController",
+	"ImageCodecType.namespace":                                                 "@Namespace",
+	"ImageCodecType.user_defined_value":                                        "@UserDefinedValue",
+	"ImageCodecType.version":                                                   "@Version",
+	"ImageType.namespace":                                                      "@Namespace",
+	"ImageType.user_defined_value":                                             "@UserDefinedValue",
+	"InstrumentType.namespace":                                                 "@Namespace",
+	"InstrumentType.user_defined_value":                                        "@UserDefinedValue",
+	"IsCredited.may_be_shared":                                                 "@MayBeShared",
+	"KeywordsWithTerritory.applicable_territory_code":                          "@ApplicableTerritoryCode",
+	"KeywordsWithTerritory.is_default":                                         "@IsDefault",
+	"KeywordsWithTerritory.language_and_script_code":                           "@LanguageAndScriptCode",
+	"LinkedReleaseResourceReference.language_and_script_code":                  "@LanguageAndScriptCode",
+	"LinkedReleaseResourceReference.link_description":                          "@LinkDescription",
+	"LinkedReleaseResourceReference.namespace":                                 "@Namespace",
+	"LinkedReleaseResourceReference.user_defined_value":                        "@UserDefinedValue",
+	"LocationAndDateOfSession.comment":                                         "Comment",
+	"LocationAndDateOfSession.contributor":                                     "Contributor",
+	"LocationAndDateOfSession.period":                                          "Period",
+	"LocationAndDateOfSession.session_type":                                    "SessionType",
+	"LocationAndDateOfSession.venue":                                           "Venue",
+	"MarketingComment.applicable_territory_code":                               "@ApplicableTerritoryCode",
+	"MarketingComment.is_default":                                              "@IsDefault",
+	"MarketingComment.language_and_script_code":                                "@LanguageAndScriptCode",
+	"MessageAuditTrail.message_audit_trail_event":                              "MessageAuditTrailEvent",
+	"MessageAuditTrailEvent.date_time":                                         "DateTime",
+	"MessageAuditTrailEvent.messaging_party_descriptor":                        "MessagingPartyDescriptor",
+	"MessageHeader.message_audit_trail":                                        "MessageAuditTrail",
+	"MessageHeader.message_control_type":                                       "MessageControlType",
+	"MessageHeader.message_created_date_time":                                  "MessageCreatedDateTime",
+	"MessageHeader.message_file_name":                                          "MessageFileName",
+	"MessageHeader.message_id":                                                 "MessageId",
+	"MessageHeader.message_recipient":                                          "MessageRecipient",
+	"MessageHeader.message_sender":                                             "MessageSender",
+	"MessageHeader.message_thread_id":                                          "MessageThreadId",
+	"MessageHeader.sent_on_behalf_of":                                          "SentOnBehalfOf",
+	"MessagingPartyWithoutCode.party_id":                                       "PartyId",
+	"MessagingPartyWithoutCode.party_name":                                     "PartyName",
+	"MessagingPartyWithoutCode.trading_name":                                   "TradingName",
+	"MusicalWorkId.composer_catalog_number":                                    "ComposerCatalogNumber",
+	"MusicalWorkId.i_s_w_c":                                                    "ISWC",
+	"MusicalWorkId.is_replaced":                                                "@IsReplaced",
+	"MusicalWorkId.opus_number":                                                "OpusNumber",
+	"MusicalWorkId.proprietary_id":                                             "ProprietaryId",
+	"Name.language_and_script_code":                                            "@LanguageAndScriptCode",
+	"NewReleaseMessage.chapter_list":                                           "ChapterList",
+	"NewReleaseMessage.cue_sheet_list":                                         "CueSheetList",
+	"NewReleaseMessage.deal_list":                                              "DealList",
+	"NewReleaseMessage.language_and_script_code":                               "@LanguageAndScriptCode",
+	"NewReleaseMessage.message_header":                                         "MessageHeader",
+	"NewReleaseMessage.party_list":                                             "PartyList",
+	"NewReleaseMessage.release_admin":                                          "ReleaseAdmin",
+	"NewReleaseMessage.release_list":                                           "ReleaseList",
+	"NewReleaseMessage.release_profile_variant_version_id":                     "@ReleaseProfileVariantVersionId",
+	"NewReleaseMessage.release_profile_version_id":                             "@ReleaseProfileVersionId",
+	"NewReleaseMessage.resource_list":                                          "ResourceList",
+	"NewReleaseMessage.supplemental_document_list":                             "SupplementalDocumentList",
+	"OperatingSystemType.namespace":                                            "@Namespace",
+	"OperatingSystemType.user_defined_value":                                   "@UserDefinedValue",
+	"OperatingSystemType.version":                                              "@Version",
+	"PLine.language_and_script_code":                                           "@LanguageAndScriptCode",
+	"PLine.p_line_company":                                                     "PLineCompany",
+	"PLine.p_line_text":                                                        "PLineText",
+	"PLine.p_line_type":                                                        "@PLineType",
+	"PLine.year":                                                               "Year",
+	"PLineWithDefault.applicable_territory_code":                               "@ApplicableTerritoryCode",
+	"PLineWithDefault.is_default":                                              "@IsDefault",
+	"PLineWithDefault.language_and_script_code":                                "@LanguageAndScriptCode",
+	"PLineWithDefault.p_line_company":                                          "PLineCompany",
+	"PLineWithDefault.p_line_text":                                             "PLineText",
+	"PLineWithDefault.year":                                                    "Year",
+	"ParentalWarningTypeWithTerritory.applicable_territory_code":               "@ApplicableTerritoryCode",
+	"ParentalWarningTypeWithTerritory.is_default":                              "@IsDefault",
+	"ParentalWarningTypeWithTerritory.namespace":                               "@Namespace",
+	"ParentalWarningTypeWithTerritory.user_defined_value":                      "@UserDefinedValue",
+	"Party.affiliation":                                                        "Affiliation",
+	"Party.artist_profile_page":                                                "ArtistProfilePage",
+	"Party.party_id":                                                           "PartyId",
+	"Party.party_name":                                                         "PartyName",
+	"Party.party_reference":                                                    "PartyReference",
+	"Party.related_party":                                                      "RelatedParty",
+	"PartyList.party":                                                          "Party",
+	"PartyName.abbreviated_name":                                               "AbbreviatedName",
+	"PartyName.full_name":                                                      "FullName",
+	"PartyName.full_name_ascii_transcribed":                                    "FullNameAsciiTranscribed",
+	"PartyName.full_name_indexed":                                              "FullNameIndexed",
+	"PartyName.key_name":                                                       "KeyName",
+	"PartyName.language_and_script_code":                                       "@LanguageAndScriptCode",
+	"PartyName.names_after_key_name":                                           "NamesAfterKeyName",
+	"PartyName.names_before_key_name":                                          "NamesBeforeKeyName",
+	"PartyNameWithTerritory.abbreviated_name":                                  "AbbreviatedName",
+	"PartyNameWithTerritory.applicable_territory_code":                         "@ApplicableTerritoryCode",
+	"PartyNameWithTerritory.full_name":                                         "FullName",
+	"PartyNameWithTerritory.full_name_ascii_transcribed":                       "FullNameAsciiTranscribed",
+	"PartyNameWithTerritory.full_name_indexed":                                 "FullNameIndexed",
+	"PartyNameWithTerritory.is_default":                                        "@IsDefault",
+	"PartyNameWithTerritory.is_legal_name":                                     "@IsLegalName",
+	"PartyNameWithTerritory.is_nickname":                                       "@IsNickname",
+	"PartyNameWithTerritory.is_stage_name":                                     "@IsStageName",
+	"PartyNameWithTerritory.key_name":                                          "KeyName",
+	"PartyNameWithTerritory.language_and_script_code":                          "@LanguageAndScriptCode",
+	"PartyNameWithTerritory.names_after_key_name":                              "NamesAfterKeyName",
+	"PartyNameWithTerritory.names_before_key_name":                             "NamesBeforeKeyName",
+	"PartyNameWithoutCode.abbreviated_name":                                    "AbbreviatedName",
+	"PartyNameWithoutCode.full_name":                                           "FullName",
+	"PartyNameWithoutCode.full_name_ascii_transcribed":                         "FullNameAsciiTranscribed",
+	"PartyNameWithoutCode.full_name_indexed":                                   "FullNameIndexed",
+	"PartyNameWithoutCode.key_name":                                            "KeyName",
+	"PartyNameWithoutCode.names_after_key_name":                                "NamesAfterKeyName",
+	"PartyNameWithoutCode.names_before_key_name":                               "NamesBeforeKeyName",
+	"PartyRelationshipType.may_be_shared":                                      "@MayBeShared",
+	"PartyRelationshipType.namespace":                                          "@Namespace",
+	"PartyRelationshipType.user_defined_value":                                 "@UserDefinedValue",
+	"PartyWithRole.d_p_i_d":                                                    "DPID",
+	"PartyWithRole.i_p_n":                                                      "IPN",
+	"PartyWithRole.i_s_n_i":                                                    "ISNI",
+	"PartyWithRole.ipi_name_number":                                            "IpiNameNumber",
+	"PartyWithRole.party_name":                                                 "PartyName",
+	"PartyWithRole.proprietary_id":                                             "ProprietaryId",
+	"PartyWithRole.role":                                                       "Role",
+	"Percentage.has_max_value_of_one":                                          "@HasMaxValueOfOne",
+	"Period.end_date":                                                          "EndDate",
+	"Period.end_date_time":                                                     "EndDateTime",
+	"Period.start_date":                                                        "StartDate",
+	"Period.start_date_time":                                                   "StartDateTime",
+	"PeriodWithStartDate.end_date":                                             "EndDate",
+	"PeriodWithStartDate.end_date_time":                                        "EndDateTime",
+	"PeriodWithStartDate.start_date":                                           "StartDate",
+	"PeriodWithStartDate.start_date_time":                                      "StartDateTime",
+	"PeriodWithoutFlags.end_date":                                              "EndDate",
+	"PeriodWithoutFlags.end_date_time":                                         "EndDateTime",
+	"PeriodWithoutFlags.start_date":                                            "StartDate",
+	"PeriodWithoutFlags.start_date_time":                                       "StartDateTime",
+	"PhysicalReturns.latest_date_for_physical_returns":                         "LatestDateForPhysicalReturns",
+	"PhysicalReturns.physical_returns_allowed":                                 "PhysicalReturnsAllowed",
+	"Prefix.language_and_script_code":                                          "@LanguageAndScriptCode",
+	"PreviewDetails.bottom_right_corner":                                       "BottomRightCorner",
+	"PreviewDetails.expression_type":                                           "ExpressionType",
+	"PreviewDetails.top_left_corner":                                           "TopLeftCorner",
+	"Price.currency_code":                                                      "@CurrencyCode",
+	"PriceInformationWithType.bulk_order_wholesale_price_per_unit":             "BulkOrderWholesalePricePerUnit",
+	"PriceInformationWithType.namespace":                                       "@Namespace",
+	"PriceInformationWithType.price_code":                                      "PriceCode",
+	"PriceInformationWithType.price_type":                                      "@PriceType",
+	"PriceInformationWithType.suggested_retail_price":                          "SuggestedRetailPrice",
+	"PriceInformationWithType.user_defined_value":                              "@UserDefinedValue",
+	"PriceInformationWithType.wholesale_price_per_unit":                        "WholesalePricePerUnit",
+	"PriceType.namespace":                                                      "@Namespace",
+	"PromotionalCode.namespace":                                                "@Namespace",
+	"ProprietaryId.namespace":                                                  "@Namespace",
+	"PurgeReleaseMessage.language_and_script_code":                             "@LanguageAndScriptCode",
+	"PurgeReleaseMessage.message_header":                                       "MessageHeader",
+	"PurgeReleaseMessage.purged_release":                                       "PurgedRelease",
+	"PurgedRelease.contributor":                                                "Contributor",
+	"PurgedRelease.release_id":                                                 "ReleaseId",
+	"PurgedRelease.title":                                                      "Title",
+	"Purpose.namespace":                                                        "@Namespace",
+	"Purpose.user_defined_value":                                               "@UserDefinedValue",
+	"Raga.applicable_territory_code":                                           "@ApplicableTerritoryCode",
+	"Raga.is_default":                                                          "@IsDefault",
+	"RatingAgency.namespace":                                                   "@Namespace",
+	"RatingAgency.user_defined_value":                                          "@UserDefinedValue",
+	"RatingReason.namespace":                                                   "@Namespace",
+	"RatingReason.user_defined_value":                                          "@UserDefinedValue",
+	"Reason.language_and_script_code":                                          "@LanguageAndScriptCode",
+	"RelatedParty.party_related_party_reference":                               "PartyRelatedPartyReference",
+	"RelatedParty.party_relationship_type":                                     "PartyRelationshipType",
+	"RelatedRelease.additional_title":                                          "AdditionalTitle",
+	"RelatedRelease.display_artist_name":                                       "DisplayArtistName",
+	"RelatedRelease.display_title":                                             "DisplayTitle",
+	"RelatedRelease.display_title_text":                                        "DisplayTitleText",
+	"RelatedRelease.original_release_date":                                     "OriginalReleaseDate",
+	"RelatedRelease.release_date":                                              "ReleaseDate",
+	"RelatedRelease.release_id":                                                "ReleaseId",
+	"RelatedRelease.release_label_reference":                                   "ReleaseLabelReference",
+	"RelatedRelease.release_relationship_type":                                 "ReleaseRelationshipType",
+	"RelatedResource.resource_id":                                              "ResourceId",
+	"RelatedResource.resource_related_resource_reference":                      "ResourceRelatedResourceReference",
+	"RelatedResource.resource_relationship_type":                               "ResourceRelationshipType",
+	"RelatedResource.timing":                                                   "Timing",
+	"Release.additional_title":                                                 "AdditionalTitle",
+	"Release.administrating_record_company":                                    "AdministratingRecordCompany",
+	"Release.av_rating":                                                        "AvRating",
+	"Release.c_line":                                                           "CLine",
+	"Release.courtesy_line":                                                    "CourtesyLine",
+	"Release.deity":                                                            "Deity",
+	"Release.display_artist":                                                   "DisplayArtist",
+	"Release.display_artist_name":                                              "DisplayArtistName",
+	"Release.display_title":                                                    "DisplayTitle",
+	"Release.display_title_text":                                               "DisplayTitleText",
+	"Release.duration":                                                         "Duration",
+	"Release.external_resource_link":                                           "ExternalResourceLink",
+	"Release.genre":                                                            "Genre",
+	"Release.hi_res_music_description":                                         "HiResMusicDescription",
+	"Release.is_hi_res_music":                                                  "IsHiResMusic",
+	"Release.is_multi_artist_compilation":                                      "IsMultiArtistCompilation",
+	"Release.is_single_artist_compilation":                                     "IsSingleArtistCompilation",
+	"Release.is_soundtrack":                                                    "IsSoundtrack",
+	"Release.keywords":                                                         "Keywords",
+	"Release.language_and_script_code":                                         "@LanguageAndScriptCode",
+	"Release.marketing_comment":                                                "MarketingComment",
+	"Release.original_release_date":                                            "OriginalReleaseDate",
+	"Release.p_line":                                                           "PLine",
+	"Release.parental_warning_type":                                            "ParentalWarningType",
+	"Release.raga":                                                             "Raga",
+	"Release.related_release":                                                  "RelatedRelease",
+	"Release.related_resource":                                                 "RelatedResource",
+	"Release.release_date":                                                     "ReleaseDate",
+	"Release.release_id":                                                       "ReleaseId",
+	"Release.release_label_reference":                                          "ReleaseLabelReference",
+	"Release.release_reference":                                                "ReleaseReference",
+	"Release.release_type":                                                     "ReleaseType",
+	"Release.release_visibility_reference":                                     "ReleaseVisibilityReference",
+	"Release.resource_group":                                                   "ResourceGroup",
+	"Release.synopsis":                                                         "Synopsis",
+	"Release.tala":                                                             "Tala",
+	"Release.target_u_r_l":                                                     "TargetURL",
+	"ReleaseAdmin.personnel_description":                                       "PersonnelDescription",
+	"ReleaseAdmin.release_admin_id":                                            "ReleaseAdminId",
+	"ReleaseAdmin.system_description":                                          "SystemDescription",
+	"ReleaseDeal.deal":                                                         "Deal",
+	"ReleaseDeal.deal_release_reference":                                       "DealReleaseReference",
+	"ReleaseId.catalog_number":                                                 "CatalogNumber",
+	"ReleaseId.g_rid":                                                          "GRid",
+	"ReleaseId.i_c_p_n":                                                        "ICPN",
+	"ReleaseId.proprietary_id":                                                 "ProprietaryId",
+	"ReleaseLabelReference.applicable_territory_code":                          "@ApplicableTerritoryCode",
+	"ReleaseLabelReference.is_default":                                         "@IsDefault",
+	"ReleaseLabelReference.label_type":                                         "@LabelType",
+	"ReleaseLabelReference.language_and_script_code":                           "@LanguageAndScriptCode",
+	"ReleaseLabelReference.namespace":                                          "@Namespace",
+	"ReleaseLabelReference.user_defined_value":                                 "@UserDefinedValue",
+	"ReleaseLabelReferenceWithParty.access_control_party":                      "@AccessControlParty",
+	"ReleaseLabelReferenceWithParty.applicable_territory_code":                 "@ApplicableTerritoryCode",
+	"ReleaseLabelReferenceWithParty.is_default":                                "@IsDefault",
+	"ReleaseLabelReferenceWithParty.label_type":                                "@LabelType",
+	"ReleaseLabelReferenceWithParty.language_and_script_code":                  "@LanguageAndScriptCode",
+	"ReleaseLabelReferenceWithParty.namespace":                                 "@Namespace",
+	"ReleaseLabelReferenceWithParty.user_defined_value":                        "@UserDefinedValue",
+	"ReleaseList.release":                                                      "Release",
+	"ReleaseList.track_release":                                                "TrackRelease",
+	"ReleaseRelationshipType.namespace":                                        "@Namespace",
+	"ReleaseRelationshipType.user_defined_value":                               "@UserDefinedValue",
+	"ReleaseTypeForReleaseNotification.namespace":                              "@Namespace",
+	"ReleaseTypeForReleaseNotification.user_defined_value":                     "@UserDefinedValue",
+	"ReleaseVisibility.cover_art_preview_start_date_time":                      "CoverArtPreviewStartDateTime",
+	"ReleaseVisibility.full_track_listing_preview_start_date_time":             "FullTrackListingPreviewStartDateTime",
+	"ReleaseVisibility.release_display_start_date_time":                        "ReleaseDisplayStartDateTime",
+	"ReleaseVisibility.visibility_reference":                                   "VisibilityReference",
+	"ResourceContainedResourceReference.duration_used":                         "DurationUsed",
+	"ResourceContainedResourceReference.purpose":                               "Purpose",
+	"ResourceContainedResourceReference.resource_contained_resource_reference": "ResourceContainedResourceReference",
+	"ResourceContainedResourceReference.start_point":                           "StartPoint",
+	"ResourceContainedResourceReferenceList.resource_contained_resource_reference": "ResourceContainedResourceReference",
+	"ResourceContributorRole.namespace":                                            "@Namespace",
+	"ResourceContributorRole.user_defined_value":                                   "@UserDefinedValue",
+	"ResourceGroup.additional_title":                                               "AdditionalTitle",
+	"ResourceGroup.carrier_type":                                                   "CarrierType",
+	"ResourceGroup.display_artist":                                                 "DisplayArtist",
+	"ResourceGroup.display_sequence":                                               "DisplaySequence",
+	"ResourceGroup.display_title":                                                  "DisplayTitle",
+	"ResourceGroup.display_title_text":                                             "DisplayTitleText",
+	"ResourceGroup.duration":                                                       "Duration",
+	"ResourceGroup.linked_release_resource_reference":                              "LinkedReleaseResourceReference",
+	"ResourceGroup.no_display_sequence":                                            "NoDisplaySequence",
+	"ResourceGroup.release_id":                                                     "ReleaseId",
+	"ResourceGroup.resource_group":                                                 "ResourceGroup",
+	"ResourceGroup.resource_group_content_item":                                    "ResourceGroupContentItem",
+	"ResourceGroup.resource_group_release_reference":                               "ResourceGroupReleaseReference",
+	"ResourceGroup.sequence_number":                                                "SequenceNumber",
+	"ResourceGroupContentItem.display_sequence":                                    "DisplaySequence",
+	"ResourceGroupContentItem.is_bonus_resource":                                   "IsBonusResource",
+	"ResourceGroupContentItem.is_instant_gratification_resource":                   "IsInstantGratificationResource",
+	"ResourceGroupContentItem.is_pre_order_incentive_resource":                     "IsPreOrderIncentiveResource",
+	"ResourceGroupContentItem.linked_release_resource_reference":                   "LinkedReleaseResourceReference",
+	"ResourceGroupContentItem.no_display_sequence":                                 "NoDisplaySequence",
+	"ResourceGroupContentItem.release_resource_reference":                          "ReleaseResourceReference",
+	"ResourceGroupContentItem.sequence_number":                                     "SequenceNumber",
+	"ResourceId.catalog_number":                                                    "CatalogNumber",
+	"ResourceId.i_s_a_n":                                                           "ISAN",
+	"ResourceId.i_s_b_n":                                                           "ISBN",
+	"ResourceId.i_s_m_n":                                                           "ISMN",
+	"ResourceId.i_s_r_c":                                                           "ISRC",
+	"ResourceId.i_s_s_n":                                                           "ISSN",
+	"ResourceId.is_replaced":                                                       "@IsReplaced",
+	"ResourceId.proprietary_id":                                                    "ProprietaryId",
+	"ResourceId.s_i_c_i":                                                           "SICI",
+	"ResourceId.v_i_s_a_n":                                                         "VISAN",
+	"ResourceList.image":                                                           "Image",
+	"ResourceList.sheet_music":                                                     "SheetMusic",
+	"ResourceList.software":                                                        "Software",
+	"ResourceList.sound_recording":                                                 "SoundRecording",
+	"ResourceList.text":                                                            "Text",
+	"ResourceList.video":                                                           "Video",
+	"ResourceProprietaryId.is_replaced":                                            "@IsReplaced",
+	"ResourceProprietaryId.proprietary_id":                                         "ProprietaryId",
+	"ResourceRightsController.delegated_usage_rights":                              "DelegatedUsageRights",
+	"ResourceRightsController.right_share_percentage":                              "RightSharePercentage",
+	"ResourceRightsController.right_share_unknown":                                 "RightShareUnknown",
+	"ResourceRightsController.rights_control_type":                                 "RightsControlType",
+	"ResourceRightsController.rights_controller_party_reference":                   "RightsControllerPartyReference",
+	"ResourceRightsController.sequence_number":                                     "@SequenceNumber",
+	"ResourceSubGroup.additional_title":                                            "AdditionalTitle",
+	"ResourceSubGroup.carrier_type":                                                "CarrierType",
+	"ResourceSubGroup.display_artist":                                              "DisplayArtist",
+	"ResourceSubGroup.display_sequence":                                            "DisplaySequence",
+	"ResourceSubGroup.display_title":                                               "DisplayTitle",
+	"ResourceSubGroup.display_title_text":                                          "DisplayTitleText",
+	"ResourceSubGroup.duration":                                                    "Duration",
+	"ResourceSubGroup.linked_release_resource_reference":                           "LinkedReleaseResourceReference",
+	"ResourceSubGroup.no_display_sequence":                                         "NoDisplaySequence",
+	"ResourceSubGroup.release_id":                                                  "ReleaseId",
+	"ResourceSubGroup.resource_group":                                              "ResourceGroup",
+	"ResourceSubGroup.resource_group_content_item":                                 "ResourceGroupContentItem",
+	"ResourceSubGroup.resource_group_release_reference":                            "ResourceGroupReleaseReference",
+	"ResourceSubGroup.resource_group_type":                                         "@ResourceGroupType",
+	"ResourceSubGroup.sequence_number":                                             "SequenceNumber",
+	"RightsClaimPolicy.condition":                                                  "Condition",
+	"RightsClaimPolicy.rights_claim_policy_type":                                   "RightsClaimPolicyType",
+	"SamplingRate.unit_of_measure":                                                 "@UnitOfMeasure",
+	"SessionType.namespace":                                                        "@Namespace",
+	"SessionType.user_defined_value":                                               "@UserDefinedValue",
+	"SheetMusic.additional_title":                                                  "AdditionalTitle",
+	"SheetMusic.c_line":                                                            "CLine",
+	"SheetMusic.contains_hidden_content":                                           "ContainsHiddenContent",
+	"SheetMusic.contributor":                                                       "Contributor",
+	"SheetMusic.courtesy_line":                                                     "CourtesyLine",
+	"SheetMusic.creation_date":                                                     "CreationDate",
+	"SheetMusic.display_artist":                                                    "DisplayArtist",
+	"SheetMusic.display_artist_name":                                               "DisplayArtistName",
+	"SheetMusic.display_title":                                                     "DisplayTitle",
+	"SheetMusic.display_title_text":                                                "DisplayTitleText",
+	"SheetMusic.first_publication_date":                                            "FirstPublicationDate",
+	"SheetMusic.is_supplemental":                                                   "@IsSupplemental",
+	"SheetMusic.language_and_script_code":                                          "@LanguageAndScriptCode",
+	"SheetMusic.language_of_lyrics":                                                "LanguageOfLyrics",
+	"SheetMusic.parental_warning_type":                                             "ParentalWarningType",
+	"SheetMusic.related_release":                                                   "RelatedRelease",
+	"SheetMusic.related_resource":                                                  "RelatedResource",
+	"SheetMusic.resource_contained_resource_reference_list":                        "ResourceContainedResourceReferenceList",
+	"SheetMusic.resource_id":                                                       "ResourceId",
+	"SheetMusic.resource_reference":                                                "ResourceReference",
+	"SheetMusic.resource_rights_controller":                                        "ResourceRightsController",
+	"SheetMusic.technical_details":                                                 "TechnicalDetails",
+	"SheetMusic.type":                                                              "Type",
+	"SheetMusic.version_type":                                                      "VersionType",
+	"SheetMusic.work_id":                                                           "WorkId",
+	"SheetMusic.work_rights_controller":                                            "WorkRightsController",
+	"SheetMusicCodecType.namespace":                                                "@Namespace",
+	"SheetMusicCodecType.user_defined_value":                                       "@UserDefinedValue",
+	"SheetMusicCodecType.version":                                                  "@Version",
+	"SheetMusicId.i_s_m_n":                                                         "ISMN",
+	"SheetMusicId.is_replaced":                                                     "@IsReplaced",
+	"SheetMusicId.proprietary_id":                                                  "ProprietaryId",
+	"SheetMusicType.namespace":                                                     "@Namespace",
+	"SheetMusicType.user_defined_value":                                            "@UserDefinedValue",
+	"SimpleRightsType.namespace":                                                   "@Namespace",
+	"SimpleRightsType.user_defined_value":                                          "@UserDefinedValue",
+	"Software.additional_title":                                                    "AdditionalTitle",
+	"Software.c_line":                                                              "CLine",
+	"Software.contains_hidden_content":                                             "ContainsHiddenContent",
+	"Software.contributor":                                                         "Contributor",
+	"Software.courtesy_line":                                                       "CourtesyLine",
+	"Software.creation_date":                                                       "CreationDate",
+	"Software.display_artist":                                                      "DisplayArtist",
+	"Software.display_artist_name":                                                 "DisplayArtistName",
+	"Software.display_title":                                                       "DisplayTitle",
+	"Software.display_title_text":                                                  "DisplayTitleText",
+	"Software.first_publication_date":                                              "FirstPublicationDate",
+	"Software.is_supplemental":                                                     "@IsSupplemental",
+	"Software.language_and_script_code":                                            "@LanguageAndScriptCode",
+	"Software.p_line":                                                              "PLine",
+	"Software.parental_warning_type":                                               "ParentalWarningType",
+	"Software.related_release":                                                     "RelatedRelease",
+	"Software.related_resource":                                                    "RelatedResource",
+	"Software.resource_contained_resource_reference_list":                          "ResourceContainedResourceReferenceList",
+	"Software.resource_id":                                                         "ResourceId",
+	"Software.resource_reference":                                                  "ResourceReference",
+	"Software.resource_rights_controller":                                          "ResourceRightsController",
+	"Software.technical_details":                                                   "TechnicalDetails",
+	"Software.type":                                                                "Type",
+	"Software.version_type":                                                        "VersionType",
+	"Software.work_id":                                                             "WorkId",
+	"Software.work_rights_controller":                                              "WorkRightsController",
+	"SoftwareType.namespace":                                                       "@Namespace",
+	"SoftwareType.user_defined_value":                                              "@UserDefinedValue",
+	"SoundRecording.additional_title":                                              "AdditionalTitle",
+	"SoundRecording.audio_channel_configuration":                                   "AudioChannelConfiguration",
+	"SoundRecording.audio_chapter_reference":                                       "AudioChapterReference",
+	"SoundRecording.character":                                                     "Character",
+	"SoundRecording.composite_musical_work_type":                                   "CompositeMusicalWorkType",
+	"SoundRecording.contains_hidden_content":                                       "ContainsHiddenContent",
+	"SoundRecording.contributor":                                                   "Contributor",
+	"SoundRecording.courtesy_line":                                                 "CourtesyLine",
+	"SoundRecording.creation_date":                                                 "CreationDate",
+	"SoundRecording.deity":                                                         "Deity",
+	"SoundRecording.disable_crossfade":                                             "DisableCrossfade",
+	"SoundRecording.disable_search":                                                "DisableSearch",
+	"SoundRecording.display_artist":                                                "DisplayArtist",
+	"SoundRecording.display_artist_name":                                           "DisplayArtistName",
+	"SoundRecording.display_credits":                                               "DisplayCredits",
+	"SoundRecording.display_title":                                                 "DisplayTitle",
+	"SoundRecording.display_title_text":                                            "DisplayTitleText",
+	"SoundRecording.duration":                                                      "Duration",
+	"SoundRecording.first_publication_date":                                        "FirstPublicationDate",
+	"SoundRecording.is_cover":                                                      "IsCover",
+	"SoundRecording.is_hi_res_music":                                               "IsHiResMusic",
+	"SoundRecording.is_instrumental":                                               "IsInstrumental",
+	"SoundRecording.is_remastered":                                                 "IsRemastered",
+	"SoundRecording.is_supplemental":                                               "@IsSupplemental",
+	"SoundRecording.language_and_script_code":                                      "@LanguageAndScriptCode",
+	"SoundRecording.language_of_performance":                                       "LanguageOfPerformance",
+	"SoundRecording.location_and_date_of_session":                                  "LocationAndDateOfSession",
+	"SoundRecording.mastered_date":                                                 "MasteredDate",
+	"SoundRecording.p_line":                                                        "PLine",
+	"SoundRecording.parental_warning_type":                                         "ParentalWarningType",
+	"SoundRecording.raga":                                                          "Raga",
+	"SoundRecording.related_release":                                               "RelatedRelease",
+	"SoundRecording.related_resource":                                              "RelatedResource",
+	"SoundRecording.remastered_date":                                               "RemasteredDate",
+	"SoundRecording.resource_id":                                                   "ResourceId",
+	"SoundRecording.resource_reference":                                            "ResourceReference",
+	"SoundRecording.resource_rights_controller":                                    "ResourceRightsController",
+	"SoundRecording.tala":                                                          "Tala",
+	"SoundRecording.technical_details":                                             "TechnicalDetails",
+	"SoundRecording.type":                                                          "Type",
+	"SoundRecording.version_type":                                                  "VersionType",
+	"SoundRecording.work_id":                                                       "WorkId",
+	"SoundRecording.work_rights_controller":                                        "WorkRightsController",
+	"SoundRecordingId.catalog_number":                                              "CatalogNumber",
+	"SoundRecordingId.i_s_r_c":                                                     "ISRC",
+	"SoundRecordingId.is_replaced":                                                 "@IsReplaced",
+	"SoundRecordingId.proprietary_id":                                              "ProprietaryId",
+	"SoundRecordingPreviewDetails.bottom_right_corner":                             "BottomRightCorner",
+	"SoundRecordingPreviewDetails.duration":                                        "Duration",
+	"SoundRecordingPreviewDetails.end_point":                                       "EndPoint",
+	"SoundRecordingPreviewDetails.expression_type":                                 "ExpressionType",
+	"SoundRecordingPreviewDetails.start_point":                                     "StartPoint",
+	"SoundRecordingPreviewDetails.top_left_corner":                                 "TopLeftCorner",
+	"SoundRecordingType.namespace":                                                 "@Namespace",
+	"SoundRecordingType.user_defined_value":                                        "@UserDefinedValue",
+	"SubGenreCategory.value":                                                       "Value",
+	"SubGenreCategoryValue.namespace":                                              "@Namespace",
+	"SubGenreCategoryValue.user_defined_value":                                     "@UserDefinedValue",
+	"SupplementalDocumentList.supplemental_document":                               "SupplementalDocument",
+	"SynopsisWithTerritory.applicable_territory_code":                              "@ApplicableTerritoryCode",
+	"SynopsisWithTerritory.is_default":                                             "@IsDefault",
+	"SynopsisWithTerritory.is_short_synopsis":                                      "@IsShortSynopsis",
+	"SynopsisWithTerritory.language_and_script_code":                               "@LanguageAndScriptCode",
+	"Tala.applicable_territory_code":                                               "@ApplicableTerritoryCode",
+	"Tala.is_default":                                                              "@IsDefault",
+	"TechnicalImageDetails.applicable_territory_code":                              "@ApplicableTerritoryCode",
+	"TechnicalImageDetails.aspect_ratio":                                           "AspectRatio",
+	"TechnicalImageDetails.bit_depth":                                              "BitDepth",
+	"TechnicalImageDetails.color_depth":                                            "ColorDepth",
+	"TechnicalImageDetails.file":                                                   "File",
+	"TechnicalImageDetails.fingerprint":                                            "Fingerprint",
+	"TechnicalImageDetails.image_codec_type":                                       "ImageCodecType",
+	"TechnicalImageDetails.image_height":                                           "ImageHeight",
+	"TechnicalImageDetails.image_resolution":                                       "ImageResolution",
+	"TechnicalImageDetails.image_width":                                            "ImageWidth",
+	"TechnicalImageDetails.is_default":                                             "@IsDefault",
+	"TechnicalImageDetails.is_preview":                                             "IsPreview",
+	"TechnicalImageDetails.is_provided_in_delivery":                                "IsProvidedInDelivery",
+	"TechnicalImageDetails.language_and_script_code":                               "@LanguageAndScriptCode",
+	"TechnicalImageDetails.preview_details":                                        "PreviewDetails",
+	"TechnicalImageDetails.technical_resource_details_reference":                   "TechnicalResourceDetailsReference",
+	"TechnicalSheetMusicDetails.applicable_territory_code":                         "@ApplicableTerritoryCode",
+	"TechnicalSheetMusicDetails.bit_depth":                                         "BitDepth",
+	"TechnicalSheetMusicDetails.file":                                              "File",
+	"TechnicalSheetMusicDetails.fingerprint":                                       "Fingerprint",
+	"TechnicalSheetMusicDetails.is_default":                                        "@IsDefault",
+	"TechnicalSheetMusicDetails.is_preview":                                        "IsPreview",
+	"TechnicalSheetMusicDetails.is_provided_in_delivery":                           "IsProvidedInDelivery",
+	"TechnicalSheetMusicDetails.language_and_script_code":                          "@LanguageAndScriptCode",
+	"TechnicalSheetMusicDetails.preview_details":                                   "PreviewDetails",
+	"TechnicalSheetMusicDetails.sheet_music_codec_type":                            "SheetMusicCodecType",
+	"TechnicalSheetMusicDetails.technical_resource_details_reference":              "TechnicalResourceDetailsReference",
+	"TechnicalSoftwareDetails.applicable_territory_code":                           "@ApplicableTerritoryCode",
+	"TechnicalSoftwareDetails.bit_depth":                                           "BitDepth",
+	"TechnicalSoftwareDetails.file":                                                "File",
+	"TechnicalSoftwareDetails.fingerprint":                                         "Fingerprint",
+	"TechnicalSoftwareDetails.is_default":                                          "@IsDefault",
+	"TechnicalSoftwareDetails.is_preview":                                          "IsPreview",
+	"TechnicalSoftwareDetails.is_provided_in_delivery":                             "IsProvidedInDelivery",
+	"TechnicalSoftwareDetails.language_and_script_code":                            "@LanguageAndScriptCode",
+	"TechnicalSoftwareDetails.operating_system_type":                               "OperatingSystemType",
+	"TechnicalSoftwareDetails.preview_details":                                     "PreviewDetails",
+	"TechnicalSoftwareDetails.technical_resource_details_reference":                "TechnicalResourceDetailsReference",
+	"TechnicalSoundRecordingDetails.applicable_territory_code":                     "@ApplicableTerritoryCode",
+	"TechnicalSoundRecordingDetails.audio_codec_type":                              "AudioCodecType",
+	"TechnicalSoundRecordingDetails.bit_depth":                                     "BitDepth",
+	"TechnicalSoundRecordingDetails.bit_rate":                                      "BitRate",
+	"TechnicalSoundRecordingDetails.bits_per_sample":                               "BitsPerSample",
+	"TechnicalSoundRecordingDetails.duration":                                      "Duration",
+	"TechnicalSoundRecordingDetails.encoding_description":                          "EncodingDescription",
+	"TechnicalSoundRecordingDetails.encoding_id":                                   "EncodingId",
+	"TechnicalSoundRecordingDetails.file":                                          "File",
+	"TechnicalSoundRecordingDetails.fingerprint":                                   "Fingerprint",
+	"TechnicalSoundRecordingDetails.is_default":                                    "@IsDefault",
+	"TechnicalSoundRecordingDetails.is_preview":                                    "IsPreview",
+	"TechnicalSoundRecordingDetails.is_provided_in_delivery":                       "IsProvidedInDelivery",
+	"TechnicalSoundRecordingDetails.language_and_script_code":                      "@LanguageAndScriptCode",
+	"TechnicalSoundRecordingDetails.number_of_channels":                            "NumberOfChannels",
+	"TechnicalSoundRecordingDetails.original_bit_rate":                             "OriginalBitRate",
+	"TechnicalSoundRecordingDetails.original_sampling_rate":                        "OriginalSamplingRate",
+	"TechnicalSoundRecordingDetails.preview_details":                               "PreviewDetails",
+	"TechnicalSoundRecordingDetails.sampling_rate":                                 "SamplingRate",
+	"TechnicalSoundRecordingDetails.technical_resource_details_reference":          "TechnicalResourceDetailsReference",
+	"TechnicalTextDetails.applicable_territory_code":                               "@ApplicableTerritoryCode",
+	"TechnicalTextDetails.bit_depth":                                               "BitDepth",
+	"TechnicalTextDetails.file":                                                    "File",
+	"TechnicalTextDetails.fingerprint":                                             "Fingerprint",
+	"TechnicalTextDetails.is_default":                                              "@IsDefault",
+	"TechnicalTextDetails.is_preview":                                              "IsPreview",
+	"TechnicalTextDetails.is_provided_in_delivery":                                 "IsProvidedInDelivery",
+	"TechnicalTextDetails.language_and_script_code":                                "@LanguageAndScriptCode",
+	"TechnicalTextDetails.preview_details":                                         "PreviewDetails",
+	"TechnicalTextDetails.technical_resource_details_reference":                    "TechnicalResourceDetailsReference",
+	"TechnicalTextDetails.text_codec_type":                                         "TextCodecType",
+	"TechnicalVideoDetails.applicable_territory_code":                              "@ApplicableTerritoryCode",
+	"TechnicalVideoDetails.aspect_ratio":                                           "AspectRatio",
+	"TechnicalVideoDetails.audio_bit_rate":                                         "AudioBitRate",
+	"TechnicalVideoDetails.audio_bits_per_sample":                                  "AudioBitsPerSample",
+	"TechnicalVideoDetails.audio_codec_type":                                       "AudioCodecType",
+	"TechnicalVideoDetails.audio_sampling_rate":                                    "AudioSamplingRate",
+	"TechnicalVideoDetails.bit_depth":                                              "BitDepth",
+	"TechnicalVideoDetails.color_depth":                                            "ColorDepth",
+	"TechnicalVideoDetails.container_format":                                       "ContainerFormat",
+	"TechnicalVideoDetails.core_area":                                              "CoreArea",
+	"TechnicalVideoDetails.duration":                                               "Duration",
+	"TechnicalVideoDetails.encoding_description":                                   "EncodingDescription",
+	"TechnicalVideoDetails.encoding_id":                                            "EncodingId",
+	"TechnicalVideoDetails.file":                                                   "File",
+	"TechnicalVideoDetails.fingerprint":                                            "Fingerprint",
+	"TechnicalVideoDetails.frame_rate":                                             "FrameRate",
+	"TechnicalVideoDetails.image_height":                                           "ImageHeight",
+	"TechnicalVideoDetails.image_width":                                            "ImageWidth",
+	"TechnicalVideoDetails.is_default":                                             "@IsDefault",
+	"TechnicalVideoDetails.is_preview":                                             "IsPreview",
+	"TechnicalVideoDetails.is_provided_in_delivery":                                "IsProvidedInDelivery",
+	"TechnicalVideoDetails.language_and_script_code":                               "@LanguageAndScriptCode",
+	"TechnicalVideoDetails.number_of_audio_channels":                               "NumberOfAudioChannels",
+	"TechnicalVideoDetails.overall_bit_rate":                                       "OverallBitRate",
+	"TechnicalVideoDetails.preview_details":                                        "PreviewDetails",
+	"TechnicalVideoDetails.technical_resource_details_reference":                   "TechnicalResourceDetailsReference",
+	"TechnicalVideoDetails.video_bit_rate":                                         "VideoBitRate",
+	"TechnicalVideoDetails.video_codec_type":                                       "VideoCodecType",
+	"TechnicalVideoDetails.video_definition_type":                                  "VideoDefinitionType",
+	"Text.additional_title":                                                        "AdditionalTitle",
+	"Text.c_line":                                                                  "CLine",
+	"Text.contains_hidden_content":                                                 "ContainsHiddenContent",
+	"Text.contributor":                                                             "Contributor",
+	"Text.courtesy_line":                                                           "CourtesyLine",
+	"Text.creation_date":                                                           "CreationDate",
+	"Text.display_artist":                                                          "DisplayArtist",
+	"Text.display_artist_name":                                                     "DisplayArtistName",
+	"Text.display_title":                                                           "DisplayTitle",
+	"Text.display_title_text":                                                      "DisplayTitleText",
+	"Text.first_publication_date":                                                  "FirstPublicationDate",
+	"Text.is_supplemental":                                                         "@IsSupplemental",
+	"Text.language_and_script_code":                                                "@LanguageAndScriptCode",
+	"Text.parental_warning_type":                                                   "ParentalWarningType",
+	"Text.related_release":                                                         "RelatedRelease",
+	"Text.related_resource":                                                        "RelatedResource",
+	"Text.resource_contained_resource_reference_list":                              "ResourceContainedResourceReferenceList",
+	"Text.resource_id":                                                             "ResourceId",
+	"Text.resource_reference":                                                      "ResourceReference",
+	"Text.resource_rights_controller":                                              "ResourceRightsController",
+	"Text.technical_details":                                                       "TechnicalDetails",
+	"Text.type":                                                                    "Type",
+	"Text.version_type":                                                            "VersionType",
+	"Text.work_id":                                                                 "WorkId",
+	"Text.work_rights_controller":                                                  "WorkRightsController",
+	"TextCodecType.namespace":                                                      "@Namespace",
+	"TextCodecType.user_defined_value":                                             "@UserDefinedValue",
+	"TextCodecType.version":                                                        "@Version",
+	"TextId.i_s_b_n":                                                               "ISBN",
+	"TextId.i_s_s_n":                                                               "ISSN",
+	"TextId.is_replaced":                                                           "@IsReplaced",
+	"TextId.proprietary_id":                                                        "ProprietaryId",
+	"TextId.s_i_c_i":                                                               "SICI",
+	"TextType.namespace":                                                           "@Namespace",
+	"TextType.user_defined_value":                                                  "@UserDefinedValue",
+	"TextWithFormat.applicable_territory_code":                                     "@ApplicableTerritoryCode",
+	"TextWithFormat.format":                                                        "@Format",
+	"TextWithFormat.is_default":                                                    "@IsDefault",
+	"TextWithFormat.language_and_script_code":                                      "@LanguageAndScriptCode",
+	"TextWithFormat.namespace":                                                     "@Namespace",
+	"TextWithFormat.user_defined_value":                                            "@UserDefinedValue",
+	"TextWithoutTerritory.applicable_territory_code":                               "@ApplicableTerritoryCode",
+	"TextWithoutTerritory.format":                                                  "@Format",
+	"TextWithoutTerritory.is_default":                                              "@IsDefault",
+	"TextWithoutTerritory.language_and_script_code":                                "@LanguageAndScriptCode",
+	"TextWithoutTerritory.namespace":                                               "@Namespace",
+	"TextWithoutTerritory.user_defined_value":                                      "@UserDefinedValue",
+	"Timing.duration_used":                                                         "DurationUsed",
+	"Timing.start_point":                                                           "StartPoint",
+	"Title.language_and_script_code":                                               "@LanguageAndScriptCode",
+	"Title.sub_title":                                                              "SubTitle",
+	"Title.title_text":                                                             "TitleText",
+	"Title.title_type":                                                             "@TitleType",
+	"TitleDisplayInformation.is_displayed_in_title":                                "IsDisplayedInTitle",
+	"TitleDisplayInformation.language_and_script_code":                             "@LanguageAndScriptCode",
+	"TitleDisplayInformation.prefix":                                               "Prefix",
+	"TitleDisplayInformation.sequence_number":                                      "@SequenceNumber",
+	"TrackRelease.additional_title":                                                "AdditionalTitle",
+	"TrackRelease.display_title":                                                   "DisplayTitle",
+	"TrackRelease.display_title_text":                                              "DisplayTitleText",
+	"TrackRelease.genre":                                                           "Genre",
+	"TrackRelease.is_main_release":                                                 "@IsMainRelease",
+	"TrackRelease.keywords":                                                        "Keywords",
+	"TrackRelease.linked_release_resource_reference":                               "LinkedReleaseResourceReference",
+	"TrackRelease.marketing_comment":                                               "MarketingComment",
+	"TrackRelease.related_release":                                                 "RelatedRelease",
+	"TrackRelease.related_resource":                                                "RelatedResource",
+	"TrackRelease.release_id":                                                      "ReleaseId",
+	"TrackRelease.release_label_reference":                                         "ReleaseLabelReference",
+	"TrackRelease.release_reference":                                               "ReleaseReference",
+	"TrackRelease.release_resource_reference":                                      "ReleaseResourceReference",
+	"TrackRelease.release_visibility_reference":                                    "ReleaseVisibilityReference",
+	"TrackRelease.synopsis":                                                        "Synopsis",
+	"TrackRelease.target_u_r_l":                                                    "TargetURL",
+	"TrackReleaseVisibility.clip_preview_start_date_time":                          "ClipPreviewStartDateTime",
+	"TrackReleaseVisibility.track_listing_preview_start_date_time":                 "TrackListingPreviewStartDateTime",
+	"TrackReleaseVisibility.visibility_reference":                                  "VisibilityReference",
+	"UseType.namespace":                                                            "@Namespace",
+	"UseType.user_defined_value":                                                   "@UserDefinedValue",
+	"UserInterfaceType.namespace":                                                  "@Namespace",
+	"UserInterfaceType.user_defined_value":                                         "@UserDefinedValue",
+	"ValidityPeriod.end_date":                                                      "EndDate",
+	"ValidityPeriod.start_date":                                                    "StartDate",
+	"Venue.location_code":                                                          "LocationCode",
+	"Venue.territory_code":                                                         "TerritoryCode",
+	"Venue.venue_address":                                                          "VenueAddress",
+	"Venue.venue_name":                                                             "VenueName",
+	"Venue.venue_room":                                                             "VenueRoom",
+	"VersionType.namespace":                                                        "@Namespace",
+	"VersionType.user_defined_value":                                               "@UserDefinedValue",
+	"Video.additional_title":                                                       "AdditionalTitle",
+	"Video.av_rating":                                                              "AvRating",
+	"Video.c_line":                                                                 "CLine",
+	"Video.character":                                                              "Character",
+	"Video.composite_musical_work_type":                                            "CompositeMusicalWorkType",
+	"Video.contains_hidden_content":                                                "ContainsHiddenContent",
+	"Video.contributor":                                                            "Contributor",
+	"Video.courtesy_line":                                                          "CourtesyLine",
+	"Video.creation_date":                                                          "CreationDate",
+	"Video.deity":                                                                  "Deity",
+	"Video.display_artist":                                                         "DisplayArtist",
+	"Video.display_artist_name":                                                    "DisplayArtistName",
+	"Video.display_credits":                                                        "DisplayCredits",
+	"Video.display_title":                                                          "DisplayTitle",
+	"Video.display_title_text":                                                     "DisplayTitleText",
+	"Video.duration":                                                               "Duration",
+	"Video.first_publication_date":                                                 "FirstPublicationDate",
+	"Video.is_cover":                                                               "IsCover",
+	"Video.is_instrumental":                                                        "IsInstrumental",
+	"Video.is_remastered":                                                          "IsRemastered",
+	"Video.is_supplemental":                                                        "@IsSupplemental",
+	"Video.language_and_script_code":                                               "@LanguageAndScriptCode",
+	"Video.language_of_dubbing":                                                    "LanguageOfDubbing",
+	"Video.language_of_performance":                                                "LanguageOfPerformance",
+	"Video.mastered_date":                                                          "MasteredDate",
+	"Video.p_line":                                                                 "PLine",
+	"Video.parental_warning_type":                                                  "ParentalWarningType",
+	"Video.raga":                                                                   "Raga",
+	"Video.reason_for_cue_sheet_absence":                                           "ReasonForCueSheetAbsence",
+	"Video.related_release":                                                        "RelatedRelease",
+	"Video.related_resource":                                                       "RelatedResource",
+	"Video.remastered_date":                                                        "RemasteredDate",
+	"Video.resource_contained_resource_reference_list":                             "ResourceContainedResourceReferenceList",
+	"Video.resource_id":                                                            "ResourceId",
+	"Video.resource_reference":                                                     "ResourceReference",
+	"Video.resource_rights_controller":                                             "ResourceRightsController",
+	"Video.sub_title_language":                                                     "SubTitleLanguage",
+	"Video.tala":                                                                   "Tala",
+	"Video.technical_details":                                                      "TechnicalDetails",
+	"Video.type":                                                                   "Type",
+	"Video.version_type":                                                           "VersionType",
+	"Video.video_chapter_reference":                                                "VideoChapterReference",
+	"Video.video_cue_sheet_reference":                                              "VideoCueSheetReference",
+	"Video.work_id":                                                                "WorkId",
+	"Video.work_rights_controller":                                                 "WorkRightsController",
+	"VideoCodecType.namespace":                                                     "@Namespace",
+	"VideoCodecType.user_defined_value":                                            "@UserDefinedValue",
+	"VideoCodecType.version":                                                       "@Version",
+	"VideoDefinitionType.namespace":                                                "@Namespace",
+	"VideoDefinitionType.user_defined_value":                                       "@UserDefinedValue",
+	"VideoId.catalog_number":                                                       "CatalogNumber",
+	"VideoId.e_i_d_r":                                                              "EIDR",
+	"VideoId.i_s_a_n":                                                              "ISAN",
+	"VideoId.i_s_r_c":                                                              "ISRC",
+	"VideoId.is_replaced":                                                          "@IsReplaced",
+	"VideoId.proprietary_id":                                                       "ProprietaryId",
+	"VideoId.v_i_s_a_n":                                                            "VISAN",
+	"VideoType.namespace":                                                          "@Namespace",
+	"VideoType.user_defined_value":                                                 "@UserDefinedValue",
+	"WorkRightsController.end_date":                                                "EndDate",
+	"WorkRightsController.right_share_percentage":                                  "RightSharePercentage",
+	"WorkRightsController.right_share_unknown":                                     "RightShareUnknown",
+	"WorkRightsController.rights_control_type":                                     "RightsControlType",
+	"WorkRightsController.rights_controller_party_reference":                       "RightsControllerPartyReference",
+	"WorkRightsController.rights_controller_type":                                  "RightsControllerType",
+	"WorkRightsController.start_date":                                              "StartDate",
+	"WorkRightsController.territory":                                               "Territory",
+}
+
+// AddDisplayArtist adds a DisplayArtist with DisplayArtistRole role to the Release, referencing the Party
+// whose FullName is name. The Party is added to the PartyList, with the first free P<n> PartyReference,
+// unless one has that name. The Release's DisplayArtistName is left as is.
+func (m *NewReleaseMessage) AddDisplayArtist(name, role string) {
+	if m.PartyList == nil {
+		m.PartyList = &PartyList{}
+	}
+	if m.ReleaseList == nil {
+		m.ReleaseList = &ReleaseList{}
+	}
+	if m.ReleaseList.Release == nil {
+		m.ReleaseList.Release = &Release{}
+	}
+
+	reference := ""
+	taken := make(map[string]bool)
+	for _, party := range m.PartyList.Party {
+		taken[party.GetPartyReference()] = true
+		for _, partyName := range party.GetPartyName() {
+			if reference == "" && partyName.GetFullName().GetValue() == name {
+				reference = party.GetPartyReference()
+			}
+		}
+	}
+	for n := 1; reference == ""; n++ {
+		if candidate := fmt.Sprintf("P%d", n); !taken[candidate] {
+			reference = candidate
+			m.PartyList.Party = append(m.PartyList.Party, &Party{
+				PartyReference: reference,
+				PartyName:      []*PartyNameWithTerritory{{FullName: &Name{Value: name}}},
+			})
+		}
+	}
+
+	release := m.ReleaseList.Release
+	release.DisplayArtist = append(release.DisplayArtist, &DisplayArtist{
+		ArtistPartyReference: reference,
+		DisplayArtistRole:    &DisplayArtistRole{Value: role},
+		SequenceNumber:       int32(len(release.DisplayArtist) + 1),
+	})
+}
@@ -0,0 +1,89 @@
+// Code generated by generate-go-extensions. DO NOT EDIT.
+
+package ernv432
+
+import "iter"
+
+// Resource is implemented by the resource types of the package, which share a ResourceReference and a
+// resource type: Image, SheetMusic, Software, SoundRecording, Text, Video
+type Resource interface {
+	GetResourceReference() string
+	// ResourceType returns the Value of the resource's type element, e.g. MusicalWorkSoundRecording
+	ResourceType() string
+}
+
+var (
+	_ Resource = (*Image)(nil)
+	_ Resource = (*SheetMusic)(nil)
+	_ Resource = (*Software)(nil)
+	_ Resource = (*SoundRecording)(nil)
+	_ Resource = (*Text)(nil)
+	_ Resource = (*Video)(nil)
+)
+
+// ResourceType returns the Value of Type
+func (x *Image) ResourceType() string {
+	return x.GetType().GetValue()
+}
+
+// ResourceType returns the Value of Type
+func (x *SheetMusic) ResourceType() string {
+	return x.GetType().GetValue()
+}
+
+// ResourceType returns the Value of Type
+func (x *Software) ResourceType() string {
+	return x.GetType().GetValue()
+}
+
+// ResourceType returns the Value of Type
+func (x *SoundRecording) ResourceType() string {
+	return x.GetType().GetValue()
+}
+
+// ResourceType returns the Value of Type
+func (x *Text) ResourceType() string {
+	return x.GetType().GetValue()
+}
+
+// ResourceType returns the Value of Type
+func (x *Video) ResourceType() string {
+	return x.GetType().GetValue()
+}
+
+// Resources yields the resources of the list, kind by kind in schema order:
+// SoundRecording, Video, Image, Text, SheetMusic, Software
+func (x *ResourceList) Resources() iter.Seq[Resource] {
+	return func(yield func(Resource) bool) {
+		for _, resource := range x.GetSoundRecording() {
+			if !yield(resource) {
+				return
+			}
+		}
+		for _, resource := range x.GetVideo() {
+			if !yield(resource) {
+				return
+			}
+		}
+		for _, resource := range x.GetImage() {
+			if !yield(resource) {
+				return
+			}
+		}
+		for _, resource := range x.GetText() {
+			if !yield(resource) {
+				return
+			}
+		}
+		for _, resource := range x.GetSheetMusic() {
+			if !yield(resource) {
+				return
+			}
+		}
+		for _, resource := range x.GetSoftware() {
+			if !yield(resource) {
+				return
+			}
+		}
+	}
+}
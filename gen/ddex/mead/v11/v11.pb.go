@@ -6075,7 +6075,9 @@ type Content struct {
 	// @gotags: xml:"type,attr"
 	Type string `protobuf:"bytes,1,opt,name=type,proto3" json:"type,omitempty" xml:"type,attr"`
 	// @gotags: xml:"src,attr"
-	Src           string `protobuf:"bytes,2,opt,name=src,proto3" json:"src,omitempty" xml:"src,attr"`
+	Src string `protobuf:"bytes,2,opt,name=src,proto3" json:"src,omitempty" xml:"src,attr"`
+	// @gotags: xml:",innerxml"
+	MixedContent  string `protobuf:"bytes,3,opt,name=mixed_content,json=mixedContent,proto3" json:"mixed_content,omitempty" xml:",innerxml"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -6124,6 +6126,13 @@ func (x *Content) GetSrc() string {
 	return ""
 }
 
+func (x *Content) GetMixedContent() string {
+	if x != nil {
+		return x.MixedContent
+	}
+	return ""
+}
+
 type DateTime struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
 	// @gotags: xml:",chardata"
@@ -6335,7 +6344,9 @@ type Link struct {
 	// @gotags: xml:"title,attr"
 	Title string `protobuf:"bytes,5,opt,name=title,proto3" json:"title,omitempty" xml:"title,attr"`
 	// @gotags: xml:"length,attr"
-	Length        int32 `protobuf:"varint,6,opt,name=length,proto3" json:"length,omitempty" xml:"length,attr"`
+	Length int32 `protobuf:"varint,6,opt,name=length,proto3" json:"length,omitempty" xml:"length,attr"`
+	// @gotags: xml:",innerxml"
+	MixedContent  string `protobuf:"bytes,7,opt,name=mixed_content,json=mixedContent,proto3" json:"mixed_content,omitempty" xml:",innerxml"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -6412,6 +6423,13 @@ func (x *Link) GetLength() int32 {
 	return 0
 }
 
+func (x *Link) GetMixedContent() string {
+	if x != nil {
+		return x.MixedContent
+	}
+	return ""
+}
+
 type Logo struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
 	// @gotags: xml:",chardata"
@@ -6667,7 +6685,9 @@ func (x *Source) GetUpdated() *DateTime {
 type Text struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
 	// @gotags: xml:"type,attr"
-	Type          string `protobuf:"bytes,1,opt,name=type,proto3" json:"type,omitempty" xml:"type,attr"`
+	Type string `protobuf:"bytes,1,opt,name=type,proto3" json:"type,omitempty" xml:"type,attr"`
+	// @gotags: xml:",innerxml"
+	MixedContent  string `protobuf:"bytes,2,opt,name=mixed_content,json=mixedContent,proto3" json:"mixed_content,omitempty" xml:",innerxml"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -6709,6 +6729,13 @@ func (x *Text) GetType() string {
 	return ""
 }
 
+func (x *Text) GetMixedContent() string {
+	if x != nil {
+		return x.MixedContent
+	}
+	return ""
+}
+
 type URI struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
 	// @gotags: xml:",chardata"
@@ -12402,10 +12429,11 @@ const file_ddex_mead_v11_v11_proto_rawDesc = "" +
 	"\bCategory\x12\x12\n" +
 	"\x04term\x18\x01 \x01(\tR\x04term\x12\x16\n" +
 	"\x06scheme\x18\x02 \x01(\tR\x06scheme\x12\x14\n" +
-	"\x05label\x18\x03 \x01(\tR\x05label\"/\n" +
+	"\x05label\x18\x03 \x01(\tR\x05label\"T\n" +
 	"\aContent\x12\x12\n" +
 	"\x04type\x18\x01 \x01(\tR\x04type\x12\x10\n" +
-	"\x03src\x18\x02 \x01(\tR\x03src\" \n" +
+	"\x03src\x18\x02 \x01(\tR\x03src\x12#\n" +
+	"\rmixed_content\x18\x03 \x01(\tR\fmixedContent\" \n" +
 	"\bDateTime\x12\x14\n" +
 	"\x05value\x18\x01 \x01(\tR\x05value\"M\n" +
 	"\tGenerator\x12\x14\n" +
@@ -12415,14 +12443,15 @@ const file_ddex_mead_v11_v11_proto_rawDesc = "" +
 	"\x04Icon\x12\x14\n" +
 	"\x05value\x18\x01 \x01(\tR\x05value\"\x1a\n" +
 	"\x02Id\x12\x14\n" +
-	"\x05value\x18\x01 \x01(\tR\x05value\"\x8a\x01\n" +
+	"\x05value\x18\x01 \x01(\tR\x05value\"\xaf\x01\n" +
 	"\x04Link\x12\x12\n" +
 	"\x04href\x18\x01 \x01(\tR\x04href\x12\x10\n" +
 	"\x03rel\x18\x02 \x01(\tR\x03rel\x12\x12\n" +
 	"\x04type\x18\x03 \x01(\tR\x04type\x12\x1a\n" +
 	"\bhreflang\x18\x04 \x01(\tR\bhreflang\x12\x14\n" +
 	"\x05title\x18\x05 \x01(\tR\x05title\x12\x16\n" +
-	"\x06length\x18\x06 \x01(\x05R\x06length\"\x1c\n" +
+	"\x06length\x18\x06 \x01(\x05R\x06length\x12#\n" +
+	"\rmixed_content\x18\a \x01(\tR\fmixedContent\"\x1c\n" +
 	"\x04Logo\x12\x14\n" +
 	"\x05value\x18\x01 \x01(\tR\x05value\"X\n" +
 	"\x06Person\x12\x12\n" +
@@ -12442,9 +12471,10 @@ const file_ddex_mead_v11_v11_proto_rawDesc = "" +
 	"\bsubtitle\x18\n" +
 	" \x01(\v2\x13.ddex.mead.v11.TextR\bsubtitle\x12)\n" +
 	"\x05title\x18\v \x01(\v2\x13.ddex.mead.v11.TextR\x05title\x121\n" +
-	"\aupdated\x18\f \x01(\v2\x17.ddex.mead.v11.DateTimeR\aupdated\"\x1a\n" +
+	"\aupdated\x18\f \x01(\v2\x17.ddex.mead.v11.DateTimeR\aupdated\"?\n" +
 	"\x04Text\x12\x12\n" +
-	"\x04type\x18\x01 \x01(\tR\x04type\"\x1b\n" +
+	"\x04type\x18\x01 \x01(\tR\x04type\x12#\n" +
+	"\rmixed_content\x18\x02 \x01(\tR\fmixedContent\"\x1b\n" +
 	"\x03URI\x12\x14\n" +
 	"\x05value\x18\x01 \x01(\tR\x05value\"Q\n" +
 	"\x10AllTerritoryCode\x12\x14\n" +
@@ -4,8 +4,13 @@ package meadv11
 
 import (
 	"encoding/xml"
+	"fmt"
 	"reflect"
+	"runtime"
+	"sort"
 	"strings"
+	"sync"
+	"weak"
 )
 
 // Package-level namespace constants
@@ -15,6 +20,17 @@ const (
 	NamespaceXSI    = "http://www.w3.org/2001/XMLSchema-instance"
 )
 
+// parseWarnings holds the ParseWarnings of root messages by weak pointer, as the generated message
+// structs have no field for them
+var parseWarnings sync.Map
+
+// meadMessageAttrs caches the attributes that struct fields of MeadMessage handle, which MarshalXML
+// must not repeat from NamespaceAttrs
+var (
+	meadMessageAttrsOnce sync.Once
+	meadMessageAttrs     map[string]bool
+)
+
 // MarshalXML implements xml.Marshaler for MeadMessage
 func (m *MeadMessage) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
 	// Set default namespace values if empty
@@ -24,34 +40,38 @@ func (m *MeadMessage) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
 	// Set the namespace on the start element
 	start.Name.Space = Namespace
 
-	// Add namespace attributes to the element, avoiding duplicates
-	// Use reflection to find which attributes are already handled by struct fields
-	existingAttrs := make(map[string]bool)
-	v := reflect.ValueOf(m).Elem()
-	t := v.Type()
-	for i := 0; i < v.NumField(); i++ {
-		field := t.Field(i)
-		if xmlTag := field.Tag.Get("xml"); xmlTag != "" && xmlTag != "-" {
-			// Parse the XML tag to get the attribute name
-			if strings.HasSuffix(xmlTag, ",attr") {
-				attrName := strings.TrimSuffix(xmlTag, ",attr")
-				if colonIdx := strings.Index(attrName, ":"); colonIdx >= 0 {
-					// For tags like "xmlns:ern,attr" or "xsi:schemaLocation,attr"
-					existingAttrs[attrName] = true
-				} else if attrName != "" {
-					// For tags like "LanguageAndScriptCode,attr"
-					existingAttrs[attrName] = true
+	// Add namespace attributes to the element, avoiding duplicates. The attributes already handled by
+	// struct fields are fixed per type, so they are found by reflection once and cached
+	meadMessageAttrsOnce.Do(func() {
+		meadMessageAttrs = make(map[string]bool)
+		t := reflect.TypeOf(m).Elem()
+		for i := 0; i < t.NumField(); i++ {
+			field := t.Field(i)
+			if xmlTag := field.Tag.Get("xml"); xmlTag != "" && xmlTag != "-" {
+				// Parse the XML tag to get the attribute name, from tags like "xmlns:ern,attr",
+				// "xsi:schemaLocation,attr" or "LanguageAndScriptCode,attr"
+				if strings.HasSuffix(xmlTag, ",attr") {
+					if attrName := strings.TrimSuffix(xmlTag, ",attr"); attrName != "" {
+						meadMessageAttrs[attrName] = true
+					}
 				}
 			}
 		}
-	}
+	})
+	existingAttrs := meadMessageAttrs
 
-	// Add attributes from the map that aren't already handled
-	for key, value := range m.NamespaceAttrs {
+	// Add attributes from the map that aren't already handled, sorted by key
+	// so that marshaled output is deterministic
+	keys := make([]string, 0, len(m.NamespaceAttrs))
+	for key := range m.NamespaceAttrs {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	for _, key := range keys {
 		if !existingAttrs[key] {
 			start.Attr = append(start.Attr, xml.Attr{
 				Name:  xml.Name{Local: key},
-				Value: value,
+				Value: m.NamespaceAttrs[key],
 			})
 		}
 	}
@@ -67,6 +87,9 @@ func (m *MeadMessage) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error
 	if m.NamespaceAttrs == nil {
 		m.NamespaceAttrs = make(map[string]string)
 	}
+	// Namespace declarations of this element, to report conflicting ones as parse warnings
+	var warnings []string
+	declared := make(map[string]string)
 	for _, attr := range start.Attr {
 		// Capture all xmlns:* attributes and xsi:schemaLocation
 		if attr.Name.Space == "xmlns" || attr.Name.Local == "xmlns" ||
@@ -79,13 +102,788 @@ func (m *MeadMessage) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error
 				// Preserve the namespace prefix for attributes like xsi:schemaLocation
 				if attr.Name.Space == "http://www.w3.org/2001/XMLSchema-instance" {
 					key = "xsi:" + attr.Name.Local
+					// The document may bind XMLSchema-instance to another prefix; the attribute is
+					// always written back as xsi:, so make sure that prefix is declared
+					if _, ok := m.NamespaceAttrs["xmlns:xsi"]; !ok {
+						m.NamespaceAttrs["xmlns:xsi"] = attr.Name.Space
+					}
+				}
+			}
+			if key == "xmlns" || strings.HasPrefix(key, "xmlns:") {
+				// The map keeps only the last declaration of a prefix, so record the conflict
+				if previous, ok := declared[key]; ok && previous != attr.Value {
+					warnings = append(warnings, fmt.Sprintf("%s is declared twice, as %q and %q", key, previous, attr.Value))
 				}
+				declared[key] = attr.Value
 			}
 			m.NamespaceAttrs[key] = attr.Value
 		}
 	}
+	// Replace the warnings of any earlier parse into m; the entry is dropped when m is collected
+	ref := weak.Make(m)
+	parseWarnings.Delete(ref)
+	if len(warnings) > 0 {
+		parseWarnings.Store(ref, warnings)
+		runtime.AddCleanup(m, func(ref weak.Pointer[MeadMessage]) { parseWarnings.Delete(ref) }, ref)
+	}
 
 	// Create an alias type to avoid infinite recursion
 	type alias MeadMessage
 	return d.DecodeElement((*alias)(m), &start)
 }
+
+// SetNamespaceAttr sets an attribute written on the root element. key is the attribute's qualified
+// name as it appears in the document: "xmlns" for the default namespace, "xmlns:<prefix>" for a
+// namespace declaration such as "xmlns:avs", or "xsi:schemaLocation".
+func (m *MeadMessage) SetNamespaceAttr(key, value string) {
+	if m.NamespaceAttrs == nil {
+		m.NamespaceAttrs = make(map[string]string)
+	}
+	m.NamespaceAttrs[key] = value
+}
+
+// GetNamespaceAttr returns the root element attribute stored under key (see SetNamespaceAttr) and
+// whether it is set
+func (m *MeadMessage) GetNamespaceAttr(key string) (string, bool) {
+	if m == nil {
+		return "", false
+	}
+	value, ok := m.NamespaceAttrs[key]
+	return value, ok
+}
+
+// ParseWarnings returns the problems UnmarshalXML found in the root element without failing the
+// parse, namely namespace prefixes declared twice with different namespaces, of which NamespaceAttrs
+// keeps only the last
+func (m *MeadMessage) ParseWarnings() []string {
+	if m == nil {
+		return nil
+	}
+	if warnings, ok := parseWarnings.Load(weak.Make(m)); ok {
+		return warnings.([]string)
+	}
+	return nil
+}
+
+// GetSubscriptionIdOr returns SubscriptionId, or def when it is empty or x is nil
+func (x *MeadMessage) GetSubscriptionIdOr(def string) string {
+	if v := x.GetSubscriptionId(); v != "" {
+		return v
+	}
+	return def
+}
+
+// GetAvsVersionIdOr returns AvsVersionId, or def when it is empty or x is nil
+func (x *MeadMessage) GetAvsVersionIdOr(def string) string {
+	if v := x.GetAvsVersionId(); v != "" {
+		return v
+	}
+	return def
+}
+
+// GetLanguageAndScriptCodeOr returns LanguageAndScriptCode, or def when it is empty or x is nil
+func (x *MeadMessage) GetLanguageAndScriptCodeOr(def string) string {
+	if v := x.GetLanguageAndScriptCode(); v != "" {
+		return v
+	}
+	return def
+}
+
+// GetMessageThreadIdOr returns MessageThreadId, or def when it is empty or x is nil
+func (x *MessageHeader) GetMessageThreadIdOr(def string) string {
+	if v := x.GetMessageThreadId(); v != "" {
+		return v
+	}
+	return def
+}
+
+// GetMessageIdOr returns MessageId, or def when it is empty or x is nil
+func (x *MessageHeader) GetMessageIdOr(def string) string {
+	if v := x.GetMessageId(); v != "" {
+		return v
+	}
+	return def
+}
+
+// GetMessageFileNameOr returns MessageFileName, or def when it is empty or x is nil
+func (x *MessageHeader) GetMessageFileNameOr(def string) string {
+	if v := x.GetMessageFileName(); v != "" {
+		return v
+	}
+	return def
+}
+
+// GetMessageCreatedDateTimeOr returns MessageCreatedDateTime, or def when it is empty or x is nil
+func (x *MessageHeader) GetMessageCreatedDateTimeOr(def string) string {
+	if v := x.GetMessageCreatedDateTime(); v != "" {
+		return v
+	}
+	return def
+}
+
+// GetMessageControlTypeOr returns MessageControlType, or def when it is empty or x is nil
+func (x *MessageHeader) GetMessageControlTypeOr(def string) string {
+	if v := x.GetMessageControlType(); v != "" {
+		return v
+	}
+	return def
+}
+
+// FieldToElement maps each proto field, keyed "Message.proto_field_name", to the DDEX element it
+// comes from; attributes are prefixed with @. Character data fields are not listed.
+var FieldToElement = map[string]string{
+	"AbsolutePitch.metadata_source_reference":                   "MetadataSourceReference",
+	"AbsolutePitch.modulation":                                  "Modulation",
+	"AbsolutePitch.value":                                       "Value",
+	"Activity.description":                                      "Description",
+	"Activity.language_and_script_of_activity":                  "LanguageAndScriptOfActivity",
+	"Activity.metadata_source_reference":                        "MetadataSourceReference",
+	"Activity.territory_of_activity_description":                "TerritoryOfActivityDescription",
+	"Activity.value":                                            "Value",
+	"ActivityValue.namespace":                                   "@Namespace",
+	"ActivityValue.user_defined_value":                          "@UserDefinedValue",
+	"AllTerritoryCode.identifier_type":                          "@IdentifierType",
+	"AlternativeTitle.language_and_script_of_title":             "LanguageAndScriptOfTitle",
+	"AlternativeTitle.metadata_source_reference":                "MetadataSourceReference",
+	"AlternativeTitle.namespace":                                "@Namespace",
+	"AlternativeTitle.sub_title":                                "SubTitle",
+	"AlternativeTitle.title_text":                               "TitleText",
+	"AlternativeTitle.title_type":                               "@TitleType",
+	"AlternativeTitle.user_defined_value":                       "@UserDefinedValue",
+	"Annotation.metadata_source_reference":                      "MetadataSourceReference",
+	"Annotation.text":                                           "Text",
+	"ArtistTypeValue.namespace":                                 "@Namespace",
+	"ArtistTypeValue.user_defined_value":                        "@UserDefinedValue",
+	"ArtisticInfluence.description":                             "Description",
+	"ArtisticInfluence.is_influenced":                           "IsInfluenced",
+	"ArtisticInfluence.is_influencer":                           "IsInfluencer",
+	"ArtisticInfluence.metadata_source_reference":               "MetadataSourceReference",
+	"ArtisticInfluence.party":                                   "Party",
+	"ArtisticInfluence.release":                                 "Release",
+	"ArtisticInfluence.resource":                                "Resource",
+	"ArtisticInfluence.work":                                    "Work",
+	"ArtisticStyle.metadata_source_reference":                   "MetadataSourceReference",
+	"ArtisticStyle.territory_of_artistic_style_description":     "TerritoryOfArtisticStyleDescription",
+	"ArtisticStyle.value":                                       "Value",
+	"Award.award_name":                                          "AwardName",
+	"Award.awarded_party":                                       "AwardedParty",
+	"Award.awarding_body":                                       "AwardingBody",
+	"Award.comment":                                             "Comment",
+	"Award.date":                                                "Date",
+	"Award.is_winner":                                           "IsWinner",
+	"Award.metadata_source_reference":                           "MetadataSourceReference",
+	"BeatsPerMinute.metadata_source_reference":                  "MetadataSourceReference",
+	"BeatsPerMinute.modulation":                                 "Modulation",
+	"BeatsPerMinute.value":                                      "Value",
+	"CatalogNumber.namespace":                                   "@Namespace",
+	"Category.label":                                            "@label",
+	"Category.scheme":                                           "@scheme",
+	"Category.term":                                             "@term",
+	"ChartEntry.comment":                                        "Comment",
+	"ChartEntry.date":                                           "Date",
+	"ChartEntry.duration":                                       "Duration",
+	"ChartEntry.position":                                       "Position",
+	"ChildWorkHierarchy.child":                                  "Child",
+	"ChildWorkHierarchy.form":                                   "Form",
+	"ChildWorkHierarchy.is_complete":                            "IsComplete",
+	"ChildWorkHierarchy.is_described_element":                   "IsDescribedElement",
+	"ChildWorkHierarchy.sequence_number":                        "SequenceNumber",
+	"ChildWorkHierarchy.work_id":                                "WorkId",
+	"ChildWorkHierarchy.work_title":                             "WorkTitle",
+	"ClassicalPeriod.language_and_script_of_classical_period":   "LanguageAndScriptOfClassicalPeriod",
+	"ClassicalPeriod.metadata_source_reference":                 "MetadataSourceReference",
+	"ClassicalPeriod.name":                                      "Name",
+	"ClassicalPeriod.territory_of_classical_period_description": "TerritoryOfClassicalPeriodDescription",
+	"CommentaryNote.author":                                     "Author",
+	"CommentaryNote.commentary_note_type":                       "CommentaryNoteType",
+	"CommentaryNote.language_and_script_of_commentary_note":     "LanguageAndScriptOfCommentaryNote",
+	"CommentaryNote.metadata_source_reference":                  "MetadataSourceReference",
+	"CommentaryNote.territory_of_commentary_note_description":   "TerritoryOfCommentaryNoteDescription",
+	"CommentaryNote.text":                                       "Text",
+	"CommentaryNoteType.namespace":                              "@Namespace",
+	"CommentaryNoteType.user_defined_value":                     "@UserDefinedValue",
+	"Content.src":                                               "@src",
+	"Content.type":                                              "@type",
+	"Contributor.identifier":                                    "Identifier",
+	"Contributor.name":                                          "Name",
+	"Contributor.role":                                          "Role",
+	"CurrentTerritoryCode.identifier_type":                      "@IdentifierType",
+	"DanceStyle.description":                                    "Description",
+	"DanceStyle.language_and_script_of_dance_style":             "LanguageAndScriptOfDanceStyle",
+	"DanceStyle.metadata_source_reference":                      "MetadataSourceReference",
+	"DanceStyle.territory_of_dance_style_description":           "TerritoryOfDanceStyleDescription",
+	"DanceStyle.value":                                          "Value",
+	"DanceStyleValue.namespace":                                 "@Namespace",
+	"DanceStyleValue.user_defined_value":                        "@UserDefinedValue",
+	"Date.is_approximate":                                       "@IsApproximate",
+	"DerivedRecording.display_artist":                           "DisplayArtist",
+	"DerivedRecording.display_artist_name":                      "DisplayArtistName",
+	"DerivedRecording.metadata_source_reference":                "MetadataSourceReference",
+	"DerivedRecording.related_resource_type":                    "RelatedResourceType",
+	"DerivedRecording.resource_id":                              "ResourceId",
+	"DerivedRecording.title":                                    "Title",
+	"DetailedHashSum.algorithm":                                 "Algorithm",
+	"DetailedHashSum.data_type":                                 "DataType",
+	"DetailedHashSum.hash_sum_value":                            "HashSumValue",
+	"DetailedHashSum.parameter":                                 "Parameter",
+	"DetailedHashSum.version":                                   "Version",
+	"DetailedPartyId.cisac_society_id":                          "CisacSocietyId",
+	"DetailedPartyId.d_p_i_d":                                   "DPID",
+	"DetailedPartyId.i_p_n":                                     "IPN",
+	"DetailedPartyId.i_s_n_i":                                   "ISNI",
+	"DetailedPartyId.ipi_name_number":                           "IpiNameNumber",
+	"DetailedPartyId.proprietary_id":                            "ProprietaryId",
+	"DisplayArtistNameWithDefault.applicable_territory_code":    "@ApplicableTerritoryCode",
+	"DisplayArtistNameWithDefault.is_default":                   "@IsDefault",
+	"DisplayArtistNameWithDefault.language_and_script_code":     "@LanguageAndScriptCode",
+	"DisplayArtistNameWithPronunciation.name":                   "Name",
+	"DisplayArtistNameWithPronunciation.pronunciation":          "Pronunciation",
+	"DisplaySubTitle.is_displayed_in_title":                     "@IsDisplayedInTitle",
+	"DisplaySubTitle.namespace":                                 "@Namespace",
+	"DisplaySubTitle.pronunciation":                             "Pronunciation",
+	"DisplaySubTitle.sequence_number":                           "@SequenceNumber",
+	"DisplaySubTitle.sub_title_type":                            "@SubTitleType",
+	"DisplaySubTitle.title":                                     "Title",
+	"DisplaySubTitle.user_defined_value":                        "@UserDefinedValue",
+	"DisplayTitle.applicable_territory_code":                    "@ApplicableTerritoryCode",
+	"DisplayTitle.is_default":                                   "@IsDefault",
+	"DisplayTitle.language_and_script_code":                     "@LanguageAndScriptCode",
+	"DisplayTitle.sub_title":                                    "SubTitle",
+	"DisplayTitle.title_text":                                   "TitleText",
+	"Duration.namespace":                                        "@Namespace",
+	"Duration.unit_of_duration":                                 "@UnitOfDuration",
+	"Duration.user_defined_value":                               "@UserDefinedValue",
+	"Entry.author":                                              "author",
+	"Entry.avs_version_id":                                      "@AvsVersionId",
+	"Entry.category":                                            "category",
+	"Entry.content":                                             "content",
+	"Entry.contributor":                                         "contributor",
+	"Entry.id":                                                  "id",
+	"Entry.link":                                                "link",
+	"Entry.published":                                           "published",
+	"Entry.rights":                                              "rights",
+	"Entry.source":                                              "source",
+	"Entry.subscription_id":                                     "SubscriptionId",
+	"Entry.summary":                                             "summary",
+	"Entry.title":                                               "title",
+	"Entry.updated":                                             "updated",
+	"Epoch.end_date":                                            "EndDate",
+	"Epoch.language_and_script_of_epoch":                        "LanguageAndScriptOfEpoch",
+	"Epoch.metadata_source_reference":                           "MetadataSourceReference",
+	"Epoch.related_artist":                                      "RelatedArtist",
+	"Epoch.related_creation":                                    "RelatedCreation",
+	"Epoch.start_date":                                          "StartDate",
+	"Epoch.territory_of_epoch_description":                      "TerritoryOfEpochDescription",
+	"Epoch.value":                                               "Value",
+	"EventDate.applicable_territory_code":                       "@ApplicableTerritoryCode",
+	"EventDate.is_after":                                        "@IsAfter",
+	"EventDate.is_approximate":                                  "@IsApproximate",
+	"EventDate.is_before":                                       "@IsBefore",
+	"EventDate.language_and_script_code":                        "@LanguageAndScriptCode",
+	"EventDate.location_description":                            "@LocationDescription",
+	"EventDateTime.is_after":                                    "@IsAfter",
+	"EventDateTime.is_approximate":                              "@IsApproximate",
+	"EventDateTime.is_before":                                   "@IsBefore",
+	"EventDateTime.language_and_script_code":                    "@LanguageAndScriptCode",
+	"EventDateTime.location_description":                        "@LocationDescription",
+	"EventDateTime.territory_code":                              "@TerritoryCode",
+	"EventDateWithoutFlags.applicable_territory_code":           "@ApplicableTerritoryCode",
+	"EventDateWithoutFlags.is_approximate":                      "@IsApproximate",
+	"EventDateWithoutFlags.language_and_script_code":            "@LanguageAndScriptCode",
+	"EventDateWithoutFlags.location_description":                "@LocationDescription",
+	"Feed.author":                                               "author",
+	"Feed.category":                                             "category",
+	"Feed.contributor":                                          "contributor",
+	"Feed.entry":                                                "entry",
+	"Feed.generator":                                            "generator",
+	"Feed.icon":                                                 "icon",
+	"Feed.id":                                                   "id",
+	"Feed.link":                                                 "link",
+	"Feed.logo":                                                 "logo",
+	"Feed.rights":                                               "rights",
+	"Feed.subtitle":                                             "subtitle",
+	"Feed.title":                                                "title",
+	"Feed.updated":                                              "updated",
+	"File.file_size":                                            "FileSize",
+	"File.hash_sum":                                             "HashSum",
+	"File.u_r_i":                                                "URI",
+	"Flag.metadata_source_reference":                            "MetadataSourceReference",
+	"Flag.value":                                                "Value",
+	"Focus.comment":                                             "Comment",
+	"Focus.display_artist":                                      "DisplayArtist",
+	"Focus.display_artist_name":                                 "DisplayArtistName",
+	"Focus.is_default":                                          "@IsDefault",
+	"Focus.metadata_source_reference":                           "MetadataSourceReference",
+	"Focus.party":                                               "Party",
+	"Focus.period_of_being_focus":                               "PeriodOfBeingFocus",
+	"Focus.sequence_number":                                     "SequenceNumber",
+	"Focus.territory_of_being_focus_track_description":          "TerritoryOfBeingFocusTrackDescription",
+	"Focus.writer":                                              "Writer",
+	"Form.metadata_source_reference":                            "MetadataSourceReference",
+	"Form.value":                                                "Value",
+	"FormValue.namespace":                                       "@Namespace",
+	"FormValue.user_defined_value":                              "@UserDefinedValue",
+	"Generator.uri":                                             "@uri",
+	"Generator.version":                                         "@version",
+	"GenreCategory.description":                                 "Description",
+	"GenreCategory.metadata_source_reference":                   "MetadataSourceReference",
+	"GenreCategory.territory_of_genre_category_description":     "TerritoryOfGenreCategoryDescription",
+	"GenreCategory.value":                                       "Value",
+	"GenreCategoryValue.language_and_script_code":               "@LanguageAndScriptCode",
+	"GenreCategoryValue.namespace":                              "@Namespace",
+	"GenreCategoryValue.user_defined_value":                     "@UserDefinedValue",
+	"Harmony.metadata_source_reference":                         "MetadataSourceReference",
+	"Harmony.mode":                                              "Mode",
+	"Harmony.modulation":                                        "Modulation",
+	"Harmony.root_chord_note":                                   "RootChordNote",
+	"Harmony.root_chord_quality":                                "RootChordQuality",
+	"HarmonyModulation.end_bar":                                 "EndBar",
+	"HarmonyModulation.end_point":                               "EndPoint",
+	"HarmonyModulation.mode":                                    "Mode",
+	"HarmonyModulation.root_chord_note":                         "RootChordNote",
+	"HarmonyModulation.root_chord_quality":                      "RootChordQuality",
+	"HarmonyModulation.start_bar":                               "StartBar",
+	"HarmonyModulation.start_point":                             "StartPoint",
+	"HashSumAlgorithmType.namespace":                            "@Namespace",
+	"HashSumAlgorithmType.user_defined_value":                   "@UserDefinedValue",
+	"HistoricChartingInformation.chart_entry":                   "ChartEntry",
+	"HistoricChartingInformation.chart_name":                    "ChartName",
+	"HistoricChartingInformation.comment":                       "Comment",
+	"HistoricChartingInformation.duration_in_charts":            "DurationInCharts",
+	"HistoricChartingInformation.metadata_source_reference":     "MetadataSourceReference",
+	"HistoricChartingInformation.territory_code":                "TerritoryCode",
+	"HistoricChartingInformation.top_position":                  "TopPosition",
+	"Image.file":                                                "File",
+	"Image.image_type":                                          "ImageType",
+	"Image.metadata_source_reference":                           "MetadataSourceReference",
+	"ImageType.namespace":                                       "@Namespace",
+	"ImageType.user_defined_value":                              "@UserDefinedValue",
+	"ImpactDate.date":                                           "Date",
+	"ImpactDate.territory_code":                                 "TerritoryCode",
+	"Instrument.metadata_source_reference":                      "MetadataSourceReference",
+	"Instrument.value":                                          "Value",
+	"InstrumentUsed.is_featured":                                "@IsFeatured",
+	"InstrumentUsed.metadata_source_reference":                  "MetadataSourceReference",
+	"InstrumentUsed.value":                                      "Value",
+	"InstrumentValue.namespace":                                 "@Namespace",
+	"InstrumentValue.user_defined_value":                        "@UserDefinedValue",
+	"Intensity.metadata_source_reference":                       "MetadataSourceReference",
+	"Intensity.value":                                           "Value",
+	"IntensityValue.namespace":                                  "@Namespace",
+	"IntensityValue.user_defined_value":                         "@UserDefinedValue",
+	"Link.href":                                                 "@href",
+	"Link.hreflang":                                             "@hreflang",
+	"Link.length":                                               "@length",
+	"Link.rel":                                                  "@rel",
+	"Link.title":                                                "@title",
+	"Link.type":                                                 "@type",
+	"LocationAndDateOfSession.comment":                          "Comment",
+	"LocationAndDateOfSession.contributor":                      "Contributor",
+	"LocationAndDateOfSession.metadata_source_reference":        "MetadataSourceReference",
+	"LocationAndDateOfSession.period":                           "Period",
+	"LocationAndDateOfSession.session_type":                     "SessionType",
+	"LocationAndDateOfSession.venue":                            "Venue",
+	"Lyrics.is_misquoted":                                       "@IsMisquoted",
+	"Lyrics.language_and_script_of_lyrics":                      "LanguageAndScriptOfLyrics",
+	"Lyrics.lyrics_type":                                        "@LyricsType",
+	"Lyrics.lyrics_type_namespace":                              "@LyricsTypeNamespace",
+	"Lyrics.lyrics_type_user_defined_value":                     "@LyricsTypeUserDefinedValue",
+	"Lyrics.metadata_source_reference":                          "MetadataSourceReference",
+	"Lyrics.pronunciation":                                      "Pronunciation",
+	"Lyrics.sequence_number":                                    "@SequenceNumber",
+	"Lyrics.territory_of_lyrics_description":                    "TerritoryOfLyricsDescription",
+	"Lyrics.text":                                               "Text",
+	"LyricsText.format":                                         "@Format",
+	"LyricsText.format_namespace":                               "@FormatNamespace",
+	"LyricsText.format_user_defined_value":                      "@FormatUserDefinedValue",
+	"LyricsText.is_complete":                                    "@IsComplete",
+	"MeadMessage.avs_version_id":                                "@AvsVersionId",
+	"MeadMessage.language_and_script_code":                      "@LanguageAndScriptCode",
+	"MeadMessage.message_header":                                "MessageHeader",
+	"MeadMessage.metadata_source_list":                          "MetadataSourceList",
+	"MeadMessage.release_information_list":                      "ReleaseInformationList",
+	"MeadMessage.resource_information_list":                     "ResourceInformationList",
+	"MeadMessage.subscription_id":                               "SubscriptionId",
+	"MeadMessage.work_information_list":                         "WorkInformationList",
+	"MessageAuditTrail.message_audit_trail_event":               "MessageAuditTrailEvent",
+	"MessageAuditTrailEvent.date_time":                          "DateTime",
+	"MessageAuditTrailEvent.messaging_party_descriptor":         "MessagingPartyDescriptor",
+	"MessageHeader.message_audit_trail":                         "MessageAuditTrail",
+	"MessageHeader.message_control_type":                        "MessageControlType",
+	"MessageHeader.message_created_date_time":                   "MessageCreatedDateTime",
+	"MessageHeader.message_file_name":                           "MessageFileName",
+	"MessageHeader.message_id":                                  "MessageId",
+	"MessageHeader.message_recipient":                           "MessageRecipient",
+	"MessageHeader.message_sender":                              "MessageSender",
+	"MessageHeader.message_thread_id":                           "MessageThreadId",
+	"MessageHeader.sent_on_behalf_of":                           "SentOnBehalfOf",
+	"MessagingPartyWithoutCode.party_id":                        "PartyId",
+	"MessagingPartyWithoutCode.party_name":                      "PartyName",
+	"MessagingPartyWithoutCode.trading_name":                    "TradingName",
+	"MetadataSource.metadata_source_type":                       "MetadataSourceType",
+	"MetadataSource.party_id":                                   "PartyId",
+	"MetadataSource.party_name":                                 "PartyName",
+	"MetadataSource.source_reference":                           "SourceReference",
+	"MetadataSourceList.metadata_source":                        "MetadataSource",
+	"MetadataSourceReference.assertion_date_time":               "@AssertionDateTime",
+	"MetadataSourceReference.status":                            "@Status",
+	"MetadataSourceReference.weight":                            "@Weight",
+	"MetadataSourceType.namespace":                              "@Namespace",
+	"MetadataSourceType.user_defined_value":                     "@UserDefinedValue",
+	"Meter.note_equivalent_to_beat":                             "NoteEquivalentToBeat",
+	"Meter.number_of_beats_in_bar":                              "NumberOfBeatsInBar",
+	"Mode.namespace":                                            "@Namespace",
+	"Mode.user_defined_value":                                   "@UserDefinedValue",
+	"Modulation.end_bar":                                        "EndBar",
+	"Modulation.end_point":                                      "EndPoint",
+	"Modulation.start_bar":                                      "StartBar",
+	"Modulation.start_point":                                    "StartPoint",
+	"Modulation.value":                                          "Value",
+	"Mood.description":                                          "Description",
+	"Mood.language_and_script_of_mood":                          "LanguageAndScriptOfMood",
+	"Mood.metadata_source_reference":                            "MetadataSourceReference",
+	"Mood.mood_type":                                            "@MoodType",
+	"Mood.territory_of_mood_description":                        "TerritoryOfMoodDescription",
+	"Mood.value":                                                "Value",
+	"MoodValue.applies_to_composition":                          "@AppliesToComposition",
+	"MoodValue.applies_to_lyrics":                               "@AppliesToLyrics",
+	"MoodValue.namespace":                                       "@Namespace",
+	"MoodValue.user_defined_value":                              "@UserDefinedValue",
+	"MusicalWorkIdWithoutFlag.composer_catalog_number":          "ComposerCatalogNumber",
+	"MusicalWorkIdWithoutFlag.i_s_w_c":                          "ISWC",
+	"MusicalWorkIdWithoutFlag.opus_number":                      "OpusNumber",
+	"MusicalWorkIdWithoutFlag.proprietary_id":                   "ProprietaryId",
+	"Name.language_and_script_code":                             "@LanguageAndScriptCode",
+	"NameWithPronunciationAndScriptCode.name":                   "Name",
+	"NameWithPronunciationAndScriptCode.pronunciation":          "Pronunciation",
+	"Party.d_p_i_d":                                             "DPID",
+	"Party.i_p_n":                                               "IPN",
+	"Party.i_s_n_i":                                             "ISNI",
+	"Party.ipi_name_number":                                     "IpiNameNumber",
+	"Party.party_name":                                          "PartyName",
+	"Party.proprietary_id":                                      "ProprietaryId",
+	"PartyDescriptorWithPronunciation.party_id":                 "PartyId",
+	"PartyDescriptorWithPronunciation.party_name":               "PartyName",
+	"PartyNameWithPronunciation.abbreviated_name":               "AbbreviatedName",
+	"PartyNameWithPronunciation.applicable_territory_code":      "@ApplicableTerritoryCode",
+	"PartyNameWithPronunciation.full_name":                      "FullName",
+	"PartyNameWithPronunciation.full_name_ascii_transcribed":    "FullNameAsciiTranscribed",
+	"PartyNameWithPronunciation.full_name_indexed":              "FullNameIndexed",
+	"PartyNameWithPronunciation.key_name":                       "KeyName",
+	"PartyNameWithPronunciation.language_and_script_code":       "@LanguageAndScriptCode",
+	"PartyNameWithPronunciation.names_after_key_name":           "NamesAfterKeyName",
+	"PartyNameWithPronunciation.names_before_key_name":          "NamesBeforeKeyName",
+	"PartyNameWithoutCode.abbreviated_name":                     "AbbreviatedName",
+	"PartyNameWithoutCode.full_name":                            "FullName",
+	"PartyNameWithoutCode.full_name_ascii_transcribed":          "FullNameAsciiTranscribed",
+	"PartyNameWithoutCode.full_name_indexed":                    "FullNameIndexed",
+	"PartyNameWithoutCode.key_name":                             "KeyName",
+	"PartyNameWithoutCode.names_after_key_name":                 "NamesAfterKeyName",
+	"PartyNameWithoutCode.names_before_key_name":                "NamesBeforeKeyName",
+	"Period.end_date":                                           "EndDate",
+	"Period.end_date_time":                                      "EndDateTime",
+	"Period.start_date":                                         "StartDate",
+	"Period.start_date_time":                                    "StartDateTime",
+	"PeriodValue.namespace":                                     "@Namespace",
+	"PeriodValue.user_defined_value":                            "@UserDefinedValue",
+	"PeriodWithTime.end_date_time":                              "EndDateTime",
+	"PeriodWithTime.start_date_time":                            "StartDateTime",
+	"Person.email":                                              "email",
+	"Person.name":                                               "name",
+	"Person.uri":                                                "uri",
+	"Pronunciation.encoding_namespace":                          "@EncodingNamespace",
+	"Pronunciation.encoding_type":                               "@EncodingType",
+	"Pronunciation.encoding_user_defined_value":                 "@EncodingUserDefinedValue",
+	"Pronunciation.is_canonical":                                "@IsCanonical",
+	"Pronunciation.language_code":                               "@LanguageCode",
+	"ProprietaryId.namespace":                                   "@Namespace",
+	"RecordingPart.comment":                                     "Comment",
+	"RecordingPart.end_point":                                   "EndPoint",
+	"RecordingPart.metadata_source_reference":                   "MetadataSourceReference",
+	"RecordingPart.recording_part_type":                         "RecordingPartType",
+	"RecordingPart.sequence_number":                             "@SequenceNumber",
+	"RecordingPart.start_point":                                 "StartPoint",
+	"RecordingPart.unit":                                        "Unit",
+	"RecordingPart.usage_information":                           "UsageInformation",
+	"RecordingPartType.namespace":                               "@Namespace",
+	"RecordingPartType.user_defined_value":                      "@UserDefinedValue",
+	"RelatedCreation.musical_work_id":                           "MusicalWorkId",
+	"RelatedCreation.release_id":                                "ReleaseId",
+	"RelatedCreation.resource_id":                               "ResourceId",
+	"RelatedCreation.title":                                     "Title",
+	"RelatedResourceType.namespace":                             "@Namespace",
+	"RelatedResourceType.user_defined_value":                    "@UserDefinedValue",
+	"RelatedWork.metadata_source_reference":                     "MetadataSourceReference",
+	"RelatedWork.work_id":                                       "WorkId",
+	"RelatedWork.work_relationship_type":                        "WorkRelationshipType",
+	"RelatedWork.work_title":                                    "WorkTitle",
+	"RelatedWork.writer":                                        "Writer",
+	"Release.display_artist":                                    "DisplayArtist",
+	"Release.display_artist_name":                               "DisplayArtistName",
+	"Release.g_rid":                                             "GRid",
+	"Release.i_c_p_n":                                           "ICPN",
+	"Release.proprietary_release_id":                            "ProprietaryReleaseId",
+	"Release.release_title":                                     "ReleaseTitle",
+	"ReleaseId.catalog_number":                                  "CatalogNumber",
+	"ReleaseId.g_rid":                                           "GRid",
+	"ReleaseId.i_c_p_n":                                         "ICPN",
+	"ReleaseId.i_s_r_c":                                         "ISRC",
+	"ReleaseId.proprietary_id":                                  "ProprietaryId",
+	"ReleaseInformation.activity":                               "Activity",
+	"ReleaseInformation.alternative_title":                      "AlternativeTitle",
+	"ReleaseInformation.applicable_territory_code":              "@ApplicableTerritoryCode",
+	"ReleaseInformation.artistic_influence":                     "ArtisticInfluence",
+	"ReleaseInformation.artistic_style":                         "ArtisticStyle",
+	"ReleaseInformation.award":                                  "Award",
+	"ReleaseInformation.commentary_note":                        "CommentaryNote",
+	"ReleaseInformation.epoch":                                  "Epoch",
+	"ReleaseInformation.focus":                                  "Focus",
+	"ReleaseInformation.genre_category":                         "GenreCategory",
+	"ReleaseInformation.historic_charting_information":          "HistoricChartingInformation",
+	"ReleaseInformation.image":                                  "Image",
+	"ReleaseInformation.is_similar":                             "IsSimilar",
+	"ReleaseInformation.mood":                                   "Mood",
+	"ReleaseInformation.priority_period_end_date":               "@PriorityPeriodEndDate",
+	"ReleaseInformation.priority_period_start_date":             "@PriorityPeriodStartDate",
+	"ReleaseInformation.release_summary":                        "ReleaseSummary",
+	"ReleaseInformation.sub_genre_category":                     "SubGenreCategory",
+	"ReleaseInformation.theme":                                  "Theme",
+	"ReleaseInformationList.release_information":                "ReleaseInformation",
+	"ReleaseSummary.display_artist":                             "DisplayArtist",
+	"ReleaseSummary.display_artist_name":                        "DisplayArtistName",
+	"ReleaseSummary.display_title":                              "DisplayTitle",
+	"ReleaseSummary.release_id":                                 "ReleaseId",
+	"ReleaseTitle.language_and_script_code":                     "@LanguageAndScriptCode",
+	"RelevantResource.resource_id":                              "ResourceId",
+	"RelevantResource.resource_relationship_type":               "ResourceRelationshipType",
+	"Resource.catalog_number":                                   "CatalogNumber",
+	"Resource.display_artist":                                   "DisplayArtist",
+	"Resource.display_artist_name":                              "DisplayArtistName",
+	"Resource.i_s_r_c":                                          "ISRC",
+	"Resource.proprietary_resource_id":                          "ProprietaryResourceId",
+	"Resource.resource_title":                                   "ResourceTitle",
+	"ResourceContributorRole.namespace":                         "@Namespace",
+	"ResourceContributorRole.user_defined_value":                "@UserDefinedValue",
+	"ResourceIdWithoutFlag.catalog_number":                      "CatalogNumber",
+	"ResourceIdWithoutFlag.i_s_a_n":                             "ISAN",
+	"ResourceIdWithoutFlag.i_s_b_n":                             "ISBN",
+	"ResourceIdWithoutFlag.i_s_m_n":                             "ISMN",
+	"ResourceIdWithoutFlag.i_s_r_c":                             "ISRC",
+	"ResourceIdWithoutFlag.i_s_s_n":                             "ISSN",
+	"ResourceIdWithoutFlag.proprietary_id":                      "ProprietaryId",
+	"ResourceIdWithoutFlag.s_i_c_i":                             "SICI",
+	"ResourceIdWithoutFlag.v_i_s_a_n":                           "VISAN",
+	"ResourceInformation.absolute_pitch":                        "AbsolutePitch",
+	"ResourceInformation.activity":                              "Activity",
+	"ResourceInformation.alternative_title":                     "AlternativeTitle",
+	"ResourceInformation.applicable_territory_code":             "@ApplicableTerritoryCode",
+	"ResourceInformation.artistic_influence":                    "ArtisticInfluence",
+	"ResourceInformation.artistic_style":                        "ArtisticStyle",
+	"ResourceInformation.award":                                 "Award",
+	"ResourceInformation.beats_per_minute":                      "BeatsPerMinute",
+	"ResourceInformation.classical_period":                      "ClassicalPeriod",
+	"ResourceInformation.commentary_note":                       "CommentaryNote",
+	"ResourceInformation.dance_style":                           "DanceStyle",
+	"ResourceInformation.epoch":                                 "Epoch",
+	"ResourceInformation.focus":                                 "Focus",
+	"ResourceInformation.form":                                  "Form",
+	"ResourceInformation.genre_category":                        "GenreCategory",
+	"ResourceInformation.harmony":                               "Harmony",
+	"ResourceInformation.historic_charting_information":         "HistoricChartingInformation",
+	"ResourceInformation.image":                                 "Image",
+	"ResourceInformation.impact_date":                           "ImpactDate",
+	"ResourceInformation.instrument_used":                       "InstrumentUsed",
+	"ResourceInformation.intensity":                             "Intensity",
+	"ResourceInformation.is_cover":                              "IsCover",
+	"ResourceInformation.is_original":                           "IsOriginal",
+	"ResourceInformation.is_similar":                            "IsSimilar",
+	"ResourceInformation.location_and_date_of_session":          "LocationAndDateOfSession",
+	"ResourceInformation.lyrics":                                "Lyrics",
+	"ResourceInformation.mood":                                  "Mood",
+	"ResourceInformation.priority_period_end_date":              "@PriorityPeriodEndDate",
+	"ResourceInformation.priority_period_start_date":            "@PriorityPeriodStartDate",
+	"ResourceInformation.recording_part":                        "RecordingPart",
+	"ResourceInformation.related_resource":                      "RelatedResource",
+	"ResourceInformation.resource_summary":                      "ResourceSummary",
+	"ResourceInformation.rhythm_style":                          "RhythmStyle",
+	"ResourceInformation.sample":                                "Sample",
+	"ResourceInformation.sub_genre_category":                    "SubGenreCategory",
+	"ResourceInformation.tempo":                                 "Tempo",
+	"ResourceInformation.theme":                                 "Theme",
+	"ResourceInformation.time_signature":                        "TimeSignature",
+	"ResourceInformation.usage":                                 "Usage",
+	"ResourceInformation.used_musical_work":                     "UsedMusicalWork",
+	"ResourceInformation.vocal_register":                        "VocalRegister",
+	"ResourceInformationList.resource_information":              "ResourceInformation",
+	"ResourceRelationship.display_artist":                       "DisplayArtist",
+	"ResourceRelationship.display_artist_name":                  "DisplayArtistName",
+	"ResourceRelationship.metadata_source_reference":            "MetadataSourceReference",
+	"ResourceRelationship.related_resource_type":                "RelatedResourceType",
+	"ResourceRelationship.resource_id":                          "ResourceId",
+	"ResourceRelationship.title":                                "Title",
+	"ResourceRelationshipType.namespace":                        "@Namespace",
+	"ResourceRelationshipType.user_defined_value":               "@UserDefinedValue",
+	"ResourceSummary.display_artist":                            "DisplayArtist",
+	"ResourceSummary.display_artist_name":                       "DisplayArtistName",
+	"ResourceSummary.display_title":                             "DisplayTitle",
+	"ResourceSummary.resource_id":                               "ResourceId",
+	"ResourceTitle.language_and_script_code":                    "@LanguageAndScriptCode",
+	"RhythmStyle.description":                                   "Description",
+	"RhythmStyle.language_and_script_of_rhythm_style":           "LanguageAndScriptOfRhythmStyle",
+	"RhythmStyle.metadata_source_reference":                     "MetadataSourceReference",
+	"RhythmStyle.territory_of_rhythm_style_description":         "TerritoryOfRhythmStyleDescription",
+	"RhythmStyle.value":                                         "Value",
+	"RhythmStyleValue.namespace":                                "@Namespace",
+	"RhythmStyleValue.user_defined_value":                       "@UserDefinedValue",
+	"RootChordNote.namespace":                                   "@Namespace",
+	"RootChordNote.user_defined_value":                          "@UserDefinedValue",
+	"RootChordQuality.namespace":                                "@Namespace",
+	"RootChordQuality.user_defined_value":                       "@UserDefinedValue",
+	"Sample.contains_samples":                                   "ContainsSamples",
+	"Sample.description":                                        "Description",
+	"Sample.host_timing":                                        "HostTiming",
+	"Sample.is_contained_in_sample":                             "IsContainedInSample",
+	"Sample.metadata_source_reference":                          "MetadataSourceReference",
+	"Sample.related_resource":                                   "RelatedResource",
+	"Sample.sample_feature":                                     "SampleFeature",
+	"Sample.sample_timing":                                      "SampleTiming",
+	"SampleFeature.language_and_script_code":                    "@LanguageAndScriptCode",
+	"SessionType.namespace":                                     "@Namespace",
+	"SessionType.user_defined_value":                            "@UserDefinedValue",
+	"SimilarRelease.description":                                "Description",
+	"SimilarRelease.metadata_source_reference":                  "MetadataSourceReference",
+	"SimilarRelease.release":                                    "Release",
+	"SimilarResource.description":                               "Description",
+	"SimilarResource.metadata_source_reference":                 "MetadataSourceReference",
+	"SimilarResource.resource":                                  "Resource",
+	"SimilarWork.description":                                   "Description",
+	"SimilarWork.metadata_source_reference":                     "MetadataSourceReference",
+	"SimilarWork.work":                                          "Work",
+	"Source.author":                                             "author",
+	"Source.category":                                           "category",
+	"Source.contributor":                                        "contributor",
+	"Source.generator":                                          "generator",
+	"Source.icon":                                               "icon",
+	"Source.id":                                                 "id",
+	"Source.link":                                               "link",
+	"Source.logo":                                               "logo",
+	"Source.rights":                                             "rights",
+	"Source.subtitle":                                           "subtitle",
+	"Source.title":                                              "title",
+	"Source.updated":                                            "updated",
+	"SubGenreCategory.metadata_source_reference":                "MetadataSourceReference",
+	"SubGenreCategory.value":                                    "Value",
+	"SubGenreCategoryValue.namespace":                           "@Namespace",
+	"SubGenreCategoryValue.user_defined_value":                  "@UserDefinedValue",
+	"TempoValue.namespace":                                      "@Namespace",
+	"TempoValue.user_defined_value":                             "@UserDefinedValue",
+	"Text.type":                                                 "@type",
+	"TextWithFormat.applicable_territory_code":                  "@ApplicableTerritoryCode",
+	"TextWithFormat.format":                                     "@Format",
+	"TextWithFormat.is_default":                                 "@IsDefault",
+	"TextWithFormat.language_and_script_code":                   "@LanguageAndScriptCode",
+	"TextWithFormat.namespace":                                  "@Namespace",
+	"TextWithFormat.user_defined_value":                         "@UserDefinedValue",
+	"TextWithoutTerritory.applicable_territory_code":            "@ApplicableTerritoryCode",
+	"TextWithoutTerritory.format":                               "@Format",
+	"TextWithoutTerritory.is_default":                           "@IsDefault",
+	"TextWithoutTerritory.language_and_script_code":             "@LanguageAndScriptCode",
+	"TextWithoutTerritory.namespace":                            "@Namespace",
+	"TextWithoutTerritory.user_defined_value":                   "@UserDefinedValue",
+	"Theme.description":                                         "Description",
+	"Theme.language_and_script_of_theme":                        "LanguageAndScriptOfTheme",
+	"Theme.metadata_source_reference":                           "MetadataSourceReference",
+	"Theme.territory_of_theme_description":                      "TerritoryOfThemeDescription",
+	"Theme.theme_type":                                          "@ThemeType",
+	"Theme.value":                                               "Value",
+	"ThemeValue.namespace":                                      "@Namespace",
+	"ThemeValue.user_defined_value":                             "@UserDefinedValue",
+	"TimeSignature.metadata_source_reference":                   "MetadataSourceReference",
+	"TimeSignature.meter":                                       "Meter",
+	"TimeSignature.modulation":                                  "Modulation",
+	"TimeSignature.no_meter_available":                          "NoMeterAvailable",
+	"TimeSignature.too_many_tempi":                              "TooManyTempi",
+	"TimeSignatureModulation.end_bar":                           "EndBar",
+	"TimeSignatureModulation.end_point":                         "EndPoint",
+	"TimeSignatureModulation.meter":                             "Meter",
+	"TimeSignatureModulation.no_meter_available":                "NoMeterAvailable",
+	"TimeSignatureModulation.start_bar":                         "StartBar",
+	"TimeSignatureModulation.start_point":                       "StartPoint",
+	"TimeSignatureModulation.value":                             "Value",
+	"Timing.duration_used":                                      "DurationUsed",
+	"Timing.start_point":                                        "StartPoint",
+	"TitleText.pronunciation":                                   "Pronunciation",
+	"TitleText.title":                                           "Title",
+	"TitleWithPronunciation.language_and_script_code":           "@LanguageAndScriptCode",
+	"TitleWithPronunciation.namespace":                          "@Namespace",
+	"TitleWithPronunciation.sub_title":                          "SubTitle",
+	"TitleWithPronunciation.title_text":                         "TitleText",
+	"TitleWithPronunciation.title_type":                         "@TitleType",
+	"TitleWithPronunciation.user_defined_value":                 "@UserDefinedValue",
+	"Usage.description":                                         "Description",
+	"Usage.metadata_source_reference":                           "MetadataSourceReference",
+	"Usage.relevant_resource":                                   "RelevantResource",
+	"Usage.sequence_number":                                     "SequenceNumber",
+	"Usage.territory_of_usage_description":                      "TerritoryOfUsageDescription",
+	"Usage.usage_date":                                          "UsageDate",
+	"Usage.usage_period":                                        "UsagePeriod",
+	"UsagePeriod.end_date":                                      "EndDate",
+	"UsagePeriod.start_date":                                    "StartDate",
+	"UsedMusicalWork.metadata_source_reference":                 "MetadataSourceReference",
+	"UsedMusicalWork.resource_musical_work_reference":           "ResourceMusicalWorkReference",
+	"Venue.location_code":                                       "LocationCode",
+	"Venue.territory_code":                                      "TerritoryCode",
+	"Venue.venue_address":                                       "VenueAddress",
+	"Venue.venue_name":                                          "VenueName",
+	"Venue.venue_room":                                          "VenueRoom",
+	"VocalRegister.metadata_source_reference":                   "MetadataSourceReference",
+	"VocalRegister.value":                                       "Value",
+	"VocalRegisterValue.namespace":                              "@Namespace",
+	"VocalRegisterValue.user_defined_value":                     "@UserDefinedValue",
+	"Work.i_s_w_c":                                              "ISWC",
+	"Work.proprietary_work_id":                                  "ProprietaryWorkId",
+	"Work.work_title":                                           "WorkTitle",
+	"Work.writer":                                               "Writer",
+	"WorkHierarchy.child":                                       "Child",
+	"WorkHierarchy.form":                                        "Form",
+	"WorkHierarchy.is_complete":                                 "IsComplete",
+	"WorkHierarchy.is_described_element":                        "IsDescribedElement",
+	"WorkHierarchy.metadata_source_reference":                   "MetadataSourceReference",
+	"WorkHierarchy.sequence_number":                             "SequenceNumber",
+	"WorkHierarchy.work_id":                                     "WorkId",
+	"WorkHierarchy.work_title":                                  "WorkTitle",
+	"WorkInformation.activity":                                  "Activity",
+	"WorkInformation.alternative_title":                         "AlternativeTitle",
+	"WorkInformation.artistic_influence":                        "ArtisticInfluence",
+	"WorkInformation.award":                                     "Award",
+	"WorkInformation.classical_period":                          "ClassicalPeriod",
+	"WorkInformation.commentary_note":                           "CommentaryNote",
+	"WorkInformation.dance_style":                               "DanceStyle",
+	"WorkInformation.derived_recording":                         "DerivedRecording",
+	"WorkInformation.epoch":                                     "Epoch",
+	"WorkInformation.focus":                                     "Focus",
+	"WorkInformation.form":                                      "Form",
+	"WorkInformation.genre_category":                            "GenreCategory",
+	"WorkInformation.harmony":                                   "Harmony",
+	"WorkInformation.is_similar":                                "IsSimilar",
+	"WorkInformation.lyrics":                                    "Lyrics",
+	"WorkInformation.mood":                                      "Mood",
+	"WorkInformation.musical_work_reference":                    "MusicalWorkReference",
+	"WorkInformation.related_work":                              "RelatedWork",
+	"WorkInformation.rhythm_style":                              "RhythmStyle",
+	"WorkInformation.sub_genre_category":                        "SubGenreCategory",
+	"WorkInformation.target_instrument":                         "TargetInstrument",
+	"WorkInformation.tempo":                                     "Tempo",
+	"WorkInformation.theme":                                     "Theme",
+	"WorkInformation.time_signature":                            "TimeSignature",
+	"WorkInformation.vocal_register":                            "VocalRegister",
+	"WorkInformation.work_hierarchy":                            "WorkHierarchy",
+	"WorkInformation.work_summary":                              "WorkSummary",
+	"WorkInformationList.work_information":                      "WorkInformation",
+	"WorkRelationshipType.namespace":                            "@Namespace",
+	"WorkRelationshipType.user_defined_value":                   "@UserDefinedValue",
+	"WorkSummary.musical_work_id":                               "MusicalWorkId",
+	"WorkSummary.work_title":                                    "WorkTitle",
+	"WorkSummary.writer":                                        "Writer",
+	"WorkTitle.language_and_script_code":                        "@LanguageAndScriptCode",
+}
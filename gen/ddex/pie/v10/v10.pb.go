@@ -3303,7 +3303,9 @@ type Content struct {
 	// @gotags: xml:"type,attr"
 	Type string `protobuf:"bytes,1,opt,name=type,proto3" json:"type,omitempty" xml:"type,attr"`
 	// @gotags: xml:"src,attr"
-	Src           string `protobuf:"bytes,2,opt,name=src,proto3" json:"src,omitempty" xml:"src,attr"`
+	Src string `protobuf:"bytes,2,opt,name=src,proto3" json:"src,omitempty" xml:"src,attr"`
+	// @gotags: xml:",innerxml"
+	MixedContent  string `protobuf:"bytes,3,opt,name=mixed_content,json=mixedContent,proto3" json:"mixed_content,omitempty" xml:",innerxml"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -3352,6 +3354,13 @@ func (x *Content) GetSrc() string {
 	return ""
 }
 
+func (x *Content) GetMixedContent() string {
+	if x != nil {
+		return x.MixedContent
+	}
+	return ""
+}
+
 type DateTime struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
 	// @gotags: xml:",chardata"
@@ -3563,7 +3572,9 @@ type Link struct {
 	// @gotags: xml:"title,attr"
 	Title string `protobuf:"bytes,5,opt,name=title,proto3" json:"title,omitempty" xml:"title,attr"`
 	// @gotags: xml:"length,attr"
-	Length        int32 `protobuf:"varint,6,opt,name=length,proto3" json:"length,omitempty" xml:"length,attr"`
+	Length int32 `protobuf:"varint,6,opt,name=length,proto3" json:"length,omitempty" xml:"length,attr"`
+	// @gotags: xml:",innerxml"
+	MixedContent  string `protobuf:"bytes,7,opt,name=mixed_content,json=mixedContent,proto3" json:"mixed_content,omitempty" xml:",innerxml"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -3640,6 +3651,13 @@ func (x *Link) GetLength() int32 {
 	return 0
 }
 
+func (x *Link) GetMixedContent() string {
+	if x != nil {
+		return x.MixedContent
+	}
+	return ""
+}
+
 type Logo struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
 	// @gotags: xml:",chardata"
@@ -3895,7 +3913,9 @@ func (x *Source) GetUpdated() *DateTime {
 type Text struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
 	// @gotags: xml:"type,attr"
-	Type          string `protobuf:"bytes,1,opt,name=type,proto3" json:"type,omitempty" xml:"type,attr"`
+	Type string `protobuf:"bytes,1,opt,name=type,proto3" json:"type,omitempty" xml:"type,attr"`
+	// @gotags: xml:",innerxml"
+	MixedContent  string `protobuf:"bytes,2,opt,name=mixed_content,json=mixedContent,proto3" json:"mixed_content,omitempty" xml:",innerxml"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -3937,6 +3957,13 @@ func (x *Text) GetType() string {
 	return ""
 }
 
+func (x *Text) GetMixedContent() string {
+	if x != nil {
+		return x.MixedContent
+	}
+	return ""
+}
+
 type URI struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
 	// @gotags: xml:",chardata"
@@ -9136,10 +9163,11 @@ const file_ddex_pie_v10_v10_proto_rawDesc = "" +
 	"\bCategory\x12\x12\n" +
 	"\x04term\x18\x01 \x01(\tR\x04term\x12\x16\n" +
 	"\x06scheme\x18\x02 \x01(\tR\x06scheme\x12\x14\n" +
-	"\x05label\x18\x03 \x01(\tR\x05label\"/\n" +
+	"\x05label\x18\x03 \x01(\tR\x05label\"T\n" +
 	"\aContent\x12\x12\n" +
 	"\x04type\x18\x01 \x01(\tR\x04type\x12\x10\n" +
-	"\x03src\x18\x02 \x01(\tR\x03src\" \n" +
+	"\x03src\x18\x02 \x01(\tR\x03src\x12#\n" +
+	"\rmixed_content\x18\x03 \x01(\tR\fmixedContent\" \n" +
 	"\bDateTime\x12\x14\n" +
 	"\x05value\x18\x01 \x01(\tR\x05value\"M\n" +
 	"\tGenerator\x12\x14\n" +
@@ -9149,14 +9177,15 @@ const file_ddex_pie_v10_v10_proto_rawDesc = "" +
 	"\x04Icon\x12\x14\n" +
 	"\x05value\x18\x01 \x01(\tR\x05value\"\x1a\n" +
 	"\x02Id\x12\x14\n" +
-	"\x05value\x18\x01 \x01(\tR\x05value\"\x8a\x01\n" +
+	"\x05value\x18\x01 \x01(\tR\x05value\"\xaf\x01\n" +
 	"\x04Link\x12\x12\n" +
 	"\x04href\x18\x01 \x01(\tR\x04href\x12\x10\n" +
 	"\x03rel\x18\x02 \x01(\tR\x03rel\x12\x12\n" +
 	"\x04type\x18\x03 \x01(\tR\x04type\x12\x1a\n" +
 	"\bhreflang\x18\x04 \x01(\tR\bhreflang\x12\x14\n" +
 	"\x05title\x18\x05 \x01(\tR\x05title\x12\x16\n" +
-	"\x06length\x18\x06 \x01(\x05R\x06length\"\x1c\n" +
+	"\x06length\x18\x06 \x01(\x05R\x06length\x12#\n" +
+	"\rmixed_content\x18\a \x01(\tR\fmixedContent\"\x1c\n" +
 	"\x04Logo\x12\x14\n" +
 	"\x05value\x18\x01 \x01(\tR\x05value\"W\n" +
 	"\x06Person\x12\x12\n" +
@@ -9176,9 +9205,10 @@ const file_ddex_pie_v10_v10_proto_rawDesc = "" +
 	"\bsubtitle\x18\n" +
 	" \x01(\v2\x12.ddex.pie.v10.TextR\bsubtitle\x12(\n" +
 	"\x05title\x18\v \x01(\v2\x12.ddex.pie.v10.TextR\x05title\x120\n" +
-	"\aupdated\x18\f \x01(\v2\x16.ddex.pie.v10.DateTimeR\aupdated\"\x1a\n" +
+	"\aupdated\x18\f \x01(\v2\x16.ddex.pie.v10.DateTimeR\aupdated\"?\n" +
 	"\x04Text\x12\x12\n" +
-	"\x04type\x18\x01 \x01(\tR\x04type\"\x1b\n" +
+	"\x04type\x18\x01 \x01(\tR\x04type\x12#\n" +
+	"\rmixed_content\x18\x02 \x01(\tR\fmixedContent\"\x1b\n" +
 	"\x03URI\x12\x14\n" +
 	"\x05value\x18\x01 \x01(\tR\x05value\"Q\n" +
 	"\x10AllTerritoryCode\x12\x14\n" +
@@ -4,8 +4,13 @@ package piev10
 
 import (
 	"encoding/xml"
+	"fmt"
 	"reflect"
+	"runtime"
+	"sort"
 	"strings"
+	"sync"
+	"weak"
 )
 
 // Package-level namespace constants
@@ -15,6 +20,17 @@ const (
 	NamespaceXSI    = "http://www.w3.org/2001/XMLSchema-instance"
 )
 
+// parseWarnings holds the ParseWarnings of root messages by weak pointer, as the generated message
+// structs have no field for them
+var parseWarnings sync.Map
+
+// pieMessageAttrs caches the attributes that struct fields of PieMessage handle, which MarshalXML
+// must not repeat from NamespaceAttrs
+var (
+	pieMessageAttrsOnce sync.Once
+	pieMessageAttrs     map[string]bool
+)
+
 // MarshalXML implements xml.Marshaler for PieMessage
 func (m *PieMessage) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
 	// Set default namespace values if empty
@@ -24,34 +40,38 @@ func (m *PieMessage) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
 	// Set the namespace on the start element
 	start.Name.Space = Namespace
 
-	// Add namespace attributes to the element, avoiding duplicates
-	// Use reflection to find which attributes are already handled by struct fields
-	existingAttrs := make(map[string]bool)
-	v := reflect.ValueOf(m).Elem()
-	t := v.Type()
-	for i := 0; i < v.NumField(); i++ {
-		field := t.Field(i)
-		if xmlTag := field.Tag.Get("xml"); xmlTag != "" && xmlTag != "-" {
-			// Parse the XML tag to get the attribute name
-			if strings.HasSuffix(xmlTag, ",attr") {
-				attrName := strings.TrimSuffix(xmlTag, ",attr")
-				if colonIdx := strings.Index(attrName, ":"); colonIdx >= 0 {
-					// For tags like "xmlns:ern,attr" or "xsi:schemaLocation,attr"
-					existingAttrs[attrName] = true
-				} else if attrName != "" {
-					// For tags like "LanguageAndScriptCode,attr"
-					existingAttrs[attrName] = true
+	// Add namespace attributes to the element, avoiding duplicates. The attributes already handled by
+	// struct fields are fixed per type, so they are found by reflection once and cached
+	pieMessageAttrsOnce.Do(func() {
+		pieMessageAttrs = make(map[string]bool)
+		t := reflect.TypeOf(m).Elem()
+		for i := 0; i < t.NumField(); i++ {
+			field := t.Field(i)
+			if xmlTag := field.Tag.Get("xml"); xmlTag != "" && xmlTag != "-" {
+				// Parse the XML tag to get the attribute name, from tags like "xmlns:ern,attr",
+				// "xsi:schemaLocation,attr" or "LanguageAndScriptCode,attr"
+				if strings.HasSuffix(xmlTag, ",attr") {
+					if attrName := strings.TrimSuffix(xmlTag, ",attr"); attrName != "" {
+						pieMessageAttrs[attrName] = true
+					}
 				}
 			}
 		}
-	}
+	})
+	existingAttrs := pieMessageAttrs
 
-	// Add attributes from the map that aren't already handled
-	for key, value := range m.NamespaceAttrs {
+	// Add attributes from the map that aren't already handled, sorted by key
+	// so that marshaled output is deterministic
+	keys := make([]string, 0, len(m.NamespaceAttrs))
+	for key := range m.NamespaceAttrs {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	for _, key := range keys {
 		if !existingAttrs[key] {
 			start.Attr = append(start.Attr, xml.Attr{
 				Name:  xml.Name{Local: key},
-				Value: value,
+				Value: m.NamespaceAttrs[key],
 			})
 		}
 	}
@@ -67,6 +87,9 @@ func (m *PieMessage) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error
 	if m.NamespaceAttrs == nil {
 		m.NamespaceAttrs = make(map[string]string)
 	}
+	// Namespace declarations of this element, to report conflicting ones as parse warnings
+	var warnings []string
+	declared := make(map[string]string)
 	for _, attr := range start.Attr {
 		// Capture all xmlns:* attributes and xsi:schemaLocation
 		if attr.Name.Space == "xmlns" || attr.Name.Local == "xmlns" ||
@@ -79,17 +102,76 @@ func (m *PieMessage) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error
 				// Preserve the namespace prefix for attributes like xsi:schemaLocation
 				if attr.Name.Space == "http://www.w3.org/2001/XMLSchema-instance" {
 					key = "xsi:" + attr.Name.Local
+					// The document may bind XMLSchema-instance to another prefix; the attribute is
+					// always written back as xsi:, so make sure that prefix is declared
+					if _, ok := m.NamespaceAttrs["xmlns:xsi"]; !ok {
+						m.NamespaceAttrs["xmlns:xsi"] = attr.Name.Space
+					}
 				}
 			}
+			if key == "xmlns" || strings.HasPrefix(key, "xmlns:") {
+				// The map keeps only the last declaration of a prefix, so record the conflict
+				if previous, ok := declared[key]; ok && previous != attr.Value {
+					warnings = append(warnings, fmt.Sprintf("%s is declared twice, as %q and %q", key, previous, attr.Value))
+				}
+				declared[key] = attr.Value
+			}
 			m.NamespaceAttrs[key] = attr.Value
 		}
 	}
+	// Replace the warnings of any earlier parse into m; the entry is dropped when m is collected
+	ref := weak.Make(m)
+	parseWarnings.Delete(ref)
+	if len(warnings) > 0 {
+		parseWarnings.Store(ref, warnings)
+		runtime.AddCleanup(m, func(ref weak.Pointer[PieMessage]) { parseWarnings.Delete(ref) }, ref)
+	}
 
 	// Create an alias type to avoid infinite recursion
 	type alias PieMessage
 	return d.DecodeElement((*alias)(m), &start)
 }
 
+// SetNamespaceAttr sets an attribute written on the root element. key is the attribute's qualified
+// name as it appears in the document: "xmlns" for the default namespace, "xmlns:<prefix>" for a
+// namespace declaration such as "xmlns:avs", or "xsi:schemaLocation".
+func (m *PieMessage) SetNamespaceAttr(key, value string) {
+	if m.NamespaceAttrs == nil {
+		m.NamespaceAttrs = make(map[string]string)
+	}
+	m.NamespaceAttrs[key] = value
+}
+
+// GetNamespaceAttr returns the root element attribute stored under key (see SetNamespaceAttr) and
+// whether it is set
+func (m *PieMessage) GetNamespaceAttr(key string) (string, bool) {
+	if m == nil {
+		return "", false
+	}
+	value, ok := m.NamespaceAttrs[key]
+	return value, ok
+}
+
+// ParseWarnings returns the problems UnmarshalXML found in the root element without failing the
+// parse, namely namespace prefixes declared twice with different namespaces, of which NamespaceAttrs
+// keeps only the last
+func (m *PieMessage) ParseWarnings() []string {
+	if m == nil {
+		return nil
+	}
+	if warnings, ok := parseWarnings.Load(weak.Make(m)); ok {
+		return warnings.([]string)
+	}
+	return nil
+}
+
+// pieRequestMessageAttrs caches the attributes that struct fields of PieRequestMessage handle, which MarshalXML
+// must not repeat from NamespaceAttrs
+var (
+	pieRequestMessageAttrsOnce sync.Once
+	pieRequestMessageAttrs     map[string]bool
+)
+
 // MarshalXML implements xml.Marshaler for PieRequestMessage
 func (m *PieRequestMessage) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
 	// Set default namespace values if empty
@@ -99,34 +181,38 @@ func (m *PieRequestMessage) MarshalXML(e *xml.Encoder, start xml.StartElement) e
 	// Set the namespace on the start element
 	start.Name.Space = Namespace
 
-	// Add namespace attributes to the element, avoiding duplicates
-	// Use reflection to find which attributes are already handled by struct fields
-	existingAttrs := make(map[string]bool)
-	v := reflect.ValueOf(m).Elem()
-	t := v.Type()
-	for i := 0; i < v.NumField(); i++ {
-		field := t.Field(i)
-		if xmlTag := field.Tag.Get("xml"); xmlTag != "" && xmlTag != "-" {
-			// Parse the XML tag to get the attribute name
-			if strings.HasSuffix(xmlTag, ",attr") {
-				attrName := strings.TrimSuffix(xmlTag, ",attr")
-				if colonIdx := strings.Index(attrName, ":"); colonIdx >= 0 {
-					// For tags like "xmlns:ern,attr" or "xsi:schemaLocation,attr"
-					existingAttrs[attrName] = true
-				} else if attrName != "" {
-					// For tags like "LanguageAndScriptCode,attr"
-					existingAttrs[attrName] = true
+	// Add namespace attributes to the element, avoiding duplicates. The attributes already handled by
+	// struct fields are fixed per type, so they are found by reflection once and cached
+	pieRequestMessageAttrsOnce.Do(func() {
+		pieRequestMessageAttrs = make(map[string]bool)
+		t := reflect.TypeOf(m).Elem()
+		for i := 0; i < t.NumField(); i++ {
+			field := t.Field(i)
+			if xmlTag := field.Tag.Get("xml"); xmlTag != "" && xmlTag != "-" {
+				// Parse the XML tag to get the attribute name, from tags like "xmlns:ern,attr",
+				// "xsi:schemaLocation,attr" or "LanguageAndScriptCode,attr"
+				if strings.HasSuffix(xmlTag, ",attr") {
+					if attrName := strings.TrimSuffix(xmlTag, ",attr"); attrName != "" {
+						pieRequestMessageAttrs[attrName] = true
+					}
 				}
 			}
 		}
-	}
+	})
+	existingAttrs := pieRequestMessageAttrs
 
-	// Add attributes from the map that aren't already handled
-	for key, value := range m.NamespaceAttrs {
+	// Add attributes from the map that aren't already handled, sorted by key
+	// so that marshaled output is deterministic
+	keys := make([]string, 0, len(m.NamespaceAttrs))
+	for key := range m.NamespaceAttrs {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	for _, key := range keys {
 		if !existingAttrs[key] {
 			start.Attr = append(start.Attr, xml.Attr{
 				Name:  xml.Name{Local: key},
-				Value: value,
+				Value: m.NamespaceAttrs[key],
 			})
 		}
 	}
@@ -142,6 +228,9 @@ func (m *PieRequestMessage) UnmarshalXML(d *xml.Decoder, start xml.StartElement)
 	if m.NamespaceAttrs == nil {
 		m.NamespaceAttrs = make(map[string]string)
 	}
+	// Namespace declarations of this element, to report conflicting ones as parse warnings
+	var warnings []string
+	declared := make(map[string]string)
 	for _, attr := range start.Attr {
 		// Capture all xmlns:* attributes and xsi:schemaLocation
 		if attr.Name.Space == "xmlns" || attr.Name.Local == "xmlns" ||
@@ -154,13 +243,617 @@ func (m *PieRequestMessage) UnmarshalXML(d *xml.Decoder, start xml.StartElement)
 				// Preserve the namespace prefix for attributes like xsi:schemaLocation
 				if attr.Name.Space == "http://www.w3.org/2001/XMLSchema-instance" {
 					key = "xsi:" + attr.Name.Local
+					// The document may bind XMLSchema-instance to another prefix; the attribute is
+					// always written back as xsi:, so make sure that prefix is declared
+					if _, ok := m.NamespaceAttrs["xmlns:xsi"]; !ok {
+						m.NamespaceAttrs["xmlns:xsi"] = attr.Name.Space
+					}
+				}
+			}
+			if key == "xmlns" || strings.HasPrefix(key, "xmlns:") {
+				// The map keeps only the last declaration of a prefix, so record the conflict
+				if previous, ok := declared[key]; ok && previous != attr.Value {
+					warnings = append(warnings, fmt.Sprintf("%s is declared twice, as %q and %q", key, previous, attr.Value))
 				}
+				declared[key] = attr.Value
 			}
 			m.NamespaceAttrs[key] = attr.Value
 		}
 	}
+	// Replace the warnings of any earlier parse into m; the entry is dropped when m is collected
+	ref := weak.Make(m)
+	parseWarnings.Delete(ref)
+	if len(warnings) > 0 {
+		parseWarnings.Store(ref, warnings)
+		runtime.AddCleanup(m, func(ref weak.Pointer[PieRequestMessage]) { parseWarnings.Delete(ref) }, ref)
+	}
 
 	// Create an alias type to avoid infinite recursion
 	type alias PieRequestMessage
 	return d.DecodeElement((*alias)(m), &start)
 }
+
+// SetNamespaceAttr sets an attribute written on the root element. key is the attribute's qualified
+// name as it appears in the document: "xmlns" for the default namespace, "xmlns:<prefix>" for a
+// namespace declaration such as "xmlns:avs", or "xsi:schemaLocation".
+func (m *PieRequestMessage) SetNamespaceAttr(key, value string) {
+	if m.NamespaceAttrs == nil {
+		m.NamespaceAttrs = make(map[string]string)
+	}
+	m.NamespaceAttrs[key] = value
+}
+
+// GetNamespaceAttr returns the root element attribute stored under key (see SetNamespaceAttr) and
+// whether it is set
+func (m *PieRequestMessage) GetNamespaceAttr(key string) (string, bool) {
+	if m == nil {
+		return "", false
+	}
+	value, ok := m.NamespaceAttrs[key]
+	return value, ok
+}
+
+// ParseWarnings returns the problems UnmarshalXML found in the root element without failing the
+// parse, namely namespace prefixes declared twice with different namespaces, of which NamespaceAttrs
+// keeps only the last
+func (m *PieRequestMessage) ParseWarnings() []string {
+	if m == nil {
+		return nil
+	}
+	if warnings, ok := parseWarnings.Load(weak.Make(m)); ok {
+		return warnings.([]string)
+	}
+	return nil
+}
+
+// GetAvsVersionIdOr returns AvsVersionId, or def when it is empty or x is nil
+func (x *PieMessage) GetAvsVersionIdOr(def string) string {
+	if v := x.GetAvsVersionId(); v != "" {
+		return v
+	}
+	return def
+}
+
+// GetLanguageAndScriptCodeOr returns LanguageAndScriptCode, or def when it is empty or x is nil
+func (x *PieMessage) GetLanguageAndScriptCodeOr(def string) string {
+	if v := x.GetLanguageAndScriptCode(); v != "" {
+		return v
+	}
+	return def
+}
+
+// GetMessageThreadIdOr returns MessageThreadId, or def when it is empty or x is nil
+func (x *MessageHeader) GetMessageThreadIdOr(def string) string {
+	if v := x.GetMessageThreadId(); v != "" {
+		return v
+	}
+	return def
+}
+
+// GetMessageIdOr returns MessageId, or def when it is empty or x is nil
+func (x *MessageHeader) GetMessageIdOr(def string) string {
+	if v := x.GetMessageId(); v != "" {
+		return v
+	}
+	return def
+}
+
+// GetMessageFileNameOr returns MessageFileName, or def when it is empty or x is nil
+func (x *MessageHeader) GetMessageFileNameOr(def string) string {
+	if v := x.GetMessageFileName(); v != "" {
+		return v
+	}
+	return def
+}
+
+// GetMessageCreatedDateTimeOr returns MessageCreatedDateTime, or def when it is empty or x is nil
+func (x *MessageHeader) GetMessageCreatedDateTimeOr(def string) string {
+	if v := x.GetMessageCreatedDateTime(); v != "" {
+		return v
+	}
+	return def
+}
+
+// GetMessageControlTypeOr returns MessageControlType, or def when it is empty or x is nil
+func (x *MessageHeader) GetMessageControlTypeOr(def string) string {
+	if v := x.GetMessageControlType(); v != "" {
+		return v
+	}
+	return def
+}
+
+// GetAvsVersionIdOr returns AvsVersionId, or def when it is empty or x is nil
+func (x *PieRequestMessage) GetAvsVersionIdOr(def string) string {
+	if v := x.GetAvsVersionId(); v != "" {
+		return v
+	}
+	return def
+}
+
+// GetLanguageAndScriptCodeOr returns LanguageAndScriptCode, or def when it is empty or x is nil
+func (x *PieRequestMessage) GetLanguageAndScriptCodeOr(def string) string {
+	if v := x.GetLanguageAndScriptCode(); v != "" {
+		return v
+	}
+	return def
+}
+
+// FieldToElement maps each proto field, keyed "Message.proto_field_name", to the DDEX element it
+// comes from; attributes are prefixed with @. Character data fields are not listed.
+var FieldToElement = map[string]string{
+	"AllTerritoryCode.identifier_type":                          "@IdentifierType",
+	"ArtistType.metadata_source_reference":                      "MetadataSourceReference",
+	"ArtistType.territory_of_artist_type_description":           "TerritoryOfArtistTypeDescription",
+	"ArtistType.value":                                          "Value",
+	"ArtistTypeValue.namespace":                                 "@Namespace",
+	"ArtistTypeValue.user_defined_value":                        "@UserDefinedValue",
+	"ArtisticInfluence.description":                             "Description",
+	"ArtisticInfluence.is_influenced":                           "IsInfluenced",
+	"ArtisticInfluence.is_influencer":                           "IsInfluencer",
+	"ArtisticInfluence.metadata_source_reference":               "MetadataSourceReference",
+	"ArtisticInfluence.party":                                   "Party",
+	"ArtisticInfluence.release":                                 "Release",
+	"ArtisticInfluence.resource":                                "Resource",
+	"ArtisticInfluence.work":                                    "Work",
+	"Award.award_name":                                          "AwardName",
+	"Award.awarded_party":                                       "AwardedParty",
+	"Award.awarding_body":                                       "AwardingBody",
+	"Award.comment":                                             "Comment",
+	"Award.date":                                                "Date",
+	"Award.is_winner":                                           "IsWinner",
+	"Award.metadata_source_reference":                           "MetadataSourceReference",
+	"Biography.author":                                          "Author",
+	"Biography.metadata_source_reference":                       "MetadataSourceReference",
+	"Biography.text":                                            "Text",
+	"BiographyText.applicable_territory_code":                   "@ApplicableTerritoryCode",
+	"BiographyText.format":                                      "@Format",
+	"BiographyText.language_and_script_code":                    "@LanguageAndScriptCode",
+	"BiographyText.namespace":                                   "@Namespace",
+	"BiographyText.user_defined_value":                          "@UserDefinedValue",
+	"CatalogNumber.namespace":                                   "@Namespace",
+	"Category.label":                                            "@label",
+	"Category.scheme":                                           "@scheme",
+	"Category.term":                                             "@term",
+	"ClassicalPeriod.language_and_script_of_classical_period":   "LanguageAndScriptOfClassicalPeriod",
+	"ClassicalPeriod.metadata_source_reference":                 "MetadataSourceReference",
+	"ClassicalPeriod.name":                                      "Name",
+	"ClassicalPeriod.territory_of_classical_period_description": "TerritoryOfClassicalPeriodDescription",
+	"CommentaryNote.author":                                     "Author",
+	"CommentaryNote.commentary_note_type":                       "CommentaryNoteType",
+	"CommentaryNote.language_and_script_of_commentary_note":     "LanguageAndScriptOfCommentaryNote",
+	"CommentaryNote.metadata_source_reference":                  "MetadataSourceReference",
+	"CommentaryNote.territory_of_commentary_note_description":   "TerritoryOfCommentaryNoteDescription",
+	"CommentaryNote.text":                                       "Text",
+	"CommentaryNoteType.namespace":                              "@Namespace",
+	"CommentaryNoteType.user_defined_value":                     "@UserDefinedValue",
+	"Content.src":                                               "@src",
+	"Content.type":                                              "@type",
+	"Contribution.event":                                        "Event",
+	"Contribution.has_made_contracted_contribution":             "HasMadeContractedContribution",
+	"Contribution.has_made_featured_contribution":               "HasMadeFeaturedContribution",
+	"Contribution.is_primary_role":                              "IsPrimaryRole",
+	"Contribution.role":                                         "Role",
+	"ContributorRole.namespace":                                 "@Namespace",
+	"ContributorRole.user_defined_value":                        "@UserDefinedValue",
+	"CreationDescription.display_artist_name":                   "DisplayArtistName",
+	"CreationDescription.publication_date":                      "PublicationDate",
+	"CreationDescription.title":                                 "Title",
+	"Date.is_approximate":                                       "@IsApproximate",
+	"Description.language_and_script_code":                      "@LanguageAndScriptCode",
+	"DetailedHashSum.algorithm":                                 "Algorithm",
+	"DetailedHashSum.data_type":                                 "DataType",
+	"DetailedHashSum.hash_sum_value":                            "HashSumValue",
+	"DetailedHashSum.parameter":                                 "Parameter",
+	"DetailedHashSum.version":                                   "Version",
+	"DetailedPartyId.cisac_society_id":                          "CisacSocietyId",
+	"DetailedPartyId.d_p_i_d":                                   "DPID",
+	"DetailedPartyId.i_p_n":                                     "IPN",
+	"DetailedPartyId.i_s_n_i":                                   "ISNI",
+	"DetailedPartyId.ipi_name_number":                           "IpiNameNumber",
+	"DetailedPartyId.proprietary_id":                            "ProprietaryId",
+	"DetailedPartyIdForParty.cisac_society_id":                  "CisacSocietyId",
+	"DetailedPartyIdForParty.d_p_i_d":                           "DPID",
+	"DetailedPartyIdForParty.i_p_n":                             "IPN",
+	"DetailedPartyIdForParty.i_s_n_i":                           "ISNI",
+	"DetailedPartyIdForParty.ipi_name_number":                   "IpiNameNumber",
+	"DetailedPartyIdForParty.is_deprecated":                     "@IsDeprecated",
+	"DetailedPartyIdForParty.proprietary_id":                    "ProprietaryId",
+	"DisplayArtistName.applicable_territory_code":               "@ApplicableTerritoryCode",
+	"DisplayArtistName.language_and_script_code":                "@LanguageAndScriptCode",
+	"DisplayArtistNameWithDefault.applicable_territory_code":    "@ApplicableTerritoryCode",
+	"DisplayArtistNameWithDefault.is_default":                   "@IsDefault",
+	"DisplayArtistNameWithDefault.language_and_script_code":     "@LanguageAndScriptCode",
+	"DisplayArtistNameWithPronunciation.name":                   "Name",
+	"DisplayArtistNameWithPronunciation.pronunciation":          "Pronunciation",
+	"DisplaySubTitle.is_displayed_in_title":                     "@IsDisplayedInTitle",
+	"DisplaySubTitle.namespace":                                 "@Namespace",
+	"DisplaySubTitle.pronunciation":                             "Pronunciation",
+	"DisplaySubTitle.sequence_number":                           "@SequenceNumber",
+	"DisplaySubTitle.sub_title_type":                            "@SubTitleType",
+	"DisplaySubTitle.title":                                     "Title",
+	"DisplaySubTitle.user_defined_value":                        "@UserDefinedValue",
+	"DisplayTitle.applicable_territory_code":                    "@ApplicableTerritoryCode",
+	"DisplayTitle.is_default":                                   "@IsDefault",
+	"DisplayTitle.language_and_script_code":                     "@LanguageAndScriptCode",
+	"DisplayTitle.sub_title":                                    "SubTitle",
+	"DisplayTitle.title_text":                                   "TitleText",
+	"Entry.author":                                              "author",
+	"Entry.avs_version_id":                                      "@AvsVersionId",
+	"Entry.category":                                            "category",
+	"Entry.content":                                             "content",
+	"Entry.contributor":                                         "contributor",
+	"Entry.id":                                                  "id",
+	"Entry.link":                                                "link",
+	"Entry.party":                                               "Party",
+	"Entry.published":                                           "published",
+	"Entry.rights":                                              "rights",
+	"Entry.source":                                              "source",
+	"Entry.subscription_id":                                     "SubscriptionId",
+	"Entry.summary":                                             "summary",
+	"Entry.title":                                               "title",
+	"Entry.updated":                                             "updated",
+	"Epoch.end_date":                                            "EndDate",
+	"Epoch.language_and_script_of_epoch":                        "LanguageAndScriptOfEpoch",
+	"Epoch.metadata_source_reference":                           "MetadataSourceReference",
+	"Epoch.related_artist":                                      "RelatedArtist",
+	"Epoch.related_creation":                                    "RelatedCreation",
+	"Epoch.start_date":                                          "StartDate",
+	"Epoch.territory_of_epoch_description":                      "TerritoryOfEpochDescription",
+	"Epoch.value":                                               "Value",
+	"Event.date":                                                "Date",
+	"Event.end_date":                                            "EndDate",
+	"Event.event_description":                                   "EventDescription",
+	"Event.event_type":                                          "EventType",
+	"Event.metadata_source_reference":                           "MetadataSourceReference",
+	"Event.start_date":                                          "StartDate",
+	"EventDate.applicable_territory_code":                       "@ApplicableTerritoryCode",
+	"EventDate.is_after":                                        "@IsAfter",
+	"EventDate.is_approximate":                                  "@IsApproximate",
+	"EventDate.is_before":                                       "@IsBefore",
+	"EventDate.language_and_script_code":                        "@LanguageAndScriptCode",
+	"EventDate.location_description":                            "@LocationDescription",
+	"EventType.namespace":                                       "@Namespace",
+	"EventType.user_defined_value":                              "@UserDefinedValue",
+	"Feed.author":                                               "author",
+	"Feed.category":                                             "category",
+	"Feed.contributor":                                          "contributor",
+	"Feed.entry":                                                "entry",
+	"Feed.generator":                                            "generator",
+	"Feed.icon":                                                 "icon",
+	"Feed.id":                                                   "id",
+	"Feed.link":                                                 "link",
+	"Feed.logo":                                                 "logo",
+	"Feed.rights":                                               "rights",
+	"Feed.subtitle":                                             "subtitle",
+	"Feed.title":                                                "title",
+	"Feed.updated":                                              "updated",
+	"File.file_size":                                            "FileSize",
+	"File.hash_sum":                                             "HashSum",
+	"File.u_r_i":                                                "URI",
+	"Focus.comment":                                             "Comment",
+	"Focus.display_artist":                                      "DisplayArtist",
+	"Focus.display_artist_name":                                 "DisplayArtistName",
+	"Focus.focus_release":                                       "FocusRelease",
+	"Focus.focus_track":                                         "FocusTrack",
+	"Focus.focus_work":                                          "FocusWork",
+	"Focus.is_default":                                          "@IsDefault",
+	"Focus.metadata_source_reference":                           "MetadataSourceReference",
+	"Focus.period_of_being_focus":                               "PeriodOfBeingFocus",
+	"Focus.sequence_number":                                     "SequenceNumber",
+	"Focus.territory_of_being_focus_track_description":          "TerritoryOfBeingFocusTrackDescription",
+	"Focus.writer":                                              "Writer",
+	"Gender.do_not_display":                                     "@DoNotDisplay",
+	"Gender.metadata_source_reference":                          "MetadataSourceReference",
+	"Gender.value":                                              "Value",
+	"GenderValue.namespace":                                     "@Namespace",
+	"GenderValue.user_defined_value":                            "@UserDefinedValue",
+	"Generator.uri":                                             "@uri",
+	"Generator.version":                                         "@version",
+	"HashSumAlgorithmType.namespace":                            "@Namespace",
+	"HashSumAlgorithmType.user_defined_value":                   "@UserDefinedValue",
+	"Image.file":                                                "File",
+	"Image.image_type":                                          "ImageType",
+	"Image.metadata_source_reference":                           "MetadataSourceReference",
+	"ImageType.namespace":                                       "@Namespace",
+	"ImageType.user_defined_value":                              "@UserDefinedValue",
+	"Link.href":                                                 "@href",
+	"Link.hreflang":                                             "@hreflang",
+	"Link.length":                                               "@length",
+	"Link.rel":                                                  "@rel",
+	"Link.title":                                                "@title",
+	"Link.type":                                                 "@type",
+	"MessageAuditTrail.message_audit_trail_event":               "MessageAuditTrailEvent",
+	"MessageAuditTrailEvent.date_time":                          "DateTime",
+	"MessageAuditTrailEvent.messaging_party_descriptor":         "MessagingPartyDescriptor",
+	"MessageHeader.message_audit_trail":                         "MessageAuditTrail",
+	"MessageHeader.message_control_type":                        "MessageControlType",
+	"MessageHeader.message_created_date_time":                   "MessageCreatedDateTime",
+	"MessageHeader.message_file_name":                           "MessageFileName",
+	"MessageHeader.message_id":                                  "MessageId",
+	"MessageHeader.message_recipient":                           "MessageRecipient",
+	"MessageHeader.message_sender":                              "MessageSender",
+	"MessageHeader.message_thread_id":                           "MessageThreadId",
+	"MessageHeader.sent_on_behalf_of":                           "SentOnBehalfOf",
+	"MessagingPartyWithoutCode.party_id":                        "PartyId",
+	"MessagingPartyWithoutCode.party_name":                      "PartyName",
+	"MessagingPartyWithoutCode.trading_name":                    "TradingName",
+	"MetadataSource.metadata_source_type":                       "MetadataSourceType",
+	"MetadataSource.party_id":                                   "PartyId",
+	"MetadataSource.party_name":                                 "PartyName",
+	"MetadataSource.source_reference":                           "SourceReference",
+	"MetadataSourceList.metadata_source":                        "MetadataSource",
+	"MetadataSourceReference.assertion_date_time":               "@AssertionDateTime",
+	"MetadataSourceReference.status":                            "@Status",
+	"MetadataSourceReference.weight":                            "@Weight",
+	"MetadataSourceType.namespace":                              "@Namespace",
+	"MetadataSourceType.user_defined_value":                     "@UserDefinedValue",
+	"MusicalWorkIdWithoutFlag.composer_catalog_number":          "ComposerCatalogNumber",
+	"MusicalWorkIdWithoutFlag.i_s_w_c":                          "ISWC",
+	"MusicalWorkIdWithoutFlag.opus_number":                      "OpusNumber",
+	"MusicalWorkIdWithoutFlag.proprietary_id":                   "ProprietaryId",
+	"Name.language_and_script_code":                             "@LanguageAndScriptCode",
+	"NameId.i_p_n":                                              "IPN",
+	"NameId.i_s_n_i":                                            "ISNI",
+	"NameId.ipi_name_number":                                    "IpiNameNumber",
+	"NameId.is_deprecated":                                      "@IsDeprecated",
+	"NameId.proprietary_id":                                     "ProprietaryId",
+	"NameWithPronunciation.name":                                "Name",
+	"NameWithPronunciation.pronunciation":                       "Pronunciation",
+	"NameWithPronunciationAndScriptCode.name":                   "Name",
+	"NameWithPronunciationAndScriptCode.pronunciation":          "Pronunciation",
+	"NameWithScriptCode.name":                                   "Name",
+	"NameWithScriptCode.pronunciation":                          "Pronunciation",
+	"Nationality.metadata_source_reference":                     "MetadataSourceReference",
+	"Nationality.value":                                         "Value",
+	"Party.artist_type":                                         "ArtistType",
+	"Party.artistic_influence":                                  "ArtisticInfluence",
+	"Party.award":                                               "Award",
+	"Party.biography":                                           "Biography",
+	"Party.classical_period":                                    "ClassicalPeriod",
+	"Party.commentary_note":                                     "CommentaryNote",
+	"Party.epoch":                                               "Epoch",
+	"Party.event":                                               "Event",
+	"Party.focus":                                               "Focus",
+	"Party.gender":                                              "Gender",
+	"Party.image":                                               "Image",
+	"Party.language_and_script_code":                            "@LanguageAndScriptCode",
+	"Party.nationality":                                         "Nationality",
+	"Party.party_id":                                            "PartyId",
+	"Party.party_name":                                          "PartyName",
+	"Party.party_reference":                                     "PartyReference",
+	"Party.party_type":                                          "PartyType",
+	"Party.primary_role":                                        "PrimaryRole",
+	"Party.related_creation":                                    "RelatedCreation",
+	"Party.related_party":                                       "RelatedParty",
+	"Party.social_media_u_r_l":                                  "SocialMediaURL",
+	"Party.vocal_register":                                      "VocalRegister",
+	"PartyDescriptorForEntry.party_id":                          "PartyId",
+	"PartyDescriptorForEntry.party_name":                        "PartyName",
+	"PartyDescriptorWithPronunciation.party_id":                 "PartyId",
+	"PartyDescriptorWithPronunciation.party_name":               "PartyName",
+	"PartyList.party":                                           "Party",
+	"PartyName.abbreviated_name":                                "AbbreviatedName",
+	"PartyName.applicable_territory_code":                       "@ApplicableTerritoryCode",
+	"PartyName.full_name":                                       "FullName",
+	"PartyName.full_name_ascii_transcribed":                     "FullNameAsciiTranscribed",
+	"PartyName.full_name_indexed":                               "FullNameIndexed",
+	"PartyName.is_canonical":                                    "@IsCanonical",
+	"PartyName.is_default":                                      "@IsDefault",
+	"PartyName.key_name":                                        "KeyName",
+	"PartyName.language_and_script_code":                        "@LanguageAndScriptCode",
+	"PartyName.metadata_source_reference":                       "MetadataSourceReference",
+	"PartyName.name_id":                                         "NameId",
+	"PartyName.names_after_key_name":                            "NamesAfterKeyName",
+	"PartyName.names_before_key_name":                           "NamesBeforeKeyName",
+	"PartyName.party_name_format":                               "PartyNameFormat",
+	"PartyName.party_name_purpose":                              "PartyNamePurpose",
+	"PartyName.party_name_type":                                 "PartyNameType",
+	"PartyName.reason_for_name_change":                          "ReasonForNameChange",
+	"PartyName.related_creation":                                "RelatedCreation",
+	"PartyName.short_name":                                      "ShortName",
+	"PartyName.titles_after_names":                              "TitlesAfterNames",
+	"PartyName.titles_before_names":                             "TitlesBeforeNames",
+	"PartyName.validity_period":                                 "ValidityPeriod",
+	"PartyNameForRequest.abbreviated_name":                      "AbbreviatedName",
+	"PartyNameForRequest.full_name":                             "FullName",
+	"PartyNameForRequest.full_name_ascii_transcribed":           "FullNameAsciiTranscribed",
+	"PartyNameForRequest.full_name_indexed":                     "FullNameIndexed",
+	"PartyNameForRequest.key_name":                              "KeyName",
+	"PartyNameForRequest.language_and_script_code":              "@LanguageAndScriptCode",
+	"PartyNameForRequest.names_after_key_name":                  "NamesAfterKeyName",
+	"PartyNameForRequest.names_before_key_name":                 "NamesBeforeKeyName",
+	"PartyNameFormat.namespace":                                 "@Namespace",
+	"PartyNameFormat.user_defined_value":                        "@UserDefinedValue",
+	"PartyNamePurpose.namespace":                                "@Namespace",
+	"PartyNamePurpose.user_defined_value":                       "@UserDefinedValue",
+	"PartyNameType.namespace":                                   "@Namespace",
+	"PartyNameType.user_defined_value":                          "@UserDefinedValue",
+	"PartyNameWithPronunciation.abbreviated_name":               "AbbreviatedName",
+	"PartyNameWithPronunciation.applicable_territory_code":      "@ApplicableTerritoryCode",
+	"PartyNameWithPronunciation.full_name":                      "FullName",
+	"PartyNameWithPronunciation.full_name_ascii_transcribed":    "FullNameAsciiTranscribed",
+	"PartyNameWithPronunciation.full_name_indexed":              "FullNameIndexed",
+	"PartyNameWithPronunciation.key_name":                       "KeyName",
+	"PartyNameWithPronunciation.language_and_script_code":       "@LanguageAndScriptCode",
+	"PartyNameWithPronunciation.names_after_key_name":           "NamesAfterKeyName",
+	"PartyNameWithPronunciation.names_before_key_name":          "NamesBeforeKeyName",
+	"PartyNameWithoutCode.abbreviated_name":                     "AbbreviatedName",
+	"PartyNameWithoutCode.full_name":                            "FullName",
+	"PartyNameWithoutCode.full_name_ascii_transcribed":          "FullNameAsciiTranscribed",
+	"PartyNameWithoutCode.full_name_indexed":                    "FullNameIndexed",
+	"PartyNameWithoutCode.key_name":                             "KeyName",
+	"PartyNameWithoutCode.names_after_key_name":                 "NamesAfterKeyName",
+	"PartyNameWithoutCode.names_before_key_name":                "NamesBeforeKeyName",
+	"PartyRelationshipType.namespace":                           "@Namespace",
+	"PartyRelationshipType.user_defined_value":                  "@UserDefinedValue",
+	"PartyType.metadata_source_reference":                       "MetadataSourceReference",
+	"PartyType.value":                                           "Value",
+	"PartyTypeValue.namespace":                                  "@Namespace",
+	"PartyTypeValue.user_defined_value":                         "@UserDefinedValue",
+	"PeriodValue.namespace":                                     "@Namespace",
+	"PeriodValue.user_defined_value":                            "@UserDefinedValue",
+	"PeriodWithTime.end_date_time":                              "EndDateTime",
+	"PeriodWithTime.start_date_time":                            "StartDateTime",
+	"Person.email":                                              "email",
+	"Person.name":                                               "name",
+	"Person.uri":                                                "uri",
+	"PieMessage.avs_version_id":                                 "@AvsVersionId",
+	"PieMessage.language_and_script_code":                       "@LanguageAndScriptCode",
+	"PieMessage.message_header":                                 "MessageHeader",
+	"PieMessage.metadata_source_list":                           "MetadataSourceList",
+	"PieMessage.party_list":                                     "PartyList",
+	"PieRequestMessage.avs_version_id":                          "@AvsVersionId",
+	"PieRequestMessage.language_and_script_code":                "@LanguageAndScriptCode",
+	"PieRequestMessage.message_header":                          "MessageHeader",
+	"PieRequestMessage.requested_party":                         "RequestedParty",
+	"PrimaryRole.metadata_source_reference":                     "MetadataSourceReference",
+	"PrimaryRole.value":                                         "Value",
+	"Pronunciation.encoding_namespace":                          "@EncodingNamespace",
+	"Pronunciation.encoding_type":                               "@EncodingType",
+	"Pronunciation.encoding_user_defined_value":                 "@EncodingUserDefinedValue",
+	"Pronunciation.is_canonical":                                "@IsCanonical",
+	"Pronunciation.language_code":                               "@LanguageCode",
+	"PronunciationForParty.encoding_namespace":                  "@EncodingNamespace",
+	"PronunciationForParty.encoding_type":                       "@EncodingType",
+	"PronunciationForParty.encoding_user_defined_value":         "@EncodingUserDefinedValue",
+	"PronunciationForParty.is_canonical":                        "@IsCanonical",
+	"PronunciationForParty.is_mispronounced":                    "@IsMispronounced",
+	"PronunciationForParty.language_code":                       "@LanguageCode",
+	"ProprietaryId.namespace":                                   "@Namespace",
+	"ReasonForNameChange.namespace":                             "@Namespace",
+	"ReasonForNameChange.user_defined_value":                    "@UserDefinedValue",
+	"RelatedCreation.musical_work_id":                           "MusicalWorkId",
+	"RelatedCreation.release_id":                                "ReleaseId",
+	"RelatedCreation.resource_id":                               "ResourceId",
+	"RelatedCreation.title":                                     "Title",
+	"RelatedCreationForParty.contract":                          "Contract",
+	"RelatedCreationForParty.contribution":                      "Contribution",
+	"RelatedCreationForParty.creation_description":              "CreationDescription",
+	"RelatedCreationForParty.creation_type":                     "CreationType",
+	"RelatedCreationForParty.is_confidential":                   "@IsConfidential",
+	"RelatedCreationForParty.is_false":                          "@IsFalse",
+	"RelatedCreationForParty.is_first_creation":                 "@IsFirstCreation",
+	"RelatedCreationForParty.is_last_creation":                  "@IsLastCreation",
+	"RelatedCreationForParty.metadata_source_reference":         "MetadataSourceReference",
+	"RelatedCreationForParty.musical_work_id":                   "MusicalWorkId",
+	"RelatedCreationForParty.relationship_description":          "RelationshipDescription",
+	"RelatedCreationForParty.release_id":                        "ReleaseId",
+	"RelatedCreationForParty.resource_id":                       "ResourceId",
+	"RelatedParty.business_purpose":                             "BusinessPurpose",
+	"RelatedParty.contract":                                     "Contract",
+	"RelatedParty.description":                                  "Description",
+	"RelatedParty.is_confidential":                              "@IsConfidential",
+	"RelatedParty.is_false":                                     "@IsFalse",
+	"RelatedParty.metadata_source_reference":                    "MetadataSourceReference",
+	"RelatedParty.party_id":                                     "PartyId",
+	"RelatedParty.party_name":                                   "PartyName",
+	"RelatedParty.party_related_party_reference":                "PartyRelatedPartyReference",
+	"RelatedParty.party_relationship_type":                      "PartyRelationshipType",
+	"RelatedParty.related_creation":                             "RelatedCreation",
+	"RelatedParty.validity_period":                              "ValidityPeriod",
+	"Release.display_artist":                                    "DisplayArtist",
+	"Release.display_artist_name":                               "DisplayArtistName",
+	"Release.g_rid":                                             "GRid",
+	"Release.i_c_p_n":                                           "ICPN",
+	"Release.proprietary_release_id":                            "ProprietaryReleaseId",
+	"Release.release_title":                                     "ReleaseTitle",
+	"ReleaseForRequest.release_id":                              "ReleaseId",
+	"ReleaseForRequest.release_title":                           "ReleaseTitle",
+	"ReleaseId.catalog_number":                                  "CatalogNumber",
+	"ReleaseId.g_rid":                                           "GRid",
+	"ReleaseId.i_c_p_n":                                         "ICPN",
+	"ReleaseId.i_s_r_c":                                         "ISRC",
+	"ReleaseId.proprietary_id":                                  "ProprietaryId",
+	"ReleaseSummary.display_artist":                             "DisplayArtist",
+	"ReleaseSummary.display_artist_name":                        "DisplayArtistName",
+	"ReleaseSummary.display_title":                              "DisplayTitle",
+	"ReleaseSummary.release_id":                                 "ReleaseId",
+	"ReleaseTitle.language_and_script_code":                     "@LanguageAndScriptCode",
+	"RequestedParty.party_id":                                   "PartyId",
+	"RequestedParty.party_name":                                 "PartyName",
+	"RequestedParty.release":                                    "Release",
+	"RequestedParty.resource":                                   "Resource",
+	"RequestedParty.role":                                       "Role",
+	"RequestedParty.work":                                       "Work",
+	"Resource.catalog_number":                                   "CatalogNumber",
+	"Resource.display_artist":                                   "DisplayArtist",
+	"Resource.display_artist_name":                              "DisplayArtistName",
+	"Resource.i_s_r_c":                                          "ISRC",
+	"Resource.proprietary_resource_id":                          "ProprietaryResourceId",
+	"Resource.resource_title":                                   "ResourceTitle",
+	"ResourceForRequest.resource_id":                            "ResourceId",
+	"ResourceForRequest.resource_title":                         "ResourceTitle",
+	"ResourceForRequest.resource_type":                          "ResourceType",
+	"ResourceIdWithoutFlag.catalog_number":                      "CatalogNumber",
+	"ResourceIdWithoutFlag.i_s_a_n":                             "ISAN",
+	"ResourceIdWithoutFlag.i_s_b_n":                             "ISBN",
+	"ResourceIdWithoutFlag.i_s_m_n":                             "ISMN",
+	"ResourceIdWithoutFlag.i_s_r_c":                             "ISRC",
+	"ResourceIdWithoutFlag.i_s_s_n":                             "ISSN",
+	"ResourceIdWithoutFlag.proprietary_id":                      "ProprietaryId",
+	"ResourceIdWithoutFlag.s_i_c_i":                             "SICI",
+	"ResourceIdWithoutFlag.v_i_s_a_n":                           "VISAN",
+	"ResourceSummary.display_artist":                            "DisplayArtist",
+	"ResourceSummary.display_artist_name":                       "DisplayArtistName",
+	"ResourceSummary.display_title":                             "DisplayTitle",
+	"ResourceSummary.resource_id":                               "ResourceId",
+	"ResourceTitle.language_and_script_code":                    "@LanguageAndScriptCode",
+	"SocialMediaURL.applicable_territory_code":                  "@ApplicableTerritoryCode",
+	"SocialMediaURL.language_code":                              "@LanguageCode",
+	"Source.author":                                             "author",
+	"Source.category":                                           "category",
+	"Source.contributor":                                        "contributor",
+	"Source.generator":                                          "generator",
+	"Source.icon":                                               "icon",
+	"Source.id":                                                 "id",
+	"Source.link":                                               "link",
+	"Source.logo":                                               "logo",
+	"Source.rights":                                             "rights",
+	"Source.subtitle":                                           "subtitle",
+	"Source.title":                                              "title",
+	"Source.updated":                                            "updated",
+	"SubTitle.sub_title_type":                                   "@SubTitleType",
+	"Text.type":                                                 "@type",
+	"TextWithFormat.applicable_territory_code":                  "@ApplicableTerritoryCode",
+	"TextWithFormat.format":                                     "@Format",
+	"TextWithFormat.is_default":                                 "@IsDefault",
+	"TextWithFormat.language_and_script_code":                   "@LanguageAndScriptCode",
+	"TextWithFormat.namespace":                                  "@Namespace",
+	"TextWithFormat.user_defined_value":                         "@UserDefinedValue",
+	"TextWithoutTerritory.applicable_territory_code":            "@ApplicableTerritoryCode",
+	"TextWithoutTerritory.format":                               "@Format",
+	"TextWithoutTerritory.is_default":                           "@IsDefault",
+	"TextWithoutTerritory.language_and_script_code":             "@LanguageAndScriptCode",
+	"TextWithoutTerritory.namespace":                            "@Namespace",
+	"TextWithoutTerritory.user_defined_value":                   "@UserDefinedValue",
+	"TitleText.pronunciation":                                   "Pronunciation",
+	"TitleText.title":                                           "Title",
+	"TitleWithPronunciation.language_and_script_code":           "@LanguageAndScriptCode",
+	"TitleWithPronunciation.namespace":                          "@Namespace",
+	"TitleWithPronunciation.sub_title":                          "SubTitle",
+	"TitleWithPronunciation.title_text":                         "TitleText",
+	"TitleWithPronunciation.title_type":                         "@TitleType",
+	"TitleWithPronunciation.user_defined_value":                 "@UserDefinedValue",
+	"TitleWithUDV.language_and_script_code":                     "@LanguageAndScriptCode",
+	"TitleWithUDV.namespace":                                    "@Namespace",
+	"TitleWithUDV.sub_title":                                    "SubTitle",
+	"TitleWithUDV.title_text":                                   "TitleText",
+	"TitleWithUDV.title_type":                                   "@TitleType",
+	"TitleWithUDV.user_defined_value":                           "@UserDefinedValue",
+	"ValidityPeriod.end_date":                                   "EndDate",
+	"ValidityPeriod.start_date":                                 "StartDate",
+	"VocalRegister.metadata_source_reference":                   "MetadataSourceReference",
+	"VocalRegister.value":                                       "Value",
+	"VocalRegisterValue.namespace":                              "@Namespace",
+	"VocalRegisterValue.user_defined_value":                     "@UserDefinedValue",
+	"Work.i_s_w_c":                                              "ISWC",
+	"Work.proprietary_work_id":                                  "ProprietaryWorkId",
+	"Work.work_title":                                           "WorkTitle",
+	"Work.writer":                                               "Writer",
+	"WorkForRequest.work_id":                                    "WorkId",
+	"WorkForRequest.work_title":                                 "WorkTitle",
+	"WorkSummary.musical_work_id":                               "MusicalWorkId",
+	"WorkSummary.work_title":                                    "WorkTitle",
+	"WorkSummary.writer":                                        "Writer",
+	"WorkTitle.language_and_script_code":                        "@LanguageAndScriptCode",
+}
@@ -6,6 +6,7 @@ import (
 	"encoding/xml"
 	"fmt"
 	"reflect"
+	"sort"
 	"strings"
 
 	// Auto-generated imports for all DDEX message types
@@ -235,3 +236,772 @@ func GetAvailableTypes() []string {
 	}
 	return types
 }
+
+// deprecatedElements lists, by generated type, the child elements and attributes that the DDEX
+// schema documents as deprecated
+var deprecatedElements = map[reflect.Type][]string{
+	reflect.TypeOf(ernv381.DealTerms{}):                        {"AllDealsCancelled", "PreOrderPreviewDate", "PreOrderPreviewDateTime", "TakeDown"},
+	reflect.TypeOf(ernv381.ICPN{}):                             {"IsEan"},
+	reflect.TypeOf(ernv381.Image{}):                            {"IsUpdated"},
+	reflect.TypeOf(ernv381.MIDI{}):                             {"IsBonusResource", "IsUpdated", "MasteredDate", "RemasteredDate"},
+	reflect.TypeOf(ernv381.MidiDetailsByTerritory{}):           {"RemasteredDate"},
+	reflect.TypeOf(ernv381.MusicalWork{}):                      {"IsUpdated"},
+	reflect.TypeOf(ernv381.NewReleaseMessage{}):                {"UpdateIndicator"},
+	reflect.TypeOf(ernv381.Release{}):                          {"GlobalOriginalReleaseDate", "GlobalReleaseDate"},
+	reflect.TypeOf(ernv381.ReleaseDetailsByTerritory{}):        {"OriginalDigitalReleaseDate"},
+	reflect.TypeOf(ernv381.SheetMusic{}):                       {"IsUpdated"},
+	reflect.TypeOf(ernv381.Software{}):                         {"IsUpdated"},
+	reflect.TypeOf(ernv381.SoundRecording{}):                   {"IsBonusResource", "IsUpdated"},
+	reflect.TypeOf(ernv381.SoundRecordingDetailsByTerritory{}): {"RemasteredDate"},
+	reflect.TypeOf(ernv381.Text{}):                             {"IsUpdated"},
+	reflect.TypeOf(ernv381.UserDefinedResource{}):              {"IsUpdated"},
+	reflect.TypeOf(ernv381.Video{}):                            {"IsBonusResource", "IsUpdated"},
+	reflect.TypeOf(ernv383.DealTerms{}):                        {"AllDealsCancelled", "PreOrderPreviewDate", "PreOrderPreviewDateTime", "TakeDown"},
+	reflect.TypeOf(ernv383.ICPN{}):                             {"IsEan"},
+	reflect.TypeOf(ernv383.Image{}):                            {"IsUpdated"},
+	reflect.TypeOf(ernv383.MIDI{}):                             {"IsBonusResource", "IsUpdated", "MasteredDate", "RemasteredDate"},
+	reflect.TypeOf(ernv383.MidiDetailsByTerritory{}):           {"RemasteredDate"},
+	reflect.TypeOf(ernv383.MusicalWork{}):                      {"IsUpdated"},
+	reflect.TypeOf(ernv383.NewReleaseMessage{}):                {"UpdateIndicator"},
+	reflect.TypeOf(ernv383.Release{}):                          {"GlobalOriginalReleaseDate", "GlobalReleaseDate"},
+	reflect.TypeOf(ernv383.ReleaseDetailsByTerritory{}):        {"OriginalDigitalReleaseDate"},
+	reflect.TypeOf(ernv383.SheetMusic{}):                       {"IsUpdated"},
+	reflect.TypeOf(ernv383.Software{}):                         {"IsUpdated"},
+	reflect.TypeOf(ernv383.SoundRecording{}):                   {"IsBonusResource", "IsUpdated"},
+	reflect.TypeOf(ernv383.SoundRecordingDetailsByTerritory{}): {"RemasteredDate"},
+	reflect.TypeOf(ernv383.Text{}):                             {"IsUpdated"},
+	reflect.TypeOf(ernv383.UserDefinedResource{}):              {"IsUpdated"},
+	reflect.TypeOf(ernv383.Video{}):                            {"IsBonusResource", "IsUpdated"},
+	reflect.TypeOf(ernv42.PeriodWithStartDate{}):               {"EndDate", "StartDate"},
+	reflect.TypeOf(ernv42.PeriodWithoutFlags{}):                {"EndDate", "StartDate"},
+	reflect.TypeOf(ernv43.PeriodWithStartDate{}):               {"EndDate", "StartDate"},
+	reflect.TypeOf(ernv43.PeriodWithoutFlags{}):                {"EndDate", "StartDate"},
+	reflect.TypeOf(ernv43.SoundRecording{}):                    {"IsInstrumental"},
+	reflect.TypeOf(ernv43.Video{}):                             {"IsInstrumental"},
+	reflect.TypeOf(ernv432.Contributor{}):                      {"InstrumentType"},
+	reflect.TypeOf(ernv432.PeriodWithStartDate{}):              {"EndDate", "StartDate"},
+	reflect.TypeOf(ernv432.PeriodWithoutFlags{}):               {"EndDate", "StartDate"},
+	reflect.TypeOf(ernv432.SoundRecording{}):                   {"IsInstrumental"},
+	reflect.TypeOf(ernv432.Video{}):                            {"IsInstrumental"},
+}
+
+// DeprecatedFieldsUsed returns the sorted paths of the populated elements and attributes of a parsed
+// message that the DDEX schema documents as deprecated, e.g. /NewReleaseMessage/ResourceList/SoundRecording/IsInstrumental
+func DeprecatedFieldsUsed(msg interface{}) []string {
+	seen := make(map[string]bool)
+	var paths []string
+
+	var walk func(v reflect.Value, path string)
+	walk = func(v reflect.Value, path string) {
+		switch v.Kind() {
+		case reflect.Ptr, reflect.Interface:
+			if !v.IsNil() {
+				walk(v.Elem(), path)
+			}
+		case reflect.Slice:
+			if v.Type().Elem().Kind() == reflect.Uint8 {
+				return
+			}
+			for i := 0; i < v.Len(); i++ {
+				walk(v.Index(i), path)
+			}
+		case reflect.Struct:
+			deprecated := deprecatedElements[v.Type()]
+			t := v.Type()
+			for i := 0; i < t.NumField(); i++ {
+				tag := t.Field(i).Tag.Get("xml")
+				if !t.Field(i).IsExported() || tag == "" || tag == "-" {
+					continue
+				}
+				name, options, _ := strings.Cut(tag, ",")
+				if name == "" {
+					continue
+				}
+
+				isAttr := strings.Contains(options, "attr")
+				childPath := path + "/" + name
+				if isAttr {
+					childPath = path + "@" + name
+				}
+				for _, deprecatedName := range deprecated {
+					if deprecatedName == name && !v.Field(i).IsZero() && !seen[childPath] {
+						seen[childPath] = true
+						paths = append(paths, childPath)
+					}
+				}
+				if !isAttr {
+					walk(v.Field(i), childPath)
+				}
+			}
+		}
+	}
+
+	v := reflect.ValueOf(msg)
+	for v.Kind() == reflect.Ptr || v.Kind() == reflect.Interface {
+		if v.IsNil() {
+			return nil
+		}
+		v = v.Elem()
+	}
+	if v.Kind() == reflect.Struct {
+		walk(v, "/"+v.Type().Name())
+	}
+
+	sort.Strings(paths)
+	return paths
+}
+
+// childElementOrder maps generated types to the order the DDEX schema sequence requires of their
+// child elements
+var childElementOrder = map[reflect.Type][]string{
+	reflect.TypeOf(ernv381.ArtistDelegatedUsageRights{}):              {"UseType", "UserInterfaceType", "PeriodOfRightsDelegation", "TerritoryOfRightsDelegation", "MembershipType"},
+	reflect.TypeOf(ernv381.AvRating{}):                                {"RatingText", "RatingAgency", "RatingSchemeDescription"},
+	reflect.TypeOf(ernv381.CLine{}):                                   {"Year", "CLineCompany", "CLineText"},
+	reflect.TypeOf(ernv381.CatalogItem{}):                             {"TerritoryCode", "ReleaseId", "Title", "DisplayArtistName", "ContributorName", "DisplayTitle", "LabelName", "Genre", "PLine", "CLine", "ReleaseDate"},
+	reflect.TypeOf(ernv381.CatalogListMessage{}):                      {"MessageHeader", "PublicationDate", "CatalogItem"},
+	reflect.TypeOf(ernv381.CatalogTransfer{}):                         {"CatalogTransferCompleted", "EffectiveTransferDate", "CatalogReleaseReferenceList", "TerritoryCode", "ExcludedTerritoryCode", "TransferringFrom", "TransferringTo"},
+	reflect.TypeOf(ernv381.Collection{}):                              {"CollectionId", "CollectionType", "CollectionReference", "EquivalentReleaseReference", "Title", "SequenceNumber", "Contributor", "Character", "CollectionCollectionReferenceList", "IsComplete", "Duration", "DurationOfMusicalContent", "CreationDate", "ReleaseDate", "OriginalReleaseDate", "OriginalLanguage", "CollectionDetailsByTerritory", "CollectionResourceReferenceList", "CollectionWorkReferenceList", "RepresentativeImageReference", "PLine", "CLine"},
+	reflect.TypeOf(ernv381.CollectionCollectionReference{}):           {"SequenceNumber", "CollectionCollectionReference", "StartTime", "Duration", "EndTime", "InclusionDate"},
+	reflect.TypeOf(ernv381.CollectionCollectionReferenceList{}):       {"NumberOfCollections", "CollectionCollectionReference"},
+	reflect.TypeOf(ernv381.CollectionDetailsByTerritory{}):            {"TerritoryCode", "ExcludedTerritoryCode", "Title", "Contributor", "IsComplete", "Character"},
+	reflect.TypeOf(ernv381.CollectionId{}):                            {"GRid", "ISRC", "ISAN", "VISAN", "ICPN", "CatalogNumber", "ProprietaryId"},
+	reflect.TypeOf(ernv381.CollectionResourceReference{}):             {"SequenceNumber", "CollectionResourceReference", "Duration"},
+	reflect.TypeOf(ernv381.CollectionWorkReference{}):                 {"CollectionWorkReference", "Duration"},
+	reflect.TypeOf(ernv381.Condition{}):                               {"Value", "Unit", "RelationalRelator"},
+	reflect.TypeOf(ernv381.ContactId{}):                               {"EmailAddress", "PhoneNumber", "FaxNumber"},
+	reflect.TypeOf(ernv381.CreationId{}):                              {"ISWC", "OpusNumber", "ComposerCatalogNumber", "ISRC", "ISMN", "ISAN", "VISAN", "ISBN", "ISSN", "SICI", "CatalogNumber", "ProprietaryId"},
+	reflect.TypeOf(ernv381.Cue{}):                                     {"CueUseType", "CueThemeType", "CueVocalType", "IsDance", "CueVisualPerceptionType", "CueOrigin", "CueCreationReference", "ReferencedCreationType", "ReferencedCreationId", "ReferencedCreationTitle", "ReferencedCreationContributor", "ReferencedIndirectCreationContributor", "ReferencedCreationCharacter", "HasMusicalContent", "StartTime", "Duration", "EndTime", "PLine", "CLine"},
+	reflect.TypeOf(ernv381.CueCreationReference{}):                    {"CueWorkReference", "CueResourceReference"},
+	reflect.TypeOf(ernv381.CueSheet{}):                                {"CueSheetId", "CueSheetReference", "CueSheetType", "Cue"},
+	reflect.TypeOf(ernv381.Deal{}):                                    {"DealReference", "DealTerms", "ResourceUsage", "DealTechnicalResourceDetailsReferenceList", "DistributionChannelPage"},
+	reflect.TypeOf(ernv381.DealResourceReferenceList{}):               {"DealResourceReference", "Period"},
+	reflect.TypeOf(ernv381.DealTerms{}):                               {"IsPreOrderDeal", "CommercialModelType", "Usage", "AllDealsCancelled", "TakeDown", "TerritoryCode", "ExcludedTerritoryCode", "DistributionChannel", "ExcludedDistributionChannel", "PriceInformation", "IsPromotional", "PromotionalCode", "ValidityPeriod", "ConsumerRentalPeriod", "PreOrderReleaseDate", "ReleaseDisplayStartDate", "TrackListingPreviewStartDate", "CoverArtPreviewStartDate", "ClipPreviewStartDate", "ReleaseDisplayStartDateTime", "TrackListingPreviewStartDateTime", "CoverArtPreviewStartDateTime", "ClipPreviewStartDateTime", "PreOrderPreviewDate", "PreOrderPreviewDateTime", "PreOrderIncentiveResourceList", "InstantGratificationResourceList", "IsExclusive", "RelatedReleaseOfferSet", "PhysicalReturns", "NumberOfProductsPerCarton", "RightsClaimPolicy", "WebPolicy"},
+	reflect.TypeOf(ernv381.ExtendedResourceGroupContentItem{}):        {"SequenceNumber", "SequenceSubNumber", "ResourceType", "ReleaseResourceReference", "LinkedReleaseResourceReference", "ResourceGroupContentItemReleaseReference", "ReleaseId", "Duration", "IsHiddenResource", "IsBonusResource", "IsInstantGratificationResource", "IsPreOrderIncentiveResource"},
+	reflect.TypeOf(ernv381.ExternalResourceLink{}):                    {"URL", "ValidityPeriod", "ExternalLink", "ExternallyLinkedResourceType", "FileFormat"},
+	reflect.TypeOf(ernv381.File{}):                                    {"FileName", "FilePath", "URL", "HashSum"},
+	reflect.TypeOf(ernv381.Fingerprint{}):                             {"Fingerprint", "FingerprintAlgorithmType", "FingerprintAlgorithmVersion", "FingerprintAlgorithmParameter", "FingerprintDataType"},
+	reflect.TypeOf(ernv381.FulfillmentDate{}):                         {"FulfillmentDate", "ResourceReleaseReference"},
+	reflect.TypeOf(ernv381.Genre{}):                                   {"GenreText", "SubGenre"},
+	reflect.TypeOf(ernv381.HashSum{}):                                 {"HashSum", "HashSumAlgorithmType", "HashSumDataType"},
+	reflect.TypeOf(ernv381.HostSoundCarrier{}):                        {"ReleaseId", "RightsAgreementId", "Title", "DisplayArtist", "AdministratingRecordCompany", "TrackNumber", "VolumeNumberInSet"},
+	reflect.TypeOf(ernv381.Image{}):                                   {"ImageType", "IsArtistRelated", "ImageId", "ResourceReference", "Title", "CreationDate", "ImageDetailsByTerritory"},
+	reflect.TypeOf(ernv381.ImageDetailsByTerritory{}):                 {"TerritoryCode", "ExcludedTerritoryCode", "Title", "ResourceContributor", "IndirectResourceContributor", "DisplayArtistName", "CLine", "Description", "CourtesyLine", "ResourceReleaseDate", "OriginalResourceReleaseDate", "FulfillmentDate", "Keywords", "Synopsis", "Genre", "ParentalWarningType", "TechnicalImageDetails"},
+	reflect.TypeOf(ernv381.MIDI{}):                                    {"MidiType", "IsArtistRelated", "MidiId", "IndirectMidiId", "ResourceReference", "ReferenceTitle", "InstrumentationDescription", "IsMedley", "IsPotpourri", "IsInstrumental", "IsBackground", "IsHiddenResource", "IsBonusResource", "IsComputerGenerated", "NoSilenceBefore", "NoSilenceAfter", "PerformerInformationRequired", "LanguageOfPerformance", "Duration", "RightsAgreementId", "ResourceMusicalWorkReferenceList", "ResourceContainedResourceReferenceList", "CreationDate", "MasteredDate", "RemasteredDate", "MidiDetailsByTerritory"},
+	reflect.TypeOf(ernv381.Membership{}):                              {"Organization", "MembershipType", "StartDate", "EndDate"},
+	reflect.TypeOf(ernv381.MessageAuditTrailEvent{}):                  {"MessagingPartyDescriptor", "DateTime"},
+	reflect.TypeOf(ernv381.MessageHeader{}):                           {"MessageThreadId", "MessageId", "MessageFileName", "MessageSender", "SentOnBehalfOf", "MessageRecipient", "MessageCreatedDateTime", "MessageAuditTrail", "Comment", "MessageControlType"},
+	reflect.TypeOf(ernv381.MessagingParty{}):                          {"PartyId", "PartyName", "TradingName"},
+	reflect.TypeOf(ernv381.MidiDetailsByTerritory{}):                  {"TerritoryCode", "ExcludedTerritoryCode", "Title", "DisplayArtist", "ResourceContributor", "IndirectResourceContributor", "RightsAgreementId", "DisplayArtistName", "LabelName", "RightsController", "RemasteredDate", "ResourceReleaseDate", "OriginalResourceReleaseDate", "CLine", "CourtesyLine", "SequenceNumber", "HostSoundCarrier", "MarketingComment", "Genre", "ParentalWarningType", "FulfillmentDate", "Keywords", "Synopsis", "TechnicalMidiDetails"},
+	reflect.TypeOf(ernv381.MusicalWork{}):                             {"MusicalWorkId", "MusicalWorkReference", "ReferenceTitle", "RightsAgreementId", "MusicalWorkContributor", "MusicalWorkType", "RightShare", "MusicalWorkDetailsByTerritory"},
+	reflect.TypeOf(ernv381.MusicalWorkDetailsByTerritory{}):           {"TerritoryCode", "ExcludedTerritoryCode", "MusicalWorkContributor", "DisplayArtistName"},
+	reflect.TypeOf(ernv381.MusicalWorkId{}):                           {"ISWC", "OpusNumber", "ComposerCatalogNumber", "ProprietaryId"},
+	reflect.TypeOf(ernv381.NewReleaseMessage{}):                       {"MessageHeader", "UpdateIndicator", "IsBackfill", "CatalogTransfer", "WorkList", "CueSheetList", "ResourceList", "CollectionList", "ReleaseList", "DealList"},
+	reflect.TypeOf(ernv381.PLine{}):                                   {"Year", "PLineCompany", "PLineText"},
+	reflect.TypeOf(ernv381.PartyName{}):                               {"FullName", "FullNameAsciiTranscribed", "FullNameIndexed", "NamesBeforeKeyName", "KeyName", "NamesAfterKeyName", "AbbreviatedName"},
+	reflect.TypeOf(ernv381.Performance{}):                             {"Territory", "Date"},
+	reflect.TypeOf(ernv381.Period{}):                                  {"StartDate", "EndDate", "StartDateTime", "EndDateTime"},
+	reflect.TypeOf(ernv381.PhysicalReturns{}):                         {"PhysicalReturnsAllowed", "LatestDateForPhysicalReturns"},
+	reflect.TypeOf(ernv381.PreviewDetails{}):                          {"PartType", "TopLeftCorner", "BottomRightCorner", "ExpressionType"},
+	reflect.TypeOf(ernv381.PriceInformation{}):                        {"Description", "PriceRangeType", "PriceType", "WholesalePricePerUnit", "BulkOrderWholesalePricePerUnit", "SuggestedRetailPrice"},
+	reflect.TypeOf(ernv381.PurgeReleaseMessage{}):                     {"MessageHeader", "PurgedRelease"},
+	reflect.TypeOf(ernv381.PurgedRelease{}):                           {"ReleaseId", "Title", "ResourceContributor"},
+	reflect.TypeOf(ernv381.ReferenceTitle{}):                          {"TitleText", "SubTitle"},
+	reflect.TypeOf(ernv381.RelatedRelease{}):                          {"ReleaseId", "ReferenceTitle", "ReleaseSummaryDetailsByTerritory", "RightsAgreementId", "ReleaseRelationshipType", "ReleaseDate", "OriginalReleaseDate"},
+	reflect.TypeOf(ernv381.RelatedReleaseOfferSet{}):                  {"ReleaseId", "ReleaseDescription", "Deal"},
+	reflect.TypeOf(ernv381.Release{}):                                 {"ReleaseId", "ReleaseReference", "ExternalResourceLink", "SalesReportingProxyReleaseId", "ReferenceTitle", "ReleaseResourceReferenceList", "ResourceOmissionReason", "ReleaseCollectionReferenceList", "ReleaseType", "ReleaseDetailsByTerritory", "LanguageOfPerformance", "LanguageOfDubbing", "SubTitleLanguage", "Duration", "RightsAgreementId", "PLine", "CLine", "ArtistProfilePage", "GlobalReleaseDate", "GlobalOriginalReleaseDate"},
+	reflect.TypeOf(ernv381.ReleaseCollectionReferenceList{}):          {"NumberOfCollections", "ReleaseCollectionReference"},
+	reflect.TypeOf(ernv381.ReleaseDeal{}):                             {"DealReleaseReference", "Deal", "EffectiveDate"},
+	reflect.TypeOf(ernv381.ReleaseDetailsByTerritory{}):               {"TerritoryCode", "ExcludedTerritoryCode", "DisplayArtistName", "LabelName", "RightsAgreementId", "Title", "DisplayArtist", "IsMultiArtistCompilation", "AdministratingRecordCompany", "ReleaseType", "RelatedRelease", "ParentalWarningType", "AvRating", "MarketingComment", "ResourceGroup", "Genre", "PLine", "CLine", "ReleaseDate", "OriginalReleaseDate", "OriginalDigitalReleaseDate", "FileAvailabilityDescription", "File", "Keywords", "Synopsis", "Character", "NumberOfUnitsPerPhysicalRelease", "DisplayConductor"},
+	reflect.TypeOf(ernv381.ReleaseId{}):                               {"GRid", "ISRC", "ICPN", "CatalogNumber", "ProprietaryId"},
+	reflect.TypeOf(ernv381.ReleaseSummaryDetailsByTerritory{}):        {"TerritoryCode", "ExcludedTerritoryCode", "DisplayArtistName", "LabelName", "RightsAgreementId"},
+	reflect.TypeOf(ernv381.ResourceContainedResourceReference{}):      {"ResourceContainedResourceReference", "DurationUsed", "StartPoint", "Purpose"},
+	reflect.TypeOf(ernv381.ResourceGroup{}):                           {"Title", "SequenceNumber", "DisplayArtist", "DisplayConductor", "DisplayComposer", "ResourceContributor", "IndirectResourceContributor", "CarrierType", "ResourceGroup", "ResourceGroupContentItem", "ResourceGroupResourceReferenceList", "ResourceGroupReleaseReference", "ReleaseId"},
+	reflect.TypeOf(ernv381.ResourceList{}):                            {"SoundRecording", "MIDI", "Video", "Image", "Text", "SheetMusic", "Software", "UserDefinedResource"},
+	reflect.TypeOf(ernv381.ResourceMusicalWorkReference{}):            {"SequenceNumber", "DurationUsed", "IsFragment", "ResourceMusicalWorkReference"},
+	reflect.TypeOf(ernv381.ResourceUsage{}):                           {"DealResourceReference", "Usage"},
+	reflect.TypeOf(ernv381.RightShare{}):                              {"RightShareId", "RightShareReference", "RightShareCreationReferenceList", "TerritoryCode", "ExcludedTerritoryCode", "RightsType", "UseType", "UserInterfaceType", "DistributionChannelType", "CarrierType", "CommercialModelType", "MusicalWorkRightsClaimType", "RightsController", "ValidityPeriod", "RightShareUnknown", "RightSharePercentage", "TariffReference", "LicenseStatus", "HasFirstLicenseRefusal"},
+	reflect.TypeOf(ernv381.RightShareCreationReferenceList{}):         {"RightShareWorkReference", "RightShareResourceReference", "RightShareReleaseReference"},
+	reflect.TypeOf(ernv381.RightsAgreementId{}):                       {"MWLI", "ProprietaryId"},
+	reflect.TypeOf(ernv381.RightsClaimPolicy{}):                       {"Condition", "RightsClaimPolicyType"},
+	reflect.TypeOf(ernv381.SalesReportingProxyReleaseId{}):            {"ReleaseId", "Reason", "ReasonType"},
+	reflect.TypeOf(ernv381.SheetMusic{}):                              {"SheetMusicType", "IsArtistRelated", "SheetMusicId", "IndirectSheetMusicId", "ResourceReference", "LanguageOfLyrics", "RightsAgreementId", "ResourceMusicalWorkReferenceList", "ResourceContainedResourceReferenceList", "ReferenceTitle", "CreationDate", "SheetMusicDetailsByTerritory"},
+	reflect.TypeOf(ernv381.SheetMusicDetailsByTerritory{}):            {"TerritoryCode", "ExcludedTerritoryCode", "Title", "ResourceContributor", "IndirectResourceContributor", "DisplayArtistName", "CLine", "CourtesyLine", "ResourceReleaseDate", "OriginalResourceReleaseDate", "FulfillmentDate", "Genre", "ParentalWarningType", "TechnicalSheetMusicDetails"},
+	reflect.TypeOf(ernv381.SheetMusicId{}):                            {"ISMN", "ProprietaryId"},
+	reflect.TypeOf(ernv381.SocietyAffiliation{}):                      {"TerritoryCode", "ExcludedTerritoryCode", "MusicRightsSociety"},
+	reflect.TypeOf(ernv381.Software{}):                                {"SoftwareType", "IsArtistRelated", "SoftwareId", "IndirectSoftwareId", "ResourceReference", "ResourceMusicalWorkReferenceList", "ResourceContainedResourceReferenceList", "Title", "CreationDate", "SoftwareDetailsByTerritory"},
+	reflect.TypeOf(ernv381.SoftwareDetailsByTerritory{}):              {"TerritoryCode", "ExcludedTerritoryCode", "Title", "ResourceContributor", "IndirectResourceContributor", "DisplayArtistName", "PLine", "CLine", "CourtesyLine", "ResourceReleaseDate", "OriginalResourceReleaseDate", "FulfillmentDate", "Keywords", "Synopsis", "Genre", "ParentalWarningType", "TechnicalSoftwareDetails"},
+	reflect.TypeOf(ernv381.SoundRecording{}):                          {"SoundRecordingType", "IsArtistRelated", "SoundRecordingId", "IndirectSoundRecordingId", "ResourceReference", "ReferenceTitle", "InstrumentationDescription", "IsMedley", "IsPotpourri", "IsInstrumental", "IsBackground", "IsHiddenResource", "IsBonusResource", "HasPreOrderFulfillment", "IsComputerGenerated", "IsRemastered", "NoSilenceBefore", "NoSilenceAfter", "PerformerInformationRequired", "LanguageOfPerformance", "Duration", "RightsAgreementId", "SoundRecordingCollectionReferenceList", "ResourceMusicalWorkReferenceList", "ResourceContainedResourceReferenceList", "CreationDate", "MasteredDate", "RemasteredDate", "SoundRecordingDetailsByTerritory", "TerritoryOfCommissioning", "NumberOfFeaturedArtists", "NumberOfNonFeaturedArtists", "NumberOfContractedArtists", "NumberOfNonContractedArtists"},
+	reflect.TypeOf(ernv381.SoundRecordingCollectionReference{}):       {"SequenceNumber", "SoundRecordingCollectionReference", "StartTime", "Duration", "EndTime", "ReleaseResourceType"},
+	reflect.TypeOf(ernv381.SoundRecordingCollectionReferenceList{}):   {"NumberOfCollections", "SoundRecordingCollectionReference"},
+	reflect.TypeOf(ernv381.SoundRecordingDetailsByTerritory{}):        {"TerritoryCode", "ExcludedTerritoryCode", "Title", "DisplayArtist", "DisplayConductor", "ResourceContributor", "IndirectResourceContributor", "RightsAgreementId", "DisplayArtistName", "LabelName", "RightsController", "RemasteredDate", "ResourceReleaseDate", "OriginalResourceReleaseDate", "PLine", "CourtesyLine", "SequenceNumber", "HostSoundCarrier", "MarketingComment", "Genre", "ParentalWarningType", "AvRating", "TechnicalSoundRecordingDetails", "FulfillmentDate", "Keywords", "Synopsis"},
+	reflect.TypeOf(ernv381.SoundRecordingId{}):                        {"ISRC", "CatalogNumber", "ProprietaryId"},
+	reflect.TypeOf(ernv381.SoundRecordingPreviewDetails{}):            {"PartType", "StartPoint", "EndPoint", "Duration", "TopLeftCorner", "BottomRightCorner", "ExpressionType"},
+	reflect.TypeOf(ernv381.TechnicalImageDetails{}):                   {"TechnicalResourceDetailsReference", "DrmPlatformType", "ContainerFormat", "ImageCodecType", "ImageHeight", "ImageWidth", "AspectRatio", "ColorDepth", "ImageResolution", "IsPreview", "PreviewDetails", "FulfillmentDate", "ConsumerFulfillmentDate", "FileAvailabilityDescription", "File", "Fingerprint"},
+	reflect.TypeOf(ernv381.TechnicalInstantiation{}):                  {"DrmEnforcementType", "VideoDefinitionType", "CodingType", "BitRate"},
+	reflect.TypeOf(ernv381.TechnicalMidiDetails{}):                    {"TechnicalResourceDetailsReference", "Duration", "ResourceProcessingRequired", "UsableResourceDuration", "IsPreview", "PreviewDetails", "FulfillmentDate", "ConsumerFulfillmentDate", "FileAvailabilityDescription", "File", "NumberOfVoices", "SoundProcessorType", "Fingerprint"},
+	reflect.TypeOf(ernv381.TechnicalSheetMusicDetails{}):              {"TechnicalResourceDetailsReference", "DrmPlatformType", "ContainerFormat", "SheetMusicCodecType", "IsPreview", "PreviewDetails", "FulfillmentDate", "ConsumerFulfillmentDate", "FileAvailabilityDescription", "File", "Fingerprint"},
+	reflect.TypeOf(ernv381.TechnicalSoftwareDetails{}):                {"TechnicalResourceDetailsReference", "DrmPlatformType", "OperatingSystemType", "IsPreview", "PreviewDetails", "FulfillmentDate", "ConsumerFulfillmentDate", "FileAvailabilityDescription", "File", "Fingerprint"},
+	reflect.TypeOf(ernv381.TechnicalSoundRecordingDetails{}):          {"TechnicalResourceDetailsReference", "DrmPlatformType", "ContainerFormat", "AudioCodecType", "BitRate", "NumberOfChannels", "SamplingRate", "BitsPerSample", "Duration", "ResourceProcessingRequired", "UsableResourceDuration", "IsPreview", "PreviewDetails", "FulfillmentDate", "ConsumerFulfillmentDate", "FileAvailabilityDescription", "File", "Fingerprint"},
+	reflect.TypeOf(ernv381.TechnicalTextDetails{}):                    {"TechnicalResourceDetailsReference", "DrmPlatformType", "ContainerFormat", "TextCodecType", "IsPreview", "PreviewDetails", "FulfillmentDate", "ConsumerFulfillmentDate", "FileAvailabilityDescription", "File", "Fingerprint"},
+	reflect.TypeOf(ernv381.TechnicalUserDefinedResourceDetails{}):     {"TechnicalResourceDetailsReference", "UserDefinedValue", "IsPreview", "PreviewDetails", "FulfillmentDate", "ConsumerFulfillmentDate", "FileAvailabilityDescription", "File", "Fingerprint"},
+	reflect.TypeOf(ernv381.TechnicalVideoDetails{}):                   {"TechnicalResourceDetailsReference", "DrmPlatformType", "OverallBitRate", "ContainerFormat", "VideoCodecType", "VideoBitRate", "FrameRate", "ImageHeight", "ImageWidth", "AspectRatio", "ColorDepth", "VideoDefinitionType", "AudioCodecType", "AudioBitRate", "NumberOfAudioChannels", "AudioSamplingRate", "AudioBitsPerSample", "Duration", "ResourceProcessingRequired", "UsableResourceDuration", "IsPreview", "PreviewDetails", "FulfillmentDate", "ConsumerFulfillmentDate", "FileAvailabilityDescription", "File", "Fingerprint"},
+	reflect.TypeOf(ernv381.Text{}):                                    {"TextType", "IsArtistRelated", "TextId", "IndirectTextId", "ResourceReference", "ResourceMusicalWorkReferenceList", "ResourceContainedResourceReferenceList", "Title", "CreationDate", "TextDetailsByTerritory"},
+	reflect.TypeOf(ernv381.TextDetailsByTerritory{}):                  {"TerritoryCode", "ExcludedTerritoryCode", "Title", "ResourceContributor", "IndirectResourceContributor", "DisplayArtistName", "CLine", "CourtesyLine", "ResourceReleaseDate", "OriginalResourceReleaseDate", "FulfillmentDate", "Keywords", "Synopsis", "Genre", "ParentalWarningType", "TechnicalTextDetails"},
+	reflect.TypeOf(ernv381.TextId{}):                                  {"ISBN", "ISSN", "SICI", "ProprietaryId"},
+	reflect.TypeOf(ernv381.Title{}):                                   {"TitleText", "SubTitle"},
+	reflect.TypeOf(ernv381.Usage{}):                                   {"UseType", "UserInterfaceType", "DistributionChannelType", "CarrierType", "TechnicalInstantiation", "NumberOfUsages"},
+	reflect.TypeOf(ernv381.UserDefinedResource{}):                     {"UserDefinedResourceType", "IsArtistRelated", "UserDefinedResourceId", "IndirectUserDefinedResourceId", "ResourceReference", "ResourceMusicalWorkReferenceList", "ResourceContainedResourceReferenceList", "Title", "UserDefinedValue", "CreationDate", "UserDefinedResourceDetailsByTerritory"},
+	reflect.TypeOf(ernv381.UserDefinedResourceDetailsByTerritory{}):   {"TerritoryCode", "ExcludedTerritoryCode", "Title", "ResourceContributor", "IndirectResourceContributor", "DisplayArtistName", "UserDefinedValue", "PLine", "CLine", "ResourceReleaseDate", "OriginalResourceReleaseDate", "FulfillmentDate", "Keywords", "Synopsis", "Genre", "ParentalWarningType", "TechnicalUserDefinedResourceDetails"},
+	reflect.TypeOf(ernv381.Video{}):                                   {"VideoType", "IsArtistRelated", "VideoId", "IndirectVideoId", "ResourceReference", "VideoCueSheetReference", "ReasonForCueSheetAbsence", "ReferenceTitle", "Title", "InstrumentationDescription", "IsMedley", "IsPotpourri", "IsInstrumental", "IsBackground", "IsHiddenResource", "IsBonusResource", "HasPreOrderFulfillment", "IsRemastered", "NoSilenceBefore", "NoSilenceAfter", "PerformerInformationRequired", "LanguageOfPerformance", "LanguageOfDubbing", "SubTitleLanguage", "Duration", "RightsAgreementId", "VideoCollectionReferenceList", "ResourceMusicalWorkReferenceList", "ResourceContainedResourceReferenceList", "CreationDate", "MasteredDate", "RemasteredDate", "VideoDetailsByTerritory", "TerritoryOfCommissioning", "NumberOfFeaturedArtists", "NumberOfNonFeaturedArtists", "NumberOfContractedArtists", "NumberOfNonContractedArtists"},
+	reflect.TypeOf(ernv381.VideoDetailsByTerritory{}):                 {"TerritoryCode", "ExcludedTerritoryCode", "Title", "DisplayArtist", "DisplayConductor", "ResourceContributor", "IndirectResourceContributor", "RightsAgreementId", "DisplayArtistName", "LabelName", "RightsController", "RemasteredDate", "ResourceReleaseDate", "OriginalResourceReleaseDate", "PLine", "CourtesyLine", "SequenceNumber", "HostSoundCarrier", "MarketingComment", "Genre", "ParentalWarningType", "AvRating", "FulfillmentDate", "Keywords", "Synopsis", "CLine", "TechnicalVideoDetails", "Character"},
+	reflect.TypeOf(ernv381.VideoId{}):                                 {"ISRC", "ISAN", "VISAN", "CatalogNumber", "ProprietaryId", "EIDR"},
+	reflect.TypeOf(ernv381.WebPage{}):                                 {"PartyId", "ReleaseId", "PageName", "URL", "UserName", "Password"},
+	reflect.TypeOf(ernv381.WebPolicy{}):                               {"Condition", "AccessBlockingRequested", "AccessLimitation", "EmbeddingAllowed", "UserRatingAllowed", "UserCommentAllowed", "UserResponsesAllowed", "SyndicationAllowed"},
+	reflect.TypeOf(ernv383.ArtistDelegatedUsageRights{}):              {"UseType", "UserInterfaceType", "PeriodOfRightsDelegation", "TerritoryOfRightsDelegation", "MembershipType"},
+	reflect.TypeOf(ernv383.AvRating{}):                                {"RatingText", "RatingAgency", "RatingSchemeDescription"},
+	reflect.TypeOf(ernv383.CLine{}):                                   {"Year", "CLineCompany", "CLineText"},
+	reflect.TypeOf(ernv383.CatalogItem{}):                             {"TerritoryCode", "ReleaseId", "Title", "DisplayArtistName", "ContributorName", "DisplayTitle", "LabelName", "Genre", "PLine", "CLine", "ReleaseDate"},
+	reflect.TypeOf(ernv383.CatalogListMessage{}):                      {"MessageHeader", "PublicationDate", "CatalogItem"},
+	reflect.TypeOf(ernv383.CatalogTransfer{}):                         {"CatalogTransferCompleted", "EffectiveTransferDate", "CatalogReleaseReferenceList", "TerritoryCode", "ExcludedTerritoryCode", "TransferringFrom", "TransferringTo"},
+	reflect.TypeOf(ernv383.Collection{}):                              {"CollectionId", "CollectionType", "CollectionReference", "EquivalentReleaseReference", "Title", "SequenceNumber", "Contributor", "Character", "CollectionCollectionReferenceList", "IsComplete", "Duration", "DurationOfMusicalContent", "CreationDate", "ReleaseDate", "OriginalReleaseDate", "OriginalLanguage", "CollectionDetailsByTerritory", "CollectionResourceReferenceList", "CollectionWorkReferenceList", "RepresentativeImageReference", "PLine", "CLine"},
+	reflect.TypeOf(ernv383.CollectionCollectionReference{}):           {"SequenceNumber", "CollectionCollectionReference", "StartTime", "Duration", "EndTime", "InclusionDate"},
+	reflect.TypeOf(ernv383.CollectionCollectionReferenceList{}):       {"NumberOfCollections", "CollectionCollectionReference"},
+	reflect.TypeOf(ernv383.CollectionDetailsByTerritory{}):            {"TerritoryCode", "ExcludedTerritoryCode", "Title", "Contributor", "IsComplete", "Character"},
+	reflect.TypeOf(ernv383.CollectionId{}):                            {"GRid", "ISRC", "ISAN", "VISAN", "ICPN", "CatalogNumber", "ProprietaryId"},
+	reflect.TypeOf(ernv383.CollectionResourceReference{}):             {"SequenceNumber", "CollectionResourceReference", "Duration"},
+	reflect.TypeOf(ernv383.CollectionWorkReference{}):                 {"CollectionWorkReference", "Duration"},
+	reflect.TypeOf(ernv383.Condition{}):                               {"Value", "Unit", "ReferenceCreation", "RelationalRelator"},
+	reflect.TypeOf(ernv383.ContactId{}):                               {"EmailAddress", "PhoneNumber", "FaxNumber"},
+	reflect.TypeOf(ernv383.CreationId{}):                              {"ISWC", "OpusNumber", "ComposerCatalogNumber", "ISRC", "ISMN", "ISAN", "VISAN", "ISBN", "ISSN", "SICI", "CatalogNumber", "ProprietaryId"},
+	reflect.TypeOf(ernv383.Cue{}):                                     {"CueUseType", "CueThemeType", "CueVocalType", "IsDance", "CueVisualPerceptionType", "CueOrigin", "CueCreationReference", "ReferencedCreationType", "ReferencedCreationId", "ReferencedCreationTitle", "ReferencedCreationContributor", "ReferencedIndirectCreationContributor", "ReferencedCreationCharacter", "HasMusicalContent", "StartTime", "Duration", "EndTime", "PLine", "CLine"},
+	reflect.TypeOf(ernv383.CueCreationReference{}):                    {"CueWorkReference", "CueResourceReference"},
+	reflect.TypeOf(ernv383.CueSheet{}):                                {"CueSheetId", "CueSheetReference", "CueSheetType", "Cue"},
+	reflect.TypeOf(ernv383.Deal{}):                                    {"DealReference", "DealTerms", "ResourceUsage", "DealTechnicalResourceDetailsReferenceList", "DistributionChannelPage"},
+	reflect.TypeOf(ernv383.DealResourceReferenceList{}):               {"DealResourceReference", "Period"},
+	reflect.TypeOf(ernv383.DealTerms{}):                               {"IsPreOrderDeal", "CommercialModelType", "Usage", "AllDealsCancelled", "TakeDown", "TerritoryCode", "ExcludedTerritoryCode", "DistributionChannel", "ExcludedDistributionChannel", "PriceInformation", "IsPromotional", "PromotionalCode", "ValidityPeriod", "ConsumerRentalPeriod", "PreOrderReleaseDate", "ReleaseDisplayStartDate", "TrackListingPreviewStartDate", "CoverArtPreviewStartDate", "ClipPreviewStartDate", "ReleaseDisplayStartDateTime", "TrackListingPreviewStartDateTime", "CoverArtPreviewStartDateTime", "ClipPreviewStartDateTime", "PreOrderPreviewDate", "PreOrderPreviewDateTime", "PreOrderIncentiveResourceList", "InstantGratificationResourceList", "IsExclusive", "RelatedReleaseOfferSet", "PhysicalReturns", "NumberOfProductsPerCarton", "RightsClaimPolicy", "WebPolicy"},
+	reflect.TypeOf(ernv383.ExtendedResourceGroupContentItem{}):        {"SequenceNumber", "SequenceSubNumber", "ResourceType", "ReleaseResourceReference", "LinkedReleaseResourceReference", "ResourceGroupContentItemReleaseReference", "ReleaseId", "Duration", "IsHiddenResource", "IsBonusResource", "IsInstantGratificationResource", "IsPreOrderIncentiveResource"},
+	reflect.TypeOf(ernv383.ExternalResourceLink{}):                    {"URL", "ValidityPeriod", "ExternalLink", "ExternallyLinkedResourceType", "FileFormat"},
+	reflect.TypeOf(ernv383.File{}):                                    {"FileName", "FilePath", "URL", "HashSum"},
+	reflect.TypeOf(ernv383.Fingerprint{}):                             {"Fingerprint", "FingerprintAlgorithmType", "FingerprintAlgorithmVersion", "FingerprintAlgorithmParameter", "FingerprintDataType"},
+	reflect.TypeOf(ernv383.FulfillmentDate{}):                         {"FulfillmentDate", "ResourceReleaseReference"},
+	reflect.TypeOf(ernv383.Genre{}):                                   {"GenreText", "SubGenre"},
+	reflect.TypeOf(ernv383.HashSum{}):                                 {"HashSum", "HashSumAlgorithmType", "HashSumDataType"},
+	reflect.TypeOf(ernv383.HostSoundCarrier{}):                        {"ReleaseId", "RightsAgreementId", "Title", "DisplayArtist", "AdministratingRecordCompany", "TrackNumber", "VolumeNumberInSet"},
+	reflect.TypeOf(ernv383.Image{}):                                   {"ImageType", "IsArtistRelated", "ImageId", "ResourceReference", "Title", "CreationDate", "ImageDetailsByTerritory"},
+	reflect.TypeOf(ernv383.ImageDetailsByTerritory{}):                 {"TerritoryCode", "ExcludedTerritoryCode", "Title", "ResourceContributor", "IndirectResourceContributor", "DisplayArtistName", "CLine", "Description", "CourtesyLine", "ResourceReleaseDate", "OriginalResourceReleaseDate", "FulfillmentDate", "Keywords", "Synopsis", "Genre", "ParentalWarningType", "TechnicalImageDetails"},
+	reflect.TypeOf(ernv383.MIDI{}):                                    {"MidiType", "IsArtistRelated", "MidiId", "IndirectMidiId", "ResourceReference", "ReferenceTitle", "InstrumentationDescription", "IsMedley", "IsPotpourri", "IsInstrumental", "IsBackground", "IsHiddenResource", "IsBonusResource", "IsComputerGenerated", "NoSilenceBefore", "NoSilenceAfter", "PerformerInformationRequired", "LanguageOfPerformance", "Duration", "RightsAgreementId", "ResourceMusicalWorkReferenceList", "ResourceContainedResourceReferenceList", "CreationDate", "MasteredDate", "RemasteredDate", "MidiDetailsByTerritory"},
+	reflect.TypeOf(ernv383.Membership{}):                              {"Organization", "MembershipType", "StartDate", "EndDate"},
+	reflect.TypeOf(ernv383.MessageAuditTrailEvent{}):                  {"MessagingPartyDescriptor", "DateTime"},
+	reflect.TypeOf(ernv383.MessageHeader{}):                           {"MessageThreadId", "MessageId", "MessageFileName", "MessageSender", "SentOnBehalfOf", "MessageRecipient", "MessageCreatedDateTime", "MessageAuditTrail", "Comment", "MessageControlType"},
+	reflect.TypeOf(ernv383.MessagingParty{}):                          {"PartyId", "PartyName", "TradingName"},
+	reflect.TypeOf(ernv383.MidiDetailsByTerritory{}):                  {"TerritoryCode", "ExcludedTerritoryCode", "Title", "DisplayArtist", "ResourceContributor", "IndirectResourceContributor", "RightsAgreementId", "DisplayArtistName", "LabelName", "RightsController", "RemasteredDate", "ResourceReleaseDate", "OriginalResourceReleaseDate", "CLine", "CourtesyLine", "SequenceNumber", "HostSoundCarrier", "MarketingComment", "Genre", "ParentalWarningType", "FulfillmentDate", "Keywords", "Synopsis", "TechnicalMidiDetails"},
+	reflect.TypeOf(ernv383.MusicalWork{}):                             {"MusicalWorkId", "MusicalWorkReference", "ReferenceTitle", "RightsAgreementId", "MusicalWorkContributor", "MusicalWorkType", "RightShare", "MusicalWorkDetailsByTerritory"},
+	reflect.TypeOf(ernv383.MusicalWorkDetailsByTerritory{}):           {"TerritoryCode", "ExcludedTerritoryCode", "MusicalWorkContributor", "DisplayArtistName"},
+	reflect.TypeOf(ernv383.MusicalWorkId{}):                           {"ISWC", "OpusNumber", "ComposerCatalogNumber", "ProprietaryId"},
+	reflect.TypeOf(ernv383.NewReleaseMessage{}):                       {"MessageHeader", "UpdateIndicator", "IsBackfill", "CatalogTransfer", "WorkList", "CueSheetList", "ResourceList", "CollectionList", "ReleaseList", "DealList"},
+	reflect.TypeOf(ernv383.PLine{}):                                   {"Year", "PLineCompany", "PLineText"},
+	reflect.TypeOf(ernv383.PartyName{}):                               {"FullName", "FullNameAsciiTranscribed", "FullNameIndexed", "NamesBeforeKeyName", "KeyName", "NamesAfterKeyName", "AbbreviatedName"},
+	reflect.TypeOf(ernv383.Performance{}):                             {"Territory", "Date"},
+	reflect.TypeOf(ernv383.Period{}):                                  {"StartDate", "EndDate", "StartDateTime", "EndDateTime"},
+	reflect.TypeOf(ernv383.PhysicalReturns{}):                         {"PhysicalReturnsAllowed", "LatestDateForPhysicalReturns"},
+	reflect.TypeOf(ernv383.PreviewDetails{}):                          {"PartType", "TopLeftCorner", "BottomRightCorner", "ExpressionType"},
+	reflect.TypeOf(ernv383.PriceInformation{}):                        {"Description", "PriceRangeType", "PriceType", "WholesalePricePerUnit", "BulkOrderWholesalePricePerUnit", "SuggestedRetailPrice"},
+	reflect.TypeOf(ernv383.PurgeReleaseMessage{}):                     {"MessageHeader", "PurgedRelease"},
+	reflect.TypeOf(ernv383.PurgedRelease{}):                           {"ReleaseId", "Title", "ResourceContributor"},
+	reflect.TypeOf(ernv383.ReferenceTitle{}):                          {"TitleText", "SubTitle"},
+	reflect.TypeOf(ernv383.RelatedRelease{}):                          {"ReleaseId", "ReferenceTitle", "ReleaseSummaryDetailsByTerritory", "RightsAgreementId", "ReleaseRelationshipType", "ReleaseDate", "OriginalReleaseDate"},
+	reflect.TypeOf(ernv383.RelatedReleaseOfferSet{}):                  {"ReleaseId", "ReleaseDescription", "Deal"},
+	reflect.TypeOf(ernv383.Release{}):                                 {"ReleaseId", "ReleaseReference", "ExternalResourceLink", "SalesReportingProxyReleaseId", "ReferenceTitle", "ReleaseResourceReferenceList", "ResourceOmissionReason", "ReleaseCollectionReferenceList", "ReleaseType", "ReleaseDetailsByTerritory", "LanguageOfPerformance", "LanguageOfDubbing", "SubTitleLanguage", "Duration", "RightsAgreementId", "PLine", "CLine", "ArtistProfilePage", "GlobalReleaseDate", "GlobalOriginalReleaseDate"},
+	reflect.TypeOf(ernv383.ReleaseCollectionReferenceList{}):          {"NumberOfCollections", "ReleaseCollectionReference"},
+	reflect.TypeOf(ernv383.ReleaseDeal{}):                             {"DealReleaseReference", "Deal", "EffectiveDate"},
+	reflect.TypeOf(ernv383.ReleaseDetailsByTerritory{}):               {"TerritoryCode", "ExcludedTerritoryCode", "DisplayArtistName", "LabelName", "RightsAgreementId", "Title", "DisplayArtist", "IsMultiArtistCompilation", "AdministratingRecordCompany", "ReleaseType", "RelatedRelease", "ParentalWarningType", "AvRating", "MarketingComment", "ResourceGroup", "Genre", "PLine", "CLine", "ReleaseDate", "OriginalReleaseDate", "OriginalDigitalReleaseDate", "FileAvailabilityDescription", "File", "Keywords", "Synopsis", "Character", "NumberOfUnitsPerPhysicalRelease", "DisplayConductor"},
+	reflect.TypeOf(ernv383.ReleaseId{}):                               {"GRid", "ISRC", "ICPN", "CatalogNumber", "ProprietaryId"},
+	reflect.TypeOf(ernv383.ReleaseSummaryDetailsByTerritory{}):        {"TerritoryCode", "ExcludedTerritoryCode", "DisplayArtistName", "LabelName", "RightsAgreementId"},
+	reflect.TypeOf(ernv383.ResourceContainedResourceReference{}):      {"ResourceContainedResourceReference", "DurationUsed", "StartPoint", "Purpose"},
+	reflect.TypeOf(ernv383.ResourceGroup{}):                           {"Title", "SequenceNumber", "DisplayArtist", "DisplayConductor", "DisplayComposer", "ResourceContributor", "IndirectResourceContributor", "CarrierType", "ResourceGroup", "ResourceGroupContentItem", "ResourceGroupResourceReferenceList", "ResourceGroupReleaseReference", "ReleaseId"},
+	reflect.TypeOf(ernv383.ResourceList{}):                            {"SoundRecording", "MIDI", "Video", "Image", "Text", "SheetMusic", "Software", "UserDefinedResource"},
+	reflect.TypeOf(ernv383.ResourceMusicalWorkReference{}):            {"SequenceNumber", "DurationUsed", "IsFragment", "ResourceMusicalWorkReference"},
+	reflect.TypeOf(ernv383.ResourceUsage{}):                           {"DealResourceReference", "Usage"},
+	reflect.TypeOf(ernv383.RightShare{}):                              {"RightShareId", "RightShareReference", "RightShareCreationReferenceList", "TerritoryCode", "ExcludedTerritoryCode", "RightsType", "UseType", "UserInterfaceType", "DistributionChannelType", "CarrierType", "CommercialModelType", "MusicalWorkRightsClaimType", "RightsController", "ValidityPeriod", "RightShareUnknown", "RightSharePercentage", "TariffReference", "LicenseStatus", "HasFirstLicenseRefusal"},
+	reflect.TypeOf(ernv383.RightShareCreationReferenceList{}):         {"RightShareWorkReference", "RightShareResourceReference", "RightShareReleaseReference"},
+	reflect.TypeOf(ernv383.RightsAgreementId{}):                       {"MWLI", "ProprietaryId"},
+	reflect.TypeOf(ernv383.RightsClaimPolicy{}):                       {"Condition", "RightsClaimPolicyType"},
+	reflect.TypeOf(ernv383.SalesReportingProxyReleaseId{}):            {"ReleaseId", "Reason", "ReasonType"},
+	reflect.TypeOf(ernv383.SheetMusic{}):                              {"SheetMusicType", "IsArtistRelated", "SheetMusicId", "IndirectSheetMusicId", "ResourceReference", "LanguageOfLyrics", "RightsAgreementId", "ResourceMusicalWorkReferenceList", "ResourceContainedResourceReferenceList", "ReferenceTitle", "CreationDate", "SheetMusicDetailsByTerritory"},
+	reflect.TypeOf(ernv383.SheetMusicDetailsByTerritory{}):            {"TerritoryCode", "ExcludedTerritoryCode", "Title", "ResourceContributor", "IndirectResourceContributor", "DisplayArtistName", "CLine", "CourtesyLine", "ResourceReleaseDate", "OriginalResourceReleaseDate", "FulfillmentDate", "Genre", "ParentalWarningType", "TechnicalSheetMusicDetails"},
+	reflect.TypeOf(ernv383.SheetMusicId{}):                            {"ISMN", "ProprietaryId"},
+	reflect.TypeOf(ernv383.SocietyAffiliation{}):                      {"TerritoryCode", "ExcludedTerritoryCode", "MusicRightsSociety"},
+	reflect.TypeOf(ernv383.Software{}):                                {"SoftwareType", "IsArtistRelated", "SoftwareId", "IndirectSoftwareId", "ResourceReference", "ResourceMusicalWorkReferenceList", "ResourceContainedResourceReferenceList", "Title", "CreationDate", "SoftwareDetailsByTerritory"},
+	reflect.TypeOf(ernv383.SoftwareDetailsByTerritory{}):              {"TerritoryCode", "ExcludedTerritoryCode", "Title", "ResourceContributor", "IndirectResourceContributor", "DisplayArtistName", "PLine", "CLine", "CourtesyLine", "ResourceReleaseDate", "OriginalResourceReleaseDate", "FulfillmentDate", "Keywords", "Synopsis", "Genre", "ParentalWarningType", "TechnicalSoftwareDetails"},
+	reflect.TypeOf(ernv383.SoundRecording{}):                          {"SoundRecordingType", "IsArtistRelated", "SoundRecordingId", "IndirectSoundRecordingId", "ResourceReference", "ReferenceTitle", "InstrumentationDescription", "IsMedley", "IsPotpourri", "IsInstrumental", "IsBackground", "IsHiddenResource", "IsBonusResource", "HasPreOrderFulfillment", "IsComputerGenerated", "IsRemastered", "NoSilenceBefore", "NoSilenceAfter", "PerformerInformationRequired", "LanguageOfPerformance", "Duration", "RightsAgreementId", "SoundRecordingCollectionReferenceList", "ResourceMusicalWorkReferenceList", "ResourceContainedResourceReferenceList", "CreationDate", "MasteredDate", "RemasteredDate", "SoundRecordingDetailsByTerritory", "TerritoryOfCommissioning", "NumberOfFeaturedArtists", "NumberOfNonFeaturedArtists", "NumberOfContractedArtists", "NumberOfNonContractedArtists"},
+	reflect.TypeOf(ernv383.SoundRecordingCollectionReference{}):       {"SequenceNumber", "SoundRecordingCollectionReference", "StartTime", "Duration", "EndTime", "ReleaseResourceType"},
+	reflect.TypeOf(ernv383.SoundRecordingCollectionReferenceList{}):   {"NumberOfCollections", "SoundRecordingCollectionReference"},
+	reflect.TypeOf(ernv383.SoundRecordingDetailsByTerritory{}):        {"TerritoryCode", "ExcludedTerritoryCode", "Title", "DisplayArtist", "DisplayConductor", "ResourceContributor", "IndirectResourceContributor", "RightsAgreementId", "DisplayArtistName", "LabelName", "RightsController", "RemasteredDate", "ResourceReleaseDate", "OriginalResourceReleaseDate", "PLine", "CourtesyLine", "SequenceNumber", "HostSoundCarrier", "MarketingComment", "Genre", "ParentalWarningType", "AvRating", "TechnicalSoundRecordingDetails", "FulfillmentDate", "Keywords", "Synopsis"},
+	reflect.TypeOf(ernv383.SoundRecordingId{}):                        {"ISRC", "CatalogNumber", "ProprietaryId"},
+	reflect.TypeOf(ernv383.SoundRecordingPreviewDetails{}):            {"PartType", "StartPoint", "EndPoint", "Duration", "TopLeftCorner", "BottomRightCorner", "ExpressionType"},
+	reflect.TypeOf(ernv383.TechnicalImageDetails{}):                   {"TechnicalResourceDetailsReference", "DrmPlatformType", "ContainerFormat", "ImageCodecType", "ImageHeight", "ImageWidth", "AspectRatio", "ColorDepth", "ImageResolution", "IsPreview", "PreviewDetails", "FulfillmentDate", "ConsumerFulfillmentDate", "FileAvailabilityDescription", "File", "Fingerprint"},
+	reflect.TypeOf(ernv383.TechnicalInstantiation{}):                  {"DrmEnforcementType", "VideoDefinitionType", "CodingType", "BitRate"},
+	reflect.TypeOf(ernv383.TechnicalMidiDetails{}):                    {"TechnicalResourceDetailsReference", "Duration", "ResourceProcessingRequired", "UsableResourceDuration", "IsPreview", "PreviewDetails", "FulfillmentDate", "ConsumerFulfillmentDate", "FileAvailabilityDescription", "File", "NumberOfVoices", "SoundProcessorType", "Fingerprint"},
+	reflect.TypeOf(ernv383.TechnicalSheetMusicDetails{}):              {"TechnicalResourceDetailsReference", "DrmPlatformType", "ContainerFormat", "SheetMusicCodecType", "IsPreview", "PreviewDetails", "FulfillmentDate", "ConsumerFulfillmentDate", "FileAvailabilityDescription", "File", "Fingerprint"},
+	reflect.TypeOf(ernv383.TechnicalSoftwareDetails{}):                {"TechnicalResourceDetailsReference", "DrmPlatformType", "OperatingSystemType", "IsPreview", "PreviewDetails", "FulfillmentDate", "ConsumerFulfillmentDate", "FileAvailabilityDescription", "File", "Fingerprint"},
+	reflect.TypeOf(ernv383.TechnicalSoundRecordingDetails{}):          {"TechnicalResourceDetailsReference", "DrmPlatformType", "ContainerFormat", "AudioCodecType", "BitRate", "NumberOfChannels", "SamplingRate", "BitsPerSample", "Duration", "ResourceProcessingRequired", "UsableResourceDuration", "IsPreview", "PreviewDetails", "FulfillmentDate", "ConsumerFulfillmentDate", "FileAvailabilityDescription", "File", "Fingerprint"},
+	reflect.TypeOf(ernv383.TechnicalTextDetails{}):                    {"TechnicalResourceDetailsReference", "DrmPlatformType", "ContainerFormat", "TextCodecType", "IsPreview", "PreviewDetails", "FulfillmentDate", "ConsumerFulfillmentDate", "FileAvailabilityDescription", "File", "Fingerprint"},
+	reflect.TypeOf(ernv383.TechnicalUserDefinedResourceDetails{}):     {"TechnicalResourceDetailsReference", "UserDefinedValue", "IsPreview", "PreviewDetails", "FulfillmentDate", "ConsumerFulfillmentDate", "FileAvailabilityDescription", "File", "Fingerprint"},
+	reflect.TypeOf(ernv383.TechnicalVideoDetails{}):                   {"TechnicalResourceDetailsReference", "DrmPlatformType", "OverallBitRate", "ContainerFormat", "VideoCodecType", "VideoBitRate", "FrameRate", "ImageHeight", "ImageWidth", "AspectRatio", "ColorDepth", "VideoDefinitionType", "AudioCodecType", "AudioBitRate", "NumberOfAudioChannels", "AudioSamplingRate", "AudioBitsPerSample", "Duration", "ResourceProcessingRequired", "UsableResourceDuration", "IsPreview", "PreviewDetails", "FulfillmentDate", "ConsumerFulfillmentDate", "FileAvailabilityDescription", "File", "Fingerprint"},
+	reflect.TypeOf(ernv383.Text{}):                                    {"TextType", "IsArtistRelated", "TextId", "IndirectTextId", "ResourceReference", "ResourceMusicalWorkReferenceList", "ResourceContainedResourceReferenceList", "Title", "CreationDate", "TextDetailsByTerritory"},
+	reflect.TypeOf(ernv383.TextDetailsByTerritory{}):                  {"TerritoryCode", "ExcludedTerritoryCode", "Title", "ResourceContributor", "IndirectResourceContributor", "DisplayArtistName", "CLine", "CourtesyLine", "ResourceReleaseDate", "OriginalResourceReleaseDate", "FulfillmentDate", "Keywords", "Synopsis", "Genre", "ParentalWarningType", "TechnicalTextDetails"},
+	reflect.TypeOf(ernv383.TextId{}):                                  {"ISBN", "ISSN", "SICI", "ProprietaryId"},
+	reflect.TypeOf(ernv383.Title{}):                                   {"TitleText", "SubTitle"},
+	reflect.TypeOf(ernv383.Usage{}):                                   {"UseType", "UserInterfaceType", "DistributionChannelType", "CarrierType", "TechnicalInstantiation", "NumberOfUsages"},
+	reflect.TypeOf(ernv383.UserDefinedResource{}):                     {"UserDefinedResourceType", "IsArtistRelated", "UserDefinedResourceId", "IndirectUserDefinedResourceId", "ResourceReference", "ResourceMusicalWorkReferenceList", "ResourceContainedResourceReferenceList", "Title", "UserDefinedValue", "CreationDate", "UserDefinedResourceDetailsByTerritory"},
+	reflect.TypeOf(ernv383.UserDefinedResourceDetailsByTerritory{}):   {"TerritoryCode", "ExcludedTerritoryCode", "Title", "ResourceContributor", "IndirectResourceContributor", "DisplayArtistName", "UserDefinedValue", "PLine", "CLine", "ResourceReleaseDate", "OriginalResourceReleaseDate", "FulfillmentDate", "Keywords", "Synopsis", "Genre", "ParentalWarningType", "TechnicalUserDefinedResourceDetails"},
+	reflect.TypeOf(ernv383.Video{}):                                   {"VideoType", "IsArtistRelated", "VideoId", "IndirectVideoId", "ResourceReference", "VideoCueSheetReference", "ReasonForCueSheetAbsence", "ReferenceTitle", "Title", "InstrumentationDescription", "IsMedley", "IsPotpourri", "IsInstrumental", "IsBackground", "IsHiddenResource", "IsBonusResource", "HasPreOrderFulfillment", "IsRemastered", "NoSilenceBefore", "NoSilenceAfter", "PerformerInformationRequired", "LanguageOfPerformance", "LanguageOfDubbing", "SubTitleLanguage", "Duration", "RightsAgreementId", "VideoCollectionReferenceList", "ResourceMusicalWorkReferenceList", "ResourceContainedResourceReferenceList", "CreationDate", "MasteredDate", "RemasteredDate", "VideoDetailsByTerritory", "TerritoryOfCommissioning", "NumberOfFeaturedArtists", "NumberOfNonFeaturedArtists", "NumberOfContractedArtists", "NumberOfNonContractedArtists"},
+	reflect.TypeOf(ernv383.VideoDetailsByTerritory{}):                 {"TerritoryCode", "ExcludedTerritoryCode", "Title", "DisplayArtist", "DisplayConductor", "ResourceContributor", "IndirectResourceContributor", "RightsAgreementId", "DisplayArtistName", "LabelName", "RightsController", "RemasteredDate", "ResourceReleaseDate", "OriginalResourceReleaseDate", "PLine", "CourtesyLine", "SequenceNumber", "HostSoundCarrier", "MarketingComment", "Genre", "ParentalWarningType", "AvRating", "FulfillmentDate", "Keywords", "Synopsis", "CLine", "TechnicalVideoDetails", "Character"},
+	reflect.TypeOf(ernv383.VideoId{}):                                 {"ISRC", "ISAN", "VISAN", "CatalogNumber", "ProprietaryId", "EIDR"},
+	reflect.TypeOf(ernv383.WebPage{}):                                 {"PartyId", "ReleaseId", "PageName", "URL", "UserName", "Password"},
+	reflect.TypeOf(ernv383.WebPolicy{}):                               {"Condition", "AccessBlockingRequested", "AccessLimitation", "EmbeddingAllowed", "UserRatingAllowed", "UserCommentAllowed", "UserResponsesAllowed", "SyndicationAllowed"},
+	reflect.TypeOf(ernv42.AdditionalTitle{}):                          {"TitleText", "SubTitle"},
+	reflect.TypeOf(ernv42.AdministratingRecordCompanyWithReference{}): {"RecordCompanyPartyReference", "Role"},
+	reflect.TypeOf(ernv42.Affiliation{}):                              {"CompanyName", "PartyAffiliateReference", "Type", "TerritoryCode", "ExcludedTerritoryCode", "ValidityPeriod", "RightsType", "PercentageOfRightsAssignment"},
+	reflect.TypeOf(ernv42.AvRating{}):                                 {"Rating", "Agency", "Reason"},
+	reflect.TypeOf(ernv42.CLine{}):                                    {"Year", "CLineCompany", "CLineText"},
+	reflect.TypeOf(ernv42.CLineWithDefault{}):                         {"Year", "CLineCompany", "CLineText"},
+	reflect.TypeOf(ernv42.Chapter{}):                                  {"ChapterReference", "ChapterId", "DisplayTitleText", "DisplayTitle", "AdditionalTitle", "SequenceNumber", "Contributor", "Character", "RepresentativeImageReference", "StartTime", "Duration", "EndTime"},
+	reflect.TypeOf(ernv42.Character{}):                                {"CharacterPartyReference", "Performer"},
+	reflect.TypeOf(ernv42.ConditionForRightsClaimPolicy{}):            {"Value", "Unit", "ReferenceCreation", "RelationalRelator", "MeasurementType"},
+	reflect.TypeOf(ernv42.Contributor{}):                              {"ContributorPartyReference", "Role", "InstrumentType", "HasMadeFeaturedContribution", "HasMadeContractedContribution", "IsCredited", "DisplayCredits"},
+	reflect.TypeOf(ernv42.CoreArea{}):                                 {"TopLeftCorner", "BottomRightCorner"},
+	reflect.TypeOf(ernv42.Deal{}):                                     {"DealReference", "IsCommunicatedOutOfBand", "DealTerms", "DealTechnicalResourceDetailsReferenceList", "DistributionChannelPage"},
+	reflect.TypeOf(ernv42.DealList{}):                                 {"ReleaseDeal", "ReleaseVisibility", "TrackReleaseVisibility"},
+	reflect.TypeOf(ernv42.DealTerms{}):                                {"TerritoryCode", "ExcludedTerritoryCode", "ValidityPeriod", "CommercialModelType", "UseType", "UserInterfaceType", "CarrierType", "TechnicalInstantiation", "NumberOfUsages", "DistributionChannel", "ExcludedDistributionChannel", "RightsClaimPolicy", "PriceInformation", "IsPromotional", "PromotionalCode", "IsPreOrderDeal", "InstantGratificationResourceList", "PhysicalReturns", "NumberOfProductsPerCarton"},
+	reflect.TypeOf(ernv42.DealTermsTechnicalInstantiation{}):          {"VideoDefinitionType", "CodingType", "BitRate"},
+	reflect.TypeOf(ernv42.DelegatedUsageRights{}):                     {"UseType", "PeriodOfRightsDelegation", "TerritoryOfRightsDelegation"},
+	reflect.TypeOf(ernv42.DetailedCue{}):                              {"CueUseType", "CueThemeType", "CueVocalType", "CueVisualPerceptionType", "CueOrigin", "ResourceId", "WorkId", "DisplayTitleText", "DisplayTitle", "AdditionalTitle", "Contributor", "IsDance", "HasMusicalContent", "PLine", "CLine", "StartTime", "Duration", "EndTime"},
+	reflect.TypeOf(ernv42.DetailedCueSheet{}):                         {"CueSheetId", "CueSheetReference", "CueSheetType", "Cue"},
+	reflect.TypeOf(ernv42.DetailedHashSum{}):                          {"Algorithm", "Version", "Parameter", "DataType", "HashSumValue"},
+	reflect.TypeOf(ernv42.DetailedPartyId{}):                          {"ISNI", "DPID", "IpiNameNumber", "IPN", "CisacSocietyId", "ProprietaryId"},
+	reflect.TypeOf(ernv42.DisplayArtist{}):                            {"ArtistPartyReference", "DisplayArtistRole", "ArtisticRole", "TitleDisplayInformation"},
+	reflect.TypeOf(ernv42.DisplayTitle{}):                             {"TitleText", "SubTitle"},
+	reflect.TypeOf(ernv42.DistributionChannelPage{}):                  {"PartyId", "PageName", "URL", "UserName"},
+	reflect.TypeOf(ernv42.ExternalResourceLink{}):                     {"URL", "ValidityPeriod", "ExternalLink", "ExternallyLinkedResourceType", "FileFormat"},
+	reflect.TypeOf(ernv42.File{}):                                     {"URI", "HashSum", "FileSize"},
+	reflect.TypeOf(ernv42.Fingerprint{}):                              {"Algorithm", "Version", "Parameter", "File", "DataType", "FingerprintValue"},
+	reflect.TypeOf(ernv42.FulfillmentDateWithTerritory{}):             {"FulfillmentDate", "ResourceReleaseReference"},
+	reflect.TypeOf(ernv42.GenreCategory{}):                            {"Value", "Description"},
+	reflect.TypeOf(ernv42.GenreWithTerritory{}):                       {"GenreText", "SubGenre", "GenreCategory", "SubGenreCategory"},
+	reflect.TypeOf(ernv42.Image{}):                                    {"ResourceReference", "Type", "ResourceId", "DisplayTitleText", "DisplayTitle", "AdditionalTitle", "VersionType", "DisplayArtistName", "DisplayArtist", "Contributor", "ResourceRightsController", "WorkRightsController", "CLine", "CourtesyLine", "CreationDate", "FirstPublicationDate", "ParentalWarningType", "RelatedRelease", "RelatedResource", "ContainsHiddenContent", "Description", "TechnicalDetails"},
+	reflect.TypeOf(ernv42.LocationAndDateOfSession{}):                 {"SessionType", "Period", "Venue", "Comment", "Contributor"},
+	reflect.TypeOf(ernv42.MessageAuditTrailEvent{}):                   {"MessagingPartyDescriptor", "DateTime"},
+	reflect.TypeOf(ernv42.MessageHeader{}):                            {"MessageThreadId", "MessageId", "MessageFileName", "MessageSender", "SentOnBehalfOf", "MessageRecipient", "MessageCreatedDateTime", "MessageAuditTrail", "MessageControlType"},
+	reflect.TypeOf(ernv42.MessagingPartyWithoutCode{}):                {"PartyId", "PartyName", "TradingName"},
+	reflect.TypeOf(ernv42.MusicalWorkId{}):                            {"ISWC", "OpusNumber", "ComposerCatalogNumber", "ProprietaryId"},
+	reflect.TypeOf(ernv42.NewReleaseMessage{}):                        {"MessageHeader", "ReleaseAdmin", "PartyList", "CueSheetList", "ResourceList", "ChapterList", "ReleaseList", "DealList", "SupplementalDocumentList"},
+	reflect.TypeOf(ernv42.PLine{}):                                    {"Year", "PLineCompany", "PLineText"},
+	reflect.TypeOf(ernv42.PLineWithDefault{}):                         {"Year", "PLineCompany", "PLineText"},
+	reflect.TypeOf(ernv42.PartyName{}):                                {"FullName", "FullNameAsciiTranscribed", "FullNameIndexed", "NamesBeforeKeyName", "KeyName", "NamesAfterKeyName", "AbbreviatedName"},
+	reflect.TypeOf(ernv42.PartyNameWithTerritory{}):                   {"FullName", "FullNameAsciiTranscribed", "FullNameIndexed", "NamesBeforeKeyName", "KeyName", "NamesAfterKeyName", "AbbreviatedName"},
+	reflect.TypeOf(ernv42.PartyNameWithoutCode{}):                     {"FullName", "FullNameAsciiTranscribed", "FullNameIndexed", "NamesBeforeKeyName", "KeyName", "NamesAfterKeyName", "AbbreviatedName"},
+	reflect.TypeOf(ernv42.PartyWithRole{}):                            {"ISNI", "DPID", "IpiNameNumber", "IPN", "ProprietaryId", "PartyName", "Role"},
+	reflect.TypeOf(ernv42.Period{}):                                   {"StartDate", "EndDate", "StartDateTime", "EndDateTime"},
+	reflect.TypeOf(ernv42.PeriodWithStartDate{}):                      {"StartDate", "EndDate", "StartDateTime", "EndDateTime"},
+	reflect.TypeOf(ernv42.PeriodWithoutFlags{}):                       {"StartDate", "EndDate", "StartDateTime", "EndDateTime"},
+	reflect.TypeOf(ernv42.PhysicalReturns{}):                          {"PhysicalReturnsAllowed", "LatestDateForPhysicalReturns"},
+	reflect.TypeOf(ernv42.PreviewDetails{}):                           {"TopLeftCorner", "BottomRightCorner", "ExpressionType"},
+	reflect.TypeOf(ernv42.PriceInformationWithType{}):                 {"PriceCode", "WholesalePricePerUnit", "BulkOrderWholesalePricePerUnit", "SuggestedRetailPrice"},
+	reflect.TypeOf(ernv42.PurgeReleaseMessage{}):                      {"MessageHeader", "PurgedRelease"},
+	reflect.TypeOf(ernv42.PurgedRelease{}):                            {"ReleaseId", "Title", "Contributor"},
+	reflect.TypeOf(ernv42.RelatedParty{}):                             {"PartyRelatedPartyReference", "PartyRelationshipType"},
+	reflect.TypeOf(ernv42.RelatedRelease{}):                           {"ReleaseRelationshipType", "ReleaseId", "DisplayTitleText", "DisplayTitle", "AdditionalTitle", "DisplayArtistName", "ReleaseLabelReference", "ReleaseDate", "OriginalReleaseDate"},
+	reflect.TypeOf(ernv42.RelatedResource{}):                          {"ResourceRelationshipType", "ResourceRelatedResourceReference", "ResourceId", "Timing"},
+	reflect.TypeOf(ernv42.Release{}):                                  {"ReleaseReference", "ReleaseType", "ReleaseId", "DisplayTitleText", "DisplayTitle", "AdditionalTitle", "DisplayArtistName", "DisplayArtist", "ReleaseLabelReference", "AdministratingRecordCompany", "PLine", "CLine", "CourtesyLine", "Duration", "Genre", "ReleaseDate", "OriginalReleaseDate", "ReleaseVisibilityReference", "ParentalWarningType", "AvRating", "RelatedRelease", "RelatedResource", "IsSingleArtistCompilation", "IsMultiArtistCompilation", "ResourceGroup", "ExternalResourceLink", "TargetURL", "Keywords", "Synopsis", "Raga", "Tala", "Deity", "HiResMusicDescription", "IsSoundtrack", "IsHiResMusic", "MarketingComment"},
+	reflect.TypeOf(ernv42.ReleaseAdmin{}):                             {"ReleaseAdminId", "PersonnelDescription", "SystemDescription"},
+	reflect.TypeOf(ernv42.ReleaseDeal{}):                              {"DealReleaseReference", "Deal"},
+	reflect.TypeOf(ernv42.ReleaseId{}):                                {"GRid", "ICPN", "CatalogNumber", "ProprietaryId"},
+	reflect.TypeOf(ernv42.ReleaseList{}):                              {"Release", "TrackRelease"},
+	reflect.TypeOf(ernv42.ReleaseVisibility{}):                        {"VisibilityReference", "ReleaseDisplayStartDateTime", "CoverArtPreviewStartDateTime", "FullTrackListingPreviewStartDateTime"},
+	reflect.TypeOf(ernv42.ResourceContainedResourceReference{}):       {"ResourceContainedResourceReference", "DurationUsed", "StartPoint", "Purpose"},
+	reflect.TypeOf(ernv42.ResourceGroup{}):                            {"DisplayTitleText", "DisplayTitle", "AdditionalTitle", "SequenceNumber", "NoDisplaySequence", "DisplaySequence", "DisplayArtist", "CarrierType", "Duration", "ResourceGroupReleaseReference", "ReleaseId", "ResourceGroup", "ResourceGroupContentItem", "LinkedReleaseResourceReference"},
+	reflect.TypeOf(ernv42.ResourceGroupContentItem{}):                 {"SequenceNumber", "NoDisplaySequence", "DisplaySequence", "ReleaseResourceReference", "LinkedReleaseResourceReference", "IsBonusResource", "IsInstantGratificationResource", "IsPreOrderIncentiveResource"},
+	reflect.TypeOf(ernv42.ResourceId{}):                               {"ISRC", "ISMN", "ISAN", "VISAN", "ISBN", "ISSN", "SICI", "CatalogNumber", "ProprietaryId"},
+	reflect.TypeOf(ernv42.ResourceList{}):                             {"SoundRecording", "Video", "Image", "Text", "SheetMusic", "Software"},
+	reflect.TypeOf(ernv42.ResourceRightsController{}):                 {"RightsControllerPartyReference", "RightsControlType", "RightShareUnknown", "RightSharePercentage", "DelegatedUsageRights"},
+	reflect.TypeOf(ernv42.ResourceSubGroup{}):                         {"DisplayTitleText", "DisplayTitle", "AdditionalTitle", "SequenceNumber", "NoDisplaySequence", "DisplaySequence", "DisplayArtist", "CarrierType", "Duration", "ResourceGroupReleaseReference", "ReleaseId", "ResourceGroup", "ResourceGroupContentItem", "LinkedReleaseResourceReference"},
+	reflect.TypeOf(ernv42.RightsClaimPolicy{}):                        {"Condition", "RightsClaimPolicyType"},
+	reflect.TypeOf(ernv42.SheetMusic{}):                               {"ResourceReference", "Type", "ResourceId", "WorkId", "DisplayTitleText", "DisplayTitle", "AdditionalTitle", "VersionType", "DisplayArtistName", "DisplayArtist", "Contributor", "ResourceRightsController", "WorkRightsController", "CLine", "CourtesyLine", "CreationDate", "FirstPublicationDate", "ParentalWarningType", "RelatedRelease", "RelatedResource", "ContainsHiddenContent", "LanguageOfLyrics", "ResourceContainedResourceReferenceList", "TechnicalDetails"},
+	reflect.TypeOf(ernv42.SheetMusicId{}):                             {"ISMN", "ProprietaryId"},
+	reflect.TypeOf(ernv42.Software{}):                                 {"ResourceReference", "Type", "ResourceId", "WorkId", "DisplayTitleText", "DisplayTitle", "AdditionalTitle", "VersionType", "DisplayArtistName", "DisplayArtist", "Contributor", "ResourceRightsController", "WorkRightsController", "PLine", "CLine", "CourtesyLine", "CreationDate", "FirstPublicationDate", "ParentalWarningType", "RelatedRelease", "RelatedResource", "ContainsHiddenContent", "ResourceContainedResourceReferenceList", "TechnicalDetails"},
+	reflect.TypeOf(ernv42.SoundRecording{}):                           {"ResourceReference", "Type", "ResourceId", "WorkId", "DisplayTitleText", "DisplayTitle", "AdditionalTitle", "VersionType", "DisplayArtistName", "DisplayArtist", "Contributor", "Character", "ResourceRightsController", "WorkRightsController", "PLine", "CourtesyLine", "Duration", "CreationDate", "MasteredDate", "RemasteredDate", "FirstPublicationDate", "LocationAndDateOfSession", "ParentalWarningType", "RelatedRelease", "RelatedResource", "CompositeMusicalWorkType", "IsCover", "IsInstrumental", "ContainsHiddenContent", "IsRemastered", "IsHiResMusic", "DisableCrossfade", "DisableSearch", "DisplayCredits", "LanguageOfPerformance", "AudioChannelConfiguration", "TechnicalDetails", "Raga", "Tala", "Deity", "AudioChapterReference"},
+	reflect.TypeOf(ernv42.SoundRecordingId{}):                         {"ISRC", "CatalogNumber", "ProprietaryId"},
+	reflect.TypeOf(ernv42.SoundRecordingPreviewDetails{}):             {"StartPoint", "EndPoint", "Duration", "TopLeftCorner", "BottomRightCorner", "ExpressionType"},
+	reflect.TypeOf(ernv42.TechnicalImageDetails{}):                    {"TechnicalResourceDetailsReference", "ImageCodecType", "ImageHeight", "ImageWidth", "AspectRatio", "ColorDepth", "ImageResolution", "BitDepth", "IsPreview", "PreviewDetails", "File", "IsProvidedInDelivery", "Fingerprint"},
+	reflect.TypeOf(ernv42.TechnicalSheetMusicDetails{}):               {"TechnicalResourceDetailsReference", "SheetMusicCodecType", "BitDepth", "IsPreview", "PreviewDetails", "File", "IsProvidedInDelivery", "Fingerprint"},
+	reflect.TypeOf(ernv42.TechnicalSoftwareDetails{}):                 {"TechnicalResourceDetailsReference", "OperatingSystemType", "BitDepth", "IsPreview", "PreviewDetails", "File", "IsProvidedInDelivery", "Fingerprint"},
+	reflect.TypeOf(ernv42.TechnicalSoundRecordingDetails{}):           {"TechnicalResourceDetailsReference", "EncodingId", "AudioCodecType", "BitRate", "OriginalBitRate", "NumberOfChannels", "SamplingRate", "OriginalSamplingRate", "BitsPerSample", "Duration", "BitDepth", "IsPreview", "PreviewDetails", "File", "IsProvidedInDelivery", "Fingerprint", "EncodingDescription"},
+	reflect.TypeOf(ernv42.TechnicalTextDetails{}):                     {"TechnicalResourceDetailsReference", "TextCodecType", "BitDepth", "IsPreview", "PreviewDetails", "File", "IsProvidedInDelivery", "Fingerprint"},
+	reflect.TypeOf(ernv42.TechnicalVideoDetails{}):                    {"TechnicalResourceDetailsReference", "EncodingId", "OverallBitRate", "ContainerFormat", "VideoCodecType", "VideoBitRate", "FrameRate", "ImageHeight", "ImageWidth", "AspectRatio", "CoreArea", "ColorDepth", "VideoDefinitionType", "AudioCodecType", "AudioBitRate", "NumberOfAudioChannels", "AudioSamplingRate", "AudioBitsPerSample", "Duration", "BitDepth", "IsPreview", "PreviewDetails", "File", "IsProvidedInDelivery", "Fingerprint", "EncodingDescription"},
+	reflect.TypeOf(ernv42.Text{}):                                     {"ResourceReference", "Type", "ResourceId", "WorkId", "DisplayTitleText", "DisplayTitle", "AdditionalTitle", "VersionType", "DisplayArtistName", "DisplayArtist", "Contributor", "ResourceRightsController", "WorkRightsController", "CLine", "CourtesyLine", "CreationDate", "FirstPublicationDate", "ParentalWarningType", "RelatedRelease", "RelatedResource", "ContainsHiddenContent", "ResourceContainedResourceReferenceList", "TechnicalDetails"},
+	reflect.TypeOf(ernv42.TextId{}):                                   {"ISBN", "ISSN", "SICI", "ProprietaryId"},
+	reflect.TypeOf(ernv42.Timing{}):                                   {"StartPoint", "DurationUsed"},
+	reflect.TypeOf(ernv42.Title{}):                                    {"TitleText", "SubTitle"},
+	reflect.TypeOf(ernv42.TitleDisplayInformation{}):                  {"IsDisplayedInTitle", "Prefix"},
+	reflect.TypeOf(ernv42.TrackRelease{}):                             {"ReleaseReference", "ReleaseId", "DisplayTitleText", "DisplayTitle", "AdditionalTitle", "ReleaseResourceReference", "LinkedReleaseResourceReference", "ReleaseLabelReference", "Genre", "ReleaseVisibilityReference", "RelatedRelease", "RelatedResource", "TargetURL", "Keywords", "Synopsis", "MarketingComment"},
+	reflect.TypeOf(ernv42.TrackReleaseVisibility{}):                   {"VisibilityReference", "TrackListingPreviewStartDateTime", "ClipPreviewStartDateTime"},
+	reflect.TypeOf(ernv42.ValidityPeriod{}):                           {"StartDate", "EndDate"},
+	reflect.TypeOf(ernv42.Venue{}):                                    {"VenueName", "VenueAddress", "TerritoryCode", "LocationCode", "VenueRoom"},
+	reflect.TypeOf(ernv42.Video{}):                                    {"ResourceReference", "Type", "ResourceId", "WorkId", "DisplayTitleText", "DisplayTitle", "AdditionalTitle", "VersionType", "DisplayArtistName", "DisplayArtist", "Contributor", "Character", "ResourceRightsController", "WorkRightsController", "PLine", "CLine", "CourtesyLine", "Duration", "CreationDate", "MasteredDate", "RemasteredDate", "FirstPublicationDate", "ParentalWarningType", "AvRating", "RelatedRelease", "RelatedResource", "CompositeMusicalWorkType", "VideoCueSheetReference", "ReasonForCueSheetAbsence", "IsCover", "IsInstrumental", "ContainsHiddenContent", "IsRemastered", "DisplayCredits", "LanguageOfPerformance", "LanguageOfDubbing", "SubTitleLanguage", "ResourceContainedResourceReferenceList", "TechnicalDetails", "Raga", "Tala", "Deity", "VideoChapterReference"},
+	reflect.TypeOf(ernv42.VideoId{}):                                  {"ISRC", "ISAN", "VISAN", "CatalogNumber", "ProprietaryId", "EIDR"},
+	reflect.TypeOf(ernv42.WorkRightsController{}):                     {"RightsControllerPartyReference", "RightsControlType", "RightsControllerType", "RightShareUnknown", "RightSharePercentage", "Territory", "StartDate", "EndDate"},
+	reflect.TypeOf(ernv43.AdditionalTitle{}):                          {"TitleText", "SubTitle"},
+	reflect.TypeOf(ernv43.AdministratingRecordCompanyWithReference{}): {"RecordCompanyPartyReference", "Role"},
+	reflect.TypeOf(ernv43.Affiliation{}):                              {"CompanyName", "PartyAffiliateReference", "Type", "TerritoryCode", "ExcludedTerritoryCode", "ValidityPeriod", "RightsType", "PercentageOfRightsAssignment"},
+	reflect.TypeOf(ernv43.AudioDeliveryFile{}):                        {"Type", "ContainerFormat", "AudioCodecType", "BitRate", "OriginalBitRate", "NumberOfChannels", "NumberOfAudioObjects", "SamplingRate", "OriginalSamplingRate", "BitsPerSample", "Duration", "BitDepth", "File", "Fingerprint", "IsProvidedInDelivery"},
+	reflect.TypeOf(ernv43.AvRating{}):                                 {"Rating", "Agency", "Reason"},
+	reflect.TypeOf(ernv43.CLine{}):                                    {"Year", "CLineCompany", "CLineText"},
+	reflect.TypeOf(ernv43.CLineWithDefault{}):                         {"Year", "CLineCompany", "CLineText"},
+	reflect.TypeOf(ernv43.Channel{}):                                  {"ProprietaryId", "URL"},
+	reflect.TypeOf(ernv43.Chapter{}):                                  {"ChapterReference", "ChapterId", "DisplayTitleText", "DisplayTitle", "AdditionalTitle", "SequenceNumber", "Contributor", "Character", "RepresentativeImageReference", "StartTime", "Duration", "EndTime"},
+	reflect.TypeOf(ernv43.Character{}):                                {"CharacterPartyReference", "Performer"},
+	reflect.TypeOf(ernv43.ClipDetails{}):                              {"ClipType", "TopLeftCorner", "BottomRightCorner", "ExpressionType"},
+	reflect.TypeOf(ernv43.ClipRelease{}):                              {"ReleaseReference", "ReleaseId", "DisplayTitleText", "DisplayTitle", "AdditionalTitle", "ReleaseResourceReference", "ReleaseLabelReference", "Genre", "RelatedRelease"},
+	reflect.TypeOf(ernv43.ConditionForRightsClaimPolicy{}):            {"Value", "Unit", "ReferenceCreation", "RelationalRelator", "MeasurementType", "Segment", "ServiceException"},
+	reflect.TypeOf(ernv43.Contributor{}):                              {"ContributorPartyReference", "Role", "InstrumentType", "HasMadeFeaturedContribution", "HasMadeContractedContribution", "IsCredited", "DisplayCredits"},
+	reflect.TypeOf(ernv43.CoreArea{}):                                 {"TopLeftCorner", "BottomRightCorner"},
+	reflect.TypeOf(ernv43.Cue{}):                                      {"CueUseType", "CueThemeType", "CueVocalType", "CueVisualPerceptionType", "CueOrigin", "ResourceId", "WorkId", "DisplayTitleText", "DisplayTitle", "AdditionalTitle", "Contributor", "IsDance", "HasMusicalContent", "PLine", "CLine", "StartTime", "Duration", "EndTime"},
+	reflect.TypeOf(ernv43.CueSheet{}):                                 {"CueSheetId", "CueSheetReference", "CueSheetType", "Cue"},
+	reflect.TypeOf(ernv43.Deal{}):                                     {"DealReference", "IsCommunicatedOutOfBand", "DealTerms", "DealTechnicalResourceDetailsReferenceList", "DistributionChannelPage"},
+	reflect.TypeOf(ernv43.DealList{}):                                 {"ReleaseDeal", "ReleaseVisibility", "TrackReleaseVisibility"},
+	reflect.TypeOf(ernv43.DealTerms{}):                                {"TerritoryCode", "ExcludedTerritoryCode", "ValidityPeriod", "CommercialModelType", "UseType", "UserInterfaceType", "CarrierType", "TechnicalInstantiation", "NumberOfUsages", "DistributionChannel", "ExcludedDistributionChannel", "RightsClaimPolicy", "PriceInformation", "IsPromotional", "PromotionalCode", "IsPreOrderDeal", "InstantGratificationResourceList", "PhysicalReturns", "NumberOfProductsPerCarton"},
+	reflect.TypeOf(ernv43.DealTermsTechnicalInstantiation{}):          {"VideoDefinitionType", "CodingType", "BitRate"},
+	reflect.TypeOf(ernv43.DelegatedUsageRights{}):                     {"UseType", "PeriodOfRightsDelegation", "TerritoryOfRightsDelegation"},
+	reflect.TypeOf(ernv43.DetailedHashSum{}):                          {"Algorithm", "Version", "Parameter", "DataType", "HashSumValue"},
+	reflect.TypeOf(ernv43.DetailedPartyId{}):                          {"ISNI", "DPID", "IpiNameNumber", "IPN", "CisacSocietyId", "ProprietaryId"},
+	reflect.TypeOf(ernv43.DisplayArtist{}):                            {"ArtistPartyReference", "DisplayArtistRole", "ArtisticRole", "TitleDisplayInformation"},
+	reflect.TypeOf(ernv43.DisplayTitle{}):                             {"TitleText", "SubTitle"},
+	reflect.TypeOf(ernv43.DistributionChannelPage{}):                  {"PartyId", "PageName", "URL", "UserName"},
+	reflect.TypeOf(ernv43.EditionContributor{}):                       {"ContributorPartyReference", "Role", "HasMadeFeaturedContribution", "HasMadeContractedContribution", "IsCredited", "DisplayCredits"},
+	reflect.TypeOf(ernv43.ExternalResourceLink{}):                     {"URL", "ValidityPeriod", "ExternalLink", "ExternallyLinkedResourceType", "FileFormat"},
+	reflect.TypeOf(ernv43.File{}):                                     {"URI", "HashSum", "FileSize"},
+	reflect.TypeOf(ernv43.Fingerprint{}):                              {"Algorithm", "Version", "Parameter", "File", "DataType", "FingerprintValue"},
+	reflect.TypeOf(ernv43.FulfillmentDateWithTerritory{}):             {"FulfillmentDate", "ResourceReleaseReference"},
+	reflect.TypeOf(ernv43.GenreCategory{}):                            {"Value", "Description"},
+	reflect.TypeOf(ernv43.GenreWithTerritory{}):                       {"GenreText", "SubGenre", "GenreCategory", "SubGenreCategory"},
+	reflect.TypeOf(ernv43.Image{}):                                    {"ResourceReference", "Type", "ResourceId", "DisplayTitleText", "DisplayTitle", "AdditionalTitle", "VersionType", "DisplayArtistName", "DisplayArtist", "Contributor", "ResourceRightsController", "WorkRightsController", "CLine", "CourtesyLine", "CreationDate", "FirstPublicationDate", "ParentalWarningType", "RelatedRelease", "RelatedResource", "ContainsHiddenContent", "Description", "TechnicalDetails"},
+	reflect.TypeOf(ernv43.LocationAndDateOfSession{}):                 {"SessionType", "Period", "Venue", "Comment", "Contributor"},
+	reflect.TypeOf(ernv43.MessageAuditTrailEvent{}):                   {"MessagingPartyDescriptor", "DateTime"},
+	reflect.TypeOf(ernv43.MessageHeader{}):                            {"MessageThreadId", "MessageId", "MessageFileName", "MessageSender", "SentOnBehalfOf", "MessageRecipient", "MessageCreatedDateTime", "MessageAuditTrail", "MessageControlType"},
+	reflect.TypeOf(ernv43.MessagingPartyWithoutCode{}):                {"PartyId", "PartyName", "TradingName"},
+	reflect.TypeOf(ernv43.MusicalWorkId{}):                            {"ISWC", "OpusNumber", "ComposerCatalogNumber", "ProprietaryId"},
+	reflect.TypeOf(ernv43.NewReleaseMessage{}):                        {"MessageHeader", "ReleaseAdmin", "PartyList", "CueSheetList", "ResourceList", "ChapterList", "ReleaseList", "DealList", "SupplementalDocumentList"},
+	reflect.TypeOf(ernv43.PLine{}):                                    {"Year", "PLineCompany", "PLineText"},
+	reflect.TypeOf(ernv43.PLineWithDefault{}):                         {"Year", "PLineCompany", "PLineText"},
+	reflect.TypeOf(ernv43.PartyName{}):                                {"FullName", "FullNameAsciiTranscribed", "FullNameIndexed", "NamesBeforeKeyName", "KeyName", "NamesAfterKeyName", "AbbreviatedName"},
+	reflect.TypeOf(ernv43.PartyNameWithTerritory{}):                   {"FullName", "FullNameAsciiTranscribed", "FullNameIndexed", "NamesBeforeKeyName", "KeyName", "NamesAfterKeyName", "AbbreviatedName"},
+	reflect.TypeOf(ernv43.PartyNameWithoutCode{}):                     {"FullName", "FullNameAsciiTranscribed", "FullNameIndexed", "NamesBeforeKeyName", "KeyName", "NamesAfterKeyName", "AbbreviatedName"},
+	reflect.TypeOf(ernv43.PartyWithRole{}):                            {"ISNI", "DPID", "IpiNameNumber", "IPN", "ProprietaryId", "PartyName", "Role"},
+	reflect.TypeOf(ernv43.Period{}):                                   {"StartDate", "EndDate", "StartDateTime", "EndDateTime"},
+	reflect.TypeOf(ernv43.PeriodWithStartDate{}):                      {"StartDate", "EndDate", "StartDateTime", "EndDateTime"},
+	reflect.TypeOf(ernv43.PeriodWithoutFlags{}):                       {"StartDate", "EndDate", "StartDateTime", "EndDateTime"},
+	reflect.TypeOf(ernv43.PhysicalReturns{}):                          {"PhysicalReturnsAllowed", "LatestDateForPhysicalReturns"},
+	reflect.TypeOf(ernv43.PriceInformationWithType{}):                 {"PriceCode", "WholesalePricePerUnit", "BulkOrderWholesalePricePerUnit", "SuggestedRetailPrice"},
+	reflect.TypeOf(ernv43.PurgeReleaseMessage{}):                      {"MessageHeader", "PurgedRelease"},
+	reflect.TypeOf(ernv43.PurgedRelease{}):                            {"ReleaseId", "Title", "Contributor"},
+	reflect.TypeOf(ernv43.RelatedParty{}):                             {"PartyRelatedPartyReference", "PartyRelationshipType"},
+	reflect.TypeOf(ernv43.RelatedRelease{}):                           {"ReleaseRelationshipType", "ReleaseId", "DisplayTitleText", "DisplayTitle", "AdditionalTitle", "DisplayArtistName", "ReleaseLabelReference", "ReleaseDate", "OriginalReleaseDate"},
+	reflect.TypeOf(ernv43.RelatedResource{}):                          {"ResourceRelationshipType", "ResourceRelatedResourceReference", "ResourceId", "Timing"},
+	reflect.TypeOf(ernv43.Release{}):                                  {"ReleaseReference", "ReleaseType", "ReleaseId", "DisplayTitleText", "DisplayTitle", "AdditionalTitle", "VersionType", "DisplayArtistName", "DisplayArtist", "ReleaseLabelReference", "AdministratingRecordCompany", "PLine", "CLine", "CourtesyLine", "Duration", "Genre", "ReleaseDate", "OriginalReleaseDate", "ReleaseVisibilityReference", "ParentalWarningType", "AvRating", "RelatedRelease", "RelatedResource", "IsSingleArtistCompilation", "IsMultiArtistCompilation", "ResourceGroup", "ExternalResourceLink", "TargetURL", "Keywords", "Synopsis", "Raga", "Tala", "Deity", "HiResMusicDescription", "IsSoundtrack", "IsHiResMusic", "MarketingComment"},
+	reflect.TypeOf(ernv43.ReleaseAdmin{}):                             {"ReleaseAdminId", "PersonnelDescription", "SystemDescription"},
+	reflect.TypeOf(ernv43.ReleaseDeal{}):                              {"DealReleaseReference", "Deal"},
+	reflect.TypeOf(ernv43.ReleaseId{}):                                {"GRid", "ICPN", "CatalogNumber", "ProprietaryId"},
+	reflect.TypeOf(ernv43.ReleaseList{}):                              {"Release", "TrackRelease", "ClipRelease"},
+	reflect.TypeOf(ernv43.ReleaseVisibility{}):                        {"VisibilityReference", "TerritoryCode", "ExcludedTerritoryCode", "ReleaseDisplayStartDateTime", "CoverArtPreviewStartDateTime", "FullTrackListingPreviewStartDateTime", "ClipPreviewStartDateTime"},
+	reflect.TypeOf(ernv43.ResourceContainedResourceReference{}):       {"ResourceContainedResourceReference", "DurationUsed", "StartPoint", "Purpose"},
+	reflect.TypeOf(ernv43.ResourceGroup{}):                            {"DisplayTitleText", "DisplayTitle", "AdditionalTitle", "SequenceNumber", "NoDisplaySequence", "DisplaySequence", "DisplayArtist", "CarrierType", "Duration", "ResourceGroupReleaseReference", "ReleaseId", "ResourceGroup", "ResourceGroupContentItem", "LinkedReleaseResourceReference"},
+	reflect.TypeOf(ernv43.ResourceGroupContentItem{}):                 {"SequenceNumber", "NoDisplaySequence", "DisplaySequence", "ReleaseResourceReference", "LinkedReleaseResourceReference", "IsBonusResource", "IsInstantGratificationResource", "IsPreOrderIncentiveResource"},
+	reflect.TypeOf(ernv43.ResourceId{}):                               {"ISRC", "ISMN", "ISAN", "VISAN", "ISBN", "ISSN", "SICI", "CatalogNumber", "ProprietaryId"},
+	reflect.TypeOf(ernv43.ResourceList{}):                             {"SoundRecording", "Video", "Image", "Text", "SheetMusic", "Software"},
+	reflect.TypeOf(ernv43.ResourceRightsController{}):                 {"RightsControllerPartyReference", "RightsControlType", "RightShareUnknown", "RightSharePercentage", "DelegatedUsageRights"},
+	reflect.TypeOf(ernv43.ResourceSubGroup{}):                         {"DisplayTitleText", "DisplayTitle", "AdditionalTitle", "SequenceNumber", "NoDisplaySequence", "DisplaySequence", "DisplayArtist", "CarrierType", "Duration", "ResourceGroupReleaseReference", "ReleaseId", "ResourceGroup", "ResourceGroupContentItem", "LinkedReleaseResourceReference"},
+	reflect.TypeOf(ernv43.RightsClaimPolicy{}):                        {"Condition", "RightsClaimPolicyType"},
+	reflect.TypeOf(ernv43.Segment{}):                                  {"StartTime", "Duration", "EndTime"},
+	reflect.TypeOf(ernv43.SheetMusic{}):                               {"ResourceReference", "Type", "ResourceId", "WorkId", "DisplayTitleText", "DisplayTitle", "AdditionalTitle", "VersionType", "DisplayArtistName", "DisplayArtist", "Contributor", "ResourceRightsController", "WorkRightsController", "CLine", "CourtesyLine", "CreationDate", "FirstPublicationDate", "ParentalWarningType", "RelatedRelease", "RelatedResource", "ContainsHiddenContent", "LanguageOfLyrics", "ResourceContainedResourceReferenceList", "TechnicalDetails"},
+	reflect.TypeOf(ernv43.SheetMusicId{}):                             {"ISMN", "ProprietaryId"},
+	reflect.TypeOf(ernv43.Software{}):                                 {"ResourceReference", "Type", "ResourceId", "WorkId", "DisplayTitleText", "DisplayTitle", "AdditionalTitle", "VersionType", "DisplayArtistName", "DisplayArtist", "Contributor", "ResourceRightsController", "WorkRightsController", "PLine", "CLine", "CourtesyLine", "CreationDate", "FirstPublicationDate", "ParentalWarningType", "RelatedRelease", "RelatedResource", "ContainsHiddenContent", "ResourceContainedResourceReferenceList", "TechnicalDetails"},
+	reflect.TypeOf(ernv43.SoundRecording{}):                           {"ResourceReference", "Type", "SoundRecordingEdition", "RecordingFormat", "WorkId", "DisplayTitleText", "DisplayTitle", "AdditionalTitle", "VersionType", "DisplayArtistName", "DisplayArtist", "Contributor", "Character", "ResourceRightsController", "WorkRightsController", "CourtesyLine", "Duration", "CreationDate", "MasteredDate", "RemasteredDate", "FirstPublicationDate", "LocationAndDateOfSession", "ParentalWarningType", "RelatedRelease", "RelatedResource", "CompositeMusicalWorkType", "IsCover", "HasVocalPerformance", "HasForegroundVocalPerformance", "IsInstrumental", "ContainsHiddenContent", "IsRemastered", "IsHiResMusic", "DisableCrossfade", "DisableSearch", "DisplayCredits", "LanguageOfPerformance", "Raga", "Tala", "Deity", "AudioChapterReference"},
+	reflect.TypeOf(ernv43.SoundRecordingClipDetails{}):                {"TechnicalResourceDetailsReference", "ClipType", "Timing", "ExpressionType", "DeliveryFile"},
+	reflect.TypeOf(ernv43.SoundRecordingEdition{}):                    {"Type", "ResourceId", "EditionContributor", "PLine", "RecordingMode", "TechnicalDetails"},
+	reflect.TypeOf(ernv43.SoundRecordingId{}):                         {"ISRC", "CatalogNumber", "ProprietaryId"},
+	reflect.TypeOf(ernv43.TechnicalImageDetails{}):                    {"TechnicalResourceDetailsReference", "ImageCodecType", "ImageHeight", "ImageWidth", "AspectRatio", "ColorDepth", "ImageResolution", "BitDepth", "IsClip", "ClipDetails", "File", "IsProvidedInDelivery", "Fingerprint"},
+	reflect.TypeOf(ernv43.TechnicalSheetMusicDetails{}):               {"TechnicalResourceDetailsReference", "SheetMusicCodecType", "BitDepth", "IsClip", "ClipDetails", "File", "IsProvidedInDelivery", "Fingerprint"},
+	reflect.TypeOf(ernv43.TechnicalSoftwareDetails{}):                 {"TechnicalResourceDetailsReference", "OperatingSystemType", "BitDepth", "IsClip", "ClipDetails", "File", "IsProvidedInDelivery", "Fingerprint"},
+	reflect.TypeOf(ernv43.TechnicalSoundRecordingDetails{}):           {"TechnicalResourceDetailsReference", "DeliveryFile", "HasImmersiveAudioMetadata", "IsClip", "ClipDetails"},
+	reflect.TypeOf(ernv43.TechnicalTextDetails{}):                     {"TechnicalResourceDetailsReference", "TextCodecType", "BitDepth", "IsClip", "ClipDetails", "File", "IsProvidedInDelivery", "Fingerprint"},
+	reflect.TypeOf(ernv43.TechnicalVideoDetails{}):                    {"TechnicalResourceDetailsReference", "OverallBitRate", "DeliveryFile", "IsClip", "ClipDetails"},
+	reflect.TypeOf(ernv43.Text{}):                                     {"ResourceReference", "Type", "ResourceId", "WorkId", "DisplayTitleText", "DisplayTitle", "AdditionalTitle", "VersionType", "DisplayArtistName", "DisplayArtist", "Contributor", "ResourceRightsController", "WorkRightsController", "CLine", "CourtesyLine", "CreationDate", "FirstPublicationDate", "ParentalWarningType", "RelatedRelease", "RelatedResource", "ContainsHiddenContent", "ResourceContainedResourceReferenceList", "TechnicalDetails", "LanguageOfText"},
+	reflect.TypeOf(ernv43.TextId{}):                                   {"ISBN", "ISSN", "SICI", "ProprietaryId"},
+	reflect.TypeOf(ernv43.Timing{}):                                   {"StartPoint", "EndPoint", "DurationUsed"},
+	reflect.TypeOf(ernv43.Title{}):                                    {"TitleText", "SubTitle"},
+	reflect.TypeOf(ernv43.TitleDisplayInformation{}):                  {"IsDisplayedInTitle", "Prefix"},
+	reflect.TypeOf(ernv43.TrackRelease{}):                             {"ReleaseReference", "ReleaseId", "DisplayTitleText", "DisplayTitle", "AdditionalTitle", "ReleaseResourceReference", "LinkedReleaseResourceReference", "ReleaseLabelReference", "Genre", "ReleaseVisibilityReference", "RelatedRelease", "RelatedResource", "TargetURL", "Keywords", "Synopsis", "MarketingComment"},
+	reflect.TypeOf(ernv43.TrackReleaseVisibility{}):                   {"VisibilityReference", "TerritoryCode", "ExcludedTerritoryCode", "TrackListingPreviewStartDateTime", "ClipPreviewStartDateTime"},
+	reflect.TypeOf(ernv43.ValidityPeriod{}):                           {"StartDate", "EndDate"},
+	reflect.TypeOf(ernv43.Venue{}):                                    {"VenueName", "VenueAddress", "TerritoryCode", "LocationCode", "VenueRoom"},
+	reflect.TypeOf(ernv43.Video{}):                                    {"ResourceReference", "Type", "VideoEdition", "RecordingFormat", "WorkId", "DisplayTitleText", "DisplayTitle", "AdditionalTitle", "VersionType", "DisplayArtistName", "DisplayArtist", "Contributor", "Character", "ResourceRightsController", "WorkRightsController", "CourtesyLine", "Duration", "CreationDate", "MasteredDate", "RemasteredDate", "FirstPublicationDate", "ParentalWarningType", "AvRating", "RelatedRelease", "RelatedResource", "CompositeMusicalWorkType", "VideoCueSheetReference", "ReasonForCueSheetAbsence", "IsCover", "HasVocalPerformance", "HasForegroundVocalPerformance", "IsInstrumental", "ContainsHiddenContent", "IsRemastered", "DisplayCredits", "LanguageOfPerformance", "LanguageOfDubbing", "SubTitleLanguage", "ResourceContainedResourceReferenceList", "Raga", "Tala", "Deity", "VideoChapterReference"},
+	reflect.TypeOf(ernv43.VideoClipDetails{}):                         {"TechnicalResourceDetailsReference", "ClipType", "Timing", "TopLeftCorner", "BottomRightCorner", "ExpressionType", "DeliveryFile"},
+	reflect.TypeOf(ernv43.VideoDeliveryFile{}):                        {"Type", "ContainerFormat", "VideoCodecType", "VideoBitRate", "FrameRate", "ImageHeight", "ImageWidth", "AspectRatio", "CoreArea", "ColorDepth", "VideoDefinitionType", "AudioCodecType", "HasImmersiveAudioMetadata", "ElectroOpticalTransferFunctionType", "PrimaryColorType", "HdrVideoDynamicMetadataType", "HdrVideoStaticMetadataType", "AudioBitRate", "NumberOfAudioChannels", "NumberOfAudioObjects", "AudioSamplingRate", "AudioBitsPerSample", "Duration", "BitDepth", "File", "Fingerprint", "IsProvidedInDelivery"},
+	reflect.TypeOf(ernv43.VideoEdition{}):                             {"Type", "ResourceId", "EditionContributor", "PLine", "CLine", "RecordingMode", "TechnicalDetails"},
+	reflect.TypeOf(ernv43.VideoId{}):                                  {"ISRC", "ISAN", "VISAN", "CatalogNumber", "ProprietaryId", "EIDR"},
+	reflect.TypeOf(ernv43.WorkRightsController{}):                     {"RightsControllerPartyReference", "RightsControlType", "RightsControllerType", "RightShareUnknown", "RightSharePercentage", "Territory", "StartDate", "EndDate"},
+	reflect.TypeOf(ernv432.AdministratingRecordCompany{}):             {"RecordCompanyPartyReference", "Role"},
+	reflect.TypeOf(ernv432.Affiliation{}):                             {"CompanyName", "PartyAffiliateReference", "Type", "TerritoryCode", "ExcludedTerritoryCode", "ValidityPeriod", "RightsType", "PercentageOfRightsAssignment"},
+	reflect.TypeOf(ernv432.AudioDeliveryFile{}):                       {"Type", "ContainerFormat", "AudioCodecType", "BitRate", "OriginalBitRate", "NumberOfChannels", "NumberOfAudioObjects", "SamplingRate", "OriginalSamplingRate", "BitsPerSample", "Duration", "BitDepth", "File", "Fingerprint", "IsProvidedInDelivery"},
+	reflect.TypeOf(ernv432.AvRating{}):                                {"Rating", "Agency", "Reason"},
+	reflect.TypeOf(ernv432.CLine{}):                                   {"Year", "CLineCompany", "CLineText"},
+	reflect.TypeOf(ernv432.Channel{}):                                 {"ProprietaryId", "URL"},
+	reflect.TypeOf(ernv432.Chapter{}):                                 {"ChapterReference", "ChapterId", "DisplayTitleText", "DisplayTitle", "FormalTitle", "GroupingTitle", "SequenceNumber", "Contributor", "Character", "RepresentativeImageReference", "StartTime", "Duration", "EndTime"},
+	reflect.TypeOf(ernv432.ChapterId{}):                               {"ISRC", "ISAN", "VISAN", "EIDR", "ISWC", "CatalogNumber", "ProprietaryId"},
+	reflect.TypeOf(ernv432.Character{}):                               {"CharacterPartyReference", "Performer"},
+	reflect.TypeOf(ernv432.ClipDetails{}):                             {"ClipType", "TopLeftCorner", "BottomRightCorner", "ExpressionType"},
+	reflect.TypeOf(ernv432.ClipRelease{}):                             {"ReleaseReference", "ReleaseId", "DisplayTitleText", "DisplayTitle", "FormalTitle", "GroupingTitle", "ReleaseResourceReference", "ReleaseLabelReference", "DisplayGenre", "RelatedRelease"},
+	reflect.TypeOf(ernv432.ConditionForRightsClaimPolicy{}):           {"Value", "Unit", "ReferenceCreation", "RelationalRelator", "MeasurementType", "Segment", "ServiceException"},
+	reflect.TypeOf(ernv432.Contributor{}):                             {"ContributorPartyReference", "SpecialContributor", "Role", "InstrumentType", "AiContribution", "HasMadeFeaturedContribution", "HasMadeContractedContribution", "IsCredited", "DisplayCredits"},
+	reflect.TypeOf(ernv432.ContributorRole{}):                         {"Value", "InstrumentType"},
+	reflect.TypeOf(ernv432.CoreArea{}):                                {"TopLeftCorner", "BottomRightCorner"},
+	reflect.TypeOf(ernv432.Cue{}):                                     {"CueUseType", "CueThemeType", "CueVocalType", "CueVisualPerceptionType", "CueOrigin", "ResourceId", "WorkId", "DisplayTitleText", "DisplayTitle", "FormalTitle", "GroupingTitle", "Contributor", "IsDance", "HasMusicalContent", "PLine", "CLine", "StartTime", "Duration", "EndTime"},
+	reflect.TypeOf(ernv432.CueSheet{}):                                {"CueSheetId", "CueSheetReference", "CueSheetType", "Cue"},
+	reflect.TypeOf(ernv432.Deal{}):                                    {"DealReference", "IsCommunicatedOutOfBand", "DealTerms", "DealTechnicalResourceDetailsReferenceList", "DistributionChannelPage"},
+	reflect.TypeOf(ernv432.DealList{}):                                {"ReleaseDeal", "ReleaseVisibility", "TrackReleaseVisibility"},
+	reflect.TypeOf(ernv432.DealTerms{}):                               {"TerritoryCode", "ExcludedTerritoryCode", "ValidityPeriod", "CommercialModelType", "UseType", "UserInterfaceType", "CarrierType", "TechnicalInstantiation", "NumberOfUsages", "DistributionChannel", "ExcludedDistributionChannel", "RightsClaimPolicy", "PriceInformation", "IsPromotional", "PromotionalCode", "IsPreOrderDeal", "InstantGratificationResourceList", "PhysicalReturns", "NumberOfProductsPerCarton"},
+	reflect.TypeOf(ernv432.DealTermsTechnicalInstantiation{}):         {"VideoDefinitionType", "CodingType", "BitRate"},
+	reflect.TypeOf(ernv432.DelegatedUsageRights{}):                    {"UseType", "PeriodOfRightsDelegation", "TerritoryOfRightsDelegation"},
+	reflect.TypeOf(ernv432.DetailedHashSum{}):                         {"Algorithm", "Version", "Parameter", "DataType", "HashSumValue"},
+	reflect.TypeOf(ernv432.DetailedPartyId{}):                         {"ISNI", "DPID", "IpiNameNumber", "IPN", "CisacSocietyId", "ProprietaryId"},
+	reflect.TypeOf(ernv432.DisplayArtist{}):                           {"ArtistPartyReference", "DisplayArtistRole", "SpecialDisplayArtist", "ArtisticRole", "TitleDisplayInformation", "DisplayCredits"},
+	reflect.TypeOf(ernv432.DisplayTitle{}):                            {"TitleText", "SubTitle"},
+	reflect.TypeOf(ernv432.DistributionChannelPage{}):                 {"PartyId", "PageName", "URL", "UserName"},
+	reflect.TypeOf(ernv432.EditionContributor{}):                      {"ContributorPartyReference", "SpecialContributor", "Role", "AiContribution", "HasMadeFeaturedContribution", "HasMadeContractedContribution", "IsCredited", "DisplayCredits"},
+	reflect.TypeOf(ernv432.ExternalResourceLink{}):                    {"URL", "ValidityPeriod", "ExternalLink", "ExternallyLinkedResourceType", "FileFormat"},
+	reflect.TypeOf(ernv432.File{}):                                    {"URI", "HashSum", "FileSize"},
+	reflect.TypeOf(ernv432.Fingerprint{}):                             {"Algorithm", "Version", "Parameter", "File", "DataType", "FingerprintValue"},
+	reflect.TypeOf(ernv432.FulfillmentDate{}):                         {"FulfillmentDate", "ResourceReleaseReference"},
+	reflect.TypeOf(ernv432.GenreCategory{}):                           {"Value", "Description"},
+	reflect.TypeOf(ernv432.GenreWithTerritory{}):                      {"GenreText", "SubGenre", "GenreCategory", "SubGenreCategory"},
+	reflect.TypeOf(ernv432.Image{}):                                   {"ResourceReference", "Type", "ResourceId", "DisplayTitleText", "DisplayTitle", "FormalTitle", "GroupingTitle", "VersionType", "DisplayArtistName", "DisplayArtist", "Contributor", "ResourceRightsController", "WorkRightsController", "CLine", "CourtesyLine", "CreationDate", "FirstPublicationDate", "ParentalWarningType", "RelatedRelease", "RelatedResource", "ContainsAI", "ContainsHiddenContent", "Description", "TechnicalDetails"},
+	reflect.TypeOf(ernv432.LocationAndDateOfSession{}):                {"SessionType", "Period", "Venue", "Comment", "Contributor"},
+	reflect.TypeOf(ernv432.MessageAuditTrailEvent{}):                  {"MessagingPartyDescriptor", "DateTime"},
+	reflect.TypeOf(ernv432.MessageHeader{}):                           {"MessageThreadId", "MessageId", "MessageFileName", "MessageSender", "SentOnBehalfOf", "MessageRecipient", "SentAsRequestedBy", "MessageCreatedDateTime", "MessageAuditTrail", "MessageControlType"},
+	reflect.TypeOf(ernv432.MessagingPartyWithoutCode{}):               {"PartyId", "PartyName", "TradingName"},
+	reflect.TypeOf(ernv432.MusicalWorkId{}):                           {"ISWC", "OpusNumber", "ComposerCatalogNumber", "ProprietaryId"},
+	reflect.TypeOf(ernv432.NewReleaseMessage{}):                       {"MessageHeader", "ReleaseAdmin", "PartyList", "CueSheetList", "ResourceList", "ChapterList", "ReleaseList", "DealList", "SupplementalDocumentList"},
+	reflect.TypeOf(ernv432.PLine{}):                                   {"Year", "PLineCompany", "PLineText"},
+	reflect.TypeOf(ernv432.PartyList{}):                               {"Party", "Brand"},
+	reflect.TypeOf(ernv432.PartyName{}):                               {"FullName", "FullNameAsciiTranscribed", "FullNameIndexed", "NamesBeforeKeyName", "KeyName", "NamesAfterKeyName", "AbbreviatedName"},
+	reflect.TypeOf(ernv432.PartyNameWithTerritory{}):                  {"FullName", "FullNameAsciiTranscribed", "FullNameIndexed", "NamesBeforeKeyName", "KeyName", "NamesAfterKeyName", "AbbreviatedName"},
+	reflect.TypeOf(ernv432.PartyNameWithoutCode{}):                    {"FullName", "FullNameAsciiTranscribed", "FullNameIndexed", "NamesBeforeKeyName", "KeyName", "NamesAfterKeyName", "AbbreviatedName"},
+	reflect.TypeOf(ernv432.PartyWithRole{}):                           {"ISNI", "DPID", "IpiNameNumber", "IPN", "ProprietaryId", "PartyName", "Role"},
+	reflect.TypeOf(ernv432.Period{}):                                  {"StartDate", "EndDate", "StartDateTime", "EndDateTime"},
+	reflect.TypeOf(ernv432.PeriodWithStartDate{}):                     {"StartDate", "EndDate", "StartDateTime", "EndDateTime"},
+	reflect.TypeOf(ernv432.PeriodWithoutFlags{}):                      {"StartDate", "EndDate", "StartDateTime", "EndDateTime"},
+	reflect.TypeOf(ernv432.PhysicalReturns{}):                         {"PhysicalReturnsAllowed", "LatestDateForPhysicalReturns"},
+	reflect.TypeOf(ernv432.PriceInformation{}):                        {"PriceCode", "WholesalePricePerUnit", "BulkOrderWholesalePricePerUnit", "SuggestedRetailPrice"},
+	reflect.TypeOf(ernv432.PurgeReleaseMessage{}):                     {"MessageHeader", "PurgedRelease"},
+	reflect.TypeOf(ernv432.PurgedRelease{}):                           {"ReleaseId", "Title", "Contributor"},
+	reflect.TypeOf(ernv432.RelatedParty{}):                            {"PartyRelatedPartyReference", "PartyRelationshipType"},
+	reflect.TypeOf(ernv432.RelatedRelease{}):                          {"ReleaseRelationshipType", "ReleaseId", "DisplayTitleText", "DisplayTitle", "FormalTitle", "GroupingTitle", "DisplayArtistName", "ReleaseLabelReference", "ReleaseDate", "OriginalReleaseDate"},
+	reflect.TypeOf(ernv432.RelatedResource{}):                         {"ResourceRelationshipType", "ResourceRelatedResourceReference", "ResourceId", "Timing"},
+	reflect.TypeOf(ernv432.Release{}):                                 {"ReleaseReference", "ReleaseType", "ReleaseId", "DisplayTitleText", "DisplayTitle", "FormalTitle", "GroupingTitle", "VersionType", "DisplayArtistName", "DisplayArtist", "ReleaseLabelReference", "AdministratingRecordCompany", "PLine", "CLine", "CourtesyLine", "Duration", "DisplayGenre", "ReleaseDate", "OriginalReleaseDate", "ReleaseVisibilityReference", "ParentalWarningType", "AvRating", "RelatedRelease", "RelatedResource", "IsSingleArtistCompilation", "IsMultiArtistCompilation", "ResourceGroup", "ExternalResourceLink", "TargetURL", "Keywords", "Synopsis", "Raga", "Tala", "Deity", "HiResMusicDescription", "ContainsAI", "IsSoundtrack", "IsHiResMusic", "MarketingComment"},
+	reflect.TypeOf(ernv432.ReleaseAdmin{}):                            {"ReleaseAdminId", "PersonnelDescription", "SystemDescription"},
+	reflect.TypeOf(ernv432.ReleaseDeal{}):                             {"DealReleaseReference", "Deal"},
+	reflect.TypeOf(ernv432.ReleaseId{}):                               {"GRid", "ICPN", "CatalogNumber", "ProprietaryId"},
+	reflect.TypeOf(ernv432.ReleaseList{}):                             {"Release", "TrackRelease", "ClipRelease"},
+	reflect.TypeOf(ernv432.ReleaseVisibility{}):                       {"VisibilityReference", "TerritoryCode", "ExcludedTerritoryCode", "ReleaseDisplayStartDateTime", "CoverArtPreviewStartDateTime", "FullTrackListingPreviewStartDateTime", "ClipPreviewStartDateTime"},
+	reflect.TypeOf(ernv432.ResourceContainedResourceReference{}):      {"ResourceContainedResourceReference", "DurationUsed", "StartPoint", "Purpose"},
+	reflect.TypeOf(ernv432.ResourceGroup{}):                           {"DisplayTitleText", "DisplayTitle", "FormalTitle", "GroupingTitle", "SequenceNumber", "NoDisplaySequence", "DisplaySequence", "DisplayArtist", "CarrierType", "Duration", "ResourceGroupReleaseReference", "ReleaseId", "ResourceGroup", "ResourceGroupContentItem", "LinkedReleaseResourceReference"},
+	reflect.TypeOf(ernv432.ResourceGroupContentItem{}):                {"SequenceNumber", "NoDisplaySequence", "DisplaySequence", "ReleaseResourceReference", "LinkedReleaseResourceReference", "IsBonusResource", "IsInstantGratificationResource", "IsPreOrderIncentiveResource"},
+	reflect.TypeOf(ernv432.ResourceId{}):                              {"ISRC", "ISMN", "ISAN", "VISAN", "ISBN", "ISSN", "SICI", "CatalogNumber", "ProprietaryId"},
+	reflect.TypeOf(ernv432.ResourceList{}):                            {"SoundRecording", "Video", "Image", "Text", "SheetMusic", "Software"},
+	reflect.TypeOf(ernv432.ResourceRightsController{}):                {"RightsControllerPartyReference", "RightsControlType", "RightShareUnknown", "RightSharePercentage", "DelegatedUsageRights"},
+	reflect.TypeOf(ernv432.ResourceSubGroup{}):                        {"DisplayTitleText", "DisplayTitle", "FormalTitle", "GroupingTitle", "SequenceNumber", "NoDisplaySequence", "DisplaySequence", "DisplayArtist", "CarrierType", "Duration", "ResourceGroupReleaseReference", "ReleaseId", "ResourceGroup", "ResourceGroupContentItem", "LinkedReleaseResourceReference"},
+	reflect.TypeOf(ernv432.RightsClaimPolicy{}):                       {"Condition", "RightsClaimPolicyType", "RightsClaimPolicyReason"},
+	reflect.TypeOf(ernv432.Segment{}):                                 {"StartTime", "Duration", "EndTime"},
+	reflect.TypeOf(ernv432.SheetMusic{}):                              {"ResourceReference", "Type", "ResourceId", "WorkId", "DisplayTitleText", "DisplayTitle", "FormalTitle", "GroupingTitle", "VersionType", "DisplayArtistName", "DisplayArtist", "Contributor", "ResourceRightsController", "WorkRightsController", "CLine", "CourtesyLine", "CreationDate", "FirstPublicationDate", "ParentalWarningType", "RelatedRelease", "RelatedResource", "ContainsHiddenContent", "LanguageOfLyrics", "ResourceContainedResourceReferenceList", "TechnicalDetails"},
+	reflect.TypeOf(ernv432.SheetMusicId{}):                            {"ISMN", "ProprietaryId"},
+	reflect.TypeOf(ernv432.Software{}):                                {"ResourceReference", "Type", "ResourceId", "WorkId", "DisplayTitleText", "DisplayTitle", "FormalTitle", "GroupingTitle", "VersionType", "DisplayArtistName", "DisplayArtist", "Contributor", "ResourceRightsController", "WorkRightsController", "PLine", "CLine", "CourtesyLine", "CreationDate", "FirstPublicationDate", "ParentalWarningType", "RelatedRelease", "RelatedResource", "ContainsHiddenContent", "ResourceContainedResourceReferenceList", "TechnicalDetails"},
+	reflect.TypeOf(ernv432.SoundRecording{}):                          {"ResourceReference", "Type", "SoundRecordingEdition", "RecordingFormat", "WorkId", "DisplayTitleText", "DisplayTitle", "FormalTitle", "GroupingTitle", "VersionType", "DisplayArtistName", "DisplayArtist", "Contributor", "Character", "ResourceRightsController", "WorkRightsController", "CourtesyLine", "Duration", "CreationDate", "MasteredDate", "RemasteredDate", "FirstPublicationDate", "LocationAndDateOfSession", "ParentalWarningType", "RelatedRelease", "RelatedResource", "CompositeMusicalWorkType", "ContainsAI", "IsCover", "HasVocalPerformance", "HasForegroundVocalPerformance", "IsInstrumental", "ContainsHiddenContent", "IsRemastered", "IsHiResMusic", "DisableCrossfade", "DisableSearch", "DisplayCredits", "LanguageOfPerformance", "Raga", "Tala", "Deity", "AudioChapterReference"},
+	reflect.TypeOf(ernv432.SoundRecordingClipDetails{}):               {"TechnicalResourceDetailsReference", "ClipType", "Timing", "ExpressionType", "DeliveryFile"},
+	reflect.TypeOf(ernv432.SoundRecordingEdition{}):                   {"Type", "ResourceId", "EditionContributor", "PLine", "RecordingMode", "TechnicalDetails"},
+	reflect.TypeOf(ernv432.SoundRecordingId{}):                        {"ISRC", "CatalogNumber", "ProprietaryId"},
+	reflect.TypeOf(ernv432.SubGenreCategory{}):                        {"Value", "Description"},
+	reflect.TypeOf(ernv432.TechnicalImageDetails{}):                   {"TechnicalResourceDetailsReference", "ImageCodecType", "ImageHeight", "ImageWidth", "AspectRatio", "ColorDepth", "ImageResolution", "BitDepth", "IsClip", "ClipDetails", "File", "IsProvidedInDelivery", "Fingerprint"},
+	reflect.TypeOf(ernv432.TechnicalSheetMusicDetails{}):              {"TechnicalResourceDetailsReference", "SheetMusicCodecType", "BitDepth", "IsClip", "ClipDetails", "File", "IsProvidedInDelivery", "Fingerprint"},
+	reflect.TypeOf(ernv432.TechnicalSoftwareDetails{}):                {"TechnicalResourceDetailsReference", "OperatingSystemType", "BitDepth", "IsClip", "ClipDetails", "File", "IsProvidedInDelivery", "Fingerprint"},
+	reflect.TypeOf(ernv432.TechnicalSoundRecordingDetails{}):          {"TechnicalResourceDetailsReference", "DeliveryFile", "HasImmersiveAudioMetadata", "IsClip", "ClipDetails"},
+	reflect.TypeOf(ernv432.TechnicalTextDetails{}):                    {"TechnicalResourceDetailsReference", "TextCodecType", "BitDepth", "IsClip", "ClipDetails", "File", "IsProvidedInDelivery", "Fingerprint"},
+	reflect.TypeOf(ernv432.TechnicalVideoDetails{}):                   {"TechnicalResourceDetailsReference", "OverallBitRate", "DeliveryFile", "IsClip", "ClipDetails"},
+	reflect.TypeOf(ernv432.Text{}):                                    {"ResourceReference", "Type", "ResourceId", "WorkId", "DisplayTitleText", "DisplayTitle", "FormalTitle", "GroupingTitle", "VersionType", "DisplayArtistName", "DisplayArtist", "Contributor", "ResourceRightsController", "WorkRightsController", "CLine", "CourtesyLine", "CreationDate", "FirstPublicationDate", "ParentalWarningType", "RelatedRelease", "RelatedResource", "ContainsAI", "ContainsHiddenContent", "ResourceContainedResourceReferenceList", "TechnicalDetails", "LanguageOfText"},
+	reflect.TypeOf(ernv432.TextId{}):                                  {"ISBN", "ISSN", "SICI", "ProprietaryId"},
+	reflect.TypeOf(ernv432.Timing{}):                                  {"StartPoint", "EndPoint", "DurationUsed"},
+	reflect.TypeOf(ernv432.Title{}):                                   {"TitleText", "SubTitle"},
+	reflect.TypeOf(ernv432.TitleDisplayInformation{}):                 {"IsDisplayedInTitle", "Prefix"},
+	reflect.TypeOf(ernv432.TrackRelease{}):                            {"ReleaseReference", "ReleaseId", "DisplayTitleText", "DisplayTitle", "FormalTitle", "GroupingTitle", "ReleaseResourceReference", "LinkedReleaseResourceReference", "ReleaseLabelReference", "DisplayGenre", "ReleaseVisibilityReference", "RelatedRelease", "RelatedResource", "TargetURL", "Keywords", "Synopsis", "MarketingComment"},
+	reflect.TypeOf(ernv432.TrackReleaseVisibility{}):                  {"VisibilityReference", "TerritoryCode", "ExcludedTerritoryCode", "TrackListingPreviewStartDateTime", "ClipPreviewStartDateTime"},
+	reflect.TypeOf(ernv432.ValidityPeriod{}):                          {"StartDate", "EndDate"},
+	reflect.TypeOf(ernv432.Venue{}):                                   {"VenueName", "VenueAddress", "TerritoryCode", "LocationCode", "VenueRoom"},
+	reflect.TypeOf(ernv432.Video{}):                                   {"ResourceReference", "Type", "VideoEdition", "RecordingFormat", "WorkId", "DisplayTitleText", "DisplayTitle", "FormalTitle", "GroupingTitle", "VersionType", "DisplayArtistName", "DisplayArtist", "Contributor", "Character", "ResourceRightsController", "WorkRightsController", "CourtesyLine", "Duration", "CreationDate", "MasteredDate", "RemasteredDate", "FirstPublicationDate", "ParentalWarningType", "AvRating", "RelatedRelease", "RelatedResource", "CompositeMusicalWorkType", "VideoCueSheetReference", "ReasonForCueSheetAbsence", "ContainsAI", "IsCover", "HasVocalPerformance", "HasForegroundVocalPerformance", "IsInstrumental", "ContainsHiddenContent", "IsRemastered", "DisplayCredits", "LanguageOfPerformance", "LanguageOfDubbing", "SubTitleLanguage", "ResourceContainedResourceReferenceList", "Raga", "Tala", "Deity", "VideoChapterReference"},
+	reflect.TypeOf(ernv432.VideoClipDetails{}):                        {"TechnicalResourceDetailsReference", "ClipType", "Timing", "TopLeftCorner", "BottomRightCorner", "ExpressionType", "DeliveryFile"},
+	reflect.TypeOf(ernv432.VideoDeliveryFile{}):                       {"Type", "ContainerFormat", "VideoCodecType", "VideoBitRate", "FrameRate", "ImageHeight", "ImageWidth", "AspectRatio", "CoreArea", "ColorDepth", "VideoDefinitionType", "AudioCodecType", "HasImmersiveAudioMetadata", "ElectroOpticalTransferFunctionType", "PrimaryColorType", "HdrVideoDynamicMetadataType", "HdrVideoStaticMetadataType", "AudioBitRate", "NumberOfAudioChannels", "NumberOfAudioObjects", "AudioSamplingRate", "AudioBitsPerSample", "Duration", "BitDepth", "File", "Fingerprint", "IsProvidedInDelivery"},
+	reflect.TypeOf(ernv432.VideoEdition{}):                            {"Type", "ResourceId", "EditionContributor", "PLine", "CLine", "RecordingMode", "TechnicalDetails"},
+	reflect.TypeOf(ernv432.VideoId{}):                                 {"ISRC", "ISAN", "VISAN", "CatalogNumber", "ProprietaryId", "EIDR"},
+	reflect.TypeOf(ernv432.WorkRightsController{}):                    {"RightsControllerPartyReference", "RightsControlType", "RightsControllerType", "RightShareUnknown", "RightSharePercentage", "Territory", "StartDate", "EndDate"},
+	reflect.TypeOf(meadv11.AbsolutePitch{}):                           {"MetadataSourceReference", "Value", "Modulation"},
+	reflect.TypeOf(meadv11.Activity{}):                                {"MetadataSourceReference", "Value", "Description", "LanguageAndScriptOfActivity", "TerritoryOfActivityDescription"},
+	reflect.TypeOf(meadv11.AlternativeTitle{}):                        {"MetadataSourceReference", "TitleText", "SubTitle", "LanguageAndScriptOfTitle"},
+	reflect.TypeOf(meadv11.Annotation{}):                              {"MetadataSourceReference", "Text"},
+	reflect.TypeOf(meadv11.ArtisticInfluence{}):                       {"MetadataSourceReference", "Party", "Work", "Resource", "Release", "Description", "IsInfluenced", "IsInfluencer"},
+	reflect.TypeOf(meadv11.ArtisticStyle{}):                           {"MetadataSourceReference", "Value", "TerritoryOfArtisticStyleDescription"},
+	reflect.TypeOf(meadv11.Award{}):                                   {"MetadataSourceReference", "AwardingBody", "AwardedParty", "AwardName", "Date", "IsWinner", "Comment"},
+	reflect.TypeOf(meadv11.BeatsPerMinute{}):                          {"MetadataSourceReference", "Value", "Modulation"},
+	reflect.TypeOf(meadv11.ChartEntry{}):                              {"Position", "Date", "Duration", "Comment"},
+	reflect.TypeOf(meadv11.ChildWorkHierarchy{}):                      {"IsDescribedElement", "IsComplete", "SequenceNumber", "WorkId", "WorkTitle", "Child", "Form"},
+	reflect.TypeOf(meadv11.ClassicalPeriod{}):                         {"MetadataSourceReference", "Name", "LanguageAndScriptOfClassicalPeriod", "TerritoryOfClassicalPeriodDescription"},
+	reflect.TypeOf(meadv11.CommentaryNote{}):                          {"MetadataSourceReference", "Text", "CommentaryNoteType", "LanguageAndScriptOfCommentaryNote", "TerritoryOfCommentaryNoteDescription", "Author"},
+	reflect.TypeOf(meadv11.Contributor{}):                             {"Identifier", "Name", "Role"},
+	reflect.TypeOf(meadv11.DanceStyle{}):                              {"MetadataSourceReference", "Value", "Description", "LanguageAndScriptOfDanceStyle", "TerritoryOfDanceStyleDescription"},
+	reflect.TypeOf(meadv11.DerivedRecording{}):                        {"MetadataSourceReference", "ResourceId", "RelatedResourceType", "Title", "DisplayArtistName", "DisplayArtist"},
+	reflect.TypeOf(meadv11.DetailedHashSum{}):                         {"Algorithm", "Version", "Parameter", "DataType", "HashSumValue"},
+	reflect.TypeOf(meadv11.DetailedPartyId{}):                         {"ISNI", "DPID", "IpiNameNumber", "IPN", "CisacSocietyId", "ProprietaryId"},
+	reflect.TypeOf(meadv11.DisplayArtistNameWithPronunciation{}):      {"Name", "Pronunciation"},
+	reflect.TypeOf(meadv11.DisplaySubTitle{}):                         {"Title", "Pronunciation"},
+	reflect.TypeOf(meadv11.DisplayTitle{}):                            {"TitleText", "SubTitle"},
+	reflect.TypeOf(meadv11.Epoch{}):                                   {"MetadataSourceReference", "Value", "RelatedArtist", "RelatedCreation", "StartDate", "EndDate", "LanguageAndScriptOfEpoch", "TerritoryOfEpochDescription"},
+	reflect.TypeOf(meadv11.File{}):                                    {"URI", "HashSum", "FileSize"},
+	reflect.TypeOf(meadv11.Flag{}):                                    {"MetadataSourceReference", "Value"},
+	reflect.TypeOf(meadv11.Focus{}):                                   {"MetadataSourceReference", "Party", "DisplayArtistName", "DisplayArtist", "Writer", "SequenceNumber", "PeriodOfBeingFocus", "TerritoryOfBeingFocusTrackDescription", "Comment"},
+	reflect.TypeOf(meadv11.Form{}):                                    {"MetadataSourceReference", "Value"},
+	reflect.TypeOf(meadv11.GenreCategory{}):                           {"MetadataSourceReference", "TerritoryOfGenreCategoryDescription", "Value", "Description"},
+	reflect.TypeOf(meadv11.Harmony{}):                                 {"MetadataSourceReference", "RootChordNote", "RootChordQuality", "Mode", "Modulation"},
+	reflect.TypeOf(meadv11.HarmonyModulation{}):                       {"StartPoint", "EndPoint", "StartBar", "EndBar", "RootChordNote", "RootChordQuality", "Mode"},
+	reflect.TypeOf(meadv11.HistoricChartingInformation{}):             {"MetadataSourceReference", "TerritoryCode", "ChartName", "DurationInCharts", "TopPosition", "ChartEntry", "Comment"},
+	reflect.TypeOf(meadv11.Image{}):                                   {"MetadataSourceReference", "File", "ImageType"},
+	reflect.TypeOf(meadv11.ImpactDate{}):                              {"Date", "TerritoryCode"},
+	reflect.TypeOf(meadv11.Instrument{}):                              {"MetadataSourceReference", "Value"},
+	reflect.TypeOf(meadv11.InstrumentUsed{}):                          {"MetadataSourceReference", "Value"},
+	reflect.TypeOf(meadv11.Intensity{}):                               {"MetadataSourceReference", "Value"},
+	reflect.TypeOf(meadv11.LocationAndDateOfSession{}):                {"MetadataSourceReference", "SessionType", "Period", "Venue", "Comment", "Contributor"},
+	reflect.TypeOf(meadv11.Lyrics{}):                                  {"MetadataSourceReference", "Text", "LanguageAndScriptOfLyrics", "TerritoryOfLyricsDescription", "Pronunciation"},
+	reflect.TypeOf(meadv11.MeadMessage{}):                             {"MessageHeader", "SubscriptionId", "MetadataSourceList", "WorkInformationList", "ResourceInformationList", "ReleaseInformationList"},
+	reflect.TypeOf(meadv11.MessageAuditTrailEvent{}):                  {"MessagingPartyDescriptor", "DateTime"},
+	reflect.TypeOf(meadv11.MessageHeader{}):                           {"MessageThreadId", "MessageId", "MessageFileName", "MessageSender", "SentOnBehalfOf", "MessageRecipient", "MessageCreatedDateTime", "MessageAuditTrail", "MessageControlType"},
+	reflect.TypeOf(meadv11.MessagingPartyWithoutCode{}):               {"PartyId", "PartyName", "TradingName"},
+	reflect.TypeOf(meadv11.Meter{}):                                   {"NumberOfBeatsInBar", "NoteEquivalentToBeat"},
+	reflect.TypeOf(meadv11.Modulation{}):                              {"StartPoint", "EndPoint", "StartBar", "EndBar", "Value"},
+	reflect.TypeOf(meadv11.Mood{}):                                    {"MetadataSourceReference", "Value", "Description", "LanguageAndScriptOfMood", "TerritoryOfMoodDescription"},
+	reflect.TypeOf(meadv11.MusicalWorkIdWithoutFlag{}):                {"ISWC", "OpusNumber", "ComposerCatalogNumber", "ProprietaryId"},
+	reflect.TypeOf(meadv11.NameWithPronunciationAndScriptCode{}):      {"Name", "Pronunciation"},
+	reflect.TypeOf(meadv11.Party{}):                                   {"ISNI", "DPID", "IpiNameNumber", "IPN", "ProprietaryId", "PartyName"},
+	reflect.TypeOf(meadv11.PartyNameWithPronunciation{}):              {"FullName", "FullNameAsciiTranscribed", "FullNameIndexed", "NamesBeforeKeyName", "KeyName", "NamesAfterKeyName", "AbbreviatedName"},
+	reflect.TypeOf(meadv11.PartyNameWithoutCode{}):                    {"FullName", "FullNameAsciiTranscribed", "FullNameIndexed", "NamesBeforeKeyName", "KeyName", "NamesAfterKeyName", "AbbreviatedName"},
+	reflect.TypeOf(meadv11.Period{}):                                  {"StartDate", "EndDate", "StartDateTime", "EndDateTime"},
+	reflect.TypeOf(meadv11.PeriodWithTime{}):                          {"StartDateTime", "EndDateTime"},
+	reflect.TypeOf(meadv11.RecordingPart{}):                           {"MetadataSourceReference", "Unit", "StartPoint", "EndPoint", "RecordingPartType", "Comment", "UsageInformation"},
+	reflect.TypeOf(meadv11.RelatedCreation{}):                         {"ReleaseId", "ResourceId", "MusicalWorkId", "Title"},
+	reflect.TypeOf(meadv11.RelatedWork{}):                             {"MetadataSourceReference", "WorkId", "WorkTitle", "WorkRelationshipType", "Writer"},
+	reflect.TypeOf(meadv11.Release{}):                                 {"GRid", "ICPN", "ProprietaryReleaseId", "ReleaseTitle", "DisplayArtistName", "DisplayArtist"},
+	reflect.TypeOf(meadv11.ReleaseId{}):                               {"GRid", "ISRC", "ICPN", "CatalogNumber", "ProprietaryId"},
+	reflect.TypeOf(meadv11.ReleaseInformation{}):                      {"ReleaseSummary", "GenreCategory", "SubGenreCategory", "Focus", "Mood", "ArtisticStyle", "Theme", "Activity", "CommentaryNote", "Epoch", "ArtisticInfluence", "IsSimilar", "HistoricChartingInformation", "Award", "AlternativeTitle", "Image"},
+	reflect.TypeOf(meadv11.ReleaseSummary{}):                          {"ReleaseId", "DisplayTitle", "DisplayArtistName", "DisplayArtist"},
+	reflect.TypeOf(meadv11.RelevantResource{}):                        {"ResourceId", "ResourceRelationshipType"},
+	reflect.TypeOf(meadv11.Resource{}):                                {"ISRC", "CatalogNumber", "ProprietaryResourceId", "ResourceTitle", "DisplayArtistName", "DisplayArtist"},
+	reflect.TypeOf(meadv11.ResourceIdWithoutFlag{}):                   {"ISRC", "ISMN", "ISAN", "VISAN", "ISBN", "ISSN", "SICI", "CatalogNumber", "ProprietaryId"},
+	reflect.TypeOf(meadv11.ResourceInformation{}):                     {"ResourceSummary", "GenreCategory", "SubGenreCategory", "Form", "VocalRegister", "Focus", "AbsolutePitch", "TimeSignature", "Tempo", "BeatsPerMinute", "Intensity", "InstrumentUsed", "Harmony", "IsOriginal", "IsCover", "Mood", "DanceStyle", "RhythmStyle", "ArtisticStyle", "Theme", "Activity", "UsedMusicalWork", "RelatedResource", "Lyrics", "CommentaryNote", "Sample", "RecordingPart", "Usage", "ImpactDate", "ClassicalPeriod", "Epoch", "ArtisticInfluence", "IsSimilar", "HistoricChartingInformation", "Award", "LocationAndDateOfSession", "AlternativeTitle", "Image"},
+	reflect.TypeOf(meadv11.ResourceRelationship{}):                    {"MetadataSourceReference", "ResourceId", "RelatedResourceType", "Title", "DisplayArtistName", "DisplayArtist"},
+	reflect.TypeOf(meadv11.ResourceSummary{}):                         {"ResourceId", "DisplayTitle", "DisplayArtistName", "DisplayArtist"},
+	reflect.TypeOf(meadv11.RhythmStyle{}):                             {"MetadataSourceReference", "Value", "Description", "LanguageAndScriptOfRhythmStyle", "TerritoryOfRhythmStyleDescription"},
+	reflect.TypeOf(meadv11.SimilarRelease{}):                          {"MetadataSourceReference", "Release", "Description"},
+	reflect.TypeOf(meadv11.SimilarResource{}):                         {"MetadataSourceReference", "Resource", "Description"},
+	reflect.TypeOf(meadv11.SimilarWork{}):                             {"MetadataSourceReference", "Work", "Description"},
+	reflect.TypeOf(meadv11.SubGenreCategory{}):                        {"MetadataSourceReference", "Value"},
+	reflect.TypeOf(meadv11.Theme{}):                                   {"MetadataSourceReference", "Value", "Description", "LanguageAndScriptOfTheme", "TerritoryOfThemeDescription"},
+	reflect.TypeOf(meadv11.TimeSignature{}):                           {"MetadataSourceReference", "Meter", "NoMeterAvailable", "TooManyTempi", "Modulation"},
+	reflect.TypeOf(meadv11.TimeSignatureModulation{}):                 {"StartPoint", "EndPoint", "StartBar", "EndBar", "Meter", "NoMeterAvailable", "Value"},
+	reflect.TypeOf(meadv11.Timing{}):                                  {"StartPoint", "DurationUsed"},
+	reflect.TypeOf(meadv11.TitleText{}):                               {"Title", "Pronunciation"},
+	reflect.TypeOf(meadv11.TitleWithPronunciation{}):                  {"TitleText", "SubTitle"},
+	reflect.TypeOf(meadv11.Usage{}):                                   {"MetadataSourceReference", "UsageDate", "UsagePeriod", "Description", "TerritoryOfUsageDescription", "SequenceNumber", "RelevantResource"},
+	reflect.TypeOf(meadv11.UsagePeriod{}):                             {"StartDate", "EndDate"},
+	reflect.TypeOf(meadv11.UsedMusicalWork{}):                         {"MetadataSourceReference", "ResourceMusicalWorkReference"},
+	reflect.TypeOf(meadv11.Venue{}):                                   {"VenueName", "VenueAddress", "TerritoryCode", "LocationCode", "VenueRoom"},
+	reflect.TypeOf(meadv11.VocalRegister{}):                           {"MetadataSourceReference", "Value"},
+	reflect.TypeOf(meadv11.Work{}):                                    {"ISWC", "ProprietaryWorkId", "WorkTitle", "Writer"},
+	reflect.TypeOf(meadv11.WorkHierarchy{}):                           {"MetadataSourceReference", "IsDescribedElement", "IsComplete", "SequenceNumber", "WorkId", "WorkTitle", "Child", "Form"},
+	reflect.TypeOf(meadv11.WorkInformation{}):                         {"MusicalWorkReference", "WorkSummary", "GenreCategory", "SubGenreCategory", "Form", "VocalRegister", "Focus", "TimeSignature", "Tempo", "TargetInstrument", "Harmony", "Mood", "DanceStyle", "RhythmStyle", "Theme", "Activity", "WorkHierarchy", "RelatedWork", "DerivedRecording", "Lyrics", "CommentaryNote", "ClassicalPeriod", "Epoch", "ArtisticInfluence", "IsSimilar", "Award", "AlternativeTitle"},
+	reflect.TypeOf(meadv11.WorkSummary{}):                             {"MusicalWorkId", "WorkTitle", "Writer"},
+	reflect.TypeOf(piev10.ArtistType{}):                               {"MetadataSourceReference", "Value", "TerritoryOfArtistTypeDescription"},
+	reflect.TypeOf(piev10.ArtisticInfluence{}):                        {"MetadataSourceReference", "Party", "Work", "Resource", "Release", "Description", "IsInfluenced", "IsInfluencer"},
+	reflect.TypeOf(piev10.Award{}):                                    {"MetadataSourceReference", "AwardingBody", "AwardedParty", "AwardName", "Date", "IsWinner", "Comment"},
+	reflect.TypeOf(piev10.Biography{}):                                {"MetadataSourceReference", "Text", "Author"},
+	reflect.TypeOf(piev10.ClassicalPeriod{}):                          {"MetadataSourceReference", "Name", "LanguageAndScriptOfClassicalPeriod", "TerritoryOfClassicalPeriodDescription"},
+	reflect.TypeOf(piev10.CommentaryNote{}):                           {"MetadataSourceReference", "Text", "CommentaryNoteType", "LanguageAndScriptOfCommentaryNote", "TerritoryOfCommentaryNoteDescription", "Author"},
+	reflect.TypeOf(piev10.Contribution{}):                             {"Role", "IsPrimaryRole", "HasMadeFeaturedContribution", "HasMadeContractedContribution", "Event"},
+	reflect.TypeOf(piev10.CreationDescription{}):                      {"Title", "DisplayArtistName", "PublicationDate"},
+	reflect.TypeOf(piev10.DetailedHashSum{}):                          {"Algorithm", "Version", "Parameter", "DataType", "HashSumValue"},
+	reflect.TypeOf(piev10.DetailedPartyId{}):                          {"ISNI", "DPID", "IpiNameNumber", "IPN", "CisacSocietyId", "ProprietaryId"},
+	reflect.TypeOf(piev10.DetailedPartyIdForParty{}):                  {"ISNI", "DPID", "IpiNameNumber", "IPN", "CisacSocietyId", "ProprietaryId"},
+	reflect.TypeOf(piev10.DisplayArtistNameWithPronunciation{}):       {"Name", "Pronunciation"},
+	reflect.TypeOf(piev10.DisplaySubTitle{}):                          {"Title", "Pronunciation"},
+	reflect.TypeOf(piev10.DisplayTitle{}):                             {"TitleText", "SubTitle"},
+	reflect.TypeOf(piev10.Epoch{}):                                    {"MetadataSourceReference", "Value", "RelatedArtist", "RelatedCreation", "StartDate", "EndDate", "LanguageAndScriptOfEpoch", "TerritoryOfEpochDescription"},
+	reflect.TypeOf(piev10.Event{}):                                    {"MetadataSourceReference", "Date", "StartDate", "EndDate", "EventType", "EventDescription"},
+	reflect.TypeOf(piev10.File{}):                                     {"URI", "HashSum", "FileSize"},
+	reflect.TypeOf(piev10.Focus{}):                                    {"MetadataSourceReference", "FocusTrack", "FocusRelease", "FocusWork", "DisplayArtistName", "DisplayArtist", "Writer", "SequenceNumber", "PeriodOfBeingFocus", "TerritoryOfBeingFocusTrackDescription", "Comment"},
+	reflect.TypeOf(piev10.Gender{}):                                   {"MetadataSourceReference", "Value"},
+	reflect.TypeOf(piev10.Image{}):                                    {"MetadataSourceReference", "File", "ImageType"},
+	reflect.TypeOf(piev10.MessageAuditTrailEvent{}):                   {"MessagingPartyDescriptor", "DateTime"},
+	reflect.TypeOf(piev10.MessageHeader{}):                            {"MessageThreadId", "MessageId", "MessageFileName", "MessageSender", "SentOnBehalfOf", "MessageRecipient", "MessageCreatedDateTime", "MessageAuditTrail", "MessageControlType"},
+	reflect.TypeOf(piev10.MessagingPartyWithoutCode{}):                {"PartyId", "PartyName", "TradingName"},
+	reflect.TypeOf(piev10.MusicalWorkIdWithoutFlag{}):                 {"ISWC", "OpusNumber", "ComposerCatalogNumber", "ProprietaryId"},
+	reflect.TypeOf(piev10.NameId{}):                                   {"ISNI", "IpiNameNumber", "IPN", "ProprietaryId"},
+	reflect.TypeOf(piev10.NameWithPronunciation{}):                    {"Name", "Pronunciation"},
+	reflect.TypeOf(piev10.NameWithPronunciationAndScriptCode{}):       {"Name", "Pronunciation"},
+	reflect.TypeOf(piev10.NameWithScriptCode{}):                       {"Name", "Pronunciation"},
+	reflect.TypeOf(piev10.Nationality{}):                              {"MetadataSourceReference", "Value"},
+	reflect.TypeOf(piev10.Party{}):                                    {"PartyReference", "PartyId", "PartyName", "PartyType", "Event", "RelatedParty", "RelatedCreation", "Gender", "Nationality", "PrimaryRole", "VocalRegister", "Focus", "ArtistType", "ClassicalPeriod", "Epoch", "ArtisticInfluence", "Award", "Biography", "Image", "SocialMediaURL", "CommentaryNote"},
+	reflect.TypeOf(piev10.PartyDescriptorForEntry{}):                  {"PartyId", "PartyName"},
+	reflect.TypeOf(piev10.PartyName{}):                                {"MetadataSourceReference", "NameId", "PartyNameType", "ReasonForNameChange", "PartyNamePurpose", "PartyNameFormat", "FullName", "FullNameAsciiTranscribed", "FullNameIndexed", "TitlesBeforeNames", "NamesBeforeKeyName", "KeyName", "NamesAfterKeyName", "TitlesAfterNames", "ShortName", "AbbreviatedName", "ValidityPeriod", "RelatedCreation"},
+	reflect.TypeOf(piev10.PartyNameForRequest{}):                      {"FullName", "FullNameAsciiTranscribed", "FullNameIndexed", "NamesBeforeKeyName", "KeyName", "NamesAfterKeyName", "AbbreviatedName"},
+	reflect.TypeOf(piev10.PartyNameWithPronunciation{}):               {"FullName", "FullNameAsciiTranscribed", "FullNameIndexed", "NamesBeforeKeyName", "KeyName", "NamesAfterKeyName", "AbbreviatedName"},
+	reflect.TypeOf(piev10.PartyNameWithoutCode{}):                     {"FullName", "FullNameAsciiTranscribed", "FullNameIndexed", "NamesBeforeKeyName", "KeyName", "NamesAfterKeyName", "AbbreviatedName"},
+	reflect.TypeOf(piev10.PartyType{}):                                {"MetadataSourceReference", "Value"},
+	reflect.TypeOf(piev10.PeriodWithTime{}):                           {"StartDateTime", "EndDateTime"},
+	reflect.TypeOf(piev10.PieMessage{}):                               {"MessageHeader", "MetadataSourceList", "PartyList"},
+	reflect.TypeOf(piev10.PieRequestMessage{}):                        {"MessageHeader", "RequestedParty"},
+	reflect.TypeOf(piev10.PrimaryRole{}):                              {"MetadataSourceReference", "Value"},
+	reflect.TypeOf(piev10.RelatedCreation{}):                          {"ReleaseId", "ResourceId", "MusicalWorkId", "Title"},
+	reflect.TypeOf(piev10.RelatedCreationForParty{}):                  {"MetadataSourceReference", "CreationType", "Contribution", "ReleaseId", "ResourceId", "MusicalWorkId", "CreationDescription", "RelationshipDescription", "Contract"},
+	reflect.TypeOf(piev10.RelatedParty{}):                             {"MetadataSourceReference", "PartyRelationshipType", "BusinessPurpose", "PartyRelatedPartyReference", "PartyId", "PartyName", "Description", "Contract", "ValidityPeriod", "RelatedCreation"},
+	reflect.TypeOf(piev10.Release{}):                                  {"GRid", "ICPN", "ProprietaryReleaseId", "ReleaseTitle", "DisplayArtistName", "DisplayArtist"},
+	reflect.TypeOf(piev10.ReleaseForRequest{}):                        {"ReleaseId", "ReleaseTitle"},
+	reflect.TypeOf(piev10.ReleaseId{}):                                {"GRid", "ISRC", "ICPN", "CatalogNumber", "ProprietaryId"},
+	reflect.TypeOf(piev10.ReleaseSummary{}):                           {"ReleaseId", "DisplayTitle", "DisplayArtistName", "DisplayArtist"},
+	reflect.TypeOf(piev10.RequestedParty{}):                           {"PartyId", "PartyName", "Role", "Release", "Resource", "Work"},
+	reflect.TypeOf(piev10.Resource{}):                                 {"ISRC", "CatalogNumber", "ProprietaryResourceId", "ResourceTitle", "DisplayArtistName", "DisplayArtist"},
+	reflect.TypeOf(piev10.ResourceForRequest{}):                       {"ResourceId", "ResourceTitle", "ResourceType"},
+	reflect.TypeOf(piev10.ResourceIdWithoutFlag{}):                    {"ISRC", "ISMN", "ISAN", "VISAN", "ISBN", "ISSN", "SICI", "CatalogNumber", "ProprietaryId"},
+	reflect.TypeOf(piev10.ResourceSummary{}):                          {"ResourceId", "DisplayTitle", "DisplayArtistName", "DisplayArtist"},
+	reflect.TypeOf(piev10.TitleText{}):                                {"Title", "Pronunciation"},
+	reflect.TypeOf(piev10.TitleWithPronunciation{}):                   {"TitleText", "SubTitle"},
+	reflect.TypeOf(piev10.TitleWithUDV{}):                             {"TitleText", "SubTitle"},
+	reflect.TypeOf(piev10.ValidityPeriod{}):                           {"StartDate", "EndDate"},
+	reflect.TypeOf(piev10.VocalRegister{}):                            {"MetadataSourceReference", "Value"},
+	reflect.TypeOf(piev10.Work{}):                                     {"ISWC", "ProprietaryWorkId", "WorkTitle", "Writer"},
+	reflect.TypeOf(piev10.WorkForRequest{}):                           {"WorkId", "WorkTitle"},
+	reflect.TypeOf(piev10.WorkSummary{}):                              {"MusicalWorkId", "WorkTitle", "Writer"},
+}
+
+// ChildElementOrder returns the child element names of generated type t in the order its DDEX schema
+// sequence requires, or nil if the schema lets them appear in any order
+func ChildElementOrder(t reflect.Type) []string {
+	for t.Kind() == reflect.Ptr {
+		t = t.Elem()
+	}
+	return childElementOrder[t]
+}
@@ -0,0 +1,37 @@
+package ddex
+
+import (
+	"fmt"
+	"reflect"
+	"strings"
+)
+
+// GenreError describes a GenreText value that is not in the accepted genre list
+type GenreError struct {
+	// Path is the DDEX path of the GenreText element, e.g. /NewReleaseMessage/ReleaseList/Release/Genre/GenreText
+	Path string
+	// Value is the rejected genre
+	Value string
+}
+
+// Error implements the error interface
+func (e GenreError) Error() string {
+	return fmt.Sprintf("%s: genre %q is not allowed", e.Path, e.Value)
+}
+
+// ValidateGenres checks every Genre/GenreText in any generated DDEX message against allowed, the genre
+// list accepted by the recipient (e.g. a DSP). Values are compared exactly after trimming surrounding
+// whitespace; each occurrence is reported.
+func ValidateGenres(msg interface{}, allowed map[string]bool) []GenreError {
+	var errs []GenreError
+	walkScalars(msg, func(path string, field xmlField, value reflect.Value) {
+		if !strings.HasSuffix(path, "/GenreText") || value.Kind() != reflect.String {
+			return
+		}
+		genre := strings.TrimSpace(value.String())
+		if !allowed[genre] {
+			errs = append(errs, GenreError{Path: path, Value: genre})
+		}
+	})
+	return errs
+}
@@ -2,10 +2,7 @@ module github.com/alecsavvy/ddex-proto
 
 go 1.25.0
 
-require (
-	github.com/davecgh/go-spew v1.1.1
-	google.golang.org/protobuf v1.36.9
-)
+require google.golang.org/protobuf v1.36.9
 
 require (
 	github.com/beevik/etree v1.6.0
@@ -13,6 +10,7 @@ require (
 )
 
 require (
+	github.com/davecgh/go-spew v1.1.1 // indirect
 	github.com/pmezard/go-difflib v1.0.0 // indirect
 	gopkg.in/yaml.v3 v3.0.1 // indirect
 )
@@ -0,0 +1,97 @@
+package ddex
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"reflect"
+	"sort"
+	"strings"
+
+	"google.golang.org/protobuf/proto"
+)
+
+// ContentHash returns the hex SHA-256 of a canonical form of a DDEX message, for detecting whether a
+// redelivery changed anything meaningful. The canonical form drops the root NamespaceAttrs (xmlns
+// declarations and xsi:schemaLocation), trims surrounding whitespace from text, and sorts repeated elements
+// that declare a message-local reference (SoundRecordings, Releases, Parties, ...) by that reference, so
+// files differing only in those respects hash identically. The message itself is not modified.
+func ContentHash(msg interface{}) (string, error) {
+	protoMsg, ok := msg.(proto.Message)
+	if !ok {
+		return "", fmt.Errorf("%T is not a protobuf message", msg)
+	}
+	canonical := proto.Clone(protoMsg)
+
+	v := reflect.ValueOf(canonical).Elem()
+	if attrs := v.FieldByName("NamespaceAttrs"); attrs.IsValid() && attrs.Kind() == reflect.Map {
+		attrs.Set(reflect.Zero(attrs.Type()))
+	}
+	trimLeafText(canonical, WhitespaceTrimEdges)
+	sortByReference(v)
+
+	data, err := proto.MarshalOptions{Deterministic: true}.Marshal(canonical)
+	if err != nil {
+		return "", fmt.Errorf("failed to marshal canonical message: %w", err)
+	}
+	sum := sha256.Sum256(data)
+	return hex.EncodeToString(sum[:]), nil
+}
+
+// sortByReference stably sorts, in place and at any depth, every slice of structs that declare a
+// message-local reference by the first value of that reference
+func sortByReference(v reflect.Value) {
+	switch v.Kind() {
+	case reflect.Ptr, reflect.Interface:
+		if !v.IsNil() {
+			sortByReference(v.Elem())
+		}
+	case reflect.Slice:
+		for i := 0; i < v.Len(); i++ {
+			sortByReference(v.Index(i))
+		}
+		if v.Len() > 1 && v.Type().Elem().Kind() == reflect.Ptr && v.Type().Elem().Elem().Kind() == reflect.Struct {
+			keys := make([]string, v.Len())
+			for i := range keys {
+				keys[i] = referenceKey(v.Index(i).Elem())
+			}
+			sort.Stable(referenceSorter{keys: keys, swap: reflect.Swapper(v.Interface())})
+		}
+	case reflect.Struct:
+		for i := 0; i < v.NumField(); i++ {
+			if v.Type().Field(i).IsExported() {
+				sortByReference(v.Field(i))
+			}
+		}
+	}
+}
+
+// referenceKey returns the first message-local reference a struct declares, or "" if it declares none
+func referenceKey(v reflect.Value) string {
+	if !v.IsValid() {
+		return ""
+	}
+	for i := 0; i < v.NumField(); i++ {
+		field, ok := xmlFieldOf(v.Type().Field(i))
+		if !ok || !definingReferences[field.Name] {
+			continue
+		}
+		if values := stringValues(v.Field(i)); len(values) > 0 {
+			return strings.TrimSpace(values[0])
+		}
+	}
+	return ""
+}
+
+// referenceSorter orders the items of a slice by their reference keys, swapping both together
+type referenceSorter struct {
+	keys []string
+	swap func(i, j int)
+}
+
+func (s referenceSorter) Len() int           { return len(s.keys) }
+func (s referenceSorter) Less(i, j int) bool { return s.keys[i] < s.keys[j] }
+func (s referenceSorter) Swap(i, j int) {
+	s.keys[i], s.keys[j] = s.keys[j], s.keys[i]
+	s.swap(i, j)
+}
@@ -0,0 +1,199 @@
+package ddex
+
+import (
+	"bytes"
+	"encoding/xml"
+	"fmt"
+	"io"
+	"reflect"
+	"strings"
+
+	"github.com/alecsavvy/ddex-proto/gen"
+)
+
+// Header is the set of MessageHeader accessors shared by every supported DDEX message type and version
+type Header interface {
+	GetMessageThreadId() string
+	GetMessageId() string
+	GetMessageFileName() string
+	GetMessageCreatedDateTime() string
+	GetMessageControlType() string
+}
+
+// GetHeader returns the MessageHeader of a parsed DDEX message regardless of its type or version
+func GetHeader(msg interface{}) (Header, error) {
+	v := reflect.ValueOf(msg)
+	if !v.IsValid() || (v.Kind() == reflect.Ptr && v.IsNil()) {
+		return nil, fmt.Errorf("message is nil")
+	}
+
+	getter := v.MethodByName("GetMessageHeader")
+	if !getter.IsValid() {
+		return nil, fmt.Errorf("%T has no MessageHeader", msg)
+	}
+
+	result := getter.Call(nil)[0]
+	if result.IsNil() {
+		return nil, fmt.Errorf("%T has an empty MessageHeader", msg)
+	}
+
+	header, ok := result.Interface().(Header)
+	if !ok {
+		return nil, fmt.Errorf("%T has an unsupported MessageHeader type %s", msg, result.Type())
+	}
+	return header, nil
+}
+
+// MessageControlTypeTest is the MessageControlType value marking a message as a test delivery
+const MessageControlTypeTest = "TestMessage"
+
+// IsTestMessage reports whether a parsed message's MessageHeader marks it as a test message
+// (MessageControlType TestMessage, compared case-insensitively) so it can be kept out of production ingestion
+func IsTestMessage(msg interface{}) (bool, error) {
+	header, err := GetHeader(msg)
+	if err != nil {
+		return false, err
+	}
+	return strings.EqualFold(strings.TrimSpace(header.GetMessageControlType()), MessageControlTypeTest), nil
+}
+
+// HeaderInfo is a MessageHeader normalized across message types and versions, with surrounding whitespace
+// trimmed from every value
+type HeaderInfo struct {
+	MessageThreadId        string
+	MessageId              string
+	MessageFileName        string
+	MessageCreatedDateTime string
+	MessageControlType     string
+	// MessageSender is the zero HeaderParty when the header has none
+	MessageSender HeaderParty
+	// MessageRecipients are in document order; ERN 4 and later allow several
+	MessageRecipients []HeaderParty
+}
+
+// HeaderParty is the MessageSender or a MessageRecipient of a HeaderInfo
+type HeaderParty struct {
+	// PartyIds are the party's identifiers; ERN 3.8.x parties may have several, later versions one
+	PartyIds []string
+	// PartyName is the FullName of the party, empty if it has none
+	PartyName string
+}
+
+// ParseHeaderOnly detects the message type and version of a DDEX document and decodes just its
+// MessageHeader, skipping the rest of the message, into a HeaderInfo. This is much cheaper than a full
+// parse for routing and triage of large files.
+func ParseHeaderOnly(data []byte) (header *HeaderInfo, messageType, version string, err error) {
+	messageType, version, messageName, err := gen.DetectMessageType(data)
+	if err != nil {
+		return nil, "", "", err
+	}
+	msg, err := gen.NewByMessageName(messageType, version, messageName)
+	if err != nil {
+		return nil, "", "", err
+	}
+
+	field, ok := reflect.TypeOf(msg).Elem().FieldByName("MessageHeader")
+	if !ok || field.Type.Kind() != reflect.Ptr {
+		return nil, "", "", fmt.Errorf("%T has no MessageHeader", msg)
+	}
+	value := reflect.New(field.Type.Elem())
+	if err := decodeHeader(data, value.Interface()); err != nil {
+		return nil, "", "", err
+	}
+
+	generated, ok := value.Interface().(Header)
+	if !ok {
+		return nil, "", "", fmt.Errorf("%T has an unsupported MessageHeader type %s", msg, field.Type)
+	}
+	header = &HeaderInfo{
+		MessageThreadId:        strings.TrimSpace(generated.GetMessageThreadId()),
+		MessageId:              strings.TrimSpace(generated.GetMessageId()),
+		MessageFileName:        strings.TrimSpace(generated.GetMessageFileName()),
+		MessageCreatedDateTime: strings.TrimSpace(generated.GetMessageCreatedDateTime()),
+		MessageControlType:     strings.TrimSpace(generated.GetMessageControlType()),
+	}
+	if sender := value.Elem().FieldByName("MessageSender"); sender.IsValid() && !sender.IsNil() {
+		header.MessageSender = headerParty(sender.Elem())
+	}
+	recipients := value.Elem().FieldByName("MessageRecipient")
+	for i := 0; recipients.IsValid() && i < recipients.Len(); i++ {
+		if recipient := recipients.Index(i); !recipient.IsNil() {
+			header.MessageRecipients = append(header.MessageRecipients, headerParty(recipient.Elem()))
+		}
+	}
+	return header, messageType, version, nil
+}
+
+// headerParty normalizes a MessagingParty or MessagingPartyWithoutCode
+func headerParty(party reflect.Value) HeaderParty {
+	var normalized HeaderParty
+	for _, id := range partyIdsOf(party) {
+		if value := strings.TrimSpace(id.Value); value != "" {
+			normalized.PartyIds = append(normalized.PartyIds, value)
+		}
+	}
+	if name := party.FieldByName("PartyName"); name.IsValid() && !name.IsNil() {
+		normalized.PartyName = firstText(name.Elem().FieldByName("FullName"))
+	}
+	return normalized
+}
+
+// decodeHeader decodes just the MessageHeader element of a DDEX document into v, without unmarshaling
+// the rest of the message. Decoding stops as soon as the header has been read.
+func decodeHeader(xmlData []byte, v interface{}) error {
+	decoder := xml.NewDecoder(bytes.NewReader(xmlData))
+	depth := 0
+	for {
+		tok, err := decoder.Token()
+		if err == io.EOF {
+			return fmt.Errorf("no MessageHeader found")
+		}
+		if err != nil {
+			return fmt.Errorf("failed to parse XML: %w", err)
+		}
+
+		switch t := tok.(type) {
+		case xml.StartElement:
+			depth++
+			// The header is always a direct child of the root element
+			if depth == 2 && t.Name.Local == "MessageHeader" {
+				return decoder.DecodeElement(v, &t)
+			}
+			if depth == 2 {
+				if err := decoder.Skip(); err != nil {
+					return fmt.Errorf("failed to parse XML: %w", err)
+				}
+				depth--
+			}
+		case xml.EndElement:
+			depth--
+		}
+	}
+}
+
+// FindDuplicateMessageIds reads only the MessageHeader of each file and returns every MessageId used by more
+// than one file, mapped to the indices of those files in ascending order
+func FindDuplicateMessageIds(files [][]byte) (map[string][]int, error) {
+	var header struct {
+		MessageId string `xml:"MessageId"`
+	}
+
+	indices := make(map[string][]int)
+	for i, file := range files {
+		header.MessageId = ""
+		if err := decodeHeader(file, &header); err != nil {
+			return nil, fmt.Errorf("file %d: %w", i, err)
+		}
+		if id := strings.TrimSpace(header.MessageId); id != "" {
+			indices[id] = append(indices[id], i)
+		}
+	}
+
+	duplicates := make(map[string][]int)
+	for id, fileIndices := range indices {
+		if len(fileIndices) > 1 {
+			duplicates[id] = fileIndices
+		}
+	}
+	return duplicates, nil
+}
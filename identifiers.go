@@ -0,0 +1,71 @@
+package ddex
+
+import (
+	"fmt"
+	"reflect"
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+// isrcPattern matches an ISRC (ISO 3901): country code, registrant code, year of reference and designation
+// code, without hyphens. The XSDs document this syntax but type the element as xs:string.
+var isrcPattern = regexp.MustCompile(`^[a-zA-Z]{2}[a-zA-Z0-9]{3}[0-9]{7}$`)
+
+// icpnPattern matches an ICPN: a GTIN-8, UPC-A (GTIN-12), EAN-13 or GTIN-14
+var icpnPattern = regexp.MustCompile(`^(?:[0-9]{8}|[0-9]{12,14})$`)
+
+// IdentifierError describes a malformed ISRC or ICPN
+type IdentifierError struct {
+	// Path is the DDEX path of the element, e.g. /NewReleaseMessage/ReleaseList/Release/ReleaseId/ICPN
+	Path string
+	// Value is the rejected identifier
+	Value string
+	// Message explains why the identifier is malformed
+	Message string
+}
+
+// Error implements the error interface
+func (e IdentifierError) Error() string {
+	return fmt.Sprintf("%s: %s (%q)", e.Path, e.Message, e.Value)
+}
+
+// ValidateIdentifiers checks the ISRC and ICPN elements of any generated DDEX message: an ISRC must have
+// the form CC-XXX-YY-NNNNN without hyphens (e.g. USRC17607839) and an ICPN must be 8, 12, 13 or 14 digits
+// with a valid GS1 check digit
+func ValidateIdentifiers(msg interface{}) []IdentifierError {
+	var errs []IdentifierError
+	walkScalars(msg, func(path string, field xmlField, value reflect.Value) {
+		if value.Kind() != reflect.String {
+			return
+		}
+		text := strings.TrimSpace(value.String())
+		switch {
+		case strings.HasSuffix(path, "/ISRC"):
+			if !isrcPattern.MatchString(text) {
+				errs = append(errs, IdentifierError{Path: path, Value: text, Message: "ISRC is not of the form CCXXXYYNNNNN"})
+			}
+		case strings.HasSuffix(path, "/ICPN"):
+			switch {
+			case !icpnPattern.MatchString(text):
+				errs = append(errs, IdentifierError{Path: path, Value: text, Message: "ICPN is not 8, 12, 13 or 14 digits"})
+			case gtin(text[:len(text)-1]) != text:
+				errs = append(errs, IdentifierError{Path: path, Value: text, Message: "ICPN check digit is wrong"})
+			}
+		}
+	})
+	return errs
+}
+
+// gtin appends the GS1 check digit to the digits of a GTIN
+func gtin(digits string) string {
+	sum := 0
+	for i := len(digits) - 1; i >= 0; i-- {
+		weight := 3
+		if (len(digits)-1-i)%2 == 1 {
+			weight = 1
+		}
+		sum += int(digits[i]-'0') * weight
+	}
+	return digits + strconv.Itoa((10-sum%10)%10)
+}
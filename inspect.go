@@ -0,0 +1,23 @@
+package ddex
+
+import (
+	"reflect"
+	"sort"
+)
+
+// PopulatedPaths returns the sorted, de-duplicated DDEX paths (/Root/Child, /Root/Child@attr) of every
+// non-empty scalar field in a parsed message. Unlike testutil.CollectAllPaths it works on the struct
+// directly, so coverage can be reported without re-marshaling.
+func PopulatedPaths(msg interface{}) []string {
+	seen := make(map[string]bool)
+	walkScalars(msg, func(path string, _ xmlField, _ reflect.Value) {
+		seen[path] = true
+	})
+
+	paths := make([]string, 0, len(seen))
+	for path := range seen {
+		paths = append(paths, path)
+	}
+	sort.Strings(paths)
+	return paths
+}
@@ -0,0 +1,40 @@
+package ddex
+
+import (
+	"strings"
+
+	ernv432 "github.com/alecsavvy/ddex-proto/gen/ddex/ern/v432"
+)
+
+// normalizeISRC uppercases an ISRC and drops the hyphens of its display form (US-S1Z-99-00001)
+func normalizeISRC(isrc string) string {
+	return strings.ToUpper(strings.ReplaceAll(strings.TrimSpace(isrc), "-", ""))
+}
+
+// FindDuplicateISRCs returns each ISRC assigned to more than one SoundRecording, mapped to the
+// ResourceReferences of those recordings in message order. ISRCs are compared without hyphens and case, and
+// an ISRC repeated across the editions of a single recording is not a duplicate.
+func FindDuplicateISRCs(msg *ernv432.NewReleaseMessage) map[string][]string {
+	references := make(map[string][]string)
+	for _, sr := range msg.GetResourceList().GetSoundRecording() {
+		seen := make(map[string]bool)
+		for _, edition := range sr.GetSoundRecordingEdition() {
+			for _, id := range edition.GetResourceId() {
+				isrc := normalizeISRC(id.GetISRC())
+				if isrc == "" || seen[isrc] {
+					continue
+				}
+				seen[isrc] = true
+				references[isrc] = append(references[isrc], sr.GetResourceReference())
+			}
+		}
+	}
+
+	duplicates := make(map[string][]string)
+	for isrc, refs := range references {
+		if len(refs) > 1 {
+			duplicates[isrc] = refs
+		}
+	}
+	return duplicates
+}
@@ -0,0 +1,161 @@
+package ddex
+
+import (
+	"fmt"
+	"reflect"
+	"strings"
+)
+
+// localizedNameElements are the repeated name elements whose entries are localized with LanguageAndScriptCode
+var localizedNameElements = map[string]bool{
+	"DisplayArtistName": true,
+	"PartyName":         true,
+}
+
+// LocError describes a set of localized artist names that DSPs would reject
+type LocError struct {
+	// Path is the DDEX path of the name elements, e.g. /NewReleaseMessage/PartyList/Party/PartyName
+	Path string
+	// Reference is the reference of the containing Party, Release or resource, if any
+	Reference string
+	// Language is the duplicated LanguageAndScriptCode, empty for a missing or repeated default
+	Language string
+	// Message explains the violation
+	Message string
+}
+
+// Error implements the error interface
+func (e LocError) Error() string {
+	if e.Reference == "" {
+		return fmt.Sprintf("%s: %s", e.Path, e.Message)
+	}
+	return fmt.Sprintf("%s (%s): %s", e.Path, e.Reference, e.Message)
+}
+
+// ValidateArtistNameLocalization checks every localized set of DisplayArtistName or PartyName values in any
+// generated DDEX message: where at least one entry carries a LanguageAndScriptCode, exactly one entry must
+// be the default and no language may occur twice. The default is the entry with IsDefault="true" (ERN 4)
+// or, if no entry has it, the entry without a LanguageAndScriptCode. Entries limited to different
+// ApplicableTerritoryCodes are separate sets. Violations name the containing Party, Release or resource
+// reference.
+func ValidateArtistNameLocalization(msg interface{}) []LocError {
+	v := reflect.ValueOf(msg)
+	for v.Kind() == reflect.Ptr || v.Kind() == reflect.Interface {
+		if v.IsNil() {
+			return nil
+		}
+		v = v.Elem()
+	}
+	if v.Kind() != reflect.Struct {
+		return nil
+	}
+
+	var errs []LocError
+	checkLocalization(v, "/"+v.Type().Name(), "", &errs)
+	return errs
+}
+
+// checkLocalization walks a value, checking the localized name sets of each struct with the nearest
+// reference in scope
+func checkLocalization(v reflect.Value, path, reference string, errs *[]LocError) {
+	switch v.Kind() {
+	case reflect.Ptr, reflect.Interface:
+		if !v.IsNil() {
+			checkLocalization(v.Elem(), path, reference, errs)
+		}
+	case reflect.Slice:
+		if v.Type().Elem().Kind() == reflect.Uint8 {
+			return
+		}
+		for i := 0; i < v.Len(); i++ {
+			checkLocalization(v.Index(i), path, reference, errs)
+		}
+	case reflect.Struct:
+		if key := referenceKey(v); key != "" {
+			reference = key
+		}
+		t := v.Type()
+		for i := 0; i < t.NumField(); i++ {
+			field, ok := xmlFieldOf(t.Field(i))
+			if !ok || field.Attr || field.CharData || field.InnerXML {
+				continue
+			}
+			childPath := path + "/" + field.Name
+			if localizedNameElements[field.Name] && v.Field(i).Kind() == reflect.Slice {
+				*errs = append(*errs, checkNameSet(v.Field(i), childPath, reference)...)
+			}
+			checkLocalization(v.Field(i), childPath, reference, errs)
+		}
+	}
+}
+
+// checkNameSet checks the entries of one repeated name element, grouped by ApplicableTerritoryCode
+func checkNameSet(names reflect.Value, path, reference string) []LocError {
+	type nameSet struct {
+		unlabeled int
+		flagged   int
+		labeled   bool
+		languages map[string]bool
+	}
+	sets := make(map[string]*nameSet)
+	var territories []string
+
+	var errs []LocError
+	for i := 0; i < names.Len(); i++ {
+		name := names.Index(i)
+		if name.Kind() != reflect.Ptr || name.IsNil() {
+			continue
+		}
+		name = name.Elem()
+		languageField := name.FieldByName("LanguageAndScriptCode")
+		if languageField.Kind() != reflect.String {
+			return nil
+		}
+		language := strings.TrimSpace(languageField.String())
+		territory := ""
+		if territoryField := name.FieldByName("ApplicableTerritoryCode"); territoryField.Kind() == reflect.String {
+			territory = strings.TrimSpace(territoryField.String())
+		}
+
+		set, ok := sets[territory]
+		if !ok {
+			set = &nameSet{languages: make(map[string]bool)}
+			sets[territory] = set
+			territories = append(territories, territory)
+		}
+		if isDefault := name.FieldByName("IsDefault"); isDefault.Kind() == reflect.Bool && isDefault.Bool() {
+			set.flagged++
+		}
+		if language == "" {
+			set.unlabeled++
+			continue
+		}
+		set.labeled = true
+		if set.languages[strings.ToLower(language)] {
+			errs = append(errs, LocError{Path: path, Reference: reference, Language: language, Message: fmt.Sprintf("language %s occurs more than once", language)})
+		}
+		set.languages[strings.ToLower(language)] = true
+	}
+
+	for _, territory := range territories {
+		set := sets[territory]
+		if !set.labeled {
+			continue
+		}
+		scope := ""
+		if territory != "" {
+			scope = " for " + territory
+		}
+		defaults := set.unlabeled
+		if set.flagged > 0 {
+			defaults = set.flagged
+		}
+		switch {
+		case defaults == 0:
+			errs = append(errs, LocError{Path: path, Reference: reference, Message: "localized names have no default" + scope})
+		case defaults > 1:
+			errs = append(errs, LocError{Path: path, Reference: reference, Message: fmt.Sprintf("localized names have %d defaults%s", defaults, scope)})
+		}
+	}
+	return errs
+}
@@ -0,0 +1,313 @@
+package ddex
+
+import (
+	"bytes"
+	"encoding/xml"
+	"fmt"
+	"io"
+	"reflect"
+	"strings"
+
+	"google.golang.org/protobuf/proto"
+)
+
+// schemaLocationAttr is the NamespaceAttrs key under which xsi:schemaLocation is captured on unmarshal
+const schemaLocationAttr = "xsi:schemaLocation"
+
+// MarshalWithoutSchemaLocation marshals a DDEX root message to indented XML without the
+// xsi:schemaLocation attribute, keeping all xmlns declarations. Useful for partner validators
+// that try to resolve the schema location. The message itself is not modified.
+func MarshalWithoutSchemaLocation(msg interface{}) ([]byte, error) {
+	stripped, err := withNamespaceAttrs(msg, func(attrs map[string]string) {
+		delete(attrs, schemaLocationAttr)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return xml.MarshalIndent(stripped, "", "  ")
+}
+
+// withNamespaceAttrs returns a clone of a root message (see proto.Clone) whose NamespaceAttrs has edit
+// applied, so that marshal-time attribute changes never touch the caller's message
+func withNamespaceAttrs(msg interface{}, edit func(attrs map[string]string)) (interface{}, error) {
+	v := reflect.ValueOf(msg)
+	if v.Kind() != reflect.Ptr || v.IsNil() || v.Elem().Kind() != reflect.Struct {
+		return nil, fmt.Errorf("expected pointer to DDEX message struct, got %T", msg)
+	}
+	message, ok := msg.(proto.Message)
+	if !ok {
+		return nil, fmt.Errorf("%T is not a protobuf message", msg)
+	}
+
+	field := v.Elem().FieldByName("NamespaceAttrs")
+	if !field.IsValid() || field.Type() != reflect.TypeOf(map[string]string(nil)) {
+		return nil, fmt.Errorf("%T is not a DDEX root message (no NamespaceAttrs)", msg)
+	}
+
+	copied := proto.Clone(message)
+	attrs := reflect.ValueOf(copied).Elem().FieldByName("NamespaceAttrs")
+	if attrs.IsNil() {
+		attrs.Set(reflect.ValueOf(make(map[string]string)))
+	}
+	edit(attrs.Interface().(map[string]string))
+	return copied, nil
+}
+
+// WhitespaceMode controls how MarshalTo treats surrounding whitespace in the text of leaf elements
+type WhitespaceMode int
+
+const (
+	// WhitespacePreserve writes text content exactly as stored in the message (the default)
+	WhitespacePreserve WhitespaceMode = iota
+	// WhitespaceTrimEmpty writes leaf elements whose text is only whitespace as empty elements
+	WhitespaceTrimEmpty
+	// WhitespaceTrimEdges additionally trims leading and trailing whitespace from leaf element text.
+	// Whitespace inside a value is never changed.
+	WhitespaceTrimEdges
+)
+
+// MarshalOptions configures MarshalTo and MarshalIndent
+type MarshalOptions struct {
+	// Prefix and Indent are passed to xml.Encoder.Indent; both empty produces compact output
+	Prefix string
+	Indent string
+	// Whitespace controls trimming of leaf element text
+	Whitespace WhitespaceMode
+	// SelfClosing writes empty elements as <Foo/> instead of <Foo></Foo>. Elements with any text, even
+	// only whitespace, keep their end tag.
+	SelfClosing bool
+	// PrefixedRoot writes the root element with the prefix NamespaceAttrs declares for the message
+	// namespace (e.g. <ern:NewReleaseMessage xmlns:ern="...">) instead of as a default namespace
+	PrefixedRoot bool
+	// ElementNames renames elements in the output by DDEX path, e.g.
+	// "/NewReleaseMessage/ResourceList/SoundRecording/ResourceReference": "SoundRecordingId". It is a
+	// compatibility shim for partners that require non-conformant element names; the output no longer
+	// validates against the DDEX schema, so use it only for those partners.
+	ElementNames map[string]string
+}
+
+// DefaultMarshalOptions matches xml.MarshalIndent(msg, "", "  ")
+var DefaultMarshalOptions = MarshalOptions{Indent: "  "}
+
+// MarshalIndent marshals a DDEX message to XML using opts
+func MarshalIndent(msg interface{}, opts MarshalOptions) ([]byte, error) {
+	var buf bytes.Buffer
+	if err := MarshalTo(&buf, msg, opts); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+// MarshalTo marshals a DDEX message to w as XML using opts. The message itself is not modified.
+func MarshalTo(w io.Writer, msg interface{}, opts MarshalOptions) error {
+	if opts.Whitespace != WhitespacePreserve {
+		protoMsg, ok := msg.(proto.Message)
+		if !ok {
+			return fmt.Errorf("%T is not a protobuf message", msg)
+		}
+		cloned := proto.Clone(protoMsg)
+		trimLeafText(cloned, opts.Whitespace)
+		msg = cloned
+	}
+
+	if opts.SelfClosing || opts.PrefixedRoot || len(opts.ElementNames) > 0 {
+		var buf bytes.Buffer
+		encoder := xml.NewEncoder(&buf)
+		encoder.Indent(opts.Prefix, opts.Indent)
+		if err := encoder.Encode(msg); err != nil {
+			return err
+		}
+		if err := encoder.Close(); err != nil {
+			return err
+		}
+		data := buf.Bytes()
+		var err error
+		if opts.SelfClosing {
+			if data, err = selfCloseEmptyElements(data); err != nil {
+				return err
+			}
+		}
+		if opts.PrefixedRoot {
+			if data, err = prefixRootElement(data); err != nil {
+				return err
+			}
+		}
+		if len(opts.ElementNames) > 0 {
+			if data, err = renameElements(data, opts.ElementNames); err != nil {
+				return err
+			}
+		}
+		_, err = w.Write(data)
+		return err
+	}
+
+	encoder := xml.NewEncoder(w)
+	encoder.Indent(opts.Prefix, opts.Indent)
+	if err := encoder.Encode(msg); err != nil {
+		return err
+	}
+	return encoder.Close()
+}
+
+// selfCloseEmptyElements rewrites every start tag immediately followed by its end tag to a self-closing
+// tag, using the token offsets of a raw decode pass so that all other bytes are copied unchanged
+func selfCloseEmptyElements(data []byte) ([]byte, error) {
+	var out bytes.Buffer
+	out.Grow(len(data))
+
+	decoder := xml.NewDecoder(bytes.NewReader(data))
+	copied := int64(0)
+	startEnd := int64(-1) // offset just past the last start tag, while nothing has followed it
+	for {
+		offset := decoder.InputOffset()
+		token, err := decoder.RawToken()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, err
+		}
+
+		switch token.(type) {
+		case xml.StartElement:
+			startEnd = decoder.InputOffset()
+			// Tags that are already self-closing report their end element at the same offset
+			if bytes.HasSuffix(data[:startEnd], []byte("/>")) {
+				startEnd = -1
+			}
+		case xml.EndElement:
+			if startEnd >= 0 && offset == startEnd {
+				out.Write(data[copied : startEnd-1])
+				out.WriteString("/>")
+				copied = decoder.InputOffset()
+			}
+			startEnd = -1
+		default:
+			startEnd = -1
+		}
+	}
+	out.Write(data[copied:])
+	return out.Bytes(), nil
+}
+
+// renameElements rewrites the start and end tags of the elements whose DDEX path (by local names, e.g.
+// /NewReleaseMessage/ResourceList/SoundRecording/ResourceReference) is in names, keeping any prefix.
+// Token offsets of a raw decode pass locate the tags, so all other bytes are copied unchanged.
+func renameElements(data []byte, names map[string]string) ([]byte, error) {
+	var out bytes.Buffer
+	out.Grow(len(data))
+
+	decoder := xml.NewDecoder(bytes.NewReader(data))
+	copied := int64(0)
+	var path []string
+	var selfClosing []bool
+	rename := func(offset int64, name xml.Name) {
+		newName, ok := names["/"+strings.Join(path, "/")]
+		if !ok {
+			return
+		}
+		qualified := name.Local
+		if name.Space != "" {
+			qualified = name.Space + ":" + name.Local
+			newName = name.Space + ":" + newName
+		}
+		out.Write(data[copied:offset])
+		out.WriteString(newName)
+		copied = offset + int64(len(qualified))
+	}
+
+	for {
+		offset := decoder.InputOffset()
+		token, err := decoder.RawToken()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, err
+		}
+
+		switch token := token.(type) {
+		case xml.StartElement:
+			path = append(path, token.Name.Local)
+			selfClosing = append(selfClosing, bytes.HasSuffix(data[:decoder.InputOffset()], []byte("/>")))
+			rename(offset+1, token.Name)
+		case xml.EndElement:
+			// Self-closing tags report an end element without an end tag of their own
+			if !selfClosing[len(selfClosing)-1] {
+				rename(offset+2, token.Name)
+			}
+			path, selfClosing = path[:len(path)-1], selfClosing[:len(selfClosing)-1]
+		}
+	}
+	out.Write(data[copied:])
+	return out.Bytes(), nil
+}
+
+// trimLeafText applies mode to the text of every leaf element in msg in place. Attribute values are left as is.
+func trimLeafText(msg interface{}, mode WhitespaceMode) {
+	walkScalars(msg, func(_ string, field xmlField, value reflect.Value) {
+		if field.Attr || field.InnerXML || value.Kind() != reflect.String || !value.CanSet() {
+			return
+		}
+
+		text := value.String()
+		switch {
+		case strings.TrimSpace(text) == "":
+			value.SetString("")
+		case mode == WhitespaceTrimEdges:
+			value.SetString(strings.TrimSpace(text))
+		}
+	})
+}
+
+// DocumentOptions configures MarshalDocument
+type DocumentOptions struct {
+	// MarshalOptions controls the message itself, including Prefix and Indent
+	MarshalOptions
+	// OmitDeclaration leaves out the <?xml ?> declaration
+	OmitDeclaration bool
+	// Version is the declared XML version, "1.0" if empty; encoding/xml only writes XML 1.0
+	Version string
+	// Encoding is the declared encoding, "UTF-8" if empty. The output is always UTF-8, so no other
+	// encoding can be declared.
+	Encoding string
+	// Standalone is the declared standalone value, "yes" or "no"; empty leaves it out
+	Standalone string
+}
+
+// MarshalDocument marshals a DDEX message to a complete XML document using opts: the XML declaration,
+// e.g. <?xml version="1.0" encoding="UTF-8"?> as in xml.Header, followed by the message
+func MarshalDocument(msg interface{}, opts DocumentOptions) ([]byte, error) {
+	var buf bytes.Buffer
+	if !opts.OmitDeclaration {
+		version := opts.Version
+		if version == "" {
+			version = "1.0"
+		}
+		if version != "1.0" {
+			return nil, fmt.Errorf("unsupported XML version %q (supported: 1.0)", version)
+		}
+		encoding := opts.Encoding
+		if encoding == "" {
+			encoding = "UTF-8"
+		}
+		if !strings.EqualFold(encoding, "UTF-8") {
+			return nil, fmt.Errorf("unsupported encoding %q (supported: UTF-8)", encoding)
+		}
+
+		fmt.Fprintf(&buf, `<?xml version="%s" encoding="%s"`, version, encoding)
+		switch opts.Standalone {
+		case "":
+		case "yes", "no":
+			fmt.Fprintf(&buf, ` standalone="%s"`, opts.Standalone)
+		default:
+			return nil, fmt.Errorf("invalid standalone value %q (must be yes or no)", opts.Standalone)
+		}
+		buf.WriteString("?>\n")
+	}
+
+	if err := MarshalTo(&buf, msg, opts.MarshalOptions); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
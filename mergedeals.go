@@ -0,0 +1,142 @@
+package ddex
+
+import (
+	"sort"
+	"strings"
+
+	ernv432 "github.com/alecsavvy/ddex-proto/gen/ddex/ern/v432"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+// MergeDeals collapses a base deal and its territory-specific overrides, as some partners send them in
+// separate Deal elements, into deals that do not overlap. Deals overlap when they are for the same
+// ReleaseReference with the same CommercialModelTypes and UseTypes; each such group is merged on its own,
+// and deals without a TerritoryCode or ExcludedTerritoryCode are returned unchanged. The precedence rules:
+//
+//   - A base deal is Worldwide: its TerritoryCode lists Worldwide, or it has only ExcludedTerritoryCode.
+//     Later base deals override earlier ones term by term and their ExcludedTerritoryCodes are combined.
+//   - Any other deal overrides the base in each territory of its TerritoryCode list. Starting from the
+//     base terms (unless the base excludes the territory), the terms it sets replace the base's, a
+//     repeated term such as PriceInformation as a whole. Unset terms are inherited, so an override cannot
+//     clear a base term. Overrides of the same territory apply in order, the last one winning.
+//
+// The result has, per group, the base deal with the TerritoryCode Worldwide and the overridden
+// territories added to its ExcludedTerritoryCode, followed by one deal per overridden territory in order
+// of first appearance with that TerritoryCode alone. Deal level fields such as DealReference come from
+// the last deal applied. The input deals are not modified.
+func MergeDeals(deals []Deal) []Deal {
+	type dealGroup struct {
+		base        *ernv432.Deal
+		excluded    []*ernv432.CurrentTerritoryCode
+		territories []string
+		overrides   map[string][]*ernv432.Deal
+	}
+	var merged []Deal
+	var keys []string
+	groups := make(map[string]*dealGroup)
+	releases := make(map[string]string)
+
+	for _, deal := range deals {
+		terms := deal.Deal.GetDealTerms()
+		if len(terms.GetTerritoryCode()) == 0 && len(terms.GetExcludedTerritoryCode()) == 0 {
+			merged = append(merged, deal)
+			continue
+		}
+
+		key := dealGroupKey(deal.ReleaseReference, terms)
+		group, ok := groups[key]
+		if !ok {
+			group = &dealGroup{overrides: make(map[string][]*ernv432.Deal)}
+			groups[key] = group
+			keys = append(keys, key)
+			releases[key] = deal.ReleaseReference
+		}
+
+		if len(terms.GetTerritoryCode()) == 0 || containsTerritory(terms.GetTerritoryCode(), TerritoryWorldwide) {
+			group.base = overrideDeal(group.base, deal.Deal)
+			group.excluded = append(group.excluded, terms.GetExcludedTerritoryCode()...)
+			continue
+		}
+		for _, code := range terms.GetTerritoryCode() {
+			territory := strings.ToUpper(strings.TrimSpace(code.GetValue()))
+			if _, ok := group.overrides[territory]; !ok {
+				group.territories = append(group.territories, territory)
+			}
+			group.overrides[territory] = append(group.overrides[territory], deal.Deal)
+		}
+	}
+
+	for _, key := range keys {
+		group := groups[key]
+		if group.base != nil {
+			base := proto.Clone(group.base).(*ernv432.Deal)
+			excluded := make(map[string]bool)
+			for _, code := range group.excluded {
+				excluded[strings.ToUpper(strings.TrimSpace(code.GetValue()))] = true
+			}
+			for _, territory := range group.territories {
+				excluded[territory] = true
+			}
+			base.DealTerms.TerritoryCode = []*ernv432.CurrentTerritoryCode{{Value: TerritoryWorldwide}}
+			base.DealTerms.ExcludedTerritoryCode = nil
+			for _, territory := range sortedKeys(excluded) {
+				base.DealTerms.ExcludedTerritoryCode = append(base.DealTerms.ExcludedTerritoryCode, &ernv432.CurrentTerritoryCode{Value: territory})
+			}
+			merged = append(merged, Deal{ReleaseReference: releases[key], Deal: base})
+		}
+
+		for _, territory := range group.territories {
+			var deal *ernv432.Deal
+			if group.base != nil && !containsTerritory(group.excluded, territory) {
+				deal = group.base
+			}
+			for _, override := range group.overrides[territory] {
+				deal = overrideDeal(deal, override)
+			}
+			deal.DealTerms.TerritoryCode = []*ernv432.CurrentTerritoryCode{{Value: territory}}
+			deal.DealTerms.ExcludedTerritoryCode = nil
+			merged = append(merged, Deal{ReleaseReference: releases[key], Deal: deal})
+		}
+	}
+	return merged
+}
+
+// dealGroupKey identifies the deals that overlap: the release and the sorted CommercialModelTypes and
+// UseTypes of the terms
+func dealGroupKey(releaseReference string, terms *ernv432.DealTerms) string {
+	var models, uses []string
+	for _, model := range terms.GetCommercialModelType() {
+		models = append(models, strings.TrimSpace(model.GetValue()))
+	}
+	for _, use := range terms.GetUseType() {
+		uses = append(uses, strings.TrimSpace(use.GetValue()))
+	}
+	sort.Strings(models)
+	sort.Strings(uses)
+	return strings.TrimSpace(releaseReference) + "|" + strings.Join(models, ",") + "|" + strings.Join(uses, ",")
+}
+
+// overrideDeal returns a copy of override whose DealTerms are base's with the terms override sets
+// replacing them; base may be nil
+func overrideDeal(base, override *ernv432.Deal) *ernv432.Deal {
+	merged := proto.Clone(override).(*ernv432.Deal)
+	if base == nil {
+		if merged.DealTerms == nil {
+			merged.DealTerms = &ernv432.DealTerms{}
+		}
+		return merged
+	}
+
+	terms := &ernv432.DealTerms{}
+	if base.GetDealTerms() != nil {
+		terms = proto.Clone(base.GetDealTerms()).(*ernv432.DealTerms)
+	}
+	target := terms.ProtoReflect()
+	merged.GetDealTerms().ProtoReflect().Range(func(fd protoreflect.FieldDescriptor, value protoreflect.Value) bool {
+		target.Set(fd, value)
+		return true
+	})
+	merged.DealTerms = terms
+	return merged
+}
@@ -0,0 +1,148 @@
+package ddex
+
+import (
+	"bytes"
+	"encoding/xml"
+	"fmt"
+	"io"
+	"reflect"
+	"sort"
+	"strings"
+)
+
+// RemapNamespacePrefix renames the namespace prefix from to to on a parsed root message (any type with
+// NamespaceAttrs), e.g. ern to ernm or avs to a partner-specific prefix. The xmlns:<from> declaration is
+// rewritten to xmlns:<to> and captured attributes qualified with from: are moved to to:. It returns an
+// error if from is not declared or to is already bound to a different namespace. Marshal with
+// MarshalOptions.PrefixedRoot to write the root element itself with the remapped message prefix.
+func RemapNamespacePrefix(msg interface{}, from, to string) error {
+	for _, prefix := range []string{from, to} {
+		if prefix == "" || strings.Contains(prefix, ":") || strings.HasPrefix(strings.ToLower(prefix), "xml") {
+			return fmt.Errorf("invalid namespace prefix %q", prefix)
+		}
+	}
+
+	v := reflect.ValueOf(msg)
+	if v.Kind() != reflect.Ptr || v.IsNil() || v.Elem().Kind() != reflect.Struct {
+		return fmt.Errorf("expected pointer to DDEX message struct, got %T", msg)
+	}
+	field := v.Elem().FieldByName("NamespaceAttrs")
+	if !field.IsValid() || field.Type() != reflect.TypeOf(map[string]string(nil)) {
+		return fmt.Errorf("%T is not a DDEX root message (no NamespaceAttrs)", msg)
+	}
+	attrs := field.Interface().(map[string]string)
+
+	namespace, ok := attrs["xmlns:"+from]
+	if !ok {
+		return fmt.Errorf("namespace prefix %q is not declared", from)
+	}
+	if from == to {
+		return nil
+	}
+	if bound, ok := attrs["xmlns:"+to]; ok && bound != namespace {
+		return fmt.Errorf("namespace prefix %q is already bound to %s", to, bound)
+	}
+
+	delete(attrs, "xmlns:"+from)
+	for key, value := range attrs {
+		if strings.HasPrefix(key, from+":") {
+			delete(attrs, key)
+			attrs[to+strings.TrimPrefix(key, from)] = value
+		}
+	}
+	attrs["xmlns:"+to] = namespace
+	return nil
+}
+
+// prefixRootElement rewrites the root element of marshaled XML from the default namespace form
+// <Name xmlns="ns" xmlns:p="ns" ...> to <p:Name xmlns:p="ns" ...>, using the first prefix (by name) that
+// the root declares for its own namespace. Output without such a prefix is returned unchanged. Children
+// of DDEX messages are unqualified, so only the root start and end tags change.
+func prefixRootElement(data []byte) ([]byte, error) {
+	decoder := xml.NewDecoder(bytes.NewReader(data))
+	var (
+		root                 xml.StartElement
+		startBegin, startEnd int64 = -1, -1
+		endBegin, endEnd     int64 = -1, -1
+		depth                int
+	)
+	for {
+		offset := decoder.InputOffset()
+		token, err := decoder.RawToken()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, err
+		}
+
+		switch token := token.(type) {
+		case xml.StartElement:
+			if depth == 0 && startBegin < 0 {
+				root = token.Copy()
+				startBegin, startEnd = offset, decoder.InputOffset()
+			}
+			depth++
+		case xml.EndElement:
+			depth--
+			if depth == 0 && endBegin < 0 {
+				endBegin, endEnd = offset, decoder.InputOffset()
+			}
+		}
+	}
+	if startBegin < 0 {
+		return data, nil
+	}
+
+	var namespace string
+	var prefixes []string
+	for _, attr := range root.Attr {
+		if attr.Name.Space == "" && attr.Name.Local == "xmlns" {
+			namespace = attr.Value
+		}
+	}
+	for _, attr := range root.Attr {
+		if attr.Name.Space == "xmlns" && namespace != "" && attr.Value == namespace {
+			prefixes = append(prefixes, attr.Name.Local)
+		}
+	}
+	if len(prefixes) == 0 || root.Name.Space != "" {
+		return data, nil
+	}
+	sort.Strings(prefixes)
+	name := prefixes[0] + ":" + root.Name.Local
+
+	var out bytes.Buffer
+	out.Grow(len(data) + 2*len(prefixes[0]))
+	out.Write(data[:startBegin])
+	out.WriteString("<" + name)
+	for _, attr := range root.Attr {
+		if attr.Name.Space == "" && attr.Name.Local == "xmlns" {
+			continue
+		}
+		out.WriteByte(' ')
+		if attr.Name.Space != "" {
+			out.WriteString(attr.Name.Space + ":")
+		}
+		out.WriteString(attr.Name.Local + `="`)
+		if err := xml.EscapeText(&out, []byte(attr.Value)); err != nil {
+			return nil, err
+		}
+		out.WriteByte('"')
+	}
+
+	// A self-closing root reports its end element at the offset just past the start tag
+	if bytes.HasSuffix(data[:startEnd], []byte("/>")) {
+		out.WriteString("/>")
+		out.Write(data[startEnd:])
+		return out.Bytes(), nil
+	}
+	out.WriteByte('>')
+	if endBegin < 0 {
+		return nil, fmt.Errorf("root element %s is not closed", root.Name.Local)
+	}
+	out.Write(data[startEnd:endBegin])
+	out.WriteString("</" + name + ">")
+	out.Write(data[endEnd:])
+	return out.Bytes(), nil
+}
@@ -0,0 +1,253 @@
+package ddex
+
+import (
+	"bytes"
+	"encoding/xml"
+	"errors"
+	"fmt"
+	"io"
+	"reflect"
+	"regexp"
+	"strings"
+	"unicode/utf16"
+	"unicode/utf8"
+
+	"github.com/alecsavvy/ddex-proto/gen"
+)
+
+// ParseOption configures ParseWithOptions and ParseAnyWithOptions
+type ParseOption func(*parseOptions)
+
+// parseOptions holds the settings set by ParseOption values
+type parseOptions struct {
+	limits           Limits
+	charsetDetection bool
+	validation       bool
+	strictReferences bool
+	strictElements   bool
+}
+
+// WithLimits aborts the parse once the document exceeds limits, like ParseAnyLimited
+func WithLimits(limits Limits) ParseOption {
+	return func(o *parseOptions) { o.limits = limits }
+}
+
+// WithCharsetDetection transcodes documents in UTF-16 (by byte order mark), ISO-8859-1 or windows-1252 (by
+// XML declaration) to UTF-8 before parsing. Without it only UTF-8 and US-ASCII documents parse.
+func WithCharsetDetection() ParseOption {
+	return func(o *parseOptions) { o.charsetDetection = true }
+}
+
+// WithValidation fails the parse when the document does not conform to its XSD (see ValidateAgainstSchema)
+func WithValidation() ParseOption {
+	return func(o *parseOptions) { o.validation = true }
+}
+
+// WithStrictReferences fails the parse when the message has a message-local reference that does not
+// resolve (see ValidateReferences)
+func WithStrictReferences() ParseOption {
+	return func(o *parseOptions) { o.strictReferences = true }
+}
+
+// WithStrictElements fails the parse with an *UnknownElementsError when the document has elements the
+// generated message has no field for, which the decoder would otherwise silently drop
+func WithStrictElements() ParseOption {
+	return func(o *parseOptions) { o.strictElements = true }
+}
+
+// UnknownElementsError lists the elements of a document that are not in its generated message
+type UnknownElementsError struct {
+	// Paths are the sorted paths of the unknown elements, e.g. /NewReleaseMessage/MessageHeader/Priority
+	Paths []string
+}
+
+// Error implements the error interface
+func (e *UnknownElementsError) Error() string {
+	return fmt.Sprintf("%d unknown elements: %s", len(e.Paths), strings.Join(e.Paths, ", "))
+}
+
+// ParseWithOptions is gen.Parse with opts applied. For versions with several root messages, the message
+// matching the document's root element is used.
+func ParseWithOptions(xmlData []byte, messageType, version string, opts ...ParseOption) (interface{}, error) {
+	options, xmlData, err := applyParseOptions(xmlData, opts)
+	if err != nil {
+		return nil, err
+	}
+
+	message, err := newForRoot(xmlData, messageType, version)
+	if err != nil {
+		return nil, err
+	}
+	if err := parseInto(xmlData, message, options); err != nil {
+		return nil, fmt.Errorf("failed to parse %s/%s: %w", messageType, version, err)
+	}
+	return message, nil
+}
+
+// ParseAnyWithOptions is gen.ParseAny with opts applied
+func ParseAnyWithOptions(xmlData []byte, opts ...ParseOption) (message interface{}, messageType, version string, err error) {
+	options, xmlData, err := applyParseOptions(xmlData, opts)
+	if err != nil {
+		return nil, "", "", err
+	}
+
+	msgType, ver, msgName, err := gen.DetectMessageType(xmlData)
+	if err != nil {
+		return nil, "", "", fmt.Errorf("failed to detect message type: %w", err)
+	}
+
+	message, err = gen.NewByMessageName(msgType, ver, msgName)
+	if err != nil {
+		return nil, "", "", fmt.Errorf("failed to create message instance: %w", err)
+	}
+
+	if err := parseInto(xmlData, message, options); err != nil {
+		return nil, "", "", err
+	}
+	return message, msgType, ver, nil
+}
+
+// newForRoot creates the message of messageType and version whose root element is the document's, falling
+// back to gen.New when none matches
+func newForRoot(xmlData []byte, messageType, version string) (interface{}, error) {
+	rootElement, _, err := readRoot(xmlData)
+	if err != nil {
+		return nil, err
+	}
+	for key, info := range gen.GetRegisteredTypes() {
+		if strings.HasPrefix(key, messageType+"/"+version+"/") && info.RootElement == rootElement {
+			return gen.NewByMessageName(messageType, version, strings.TrimPrefix(key, messageType+"/"+version+"/"))
+		}
+	}
+	return gen.New(messageType, version)
+}
+
+// applyParseOptions collects opts and, with charset detection, transcodes xmlData to UTF-8
+func applyParseOptions(xmlData []byte, opts []ParseOption) (parseOptions, []byte, error) {
+	var options parseOptions
+	for _, opt := range opts {
+		opt(&options)
+	}
+	if options.charsetDetection {
+		converted, err := toUTF8(xmlData)
+		if err != nil {
+			return options, nil, err
+		}
+		xmlData = converted
+	}
+	return options, xmlData, nil
+}
+
+// parseInto decodes xmlData into message under options, then checks it. The decode enforces the limits,
+// so the unknown element and schema checks, which read the whole document again, only run on documents
+// within them.
+func parseInto(xmlData []byte, message interface{}, options parseOptions) error {
+	reader := &limitedTokenReader{
+		decoder: xml.NewDecoder(bytes.NewReader(xmlData)),
+		limits:  options.limits,
+	}
+	if err := xml.NewTokenDecoder(reader).Decode(message); err != nil {
+		return fmt.Errorf("failed to unmarshal XML: %w", err)
+	}
+	// The checks below read past the root element too, so the rest of the document must be within the
+	// limits as well
+	for options.strictElements || options.validation {
+		if _, err := reader.Token(); err == io.EOF {
+			break
+		} else if err != nil {
+			return fmt.Errorf("failed to read XML: %w", err)
+		}
+	}
+
+	if options.strictElements {
+		if unknown := missingElements(xmlData, reflect.TypeOf(message).Elem()); len(unknown) > 0 {
+			return &UnknownElementsError{Paths: unknown}
+		}
+	}
+
+	if options.validation {
+		if errs := ValidateAgainstSchema(xmlData); len(errs) > 0 {
+			return fmt.Errorf("schema validation failed: %w", joinErrors(errs))
+		}
+	}
+
+	if options.strictReferences {
+		if errs := ValidateReferences(message, nil); len(errs) > 0 {
+			return fmt.Errorf("reference validation failed: %w", joinErrors(errs))
+		}
+	}
+	return nil
+}
+
+// joinErrors wraps a list of validation errors with errors.Join
+func joinErrors[E error](errs []E) error {
+	wrapped := make([]error, len(errs))
+	for i, err := range errs {
+		wrapped[i] = err
+	}
+	return errors.Join(wrapped...)
+}
+
+// encodingDecl matches the encoding pseudo-attribute of an XML declaration
+var encodingDecl = regexp.MustCompile(`^(<\?xml[^>]*?encoding\s*=\s*)(["'])([^"']*)(["'])`)
+
+// windows1252 maps the bytes 0x80..0x9F of windows-1252 to Unicode; the other bytes match ISO-8859-1.
+// Undefined bytes map to the C1 control character of the same value.
+var windows1252 = [32]rune{
+	'€', 0x81, '‚', 'ƒ', '„', '…', '†', '‡', 'ˆ', '‰', 'Š', '‹', 'Œ', 0x8D, 'Ž', 0x8F,
+	0x90, '‘', '’', '“', '”', '•', '–', '—', '˜', '™', 'š', '›', 'œ', 0x9D, 'ž', 'Ÿ',
+}
+
+// toUTF8 transcodes a document to UTF-8 by its byte order mark or declared encoding, rewriting the
+// declaration to UTF-8. UTF-8 documents are returned unchanged apart from a dropped byte order mark.
+func toUTF8(data []byte) ([]byte, error) {
+	switch {
+	case bytes.HasPrefix(data, []byte{0xEF, 0xBB, 0xBF}):
+		data = data[3:]
+	case bytes.HasPrefix(data, []byte{0xFF, 0xFE}), bytes.HasPrefix(data, []byte{0xFE, 0xFF}):
+		if len(data)%2 != 0 {
+			return nil, fmt.Errorf("UTF-16 document has an odd number of bytes")
+		}
+		units := make([]uint16, 0, len(data)/2-1)
+		for i := 2; i < len(data); i += 2 {
+			if data[0] == 0xFF {
+				units = append(units, uint16(data[i])|uint16(data[i+1])<<8)
+			} else {
+				units = append(units, uint16(data[i])<<8|uint16(data[i+1]))
+			}
+		}
+		return setEncodingDecl([]byte(string(utf16.Decode(units)))), nil
+	}
+
+	match := encodingDecl.FindSubmatch(data)
+	if match == nil {
+		return data, nil
+	}
+	var decode func(b byte) rune
+	switch strings.ToLower(string(match[3])) {
+	case "utf-8", "utf8", "us-ascii", "ascii":
+		return data, nil
+	case "iso-8859-1", "iso8859-1", "latin1", "latin-1":
+		decode = func(b byte) rune { return rune(b) }
+	case "windows-1252", "cp1252":
+		decode = func(b byte) rune {
+			if b >= 0x80 && b <= 0x9F {
+				return windows1252[b-0x80]
+			}
+			return rune(b)
+		}
+	default:
+		return nil, fmt.Errorf("unsupported encoding %q", match[3])
+	}
+
+	out := make([]byte, 0, len(data)+len(data)/8)
+	for _, b := range data {
+		out = utf8.AppendRune(out, decode(b))
+	}
+	return setEncodingDecl(out), nil
+}
+
+// setEncodingDecl rewrites the encoding declared by an XML declaration to UTF-8
+func setEncodingDecl(data []byte) []byte {
+	return encodingDecl.ReplaceAll(data, []byte("${1}${2}UTF-8${4}"))
+}
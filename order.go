@@ -0,0 +1,140 @@
+package ddex
+
+import (
+	"bytes"
+	"encoding/xml"
+	"fmt"
+	"io"
+	"reflect"
+
+	"github.com/alecsavvy/ddex-proto/gen"
+)
+
+// OrderError describes a child element that appears after a sibling the schema sequence puts later
+type OrderError struct {
+	// Path is the DDEX path of the out-of-order element, e.g. /NewReleaseMessage/ResourceList/SoundRecording
+	Path string
+	// Element is the out-of-order element and After the preceding sibling it must come before
+	Element string
+	After   string
+	// Message explains the problem when the document could not be checked
+	Message string
+}
+
+// Error implements the error interface
+func (e OrderError) Error() string {
+	if e.Message != "" {
+		return fmt.Sprintf("%s: %s", e.Path, e.Message)
+	}
+	return fmt.Sprintf("%s: %s must come before %s", e.Path, e.Element, e.After)
+}
+
+// orderFrame is an open element during ValidateElementOrder
+type orderFrame struct {
+	path string
+	// t is the generated struct type of the element, nil for scalars and unknown elements
+	t reflect.Type
+	// order is the schema sequence of the element's children, nil if unchecked
+	order []string
+	// last is the sequence position and name of the latest child seen so far
+	last     int
+	lastName string
+}
+
+// ValidateElementOrder checks that the child elements of every element in xmlData follow the sequence of
+// the DDEX schema for messageType and version (e.g. "ern", "v432"). Types whose schema content is a
+// repeating choice or sequence (see gen.ChildElementOrder) and elements unknown to the schema are not
+// checked.
+func ValidateElementOrder(xmlData []byte, messageType, version string) []OrderError {
+	rootElement, _, err := readRoot(xmlData)
+	if err != nil {
+		return []OrderError{{Path: "/", Message: err.Error()}}
+	}
+	root, err := gen.NewByMessageName(messageType, version, rootElement)
+	if err != nil {
+		return []OrderError{{Path: "/" + rootElement, Message: err.Error()}}
+	}
+
+	var errs []OrderError
+	var stack []*orderFrame
+
+	decoder := xml.NewDecoder(bytes.NewReader(xmlData))
+	for {
+		token, err := decoder.Token()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			errs = append(errs, OrderError{Path: "/" + rootElement, Message: err.Error()})
+			break
+		}
+
+		switch t := token.(type) {
+		case xml.StartElement:
+			name := t.Name.Local
+			if len(stack) == 0 {
+				stack = append(stack, newOrderFrame("/"+name, reflect.TypeOf(root).Elem()))
+				continue
+			}
+
+			parent := stack[len(stack)-1]
+			var childType reflect.Type
+			if parent.t != nil {
+				childType = childStructType(parent.t, name)
+			}
+			frame := newOrderFrame(parent.path+"/"+name, childType)
+
+			if index := indexOf(parent.order, name); index >= 0 {
+				if index < parent.last {
+					errs = append(errs, OrderError{Path: frame.path, Element: name, After: parent.lastName})
+				} else {
+					parent.last, parent.lastName = index, name
+				}
+			}
+			stack = append(stack, frame)
+		case xml.EndElement:
+			stack = stack[:len(stack)-1]
+		}
+	}
+	return errs
+}
+
+// newOrderFrame opens an element of generated struct type t, which may be nil
+func newOrderFrame(path string, t reflect.Type) *orderFrame {
+	frame := &orderFrame{path: path, t: t, last: -1}
+	if t != nil {
+		frame.order = gen.ChildElementOrder(t)
+	}
+	return frame
+}
+
+// childStructType returns the generated struct type of child element name in struct type t, or nil for
+// scalar content and unknown elements
+func childStructType(t reflect.Type, name string) reflect.Type {
+	for i := 0; i < t.NumField(); i++ {
+		field, ok := xmlFieldOf(t.Field(i))
+		if !ok || field.Attr || field.CharData || field.InnerXML || field.Name != name {
+			continue
+		}
+
+		childType := t.Field(i).Type
+		for childType.Kind() == reflect.Ptr || childType.Kind() == reflect.Slice {
+			childType = childType.Elem()
+		}
+		if childType.Kind() != reflect.Struct {
+			return nil
+		}
+		return childType
+	}
+	return nil
+}
+
+// indexOf returns the position of name in names, or -1
+func indexOf(names []string, name string) int {
+	for i, n := range names {
+		if n == name {
+			return i
+		}
+	}
+	return -1
+}
@@ -0,0 +1,37 @@
+package ddex
+
+import (
+	"path"
+	"reflect"
+	"strings"
+
+	ernv432 "github.com/alecsavvy/ddex-proto/gen/ddex/ern/v432"
+)
+
+// FindOrphanResources returns, in document order, the ResourceReferences declared in the ResourceList that
+// no release uses: no ReleaseResourceReference or LinkedReleaseResourceReference of a Release or TrackRelease
+// names them. Orphans waste storage and often point to a packaging bug; this is the inverse of the dangling
+// references ValidateReferences reports.
+func FindOrphanResources(msg *ernv432.NewReleaseMessage) []string {
+	used := make(map[string]bool)
+	walkScalars(msg.GetReleaseList(), func(elementPath string, field xmlField, value reflect.Value) {
+		if value.Kind() == reflect.String && !field.Attr && strings.HasSuffix(path.Base(elementPath), "ResourceReference") {
+			used[strings.TrimSpace(value.String())] = true
+		}
+	})
+
+	var orphans []string
+	seen := make(map[string]bool)
+	walkScalars(msg.GetResourceList(), func(elementPath string, field xmlField, value reflect.Value) {
+		// Only the reference each resource declares, /ResourceList/<Resource>/ResourceReference
+		if value.Kind() != reflect.String || strings.Count(elementPath, "/") != 3 || path.Base(elementPath) != "ResourceReference" {
+			return
+		}
+		reference := strings.TrimSpace(value.String())
+		if !used[reference] && !seen[reference] {
+			orphans = append(orphans, reference)
+		}
+		seen[reference] = true
+	})
+	return orphans
+}
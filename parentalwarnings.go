@@ -0,0 +1,134 @@
+package ddex
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+
+	ernv432 "github.com/alecsavvy/ddex-proto/gen/ddex/ern/v432"
+)
+
+// ParentalWarningTypeExplicit is the ParentalWarningType value of explicit content
+const ParentalWarningTypeExplicit = "Explicit"
+
+// explicitTitlePattern matches titles marked explicit, e.g. "Song (Explicit)"
+var explicitTitlePattern = regexp.MustCompile(`(?i)\bexplicit\b`)
+
+// editedVersionTypes are the VersionTypes of a cleaned-up version of explicit content
+var editedVersionTypes = map[string]bool{"CleanVersion": true, "EditedVersion": true}
+
+// WarningError describes a SoundRecording or Release without a ParentalWarningType that needs one
+type WarningError struct {
+	// Path is the DDEX path of the missing element, e.g.
+	// /NewReleaseMessage/ResourceList/SoundRecording/ParentalWarningType
+	Path string
+	// Reference is the ResourceReference of the SoundRecording or the ReleaseReference of the Release
+	Reference string
+	// Message explains why the ParentalWarningType is needed
+	Message string
+}
+
+// Error implements the error interface
+func (e WarningError) Error() string {
+	return fmt.Sprintf("%s: %s (%q)", e.Path, e.Message, e.Reference)
+}
+
+// ValidateParentalWarnings reports the SoundRecordings and the Release of msg that have no
+// ParentalWarningType although they need one: always when the message declares a
+// ReleaseProfileVersionId, as the release profiles require it, and otherwise when something suggests
+// explicit content: an Explicit release containing the recording or an Explicit recording in the release,
+// "Explicit" in a title or subtitle, or a CleanVersion or EditedVersion VersionType. Each is reported
+// once, with the first reason found.
+func ValidateParentalWarnings(msg *ernv432.NewReleaseMessage) []WarningError {
+	const (
+		recordingPath = "/NewReleaseMessage/ResourceList/SoundRecording/ParentalWarningType"
+		releasePath   = "/NewReleaseMessage/ReleaseList/Release/ParentalWarningType"
+	)
+	profile := strings.TrimSpace(msg.GetReleaseProfileVersionId())
+	release := msg.GetReleaseList().GetRelease()
+
+	// The ResourceReferences the Release contains
+	contained := make(map[string]bool)
+	listGroup(release.GetResourceGroup().GetResourceGroupContentItem(), release.GetResourceGroup().GetResourceGroup(), 0,
+		func(item *ernv432.ResourceGroupContentItem, _, _ int) error {
+			contained[strings.TrimSpace(item.GetReleaseResourceReference())] = true
+			return nil
+		})
+	releaseExplicit := hasParentalWarning(release.GetParentalWarningType(), ParentalWarningTypeExplicit)
+
+	var errs []WarningError
+	var explicitRecordings []string
+	for _, recording := range msg.GetResourceList().GetSoundRecording() {
+		reference := strings.TrimSpace(recording.GetResourceReference())
+		if hasParentalWarning(recording.GetParentalWarningType(), ParentalWarningTypeExplicit) && contained[reference] {
+			explicitRecordings = append(explicitRecordings, reference)
+		}
+		if hasParentalWarning(recording.GetParentalWarningType(), "") {
+			continue
+		}
+
+		reason := ""
+		switch {
+		case profile != "":
+			reason = fmt.Sprintf("ParentalWarningType is required by the %s release profile", profile)
+		case releaseExplicit && contained[reference]:
+			reason = fmt.Sprintf("no ParentalWarningType on a recording of Explicit release %s", strings.TrimSpace(release.GetReleaseReference()))
+		default:
+			reason = explicitSignal(recording.GetDisplayTitleText(), recording.GetDisplayTitle(), recording.GetVersionType())
+		}
+		if reason != "" {
+			errs = append(errs, WarningError{Path: recordingPath, Reference: reference, Message: reason})
+		}
+	}
+
+	if release != nil && !hasParentalWarning(release.GetParentalWarningType(), "") {
+		reason := ""
+		switch {
+		case profile != "":
+			reason = fmt.Sprintf("ParentalWarningType is required by the %s release profile", profile)
+		case len(explicitRecordings) > 0:
+			reason = fmt.Sprintf("no ParentalWarningType on a release containing Explicit recordings %s", strings.Join(explicitRecordings, ", "))
+		default:
+			reason = explicitSignal(release.GetDisplayTitleText(), release.GetDisplayTitle(), nil)
+		}
+		if reason != "" {
+			errs = append(errs, WarningError{Path: releasePath, Reference: strings.TrimSpace(release.GetReleaseReference()), Message: reason})
+		}
+	}
+	return errs
+}
+
+// hasParentalWarning reports whether warnings has value, or any non-empty value when value is ""
+func hasParentalWarning(warnings []*ernv432.ParentalWarningTypeWithStandard, value string) bool {
+	for _, warning := range warnings {
+		if warningValue := strings.TrimSpace(warning.GetValue()); warningValue != "" && (value == "" || strings.EqualFold(warningValue, value)) {
+			return true
+		}
+	}
+	return false
+}
+
+// explicitSignal returns why titles or version types suggest explicit content, "" if they do not
+func explicitSignal(titleTexts []*ernv432.DisplayTitleText, titles []*ernv432.DisplayTitle, versionTypes []*ernv432.VersionType) string {
+	var texts []string
+	for _, titleText := range titleTexts {
+		texts = append(texts, titleText.GetValue())
+	}
+	for _, title := range titles {
+		texts = append(texts, title.GetTitleText())
+		for _, subTitle := range title.GetSubTitle() {
+			texts = append(texts, subTitle.GetValue())
+		}
+	}
+	for _, text := range texts {
+		if explicitTitlePattern.MatchString(text) {
+			return fmt.Sprintf("no ParentalWarningType although the title %q is marked explicit", strings.TrimSpace(text))
+		}
+	}
+	for _, versionType := range versionTypes {
+		if editedVersionTypes[strings.TrimSpace(versionType.GetValue())] {
+			return fmt.Sprintf("no ParentalWarningType on a %s of explicit content", strings.TrimSpace(versionType.GetValue()))
+		}
+	}
+	return ""
+}
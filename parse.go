@@ -0,0 +1,152 @@
+package ddex
+
+import (
+	"bytes"
+	"encoding/xml"
+	"fmt"
+	"io"
+
+	"github.com/alecsavvy/ddex-proto/gen"
+)
+
+// Limits bounds the resources a parse may consume. A zero value for a field means no limit.
+type Limits struct {
+	// MaxDepth is the maximum element nesting depth (the root element is depth 1)
+	MaxDepth int
+	// MaxTokens is the maximum number of XML tokens (elements, character data, comments, ...) read
+	MaxTokens int
+}
+
+// ParseAnyLimited auto-detects the DDEX message type like gen.ParseAny, but aborts with an error as soon
+// as the document exceeds the nesting depth or token count in limits. Use it for untrusted input.
+func ParseAnyLimited(xmlData []byte, limits Limits) (message interface{}, messageType, version string, err error) {
+	return ParseAnyWithOptions(xmlData, WithLimits(limits))
+}
+
+// ParseAnyStrict auto-detects the DDEX message type like gen.ParseAny, but fails with an
+// *UnknownElementsError listing the paths of the elements not in the schema of the detected version
+// instead of dropping them (see WithStrictElements). Use it to certify deliveries.
+func ParseAnyStrict(xmlData []byte) (message interface{}, messageType, version string, err error) {
+	return ParseAnyWithOptions(xmlData, WithStrictElements())
+}
+
+// ParseTyped auto-detects and parses a DDEX message and returns it as *T, e.g.
+// ParseTyped[ernv432.NewReleaseMessage](data). It fails if the detected message is not a T.
+func ParseTyped[T any](xmlData []byte) (*T, error) {
+	msg, messageType, version, err := gen.ParseAny(xmlData)
+	if err != nil {
+		return nil, err
+	}
+
+	typed, ok := msg.(*T)
+	if !ok {
+		return nil, fmt.Errorf("detected %s %s message %T, expected %T", messageType, version, msg, typed)
+	}
+	return typed, nil
+}
+
+// ParseTypedReader is ParseTyped for a message read from r
+func ParseTypedReader[T any](r io.Reader) (*T, error) {
+	xmlData, err := io.ReadAll(r)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read XML: %w", err)
+	}
+	return ParseTyped[T](xmlData)
+}
+
+// ParsedMessage is a message parsed by ParseAnyPreserving together with the bytes it was parsed from
+type ParsedMessage struct {
+	// Message is the typed message, e.g. *ernv432.NewReleaseMessage
+	Message     interface{}
+	MessageType string
+	Version     string
+	raw         []byte
+}
+
+// ParseAnyPreserving auto-detects and parses a DDEX message like gen.ParseAny, keeping a copy of data so
+// that the document received can be re-emitted byte for byte (see Reserialize) whatever is done to the
+// parsed message or to data afterwards
+func ParseAnyPreserving(data []byte) (*ParsedMessage, error) {
+	msg, messageType, version, err := gen.ParseAny(data)
+	if err != nil {
+		return nil, err
+	}
+	return &ParsedMessage{
+		Message:     msg,
+		MessageType: messageType,
+		Version:     version,
+		raw:         bytes.Clone(data),
+	}, nil
+}
+
+// Reserialize returns the original bytes of the message, unchanged by edits to Message. Unlike
+// marshaling Message it keeps the source's formatting, comments, namespace prefixes and encoding.
+func (p *ParsedMessage) Reserialize() []byte {
+	return bytes.Clone(p.raw)
+}
+
+// ParseAndValidate parses a DDEX message like gen.ParseAny and checks it for the problems an ingest can
+// flag without rejecting the delivery: schema violations such as missing required elements
+// (ValidateAgainstSchema), unresolved references (ValidateReferences), deals for releases the message does
+// not declare (ValidateDealReleaseReferences), malformed ISRCs and ICPNs (ValidateIdentifiers) and unusable
+// MessageSender or MessageRecipient PartyIds (ValidateMessageParties).
+// The message is returned with every validation error found; the final error is only set when the
+// document cannot be parsed.
+func ParseAndValidate(data []byte) (interface{}, []error, error) {
+	msg, _, _, err := gen.ParseAny(data)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var errs []error
+	for _, schemaErr := range ValidateAgainstSchema(data) {
+		errs = append(errs, schemaErr)
+	}
+	for _, refErr := range ValidateReferences(msg, nil) {
+		errs = append(errs, refErr)
+	}
+	for _, dealErr := range ValidateDealReleaseReferences(msg, nil) {
+		errs = append(errs, dealErr)
+	}
+	for _, identifierErr := range ValidateIdentifiers(msg) {
+		errs = append(errs, identifierErr)
+	}
+	for _, partyErr := range ValidateMessageParties(msg) {
+		errs = append(errs, partyErr)
+	}
+	return msg, errs, nil
+}
+
+// limitedTokenReader feeds raw tokens to a decoder while enforcing Limits. Raw tokens are passed through
+// so that the outer decoder still performs namespace translation and start/end element matching.
+type limitedTokenReader struct {
+	decoder *xml.Decoder
+	limits  Limits
+	depth   int
+	tokens  int
+}
+
+// Token implements xml.TokenReader
+func (r *limitedTokenReader) Token() (xml.Token, error) {
+	tok, err := r.decoder.RawToken()
+	if err != nil {
+		return nil, err
+	}
+
+	r.tokens++
+	if r.limits.MaxTokens > 0 && r.tokens > r.limits.MaxTokens {
+		return nil, fmt.Errorf("token limit exceeded: more than %d tokens", r.limits.MaxTokens)
+	}
+
+	switch tok.(type) {
+	case xml.StartElement:
+		r.depth++
+		if r.limits.MaxDepth > 0 && r.depth > r.limits.MaxDepth {
+			return nil, fmt.Errorf("depth limit exceeded: more than %d nested elements", r.limits.MaxDepth)
+		}
+	case xml.EndElement:
+		r.depth--
+	}
+
+	return tok, nil
+}
@@ -0,0 +1,147 @@
+package ddex
+
+import (
+	"fmt"
+	"reflect"
+	"regexp"
+	"strings"
+)
+
+// dpidPattern matches a DDEX Party ID: PADPIDA followed by ten characters and a check character
+var dpidPattern = regexp.MustCompile(`^PADPIDA[0-9A-Z]{10}[0-9A-Z]$`)
+
+// isniPattern matches an ISNI: fifteen digits and a check character
+var isniPattern = regexp.MustCompile(`^[0-9]{15}[0-9X]$`)
+
+// PartyError describes a MessageSender or MessageRecipient without a usable PartyId
+type PartyError struct {
+	// Party is the failing party, "MessageSender" or "MessageRecipient"
+	Party string
+	// PartyId is the rejected identifier, empty if the party has none
+	PartyId string
+	// Message explains why the PartyId is unusable
+	Message string
+}
+
+// Error implements the error interface
+func (e PartyError) Error() string {
+	if e.PartyId == "" {
+		return fmt.Sprintf("%s: %s", e.Party, e.Message)
+	}
+	return fmt.Sprintf("%s: %s (%q)", e.Party, e.Message, e.PartyId)
+}
+
+// messagePartyId is a PartyId of a messaging party with the namespace attributes of ERN 3.8.x
+type messagePartyId struct {
+	Value     string
+	Namespace string
+	IsDPID    bool
+	IsISNI    bool
+}
+
+// ValidateMessageParties checks that the MessageHeader of any generated DDEX message has a MessageSender and
+// a MessageRecipient, each with a non-empty PartyId in a recognized namespace, so deliveries can be routed.
+// A DDEX Party ID must have the form PADPIDA plus ten characters and a check character (e.g.
+// PADPIDA2007050901U), which is stricter than the XSD pattern; ERN 3.8.x PartyIds may instead be ISNIs
+// (IsISNI) or proprietary identifiers with a Namespace attribute. Every recipient is checked.
+func ValidateMessageParties(msg interface{}) []PartyError {
+	v := reflect.ValueOf(msg)
+	for v.Kind() == reflect.Ptr || v.Kind() == reflect.Interface {
+		if v.IsNil() {
+			return []PartyError{{Party: "MessageHeader", Message: "message is nil"}}
+		}
+		v = v.Elem()
+	}
+	var header reflect.Value
+	if v.Kind() == reflect.Struct {
+		header = v.FieldByName("MessageHeader")
+	}
+	if !header.IsValid() || header.IsNil() {
+		return []PartyError{{Party: "MessageHeader", Message: "message has no MessageHeader"}}
+	}
+	header = header.Elem()
+
+	var errs []PartyError
+	for _, role := range []string{"MessageSender", "MessageRecipient"} {
+		field := header.FieldByName(role)
+		var parties []reflect.Value
+		switch {
+		case !field.IsValid():
+		case field.Kind() == reflect.Slice:
+			for i := 0; i < field.Len(); i++ {
+				parties = append(parties, field.Index(i))
+			}
+		default:
+			parties = append(parties, field)
+		}
+
+		found := false
+		for _, party := range parties {
+			if party.IsNil() {
+				continue
+			}
+			found = true
+			errs = append(errs, validatePartyIds(role, partyIdsOf(party.Elem()))...)
+		}
+		if !found {
+			errs = append(errs, PartyError{Party: role, Message: "message has no " + role})
+		}
+	}
+	return errs
+}
+
+// partyIdsOf returns the PartyIds of a MessagingParty or MessagingPartyWithoutCode
+func partyIdsOf(party reflect.Value) []messagePartyId {
+	field := party.FieldByName("PartyId")
+	switch field.Kind() {
+	case reflect.String:
+		if strings.TrimSpace(field.String()) == "" {
+			return nil
+		}
+		return []messagePartyId{{Value: field.String()}}
+	case reflect.Slice:
+		var ids []messagePartyId
+		for i := 0; i < field.Len(); i++ {
+			id := field.Index(i)
+			if id.IsNil() {
+				continue
+			}
+			id = id.Elem()
+			ids = append(ids, messagePartyId{
+				Value:     id.FieldByName("Value").String(),
+				Namespace: id.FieldByName("Namespace").String(),
+				IsDPID:    id.FieldByName("IsDPID").Bool(),
+				IsISNI:    id.FieldByName("IsISNI").Bool(),
+			})
+		}
+		return ids
+	}
+	return nil
+}
+
+// validatePartyIds reports a party without PartyIds and each PartyId that is not valid in its namespace
+func validatePartyIds(role string, ids []messagePartyId) []PartyError {
+	if len(ids) == 0 {
+		return []PartyError{{Party: role, Message: "party has no PartyId"}}
+	}
+
+	var errs []PartyError
+	for _, id := range ids {
+		value := strings.TrimSpace(id.Value)
+		switch {
+		case value == "":
+			errs = append(errs, PartyError{Party: role, Message: "PartyId is empty"})
+		case id.IsDPID || strings.HasPrefix(value, "PADPID"):
+			if !dpidPattern.MatchString(value) {
+				errs = append(errs, PartyError{Party: role, PartyId: value, Message: "PartyId is not a DDEX Party ID of the form PADPIDAxxxxxxxxxxC"})
+			}
+		case id.IsISNI:
+			if !isniPattern.MatchString(value) {
+				errs = append(errs, PartyError{Party: role, PartyId: value, Message: "PartyId is not an ISNI of sixteen characters"})
+			}
+		case id.Namespace == "":
+			errs = append(errs, PartyError{Party: role, PartyId: value, Message: "PartyId is not in a recognized namespace"})
+		}
+	}
+	return errs
+}
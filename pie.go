@@ -0,0 +1,77 @@
+package ddex
+
+import (
+	"reflect"
+	"strings"
+
+	piev10 "github.com/alecsavvy/ddex-proto/gen/ddex/pie/v10"
+)
+
+// ResolveParty returns the element of a PIE message that a message-local reference points at: the Party
+// with that PartyReference (*piev10.Party), or the MetadataSource with that SourceReference
+// (*piev10.MetadataSource), which MetadataSourceReference elements point at
+func ResolveParty(msg *piev10.PieMessage, ref string) (interface{}, bool) {
+	target, ok := pieReferenceIndex(msg)[strings.TrimSpace(ref)]
+	return target, ok
+}
+
+// pieReferenceIndex maps the PartyReferences and SourceReferences of a PIE message to their Party or
+// MetadataSource; the first definition of a reference wins
+func pieReferenceIndex(msg *piev10.PieMessage) map[string]interface{} {
+	index := make(map[string]interface{})
+	for _, party := range msg.GetPartyList().GetParty() {
+		if reference := strings.TrimSpace(party.GetPartyReference()); reference != "" && index[reference] == nil {
+			index[reference] = party
+		}
+	}
+	for _, source := range msg.GetMetadataSourceList().GetMetadataSource() {
+		if reference := strings.TrimSpace(source.GetSourceReference()); reference != "" && index[reference] == nil {
+			index[reference] = source
+		}
+	}
+	return index
+}
+
+// ValidatePartyReferences checks the message-local references of a PIE message, like ValidateReferences
+// does for ERN: every PartyRelatedPartyReference must name a Party of the PartyList and every
+// MetadataSourceReference a MetadataSource of the MetadataSourceList. A PartyReference or SourceReference
+// declared twice is reported too, as ResolveParty can only return the first.
+func ValidatePartyReferences(msg *piev10.PieMessage) []RefError {
+	var errs []RefError
+	declared := make(map[string]bool)
+	declare := func(path, reference string) {
+		reference = strings.TrimSpace(reference)
+		if reference == "" {
+			return
+		}
+		if declared[reference] {
+			errs = append(errs, RefError{Path: path, Reference: reference, Message: "duplicate reference"})
+		}
+		declared[reference] = true
+	}
+	for _, party := range msg.GetPartyList().GetParty() {
+		declare("/PieMessage/PartyList/Party/PartyReference", party.GetPartyReference())
+	}
+	for _, source := range msg.GetMetadataSourceList().GetMetadataSource() {
+		declare("/PieMessage/MetadataSourceList/MetadataSource/SourceReference", source.GetSourceReference())
+	}
+
+	index := pieReferenceIndex(msg)
+	walkScalars(msg, func(path string, field xmlField, value reflect.Value) {
+		if field.Attr || value.Kind() != reflect.String {
+			return
+		}
+		reference := strings.TrimSpace(value.String())
+		switch {
+		case field.Name == "PartyRelatedPartyReference":
+			if _, ok := index[reference].(*piev10.Party); !ok {
+				errs = append(errs, RefError{Path: path, Reference: reference, Message: "unresolved party reference"})
+			}
+		case field.CharData && strings.HasSuffix(path, "/MetadataSourceReference"):
+			if _, ok := index[reference].(*piev10.MetadataSource); !ok {
+				errs = append(errs, RefError{Path: path, Reference: reference, Message: "unresolved metadata source reference"})
+			}
+		}
+	})
+	return errs
+}
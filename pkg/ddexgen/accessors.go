@@ -0,0 +1,65 @@
+package ddexgen
+
+import (
+	"fmt"
+	"go/ast"
+	"strings"
+)
+
+// defaultAccessorTypes maps the scalar field types that get GetXxxOr accessors to their zero value
+var defaultAccessorTypes = map[string]string{
+	"string": `""`,
+	"int32":  "0",
+}
+
+// generateDefaultAccessors creates GetXxxOr(def) accessors for the string and int32 fields of the root
+// messages in a .pb.go file and of their message headers. Proto3 scalars are not pointers and the
+// generated GetXxx accessors are already nil-safe, so these only add a caller-chosen default for absent
+// (empty) values.
+func generateDefaultAccessors(pbPath string) (string, error) {
+	pkg, err := parseSchemaPackage(pbPath)
+	if err != nil {
+		return "", err
+	}
+
+	var types []string
+	seen := make(map[string]bool)
+	add := func(name string) {
+		if _, ok := pkg.Structs[name]; ok && !seen[name] {
+			seen[name] = true
+			types = append(types, name)
+		}
+	}
+	for _, root := range pkg.RootOrder {
+		add(root)
+		for _, field := range pkg.Structs[root] {
+			if star, ok := field.Type.(*ast.StarExpr); ok && field.GoName == "MessageHeader" {
+				if ident, ok := star.X.(*ast.Ident); ok {
+					add(ident.Name)
+				}
+			}
+		}
+	}
+
+	var sb strings.Builder
+	for _, name := range types {
+		for _, field := range pkg.Structs[name] {
+			ident, ok := field.Type.(*ast.Ident)
+			if !ok {
+				continue
+			}
+			zero, ok := defaultAccessorTypes[ident.Name]
+			if !ok {
+				continue
+			}
+			sb.WriteString(fmt.Sprintf("\n\n// Get%sOr returns %s, or def when it is empty or x is nil\n", field.GoName, field.GoName))
+			sb.WriteString(fmt.Sprintf("func (x *%s) Get%sOr(def %s) %s {\n", name, field.GoName, ident.Name, ident.Name))
+			sb.WriteString(fmt.Sprintf("\tif v := x.Get%s(); v != %s {\n", field.GoName, zero))
+			sb.WriteString("\t\treturn v\n")
+			sb.WriteString("\t}\n")
+			sb.WriteString("\treturn def\n")
+			sb.WriteString("}")
+		}
+	}
+	return sb.String(), nil
+}
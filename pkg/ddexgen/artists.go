@@ -0,0 +1,127 @@
+package ddexgen
+
+// displayArtistPartyMethod is AddDisplayArtist for ERN 4, where display artists reference a Party of the
+// PartyList
+const displayArtistPartyMethod = `
+
+// AddDisplayArtist adds a DisplayArtist with DisplayArtistRole role to the Release, referencing the Party
+// whose FullName is name. The Party is added to the PartyList, with the first free P<n> PartyReference,
+// unless one has that name. The Release's DisplayArtistName is left as is.
+func (m *NewReleaseMessage) AddDisplayArtist(name, role string) {
+	if m.PartyList == nil {
+		m.PartyList = &PartyList{}
+	}
+	if m.ReleaseList == nil {
+		m.ReleaseList = &ReleaseList{}
+	}
+	if m.ReleaseList.Release == nil {
+		m.ReleaseList.Release = &Release{}
+	}
+
+	reference := ""
+	taken := make(map[string]bool)
+	for _, party := range m.PartyList.Party {
+		taken[party.GetPartyReference()] = true
+		for _, partyName := range party.GetPartyName() {
+			if reference == "" && partyName.GetFullName().GetValue() == name {
+				reference = party.GetPartyReference()
+			}
+		}
+	}
+	for n := 1; reference == ""; n++ {
+		if candidate := fmt.Sprintf("P%d", n); !taken[candidate] {
+			reference = candidate
+			m.PartyList.Party = append(m.PartyList.Party, &Party{
+				PartyReference: reference,
+				PartyName:      []*PartyNameWithTerritory{{FullName: &Name{Value: name}}},
+			})
+		}
+	}
+
+	release := m.ReleaseList.Release
+	release.DisplayArtist = append(release.DisplayArtist, &DisplayArtist{
+		ArtistPartyReference: reference,
+		DisplayArtistRole:    &DisplayArtistRole{Value: role},
+		SequenceNumber:       int32(len(release.DisplayArtist) + 1),
+	})
+}`
+
+// displayArtistInlineMethod is AddDisplayArtist for ERN 3, where display artists are inline in the
+// territorial release details
+const displayArtistInlineMethod = `
+
+// AddDisplayArtist adds a DisplayArtist named name with ArtistRole role to each ReleaseDetailsByTerritory
+// of the main Release: the one flagged IsMainRelease, else the first. A Release, and Worldwide details for
+// it, are created if missing. The DisplayArtistNames are left as is.
+func (m *NewReleaseMessage) AddDisplayArtist(name, role string) {
+	if m.ReleaseList == nil {
+		m.ReleaseList = &ReleaseList{}
+	}
+	var release *Release
+	for _, candidate := range m.ReleaseList.Release {
+		if release == nil && candidate.GetIsMainRelease() {
+			release = candidate
+		}
+	}
+	if release == nil {
+		if len(m.ReleaseList.Release) == 0 {
+			m.ReleaseList.Release = append(m.ReleaseList.Release, &Release{IsMainRelease: true})
+		}
+		release = m.ReleaseList.Release[0]
+	}
+	if len(release.ReleaseDetailsByTerritory) == 0 {
+		release.ReleaseDetailsByTerritory = append(release.ReleaseDetailsByTerritory, &ReleaseDetailsByTerritory{
+			TerritoryCode: []*CurrentTerritoryCode{{Value: "Worldwide"}},
+		})
+	}
+
+	for _, details := range release.ReleaseDetailsByTerritory {
+		details.DisplayArtist = append(details.DisplayArtist, &Artist{
+			PartyName:      []*PartyName{{FullName: &Name{Value: name}}},
+			ArtistRole:     []*ArtistRole{{Value: role}},
+			SequenceNumber: int32(len(details.DisplayArtist) + 1),
+		})
+	}
+}`
+
+// generateDisplayArtistHelpers creates AddDisplayArtist for the NewReleaseMessage of a .pb.go file, in the
+// form its schema version needs: a Party in the PartyList referenced from the Release in ERN 4, an inline
+// Artist in the ReleaseDetailsByTerritory in ERN 3. Packages without a NewReleaseMessage of either shape
+// get nothing.
+func generateDisplayArtistHelpers(pbPath string) (string, error) {
+	pkg, err := parseSchemaPackage(pbPath)
+	if err != nil {
+		return "", err
+	}
+
+	hasFields := func(structName string, fieldNames ...string) bool {
+		fields, ok := pkg.Structs[structName]
+		if !ok {
+			return false
+		}
+		for _, fieldName := range fieldNames {
+			found := false
+			for _, field := range fields {
+				found = found || field.GoName == fieldName
+			}
+			if !found {
+				return false
+			}
+		}
+		return true
+	}
+
+	switch {
+	case !hasFields("NewReleaseMessage", "ReleaseList") || !hasFields("Name", "Value"):
+		return "", nil
+	case hasFields("NewReleaseMessage", "PartyList") && hasFields("Party", "PartyReference", "PartyName") &&
+		hasFields("PartyNameWithTerritory", "FullName") && hasFields("Release", "DisplayArtist") &&
+		hasFields("DisplayArtist", "ArtistPartyReference", "DisplayArtistRole", "SequenceNumber"):
+		return displayArtistPartyMethod, nil
+	case hasFields("Release", "ReleaseDetailsByTerritory", "IsMainRelease") &&
+		hasFields("ReleaseDetailsByTerritory", "DisplayArtist", "TerritoryCode") &&
+		hasFields("Artist", "PartyName", "ArtistRole", "SequenceNumber"):
+		return displayArtistInlineMethod, nil
+	}
+	return "", nil
+}
@@ -0,0 +1,162 @@
+package ddexgen
+
+import (
+	"encoding/xml"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+)
+
+// avsSchemaFiles maps each AVS package directory to the XSD it was generated from
+var avsSchemaFiles = map[string]string{
+	"vlatest":   "allowed-value-sets.xsd",
+	"v20200108": "avs_20200108.xsd",
+	"v20200518": "avs20200518.xsd",
+	"v20161006": "avs_20161006.xsd",
+}
+
+// enumValuePrefix returns the part of an enum's constant names between the type name and the value,
+// e.g. "ALL_TERRITORY_CODE_" for AllTerritoryCode_ALL_TERRITORY_CODE_AF
+func enumValuePrefix(enum EnumInfo) string {
+	for _, constant := range enum.Constants {
+		if strings.HasSuffix(constant, "_UNSPECIFIED") {
+			return strings.TrimSuffix(strings.TrimPrefix(constant, enum.Name+"_"), "UNSPECIFIED")
+		}
+	}
+	return strings.ToUpper(enum.Name) + "_"
+}
+
+// enumConstantCode returns the upper-cased code a constant stands for, or "" for the UNSPECIFIED value
+// and constants that do not follow the naming scheme
+func enumConstantCode(enum EnumInfo, prefix, constant string) string {
+	if strings.HasSuffix(constant, "_UNSPECIFIED") {
+		return ""
+	}
+	value := strings.TrimPrefix(constant, enum.Name+"_")
+	if !strings.HasPrefix(value, prefix) {
+		return ""
+	}
+	code := strings.TrimPrefix(value, prefix)
+	// Values starting with a digit were prefixed with E_ to form a valid identifier
+	if len(code) > 2 && strings.HasPrefix(code, "E_") && code[2] >= '0' && code[2] <= '9' {
+		code = code[2:]
+	}
+	return code
+}
+
+// isNumericCode reports whether an AVS value consists only of digits
+func isNumericCode(value string) bool {
+	if value == "" {
+		return false
+	}
+	for _, r := range value {
+		if r < '0' || r > '9' {
+			return false
+		}
+	}
+	return true
+}
+
+// loadNumericCodeAliases reads an AVS schema and returns, by enum name, the textual value each numeric
+// value stands for. A numeric value is an alias when another value of the same simple type has the same
+// definition apart from its source, e.g. territory "4" ("Afghanistan (Source: TIS Standard).") and
+// "AF" ("Afghanistan (Source:ISO 3166-1).").
+func loadNumericCodeAliases(schemaPath string) (map[string]map[string]string, error) {
+	file, err := os.Open(schemaPath)
+	if err != nil {
+		return nil, err
+	}
+	defer file.Close()
+
+	type codeDefinition struct {
+		value      string
+		definition string
+	}
+	definitions := make(map[string][]codeDefinition)
+
+	var simpleType, value string
+	var documentation strings.Builder
+	inDocumentation := false
+
+	decoder := xml.NewDecoder(file)
+	for {
+		tok, err := decoder.Token()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, fmt.Errorf("parsing %s: %w", schemaPath, err)
+		}
+
+		switch t := tok.(type) {
+		case xml.StartElement:
+			switch t.Name.Local {
+			case "simpleType":
+				simpleType = attrValue(t, "name")
+			case "enumeration":
+				value = attrValue(t, "value")
+			case "documentation":
+				inDocumentation = value != ""
+				documentation.Reset()
+			}
+		case xml.CharData:
+			if inDocumentation {
+				documentation.Write(t)
+			}
+		case xml.EndElement:
+			switch t.Name.Local {
+			case "documentation":
+				if inDocumentation && simpleType != "" {
+					definition := documentation.String()
+					if idx := strings.Index(definition, "(Source"); idx >= 0 {
+						definition = definition[:idx]
+					}
+					definitions[simpleType] = append(definitions[simpleType], codeDefinition{
+						value:      value,
+						definition: strings.Join(strings.Fields(definition), " "),
+					})
+				}
+				inDocumentation = false
+			case "enumeration":
+				value = ""
+			case "simpleType":
+				simpleType = ""
+			}
+		}
+	}
+
+	aliases := make(map[string]map[string]string)
+	for simpleType, codes := range definitions {
+		textual := make(map[string]string)
+		for _, code := range codes {
+			if !isNumericCode(code.value) && code.definition != "" {
+				if _, exists := textual[code.definition]; !exists {
+					textual[code.definition] = code.value
+				}
+			}
+		}
+		for _, code := range codes {
+			if !isNumericCode(code.value) {
+				continue
+			}
+			if target, ok := textual[code.definition]; ok {
+				if aliases[simpleType] == nil {
+					aliases[simpleType] = make(map[string]string)
+				}
+				aliases[simpleType][code.value] = target
+			}
+		}
+	}
+	return aliases, nil
+}
+
+// attrValue returns the value of an unqualified attribute of a start element
+func attrValue(start xml.StartElement, name string) string {
+	for _, attr := range start.Attr {
+		if attr.Name.Local == name {
+			return attr.Value
+		}
+	}
+	return ""
+}
@@ -2,8 +2,10 @@ package ddexgen
 
 import (
 	"bufio"
+	"encoding/json"
 	"fmt"
 	"go/ast"
+	"go/build/constraint"
 	"go/parser"
 	"go/token"
 	"log"
@@ -44,9 +46,139 @@ func extractModulePath(targetDir string) (string, error) {
 	return "", fmt.Errorf("go.mod not found")
 }
 
-// generateExtensions generates enum_strings.go, *.xml.go, and optionally registry.go files
-// If goPackagePrefix is provided, it's used; otherwise, the module path is extracted from go.mod
-func Generate(targetDir string, verbose bool, goPackagePrefix string) error {
+// Artifact is a kind of file produced by Generate
+type Artifact string
+
+const (
+	// ArtifactEnums is enum_strings.go in each package with enums
+	ArtifactEnums Artifact = "enums"
+	// ArtifactXML is <version>.xml.go in each package with messages
+	ArtifactXML Artifact = "xml"
+	// ArtifactRegistry is registry.go at the root of the target directory
+	ArtifactRegistry Artifact = "registry"
+	// ArtifactResources is resources.go in each package with resource types, declaring the Resource
+	// interface they implement
+	ArtifactResources Artifact = "resources"
+)
+
+// AllArtifacts lists every artifact kind Generate can produce
+var AllArtifacts = []Artifact{ArtifactEnums, ArtifactXML, ArtifactRegistry, ArtifactResources}
+
+// ParseArtifacts parses a comma-separated artifact list such as "registry,enums"
+func ParseArtifacts(list string) ([]Artifact, error) {
+	var artifacts []Artifact
+	for _, name := range strings.Split(list, ",") {
+		name = strings.TrimSpace(name)
+		if name == "" {
+			continue
+		}
+		artifact := Artifact(name)
+		switch artifact {
+		case ArtifactEnums, ArtifactXML, ArtifactRegistry, ArtifactResources:
+			artifacts = append(artifacts, artifact)
+		default:
+			return nil, fmt.Errorf("unknown artifact %q (valid: registry, enums, xml, resources)", name)
+		}
+	}
+	return artifacts, nil
+}
+
+// Options configures Generate
+type Options struct {
+	// Verbose enables progress logging
+	Verbose bool
+	// GoPackagePrefix is the import path prefix of the generated packages (e.g. github.com/user/repo/gen).
+	// If empty, it's derived from go.mod.
+	GoPackagePrefix string
+	// OutDir, if set, receives the generated files in a directory tree that mirrors the target directory,
+	// so the target only holds the .pb.go files (previously generated copies there must be removed to
+	// avoid duplicate declarations). Since a Go package cannot span directories, an overlay.json is
+	// written to OutDir for use with `go build -overlay`, which places each file back in its package.
+	OutDir string
+	// Only restricts generation to the listed artifacts; empty means all of them.
+	// Files of other kinds are left untouched.
+	Only []Artifact
+	// Verify runs `go build` on the target directory after generation and fails with the compiler
+	// output if the generated code does not compile
+	Verify bool
+	// BuildTags, if set, is a build constraint expression (e.g. "ddex && !nogen") written as a
+	// //go:build line at the top of every generated file
+	BuildTags string
+	// Banner replaces the "Code generated by generate-go-extensions. DO NOT EDIT." header of the generated
+	// files; each of its lines becomes a // comment. Go tools only recognize a generated file by a line
+	// matching `^// Code generated .* DO NOT EDIT\.$`, so custom banners should keep that form.
+	Banner string
+}
+
+// defaultBanner is the header of the generated files when Options.Banner is empty
+const defaultBanner = "Code generated by generate-go-extensions. DO NOT EDIT."
+
+// fileHeader returns the lines written before the package clause of every generated file: the
+// //go:build line if BuildTags is set, then the banner
+func (o Options) fileHeader() (string, error) {
+	var sb strings.Builder
+	if tags := strings.TrimSpace(o.BuildTags); tags != "" {
+		line := "//go:build " + tags
+		if _, err := constraint.Parse(line); err != nil {
+			return "", fmt.Errorf("invalid build tags %q: %w", tags, err)
+		}
+		sb.WriteString(line + "\n\n")
+	}
+	banner := strings.TrimSpace(o.Banner)
+	if banner == "" {
+		banner = defaultBanner
+	}
+	for _, line := range strings.Split(banner, "\n") {
+		sb.WriteString(strings.TrimRight("// "+strings.TrimSpace(line), " ") + "\n")
+	}
+	sb.WriteString("\n")
+	return sb.String(), nil
+}
+
+// produces reports whether an artifact kind is selected
+func (o Options) produces(artifact Artifact) bool {
+	if len(o.Only) == 0 {
+		return true
+	}
+	for _, selected := range o.Only {
+		if selected == artifact {
+			return true
+		}
+	}
+	return false
+}
+
+// Generate generates enum_strings.go, *.xml.go, resources.go, and optionally registry.go files for the
+// .pb.go files under targetDir. registry.go is only generated when the Go package prefix is known.
+func Generate(targetDir string, opts Options) error {
+	verbose := opts.Verbose
+	goPackagePrefix := opts.GoPackagePrefix
+	outDir := opts.OutDir
+
+	header, err := opts.fileHeader()
+	if err != nil {
+		return err
+	}
+
+	overlay := make(map[string]string)
+
+	// outputPath maps a file path under targetDir to where it is written
+	outputPath := func(path string) (string, error) {
+		if outDir == "" {
+			return path, nil
+		}
+		relPath, err := filepath.Rel(targetDir, path)
+		if err != nil {
+			return "", fmt.Errorf("failed to get relative path: %w", err)
+		}
+		outPath := filepath.Join(outDir, relPath)
+		if err := os.MkdirAll(filepath.Dir(outPath), 0755); err != nil {
+			return "", err
+		}
+		overlay[path] = outPath
+		return outPath, nil
+	}
+
 	// If goPackagePrefix is not provided, try to extract it from go.mod
 	if goPackagePrefix == "" {
 		modulePath, err := extractModulePath(targetDir)
@@ -62,7 +194,7 @@ func Generate(targetDir string, verbose bool, goPackagePrefix string) error {
 	var allPackages []PackageInfo
 
 	// Find all generated protobuf packages
-	err := filepath.Walk(targetDir, func(path string, info os.FileInfo, err error) error {
+	err = filepath.Walk(targetDir, func(path string, info os.FileInfo, err error) error {
 		if err != nil {
 			return err
 		}
@@ -87,9 +219,24 @@ func Generate(targetDir string, verbose bool, goPackagePrefix string) error {
 				return fmt.Errorf("parsing messages %s: %w", path, err)
 			}
 
+			// Numeric AVS codes may stand for a textual code of the same enum
+			if schemaFile, ok := avsSchemaFiles[filepath.Base(packageDir)]; ok && len(enums) > 0 {
+				aliases, err := loadNumericCodeAliases(filepath.Join("xsd", schemaFile))
+				if err != nil && verbose {
+					log.Printf("Warning: Could not read numeric AVS codes for %s: %v", packageDir, err)
+				}
+				for i := range enums {
+					enums[i].NumericAliases = aliases[enums[i].Name]
+				}
+			}
+
 			// Generate enum strings file if there are enums
-			if len(enums) > 0 {
-				err = generateEnumStringsFile(packageDir, packageName, enums)
+			if len(enums) > 0 && opts.produces(ArtifactEnums) {
+				enumStringsPath, err := outputPath(filepath.Join(packageDir, "enum_strings.go"))
+				if err != nil {
+					return err
+				}
+				err = generateEnumStringsFile(enumStringsPath, header, packageName, enums)
 				if err != nil {
 					return fmt.Errorf("generating enum strings file for %s: %w", packageDir, err)
 				}
@@ -99,8 +246,13 @@ func Generate(targetDir string, verbose bool, goPackagePrefix string) error {
 			}
 
 			// Generate single XML file for all messages in the package
-			if len(messages) > 0 {
-				err = generatePackageXMLFile(packageDir, packageName, messages)
+			if len(messages) > 0 && opts.produces(ArtifactXML) {
+				// Use directory name for XML filename (e.g., v432.xml.go from .../v432/ directory)
+				xmlPath, err := outputPath(filepath.Join(packageDir, filepath.Base(packageDir)+".xml.go"))
+				if err != nil {
+					return err
+				}
+				err = generatePackageXMLFile(xmlPath, header, path, packageDir, packageName, messages)
 				if err != nil {
 					return fmt.Errorf("generating XML file for package %s: %w", packageDir, err)
 				}
@@ -110,6 +262,24 @@ func Generate(targetDir string, verbose bool, goPackagePrefix string) error {
 				}
 			}
 
+			// Generate the Resource interface for packages with resource types
+			if len(messages) > 0 && opts.produces(ArtifactResources) {
+				resourcesPath, err := outputPath(filepath.Join(packageDir, "resources.go"))
+				if err != nil {
+					return err
+				}
+				generated, err := generateResourcesFile(resourcesPath, header, path, packageName)
+				if err != nil {
+					return fmt.Errorf("generating resources file for %s: %w", packageDir, err)
+				}
+				if !generated {
+					delete(overlay, filepath.Join(packageDir, "resources.go"))
+				}
+				if generated && verbose {
+					log.Printf("Generated resources.go for package %s", packageName)
+				}
+			}
+
 			// Collect package info for registry generation (only DDEX packages with messages)
 			if len(messages) > 0 && strings.Contains(packageDir, "ddex") {
 				nsInfo := deriveNamespaceInfo(packageDir)
@@ -123,12 +293,25 @@ func Generate(targetDir string, verbose bool, goPackagePrefix string) error {
 					relPath = filepath.ToSlash(relPath)
 					importPath := goPackagePrefix + "/" + relPath
 
+					// Deprecations are optional: without the XSD the package simply has none
+					schemaPath := filepath.Join("xsd", nsInfo.NamespacePrefix+extractVersionFromPath(packageDir), nsInfo.SchemaFile)
+					deprecated, err := deprecationsForPackage(path, schemaPath)
+					if err != nil && verbose {
+						log.Printf("Warning: Could not read deprecations for %s: %v", packageDir, err)
+					}
+					elementOrder, err := elementOrderForPackage(path, schemaPath)
+					if err != nil && verbose {
+						log.Printf("Warning: Could not read content models for %s: %v", packageDir, err)
+					}
+
 					allPackages = append(allPackages, PackageInfo{
-						Dir:         packageDir,
-						PackageName: packageName,
-						ImportPath:  importPath,
-						Messages:    messages,
-						Namespace:   nsInfo,
+						Dir:          packageDir,
+						PackageName:  packageName,
+						ImportPath:   importPath,
+						Messages:     messages,
+						Namespace:    nsInfo,
+						Deprecated:   deprecated,
+						ElementOrder: elementOrder,
 					})
 				}
 			}
@@ -142,9 +325,12 @@ func Generate(targetDir string, verbose bool, goPackagePrefix string) error {
 	}
 
 	// Generate dynamic registry file
-	if len(allPackages) > 0 {
-		registryPath := filepath.Join(targetDir, "registry.go")
-		err = generateRegistryFileAtPath(registryPath, allPackages)
+	if len(allPackages) > 0 && opts.produces(ArtifactRegistry) {
+		registryPath, err := outputPath(filepath.Join(targetDir, "registry.go"))
+		if err != nil {
+			return err
+		}
+		err = generateRegistryFileAtPath(registryPath, header, allPackages)
 		if err != nil {
 			return fmt.Errorf("generating registry: %w", err)
 		}
@@ -153,9 +339,49 @@ func Generate(targetDir string, verbose bool, goPackagePrefix string) error {
 		}
 	}
 
+	if outDir != "" {
+		if err := writeOverlay(outDir, overlay); err != nil {
+			return fmt.Errorf("writing overlay: %w", err)
+		}
+		if verbose {
+			log.Printf("Generated overlay.json with %d files", len(overlay))
+		}
+	}
+
+	if opts.Verify {
+		if err := verifyBuild(targetDir, outDir); err != nil {
+			return err
+		}
+		if verbose {
+			log.Printf("Verified generated code compiles")
+		}
+	}
+
 	return nil
 }
 
+// writeOverlay writes a `go build -overlay` file mapping generated files to their package directories
+func writeOverlay(outDir string, replace map[string]string) error {
+	absReplace := make(map[string]string, len(replace))
+	for path, outPath := range replace {
+		absPath, err := filepath.Abs(path)
+		if err != nil {
+			return err
+		}
+		absOutPath, err := filepath.Abs(outPath)
+		if err != nil {
+			return err
+		}
+		absReplace[absPath] = absOutPath
+	}
+
+	data, err := json.MarshalIndent(map[string]map[string]string{"Replace": absReplace}, "", "  ")
+	if err != nil {
+		return err
+	}
+	return os.WriteFile(filepath.Join(outDir, "overlay.json"), append(data, '\n'), 0644)
+}
+
 // extractPackageName reads the package declaration from a Go file
 func extractPackageName(filename string) (string, error) {
 	fset := token.NewFileSet()
@@ -228,21 +454,27 @@ func findEnumConstants(node *ast.File, enumTypeName string) []string {
 type EnumInfo struct {
 	Name      string
 	Constants []string
+	// NumericAliases maps numeric AVS codes to the textual code they stand for
+	NumericAliases map[string]string
 }
 
 type MessageInfo struct {
 	Name string
+	// Oneofs is set when the message has oneof fields, which are marshaled through a shadow struct
+	Oneofs *oneofStruct
 }
 
 type PackageInfo struct {
-	Dir         string
-	PackageName string
-	ImportPath  string
-	Messages    []MessageInfo
-	Namespace   *NamespaceInfo
+	Dir          string
+	PackageName  string
+	ImportPath   string
+	Messages     []MessageInfo
+	Namespace    *NamespaceInfo
+	Deprecated   map[string][]string // struct name -> deprecated child elements and attributes
+	ElementOrder map[string][]string // struct name -> child elements in schema sequence order
 }
 
-// findMessageTypes parses a .pb.go file and extracts main message types
+// findMessageTypes parses a .pb.go file and extracts main message types and the messages with oneof fields
 func findMessageTypes(filename string) ([]MessageInfo, error) {
 	fset := token.NewFileSet()
 	node, err := parser.ParseFile(fset, filename, nil, parser.ParseComments)
@@ -250,6 +482,12 @@ func findMessageTypes(filename string) ([]MessageInfo, error) {
 		return nil, err
 	}
 
+	// Messages with oneof fields need XML methods too, whatever their name
+	oneofs, err := findOneofStructs(filename)
+	if err != nil {
+		return nil, err
+	}
+
 	var messages []MessageInfo
 
 	// Look for main message type definitions (ones ending with "Message")
@@ -262,9 +500,10 @@ func findMessageTypes(filename string) ([]MessageInfo, error) {
 						if _, ok := ts.Type.(*ast.StructType); ok {
 							// Found a struct type - check if it's a main message type
 							messageName := ts.Name.Name
-							if strings.HasSuffix(messageName, "Message") {
+							if strings.HasSuffix(messageName, "Message") || oneofs[messageName] != nil {
 								messages = append(messages, MessageInfo{
-									Name: messageName,
+									Name:   messageName,
+									Oneofs: oneofs[messageName],
 								})
 							}
 						}
@@ -278,31 +517,38 @@ func findMessageTypes(filename string) ([]MessageInfo, error) {
 }
 
 // generateEnumStringsFile creates an enum_strings.go file with String() methods and parsers
-func generateEnumStringsFile(packageDir, packageName string, enums []EnumInfo) error {
-	content := generateEnumStringsContent(packageName, enums)
-
-	enumStringsPath := filepath.Join(packageDir, "enum_strings.go")
+func generateEnumStringsFile(enumStringsPath, header, packageName string, enums []EnumInfo) error {
+	content := generateEnumStringsContent(header, packageName, enums)
 	return os.WriteFile(enumStringsPath, []byte(content), 0644)
 }
 
-// generatePackageXMLFile creates a single XML file for all messages in a package
-func generatePackageXMLFile(packageDir, packageName string, messages []MessageInfo) error {
-	content := generatePackageXMLContent(packageDir, packageName, messages)
-
-	// Use directory name for XML filename (e.g., v432.xml.go from .../v432/ directory)
-	// Package name stays as is (e.g., ernv432)
-	baseFileName := filepath.Base(packageDir)
-	xmlFileName := baseFileName + ".xml.go"
-	xmlPath := filepath.Join(packageDir, xmlFileName)
-	return os.WriteFile(xmlPath, []byte(content), 0644)
+// generatePackageXMLFile creates a single XML file for all messages in a package, followed by the
+// GetXxxOr accessors of its root messages and headers, the FieldToElement map and, for release
+// notifications, AddDisplayArtist
+// Package name stays as is (e.g., ernv432); packageDir is used to derive namespace info
+func generatePackageXMLFile(xmlPath, header, pbPath, packageDir, packageName string, messages []MessageInfo) error {
+	content := generatePackageXMLContent(header, packageDir, packageName, messages)
+	accessors, err := generateDefaultAccessors(pbPath)
+	if err != nil {
+		return err
+	}
+	fieldMap, err := generateFieldToElement(pbPath)
+	if err != nil {
+		return err
+	}
+	artists, err := generateDisplayArtistHelpers(pbPath)
+	if err != nil {
+		return err
+	}
+	return os.WriteFile(xmlPath, []byte(content+accessors+fieldMap+artists), 0644)
 }
 
 // generateEnumStringsContent creates the content for enum_strings.go
-func generateEnumStringsContent(packageName string, enums []EnumInfo) string {
+func generateEnumStringsContent(header, packageName string, enums []EnumInfo) string {
 	var sb strings.Builder
 
 	// Package header
-	sb.WriteString(fmt.Sprintf("// Code generated by generate-go-extensions. DO NOT EDIT.\n\n"))
+	sb.WriteString(header)
 	sb.WriteString(fmt.Sprintf("package %s\n\n", packageName))
 
 	if len(enums) > 0 {
@@ -403,11 +649,11 @@ func checkAVSImport(messageType, versionNumber, schemaFile string) bool {
 }
 
 // generatePackageXMLContent creates the content for a package XML file
-func generatePackageXMLContent(packageDir, packageName string, messages []MessageInfo) string {
+func generatePackageXMLContent(header, packageDir, packageName string, messages []MessageInfo) string {
 	var sb strings.Builder
 
 	// Package header
-	sb.WriteString(fmt.Sprintf("// Code generated by generate-go-extensions. DO NOT EDIT.\n\n"))
+	sb.WriteString(header)
 	sb.WriteString(fmt.Sprintf("package %s\n\n", packageName))
 
 	// Derive namespace info from package path first to check if we need strings import
@@ -428,8 +674,13 @@ func generatePackageXMLContent(packageDir, packageName string, messages []Messag
 	if needsStrings {
 		sb.WriteString("import (\n")
 		sb.WriteString("\t\"encoding/xml\"\n")
+		sb.WriteString("\t\"fmt\"\n")
 		sb.WriteString("\t\"reflect\"\n")
+		sb.WriteString("\t\"runtime\"\n")
+		sb.WriteString("\t\"sort\"\n")
 		sb.WriteString("\t\"strings\"\n")
+		sb.WriteString("\t\"sync\"\n")
+		sb.WriteString("\t\"weak\"\n")
 		sb.WriteString(")\n\n")
 	} else {
 		sb.WriteString("import \"encoding/xml\"\n\n")
@@ -445,6 +696,11 @@ func generatePackageXMLContent(packageDir, packageName string, messages []Messag
 		}
 		sb.WriteString(")\n\n")
 	}
+	if needsStrings {
+		sb.WriteString("// parseWarnings holds the ParseWarnings of root messages by weak pointer, as the generated message\n")
+		sb.WriteString("// structs have no field for them\n")
+		sb.WriteString("var parseWarnings sync.Map\n\n")
+	}
 
 	// Generate XML marshaling methods for all messages in the package
 	for i, message := range messages {
@@ -452,6 +708,10 @@ func generatePackageXMLContent(packageDir, packageName string, messages []Messag
 			sb.WriteString("\n\n")
 		}
 		sb.WriteString(generateXMLMarshalingMethods(message, nsInfo))
+		if nsInfo != nil && isRootMessage(message.Name) {
+			sb.WriteString("\n\n")
+			sb.WriteString(generateNamespaceAttrMethods(message))
+		}
 	}
 
 	return sb.String()
@@ -465,21 +725,12 @@ func generateEnumStringMethod(enum EnumInfo) string {
 	sb.WriteString(fmt.Sprintf("func (e %s) XMLString() string {\n", enum.Name))
 	sb.WriteString("\tswitch e {\n")
 
-	// Generate cases for each constant
+	// Generate cases for each constant, skipping UNSPECIFIED
+	prefix := enumValuePrefix(enum)
 	for _, constant := range enum.Constants {
-		if strings.HasSuffix(constant, "_UNSPECIFIED") {
-			continue // Skip UNSPECIFIED values
-		}
-
-		// Extract the meaningful part of the constant name
-		upperName := strings.ToUpper(enum.Name)
-		idx := strings.LastIndex(constant, upperName+"_")
-		if idx >= 0 {
-			afterPrefix := constant[idx+len(upperName)+1:]
-			if afterPrefix != "" && afterPrefix != "UNSPECIFIED" {
-				sb.WriteString(fmt.Sprintf("\tcase %s:\n", constant))
-				sb.WriteString(fmt.Sprintf("\t\treturn \"%s\"\n", afterPrefix))
-			}
+		if code := enumConstantCode(enum, prefix, constant); code != "" {
+			sb.WriteString(fmt.Sprintf("\tcase %s:\n", constant))
+			sb.WriteString(fmt.Sprintf("\t\treturn \"%s\"\n", code))
 		}
 	}
 
@@ -491,36 +742,40 @@ func generateEnumStringMethod(enum EnumInfo) string {
 	return sb.String()
 }
 
-// generateEnumParser creates the parser function for an enum
+// generateEnumParser creates the parser function for an enum. Numeric codes that the AVS defines as
+// another form of a textual code parse to the textual code's constant.
 func generateEnumParser(enum EnumInfo) string {
 	var sb strings.Builder
 
 	sb.WriteString(fmt.Sprintf("// Parse%sString parses a string value to %s enum (case-insensitive)\n", enum.Name, enum.Name))
+	if len(enum.NumericAliases) > 0 {
+		sb.WriteString("// Numeric codes are accepted as well and map to the constant of the code they stand for\n")
+	}
 	sb.WriteString(fmt.Sprintf("func Parse%sString(s string) (%s, bool) {\n", enum.Name, enum.Name))
 	sb.WriteString("\ts = strings.ToUpper(s)\n")
 	sb.WriteString("\tswitch s {\n")
 
-	// Generate cases for each constant
+	prefix := enumValuePrefix(enum)
+	constantsByKey := make(map[string]string)
+	for _, constant := range enum.Constants {
+		if code := enumConstantCode(enum, prefix, constant); code != "" {
+			constantsByKey[avsCodeKey(code)] = constant
+		}
+	}
+
+	// Generate cases for each constant, skipping UNSPECIFIED
 	for _, constant := range enum.Constants {
-		if strings.HasSuffix(constant, "_UNSPECIFIED") {
-			continue // Skip UNSPECIFIED values
-		}
-
-		// Extract the meaningful part of the constant name
-		// Try to find the enum pattern: EnumName_ENUM_NAME_VALUE
-		// We'll look for the last occurrence of the enum name in uppercase
-		upperName := strings.ToUpper(enum.Name)
-
-		// Find the pattern EnumName_..._VALUE
-		idx := strings.LastIndex(constant, upperName+"_")
-		if idx >= 0 {
-			// Skip past "EnumName_..._" to get the value part
-			afterPrefix := constant[idx+len(upperName)+1:]
-			if afterPrefix != "" && afterPrefix != "UNSPECIFIED" {
-				sb.WriteString(fmt.Sprintf("\tcase \"%s\":\n", afterPrefix))
-				sb.WriteString(fmt.Sprintf("\t\treturn %s, true\n", constant))
+		code := enumConstantCode(enum, prefix, constant)
+		if code == "" {
+			continue
+		}
+		if alias, ok := enum.NumericAliases[code]; ok {
+			if target, ok := constantsByKey[avsCodeKey(alias)]; ok {
+				constant = target
 			}
 		}
+		sb.WriteString(fmt.Sprintf("\tcase \"%s\":\n", code))
+		sb.WriteString(fmt.Sprintf("\t\treturn %s, true\n", constant))
 	}
 
 	sb.WriteString("\tdefault:\n")
@@ -531,10 +786,21 @@ func generateEnumParser(enum EnumInfo) string {
 	return sb.String()
 }
 
-// generateXMLMarshalingMethods creates MarshalXML and UnmarshalXML methods for message types
+// generateXMLMarshalingMethods creates MarshalXML and UnmarshalXML methods for message types. Messages
+// with oneof fields are encoded through a generated shadow struct (see generateOneofShadow).
 func generateXMLMarshalingMethods(message MessageInfo, nsInfo *NamespaceInfo) string {
 	var sb strings.Builder
 
+	// Root messages cache the attributes their struct fields handle
+	if nsInfo != nil && isRootMessage(message.Name) {
+		sb.WriteString(fmt.Sprintf("// %s caches the attributes that struct fields of %s handle, which MarshalXML\n", attrsName(message.Name), message.Name))
+		sb.WriteString("// must not repeat from NamespaceAttrs\n")
+		sb.WriteString("var (\n")
+		sb.WriteString(fmt.Sprintf("\t%s sync.Once\n", attrsOnceName(message.Name)))
+		sb.WriteString(fmt.Sprintf("\t%s map[string]bool\n", attrsName(message.Name)))
+		sb.WriteString(")\n\n")
+	}
+
 	// Generate MarshalXML method
 	sb.WriteString(fmt.Sprintf("// MarshalXML implements xml.Marshaler for %s\n", message.Name))
 	sb.WriteString(fmt.Sprintf("func (m *%s) MarshalXML(e *xml.Encoder, start xml.StartElement) error {\n", message.Name))
@@ -554,41 +820,49 @@ func generateXMLMarshalingMethods(message MessageInfo, nsInfo *NamespaceInfo) st
 		sb.WriteString("\tstart.Name.Space = Namespace\n\n")
 
 		// Add namespace attributes to the start element
-		sb.WriteString("\t// Add namespace attributes to the element, avoiding duplicates\n")
-		sb.WriteString("\t// Use reflection to find which attributes are already handled by struct fields\n")
-		sb.WriteString("\texistingAttrs := make(map[string]bool)\n")
-		sb.WriteString("\tv := reflect.ValueOf(m).Elem()\n")
-		sb.WriteString("\tt := v.Type()\n")
-		sb.WriteString("\tfor i := 0; i < v.NumField(); i++ {\n")
-		sb.WriteString("\t\tfield := t.Field(i)\n")
-		sb.WriteString("\t\tif xmlTag := field.Tag.Get(\"xml\"); xmlTag != \"\" && xmlTag != \"-\" {\n")
-		sb.WriteString("\t\t\t// Parse the XML tag to get the attribute name\n")
-		sb.WriteString("\t\t\tif strings.HasSuffix(xmlTag, \",attr\") {\n")
-		sb.WriteString("\t\t\t\tattrName := strings.TrimSuffix(xmlTag, \",attr\")\n")
-		sb.WriteString("\t\t\t\tif colonIdx := strings.Index(attrName, \":\"); colonIdx >= 0 {\n")
-		sb.WriteString("\t\t\t\t\t// For tags like \"xmlns:ern,attr\" or \"xsi:schemaLocation,attr\"\n")
-		sb.WriteString("\t\t\t\t\texistingAttrs[attrName] = true\n")
-		sb.WriteString("\t\t\t\t} else if attrName != \"\" {\n")
-		sb.WriteString("\t\t\t\t\t// For tags like \"LanguageAndScriptCode,attr\"\n")
-		sb.WriteString("\t\t\t\t\texistingAttrs[attrName] = true\n")
+		sb.WriteString("\t// Add namespace attributes to the element, avoiding duplicates. The attributes already handled by\n")
+		sb.WriteString("\t// struct fields are fixed per type, so they are found by reflection once and cached\n")
+		sb.WriteString(fmt.Sprintf("\t%s.Do(func() {\n", attrsOnceName(message.Name)))
+		sb.WriteString(fmt.Sprintf("\t\t%s = make(map[string]bool)\n", attrsName(message.Name)))
+		sb.WriteString("\t\tt := reflect.TypeOf(m).Elem()\n")
+		sb.WriteString("\t\tfor i := 0; i < t.NumField(); i++ {\n")
+		sb.WriteString("\t\t\tfield := t.Field(i)\n")
+		sb.WriteString("\t\t\tif xmlTag := field.Tag.Get(\"xml\"); xmlTag != \"\" && xmlTag != \"-\" {\n")
+		sb.WriteString("\t\t\t\t// Parse the XML tag to get the attribute name, from tags like \"xmlns:ern,attr\",\n")
+		sb.WriteString("\t\t\t\t// \"xsi:schemaLocation,attr\" or \"LanguageAndScriptCode,attr\"\n")
+		sb.WriteString("\t\t\t\tif strings.HasSuffix(xmlTag, \",attr\") {\n")
+		sb.WriteString("\t\t\t\t\tif attrName := strings.TrimSuffix(xmlTag, \",attr\"); attrName != \"\" {\n")
+		sb.WriteString(fmt.Sprintf("\t\t\t\t\t\t%s[attrName] = true\n", attrsName(message.Name)))
+		sb.WriteString("\t\t\t\t\t}\n")
 		sb.WriteString("\t\t\t\t}\n")
 		sb.WriteString("\t\t\t}\n")
 		sb.WriteString("\t\t}\n")
-		sb.WriteString("\t}\n\n")
-		sb.WriteString("\t// Add attributes from the map that aren't already handled\n")
-		sb.WriteString("\tfor key, value := range m.NamespaceAttrs {\n")
+		sb.WriteString("\t})\n")
+		sb.WriteString(fmt.Sprintf("\texistingAttrs := %s\n\n", attrsName(message.Name)))
+		sb.WriteString("\t// Add attributes from the map that aren't already handled, sorted by key\n")
+		sb.WriteString("\t// so that marshaled output is deterministic\n")
+		sb.WriteString("\tkeys := make([]string, 0, len(m.NamespaceAttrs))\n")
+		sb.WriteString("\tfor key := range m.NamespaceAttrs {\n")
+		sb.WriteString("\t\tkeys = append(keys, key)\n")
+		sb.WriteString("\t}\n")
+		sb.WriteString("\tsort.Strings(keys)\n")
+		sb.WriteString("\tfor _, key := range keys {\n")
 		sb.WriteString("\t\tif !existingAttrs[key] {\n")
 		sb.WriteString("\t\t\tstart.Attr = append(start.Attr, xml.Attr{\n")
 		sb.WriteString("\t\t\t\tName: xml.Name{Local: key},\n")
-		sb.WriteString("\t\t\t\tValue: value,\n")
+		sb.WriteString("\t\t\t\tValue: m.NamespaceAttrs[key],\n")
 		sb.WriteString("\t\t\t})\n")
 		sb.WriteString("\t\t}\n")
 		sb.WriteString("\t}\n\n")
 	}
 
-	sb.WriteString("\t// Create an alias type to avoid infinite recursion\n")
-	sb.WriteString(fmt.Sprintf("\ttype alias %s\n", message.Name))
-	sb.WriteString("\treturn e.EncodeElement((*alias)(m), start)\n")
+	if message.Oneofs != nil {
+		sb.WriteString(generateOneofMarshal(message.Name, message.Oneofs))
+	} else {
+		sb.WriteString("\t// Create an alias type to avoid infinite recursion\n")
+		sb.WriteString(fmt.Sprintf("\ttype alias %s\n", message.Name))
+		sb.WriteString("\treturn e.EncodeElement((*alias)(m), start)\n")
+	}
 	sb.WriteString("}\n\n")
 
 	// Generate UnmarshalXML method
@@ -601,6 +875,9 @@ func generateXMLMarshalingMethods(message MessageInfo, nsInfo *NamespaceInfo) st
 		sb.WriteString("\tif m.NamespaceAttrs == nil {\n")
 		sb.WriteString("\t\tm.NamespaceAttrs = make(map[string]string)\n")
 		sb.WriteString("\t}\n")
+		sb.WriteString("\t// Namespace declarations of this element, to report conflicting ones as parse warnings\n")
+		sb.WriteString("\tvar warnings []string\n")
+		sb.WriteString("\tdeclared := make(map[string]string)\n")
 		sb.WriteString("\tfor _, attr := range start.Attr {\n")
 		sb.WriteString("\t\t// Capture all xmlns:* attributes and xsi:schemaLocation\n")
 		sb.WriteString("\t\tif attr.Name.Space == \"xmlns\" || attr.Name.Local == \"xmlns\" ||\n")
@@ -613,21 +890,97 @@ func generateXMLMarshalingMethods(message MessageInfo, nsInfo *NamespaceInfo) st
 		sb.WriteString("\t\t\t\t// Preserve the namespace prefix for attributes like xsi:schemaLocation\n")
 		sb.WriteString("\t\t\t\tif attr.Name.Space == \"http://www.w3.org/2001/XMLSchema-instance\" {\n")
 		sb.WriteString("\t\t\t\t\tkey = \"xsi:\" + attr.Name.Local\n")
+		sb.WriteString("\t\t\t\t\t// The document may bind XMLSchema-instance to another prefix; the attribute is\n")
+		sb.WriteString("\t\t\t\t\t// always written back as xsi:, so make sure that prefix is declared\n")
+		sb.WriteString("\t\t\t\t\tif _, ok := m.NamespaceAttrs[\"xmlns:xsi\"]; !ok {\n")
+		sb.WriteString("\t\t\t\t\t\tm.NamespaceAttrs[\"xmlns:xsi\"] = attr.Name.Space\n")
+		sb.WriteString("\t\t\t\t\t}\n")
+		sb.WriteString("\t\t\t\t}\n")
+		sb.WriteString("\t\t\t}\n")
+		sb.WriteString("\t\t\tif key == \"xmlns\" || strings.HasPrefix(key, \"xmlns:\") {\n")
+		sb.WriteString("\t\t\t\t// The map keeps only the last declaration of a prefix, so record the conflict\n")
+		sb.WriteString("\t\t\t\tif previous, ok := declared[key]; ok && previous != attr.Value {\n")
+		sb.WriteString("\t\t\t\t\twarnings = append(warnings, fmt.Sprintf(\"%s is declared twice, as %q and %q\", key, previous, attr.Value))\n")
 		sb.WriteString("\t\t\t\t}\n")
+		sb.WriteString("\t\t\t\tdeclared[key] = attr.Value\n")
 		sb.WriteString("\t\t\t}\n")
 		sb.WriteString("\t\t\tm.NamespaceAttrs[key] = attr.Value\n")
 		sb.WriteString("\t\t}\n")
+		sb.WriteString("\t}\n")
+		sb.WriteString("\t// Replace the warnings of any earlier parse into m; the entry is dropped when m is collected\n")
+		sb.WriteString("\tref := weak.Make(m)\n")
+		sb.WriteString("\tparseWarnings.Delete(ref)\n")
+		sb.WriteString("\tif len(warnings) > 0 {\n")
+		sb.WriteString("\t\tparseWarnings.Store(ref, warnings)\n")
+		sb.WriteString(fmt.Sprintf("\t\truntime.AddCleanup(m, func(ref weak.Pointer[%s]) { parseWarnings.Delete(ref) }, ref)\n", message.Name))
 		sb.WriteString("\t}\n\n")
 	}
 
-	sb.WriteString("\t// Create an alias type to avoid infinite recursion\n")
-	sb.WriteString(fmt.Sprintf("\ttype alias %s\n", message.Name))
-	sb.WriteString("\treturn d.DecodeElement((*alias)(m), &start)\n")
+	if message.Oneofs != nil {
+		sb.WriteString(generateOneofUnmarshal(message.Name, message.Oneofs))
+		sb.WriteString("}\n\n")
+		sb.WriteString(generateOneofShadow(message.Name, message.Oneofs))
+	} else {
+		sb.WriteString("\t// Create an alias type to avoid infinite recursion\n")
+		sb.WriteString(fmt.Sprintf("\ttype alias %s\n", message.Name))
+		sb.WriteString("\treturn d.DecodeElement((*alias)(m), &start)\n")
+		sb.WriteString("}")
+	}
+
+	return sb.String()
+}
+
+// generateNamespaceAttrMethods creates SetNamespaceAttr and GetNamespaceAttr for a root message, so
+// callers don't have to initialize or key the NamespaceAttrs map themselves
+func generateNamespaceAttrMethods(message MessageInfo) string {
+	var sb strings.Builder
+
+	sb.WriteString("// SetNamespaceAttr sets an attribute written on the root element. key is the attribute's qualified\n")
+	sb.WriteString("// name as it appears in the document: \"xmlns\" for the default namespace, \"xmlns:<prefix>\" for a\n")
+	sb.WriteString("// namespace declaration such as \"xmlns:avs\", or \"xsi:schemaLocation\".\n")
+	sb.WriteString(fmt.Sprintf("func (m *%s) SetNamespaceAttr(key, value string) {\n", message.Name))
+	sb.WriteString("\tif m.NamespaceAttrs == nil {\n")
+	sb.WriteString("\t\tm.NamespaceAttrs = make(map[string]string)\n")
+	sb.WriteString("\t}\n")
+	sb.WriteString("\tm.NamespaceAttrs[key] = value\n")
+	sb.WriteString("}\n\n")
+
+	sb.WriteString("// GetNamespaceAttr returns the root element attribute stored under key (see SetNamespaceAttr) and\n")
+	sb.WriteString("// whether it is set\n")
+	sb.WriteString(fmt.Sprintf("func (m *%s) GetNamespaceAttr(key string) (string, bool) {\n", message.Name))
+	sb.WriteString("\tif m == nil {\n")
+	sb.WriteString("\t\treturn \"\", false\n")
+	sb.WriteString("\t}\n")
+	sb.WriteString("\tvalue, ok := m.NamespaceAttrs[key]\n")
+	sb.WriteString("\treturn value, ok\n")
+	sb.WriteString("}\n\n")
+
+	sb.WriteString("// ParseWarnings returns the problems UnmarshalXML found in the root element without failing the\n")
+	sb.WriteString("// parse, namely namespace prefixes declared twice with different namespaces, of which NamespaceAttrs\n")
+	sb.WriteString("// keeps only the last\n")
+	sb.WriteString(fmt.Sprintf("func (m *%s) ParseWarnings() []string {\n", message.Name))
+	sb.WriteString("\tif m == nil {\n")
+	sb.WriteString("\t\treturn nil\n")
+	sb.WriteString("\t}\n")
+	sb.WriteString("\tif warnings, ok := parseWarnings.Load(weak.Make(m)); ok {\n")
+	sb.WriteString("\t\treturn warnings.([]string)\n")
+	sb.WriteString("\t}\n")
+	sb.WriteString("\treturn nil\n")
 	sb.WriteString("}")
 
 	return sb.String()
 }
 
+// attrsName is the variable caching the struct field attributes of a root message
+func attrsName(message string) string {
+	return strings.ToLower(message[:1]) + message[1:] + "Attrs"
+}
+
+// attrsOnceName is the sync.Once guarding attrsName
+func attrsOnceName(message string) string {
+	return attrsName(message) + "Once"
+}
+
 // isRootMessage determines if a message type is a root message that needs namespace handling
 func isRootMessage(messageName string) bool {
 	switch messageName {
@@ -639,11 +992,11 @@ func isRootMessage(messageName string) bool {
 }
 
 // generateRegistryFile creates a registry.go file with dynamic message type registration
-func generateRegistryFileAtPath(registryPath string, packages []PackageInfo) error {
+func generateRegistryFileAtPath(registryPath, header string, packages []PackageInfo) error {
 	var sb strings.Builder
 
 	// Package header
-	sb.WriteString("// Code generated by generate-go-extensions. DO NOT EDIT.\n\n")
+	sb.WriteString(header)
 	sb.WriteString("package gen\n\n")
 
 	// Imports
@@ -651,6 +1004,7 @@ func generateRegistryFileAtPath(registryPath string, packages []PackageInfo) err
 	sb.WriteString("\t\"encoding/xml\"\n")
 	sb.WriteString("\t\"fmt\"\n")
 	sb.WriteString("\t\"reflect\"\n")
+	sb.WriteString("\t\"sort\"\n")
 	sb.WriteString("\t\"strings\"\n\n")
 
 	// Import all the generated packages
@@ -691,6 +1045,10 @@ func generateRegistryFileAtPath(registryPath string, packages []PackageInfo) err
 
 	// Generate all the registry functions
 	sb.WriteString(generateRegistryFunctions())
+	sb.WriteString("\n")
+	sb.WriteString(generateDeprecatedElements(packages))
+	sb.WriteString("\n")
+	sb.WriteString(generateElementOrder(packages))
 
 	// Write the file
 	return os.WriteFile(registryPath, []byte(sb.String()), 0644)
@@ -0,0 +1,31 @@
+package ddexgen
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+// TestGenerateFileHeader verifies the build constraint and banner written at the top of generated files
+func TestGenerateFileHeader(t *testing.T) {
+	dir := t.TempDir()
+	require.NoError(t, os.MkdirAll(filepath.Join(dir, "v1"), 0755))
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "v1/credit.pb.go"), []byte(oneofFixture), 0644))
+
+	opts := Options{
+		Only:      []Artifact{ArtifactXML},
+		BuildTags: "ddex && !nogen",
+		Banner:    "Code generated by monorepo ddex-gen. DO NOT EDIT.\n\nSee tools/ddex.",
+	}
+	require.NoError(t, Generate(dir, opts))
+	data, err := os.ReadFile(filepath.Join(dir, "v1/v1.xml.go"))
+	require.NoError(t, err)
+	require.True(t, strings.HasPrefix(string(data), "//go:build ddex && !nogen\n\n"+
+		"// Code generated by monorepo ddex-gen. DO NOT EDIT.\n//\n// See tools/ddex.\n\npackage v1\n"), string(data))
+
+	opts.BuildTags = "ddex &&"
+	require.ErrorContains(t, Generate(dir, opts), "invalid build tags")
+}
@@ -0,0 +1,251 @@
+package ddexgen
+
+import (
+	"encoding/xml"
+	"fmt"
+	"io"
+	"os"
+	"regexp"
+	"sort"
+	"strings"
+)
+
+// deprecationPattern matches the wording DDEX uses to deprecate the element or attribute being documented
+var deprecationPattern = regexp.MustCompile(`This (?:element|attribute) (?:is|has been) deprecated`)
+
+// findDeprecatedElements scans the xs:documentation of a DDEX schema file and returns, by complex type
+// name, the child elements and attributes documented as deprecated. Besides "This element is deprecated"
+// this recognizes sentences naming the element, such as "StartDate and EndDate are deprecated".
+func findDeprecatedElements(schemaPath string) (map[string][]string, error) {
+	file, err := os.Open(schemaPath)
+	if err != nil {
+		return nil, err
+	}
+	defer file.Close()
+
+	var stack []xsdNode
+	var documentation strings.Builder
+	inDocumentation := false
+	deprecated := make(map[string][]string)
+
+	decoder := xml.NewDecoder(file)
+	for {
+		tok, err := decoder.Token()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, fmt.Errorf("parsing %s: %w", schemaPath, err)
+		}
+
+		switch t := tok.(type) {
+		case xml.StartElement:
+			stack = append(stack, xsdNode{kind: t.Name.Local, name: attrValue(t, "name")})
+			if t.Name.Local == "documentation" {
+				inDocumentation = true
+				documentation.Reset()
+			}
+		case xml.CharData:
+			if inDocumentation {
+				documentation.Write(t)
+			}
+		case xml.EndElement:
+			if t.Name.Local == "documentation" {
+				inDocumentation = false
+				text := strings.Join(strings.Fields(documentation.String()), " ")
+				if owner, name, ok := documentedMember(stack); ok && isDeprecationNotice(text, name) {
+					deprecated[owner] = appendUnique(deprecated[owner], name)
+				}
+			}
+			stack = stack[:len(stack)-1]
+		}
+	}
+
+	for owner := range deprecated {
+		sort.Strings(deprecated[owner])
+	}
+	return deprecated, nil
+}
+
+// xsdNode is an open schema element with its local name and name attribute
+type xsdNode struct {
+	kind string
+	name string
+}
+
+// documentedMember returns the type owning the element or attribute whose xs:documentation is at the top
+// of stack, and that member's name. Members of anonymous complex types are owned by the enclosing element.
+func documentedMember(stack []xsdNode) (owner, member string, ok bool) {
+	i := len(stack) - 1
+	for i >= 0 && stack[i].kind != "element" && stack[i].kind != "attribute" && stack[i].kind != "complexType" {
+		i--
+	}
+	if i < 0 || stack[i].kind == "complexType" || stack[i].name == "" {
+		return "", "", false
+	}
+	member = stack[i].name
+
+	owner, ok = complexTypeOwner(stack[:i])
+	return owner, member, ok
+}
+
+// complexTypeOwner returns the name of the innermost complex type open on stack, or of the element that
+// declares it when it is anonymous
+func complexTypeOwner(stack []xsdNode) (string, bool) {
+	for i := len(stack) - 1; i >= 0; i-- {
+		if stack[i].kind != "complexType" {
+			continue
+		}
+		if stack[i].name != "" {
+			return stack[i].name, true
+		}
+		if i > 0 && stack[i-1].kind == "element" && stack[i-1].name != "" {
+			return stack[i-1].name, true
+		}
+		return "", false
+	}
+	return "", false
+}
+
+// isDeprecationNotice reports whether documentation deprecates the member called name
+func isDeprecationNotice(documentation, name string) bool {
+	if deprecationPattern.MatchString(documentation) {
+		return true
+	}
+	named := regexp.MustCompile(`\b(?:\w+ and )?` + regexp.QuoteMeta(name) + `(?: and \w+)? (?:is|are) deprecated`)
+	return named.MatchString(documentation)
+}
+
+// appendUnique appends value to values unless it is already present
+func appendUnique(values []string, value string) []string {
+	for _, existing := range values {
+		if existing == value {
+			return values
+		}
+	}
+	return append(values, value)
+}
+
+// deprecationsForPackage returns the deprecated members of a package's schema that exist as XML-mapped
+// fields of its generated structs, keyed by struct name
+func deprecationsForPackage(pbPath, schemaPath string) (map[string][]string, error) {
+	deprecated, err := findDeprecatedElements(schemaPath)
+	if err != nil {
+		return nil, err
+	}
+
+	pkg, err := parseSchemaPackage(pbPath)
+	if err != nil {
+		return nil, err
+	}
+
+	result := make(map[string][]string)
+	for owner, names := range deprecated {
+		fields, ok := pkg.Structs[owner]
+		if !ok {
+			continue
+		}
+		for _, name := range names {
+			for _, field := range fields {
+				if field.XMLName == name {
+					result[owner] = append(result[owner], name)
+					break
+				}
+			}
+		}
+	}
+	return result, nil
+}
+
+// generateDeprecatedElements creates the deprecatedElements table and DeprecatedFieldsUsed for registry.go
+func generateDeprecatedElements(packages []PackageInfo) string {
+	var sb strings.Builder
+
+	sb.WriteString("// deprecatedElements lists, by generated type, the child elements and attributes that the DDEX\n")
+	sb.WriteString("// schema documents as deprecated\n")
+	sb.WriteString("var deprecatedElements = map[reflect.Type][]string{\n")
+	for _, pkg := range packages {
+		owners := make([]string, 0, len(pkg.Deprecated))
+		for owner := range pkg.Deprecated {
+			owners = append(owners, owner)
+		}
+		sort.Strings(owners)
+
+		for _, owner := range owners {
+			quoted := make([]string, len(pkg.Deprecated[owner]))
+			for i, name := range pkg.Deprecated[owner] {
+				quoted[i] = fmt.Sprintf("%q", name)
+			}
+			sb.WriteString(fmt.Sprintf("\treflect.TypeOf(%s.%s{}): {%s},\n", pkg.PackageName, owner, strings.Join(quoted, ", ")))
+		}
+	}
+	sb.WriteString("}\n\n")
+
+	sb.WriteString(`// DeprecatedFieldsUsed returns the sorted paths of the populated elements and attributes of a parsed
+// message that the DDEX schema documents as deprecated, e.g. /NewReleaseMessage/ResourceList/SoundRecording/IsInstrumental
+func DeprecatedFieldsUsed(msg interface{}) []string {
+	seen := make(map[string]bool)
+	var paths []string
+
+	var walk func(v reflect.Value, path string)
+	walk = func(v reflect.Value, path string) {
+		switch v.Kind() {
+		case reflect.Ptr, reflect.Interface:
+			if !v.IsNil() {
+				walk(v.Elem(), path)
+			}
+		case reflect.Slice:
+			if v.Type().Elem().Kind() == reflect.Uint8 {
+				return
+			}
+			for i := 0; i < v.Len(); i++ {
+				walk(v.Index(i), path)
+			}
+		case reflect.Struct:
+			deprecated := deprecatedElements[v.Type()]
+			t := v.Type()
+			for i := 0; i < t.NumField(); i++ {
+				tag := t.Field(i).Tag.Get("xml")
+				if !t.Field(i).IsExported() || tag == "" || tag == "-" {
+					continue
+				}
+				name, options, _ := strings.Cut(tag, ",")
+				if name == "" {
+					continue
+				}
+
+				isAttr := strings.Contains(options, "attr")
+				childPath := path + "/" + name
+				if isAttr {
+					childPath = path + "@" + name
+				}
+				for _, deprecatedName := range deprecated {
+					if deprecatedName == name && !v.Field(i).IsZero() && !seen[childPath] {
+						seen[childPath] = true
+						paths = append(paths, childPath)
+					}
+				}
+				if !isAttr {
+					walk(v.Field(i), childPath)
+				}
+			}
+		}
+	}
+
+	v := reflect.ValueOf(msg)
+	for v.Kind() == reflect.Ptr || v.Kind() == reflect.Interface {
+		if v.IsNil() {
+			return nil
+		}
+		v = v.Elem()
+	}
+	if v.Kind() == reflect.Struct {
+		walk(v, "/"+v.Type().Name())
+	}
+
+	sort.Strings(paths)
+	return paths
+}
+`)
+	return sb.String()
+}
@@ -0,0 +1,49 @@
+package ddexgen
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+)
+
+// generateFieldToElement creates the FieldToElement map of a .pb.go file, from "Message.proto_field" to the
+// XML name in the field's injected xml tag. Attributes are prefixed with @; character data fields have no
+// element of their own and are left out.
+func generateFieldToElement(pbPath string) (string, error) {
+	pkg, err := parseSchemaPackage(pbPath)
+	if err != nil {
+		return "", err
+	}
+
+	entries := make(map[string]string)
+	for message, fields := range pkg.Structs {
+		for _, field := range fields {
+			if field.CharData || field.XMLName == "" || field.ProtoName == "" {
+				continue
+			}
+			element := field.XMLName
+			if field.Attr {
+				element = "@" + element
+			}
+			entries[message+"."+field.ProtoName] = element
+		}
+	}
+	if len(entries) == 0 {
+		return "", nil
+	}
+	keys := make([]string, 0, len(entries))
+	for key := range entries {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	var sb strings.Builder
+	sb.WriteString("\n\n// FieldToElement maps each proto field, keyed \"Message.proto_field_name\", to the DDEX element it\n")
+	sb.WriteString("// comes from; attributes are prefixed with @. Character data fields are not listed.\n")
+	sb.WriteString("var FieldToElement = map[string]string{\n")
+	for _, key := range keys {
+		sb.WriteString(fmt.Sprintf("\t%q: %q,\n", key, entries[key]))
+	}
+	sb.WriteString("}")
+	return sb.String(), nil
+}
@@ -0,0 +1,414 @@
+package ddexgen
+
+import (
+	"encoding/json"
+	"fmt"
+	"go/ast"
+	"go/parser"
+	"go/token"
+	"log"
+	"os"
+	"path/filepath"
+	"reflect"
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+// JSONSchemaDraft is the JSON Schema dialect emitted by GenerateJSONSchemas
+const JSONSchemaDraft = "https://json-schema.org/draft/2020-12/schema"
+
+// schemaField describes one field of a generated message struct
+type schemaField struct {
+	GoName    string
+	JSONName  string
+	ProtoName string
+	XMLName   string
+	Type      ast.Expr
+	CharData  bool
+	Attr      bool
+}
+
+// schemaPackage holds the structs and enums parsed from one .pb.go file
+type schemaPackage struct {
+	Dir       string
+	Structs   map[string][]schemaField
+	Enums     map[string][]string
+	Codes     map[string][]string
+	AVSImport string
+	RootOrder []string
+}
+
+// GenerateJSONSchemas writes a JSON Schema (draft 2020-12) for every root message found in the .pb.go
+// files under targetDir. Schemas describe the protobuf JSON form of the message, with the fields the
+// message's XSD under xsd/ requires marked required, and are written to
+// outDir/<type>/<version>/<RootMessage>.schema.json.
+func GenerateJSONSchemas(targetDir, outDir string, verbose bool) error {
+	return eachMessageSchema(targetDir, func(nsInfo *NamespaceInfo, version, root string, schema map[string]interface{}) error {
+		data, err := json.MarshalIndent(schema, "", "  ")
+		if err != nil {
+			return fmt.Errorf("encoding schema for %s: %w", root, err)
+		}
+
+		schemaPath := filepath.Join(outDir, nsInfo.NamespacePrefix, version, root+".schema.json")
+		if err := os.MkdirAll(filepath.Dir(schemaPath), 0755); err != nil {
+			return err
+		}
+		if err := os.WriteFile(schemaPath, append(data, '\n'), 0644); err != nil {
+			return err
+		}
+		if verbose {
+			log.Printf("Generated %s", schemaPath)
+		}
+		return nil
+	})
+}
+
+// eachMessageSchema builds the JSON Schema of every root message found in the .pb.go files under
+// targetDir, with its $id set, and calls emit with it
+func eachMessageSchema(targetDir string, emit func(nsInfo *NamespaceInfo, version, root string, schema map[string]interface{}) error) error {
+	packages := make(map[string]*schemaPackage)
+
+	err := filepath.Walk(targetDir, func(path string, info os.FileInfo, err error) error {
+		if err != nil {
+			return err
+		}
+		if !strings.HasSuffix(path, ".pb.go") {
+			return nil
+		}
+
+		pkg, err := parseSchemaPackage(path)
+		if err != nil {
+			return fmt.Errorf("parsing %s: %w", path, err)
+		}
+		packages[pkg.Dir] = pkg
+		return nil
+	})
+	if err != nil {
+		return fmt.Errorf("walking directory: %w", err)
+	}
+
+	// Index AVS codes by package directory so DDEX packages can resolve the AVS version they import.
+	// Enum constants are upper-cased, so the original spelling is restored from the AVS XSDs when available.
+	spellings := loadAVSSpellings("xsd")
+	avsCodesByDir := make(map[string]map[string][]string)
+	for dir, pkg := range packages {
+		if len(pkg.Codes) == 0 {
+			continue
+		}
+		for name, codes := range pkg.Codes {
+			for i, code := range codes {
+				if original, ok := spellings[avsCodeKey(code)]; ok {
+					codes[i] = original
+				}
+			}
+			pkg.Codes[name] = codes
+		}
+		avsCodesByDir[filepath.ToSlash(dir)] = pkg.Codes
+	}
+
+	for _, pkg := range packages {
+		if len(pkg.RootOrder) == 0 {
+			continue
+		}
+		nsInfo := deriveNamespaceInfo(pkg.Dir)
+		if nsInfo == nil {
+			continue
+		}
+
+		var enums map[string][]string
+		if pkg.AVSImport != "" {
+			for dir, candidate := range avsCodesByDir {
+				if strings.HasSuffix(dir, pkg.AVSImport) {
+					enums = candidate
+					break
+				}
+			}
+		}
+
+		version := extractVersionFromPath(pkg.Dir)
+
+		// Required members are optional: without the XSD no field is marked required
+		schemaPath := filepath.Join("xsd", nsInfo.NamespacePrefix+version, nsInfo.SchemaFile)
+		required, _ := requiredFieldsForPackage(pkg, schemaPath)
+
+		for _, root := range pkg.RootOrder {
+			schema := buildMessageSchema(pkg, root, enums, required)
+			schema["$id"] = fmt.Sprintf("%s/%s/%s.schema.json", nsInfo.NamespacePrefix, version, root)
+			if err := emit(nsInfo, version, root, schema); err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
+// parseSchemaPackage extracts struct fields, enum values and the AVS import from a .pb.go file
+func parseSchemaPackage(filename string) (*schemaPackage, error) {
+	fset := token.NewFileSet()
+	node, err := parser.ParseFile(fset, filename, nil, parser.ParseComments)
+	if err != nil {
+		return nil, err
+	}
+
+	pkg := &schemaPackage{
+		Dir:     filepath.Dir(filename),
+		Structs: make(map[string][]schemaField),
+		Enums:   make(map[string][]string),
+		Codes:   make(map[string][]string),
+	}
+
+	for _, imp := range node.Imports {
+		importPath, _ := strconv.Unquote(imp.Path.Value)
+		if idx := strings.Index(importPath, "/ddex/avs/"); idx >= 0 {
+			pkg.AVSImport = importPath[idx+1:]
+		}
+	}
+
+	enums, err := findEnumTypes(filename)
+	if err != nil {
+		return nil, err
+	}
+	for _, enum := range enums {
+		pkg.Enums[enum.Name] = enumValueNames(enum)
+		pkg.Codes[enum.Name] = avsCodes(enum)
+	}
+
+	for _, decl := range node.Decls {
+		genDecl, ok := decl.(*ast.GenDecl)
+		if !ok || genDecl.Tok != token.TYPE {
+			continue
+		}
+		for _, spec := range genDecl.Specs {
+			ts, ok := spec.(*ast.TypeSpec)
+			if !ok {
+				continue
+			}
+			st, ok := ts.Type.(*ast.StructType)
+			if !ok {
+				continue
+			}
+
+			var fields []schemaField
+			for _, field := range st.Fields.List {
+				if field.Tag == nil || len(field.Names) == 0 || !field.Names[0].IsExported() {
+					continue
+				}
+				tag, _ := strconv.Unquote(field.Tag.Value)
+				structTag := reflect.StructTag(tag)
+				if structTag.Get("xml") == "-" || structTag.Get("protobuf") == "" {
+					continue
+				}
+				fields = append(fields, schemaField{
+					GoName:    field.Names[0].Name,
+					JSONName:  protobufJSONName(structTag.Get("protobuf")),
+					ProtoName: protobufName(structTag.Get("protobuf")),
+					XMLName:   strings.Split(structTag.Get("xml"), ",")[0],
+					Type:      field.Type,
+					CharData:  strings.HasSuffix(structTag.Get("xml"), ",chardata"),
+					Attr:      strings.HasSuffix(structTag.Get("xml"), ",attr"),
+				})
+			}
+
+			pkg.Structs[ts.Name.Name] = fields
+			if isRootMessage(ts.Name.Name) {
+				pkg.RootOrder = append(pkg.RootOrder, ts.Name.Name)
+			}
+		}
+	}
+
+	return pkg, nil
+}
+
+// protobufJSONName returns the protojson field name from a `protobuf:"..."` struct tag
+func protobufJSONName(tag string) string {
+	var name string
+	for _, part := range strings.Split(tag, ",") {
+		switch {
+		case strings.HasPrefix(part, "json="):
+			return strings.TrimPrefix(part, "json=")
+		case strings.HasPrefix(part, "name="):
+			name = strings.TrimPrefix(part, "name=")
+		}
+	}
+	return name
+}
+
+// protobufName returns the proto field name from a `protobuf:"..."` struct tag
+func protobufName(tag string) string {
+	for _, part := range strings.Split(tag, ",") {
+		if strings.HasPrefix(part, "name=") {
+			return strings.TrimPrefix(part, "name=")
+		}
+	}
+	return ""
+}
+
+// enumValueNames returns the proto value names of an enum (the Go constants without the type prefix),
+// skipping the UNSPECIFIED zero value
+func enumValueNames(enum EnumInfo) []string {
+	var values []string
+	for _, constant := range enum.Constants {
+		if strings.HasSuffix(constant, "_UNSPECIFIED") {
+			continue
+		}
+		values = append(values, strings.TrimPrefix(constant, enum.Name+"_"))
+	}
+	return values
+}
+
+// avsCodes returns the codes of an AVS enum as derived from its constants (upper-cased)
+func avsCodes(enum EnumInfo) []string {
+	prefix := enumValuePrefix(enum)
+	var codes []string
+	for _, constant := range enum.Constants {
+		if code := enumConstantCode(enum, prefix, constant); code != "" {
+			codes = append(codes, code)
+		}
+	}
+	return codes
+}
+
+// buildMessageSchema builds the schema document for a root message with every reachable message in $defs.
+// required holds, by struct name, the XML names of the fields the XSD requires.
+func buildMessageSchema(pkg *schemaPackage, root string, avsEnums map[string][]string, required map[string]map[string]bool) map[string]interface{} {
+	defs := make(map[string]interface{})
+	queue := []string{root}
+	for len(queue) > 0 {
+		name := queue[0]
+		queue = queue[1:]
+		if _, done := defs[name]; done {
+			continue
+		}
+		def, refs := buildStructSchema(pkg, name, avsEnums, required[name])
+		defs[name] = def
+		queue = append(queue, refs...)
+	}
+
+	rootDef := defs[root].(map[string]interface{})
+	delete(defs, root)
+
+	schema := map[string]interface{}{
+		"$schema": JSONSchemaDraft,
+		"title":   root,
+	}
+	for key, value := range rootDef {
+		schema[key] = value
+	}
+	if len(defs) > 0 {
+		schema["$defs"] = defs
+	}
+	return schema
+}
+
+// buildStructSchema builds the object schema of one message and returns the messages it references.
+// Fields whose XML name is in required are listed as required.
+func buildStructSchema(pkg *schemaPackage, name string, avsEnums map[string][]string, required map[string]bool) (map[string]interface{}, []string) {
+	properties := make(map[string]interface{})
+	var refs []string
+	var requiredFields []string
+
+	for _, field := range pkg.Structs[name] {
+		property, ref := fieldSchema(pkg, field.Type)
+		if ref != "" {
+			refs = append(refs, ref)
+		}
+
+		// String values of AVS-backed elements are restricted to the AVS code list
+		enumName := field.GoName
+		if field.CharData {
+			enumName = name
+		}
+		if codes, ok := avsEnums[enumName]; ok && property["type"] == "string" {
+			property["enum"] = codes
+		}
+
+		properties[field.JSONName] = property
+		if !field.CharData && required[field.XMLName] {
+			requiredFields = append(requiredFields, field.JSONName)
+		}
+	}
+
+	schema := map[string]interface{}{
+		"type":                 "object",
+		"properties":           properties,
+		"additionalProperties": false,
+	}
+	if len(requiredFields) > 0 {
+		schema["required"] = requiredFields
+	}
+	return schema, refs
+}
+
+// fieldSchema maps a Go field type to its protojson schema, returning the referenced message name if any
+func fieldSchema(pkg *schemaPackage, expr ast.Expr) (map[string]interface{}, string) {
+	switch t := expr.(type) {
+	case *ast.StarExpr:
+		return fieldSchema(pkg, t.X)
+	case *ast.ArrayType:
+		if ident, ok := t.Elt.(*ast.Ident); ok && ident.Name == "byte" {
+			return map[string]interface{}{"type": "string", "contentEncoding": "base64"}, ""
+		}
+		items, ref := fieldSchema(pkg, t.Elt)
+		return map[string]interface{}{"type": "array", "items": items}, ref
+	case *ast.MapType:
+		values, ref := fieldSchema(pkg, t.Value)
+		return map[string]interface{}{"type": "object", "additionalProperties": values}, ref
+	case *ast.Ident:
+		switch t.Name {
+		case "string":
+			return map[string]interface{}{"type": "string"}, ""
+		case "bool":
+			return map[string]interface{}{"type": "boolean"}, ""
+		case "int32", "uint32":
+			return map[string]interface{}{"type": "integer"}, ""
+		case "int64", "uint64":
+			// protojson encodes 64-bit integers as strings
+			return map[string]interface{}{"type": []string{"integer", "string"}}, ""
+		case "float32", "float64":
+			return map[string]interface{}{"type": "number"}, ""
+		}
+		if values, ok := pkg.Enums[t.Name]; ok {
+			return map[string]interface{}{"type": "string", "enum": values}, ""
+		}
+		if _, ok := pkg.Structs[t.Name]; ok {
+			return map[string]interface{}{"$ref": "#/$defs/" + t.Name}, t.Name
+		}
+	}
+	return map[string]interface{}{}, ""
+}
+
+// xsdEnumerationPattern matches the values of xs:enumeration facets
+var xsdEnumerationPattern = regexp.MustCompile(`<xs:enumeration value="([^"]*)"`)
+
+// loadAVSSpellings maps avsCodeKey of every AVS value in the XSD files of dir to its original spelling
+func loadAVSSpellings(dir string) map[string]string {
+	spellings := make(map[string]string)
+
+	files, _ := filepath.Glob(filepath.Join(dir, "*.xsd"))
+	for _, file := range files {
+		content, err := os.ReadFile(file)
+		if err != nil {
+			continue
+		}
+		for _, match := range xsdEnumerationPattern.FindAllSubmatch(content, -1) {
+			value := string(match[1])
+			if _, exists := spellings[avsCodeKey(value)]; !exists {
+				spellings[avsCodeKey(value)] = value
+			}
+		}
+	}
+	return spellings
+}
+
+// avsCodeKey normalizes an AVS value or enum constant suffix to upper-case letters and digits
+func avsCodeKey(value string) string {
+	var sb strings.Builder
+	for _, r := range strings.ToUpper(value) {
+		if (r >= 'A' && r <= 'Z') || (r >= '0' && r <= '9') {
+			sb.WriteRune(r)
+		}
+	}
+	return sb.String()
+}
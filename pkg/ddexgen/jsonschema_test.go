@@ -0,0 +1,43 @@
+package ddexgen
+
+import (
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+// TestGenerateJSONSchemas verifies the JSON Schema for a message, including the required members from the XSD
+func TestGenerateJSONSchemas(t *testing.T) {
+	// The generator reads the XSDs under xsd/ relative to the working directory
+	t.Chdir("../..")
+	outDir := t.TempDir()
+	require.NoError(t, GenerateJSONSchemas("gen", outDir, false))
+	data, err := os.ReadFile(filepath.Join(outDir, "ern/v43/NewReleaseMessage.schema.json"))
+	require.NoError(t, err)
+
+	type objectSchema struct {
+		Type       string                     `json:"type"`
+		Properties map[string]json.RawMessage `json:"properties"`
+		Required   []string                   `json:"required"`
+	}
+	var schema struct {
+		objectSchema
+		Schema string                  `json:"$schema"`
+		Defs   map[string]objectSchema `json:"$defs"`
+	}
+	require.NoError(t, json.Unmarshal(data, &schema))
+	require.Equal(t, JSONSchemaDraft, schema.Schema)
+	require.Equal(t, "object", schema.Type)
+	require.Contains(t, schema.Properties, "releaseAdmin")
+
+	// Required elements and attributes, but not minOccurs="0" elements or choice alternatives
+	require.Equal(t, []string{"messageHeader", "partyList", "resourceList", "releaseList", "avsVersionId", "languageAndScriptCode"}, schema.Required)
+	require.Equal(t, []string{"messageId", "messageSender", "messageRecipient", "messageCreatedDateTime"}, schema.Defs["MessageHeader"].Required)
+	require.Equal(t, []string{"fullName"}, schema.Defs["PartyName"].Required)
+	// PartyId and PartyName are alternatives of a choice
+	require.Equal(t, []string{"partyReference"}, schema.Defs["Party"].Required)
+	require.Contains(t, schema.Defs["Party"].Properties, "partyName")
+}
@@ -0,0 +1,271 @@
+package ddexgen
+
+import (
+	"bytes"
+	"fmt"
+	"go/ast"
+	"go/format"
+	"go/parser"
+	"go/token"
+	"reflect"
+	"strconv"
+	"strings"
+)
+
+// oneofStruct describes a message struct with at least one oneof field. encoding/xml cannot map the
+// interface field protoc-gen-go generates for a oneof, so such structs are marshaled through a shadow
+// struct that holds each variant in a field of its own.
+type oneofStruct struct {
+	// Fields are the XML-mapped fields in declaration order; oneof fields have Variants
+	Fields []shadowField
+}
+
+// shadowField is a field of a oneof struct, or one of its oneof fields
+type shadowField struct {
+	GoName string
+	Type   string // Go type expression, for plain fields
+	Tag    string // struct tag, for plain fields
+	// Variants are the wrapper types of a oneof field
+	Variants []oneofVariant
+}
+
+// oneofVariant is one case of a oneof, e.g. the ChoiceHolder_Isrc wrapper with its Isrc field
+type oneofVariant struct {
+	Wrapper string
+	Field   string
+	Type    string
+	XMLName string
+	// Inline variants (xml:",inline") hold a message whose fields are children of the oneof's parent
+	Inline bool
+}
+
+// findOneofStructs parses a .pb.go file and returns the structs with oneof fields by name
+func findOneofStructs(filename string) (map[string]*oneofStruct, error) {
+	fset := token.NewFileSet()
+	node, err := parser.ParseFile(fset, filename, nil, parser.ParseComments)
+	if err != nil {
+		return nil, err
+	}
+
+	structs := make(map[string]*ast.StructType)
+	var order []string
+	for _, decl := range node.Decls {
+		genDecl, ok := decl.(*ast.GenDecl)
+		if !ok || genDecl.Tok != token.TYPE {
+			continue
+		}
+		for _, spec := range genDecl.Specs {
+			if ts, ok := spec.(*ast.TypeSpec); ok {
+				if st, ok := ts.Type.(*ast.StructType); ok {
+					structs[ts.Name.Name] = st
+					order = append(order, ts.Name.Name)
+				}
+			}
+		}
+	}
+
+	// protoc-gen-go marks each wrapper type with a method named after the oneof's interface
+	wrappers := make(map[string][]string)
+	for _, decl := range node.Decls {
+		fn, ok := decl.(*ast.FuncDecl)
+		if !ok || fn.Recv == nil || len(fn.Recv.List) != 1 || !strings.HasPrefix(fn.Name.Name, "is") {
+			continue
+		}
+		if star, ok := fn.Recv.List[0].Type.(*ast.StarExpr); ok {
+			if ident, ok := star.X.(*ast.Ident); ok {
+				wrappers[fn.Name.Name] = append(wrappers[fn.Name.Name], ident.Name)
+			}
+		}
+	}
+
+	result := make(map[string]*oneofStruct)
+	for _, name := range order {
+		var fields []shadowField
+		hasOneof := false
+		for _, field := range structs[name].Fields.List {
+			if field.Tag == nil || len(field.Names) == 0 || !field.Names[0].IsExported() {
+				continue
+			}
+			tag, _ := strconv.Unquote(field.Tag.Value)
+			structTag := reflect.StructTag(tag)
+
+			if structTag.Get("protobuf_oneof") != "" {
+				ident, ok := field.Type.(*ast.Ident)
+				if !ok {
+					continue
+				}
+				var variants []oneofVariant
+				for _, wrapper := range wrappers[ident.Name] {
+					variant, err := wrapperVariant(fset, wrapper, structs[wrapper])
+					if err != nil {
+						return nil, fmt.Errorf("oneof %s.%s: %w", name, field.Names[0].Name, err)
+					}
+					variants = append(variants, variant)
+				}
+				fields = append(fields, shadowField{GoName: field.Names[0].Name, Variants: variants})
+				hasOneof = true
+				continue
+			}
+
+			if structTag.Get("xml") == "-" || structTag.Get("protobuf") == "" {
+				continue
+			}
+			fields = append(fields, shadowField{
+				GoName: field.Names[0].Name,
+				Type:   exprString(fset, field.Type),
+				Tag:    fmt.Sprintf("`xml:%q`", structTag.Get("xml")),
+			})
+		}
+		if hasOneof {
+			result[name] = &oneofStruct{Fields: fields}
+		}
+	}
+	return result, nil
+}
+
+// wrapperVariant reads the single field of a oneof wrapper struct
+func wrapperVariant(fset *token.FileSet, name string, st *ast.StructType) (oneofVariant, error) {
+	if st == nil {
+		return oneofVariant{}, fmt.Errorf("wrapper type %s not found", name)
+	}
+	for _, field := range st.Fields.List {
+		if len(field.Names) == 0 || !field.Names[0].IsExported() {
+			continue
+		}
+		variant := oneofVariant{Wrapper: name, Field: field.Names[0].Name, Type: exprString(fset, field.Type)}
+		if field.Tag != nil {
+			tag, _ := strconv.Unquote(field.Tag.Value)
+			xmlTag := reflect.StructTag(tag).Get("xml")
+			variant.Inline = xmlTag == ",inline"
+			variant.XMLName = strings.Split(xmlTag, ",")[0]
+		}
+		if variant.XMLName == "" {
+			variant.XMLName = variant.Field
+		}
+		if variant.Inline && !strings.HasPrefix(variant.Type, "*") {
+			return oneofVariant{}, fmt.Errorf("inline variant %s.%s is not a message", name, variant.Field)
+		}
+		return variant, nil
+	}
+	return oneofVariant{}, fmt.Errorf("wrapper type %s has no field", name)
+}
+
+// exprString renders a type expression as Go source
+func exprString(fset *token.FileSet, expr ast.Expr) string {
+	var buf bytes.Buffer
+	format.Node(&buf, fset, expr)
+	return buf.String()
+}
+
+// shadowName is the name of the shadow struct generated for a oneof struct
+func shadowName(message string) string {
+	return "xml" + message
+}
+
+// variantField is the shadow struct field of a non-inline variant
+func variantField(oneof string, variant oneofVariant) string {
+	return oneof + "_" + variant.Field
+}
+
+// generateOneofShadow creates the shadow struct of a oneof struct. Plain fields keep their type and xml
+// tag; each variant becomes a pointer field under its element name, and inline variants are embedded so
+// that encoding/xml flattens their fields into the parent element.
+func generateOneofShadow(message string, oneofs *oneofStruct) string {
+	var sb strings.Builder
+	sb.WriteString(fmt.Sprintf("// %s mirrors %s for encoding/xml, which cannot map oneof fields: each variant\n", shadowName(message), message))
+	sb.WriteString("// of a oneof has its own field under its DDEX element name\n")
+	sb.WriteString(fmt.Sprintf("type %s struct {\n", shadowName(message)))
+	for _, field := range oneofs.Fields {
+		if field.Variants == nil {
+			sb.WriteString(fmt.Sprintf("\t%s %s %s\n", field.GoName, field.Type, field.Tag))
+			continue
+		}
+		for _, variant := range field.Variants {
+			switch {
+			case variant.Inline:
+				sb.WriteString(fmt.Sprintf("\t%s\n", variant.Type))
+			case strings.HasPrefix(variant.Type, "*") || strings.HasPrefix(variant.Type, "[]"):
+				sb.WriteString(fmt.Sprintf("\t%s %s `xml:%q`\n", variantField(field.GoName, variant), variant.Type, variant.XMLName))
+			default:
+				sb.WriteString(fmt.Sprintf("\t%s *%s `xml:%q`\n", variantField(field.GoName, variant), variant.Type, variant.XMLName))
+			}
+		}
+	}
+	sb.WriteString("}")
+	return sb.String()
+}
+
+// generateOneofMarshal writes the body of MarshalXML that encodes m through its shadow struct, with only
+// the active variant of each oneof set
+func generateOneofMarshal(message string, oneofs *oneofStruct) string {
+	var sb strings.Builder
+	sb.WriteString("\t// Encode through the shadow struct so that only the active oneof variant is written\n")
+	sb.WriteString(fmt.Sprintf("\tshadow := %s{\n", shadowName(message)))
+	for _, field := range oneofs.Fields {
+		if field.Variants == nil {
+			sb.WriteString(fmt.Sprintf("\t\t%s: m.%s,\n", field.GoName, field.GoName))
+		}
+	}
+	sb.WriteString("\t}\n")
+	for _, field := range oneofs.Fields {
+		if field.Variants == nil {
+			continue
+		}
+		sb.WriteString(fmt.Sprintf("\tswitch v := m.%s.(type) {\n", field.GoName))
+		for _, variant := range field.Variants {
+			sb.WriteString(fmt.Sprintf("\tcase *%s:\n", variant.Wrapper))
+			switch {
+			case variant.Inline:
+				sb.WriteString(fmt.Sprintf("\t\tshadow.%s = v.%s\n", strings.TrimPrefix(variant.Type, "*"), variant.Field))
+			case strings.HasPrefix(variant.Type, "*") || strings.HasPrefix(variant.Type, "[]"):
+				sb.WriteString(fmt.Sprintf("\t\tshadow.%s = v.%s\n", variantField(field.GoName, variant), variant.Field))
+			default:
+				sb.WriteString(fmt.Sprintf("\t\tshadow.%s = &v.%s\n", variantField(field.GoName, variant), variant.Field))
+			}
+		}
+		sb.WriteString("\t}\n")
+	}
+	sb.WriteString("\treturn e.EncodeElement(&shadow, start)\n")
+	return sb.String()
+}
+
+// generateOneofUnmarshal writes the body of UnmarshalXML that decodes into the shadow struct and sets
+// each oneof to the first variant present in the document
+func generateOneofUnmarshal(message string, oneofs *oneofStruct) string {
+	var sb strings.Builder
+	sb.WriteString("\t// Decode through the shadow struct and select each oneof variant by the element present\n")
+	sb.WriteString(fmt.Sprintf("\tvar shadow %s\n", shadowName(message)))
+	sb.WriteString("\tif err := d.DecodeElement(&shadow, &start); err != nil {\n")
+	sb.WriteString("\t\treturn err\n")
+	sb.WriteString("\t}\n")
+	for _, field := range oneofs.Fields {
+		if field.Variants == nil {
+			sb.WriteString(fmt.Sprintf("\tm.%s = shadow.%s\n", field.GoName, field.GoName))
+		}
+	}
+	for _, field := range oneofs.Fields {
+		if field.Variants == nil {
+			continue
+		}
+		sb.WriteString("\tswitch {\n")
+		for _, variant := range field.Variants {
+			switch {
+			case variant.Inline:
+				embedded := strings.TrimPrefix(variant.Type, "*")
+				sb.WriteString(fmt.Sprintf("\tcase shadow.%s != nil:\n", embedded))
+				sb.WriteString(fmt.Sprintf("\t\tm.%s = &%s{%s: shadow.%s}\n", field.GoName, variant.Wrapper, variant.Field, embedded))
+			case strings.HasPrefix(variant.Type, "*") || strings.HasPrefix(variant.Type, "[]"):
+				name := variantField(field.GoName, variant)
+				sb.WriteString(fmt.Sprintf("\tcase shadow.%s != nil:\n", name))
+				sb.WriteString(fmt.Sprintf("\t\tm.%s = &%s{%s: shadow.%s}\n", field.GoName, variant.Wrapper, variant.Field, name))
+			default:
+				name := variantField(field.GoName, variant)
+				sb.WriteString(fmt.Sprintf("\tcase shadow.%s != nil:\n", name))
+				sb.WriteString(fmt.Sprintf("\t\tm.%s = &%s{%s: *shadow.%s}\n", field.GoName, variant.Wrapper, variant.Field, name))
+			}
+		}
+		sb.WriteString("\t}\n")
+	}
+	sb.WriteString("\treturn nil\n")
+	return sb.String()
+}
@@ -0,0 +1,95 @@
+package ddexgen
+
+import (
+	"os"
+	"os/exec"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+// oneofFixture is a protoc-gen-go style message with a oneof of an element and a message variant
+const oneofFixture = `package v1
+
+type Credit struct {
+	Role string ` + "`protobuf:\"bytes,1,opt,name=role,proto3\" xml:\"Role\"`" + `
+	// Types that are valid to be assigned to Choice:
+	//
+	//	*Credit_PartyName
+	//	*Credit_PartyId
+	Choice isCredit_Choice ` + "`protobuf_oneof:\"choice\"`" + `
+}
+
+type PartyId struct {
+	Value string ` + "`protobuf:\"bytes,1,opt,name=value,proto3\" xml:\",chardata\"`" + `
+}
+
+type isCredit_Choice interface {
+	isCredit_Choice()
+}
+
+type Credit_PartyName struct {
+	PartyName string ` + "`protobuf:\"bytes,2,opt,name=party_name,json=partyName,proto3,oneof\" xml:\"PartyName\"`" + `
+}
+
+type Credit_PartyId struct {
+	PartyId *PartyId ` + "`protobuf:\"bytes,3,opt,name=party_id,json=partyId,proto3,oneof\" xml:\"PartyId\"`" + `
+}
+
+func (*Credit_PartyName) isCredit_Choice() {}
+
+func (*Credit_PartyId) isCredit_Choice() {}
+`
+
+// oneofProgram marshals and unmarshals the fixture through the generated methods
+const oneofProgram = `package main
+
+import (
+	"encoding/xml"
+	"fmt"
+
+	v1 "oneoftest/v1"
+)
+
+func main() {
+	for _, credit := range []*v1.Credit{
+		{Role: "Producer", Choice: &v1.Credit_PartyName{PartyName: "Jane"}},
+		{Role: "Mixer", Choice: &v1.Credit_PartyId{PartyId: &v1.PartyId{Value: "P1"}}},
+	} {
+		data, err := xml.Marshal(credit)
+		if err != nil {
+			panic(err)
+		}
+		var parsed v1.Credit
+		if err := xml.Unmarshal(data, &parsed); err != nil {
+			panic(err)
+		}
+		fmt.Printf("%s %T\n", data, parsed.Choice)
+	}
+}
+`
+
+// TestGenerateOneofXML verifies the generated oneof MarshalXML and UnmarshalXML round-trip each choice alternative
+func TestGenerateOneofXML(t *testing.T) {
+	if testing.Short() {
+		t.Skip("runs the go tool")
+	}
+	dir := t.TempDir()
+	write := func(name, content string) {
+		require.NoError(t, os.MkdirAll(filepath.Dir(filepath.Join(dir, name)), 0755))
+		require.NoError(t, os.WriteFile(filepath.Join(dir, name), []byte(content), 0644))
+	}
+	write("go.mod", "module oneoftest\n\ngo 1.25\n")
+	write("v1/credit.pb.go", oneofFixture)
+	write("cmd/main.go", oneofProgram)
+
+	require.NoError(t, Generate(dir, Options{Only: []Artifact{ArtifactXML}, Verify: true}))
+
+	cmd := exec.Command("go", "run", "./cmd")
+	cmd.Dir = dir
+	output, err := cmd.CombinedOutput()
+	require.NoError(t, err, string(output))
+	require.Equal(t, "<Credit><Role>Producer</Role><PartyName>Jane</PartyName></Credit> *v1.Credit_PartyName\n"+
+		"<Credit><Role>Mixer</Role><PartyId>P1</PartyId></Credit> *v1.Credit_PartyId\n", string(output))
+}
@@ -0,0 +1,133 @@
+package ddexgen
+
+import (
+	"encoding/json"
+	"fmt"
+	"log"
+	"os"
+	"path/filepath"
+	"sort"
+)
+
+// OpenAPIVersion is the OpenAPI version of the document written by GenerateOpenAPI
+const OpenAPIVersion = "3.1.0"
+
+// parseResponseSchema describes the JSON body of the ddexhttp handler (ddexhttp.Response)
+var parseResponseSchema = map[string]interface{}{
+	"type":        "object",
+	"description": "Result of parsing and validating a DDEX message",
+	"properties": map[string]interface{}{
+		"message_type": map[string]interface{}{"type": "string", "description": "Detected message type, e.g. ern", "examples": []string{"ern"}},
+		"version":      map[string]interface{}{"type": "string", "description": "Detected schema version, e.g. v432", "examples": []string{"v432"}},
+		"message_name": map[string]interface{}{"type": "string", "description": "Root message, e.g. NewReleaseMessage", "examples": []string{"NewReleaseMessage"}},
+		"valid":        map[string]interface{}{"type": "boolean", "description": "Whether the message parsed without validation errors"},
+		"errors":       map[string]interface{}{"type": "array", "items": map[string]interface{}{"type": "string"}, "description": "Validation errors"},
+		"error":        map[string]interface{}{"type": "string", "description": "Why the request could not be handled, e.g. a parse error"},
+	},
+	"required":             []string{"valid"},
+	"additionalProperties": false,
+}
+
+// openAPIResponses are the responses of the ddexhttp handler by component name
+var openAPIResponses = map[string]struct {
+	status      string
+	description string
+}{
+	"ParseValid":       {"200", "The message is valid"},
+	"ParseFailed":      {"400", "The document does not parse"},
+	"MethodNotAllowed": {"405", "The method is not POST"},
+	"NotAcceptable":    {"406", "The Accept header does not allow application/json"},
+	"TooLarge":         {"413", "The document is larger than the handler accepts"},
+	"ValidationFailed": {"422", "The message parses, with validation errors"},
+}
+
+// GenerateOpenAPI writes an OpenAPI 3.1 document to outPath whose components describe the parse API of
+// pkg/ddexhttp: the XML request body, the JSON response, the handler as the path item Parse (to be
+// referenced from the path it is mounted on), and the JSON Schema of every root message found in the
+// .pb.go files under targetDir as GenerateJSONSchemas builds them, named <type>.<version>.<RootMessage>,
+// e.g. ern.v432.NewReleaseMessage, with DDEXMessage being any of them.
+func GenerateOpenAPI(targetDir, outPath string, verbose bool) error {
+	schemas := map[string]interface{}{"ParseResponse": parseResponseSchema}
+	var names []string
+	err := eachMessageSchema(targetDir, func(nsInfo *NamespaceInfo, version, root string, schema map[string]interface{}) error {
+		name := fmt.Sprintf("%s.%s.%s", nsInfo.NamespacePrefix, version, root)
+		schemas[name] = schema
+		names = append(names, name)
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+	sort.Strings(names)
+	var messages []interface{}
+	for _, name := range names {
+		messages = append(messages, map[string]interface{}{"$ref": "#/components/schemas/" + name})
+	}
+	if len(messages) > 0 {
+		schemas["DDEXMessage"] = map[string]interface{}{"oneOf": messages}
+	}
+
+	responses := make(map[string]interface{})
+	statuses := make(map[string]interface{})
+	for name, response := range openAPIResponses {
+		statuses[response.status] = map[string]interface{}{"$ref": "#/components/responses/" + name}
+		responses[name] = map[string]interface{}{
+			"description": response.description,
+			"content": map[string]interface{}{
+				"application/json": map[string]interface{}{
+					"schema": map[string]interface{}{"$ref": "#/components/schemas/ParseResponse"},
+				},
+			},
+		}
+	}
+
+	document := map[string]interface{}{
+		"openapi": OpenAPIVersion,
+		"info": map[string]interface{}{
+			"title":   "DDEX parse API",
+			"version": "1.0.0",
+			"description": "POST a DDEX message as XML to have it parsed and validated. The message schemas " +
+				"describe the protobuf JSON form of each supported message.",
+		},
+		"components": map[string]interface{}{
+			"schemas": schemas,
+			"requestBodies": map[string]interface{}{
+				"DDEXMessage": map[string]interface{}{
+					"description": "A DDEX message document, one of the message schemas in XML form",
+					"required":    true,
+					"content": map[string]interface{}{
+						"application/xml": map[string]interface{}{
+							"schema": map[string]interface{}{"type": "string"},
+						},
+					},
+				},
+			},
+			"responses": responses,
+			"pathItems": map[string]interface{}{
+				"Parse": map[string]interface{}{
+					"post": map[string]interface{}{
+						"operationId": "parseDDEXMessage",
+						"summary":     "Parse and validate a DDEX message",
+						"requestBody": map[string]interface{}{"$ref": "#/components/requestBodies/DDEXMessage"},
+						"responses":   statuses,
+					},
+				},
+			},
+		},
+	}
+
+	data, err := json.MarshalIndent(document, "", "  ")
+	if err != nil {
+		return fmt.Errorf("encoding OpenAPI document: %w", err)
+	}
+	if err := os.MkdirAll(filepath.Dir(outPath), 0755); err != nil {
+		return err
+	}
+	if err := os.WriteFile(outPath, append(data, '\n'), 0644); err != nil {
+		return err
+	}
+	if verbose {
+		log.Printf("Generated %s with %d message schemas", outPath, len(names))
+	}
+	return nil
+}
@@ -0,0 +1,42 @@
+package ddexgen
+
+import (
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+// TestGenerateOpenAPI verifies the OpenAPI document's schemas, responses and path items
+func TestGenerateOpenAPI(t *testing.T) {
+	// The generator reads the XSDs under xsd/ relative to the working directory
+	t.Chdir("../..")
+	outPath := filepath.Join(t.TempDir(), "openapi.json")
+	require.NoError(t, GenerateOpenAPI("gen", outPath, false))
+	data, err := os.ReadFile(outPath)
+	require.NoError(t, err)
+
+	var document struct {
+		OpenAPI    string `json:"openapi"`
+		Components struct {
+			Schemas   map[string]json.RawMessage `json:"schemas"`
+			Responses map[string]json.RawMessage `json:"responses"`
+			PathItems map[string]struct {
+				Post struct {
+					Responses map[string]struct {
+						Ref string `json:"$ref"`
+					} `json:"responses"`
+				} `json:"post"`
+			} `json:"pathItems"`
+		} `json:"components"`
+	}
+	require.NoError(t, json.Unmarshal(data, &document))
+	require.Equal(t, "3.1.0", document.OpenAPI)
+	require.Contains(t, document.Components.Schemas, "ParseResponse")
+	require.Contains(t, document.Components.Schemas, "ern.v432.NewReleaseMessage")
+	require.Contains(t, document.Components.Schemas, "DDEXMessage")
+	require.Contains(t, document.Components.Responses, "ValidationFailed")
+	require.Equal(t, "#/components/responses/ValidationFailed", document.Components.PathItems["Parse"].Post.Responses["422"].Ref)
+}
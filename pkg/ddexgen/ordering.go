@@ -0,0 +1,154 @@
+package ddexgen
+
+import (
+	"encoding/xml"
+	"fmt"
+	"io"
+	"os"
+	"sort"
+	"strings"
+)
+
+// contentModel is the child element sequence of a complex type as declared in a DDEX schema
+type contentModel struct {
+	// base is the complex type this one extends, if any
+	base string
+	// elements are the child elements in schema order
+	elements []string
+	// free is set when the content has a repeating choice, sequence or all group, or declares an element
+	// more than once (as alternative sequences of a choice do), so its children have no single order
+	free bool
+}
+
+// findContentModels scans a DDEX schema file and returns the content model of every complex type, keyed
+// by type name (or by element name for anonymous complex types)
+func findContentModels(schemaPath string) (map[string]*contentModel, error) {
+	file, err := os.Open(schemaPath)
+	if err != nil {
+		return nil, err
+	}
+	defer file.Close()
+
+	var stack []xsdNode
+	models := make(map[string]*contentModel)
+	model := func(owner string) *contentModel {
+		if models[owner] == nil {
+			models[owner] = &contentModel{}
+		}
+		return models[owner]
+	}
+
+	decoder := xml.NewDecoder(file)
+	for {
+		tok, err := decoder.Token()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, fmt.Errorf("parsing %s: %w", schemaPath, err)
+		}
+
+		switch t := tok.(type) {
+		case xml.StartElement:
+			if owner, ok := complexTypeOwner(stack); ok {
+				switch t.Name.Local {
+				case "element":
+					if name := attrValue(t, "name"); name != "" {
+						m := model(owner)
+						elements := appendUnique(m.elements, name)
+						m.free = m.free || len(elements) == len(m.elements)
+						m.elements = elements
+					}
+				case "extension":
+					if len(stack) > 0 && stack[len(stack)-1].kind == "complexContent" {
+						base := attrValue(t, "base")
+						model(owner).base = base[strings.LastIndex(base, ":")+1:]
+					}
+				case "choice", "sequence", "all":
+					maxOccurs := attrValue(t, "maxOccurs")
+					if t.Name.Local == "all" || (maxOccurs != "" && maxOccurs != "0" && maxOccurs != "1") {
+						model(owner).free = true
+					}
+				}
+			}
+			stack = append(stack, xsdNode{kind: t.Name.Local, name: attrValue(t, "name")})
+		case xml.EndElement:
+			stack = stack[:len(stack)-1]
+		}
+	}
+	return models, nil
+}
+
+// resolveContentModel returns the child elements of type name with those of its extension bases first,
+// and whether any of them allows free ordering
+func resolveContentModel(models map[string]*contentModel, name string, depth int) ([]string, bool) {
+	model, ok := models[name]
+	if !ok || depth > 32 {
+		return nil, false
+	}
+	elements, free := resolveContentModel(models, model.base, depth+1)
+	for _, element := range model.elements {
+		elements = appendUnique(elements, element)
+	}
+	return elements, free || model.free
+}
+
+// elementOrderForPackage returns, by generated struct name, the schema order of the child elements of
+// the package's structs. Structs with fewer than two child elements or with freely ordered content are
+// left out.
+func elementOrderForPackage(pbPath, schemaPath string) (map[string][]string, error) {
+	models, err := findContentModels(schemaPath)
+	if err != nil {
+		return nil, err
+	}
+
+	pkg, err := parseSchemaPackage(pbPath)
+	if err != nil {
+		return nil, err
+	}
+
+	order := make(map[string][]string)
+	for name := range pkg.Structs {
+		elements, free := resolveContentModel(models, name, 0)
+		if !free && len(elements) > 1 {
+			order[name] = elements
+		}
+	}
+	return order, nil
+}
+
+// generateElementOrder creates the childElementOrder table and ChildElementOrder for registry.go
+func generateElementOrder(packages []PackageInfo) string {
+	var sb strings.Builder
+
+	sb.WriteString("// childElementOrder maps generated types to the order the DDEX schema sequence requires of their\n")
+	sb.WriteString("// child elements\n")
+	sb.WriteString("var childElementOrder = map[reflect.Type][]string{\n")
+	for _, pkg := range packages {
+		var names []string
+		for name := range pkg.ElementOrder {
+			names = append(names, name)
+		}
+		sort.Strings(names)
+
+		for _, name := range names {
+			quoted := make([]string, len(pkg.ElementOrder[name]))
+			for i, element := range pkg.ElementOrder[name] {
+				quoted[i] = fmt.Sprintf("%q", element)
+			}
+			sb.WriteString(fmt.Sprintf("\treflect.TypeOf(%s.%s{}): {%s},\n", pkg.PackageName, name, strings.Join(quoted, ", ")))
+		}
+	}
+	sb.WriteString("}\n\n")
+
+	sb.WriteString(`// ChildElementOrder returns the child element names of generated type t in the order its DDEX schema
+// sequence requires, or nil if the schema lets them appear in any order
+func ChildElementOrder(t reflect.Type) []string {
+	for t.Kind() == reflect.Ptr {
+		t = t.Elem()
+	}
+	return childElementOrder[t]
+}
+`)
+	return sb.String()
+}
@@ -0,0 +1,84 @@
+package ddexgen
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+// Plan lists the files Generate would write, as computed by PlanGenerate
+type Plan struct {
+	// EnumFiles are the enum_strings.go files
+	EnumFiles []string
+	// XMLFiles are the <version>.xml.go files
+	XMLFiles []string
+	// ResourceFiles are the resources.go files
+	ResourceFiles []string
+	// Registry is the path of registry.go, or empty if it would not be generated
+	Registry string
+}
+
+// PlanGenerate walks targetDir like Generate with the same options and returns the files it would write,
+// without writing anything
+func PlanGenerate(targetDir string, opts Options) (*Plan, error) {
+	outputPath := func(path string) string {
+		if opts.OutDir == "" {
+			return path
+		}
+		relPath, err := filepath.Rel(targetDir, path)
+		if err != nil {
+			return path
+		}
+		return filepath.Join(opts.OutDir, relPath)
+	}
+
+	plan := &Plan{}
+	hasPackages := false
+	err := filepath.Walk(targetDir, func(path string, info os.FileInfo, err error) error {
+		if err != nil {
+			return err
+		}
+		if !strings.HasSuffix(path, ".pb.go") {
+			return nil
+		}
+		packageDir := filepath.Dir(path)
+
+		enums, err := findEnumTypes(path)
+		if err != nil {
+			return fmt.Errorf("parsing %s: %w", path, err)
+		}
+		messages, err := findMessageTypes(path)
+		if err != nil {
+			return fmt.Errorf("parsing messages %s: %w", path, err)
+		}
+
+		if len(enums) > 0 && opts.produces(ArtifactEnums) {
+			plan.EnumFiles = append(plan.EnumFiles, outputPath(filepath.Join(packageDir, "enum_strings.go")))
+		}
+		if len(messages) > 0 && opts.produces(ArtifactXML) {
+			plan.XMLFiles = append(plan.XMLFiles, outputPath(filepath.Join(packageDir, filepath.Base(packageDir)+".xml.go")))
+		}
+		if len(messages) > 0 && opts.produces(ArtifactResources) {
+			pkg, err := parseSchemaPackage(path)
+			if err != nil {
+				return fmt.Errorf("parsing %s: %w", path, err)
+			}
+			if len(findResourceTypes(pkg)) > 0 {
+				plan.ResourceFiles = append(plan.ResourceFiles, outputPath(filepath.Join(packageDir, "resources.go")))
+			}
+		}
+		if len(messages) > 0 && strings.Contains(packageDir, "ddex") && deriveNamespaceInfo(packageDir) != nil {
+			hasPackages = true
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("walking directory: %w", err)
+	}
+
+	if hasPackages && opts.produces(ArtifactRegistry) {
+		plan.Registry = outputPath(filepath.Join(targetDir, "registry.go"))
+	}
+	return plan, nil
+}
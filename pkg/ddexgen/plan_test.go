@@ -0,0 +1,27 @@
+package ddexgen
+
+import (
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+// TestPlanGenerate verifies the generation plan for gen/ matches the generated files
+func TestPlanGenerate(t *testing.T) {
+	// The generator reads the XSDs under xsd/ relative to the working directory
+	t.Chdir("../..")
+	plan, err := PlanGenerate("gen", Options{})
+	require.NoError(t, err)
+	require.Len(t, plan.EnumFiles, 6)
+	require.Contains(t, plan.XMLFiles, filepath.Join("gen", "ddex", "ern", "v43", "v43.xml.go"))
+	require.Equal(t, filepath.Join("gen", "registry.go"), plan.Registry)
+	require.Len(t, plan.ResourceFiles, 5)
+
+	plan, err = PlanGenerate("gen", Options{Only: []Artifact{ArtifactEnums}, OutDir: "out"})
+	require.NoError(t, err)
+	require.Empty(t, plan.XMLFiles)
+	require.Empty(t, plan.ResourceFiles)
+	require.Empty(t, plan.Registry)
+	require.Contains(t, plan.EnumFiles, filepath.Join("out", "ddex", "avs", "vlatest", "enum_strings.go"))
+}
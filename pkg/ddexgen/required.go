@@ -0,0 +1,124 @@
+package ddexgen
+
+import (
+	"encoding/xml"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+)
+
+// requiredMembers are the child elements and attributes a complex type requires
+type requiredMembers struct {
+	// base is the complex type this one extends, if any
+	base string
+	// names are the required elements (minOccurs of at least 1 outside any choice or optional group) and
+	// attributes (use="required")
+	names []string
+}
+
+// findRequiredMembers scans a DDEX schema file and returns the required child elements and attributes of
+// every complex type, keyed by type name (or by element name for anonymous complex types)
+func findRequiredMembers(schemaPath string) (map[string]*requiredMembers, error) {
+	file, err := os.Open(schemaPath)
+	if err != nil {
+		return nil, err
+	}
+	defer file.Close()
+
+	var stack []xsdNode
+	// optional runs parallel to stack: whether the node is a choice or has minOccurs="0"
+	var optional []bool
+	members := make(map[string]*requiredMembers)
+	member := func(owner string) *requiredMembers {
+		if members[owner] == nil {
+			members[owner] = &requiredMembers{}
+		}
+		return members[owner]
+	}
+
+	decoder := xml.NewDecoder(file)
+	for {
+		tok, err := decoder.Token()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, fmt.Errorf("parsing %s: %w", schemaPath, err)
+		}
+
+		switch t := tok.(type) {
+		case xml.StartElement:
+			if owner, ok := complexTypeOwner(stack); ok {
+				switch t.Name.Local {
+				case "element":
+					if name := attrValue(t, "name"); name != "" && attrValue(t, "minOccurs") != "0" && !inOptionalGroup(stack, optional) {
+						m := member(owner)
+						m.names = appendUnique(m.names, name)
+					}
+				case "attribute":
+					if name := attrValue(t, "name"); name != "" && attrValue(t, "use") == "required" {
+						m := member(owner)
+						m.names = appendUnique(m.names, name)
+					}
+				case "extension":
+					if len(stack) > 0 && stack[len(stack)-1].kind == "complexContent" {
+						base := attrValue(t, "base")
+						member(owner).base = base[strings.LastIndex(base, ":")+1:]
+					}
+				}
+			}
+			stack = append(stack, xsdNode{kind: t.Name.Local, name: attrValue(t, "name")})
+			optional = append(optional, t.Name.Local == "choice" || attrValue(t, "minOccurs") == "0")
+		case xml.EndElement:
+			stack = stack[:len(stack)-1]
+			optional = optional[:len(optional)-1]
+		}
+	}
+	return members, nil
+}
+
+// inOptionalGroup reports whether a choice or an optional group lies between the top of stack and the
+// complex type it belongs to
+func inOptionalGroup(stack []xsdNode, optional []bool) bool {
+	for i := len(stack) - 1; i >= 0 && stack[i].kind != "complexType"; i-- {
+		if optional[i] {
+			return true
+		}
+	}
+	return false
+}
+
+// resolveRequiredMembers returns the required members of type name, including those of its extension
+// bases
+func resolveRequiredMembers(members map[string]*requiredMembers, name string, depth int) []string {
+	m, ok := members[name]
+	if !ok || depth > 32 {
+		return nil
+	}
+	names := resolveRequiredMembers(members, m.base, depth+1)
+	for _, member := range m.names {
+		names = appendUnique(names, member)
+	}
+	return names
+}
+
+// requiredFieldsForPackage returns, by generated struct name, the XML names of the fields the package's
+// schema requires
+func requiredFieldsForPackage(pkg *schemaPackage, schemaPath string) (map[string]map[string]bool, error) {
+	members, err := findRequiredMembers(schemaPath)
+	if err != nil {
+		return nil, err
+	}
+
+	required := make(map[string]map[string]bool)
+	for name := range pkg.Structs {
+		for _, member := range resolveRequiredMembers(members, name, 0) {
+			if required[name] == nil {
+				required[name] = make(map[string]bool)
+			}
+			required[name][member] = true
+		}
+	}
+	return required, nil
+}
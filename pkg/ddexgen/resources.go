@@ -0,0 +1,142 @@
+package ddexgen
+
+import (
+	"fmt"
+	"go/ast"
+	"os"
+	"sort"
+	"strings"
+)
+
+// resourceType is a resource struct found by findResourceTypes
+type resourceType struct {
+	Name string
+	// TypeField is the field holding the resource type: Type in ERN 4, e.g. SoundRecordingType in ERN 3
+	TypeField string
+}
+
+// findResourceTypes returns the structs of a package that are DDEX resources: a string ResourceReference
+// and a Type (or <Struct>Type) element whose Value is the resource type, sorted by name
+func findResourceTypes(pkg *schemaPackage) []resourceType {
+	var resources []resourceType
+	for name, fields := range pkg.Structs {
+		hasReference, typeField := false, ""
+		for _, field := range fields {
+			if ident, ok := field.Type.(*ast.Ident); ok && field.GoName == "ResourceReference" && ident.Name == "string" {
+				hasReference = true
+			}
+			if field.GoName != "Type" && field.GoName != name+"Type" {
+				continue
+			}
+			star, ok := field.Type.(*ast.StarExpr)
+			if !ok {
+				continue
+			}
+			ident, ok := star.X.(*ast.Ident)
+			if !ok {
+				continue
+			}
+			for _, valueField := range pkg.Structs[ident.Name] {
+				if valueIdent, ok := valueField.Type.(*ast.Ident); ok && valueField.GoName == "Value" && valueIdent.Name == "string" {
+					typeField = field.GoName
+				}
+			}
+		}
+		if hasReference && typeField != "" {
+			resources = append(resources, resourceType{Name: name, TypeField: typeField})
+		}
+	}
+	sort.Slice(resources, func(i, j int) bool { return resources[i].Name < resources[j].Name })
+	return resources
+}
+
+// generateResourcesFile writes resources.go for the resource types of a .pb.go file, reporting whether
+// the package has any
+func generateResourcesFile(resourcesPath, header, pbPath, packageName string) (bool, error) {
+	pkg, err := parseSchemaPackage(pbPath)
+	if err != nil {
+		return false, err
+	}
+	resources := findResourceTypes(pkg)
+	if len(resources) == 0 {
+		return false, nil
+	}
+	content := generateResourcesContent(header, packageName, resources, resourceListFields(pkg, resources))
+	return true, os.WriteFile(resourcesPath, []byte(content), 0644)
+}
+
+// resourceListFields returns the ResourceList fields that are lists of resources, in field order
+func resourceListFields(pkg *schemaPackage, resources []resourceType) []string {
+	isResource := make(map[string]bool)
+	for _, resource := range resources {
+		isResource[resource.Name] = true
+	}
+	var fields []string
+	for _, field := range pkg.Structs["ResourceList"] {
+		array, ok := field.Type.(*ast.ArrayType)
+		if !ok {
+			continue
+		}
+		if star, ok := array.Elt.(*ast.StarExpr); ok {
+			if ident, ok := star.X.(*ast.Ident); ok && isResource[ident.Name] {
+				fields = append(fields, field.GoName)
+			}
+		}
+	}
+	return fields
+}
+
+// generateResourcesContent creates the content of resources.go: the Resource interface, ResourceType
+// methods and assertions for the resource types, and an iterator over a ResourceList's resources
+func generateResourcesContent(header, packageName string, resources []resourceType, listFields []string) string {
+	var sb strings.Builder
+
+	sb.WriteString(header)
+	sb.WriteString(fmt.Sprintf("package %s\n\n", packageName))
+	if len(listFields) > 0 {
+		sb.WriteString("import \"iter\"\n\n")
+	}
+
+	names := make([]string, len(resources))
+	for i, resource := range resources {
+		names[i] = resource.Name
+	}
+	sb.WriteString("// Resource is implemented by the resource types of the package, which share a ResourceReference and a\n")
+	sb.WriteString(fmt.Sprintf("// resource type: %s\n", strings.Join(names, ", ")))
+	sb.WriteString("type Resource interface {\n")
+	sb.WriteString("\tGetResourceReference() string\n")
+	sb.WriteString("\t// ResourceType returns the Value of the resource's type element, e.g. MusicalWorkSoundRecording\n")
+	sb.WriteString("\tResourceType() string\n")
+	sb.WriteString("}\n\n")
+
+	sb.WriteString("var (\n")
+	for _, resource := range resources {
+		sb.WriteString(fmt.Sprintf("\t_ Resource = (*%s)(nil)\n", resource.Name))
+	}
+	sb.WriteString(")\n")
+
+	for _, resource := range resources {
+		sb.WriteString(fmt.Sprintf("\n// ResourceType returns the Value of %s\n", resource.TypeField))
+		sb.WriteString(fmt.Sprintf("func (x *%s) ResourceType() string {\n", resource.Name))
+		sb.WriteString(fmt.Sprintf("\treturn x.Get%s().GetValue()\n", resource.TypeField))
+		sb.WriteString("}\n")
+	}
+
+	if len(listFields) > 0 {
+		sb.WriteString("\n// Resources yields the resources of the list, kind by kind in schema order:\n")
+		sb.WriteString("// " + strings.Join(listFields, ", ") + "\n")
+		sb.WriteString("func (x *ResourceList) Resources() iter.Seq[Resource] {\n")
+		sb.WriteString("\treturn func(yield func(Resource) bool) {\n")
+		for _, field := range listFields {
+			sb.WriteString(fmt.Sprintf("\t\tfor _, resource := range x.Get%s() {\n", field))
+			sb.WriteString("\t\t\tif !yield(resource) {\n")
+			sb.WriteString("\t\t\t\treturn\n")
+			sb.WriteString("\t\t\t}\n")
+			sb.WriteString("\t\t}\n")
+		}
+		sb.WriteString("\t}\n")
+		sb.WriteString("}\n")
+	}
+
+	return sb.String()
+}
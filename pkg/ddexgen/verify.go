@@ -0,0 +1,32 @@
+package ddexgen
+
+import (
+	"bytes"
+	"fmt"
+	"os/exec"
+	"path/filepath"
+	"strings"
+)
+
+// verifyBuild runs `go build` on every package under targetDir, through the overlay when the generated
+// files were written to outDir, and returns the compiler output as an error if the build fails
+func verifyBuild(targetDir, outDir string) error {
+	args := []string{"build"}
+	if outDir != "" {
+		args = append(args, "-overlay", filepath.Join(outDir, "overlay.json"))
+	}
+	args = append(args, "./...")
+
+	cmd := exec.Command("go", args...)
+	cmd.Dir = targetDir
+	var output bytes.Buffer
+	cmd.Stdout = &output
+	cmd.Stderr = &output
+	if err := cmd.Run(); err != nil {
+		if _, ok := err.(*exec.ExitError); !ok {
+			return fmt.Errorf("running go build: %w", err)
+		}
+		return fmt.Errorf("generated code does not compile:\n%s", strings.TrimSpace(output.String()))
+	}
+	return nil
+}
@@ -0,0 +1,117 @@
+// Package ddexhttp serves DDEX parsing and validation over HTTP, so tools can mount an endpoint that
+// checks a delivery without writing the request handling themselves.
+package ddexhttp
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"mime"
+	"net/http"
+	"strings"
+
+	ddex "github.com/alecsavvy/ddex-proto"
+	"github.com/alecsavvy/ddex-proto/gen"
+)
+
+// MaxBodySize is the largest request body Handler reads; larger documents are rejected with 413
+const MaxBodySize = 64 << 20
+
+// Response is the JSON body Handler writes
+type Response struct {
+	// MessageType, Version and MessageName identify the parsed message, e.g. ern, v432, NewReleaseMessage
+	MessageType string `json:"message_type,omitempty"`
+	Version     string `json:"version,omitempty"`
+	MessageName string `json:"message_name,omitempty"`
+	// Valid reports whether the message parsed without validation errors
+	Valid bool `json:"valid"`
+	// Errors are the validation errors found by ddex.ParseAndValidate
+	Errors []string `json:"errors,omitempty"`
+	// Error is set when the request could not be handled, e.g. when the document does not parse
+	Error string `json:"error,omitempty"`
+}
+
+// Handler returns a handler that parses a DDEX message POSTed as the request body and validates it like
+// ddex.ParseAndValidate. It responds with a JSON Response and the status:
+//
+//   - 200 OK when the message is valid
+//   - 422 Unprocessable Entity when it parses with validation errors
+//   - 400 Bad Request when it does not parse
+//   - 405 Method Not Allowed for methods other than POST
+//   - 406 Not Acceptable when the Accept header does not allow application/json
+//   - 413 Request Entity Too Large for bodies over MaxBodySize
+func Handler() http.Handler {
+	return http.HandlerFunc(serveParse)
+}
+
+// serveParse handles a parse request
+func serveParse(w http.ResponseWriter, r *http.Request) {
+	if !acceptsJSON(r.Header.Values("Accept")) {
+		http.Error(w, "responses are application/json", http.StatusNotAcceptable)
+		return
+	}
+	if r.Method != http.MethodPost {
+		w.Header().Set("Allow", http.MethodPost)
+		writeResponse(w, http.StatusMethodNotAllowed, Response{Error: fmt.Sprintf("method %s not allowed, POST the XML document", r.Method)})
+		return
+	}
+
+	data, err := io.ReadAll(http.MaxBytesReader(w, r.Body, MaxBodySize))
+	if err != nil {
+		var maxBytesErr *http.MaxBytesError
+		if errors.As(err, &maxBytesErr) {
+			writeResponse(w, http.StatusRequestEntityTooLarge, Response{Error: fmt.Sprintf("document is larger than %d bytes", maxBytesErr.Limit)})
+			return
+		}
+		writeResponse(w, http.StatusBadRequest, Response{Error: fmt.Sprintf("failed to read request body: %v", err)})
+		return
+	}
+
+	_, validationErrs, err := ddex.ParseAndValidate(data)
+	if err != nil {
+		writeResponse(w, http.StatusBadRequest, Response{Error: err.Error()})
+		return
+	}
+
+	var resp Response
+	resp.MessageType, resp.Version, resp.MessageName, _ = gen.DetectMessageType(data)
+	for _, validationErr := range validationErrs {
+		resp.Errors = append(resp.Errors, validationErr.Error())
+	}
+	resp.Valid = len(resp.Errors) == 0
+
+	status := http.StatusOK
+	if !resp.Valid {
+		status = http.StatusUnprocessableEntity
+	}
+	writeResponse(w, status, resp)
+}
+
+// acceptsJSON reports whether Accept header values allow an application/json response; no header
+// accepts anything
+func acceptsJSON(accept []string) bool {
+	if len(accept) == 0 {
+		return true
+	}
+	for _, value := range accept {
+		for _, mediaRange := range strings.Split(value, ",") {
+			mediaType, params, err := mime.ParseMediaType(strings.TrimSpace(mediaRange))
+			if err != nil || params["q"] == "0" {
+				continue
+			}
+			switch mediaType {
+			case "application/json", "application/*", "*/*":
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// writeResponse writes resp as the JSON body with status
+func writeResponse(w http.ResponseWriter, status int, resp Response) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	json.NewEncoder(w).Encode(resp)
+}
@@ -0,0 +1,77 @@
+package ddexhttp
+
+import (
+	"bytes"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/alecsavvy/ddex-proto/testdata"
+	"github.com/stretchr/testify/require"
+)
+
+// neverEnding is an endless stream of one byte
+type neverEnding byte
+
+// Read implements io.Reader
+func (b neverEnding) Read(p []byte) (int, error) {
+	for i := range p {
+		p[i] = byte(b)
+	}
+	return len(p), nil
+}
+
+// TestHandler verifies the status codes and JSON bodies of the parse endpoint
+func TestHandler(t *testing.T) {
+	xmlData, err := testdata.DDEXTestDataFS.ReadFile("ddex/ern/v43/1 Audio.xml")
+	require.NoError(t, err)
+	broken := bytes.Replace(xmlData, []byte("<ResourceReference>A1</ResourceReference>"), []byte("<ResourceReference>X1</ResourceReference>"), 1)
+
+	serve := func(method, accept string, body io.Reader) (*httptest.ResponseRecorder, map[string]interface{}) {
+		req := httptest.NewRequest(method, "/ddex/parse", body)
+		if accept != "" {
+			req.Header.Set("Accept", accept)
+		}
+		rec := httptest.NewRecorder()
+		Handler().ServeHTTP(rec, req)
+		var resp map[string]interface{}
+		if rec.Header().Get("Content-Type") == "application/json" {
+			require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &resp), rec.Body.String())
+		}
+		return rec, resp
+	}
+
+	rec, resp := serve(http.MethodPost, "application/json", bytes.NewReader(xmlData))
+	require.Equal(t, http.StatusOK, rec.Code)
+	require.Equal(t, map[string]interface{}{"message_type": "ern", "version": "v43", "message_name": "NewReleaseMessage", "valid": true}, resp)
+
+	rec, resp = serve(http.MethodPost, "", bytes.NewReader(broken))
+	require.Equal(t, http.StatusUnprocessableEntity, rec.Code)
+	require.Equal(t, false, resp["valid"])
+	require.Equal(t, "NewReleaseMessage", resp["message_name"])
+	require.NotEmpty(t, resp["errors"])
+	require.NotContains(t, resp, "error")
+
+	rec, resp = serve(http.MethodPost, "*/*", strings.NewReader("<NotDDEX/>"))
+	require.Equal(t, http.StatusBadRequest, rec.Code)
+	require.Equal(t, false, resp["valid"])
+	require.NotEmpty(t, resp["error"])
+	require.NotContains(t, resp, "message_type")
+
+	rec, resp = serve(http.MethodGet, "", nil)
+	require.Equal(t, http.StatusMethodNotAllowed, rec.Code)
+	require.Equal(t, http.MethodPost, rec.Header().Get("Allow"))
+	require.Equal(t, "method GET not allowed, POST the XML document", resp["error"])
+
+	for _, accept := range []string{"text/html", "application/json;q=0", "application/xml, application/json; q=0"} {
+		rec, _ = serve(http.MethodPost, accept, bytes.NewReader(xmlData))
+		require.Equal(t, http.StatusNotAcceptable, rec.Code, accept)
+	}
+
+	rec, resp = serve(http.MethodPost, "", io.LimitReader(neverEnding(' '), MaxBodySize+1))
+	require.Equal(t, http.StatusRequestEntityTooLarge, rec.Code)
+	require.Equal(t, "document is larger than 67108864 bytes", resp["error"])
+}
@@ -132,6 +132,17 @@ func ParseFile(inputPath string, src interface{}, xxxSkip []string) (areas []Tex
 	return
 }
 
+// Inject returns contents with the custom tags of areas injected, without writing anything
+func Inject(contents []byte, areas []TextArea, removeTagComment bool) []byte {
+	// inject custom tags from tail of file first to preserve order
+	for i := range areas {
+		area := areas[len(areas)-i-1]
+		logf("inject custom tag %q to expression %q", area.InjectTag, string(contents[area.Start-1:area.End-1]))
+		contents = injectTag(contents, area, removeTagComment)
+	}
+	return contents
+}
+
 // WriteFile writes the modified file with injected custom tags
 func WriteFile(inputPath string, areas []TextArea, removeTagComment bool) (err error) {
 	f, err := os.Open(inputPath)
@@ -148,12 +159,7 @@ func WriteFile(inputPath string, areas []TextArea, removeTagComment bool) (err e
 		return
 	}
 
-	// inject custom tags from tail of file first to preserve order
-	for i := range areas {
-		area := areas[len(areas)-i-1]
-		logf("inject custom tag %q to expression %q", area.InjectTag, string(contents[area.Start-1:area.End-1]))
-		contents = injectTag(contents, area, removeTagComment)
-	}
+	contents = Inject(contents, areas, removeTagComment)
 	if err = os.WriteFile(inputPath, contents, 0o644); err != nil {
 		return
 	}
@@ -70,7 +70,7 @@ func injectTag(contents []byte, area TextArea, removeTagComment bool) (injected
 	ti := cti.override(iti)
 	expr = rInject.ReplaceAll(expr, []byte(fmt.Sprintf("`%s`", ti.format())))
 
-	if removeTagComment {
+	if removeTagComment && area.CommentEnd > area.CommentStart {
 		strippedComment := make([]byte, area.CommentEnd-area.CommentStart)
 		copy(strippedComment, contents[area.CommentStart-1:area.CommentEnd-1])
 		strippedComment = rAll.ReplaceAll(expr, []byte(" "))
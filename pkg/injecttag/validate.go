@@ -0,0 +1,94 @@
+package injecttag
+
+import (
+	"fmt"
+	"go/ast"
+	"go/parser"
+	"go/token"
+	"reflect"
+	"strconv"
+)
+
+// internalFields are exported message fields that are not part of the XML content model and need no xml tag
+var internalFields = map[string]bool{
+	"NamespaceAttrs": true,
+}
+
+// MissingTag is an exported field of a message struct without an xml tag, which encoding/xml would
+// marshal under its Go name and so lose on a round-trip
+type MissingTag struct {
+	Position token.Position
+	Struct   string
+	Field    string
+}
+
+// String formats the missing tag as file:line: Struct.Field has no xml tag
+func (m MissingTag) String() string {
+	return fmt.Sprintf("%s: %s.%s has no xml tag", m.Position, m.Struct, m.Field)
+}
+
+// ValidateFile parses a Go source file and returns the exported fields of its message structs (structs
+// with a protoimpl.MessageState field, as protoc-gen-go writes them) that have no xml tag, excluding
+// internal fields such as NamespaceAttrs
+func ValidateFile(inputPath string, src interface{}) (missing []MissingTag, err error) {
+	logf("validating xml tags of file %q", inputPath)
+	fset := token.NewFileSet()
+	f, err := parser.ParseFile(fset, inputPath, src, 0)
+	if err != nil {
+		return nil, err
+	}
+
+	ast.Inspect(f, func(node ast.Node) bool {
+		typeSpec, ok := node.(*ast.TypeSpec)
+		if !ok {
+			return true
+		}
+		structDecl, ok := typeSpec.Type.(*ast.StructType)
+		if !ok || !isMessageStruct(structDecl) {
+			return false
+		}
+
+		for _, field := range structDecl.Fields.List {
+			for _, name := range field.Names {
+				if !name.IsExported() || internalFields[name.Name] || hasXMLTag(field.Tag) {
+					continue
+				}
+				missing = append(missing, MissingTag{
+					Position: fset.Position(name.Pos()),
+					Struct:   typeSpec.Name.Name,
+					Field:    name.Name,
+				})
+			}
+		}
+		return false
+	})
+	logf("validated file %q, number of fields without an xml tag: %d", inputPath, len(missing))
+	return missing, nil
+}
+
+// isMessageStruct reports whether a struct has the protoimpl.MessageState field of generated messages
+func isMessageStruct(structDecl *ast.StructType) bool {
+	for _, field := range structDecl.Fields.List {
+		selector, ok := field.Type.(*ast.SelectorExpr)
+		if !ok || selector.Sel.Name != "MessageState" {
+			continue
+		}
+		if pkg, ok := selector.X.(*ast.Ident); ok && pkg.Name == "protoimpl" {
+			return true
+		}
+	}
+	return false
+}
+
+// hasXMLTag reports whether a struct tag literal has an xml key
+func hasXMLTag(tag *ast.BasicLit) bool {
+	if tag == nil {
+		return false
+	}
+	value, err := strconv.Unquote(tag.Value)
+	if err != nil {
+		return false
+	}
+	_, ok := reflect.StructTag(value).Lookup("xml")
+	return ok
+}
@@ -0,0 +1,36 @@
+package injecttag
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+const validateFixture = `package t
+
+import "google.golang.org/protobuf/runtime/protoimpl"
+
+type Release struct {
+	state          protoimpl.MessageState
+	Title          string            ` + "`protobuf:\"bytes,1,opt,name=title\" xml:\"Title\"`" + `
+	Isrc           string            ` + "`protobuf:\"bytes,2,opt,name=isrc\"`" + `
+	NamespaceAttrs map[string]string ` + "`protobuf:\"bytes,3,rep,name=namespace_attrs\"`" + `
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+// Options is not a message, so its fields need no xml tags
+type Options struct {
+	Verbose bool
+}
+`
+
+// TestValidateFile verifies exported message fields without an xml tag are reported
+func TestValidateFile(t *testing.T) {
+	missing, err := ValidateFile("t.pb.go", validateFixture)
+	require.NoError(t, err)
+	require.Len(t, missing, 1)
+	require.Equal(t, "Release", missing[0].Struct)
+	require.Equal(t, "Isrc", missing[0].Field)
+	require.Equal(t, "t.pb.go:8:2: Release.Isrc has no xml tag", missing[0].String())
+}
@@ -0,0 +1,239 @@
+package injecttag
+
+import (
+	"encoding/xml"
+	"fmt"
+	"go/ast"
+	"go/parser"
+	"go/token"
+	"io"
+	"os"
+	"reflect"
+	"sort"
+	"strings"
+)
+
+// Schema holds the child elements and attributes of each complex type in an XSD, used to derive xml
+// tags for fields that have no @gotags comment
+type Schema struct {
+	// Namespace is the schema's targetNamespace
+	Namespace string
+
+	types map[string]*schemaType
+}
+
+// schemaType is a complex type (or an element's anonymous complex type) and its members, keyed by
+// normalized name
+type schemaType struct {
+	base          string
+	simpleContent bool
+	members       map[string]schemaMember
+}
+
+// schemaMember is a child element or attribute of a complex type
+type schemaMember struct {
+	name      string
+	attr      bool
+	qualified bool
+}
+
+// schemaNode is an open XSD element while parsing
+type schemaNode struct {
+	kind string
+	name string
+}
+
+// ParseSchema reads the complex types of an XSD file
+func ParseSchema(xsdPath string) (*Schema, error) {
+	file, err := os.Open(xsdPath)
+	if err != nil {
+		return nil, err
+	}
+	defer file.Close()
+
+	schema := &Schema{types: make(map[string]*schemaType)}
+	typeOf := func(owner string) *schemaType {
+		if schema.types[owner] == nil {
+			schema.types[owner] = &schemaType{members: make(map[string]schemaMember)}
+		}
+		return schema.types[owner]
+	}
+
+	var stack []schemaNode
+	var elementsQualified, attributesQualified bool
+
+	decoder := xml.NewDecoder(file)
+	for {
+		tok, err := decoder.Token()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, fmt.Errorf("parsing %s: %w", xsdPath, err)
+		}
+
+		switch t := tok.(type) {
+		case xml.StartElement:
+			kind := t.Name.Local
+			if kind == "schema" {
+				schema.Namespace = schemaAttr(t, "targetNamespace")
+				elementsQualified = schemaAttr(t, "elementFormDefault") == "qualified"
+				attributesQualified = schemaAttr(t, "attributeFormDefault") == "qualified"
+			}
+
+			if owner, ok := schemaOwner(stack); ok {
+				switch kind {
+				case "element", "attribute":
+					name := schemaAttr(t, "name")
+					qualified := elementsQualified
+					if kind == "attribute" {
+						qualified = attributesQualified
+					}
+					if form := schemaAttr(t, "form"); form != "" {
+						qualified = form == "qualified"
+					}
+					if name != "" {
+						typeOf(owner).members[normalizeName(name)] = schemaMember{name: name, attr: kind == "attribute", qualified: qualified}
+					}
+				case "simpleContent":
+					typeOf(owner).simpleContent = true
+				case "extension":
+					base := schemaAttr(t, "base")
+					typeOf(owner).base = base[strings.LastIndex(base, ":")+1:]
+				}
+			}
+			stack = append(stack, schemaNode{kind: kind, name: schemaAttr(t, "name")})
+		case xml.EndElement:
+			stack = stack[:len(stack)-1]
+		}
+	}
+	return schema, nil
+}
+
+// schemaOwner returns the name of the innermost complex type open on stack, or of the element that
+// declares it when it is anonymous
+func schemaOwner(stack []schemaNode) (string, bool) {
+	for i := len(stack) - 1; i >= 0; i-- {
+		if stack[i].kind != "complexType" {
+			continue
+		}
+		if stack[i].name != "" {
+			return stack[i].name, true
+		}
+		if i > 0 && stack[i-1].kind == "element" && stack[i-1].name != "" {
+			return stack[i-1].name, true
+		}
+		return "", false
+	}
+	return "", false
+}
+
+func schemaAttr(t xml.StartElement, name string) string {
+	for _, attr := range t.Attr {
+		if attr.Name.Local == name {
+			return attr.Value
+		}
+	}
+	return ""
+}
+
+// normalizeName maps XSD names and Go field names to a common form, since protoc-gen-go camel-cases the
+// snake_case proto field names (ISRC becomes Isrc)
+func normalizeName(name string) string {
+	return strings.ToLower(strings.ReplaceAll(name, "_", ""))
+}
+
+// TagFor derives the xml tag value for field fieldName of the struct generated for complex type
+// typeName, searching its extension bases too. It reports false when the schema has no such member.
+func (s *Schema) TagFor(typeName, fieldName string) (string, bool) {
+	for depth := 0; depth < 32; depth++ {
+		st, ok := s.types[typeName]
+		if !ok {
+			return "", false
+		}
+		if member, ok := st.members[normalizeName(fieldName)]; ok {
+			tag := member.name
+			if member.qualified && s.Namespace != "" {
+				tag = s.Namespace + " " + tag
+			}
+			if member.attr {
+				tag += ",attr"
+			}
+			return tag, true
+		}
+		if st.simpleContent && fieldName == "Value" {
+			return ",chardata", true
+		}
+		typeName = st.base
+	}
+	return "", false
+}
+
+// ParseFileWithSchema works like ParseFile, and additionally derives xml tags from schema for the struct
+// fields that have neither an xml tag nor an @gotags comment. Structs are matched to complex types by
+// name (for nested messages, by the part after the last underscore) and fields to child elements and
+// attributes by name.
+func ParseFileWithSchema(inputPath string, src interface{}, schema *Schema) (areas []TextArea, err error) {
+	areas, err = ParseFile(inputPath, src, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	covered := make(map[int]bool)
+	for _, area := range areas {
+		covered[area.Start] = true
+	}
+
+	fset := token.NewFileSet()
+	f, err := parser.ParseFile(fset, inputPath, src, parser.ParseComments)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, decl := range f.Decls {
+		genDecl, ok := decl.(*ast.GenDecl)
+		if !ok {
+			continue
+		}
+		for _, spec := range genDecl.Specs {
+			typeSpec, ok := spec.(*ast.TypeSpec)
+			if !ok {
+				continue
+			}
+			structDecl, ok := typeSpec.Type.(*ast.StructType)
+			if !ok {
+				continue
+			}
+
+			typeName := typeSpec.Name.Name
+			typeName = typeName[strings.LastIndex(typeName, "_")+1:]
+
+			for _, field := range structDecl.Fields.List {
+				if len(field.Names) == 0 || !field.Names[0].IsExported() || field.Tag == nil || covered[int(field.Pos())] {
+					continue
+				}
+				currentTag := field.Tag.Value[1 : len(field.Tag.Value)-1]
+				if _, ok := reflect.StructTag(currentTag).Lookup("xml"); ok {
+					continue
+				}
+
+				name := field.Names[0].Name
+				tag, ok := schema.TagFor(typeName, name)
+				if !ok {
+					logf("warn: no schema member for %s.%s", typeSpec.Name.Name, name)
+					continue
+				}
+				areas = append(areas, TextArea{
+					Start:      int(field.Pos()),
+					End:        int(field.End()),
+					CurrentTag: currentTag,
+					InjectTag:  fmt.Sprintf("xml:%q", tag),
+				})
+			}
+		}
+	}
+
+	sort.SliceStable(areas, func(i, j int) bool { return areas[i].Start < areas[j].Start })
+	logf("parsed file %q with schema, number of fields to inject custom tags: %d", inputPath, len(areas))
+	return areas, nil
+}
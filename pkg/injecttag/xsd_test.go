@@ -0,0 +1,78 @@
+package injecttag
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+const schemaFixture = `<?xml version="1.0" encoding="UTF-8"?>
+<xs:schema xmlns:xs="http://www.w3.org/2001/XMLSchema" xmlns:t="http://example.com/t" targetNamespace="http://example.com/t">
+   <xs:complexType name="Release">
+      <xs:sequence>
+         <xs:element name="ReleaseReference" type="xs:string"/>
+         <xs:element name="ISRC" type="xs:string"/>
+      </xs:sequence>
+      <xs:attribute name="LanguageAndScriptCode" type="xs:string"/>
+   </xs:complexType>
+   <xs:complexType name="Title">
+      <xs:simpleContent>
+         <xs:extension base="xs:string">
+            <xs:attribute name="Namespace" type="xs:string"/>
+         </xs:extension>
+      </xs:simpleContent>
+   </xs:complexType>
+</xs:schema>
+`
+
+const structFixture = `package t
+
+type Release struct {
+	ReleaseReference      string ` + "`protobuf:\"bytes,1,opt,name=release_reference\"`" + `
+	Isrc                  string ` + "`protobuf:\"bytes,2,opt,name=isrc\"`" + `
+	LanguageAndScriptCode string ` + "`protobuf:\"bytes,3,opt,name=language_and_script_code\"`" + `
+	Extra                 string ` + "`protobuf:\"bytes,4,opt,name=extra\"`" + `
+	Tagged                string ` + "`protobuf:\"bytes,5,opt,name=tagged\" xml:\"Tagged\"`" + `
+	// @gotags: xml:"Commented"
+	Commented string ` + "`protobuf:\"bytes,6,opt,name=commented\"`" + `
+}
+
+type Release_Title struct {
+	Value     string ` + "`protobuf:\"bytes,1,opt,name=value\"`" + `
+	Namespace string ` + "`protobuf:\"bytes,2,opt,name=namespace\"`" + `
+}
+`
+
+// TestParseFileWithSchema verifies xml tags are derived from the XSD element and attribute names
+func TestParseFileWithSchema(t *testing.T) {
+	xsdPath := filepath.Join(t.TempDir(), "t.xsd")
+	require.NoError(t, os.WriteFile(xsdPath, []byte(schemaFixture), 0644))
+	schema, err := ParseSchema(xsdPath)
+	require.NoError(t, err)
+	require.Equal(t, "http://example.com/t", schema.Namespace)
+
+	areas, err := ParseFileWithSchema("t.pb.go", structFixture, schema)
+	require.NoError(t, err)
+
+	// The field each area covers, by the tag injected into it
+	tags := make(map[string]string)
+	for _, area := range areas {
+		tags[strings.Fields(structFixture[area.Start-1 : area.End-1])[0]] = area.InjectTag
+	}
+	require.Equal(t, map[string]string{
+		"ReleaseReference":      `xml:"ReleaseReference"`,
+		"Isrc":                  `xml:"ISRC"`,
+		"LanguageAndScriptCode": `xml:"LanguageAndScriptCode,attr"`,
+		"Commented":             `xml:"Commented"`,
+		"Value":                 `xml:",chardata"`,
+		"Namespace":             `xml:"Namespace,attr"`,
+	}, tags)
+
+	_, ok := schema.TagFor("Release", "Extra")
+	require.False(t, ok)
+	_, ok = schema.TagFor("Unknown", "Value")
+	require.False(t, ok)
+}
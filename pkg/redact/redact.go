@@ -0,0 +1,106 @@
+// Package redact blanks or hashes personal data in generated DDEX messages, so that copies can be logged
+// or dumped safely.
+package redact
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"reflect"
+	"strings"
+)
+
+// DefaultPIEFields are the PIE elements that hold personal data of a party: its names and their parts,
+// gender, nationality, biography and contact details
+var DefaultPIEFields = []string{
+	"FullName", "FullNameAsciiTranscribed", "FullNameIndexed", "NamesBeforeKeyName", "KeyName",
+	"NamesAfterKeyName", "AbbreviatedName", "TitlesBeforeNames", "TitlesAfterNames", "Pronunciation",
+	"Gender", "Nationality", "Biography", "SocialMediaURL", "email",
+}
+
+// Mode selects how a redacted value is replaced
+type Mode int
+
+const (
+	// Blank sets redacted values to their zero value
+	Blank Mode = iota
+	// Hash replaces redacted strings with "sha256:" and the first 16 hex digits of their digest, so equal
+	// values can still be correlated; other scalars are zeroed
+	Hash
+)
+
+// Redact blanks, in place, every element or attribute of a generated DDEX message named in fields (XML
+// names, e.g. "FullName"), including all text nested under it. A nil fields list means DefaultPIEFields.
+// Clone the message first (proto.Clone) to keep the original.
+func Redact(msg interface{}, fields []string) {
+	Apply(msg, fields, Blank)
+}
+
+// Apply is Redact with a choice of replacement
+func Apply(msg interface{}, fields []string, mode Mode) {
+	if fields == nil {
+		fields = DefaultPIEFields
+	}
+	names := make(map[string]bool, len(fields))
+	for _, field := range fields {
+		names[field] = true
+	}
+	redactValue(reflect.ValueOf(msg), names, mode, false)
+}
+
+// redactValue walks a value, replacing the scalars under a named field once inside is set
+func redactValue(v reflect.Value, names map[string]bool, mode Mode, inside bool) {
+	switch v.Kind() {
+	case reflect.Ptr, reflect.Interface:
+		if !v.IsNil() {
+			redactValue(v.Elem(), names, mode, inside)
+		}
+	case reflect.Slice:
+		if v.Type().Elem().Kind() == reflect.Uint8 {
+			if inside && v.CanSet() {
+				v.Set(reflect.Zero(v.Type()))
+			}
+			return
+		}
+		for i := 0; i < v.Len(); i++ {
+			redactValue(v.Index(i), names, mode, inside)
+		}
+	case reflect.Struct:
+		t := v.Type()
+		for i := 0; i < t.NumField(); i++ {
+			name, ok := xmlName(t.Field(i))
+			if !ok {
+				continue
+			}
+			redactValue(v.Field(i), names, mode, inside || names[name])
+		}
+	case reflect.String:
+		if inside && v.CanSet() && v.String() != "" {
+			v.SetString(replacement(v.String(), mode))
+		}
+	case reflect.Bool, reflect.Int32, reflect.Int64, reflect.Uint32, reflect.Uint64, reflect.Float32, reflect.Float64:
+		if inside && v.CanSet() {
+			v.Set(reflect.Zero(v.Type()))
+		}
+	}
+}
+
+// replacement returns what a redacted string becomes under mode
+func replacement(value string, mode Mode) string {
+	if mode == Hash {
+		sum := sha256.Sum256([]byte(value))
+		return "sha256:" + hex.EncodeToString(sum[:])[:16]
+	}
+	return ""
+}
+
+// xmlName returns the element or attribute name of an XML-mapped struct field ("" for character data)
+func xmlName(sf reflect.StructField) (string, bool) {
+	if !sf.IsExported() {
+		return "", false
+	}
+	tag, ok := sf.Tag.Lookup("xml")
+	if !ok || tag == "-" {
+		return "", false
+	}
+	return strings.Split(tag, ",")[0], true
+}
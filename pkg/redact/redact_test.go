@@ -0,0 +1,32 @@
+package redact
+
+import (
+	"encoding/xml"
+	"testing"
+
+	piev10 "github.com/alecsavvy/ddex-proto/gen/ddex/pie/v10"
+	"github.com/alecsavvy/ddex-proto/testdata"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/proto"
+)
+
+// TestRedact verifies personal data is blanked or hashed on a copy while references and ISNIs stay readable
+func TestRedact(t *testing.T) {
+	xmlData, err := testdata.DDEXTestDataFS.ReadFile("ddex/pie/v10/reward.xml")
+	require.NoError(t, err)
+	msg := &piev10.PieMessage{}
+	require.NoError(t, xml.Unmarshal(xmlData, msg))
+
+	redacted := proto.Clone(msg).(*piev10.PieMessage)
+	Redact(redacted, nil)
+	party := redacted.PartyList.Party[0]
+	require.Empty(t, party.PartyName[0].FullName.Name.Value)
+	require.Equal(t, "P1", party.PartyReference)
+	require.Equal(t, "0000000396456522", party.PartyId[0].ISNI)
+	require.Equal(t, "Norah Jones", msg.PartyList.Party[0].PartyName[0].FullName.Name.Value)
+
+	hashed := proto.Clone(msg).(*piev10.PieMessage)
+	Apply(hashed, []string{"ISNI"}, Hash)
+	require.Regexp(t, `^sha256:[0-9a-f]{16}$`, hashed.PartyList.Party[0].PartyId[0].ISNI)
+	require.Equal(t, "Norah Jones", hashed.PartyList.Party[0].PartyName[0].FullName.Name.Value)
+}
@@ -0,0 +1,144 @@
+package ddex
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	ernv432 "github.com/alecsavvy/ddex-proto/gen/ddex/ern/v432"
+)
+
+// dateLayouts are the ddex:IsoDate forms of dates: a full date, or a year and month or only a year
+var dateLayouts = []string{"2006-01-02", "2006-01", "2006"}
+
+// parseDate parses a ddex:IsoDate value as midnight UTC of its first day
+func parseDate(value string) (time.Time, error) {
+	value = strings.TrimSpace(value)
+	for _, layout := range dateLayouts {
+		if t, err := time.Parse(layout, value); err == nil {
+			return t, nil
+		}
+	}
+	return time.Time{}, fmt.Errorf("invalid date %q", value)
+}
+
+// IsPreOrder reports whether the Release or TrackRelease with releaseRef is on pre-order as of asOf: its
+// street date (the default ReleaseDate, or the first) is after asOf and a deal referencing it that has
+// not ended by asOf is a pre-order deal. A deal is a pre-order deal when DealTerms has IsPreOrderDeal,
+// which ERN 4 uses in place of the PreOrderReleaseDate of ERN 3, or when its ValidityPeriod starts before
+// the street date. Without a ReleaseDate only IsPreOrderDeal counts. It returns an error if the release
+// is not in msg or a date does not parse.
+func IsPreOrder(msg *ernv432.NewReleaseMessage, releaseRef string, asOf time.Time) (bool, error) {
+	releaseRef = strings.TrimSpace(releaseRef)
+	dates, err := releaseDates(msg, releaseRef)
+	if err != nil {
+		return false, err
+	}
+
+	var streetDate time.Time
+	if date := defaultReleaseDate(dates); date != nil {
+		if streetDate, err = parseDate(date.GetValue()); err != nil {
+			return false, fmt.Errorf("ReleaseDate of release %s: %w", releaseRef, err)
+		}
+		if !streetDate.After(asOf) {
+			return false, nil
+		}
+	}
+
+	for _, releaseDeal := range msg.GetDealList().GetReleaseDeal() {
+		referenced := false
+		for _, reference := range releaseDeal.GetDealReleaseReference() {
+			referenced = referenced || strings.TrimSpace(reference) == releaseRef
+		}
+		if !referenced {
+			continue
+		}
+
+		for _, deal := range releaseDeal.GetDeal() {
+			terms := deal.GetDealTerms()
+			start, end, err := validityBounds(terms.GetValidityPeriod())
+			if err != nil {
+				return false, fmt.Errorf("deal for release %s: %w", releaseRef, err)
+			}
+			if !end.IsZero() && !end.After(asOf) {
+				continue
+			}
+			if terms.GetIsPreOrderDeal() || (!start.IsZero() && !streetDate.IsZero() && start.Before(streetDate)) {
+				return true, nil
+			}
+		}
+	}
+	return false, nil
+}
+
+// releaseDates returns the ReleaseDates of the Release or TrackRelease with releaseRef
+func releaseDates(msg *ernv432.NewReleaseMessage, releaseRef string) ([]*ernv432.EventDateWithDefault, error) {
+	if release := msg.GetReleaseList().GetRelease(); release != nil && strings.TrimSpace(release.GetReleaseReference()) == releaseRef {
+		return release.GetReleaseDate(), nil
+	}
+	for _, trackRelease := range msg.GetReleaseList().GetTrackRelease() {
+		if strings.TrimSpace(trackRelease.GetReleaseReference()) == releaseRef {
+			return nil, nil
+		}
+	}
+	return nil, fmt.Errorf("release %s not found", releaseRef)
+}
+
+// defaultReleaseDate returns the ReleaseDate flagged IsDefault, else the one without
+// ApplicableTerritoryCode, else the first
+func defaultReleaseDate(dates []*ernv432.EventDateWithDefault) *ernv432.EventDateWithDefault {
+	for _, date := range dates {
+		if date.GetIsDefault() {
+			return date
+		}
+	}
+	for _, date := range dates {
+		if date.GetApplicableTerritoryCode() == "" {
+			return date
+		}
+	}
+	if len(dates) > 0 {
+		return dates[0]
+	}
+	return nil
+}
+
+// validityBounds returns the earliest start and latest end of a deal's validity periods, zero where a
+// period has no start or end
+func validityBounds(periods []*ernv432.PeriodWithStartDate) (start, end time.Time, err error) {
+	openEnded := false
+	for _, period := range periods {
+		var periodStart, periodEnd time.Time
+		switch {
+		case period.GetStartDateTime().GetValue() != "":
+			periodStart, err = parseDateTime(period.GetStartDateTime().GetValue())
+		case period.GetStartDate().GetValue() != "":
+			periodStart, err = parseDate(period.GetStartDate().GetValue())
+		}
+		if err != nil {
+			return time.Time{}, time.Time{}, fmt.Errorf("ValidityPeriod StartDate: %w", err)
+		}
+		switch {
+		case period.GetEndDateTime().GetValue() != "":
+			periodEnd, err = parseDateTime(period.GetEndDateTime().GetValue())
+		case period.GetEndDate().GetValue() != "":
+			periodEnd, err = parseDate(period.GetEndDate().GetValue())
+		}
+		if err != nil {
+			return time.Time{}, time.Time{}, fmt.Errorf("ValidityPeriod EndDate: %w", err)
+		}
+
+		if !periodStart.IsZero() && (start.IsZero() || periodStart.Before(start)) {
+			start = periodStart
+		}
+		if periodEnd.IsZero() {
+			openEnded = true
+		} else if periodEnd.After(end) {
+			end = periodEnd
+		}
+	}
+	if openEnded {
+		end = time.Time{}
+	}
+	return start, end, nil
+}
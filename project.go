@@ -0,0 +1,216 @@
+package ddex
+
+import (
+	"bytes"
+	"encoding/xml"
+	"fmt"
+	"io"
+	"reflect"
+	"strings"
+
+	"google.golang.org/protobuf/proto"
+)
+
+// Project returns a deep copy of a generated DDEX message in which only the allowed XML paths are populated,
+// for sharing approved fields with a partner. Paths use the form of the other validators, e.g.
+// /NewReleaseMessage/ReleaseList/Release/DisplayTitleText or /NewReleaseMessage/PartyList/Party@PartyReference
+// (no indices: a path covers every repetition). An allowed element keeps everything under it; the elements
+// above it are kept only as containers. The root's namespace declarations are kept so the projection
+// marshals like the original. It returns an error for a path that does not exist in the message type.
+func Project(msg interface{}, allowedPaths []string) (interface{}, error) {
+	protoMsg, ok := msg.(proto.Message)
+	if !ok {
+		return nil, fmt.Errorf("%T is not a protobuf message", msg)
+	}
+	root := reflect.TypeOf(msg).Elem()
+	rootPath := "/" + root.Name()
+
+	allowed := make(map[string]bool)
+	ancestors := make(map[string]bool)
+	for _, path := range allowedPaths {
+		path = strings.TrimSpace(path)
+		if !typeHasPath(root, rootPath, path) {
+			return nil, fmt.Errorf("path %s does not exist in %s", path, root.Name())
+		}
+		allowed[path] = true
+		for i := len(rootPath); i < len(path); i++ {
+			if path[i] == '/' || path[i] == '@' {
+				ancestors[path[:i]] = true
+			}
+		}
+	}
+
+	projection := proto.Clone(protoMsg)
+	if !allowed[rootPath] {
+		projectStruct(reflect.ValueOf(projection).Elem(), rootPath, allowed, ancestors)
+	}
+	return projection, nil
+}
+
+// projectStruct zeroes the XML-mapped fields of a struct at path that are neither allowed nor above an allowed path
+func projectStruct(v reflect.Value, path string, allowed, ancestors map[string]bool) {
+	t := v.Type()
+	for i := 0; i < t.NumField(); i++ {
+		field, ok := xmlFieldOf(t.Field(i))
+		if !ok {
+			continue
+		}
+		childPath := path
+		switch {
+		case field.Attr:
+			childPath = path + "@" + field.Name
+		case !field.CharData && !field.InnerXML:
+			childPath = path + "/" + field.Name
+		}
+
+		switch {
+		case childPath != path && allowed[childPath]:
+		case childPath != path && ancestors[childPath]:
+			projectValue(v.Field(i), childPath, allowed, ancestors)
+		default:
+			v.Field(i).Set(reflect.Zero(v.Field(i).Type()))
+		}
+	}
+}
+
+// projectValue descends into the structs of a field value above an allowed path
+func projectValue(v reflect.Value, path string, allowed, ancestors map[string]bool) {
+	switch v.Kind() {
+	case reflect.Ptr, reflect.Interface:
+		if !v.IsNil() {
+			projectValue(v.Elem(), path, allowed, ancestors)
+		}
+	case reflect.Slice:
+		for i := 0; i < v.Len(); i++ {
+			projectValue(v.Index(i), path, allowed, ancestors)
+		}
+	case reflect.Struct:
+		projectStruct(v, path, allowed, ancestors)
+	}
+}
+
+// typeHasPath reports whether path names an element or attribute of the struct type t found at rootPath
+func typeHasPath(t reflect.Type, rootPath, path string) bool {
+	if path == rootPath {
+		return true
+	}
+	if !strings.HasPrefix(path, rootPath) {
+		return false
+	}
+	rest := path[len(rootPath):]
+	for rest != "" {
+		for t.Kind() == reflect.Ptr || t.Kind() == reflect.Slice {
+			t = t.Elem()
+		}
+		if t.Kind() != reflect.Struct {
+			return false
+		}
+
+		attr := rest[0] == '@'
+		if rest[0] != '/' && !attr {
+			return false
+		}
+		name := rest[1:]
+		if end := strings.IndexAny(name, "/@"); end >= 0 {
+			name, rest = name[:end], name[end:]
+		} else {
+			rest = ""
+		}
+		if attr && rest != "" {
+			return false
+		}
+
+		var next reflect.Type
+		for i := 0; i < t.NumField() && next == nil; i++ {
+			field, ok := xmlFieldOf(t.Field(i))
+			if ok && field.Name == name && field.Attr == attr && !field.CharData && !field.InnerXML {
+				next = t.Field(i).Type
+			}
+		}
+		if next == nil {
+			return false
+		}
+		t = next
+	}
+	return true
+}
+
+// ParseProjection extracts the text of the requested paths from a DDEX document without unmarshaling it:
+// an xml.Decoder streams the document and skips every element that is neither requested nor above a
+// requested path. Paths use the form of Project, e.g. /NewReleaseMessage/ReleaseList/Release/DisplayTitleText
+// or /NewReleaseMessage/PartyList/Party@PartyReference, and cover every repetition. The result maps each
+// path found to its values in document order: the trimmed character data directly inside the element
+// (empty elements are left out) or the attribute value. Unlike Project, paths are not checked against a
+// message type.
+func ParseProjection(data []byte, paths []string) (map[string][]string, error) {
+	wanted := make(map[string]bool)
+	ancestors := make(map[string]bool)
+	for _, path := range paths {
+		path = strings.TrimSpace(path)
+		if !strings.HasPrefix(path, "/") || len(path) == 1 {
+			return nil, fmt.Errorf("invalid path %q: paths start with /<RootElement>", path)
+		}
+		wanted[path] = true
+		for i := 1; i < len(path); i++ {
+			if path[i] == '/' || path[i] == '@' {
+				ancestors[path[:i]] = true
+			}
+		}
+	}
+
+	type openElement struct {
+		path string
+		text *strings.Builder
+	}
+	var stack []openElement
+	values := make(map[string][]string)
+
+	decoder := xml.NewDecoder(bytes.NewReader(data))
+	for {
+		token, err := decoder.Token()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, fmt.Errorf("failed to parse XML: %w", err)
+		}
+
+		switch t := token.(type) {
+		case xml.StartElement:
+			path := "/" + t.Name.Local
+			if len(stack) > 0 {
+				path = stack[len(stack)-1].path + path
+			}
+			for _, attr := range t.Attr {
+				if attrPath := path + "@" + attr.Name.Local; wanted[attrPath] && attr.Name.Space != "xmlns" {
+					values[attrPath] = append(values[attrPath], attr.Value)
+				}
+			}
+
+			if !wanted[path] && !ancestors[path] {
+				if err := decoder.Skip(); err != nil {
+					return nil, fmt.Errorf("failed to parse XML: %w", err)
+				}
+				continue
+			}
+			element := openElement{path: path}
+			if wanted[path] {
+				element.text = &strings.Builder{}
+			}
+			stack = append(stack, element)
+		case xml.CharData:
+			if len(stack) > 0 && stack[len(stack)-1].text != nil {
+				stack[len(stack)-1].text.Write(t)
+			}
+		case xml.EndElement:
+			element := stack[len(stack)-1]
+			stack = stack[:len(stack)-1]
+			if element.text != nil {
+				if text := strings.TrimSpace(element.text.String()); text != "" {
+					values[element.path] = append(values[element.path], text)
+				}
+			}
+		}
+	}
+	return values, nil
+}
@@ -0,0 +1,58 @@
+package ddex
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/alecsavvy/ddex-proto/gen"
+	"google.golang.org/protobuf/proto"
+)
+
+// ToProtoBytes serializes a parsed DDEX message to the compact protobuf binary form.
+// NamespaceAttrs is part of the protobuf schema (namespace_attrs), so the captured xmlns and
+// xsi:schemaLocation values survive the round trip through FromProtoBytes.
+func ToProtoBytes(msg interface{}) ([]byte, error) {
+	protoMsg, ok := msg.(proto.Message)
+	if !ok {
+		return nil, fmt.Errorf("%T is not a protobuf message", msg)
+	}
+	return proto.Marshal(protoMsg)
+}
+
+// FromProtoBytes reconstructs a DDEX message from protobuf binary data using the registry type for
+// messageType, version and messageName (e.g. "ern", "v432", "PurgeReleaseMessage"). The binary form
+// carries no type information, so messageName may only be empty when the version has a single root
+// message (e.g. "mead", "v11").
+func FromProtoBytes(data []byte, messageType, version, messageName string) (interface{}, error) {
+	if messageName == "" {
+		var names []string
+		prefix := fmt.Sprintf("%s/%s/", messageType, version)
+		for key := range gen.GetRegisteredTypes() {
+			if name, ok := strings.CutPrefix(key, prefix); ok {
+				names = append(names, name)
+			}
+		}
+		if len(names) > 1 {
+			sort.Strings(names)
+			return nil, fmt.Errorf("%s/%s has several root messages (%s), pass the message name", messageType, version, strings.Join(names, ", "))
+		}
+		if len(names) == 1 {
+			messageName = names[0]
+		}
+	}
+
+	msg, err := gen.NewByMessageName(messageType, version, messageName)
+	if err != nil {
+		return nil, err
+	}
+
+	protoMsg, ok := msg.(proto.Message)
+	if !ok {
+		return nil, fmt.Errorf("%T is not a protobuf message", msg)
+	}
+	if err := proto.Unmarshal(data, protoMsg); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal protobuf: %w", err)
+	}
+	return msg, nil
+}
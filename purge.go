@@ -0,0 +1,71 @@
+package ddex
+
+import (
+	"crypto/rand"
+	"encoding/hex"
+	"fmt"
+	"maps"
+	"time"
+
+	ernv432 "github.com/alecsavvy/ddex-proto/gen/ddex/ern/v432"
+	"google.golang.org/protobuf/proto"
+)
+
+// NewPurgeForRelease builds a PurgeReleaseMessage taking down the release delivered in original. The
+// header is copied with a new random MessageId, the current MessageCreatedDateTime and without the
+// original's MessageFileName and MessageAuditTrail; the thread continues the original's (or starts at its
+// MessageId). The PurgedRelease carries the ReleaseId and display titles of the original's main Release,
+// whose purge also takes down its TrackReleases. It returns an error if original has no MessageHeader or
+// no Release with a ReleaseId.
+func NewPurgeForRelease(original *ernv432.NewReleaseMessage) (*ernv432.PurgeReleaseMessage, error) {
+	if original.GetMessageHeader() == nil {
+		return nil, fmt.Errorf("original message has no MessageHeader")
+	}
+	release := original.GetReleaseList().GetRelease()
+	if release == nil {
+		return nil, fmt.Errorf("original message has no Release")
+	}
+	if release.GetReleaseId() == nil {
+		return nil, fmt.Errorf("release %s has no ReleaseId", release.GetReleaseReference())
+	}
+
+	messageId, err := newMessageId()
+	if err != nil {
+		return nil, err
+	}
+	header := proto.Clone(original.GetMessageHeader()).(*ernv432.MessageHeader)
+	if header.MessageThreadId == "" {
+		header.MessageThreadId = header.GetMessageId()
+	}
+	header.MessageId = messageId
+	header.MessageFileName = ""
+	header.MessageAuditTrail = nil
+	header.MessageCreatedDateTime = time.Now().UTC().Format(time.RFC3339)
+
+	purged := &ernv432.PurgedRelease{
+		ReleaseId: proto.Clone(release.GetReleaseId()).(*ernv432.ReleaseId),
+	}
+	for _, title := range release.GetDisplayTitleText() {
+		purged.Title = append(purged.Title, &ernv432.Title{
+			TitleText:             title.GetValue(),
+			LanguageAndScriptCode: title.GetLanguageAndScriptCode(),
+		})
+	}
+
+	return &ernv432.PurgeReleaseMessage{
+		MessageHeader:         header,
+		PurgedRelease:         purged,
+		AvsVersionId:          original.GetAvsVersionId(),
+		LanguageAndScriptCode: original.GetLanguageAndScriptCode(),
+		NamespaceAttrs:        maps.Clone(original.GetNamespaceAttrs()),
+	}, nil
+}
+
+// newMessageId returns a random 32 character hex MessageId
+func newMessageId() (string, error) {
+	id := make([]byte, 16)
+	if _, err := rand.Read(id); err != nil {
+		return "", fmt.Errorf("generating MessageId: %w", err)
+	}
+	return hex.EncodeToString(id), nil
+}
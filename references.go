@@ -0,0 +1,207 @@
+package ddex
+
+import (
+	"fmt"
+	"reflect"
+	"strings"
+
+	ernv432 "github.com/alecsavvy/ddex-proto/gen/ddex/ern/v432"
+)
+
+// RefError describes a reference in a message that does not resolve
+type RefError struct {
+	// Path is the DDEX path of the referencing element, e.g. /PurgeReleaseMessage/PurgedRelease/ReleaseId/ICPN
+	Path string
+	// Reference is the unresolved reference or identifier value
+	Reference string
+	// Message explains why the reference is invalid
+	Message string
+}
+
+// Error implements the error interface
+func (e RefError) Error() string {
+	if e.Reference == "" {
+		return fmt.Sprintf("%s: %s", e.Path, e.Message)
+	}
+	return fmt.Sprintf("%s: %s (%q)", e.Path, e.Message, e.Reference)
+}
+
+// ValidatePurgeReferences checks that the purged release is identified by at least one identifier in
+// knownIDs (GRid, ICPN, CatalogNumber or ProprietaryId values we have previously delivered). When none of
+// the release's identifiers are known, each of them is reported.
+func ValidatePurgeReferences(msg *ernv432.PurgeReleaseMessage, knownIDs map[string]bool) []RefError {
+	const basePath = "/PurgeReleaseMessage/PurgedRelease/ReleaseId"
+
+	if msg.GetPurgedRelease().GetReleaseId() == nil {
+		return []RefError{{Path: basePath, Message: "purged release has no identifier"}}
+	}
+	releaseID := msg.GetPurgedRelease().GetReleaseId()
+
+	var candidates []RefError
+	add := func(path, id string) {
+		if id != "" {
+			candidates = append(candidates, RefError{Path: path, Reference: id, Message: "unknown release identifier"})
+		}
+	}
+	add(basePath+"/GRid", releaseID.GetGRid())
+	add(basePath+"/ICPN", releaseID.GetICPN())
+	add(basePath+"/CatalogNumber", releaseID.GetCatalogNumber().GetValue())
+	for _, proprietaryID := range releaseID.GetProprietaryId() {
+		add(basePath+"/ProprietaryId", proprietaryID.GetValue())
+	}
+
+	if len(candidates) == 0 {
+		return []RefError{{Path: basePath, Message: "purged release has no identifier"}}
+	}
+	for _, candidate := range candidates {
+		if knownIDs[candidate.Reference] {
+			return nil
+		}
+	}
+	return candidates
+}
+
+// UpdateIndicatorUpdateMessage is the ERN 3.x UpdateIndicator value of an incremental update, which may
+// reference resources and releases delivered in earlier messages
+const UpdateIndicatorUpdateMessage = "UpdateMessage"
+
+// freeReferences are *Reference elements holding sender-assigned identifiers rather than pointers to an
+// element in the same message
+var freeReferences = map[string]bool{
+	"DealReference":   true,
+	"TariffReference": true,
+	"BrandReference":  true,
+}
+
+// ValidateReferences checks that every message-local reference (ReleaseResourceReference,
+// DealReleaseReference, ArtistPartyReference, ...) in a DDEX message resolves to an element declared in
+// the same message. When the message is an incremental update (UpdateIndicator=UpdateMessage), references
+// found in knownRefs, e.g. from a prior delivery, are accepted too. ERN 4.x has no UpdateIndicator, so its
+// messages are always checked as complete.
+func ValidateReferences(msg interface{}, knownRefs map[string]bool) []RefError {
+	defined := make(map[string]string)
+	collectReferenceTargets(reflect.ValueOf(msg), defined)
+	incremental := IsUpdateMessage(msg)
+
+	var errs []RefError
+	walkScalars(msg, func(path string, field xmlField, value reflect.Value) {
+		if field.Attr || value.Kind() != reflect.String || !strings.HasSuffix(field.Name, "Reference") {
+			return
+		}
+		if definingReferences[field.Name] || freeReferences[field.Name] {
+			return
+		}
+
+		reference := strings.TrimSpace(value.String())
+		if _, ok := defined[reference]; ok || reference == "" {
+			return
+		}
+		if incremental && knownRefs[reference] {
+			return
+		}
+		errs = append(errs, RefError{Path: path, Reference: reference, Message: "unresolved reference"})
+	})
+	return errs
+}
+
+// IsUpdateMessage reports whether a message declares itself an incremental update through UpdateIndicator
+func IsUpdateMessage(msg interface{}) bool {
+	v := reflect.ValueOf(msg)
+	for v.Kind() == reflect.Ptr || v.Kind() == reflect.Interface {
+		if v.IsNil() {
+			return false
+		}
+		v = v.Elem()
+	}
+	if v.Kind() != reflect.Struct {
+		return false
+	}
+
+	indicator := v.FieldByName("UpdateIndicator")
+	return indicator.IsValid() && indicator.Kind() == reflect.String &&
+		strings.TrimSpace(indicator.String()) == UpdateIndicatorUpdateMessage
+}
+
+// DealRefError describes a deal whose DealReleaseReference names no Release or TrackRelease of the message
+type DealRefError struct {
+	// Path is the DDEX path of the reference, e.g. /NewReleaseMessage/DealList/ReleaseDeal/DealReleaseReference
+	Path string
+	// DealReference is the DealReference of the deal, "" if it has none
+	DealReference string
+	// ReleaseReference is the dangling release reference
+	ReleaseReference string
+}
+
+// Error implements the error interface
+func (e DealRefError) Error() string {
+	deal := e.DealReference
+	if deal == "" {
+		deal = "without DealReference"
+	}
+	return fmt.Sprintf("%s: deal %s references release %q, which is not in the ReleaseList", e.Path, deal, e.ReleaseReference)
+}
+
+// ValidateDealReleaseReferences checks that the DealReleaseReferences of every ReleaseDeal in an ERN
+// message (any version) name a Release or TrackRelease declared in its ReleaseList. Unlike
+// ValidateReferences, a reference resolving to another kind of element, such as a resource, is reported
+// too. Each dangling reference is reported once per DealReference of the ReleaseDeal's Deals, and once
+// for all Deals without one. When the message is an incremental update, references in knownRefs are
+// accepted as in ValidateReferences.
+func ValidateDealReleaseReferences(msg interface{}, knownRefs map[string]bool) []DealRefError {
+	releases := make(map[string]bool)
+	walkScalars(msg, func(path string, field xmlField, value reflect.Value) {
+		if field.Name == "ReleaseReference" && !field.Attr && strings.Contains(path, "/ReleaseList/") {
+			releases[strings.TrimSpace(value.String())] = true
+		}
+	})
+	incremental := IsUpdateMessage(msg)
+
+	v := reflect.ValueOf(msg)
+	if !v.IsValid() || (v.Kind() == reflect.Ptr && v.IsNil()) {
+		return nil
+	}
+	getter := v.MethodByName("GetDealList")
+	if !getter.IsValid() {
+		return nil
+	}
+	dealList := getter.Call(nil)[0]
+	if dealList.IsNil() {
+		return nil
+	}
+	path := "/" + reflect.Indirect(v).Type().Name() + "/DealList/ReleaseDeal/DealReleaseReference"
+
+	var errs []DealRefError
+	releaseDeals := dealList.Elem().FieldByName("ReleaseDeal")
+	for i := 0; i < releaseDeals.Len(); i++ {
+		releaseDeal := releaseDeals.Index(i)
+		if releaseDeal.IsNil() {
+			continue
+		}
+		var dealReferences []string
+		seen := make(map[string]bool)
+		deals := releaseDeal.Elem().FieldByName("Deal")
+		for j := 0; j < deals.Len(); j++ {
+			if deals.Index(j).IsNil() {
+				continue
+			}
+			if reference := firstDealReference(deals.Index(j).Elem().FieldByName("DealReference")); !seen[reference] {
+				seen[reference] = true
+				dealReferences = append(dealReferences, reference)
+			}
+		}
+		if len(dealReferences) == 0 {
+			dealReferences = []string{""}
+		}
+
+		for _, reference := range stringValues(releaseDeal.Elem().FieldByName("DealReleaseReference")) {
+			reference = strings.TrimSpace(reference)
+			if releases[reference] || (incremental && knownRefs[reference]) {
+				continue
+			}
+			for _, dealReference := range dealReferences {
+				errs = append(errs, DealRefError{Path: path, DealReference: dealReference, ReleaseReference: reference})
+			}
+		}
+	}
+	return errs
+}
@@ -0,0 +1,139 @@
+package ddex
+
+import (
+	"fmt"
+	"reflect"
+	"strings"
+	"time"
+
+	ernv432 "github.com/alecsavvy/ddex-proto/gen/ddex/ern/v432"
+)
+
+// DefaultReleaseDateTolerance is how far a deal may start from the release date before
+// ValidateReleaseDateConsistency reports it
+const DefaultReleaseDateTolerance = 7 * 24 * time.Hour
+
+// validityPeriodPath is the DDEX path of the deal start dates compared against the release dates
+const validityPeriodPath = "/NewReleaseMessage/DealList/ReleaseDeal/Deal/DealTerms/ValidityPeriod"
+
+// DateError describes a deal whose start date disagrees with the release date in the metadata
+type DateError struct {
+	// Path is the DDEX path of the offending date, e.g.
+	// /NewReleaseMessage/DealList/ReleaseDeal/Deal/DealTerms/ValidityPeriod
+	Path string
+	// ReleaseReference is the release the deal is for
+	ReleaseReference string
+	// DealReference is the DealReference of the deal, "" if it has none
+	DealReference string
+	// Field is the release date compared against: ReleaseDate or OriginalReleaseDate
+	Field string
+	// ReleaseDate and StartDate are the compared values
+	ReleaseDate string
+	StartDate   string
+	// Message explains the discrepancy
+	Message string
+}
+
+// Error implements the error interface
+func (e DateError) Error() string {
+	deal := ""
+	if e.DealReference != "" {
+		deal = ", deal " + e.DealReference
+	}
+	return fmt.Sprintf("%s: %s (release %s%s)", e.Path, e.Message, e.ReleaseReference, deal)
+}
+
+// ValidateReleaseDateConsistency is ValidateReleaseDateConsistencyWithin with DefaultReleaseDateTolerance
+func ValidateReleaseDateConsistency(msg *ernv432.NewReleaseMessage) []DateError {
+	return ValidateReleaseDateConsistencyWithin(msg, DefaultReleaseDateTolerance)
+}
+
+// ValidateReleaseDateConsistencyWithin compares the earliest ValidityPeriod start of each deal for the
+// Release with its ReleaseDate and OriginalReleaseDate, the one for a territory of the deal if there is
+// one, else the default (see IsPreOrder). A deal is reported when it starts more than tolerance after the
+// ReleaseDate, or before the ReleaseDate or OriginalReleaseDate, except for pre-order deals
+// (IsPreOrderDeal), which open before the street date. Dates that do not parse are reported as well.
+func ValidateReleaseDateConsistencyWithin(msg *ernv432.NewReleaseMessage, tolerance time.Duration) []DateError {
+	release := msg.GetReleaseList().GetRelease()
+	if release == nil || (len(release.GetReleaseDate()) == 0 && len(release.GetOriginalReleaseDate()) == 0) {
+		return nil
+	}
+	if tolerance < 0 {
+		tolerance = 0
+	}
+	releaseRef := strings.TrimSpace(release.GetReleaseReference())
+
+	var errs []DateError
+	for _, releaseDeal := range msg.GetDealList().GetReleaseDeal() {
+		referenced := false
+		for _, reference := range releaseDeal.GetDealReleaseReference() {
+			referenced = referenced || strings.TrimSpace(reference) == releaseRef
+		}
+		if !referenced {
+			continue
+		}
+
+		for _, deal := range releaseDeal.GetDeal() {
+			terms := deal.GetDealTerms()
+			dateErr := DateError{
+				Path:             validityPeriodPath,
+				ReleaseReference: releaseRef,
+				DealReference:    firstDealReference(reflect.ValueOf(deal.GetDealReference())),
+			}
+			start, _, err := validityBounds(terms.GetValidityPeriod())
+			if err != nil {
+				dateErr.Message = err.Error()
+				errs = append(errs, dateErr)
+				continue
+			}
+			if start.IsZero() {
+				continue
+			}
+			dateErr.StartDate = start.Format(time.DateOnly)
+
+			for _, field := range []string{"ReleaseDate", "OriginalReleaseDate"} {
+				dates := release.GetReleaseDate()
+				if field == "OriginalReleaseDate" {
+					dates = release.GetOriginalReleaseDate()
+				}
+				date := releaseDateForDeal(dates, terms)
+				if date == nil {
+					continue
+				}
+
+				compared := dateErr
+				compared.Field, compared.ReleaseDate = field, strings.TrimSpace(date.GetValue())
+				releaseDate, err := parseDate(date.GetValue())
+				if err != nil {
+					compared.Path = "/NewReleaseMessage/ReleaseList/Release/" + field
+					compared.Message = err.Error()
+					errs = append(errs, compared)
+					continue
+				}
+
+				diff := start.Sub(releaseDate)
+				switch {
+				case diff < -tolerance && !terms.GetIsPreOrderDeal():
+					compared.Message = fmt.Sprintf("deal starts %s, %d days before the %s %s", compared.StartDate, int(-diff.Hours()/24), field, compared.ReleaseDate)
+				case diff > tolerance && field == "ReleaseDate":
+					compared.Message = fmt.Sprintf("deal starts %s, %d days after the %s %s", compared.StartDate, int(diff.Hours()/24), field, compared.ReleaseDate)
+				default:
+					continue
+				}
+				errs = append(errs, compared)
+			}
+		}
+	}
+	return errs
+}
+
+// releaseDateForDeal returns the date with an ApplicableTerritoryCode among the deal's TerritoryCodes,
+// else the default date
+func releaseDateForDeal(dates []*ernv432.EventDateWithDefault, terms *ernv432.DealTerms) *ernv432.EventDateWithDefault {
+	for _, date := range dates {
+		if territory := strings.TrimSpace(date.GetApplicableTerritoryCode()); territory != "" && containsTerritory(terms.GetTerritoryCode(), territory) {
+			return date
+		}
+	}
+	return defaultReleaseDate(dates)
+}
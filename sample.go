@@ -0,0 +1,462 @@
+package ddex
+
+import (
+	"encoding/xml"
+	"fmt"
+	"math/rand/v2"
+	"regexp"
+	"regexp/syntax"
+	"sort"
+	"strconv"
+	"strings"
+	"unicode"
+
+	"github.com/alecsavvy/ddex-proto/gen"
+)
+
+// sampleRoots are the root messages GenerateSample builds for message types with several
+var sampleRoots = []string{"NewReleaseMessage", "MeadMessage", "PieMessage"}
+
+// sampleOptional are the optional elements GenerateSample includes, so that samples carry the parts
+// consumers look at (titles, artists, identifiers, a resource group and a worldwide deal) rather than
+// only what the schema requires
+var sampleOptional = map[string]bool{
+	"MessageSender": true, "MessageRecipient": true, "PartyId": true, "PartyName": true, "FullName": true,
+	"PartyList": true, "Party": true, "ReleaseList": true, "Release": true, "ReleaseReference": true, "ResourceList": true, "DisplayArtistName": true, "DisplayArtist": true, "ArtistPartyReference": true,
+	"DisplayArtistRole": true, "DisplayTitleText": true, "DisplayTitle": true, "TitleText": true,
+	"ReferenceTitle": true, "Title": true, "SoundRecording": true, "SoundRecordingEdition": true,
+	"SoundRecordingId": true, "ResourceId": true, "ISRC": true, "Duration": true, "ReleaseId": true,
+	"ICPN": true, "ResourceGroup": true, "ResourceGroupContentItem": true, "SequenceNumber": true,
+	"ReleaseResourceReference": true, "ReleaseResourceReferenceList": true, "TrackRelease": true,
+	"DealList": true, "ReleaseDeal": true, "Deal": true, "DealTerms": true, "TerritoryCode": true,
+	"ValidityPeriod": true, "StartDate": true, "CommercialModelType": true, "UseType": true,
+	"Usage": true, "ReleaseDate": true, "Genre": true, "GenreText": true, "PLine": true, "CLine": true,
+	"PLineText": true, "CLineText": true, "Year": true, "ReleaseType": true,
+}
+
+// sampleRepeated are the elements GenerateSample writes twice where the schema allows, for a couple of
+// resources and the tracks of a release
+var sampleRepeated = map[string]bool{
+	"SoundRecording":           true,
+	"ResourceGroupContentItem": true,
+	"ReleaseResourceReference": true,
+}
+
+// sampleWords and sampleNames make up plausible titles and party names
+var (
+	sampleWords = []string{"Golden", "Midnight", "Electric", "Quiet", "Paper", "Silver", "Summer", "Neon",
+		"River", "Echo", "Garden", "Horizon", "Signal", "Velvet", "Harbor", "Static"}
+	sampleNames = []string{"Ada Moreau", "The Lanterns", "Kofi Mensah", "Lena Park", "Northbound", "Iris Vale",
+		"Sample Records", "Mira Quinn"}
+)
+
+// GenerateSample builds a populated message of messageType and version ("ern", "v432") with plausible
+// placeholder values, for test fixtures: parties, a couple of sound recordings with well-formed ISRCs, a
+// release with an ICPN and a resource group, and a worldwide deal, as far as the schema of the version
+// has them. Its message-local references resolve, and the document it is decoded from follows the
+// embedded XSD; xml.Marshal of the message does not, since the generated types write every field,
+// including empty optional elements and attributes, so use GenerateSampleDocument for schema-valid XML.
+// For message types with several root messages the main one (NewReleaseMessage, MeadMessage or
+// PieMessage) is built. The output is the same on every call; see GenerateSampleWithSeed for other values.
+func GenerateSample(messageType, version string) (interface{}, error) {
+	return GenerateSampleWithSeed(messageType, version, 1)
+}
+
+// GenerateSampleWithSeed is GenerateSample with the placeholder values chosen by seed: the same seed
+// always produces the same message
+func GenerateSampleWithSeed(messageType, version string, seed uint64) (interface{}, error) {
+	messageName, document, err := generateSample(messageType, version, seed)
+	if err != nil {
+		return nil, err
+	}
+
+	msg, err := NewMessage(messageType, version, messageName)
+	if err != nil {
+		return nil, err
+	}
+	if err := xml.Unmarshal(document, msg); err != nil {
+		return nil, fmt.Errorf("failed to decode sample: %w", err)
+	}
+	return msg, nil
+}
+
+// GenerateSampleDocument returns the XML of the sample GenerateSampleWithSeed decodes for seed. Unlike
+// marshaling that message, the document passes ValidateAgainstSchema.
+func GenerateSampleDocument(messageType, version string, seed uint64) ([]byte, error) {
+	_, document, err := generateSample(messageType, version, seed)
+	return document, err
+}
+
+// generateSample writes the sample document and returns it with the name of its root message
+func generateSample(messageType, version string, seed uint64) (string, []byte, error) {
+	messageName := ""
+	for _, name := range sampleRoots {
+		if _, ok := gen.GetRegisteredTypes()[messageType+"/"+version+"/"+name]; ok {
+			messageName = name
+			break
+		}
+	}
+	if messageName == "" {
+		return "", nil, fmt.Errorf("unknown message type/version: %s/%s", messageType, version)
+	}
+	rootElement := gen.GetRegisteredTypes()[messageType+"/"+version+"/"+messageName].RootElement
+
+	schema, err := loadSchema(messageType + version)
+	if err != nil {
+		return "", nil, err
+	}
+	decl, ok := schema.elements[schema.target+" "+rootElement]
+	if !ok {
+		return "", nil, fmt.Errorf("schema of %s/%s has no element %s", messageType, version, rootElement)
+	}
+
+	location, err := SchemaLocation(messageType, version)
+	if err != nil {
+		return "", nil, err
+	}
+
+	s := &sampleBuilder{
+		schema:     schema,
+		location:   location,
+		rng:        rand.New(rand.NewPCG(seed, seed^0x5DEECE66D)),
+		validator:  &schemaValidator{schema: schema},
+		references: make(map[string]int),
+		usages:     make(map[string]int),
+		ancestors:  make(map[string]bool),
+	}
+	s.element(decl, 1, true)
+
+	document := []byte(s.out.String())
+	if errs := ValidateAgainstSchema(document); len(errs) > 0 {
+		return "", nil, fmt.Errorf("sample of %s/%s is not schema-valid: %v", messageType, version, errs[0])
+	}
+	return messageName, document, nil
+}
+
+// sampleBuilder writes a sample document for a compiled schema
+type sampleBuilder struct {
+	schema *compiledSchema
+	// location is the xsi:schemaLocation of the root element, as NewMessage sets it
+	location  string
+	rng       *rand.Rand
+	validator *schemaValidator
+	out       strings.Builder
+	// defined are the message-local references declared so far, in order
+	defined []string
+	// references counts the defining references by element name, usages the uses of each referencing element
+	references map[string]int
+	usages     map[string]int
+	// ancestors are the elements being written, so optional elements are not nested in themselves
+	ancestors map[string]bool
+	// position is the 1-based occurrence among its siblings of the element whose content is being written,
+	// used for SequenceNumbers
+	position int
+}
+
+// element writes an element with its required and sample attributes and content. index is the 1-based
+// occurrence of the element among its siblings, the position of its children.
+func (s *sampleBuilder) element(decl *schemaElement, index int, root bool) {
+
+	complexType, simpleType, typeKey := decl.complex, decl.simple, decl.typeKey
+	if complexType == nil && simpleType == nil && typeKey != "" {
+		complexType = s.schema.complexTypes[typeKey]
+	}
+
+	s.out.WriteString("<" + decl.name)
+	if root {
+		s.out.WriteString(` xmlns="` + s.schema.target + `" xmlns:xsi="http://www.w3.org/2001/XMLSchema-instance"`)
+		s.out.WriteString(` xsi:schemaLocation="` + s.location + `"`)
+	}
+	if complexType != nil {
+		for _, name := range sortedKeys(complexType.attrs) {
+			attr := complexType.attrs[name]
+			if !attr.required && !(root && name == "LanguageAndScriptCode") {
+				continue
+			}
+			s.out.WriteString(" " + name + `="`)
+			xml.EscapeText(&s.out, []byte(s.value(name, attr.simple, attr.typeKey)))
+			s.out.WriteString(`"`)
+		}
+	}
+	s.out.WriteString(">")
+
+	switch {
+	case complexType == nil:
+		xml.EscapeText(&s.out, []byte(s.value(decl.name, simpleType, typeKey)))
+	case complexType.simpleContent:
+		xml.EscapeText(&s.out, []byte(s.value(decl.name, nil, complexType.textKey)))
+	case complexType.content != nil:
+		position := s.position
+		s.ancestors[decl.name], s.position = true, index
+		s.particle(complexType.content)
+		delete(s.ancestors, decl.name)
+		s.position = position
+	}
+	s.out.WriteString("</" + decl.name + ">")
+}
+
+// particle writes the occurrences of a content model particle
+func (s *sampleBuilder) particle(p *schemaParticle) {
+	count := p.min
+	if count == 0 && s.wanted(p) {
+		count = 1
+	}
+	if p.kind == "element" && sampleRepeated[p.element.name] && count > 0 && (p.max < 0 || p.max >= 2) {
+		count = 2
+	}
+
+	for i := 1; i <= count; i++ {
+		switch p.kind {
+		case "element":
+			s.element(p.element, i, false)
+		case "sequence":
+			for _, child := range p.children {
+				s.particle(child)
+			}
+		case "choice":
+			chosen := p.children[0]
+			for _, child := range p.children {
+				if s.wanted(child) {
+					chosen = child
+					break
+				}
+			}
+			s.particle(chosen)
+		}
+	}
+}
+
+// wanted reports whether a particle holds an element the sample includes although it is optional
+func (s *sampleBuilder) wanted(p *schemaParticle) bool {
+	if p.kind == "element" {
+		return sampleOptional[p.element.name] && !s.ancestors[p.element.name]
+	}
+	for _, child := range p.children {
+		if s.wanted(child) {
+			return true
+		}
+	}
+	return false
+}
+
+// value returns a value of a simple type for the element or attribute name: a plausible candidate for
+// the name if the type accepts it, otherwise a value built from the type's enumeration or pattern
+func (s *sampleBuilder) value(name string, st *schemaSimpleType, typeKey string) string {
+	if strings.HasSuffix(name, "Reference") && !definingReferences[name] && !freeReferences[name] {
+		// Use the references declared so far that the element allows in turn
+		var matching []string
+		for _, reference := range s.defined {
+			if s.accepts(st, typeKey, reference) {
+				matching = append(matching, reference)
+			}
+		}
+		if len(matching) > 0 {
+			s.usages[name]++
+			return matching[(s.usages[name]-1)%len(matching)]
+		}
+	}
+	for _, candidate := range s.candidates(name) {
+		if s.accepts(st, typeKey, candidate) {
+			if definingReferences[name] {
+				s.defined = append(s.defined, candidate)
+			}
+			return candidate
+		}
+	}
+
+	if st == nil {
+		st = s.schema.simpleTypes[typeKey]
+	}
+	for st != nil {
+		switch {
+		case st.enums != nil:
+			return s.enumValue(st.enums)
+		case len(st.patterns) > 0:
+			if value, ok := s.patternValue(st.patterns[0]); ok && s.accepts(st, "", value) {
+				return value
+			}
+		}
+		typeKey = st.baseKey
+		st = s.schema.simpleTypes[typeKey]
+	}
+
+	switch strings.TrimPrefix(typeKey, xsdNamespace+" ") {
+	case "boolean":
+		return "false"
+	case "integer", "int", "long", "nonNegativeInteger", "positiveInteger", "decimal":
+		return strconv.Itoa(s.position)
+	case "date":
+		return "2024-03-15"
+	case "dateTime":
+		return "2024-03-15T09:30:00Z"
+	case "gYear":
+		return "2024"
+	case "duration":
+		return "PT3M30S"
+	}
+	return fmt.Sprintf("Sample %s %d", name, s.position)
+}
+
+// accepts reports whether a value is valid for the simple type st, or the type named by typeKey
+func (s *sampleBuilder) accepts(st *schemaSimpleType, typeKey, value string) bool {
+	s.validator.errs = nil
+	if st != nil {
+		s.validator.checkSimpleType(st, value, "")
+	} else {
+		s.validator.checkValue(typeKey, value, "")
+	}
+	return len(s.validator.errs) == 0
+}
+
+// candidates returns plausible values for an element or attribute name, best first
+func (s *sampleBuilder) candidates(name string) []string {
+	switch {
+	case definingReferences[name]:
+		// A1, A2, ... with the letter the reference pattern requires, tried in turn
+		s.references[name]++
+		var candidates []string
+		for _, prefix := range "ARPWTCVXQ" {
+			candidates = append(candidates, fmt.Sprintf("%c%d", prefix, s.references[name]))
+		}
+		return candidates
+	case name == "ISRC":
+		return []string{fmt.Sprintf("QZ%s24%05d", s.letters(3), s.rng.IntN(100000))}
+	case name == "ICPN":
+		return []string{gtin(fmt.Sprintf("%012d", s.rng.Int64N(1e12)))}
+	case name == "PartyId" || name == "DPID" || name == "Namespace":
+		return []string{fmt.Sprintf("PADPIDA%010d%d", s.rng.Int64N(1e10), s.rng.IntN(10))}
+	case name == "MessageId" || name == "MessageThreadId":
+		return []string{fmt.Sprintf("SAMPLE-%08d", s.rng.IntN(100000000))}
+	case name == "CatalogNumber" || name == "ProprietaryId":
+		return []string{fmt.Sprintf("CAT%06d", s.rng.IntN(1000000))}
+	case name == "SequenceNumber":
+		return []string{strconv.Itoa(s.position)}
+	case name == "TerritoryCode":
+		return []string{TerritoryWorldwide}
+	case name == "LanguageAndScriptCode" || name == "LanguageOfPerformance":
+		return []string{"en"}
+	case name == "FullName" || name == "DisplayArtistName" || name == "PartyName" || name == "Name":
+		return []string{sampleNames[s.rng.IntN(len(sampleNames))]}
+	case strings.HasSuffix(name, "TitleText") || name == "Title" || name == "DisplayTitleText":
+		return []string{sampleWords[s.rng.IntN(len(sampleWords))] + " " + sampleWords[s.rng.IntN(len(sampleWords))]}
+	case name == "PLineText":
+		return []string{"(P) 2024 Sample Records"}
+	case name == "CLineText":
+		return []string{"(C) 2024 Sample Records"}
+	case name == "MessageSchemaVersionId":
+		return []string{strings.TrimPrefix(s.schema.target, "http://ddex.net/xml/")}
+	case name == "GenreText":
+		return []string{"Pop"}
+	case name == "Year":
+		return []string{"2024"}
+	case strings.HasSuffix(name, "Duration"):
+		return []string{fmt.Sprintf("PT%dM%02dS", 2+s.rng.IntN(3), s.rng.IntN(60))}
+	case strings.HasSuffix(name, "DateTime"):
+		return []string{"2024-03-15T09:30:00Z"}
+	case strings.HasSuffix(name, "Date"):
+		return []string{"2024-03-15"}
+	}
+	return nil
+}
+
+// letters returns n random upper-case letters
+func (s *sampleBuilder) letters(n int) string {
+	var sb strings.Builder
+	for i := 0; i < n; i++ {
+		sb.WriteByte(byte('A' + s.rng.IntN(26)))
+	}
+	return sb.String()
+}
+
+// sampleAvoided are allowed values that need further elements or attributes to make sense
+var sampleAvoided = map[string]bool{"UserDefined": true, "Unknown": true}
+
+// samplePreferred are allowed values picked over the others wherever a type has them, so that samples
+// read like an ordinary album delivery
+var samplePreferred = []string{TerritoryWorldwide, "MainArtist", "Album", "OriginalMessage", "LiveMessage",
+	"PayAsYouGoModel", "PermanentDownload", "Audio", "MusicalWorkSoundRecording"}
+
+// enumValue picks one of the allowed values of an enumeration
+func (s *sampleBuilder) enumValue(enums map[string]bool) string {
+	for _, value := range samplePreferred {
+		if enums[value] {
+			return value
+		}
+	}
+	values := sortedKeys(enums)
+	usable := values[:0:0]
+	for _, value := range values {
+		if !sampleAvoided[value] {
+			usable = append(usable, value)
+		}
+	}
+	if len(usable) == 0 {
+		usable = values
+	}
+	return usable[s.rng.IntN(len(usable))]
+}
+
+// patternValue builds a string matching a compiled pattern, preferring ASCII letters and digits
+func (s *sampleBuilder) patternValue(pattern *regexp.Regexp) (string, bool) {
+	re, err := syntax.Parse(pattern.String(), syntax.Perl)
+	if err != nil {
+		return "", false
+	}
+	var sb strings.Builder
+	s.writePattern(&sb, re.Simplify())
+	return sb.String(), pattern.MatchString(sb.String())
+}
+
+// writePattern writes a string matched by a parsed regular expression
+func (s *sampleBuilder) writePattern(sb *strings.Builder, re *syntax.Regexp) {
+	repeat := func(min, max int) {
+		if max < 0 || max > min+2 {
+			max = min + 2
+		}
+		for i, n := 0, min+s.rng.IntN(max-min+1); i < n; i++ {
+			s.writePattern(sb, re.Sub[0])
+		}
+	}
+
+	switch re.Op {
+	case syntax.OpLiteral:
+		sb.WriteString(string(re.Rune))
+	case syntax.OpCharClass:
+		sb.WriteRune(s.classRune(re.Rune))
+	case syntax.OpAnyChar, syntax.OpAnyCharNotNL:
+		sb.WriteByte('x')
+	case syntax.OpCapture:
+		s.writePattern(sb, re.Sub[0])
+	case syntax.OpConcat:
+		for _, sub := range re.Sub {
+			s.writePattern(sb, sub)
+		}
+	case syntax.OpAlternate:
+		s.writePattern(sb, re.Sub[s.rng.IntN(len(re.Sub))])
+	case syntax.OpStar:
+		repeat(0, -1)
+	case syntax.OpPlus:
+		repeat(1, -1)
+	case syntax.OpQuest:
+		repeat(0, 1)
+	case syntax.OpRepeat:
+		repeat(re.Min, re.Max)
+	}
+}
+
+// classRune picks a rune of a character class given as ranges, preferring ASCII letters and digits
+func (s *sampleBuilder) classRune(ranges []rune) rune {
+	var preferred []rune
+	for i := 0; i+1 < len(ranges); i += 2 {
+		for r := ranges[i]; r <= ranges[i+1] && r < unicode.MaxASCII; r++ {
+			if unicode.IsLetter(r) || unicode.IsDigit(r) {
+				preferred = append(preferred, r)
+			}
+		}
+	}
+	if len(preferred) == 0 {
+		return ranges[0]
+	}
+	sort.Slice(preferred, func(i, j int) bool { return preferred[i] < preferred[j] })
+	return preferred[s.rng.IntN(len(preferred))]
+}
@@ -0,0 +1,129 @@
+package ddex
+
+import (
+	"bytes"
+	"encoding/xml"
+	"html"
+	"regexp"
+	"strconv"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/alecsavvy/ddex-proto/gen"
+)
+
+// entityPattern matches a character or entity reference starting at an ampersand
+var entityPattern = regexp.MustCompile(`^&(#[0-9]+|#[xX][0-9a-fA-F]+|[A-Za-z_][A-Za-z0-9._-]*);`)
+
+// xmlPredefinedEntities are the entities every XML parser understands
+var xmlPredefinedEntities = map[string]bool{"amp": true, "lt": true, "gt": true, "quot": true, "apos": true}
+
+// SanitizeXML repairs common defects in partner XML so that it can be decoded:
+//   - bare ampersands that do not start a reference are escaped as &amp;
+//   - HTML named entities (e.g. &nbsp;, &eacute;) are replaced by the characters they stand for
+//   - characters not allowed in XML 1.0 (control characters 0x00-0x08, 0x0B, 0x0C, 0x0E-0x1F, ...) and
+//     character references to them are removed, and invalid UTF-8 is replaced with U+FFFD
+//
+// This changes the content of the document, so only use it for sources known to be dirty.
+func SanitizeXML(data []byte) []byte {
+	var out bytes.Buffer
+	out.Grow(len(data))
+
+	for i := 0; i < len(data); {
+		// Ampersands in CDATA sections and comments are literal text
+		if section := verbatimSection(data[i:]); section > 0 {
+			out.Write(data[i : i+section])
+			i += section
+			continue
+		}
+
+		if data[i] == '&' {
+			i += sanitizeReference(&out, data[i:])
+			continue
+		}
+
+		r, size := utf8.DecodeRune(data[i:])
+		if r == utf8.RuneError && size <= 1 {
+			out.WriteRune(utf8.RuneError)
+		} else if isXMLChar(r) {
+			out.Write(data[i : i+size])
+		}
+		i += size
+	}
+	return out.Bytes()
+}
+
+// sanitizeReference writes the sanitized form of the reference (or bare ampersand) at the start of data
+// and returns the number of input bytes consumed
+func sanitizeReference(out *bytes.Buffer, data []byte) int {
+	match := entityPattern.Find(data)
+	if match == nil {
+		out.WriteString("&amp;")
+		return 1
+	}
+
+	name := string(match[1 : len(match)-1])
+	switch {
+	case xmlPredefinedEntities[name]:
+		out.Write(match)
+	case name[0] == '#':
+		if isXMLChar(parseCharRef(name)) {
+			out.Write(match)
+		}
+	default:
+		// Replace HTML entities with their text; unknown names are treated as a bare ampersand. html also
+		// decodes legacy entities by prefix (&copyright; becomes ©right;), which leaves the semicolon behind,
+		// so only accept a decode that consumed the whole reference (&semi; is the one entity that is ";")
+		decoded := html.UnescapeString(string(match))
+		if decoded == string(match) || (strings.HasSuffix(decoded, ";") && name != "semi") {
+			out.WriteString("&amp;")
+			return 1
+		}
+		xml.EscapeText(out, []byte(decoded))
+	}
+	return len(match)
+}
+
+// verbatimSection returns the length of the CDATA section or comment at the start of data, or 0
+func verbatimSection(data []byte) int {
+	for _, delimiters := range [][2]string{{"<![CDATA[", "]]>"}, {"<!--", "-->"}} {
+		if !bytes.HasPrefix(data, []byte(delimiters[0])) {
+			continue
+		}
+		end := bytes.Index(data[len(delimiters[0]):], []byte(delimiters[1]))
+		if end < 0 {
+			return 0
+		}
+		return len(delimiters[0]) + end + len(delimiters[1])
+	}
+	return 0
+}
+
+// parseCharRef returns the code point of a numeric character reference name such as #38 or #x26
+func parseCharRef(name string) rune {
+	var value uint64
+	var err error
+	if name[1] == 'x' || name[1] == 'X' {
+		value, err = strconv.ParseUint(name[2:], 16, 32)
+	} else {
+		value, err = strconv.ParseUint(name[1:], 10, 32)
+	}
+	if err != nil {
+		return -1
+	}
+	return rune(value)
+}
+
+// isXMLChar reports whether r is allowed in an XML 1.0 document
+func isXMLChar(r rune) bool {
+	return r == 0x09 || r == 0x0A || r == 0x0D ||
+		(r >= 0x20 && r <= 0xD7FF) ||
+		(r >= 0xE000 && r <= 0xFFFD) ||
+		(r >= 0x10000 && r <= 0x10FFFF)
+}
+
+// ParseAnyLenient is gen.ParseAny on SanitizeXML(xmlData). Sanitizing alters content (see SanitizeXML),
+// so use it only for sources known to send malformed XML.
+func ParseAnyLenient(xmlData []byte) (message interface{}, messageType, version string, err error) {
+	return gen.ParseAny(SanitizeXML(xmlData))
+}
@@ -0,0 +1,687 @@
+package ddex
+
+import (
+	"bytes"
+	"encoding/xml"
+	"fmt"
+	"io"
+	"io/fs"
+	"path"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+
+	"github.com/alecsavvy/ddex-proto/gen"
+	"github.com/alecsavvy/ddex-proto/xsd"
+)
+
+// SchemaError describes a violation of the DDEX XSD found by ValidateAgainstSchema
+type SchemaError struct {
+	// Path is the DDEX path of the offending element or attribute, e.g. /NewReleaseMessage/ResourceList
+	Path string
+	// Message explains the violation
+	Message string
+}
+
+// Error implements the error interface
+func (e SchemaError) Error() string {
+	return fmt.Sprintf("%s: %s", e.Path, e.Message)
+}
+
+// ValidateAgainstSchema checks a DDEX document against the embedded XSD of its detected message type and
+// version, without any external tooling. It covers the structural rules of the DDEX schemas: the
+// sequence, choice and cardinality of child elements, unknown elements and attributes, required
+// attributes, xsi:type, and the enumerations (AVS code lists) and patterns of simple values. Identity constraints
+// and the lexical forms of dates and durations are not checked.
+func ValidateAgainstSchema(xmlData []byte) []SchemaError {
+	messageType, version, _, err := gen.DetectMessageType(xmlData)
+	if err != nil {
+		return []SchemaError{{Path: "/", Message: err.Error()}}
+	}
+	schema, err := loadSchema(messageType + version)
+	if err != nil {
+		return []SchemaError{{Path: "/", Message: err.Error()}}
+	}
+
+	root, err := readSchemaTree(xmlData)
+	if err != nil {
+		return []SchemaError{{Path: "/", Message: err.Error()}}
+	}
+
+	v := &schemaValidator{schema: schema}
+	decl, ok := schema.elements[schema.target+" "+root.name.Local]
+	if !ok || root.name.Space != schema.target {
+		return []SchemaError{{Path: "/" + root.name.Local, Message: "not a root element of the schema"}}
+	}
+	v.validateElement(root, decl, "/"+root.name.Local)
+	return v.errs
+}
+
+// schemaCache holds the compiled schemas by directory under xsd.FS (e.g. ernv432)
+var schemaCache sync.Map
+
+// schemaEntry compiles a schema once
+type schemaEntry struct {
+	once   sync.Once
+	schema *compiledSchema
+	err    error
+}
+
+// loadSchema compiles the schema in directory dir of the embedded XSDs, with its imports
+func loadSchema(dir string) (*compiledSchema, error) {
+	value, _ := schemaCache.LoadOrStore(dir, &schemaEntry{})
+	entry := value.(*schemaEntry)
+	entry.once.Do(func() {
+		files, err := fs.Glob(xsd.FS, dir+"/*.xsd")
+		if err != nil || len(files) == 0 {
+			entry.err = fmt.Errorf("no embedded schema for %s", dir)
+			return
+		}
+		schema := &compiledSchema{
+			elements:     make(map[string]*schemaElement),
+			complexTypes: make(map[string]*schemaComplexType),
+			simpleTypes:  make(map[string]*schemaSimpleType),
+		}
+		entry.err = schema.load(files[0], true, make(map[string]bool))
+		entry.schema = schema
+	})
+	return entry.schema, entry.err
+}
+
+// compiledSchema is the subset of an XSD needed for validation. Declarations are keyed by
+// "namespace localName".
+type compiledSchema struct {
+	target       string
+	elements     map[string]*schemaElement
+	complexTypes map[string]*schemaComplexType
+	simpleTypes  map[string]*schemaSimpleType
+}
+
+// schemaElement is an element declaration; typeKey refers to a named type, otherwise the type is inline
+type schemaElement struct {
+	name    string
+	typeKey string
+	complex *schemaComplexType
+	simple  *schemaSimpleType
+}
+
+// schemaComplexType is a complex type with element-only content or simple content
+type schemaComplexType struct {
+	content      *schemaParticle
+	attrs        map[string]*schemaAttribute
+	anyAttribute bool
+	// textKey is the base type of simple content; simpleContent is set for simple content types
+	simpleContent bool
+	textKey       string
+}
+
+// schemaAttribute is an attribute declaration
+type schemaAttribute struct {
+	required bool
+	typeKey  string
+	simple   *schemaSimpleType
+}
+
+// schemaSimpleType restricts a base type by enumeration and pattern
+type schemaSimpleType struct {
+	baseKey  string
+	enums    map[string]bool
+	patterns []*regexp.Regexp
+}
+
+// schemaParticle is an element, sequence, choice or wildcard with its occurrence bounds (max < 0 is unbounded)
+type schemaParticle struct {
+	kind     string
+	min, max int
+	element  *schemaElement
+	children []*schemaParticle
+}
+
+// schemaNode is a parsed XML element, of either a schema file or a validated document
+type schemaNode struct {
+	name     xml.Name
+	attrs    []xml.Attr
+	text     strings.Builder
+	children []*schemaNode
+	// namespaces maps the prefixes in scope ("" for the default namespace) to namespace URIs
+	namespaces map[string]string
+}
+
+// attr returns the value of the unqualified attribute name
+func (n *schemaNode) attr(name string) string {
+	for _, attr := range n.attrs {
+		if attr.Name.Local == name && attr.Name.Space == "" {
+			return attr.Value
+		}
+	}
+	return ""
+}
+
+// readSchemaTree parses an XML document into a tree of schemaNodes
+func readSchemaTree(data []byte) (*schemaNode, error) {
+	decoder := xml.NewDecoder(bytes.NewReader(data))
+	var root *schemaNode
+	var stack []*schemaNode
+	for {
+		tok, err := decoder.Token()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, fmt.Errorf("failed to parse XML: %w", err)
+		}
+		switch t := tok.(type) {
+		case xml.StartElement:
+			node := &schemaNode{name: t.Name, attrs: t.Attr}
+			if len(stack) > 0 {
+				parent := stack[len(stack)-1]
+				parent.children = append(parent.children, node)
+				node.namespaces = parent.namespaces
+			} else {
+				root = node
+			}
+			node.namespaces = scopeNamespaces(node.namespaces, t.Attr)
+			stack = append(stack, node)
+		case xml.EndElement:
+			stack = stack[:len(stack)-1]
+		case xml.CharData:
+			if len(stack) > 0 {
+				stack[len(stack)-1].text.Write(t)
+			}
+		}
+	}
+	if root == nil {
+		return nil, fmt.Errorf("document has no root element")
+	}
+	return root, nil
+}
+
+// scopeNamespaces returns the namespaces in scope on an element with attrs, given those of its parent.
+// The parent's map is shared unless the element declares namespaces of its own.
+func scopeNamespaces(parent map[string]string, attrs []xml.Attr) map[string]string {
+	scope, copied := parent, false
+	for _, attr := range attrs {
+		var prefix string
+		switch {
+		case attr.Name.Space == "xmlns":
+			prefix = attr.Name.Local
+		case attr.Name.Space == "" && attr.Name.Local == "xmlns":
+		default:
+			continue
+		}
+		if !copied {
+			scope, copied = make(map[string]string, len(parent)+1), true
+			for key, value := range parent {
+				scope[key] = value
+			}
+		}
+		scope[prefix] = attr.Value
+	}
+	return scope
+}
+
+// xsdNamespace is the namespace of the XML Schema built-in types
+const xsdNamespace = "http://www.w3.org/2001/XMLSchema"
+
+// xsiNamespace is the XML Schema instance namespace of xsi:type and xsi:schemaLocation
+const xsiNamespace = "http://www.w3.org/2001/XMLSchema-instance"
+
+// load adds the declarations of the schema file at name, and of the files it imports, to s
+func (s *compiledSchema) load(name string, main bool, loaded map[string]bool) error {
+	if loaded[name] {
+		return nil
+	}
+	loaded[name] = true
+
+	data, err := fs.ReadFile(xsd.FS, name)
+	if err != nil {
+		return err
+	}
+	root, err := readSchemaTree(data)
+	if err != nil {
+		return fmt.Errorf("%s: %w", name, err)
+	}
+
+	prefixes := make(map[string]string)
+	for _, attr := range root.attrs {
+		if attr.Name.Space == "xmlns" {
+			prefixes[attr.Name.Local] = attr.Value
+		}
+	}
+	target := root.attr("targetNamespace")
+	if main {
+		s.target = target
+	}
+	f := &schemaFile{schema: s, target: target, prefixes: prefixes}
+
+	for _, child := range root.children {
+		switch child.name.Local {
+		case "import":
+			// Imports are resolved against the embedded files, which all sit in the top directory
+			if location := child.attr("schemaLocation"); location != "" {
+				if err := s.load(path.Base(location), false, loaded); err != nil {
+					return err
+				}
+			}
+		case "element":
+			s.elements[target+" "+child.attr("name")] = f.element(child)
+		case "complexType":
+			s.complexTypes[target+" "+child.attr("name")] = f.complexType(child)
+		case "simpleType":
+			s.simpleTypes[target+" "+child.attr("name")] = f.simpleType(child)
+		}
+	}
+	return nil
+}
+
+// schemaFile compiles the declarations of one schema file
+type schemaFile struct {
+	schema   *compiledSchema
+	target   string
+	prefixes map[string]string
+}
+
+// key resolves a QName attribute value such as avs:ArtistRole to a declaration key
+func (f *schemaFile) key(qname string) string {
+	if qname == "" {
+		return ""
+	}
+	prefix, local := "", qname
+	if i := strings.Index(qname, ":"); i >= 0 {
+		prefix, local = qname[:i], qname[i+1:]
+	}
+	return f.prefixes[prefix] + " " + local
+}
+
+func (f *schemaFile) element(n *schemaNode) *schemaElement {
+	element := &schemaElement{name: n.attr("name"), typeKey: f.key(n.attr("type"))}
+	for _, child := range n.children {
+		switch child.name.Local {
+		case "complexType":
+			element.complex = f.complexType(child)
+		case "simpleType":
+			element.simple = f.simpleType(child)
+		}
+	}
+	return element
+}
+
+func (f *schemaFile) complexType(n *schemaNode) *schemaComplexType {
+	ct := &schemaComplexType{attrs: make(map[string]*schemaAttribute)}
+	var collect func(n *schemaNode)
+	collect = func(n *schemaNode) {
+		for _, child := range n.children {
+			switch child.name.Local {
+			case "sequence", "choice":
+				ct.content = f.particle(child)
+			case "attribute":
+				ct.attrs[child.attr("name")] = f.attribute(child)
+			case "anyAttribute":
+				ct.anyAttribute = true
+			case "simpleContent":
+				ct.simpleContent = true
+				collect(child)
+			case "extension", "restriction":
+				ct.textKey = f.key(child.attr("base"))
+				collect(child)
+			}
+		}
+	}
+	collect(n)
+	return ct
+}
+
+func (f *schemaFile) attribute(n *schemaNode) *schemaAttribute {
+	attr := &schemaAttribute{required: n.attr("use") == "required", typeKey: f.key(n.attr("type"))}
+	for _, child := range n.children {
+		if child.name.Local == "simpleType" {
+			attr.simple = f.simpleType(child)
+		}
+	}
+	return attr
+}
+
+func (f *schemaFile) simpleType(n *schemaNode) *schemaSimpleType {
+	st := &schemaSimpleType{}
+	for _, child := range n.children {
+		if child.name.Local != "restriction" {
+			continue
+		}
+		st.baseKey = f.key(child.attr("base"))
+		for _, facet := range child.children {
+			switch facet.name.Local {
+			case "enumeration":
+				if st.enums == nil {
+					st.enums = make(map[string]bool)
+				}
+				st.enums[facet.attr("value")] = true
+			case "pattern":
+				if re, err := regexp.Compile("^(?:" + facet.attr("value") + ")$"); err == nil {
+					st.patterns = append(st.patterns, re)
+				}
+			}
+		}
+	}
+	return st
+}
+
+func (f *schemaFile) particle(n *schemaNode) *schemaParticle {
+	p := &schemaParticle{kind: n.name.Local, min: 1, max: 1}
+	if value := n.attr("minOccurs"); value != "" {
+		p.min, _ = strconv.Atoi(value)
+	}
+	if value := n.attr("maxOccurs"); value == "unbounded" {
+		p.max = -1
+	} else if value != "" {
+		p.max, _ = strconv.Atoi(value)
+	}
+
+	switch p.kind {
+	case "element":
+		p.element = f.element(n)
+	case "sequence", "choice":
+		for _, child := range n.children {
+			switch child.name.Local {
+			case "element", "sequence", "choice", "any":
+				p.children = append(p.children, f.particle(child))
+			}
+		}
+	}
+	return p
+}
+
+// schemaValidator collects the violations of a document
+type schemaValidator struct {
+	schema *compiledSchema
+	errs   []SchemaError
+}
+
+func (v *schemaValidator) report(path, format string, args ...interface{}) {
+	v.errs = append(v.errs, SchemaError{Path: path, Message: fmt.Sprintf(format, args...)})
+}
+
+// validateElement checks a document element against its declaration
+func (v *schemaValidator) validateElement(n *schemaNode, decl *schemaElement, elementPath string) {
+	complexType, simpleType, typeKey := decl.complex, decl.simple, decl.typeKey
+	v.checkXsiType(n, typeKey, elementPath)
+	if complexType == nil && simpleType == nil && typeKey != "" {
+		complexType = v.schema.complexTypes[typeKey]
+	}
+
+	if complexType == nil {
+		if len(n.children) > 0 {
+			v.report(elementPath, "element %s cannot have child elements", n.children[0].name.Local)
+		}
+		if simpleType != nil {
+			v.checkSimpleType(simpleType, n.text.String(), elementPath)
+		} else if typeKey != "" {
+			v.checkValue(typeKey, n.text.String(), elementPath)
+		}
+		return
+	}
+
+	v.validateAttributes(n, complexType, elementPath)
+
+	if complexType.simpleContent {
+		if len(n.children) > 0 {
+			v.report(elementPath, "element %s cannot have child elements", n.children[0].name.Local)
+		}
+		v.checkValue(complexType.textKey, n.text.String(), elementPath)
+		return
+	}
+
+	if strings.TrimSpace(n.text.String()) != "" {
+		v.report(elementPath, "text is not allowed in element-only content")
+	}
+	if complexType.content == nil {
+		if len(n.children) > 0 {
+			v.report(elementPath+"/"+n.children[0].name.Local, "element is not allowed here, %s has no child elements", n.name.Local)
+		}
+		return
+	}
+
+	m := &contentMatcher{children: n.children}
+	ends := m.match(complexType.content, 0)
+	if !ends[len(n.children)] {
+		m.expected = make(map[string]bool)
+		m.match(complexType.content, 0)
+		expected := sortedKeys(m.expected)
+		if m.furthest < len(n.children) {
+			unexpected := n.children[m.furthest].name.Local
+			v.report(elementPath+"/"+unexpected, "unexpected element %s, expected %s", unexpected, describeExpected(expected))
+		} else {
+			v.report(elementPath, "missing child element, expected %s", describeExpected(expected))
+		}
+		return
+	}
+
+	declarations := make(map[string]*schemaElement)
+	collectElementDeclarations(complexType.content, declarations)
+	for _, child := range n.children {
+		if child.name.Space != "" && child.name.Space != v.schema.target {
+			continue
+		}
+		if childDecl, ok := declarations[child.name.Local]; ok {
+			v.validateElement(child, childDecl, elementPath+"/"+child.name.Local)
+		}
+	}
+}
+
+// checkXsiType checks that an xsi:type on an element names the element's declared type. The DDEX schemas
+// declare no abstract or derived types, so there is no other type an instance could select, and the
+// generated structs always decode an element as its declared type.
+func (v *schemaValidator) checkXsiType(n *schemaNode, typeKey, elementPath string) {
+	for _, attr := range n.attrs {
+		if attr.Name.Space != xsiNamespace || attr.Name.Local != "type" {
+			continue
+		}
+
+		prefix, local := "", strings.TrimSpace(attr.Value)
+		if i := strings.Index(local, ":"); i >= 0 {
+			prefix, local = local[:i], local[i+1:]
+		}
+		namespace, ok := n.namespaces[prefix]
+		switch {
+		case !ok && prefix != "":
+			v.report(elementPath+"@type", "xsi:type %q uses undeclared prefix %q", attr.Value, prefix)
+		case typeKey == "":
+			v.report(elementPath+"@type", "xsi:type %q is not allowed on an element with an anonymous type", attr.Value)
+		case namespace+" "+local != typeKey:
+			v.report(elementPath+"@type", "xsi:type %q is not the declared type %s of the element", attr.Value, typeKey[strings.LastIndex(typeKey, " ")+1:])
+		}
+	}
+}
+
+// validateAttributes checks the attributes of an element against its complex type. Namespace
+// declarations and attributes in other namespaces (xsi:schemaLocation, xml:lang) are not checked.
+func (v *schemaValidator) validateAttributes(n *schemaNode, ct *schemaComplexType, elementPath string) {
+	present := make(map[string]bool)
+	for _, attr := range n.attrs {
+		if attr.Name.Space != "" || attr.Name.Local == "xmlns" {
+			continue
+		}
+		present[attr.Name.Local] = true
+		decl, ok := ct.attrs[attr.Name.Local]
+		if !ok {
+			if !ct.anyAttribute {
+				v.report(elementPath+"@"+attr.Name.Local, "attribute is not allowed")
+			}
+			continue
+		}
+		if decl.simple != nil {
+			v.checkSimpleType(decl.simple, attr.Value, elementPath+"@"+attr.Name.Local)
+		} else {
+			v.checkValue(decl.typeKey, attr.Value, elementPath+"@"+attr.Name.Local)
+		}
+	}
+
+	for _, name := range sortedKeys(ct.attrs) {
+		if ct.attrs[name].required && !present[name] {
+			v.report(elementPath+"@"+name, "required attribute is missing")
+		}
+	}
+}
+
+// checkValue checks a simple value against the named simple type, or a built-in type
+func (v *schemaValidator) checkValue(typeKey, value, valuePath string) {
+	if st, ok := v.schema.simpleTypes[typeKey]; ok {
+		v.checkSimpleType(st, value, valuePath)
+		return
+	}
+
+	value = strings.TrimSpace(value)
+	switch strings.TrimPrefix(typeKey, xsdNamespace+" ") {
+	case "boolean":
+		if value != "true" && value != "false" && value != "1" && value != "0" {
+			v.report(valuePath, "%q is not a boolean", value)
+		}
+	case "integer", "int", "long", "nonNegativeInteger", "positiveInteger":
+		if _, err := strconv.ParseInt(value, 10, 64); err != nil {
+			v.report(valuePath, "%q is not an integer", value)
+		}
+	case "decimal":
+		if _, err := strconv.ParseFloat(value, 64); err != nil {
+			v.report(valuePath, "%q is not a decimal", value)
+		}
+	}
+}
+
+// checkSimpleType checks a value against the enumeration and patterns of a simple type and its base
+func (v *schemaValidator) checkSimpleType(st *schemaSimpleType, value, valuePath string) {
+	trimmed := strings.TrimSpace(value)
+	if st.enums != nil && !st.enums[trimmed] {
+		v.report(valuePath, "%q is not an allowed value", trimmed)
+		return
+	}
+	if len(st.patterns) > 0 {
+		matched := false
+		for _, pattern := range st.patterns {
+			if pattern.MatchString(trimmed) {
+				matched = true
+				break
+			}
+		}
+		if !matched {
+			v.report(valuePath, "%q does not match the required pattern", trimmed)
+			return
+		}
+	}
+	if st.baseKey != "" {
+		v.checkValue(st.baseKey, value, valuePath)
+	}
+}
+
+// contentMatcher matches the child elements of a document element against a content model. When
+// expected is set, it also records the elements that could follow the longest matched prefix.
+type contentMatcher struct {
+	children []*schemaNode
+	furthest int
+	expected map[string]bool
+}
+
+// match returns the positions at which p, repeated within its bounds, can end when starting at start
+func (m *contentMatcher) match(p *schemaParticle, start int) map[int]bool {
+	ends := make(map[int]bool)
+	if p.min == 0 {
+		ends[start] = true
+	}
+
+	current := map[int]bool{start: true}
+	for count := 1; len(current) > 0 && (p.max < 0 || count <= p.max); count++ {
+		next := make(map[int]bool)
+		for pos := range current {
+			for end := range m.matchOnce(p, pos) {
+				if end == pos {
+					// The particle can match nothing, so any remaining occurrences can too
+					ends[pos] = true
+					continue
+				}
+				next[end] = true
+			}
+		}
+		if count >= p.min {
+			for end := range next {
+				ends[end] = true
+			}
+		}
+		current = next
+	}
+	return ends
+}
+
+// matchOnce returns the end positions of a single occurrence of p starting at start
+func (m *contentMatcher) matchOnce(p *schemaParticle, start int) map[int]bool {
+	ends := make(map[int]bool)
+	switch p.kind {
+	case "element":
+		if start < len(m.children) && m.children[start].name.Local == p.element.name {
+			ends[start+1] = true
+			if start+1 > m.furthest {
+				m.furthest = start + 1
+			}
+		} else if m.expected != nil && start == m.furthest {
+			m.expected[p.element.name] = true
+		}
+	case "any":
+		if start < len(m.children) && m.children[start].name.Space != "" {
+			ends[start+1] = true
+			if start+1 > m.furthest {
+				m.furthest = start + 1
+			}
+		}
+	case "sequence":
+		positions := map[int]bool{start: true}
+		for _, child := range p.children {
+			next := make(map[int]bool)
+			for pos := range positions {
+				for end := range m.match(child, pos) {
+					next[end] = true
+				}
+			}
+			positions = next
+		}
+		ends = positions
+	case "choice":
+		for _, child := range p.children {
+			for end := range m.match(child, start) {
+				ends[end] = true
+			}
+		}
+	}
+	return ends
+}
+
+// collectElementDeclarations maps the names of the elements in a content model to their declarations
+func collectElementDeclarations(p *schemaParticle, declarations map[string]*schemaElement) {
+	if p.kind == "element" {
+		if _, ok := declarations[p.element.name]; !ok {
+			declarations[p.element.name] = p.element
+		}
+	}
+	for _, child := range p.children {
+		collectElementDeclarations(child, declarations)
+	}
+}
+
+// describeExpected lists the element names a content model accepts at a position
+func describeExpected(names []string) string {
+	switch len(names) {
+	case 0:
+		return "no further elements"
+	case 1:
+		return names[0]
+	}
+	return "one of " + strings.Join(names, ", ")
+}
+
+// sortedKeys returns the keys of a map in ascending order
+func sortedKeys[V any](m map[string]V) []string {
+	keys := make([]string, 0, len(m))
+	for key := range m {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	return keys
+}
@@ -0,0 +1,63 @@
+package ddex
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/alecsavvy/ddex-proto/gen"
+)
+
+// SchemaLocationBaseURL is the host and path under which NewMessage and SchemaLocation point
+// xsi:schemaLocation, e.g. an internal mirror of the DDEX schemas. Only the schema URL changes;
+// the namespace URIs are fixed by the standard and always stay on ddex.net.
+var SchemaLocationBaseURL = "http://ddex.net/xml"
+
+// schemaFileNames is the entry XSD file of each message type
+var schemaFileNames = map[string]string{
+	"ern":  "release-notification.xsd",
+	"mead": "media-enrichment-and-description.xsd",
+	"pie":  "party-identification-and-enrichment.xsd",
+}
+
+// SchemaLocation returns the xsi:schemaLocation value for a message type and version (e.g. "ern", "v432"),
+// pairing the namespace with the XSD under SchemaLocationBaseURL:
+// "http://ddex.net/xml/ern/432 http://ddex.net/xml/ern/432/release-notification.xsd"
+func SchemaLocation(messageType, version string) (string, error) {
+	fileName, ok := schemaFileNames[messageType]
+	if !ok {
+		return "", fmt.Errorf("unknown message type: %s", messageType)
+	}
+
+	namespace := ""
+	for key, info := range gen.GetRegisteredTypes() {
+		if strings.HasPrefix(key, messageType+"/"+version+"/") {
+			namespace = info.Namespace
+			break
+		}
+	}
+	if namespace == "" {
+		return "", fmt.Errorf("unknown message type/version: %s/%s", messageType, version)
+	}
+
+	base := strings.TrimSuffix(SchemaLocationBaseURL, "/")
+	return fmt.Sprintf("%s %s/%s/%s/%s", namespace, base, messageType, strings.TrimPrefix(version, "v"), fileName), nil
+}
+
+// NewMessage creates an empty root message like gen.NewByMessageName with xmlns:xsi and an
+// xsi:schemaLocation built from SchemaLocationBaseURL already set, so marshaled output is self-describing
+func NewMessage(messageType, version, messageName string) (interface{}, error) {
+	location, err := SchemaLocation(messageType, version)
+	if err != nil {
+		return nil, err
+	}
+
+	msg, err := gen.NewByMessageName(messageType, version, messageName)
+	if err != nil {
+		return nil, err
+	}
+
+	return withNamespaceAttrs(msg, func(attrs map[string]string) {
+		attrs["xmlns:xsi"] = "http://www.w3.org/2001/XMLSchema-instance"
+		attrs[schemaLocationAttr] = location
+	})
+}
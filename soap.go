@@ -0,0 +1,153 @@
+package ddex
+
+import (
+	"bytes"
+	"encoding/xml"
+	"fmt"
+	"io"
+	"maps"
+	"sort"
+
+	"github.com/alecsavvy/ddex-proto/gen"
+)
+
+// SOAP envelope namespaces recognized by ParseSOAPWrapped
+const (
+	soap11Namespace = "http://schemas.xmlsoap.org/soap/envelope/"
+	soap12Namespace = "http://www.w3.org/2003/05/soap-envelope"
+)
+
+// ParseSOAPWrapped parses a DDEX message delivered as the first element of the Body of a SOAP 1.1 or 1.2
+// Envelope. The message subtree is extracted, together with the namespace declarations it inherits from
+// the Envelope and Body, and parsed with gen.ParseAny. The SOAP Header, if any, is ignored.
+func ParseSOAPWrapped(data []byte) (message interface{}, messageType, version string, err error) {
+	messageData, err := soapBodyMessage(data)
+	if err != nil {
+		return nil, "", "", err
+	}
+	return gen.ParseAny(messageData)
+}
+
+// soapBodyMessage returns the first element inside soap:Envelope/soap:Body as a standalone document
+func soapBodyMessage(data []byte) ([]byte, error) {
+	decoder := xml.NewDecoder(bytes.NewReader(data))
+	// Namespace declarations of the Envelope and Body, by prefix ("" for the default namespace); those of
+	// the Header and other skipped elements never apply to the message
+	scope := make(map[string]string)
+	soapNamespace := ""
+	depth := 0
+	inBody := false
+	for {
+		offset := decoder.InputOffset()
+		token, err := decoder.RawToken()
+		if err == io.EOF {
+			return nil, fmt.Errorf("no SOAP Body found")
+		}
+		if err != nil {
+			return nil, fmt.Errorf("failed to read SOAP envelope: %w", err)
+		}
+
+		switch t := token.(type) {
+		case xml.StartElement:
+			declared := namespaceDeclarations(t)
+			namespace, ok := declared[t.Name.Space]
+			if !ok {
+				namespace = scope[t.Name.Space]
+			}
+
+			switch {
+			case depth == 0:
+				if t.Name.Local != "Envelope" || (namespace != soap11Namespace && namespace != soap12Namespace) {
+					return nil, fmt.Errorf("root element %s is not a SOAP Envelope", t.Name.Local)
+				}
+				soapNamespace = namespace
+			case depth == 1 && t.Name.Local == "Body" && namespace == soapNamespace:
+				inBody = true
+			case depth == 1:
+				// Skip the SOAP Header and anything else beside the Body
+				if err := skipRaw(decoder); err != nil {
+					return nil, err
+				}
+				continue
+			default:
+				if err := skipRaw(decoder); err != nil {
+					return nil, err
+				}
+				return withInheritedNamespaces(data[offset:decoder.InputOffset()], t, scope, soapNamespace), nil
+			}
+			maps.Copy(scope, declared)
+			depth++
+		case xml.EndElement:
+			depth--
+			if inBody {
+				return nil, fmt.Errorf("SOAP Body contains no message")
+			}
+		}
+	}
+}
+
+// skipRaw reads with RawToken past the end of the element whose start was just read
+func skipRaw(decoder *xml.Decoder) error {
+	for depth := 1; depth > 0; {
+		token, err := decoder.RawToken()
+		if err != nil {
+			return fmt.Errorf("failed to read SOAP envelope: %w", err)
+		}
+		switch token.(type) {
+		case xml.StartElement:
+			depth++
+		case xml.EndElement:
+			depth--
+		}
+	}
+	return nil
+}
+
+// namespaceDeclarations returns the namespaces a start tag declares, by prefix ("" for the default namespace)
+func namespaceDeclarations(start xml.StartElement) map[string]string {
+	declared := make(map[string]string)
+	for _, attr := range start.Attr {
+		switch {
+		case attr.Name.Space == "xmlns":
+			declared[attr.Name.Local] = attr.Value
+		case attr.Name.Space == "" && attr.Name.Local == "xmlns":
+			declared[""] = attr.Value
+		}
+	}
+	return declared
+}
+
+// withInheritedNamespaces adds to the start tag of element the declarations in scope that it does not make
+// itself, leaving out the SOAP namespace
+func withInheritedNamespaces(element []byte, start xml.StartElement, scope map[string]string, soapNamespace string) []byte {
+	own := namespaceDeclarations(start)
+
+	var prefixes []string
+	for prefix, namespace := range scope {
+		if _, ok := own[prefix]; !ok && namespace != soapNamespace {
+			prefixes = append(prefixes, prefix)
+		}
+	}
+	if len(prefixes) == 0 {
+		return element
+	}
+	sort.Strings(prefixes)
+
+	name := start.Name.Local
+	if start.Name.Space != "" {
+		name = start.Name.Space + ":" + name
+	}
+	var out bytes.Buffer
+	out.Write(element[:1+len(name)])
+	for _, prefix := range prefixes {
+		if prefix == "" {
+			out.WriteString(` xmlns="`)
+		} else {
+			out.WriteString(` xmlns:` + prefix + `="`)
+		}
+		xml.EscapeText(&out, []byte(scope[prefix]))
+		out.WriteByte('"')
+	}
+	out.Write(element[1+len(name):])
+	return out.Bytes()
+}
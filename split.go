@@ -0,0 +1,192 @@
+package ddex
+
+import (
+	"fmt"
+	"reflect"
+	"strings"
+
+	ernv432 "github.com/alecsavvy/ddex-proto/gen/ddex/ern/v432"
+	"google.golang.org/protobuf/proto"
+)
+
+// SplitByRelease explodes a NewReleaseMessage into one message per release (the main Release, each
+// TrackRelease and each ClipRelease). Each output carries a copy of the header, its single release, the
+// ReleaseDeals referencing that release, and the parties, resources, chapters, cue sheets and visibilities
+// the release transitively references. Shared elements are copied into every output, so the messages can
+// be modified independently.
+func SplitByRelease(msg *ernv432.NewReleaseMessage) ([]*ernv432.NewReleaseMessage, error) {
+	releaseList := msg.GetReleaseList()
+	var releases []*ernv432.ReleaseList
+	if releaseList.GetRelease() != nil {
+		releases = append(releases, &ernv432.ReleaseList{Release: releaseList.GetRelease()})
+	}
+	for _, trackRelease := range releaseList.GetTrackRelease() {
+		releases = append(releases, &ernv432.ReleaseList{TrackRelease: []*ernv432.TrackRelease{trackRelease}})
+	}
+	for _, clipRelease := range releaseList.GetClipRelease() {
+		releases = append(releases, &ernv432.ReleaseList{ClipRelease: []*ernv432.ClipRelease{clipRelease}})
+	}
+	if len(releases) == 0 {
+		return nil, fmt.Errorf("message has no releases")
+	}
+
+	targets := make(map[string]proto.Message)
+	for _, list := range []proto.Message{msg.GetPartyList(), msg.GetCueSheetList(), msg.GetResourceList(), msg.GetChapterList(), msg.GetDealList()} {
+		indexListItems(list, targets)
+	}
+
+	var messages []*ernv432.NewReleaseMessage
+	for _, release := range releases {
+		releaseRefs := make(map[string]bool)
+		walkScalars(release, func(path string, field xmlField, value reflect.Value) {
+			if field.Name == "ReleaseReference" {
+				releaseRefs[strings.TrimSpace(value.String())] = true
+			}
+		})
+
+		var deals []*ernv432.ReleaseDeal
+		for _, releaseDeal := range msg.GetDealList().GetReleaseDeal() {
+			for _, reference := range releaseDeal.GetDealReleaseReference() {
+				if releaseRefs[strings.TrimSpace(reference)] {
+					deal := proto.Clone(releaseDeal).(*ernv432.ReleaseDeal)
+					deal.DealReleaseReference = []string{reference}
+					deals = append(deals, deal)
+					break
+				}
+			}
+		}
+
+		included := referencedItems(targets, release, deals)
+		out := &ernv432.NewReleaseMessage{
+			MessageHeader:                  proto.Clone(msg.GetMessageHeader()).(*ernv432.MessageHeader),
+			ReleaseList:                    proto.Clone(release).(*ernv432.ReleaseList),
+			ReleaseProfileVersionId:        msg.GetReleaseProfileVersionId(),
+			ReleaseProfileVariantVersionId: msg.GetReleaseProfileVariantVersionId(),
+			AvsVersionId:                   msg.GetAvsVersionId(),
+			LanguageAndScriptCode:          msg.GetLanguageAndScriptCode(),
+		}
+		for _, admin := range msg.GetReleaseAdmin() {
+			out.ReleaseAdmin = append(out.ReleaseAdmin, proto.Clone(admin).(*ernv432.ReleaseAdmin))
+		}
+		if msg.GetSupplementalDocumentList() != nil {
+			out.SupplementalDocumentList = proto.Clone(msg.GetSupplementalDocumentList()).(*ernv432.SupplementalDocumentList)
+		}
+		for key, value := range msg.GetNamespaceAttrs() {
+			out.SetNamespaceAttr(key, value)
+		}
+
+		if list, ok := filterListItems(msg.GetPartyList(), included); ok {
+			out.PartyList = list.(*ernv432.PartyList)
+		}
+		if list, ok := filterListItems(msg.GetCueSheetList(), included); ok {
+			out.CueSheetList = list.(*ernv432.CueSheetList)
+		}
+		if list, ok := filterListItems(msg.GetResourceList(), included); ok {
+			out.ResourceList = list.(*ernv432.ResourceList)
+		}
+		if list, ok := filterListItems(msg.GetChapterList(), included); ok {
+			out.ChapterList = list.(*ernv432.ChapterList)
+		}
+		if len(deals) > 0 {
+			dealList, _ := filterListItems(msg.GetDealList(), included)
+			if dealList == nil {
+				dealList = &ernv432.DealList{}
+			}
+			out.DealList = dealList.(*ernv432.DealList)
+			out.DealList.ReleaseDeal = deals
+		}
+		messages = append(messages, out)
+	}
+	return messages, nil
+}
+
+// indexListItems adds the elements of every repeated field of a message list (PartyList, ResourceList,
+// ...) to targets under the message-local references they declare
+func indexListItems(list proto.Message, targets map[string]proto.Message) {
+	v := reflect.ValueOf(list)
+	if v.IsNil() {
+		return
+	}
+	v = v.Elem()
+	for i := 0; i < v.NumField(); i++ {
+		items := v.Field(i)
+		if items.Kind() != reflect.Slice || items.Type().Elem().Kind() != reflect.Ptr {
+			continue
+		}
+		for j := 0; j < items.Len(); j++ {
+			item, ok := items.Index(j).Interface().(proto.Message)
+			if !ok {
+				continue
+			}
+			for name := range definingReferences {
+				if field := items.Index(j).Elem().FieldByName(name); field.IsValid() {
+					for _, reference := range stringValues(field) {
+						targets[strings.TrimSpace(reference)] = item
+					}
+				}
+			}
+		}
+	}
+}
+
+// referencedItems returns the indexed targets transitively referenced from a release and its deals
+func referencedItems(targets map[string]proto.Message, release *ernv432.ReleaseList, deals []*ernv432.ReleaseDeal) map[proto.Message]bool {
+	included := make(map[proto.Message]bool)
+	queue := []interface{}{release}
+	for _, deal := range deals {
+		queue = append(queue, deal)
+	}
+
+	for len(queue) > 0 {
+		next := queue[0]
+		queue = queue[1:]
+		walkScalars(next, func(path string, field xmlField, value reflect.Value) {
+			if field.Attr || value.Kind() != reflect.String || !strings.HasSuffix(field.Name, "Reference") {
+				return
+			}
+			if definingReferences[field.Name] || freeReferences[field.Name] {
+				return
+			}
+			if target, ok := targets[strings.TrimSpace(value.String())]; ok && !included[target] {
+				included[target] = true
+				queue = append(queue, target)
+			}
+		})
+	}
+	return included
+}
+
+// filterListItems copies a message list keeping only the elements of its repeated fields that are in
+// included. The copy is deep, so it shares nothing with list. It reports false when the list is absent or
+// nothing in it is included.
+func filterListItems(list proto.Message, included map[proto.Message]bool) (proto.Message, bool) {
+	v := reflect.ValueOf(list)
+	if v.IsNil() {
+		return nil, false
+	}
+
+	// The clone's repeated fields line up with the original's, whose items are the keys of included
+	out := proto.Clone(list)
+	copied := reflect.ValueOf(out).Elem()
+	found := false
+	for i := 0; i < v.Elem().NumField(); i++ {
+		items := v.Elem().Field(i)
+		if !v.Elem().Type().Field(i).IsExported() || items.Kind() != reflect.Slice || items.Type().Elem().Kind() != reflect.Ptr {
+			continue
+		}
+
+		kept := reflect.MakeSlice(items.Type(), 0, 0)
+		for j := 0; j < items.Len(); j++ {
+			if item, ok := items.Index(j).Interface().(proto.Message); ok && included[item] {
+				kept = reflect.Append(kept, copied.Field(i).Index(j))
+			}
+		}
+		if kept.Len() > 0 {
+			copied.Field(i).Set(kept)
+			found = true
+		} else {
+			copied.Field(i).Set(reflect.Zero(items.Type()))
+		}
+	}
+	return out, found
+}
@@ -0,0 +1,121 @@
+package ddex
+
+import (
+	"reflect"
+	"strings"
+)
+
+// Stats summarizes the contents of a DDEX message
+type Stats struct {
+	// Resources counts the children of ResourceList by element name (SoundRecording, Video, Image, ...)
+	Resources map[string]int
+	// Releases counts the children of ReleaseList (Release, TrackRelease, ClipRelease)
+	Releases int
+	// Deals counts Deal elements anywhere in the message
+	Deals int
+	// Parties counts the children of PartyList (ERN 4.x, PIE)
+	Parties int
+	// Elements counts every element that would be marshaled with content, including the root
+	Elements int
+}
+
+// MessageStats counts the resources, releases, deals, parties and elements of any generated DDEX message
+// in a single reflection pass
+func MessageStats(msg interface{}) Stats {
+	stats := Stats{Resources: make(map[string]int)}
+
+	v := reflect.ValueOf(msg)
+	for v.Kind() == reflect.Ptr || v.Kind() == reflect.Interface {
+		if v.IsNil() {
+			return stats
+		}
+		v = v.Elem()
+	}
+	if v.Kind() != reflect.Struct {
+		return stats
+	}
+
+	stats.Elements++
+	countChildren(v, v.Type().Name(), &stats)
+	return stats
+}
+
+// countChildren counts the child elements of the struct for element parent and recurses into them
+func countChildren(v reflect.Value, parent string, stats *Stats) {
+	t := v.Type()
+	for i := 0; i < t.NumField(); i++ {
+		field, ok := xmlFieldOf(t.Field(i))
+		if !ok || field.Attr || field.CharData || field.InnerXML {
+			continue
+		}
+		countElement(v.Field(i), field.Name, parent, stats)
+	}
+}
+
+// countElement counts each occurrence of a child element value, skipping nil and empty scalars
+func countElement(v reflect.Value, name, parent string, stats *Stats) {
+	switch v.Kind() {
+	case reflect.Ptr, reflect.Interface:
+		if !v.IsNil() {
+			countElement(v.Elem(), name, parent, stats)
+		}
+		return
+	case reflect.Slice:
+		if v.Type().Elem().Kind() != reflect.Uint8 {
+			for i := 0; i < v.Len(); i++ {
+				countElement(v.Index(i), name, parent, stats)
+			}
+			return
+		}
+		if v.Len() == 0 {
+			return
+		}
+	case reflect.Map:
+		return
+	case reflect.Struct:
+	default:
+		if v.IsZero() {
+			return
+		}
+	}
+
+	stats.Elements++
+	switch {
+	case parent == "ResourceList":
+		stats.Resources[name]++
+	case parent == "ReleaseList":
+		stats.Releases++
+	case parent == "PartyList":
+		stats.Parties++
+	case name == "Deal":
+		stats.Deals++
+	}
+
+	if v.Kind() == reflect.Struct {
+		countChildren(v, name, stats)
+	}
+}
+
+// FieldFrequency tallies, for every DDEX path (/Root/Child, /Root/Child@attr), how many of messages
+// populate it: have a non-empty value there or below it. Each message counts once per path however often
+// the element repeats, so a count equal to len(messages) means every message has the field.
+func FieldFrequency(messages []interface{}) map[string]int {
+	frequency := make(map[string]int)
+	for _, msg := range messages {
+		seen := make(map[string]bool)
+		walkScalars(msg, func(path string, _ xmlField, _ reflect.Value) {
+			for !seen[path] {
+				seen[path] = true
+				frequency[path]++
+
+				// The enclosing element is populated too
+				cut := strings.LastIndexAny(path, "/@")
+				if cut <= 0 {
+					break
+				}
+				path = path[:cut]
+			}
+		})
+	}
+	return frequency
+}
@@ -0,0 +1,92 @@
+package ddex
+
+import (
+	"fmt"
+	"path"
+	"reflect"
+)
+
+// ValidateSupplementalReferences checks that the external documents a message of any supported version
+// references accompany the delivery: the File of each resource's technical details (audio, artwork,
+// lyrics and other sidecars), by its URI in ERN 4 and by URL, or FilePath and FileName, in ERN 3. exists
+// is called once per distinct URI; each File whose URI it rejects is reported with the path of the URI
+// element and the referencing resource.
+func ValidateSupplementalReferences(msg interface{}, exists func(uri string) bool) []RefError {
+	v := reflect.ValueOf(msg)
+	for v.Kind() == reflect.Ptr || v.Kind() == reflect.Interface {
+		if v.IsNil() {
+			return nil
+		}
+		v = v.Elem()
+	}
+	if v.Kind() != reflect.Struct {
+		return nil
+	}
+
+	resolved := make(map[string]bool)
+	var errs []RefError
+	walkFiles(v, "/"+v.Type().Name(), "", func(filePath, resourceRef, uri string) {
+		found, ok := resolved[uri]
+		if !ok {
+			found = exists(uri)
+			resolved[uri] = found
+		}
+		if found {
+			return
+		}
+		message := "referenced file is not in the delivery"
+		if resourceRef != "" {
+			message = fmt.Sprintf("file of resource %s is not in the delivery", resourceRef)
+		}
+		errs = append(errs, RefError{Path: filePath, Reference: uri, Message: message})
+	})
+	return errs
+}
+
+// walkFiles calls visit with the path of the URI element, the enclosing ResourceReference and the URI of
+// every File element under a value at path
+func walkFiles(v reflect.Value, path, resourceRef string, visit func(path, resourceRef, uri string)) {
+	switch v.Kind() {
+	case reflect.Ptr, reflect.Interface:
+		if !v.IsNil() {
+			walkFiles(v.Elem(), path, resourceRef, visit)
+		}
+	case reflect.Slice:
+		for i := 0; i < v.Len(); i++ {
+			walkFiles(v.Index(i), path, resourceRef, visit)
+		}
+	case reflect.Struct:
+		if reference := stringField(v, "ResourceReference"); reference != "" {
+			resourceRef = reference
+		}
+		if v.Type().Name() == "File" {
+			if uriPath, uri := fileURI(v); uri != "" {
+				visit(path+uriPath, resourceRef, uri)
+			}
+			return
+		}
+
+		t := v.Type()
+		for i := 0; i < t.NumField(); i++ {
+			field, ok := xmlFieldOf(t.Field(i))
+			if ok && !field.Attr && !field.CharData && !field.InnerXML {
+				walkFiles(v.Field(i), path+"/"+field.Name, resourceRef, visit)
+			}
+		}
+	}
+}
+
+// fileURI returns the URI of a File element and the path of the element holding it: URI in ERN 4, URL or
+// FilePath joined with FileName in ERN 3
+func fileURI(file reflect.Value) (string, string) {
+	if uri := stringField(file, "URI"); uri != "" {
+		return "/URI", uri
+	}
+	if url := stringField(file, "URL"); url != "" {
+		return "/URL", url
+	}
+	if name := stringField(file, "FileName"); name != "" {
+		return "/FileName", path.Join(stringField(file, "FilePath"), name)
+	}
+	return "", ""
+}
@@ -0,0 +1,145 @@
+package ddex
+
+import (
+	"fmt"
+	"strings"
+
+	ernv432 "github.com/alecsavvy/ddex-proto/gen/ddex/ern/v432"
+)
+
+// AllowedHashSumAlgorithms is the set of HashSum algorithms accepted by ValidateTechnicalReferences
+var AllowedHashSumAlgorithms = map[string]bool{
+	"MD5":     true,
+	"SHA-256": true,
+}
+
+// TechError describes a resource whose technical details do not reference a usable file
+type TechError struct {
+	// ResourceReference is the reference of the resource, e.g. A1
+	ResourceReference string
+	// Path is the DDEX path of the offending element
+	Path string
+	// Message explains the problem
+	Message string
+}
+
+// Error implements the error interface
+func (e TechError) Error() string {
+	return fmt.Sprintf("%s (%s): %s", e.Path, e.ResourceReference, e.Message)
+}
+
+// ValidateTechnicalReferences checks that every resource has TechnicalDetails with at least one File that
+// has a non-empty URI, and that every declared HashSum algorithm is in AllowedHashSumAlgorithms
+func ValidateTechnicalReferences(msg *ernv432.NewReleaseMessage) []TechError {
+	var errs []TechError
+	for _, resource := range technicalFiles(msg) {
+		errs = append(errs, checkTechnicalFiles(resource.reference, resource.resourceType, resource.filePath, resource.files)...)
+	}
+	return errs
+}
+
+// resourceFiles are the Files in the technical details of one resource
+type resourceFiles struct {
+	reference    string
+	resourceType string
+	// filePath is the path of the File elements relative to the resource
+	filePath string
+	files    []*ernv432.File
+}
+
+// technicalFiles collects the technical details Files of every resource, in document order
+func technicalFiles(msg *ernv432.NewReleaseMessage) []resourceFiles {
+	var resources []resourceFiles
+	resourceList := msg.GetResourceList()
+
+	for _, sr := range resourceList.GetSoundRecording() {
+		var files []*ernv432.File
+		for _, edition := range sr.GetSoundRecordingEdition() {
+			for _, details := range edition.GetTechnicalDetails() {
+				for _, deliveryFile := range details.GetDeliveryFile() {
+					files = append(files, deliveryFile.GetFile())
+				}
+			}
+		}
+		resources = append(resources, resourceFiles{sr.GetResourceReference(), "SoundRecording", "SoundRecordingEdition/TechnicalDetails/DeliveryFile/File", files})
+	}
+
+	for _, video := range resourceList.GetVideo() {
+		var files []*ernv432.File
+		for _, edition := range video.GetVideoEdition() {
+			for _, details := range edition.GetTechnicalDetails() {
+				for _, deliveryFile := range details.GetDeliveryFile() {
+					files = append(files, deliveryFile.GetFile())
+				}
+			}
+		}
+		resources = append(resources, resourceFiles{video.GetResourceReference(), "Video", "VideoEdition/TechnicalDetails/DeliveryFile/File", files})
+	}
+
+	for _, image := range resourceList.GetImage() {
+		var files []*ernv432.File
+		for _, details := range image.GetTechnicalDetails() {
+			files = append(files, details.GetFile())
+		}
+		resources = append(resources, resourceFiles{image.GetResourceReference(), "Image", "TechnicalDetails/File", files})
+	}
+
+	for _, text := range resourceList.GetText() {
+		var files []*ernv432.File
+		for _, details := range text.GetTechnicalDetails() {
+			files = append(files, details.GetFile())
+		}
+		resources = append(resources, resourceFiles{text.GetResourceReference(), "Text", "TechnicalDetails/File", files})
+	}
+
+	for _, sheetMusic := range resourceList.GetSheetMusic() {
+		var files []*ernv432.File
+		for _, details := range sheetMusic.GetTechnicalDetails() {
+			files = append(files, details.GetFile())
+		}
+		resources = append(resources, resourceFiles{sheetMusic.GetResourceReference(), "SheetMusic", "TechnicalDetails/File", files})
+	}
+
+	for _, software := range resourceList.GetSoftware() {
+		var files []*ernv432.File
+		for _, details := range software.GetTechnicalDetails() {
+			files = append(files, details.GetFile())
+		}
+		resources = append(resources, resourceFiles{software.GetResourceReference(), "Software", "TechnicalDetails/File", files})
+	}
+
+	return resources
+}
+
+// checkTechnicalFiles validates the files collected from one resource's technical details;
+// filePath is the path of the File elements relative to the resource
+func checkTechnicalFiles(resourceReference, resourceType, filePath string, files []*ernv432.File) []TechError {
+	path := "/NewReleaseMessage/ResourceList/" + resourceType
+	var errs []TechError
+
+	hasURI := false
+	for _, file := range files {
+		if strings.TrimSpace(file.GetURI()) != "" {
+			hasURI = true
+		}
+
+		if algorithm := file.GetHashSum().GetAlgorithm(); algorithm != nil {
+			if !AllowedHashSumAlgorithms[strings.TrimSpace(algorithm.GetValue())] {
+				errs = append(errs, TechError{
+					ResourceReference: resourceReference,
+					Path:              path + "/" + filePath + "/HashSum/Algorithm",
+					Message:           fmt.Sprintf("unsupported HashSum algorithm %q", algorithm.GetValue()),
+				})
+			}
+		}
+	}
+
+	if !hasURI {
+		errs = append(errs, TechError{
+			ResourceReference: resourceReference,
+			Path:              path,
+			Message:           "no TechnicalDetails File with a URI",
+		})
+	}
+	return errs
+}
@@ -0,0 +1,168 @@
+package ddex
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"sync"
+
+	avslatest "github.com/alecsavvy/ddex-proto/gen/ddex/avs/vlatest"
+	ernv432 "github.com/alecsavvy/ddex-proto/gen/ddex/ern/v432"
+)
+
+// TerritoryWorldwide is the DDEX territory code that covers every territory
+const TerritoryWorldwide = "Worldwide"
+
+// Deal is a deal that applies to a release, as resolved by DealsForTerritory
+type Deal struct {
+	// ReleaseReference is the DealReleaseReference the deal was listed under
+	ReleaseReference string
+	Deal             *ernv432.Deal
+}
+
+// DealsForTerritory returns, per release, the deals in msg that apply to an ISO 3166-1 territory code.
+// A deal applies when its TerritoryCode list contains the territory or Worldwide (or only
+// ExcludedTerritoryCode is given, which implies Worldwide) and the territory is not excluded.
+func DealsForTerritory(msg *ernv432.NewReleaseMessage, territory string) []Deal {
+	var deals []Deal
+	for _, releaseDeal := range msg.GetDealList().GetReleaseDeal() {
+		for _, deal := range releaseDeal.GetDeal() {
+			if !dealAppliesInTerritory(deal.GetDealTerms(), territory) {
+				continue
+			}
+			for _, releaseReference := range releaseDeal.GetDealReleaseReference() {
+				deals = append(deals, Deal{ReleaseReference: releaseReference, Deal: deal})
+			}
+		}
+	}
+	return deals
+}
+
+// dealAppliesInTerritory applies the TerritoryCode/ExcludedTerritoryCode rules of a deal's terms
+func dealAppliesInTerritory(terms *ernv432.DealTerms, territory string) bool {
+	if terms == nil {
+		return false
+	}
+
+	if containsTerritory(terms.GetExcludedTerritoryCode(), territory) {
+		return false
+	}
+
+	included := terms.GetTerritoryCode()
+	if len(included) == 0 {
+		// ExcludedTerritoryCode on its own means Worldwide minus the exclusions
+		return len(terms.GetExcludedTerritoryCode()) > 0
+	}
+	return containsTerritory(included, territory) || containsTerritory(included, TerritoryWorldwide)
+}
+
+// containsTerritory reports whether codes lists territory, ignoring case and surrounding whitespace
+func containsTerritory(codes []*ernv432.CurrentTerritoryCode, territory string) bool {
+	territory = strings.TrimSpace(territory)
+	for _, code := range codes {
+		if strings.EqualFold(strings.TrimSpace(code.GetValue()), territory) {
+			return true
+		}
+	}
+	return false
+}
+
+// deprecatedTerritories are AVS CurrentTerritoryCode values DDEX deprecated in 2017-11 (Netherlands
+// Antilles, Serbia and Montenegro), which Worldwide no longer expands to
+var deprecatedTerritories = map[string]bool{"AN": true, "CS": true}
+
+// worldwideTerritories is the set of ISO 3166-1 alpha-2 codes in the AVS CurrentTerritoryCode list
+var worldwideTerritories = sync.OnceValue(func() map[string]bool {
+	territories := make(map[string]bool)
+	for number := range avslatest.CurrentTerritoryCode_name {
+		code := avslatest.CurrentTerritoryCode(number).XMLString()
+		if isAlpha2(code) && !deprecatedTerritories[code] {
+			territories[code] = true
+		}
+	}
+	return territories
+})
+
+// isAlpha2 reports whether code is a two-letter uppercase ISO 3166-1 code
+func isAlpha2(code string) bool {
+	return len(code) == 2 && code[0] >= 'A' && code[0] <= 'Z' && code[1] >= 'A' && code[1] <= 'Z'
+}
+
+// CoveredTerritories returns the sorted ISO 3166-1 alpha-2 codes covered by the deals of a release: the
+// union over every Deal of a ReleaseDeal referencing releaseRef of its TerritoryCode list minus its
+// ExcludedTerritoryCode list. Worldwide (or exclusions on their own) expands to every current ISO
+// territory of the AVS. It returns an error if no deal references the release, or a deal uses a code that
+// does not resolve to ISO 3166-1 territories (a TIS region or ISO 3166-2 subdivision).
+func CoveredTerritories(msg *ernv432.NewReleaseMessage, releaseRef string) ([]string, error) {
+	releaseRef = strings.TrimSpace(releaseRef)
+	covered := make(map[string]bool)
+	found := false
+	for _, releaseDeal := range msg.GetDealList().GetReleaseDeal() {
+		referenced := false
+		for _, reference := range releaseDeal.GetDealReleaseReference() {
+			referenced = referenced || strings.TrimSpace(reference) == releaseRef
+		}
+		if !referenced {
+			continue
+		}
+		found = true
+
+		for _, deal := range releaseDeal.GetDeal() {
+			territories, err := dealTerritories(deal.GetDealTerms())
+			if err != nil {
+				return nil, fmt.Errorf("deal for release %s: %w", releaseRef, err)
+			}
+			for territory := range territories {
+				covered[territory] = true
+			}
+		}
+	}
+	if !found {
+		return nil, fmt.Errorf("no deals reference release %s", releaseRef)
+	}
+
+	territories := make([]string, 0, len(covered))
+	for territory := range covered {
+		territories = append(territories, territory)
+	}
+	sort.Strings(territories)
+	return territories, nil
+}
+
+// dealTerritories resolves the TerritoryCode/ExcludedTerritoryCode rules of a deal's terms, following
+// dealAppliesInTerritory, to a set of ISO 3166-1 codes
+func dealTerritories(terms *ernv432.DealTerms) (map[string]bool, error) {
+	territories := make(map[string]bool)
+	if terms == nil {
+		return territories, nil
+	}
+
+	included := terms.GetTerritoryCode()
+	if len(included) == 0 && len(terms.GetExcludedTerritoryCode()) > 0 {
+		for territory := range worldwideTerritories() {
+			territories[territory] = true
+		}
+	}
+	for _, code := range included {
+		territory := strings.ToUpper(strings.TrimSpace(code.GetValue()))
+		switch {
+		case strings.EqualFold(territory, TerritoryWorldwide):
+			for territory := range worldwideTerritories() {
+				territories[territory] = true
+			}
+		case isAlpha2(territory):
+			territories[territory] = true
+		default:
+			return nil, fmt.Errorf("territory code %q does not resolve to ISO 3166-1 territories", code.GetValue())
+		}
+	}
+
+	for _, code := range terms.GetExcludedTerritoryCode() {
+		territory := strings.ToUpper(strings.TrimSpace(code.GetValue()))
+		if !isAlpha2(territory) {
+			return nil, fmt.Errorf("excluded territory code %q does not resolve to ISO 3166-1 territories", code.GetValue())
+		}
+		delete(territories, territory)
+	}
+	return territories, nil
+}
@@ -0,0 +1,91 @@
+package testutil
+
+import (
+	"bytes"
+	"flag"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/alecsavvy/ddex-proto/testdata"
+)
+
+// UpdateGoldenFiles rewrites golden files from the current marshal output instead of comparing against them.
+// Enable with: go test -run TestDDEX -update
+var UpdateGoldenFiles = flag.Bool("update", false, "update golden files with current marshal output")
+
+// GoldenDir is the directory (relative to the test package) where golden files are stored
+const GoldenDir = "testdata/golden"
+
+// GetGoldenFilePath returns the path of the golden file for a given test file
+func GetGoldenFilePath(messageType, version, testName string) string {
+	return filepath.Join(GoldenDir, messageType, version, testName)
+}
+
+// RunGoldenTests parses and re-marshals every test file for a message type and version and compares the
+// result to the committed golden file. When UpdateGoldenFiles is set the golden files are rewritten instead.
+func RunGoldenTests(t *testing.T, messageType, version string, marshaler RoundTripValidator) {
+	testFiles, err := testdata.GenerateTestFileMap(messageType, version)
+	if err != nil {
+		t.Fatalf("Failed to generate test file map: %v", err)
+	}
+
+	if len(testFiles) == 0 {
+		t.Skipf("No %s %s test files available yet", messageType, version)
+	}
+
+	for testName, xmlData := range testFiles {
+		t.Run(testName, func(t *testing.T) {
+			marshaled, err := marshaler(xmlData)
+			if err != nil {
+				t.Fatalf("Failed to re-marshal %s: %v", testName, err)
+			}
+
+			goldenPath := GetGoldenFilePath(messageType, version, testName)
+
+			if *UpdateGoldenFiles {
+				if err := os.MkdirAll(filepath.Dir(goldenPath), 0755); err != nil {
+					t.Fatalf("Failed to create golden directory: %v", err)
+				}
+				if err := os.WriteFile(goldenPath, marshaled, 0644); err != nil {
+					t.Fatalf("Failed to write golden file %s: %v", goldenPath, err)
+				}
+				t.Logf("✓ Updated golden file %s", goldenPath)
+				return
+			}
+
+			golden, err := os.ReadFile(goldenPath)
+			if err != nil {
+				t.Fatalf("Failed to read golden file %s (run with -update to create it): %v", goldenPath, err)
+			}
+
+			if !bytes.Equal(golden, marshaled) {
+				t.Errorf("🔴 Marshal output differs from golden file %s (run with -update if the change is intended)", goldenPath)
+				if line, want, got := firstDifferingLine(golden, marshaled); line > 0 {
+					t.Errorf("  first difference at line %d:\n    golden: %s\n    actual: %s", line, want, got)
+				}
+			}
+		})
+	}
+}
+
+// firstDifferingLine returns the 1-based line number and contents of the first line that differs
+func firstDifferingLine(golden, actual []byte) (int, string, string) {
+	goldenLines := strings.Split(string(golden), "\n")
+	actualLines := strings.Split(string(actual), "\n")
+
+	for i := 0; i < Max(len(goldenLines), len(actualLines)); i++ {
+		var want, got string
+		if i < len(goldenLines) {
+			want = goldenLines[i]
+		}
+		if i < len(actualLines) {
+			got = actualLines[i]
+		}
+		if want != got {
+			return i + 1, strings.TrimSpace(want), strings.TrimSpace(got)
+		}
+	}
+	return 0, "", ""
+}
@@ -0,0 +1,177 @@
+package ddex
+
+import (
+	"reflect"
+	"strings"
+
+	ernv432 "github.com/alecsavvy/ddex-proto/gen/ddex/ern/v432"
+)
+
+// ResourcesMissingTitle returns the ResourceReferences of SoundRecordings, Videos and Images that have no
+// non-empty DisplayTitleText or DisplayTitle/TitleText. ERN 4.3.2 has no ReferenceTitle; DisplayTitleText
+// is its replacement.
+func ResourcesMissingTitle(msg *ernv432.NewReleaseMessage) []string {
+	var missing []string
+	resources := msg.GetResourceList()
+
+	for _, sr := range resources.GetSoundRecording() {
+		if !hasTitle(sr.GetDisplayTitleText(), sr.GetDisplayTitle()) {
+			missing = append(missing, sr.GetResourceReference())
+		}
+	}
+	for _, video := range resources.GetVideo() {
+		if !hasTitle(video.GetDisplayTitleText(), video.GetDisplayTitle()) {
+			missing = append(missing, video.GetResourceReference())
+		}
+	}
+	for _, image := range resources.GetImage() {
+		if !hasTitle(image.GetDisplayTitleText(), image.GetDisplayTitle()) {
+			missing = append(missing, image.GetResourceReference())
+		}
+	}
+
+	return missing
+}
+
+// hasTitle reports whether any display title has non-whitespace text
+func hasTitle(titleTexts []*ernv432.DisplayTitleText, titles []*ernv432.DisplayTitle) bool {
+	for _, titleText := range titleTexts {
+		if strings.TrimSpace(titleText.GetValue()) != "" {
+			return true
+		}
+	}
+	for _, title := range titles {
+		if strings.TrimSpace(title.GetTitleText()) != "" {
+			return true
+		}
+	}
+	return false
+}
+
+// localizedTitleFields are the title elements LocalizedTitles reads, most preferred first
+var localizedTitleFields = map[string]int{
+	"DisplayTitleText": 0,
+	"DisplayTitle":     1,
+	"Title":            2,
+	"ReferenceTitle":   3,
+}
+
+// LocalizedTitles returns the titles of the releases and resources of any generated DDEX message, keyed by
+// ReleaseReference or ResourceReference and then by LanguageAndScriptCode ("" for titles without one). Each
+// release or resource is searched for DisplayTitleText, DisplayTitle, Title and ReferenceTitle elements,
+// including those nested in ReleaseDetailsByTerritory and the like; per language the first title of the
+// most preferred kind, in that order, is used.
+func LocalizedTitles(msg interface{}) map[string]map[string]string {
+	titles := make(map[string]map[string]string)
+	collectLocalizedTitles(reflect.ValueOf(msg), titles)
+	return titles
+}
+
+// collectLocalizedTitles walks a value for releases and resources and records their titles
+func collectLocalizedTitles(v reflect.Value, titles map[string]map[string]string) {
+	switch v.Kind() {
+	case reflect.Ptr, reflect.Interface:
+		if !v.IsNil() {
+			collectLocalizedTitles(v.Elem(), titles)
+		}
+	case reflect.Slice:
+		for i := 0; i < v.Len(); i++ {
+			collectLocalizedTitles(v.Index(i), titles)
+		}
+	case reflect.Struct:
+		if reference := titledReference(v); reference != "" {
+			ranks := make(map[string]int)
+			byLanguage := make(map[string]string)
+			gatherTitles(v, ranks, byLanguage)
+			if len(byLanguage) > 0 {
+				titles[reference] = byLanguage
+			}
+		}
+		for i := 0; i < v.NumField(); i++ {
+			if _, ok := xmlFieldOf(v.Type().Field(i)); ok {
+				collectLocalizedTitles(v.Field(i), titles)
+			}
+		}
+	}
+}
+
+// titledReference returns the ReleaseReference or ResourceReference a struct declares, or ""
+func titledReference(v reflect.Value) string {
+	for _, name := range []string{"ReleaseReference", "ResourceReference"} {
+		// ERN 3.8.x repeats ReleaseReference, whose first value is the release's own
+		if values := stringValues(v.FieldByName(name)); len(values) > 0 {
+			return strings.TrimSpace(values[0])
+		}
+	}
+	return ""
+}
+
+// gatherTitles records the titles of a release or resource by language, keeping the best ranked per
+// language; it does not descend into nested releases or resources
+func gatherTitles(v reflect.Value, ranks map[string]int, byLanguage map[string]string) {
+	t := v.Type()
+	for i := 0; i < t.NumField(); i++ {
+		field, ok := xmlFieldOf(t.Field(i))
+		if !ok || field.Attr || field.CharData || field.InnerXML {
+			continue
+		}
+		rank, isTitle := localizedTitleFields[field.Name]
+		for _, element := range structElements(v.Field(i)) {
+			if !isTitle {
+				if titledReference(element) == "" {
+					gatherTitles(element, ranks, byLanguage)
+				}
+				continue
+			}
+			language, text := titleOf(element)
+			if text == "" {
+				continue
+			}
+			if current, ok := ranks[language]; !ok || rank < current {
+				ranks[language] = rank
+				byLanguage[language] = text
+			}
+		}
+	}
+}
+
+// structElements returns the struct values of a pointer, slice of pointers, or struct field value
+func structElements(v reflect.Value) []reflect.Value {
+	switch v.Kind() {
+	case reflect.Ptr:
+		if !v.IsNil() && v.Elem().Kind() == reflect.Struct {
+			return []reflect.Value{v.Elem()}
+		}
+	case reflect.Slice:
+		var elements []reflect.Value
+		for i := 0; i < v.Len(); i++ {
+			elements = append(elements, structElements(v.Index(i))...)
+		}
+		return elements
+	case reflect.Struct:
+		return []reflect.Value{v}
+	}
+	return nil
+}
+
+// titleOf returns the language and text of a title element: its character data or TitleText, with the
+// LanguageAndScriptCode of the title or, failing that, of its TitleText
+func titleOf(title reflect.Value) (language, text string) {
+	language = stringField(title, "LanguageAndScriptCode")
+	text = stringField(title, "Value")
+	if text != "" {
+		return language, text
+	}
+
+	titleText := title.FieldByName("TitleText")
+	if titleText.Kind() == reflect.String {
+		return language, strings.TrimSpace(titleText.String())
+	}
+	for _, element := range structElements(titleText) {
+		if language == "" {
+			language = stringField(element, "LanguageAndScriptCode")
+		}
+		return language, stringField(element, "Value")
+	}
+	return language, ""
+}
@@ -0,0 +1,179 @@
+package ddex
+
+import (
+	"fmt"
+	"sort"
+
+	ernv432 "github.com/alecsavvy/ddex-proto/gen/ddex/ern/v432"
+)
+
+// Track is a sound recording or video of a release, as resolved by TrackListing
+type Track struct {
+	ResourceReference string
+	// DiscNumber is the SequenceNumber (or 1-based position) of the top-level ResourceGroup the track
+	// is in, or 1 for tracks directly in the release's ResourceGroup
+	DiscNumber int
+	// TrackNumber is the SequenceNumber (or 1-based position) of the track in its ResourceGroup
+	TrackNumber   int
+	Title         string
+	DisplayArtist string
+	ISRC          string
+	// Duration is the ISO 8601 duration as given, e.g. PT3M25S
+	Duration string
+}
+
+// TrackListing returns the tracks of the Release or TrackRelease with releaseRef in listing order. The
+// ResourceGroup of a release is traversed depth first, ordering the ResourceGroupContentItems and
+// nested ResourceGroups of each group by SequenceNumber (document order where they have none), so the
+// top-level groups of a multi-disc release become its discs. Items referencing resources other than
+// SoundRecordings and Videos (such as a booklet) are not tracks and are skipped; titles and artist names
+// are the default (or first) DisplayTitleText and DisplayArtistName.
+func TrackListing(msg *ernv432.NewReleaseMessage, releaseRef string) ([]Track, error) {
+	tracks := make(map[string]Track)
+	others := make(map[string]bool)
+	list := msg.GetResourceList()
+	for _, recording := range list.GetSoundRecording() {
+		track := newTrack(recording.GetResourceReference(), recording.GetDisplayTitleText(), recording.GetDisplayTitle(),
+			recording.GetDisplayArtistName(), recording.GetDuration())
+		for _, edition := range recording.GetSoundRecordingEdition() {
+			for _, id := range edition.GetResourceId() {
+				if track.ISRC == "" {
+					track.ISRC = id.GetISRC()
+				}
+			}
+		}
+		tracks[track.ResourceReference] = track
+	}
+	for _, video := range list.GetVideo() {
+		track := newTrack(video.GetResourceReference(), video.GetDisplayTitleText(), video.GetDisplayTitle(),
+			video.GetDisplayArtistName(), video.GetDuration())
+		for _, edition := range video.GetVideoEdition() {
+			for _, id := range edition.GetResourceId() {
+				if track.ISRC == "" {
+					track.ISRC = id.GetISRC()
+				}
+			}
+		}
+		tracks[track.ResourceReference] = track
+	}
+	for _, image := range list.GetImage() {
+		others[image.GetResourceReference()] = true
+	}
+	for _, text := range list.GetText() {
+		others[text.GetResourceReference()] = true
+	}
+	for _, sheetMusic := range list.GetSheetMusic() {
+		others[sheetMusic.GetResourceReference()] = true
+	}
+
+	resolve := func(reference string, disc, number int) (*Track, error) {
+		track, ok := tracks[reference]
+		if !ok {
+			if others[reference] {
+				return nil, nil
+			}
+			return nil, fmt.Errorf("release %s references unknown resource %s", releaseRef, reference)
+		}
+		track.DiscNumber = disc
+		track.TrackNumber = number
+		return &track, nil
+	}
+
+	if release := msg.GetReleaseList().GetRelease(); release != nil && release.GetReleaseReference() == releaseRef {
+		var listing []Track
+		group := release.GetResourceGroup()
+		err := listGroup(group.GetResourceGroupContentItem(), group.GetResourceGroup(), 0, func(item *ernv432.ResourceGroupContentItem, disc, number int) error {
+			if disc == 0 {
+				disc = 1
+			}
+			track, err := resolve(item.GetReleaseResourceReference(), disc, number)
+			if track != nil {
+				listing = append(listing, *track)
+			}
+			return err
+		})
+		return listing, err
+	}
+	for _, trackRelease := range msg.GetReleaseList().GetTrackRelease() {
+		if trackRelease.GetReleaseReference() != releaseRef {
+			continue
+		}
+		track, err := resolve(trackRelease.GetReleaseResourceReference(), 1, 1)
+		if err != nil || track == nil {
+			return nil, err
+		}
+		return []Track{*track}, nil
+	}
+	return nil, fmt.Errorf("release %s not found", releaseRef)
+}
+
+// newTrack builds a Track from the fields SoundRecordings and Videos share
+func newTrack(reference string, titleTexts []*ernv432.DisplayTitleText, titles []*ernv432.DisplayTitle, names []*ernv432.DisplayArtistNameWithOriginalLanguage, duration string) Track {
+	track := Track{ResourceReference: reference, DisplayArtist: defaultDisplayArtistName(names), Duration: duration}
+	if title := defaultDisplayTitleText(titleTexts); title != nil {
+		track.Title = title.GetValue()
+	} else if title := defaultDisplayTitle(titles); title != nil {
+		track.Title = title.GetTitleText()
+	}
+	return track
+}
+
+// groupEntry is a content item or nested group of a ResourceGroup with its listing position
+type groupEntry struct {
+	sequence int
+	item     *ernv432.ResourceGroupContentItem
+	group    *ernv432.ResourceSubGroup
+}
+
+// listGroup visits the content items of a resource group and its nested groups in listing order. disc is
+// the number of the top-level group being listed, 0 while listing the release's own group.
+func listGroup(items []*ernv432.ResourceGroupContentItem, groups []*ernv432.ResourceSubGroup, disc int, visit func(item *ernv432.ResourceGroupContentItem, disc, number int) error) error {
+	var entries []groupEntry
+	numbered := true
+	for _, item := range items {
+		entries = append(entries, groupEntry{sequence: int(item.GetSequenceNumber()), item: item})
+		numbered = numbered && item.GetSequenceNumber() > 0
+	}
+	for _, group := range groups {
+		entries = append(entries, groupEntry{sequence: int(group.GetSequenceNumber()), group: group})
+		numbered = numbered && group.GetSequenceNumber() > 0
+	}
+	if numbered {
+		sort.SliceStable(entries, func(i, j int) bool { return entries[i].sequence < entries[j].sequence })
+	} else {
+		// Without sequence numbers items come before nested groups, as in the XSD content model
+		for i := range entries {
+			entries[i].sequence = i + 1
+		}
+	}
+
+	for _, entry := range entries {
+		if entry.item != nil {
+			if err := visit(entry.item, disc, entry.sequence); err != nil {
+				return err
+			}
+			continue
+		}
+		groupDisc := disc
+		if groupDisc == 0 {
+			groupDisc = entry.sequence
+		}
+		if err := listGroup(entry.group.GetResourceGroupContentItem(), entry.group.GetResourceGroup(), groupDisc, visit); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// defaultDisplayArtistName returns the DisplayArtistName without ApplicableTerritoryCode, or the first one
+func defaultDisplayArtistName(names []*ernv432.DisplayArtistNameWithOriginalLanguage) string {
+	for _, name := range names {
+		if name.GetApplicableTerritoryCode() == "" {
+			return name.GetValue()
+		}
+	}
+	if len(names) > 0 {
+		return names[0].GetValue()
+	}
+	return ""
+}
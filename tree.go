@@ -0,0 +1,102 @@
+package ddex
+
+import (
+	"fmt"
+	"reflect"
+	"strings"
+)
+
+// TreeString renders any generated DDEX message as a compact indented tree of its populated elements, by
+// DDEX element name. An element with text shows it after a colon and attributes are listed beneath their
+// element as @Name: value; nil and empty fields and the NamespaceAttrs map are left out, e.g.
+//
+//	NewReleaseMessage
+//	  @AvsVersionId: 7
+//	  MessageHeader
+//	    MessageId: Test1.1
+func TreeString(msg interface{}) string {
+	v := reflect.ValueOf(msg)
+	for v.Kind() == reflect.Ptr || v.Kind() == reflect.Interface {
+		if v.IsNil() {
+			return ""
+		}
+		v = v.Elem()
+	}
+	if v.Kind() != reflect.Struct {
+		return ""
+	}
+
+	var sb strings.Builder
+	writeTreeStruct(&sb, v, v.Type().Name(), 0)
+	return sb.String()
+}
+
+// writeTreeValue writes the tree of an element value named name at depth, one node per slice item
+func writeTreeValue(sb *strings.Builder, v reflect.Value, name string, depth int) {
+	switch v.Kind() {
+	case reflect.Ptr, reflect.Interface:
+		if !v.IsNil() {
+			writeTreeValue(sb, v.Elem(), name, depth)
+		}
+	case reflect.Slice:
+		if v.Type().Elem().Kind() == reflect.Uint8 {
+			if v.Len() > 0 {
+				writeTreeLine(sb, depth, name, string(v.Bytes()))
+			}
+			return
+		}
+		for i := 0; i < v.Len(); i++ {
+			writeTreeValue(sb, v.Index(i), name, depth)
+		}
+	case reflect.Struct:
+		writeTreeStruct(sb, v, name, depth)
+	case reflect.Map:
+		// Maps (e.g. NamespaceAttrs) are not part of the XML content model
+	default:
+		if !v.IsZero() {
+			writeTreeLine(sb, depth, name, fmt.Sprint(v.Interface()))
+		}
+	}
+}
+
+// writeTreeStruct writes an element with its text, attributes and child elements, or nothing if none of
+// them is populated
+func writeTreeStruct(sb *strings.Builder, v reflect.Value, name string, depth int) {
+	var text string
+	var attrs, children strings.Builder
+	t := v.Type()
+	for i := 0; i < t.NumField(); i++ {
+		field, ok := xmlFieldOf(t.Field(i))
+		if !ok {
+			continue
+		}
+		switch {
+		case field.CharData || field.InnerXML:
+			walkValue(v.Field(i), "", field, func(_ string, _ xmlField, value reflect.Value) {
+				text += strings.TrimSpace(fmt.Sprint(value.Interface()))
+			})
+		case field.Attr:
+			writeTreeValue(&attrs, v.Field(i), "@"+field.Name, depth+1)
+		default:
+			writeTreeValue(&children, v.Field(i), field.Name, depth+1)
+		}
+	}
+	if text == "" && attrs.Len() == 0 && children.Len() == 0 {
+		return
+	}
+
+	writeTreeLine(sb, depth, name, text)
+	sb.WriteString(attrs.String())
+	sb.WriteString(children.String())
+}
+
+// writeTreeLine writes a node: name, and ": value" when it has a value
+func writeTreeLine(sb *strings.Builder, depth int, name, value string) {
+	sb.WriteString(strings.Repeat("  ", depth))
+	sb.WriteString(name)
+	if value != "" {
+		sb.WriteString(": ")
+		sb.WriteString(value)
+	}
+	sb.WriteString("\n")
+}
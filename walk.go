@@ -0,0 +1,106 @@
+package ddex
+
+import (
+	"reflect"
+	"strings"
+)
+
+// xmlField describes how a generated struct field is mapped to XML by its xml struct tag
+type xmlField struct {
+	Name     string
+	Attr     bool
+	CharData bool
+	InnerXML bool
+}
+
+// xmlFieldOf parses the xml tag of a struct field, returning false for fields that are not marshaled
+func xmlFieldOf(sf reflect.StructField) (xmlField, bool) {
+	if !sf.IsExported() {
+		return xmlField{}, false
+	}
+	tag, ok := sf.Tag.Lookup("xml")
+	if !ok || tag == "-" {
+		return xmlField{}, false
+	}
+
+	parts := strings.Split(tag, ",")
+	field := xmlField{Name: parts[0]}
+	for _, opt := range parts[1:] {
+		switch opt {
+		case "attr":
+			field.Attr = true
+		case "chardata":
+			field.CharData = true
+		case "innerxml":
+			field.InnerXML = true
+		}
+	}
+	if field.Name == "" && !field.CharData && !field.InnerXML {
+		field.Name = sf.Name
+	}
+	return field, true
+}
+
+// walkScalars calls visit with the DDEX path (/Root/Child, /Root/Child@attr), xml mapping and value of every
+// non-empty scalar in a generated message, in document order. Character data is reported under its element's path.
+func walkScalars(msg interface{}, visit func(path string, field xmlField, value reflect.Value)) {
+	v := reflect.ValueOf(msg)
+	for v.Kind() == reflect.Ptr || v.Kind() == reflect.Interface {
+		if v.IsNil() {
+			return
+		}
+		v = v.Elem()
+	}
+	if v.Kind() != reflect.Struct {
+		return
+	}
+	walkStruct(v, "/"+v.Type().Name(), visit)
+}
+
+// walkStruct visits the XML-mapped fields of a struct value rooted at path
+func walkStruct(v reflect.Value, path string, visit func(path string, field xmlField, value reflect.Value)) {
+	t := v.Type()
+	for i := 0; i < t.NumField(); i++ {
+		field, ok := xmlFieldOf(t.Field(i))
+		if !ok {
+			continue
+		}
+
+		switch {
+		case field.Attr:
+			walkValue(v.Field(i), path+"@"+field.Name, field, visit)
+		case field.CharData || field.InnerXML:
+			walkValue(v.Field(i), path, field, visit)
+		default:
+			walkValue(v.Field(i), path+"/"+field.Name, field, visit)
+		}
+	}
+}
+
+// walkValue visits a field value, descending into pointers, slices and nested structs
+func walkValue(v reflect.Value, path string, field xmlField, visit func(path string, field xmlField, value reflect.Value)) {
+	switch v.Kind() {
+	case reflect.Ptr, reflect.Interface:
+		if !v.IsNil() {
+			walkValue(v.Elem(), path, field, visit)
+		}
+	case reflect.Slice:
+		if v.Type().Elem().Kind() == reflect.Uint8 {
+			if v.Len() > 0 {
+				visit(path, field, v)
+			}
+			return
+		}
+		for i := 0; i < v.Len(); i++ {
+			walkValue(v.Index(i), path, field, visit)
+		}
+	case reflect.Struct:
+		walkStruct(v, path, visit)
+	case reflect.Map:
+		// Maps (e.g. NamespaceAttrs) are not part of the XML content model
+	default:
+		if !v.IsZero() {
+			visit(path, field, v)
+		}
+	}
+}
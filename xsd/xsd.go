@@ -0,0 +1,9 @@
+// Package xsd embeds the DDEX schema files used for code generation and for ddex.ValidateAgainstSchema
+package xsd
+
+import "embed"
+
+// FS embeds the message schemas (e.g. ernv432/release-notification.xsd) and the AVS schemas they import
+//
+//go:embed *.xsd */*.xsd
+var FS embed.FS
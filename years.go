@@ -0,0 +1,51 @@
+package ddex
+
+import (
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// minCopyrightYear is the earliest PLine/CLine year ValidateCopyrightYears accepts
+const minCopyrightYear = 1900
+
+// YearError describes a PLine or CLine Year that is not a plausible copyright year
+type YearError struct {
+	// Path is the DDEX path of the Year element, e.g. /NewReleaseMessage/ReleaseList/Release/PLine/Year
+	Path string
+	// Year is the rejected value
+	Year string
+	// Message explains why the year is invalid
+	Message string
+}
+
+// Error implements the error interface
+func (e YearError) Error() string {
+	return fmt.Sprintf("%s: %s (%q)", e.Path, e.Message, e.Year)
+}
+
+// ValidateCopyrightYears checks every PLine/Year and CLine/Year in any generated DDEX message is a
+// four-digit year from 1900 to next year, catching placeholders such as "0000" and typos in the future
+// that distributors reject. Each invalid occurrence is reported.
+func ValidateCopyrightYears(msg interface{}) []YearError {
+	maxYear := time.Now().Year() + 1
+
+	var errs []YearError
+	walkScalars(msg, func(path string, field xmlField, value reflect.Value) {
+		if value.Kind() != reflect.String || !(strings.HasSuffix(path, "/PLine/Year") || strings.HasSuffix(path, "/CLine/Year")) {
+			return
+		}
+
+		text := strings.TrimSpace(value.String())
+		year, err := strconv.Atoi(text)
+		switch {
+		case len(text) != 4 || err != nil || strings.Trim(text, "0123456789") != "":
+			errs = append(errs, YearError{Path: path, Year: text, Message: "year is not four digits"})
+		case year < minCopyrightYear || year > maxYear:
+			errs = append(errs, YearError{Path: path, Year: text, Message: fmt.Sprintf("year is outside %d..%d", minCopyrightYear, maxYear)})
+		}
+	})
+	return errs
+}
@@ -0,0 +1,81 @@
+package ddex
+
+import (
+	"archive/zip"
+	"fmt"
+	"io"
+	"path"
+	"strings"
+
+	"github.com/alecsavvy/ddex-proto/gen"
+)
+
+// ZipEntry is a file of a zip archive read by ParseZip
+type ZipEntry struct {
+	// Name is the path of the file in the archive
+	Name string
+	// Message is the parsed message of an .xml entry, nil for other files
+	Message     interface{}
+	MessageType string
+	Version     string
+	// Err is set for an .xml entry that could not be read or parsed as a DDEX message
+	Err error
+}
+
+// MaxZipEntrySize is the largest uncompressed .xml entry ParseZip reads; larger entries are reported in
+// their Err rather than read, so a small archive cannot expand into an unbounded document
+const MaxZipEntrySize = 64 << 20
+
+// ParseZip reads a zip archive of a delivery, such as a message with its audio and artwork, and returns
+// its files in archive order. Each .xml file (by extension, ignoring case) is parsed like gen.ParseAny;
+// other files, such as media, are listed by name without being read. A file that does not parse is
+// reported in its entry's Err and does not stop the others. Directories and the __MACOSX metadata that
+// macOS adds to archives are skipped. The error is only set when r is not a readable zip archive.
+// .xml entries larger than MaxZipEntrySize are not parsed (see ParseZipWithLimit).
+func ParseZip(r io.ReaderAt, size int64) ([]ZipEntry, error) {
+	return ParseZipWithLimit(r, size, MaxZipEntrySize)
+}
+
+// ParseZipWithLimit is ParseZip with .xml entries limited to maxEntrySize uncompressed bytes, whether
+// declared in the archive or found on reading. A larger entry gets an Err and is not parsed.
+func ParseZipWithLimit(r io.ReaderAt, size, maxEntrySize int64) ([]ZipEntry, error) {
+	archive, err := zip.NewReader(r, size)
+	if err != nil {
+		return nil, fmt.Errorf("failed to open zip archive: %w", err)
+	}
+
+	var entries []ZipEntry
+	for _, file := range archive.File {
+		if file.FileInfo().IsDir() || strings.HasPrefix(file.Name, "__MACOSX/") {
+			continue
+		}
+		entry := ZipEntry{Name: file.Name}
+		if strings.EqualFold(path.Ext(file.Name), ".xml") {
+			entry.Message, entry.MessageType, entry.Version, entry.Err = parseZipFile(file, maxEntrySize)
+		}
+		entries = append(entries, entry)
+	}
+	return entries, nil
+}
+
+// parseZipFile reads and parses one file of an archive of at most maxSize uncompressed bytes
+func parseZipFile(file *zip.File, maxSize int64) (message interface{}, messageType, version string, err error) {
+	if file.UncompressedSize64 > uint64(maxSize) {
+		return nil, "", "", fmt.Errorf("%s is larger than %d bytes", file.Name, maxSize)
+	}
+	rc, err := file.Open()
+	if err != nil {
+		return nil, "", "", fmt.Errorf("failed to open %s: %w", file.Name, err)
+	}
+	defer rc.Close()
+
+	// The declared size may understate the content, so the read is bounded too
+	xmlData, err := io.ReadAll(io.LimitReader(rc, maxSize+1))
+	if err != nil {
+		return nil, "", "", fmt.Errorf("failed to read %s: %w", file.Name, err)
+	}
+	if int64(len(xmlData)) > maxSize {
+		return nil, "", "", fmt.Errorf("%s is larger than %d bytes", file.Name, maxSize)
+	}
+	return gen.ParseAny(xmlData)
+}